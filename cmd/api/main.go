@@ -19,15 +19,16 @@ import (
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/telemetry"
 	"github.com/fumkob/ezqrin-server/internal/interface/api"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
 	"go.opentelemetry.io/contrib/bridges/otelzap"
 	"go.uber.org/zap"
 )
 
 const (
-	shutdownTimeout      = 15 * time.Second
-	dbHealthCheckTimeout = 30 * time.Second
-	dbRetryInterval      = 5 * time.Second
+	telemetryShutdownTimeout = 15 * time.Second
+	dbHealthCheckTimeout     = 30 * time.Second
+	dbRetryInterval          = 5 * time.Second
 )
 
 // app holds the application's core dependencies.
@@ -68,6 +69,12 @@ func main() {
 		appLogger = appLogger.WithOTelCore(otelCore)
 	}
 
+	// Log the effective configuration so that misconfiguration can be diagnosed; secrets are masked
+	appLogger.Info("starting ezqrin-server",
+		zap.String("environment", cfg.Server.Environment),
+		zap.Any("config", cfg.Redacted()),
+	)
+
 	a := &app{
 		logger:            appLogger,
 		telemetryShutdown: shutdownTelemetry,
@@ -85,18 +92,42 @@ func main() {
 		a.logger.Fatal("failed to initialize container", zap.Error(err))
 	}
 
-	// Setup router with dependencies
+	// Start the outbox relay; it is stopped before the database is closed.
+	// With webhooks disabled, domain events stay queued in the outbox until they are enabled again.
+	if cfg.Features.Webhooks {
+		stopRelay := startWorker(appContainer.OutboxRelay.Run)
+		defer stopRelay()
+	} else {
+		a.logger.Info("webhooks feature disabled, outbox relay not started")
+	}
+
+	// Start the retention purger when a retention period is configured
+	if cfg.Retention.PurgeAfterDays > 0 {
+		stopPurger := startWorker(appContainer.RetentionPurger.Run)
+		defer stopPurger()
+	} else {
+		a.logger.Info("data retention purge disabled, retention purger not started")
+	}
+
+	// Start the participant expirer; events opt in by setting a tentative expiry
+	stopExpirer := startWorker(appContainer.ParticipantExpirer.Run)
+	defer stopExpirer()
+
+	// Setup router with dependencies; shutdown also waits for the QR scans still being recorded
+	drain := middleware.NewDrain()
+	drain.WaitFor(appContainer.UseCases.Checkin.WaitForScans)
 	router := api.SetupRouter(&api.RouterDependencies{
 		Config:    cfg,
 		Logger:    a.logger,
 		DB:        a.db,
 		Cache:     a.cache,
 		Container: appContainer,
+		Drain:     drain,
 	})
 
 	// Create and run HTTP server
 	srv := createServer(cfg, router)
-	a.runServerWithGracefulShutdown(srv, cfg)
+	a.runServerWithGracefulShutdown(srv, drain, cfg)
 }
 
 // createServer creates and configures the HTTP server.
@@ -110,8 +141,10 @@ func createServer(cfg *config.Config, handler http.Handler) *http.Server {
 	}
 }
 
-// runServerWithGracefulShutdown starts the server and handles graceful shutdown.
-func (a *app) runServerWithGracefulShutdown(srv *http.Server, cfg *config.Config) {
+// runServerWithGracefulShutdown starts the server and handles graceful shutdown. On a signal
+// it drains first: new requests are rejected with 503 and check-in streams are closed while
+// the requests in flight get up to the shutdown timeout to finish.
+func (a *app) runServerWithGracefulShutdown(srv *http.Server, drain *middleware.Drain, cfg *config.Config) {
 	// Start server in a goroutine
 	serverErrors := make(chan error, 1)
 	go func() {
@@ -137,9 +170,18 @@ func (a *app) runServerWithGracefulShutdown(srv *http.Server, cfg *config.Config
 		)
 
 		// Create context with timeout for shutdown
-		shutdownCtx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
 		defer cancel()
 
+		// Stop accepting requests and wait for the ones in flight
+		drain.Start()
+		if err := drain.Wait(shutdownCtx); err != nil {
+			a.logger.Warn("shutdown timeout reached with requests in flight",
+				zap.Int64("in_flight", drain.InFlight()),
+				zap.Duration("timeout", cfg.Server.ShutdownTimeout),
+			)
+		}
+
 		// Attempt graceful shutdown
 		if err := srv.Shutdown(shutdownCtx); err != nil {
 			a.logger.Error("error during shutdown", zap.Error(err))
@@ -152,6 +194,21 @@ func (a *app) runServerWithGracefulShutdown(srv *http.Server, cfg *config.Config
 	}
 }
 
+// startWorker runs a background worker and returns a function that stops it and waits
+// for the batch in progress to finish.
+func startWorker(run func(ctx context.Context)) func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		run(ctx)
+	}()
+	return func() {
+		cancel()
+		<-done
+	}
+}
+
 // initializeInfrastructure initializes basic infrastructure dependencies.
 func (a *app) initializeInfrastructure(ctx context.Context, cfg *config.Config) error {
 	a.logger.Info("initializing application infrastructure")
@@ -237,7 +294,7 @@ func (a *app) cleanup() {
 
 	// Shutdown telemetry before logger sync to flush remaining telemetry data
 	if a.telemetryShutdown != nil {
-		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		ctx, cancel := context.WithTimeout(context.Background(), telemetryShutdownTimeout)
 		defer cancel()
 		if err := a.telemetryShutdown(ctx); err != nil {
 			if a.logger != nil {
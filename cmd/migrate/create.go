@@ -0,0 +1,98 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+)
+
+const (
+	migrationFilePerm = 0o644
+	migrationSeqWidth = 6
+)
+
+var (
+	// migrationNamePattern restricts names to the snake_case used by existing migrations.
+	migrationNamePattern = regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`)
+	// migrationFilePattern matches migration files and captures their sequence number.
+	migrationFilePattern = regexp.MustCompile(`^(\d+)_.+\.(up|down)\.sql$`)
+)
+
+// handleCreate scaffolds a pair of empty up and down migration files named after
+// the next sequence number in the migrations directory.
+func handleCreate() error {
+	if len(os.Args) < minArgsForStepOrForce {
+		return fmt.Errorf("usage: migrate create <name>")
+	}
+	name := os.Args[2]
+	if !migrationNamePattern.MatchString(name) {
+		return fmt.Errorf("invalid migration name %q: use lowercase letters, digits and underscores", name)
+	}
+
+	seq, err := nextMigrationSeq(migrationsDir)
+	if err != nil {
+		return err
+	}
+	base := fmt.Sprintf("%0*d_%s", migrationSeqWidth, seq, name)
+	upPath := filepath.Join(migrationsDir, base+".up.sql")
+	downPath := filepath.Join(migrationsDir, base+".down.sql")
+
+	if err := createMigrationFile(upPath, fmt.Sprintf("-- %s: apply %s\n", base, name)); err != nil {
+		return err
+	}
+	if err := createMigrationFile(downPath, fmt.Sprintf("-- %s: revert %s\n", base, name)); err != nil {
+		// Don't leave an up migration without its down migration behind
+		if rmErr := os.Remove(upPath); rmErr != nil {
+			log.Printf("Warning: failed to remove %s: %v", upPath, rmErr)
+		}
+		return err
+	}
+
+	fmt.Println(upPath)
+	fmt.Println(downPath)
+	return nil
+}
+
+// nextMigrationSeq returns one past the highest sequence number in dir.
+func nextMigrationSeq(dir string) (int, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return 0, fmt.Errorf("failed to read migrations directory: %w", err)
+	}
+	highest := 0
+	for _, entry := range entries {
+		match := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		seq, err := strconv.Atoi(match[1])
+		if err != nil {
+			return 0, fmt.Errorf("invalid migration sequence in %s: %w", entry.Name(), err)
+		}
+		highest = max(highest, seq)
+	}
+	return highest + 1, nil
+}
+
+// createMigrationFile writes a new migration file, refusing to overwrite an existing one.
+func createMigrationFile(path, content string) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, migrationFilePerm)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return fmt.Errorf("migration file %s already exists", path)
+		}
+		return fmt.Errorf("failed to create migration file: %w", err)
+	}
+	if _, err := f.WriteString(content); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to write migration file: %w", err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("failed to close migration file: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/source"
+)
+
+const dryRunFlag = "--dry-run"
+
+// pendingMigration is a migration of the source that the database has not applied yet.
+type pendingMigration struct {
+	version uint
+	name    string
+}
+
+// handleUpDryRun reports the current version and the pending migrations without applying them.
+// It fails if the database is dirty so CI runs catch a failed earlier migration.
+func handleUpDryRun(m *migrate.Migrate) error {
+	current, dirty, err := m.Version()
+	hasVersion := true
+	if errors.Is(err, migrate.ErrNilVersion) {
+		hasVersion = false
+	} else if err != nil {
+		return fmt.Errorf("failed to get migration version: %w", err)
+	}
+	if dirty {
+		return fmt.Errorf("database is in dirty state at version %d; fix it and use force before migrating", current)
+	}
+
+	pending, err := listPendingMigrations(migrationsPathPrefix, current, hasVersion)
+	if err != nil {
+		return err
+	}
+
+	if hasVersion {
+		fmt.Printf("Current version: %d\n", current)
+	} else {
+		fmt.Println("Current version: none (no migrations applied)")
+	}
+	if len(pending) == 0 {
+		fmt.Println("No pending migrations")
+		return nil
+	}
+	fmt.Printf("Pending migrations (%d):\n", len(pending))
+	for _, p := range pending {
+		fmt.Printf("  %0*d_%s\n", migrationSeqWidth, p.version, p.name)
+	}
+	return nil
+}
+
+// listPendingMigrations returns the migrations of the source newer than the current version,
+// or all of them if the database has no version yet.
+func listPendingMigrations(sourceURL string, current uint, hasVersion bool) ([]pendingMigration, error) {
+	src, err := source.Open(sourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open migration source: %w", err)
+	}
+	defer func() {
+		if err := src.Close(); err != nil {
+			log.Printf("Warning: failed to close migration source: %v", err)
+		}
+	}()
+
+	var pending []pendingMigration
+	version, err := src.First()
+	for err == nil {
+		if !hasVersion || version > current {
+			name, readErr := readMigrationName(src, version)
+			if readErr != nil {
+				return nil, readErr
+			}
+			pending = append(pending, pendingMigration{version: version, name: name})
+		}
+		version, err = src.Next(version)
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to list migrations: %w", err)
+	}
+	return pending, nil
+}
+
+// readMigrationName returns the identifier of the up migration of a version.
+func readMigrationName(src source.Driver, version uint) (string, error) {
+	r, name, err := src.ReadUp(version)
+	if err != nil {
+		return "", fmt.Errorf("failed to read migration %d: %w", version, err)
+	}
+	if err := r.Close(); err != nil {
+		return "", fmt.Errorf("failed to close migration %d: %w", version, err)
+	}
+	return name, nil
+}
@@ -15,7 +15,8 @@ import (
 const (
 	minArgsForCommand     = 2
 	minArgsForStepOrForce = 3
-	migrationsPathPrefix  = "file://internal/infrastructure/database/migrations"
+	migrationsDir         = "internal/infrastructure/database/migrations"
+	migrationsPathPrefix  = "file://" + migrationsDir
 )
 
 func main() {
@@ -24,12 +25,29 @@ func main() {
 		os.Exit(1)
 	}
 
+	// The create command only scaffolds files and needs no configuration
+	command := os.Args[1]
+	if command == "create" {
+		if err := handleCreate(); err != nil {
+			log.Fatalf("Command failed: %v", err)
+		}
+		return
+	}
+
 	// Load configuration using centralized config package
 	cfg, err := config.Load()
 	if err != nil {
 		log.Fatalf("Failed to load configuration: %v", err)
 	}
 
+	// The seed command writes through the repositories and needs no migrate instance
+	if command == "seed" {
+		if err := handleSeed(cfg); err != nil {
+			log.Fatalf("Command failed: %v", err)
+		}
+		return
+	}
+
 	// Build database connection string from config
 	databaseURL := buildDatabaseURL(cfg)
 
@@ -45,7 +63,6 @@ func main() {
 	}()
 
 	// Execute command
-	command := os.Args[1]
 	if err := executeCommand(m, command); err != nil {
 		log.Fatalf("Command failed: %v", err)
 	}
@@ -68,6 +85,9 @@ func buildDatabaseURL(cfg *config.Config) string {
 func executeCommand(m *migrate.Migrate, command string) error {
 	switch command {
 	case "up":
+		if len(os.Args) >= minArgsForStepOrForce && os.Args[2] == dryRunFlag {
+			return handleUpDryRun(m)
+		}
 		return handleUp(m)
 	case "down":
 		return handleDown(m)
@@ -152,10 +172,13 @@ func printUsage() {
 	fmt.Println("Usage: migrate <command> [args]")
 	fmt.Println("\nCommands:")
 	fmt.Println("  up              Apply all pending migrations")
+	fmt.Println("  up --dry-run    List pending migrations without applying them (fails if dirty)")
 	fmt.Println("  down            Rollback all migrations")
 	fmt.Println("  step <n>        Apply next n migrations (use negative for rollback)")
 	fmt.Println("  version         Show current migration version")
 	fmt.Println("  force <version> Force set migration version (use with caution)")
+	fmt.Println("  create <name>   Create empty up/down migration files with the next sequence number")
+	fmt.Println("  seed            Insert sample users, events and participants (development only)")
 	fmt.Println("\nConfiguration:")
 	fmt.Println("  Database configuration is loaded from:")
 	fmt.Println("  1. config/default.yaml (base configuration)")
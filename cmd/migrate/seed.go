@@ -0,0 +1,224 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
+	"github.com/fumkob/ezqrin-server/internal/usecase/qrtoken"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+)
+
+// Seed accounts share one well-known password; they are for local development only.
+const (
+	seedAdminEmail     = "admin@ezqrin.local"
+	seedOrganizerEmail = "organizer@ezqrin.local"
+	seedPassword       = "Password123!"
+	seedTimeout        = 30 * time.Second
+)
+
+// seedEvent describes a sample event and the participants seeded into it.
+type seedEvent struct {
+	name         string
+	location     string
+	startsIn     time.Duration
+	participants []seedParticipant
+}
+
+type seedParticipant struct {
+	name  string
+	email string
+}
+
+var seedEvents = []seedEvent{
+	{
+		name:     "Sample Tech Conference",
+		location: "Tokyo International Forum",
+		startsIn: 30 * 24 * time.Hour,
+		participants: []seedParticipant{
+			{name: "Taro Yamada", email: "taro.yamada@example.com"},
+			{name: "Hanako Suzuki", email: "hanako.suzuki@example.com"},
+			{name: "John Smith", email: "john.smith@example.com"},
+		},
+	},
+	{
+		name:     "Sample Product Meetup",
+		location: "Shibuya Community Hall",
+		startsIn: 7 * 24 * time.Hour,
+		participants: []seedParticipant{
+			{name: "Jane Doe", email: "jane.doe@example.com"},
+			{name: "Kenji Sato", email: "kenji.sato@example.com"},
+		},
+	},
+}
+
+// handleSeed inserts sample users, events and participants for local development.
+// It refuses to run against databases that look like production and skips seeding
+// if the seed admin already exists.
+func handleSeed(cfg *config.Config) error {
+	if cfg.IsProduction() || cfg.Database.SSLMode != "disable" {
+		return fmt.Errorf("refusing to seed: environment looks like production " +
+			"(SERVER_ENV must not be production and DB_SSL_MODE must be disable)")
+	}
+
+	appLogger, err := logger.New(logger.Config{
+		Level:       cfg.Logging.Level,
+		Format:      cfg.Logging.Format,
+		Environment: cfg.Server.Environment,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to initialize logger: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), seedTimeout)
+	defer cancel()
+
+	db, err := database.NewPostgresDB(ctx, &cfg.Database, appLogger)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database: %w", err)
+	}
+	defer db.Close()
+
+	userRepo := database.NewUserRepository(db.GetPool(), appLogger)
+	exists, err := userRepo.ExistsByEmail(ctx, seedAdminEmail)
+	if err != nil {
+		return fmt.Errorf("failed to check seed admin: %w", err)
+	}
+	if exists {
+		log.Printf("Seed admin %s already exists, skipping seed\n", seedAdminEmail)
+		return nil
+	}
+
+	tokenGenerator, err := crypto.NewTokenGenerator(
+		cfg.QRCode.TokenStrategy, cfg.QRCode.HMACSecret, cfg.QRCode.TokenBytes,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to initialize QR token generator: %w", err)
+	}
+	participantRepo := database.NewParticipantRepository(db.GetPool(), appLogger)
+	s := &seeder{
+		userRepo:        userRepo,
+		eventRepo:       database.NewEventRepository(db.GetPool(), appLogger),
+		participantRepo: participantRepo,
+		qrTokens:        qrtoken.NewIssuer(tokenGenerator, participantRepo, cfg.QRCode.TokenMaxAttempts),
+		qrHostingURL:    cfg.QRCode.HostingBaseURL,
+	}
+	if err := db.WithTransaction(ctx, s.seed); err != nil {
+		return fmt.Errorf("failed to seed database: %w", err)
+	}
+
+	log.Printf("Seed data inserted; log in as %s or %s with password %s\n",
+		seedAdminEmail, seedOrganizerEmail, seedPassword)
+	return nil
+}
+
+// seeder writes the seed data through the application's repositories.
+type seeder struct {
+	userRepo        repository.UserRepository
+	eventRepo       repository.EventRepository
+	participantRepo repository.ParticipantRepository
+	qrTokens        *qrtoken.Issuer
+	qrHostingURL    string
+}
+
+// seed creates the seed admin, the sample organizer and the organizer's events.
+func (s *seeder) seed(ctx context.Context) error {
+	if _, err := s.createUser(ctx, seedAdminEmail, "Seed Admin", entity.RoleAdmin); err != nil {
+		return err
+	}
+	organizer, err := s.createUser(ctx, seedOrganizerEmail, "Sample Organizer", entity.RoleOrganizer)
+	if err != nil {
+		return err
+	}
+	for _, se := range seedEvents {
+		if err := s.createEvent(ctx, organizer.ID, se); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// createUser creates a verified user with the seed password, hashed as at registration.
+func (s *seeder) createUser(ctx context.Context, email, name string, role entity.UserRole) (*entity.User, error) {
+	passwordHash, err := crypto.HashPassword(seedPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %w", err)
+	}
+	now := time.Now()
+	user := &entity.User{
+		ID:            uuid.New(),
+		Email:         email,
+		PasswordHash:  passwordHash,
+		Name:          name,
+		Role:          role,
+		EmailVerified: true,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+	if err := user.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid seed user %s: %w", email, err)
+	}
+	if err := s.userRepo.Create(ctx, user); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
+
+// createEvent creates a published event and its confirmed participants.
+func (s *seeder) createEvent(ctx context.Context, organizerID uuid.UUID, se seedEvent) error {
+	now := time.Now()
+	event := &entity.Event{
+		ID:          uuid.New(),
+		OrganizerID: organizerID,
+		Name:        se.name,
+		Description: "Sample event created by the migrate seed command",
+		StartDate:   now.Add(se.startsIn).Truncate(time.Hour),
+		Location:    se.location,
+		Timezone:    "Asia/Tokyo",
+		Status:      entity.StatusPublished,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	if err := event.Validate(); err != nil {
+		return fmt.Errorf("invalid seed event %q: %w", se.name, err)
+	}
+	if err := s.eventRepo.Create(ctx, event); err != nil {
+		return err
+	}
+
+	participantIDs := make([]uuid.UUID, len(se.participants))
+	for i := range se.participants {
+		participantIDs[i] = uuid.New()
+	}
+	tokens, err := s.qrTokens.IssueBatch(ctx, event.ID, participantIDs)
+	if err != nil {
+		return err
+	}
+	for i, sp := range se.participants {
+		qrToken := tokens[participantIDs[i]]
+		participant := &entity.Participant{
+			ID:                participantIDs[i],
+			EventID:           event.ID,
+			Name:              sp.name,
+			Email:             sp.email,
+			QRCode:            qrToken,
+			QRCodeGeneratedAt: now,
+			QRDistributionURL: crypto.GenerateQRDistributionURL(s.qrHostingURL, qrToken),
+			Status:            entity.ParticipantStatusConfirmed,
+			PaymentStatus:     entity.PaymentUnpaid,
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		}
+		if err := s.participantRepo.Create(ctx, participant); err != nil {
+			return err
+		}
+	}
+	return nil
+}
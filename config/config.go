@@ -4,34 +4,62 @@ package config
 import (
 	"bufio"
 	"fmt"
+	"net"
+	"net/url"
 	"os"
 	"strings"
 	"time"
 
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	"github.com/fumkob/ezqrin-server/pkg/i18n"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/spf13/viper"
 )
 
 const (
 	envKeyValueParts      = 2
 	jwtSecretMinLength    = 32
+	twoFactorKeyMinLength = 32
 	qrHMACSecretMinLength = 32
-	minPort               = 1
-	maxPort               = 65535
-	minDatabaseConns      = 1
-	minRedisDB            = 0
+	// qrTokenMinBytes matches the entropy of the original hex QR tokens;
+	// qrTokenMaxBytes is the size of an HMAC-SHA256 sum.
+	qrTokenMinBytes  = 6
+	qrTokenMaxBytes  = 32
+	minPort          = 1
+	maxPort          = 65535
+	minDatabaseConns = 1
+	minRedisDB       = 0
 )
 
 // Config holds all application configuration
 type Config struct {
-	Server    ServerConfig
-	Database  DatabaseConfig
-	Redis     RedisConfig
-	JWT       JWTConfig
-	Logging   LoggingConfig
-	CORS      CORSConfig
-	QRCode    QRCodeConfig
-	Email     EmailConfig
-	Telemetry TelemetryConfig
+	Server            ServerConfig
+	Database          DatabaseConfig
+	Redis             RedisConfig
+	JWT               JWTConfig
+	TwoFactor         TwoFactorConfig
+	Logging           LoggingConfig
+	CORS              CORSConfig
+	PublicCORS        CORSConfig
+	QRCode            QRCodeConfig
+	Email             EmailConfig
+	Telemetry         TelemetryConfig
+	Payment           PaymentConfig
+	I18n              I18nConfig
+	Invite            InviteConfig
+	EmailVerification EmailVerificationConfig
+	Webhook           WebhookConfig
+	Outbox            OutboxConfig
+	Stats             StatsConfig
+	Features          FeaturesConfig
+	Pagination        PaginationConfig
+	Checkin           CheckinConfig
+	Retention         RetentionConfig
+	ParticipantExpiry ParticipantExpiryConfig
+	PublicRateLimit   PublicRateLimitConfig
+	AuthRateLimit     AuthRateLimitConfig
+	APIRateLimit      APIRateLimitConfig
+	Recurrence        RecurrenceConfig
 }
 
 // ServerConfig contains server-related configuration
@@ -41,6 +69,30 @@ type ServerConfig struct {
 	ReadTimeout  time.Duration
 	WriteTimeout time.Duration
 	IdleTimeout  time.Duration
+	// RequestTimeout bounds the time a route may take before it answers 503; 0 disables it
+	RequestTimeout time.Duration
+	// AuthRequestTimeout overrides RequestTimeout for the authentication routes
+	AuthRequestTimeout time.Duration
+	// BulkRequestTimeout overrides RequestTimeout for CSV imports/exports and bulk sends
+	BulkRequestTimeout time.Duration
+	// IdempotencyKeyTTL is how long the response of a request with an Idempotency-Key header is
+	// replayed for repeats of that request; 0 disables idempotency keys
+	IdempotencyKeyTTL time.Duration
+	// DocsEnabled serves the interactive API documentation at /docs; the OpenAPI spec itself
+	// is always served
+	DocsEnabled bool
+	// CompressionMinSize is the smallest response body, in bytes, that is gzipped for clients
+	// accepting it
+	CompressionMinSize int
+	// MaxBodySize is the largest request body, in bytes, accepted by JSON routes; 0 disables the limit
+	MaxBodySize int64
+	// MaxUploadSize overrides MaxBodySize for file uploads such as CSV imports
+	MaxUploadSize int64
+	// ShutdownTimeout is how long in-flight requests may take to finish once shutdown begins
+	ShutdownTimeout time.Duration
+	// TrustedProxies are the IPs and CIDR ranges of the reverse proxies whose X-Forwarded-For and
+	// X-Real-IP headers give the client IP; without any, the client IP is the peer address
+	TrustedProxies []string
 }
 
 // DatabaseConfig contains database connection configuration
@@ -55,6 +107,7 @@ type DatabaseConfig struct {
 	MinConns        int           // Minimum connections to maintain in pool (maps to pgxpool.MinConns)
 	MaxConnLifetime time.Duration // Maximum lifetime of a connection (maps to pgxpool.MaxConnLifetime)
 	MaxConnIdleTime time.Duration // Maximum idle time of a connection (maps to pgxpool.MaxConnIdleTime)
+	QueryTimeout    time.Duration // Maximum duration of each query, including reading its rows; 0 disables it
 }
 
 // RedisConfig contains Redis connection configuration
@@ -77,10 +130,49 @@ type RedisConfig struct {
 
 // JWTConfig contains JWT token configuration
 type JWTConfig struct {
-	Secret                   string
-	AccessTokenExpiry        time.Duration
+	Secret string
+	// AccessTokenExpiry is how long access tokens are valid, reported to clients as expires_in.
+	AccessTokenExpiry time.Duration
+	// RefreshTokenExpiryWeb and RefreshTokenExpiryMobile are how long refresh tokens of web and
+	// mobile clients are valid; both must be longer than AccessTokenExpiry.
 	RefreshTokenExpiryWeb    time.Duration
 	RefreshTokenExpiryMobile time.Duration
+	// Algorithm signs the tokens: "HS256" with Secret, or "RS256" with the key pair below.
+	// Secret is required either way, as it also signs password reset tokens.
+	Algorithm crypto.SigningAlgorithm
+	// PrivateKeyPath and PublicKeyPath locate the PEM-encoded RS256 keys; the public key is
+	// derived from the private key when its path is empty.
+	PrivateKeyPath string
+	PublicKeyPath  string
+	// RetiredSecrets and RetiredPublicKeyPaths are keys previously signed with, most recently
+	// retired first. Tokens they signed are still accepted until they expire.
+	RetiredSecrets        []string
+	RetiredPublicKeyPaths []string
+	// Audience is set as the "aud" claim of issued tokens, and tokens for another audience are
+	// rejected. Empty issues tokens without an audience and skips the check.
+	Audience string
+}
+
+// SigningConfig returns the configuration tokens are signed and verified with.
+func (c JWTConfig) SigningConfig() crypto.SigningConfig {
+	return crypto.SigningConfig{
+		Algorithm:             c.Algorithm,
+		Secret:                c.Secret,
+		PrivateKeyPath:        c.PrivateKeyPath,
+		PublicKeyPath:         c.PublicKeyPath,
+		RetiredSecrets:        c.RetiredSecrets,
+		RetiredPublicKeyPaths: c.RetiredPublicKeyPaths,
+		Audience:              c.Audience,
+	}
+}
+
+// TwoFactorConfig contains TOTP two-factor authentication configuration
+type TwoFactorConfig struct {
+	// EncryptionKey encrypts the TOTP secrets stored in the database. Empty disables
+	// enrolling in two-factor authentication.
+	EncryptionKey string
+	// Issuer names the service in authenticator apps
+	Issuer string
 }
 
 // LoggingConfig contains logging configuration
@@ -89,7 +181,8 @@ type LoggingConfig struct {
 	Format string
 }
 
-// CORSConfig contains CORS configuration
+// CORSConfig contains the CORS policy of a group of routes. Config.CORS applies to the
+// authenticated and admin API; Config.PublicCORS to the public routes, see PublicCORSPolicy.
 type CORSConfig struct {
 	AllowedOrigins   []string
 	AllowedMethods   []string
@@ -102,6 +195,174 @@ type QRCodeConfig struct {
 	HMACSecret        string
 	HostingBaseURL    string
 	WalletPassBaseURL string
+	// TokenStrategy selects how QR tokens are generated: "random", "hmac" or "signed".
+	TokenStrategy crypto.QRTokenStrategy
+	// TokenBytes is the number of random or HMAC bytes encoded into each token.
+	TokenBytes int
+	// TokenMaxAttempts bounds how many tokens are tried per participant before giving up on collisions.
+	TokenMaxAttempts int
+	// TokenTTL is how long after issue signed QR tokens are accepted at check-in; zero accepts them indefinitely.
+	TokenTTL time.Duration
+}
+
+// PaymentConfig contains payment-related configuration
+type PaymentConfig struct {
+	// DefaultCurrency is the ISO 4217 code assigned to new events that do not specify one.
+	// An empty value leaves new events without a currency.
+	DefaultCurrency string
+}
+
+// InviteConfig contains participant invitation configuration
+type InviteConfig struct {
+	// AcceptBaseURL is the page invitees open to accept; the token is added as the "token" query parameter.
+	// Invitation emails cannot be sent while it is empty.
+	AcceptBaseURL string
+	// TokenExpiry is how long an invitation link remains valid.
+	TokenExpiry time.Duration
+}
+
+// EmailVerificationMode selects what users can do before verifying their email address.
+type EmailVerificationMode string
+
+const (
+	// EmailVerificationSoft lets unverified users use the API like verified ones.
+	EmailVerificationSoft EmailVerificationMode = "soft"
+	// EmailVerificationHard blocks unverified users from creating events until they verify.
+	EmailVerificationHard EmailVerificationMode = "hard"
+)
+
+// EmailVerificationConfig contains user email verification configuration
+type EmailVerificationConfig struct {
+	// Mode selects whether unverified users are blocked from creating events: "soft" (default) or "hard".
+	// Unverified users can log in in both modes.
+	Mode EmailVerificationMode
+	// BaseURL is the page users open to verify their email; the token is added as the "token" query parameter.
+	// Verification emails cannot be sent while it is empty.
+	BaseURL string
+	// TokenExpiry is how long a verification link remains valid.
+	TokenExpiry time.Duration
+}
+
+// WebhookConfig contains webhook delivery configuration
+type WebhookConfig struct {
+	// URLs receive every domain event as a JSON POST. Events without a subscriber are discarded.
+	// Check-ins and registrations are also posted to the webhook of their event, if one is set.
+	URLs []string
+	// Secret signs webhook bodies in the X-Ezqrin-Signature header. Empty disables signing.
+	Secret string
+	// Timeout bounds each webhook request.
+	Timeout time.Duration
+}
+
+// OutboxConfig contains transactional outbox relay configuration
+type OutboxConfig struct {
+	PollInterval   time.Duration // Delay between polls once the outbox is drained
+	BatchSize      int           // Maximum messages claimed per poll
+	Lease          time.Duration // How long a claimed message is hidden before it may be retried
+	MaxAttempts    int           // Delivery attempts before a message is abandoned
+	RetryBaseDelay time.Duration // Delay before the first retry; doubles on every further attempt
+	RetryMaxDelay  time.Duration // Upper bound for the retry delay
+}
+
+// StatsConfig contains event statistics warning thresholds.
+// Rates are fractions in [0, 1]; zero disables the corresponding warning.
+type StatsConfig struct {
+	NoShowRateWarning     float64 // Warn when a past event's no-show rate exceeds this
+	LowCheckinRateWarning float64 // Warn when an ongoing event's check-in rate is below this
+	CapacityWarning       float64 // Warn when confirmed participants fill this much of an event's capacity
+}
+
+// CheckinConfig contains check-in configuration
+type CheckinConfig struct {
+	// UndoWindow is how long after a cancellation the check-in can still be restored.
+	// Zero disables restoring.
+	UndoWindow time.Duration
+	// DebounceWindow is how long after a check-in a repeat check-in of the participant answers
+	// with that check-in instead of a conflict. Zero disables debouncing.
+	DebounceWindow time.Duration
+	// WindowMargin opens check-in this long before an event starts and closes it this long after
+	// the event ends. Zero accepts check-ins at any time.
+	WindowMargin time.Duration
+}
+
+// RetentionConfig contains participant data retention configuration
+type RetentionConfig struct {
+	// PurgeAfterDays is how many days after a completed event ends its participants are
+	// anonymized. Zero disables the retention purge.
+	PurgeAfterDays int
+	Interval       time.Duration // Delay between purge runs
+	BatchSize      int           // Maximum events purged per run
+}
+
+// ParticipantExpiryConfig contains the schedule of the participant expirer. Whether and when
+// participants expire is set per event.
+type ParticipantExpiryConfig struct {
+	Interval  time.Duration // Delay between expiry runs
+	BatchSize int           // Maximum participants expired per run
+}
+
+// PublicRateLimitConfig contains the rate limits of the attendee-facing routes reachable
+// without authentication. Requests are counted per client IP and per event in fixed windows;
+// a zero limit disables that cap.
+type PublicRateLimitConfig struct {
+	PerIP    int           // Maximum requests per client IP per window
+	PerEvent int           // Maximum requests per event per window, across all clients
+	Window   time.Duration // Length of a counting window
+}
+
+// AuthRateLimitConfig contains the rate limits of the authentication routes on which
+// credentials can be guessed. Requests are counted per route and client IP, and logins per
+// account, in fixed windows, before they are authenticated; a zero limit disables that cap.
+type AuthRateLimitConfig struct {
+	PerIP      int           // Maximum requests to a route per client IP per window
+	PerAccount int           // Maximum logins per account per window, across all clients
+	Window     time.Duration // Length of a counting window
+}
+
+// APIRateLimitConfig contains the rate limits of the routes requiring authentication. Requests
+// are counted per client IP and per user in fixed windows, once authenticated; a zero limit
+// disables that cap.
+type APIRateLimitConfig struct {
+	PerIP   int           // Maximum requests per client IP per window
+	PerUser int           // Maximum requests per authenticated user per window
+	Window  time.Duration // Length of a counting window
+}
+
+// RecurrenceConfig contains the bounds of recurring events.
+type RecurrenceConfig struct {
+	MaxOccurrences int // Maximum occurrences a recurring event may be expanded into
+}
+
+// PaginationConfig contains the page size bounds of each list endpoint
+type PaginationConfig struct {
+	Events       PageSizeConfig // GET /events
+	Participants PageSizeConfig // GET /events/{id}/participants
+	Checkins     PageSizeConfig // GET /events/{id}/checkins
+	Users        PageSizeConfig // GET /users
+	AuditLogs    PageSizeConfig // GET /audit-logs
+}
+
+// PageSizeConfig bounds the per_page query parameter of a list endpoint
+type PageSizeConfig struct {
+	DefaultPerPage int // Page size used when per_page is omitted
+	MaxPerPage     int // Larger per_page values are clamped to this
+}
+
+// FeaturesConfig toggles optional subsystems. A disabled feature's routes are not registered,
+// so they answer 404, and its background workers are not started. All features default to enabled.
+type FeaturesConfig struct {
+	Webhooks      bool // Outbox relay delivering domain events to webhook subscribers
+	Invitations   bool // Participant invitation and invitation acceptance routes
+	WalkInCheckin bool // Walk-in check-in route
+	// Waitlist waitlists participants registering as confirmed once their event is at capacity;
+	// disabled, they are rejected instead. Participants already waitlisted can still be promoted.
+	Waitlist bool
+}
+
+// I18nConfig contains localization configuration
+type I18nConfig struct {
+	// DefaultLocale is used when the Accept-Language header names no supported locale.
+	DefaultLocale string
 }
 
 // EmailBackend identifies which email sending implementation to use.
@@ -140,6 +401,17 @@ type EmailConfig struct {
 	// Use this when the recipient's mail server blocks HTML emails.
 	// Set via EMAIL_PLAIN_TEXT_ONLY=true.
 	PlainTextOnly bool
+
+	// DomainCheck looks up the MX records of participant email domains on create and import,
+	// so that typos like gmial.com are caught before confirmations bounce.
+	DomainCheck bool
+	// DomainCheckReject rejects participants whose email domain cannot receive mail instead of
+	// only warning about them.
+	DomainCheckReject bool
+	// DomainCheckTimeout bounds each lookup; a domain that cannot be checked in time is allowed.
+	DomainCheckTimeout time.Duration
+	// DomainCheckCacheTTL is how long the result for a domain is cached in Redis.
+	DomainCheckCacheTTL time.Duration
 }
 
 // TelemetryConfig contains OpenTelemetry configuration.
@@ -244,11 +516,21 @@ func loadEnvFile(v *viper.Viper, filepath string) error {
 // This is the single source of truth for all environment variable bindings.
 var envKeyMap = map[string]string{
 	// Server
-	"SERVER_PORT":          "server.port",
-	"SERVER_ENV":           "server.environment",
-	"SERVER_READ_TIMEOUT":  "server.read_timeout",
-	"SERVER_WRITE_TIMEOUT": "server.write_timeout",
-	"SERVER_IDLE_TIMEOUT":  "server.idle_timeout",
+	"SERVER_PORT":                 "server.port",
+	"SERVER_ENV":                  "server.environment",
+	"SERVER_READ_TIMEOUT":         "server.read_timeout",
+	"SERVER_WRITE_TIMEOUT":        "server.write_timeout",
+	"SERVER_IDLE_TIMEOUT":         "server.idle_timeout",
+	"SERVER_REQUEST_TIMEOUT":      "server.request_timeout",
+	"SERVER_AUTH_REQUEST_TIMEOUT": "server.auth_request_timeout",
+	"SERVER_BULK_REQUEST_TIMEOUT": "server.bulk_request_timeout",
+	"SERVER_IDEMPOTENCY_KEY_TTL":  "server.idempotency_key_ttl",
+	"SERVER_DOCS_ENABLED":         "server.docs_enabled",
+	"SERVER_COMPRESSION_MIN_SIZE": "server.compression_min_size",
+	"SERVER_MAX_BODY_SIZE":        "server.max_body_size",
+	"SERVER_MAX_UPLOAD_SIZE":      "server.max_upload_size",
+	"SERVER_SHUTDOWN_TIMEOUT":     "server.shutdown_timeout",
+	"SERVER_TRUSTED_PROXIES":      "server.trusted_proxies",
 
 	// Database
 	"DB_HOST":               "database.host",
@@ -261,6 +543,7 @@ var envKeyMap = map[string]string{
 	"DB_MIN_CONNS":          "database.min_conns",
 	"DB_MAX_CONN_LIFETIME":  "database.max_conn_lifetime",
 	"DB_MAX_CONN_IDLE_TIME": "database.max_conn_idle_time",
+	"DB_QUERY_TIMEOUT":      "database.query_timeout",
 
 	// Redis
 	"REDIS_HOST":     "redis.host",
@@ -273,6 +556,16 @@ var envKeyMap = map[string]string{
 	"JWT_ACCESS_TOKEN_EXPIRY":         "jwt.access_token_expiry",
 	"JWT_REFRESH_TOKEN_EXPIRY_WEB":    "jwt.refresh_token_expiry_web",
 	"JWT_REFRESH_TOKEN_EXPIRY_MOBILE": "jwt.refresh_token_expiry_mobile",
+	"JWT_ALGORITHM":                   "jwt.algorithm",
+	"JWT_PRIVATE_KEY_PATH":            "jwt.private_key_path",
+	"JWT_PUBLIC_KEY_PATH":             "jwt.public_key_path",
+	"JWT_RETIRED_SECRETS":             "jwt.retired_secrets",
+	"JWT_RETIRED_PUBLIC_KEY_PATHS":    "jwt.retired_public_key_paths",
+	"JWT_AUDIENCE":                    "jwt.audience",
+
+	// Two-factor authentication
+	"TWO_FACTOR_ENCRYPTION_KEY": "two_factor.encryption_key",
+	"TWO_FACTOR_ISSUER":         "two_factor.issuer",
 
 	// Logging
 	"LOG_LEVEL":  "logging.level",
@@ -284,10 +577,95 @@ var envKeyMap = map[string]string{
 	"CORS_ALLOWED_HEADERS":   "cors.allowed_headers",
 	"CORS_ALLOW_CREDENTIALS": "cors.allow_credentials",
 
+	// Public CORS
+	"PUBLIC_CORS_ALLOWED_ORIGINS":   "public_cors.allowed_origins",
+	"PUBLIC_CORS_ALLOWED_METHODS":   "public_cors.allowed_methods",
+	"PUBLIC_CORS_ALLOWED_HEADERS":   "public_cors.allowed_headers",
+	"PUBLIC_CORS_ALLOW_CREDENTIALS": "public_cors.allow_credentials",
+
 	// QR Code
-	"QR_HMAC_SECRET":       "qrcode.hmac_secret",
-	"QR_HOSTING_BASE_URL":  "qrcode.hosting_base_url",
-	"WALLET_PASS_BASE_URL": "qrcode.wallet_pass_base_url",
+	"QR_HMAC_SECRET":        "qrcode.hmac_secret",
+	"QR_HOSTING_BASE_URL":   "qrcode.hosting_base_url",
+	"WALLET_PASS_BASE_URL":  "qrcode.wallet_pass_base_url",
+	"QR_TOKEN_STRATEGY":     "qrcode.token_strategy",
+	"QR_TOKEN_BYTES":        "qrcode.token_bytes",
+	"QR_TOKEN_MAX_ATTEMPTS": "qrcode.token_max_attempts",
+	"QR_TOKEN_TTL":          "qrcode.token_ttl",
+
+	// Invitations
+	"INVITE_ACCEPT_BASE_URL": "invite.accept_base_url",
+	"INVITE_TOKEN_EXPIRY":    "invite.token_expiry",
+
+	// Email verification
+	"EMAIL_VERIFICATION_MODE":         "email_verification.mode",
+	"EMAIL_VERIFICATION_BASE_URL":     "email_verification.base_url",
+	"EMAIL_VERIFICATION_TOKEN_EXPIRY": "email_verification.token_expiry",
+
+	// Webhooks
+	"WEBHOOK_URLS":    "webhook.urls",
+	"WEBHOOK_SECRET":  "webhook.secret",
+	"WEBHOOK_TIMEOUT": "webhook.timeout",
+
+	// Outbox
+	"OUTBOX_POLL_INTERVAL":    "outbox.poll_interval",
+	"OUTBOX_BATCH_SIZE":       "outbox.batch_size",
+	"OUTBOX_LEASE":            "outbox.lease",
+	"OUTBOX_MAX_ATTEMPTS":     "outbox.max_attempts",
+	"OUTBOX_RETRY_BASE_DELAY": "outbox.retry_base_delay",
+	"OUTBOX_RETRY_MAX_DELAY":  "outbox.retry_max_delay",
+
+	// Stats
+	"STATS_NO_SHOW_RATE_WARNING":     "stats.no_show_rate_warning",
+	"STATS_LOW_CHECKIN_RATE_WARNING": "stats.low_checkin_rate_warning",
+	"STATS_CAPACITY_WARNING":         "stats.capacity_warning",
+
+	// Features
+	"FEATURE_WEBHOOKS":        "features.webhooks",
+	"FEATURE_INVITATIONS":     "features.invitations",
+	"FEATURE_WALK_IN_CHECKIN": "features.walk_in_checkin",
+	"FEATURE_WAITLIST":        "features.waitlist",
+
+	// Check-in
+	"CHECKIN_UNDO_WINDOW":     "checkin.undo_window",
+	"CHECKIN_DEBOUNCE_WINDOW": "checkin.debounce_window",
+	"CHECKIN_WINDOW_MARGIN":   "checkin.window_margin",
+
+	// Retention
+	"RETENTION_PURGE_AFTER_DAYS": "retention.purge_after_days",
+	"RETENTION_INTERVAL":         "retention.interval",
+	"RETENTION_BATCH_SIZE":       "retention.batch_size",
+
+	// Participant expiry
+	"PARTICIPANT_EXPIRY_INTERVAL":   "participant_expiry.interval",
+	"PARTICIPANT_EXPIRY_BATCH_SIZE": "participant_expiry.batch_size",
+
+	// Public rate limit
+	"PUBLIC_RATE_LIMIT_PER_IP":    "public_rate_limit.per_ip",
+	"PUBLIC_RATE_LIMIT_PER_EVENT": "public_rate_limit.per_event",
+	"PUBLIC_RATE_LIMIT_WINDOW":    "public_rate_limit.window",
+
+	// Authentication and API rate limits
+	"AUTH_RATE_LIMIT_PER_IP":      "auth_rate_limit.per_ip",
+	"AUTH_RATE_LIMIT_PER_ACCOUNT": "auth_rate_limit.per_account",
+	"AUTH_RATE_LIMIT_WINDOW":      "auth_rate_limit.window",
+	"API_RATE_LIMIT_PER_IP":       "api_rate_limit.per_ip",
+	"API_RATE_LIMIT_PER_USER":     "api_rate_limit.per_user",
+	"API_RATE_LIMIT_WINDOW":       "api_rate_limit.window",
+
+	// Recurrence
+	"RECURRENCE_MAX_OCCURRENCES": "recurrence.max_occurrences",
+
+	// Pagination
+	"PAGINATION_EVENTS_DEFAULT_PER_PAGE":       "pagination.events.default_per_page",
+	"PAGINATION_EVENTS_MAX_PER_PAGE":           "pagination.events.max_per_page",
+	"PAGINATION_PARTICIPANTS_DEFAULT_PER_PAGE": "pagination.participants.default_per_page",
+	"PAGINATION_PARTICIPANTS_MAX_PER_PAGE":     "pagination.participants.max_per_page",
+	"PAGINATION_CHECKINS_DEFAULT_PER_PAGE":     "pagination.checkins.default_per_page",
+	"PAGINATION_CHECKINS_MAX_PER_PAGE":         "pagination.checkins.max_per_page",
+	"PAGINATION_USERS_DEFAULT_PER_PAGE":        "pagination.users.default_per_page",
+	"PAGINATION_USERS_MAX_PER_PAGE":            "pagination.users.max_per_page",
+	"PAGINATION_AUDIT_LOGS_DEFAULT_PER_PAGE":   "pagination.audit_logs.default_per_page",
+	"PAGINATION_AUDIT_LOGS_MAX_PER_PAGE":       "pagination.audit_logs.max_per_page",
 
 	// Telemetry
 	"OTEL_ENABLED":                "telemetry.enabled",
@@ -299,18 +677,28 @@ var envKeyMap = map[string]string{
 	"OTEL_LOGS_EXPORTER":          "telemetry.logs_exporter",
 
 	// Email
-	"EMAIL_BACKEND":             "email.backend",
-	"EMAIL_FROM_ADDRESS":        "email.from_address",
-	"EMAIL_FROM_NAME":           "email.from_name",
-	"EMAIL_SMTP_HOST":           "email.smtp_host",
-	"EMAIL_SMTP_PORT":           "email.smtp_port",
-	"EMAIL_SMTP_USER":           "email.smtp_user",
-	"EMAIL_SMTP_PASSWORD":       "email.smtp_password",
-	"EMAIL_SMTP_TLS":            "email.smtp_tls",
-	"EMAIL_GMAIL_CLIENT_ID":     "email.gmail_client_id",
-	"EMAIL_GMAIL_CLIENT_SECRET": "email.gmail_client_secret",
-	"EMAIL_GMAIL_REFRESH_TOKEN": "email.gmail_refresh_token",
-	"EMAIL_PLAIN_TEXT_ONLY":     "email.plain_text_only",
+	"EMAIL_BACKEND":                "email.backend",
+	"EMAIL_FROM_ADDRESS":           "email.from_address",
+	"EMAIL_FROM_NAME":              "email.from_name",
+	"EMAIL_SMTP_HOST":              "email.smtp_host",
+	"EMAIL_SMTP_PORT":              "email.smtp_port",
+	"EMAIL_SMTP_USER":              "email.smtp_user",
+	"EMAIL_SMTP_PASSWORD":          "email.smtp_password",
+	"EMAIL_SMTP_TLS":               "email.smtp_tls",
+	"EMAIL_GMAIL_CLIENT_ID":        "email.gmail_client_id",
+	"EMAIL_GMAIL_CLIENT_SECRET":    "email.gmail_client_secret",
+	"EMAIL_GMAIL_REFRESH_TOKEN":    "email.gmail_refresh_token",
+	"EMAIL_PLAIN_TEXT_ONLY":        "email.plain_text_only",
+	"EMAIL_DOMAIN_CHECK":           "email.domain_check",
+	"EMAIL_DOMAIN_CHECK_REJECT":    "email.domain_check_reject",
+	"EMAIL_DOMAIN_CHECK_TIMEOUT":   "email.domain_check_timeout",
+	"EMAIL_DOMAIN_CHECK_CACHE_TTL": "email.domain_check_cache_ttl",
+
+	// Payment
+	"PAYMENT_DEFAULT_CURRENCY": "payment.default_currency",
+
+	// I18n
+	"I18N_DEFAULT_LOCALE": "i18n.default_locale",
 }
 
 // convertEnvKeyToViperKey converts environment variable key to viper key
@@ -341,6 +729,7 @@ func unmarshalDatabaseConfig(v *viper.Viper, cfg *Config) {
 	cfg.Database.MinConns = v.GetInt("database.min_conns")
 	cfg.Database.MaxConnLifetime = v.GetDuration("database.max_conn_lifetime")
 	cfg.Database.MaxConnIdleTime = v.GetDuration("database.max_conn_idle_time")
+	cfg.Database.QueryTimeout = v.GetDuration("database.query_timeout")
 }
 
 // unmarshalRedisConfig maps Redis configuration from viper to Config
@@ -371,6 +760,10 @@ func unmarshalEmailConfig(v *viper.Viper, cfg *Config) {
 	cfg.Email.GmailClientSecret = v.GetString("email.gmail_client_secret")
 	cfg.Email.GmailRefreshToken = v.GetString("email.gmail_refresh_token")
 	cfg.Email.PlainTextOnly = v.GetBool("email.plain_text_only")
+	cfg.Email.DomainCheck = v.GetBool("email.domain_check")
+	cfg.Email.DomainCheckReject = v.GetBool("email.domain_check_reject")
+	cfg.Email.DomainCheckTimeout = v.GetDuration("email.domain_check_timeout")
+	cfg.Email.DomainCheckCacheTTL = v.GetDuration("email.domain_check_cache_ttl")
 }
 
 // unmarshalTelemetryConfig maps telemetry configuration from viper to Config.
@@ -384,6 +777,50 @@ func unmarshalTelemetryConfig(v *viper.Viper, cfg *Config) {
 	cfg.Telemetry.LogsExporter = v.GetString("telemetry.logs_exporter")
 }
 
+// unmarshalOutboxConfig maps webhook and outbox relay configuration from viper to Config.
+func unmarshalOutboxConfig(v *viper.Viper, cfg *Config) {
+	// WEBHOOK_URLS is a comma-separated string; YAML may use an array
+	if urlsStr := v.GetString("webhook.urls"); urlsStr != "" {
+		cfg.Webhook.URLs = splitAndTrim(urlsStr, ",")
+	} else {
+		cfg.Webhook.URLs = v.GetStringSlice("webhook.urls")
+	}
+	cfg.Webhook.Secret = v.GetString("webhook.secret")
+	cfg.Webhook.Timeout = v.GetDuration("webhook.timeout")
+
+	cfg.Outbox.PollInterval = v.GetDuration("outbox.poll_interval")
+	cfg.Outbox.BatchSize = v.GetInt("outbox.batch_size")
+	cfg.Outbox.Lease = v.GetDuration("outbox.lease")
+	cfg.Outbox.MaxAttempts = v.GetInt("outbox.max_attempts")
+	cfg.Outbox.RetryBaseDelay = v.GetDuration("outbox.retry_base_delay")
+	cfg.Outbox.RetryMaxDelay = v.GetDuration("outbox.retry_max_delay")
+}
+
+// unmarshalPageSizeConfig maps the page size bounds of one list endpoint from viper.
+func unmarshalPageSizeConfig(v *viper.Viper, key string) PageSizeConfig {
+	return PageSizeConfig{
+		DefaultPerPage: v.GetInt(key + ".default_per_page"),
+		MaxPerPage:     v.GetInt(key + ".max_per_page"),
+	}
+}
+
+// unmarshalCORSConfig maps the CORS policy of one group of routes from viper.
+func unmarshalCORSConfig(v *viper.Viper, key string) CORSConfig {
+	// Lists can be a string (comma-separated, from the environment) or a slice (from YAML)
+	list := func(name string) []string {
+		if str := v.GetString(key + "." + name); str != "" {
+			return splitAndTrim(str, ",")
+		}
+		return v.GetStringSlice(key + "." + name)
+	}
+	return CORSConfig{
+		AllowedOrigins:   list("allowed_origins"),
+		AllowedMethods:   list("allowed_methods"),
+		AllowedHeaders:   list("allowed_headers"),
+		AllowCredentials: v.GetBool(key + ".allow_credentials"),
+	}
+}
+
 // unmarshalConfig maps viper configuration to Config struct
 func unmarshalConfig(v *viper.Viper, cfg *Config) error {
 	cfg.Server.Port = v.GetInt("server.port")
@@ -391,6 +828,21 @@ func unmarshalConfig(v *viper.Viper, cfg *Config) error {
 	cfg.Server.ReadTimeout = v.GetDuration("server.read_timeout")
 	cfg.Server.WriteTimeout = v.GetDuration("server.write_timeout")
 	cfg.Server.IdleTimeout = v.GetDuration("server.idle_timeout")
+	cfg.Server.RequestTimeout = v.GetDuration("server.request_timeout")
+	cfg.Server.AuthRequestTimeout = v.GetDuration("server.auth_request_timeout")
+	cfg.Server.BulkRequestTimeout = v.GetDuration("server.bulk_request_timeout")
+	cfg.Server.IdempotencyKeyTTL = v.GetDuration("server.idempotency_key_ttl")
+	cfg.Server.DocsEnabled = v.GetBool("server.docs_enabled")
+	cfg.Server.CompressionMinSize = v.GetInt("server.compression_min_size")
+	cfg.Server.MaxBodySize = v.GetInt64("server.max_body_size")
+	cfg.Server.MaxUploadSize = v.GetInt64("server.max_upload_size")
+	cfg.Server.ShutdownTimeout = v.GetDuration("server.shutdown_timeout")
+	// SERVER_TRUSTED_PROXIES is a comma-separated string; YAML may use an array
+	if proxies := v.GetString("server.trusted_proxies"); proxies != "" {
+		cfg.Server.TrustedProxies = splitAndTrim(proxies, ",")
+	} else {
+		cfg.Server.TrustedProxies = v.GetStringSlice("server.trusted_proxies")
+	}
 
 	unmarshalDatabaseConfig(v, cfg)
 	unmarshalRedisConfig(v, cfg)
@@ -399,29 +851,86 @@ func unmarshalConfig(v *viper.Viper, cfg *Config) error {
 	cfg.JWT.AccessTokenExpiry = v.GetDuration("jwt.access_token_expiry")
 	cfg.JWT.RefreshTokenExpiryWeb = v.GetDuration("jwt.refresh_token_expiry_web")
 	cfg.JWT.RefreshTokenExpiryMobile = v.GetDuration("jwt.refresh_token_expiry_mobile")
+	cfg.JWT.Algorithm = crypto.SigningAlgorithm(v.GetString("jwt.algorithm"))
+	cfg.JWT.PrivateKeyPath = v.GetString("jwt.private_key_path")
+	cfg.JWT.PublicKeyPath = v.GetString("jwt.public_key_path")
+	// The retired keys are comma-separated strings in the environment; YAML may use arrays
+	if secrets := v.GetString("jwt.retired_secrets"); secrets != "" {
+		cfg.JWT.RetiredSecrets = splitAndTrim(secrets, ",")
+	} else {
+		cfg.JWT.RetiredSecrets = v.GetStringSlice("jwt.retired_secrets")
+	}
+	if paths := v.GetString("jwt.retired_public_key_paths"); paths != "" {
+		cfg.JWT.RetiredPublicKeyPaths = splitAndTrim(paths, ",")
+	} else {
+		cfg.JWT.RetiredPublicKeyPaths = v.GetStringSlice("jwt.retired_public_key_paths")
+	}
+	cfg.JWT.Audience = v.GetString("jwt.audience")
+
+	cfg.TwoFactor.EncryptionKey = v.GetString("two_factor.encryption_key")
+	cfg.TwoFactor.Issuer = v.GetString("two_factor.issuer")
 
 	cfg.Logging.Level = v.GetString("logging.level")
 	cfg.Logging.Format = v.GetString("logging.format")
 
-	// Handle CORS allowed_origins - can be string (comma-separated) or slice
-	if originsStr := v.GetString("cors.allowed_origins"); originsStr != "" {
-		// Environment variable format: comma-separated string
-		cfg.CORS.AllowedOrigins = splitAndTrim(originsStr, ",")
-	} else {
-		// YAML format: array
-		cfg.CORS.AllowedOrigins = v.GetStringSlice("cors.allowed_origins")
-	}
-
-	cfg.CORS.AllowedMethods = v.GetStringSlice("cors.allowed_methods")
-	cfg.CORS.AllowedHeaders = v.GetStringSlice("cors.allowed_headers")
-	cfg.CORS.AllowCredentials = v.GetBool("cors.allow_credentials")
+	cfg.CORS = unmarshalCORSConfig(v, "cors")
+	cfg.PublicCORS = unmarshalCORSConfig(v, "public_cors")
 
 	cfg.QRCode.HMACSecret = v.GetString("qrcode.hmac_secret")
 	cfg.QRCode.HostingBaseURL = v.GetString("qrcode.hosting_base_url")
 	cfg.QRCode.WalletPassBaseURL = v.GetString("qrcode.wallet_pass_base_url")
+	cfg.QRCode.TokenStrategy = crypto.QRTokenStrategy(v.GetString("qrcode.token_strategy"))
+	cfg.QRCode.TokenBytes = v.GetInt("qrcode.token_bytes")
+	cfg.QRCode.TokenMaxAttempts = v.GetInt("qrcode.token_max_attempts")
+	cfg.QRCode.TokenTTL = v.GetDuration("qrcode.token_ttl")
+
+	cfg.Invite.AcceptBaseURL = v.GetString("invite.accept_base_url")
+	cfg.Invite.TokenExpiry = v.GetDuration("invite.token_expiry")
+
+	cfg.EmailVerification.Mode = EmailVerificationMode(v.GetString("email_verification.mode"))
+	cfg.EmailVerification.BaseURL = v.GetString("email_verification.base_url")
+	cfg.EmailVerification.TokenExpiry = v.GetDuration("email_verification.token_expiry")
 
 	unmarshalEmailConfig(v, cfg)
 	unmarshalTelemetryConfig(v, cfg)
+	unmarshalOutboxConfig(v, cfg)
+
+	cfg.Payment.DefaultCurrency = v.GetString("payment.default_currency")
+	cfg.I18n.DefaultLocale = v.GetString("i18n.default_locale")
+	cfg.Stats.NoShowRateWarning = v.GetFloat64("stats.no_show_rate_warning")
+	cfg.Stats.LowCheckinRateWarning = v.GetFloat64("stats.low_checkin_rate_warning")
+	cfg.Stats.CapacityWarning = v.GetFloat64("stats.capacity_warning")
+
+	cfg.Features.Webhooks = v.GetBool("features.webhooks")
+	cfg.Features.Invitations = v.GetBool("features.invitations")
+	cfg.Features.WalkInCheckin = v.GetBool("features.walk_in_checkin")
+	cfg.Features.Waitlist = v.GetBool("features.waitlist")
+
+	cfg.Checkin.UndoWindow = v.GetDuration("checkin.undo_window")
+	cfg.Checkin.DebounceWindow = v.GetDuration("checkin.debounce_window")
+	cfg.Checkin.WindowMargin = v.GetDuration("checkin.window_margin")
+
+	cfg.Retention.PurgeAfterDays = v.GetInt("retention.purge_after_days")
+	cfg.Retention.Interval = v.GetDuration("retention.interval")
+	cfg.Retention.BatchSize = v.GetInt("retention.batch_size")
+	cfg.ParticipantExpiry.Interval = v.GetDuration("participant_expiry.interval")
+	cfg.ParticipantExpiry.BatchSize = v.GetInt("participant_expiry.batch_size")
+	cfg.PublicRateLimit.PerIP = v.GetInt("public_rate_limit.per_ip")
+	cfg.PublicRateLimit.PerEvent = v.GetInt("public_rate_limit.per_event")
+	cfg.PublicRateLimit.Window = v.GetDuration("public_rate_limit.window")
+	cfg.AuthRateLimit.PerIP = v.GetInt("auth_rate_limit.per_ip")
+	cfg.AuthRateLimit.PerAccount = v.GetInt("auth_rate_limit.per_account")
+	cfg.AuthRateLimit.Window = v.GetDuration("auth_rate_limit.window")
+	cfg.APIRateLimit.PerIP = v.GetInt("api_rate_limit.per_ip")
+	cfg.APIRateLimit.PerUser = v.GetInt("api_rate_limit.per_user")
+	cfg.APIRateLimit.Window = v.GetDuration("api_rate_limit.window")
+	cfg.Recurrence.MaxOccurrences = v.GetInt("recurrence.max_occurrences")
+
+	cfg.Pagination.Events = unmarshalPageSizeConfig(v, "pagination.events")
+	cfg.Pagination.Participants = unmarshalPageSizeConfig(v, "pagination.participants")
+	cfg.Pagination.Checkins = unmarshalPageSizeConfig(v, "pagination.checkins")
+	cfg.Pagination.Users = unmarshalPageSizeConfig(v, "pagination.users")
+	cfg.Pagination.AuditLogs = unmarshalPageSizeConfig(v, "pagination.audit_logs")
 
 	// Validate required fields
 	if cfg.Database.User == "" {
@@ -472,6 +981,9 @@ func (c *Config) Validate() error {
 	if err := c.validateJWT(); err != nil {
 		return err
 	}
+	if err := c.validateTwoFactor(); err != nil {
+		return err
+	}
 	if err := c.validateLogging(); err != nil {
 		return err
 	}
@@ -481,6 +993,48 @@ func (c *Config) Validate() error {
 	if err := c.validateEmail(); err != nil {
 		return err
 	}
+	if err := c.validateInvite(); err != nil {
+		return err
+	}
+	if err := c.validateEmailVerification(); err != nil {
+		return err
+	}
+	if err := c.validateOutbox(); err != nil {
+		return err
+	}
+	if err := c.validateStats(); err != nil {
+		return err
+	}
+	if err := c.validatePagination(); err != nil {
+		return err
+	}
+	if err := c.validateCheckin(); err != nil {
+		return err
+	}
+	if err := c.validateRetention(); err != nil {
+		return err
+	}
+	if err := c.validateParticipantExpiry(); err != nil {
+		return err
+	}
+	if err := c.validatePublicRateLimit(); err != nil {
+		return err
+	}
+	if err := c.validateAuthRateLimit(); err != nil {
+		return err
+	}
+	if err := c.validateAPIRateLimit(); err != nil {
+		return err
+	}
+	if err := c.validateRecurrence(); err != nil {
+		return err
+	}
+	if err := c.validatePayment(); err != nil {
+		return err
+	}
+	if err := c.validateI18n(); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -507,6 +1061,22 @@ func (c *Config) IsProduction() bool {
 	return c.Server.Environment == "production"
 }
 
+// PublicCORSPolicy returns the CORS policy of the public routes: PublicCORS once it lists
+// allowed origins, taking the methods and headers it leaves empty from CORS, or else CORS.
+func (c *Config) PublicCORSPolicy() CORSConfig {
+	if len(c.PublicCORS.AllowedOrigins) == 0 {
+		return c.CORS
+	}
+	policy := c.PublicCORS
+	if len(policy.AllowedMethods) == 0 {
+		policy.AllowedMethods = c.CORS.AllowedMethods
+	}
+	if len(policy.AllowedHeaders) == 0 {
+		policy.AllowedHeaders = c.CORS.AllowedHeaders
+	}
+	return policy
+}
+
 // validateQRCode validates QR code configuration.
 func (c *Config) validateQRCode() error {
 	if c.QRCode.HMACSecret == "" {
@@ -519,6 +1089,27 @@ func (c *Config) validateQRCode() error {
 			len(c.QRCode.HMACSecret),
 		)
 	}
+	switch c.QRCode.TokenStrategy {
+	case crypto.QRTokenStrategyRandom, crypto.QRTokenStrategyHMAC, crypto.QRTokenStrategySigned:
+	default:
+		return fmt.Errorf(
+			"QR token strategy %q is invalid, must be %q, %q or %q (set QR_TOKEN_STRATEGY)",
+			c.QRCode.TokenStrategy, crypto.QRTokenStrategyRandom, crypto.QRTokenStrategyHMAC,
+			crypto.QRTokenStrategySigned,
+		)
+	}
+	if c.QRCode.TokenBytes < qrTokenMinBytes || c.QRCode.TokenBytes > qrTokenMaxBytes {
+		return fmt.Errorf(
+			"QR token bytes must be between %d and %d, got %d (set QR_TOKEN_BYTES)",
+			qrTokenMinBytes, qrTokenMaxBytes, c.QRCode.TokenBytes,
+		)
+	}
+	if c.QRCode.TokenMaxAttempts < 1 {
+		return fmt.Errorf("QR token max attempts must be at least 1 (set QR_TOKEN_MAX_ATTEMPTS)")
+	}
+	if c.QRCode.TokenTTL < 0 {
+		return fmt.Errorf("QR token TTL must not be negative (set QR_TOKEN_TTL)")
+	}
 	return nil
 }
 
@@ -552,6 +1143,236 @@ func (c *Config) validateEmail() error {
 			c.Email.Backend, EmailBackendSMTP, EmailBackendGmail,
 		)
 	}
+	if c.Email.DomainCheck {
+		if c.Email.DomainCheckTimeout <= 0 {
+			return fmt.Errorf("email domain check timeout must be positive (set EMAIL_DOMAIN_CHECK_TIMEOUT)")
+		}
+		if c.Email.DomainCheckCacheTTL <= 0 {
+			return fmt.Errorf("email domain check cache TTL must be positive (set EMAIL_DOMAIN_CHECK_CACHE_TTL)")
+		}
+	}
+	return nil
+}
+
+// validateInvite validates participant invitation configuration.
+func (c *Config) validateInvite() error {
+	if c.Invite.TokenExpiry <= 0 {
+		return fmt.Errorf("invite token expiry must be positive (set INVITE_TOKEN_EXPIRY)")
+	}
+	return nil
+}
+
+// validateEmailVerification validates user email verification configuration.
+func (c *Config) validateEmailVerification() error {
+	switch c.EmailVerification.Mode {
+	case EmailVerificationSoft:
+	case EmailVerificationHard:
+		// Users could never verify, and so never create events, without verification emails
+		if c.EmailVerification.BaseURL == "" {
+			return fmt.Errorf("email verification base URL is required in hard mode (set EMAIL_VERIFICATION_BASE_URL)")
+		}
+	default:
+		return fmt.Errorf(
+			"email verification mode must be \"soft\" or \"hard\", got %q (set EMAIL_VERIFICATION_MODE)",
+			c.EmailVerification.Mode,
+		)
+	}
+	if c.EmailVerification.TokenExpiry <= 0 {
+		return fmt.Errorf("email verification token expiry must be positive (set EMAIL_VERIFICATION_TOKEN_EXPIRY)")
+	}
+	return nil
+}
+
+// validateOutbox validates webhook delivery and outbox relay configuration.
+func (c *Config) validateOutbox() error {
+	for _, raw := range c.Webhook.URLs {
+		u, err := url.Parse(raw)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("webhook URL %q must be an absolute http(s) URL (set WEBHOOK_URLS)", raw)
+		}
+	}
+	if c.Webhook.Timeout <= 0 {
+		return fmt.Errorf("webhook timeout must be positive (set WEBHOOK_TIMEOUT)")
+	}
+	if c.Outbox.PollInterval <= 0 {
+		return fmt.Errorf("outbox poll interval must be positive (set OUTBOX_POLL_INTERVAL)")
+	}
+	if c.Outbox.BatchSize < 1 {
+		return fmt.Errorf("outbox batch size must be at least 1 (set OUTBOX_BATCH_SIZE)")
+	}
+	if c.Outbox.MaxAttempts < 1 {
+		return fmt.Errorf("outbox max attempts must be at least 1 (set OUTBOX_MAX_ATTEMPTS)")
+	}
+	if c.Outbox.RetryBaseDelay <= 0 || c.Outbox.RetryMaxDelay < c.Outbox.RetryBaseDelay {
+		return fmt.Errorf(
+			"outbox retry delays must be positive with max >= base (set OUTBOX_RETRY_BASE_DELAY, OUTBOX_RETRY_MAX_DELAY)",
+		)
+	}
+	// A message must stay leased while all of its webhook requests, including the one to its
+	// event's webhook, may still be running, otherwise another poll could deliver it again concurrently.
+	if minLease := c.Webhook.Timeout * time.Duration(len(c.Webhook.URLs)+1); c.Outbox.Lease <= minLease {
+		return fmt.Errorf(
+			"outbox lease must exceed the webhook timeout times the number of webhook URLs plus one (%s), "+
+				"got %s (set OUTBOX_LEASE)",
+			minLease, c.Outbox.Lease,
+		)
+	}
+	return nil
+}
+
+// validateStats validates event statistics warning thresholds.
+func (c *Config) validateStats() error {
+	if c.Stats.NoShowRateWarning < 0 || c.Stats.NoShowRateWarning > 1 {
+		return fmt.Errorf("stats no-show rate warning must be between 0 and 1 (set STATS_NO_SHOW_RATE_WARNING)")
+	}
+	if c.Stats.LowCheckinRateWarning < 0 || c.Stats.LowCheckinRateWarning > 1 {
+		return fmt.Errorf(
+			"stats low check-in rate warning must be between 0 and 1 (set STATS_LOW_CHECKIN_RATE_WARNING)",
+		)
+	}
+	if c.Stats.CapacityWarning < 0 || c.Stats.CapacityWarning > 1 {
+		return fmt.Errorf("stats capacity warning must be between 0 and 1 (set STATS_CAPACITY_WARNING)")
+	}
+	return nil
+}
+
+// validateCheckin validates check-in configuration.
+func (c *Config) validateCheckin() error {
+	if c.Checkin.UndoWindow < 0 {
+		return fmt.Errorf("checkin undo window must not be negative (set CHECKIN_UNDO_WINDOW)")
+	}
+	if c.Checkin.DebounceWindow < 0 {
+		return fmt.Errorf("checkin debounce window must not be negative (set CHECKIN_DEBOUNCE_WINDOW)")
+	}
+	if c.Checkin.WindowMargin < 0 {
+		return fmt.Errorf("checkin window margin must not be negative (set CHECKIN_WINDOW_MARGIN)")
+	}
+	return nil
+}
+
+// validateRetention validates data retention configuration.
+// The interval and batch size only matter while the purge is enabled.
+func (c *Config) validateRetention() error {
+	if c.Retention.PurgeAfterDays < 0 {
+		return fmt.Errorf("retention purge after days must not be negative (set RETENTION_PURGE_AFTER_DAYS)")
+	}
+	if c.Retention.PurgeAfterDays == 0 {
+		return nil
+	}
+	if c.Retention.Interval <= 0 {
+		return fmt.Errorf("retention interval must be positive (set RETENTION_INTERVAL)")
+	}
+	if c.Retention.BatchSize < 1 {
+		return fmt.Errorf("retention batch size must be at least 1 (set RETENTION_BATCH_SIZE)")
+	}
+	return nil
+}
+
+// validateParticipantExpiry validates the participant expirer schedule.
+func (c *Config) validateParticipantExpiry() error {
+	if c.ParticipantExpiry.Interval <= 0 {
+		return fmt.Errorf("participant expiry interval must be positive (set PARTICIPANT_EXPIRY_INTERVAL)")
+	}
+	if c.ParticipantExpiry.BatchSize < 1 {
+		return fmt.Errorf("participant expiry batch size must be at least 1 (set PARTICIPANT_EXPIRY_BATCH_SIZE)")
+	}
+	return nil
+}
+
+// validatePublicRateLimit validates the rate limits of the public routes.
+func (c *Config) validatePublicRateLimit() error {
+	if c.PublicRateLimit.PerIP < 0 || c.PublicRateLimit.PerEvent < 0 {
+		return fmt.Errorf(
+			"public rate limits must not be negative (set PUBLIC_RATE_LIMIT_PER_IP and PUBLIC_RATE_LIMIT_PER_EVENT)",
+		)
+	}
+	if c.PublicRateLimit.Window <= 0 {
+		return fmt.Errorf("public rate limit window must be positive (set PUBLIC_RATE_LIMIT_WINDOW)")
+	}
+	return nil
+}
+
+// validateAuthRateLimit validates the rate limits of the authentication routes.
+func (c *Config) validateAuthRateLimit() error {
+	if c.AuthRateLimit.PerIP < 0 || c.AuthRateLimit.PerAccount < 0 {
+		return fmt.Errorf(
+			"auth rate limits must not be negative (set AUTH_RATE_LIMIT_PER_IP and AUTH_RATE_LIMIT_PER_ACCOUNT)",
+		)
+	}
+	if c.AuthRateLimit.Window <= 0 {
+		return fmt.Errorf("auth rate limit window must be positive (set AUTH_RATE_LIMIT_WINDOW)")
+	}
+	return nil
+}
+
+// validateAPIRateLimit validates the rate limits of the routes requiring authentication.
+func (c *Config) validateAPIRateLimit() error {
+	if c.APIRateLimit.PerIP < 0 || c.APIRateLimit.PerUser < 0 {
+		return fmt.Errorf(
+			"API rate limits must not be negative (set API_RATE_LIMIT_PER_IP and API_RATE_LIMIT_PER_USER)",
+		)
+	}
+	if c.APIRateLimit.Window <= 0 {
+		return fmt.Errorf("API rate limit window must be positive (set API_RATE_LIMIT_WINDOW)")
+	}
+	return nil
+}
+
+// validateRecurrence validates the bounds of recurring events.
+func (c *Config) validateRecurrence() error {
+	if c.Recurrence.MaxOccurrences < 1 {
+		return fmt.Errorf("recurrence max occurrences must be at least 1 (set RECURRENCE_MAX_OCCURRENCES)")
+	}
+	return nil
+}
+
+// validatePagination validates the page size bounds of every list endpoint.
+func (c *Config) validatePagination() error {
+	for _, p := range []struct {
+		name string
+		env  string
+		size PageSizeConfig
+	}{
+		{"events", "EVENTS", c.Pagination.Events},
+		{"participants", "PARTICIPANTS", c.Pagination.Participants},
+		{"checkins", "CHECKINS", c.Pagination.Checkins},
+		{"users", "USERS", c.Pagination.Users},
+		{"audit logs", "AUDIT_LOGS", c.Pagination.AuditLogs},
+	} {
+		if p.size.DefaultPerPage < 1 || p.size.DefaultPerPage > p.size.MaxPerPage {
+			return fmt.Errorf(
+				"pagination %s default per page must be between 1 and the max per page %d "+
+					"(set PAGINATION_%s_DEFAULT_PER_PAGE and PAGINATION_%s_MAX_PER_PAGE)",
+				p.name, p.size.MaxPerPage, p.env, p.env,
+			)
+		}
+	}
+	return nil
+}
+
+// validatePayment validates payment configuration.
+func (c *Config) validatePayment() error {
+	if c.Payment.DefaultCurrency == "" {
+		return nil
+	}
+	if !money.IsKnownCurrency(c.Payment.DefaultCurrency) {
+		return fmt.Errorf(
+			"payment default currency %q is not a known ISO 4217 code (set PAYMENT_DEFAULT_CURRENCY)",
+			c.Payment.DefaultCurrency,
+		)
+	}
+	return nil
+}
+
+// validateI18n validates localization configuration.
+func (c *Config) validateI18n() error {
+	if !i18n.IsSupported(c.I18n.DefaultLocale) {
+		return fmt.Errorf(
+			"i18n default locale must be one of %s, got %q (set I18N_DEFAULT_LOCALE)",
+			strings.Join(i18n.SupportedLocales(), ", "),
+			c.I18n.DefaultLocale,
+		)
+	}
 	return nil
 }
 
@@ -578,6 +1399,28 @@ func (c *Config) validateServer() error {
 	if c.Server.IdleTimeout <= 0 {
 		return fmt.Errorf("server idle timeout must be positive")
 	}
+	if c.Server.ShutdownTimeout <= 0 {
+		return fmt.Errorf("server shutdown timeout must be positive")
+	}
+	if c.Server.RequestTimeout < 0 || c.Server.AuthRequestTimeout < 0 || c.Server.BulkRequestTimeout < 0 {
+		return fmt.Errorf("server request timeouts must not be negative")
+	}
+	if c.Server.IdempotencyKeyTTL < 0 {
+		return fmt.Errorf("server idempotency key TTL must not be negative")
+	}
+	if c.Server.CompressionMinSize < 0 {
+		return fmt.Errorf("server compression min size must not be negative")
+	}
+	if c.Server.MaxBodySize < 0 || c.Server.MaxUploadSize < 0 {
+		return fmt.Errorf("server body size limits must not be negative")
+	}
+	for _, proxy := range c.Server.TrustedProxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				return fmt.Errorf("server trusted proxy %q must be an IP or CIDR range (set SERVER_TRUSTED_PROXIES)", proxy)
+			}
+		}
+	}
 	return nil
 }
 
@@ -634,6 +1477,9 @@ func (c *Config) validateDatabasePool() error {
 	if c.Database.MaxConnIdleTime < 0 {
 		return fmt.Errorf("database connection max idle time cannot be negative")
 	}
+	if c.Database.QueryTimeout < 0 {
+		return fmt.Errorf("database query timeout cannot be negative")
+	}
 	return nil
 }
 
@@ -668,6 +1514,46 @@ func (c *Config) validateJWT() error {
 	if c.JWT.RefreshTokenExpiryMobile <= 0 {
 		return fmt.Errorf("jwt refresh token expiry (mobile) must be positive")
 	}
+	if c.JWT.RefreshTokenExpiryWeb <= c.JWT.AccessTokenExpiry {
+		return fmt.Errorf("jwt refresh token expiry (web) must be longer than the access token expiry")
+	}
+	if c.JWT.RefreshTokenExpiryMobile <= c.JWT.AccessTokenExpiry {
+		return fmt.Errorf("jwt refresh token expiry (mobile) must be longer than the access token expiry")
+	}
+	switch c.JWT.Algorithm {
+	case crypto.SigningAlgorithmHS256:
+	case crypto.SigningAlgorithmRS256:
+		if c.JWT.PrivateKeyPath == "" {
+			return fmt.Errorf("jwt private key path is required for RS256 (set JWT_PRIVATE_KEY_PATH)")
+		}
+	default:
+		return fmt.Errorf(
+			"jwt algorithm %q is invalid, must be %q or %q (set JWT_ALGORITHM)",
+			c.JWT.Algorithm, crypto.SigningAlgorithmHS256, crypto.SigningAlgorithmRS256,
+		)
+	}
+	for _, secret := range c.JWT.RetiredSecrets {
+		if len(secret) < jwtSecretMinLength {
+			return fmt.Errorf(
+				"jwt retired secrets must be at least %d characters, got %d (set JWT_RETIRED_SECRETS)",
+				jwtSecretMinLength, len(secret),
+			)
+		}
+	}
+	return nil
+}
+
+// validateTwoFactor validates two-factor authentication configuration.
+func (c *Config) validateTwoFactor() error {
+	if c.TwoFactor.EncryptionKey != "" && len(c.TwoFactor.EncryptionKey) < twoFactorKeyMinLength {
+		return fmt.Errorf(
+			"two-factor encryption key must be at least %d characters, got %d (set TWO_FACTOR_ENCRYPTION_KEY)",
+			twoFactorKeyMinLength, len(c.TwoFactor.EncryptionKey),
+		)
+	}
+	if c.TwoFactor.Issuer == "" {
+		return fmt.Errorf("two-factor issuer is required (set TWO_FACTOR_ISSUER)")
+	}
 	return nil
 }
 
@@ -0,0 +1,24 @@
+//go:generate mockgen -destination=mocks/mock_domain_checker.go -package=mocks . DomainChecker
+
+package email
+
+import "context"
+
+// DomainStatus is the result of checking whether an email domain can receive mail.
+type DomainStatus string
+
+const (
+	// DomainDeliverable means the domain has a mail server.
+	DomainDeliverable DomainStatus = "deliverable"
+	// DomainUndeliverable means the domain does not exist or accepts no mail.
+	DomainUndeliverable DomainStatus = "undeliverable"
+	// DomainUnknown means the check could not be completed, e.g. because the lookup timed out.
+	DomainUnknown DomainStatus = "unknown"
+)
+
+// DomainChecker checks whether email domains can receive mail.
+// Implementations include MXChecker.
+type DomainChecker interface {
+	// CheckDomain never fails: a check that cannot be completed reports DomainUnknown.
+	CheckDomain(ctx context.Context, domain string) DomainStatus
+}
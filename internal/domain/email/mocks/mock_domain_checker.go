@@ -0,0 +1,56 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/fumkob/ezqrin-server/internal/domain/email (interfaces: DomainChecker)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_domain_checker.go -package=mocks . DomainChecker
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	email "github.com/fumkob/ezqrin-server/internal/domain/email"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockDomainChecker is a mock of DomainChecker interface.
+type MockDomainChecker struct {
+	ctrl     *gomock.Controller
+	recorder *MockDomainCheckerMockRecorder
+	isgomock struct{}
+}
+
+// MockDomainCheckerMockRecorder is the mock recorder for MockDomainChecker.
+type MockDomainCheckerMockRecorder struct {
+	mock *MockDomainChecker
+}
+
+// NewMockDomainChecker creates a new mock instance.
+func NewMockDomainChecker(ctrl *gomock.Controller) *MockDomainChecker {
+	mock := &MockDomainChecker{ctrl: ctrl}
+	mock.recorder = &MockDomainCheckerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDomainChecker) EXPECT() *MockDomainCheckerMockRecorder {
+	return m.recorder
+}
+
+// CheckDomain mocks base method.
+func (m *MockDomainChecker) CheckDomain(ctx context.Context, domain string) email.DomainStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckDomain", ctx, domain)
+	ret0, _ := ret[0].(email.DomainStatus)
+	return ret0
+}
+
+// CheckDomain indicates an expected call of CheckDomain.
+func (mr *MockDomainCheckerMockRecorder) CheckDomain(ctx, domain any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckDomain", reflect.TypeOf((*MockDomainChecker)(nil).CheckDomain), ctx, domain)
+}
@@ -0,0 +1,249 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditAction is the kind of change an audit log entry records.
+type AuditAction string
+
+const (
+	// AuditActionCreate records that a resource was created.
+	AuditActionCreate AuditAction = "create"
+	// AuditActionUpdate records that a resource was changed.
+	AuditActionUpdate AuditAction = "update"
+	// AuditActionDelete records that a resource was deleted, or a check-in cancelled.
+	AuditActionDelete AuditAction = "delete"
+)
+
+// AuditResourceType is the kind of resource an audit log entry is about.
+type AuditResourceType string
+
+const (
+	// AuditResourceEvent identifies events.
+	AuditResourceEvent AuditResourceType = "event"
+	// AuditResourceParticipant identifies participants.
+	AuditResourceParticipant AuditResourceType = "participant"
+	// AuditResourceCheckin identifies check-ins.
+	AuditResourceCheckin AuditResourceType = "checkin"
+)
+
+// Common validation errors for AuditLog entity
+var (
+	ErrAuditActionInvalid       = errors.New("invalid audit action")
+	ErrAuditResourceTypeInvalid = errors.New("resource_type must be one of event, participant, checkin")
+	ErrAuditActorIDRequired     = errors.New("audit actor ID is required")
+	ErrAuditResourceIDRequired  = errors.New("audit resource ID is required")
+)
+
+// AuditFields is a snapshot of the audited fields of a resource, by their JSON name.
+// Values wrapped with RedactedAuditValue are compared but never recorded.
+type AuditFields map[string]any
+
+// AuditChange is the change of one field of a resource. Old is unset for created resources and
+// New for deleted ones. Personal data is recorded only as Redacted, without its values.
+type AuditChange struct {
+	Old      any  `json:"old,omitempty"`
+	New      any  `json:"new,omitempty"`
+	Redacted bool `json:"redacted,omitempty"`
+}
+
+// AuditLog records who created, changed or deleted a resource, and which of its fields changed.
+type AuditLog struct {
+	ID           uuid.UUID
+	ActorID      uuid.UUID // User who made the change
+	Action       AuditAction
+	ResourceType AuditResourceType
+	ResourceID   uuid.UUID
+	Changes      map[string]AuditChange // Changed fields by JSON name; empty if none changed
+	CreatedAt    time.Time
+}
+
+// redactedAuditValue holds personal data that may show as changed in the audit log, but
+// whose values are kept out of it.
+type redactedAuditValue struct {
+	value any
+}
+
+// RedactedAuditValue marks a field value of AuditFields as personal data.
+func RedactedAuditValue(value any) any {
+	return redactedAuditValue{value: value}
+}
+
+// NewAuditLog creates an audit log entry of actorID performing action on a resource, recording
+// the fields that differ between the before and after snapshots of the resource.
+// before is nil for created resources and after is nil for deleted ones.
+func NewAuditLog(
+	actorID uuid.UUID,
+	action AuditAction,
+	resourceType AuditResourceType,
+	resourceID uuid.UUID,
+	before, after AuditFields,
+) (*AuditLog, error) {
+	entry := &AuditLog{
+		ID:           uuid.New(),
+		ActorID:      actorID,
+		Action:       action,
+		ResourceType: resourceType,
+		ResourceID:   resourceID,
+		Changes:      DiffAuditFields(before, after),
+		CreatedAt:    time.Now().UTC(),
+	}
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+	return entry, nil
+}
+
+// DiffAuditFields returns the changes of the fields that differ between two snapshots of a
+// resource. Values are compared by their JSON encoding; a field missing from a snapshot differs
+// from every value of the other.
+func DiffAuditFields(before, after AuditFields) map[string]AuditChange {
+	changes := make(map[string]AuditChange)
+	for name, oldValue := range before {
+		newValue, ok := after[name]
+		if !ok || !sameAuditValue(oldValue, newValue) {
+			changes[name] = auditChange(oldValue, newValue, true, ok)
+		}
+	}
+	for name, newValue := range after {
+		if _, ok := before[name]; !ok {
+			changes[name] = auditChange(nil, newValue, false, true)
+		}
+	}
+	return changes
+}
+
+// auditChange builds the change of a field from its old and new values, each only when present.
+func auditChange(oldValue, newValue any, hasOld, hasNew bool) AuditChange {
+	_, oldRedacted := oldValue.(redactedAuditValue)
+	_, newRedacted := newValue.(redactedAuditValue)
+	if oldRedacted || newRedacted {
+		return AuditChange{Redacted: true}
+	}
+
+	var change AuditChange
+	if hasOld {
+		change.Old = auditJSONValue(oldValue)
+	}
+	if hasNew {
+		change.New = auditJSONValue(newValue)
+	}
+	return change
+}
+
+// auditJSONValue returns a value in a form that is always encoded, even when it is a nil pointer.
+func auditJSONValue(value any) any {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil
+	}
+	return json.RawMessage(data)
+}
+
+// sameAuditValue reports whether two field values have the same JSON encoding.
+func sameAuditValue(a, b any) bool {
+	if redacted, ok := a.(redactedAuditValue); ok {
+		a = redacted.value
+	}
+	if redacted, ok := b.(redactedAuditValue); ok {
+		b = redacted.value
+	}
+	aJSON, aErr := json.Marshal(a)
+	bJSON, bErr := json.Marshal(b)
+	return aErr == nil && bErr == nil && string(aJSON) == string(bJSON)
+}
+
+// Validate validates the AuditLog entity fields.
+func (l *AuditLog) Validate() error {
+	switch l.Action {
+	case AuditActionCreate, AuditActionUpdate, AuditActionDelete:
+	default:
+		return ErrAuditActionInvalid
+	}
+	if err := ValidateAuditResourceType(string(l.ResourceType)); err != nil {
+		return err
+	}
+	if l.ActorID == uuid.Nil {
+		return ErrAuditActorIDRequired
+	}
+	if l.ResourceID == uuid.Nil {
+		return ErrAuditResourceIDRequired
+	}
+	return nil
+}
+
+// ValidateAuditResourceType validates if a resource type string is valid
+func ValidateAuditResourceType(resourceType string) error {
+	switch AuditResourceType(resourceType) {
+	case AuditResourceEvent, AuditResourceParticipant, AuditResourceCheckin:
+		return nil
+	default:
+		return ErrAuditResourceTypeInvalid
+	}
+}
+
+// AuditFields returns the audited fields of the event.
+func (e *Event) AuditFields() AuditFields {
+	return AuditFields{
+		"organizer_id":           e.OrganizerID,
+		"name":                   e.Name,
+		"description":            e.Description,
+		"start_date":             e.StartDate,
+		"end_date":               e.EndDate,
+		"location":               e.Location,
+		"timezone":               e.Timezone,
+		"currency":               e.Currency,
+		"fee_type":               e.FeeType,
+		"fee_amount":             e.FeeAmount,
+		"status":                 e.Status,
+		"requires_consent":       e.RequiresConsent,
+		"consent_version":        e.ConsentVersion,
+		"legal_hold":             e.LegalHold,
+		"tentative_expiry_hours": e.TentativeExpiryHours,
+		"capacity":               e.Capacity,
+		"series_id":              e.SeriesID,
+	}
+}
+
+// AuditFields returns the audited fields of the participant. Contact details, notes and
+// custom data are personal data, so the audit log only shows that they changed.
+func (p *Participant) AuditFields() AuditFields {
+	return AuditFields{
+		"event_id":        p.EventID,
+		"name":            RedactedAuditValue(p.Name),
+		"email":           RedactedAuditValue(p.Email),
+		"employee_id":     RedactedAuditValue(p.EmployeeID),
+		"phone":           RedactedAuditValue(p.Phone),
+		"qr_email":        RedactedAuditValue(p.QREmail),
+		"notes":           RedactedAuditValue(p.Notes),
+		"metadata":        RedactedAuditValue(p.Metadata),
+		"custom_data":     RedactedAuditValue(p.CustomData),
+		"status":          p.Status,
+		"walk_in":         p.WalkIn,
+		"guest_of":        p.GuestOf,
+		"payment_status":  p.PaymentStatus,
+		"payment_amount":  p.PaymentAmount,
+		"payment_date":    p.PaymentDate,
+		"consent_version": p.ConsentVersion,
+		"tags":            p.Tags,
+		"group_name":      p.GroupName,
+	}
+}
+
+// AuditFields returns the audited fields of the check-in.
+func (c *Checkin) AuditFields() AuditFields {
+	return AuditFields{
+		"event_id":       c.EventID,
+		"participant_id": c.ParticipantID,
+		"checked_in_at":  c.CheckedInAt,
+		"checked_in_by":  c.CheckedInBy,
+		"method":         c.Method,
+		"cancelled_at":   c.CancelledAt,
+		"cancelled_by":   c.CancelledBy,
+	}
+}
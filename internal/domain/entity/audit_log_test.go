@@ -0,0 +1,108 @@
+package entity_test
+
+import (
+	"encoding/json"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditLog", func() {
+	// changesJSON returns the JSON encoding of the changes of an entry, as stored in the audit log
+	changesJSON := func(entry *entity.AuditLog) string {
+		data, err := json.Marshal(entry.Changes)
+		Expect(err).NotTo(HaveOccurred())
+		return string(data)
+	}
+
+	When("a resource is updated", func() {
+		It("should record only the changed fields with their old and new values", func() {
+			event := &entity.Event{Name: "Tech Conference", Status: entity.StatusDraft, Capacity: 100}
+			before := event.AuditFields()
+			event.Name = "Tech Conference 2026"
+			event.Status = entity.StatusPublished
+
+			entry, err := entity.NewAuditLog(
+				uuid.New(), entity.AuditActionUpdate, entity.AuditResourceEvent, uuid.New(), before, event.AuditFields(),
+			)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changesJSON(entry)).To(MatchJSON(`{
+				"name": {"old": "Tech Conference", "new": "Tech Conference 2026"},
+				"status": {"old": "draft", "new": "published"}
+			}`))
+		})
+
+		It("should record a cleared field with a null new value", func() {
+			participant := &entity.Participant{Status: entity.ParticipantStatusConfirmed, Tags: []string{"vip"}}
+			before := participant.AuditFields()
+			participant.Tags = nil
+
+			data, err := json.Marshal(entity.DiffAuditFields(before, participant.AuditFields()))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(MatchJSON(`{"tags": {"old": ["vip"], "new": null}}`))
+		})
+
+		It("should record that personal data changed without its values", func() {
+			participant := &entity.Participant{Name: "Alice", Email: "alice@example.com"}
+			before := participant.AuditFields()
+			participant.Email = "alice@example.org"
+
+			changes := entity.DiffAuditFields(before, participant.AuditFields())
+
+			Expect(changes).To(Equal(map[string]entity.AuditChange{"email": {Redacted: true}}))
+		})
+
+		It("should record no changes when nothing changed", func() {
+			checkin := &entity.Checkin{Method: entity.CheckinMethodQRCode}
+
+			Expect(entity.DiffAuditFields(checkin.AuditFields(), checkin.AuditFields())).To(BeEmpty())
+		})
+	})
+
+	When("a resource is created", func() {
+		It("should record every field with its new value only", func() {
+			checkin := &entity.Checkin{Method: entity.CheckinMethodManual}
+
+			changes := entity.DiffAuditFields(nil, checkin.AuditFields())
+
+			Expect(changes).To(HaveLen(len(checkin.AuditFields())))
+			Expect(changes["method"].Old).To(BeNil())
+			Expect(changes["method"].New).To(BeEquivalentTo(`"manual"`))
+		})
+	})
+
+	When("a resource is deleted", func() {
+		It("should record every field with its old value only", func() {
+			checkin := &entity.Checkin{Method: entity.CheckinMethodManual}
+
+			changes := entity.DiffAuditFields(checkin.AuditFields(), nil)
+
+			Expect(changes["method"].Old).To(BeEquivalentTo(`"manual"`))
+			Expect(changes["method"].New).To(BeNil())
+		})
+	})
+
+	When("validating", func() {
+		It("should reject an unknown resource type", func() {
+			_, err := entity.NewAuditLog(uuid.New(), entity.AuditActionCreate, "user", uuid.New(), nil, nil)
+
+			Expect(err).To(MatchError(entity.ErrAuditResourceTypeInvalid))
+		})
+
+		It("should reject an unknown action", func() {
+			_, err := entity.NewAuditLog(uuid.New(), "restore", entity.AuditResourceEvent, uuid.New(), nil, nil)
+
+			Expect(err).To(MatchError(entity.ErrAuditActionInvalid))
+		})
+
+		It("should require the actor", func() {
+			_, err := entity.NewAuditLog(uuid.Nil, entity.AuditActionCreate, entity.AuditResourceEvent, uuid.New(), nil, nil)
+
+			Expect(err).To(MatchError(entity.ErrAuditActorIDRequired))
+		})
+	})
+})
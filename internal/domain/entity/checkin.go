@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"time"
+	"unicode/utf8"
 
 	"github.com/google/uuid"
 )
@@ -19,11 +20,41 @@ const (
 	CheckinMethodManual CheckinMethod = "manual"
 )
 
+// ScanOutcome is the result of a QR scan at check-in.
+type ScanOutcome string
+
+const (
+	// ScanOutcomeSuccess means the scan checked the participant in.
+	ScanOutcomeSuccess ScanOutcome = "success"
+	// ScanOutcomeDuplicate means the participant had already checked in.
+	ScanOutcomeDuplicate ScanOutcome = "duplicate"
+	// ScanOutcomeNotFound means the QR code is genuine but matches no participant of the event.
+	ScanOutcomeNotFound ScanOutcome = "not_found"
+	// ScanOutcomeInvalid means the QR code was not issued by this server or the participant
+	// may not check in, e.g. because they cancelled.
+	ScanOutcomeInvalid ScanOutcome = "invalid"
+)
+
+// CheckinScan records one QR scan attempt at check-in, whatever its outcome.
+type CheckinScan struct {
+	EventID   uuid.UUID
+	Outcome   ScanOutcome
+	ScannedAt time.Time
+}
+
+// Checkin field constraints
+const (
+	CheckinSourceMaxLength   = 50  // characters, not bytes
+	CheckinDeviceIDMaxLength = 100 // characters, not bytes
+)
+
 // Common validation errors for Checkin entity
 var (
 	ErrCheckinEventIDRequired       = errors.New("event ID is required")
 	ErrCheckinParticipantIDRequired = errors.New("participant ID is required")
 	ErrCheckinMethodInvalid         = errors.New("invalid checkin method")
+	ErrCheckinSourceInvalid         = errors.New("source must be 1-50 characters")
+	ErrCheckinDeviceIDInvalid       = errors.New("device ID must be 1-100 characters")
 	ErrCheckinAlreadyExists         = errors.New("participant has already checked in")
 )
 
@@ -36,6 +67,10 @@ type Checkin struct {
 	CheckedInBy   *uuid.UUID // Nullable - can be NULL for self-service kiosks
 	Method        CheckinMethod
 	DeviceInfo    *json.RawMessage // JSONB for device metadata (OS, browser, app version, etc.)
+	Source        *string          // Client or channel the check-in was made from (e.g. "kiosk"); nil if not reported
+	DeviceID      *string          // Device or scanning station that made the check-in; nil if not reported
+	CancelledAt   *time.Time       // Set when the check-in was cancelled; nil for active check-ins
+	CancelledBy   *uuid.UUID       // User who cancelled the check-in
 }
 
 // Validate validates the Checkin entity fields.
@@ -49,9 +84,20 @@ func (c *Checkin) Validate() error {
 	if !c.IsValidMethod() {
 		return ErrCheckinMethodInvalid
 	}
+	if !validOptionalLength(c.Source, CheckinSourceMaxLength) {
+		return ErrCheckinSourceInvalid
+	}
+	if !validOptionalLength(c.DeviceID, CheckinDeviceIDMaxLength) {
+		return ErrCheckinDeviceIDInvalid
+	}
 	return nil
 }
 
+// validOptionalLength reports whether an optional value is nil or 1 to maxLength characters long
+func validOptionalLength(value *string, maxLength int) bool {
+	return value == nil || (*value != "" && utf8.RuneCountInString(*value) <= maxLength)
+}
+
 // IsValidMethod checks if the checkin method is valid.
 func (c *Checkin) IsValidMethod() bool {
 	switch c.Method {
@@ -62,6 +108,11 @@ func (c *Checkin) IsValidMethod() bool {
 	}
 }
 
+// IsCancelled returns true if the check-in was cancelled and no longer counts as checked in.
+func (c *Checkin) IsCancelled() bool {
+	return c.CancelledAt != nil
+}
+
 // IsQRCodeMethod returns true if the check-in was performed via QR code scan.
 func (c *Checkin) IsQRCodeMethod() bool {
 	return c.Method == CheckinMethodQRCode
@@ -2,6 +2,7 @@ package entity_test
 
 import (
 	"encoding/json"
+	"strings"
 	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
@@ -92,6 +93,33 @@ var _ = Describe("Checkin", func() {
 				Expect(validCheckin.Validate()).To(Succeed())
 			})
 		})
+
+		Context("with source and device ID", func() {
+			It("should succeed", func() {
+				source, deviceID := "scanner-app", "gate-a-01"
+				validCheckin.Source = &source
+				validCheckin.DeviceID = &deviceID
+				Expect(validCheckin.Validate()).To(Succeed())
+			})
+		})
+
+		DescribeTable("with an invalid source",
+			func(source string) {
+				validCheckin.Source = &source
+				Expect(validCheckin.Validate()).To(MatchError(entity.ErrCheckinSourceInvalid))
+			},
+			Entry("empty", ""),
+			Entry("too long", strings.Repeat("s", entity.CheckinSourceMaxLength+1)),
+		)
+
+		DescribeTable("with an invalid device ID",
+			func(deviceID string) {
+				validCheckin.DeviceID = &deviceID
+				Expect(validCheckin.Validate()).To(MatchError(entity.ErrCheckinDeviceIDInvalid))
+			},
+			Entry("empty", ""),
+			Entry("too long", strings.Repeat("d", entity.CheckinDeviceIDMaxLength+1)),
+		)
 	})
 
 	When("checking method type", func() {
@@ -112,6 +140,22 @@ var _ = Describe("Checkin", func() {
 		})
 	})
 
+	When("checking cancellation", func() {
+		Context("with an active check-in", func() {
+			It("should not be cancelled", func() {
+				Expect(validCheckin.IsCancelled()).To(BeFalse())
+			})
+		})
+
+		Context("with a cancelled check-in", func() {
+			It("should be cancelled", func() {
+				validCheckin.CancelledAt = &now
+				validCheckin.CancelledBy = &checkedInBy
+				Expect(validCheckin.IsCancelled()).To(BeTrue())
+			})
+		})
+	})
+
 	When("checking method validity", func() {
 		Context("with valid QR code method", func() {
 			It("should return true", func() {
@@ -0,0 +1,208 @@
+package entity
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// CustomFieldType represents the kind of value a custom participant field holds.
+type CustomFieldType string
+
+const (
+	// CustomFieldTypeText holds free text.
+	CustomFieldTypeText CustomFieldType = "text"
+	// CustomFieldTypeNumber holds a number.
+	CustomFieldTypeNumber CustomFieldType = "number"
+	// CustomFieldTypeBoolean holds true or false.
+	CustomFieldTypeBoolean CustomFieldType = "boolean"
+	// CustomFieldTypeSelect holds one of the field's options.
+	CustomFieldTypeSelect CustomFieldType = "select"
+)
+
+// CustomField defines a piece of data an event collects from its participants beyond the
+// built-in fields, e.g. a t-shirt size. Participants store the values by Key.
+type CustomField struct {
+	Key      string          `json:"key"`
+	Label    string          `json:"label"`
+	Type     CustomFieldType `json:"type"`
+	Required bool            `json:"required"`
+	Options  []string        `json:"options,omitempty"` // Allowed values; select fields only
+}
+
+// Validation constants for custom fields
+const (
+	MaxCustomFields              = 50
+	CustomFieldKeyMaxLength      = 50
+	CustomFieldLabelMaxLength    = 255
+	MaxCustomFieldOptions        = 100
+	CustomFieldOptionMaxLength   = 255
+	CustomFieldTextValueMaxChars = 1000
+)
+
+// customFieldKeyPattern matches keys usable as JSON keys and CSV column names alike
+var customFieldKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Custom field validation errors
+var (
+	ErrCustomFieldTooMany        = errors.New("an event must not have more than 50 custom fields")
+	ErrCustomFieldKeyInvalid     = errors.New("custom field key must match ^[a-z][a-z0-9_]*$ and not exceed 50 characters")
+	ErrCustomFieldKeyDuplicate   = errors.New("custom field keys must be unique")
+	ErrCustomFieldKeyReserved    = errors.New("custom field key is the name of a built-in participant field")
+	ErrCustomFieldLabelInvalid   = errors.New("custom field label is required and must not exceed 255 characters")
+	ErrCustomFieldTypeInvalid    = errors.New("invalid custom field type")
+	ErrCustomFieldOptionsInvalid = errors.New("select fields need 1-100 unique options, other fields none")
+	ErrCustomDataUnknownField    = errors.New("custom data has a field the event does not define")
+	ErrCustomDataRequired        = errors.New("custom data is missing a required field")
+	ErrCustomDataTypeMismatch    = errors.New("custom data value does not match the field type")
+	ErrCustomDataOptionInvalid   = errors.New("custom data value is not one of the field options")
+	ErrCustomDataTextTooLong     = errors.New("custom data text must not exceed 1000 characters")
+)
+
+// reservedCustomFieldKeys are the participant columns of CSV imports and exports, which custom
+// fields must not shadow
+var reservedCustomFieldKeys = []string{
+	"id", "name", "email", "employee_id", "phone", "qr_email", "guest_of", "status", "qr_code",
+	"qr_code_generated_at", "qr_distribution_url", "payment_status", "payment_amount", "payment_currency",
+	"payment_date", "checked_in", "checked_in_at", "metadata", "custom_data", "created_at", "updated_at",
+}
+
+// ValidateCustomFields validates the custom field definitions of an event.
+func ValidateCustomFields(fields []CustomField) error {
+	if len(fields) > MaxCustomFields {
+		return ErrCustomFieldTooMany
+	}
+	keys := make(map[string]bool, len(fields))
+	for _, field := range fields {
+		if len(field.Key) > CustomFieldKeyMaxLength || !customFieldKeyPattern.MatchString(field.Key) {
+			return fmt.Errorf("%w: %q", ErrCustomFieldKeyInvalid, field.Key)
+		}
+		if slices.Contains(reservedCustomFieldKeys, field.Key) {
+			return fmt.Errorf("%w: %q", ErrCustomFieldKeyReserved, field.Key)
+		}
+		if keys[field.Key] {
+			return fmt.Errorf("%w: %q", ErrCustomFieldKeyDuplicate, field.Key)
+		}
+		keys[field.Key] = true
+		if strings.TrimSpace(field.Label) == "" || utf8.RuneCountInString(field.Label) > CustomFieldLabelMaxLength {
+			return fmt.Errorf("%w: %q", ErrCustomFieldLabelInvalid, field.Key)
+		}
+		if err := validateCustomFieldOptions(field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateCustomFieldOptions checks the type of a field and that only select fields have options.
+func validateCustomFieldOptions(field CustomField) error {
+	switch field.Type {
+	case CustomFieldTypeText, CustomFieldTypeNumber, CustomFieldTypeBoolean:
+		if len(field.Options) > 0 {
+			return fmt.Errorf("%w: %q", ErrCustomFieldOptionsInvalid, field.Key)
+		}
+		return nil
+	case CustomFieldTypeSelect:
+		if len(field.Options) == 0 || len(field.Options) > MaxCustomFieldOptions {
+			return fmt.Errorf("%w: %q", ErrCustomFieldOptionsInvalid, field.Key)
+		}
+		seen := make(map[string]bool, len(field.Options))
+		for _, option := range field.Options {
+			if option == "" || utf8.RuneCountInString(option) > CustomFieldOptionMaxLength || seen[option] {
+				return fmt.Errorf("%w: %q", ErrCustomFieldOptionsInvalid, field.Key)
+			}
+			seen[option] = true
+		}
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrCustomFieldTypeInvalid, field.Type)
+	}
+}
+
+// FindCustomField returns the custom field with the given key, if the event defines one.
+func (e *Event) FindCustomField(key string) (CustomField, bool) {
+	for _, field := range e.CustomFields {
+		if field.Key == key {
+			return field, true
+		}
+	}
+	return CustomField{}, false
+}
+
+// ValidateCustomData checks participant custom data against the event's custom fields: every
+// key must be a defined field, every required field must be present and every value must
+// match its field's type. JSON numbers are expected as float64, as encoding/json decodes them.
+func (e *Event) ValidateCustomData(data map[string]any) error {
+	for key, value := range data {
+		field, ok := e.FindCustomField(key)
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrCustomDataUnknownField, key)
+		}
+		if err := field.validateValue(value); err != nil {
+			return err
+		}
+	}
+	for _, field := range e.CustomFields {
+		if _, ok := data[field.Key]; field.Required && !ok {
+			return fmt.Errorf("%w: %q", ErrCustomDataRequired, field.Key)
+		}
+	}
+	return nil
+}
+
+// validateValue checks that value fits the field. A null value counts as missing for required
+// fields and is otherwise accepted.
+func (f CustomField) validateValue(value any) error {
+	if value == nil {
+		if f.Required {
+			return fmt.Errorf("%w: %q", ErrCustomDataRequired, f.Key)
+		}
+		return nil
+	}
+	var ok bool
+	switch f.Type {
+	case CustomFieldTypeText:
+		var text string
+		if text, ok = value.(string); ok && utf8.RuneCountInString(text) > CustomFieldTextValueMaxChars {
+			return fmt.Errorf("%w: %q", ErrCustomDataTextTooLong, f.Key)
+		}
+	case CustomFieldTypeNumber:
+		_, ok = value.(float64)
+	case CustomFieldTypeBoolean:
+		_, ok = value.(bool)
+	case CustomFieldTypeSelect:
+		var option string
+		if option, ok = value.(string); ok && !slices.Contains(f.Options, option) {
+			return fmt.Errorf("%w: %q", ErrCustomDataOptionInvalid, f.Key)
+		}
+	}
+	if !ok {
+		return fmt.Errorf("%w: %q must be a %s", ErrCustomDataTypeMismatch, f.Key, f.Type)
+	}
+	return nil
+}
+
+// ParseValue converts the text form of a value of the field, e.g. a CSV cell, to the value
+// stored in custom data. Numbers and booleans are parsed; text and options are kept as is.
+func (f CustomField) ParseValue(s string) (any, error) {
+	switch f.Type {
+	case CustomFieldTypeNumber:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q must be a number", ErrCustomDataTypeMismatch, f.Key)
+		}
+		return v, nil
+	case CustomFieldTypeBoolean:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q must be true or false", ErrCustomDataTypeMismatch, f.Key)
+		}
+		return v, nil
+	default:
+		return s, nil
+	}
+}
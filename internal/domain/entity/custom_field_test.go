@@ -0,0 +1,145 @@
+package entity_test
+
+import (
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CustomField", func() {
+	var fields []entity.CustomField
+
+	BeforeEach(func() {
+		fields = []entity.CustomField{
+			{Key: "company", Label: "Company", Type: entity.CustomFieldTypeText, Required: true},
+			{Key: "age", Label: "Age", Type: entity.CustomFieldTypeNumber},
+			{Key: "vegetarian", Label: "Vegetarian", Type: entity.CustomFieldTypeBoolean},
+			{Key: "tshirt_size", Label: "T-shirt size", Type: entity.CustomFieldTypeSelect, Options: []string{"S", "M", "L"}},
+		}
+	})
+
+	Describe("ValidateCustomFields", func() {
+		It("should accept valid definitions", func() {
+			Expect(entity.ValidateCustomFields(fields)).To(Succeed())
+		})
+
+		It("should accept no definitions", func() {
+			Expect(entity.ValidateCustomFields(nil)).To(Succeed())
+		})
+
+		It("should reject an invalid key", func() {
+			fields[0].Key = "Company Name"
+			Expect(entity.ValidateCustomFields(fields)).To(MatchError(entity.ErrCustomFieldKeyInvalid))
+		})
+
+		It("should reject a key longer than 50 characters", func() {
+			fields[0].Key = strings.Repeat("k", 51)
+			Expect(entity.ValidateCustomFields(fields)).To(MatchError(entity.ErrCustomFieldKeyInvalid))
+		})
+
+		It("should reject the key of a built-in participant field", func() {
+			fields[0].Key = "email"
+			Expect(entity.ValidateCustomFields(fields)).To(MatchError(entity.ErrCustomFieldKeyReserved))
+		})
+
+		It("should reject duplicate keys", func() {
+			fields[1].Key = "company"
+			Expect(entity.ValidateCustomFields(fields)).To(MatchError(entity.ErrCustomFieldKeyDuplicate))
+		})
+
+		It("should reject a blank label", func() {
+			fields[0].Label = " "
+			Expect(entity.ValidateCustomFields(fields)).To(MatchError(entity.ErrCustomFieldLabelInvalid))
+		})
+
+		It("should reject an unknown type", func() {
+			fields[0].Type = "date"
+			Expect(entity.ValidateCustomFields(fields)).To(MatchError(entity.ErrCustomFieldTypeInvalid))
+		})
+
+		It("should reject a select field without options", func() {
+			fields[3].Options = nil
+			Expect(entity.ValidateCustomFields(fields)).To(MatchError(entity.ErrCustomFieldOptionsInvalid))
+		})
+
+		It("should reject duplicate options", func() {
+			fields[3].Options = []string{"S", "S"}
+			Expect(entity.ValidateCustomFields(fields)).To(MatchError(entity.ErrCustomFieldOptionsInvalid))
+		})
+
+		It("should reject options on a field that is not a select field", func() {
+			fields[0].Options = []string{"Acme"}
+			Expect(entity.ValidateCustomFields(fields)).To(MatchError(entity.ErrCustomFieldOptionsInvalid))
+		})
+
+		It("should reject more than 50 fields", func() {
+			many := make([]entity.CustomField, entity.MaxCustomFields+1)
+			Expect(entity.ValidateCustomFields(many)).To(MatchError(entity.ErrCustomFieldTooMany))
+		})
+	})
+
+	Describe("Event.ValidateCustomData", func() {
+		var event *entity.Event
+
+		BeforeEach(func() {
+			event = &entity.Event{CustomFields: fields}
+		})
+
+		It("should accept values matching their fields", func() {
+			data := map[string]any{"company": "Acme", "age": float64(30), "vegetarian": true, "tshirt_size": "M"}
+			Expect(event.ValidateCustomData(data)).To(Succeed())
+		})
+
+		It("should accept leaving out optional fields", func() {
+			Expect(event.ValidateCustomData(map[string]any{"company": "Acme"})).To(Succeed())
+		})
+
+		It("should reject a missing required field", func() {
+			Expect(event.ValidateCustomData(map[string]any{"age": float64(30)})).
+				To(MatchError(entity.ErrCustomDataRequired))
+		})
+
+		It("should reject a null required field", func() {
+			Expect(event.ValidateCustomData(map[string]any{"company": nil})).To(MatchError(entity.ErrCustomDataRequired))
+		})
+
+		It("should reject an unknown key", func() {
+			data := map[string]any{"company": "Acme", "hobby": "chess"}
+			Expect(event.ValidateCustomData(data)).To(MatchError(entity.ErrCustomDataUnknownField))
+		})
+
+		It("should reject a value of the wrong type", func() {
+			data := map[string]any{"company": "Acme", "age": "thirty"}
+			Expect(event.ValidateCustomData(data)).To(MatchError(entity.ErrCustomDataTypeMismatch))
+		})
+
+		It("should reject a value that is not an option", func() {
+			data := map[string]any{"company": "Acme", "tshirt_size": "XXL"}
+			Expect(event.ValidateCustomData(data)).To(MatchError(entity.ErrCustomDataOptionInvalid))
+		})
+
+		It("should reject text longer than 1000 characters", func() {
+			data := map[string]any{"company": strings.Repeat("a", entity.CustomFieldTextValueMaxChars+1)}
+			Expect(event.ValidateCustomData(data)).To(MatchError(entity.ErrCustomDataTextTooLong))
+		})
+	})
+
+	Describe("ParseValue", func() {
+		It("should parse numbers and booleans", func() {
+			Expect(fields[1].ParseValue("42.5")).To(Equal(42.5))
+			Expect(fields[2].ParseValue("true")).To(Equal(true))
+		})
+
+		It("should keep text and options as is", func() {
+			Expect(fields[0].ParseValue("Acme")).To(Equal("Acme"))
+			Expect(fields[3].ParseValue("M")).To(Equal("M"))
+		})
+
+		It("should reject text that is not a number", func() {
+			_, err := fields[1].ParseValue("many")
+			Expect(err).To(MatchError(entity.ErrCustomDataTypeMismatch))
+		})
+	})
+})
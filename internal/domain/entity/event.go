@@ -4,6 +4,7 @@ import (
 	"errors"
 	"time"
 
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -23,25 +24,60 @@ const (
 	StatusCancelled EventStatus = "cancelled"
 )
 
+// FeeType represents how participants of an event are charged.
+type FeeType string
+
+const (
+	// FeeTypeFree means participants are not charged.
+	FeeTypeFree FeeType = "free"
+	// FeeTypeFixed means every participant is charged the same amount.
+	FeeTypeFixed FeeType = "fixed"
+	// FeeTypeTiered means participants are charged the amount of a named tier.
+	FeeTypeTiered FeeType = "tiered"
+)
+
+// FeeTier is a named price level of a tiered event fee.
+type FeeTier struct {
+	Name   string       `json:"name"`
+	Amount money.Amount `json:"amount"`
+}
+
 // Validation constants for Event entity
 const (
 	EventNameMinLength        = 1
 	EventNameMaxLength        = 255
 	EventDescriptionMaxLength = 5000
 	EventLocationMaxLength    = 500
+	EventFeeTierNameMaxLength = 100
+	ConsentVersionMaxLength   = 50
+	TentativeExpiryMaxHours   = 8760 // one year
 )
 
 // Common validation errors for Event entity
 var (
-	ErrEventNameRequired       = errors.New("event name is required")
-	ErrEventNameTooLong        = errors.New("event name must not exceed 255 characters")
-	ErrEventDescriptionTooLong = errors.New("event description must not exceed 5000 characters")
-	ErrEventStartDateRequired  = errors.New("event start date is required")
-	ErrEventEndDateBeforeStart = errors.New("event end date must be after start date")
-	ErrEventLocationTooLong    = errors.New("event location must not exceed 500 characters")
-	ErrEventStatusInvalid      = errors.New("invalid event status")
-	ErrEventInvalidTransition  = errors.New("invalid event status transition")
-	ErrEventTimezoneInvalid    = errors.New("invalid IANA timezone identifier")
+	ErrEventNameRequired         = errors.New("event name is required")
+	ErrEventNameTooLong          = errors.New("event name must not exceed 255 characters")
+	ErrEventDescriptionTooLong   = errors.New("event description must not exceed 5000 characters")
+	ErrEventStartDateRequired    = errors.New("event start date is required")
+	ErrEventEndDateBeforeStart   = errors.New("event end date must be after start date")
+	ErrEventLocationTooLong      = errors.New("event location must not exceed 500 characters")
+	ErrEventStatusInvalid        = errors.New("invalid event status")
+	ErrEventInvalidTransition    = errors.New("invalid event status transition")
+	ErrEventTimezoneInvalid      = errors.New("invalid IANA timezone identifier")
+	ErrEventCurrencyInvalid      = errors.New("invalid ISO 4217 currency code")
+	ErrEventFeeTypeInvalid       = errors.New("invalid event fee type")
+	ErrEventFeeMismatch          = errors.New("event fee amount and tiers do not match the fee type")
+	ErrEventFeeAmountInvalid     = errors.New("fixed event fee must be greater than zero")
+	ErrEventFeeTiersRequired     = errors.New("tiered event fee requires at least one tier")
+	ErrEventFeeTierNameInvalid   = errors.New("fee tier name is required and must not exceed 100 characters")
+	ErrEventFeeTierAmountInvalid = errors.New("fee tier amount must not be negative")
+	ErrEventFeeTierDuplicate     = errors.New("fee tier names must be unique")
+	ErrEventFeeCurrencyMissing   = errors.New("paid event fee requires the event to have a currency")
+	ErrEventConsentVersionNeeded = errors.New("events requiring consent need a consent version")
+	ErrEventConsentVersionLong   = errors.New("consent version must not exceed 50 characters")
+	ErrEventTentativeExpiryRange = errors.New("tentative expiry must be between 1 and 8760 hours")
+	ErrEventCapacityNegative     = errors.New("event capacity must not be negative")
+	ErrEventCapacityBelowTaken   = errors.New("event capacity must not be below the number of confirmed participants")
 )
 
 // Event represents an event created by an organizer.
@@ -54,42 +90,96 @@ type Event struct {
 	EndDate     *time.Time
 	Location    string
 	Timezone    string
+	Currency    string       // ISO 4217 code for participant payment amounts; empty if unset
+	FeeType     FeeType      // Empty if participant amounts are set individually
+	FeeAmount   money.Amount // Fixed fee in minor units of Currency; zero unless FeeType is fixed
+	FeeTiers    []FeeTier    // Named fee levels; empty unless FeeType is tiered
 	Status      EventStatus
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 
+	// RequiresConsent blocks check-in of participants who have not accepted the consent terms,
+	// e.g. a signed waiver. ConsentVersion identifies the current terms.
+	RequiresConsent bool
+	ConsentVersion  string
+
+	// LegalHold exempts the event from the retention purge. PIIPurgedAt is when its
+	// participants were anonymized by the purge; nil if they were not.
+	LegalHold   bool
+	PIIPurgedAt *time.Time
+
+	// TentativeExpiryHours is how long participants may stay tentative or invited before they
+	// expire; zero if they never expire.
+	TentativeExpiryHours int
+
+	// Capacity is how many participants can be confirmed; further participants are waitlisted.
+	// Zero if the event has no capacity limit.
+	Capacity int
+
+	// CustomFields defines the data collected from participants beyond the built-in fields,
+	// which they store in their CustomData.
+	CustomFields []CustomField
+
+	// SeriesID links the occurrences of a recurring event; nil if the event does not recur.
+	SeriesID *uuid.UUID
+
+	// DeletedAt is when the event was soft deleted; nil for live events.
+	DeletedAt *time.Time
+
 	// Read-only aggregated fields populated by repository queries.
 	ParticipantCount int64
 	CheckedInCount   int64
+	// SearchRank is the relevance of the event to the full-text search of the list it was
+	// listed by, higher for better matches; nil unless the list was searched by full text.
+	SearchRank *float64
 }
 
-// Validate validates the Event entity fields.
+// Validate validates the Event entity fields, reporting every failure found (see JoinValidationErrors).
 func (e *Event) Validate() error {
+	var errs []error
 	if e.Name == "" {
-		return ErrEventNameRequired
-	}
-	if len(e.Name) > EventNameMaxLength {
-		return ErrEventNameTooLong
+		errs = append(errs, ErrEventNameRequired)
+	} else if len(e.Name) > EventNameMaxLength {
+		errs = append(errs, ErrEventNameTooLong)
 	}
 	if len(e.Description) > EventDescriptionMaxLength {
-		return ErrEventDescriptionTooLong
+		errs = append(errs, ErrEventDescriptionTooLong)
 	}
 	if e.StartDate.IsZero() {
-		return ErrEventStartDateRequired
-	}
-	if e.EndDate != nil && e.EndDate.Before(e.StartDate) {
-		return ErrEventEndDateBeforeStart
+		errs = append(errs, ErrEventStartDateRequired)
+	} else if e.EndDate != nil && e.EndDate.Before(e.StartDate) {
+		errs = append(errs, ErrEventEndDateBeforeStart)
 	}
 	if len(e.Location) > EventLocationMaxLength {
-		return ErrEventLocationTooLong
+		errs = append(errs, ErrEventLocationTooLong)
 	}
 	if err := e.validateTimezone(); err != nil {
-		return err
+		errs = append(errs, err)
+	}
+	if e.Currency != "" && !money.IsKnownCurrency(e.Currency) {
+		errs = append(errs, ErrEventCurrencyInvalid)
+	}
+	if err := e.validateFee(); err != nil {
+		errs = append(errs, err)
+	}
+	if e.RequiresConsent && e.ConsentVersion == "" {
+		errs = append(errs, ErrEventConsentVersionNeeded)
+	} else if len(e.ConsentVersion) > ConsentVersionMaxLength {
+		errs = append(errs, ErrEventConsentVersionLong)
+	}
+	if e.TentativeExpiryHours < 0 || e.TentativeExpiryHours > TentativeExpiryMaxHours {
+		errs = append(errs, ErrEventTentativeExpiryRange)
+	}
+	if e.Capacity < 0 {
+		errs = append(errs, ErrEventCapacityNegative)
+	}
+	if err := ValidateCustomFields(e.CustomFields); err != nil {
+		errs = append(errs, err)
 	}
 	if !e.IsValidStatus() {
-		return ErrEventStatusInvalid
+		errs = append(errs, ErrEventStatusInvalid)
 	}
-	return nil
+	return JoinValidationErrors(errs...)
 }
 
 // IsValidStatus checks if the event status is valid.
@@ -153,11 +243,69 @@ func (e *Event) IsCompleted() bool {
 	return e.Status == StatusCompleted
 }
 
+// HasCurrency returns true if the event has a currency for payment amounts.
+func (e *Event) HasCurrency() bool {
+	return e.Currency != ""
+}
+
+// HasCapacity returns true if the event limits how many participants can be confirmed.
+func (e *Event) HasCapacity() bool {
+	return e.Capacity > 0
+}
+
+// RemainingCapacity returns how many more participants can be confirmed when confirmed are,
+// never less than zero. Only meaningful for events with a capacity.
+func (e *Event) RemainingCapacity(confirmed int64) int64 {
+	return max(int64(e.Capacity)-confirmed, 0)
+}
+
+// IsFree returns true if the event has a free fee model.
+func (e *Event) IsFree() bool {
+	return e.FeeType == FeeTypeFree
+}
+
+// FindFeeTier returns the fee tier with the given name, if the event has one.
+func (e *Event) FindFeeTier(name string) (FeeTier, bool) {
+	for _, tier := range e.FeeTiers {
+		if tier.Name == name {
+			return tier, true
+		}
+	}
+	return FeeTier{}, false
+}
+
 // IsCancelled returns true if the event has been cancelled.
 func (e *Event) IsCancelled() bool {
 	return e.Status == StatusCancelled
 }
 
+// CheckinWindow returns when check-in for the event opens and closes: margin before it starts
+// until margin after it ends. Events without an end date end with their start day, in the
+// event's time zone.
+func (e *Event) CheckinWindow(margin time.Duration) (opens, closes time.Time) {
+	var end time.Time
+	if e.EndDate != nil {
+		end = *e.EndDate
+	} else {
+		start := e.StartDate.In(e.TimezoneLocation())
+		end = time.Date(start.Year(), start.Month(), start.Day()+1, 0, 0, 0, 0, start.Location())
+	}
+	return e.StartDate.Add(-margin), end.Add(margin)
+}
+
+// TimezoneLocation returns the time zone of the event, or UTC if it has none.
+// The timezone must be valid.
+func (e *Event) TimezoneLocation() *time.Location {
+	if e.Timezone == "" {
+		return time.UTC
+	}
+	loc, err := time.LoadLocation(e.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
 // validateTimezone checks that the timezone, if set, is a valid IANA timezone identifier.
 func (e *Event) validateTimezone() error {
 	if e.Timezone == "" {
@@ -169,3 +317,57 @@ func (e *Event) validateTimezone() error {
 	}
 	return nil
 }
+
+// validateFee checks that the fee amount and tiers are consistent with the fee type.
+// Charged fees are interpreted in the event's currency, which must therefore be set.
+func (e *Event) validateFee() error {
+	switch e.FeeType {
+	case "", FeeTypeFree:
+		if e.FeeAmount != 0 || len(e.FeeTiers) > 0 {
+			return ErrEventFeeMismatch
+		}
+		return nil
+	case FeeTypeFixed:
+		if len(e.FeeTiers) > 0 {
+			return ErrEventFeeMismatch
+		}
+		if e.FeeAmount <= 0 {
+			return ErrEventFeeAmountInvalid
+		}
+	case FeeTypeTiered:
+		if e.FeeAmount != 0 {
+			return ErrEventFeeMismatch
+		}
+		if err := e.validateFeeTiers(); err != nil {
+			return err
+		}
+	default:
+		return ErrEventFeeTypeInvalid
+	}
+
+	if !e.HasCurrency() {
+		return ErrEventFeeCurrencyMissing
+	}
+	return nil
+}
+
+// validateFeeTiers checks that tiered fees have uniquely named, non-negative tiers.
+func (e *Event) validateFeeTiers() error {
+	if len(e.FeeTiers) == 0 {
+		return ErrEventFeeTiersRequired
+	}
+	seen := make(map[string]struct{}, len(e.FeeTiers))
+	for _, tier := range e.FeeTiers {
+		if tier.Name == "" || len(tier.Name) > EventFeeTierNameMaxLength {
+			return ErrEventFeeTierNameInvalid
+		}
+		if tier.Amount.IsNegative() {
+			return ErrEventFeeTierAmountInvalid
+		}
+		if _, ok := seen[tier.Name]; ok {
+			return ErrEventFeeTierDuplicate
+		}
+		seen[tier.Name] = struct{}{}
+	}
+	return nil
+}
@@ -1,9 +1,11 @@
 package entity_test
 
 import (
+	"strings"
 	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -45,6 +47,23 @@ var _ = Describe("Event", func() {
 			})
 		})
 
+		Context("with several invalid fields", func() {
+			It("should report every failure", func() {
+				validEvent.Name = ""
+				validEvent.Capacity = -1
+				validEvent.Timezone = "Mars/Olympus"
+
+				err := validEvent.Validate()
+
+				Expect(err).To(MatchError(entity.ErrEventNameRequired))
+				Expect(err).To(MatchError(entity.ErrEventTimezoneInvalid))
+				Expect(err).To(MatchError(entity.ErrEventCapacityNegative))
+				Expect(err.Error()).To(Equal(
+					"event name is required; invalid IANA timezone identifier; event capacity must not be negative",
+				))
+			})
+		})
+
 		Context("with name too long", func() {
 			It("should fail", func() {
 				validEvent.Name = string(make([]byte, entity.EventNameMaxLength+1))
@@ -130,6 +149,189 @@ var _ = Describe("Event", func() {
 				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventTimezoneInvalid))
 			})
 		})
+
+		Context("with valid ISO 4217 currency", func() {
+			It("should succeed", func() {
+				validEvent.Currency = "USD"
+				Expect(validEvent.Validate()).To(Succeed())
+				Expect(validEvent.HasCurrency()).To(BeTrue())
+			})
+		})
+
+		Context("with empty currency", func() {
+			It("should succeed", func() {
+				validEvent.Currency = ""
+				Expect(validEvent.Validate()).To(Succeed())
+				Expect(validEvent.HasCurrency()).To(BeFalse())
+			})
+		})
+
+		Context("with unknown currency code", func() {
+			It("should fail", func() {
+				validEvent.Currency = "XYZ"
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventCurrencyInvalid))
+			})
+		})
+
+		Context("with lower-case currency code", func() {
+			It("should fail", func() {
+				validEvent.Currency = "usd"
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventCurrencyInvalid))
+			})
+		})
+
+		Context("with a free fee", func() {
+			It("should succeed without a currency", func() {
+				validEvent.FeeType = entity.FeeTypeFree
+				Expect(validEvent.Validate()).To(Succeed())
+				Expect(validEvent.IsFree()).To(BeTrue())
+			})
+
+			It("should fail with a fee amount", func() {
+				validEvent.FeeType = entity.FeeTypeFree
+				validEvent.FeeAmount = money.FromMinorUnits(100000)
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventFeeMismatch))
+			})
+		})
+
+		Context("with a fixed fee", func() {
+			BeforeEach(func() {
+				validEvent.Currency = "JPY"
+				validEvent.FeeType = entity.FeeTypeFixed
+				validEvent.FeeAmount = money.FromMinorUnits(300000)
+			})
+
+			It("should succeed", func() {
+				Expect(validEvent.Validate()).To(Succeed())
+			})
+
+			It("should fail with a zero amount", func() {
+				validEvent.FeeAmount = 0
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventFeeAmountInvalid))
+			})
+
+			It("should fail with tiers", func() {
+				validEvent.FeeTiers = []entity.FeeTier{{Name: "standard", Amount: money.FromMinorUnits(300000)}}
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventFeeMismatch))
+			})
+
+			It("should fail without a currency", func() {
+				validEvent.Currency = ""
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventFeeCurrencyMissing))
+			})
+		})
+
+		Context("with a tiered fee", func() {
+			BeforeEach(func() {
+				validEvent.Currency = "JPY"
+				validEvent.FeeType = entity.FeeTypeTiered
+				validEvent.FeeTiers = []entity.FeeTier{
+					{Name: "early_bird", Amount: money.FromMinorUnits(200000)},
+					{Name: "standard", Amount: money.FromMinorUnits(300000)},
+				}
+			})
+
+			It("should succeed and find tiers by name", func() {
+				Expect(validEvent.Validate()).To(Succeed())
+
+				tier, ok := validEvent.FindFeeTier("standard")
+				Expect(ok).To(BeTrue())
+				Expect(tier.Amount).To(Equal(money.FromMinorUnits(300000)))
+
+				_, ok = validEvent.FindFeeTier("vip")
+				Expect(ok).To(BeFalse())
+			})
+
+			It("should fail without tiers", func() {
+				validEvent.FeeTiers = nil
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventFeeTiersRequired))
+			})
+
+			It("should fail with an unnamed tier", func() {
+				validEvent.FeeTiers[0].Name = ""
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventFeeTierNameInvalid))
+			})
+
+			It("should fail with a negative tier amount", func() {
+				validEvent.FeeTiers[0].Amount = money.FromMinorUnits(-1)
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventFeeTierAmountInvalid))
+			})
+
+			It("should fail with duplicate tier names", func() {
+				validEvent.FeeTiers[1].Name = "early_bird"
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventFeeTierDuplicate))
+			})
+
+			It("should fail with a fee amount", func() {
+				validEvent.FeeAmount = money.FromMinorUnits(100)
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventFeeMismatch))
+			})
+		})
+
+		Context("with an unknown fee type", func() {
+			It("should fail", func() {
+				validEvent.FeeType = entity.FeeType("donation")
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventFeeTypeInvalid))
+			})
+		})
+
+		Context("with required consent", func() {
+			BeforeEach(func() {
+				validEvent.RequiresConsent = true
+			})
+
+			It("should succeed with a consent version", func() {
+				validEvent.ConsentVersion = "2025-01"
+				Expect(validEvent.Validate()).To(Succeed())
+			})
+
+			It("should fail without a consent version", func() {
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventConsentVersionNeeded))
+			})
+
+			It("should fail with a consent version longer than 50 characters", func() {
+				validEvent.ConsentVersion = strings.Repeat("v", 51)
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventConsentVersionLong))
+			})
+		})
+
+		Context("with a tentative expiry", func() {
+			It("should succeed with up to a year", func() {
+				validEvent.TentativeExpiryHours = entity.TentativeExpiryMaxHours
+				Expect(validEvent.Validate()).To(Succeed())
+			})
+
+			It("should fail with a negative number of hours", func() {
+				validEvent.TentativeExpiryHours = -1
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventTentativeExpiryRange))
+			})
+
+			It("should fail with more than a year", func() {
+				validEvent.TentativeExpiryHours = entity.TentativeExpiryMaxHours + 1
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventTentativeExpiryRange))
+			})
+		})
+
+		Context("with a capacity", func() {
+			It("should succeed and report the remaining places", func() {
+				validEvent.Capacity = 10
+				Expect(validEvent.Validate()).To(Succeed())
+				Expect(validEvent.HasCapacity()).To(BeTrue())
+				Expect(validEvent.RemainingCapacity(7)).To(Equal(int64(3)))
+				Expect(validEvent.RemainingCapacity(12)).To(Equal(int64(0)))
+			})
+
+			It("should have no capacity limit when zero", func() {
+				validEvent.Capacity = 0
+				Expect(validEvent.Validate()).To(Succeed())
+				Expect(validEvent.HasCapacity()).To(BeFalse())
+			})
+
+			It("should fail with a negative capacity", func() {
+				validEvent.Capacity = -1
+				Expect(validEvent.Validate()).To(MatchError(entity.ErrEventCapacityNegative))
+			})
+		})
 	})
 
 	When("transitioning event status", func() {
@@ -207,4 +409,30 @@ var _ = Describe("Event", func() {
 			})
 		})
 	})
+
+	When("computing the check-in window", func() {
+		It("should open the margin before the start and close the margin after the end", func() {
+			start := time.Date(2026, 11, 3, 9, 0, 0, 0, time.UTC)
+			end := start.Add(8 * time.Hour)
+			event := &entity.Event{StartDate: start, EndDate: &end}
+
+			opens, closes := event.CheckinWindow(time.Hour)
+
+			Expect(opens).To(Equal(start.Add(-time.Hour)))
+			Expect(closes).To(Equal(end.Add(time.Hour)))
+		})
+
+		It("should end an event without an end date with its start day in its time zone", func() {
+			tokyo, err := time.LoadLocation("Asia/Tokyo")
+			Expect(err).NotTo(HaveOccurred())
+			event := &entity.Event{
+				StartDate: time.Date(2026, 11, 3, 9, 0, 0, 0, tokyo).UTC(),
+				Timezone:  "Asia/Tokyo",
+			}
+
+			_, closes := event.CheckinWindow(30 * time.Minute)
+
+			Expect(closes).To(BeTemporally("==", time.Date(2026, 11, 4, 0, 30, 0, 0, tokyo)))
+		})
+	})
 })
@@ -0,0 +1,62 @@
+package entity
+
+import (
+	"errors"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WebhookDeliveryStatus is the outcome of the latest delivery attempt to an event webhook.
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliverySucceeded means the webhook answered with a 2xx status.
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryFailed means the webhook answered with another status, timed out or was unreachable.
+	WebhookDeliveryFailed WebhookDeliveryStatus = "failed"
+)
+
+const (
+	maxEventWebhookURLLength    = 2048
+	maxEventWebhookSecretLength = 255
+)
+
+// Common validation errors for EventWebhook entity
+var (
+	ErrEventWebhookURLInvalid     = errors.New("webhook URL must be an absolute http(s) URL of at most 2048 characters")
+	ErrEventWebhookSecretRequired = errors.New("webhook secret is required")
+	ErrEventWebhookSecretTooLong  = errors.New("webhook secret must not exceed 255 characters")
+)
+
+// EventWebhook is an event's own webhook. It receives the event's check-in and registration
+// notifications, signed with its secret, in addition to the server-wide webhook URLs.
+type EventWebhook struct {
+	EventID            uuid.UUID
+	URL                string
+	Secret             string
+	LastDeliveryAt     *time.Time             // nil before the first delivery attempt
+	LastDeliveryStatus *WebhookDeliveryStatus // nil before the first delivery attempt
+	LastDeliveryError  string                 // empty unless the latest attempt failed
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// Validate validates the EventWebhook entity fields.
+func (w *EventWebhook) Validate() error {
+	if len(w.URL) > maxEventWebhookURLLength {
+		return ErrEventWebhookURLInvalid
+	}
+	u, err := url.Parse(w.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return ErrEventWebhookURLInvalid
+	}
+	if w.Secret == "" {
+		return ErrEventWebhookSecretRequired
+	}
+	if len(w.Secret) > maxEventWebhookSecretLength {
+		return ErrEventWebhookSecretTooLong
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package entity_test
+
+import (
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EventWebhook", func() {
+	var webhook *entity.EventWebhook
+
+	BeforeEach(func() {
+		webhook = &entity.EventWebhook{
+			EventID: uuid.New(),
+			URL:     "https://hooks.example.com/ezqrin",
+			Secret:  "whsec_0123456789",
+		}
+	})
+
+	When("validating", func() {
+		It("should accept an https URL with a secret", func() {
+			Expect(webhook.Validate()).To(Succeed())
+		})
+
+		DescribeTable("should reject URLs that are not absolute http(s) URLs",
+			func(raw string) {
+				webhook.URL = raw
+				Expect(webhook.Validate()).To(MatchError(entity.ErrEventWebhookURLInvalid))
+			},
+			Entry("empty", ""),
+			Entry("relative", "/hooks/ezqrin"),
+			Entry("another scheme", "ftp://hooks.example.com"),
+			Entry("without a host", "https://"),
+			Entry("too long", "https://hooks.example.com/"+strings.Repeat("a", 2048)),
+		)
+
+		It("should require a secret", func() {
+			webhook.Secret = ""
+			Expect(webhook.Validate()).To(MatchError(entity.ErrEventWebhookSecretRequired))
+		})
+
+		It("should reject a secret longer than 255 characters", func() {
+			webhook.Secret = strings.Repeat("s", 256)
+			Expect(webhook.Validate()).To(MatchError(entity.ErrEventWebhookSecretTooLong))
+		})
+	})
+})
@@ -0,0 +1,153 @@
+package entity
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// OutboxEventType identifies the kind of domain event stored in the outbox.
+type OutboxEventType string
+
+const (
+	// OutboxEventCheckinCreated is recorded when a participant checks in.
+	OutboxEventCheckinCreated OutboxEventType = "checkin.created"
+	// OutboxEventEventPublished is recorded when an event becomes published.
+	OutboxEventEventPublished OutboxEventType = "event.published"
+	// OutboxEventParticipantExpired is recorded when a tentative or invited participant expires.
+	OutboxEventParticipantExpired OutboxEventType = "participant.expired"
+	// OutboxEventParticipantCreated is recorded when a participant is registered.
+	OutboxEventParticipantCreated OutboxEventType = "participant.created"
+)
+
+// Common validation errors for OutboxMessage entity
+var (
+	ErrOutboxEventTypeInvalid     = errors.New("invalid outbox event type")
+	ErrOutboxAggregateIDRequired  = errors.New("outbox aggregate ID is required")
+	ErrOutboxPayloadRequired      = errors.New("outbox payload is required")
+	ErrOutboxNextAttemptAtMissing = errors.New("outbox next attempt time is required")
+)
+
+// OutboxMessage is a domain event waiting to be delivered to webhook subscribers.
+// It is written in the same transaction as the state change it describes, and a relay
+// delivers it afterwards, so delivery is at-least-once even across restarts.
+type OutboxMessage struct {
+	ID            uuid.UUID
+	EventType     OutboxEventType
+	AggregateID   uuid.UUID // ID of the entity the event is about (check-in, event or participant)
+	Payload       json.RawMessage
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	DeliveredAt   *time.Time
+	DeliveredTo   []string   // destinations that accepted the message in an earlier attempt
+	FailedAt      *time.Time // set when delivery is abandoned after the maximum number of attempts
+	CreatedAt     time.Time
+}
+
+// CheckinCreatedPayload is the payload of a checkin.created outbox message.
+type CheckinCreatedPayload struct {
+	CheckinID     uuid.UUID     `json:"checkin_id"`
+	EventID       uuid.UUID     `json:"event_id"`
+	ParticipantID uuid.UUID     `json:"participant_id"`
+	CheckedInAt   time.Time     `json:"checked_in_at"`
+	Method        CheckinMethod `json:"method"`
+}
+
+// EventPublishedPayload is the payload of an event.published outbox message.
+type EventPublishedPayload struct {
+	EventID     uuid.UUID `json:"event_id"`
+	OrganizerID uuid.UUID `json:"organizer_id"`
+	Name        string    `json:"name"`
+	StartDate   time.Time `json:"start_date"`
+}
+
+// ParticipantExpiredPayload is the payload of a participant.expired outbox message.
+type ParticipantExpiredPayload struct {
+	ParticipantID  uuid.UUID         `json:"participant_id"`
+	EventID        uuid.UUID         `json:"event_id"`
+	PreviousStatus ParticipantStatus `json:"previous_status"`
+	ExpiredAt      time.Time         `json:"expired_at"`
+}
+
+// ParticipantCreatedPayload is the payload of a participant.created outbox message.
+type ParticipantCreatedPayload struct {
+	ParticipantID uuid.UUID         `json:"participant_id"`
+	EventID       uuid.UUID         `json:"event_id"`
+	Name          string            `json:"name"`
+	Email         string            `json:"email"`
+	Status        ParticipantStatus `json:"status"`
+	CreatedAt     time.Time         `json:"created_at"`
+}
+
+// NewOutboxMessage creates an outbox message due for immediate delivery with the JSON-encoded payload.
+func NewOutboxMessage(eventType OutboxEventType, aggregateID uuid.UUID, payload any) (*OutboxMessage, error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal outbox payload: %w", err)
+	}
+
+	now := time.Now().UTC()
+	msg := &OutboxMessage{
+		ID:            uuid.New(),
+		EventType:     eventType,
+		AggregateID:   aggregateID,
+		Payload:       data,
+		NextAttemptAt: now,
+		CreatedAt:     now,
+	}
+	if err := msg.Validate(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// Validate validates the OutboxMessage entity fields.
+func (m *OutboxMessage) Validate() error {
+	if !m.IsValidEventType() {
+		return ErrOutboxEventTypeInvalid
+	}
+	if m.AggregateID == uuid.Nil {
+		return ErrOutboxAggregateIDRequired
+	}
+	if len(m.Payload) == 0 {
+		return ErrOutboxPayloadRequired
+	}
+	if m.NextAttemptAt.IsZero() {
+		return ErrOutboxNextAttemptAtMissing
+	}
+	return nil
+}
+
+// IsValidEventType checks if the outbox event type is known.
+func (m *OutboxMessage) IsValidEventType() bool {
+	switch m.EventType {
+	case OutboxEventCheckinCreated, OutboxEventEventPublished, OutboxEventParticipantExpired,
+		OutboxEventParticipantCreated:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsDelivered returns true if the message has been delivered.
+func (m *OutboxMessage) IsDelivered() bool {
+	return m.DeliveredAt != nil
+}
+
+// IsDeliveredTo returns true if the destination accepted the message in an earlier attempt.
+func (m *OutboxMessage) IsDeliveredTo(destination string) bool {
+	return slices.Contains(m.DeliveredTo, destination)
+}
+
+// MarkDeliveredTo records that the destination accepted the message, so that it is skipped
+// when the message is retried for other destinations.
+func (m *OutboxMessage) MarkDeliveredTo(destination string) {
+	if !m.IsDeliveredTo(destination) {
+		m.DeliveredTo = append(m.DeliveredTo, destination)
+	}
+}
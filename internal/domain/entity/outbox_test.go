@@ -0,0 +1,70 @@
+package entity_test
+
+import (
+	"encoding/json"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutboxMessage", func() {
+	When("creating an outbox message", func() {
+		Context("with a known event type and payload", func() {
+			It("should encode the payload and be due immediately", func() {
+				aggregateID := uuid.New()
+				msg, err := entity.NewOutboxMessage(
+					entity.OutboxEventEventPublished,
+					aggregateID,
+					entity.EventPublishedPayload{EventID: aggregateID, Name: "Tech Conf"},
+				)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(msg.ID).NotTo(Equal(uuid.Nil))
+				Expect(msg.AggregateID).To(Equal(aggregateID))
+				Expect(msg.Attempts).To(BeZero())
+				Expect(msg.NextAttemptAt).To(Equal(msg.CreatedAt))
+				Expect(msg.IsDelivered()).To(BeFalse())
+
+				var payload map[string]any
+				Expect(json.Unmarshal(msg.Payload, &payload)).To(Succeed())
+				Expect(payload).To(HaveKeyWithValue("name", "Tech Conf"))
+			})
+		})
+
+		Context("with an unknown event type", func() {
+			It("should return ErrOutboxEventTypeInvalid", func() {
+				_, err := entity.NewOutboxMessage("participant.renamed", uuid.New(), struct{}{})
+				Expect(err).To(MatchError(entity.ErrOutboxEventTypeInvalid))
+			})
+		})
+
+		Context("with a nil aggregate ID", func() {
+			It("should return ErrOutboxAggregateIDRequired", func() {
+				_, err := entity.NewOutboxMessage(entity.OutboxEventCheckinCreated, uuid.Nil, struct{}{})
+				Expect(err).To(MatchError(entity.ErrOutboxAggregateIDRequired))
+			})
+		})
+
+		Context("with a payload that cannot be encoded", func() {
+			It("should return an error", func() {
+				_, err := entity.NewOutboxMessage(entity.OutboxEventCheckinCreated, uuid.New(), make(chan int))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("MarkDeliveredTo", func() {
+		It("should record each destination once", func() {
+			msg := &entity.OutboxMessage{}
+
+			msg.MarkDeliveredTo("https://a.example.com/hook")
+			msg.MarkDeliveredTo("https://a.example.com/hook")
+
+			Expect(msg.DeliveredTo).To(Equal([]string{"https://a.example.com/hook"}))
+			Expect(msg.IsDeliveredTo("https://a.example.com/hook")).To(BeTrue())
+			Expect(msg.IsDeliveredTo("https://b.example.com/hook")).To(BeFalse())
+		})
+	})
+})
@@ -4,8 +4,11 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"errors"
+	"strings"
 	"time"
+	"unicode/utf8"
 
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/fumkob/ezqrin-server/pkg/validator"
 	"github.com/google/uuid"
 )
@@ -22,6 +25,16 @@ const (
 	ParticipantStatusCancelled ParticipantStatus = "cancelled"
 	// ParticipantStatusDeclined means the participant has declined.
 	ParticipantStatusDeclined ParticipantStatus = "declined"
+	// ParticipantStatusInvited means the participant was invited and has not accepted yet.
+	// Invited participants have no QR code until they accept the invitation.
+	ParticipantStatusInvited ParticipantStatus = "invited"
+	// ParticipantStatusExpired means the participant stayed tentative or invited for longer than
+	// the event's tentative expiry. Only the expiry worker sets it; an expired invitation keeps
+	// having no QR code.
+	ParticipantStatusExpired ParticipantStatus = "expired"
+	// ParticipantStatusWaitlisted means the participant registered as confirmed while the event
+	// was at capacity. They are confirmed when promoted.
+	ParticipantStatusWaitlisted ParticipantStatus = "waitlisted"
 )
 
 // PaymentStatus represents the payment status of a participant.
@@ -40,22 +53,36 @@ const (
 	ParticipantNameMaxLength       = 255
 	ParticipantPhoneMaxLength      = 50
 	ParticipantEmployeeIDMaxLength = 255
+	ParticipantNotesMaxLength      = 2000  // characters, not bytes
+	ParticipantMaxTags             = 20    // per participant
+	ParticipantTagMaxLength        = 50    // characters, not bytes
+	ParticipantGroupNameMaxLength  = 100   // characters, not bytes
 	MaxMetadataSize                = 10240 // 10KB
 )
 
 // Common validation errors for Participant entity
 var (
-	ErrParticipantNameRequired         = errors.New("participant name is required")
-	ErrParticipantNameTooLong          = errors.New("participant name must not exceed 255 characters")
-	ErrParticipantEmailRequired        = errors.New("participant email is required")
-	ErrParticipantEmailInvalid         = errors.New("participant email format is invalid")
-	ErrParticipantQRCodeRequired       = errors.New("QR code is required")
-	ErrParticipantStatusInvalid        = errors.New("invalid participant status")
-	ErrParticipantPhoneTooLong         = errors.New("phone number must not exceed 50 characters")
-	ErrParticipantEmployeeIDTooLong    = errors.New("employee ID must not exceed 255 characters")
-	ErrParticipantPaymentStatusInvalid = errors.New("invalid payment status")
-	ErrParticipantMetadataTooLarge     = errors.New("metadata must not exceed 10KB")
-	ErrParticipantEventIDRequired      = errors.New("event ID is required")
+	ErrParticipantNameRequired           = errors.New("participant name is required")
+	ErrParticipantNameTooLong            = errors.New("participant name must not exceed 255 characters")
+	ErrParticipantEmailRequired          = errors.New("participant email is required")
+	ErrParticipantEmailInvalid           = errors.New("participant email format is invalid")
+	ErrParticipantQRCodeRequired         = errors.New("QR code is required")
+	ErrParticipantInvitedQRCode          = errors.New("invited participants receive a QR code only when they accept")
+	ErrParticipantStatusInvalid          = errors.New("invalid participant status")
+	ErrParticipantExpiredStatusReserved  = errors.New("participants expire only automatically")
+	ErrParticipantPhoneTooLong           = errors.New("phone number must not exceed 50 characters")
+	ErrParticipantEmployeeIDTooLong      = errors.New("employee ID must not exceed 255 characters")
+	ErrParticipantPaymentStatusInvalid   = errors.New("invalid payment status")
+	ErrParticipantPaymentAmountInvalid   = errors.New("payment amount must not be negative")
+	ErrParticipantPaymentCurrencyMissing = errors.New("payment amount requires the event to have a currency")
+	ErrParticipantFeeTierUnknown         = errors.New("fee tier does not exist for this event")
+	ErrParticipantFeeTierNotApplicable   = errors.New("fee tier can only be chosen for events with a tiered fee")
+	ErrParticipantMetadataTooLarge       = errors.New("metadata must not exceed 10KB")
+	ErrParticipantNotesTooLong           = errors.New("notes must not exceed 2000 characters")
+	ErrParticipantTooManyTags            = errors.New("a participant must not have more than 20 tags")
+	ErrParticipantTagInvalid             = errors.New("tags must be 1-50 characters")
+	ErrParticipantGroupNameInvalid       = errors.New("group name must be 1-100 characters")
+	ErrParticipantEventIDRequired        = errors.New("event ID is required")
 )
 
 // Participant represents an event participant.
@@ -68,35 +95,46 @@ type Participant struct {
 	Phone             *string // Optional, E.164 format
 	QREmail           *string // Optional, alternative email for QR code
 	Status            ParticipantStatus
+	WalkIn            bool       // Registered on the spot at check-in; walk-ins may have no email
+	GuestOf           *uuid.UUID // Registrant this participant is a guest of; guests may have no email
 	QRCode            string
 	QRCodeGeneratedAt time.Time
 	QRDistributionURL string           // Distribution URL for QR code hosting (empty if not configured)
 	Metadata          *json.RawMessage // Custom participant data (max 10KB)
+	CustomData        map[string]any   // Values of the event's custom fields by key; nil if none
 	PaymentStatus     PaymentStatus
-	PaymentAmount     *float64   // Nullable payment amount
-	PaymentDate       *time.Time // Nullable payment date
+	PaymentAmount     *money.Amount // Nullable payment amount in minor units of PaymentCurrency
+	PaymentCurrency   string        // Event's ISO 4217 currency; populated by the usecase, not persisted
+	PaymentDate       *time.Time    // Nullable payment date
+	ConsentAcceptedAt *time.Time    // When the event's consent terms were accepted; nil if not accepted
+	ConsentVersion    string        // Version of the consent terms accepted
+	InviteSentAt      *time.Time    // When the QR code email was last sent; nil if never
+	Notes             *string       // Internal staff notes; never exposed to attendees
+	Tags              []string      // Organizer labels (e.g. "vip", "follow-up"); unique, in the order added
+	GroupName         *string       // Table or group the participant is seated at (e.g. "Table 5"); nil if none
+	Warnings          []string      // Data-quality warnings found on creation; populated by the usecase, not persisted
 	CreatedAt         time.Time
 	UpdatedAt         time.Time
+	// DeletedAt is when the participant was soft deleted; nil for live participants.
+	DeletedAt *time.Time
 	// CheckedIn and CheckedInAt are populated only when fetched with check-in join queries.
 	CheckedIn   bool
 	CheckedInAt *time.Time
 }
 
-// Validate validates the Participant entity fields.
+// Validate validates the Participant entity fields, reporting every failure found (see
+// JoinValidationErrors).
 func (p *Participant) Validate() error {
-	if err := p.validateRequiredFields(); err != nil {
-		return err
-	}
-	if err := p.validateOptionalFields(); err != nil {
-		return err
-	}
-	return nil
+	errs := p.validateRequiredFields()
+	errs = append(errs, p.validateOptionalFields()...)
+	return JoinValidationErrors(errs...)
 }
 
 // IsValidStatus checks if the participant status is valid.
 func (p *Participant) IsValidStatus() bool {
 	switch p.Status {
-	case ParticipantStatusTentative, ParticipantStatusConfirmed, ParticipantStatusCancelled, ParticipantStatusDeclined:
+	case ParticipantStatusTentative, ParticipantStatusConfirmed, ParticipantStatusCancelled, ParticipantStatusDeclined,
+		ParticipantStatusInvited, ParticipantStatusExpired, ParticipantStatusWaitlisted:
 		return true
 	default:
 		return false
@@ -133,6 +171,42 @@ func (p *Participant) IsDeclined() bool {
 	return p.Status == ParticipantStatusDeclined
 }
 
+// IsExpired returns true if the participant expired before confirming.
+func (p *Participant) IsExpired() bool {
+	return p.Status == ParticipantStatusExpired
+}
+
+// IsWaitlisted returns true if the participant waits for a place at an event at capacity.
+func (p *Participant) IsWaitlisted() bool {
+	return p.Status == ParticipantStatusWaitlisted
+}
+
+// CanExpire returns true if the participant is in a status the tentative expiry applies to.
+func (p *Participant) CanExpire() bool {
+	return p.IsTentative() || p.IsInvited()
+}
+
+// IsGuest returns true if the participant is a guest of another participant.
+func (p *Participant) IsGuest() bool {
+	return p.GuestOf != nil
+}
+
+// IsInvited returns true if the participant has been invited and has not accepted yet.
+func (p *Participant) IsInvited() bool {
+	return p.Status == ParticipantStatusInvited
+}
+
+// HasConsent returns true if the participant has accepted the event's consent terms.
+func (p *Participant) HasConsent() bool {
+	return p.ConsentAcceptedAt != nil
+}
+
+// AcceptConsent records that the participant accepted the given version of the consent terms.
+func (p *Participant) AcceptConsent(version string, acceptedAt time.Time) {
+	p.ConsentAcceptedAt = &acceptedAt
+	p.ConsentVersion = version
+}
+
 // IsPaid returns true if the payment status is paid.
 func (p *Participant) IsPaid() bool {
 	return p.PaymentStatus == PaymentPaid
@@ -143,49 +217,150 @@ func (p *Participant) IsUnpaid() bool {
 	return p.PaymentStatus == PaymentUnpaid
 }
 
+// ApplyEventFee defaults the participant's payment from the event's fee model.
+// Free events force a zero amount and paid status. Fixed and tiered fees only fill in
+// an amount that was not given explicitly, so organizers can still record discounts.
+func (p *Participant) ApplyEventFee(event *Event, feeTier *string) error {
+	if feeTier != nil && event.FeeType != FeeTypeTiered {
+		return ErrParticipantFeeTierNotApplicable
+	}
+
+	switch event.FeeType {
+	case FeeTypeFree:
+		zero := money.FromMinorUnits(0)
+		p.PaymentAmount = &zero
+		p.PaymentStatus = PaymentPaid
+	case FeeTypeFixed:
+		if p.PaymentAmount == nil {
+			amount := event.FeeAmount
+			p.PaymentAmount = &amount
+		}
+	case FeeTypeTiered:
+		if feeTier == nil {
+			return nil
+		}
+		tier, ok := event.FindFeeTier(*feeTier)
+		if !ok {
+			return ErrParticipantFeeTierUnknown
+		}
+		if p.PaymentAmount == nil {
+			amount := tier.Amount
+			p.PaymentAmount = &amount
+		}
+	}
+	return nil
+}
+
 // validateRequiredFields validates required fields.
-func (p *Participant) validateRequiredFields() error {
+func (p *Participant) validateRequiredFields() []error {
+	var errs []error
 	if p.EventID == uuid.Nil {
-		return ErrParticipantEventIDRequired
+		errs = append(errs, ErrParticipantEventIDRequired)
 	}
 	if p.Name == "" {
-		return ErrParticipantNameRequired
-	}
-	if len(p.Name) > ParticipantNameMaxLength {
-		return ErrParticipantNameTooLong
+		errs = append(errs, ErrParticipantNameRequired)
+	} else if len(p.Name) > ParticipantNameMaxLength {
+		errs = append(errs, ErrParticipantNameTooLong)
 	}
-	if p.Email == "" {
-		return ErrParticipantEmailRequired
+	if p.Email == "" && !p.WalkIn && !p.IsGuest() {
+		errs = append(errs, ErrParticipantEmailRequired)
 	}
-	if err := validator.ValidateEmail(p.Email); err != nil {
-		return ErrParticipantEmailInvalid
+	if p.Email != "" {
+		if err := validator.ValidateEmail(p.Email); err != nil {
+			errs = append(errs, ErrParticipantEmailInvalid)
+		}
 	}
-	if p.QRCode == "" {
-		return ErrParticipantQRCodeRequired
-	}
-	if !p.IsValidStatus() {
-		return ErrParticipantStatusInvalid
+	switch {
+	case !p.IsValidStatus():
+		errs = append(errs, ErrParticipantStatusInvalid)
+	case p.IsInvited() && p.QRCode != "":
+		errs = append(errs, ErrParticipantInvitedQRCode)
+	case !p.IsInvited() && !p.IsExpired() && p.QRCode == "":
+		errs = append(errs, ErrParticipantQRCodeRequired)
 	}
 	if !p.IsValidPaymentStatus() {
-		return ErrParticipantPaymentStatusInvalid
+		errs = append(errs, ErrParticipantPaymentStatusInvalid)
 	}
-	return nil
+	return errs
 }
 
 // validateOptionalFields validates optional fields.
-func (p *Participant) validateOptionalFields() error {
+func (p *Participant) validateOptionalFields() []error {
+	var errs []error
 	if p.Phone != nil && len(*p.Phone) > ParticipantPhoneMaxLength {
-		return ErrParticipantPhoneTooLong
+		errs = append(errs, ErrParticipantPhoneTooLong)
 	}
 	if p.EmployeeID != nil && len(*p.EmployeeID) > ParticipantEmployeeIDMaxLength {
-		return ErrParticipantEmployeeIDTooLong
+		errs = append(errs, ErrParticipantEmployeeIDTooLong)
 	}
 	if p.Metadata != nil && len(*p.Metadata) > MaxMetadataSize {
-		return ErrParticipantMetadataTooLarge
+		errs = append(errs, ErrParticipantMetadataTooLarge)
+	}
+	if p.Notes != nil && utf8.RuneCountInString(*p.Notes) > ParticipantNotesMaxLength {
+		errs = append(errs, ErrParticipantNotesTooLong)
+	}
+	if err := ValidateParticipantTags(p.Tags); err != nil {
+		errs = append(errs, err)
+	}
+	if err := ValidateParticipantGroupName(p.GroupName); err != nil {
+		errs = append(errs, err)
+	}
+	if p.PaymentAmount != nil && p.PaymentAmount.IsNegative() {
+		errs = append(errs, ErrParticipantPaymentAmountInvalid)
+	}
+	return errs
+}
+
+// ValidateParticipantTags checks the number of tags and the length of each tag.
+func ValidateParticipantTags(tags []string) error {
+	if len(tags) > ParticipantMaxTags {
+		return ErrParticipantTooManyTags
+	}
+	for _, tag := range tags {
+		if tag == "" || utf8.RuneCountInString(tag) > ParticipantTagMaxLength {
+			return ErrParticipantTagInvalid
+		}
 	}
 	return nil
 }
 
+// NormalizeParticipantTags trims surrounding whitespace from tags and drops empty and
+// duplicate tags, keeping the first occurrence of each. Tags are case-sensitive.
+func NormalizeParticipantTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, tag := range tags {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+	return normalized
+}
+
+// ValidateParticipantGroupName checks the length of a group name; nil means no group.
+func ValidateParticipantGroupName(groupName *string) error {
+	if groupName == nil {
+		return nil
+	}
+	if *groupName == "" || utf8.RuneCountInString(*groupName) > ParticipantGroupNameMaxLength {
+		return ErrParticipantGroupNameInvalid
+	}
+	return nil
+}
+
+// NormalizeParticipantGroupName trims surrounding whitespace from a group name, so that
+// "Table 5 " and "Table 5" are the same group.
+func NormalizeParticipantGroupName(groupName *string) *string {
+	if groupName == nil {
+		return nil
+	}
+	trimmed := strings.TrimSpace(*groupName)
+	return &trimmed
+}
+
 // String implements the Stringer interface for ParticipantStatus.
 func (s ParticipantStatus) String() string {
 	return string(s)
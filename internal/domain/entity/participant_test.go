@@ -2,9 +2,12 @@ package entity_test
 
 import (
 	"encoding/json"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -56,6 +59,23 @@ var _ = Describe("Participant Entity", func() {
 			})
 		})
 
+		Context("with several invalid fields", func() {
+			It("should return all of the errors", func() {
+				participant.Name = ""
+				participant.Email = "not-an-email"
+				phone := strings.Repeat("0", entity.ParticipantPhoneMaxLength+1)
+				participant.Phone = &phone
+
+				err := participant.Validate()
+
+				Expect(err).To(Equal(entity.ValidationErrors{
+					entity.ErrParticipantNameRequired,
+					entity.ErrParticipantEmailInvalid,
+					entity.ErrParticipantPhoneTooLong,
+				}))
+			})
+		})
+
 		Context("with name exceeding max length", func() {
 			It("should return entity.ErrParticipantNameTooLong", func() {
 				participant.Name = string(make([]byte, entity.ParticipantNameMaxLength+1))
@@ -72,6 +92,14 @@ var _ = Describe("Participant Entity", func() {
 			})
 		})
 
+		Context("with a walk-in without email", func() {
+			It("should be valid", func() {
+				participant.WalkIn = true
+				participant.Email = ""
+				Expect(participant.Validate()).To(Succeed())
+			})
+		})
+
 		Context("with invalid email format", func() {
 			It("should return entity.ErrParticipantEmailInvalid", func() {
 				participant.Email = "invalid-email"
@@ -80,6 +108,15 @@ var _ = Describe("Participant Entity", func() {
 			})
 		})
 
+		Context("with a walk-in with invalid email format", func() {
+			It("should return entity.ErrParticipantEmailInvalid", func() {
+				participant.WalkIn = true
+				participant.Email = "invalid-email"
+				err := participant.Validate()
+				Expect(err).To(Equal(entity.ErrParticipantEmailInvalid))
+			})
+		})
+
 		Context("with missing QR code", func() {
 			It("should return entity.ErrParticipantQRCodeRequired", func() {
 				participant.QRCode = ""
@@ -88,6 +125,37 @@ var _ = Describe("Participant Entity", func() {
 			})
 		})
 
+		Context("with invited status", func() {
+			BeforeEach(func() {
+				participant.Status = entity.ParticipantStatusInvited
+			})
+
+			It("should succeed without a QR code", func() {
+				participant.QRCode = ""
+				Expect(participant.Validate()).To(Succeed())
+			})
+
+			It("should return entity.ErrParticipantInvitedQRCode when a QR code is set", func() {
+				err := participant.Validate()
+				Expect(err).To(Equal(entity.ErrParticipantInvitedQRCode))
+			})
+		})
+
+		Context("with expired status", func() {
+			BeforeEach(func() {
+				participant.Status = entity.ParticipantStatusExpired
+			})
+
+			It("should succeed with the QR code of an expired tentative participant", func() {
+				Expect(participant.Validate()).To(Succeed())
+			})
+
+			It("should succeed without the QR code of an expired invitation", func() {
+				participant.QRCode = ""
+				Expect(participant.Validate()).To(Succeed())
+			})
+		})
+
 		Context("with invalid status", func() {
 			It("should return entity.ErrParticipantStatusInvalid", func() {
 				participant.Status = entity.ParticipantStatus("invalid")
@@ -122,6 +190,65 @@ var _ = Describe("Participant Entity", func() {
 			})
 		})
 
+		Context("with negative payment amount", func() {
+			It("should return entity.ErrParticipantPaymentAmountInvalid", func() {
+				amount := money.FromMinorUnits(-100)
+				participant.PaymentAmount = &amount
+				err := participant.Validate()
+				Expect(err).To(Equal(entity.ErrParticipantPaymentAmountInvalid))
+			})
+		})
+
+		Context("with notes exceeding max length", func() {
+			It("should return entity.ErrParticipantNotesTooLong", func() {
+				notes := strings.Repeat("a", entity.ParticipantNotesMaxLength+1)
+				participant.Notes = &notes
+				err := participant.Validate()
+				Expect(err).To(Equal(entity.ErrParticipantNotesTooLong))
+			})
+		})
+
+		Context("with multi-byte notes at max length", func() {
+			It("should count characters rather than bytes", func() {
+				notes := strings.Repeat("車", entity.ParticipantNotesMaxLength)
+				participant.Notes = &notes
+				Expect(participant.Validate()).To(Succeed())
+			})
+		})
+
+		Context("with more tags than the cap", func() {
+			It("should return entity.ErrParticipantTooManyTags", func() {
+				participant.Tags = make([]string, entity.ParticipantMaxTags+1)
+				for i := range participant.Tags {
+					participant.Tags[i] = fmt.Sprintf("tag-%d", i)
+				}
+				Expect(participant.Validate()).To(MatchError(entity.ErrParticipantTooManyTags))
+			})
+		})
+
+		Context("with a tag exceeding max length", func() {
+			It("should return entity.ErrParticipantTagInvalid", func() {
+				participant.Tags = []string{strings.Repeat("a", entity.ParticipantTagMaxLength+1)}
+				Expect(participant.Validate()).To(MatchError(entity.ErrParticipantTagInvalid))
+			})
+		})
+
+		Context("with a group name exceeding max length", func() {
+			It("should return entity.ErrParticipantGroupNameInvalid", func() {
+				groupName := strings.Repeat("a", entity.ParticipantGroupNameMaxLength+1)
+				participant.GroupName = &groupName
+				Expect(participant.Validate()).To(MatchError(entity.ErrParticipantGroupNameInvalid))
+			})
+		})
+
+		Context("with an empty group name", func() {
+			It("should return entity.ErrParticipantGroupNameInvalid", func() {
+				groupName := ""
+				participant.GroupName = &groupName
+				Expect(participant.Validate()).To(MatchError(entity.ErrParticipantGroupNameInvalid))
+			})
+		})
+
 		Context("with metadata exceeding max size", func() {
 			It("should return entity.ErrParticipantMetadataTooLarge", func() {
 				largeMeta := json.RawMessage(string(make([]byte, entity.MaxMetadataSize+1)))
@@ -180,6 +307,41 @@ var _ = Describe("Participant Entity", func() {
 				Expect(participant.IsDeclined()).To(BeTrue())
 			})
 		})
+
+		Context("when status is expired", func() {
+			BeforeEach(func() {
+				participant.Status = entity.ParticipantStatusExpired
+			})
+
+			It("should return true for IsExpired", func() {
+				Expect(participant.IsExpired()).To(BeTrue())
+			})
+		})
+
+		Context("when status is waitlisted", func() {
+			BeforeEach(func() {
+				participant.Status = entity.ParticipantStatusWaitlisted
+			})
+
+			It("should return true for IsWaitlisted", func() {
+				Expect(participant.IsWaitlisted()).To(BeTrue())
+				Expect(participant.Validate()).To(Succeed())
+			})
+		})
+
+		DescribeTable("CanExpire",
+			func(status entity.ParticipantStatus, expected bool) {
+				participant.Status = status
+				Expect(participant.CanExpire()).To(Equal(expected))
+			},
+			Entry("tentative", entity.ParticipantStatusTentative, true),
+			Entry("invited", entity.ParticipantStatusInvited, true),
+			Entry("confirmed", entity.ParticipantStatusConfirmed, false),
+			Entry("cancelled", entity.ParticipantStatusCancelled, false),
+			Entry("declined", entity.ParticipantStatusDeclined, false),
+			Entry("expired", entity.ParticipantStatusExpired, false),
+			Entry("waitlisted", entity.ParticipantStatusWaitlisted, false),
+		)
 	})
 
 	Describe("Payment status checks", func() {
@@ -212,6 +374,21 @@ var _ = Describe("Participant Entity", func() {
 		})
 	})
 
+	Describe("Consent", func() {
+		It("should not have consent by default", func() {
+			Expect(participant.HasConsent()).To(BeFalse())
+		})
+
+		It("should record the accepted version and time", func() {
+			acceptedAt := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+			participant.AcceptConsent("2025-01", acceptedAt)
+
+			Expect(participant.HasConsent()).To(BeTrue())
+			Expect(*participant.ConsentAcceptedAt).To(Equal(acceptedAt))
+			Expect(participant.ConsentVersion).To(Equal("2025-01"))
+		})
+	})
+
 	Describe("Valid status and payment status checks", func() {
 		Context("with valid status values", func() {
 			It("should return true for tentative", func() {
@@ -233,6 +410,16 @@ var _ = Describe("Participant Entity", func() {
 				participant.Status = entity.ParticipantStatusDeclined
 				Expect(participant.IsValidStatus()).To(BeTrue())
 			})
+
+			It("should return true for invited", func() {
+				participant.Status = entity.ParticipantStatusInvited
+				Expect(participant.IsValidStatus()).To(BeTrue())
+			})
+
+			It("should return true for expired", func() {
+				participant.Status = entity.ParticipantStatusExpired
+				Expect(participant.IsValidStatus()).To(BeTrue())
+			})
 		})
 
 		Context("with invalid status value", func() {
@@ -268,7 +455,7 @@ var _ = Describe("Participant Entity", func() {
 				phone := "+81901234567"
 				empID := "EMP001"
 				qrEmail := "qr@example.com"
-				amount := 1500.00
+				amount := money.FromMinorUnits(150000)
 				payDate := time.Now()
 
 				participant.Phone = &phone
@@ -299,4 +486,11 @@ var _ = Describe("Participant Entity", func() {
 			})
 		})
 	})
+
+	Describe("NormalizeParticipantTags", func() {
+		It("should trim tags and drop empty and duplicate ones, keeping the first occurrence", func() {
+			Expect(entity.NormalizeParticipantTags([]string{" vip ", "follow-up", "", "vip", "VIP"})).
+				To(Equal([]string{"vip", "follow-up", "VIP"}))
+		})
+	})
 })
@@ -0,0 +1,87 @@
+package entity
+
+import (
+	"errors"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	"github.com/google/uuid"
+)
+
+// PaymentMethod is how a participant paid.
+type PaymentMethod string
+
+const (
+	// PaymentMethodCash means the participant paid in cash.
+	PaymentMethodCash PaymentMethod = "cash"
+	// PaymentMethodCard means the participant paid by credit or debit card.
+	PaymentMethodCard PaymentMethod = "card"
+	// PaymentMethodBankTransfer means the participant paid by bank transfer.
+	PaymentMethodBankTransfer PaymentMethod = "bank_transfer"
+	// PaymentMethodOther means the participant paid some other way.
+	PaymentMethodOther PaymentMethod = "other"
+)
+
+// PaymentReferenceMaxLength is the maximum length of a payment reference, in characters.
+const PaymentReferenceMaxLength = 255
+
+// Common validation errors for Payment entity
+var (
+	ErrPaymentAmountInvalid      = errors.New("payment amount must be positive")
+	ErrPaymentMethodInvalid      = errors.New("invalid payment method")
+	ErrPaymentReferenceTooLong   = errors.New("payment reference must not exceed 255 characters")
+	ErrPaymentCurrencyMissing    = errors.New("payments require the event to have a currency")
+	ErrPaymentParticipantMissing = errors.New("participant ID is required")
+)
+
+// Payment records a payment received from a participant, in the currency of their event. A
+// refunded payment is kept with its refund, so a participant has at most one unrefunded payment.
+type Payment struct {
+	ID            uuid.UUID
+	EventID       uuid.UUID
+	ParticipantID uuid.UUID
+	Amount        money.Amount
+	Currency      string // Event's ISO 4217 currency at the time of the payment
+	Method        PaymentMethod
+	Reference     string // Receipt or transaction number; empty if none
+	RecordedBy    uuid.UUID
+	RecordedAt    time.Time
+	RefundedAt    *time.Time // nil unless refunded
+	RefundedBy    *uuid.UUID // nil unless refunded
+}
+
+// Validate validates the Payment entity fields.
+func (p *Payment) Validate() error {
+	if p.ParticipantID == uuid.Nil {
+		return ErrPaymentParticipantMissing
+	}
+	if p.Amount <= 0 {
+		return ErrPaymentAmountInvalid
+	}
+	if p.Currency == "" {
+		return ErrPaymentCurrencyMissing
+	}
+	if !p.IsValidMethod() {
+		return ErrPaymentMethodInvalid
+	}
+	if utf8.RuneCountInString(p.Reference) > PaymentReferenceMaxLength {
+		return ErrPaymentReferenceTooLong
+	}
+	return nil
+}
+
+// IsValidMethod checks if the payment method is valid.
+func (p *Payment) IsValidMethod() bool {
+	switch p.Method {
+	case PaymentMethodCash, PaymentMethodCard, PaymentMethodBankTransfer, PaymentMethodOther:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsRefunded reports whether the payment has been refunded.
+func (p *Payment) IsRefunded() bool {
+	return p.RefundedAt != nil
+}
@@ -0,0 +1,72 @@
+package entity_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Payment", func() {
+	var payment *entity.Payment
+
+	BeforeEach(func() {
+		payment = &entity.Payment{
+			ID:            uuid.New(),
+			EventID:       uuid.New(),
+			ParticipantID: uuid.New(),
+			Amount:        money.FromMinorUnits(150000),
+			Currency:      "JPY",
+			Method:        entity.PaymentMethodBankTransfer,
+			Reference:     "TX-20251215-001",
+			RecordedBy:    uuid.New(),
+			RecordedAt:    time.Now(),
+		}
+	})
+
+	When("validating", func() {
+		It("should accept a positive amount with a known method", func() {
+			Expect(payment.Validate()).To(Succeed())
+		})
+
+		It("should accept a payment without a reference", func() {
+			payment.Reference = ""
+			Expect(payment.Validate()).To(Succeed())
+		})
+
+		DescribeTable("should reject amounts that are not positive",
+			func(minor int64) {
+				payment.Amount = money.FromMinorUnits(minor)
+				Expect(payment.Validate()).To(MatchError(entity.ErrPaymentAmountInvalid))
+			},
+			Entry("zero", int64(0)),
+			Entry("negative", int64(-100)),
+		)
+
+		It("should reject an unknown method", func() {
+			payment.Method = "cheque"
+			Expect(payment.Validate()).To(MatchError(entity.ErrPaymentMethodInvalid))
+		})
+
+		It("should require a currency", func() {
+			payment.Currency = ""
+			Expect(payment.Validate()).To(MatchError(entity.ErrPaymentCurrencyMissing))
+		})
+
+		It("should reject a reference longer than 255 characters", func() {
+			payment.Reference = strings.Repeat("あ", entity.PaymentReferenceMaxLength+1)
+			Expect(payment.Validate()).To(MatchError(entity.ErrPaymentReferenceTooLong))
+		})
+	})
+
+	It("should report a refunded payment", func() {
+		Expect(payment.IsRefunded()).To(BeFalse())
+		refundedAt := time.Now()
+		payment.RefundedAt = &refundedAt
+		Expect(payment.IsRefunded()).To(BeTrue())
+	})
+})
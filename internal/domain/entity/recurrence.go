@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"errors"
+	"time"
+)
+
+// RecurrenceFrequency is the unit of time between the occurrences of a recurring event.
+type RecurrenceFrequency string
+
+const (
+	// RecurrenceDaily repeats an event every Interval days.
+	RecurrenceDaily RecurrenceFrequency = "daily"
+	// RecurrenceWeekly repeats an event every Interval weeks.
+	RecurrenceWeekly RecurrenceFrequency = "weekly"
+	// RecurrenceMonthly repeats an event every Interval months on the same day of the month.
+	RecurrenceMonthly RecurrenceFrequency = "monthly"
+)
+
+// Common validation errors for RecurrenceRule
+var (
+	ErrRecurrenceFrequencyInvalid   = errors.New("invalid recurrence frequency")
+	ErrRecurrenceIntervalInvalid    = errors.New("recurrence interval must be at least 1")
+	ErrRecurrenceEndInvalid         = errors.New("recurrence requires either a count or an until date")
+	ErrRecurrenceUntilBeforeStart   = errors.New("recurrence until date must not be before the event start date")
+	ErrRecurrenceTooManyOccurrences = errors.New("recurrence produces too many occurrences")
+)
+
+// RecurrenceRule describes how a recurring event repeats: every Interval days, weeks or months,
+// either Count times or until the Until date. Both include the first occurrence.
+type RecurrenceRule struct {
+	Frequency RecurrenceFrequency
+	Interval  int
+	Count     int        // Number of occurrences; zero when Until is set
+	Until     *time.Time // Latest start of an occurrence, inclusive; nil when Count is set
+}
+
+// Validate validates the RecurrenceRule fields against the start of the first occurrence.
+func (r RecurrenceRule) Validate(start time.Time) error {
+	switch r.Frequency {
+	case RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly:
+	default:
+		return ErrRecurrenceFrequencyInvalid
+	}
+	if r.Interval < 1 {
+		return ErrRecurrenceIntervalInvalid
+	}
+	if (r.Count > 0) == (r.Until != nil) || r.Count < 0 {
+		return ErrRecurrenceEndInvalid
+	}
+	if r.Until != nil && r.Until.Before(start) {
+		return ErrRecurrenceUntilBeforeStart
+	}
+	return nil
+}
+
+// Occurrences returns the start times of the occurrences of an event first starting at start,
+// the first included. Occurrences keep the wall-clock time of start in loc, so an event at
+// 10:00 local time stays at 10:00 across daylight saving changes. Monthly occurrences fall on
+// the day of the month of start; months without that day are skipped, as in RFC 5545.
+// Returns ErrRecurrenceTooManyOccurrences if the rule produces more than maxOccurrences.
+// The rule must be valid.
+func (r RecurrenceRule) Occurrences(start time.Time, loc *time.Location, maxOccurrences int) ([]time.Time, error) {
+	first := start.In(loc)
+	var starts []time.Time
+	for step := 0; r.Count == 0 || len(starts) < r.Count; step++ {
+		var next time.Time
+		switch r.Frequency {
+		case RecurrenceDaily:
+			next = first.AddDate(0, 0, step*r.Interval)
+		case RecurrenceWeekly:
+			next = first.AddDate(0, 0, 7*step*r.Interval)
+		case RecurrenceMonthly:
+			next = first.AddDate(0, step*r.Interval, 0)
+		}
+		// A skipped month normalizes into the following one, which is still before every later
+		// occurrence, so the until check holds for it too
+		if r.Until != nil && next.After(*r.Until) {
+			break
+		}
+		if next.Day() != first.Day() && r.Frequency == RecurrenceMonthly {
+			continue
+		}
+		if len(starts) == maxOccurrences {
+			return nil, ErrRecurrenceTooManyOccurrences
+		}
+		starts = append(starts, next.In(start.Location()))
+	}
+	return starts, nil
+}
@@ -0,0 +1,113 @@
+package entity_test
+
+import (
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RecurrenceRule", func() {
+	start := time.Date(2030, 1, 31, 10, 0, 0, 0, time.UTC)
+
+	Describe("Validate", func() {
+		until := func(t time.Time) *time.Time { return &t }
+
+		DescribeTable("should validate the rule",
+			func(rule entity.RecurrenceRule, expected error) {
+				err := rule.Validate(start)
+				if expected == nil {
+					Expect(err).NotTo(HaveOccurred())
+				} else {
+					Expect(err).To(MatchError(expected))
+				}
+			},
+			Entry("with a count", entity.RecurrenceRule{Frequency: entity.RecurrenceWeekly, Interval: 1, Count: 4}, nil),
+			Entry("with an until date",
+				entity.RecurrenceRule{Frequency: entity.RecurrenceDaily, Interval: 2, Until: until(start.AddDate(0, 1, 0))},
+				nil),
+			Entry("with an unknown frequency",
+				entity.RecurrenceRule{Frequency: "yearly", Interval: 1, Count: 4},
+				entity.ErrRecurrenceFrequencyInvalid),
+			Entry("with a zero interval",
+				entity.RecurrenceRule{Frequency: entity.RecurrenceWeekly, Count: 4},
+				entity.ErrRecurrenceIntervalInvalid),
+			Entry("with neither a count nor an until date",
+				entity.RecurrenceRule{Frequency: entity.RecurrenceWeekly, Interval: 1},
+				entity.ErrRecurrenceEndInvalid),
+			Entry("with both a count and an until date",
+				entity.RecurrenceRule{Frequency: entity.RecurrenceWeekly, Interval: 1, Count: 4, Until: until(start)},
+				entity.ErrRecurrenceEndInvalid),
+			Entry("with an until date before the start",
+				entity.RecurrenceRule{Frequency: entity.RecurrenceWeekly, Interval: 1, Until: until(start.Add(-time.Hour))},
+				entity.ErrRecurrenceUntilBeforeStart),
+		)
+	})
+
+	Describe("Occurrences", func() {
+		It("should repeat daily by the interval the given number of times", func() {
+			rule := entity.RecurrenceRule{Frequency: entity.RecurrenceDaily, Interval: 2, Count: 3}
+
+			starts, err := rule.Occurrences(start, time.UTC, 365)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(starts).To(Equal([]time.Time{start, start.AddDate(0, 0, 2), start.AddDate(0, 0, 4)}))
+		})
+
+		It("should repeat weekly up to and including the until date", func() {
+			until := start.AddDate(0, 0, 14)
+			rule := entity.RecurrenceRule{Frequency: entity.RecurrenceWeekly, Interval: 1, Until: &until}
+
+			starts, err := rule.Occurrences(start, time.UTC, 365)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(starts).To(Equal([]time.Time{start, start.AddDate(0, 0, 7), until}))
+		})
+
+		It("should skip months without the day of the month of the start", func() {
+			rule := entity.RecurrenceRule{Frequency: entity.RecurrenceMonthly, Interval: 1, Count: 3}
+
+			starts, err := rule.Occurrences(start, time.UTC, 365)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(starts).To(Equal([]time.Time{
+				start,
+				time.Date(2030, 3, 31, 10, 0, 0, 0, time.UTC),
+				time.Date(2030, 5, 31, 10, 0, 0, 0, time.UTC),
+			}))
+		})
+
+		It("should keep the local wall-clock time across daylight saving changes", func() {
+			ny, err := time.LoadLocation("America/New_York")
+			Expect(err).NotTo(HaveOccurred())
+			// 10:00 EST is 15:00 UTC; after the March change 10:00 EDT is 14:00 UTC
+			first := time.Date(2030, 3, 3, 15, 0, 0, 0, time.UTC)
+			rule := entity.RecurrenceRule{Frequency: entity.RecurrenceWeekly, Interval: 1, Count: 2}
+
+			starts, err := rule.Occurrences(first, ny, 365)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(starts).To(HaveLen(2))
+			Expect(starts[1]).To(Equal(time.Date(2030, 3, 10, 14, 0, 0, 0, time.UTC)))
+			Expect(starts[1].Location()).To(Equal(time.UTC))
+		})
+
+		It("should reject a rule producing more occurrences than the maximum", func() {
+			rule := entity.RecurrenceRule{Frequency: entity.RecurrenceDaily, Interval: 1, Count: 6}
+
+			_, err := rule.Occurrences(start, time.UTC, 5)
+
+			Expect(err).To(MatchError(entity.ErrRecurrenceTooManyOccurrences))
+		})
+
+		It("should reject an until date producing more occurrences than the maximum", func() {
+			until := start.AddDate(2, 0, 0)
+			rule := entity.RecurrenceRule{Frequency: entity.RecurrenceDaily, Interval: 1, Until: &until}
+
+			_, err := rule.Occurrences(start, time.UTC, 365)
+
+			Expect(err).To(MatchError(entity.ErrRecurrenceTooManyOccurrences))
+		})
+	})
+})
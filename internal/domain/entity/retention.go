@@ -0,0 +1,31 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// AnonymizedParticipantName replaces the name of participants anonymized by the retention purge
+	AnonymizedParticipantName = "Anonymized participant"
+	// AnonymizedParticipantEmailFormat formats the placeholder email of an anonymized participant
+	// from its ID. The address is unique per participant and uses the reserved .invalid domain,
+	// so it can never be mailed. The format also works with PostgreSQL's format().
+	AnonymizedParticipantEmailFormat = "anonymized-%s@anonymized.invalid"
+)
+
+// AnonymizedParticipantEmail returns the placeholder email of an anonymized participant
+func AnonymizedParticipantEmail(participantID uuid.UUID) string {
+	return fmt.Sprintf(AnonymizedParticipantEmailFormat, participantID)
+}
+
+// PIIPurge is the audit entry of a retention purge that anonymized an event's participants
+type PIIPurge struct {
+	EventID                uuid.UUID
+	OrganizerID            uuid.UUID
+	ParticipantsAnonymized int64
+	RetentionDays          int
+	PurgedAt               time.Time
+}
@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// maxSessionDeviceLength bounds the device description kept for a session.
+const maxSessionDeviceLength = 255
+
+// Session is a login of a user on one device. It lasts as long as its refresh token is
+// rotated; the access and refresh tokens issued for it carry its ID.
+type Session struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	ClientType   string    // "web" or "mobile"
+	Device       string    // User-Agent of the client that logged in
+	RefreshToken string    // Current refresh token of the session, blacklisted when it is revoked
+	IssuedAt     time.Time // When the user logged in
+	LastUsedAt   time.Time // When the session last logged in or refreshed its tokens
+	ExpiresAt    time.Time // When the current refresh token expires
+}
+
+// NewSession creates a session of a user logging in now on device.
+// Overlong device descriptions are truncated.
+func NewSession(userID uuid.UUID, clientType, device string, now time.Time) *Session {
+	if runes := []rune(device); len(runes) > maxSessionDeviceLength {
+		device = string(runes[:maxSessionDeviceLength])
+	}
+	return &Session{
+		ID:         uuid.New(),
+		UserID:     userID,
+		ClientType: clientType,
+		Device:     device,
+		IssuedAt:   now,
+		LastUsedAt: now,
+	}
+}
+
+// IsExpired reports whether the refresh token of the session has expired at now.
+func (s *Session) IsExpired(now time.Time) bool {
+	return !now.Before(s.ExpiresAt)
+}
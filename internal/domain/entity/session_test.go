@@ -0,0 +1,41 @@
+package entity_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Session", func() {
+	now := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	When("creating a session", func() {
+		It("should start it now with a new ID", func() {
+			userID := uuid.New()
+			session := entity.NewSession(userID, "web", "Mozilla/5.0", now)
+
+			Expect(session.ID).NotTo(Equal(uuid.Nil))
+			Expect(session.UserID).To(Equal(userID))
+			Expect(session.IssuedAt).To(Equal(now))
+			Expect(session.LastUsedAt).To(Equal(now))
+		})
+
+		It("should truncate overlong device descriptions", func() {
+			session := entity.NewSession(uuid.New(), "web", strings.Repeat("あ", 300), now)
+
+			Expect([]rune(session.Device)).To(HaveLen(255))
+		})
+	})
+
+	It("should expire with its refresh token", func() {
+		session := entity.NewSession(uuid.New(), "web", "", now)
+		session.ExpiresAt = now.Add(time.Hour)
+
+		Expect(session.IsExpired(now)).To(BeFalse())
+		Expect(session.IsExpired(now.Add(time.Hour))).To(BeTrue())
+	})
+})
@@ -42,18 +42,54 @@ var (
 	ErrUserAlreadyAnonymized = errors.New("user is already anonymized")
 )
 
+// Two-factor authentication constants
+const (
+	// TwoFactorBackupCodeCount is the number of backup codes issued when 2FA is enabled
+	TwoFactorBackupCodeCount = 10
+	// TwoFactorMaxFailedAttempts is the number of invalid codes after which 2FA login is
+	// refused until TwoFactorLockoutWindow has passed since the last one
+	TwoFactorMaxFailedAttempts = 5
+	// TwoFactorLockoutWindow is how long invalid two-factor codes are counted
+	TwoFactorLockoutWindow = 15 * time.Minute
+)
+
 // User represents a system user who can create and manage events
 type User struct {
-	ID           uuid.UUID
-	Email        string
-	PasswordHash string
-	Name         string
-	Role         UserRole
-	DeletedAt    *time.Time // Soft delete timestamp
-	DeletedBy    *uuid.UUID // User who performed deletion
-	IsAnonymized bool       // PII anonymization flag
-	CreatedAt    time.Time
-	UpdatedAt    time.Time
+	ID               uuid.UUID
+	Email            string
+	PasswordHash     string
+	Name             string
+	Role             UserRole
+	DeletedAt        *time.Time // Soft delete timestamp
+	DeletedBy        *uuid.UUID // User who performed deletion
+	IsAnonymized     bool       // PII anonymization flag
+	TwoFactorEnabled bool       // A TOTP or backup code is required after the password at login
+	DeactivatedAt    *time.Time // Set while an admin has deactivated the account
+	EmailVerified    bool       // The user has confirmed their email address with a verification link
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+}
+
+// UserTwoFactor holds the TOTP second factor of a user
+type UserTwoFactor struct {
+	UserID           uuid.UUID
+	EncryptedSecret  string     // TOTP secret, encrypted with the two-factor encryption key
+	EnabledAt        *time.Time // Nil while the enrollment awaits its first valid code
+	BackupCodeHashes []string   // SHA-256 hashes of the unused backup codes
+	LastUsedStep     int64      // TOTP time step of the last accepted code
+	FailedAttempts   int        // Invalid codes entered at login since the last accepted one
+	LastFailedAt     *time.Time
+}
+
+// IsEnabled returns true once the enrollment has been verified
+func (t *UserTwoFactor) IsEnabled() bool {
+	return t.EnabledAt != nil
+}
+
+// IsLockedOut returns true if too many invalid codes were entered within the lockout window
+func (t *UserTwoFactor) IsLockedOut(now time.Time) bool {
+	return t.FailedAttempts >= TwoFactorMaxFailedAttempts &&
+		t.LastFailedAt != nil && now.Sub(*t.LastFailedAt) < TwoFactorLockoutWindow
 }
 
 // Validate validates the User entity fields
@@ -108,6 +144,11 @@ func (u *User) IsDeleted() bool {
 	return u.DeletedAt != nil
 }
 
+// IsDeactivated returns true if an admin has deactivated the user's account
+func (u *User) IsDeactivated() bool {
+	return u.DeactivatedAt != nil
+}
+
 // IsAdmin returns true if the user has admin role
 func (u *User) IsAdmin() bool {
 	return u.Role == RoleAdmin
@@ -184,6 +184,19 @@ var _ = Describe("User", func() {
 		})
 	})
 
+	Describe("IsDeactivated", func() {
+		It("should return false for an active user", func() {
+			Expect((&entity.User{}).IsDeactivated()).To(BeFalse())
+		})
+
+		It("should return true once an admin has deactivated the user", func() {
+			now := time.Now()
+			user := &entity.User{DeactivatedAt: &now}
+
+			Expect(user.IsDeactivated()).To(BeTrue())
+		})
+	})
+
 	Describe("Role Checks", func() {
 		When("checking user role permissions", func() {
 			Context("with admin role", func() {
@@ -292,4 +305,45 @@ var _ = Describe("User", func() {
 			})
 		})
 	})
+
+	Describe("UserTwoFactor.IsLockedOut", func() {
+		var now time.Time
+
+		BeforeEach(func() {
+			now = time.Now()
+		})
+
+		When("the maximum of invalid codes was entered within the window", func() {
+			It("should return true", func() {
+				lastFailed := now.Add(-time.Minute)
+				twoFactor := &entity.UserTwoFactor{
+					FailedAttempts: entity.TwoFactorMaxFailedAttempts,
+					LastFailedAt:   &lastFailed,
+				}
+				Expect(twoFactor.IsLockedOut(now)).To(BeTrue())
+			})
+		})
+
+		When("the window has passed since the last invalid code", func() {
+			It("should return false", func() {
+				lastFailed := now.Add(-entity.TwoFactorLockoutWindow)
+				twoFactor := &entity.UserTwoFactor{
+					FailedAttempts: entity.TwoFactorMaxFailedAttempts,
+					LastFailedAt:   &lastFailed,
+				}
+				Expect(twoFactor.IsLockedOut(now)).To(BeFalse())
+			})
+		})
+
+		When("fewer invalid codes were entered", func() {
+			It("should return false", func() {
+				lastFailed := now
+				twoFactor := &entity.UserTwoFactor{
+					FailedAttempts: entity.TwoFactorMaxFailedAttempts - 1,
+					LastFailedAt:   &lastFailed,
+				}
+				Expect(twoFactor.IsLockedOut(now)).To(BeFalse())
+			})
+		})
+	})
 })
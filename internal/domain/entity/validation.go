@@ -0,0 +1,43 @@
+package entity
+
+import "strings"
+
+// ValidationErrors are the failures found validating an entity, so that all of them can be
+// reported at once. errors.Is and errors.As match each of them.
+type ValidationErrors []error
+
+// Error joins the messages of the failures.
+func (e ValidationErrors) Error() string {
+	messages := make([]string, len(e))
+	for i, err := range e {
+		messages[i] = err.Error()
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Unwrap returns the failures.
+func (e ValidationErrors) Unwrap() []error {
+	return e
+}
+
+// JoinValidationErrors returns the failures among errs: nil if there are none, the failure
+// itself if there is one, and otherwise ValidationErrors listing each of them. Nil errors are
+// dropped and ValidationErrors among errs are flattened.
+func JoinValidationErrors(errs ...error) error {
+	var failures ValidationErrors
+	for _, err := range errs {
+		if nested, ok := err.(ValidationErrors); ok {
+			failures = append(failures, nested...)
+		} else if err != nil {
+			failures = append(failures, err)
+		}
+	}
+	switch len(failures) {
+	case 0:
+		return nil
+	case 1:
+		return failures[0]
+	default:
+		return failures
+	}
+}
@@ -0,0 +1,35 @@
+package entity_test
+
+import (
+	"errors"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("JoinValidationErrors", func() {
+	It("should return nil without failures", func() {
+		Expect(entity.JoinValidationErrors(nil, nil)).To(Succeed())
+	})
+
+	It("should return a single failure as is", func() {
+		Expect(entity.JoinValidationErrors(nil, entity.ErrEventNameRequired)).To(Equal(entity.ErrEventNameRequired))
+	})
+
+	It("should flatten joined failures", func() {
+		nested := entity.JoinValidationErrors(entity.ErrParticipantNameRequired, entity.ErrParticipantEmailInvalid)
+		other := errors.New("payment amount requires the event to have a currency")
+
+		err := entity.JoinValidationErrors(nested, nil, other)
+
+		Expect(err).To(Equal(entity.ValidationErrors{
+			entity.ErrParticipantNameRequired, entity.ErrParticipantEmailInvalid, other,
+		}))
+		Expect(err).To(MatchError(entity.ErrParticipantEmailInvalid))
+		Expect(err.Error()).To(Equal(
+			"participant name is required; participant email format is invalid; " +
+				"payment amount requires the event to have a currency",
+		))
+	})
+})
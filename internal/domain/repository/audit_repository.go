@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=mocks/mock_audit_repository.go -package=mocks . AuditRepository
+
+// AuditLogFilter narrows the audit log entries returned by List. Nil fields match every entry.
+type AuditLogFilter struct {
+	ResourceType *entity.AuditResourceType
+	ResourceID   *uuid.UUID
+}
+
+// AuditRepository defines the interface for audit log persistence operations.
+type AuditRepository interface {
+	// Record appends an entry to the audit log. It never joins the transaction in ctx, so that
+	// recording is independent of the change it records.
+	Record(ctx context.Context, entry *entity.AuditLog) error
+
+	// List returns the audit log entries matching the filter, newest first, with their total count.
+	List(ctx context.Context, filter AuditLogFilter, offset, limit int) ([]*entity.AuditLog, int64, error)
+}
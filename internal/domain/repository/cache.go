@@ -1,4 +1,4 @@
-//go:generate mockgen -destination=mocks/mock_cache_repository.go -package=mocks . CacheRepository,TokenBlacklistRepository
+//go:generate mockgen -destination=mocks/mock_cache_repository.go -package=mocks . CacheRepository,TokenBlacklistRepository,PubSubRepository,Subscription
 
 package repository
 
@@ -14,10 +14,22 @@ type CacheRepository interface {
 	// Returns empty string if key doesn't exist.
 	Get(ctx context.Context, key string) (string, error)
 
+	// GetAndDelete atomically retrieves a value from cache and removes its key, so that only one
+	// caller can read it. Returns empty string if key doesn't exist.
+	GetAndDelete(ctx context.Context, key string) (string, error)
+
 	// Set stores a value in cache with the specified TTL.
 	// If ttl is 0, the key will not expire.
 	Set(ctx context.Context, key string, value string, ttl time.Duration) error
 
+	// SetIfNotExists atomically stores a value with the specified TTL only if the key does
+	// not exist yet. Returns false if the key already existed.
+	SetIfNotExists(ctx context.Context, key string, value string, ttl time.Duration) (bool, error)
+
+	// Increment atomically adds one to the counter stored at key and returns the new count.
+	// A new counter starts at zero and expires after ttl; incrementing it again keeps its TTL.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
+
 	// Delete removes a key from cache.
 	// No error is returned if key doesn't exist.
 	Delete(ctx context.Context, key string) error
@@ -45,4 +57,42 @@ type TokenBlacklistRepository interface {
 
 	// IsBlacklisted checks if a token is in the blacklist.
 	IsBlacklisted(ctx context.Context, token string) (bool, error)
+
+	// AddFamilyToBlacklist blacklists every token of a token family, the tokens issued for one
+	// login session, with TTL matching the expiry of the family's last token.
+	AddFamilyToBlacklist(ctx context.Context, familyID string, ttl time.Duration) error
+
+	// IsFamilyBlacklisted checks if a token family is in the blacklist.
+	IsFamilyBlacklisted(ctx context.Context, familyID string) (bool, error)
+
+	// AddUserToBlacklist blacklists every access token of a user, with TTL matching the expiry
+	// of the user's last access token.
+	AddUserToBlacklist(ctx context.Context, userID string, ttl time.Duration) error
+
+	// RemoveUserFromBlacklist accepts the access tokens of a user again.
+	// No error is returned if the user is not blacklisted.
+	RemoveUserFromBlacklist(ctx context.Context, userID string) error
+
+	// IsUserBlacklisted checks if a user is in the blacklist.
+	IsUserBlacklisted(ctx context.Context, userID string) (bool, error)
+}
+
+// PubSubRepository defines the interface for publish/subscribe messaging between server instances.
+// Messages are not stored: only subscribers connected when a message is published receive it.
+type PubSubRepository interface {
+	// Publish sends a message to every current subscriber of channel.
+	Publish(ctx context.Context, channel string, message string) error
+
+	// Subscribe subscribes to channel. Messages published after Subscribe returns are delivered
+	// on the subscription until it is closed.
+	Subscribe(ctx context.Context, channel string) (Subscription, error)
+}
+
+// Subscription is an active subscription to a publish/subscribe channel.
+type Subscription interface {
+	// Messages returns the received messages; the channel is closed when the subscription is.
+	Messages() <-chan string
+
+	// Close unsubscribes and releases the subscription.
+	Close() error
 }
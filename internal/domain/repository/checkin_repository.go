@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/google/uuid"
@@ -16,15 +17,55 @@ type CheckinStats struct {
 	CheckinRate       float64 // Percentage of participants checked in (0.0 - 100.0)
 }
 
+// CheckinProgress represents the live check-in counter for an event.
+type CheckinProgress struct {
+	CheckedInCount    int64 // Number of check-ins recorded for the event
+	TotalParticipants int64 // Number of active (tentative or confirmed) participants
+}
+
+// StaffCheckinCount is the number of check-ins recorded by one staff member.
+type StaffCheckinCount struct {
+	UserID       uuid.UUID
+	Name         string
+	CheckinCount int64
+}
+
+// CheckinAttribution breaks down an event's check-ins by who recorded them.
+type CheckinAttribution struct {
+	Staff        []StaffCheckinCount // Ordered by check-in count, highest first
+	SelfCheckins int64               // Check-ins without a checking-in user, e.g. QR self-service
+}
+
+// ScanOutcomeCount is the number of QR scans with one outcome in one time bucket.
+type ScanOutcomeCount struct {
+	BucketStart time.Time
+	Outcome     entity.ScanOutcome
+	Count       int64
+}
+
+// CheckinIntervalCount is the number of check-ins of one device in one time bucket.
+type CheckinIntervalCount struct {
+	BucketStart time.Time
+	DeviceID    *string // Device that made the check-ins; nil for check-ins without a device ID
+	Count       int64
+}
+
 // CheckinRepository defines the interface for check-in data persistence operations.
+// Cancelled check-ins are kept for the audit trail and a later restore. Only FindByID returns
+// them; every other lookup and count considers active check-ins only.
 type CheckinRepository interface {
 	BaseRepository
 
 	// Create creates a new check-in record with duplicate prevention.
-	// Returns ErrCheckinAlreadyExists if the participant has already checked in.
+	// Returns ErrCheckinAlreadyExists if the participant has an active check-in.
 	Create(ctx context.Context, checkin *entity.Checkin) error
 
-	// FindByID finds a check-in by its unique ID.
+	// BulkCreate creates check-ins for several participants in a single statement, skipping
+	// participants who already have an active check-in. Returns the IDs of the participants
+	// checked in.
+	BulkCreate(ctx context.Context, checkins []*entity.Checkin) ([]uuid.UUID, error)
+
+	// FindByID finds a check-in by its unique ID, including cancelled check-ins.
 	// Returns ErrNotFound if the check-in does not exist.
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Checkin, error)
 
@@ -32,6 +73,10 @@ type CheckinRepository interface {
 	// Returns ErrNotFound if the participant has not checked in.
 	FindByParticipant(ctx context.Context, participantID uuid.UUID) (*entity.Checkin, error)
 
+	// GetHistory finds every check-in of a participant, including cancelled ones, oldest first.
+	// A participant who checked out and in again has a check-in per entry.
+	GetHistory(ctx context.Context, participantID uuid.UUID) ([]*entity.Checkin, error)
+
 	// FindByEvent finds all check-ins for an event with pagination.
 	// Returns the check-ins and the total count of check-ins for the event.
 	FindByEvent(ctx context.Context, eventID uuid.UUID, limit, offset int) ([]*entity.Checkin, int64, error)
@@ -40,9 +85,39 @@ type CheckinRepository interface {
 	// Returns stats including total participants, checked-in count, and check-in rate.
 	GetEventStats(ctx context.Context, eventID uuid.UUID) (*CheckinStats, error)
 
-	// Delete deletes a check-in (undo check-in operation).
-	// Returns ErrNotFound if the check-in does not exist.
-	Delete(ctx context.Context, id uuid.UUID) error
+	// GetEventProgress counts check-ins and active participants for an event in a single query.
+	// Unlike GetEventStats it counts participants the same way as the event statistics.
+	GetEventProgress(ctx context.Context, eventID uuid.UUID) (*CheckinProgress, error)
+
+	// CountByStaff counts an event's check-ins per checking-in user, joined with the user's name.
+	// Check-ins without a checking-in user are counted separately as self check-ins.
+	CountByStaff(ctx context.Context, eventID uuid.UUID) (*CheckinAttribution, error)
+
+	// CountByInterval counts an event's check-ins per device in time buckets of the given size,
+	// ordered by bucket. The buckets span the event's schedule, widened to its first and last
+	// check-in, and buckets without check-ins are returned once with a nil device and a zero count.
+	CountByInterval(ctx context.Context, eventID uuid.UUID, interval time.Duration) ([]CheckinIntervalCount, error)
+
+	// RecordScan records a QR scan attempt at check-in, skipping scans of events that no longer exist.
+	RecordScan(ctx context.Context, scan *entity.CheckinScan) error
+
+	// CountScans counts an event's QR scans per outcome in time buckets of the given size,
+	// ordered by bucket. Buckets and outcomes without scans are omitted.
+	CountScans(ctx context.Context, eventID uuid.UUID, bucket time.Duration) ([]ScanOutcomeCount, error)
+
+	// Cancel marks an active check-in as cancelled by the given user (undo check-in operation).
+	// Returns ErrNotFound if the check-in does not exist or is already cancelled.
+	Cancel(ctx context.Context, id, cancelledBy uuid.UUID, cancelledAt time.Time) error
+
+	// Restore re-activates a cancelled check-in.
+	// Returns ErrNotFound if the check-in does not exist or is not cancelled, and
+	// ErrCheckinAlreadyExists if the participant has checked in again since.
+	Restore(ctx context.Context, id uuid.UUID) error
+
+	// Reassign moves every check-in of a participant, active and ended, to another participant of
+	// the same event, e.g. when merging duplicates. If both have an active check-in, the later one
+	// is ended by endedBy at endedAt first. Returns the number of check-ins moved.
+	Reassign(ctx context.Context, fromParticipantID, toParticipantID, endedBy uuid.UUID, endedAt time.Time) (int64, error)
 
 	// ExistsByParticipant checks if a participant has already checked in to an event.
 	ExistsByParticipant(ctx context.Context, eventID, participantID uuid.UUID) (bool, error)
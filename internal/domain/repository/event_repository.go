@@ -5,27 +5,52 @@ import (
 	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 )
 
 //go:generate mockgen -destination=mocks/mock_event_repository.go -package=mocks . EventRepository
 
+// MinEventFullTextSearchLength is the shortest event search term, in characters, matched by
+// full-text search.
+const MinEventFullTextSearchLength = 3
+
 // EventListFilter defines filter options for listing events.
 type EventListFilter struct {
 	OrganizerID *uuid.UUID
 	Status      *entity.EventStatus
-	Search      string
-	StartDate   *time.Time
-	EndDate     *time.Time
-	Sort        string // sort column name (empty = default "created_at")
-	Order       string // "asc" | "desc" (empty = default "desc")
+	// Search matches events whose name, description or location contains the term, or matches
+	// it by full-text search. Terms of at least MinEventFullTextSearchLength characters rank
+	// the events by full-text relevance; shorter terms leave them unranked.
+	Search string
+	// StartDateFrom and StartDateTo select events starting within the range, both inclusive.
+	StartDateFrom *time.Time
+	StartDateTo   *time.Time
+	// IncludeDeleted lists soft deleted events along with live ones.
+	IncludeDeleted bool
+	// Sort column name; empty sorts a page-based full-text search by relevance, and any other
+	// list by "created_at"
+	Sort  string
+	Order string // "asc" | "desc" (empty = default "desc")
 }
 
 // EventStats represents basic statistics for an event.
 type EventStats struct {
-	TotalParticipants int64
+	TotalParticipants  int64
+	CheckedInCount     int64
+	WalkInCount        int64            // Active participants registered at check-in, included in TotalParticipants
+	GuestCount         int64            // Active guests of other participants, included in TotalParticipants
+	ByStatus           map[string]int64 // Count by all participant statuses
+	TotalPaymentAmount money.Amount     // Sum of paid participants' amounts, in Currency
+	Currency           string           // Event's ISO 4217 currency code ("" if unset)
+	// ByGroup counts by the group participants are seated at; ungrouped participants are left out
+	ByGroup map[string]EventGroupStats
+}
+
+// EventGroupStats represents the statistics of one group of an event's participants.
+type EventGroupStats struct {
+	TotalParticipants int64 // Active participants seated at the group
 	CheckedInCount    int64
-	ByStatus          map[string]int64 // Count by all participant statuses
 }
 
 // EventRepository defines the interface for event data persistence operations.
@@ -39,19 +64,75 @@ type EventRepository interface {
 	// Returns ErrNotFound if the event does not exist.
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Event, error)
 
+	// FindDeletedByID retrieves a soft deleted event by its unique ID.
+	// Returns ErrNotFound if the event does not exist or is not deleted.
+	FindDeletedByID(ctx context.Context, id uuid.UUID) (*entity.Event, error)
+
+	// FindByIDs retrieves the events with the given IDs.
+	// IDs without an event are skipped; the order of the result is unspecified.
+	FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Event, error)
+
+	// FindBySeriesID retrieves the occurrences of a recurring event series, ordered by start date.
+	FindBySeriesID(ctx context.Context, seriesID uuid.UUID) ([]*entity.Event, error)
+
 	// List retrieves a paginated and filtered list of events.
 	// Returns the events and the total count of events matching the filter.
 	List(ctx context.Context, filter EventListFilter, offset, limit int) ([]*entity.Event, int64, error)
 
+	// ListCursor retrieves up to limit events matching the filter in its sort order, starting
+	// after the event an opaque cursor returned by a previous call points at; an empty cursor
+	// starts from the first event. Unlike List it seeks to the cursor instead of skipping rows,
+	// so deep pages cost as much as the first. Returns the events and the cursor of the next
+	// page, empty once no events remain. Returns a bad request error for a malformed cursor or
+	// one issued for another sort field or order.
+	ListCursor(ctx context.Context, filter EventListFilter, cursor string, limit int) ([]*entity.Event, string, error)
+
 	// Update updates an existing event's information.
 	// Returns ErrNotFound if the event does not exist.
 	Update(ctx context.Context, event *entity.Event) error
 
-	// Delete deletes an event from the database.
-	// Should implement cascading deletion of participants and check-ins.
+	// UpdateLogo replaces the logo image of an event; nil removes it.
+	// Returns ErrNotFound if the event does not exist.
+	UpdateLogo(ctx context.Context, id uuid.UUID, logo []byte) error
+
+	// FindLogo retrieves the logo image of an event, or nil if the event has none.
+	// Returns ErrNotFound if the event does not exist.
+	FindLogo(ctx context.Context, id uuid.UUID) ([]byte, error)
+
+	// Delete soft deletes an event together with its participants; they are left out of every
+	// read until restored. Check-ins of deleted participants are left out too.
 	// Returns ErrNotFound if the event does not exist.
 	Delete(ctx context.Context, id uuid.UUID) error
 
+	// Restore restores a deleted event together with the participants deleted with it.
+	// Returns ErrNotFound if the event does not exist or is not deleted.
+	Restore(ctx context.Context, id uuid.UUID) error
+
 	// GetStats retrieves basic statistics for an event.
 	GetStats(ctx context.Context, id uuid.UUID) (*EventStats, error)
+
+	// GetStatsBatch retrieves basic statistics for several events at once, keyed by event ID.
+	// IDs without an event are absent from the result.
+	GetStatsBatch(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*EventStats, error)
+
+	// CountConfirmedParticipants returns the number of confirmed participants of an event, the
+	// places taken from its capacity. It joins the transaction in ctx, if any.
+	CountConfirmedParticipants(ctx context.Context, id uuid.UUID) (int64, error)
+
+	// GetListLastModified returns the latest modification time of the events visible
+	// under the filter's organizer scope, including deletions and participant changes.
+	// Returns the zero time if nothing has ever been recorded for the scope.
+	GetListLastModified(ctx context.Context, filter EventListFilter) (time.Time, error)
+
+	// FindPIIPurgeDue retrieves up to limit completed events that ended before endedBefore,
+	// are not on legal hold and whose participants have not been anonymized yet.
+	// Events without an end date count as ending at their start date.
+	FindPIIPurgeDue(ctx context.Context, endedBefore time.Time, limit int) ([]*entity.Event, error)
+
+	// MarkPIIPurged stamps an event's participants as anonymized at purgedAt. It returns false,
+	// leaving the event untouched, if the event was put on legal hold or already purged.
+	MarkPIIPurged(ctx context.Context, id uuid.UUID, purgedAt time.Time) (bool, error)
+
+	// RecordPIIPurge appends an audit entry for a retention purge.
+	RecordPIIPurge(ctx context.Context, purge *entity.PIIPurge) error
 }
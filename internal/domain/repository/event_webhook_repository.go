@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=mocks/mock_event_webhook_repository.go -package=mocks . EventWebhookRepository
+
+// EventWebhookRepository defines the interface for event webhook persistence operations.
+type EventWebhookRepository interface {
+	// Upsert creates or replaces the webhook of an event. Replacing a webhook resets its
+	// last delivery, since it described the previous URL.
+	Upsert(ctx context.Context, webhook *entity.EventWebhook) error
+
+	// FindByEventID finds the webhook of an event.
+	// Returns ErrNotFound if the event has no webhook.
+	FindByEventID(ctx context.Context, eventID uuid.UUID) (*entity.EventWebhook, error)
+
+	// Delete removes the webhook of an event.
+	// Returns ErrNotFound if the event has no webhook.
+	Delete(ctx context.Context, eventID uuid.UUID) error
+
+	// RecordDelivery records the outcome of a delivery attempt to the webhook of an event.
+	// deliveryErr is empty for successful deliveries. The webhook may have been removed
+	// meanwhile, in which case nothing is recorded.
+	RecordDelivery(
+		ctx context.Context,
+		eventID uuid.UUID,
+		at time.Time,
+		status entity.WebhookDeliveryStatus,
+		deliveryErr string,
+	) error
+}
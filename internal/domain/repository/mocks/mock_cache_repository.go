@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/fumkob/ezqrin-server/internal/domain/repository (interfaces: CacheRepository,TokenBlacklistRepository)
+// Source: github.com/fumkob/ezqrin-server/internal/domain/repository (interfaces: CacheRepository,TokenBlacklistRepository,PubSubRepository,Subscription)
 //
 // Generated by this command:
 //
-//	mockgen -destination=mocks/mock_cache_repository.go -package=mocks . CacheRepository,TokenBlacklistRepository
+//	mockgen -destination=mocks/mock_cache_repository.go -package=mocks . CacheRepository,TokenBlacklistRepository,PubSubRepository,Subscription
 //
 
 // Package mocks is a generated GoMock package.
@@ -14,6 +14,7 @@ import (
 	reflect "reflect"
 	time "time"
 
+	repository "github.com/fumkob/ezqrin-server/internal/domain/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -85,6 +86,36 @@ func (mr *MockCacheRepositoryMockRecorder) Get(ctx, key any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockCacheRepository)(nil).Get), ctx, key)
 }
 
+// GetAndDelete mocks base method.
+func (m *MockCacheRepository) GetAndDelete(ctx context.Context, key string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAndDelete", ctx, key)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAndDelete indicates an expected call of GetAndDelete.
+func (mr *MockCacheRepositoryMockRecorder) GetAndDelete(ctx, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAndDelete", reflect.TypeOf((*MockCacheRepository)(nil).GetAndDelete), ctx, key)
+}
+
+// Increment mocks base method.
+func (m *MockCacheRepository) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Increment", ctx, key, ttl)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Increment indicates an expected call of Increment.
+func (mr *MockCacheRepositoryMockRecorder) Increment(ctx, key, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Increment", reflect.TypeOf((*MockCacheRepository)(nil).Increment), ctx, key, ttl)
+}
+
 // MGet mocks base method.
 func (m *MockCacheRepository) MGet(ctx context.Context, keys []string) (map[string]string, error) {
 	m.ctrl.T.Helper()
@@ -142,6 +173,21 @@ func (mr *MockCacheRepositoryMockRecorder) Set(ctx, key, value, ttl any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Set", reflect.TypeOf((*MockCacheRepository)(nil).Set), ctx, key, value, ttl)
 }
 
+// SetIfNotExists mocks base method.
+func (m *MockCacheRepository) SetIfNotExists(ctx context.Context, key, value string, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetIfNotExists", ctx, key, value, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetIfNotExists indicates an expected call of SetIfNotExists.
+func (mr *MockCacheRepositoryMockRecorder) SetIfNotExists(ctx, key, value, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetIfNotExists", reflect.TypeOf((*MockCacheRepository)(nil).SetIfNotExists), ctx, key, value, ttl)
+}
+
 // MockTokenBlacklistRepository is a mock of TokenBlacklistRepository interface.
 type MockTokenBlacklistRepository struct {
 	ctrl     *gomock.Controller
@@ -166,6 +212,20 @@ func (m *MockTokenBlacklistRepository) EXPECT() *MockTokenBlacklistRepositoryMoc
 	return m.recorder
 }
 
+// AddFamilyToBlacklist mocks base method.
+func (m *MockTokenBlacklistRepository) AddFamilyToBlacklist(ctx context.Context, familyID string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddFamilyToBlacklist", ctx, familyID, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddFamilyToBlacklist indicates an expected call of AddFamilyToBlacklist.
+func (mr *MockTokenBlacklistRepositoryMockRecorder) AddFamilyToBlacklist(ctx, familyID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddFamilyToBlacklist", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).AddFamilyToBlacklist), ctx, familyID, ttl)
+}
+
 // AddToBlacklist mocks base method.
 func (m *MockTokenBlacklistRepository) AddToBlacklist(ctx context.Context, token string, ttl time.Duration) error {
 	m.ctrl.T.Helper()
@@ -180,6 +240,20 @@ func (mr *MockTokenBlacklistRepositoryMockRecorder) AddToBlacklist(ctx, token, t
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToBlacklist", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).AddToBlacklist), ctx, token, ttl)
 }
 
+// AddUserToBlacklist mocks base method.
+func (m *MockTokenBlacklistRepository) AddUserToBlacklist(ctx context.Context, userID string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserToBlacklist", ctx, userID, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddUserToBlacklist indicates an expected call of AddUserToBlacklist.
+func (mr *MockTokenBlacklistRepositoryMockRecorder) AddUserToBlacklist(ctx, userID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToBlacklist", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).AddUserToBlacklist), ctx, userID, ttl)
+}
+
 // IsBlacklisted mocks base method.
 func (m *MockTokenBlacklistRepository) IsBlacklisted(ctx context.Context, token string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -194,3 +268,152 @@ func (mr *MockTokenBlacklistRepositoryMockRecorder) IsBlacklisted(ctx, token any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlacklisted", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).IsBlacklisted), ctx, token)
 }
+
+// IsFamilyBlacklisted mocks base method.
+func (m *MockTokenBlacklistRepository) IsFamilyBlacklisted(ctx context.Context, familyID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsFamilyBlacklisted", ctx, familyID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsFamilyBlacklisted indicates an expected call of IsFamilyBlacklisted.
+func (mr *MockTokenBlacklistRepositoryMockRecorder) IsFamilyBlacklisted(ctx, familyID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsFamilyBlacklisted", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).IsFamilyBlacklisted), ctx, familyID)
+}
+
+// IsUserBlacklisted mocks base method.
+func (m *MockTokenBlacklistRepository) IsUserBlacklisted(ctx context.Context, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserBlacklisted", ctx, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserBlacklisted indicates an expected call of IsUserBlacklisted.
+func (mr *MockTokenBlacklistRepositoryMockRecorder) IsUserBlacklisted(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserBlacklisted", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).IsUserBlacklisted), ctx, userID)
+}
+
+// RemoveUserFromBlacklist mocks base method.
+func (m *MockTokenBlacklistRepository) RemoveUserFromBlacklist(ctx context.Context, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveUserFromBlacklist", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveUserFromBlacklist indicates an expected call of RemoveUserFromBlacklist.
+func (mr *MockTokenBlacklistRepositoryMockRecorder) RemoveUserFromBlacklist(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUserFromBlacklist", reflect.TypeOf((*MockTokenBlacklistRepository)(nil).RemoveUserFromBlacklist), ctx, userID)
+}
+
+// MockPubSubRepository is a mock of PubSubRepository interface.
+type MockPubSubRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPubSubRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPubSubRepositoryMockRecorder is the mock recorder for MockPubSubRepository.
+type MockPubSubRepositoryMockRecorder struct {
+	mock *MockPubSubRepository
+}
+
+// NewMockPubSubRepository creates a new mock instance.
+func NewMockPubSubRepository(ctrl *gomock.Controller) *MockPubSubRepository {
+	mock := &MockPubSubRepository{ctrl: ctrl}
+	mock.recorder = &MockPubSubRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPubSubRepository) EXPECT() *MockPubSubRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Publish mocks base method.
+func (m *MockPubSubRepository) Publish(ctx context.Context, channel, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Publish", ctx, channel, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Publish indicates an expected call of Publish.
+func (mr *MockPubSubRepositoryMockRecorder) Publish(ctx, channel, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Publish", reflect.TypeOf((*MockPubSubRepository)(nil).Publish), ctx, channel, message)
+}
+
+// Subscribe mocks base method.
+func (m *MockPubSubRepository) Subscribe(ctx context.Context, channel string) (repository.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, channel)
+	ret0, _ := ret[0].(repository.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockPubSubRepositoryMockRecorder) Subscribe(ctx, channel any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockPubSubRepository)(nil).Subscribe), ctx, channel)
+}
+
+// MockSubscription is a mock of Subscription interface.
+type MockSubscription struct {
+	ctrl     *gomock.Controller
+	recorder *MockSubscriptionMockRecorder
+	isgomock struct{}
+}
+
+// MockSubscriptionMockRecorder is the mock recorder for MockSubscription.
+type MockSubscriptionMockRecorder struct {
+	mock *MockSubscription
+}
+
+// NewMockSubscription creates a new mock instance.
+func NewMockSubscription(ctrl *gomock.Controller) *MockSubscription {
+	mock := &MockSubscription{ctrl: ctrl}
+	mock.recorder = &MockSubscriptionMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSubscription) EXPECT() *MockSubscriptionMockRecorder {
+	return m.recorder
+}
+
+// Close mocks base method.
+func (m *MockSubscription) Close() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Close")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Close indicates an expected call of Close.
+func (mr *MockSubscriptionMockRecorder) Close() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Close", reflect.TypeOf((*MockSubscription)(nil).Close))
+}
+
+// Messages mocks base method.
+func (m *MockSubscription) Messages() <-chan string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Messages")
+	ret0, _ := ret[0].(<-chan string)
+	return ret0
+}
+
+// Messages indicates an expected call of Messages.
+func (mr *MockSubscriptionMockRecorder) Messages() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Messages", reflect.TypeOf((*MockSubscription)(nil).Messages))
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/fumkob/ezqrin-server/internal/domain/entity"
 	repository "github.com/fumkob/ezqrin-server/internal/domain/repository"
@@ -43,32 +44,92 @@ func (m *MockCheckinRepository) EXPECT() *MockCheckinRepositoryMockRecorder {
 	return m.recorder
 }
 
-// Create mocks base method.
-func (m *MockCheckinRepository) Create(ctx context.Context, checkin *entity.Checkin) error {
+// BulkCreate mocks base method.
+func (m *MockCheckinRepository) BulkCreate(ctx context.Context, checkins []*entity.Checkin) ([]uuid.UUID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", ctx, checkin)
+	ret := m.ctrl.Call(m, "BulkCreate", ctx, checkins)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkCreate indicates an expected call of BulkCreate.
+func (mr *MockCheckinRepositoryMockRecorder) BulkCreate(ctx, checkins any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreate", reflect.TypeOf((*MockCheckinRepository)(nil).BulkCreate), ctx, checkins)
+}
+
+// Cancel mocks base method.
+func (m *MockCheckinRepository) Cancel(ctx context.Context, id, cancelledBy uuid.UUID, cancelledAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", ctx, id, cancelledBy, cancelledAt)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Create indicates an expected call of Create.
-func (mr *MockCheckinRepositoryMockRecorder) Create(ctx, checkin any) *gomock.Call {
+// Cancel indicates an expected call of Cancel.
+func (mr *MockCheckinRepositoryMockRecorder) Cancel(ctx, id, cancelledBy, cancelledAt any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCheckinRepository)(nil).Create), ctx, checkin)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockCheckinRepository)(nil).Cancel), ctx, id, cancelledBy, cancelledAt)
+}
+
+// CountByInterval mocks base method.
+func (m *MockCheckinRepository) CountByInterval(ctx context.Context, eventID uuid.UUID, interval time.Duration) ([]repository.CheckinIntervalCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountByInterval", ctx, eventID, interval)
+	ret0, _ := ret[0].([]repository.CheckinIntervalCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByInterval indicates an expected call of CountByInterval.
+func (mr *MockCheckinRepositoryMockRecorder) CountByInterval(ctx, eventID, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByInterval", reflect.TypeOf((*MockCheckinRepository)(nil).CountByInterval), ctx, eventID, interval)
 }
 
-// Delete mocks base method.
-func (m *MockCheckinRepository) Delete(ctx context.Context, id uuid.UUID) error {
+// CountByStaff mocks base method.
+func (m *MockCheckinRepository) CountByStaff(ctx context.Context, eventID uuid.UUID) (*repository.CheckinAttribution, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "CountByStaff", ctx, eventID)
+	ret0, _ := ret[0].(*repository.CheckinAttribution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountByStaff indicates an expected call of CountByStaff.
+func (mr *MockCheckinRepositoryMockRecorder) CountByStaff(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountByStaff", reflect.TypeOf((*MockCheckinRepository)(nil).CountByStaff), ctx, eventID)
+}
+
+// CountScans mocks base method.
+func (m *MockCheckinRepository) CountScans(ctx context.Context, eventID uuid.UUID, bucket time.Duration) ([]repository.ScanOutcomeCount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountScans", ctx, eventID, bucket)
+	ret0, _ := ret[0].([]repository.ScanOutcomeCount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountScans indicates an expected call of CountScans.
+func (mr *MockCheckinRepositoryMockRecorder) CountScans(ctx, eventID, bucket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountScans", reflect.TypeOf((*MockCheckinRepository)(nil).CountScans), ctx, eventID, bucket)
+}
+
+// Create mocks base method.
+func (m *MockCheckinRepository) Create(ctx context.Context, checkin *entity.Checkin) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, checkin)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete.
-func (mr *MockCheckinRepositoryMockRecorder) Delete(ctx, id any) *gomock.Call {
+// Create indicates an expected call of Create.
+func (mr *MockCheckinRepositoryMockRecorder) Create(ctx, checkin any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockCheckinRepository)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockCheckinRepository)(nil).Create), ctx, checkin)
 }
 
 // ExistsByParticipant mocks base method.
@@ -132,6 +193,21 @@ func (mr *MockCheckinRepositoryMockRecorder) FindByParticipant(ctx, participantI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByParticipant", reflect.TypeOf((*MockCheckinRepository)(nil).FindByParticipant), ctx, participantID)
 }
 
+// GetEventProgress mocks base method.
+func (m *MockCheckinRepository) GetEventProgress(ctx context.Context, eventID uuid.UUID) (*repository.CheckinProgress, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventProgress", ctx, eventID)
+	ret0, _ := ret[0].(*repository.CheckinProgress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventProgress indicates an expected call of GetEventProgress.
+func (mr *MockCheckinRepositoryMockRecorder) GetEventProgress(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventProgress", reflect.TypeOf((*MockCheckinRepository)(nil).GetEventProgress), ctx, eventID)
+}
+
 // GetEventStats mocks base method.
 func (m *MockCheckinRepository) GetEventStats(ctx context.Context, eventID uuid.UUID) (*repository.CheckinStats, error) {
 	m.ctrl.T.Helper()
@@ -147,6 +223,21 @@ func (mr *MockCheckinRepositoryMockRecorder) GetEventStats(ctx, eventID any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventStats", reflect.TypeOf((*MockCheckinRepository)(nil).GetEventStats), ctx, eventID)
 }
 
+// GetHistory mocks base method.
+func (m *MockCheckinRepository) GetHistory(ctx context.Context, participantID uuid.UUID) ([]*entity.Checkin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, participantID)
+	ret0, _ := ret[0].([]*entity.Checkin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockCheckinRepositoryMockRecorder) GetHistory(ctx, participantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockCheckinRepository)(nil).GetHistory), ctx, participantID)
+}
+
 // HealthCheck mocks base method.
 func (m *MockCheckinRepository) HealthCheck(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -160,3 +251,46 @@ func (mr *MockCheckinRepositoryMockRecorder) HealthCheck(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockCheckinRepository)(nil).HealthCheck), ctx)
 }
+
+// Reassign mocks base method.
+func (m *MockCheckinRepository) Reassign(ctx context.Context, fromParticipantID, toParticipantID, endedBy uuid.UUID, endedAt time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reassign", ctx, fromParticipantID, toParticipantID, endedBy, endedAt)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Reassign indicates an expected call of Reassign.
+func (mr *MockCheckinRepositoryMockRecorder) Reassign(ctx, fromParticipantID, toParticipantID, endedBy, endedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reassign", reflect.TypeOf((*MockCheckinRepository)(nil).Reassign), ctx, fromParticipantID, toParticipantID, endedBy, endedAt)
+}
+
+// RecordScan mocks base method.
+func (m *MockCheckinRepository) RecordScan(ctx context.Context, scan *entity.CheckinScan) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordScan", ctx, scan)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordScan indicates an expected call of RecordScan.
+func (mr *MockCheckinRepositoryMockRecorder) RecordScan(ctx, scan any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordScan", reflect.TypeOf((*MockCheckinRepository)(nil).RecordScan), ctx, scan)
+}
+
+// Restore mocks base method.
+func (m *MockCheckinRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockCheckinRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockCheckinRepository)(nil).Restore), ctx, id)
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/fumkob/ezqrin-server/internal/domain/entity"
 	repository "github.com/fumkob/ezqrin-server/internal/domain/repository"
@@ -43,6 +44,21 @@ func (m *MockEventRepository) EXPECT() *MockEventRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CountConfirmedParticipants mocks base method.
+func (m *MockEventRepository) CountConfirmedParticipants(ctx context.Context, id uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountConfirmedParticipants", ctx, id)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountConfirmedParticipants indicates an expected call of CountConfirmedParticipants.
+func (mr *MockEventRepositoryMockRecorder) CountConfirmedParticipants(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountConfirmedParticipants", reflect.TypeOf((*MockEventRepository)(nil).CountConfirmedParticipants), ctx, id)
+}
+
 // Create mocks base method.
 func (m *MockEventRepository) Create(ctx context.Context, event *entity.Event) error {
 	m.ctrl.T.Helper()
@@ -86,6 +102,96 @@ func (mr *MockEventRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockEventRepository)(nil).FindByID), ctx, id)
 }
 
+// FindByIDs mocks base method.
+func (m *MockEventRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByIDs", ctx, ids)
+	ret0, _ := ret[0].([]*entity.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByIDs indicates an expected call of FindByIDs.
+func (mr *MockEventRepositoryMockRecorder) FindByIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByIDs", reflect.TypeOf((*MockEventRepository)(nil).FindByIDs), ctx, ids)
+}
+
+// FindBySeriesID mocks base method.
+func (m *MockEventRepository) FindBySeriesID(ctx context.Context, seriesID uuid.UUID) ([]*entity.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBySeriesID", ctx, seriesID)
+	ret0, _ := ret[0].([]*entity.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBySeriesID indicates an expected call of FindBySeriesID.
+func (mr *MockEventRepositoryMockRecorder) FindBySeriesID(ctx, seriesID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBySeriesID", reflect.TypeOf((*MockEventRepository)(nil).FindBySeriesID), ctx, seriesID)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockEventRepository) FindDeletedByID(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockEventRepositoryMockRecorder) FindDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockEventRepository)(nil).FindDeletedByID), ctx, id)
+}
+
+// FindLogo mocks base method.
+func (m *MockEventRepository) FindLogo(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLogo", ctx, id)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLogo indicates an expected call of FindLogo.
+func (mr *MockEventRepositoryMockRecorder) FindLogo(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLogo", reflect.TypeOf((*MockEventRepository)(nil).FindLogo), ctx, id)
+}
+
+// FindPIIPurgeDue mocks base method.
+func (m *MockEventRepository) FindPIIPurgeDue(ctx context.Context, endedBefore time.Time, limit int) ([]*entity.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPIIPurgeDue", ctx, endedBefore, limit)
+	ret0, _ := ret[0].([]*entity.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPIIPurgeDue indicates an expected call of FindPIIPurgeDue.
+func (mr *MockEventRepositoryMockRecorder) FindPIIPurgeDue(ctx, endedBefore, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPIIPurgeDue", reflect.TypeOf((*MockEventRepository)(nil).FindPIIPurgeDue), ctx, endedBefore, limit)
+}
+
+// GetListLastModified mocks base method.
+func (m *MockEventRepository) GetListLastModified(ctx context.Context, filter repository.EventListFilter) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListLastModified", ctx, filter)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListLastModified indicates an expected call of GetListLastModified.
+func (mr *MockEventRepositoryMockRecorder) GetListLastModified(ctx, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListLastModified", reflect.TypeOf((*MockEventRepository)(nil).GetListLastModified), ctx, filter)
+}
+
 // GetStats mocks base method.
 func (m *MockEventRepository) GetStats(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +207,21 @@ func (mr *MockEventRepositoryMockRecorder) GetStats(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockEventRepository)(nil).GetStats), ctx, id)
 }
 
+// GetStatsBatch mocks base method.
+func (m *MockEventRepository) GetStatsBatch(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*repository.EventStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatsBatch", ctx, ids)
+	ret0, _ := ret[0].(map[uuid.UUID]*repository.EventStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatsBatch indicates an expected call of GetStatsBatch.
+func (mr *MockEventRepositoryMockRecorder) GetStatsBatch(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatsBatch", reflect.TypeOf((*MockEventRepository)(nil).GetStatsBatch), ctx, ids)
+}
+
 // HealthCheck mocks base method.
 func (m *MockEventRepository) HealthCheck(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -131,6 +252,65 @@ func (mr *MockEventRepositoryMockRecorder) List(ctx, filter, offset, limit any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockEventRepository)(nil).List), ctx, filter, offset, limit)
 }
 
+// ListCursor mocks base method.
+func (m *MockEventRepository) ListCursor(ctx context.Context, filter repository.EventListFilter, cursor string, limit int) ([]*entity.Event, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCursor", ctx, filter, cursor, limit)
+	ret0, _ := ret[0].([]*entity.Event)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// ListCursor indicates an expected call of ListCursor.
+func (mr *MockEventRepositoryMockRecorder) ListCursor(ctx, filter, cursor, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCursor", reflect.TypeOf((*MockEventRepository)(nil).ListCursor), ctx, filter, cursor, limit)
+}
+
+// MarkPIIPurged mocks base method.
+func (m *MockEventRepository) MarkPIIPurged(ctx context.Context, id uuid.UUID, purgedAt time.Time) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPIIPurged", ctx, id, purgedAt)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkPIIPurged indicates an expected call of MarkPIIPurged.
+func (mr *MockEventRepositoryMockRecorder) MarkPIIPurged(ctx, id, purgedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPIIPurged", reflect.TypeOf((*MockEventRepository)(nil).MarkPIIPurged), ctx, id, purgedAt)
+}
+
+// RecordPIIPurge mocks base method.
+func (m *MockEventRepository) RecordPIIPurge(ctx context.Context, purge *entity.PIIPurge) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPIIPurge", ctx, purge)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordPIIPurge indicates an expected call of RecordPIIPurge.
+func (mr *MockEventRepositoryMockRecorder) RecordPIIPurge(ctx, purge any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPIIPurge", reflect.TypeOf((*MockEventRepository)(nil).RecordPIIPurge), ctx, purge)
+}
+
+// Restore mocks base method.
+func (m *MockEventRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockEventRepositoryMockRecorder) Restore(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockEventRepository)(nil).Restore), ctx, id)
+}
+
 // Update mocks base method.
 func (m *MockEventRepository) Update(ctx context.Context, event *entity.Event) error {
 	m.ctrl.T.Helper()
@@ -144,3 +324,17 @@ func (mr *MockEventRepositoryMockRecorder) Update(ctx, event any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockEventRepository)(nil).Update), ctx, event)
 }
+
+// UpdateLogo mocks base method.
+func (m *MockEventRepository) UpdateLogo(ctx context.Context, id uuid.UUID, logo []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLogo", ctx, id, logo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLogo indicates an expected call of UpdateLogo.
+func (mr *MockEventRepositoryMockRecorder) UpdateLogo(ctx, id, logo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogo", reflect.TypeOf((*MockEventRepository)(nil).UpdateLogo), ctx, id, logo)
+}
@@ -0,0 +1,101 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/fumkob/ezqrin-server/internal/domain/repository (interfaces: EventWebhookRepository)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_event_webhook_repository.go -package=mocks . EventWebhookRepository
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	entity "github.com/fumkob/ezqrin-server/internal/domain/entity"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockEventWebhookRepository is a mock of EventWebhookRepository interface.
+type MockEventWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockEventWebhookRepositoryMockRecorder is the mock recorder for MockEventWebhookRepository.
+type MockEventWebhookRepositoryMockRecorder struct {
+	mock *MockEventWebhookRepository
+}
+
+// NewMockEventWebhookRepository creates a new mock instance.
+func NewMockEventWebhookRepository(ctrl *gomock.Controller) *MockEventWebhookRepository {
+	mock := &MockEventWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockEventWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventWebhookRepository) EXPECT() *MockEventWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockEventWebhookRepository) Delete(ctx context.Context, eventID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, eventID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockEventWebhookRepositoryMockRecorder) Delete(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockEventWebhookRepository)(nil).Delete), ctx, eventID)
+}
+
+// FindByEventID mocks base method.
+func (m *MockEventWebhookRepository) FindByEventID(ctx context.Context, eventID uuid.UUID) (*entity.EventWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEventID", ctx, eventID)
+	ret0, _ := ret[0].(*entity.EventWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEventID indicates an expected call of FindByEventID.
+func (mr *MockEventWebhookRepositoryMockRecorder) FindByEventID(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEventID", reflect.TypeOf((*MockEventWebhookRepository)(nil).FindByEventID), ctx, eventID)
+}
+
+// RecordDelivery mocks base method.
+func (m *MockEventWebhookRepository) RecordDelivery(ctx context.Context, eventID uuid.UUID, at time.Time, status entity.WebhookDeliveryStatus, deliveryErr string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordDelivery", ctx, eventID, at, status, deliveryErr)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordDelivery indicates an expected call of RecordDelivery.
+func (mr *MockEventWebhookRepositoryMockRecorder) RecordDelivery(ctx, eventID, at, status, deliveryErr any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordDelivery", reflect.TypeOf((*MockEventWebhookRepository)(nil).RecordDelivery), ctx, eventID, at, status, deliveryErr)
+}
+
+// Upsert mocks base method.
+func (m *MockEventWebhookRepository) Upsert(ctx context.Context, webhook *entity.EventWebhook) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Upsert", ctx, webhook)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Upsert indicates an expected call of Upsert.
+func (mr *MockEventWebhookRepositoryMockRecorder) Upsert(ctx, webhook any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upsert", reflect.TypeOf((*MockEventWebhookRepository)(nil).Upsert), ctx, webhook)
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/fumkob/ezqrin-server/internal/domain/entity"
 	repository "github.com/fumkob/ezqrin-server/internal/domain/repository"
@@ -43,6 +44,50 @@ func (m *MockParticipantRepository) EXPECT() *MockParticipantRepositoryMockRecor
 	return m.recorder
 }
 
+// AcceptInvitation mocks base method.
+func (m *MockParticipantRepository) AcceptInvitation(ctx context.Context, id uuid.UUID, qrCode string, acceptedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvitation", ctx, id, qrCode, acceptedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptInvitation indicates an expected call of AcceptInvitation.
+func (mr *MockParticipantRepositoryMockRecorder) AcceptInvitation(ctx, id, qrCode, acceptedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvitation", reflect.TypeOf((*MockParticipantRepository)(nil).AcceptInvitation), ctx, id, qrCode, acceptedAt)
+}
+
+// AnonymizeByEventID mocks base method.
+func (m *MockParticipantRepository) AnonymizeByEventID(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AnonymizeByEventID", ctx, eventID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AnonymizeByEventID indicates an expected call of AnonymizeByEventID.
+func (mr *MockParticipantRepositoryMockRecorder) AnonymizeByEventID(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AnonymizeByEventID", reflect.TypeOf((*MockParticipantRepository)(nil).AnonymizeByEventID), ctx, eventID)
+}
+
+// AssignGroup mocks base method.
+func (m *MockParticipantRepository) AssignGroup(ctx context.Context, eventID uuid.UUID, ids []uuid.UUID, groupName *string) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignGroup", ctx, eventID, ids, groupName)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignGroup indicates an expected call of AssignGroup.
+func (mr *MockParticipantRepositoryMockRecorder) AssignGroup(ctx, eventID, ids, groupName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignGroup", reflect.TypeOf((*MockParticipantRepository)(nil).AssignGroup), ctx, eventID, ids, groupName)
+}
+
 // BulkCreate mocks base method.
 func (m *MockParticipantRepository) BulkCreate(ctx context.Context, participants []*entity.Participant) error {
 	m.ctrl.T.Helper()
@@ -57,6 +102,21 @@ func (mr *MockParticipantRepositoryMockRecorder) BulkCreate(ctx, participants an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreate", reflect.TypeOf((*MockParticipantRepository)(nil).BulkCreate), ctx, participants)
 }
 
+// CountConfirmed mocks base method.
+func (m *MockParticipantRepository) CountConfirmed(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountConfirmed", ctx, eventID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountConfirmed indicates an expected call of CountConfirmed.
+func (mr *MockParticipantRepositoryMockRecorder) CountConfirmed(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountConfirmed", reflect.TypeOf((*MockParticipantRepository)(nil).CountConfirmed), ctx, eventID)
+}
+
 // Create mocks base method.
 func (m *MockParticipantRepository) Create(ctx context.Context, participant *entity.Participant) error {
 	m.ctrl.T.Helper()
@@ -71,6 +131,34 @@ func (mr *MockParticipantRepositoryMockRecorder) Create(ctx, participant any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockParticipantRepository)(nil).Create), ctx, participant)
 }
 
+// CreateOrWaitlist mocks base method.
+func (m *MockParticipantRepository) CreateOrWaitlist(ctx context.Context, participant *entity.Participant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrWaitlist", ctx, participant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateOrWaitlist indicates an expected call of CreateOrWaitlist.
+func (mr *MockParticipantRepositoryMockRecorder) CreateOrWaitlist(ctx, participant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrWaitlist", reflect.TypeOf((*MockParticipantRepository)(nil).CreateOrWaitlist), ctx, participant)
+}
+
+// CreateWithinCapacity mocks base method.
+func (m *MockParticipantRepository) CreateWithinCapacity(ctx context.Context, participant *entity.Participant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWithinCapacity", ctx, participant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateWithinCapacity indicates an expected call of CreateWithinCapacity.
+func (mr *MockParticipantRepositoryMockRecorder) CreateWithinCapacity(ctx, participant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWithinCapacity", reflect.TypeOf((*MockParticipantRepository)(nil).CreateWithinCapacity), ctx, participant)
+}
+
 // Delete mocks base method.
 func (m *MockParticipantRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -85,6 +173,21 @@ func (mr *MockParticipantRepositoryMockRecorder) Delete(ctx, id any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockParticipantRepository)(nil).Delete), ctx, id)
 }
 
+// ExistingQRCodes mocks base method.
+func (m *MockParticipantRepository) ExistingQRCodes(ctx context.Context, qrCodes []string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExistingQRCodes", ctx, qrCodes)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExistingQRCodes indicates an expected call of ExistingQRCodes.
+func (mr *MockParticipantRepositoryMockRecorder) ExistingQRCodes(ctx, qrCodes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistingQRCodes", reflect.TypeOf((*MockParticipantRepository)(nil).ExistingQRCodes), ctx, qrCodes)
+}
+
 // ExistsByEmail mocks base method.
 func (m *MockParticipantRepository) ExistsByEmail(ctx context.Context, eventID uuid.UUID, email string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -100,6 +203,21 @@ func (mr *MockParticipantRepositoryMockRecorder) ExistsByEmail(ctx, eventID, ema
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsByEmail", reflect.TypeOf((*MockParticipantRepository)(nil).ExistsByEmail), ctx, eventID, email)
 }
 
+// ExpireDue mocks base method.
+func (m *MockParticipantRepository) ExpireDue(ctx context.Context, now time.Time, limit int) ([]repository.ParticipantExpiry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireDue", ctx, now, limit)
+	ret0, _ := ret[0].([]repository.ParticipantExpiry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireDue indicates an expected call of ExpireDue.
+func (mr *MockParticipantRepositoryMockRecorder) ExpireDue(ctx, now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireDue", reflect.TypeOf((*MockParticipantRepository)(nil).ExpireDue), ctx, now, limit)
+}
+
 // FindAllByEventID mocks base method.
 func (m *MockParticipantRepository) FindAllByEventID(ctx context.Context, eventID uuid.UUID) ([]*entity.Participant, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +233,21 @@ func (mr *MockParticipantRepositoryMockRecorder) FindAllByEventID(ctx, eventID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllByEventID", reflect.TypeOf((*MockParticipantRepository)(nil).FindAllByEventID), ctx, eventID)
 }
 
+// FindByEmailAcrossOrganizer mocks base method.
+func (m *MockParticipantRepository) FindByEmailAcrossOrganizer(ctx context.Context, organizerID *uuid.UUID, email string) ([]*entity.Participant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEmailAcrossOrganizer", ctx, organizerID, email)
+	ret0, _ := ret[0].([]*entity.Participant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEmailAcrossOrganizer indicates an expected call of FindByEmailAcrossOrganizer.
+func (mr *MockParticipantRepositoryMockRecorder) FindByEmailAcrossOrganizer(ctx, organizerID, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEmailAcrossOrganizer", reflect.TypeOf((*MockParticipantRepository)(nil).FindByEmailAcrossOrganizer), ctx, organizerID, email)
+}
+
 // FindByEmployeeID mocks base method.
 func (m *MockParticipantRepository) FindByEmployeeID(ctx context.Context, eventID uuid.UUID, employeeID string) (*entity.Participant, error) {
 	m.ctrl.T.Helper()
@@ -146,6 +279,22 @@ func (mr *MockParticipantRepositoryMockRecorder) FindByEventID(ctx, eventID, off
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEventID", reflect.TypeOf((*MockParticipantRepository)(nil).FindByEventID), ctx, eventID, offset, limit)
 }
 
+// FindByFilter mocks base method.
+func (m *MockParticipantRepository) FindByFilter(ctx context.Context, filter repository.ParticipantListFilter, offset, limit int) ([]*entity.Participant, int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByFilter", ctx, filter, offset, limit)
+	ret0, _ := ret[0].([]*entity.Participant)
+	ret1, _ := ret[1].(int64)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByFilter indicates an expected call of FindByFilter.
+func (mr *MockParticipantRepositoryMockRecorder) FindByFilter(ctx, filter, offset, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByFilter", reflect.TypeOf((*MockParticipantRepository)(nil).FindByFilter), ctx, filter, offset, limit)
+}
+
 // FindByID mocks base method.
 func (m *MockParticipantRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Participant, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +340,51 @@ func (mr *MockParticipantRepositoryMockRecorder) FindByQRCode(ctx, qrCode any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByQRCode", reflect.TypeOf((*MockParticipantRepository)(nil).FindByQRCode), ctx, qrCode)
 }
 
+// FindChangesSince mocks base method.
+func (m *MockParticipantRepository) FindChangesSince(ctx context.Context, eventID uuid.UUID, since time.Time) (*repository.ParticipantChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindChangesSince", ctx, eventID, since)
+	ret0, _ := ret[0].(*repository.ParticipantChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindChangesSince indicates an expected call of FindChangesSince.
+func (mr *MockParticipantRepositoryMockRecorder) FindChangesSince(ctx, eventID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindChangesSince", reflect.TypeOf((*MockParticipantRepository)(nil).FindChangesSince), ctx, eventID, since)
+}
+
+// FindDeletedByID mocks base method.
+func (m *MockParticipantRepository) FindDeletedByID(ctx context.Context, id uuid.UUID) (*entity.Participant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeletedByID", ctx, id)
+	ret0, _ := ret[0].(*entity.Participant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeletedByID indicates an expected call of FindDeletedByID.
+func (mr *MockParticipantRepositoryMockRecorder) FindDeletedByID(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeletedByID", reflect.TypeOf((*MockParticipantRepository)(nil).FindDeletedByID), ctx, id)
+}
+
+// GetListLastModified mocks base method.
+func (m *MockParticipantRepository) GetListLastModified(ctx context.Context, eventID uuid.UUID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListLastModified", ctx, eventID)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListLastModified indicates an expected call of GetListLastModified.
+func (mr *MockParticipantRepositoryMockRecorder) GetListLastModified(ctx, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListLastModified", reflect.TypeOf((*MockParticipantRepository)(nil).GetListLastModified), ctx, eventID)
+}
+
 // GetPaymentStats mocks base method.
 func (m *MockParticipantRepository) GetPaymentStats(ctx context.Context, eventID uuid.UUID) (*repository.ParticipantPaymentStats, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +414,76 @@ func (mr *MockParticipantRepositoryMockRecorder) HealthCheck(ctx any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HealthCheck", reflect.TypeOf((*MockParticipantRepository)(nil).HealthCheck), ctx)
 }
 
+// MarkInviteSent mocks base method.
+func (m *MockParticipantRepository) MarkInviteSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkInviteSent", ctx, id, sentAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkInviteSent indicates an expected call of MarkInviteSent.
+func (mr *MockParticipantRepositoryMockRecorder) MarkInviteSent(ctx, id, sentAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkInviteSent", reflect.TypeOf((*MockParticipantRepository)(nil).MarkInviteSent), ctx, id, sentAt)
+}
+
+// Merge mocks base method.
+func (m *MockParticipantRepository) Merge(ctx context.Context, primaryID, duplicateID uuid.UUID, mergedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, primaryID, duplicateID, mergedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockParticipantRepositoryMockRecorder) Merge(ctx, primaryID, duplicateID, mergedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockParticipantRepository)(nil).Merge), ctx, primaryID, duplicateID, mergedAt)
+}
+
+// Promote mocks base method.
+func (m *MockParticipantRepository) Promote(ctx context.Context, id uuid.UUID, promotedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Promote", ctx, id, promotedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Promote indicates an expected call of Promote.
+func (mr *MockParticipantRepositoryMockRecorder) Promote(ctx, id, promotedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Promote", reflect.TypeOf((*MockParticipantRepository)(nil).Promote), ctx, id, promotedAt)
+}
+
+// RecordConsent mocks base method.
+func (m *MockParticipantRepository) RecordConsent(ctx context.Context, id uuid.UUID, version string, acceptedAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordConsent", ctx, id, version, acceptedAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordConsent indicates an expected call of RecordConsent.
+func (mr *MockParticipantRepositoryMockRecorder) RecordConsent(ctx, id, version, acceptedAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordConsent", reflect.TypeOf((*MockParticipantRepository)(nil).RecordConsent), ctx, id, version, acceptedAt)
+}
+
+// Restore mocks base method.
+func (m *MockParticipantRepository) Restore(ctx context.Context, id uuid.UUID, waitlist bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id, waitlist)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockParticipantRepositoryMockRecorder) Restore(ctx, id, waitlist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockParticipantRepository)(nil).Restore), ctx, id, waitlist)
+}
+
 // Search mocks base method.
 func (m *MockParticipantRepository) Search(ctx context.Context, eventID uuid.UUID, query string, offset, limit int) ([]*entity.Participant, int64, error) {
 	m.ctrl.T.Helper()
@@ -236,6 +500,21 @@ func (mr *MockParticipantRepositoryMockRecorder) Search(ctx, eventID, query, off
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockParticipantRepository)(nil).Search), ctx, eventID, query, offset, limit)
 }
 
+// SuggestByName mocks base method.
+func (m *MockParticipantRepository) SuggestByName(ctx context.Context, eventID uuid.UUID, prefix string, limit int) ([]repository.ParticipantSuggestion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestByName", ctx, eventID, prefix, limit)
+	ret0, _ := ret[0].([]repository.ParticipantSuggestion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuggestByName indicates an expected call of SuggestByName.
+func (mr *MockParticipantRepositoryMockRecorder) SuggestByName(ctx, eventID, prefix, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestByName", reflect.TypeOf((*MockParticipantRepository)(nil).SuggestByName), ctx, eventID, prefix, limit)
+}
+
 // Update mocks base method.
 func (m *MockParticipantRepository) Update(ctx context.Context, participant *entity.Participant) error {
 	m.ctrl.T.Helper()
@@ -249,3 +528,76 @@ func (mr *MockParticipantRepositoryMockRecorder) Update(ctx, participant any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockParticipantRepository)(nil).Update), ctx, participant)
 }
+
+// UpdatePayment mocks base method.
+func (m *MockParticipantRepository) UpdatePayment(ctx context.Context, participant *entity.Participant, from entity.PaymentStatus) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePayment", ctx, participant, from)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePayment indicates an expected call of UpdatePayment.
+func (mr *MockParticipantRepositoryMockRecorder) UpdatePayment(ctx, participant, from any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePayment", reflect.TypeOf((*MockParticipantRepository)(nil).UpdatePayment), ctx, participant, from)
+}
+
+// UpdateStatus mocks base method.
+func (m *MockParticipantRepository) UpdateStatus(ctx context.Context, eventID uuid.UUID, ids []uuid.UUID, status entity.ParticipantStatus) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatus", ctx, eventID, ids, status)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatus indicates an expected call of UpdateStatus.
+func (mr *MockParticipantRepositoryMockRecorder) UpdateStatus(ctx, eventID, ids, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatus", reflect.TypeOf((*MockParticipantRepository)(nil).UpdateStatus), ctx, eventID, ids, status)
+}
+
+// UpdateStatusWithinCapacity mocks base method.
+func (m *MockParticipantRepository) UpdateStatusWithinCapacity(ctx context.Context, eventID uuid.UUID, ids []uuid.UUID, status entity.ParticipantStatus) ([]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStatusWithinCapacity", ctx, eventID, ids, status)
+	ret0, _ := ret[0].([]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStatusWithinCapacity indicates an expected call of UpdateStatusWithinCapacity.
+func (mr *MockParticipantRepositoryMockRecorder) UpdateStatusWithinCapacity(ctx, eventID, ids, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStatusWithinCapacity", reflect.TypeOf((*MockParticipantRepository)(nil).UpdateStatusWithinCapacity), ctx, eventID, ids, status)
+}
+
+// UpdateTags mocks base method.
+func (m *MockParticipantRepository) UpdateTags(ctx context.Context, eventID uuid.UUID, filter repository.ParticipantTagFilter, add, remove []string, maxTags int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTags", ctx, eventID, filter, add, remove, maxTags)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTags indicates an expected call of UpdateTags.
+func (mr *MockParticipantRepositoryMockRecorder) UpdateTags(ctx, eventID, filter, add, remove, maxTags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTags", reflect.TypeOf((*MockParticipantRepository)(nil).UpdateTags), ctx, eventID, filter, add, remove, maxTags)
+}
+
+// UpdateWithinCapacity mocks base method.
+func (m *MockParticipantRepository) UpdateWithinCapacity(ctx context.Context, participant *entity.Participant) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWithinCapacity", ctx, participant)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWithinCapacity indicates an expected call of UpdateWithinCapacity.
+func (mr *MockParticipantRepositoryMockRecorder) UpdateWithinCapacity(ctx, participant any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithinCapacity", reflect.TypeOf((*MockParticipantRepository)(nil).UpdateWithinCapacity), ctx, participant)
+}
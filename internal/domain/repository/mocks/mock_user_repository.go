@@ -12,8 +12,10 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/fumkob/ezqrin-server/internal/domain/entity"
+	repository "github.com/fumkob/ezqrin-server/internal/domain/repository"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
 )
@@ -42,6 +44,51 @@ func (m *MockUserRepository) EXPECT() *MockUserRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ConsumeBackupCode mocks base method.
+func (m *MockUserRepository) ConsumeBackupCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeBackupCode", ctx, userID, codeHash)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeBackupCode indicates an expected call of ConsumeBackupCode.
+func (mr *MockUserRepositoryMockRecorder) ConsumeBackupCode(ctx, userID, codeHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeBackupCode", reflect.TypeOf((*MockUserRepository)(nil).ConsumeBackupCode), ctx, userID, codeHash)
+}
+
+// ConsumeTwoFactorStep mocks base method.
+func (m *MockUserRepository) ConsumeTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeTwoFactorStep", ctx, userID, step)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeTwoFactorStep indicates an expected call of ConsumeTwoFactorStep.
+func (mr *MockUserRepositoryMockRecorder) ConsumeTwoFactorStep(ctx, userID, step any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeTwoFactorStep", reflect.TypeOf((*MockUserRepository)(nil).ConsumeTwoFactorStep), ctx, userID, step)
+}
+
+// CountActiveAdmins mocks base method.
+func (m *MockUserRepository) CountActiveAdmins(ctx context.Context) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountActiveAdmins", ctx)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountActiveAdmins indicates an expected call of CountActiveAdmins.
+func (mr *MockUserRepositoryMockRecorder) CountActiveAdmins(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountActiveAdmins", reflect.TypeOf((*MockUserRepository)(nil).CountActiveAdmins), ctx)
+}
+
 // Create mocks base method.
 func (m *MockUserRepository) Create(ctx context.Context, user *entity.User) error {
 	m.ctrl.T.Helper()
@@ -56,6 +103,20 @@ func (mr *MockUserRepositoryMockRecorder) Create(ctx, user any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockUserRepository)(nil).Create), ctx, user)
 }
 
+// EnableTwoFactor mocks base method.
+func (m *MockUserRepository) EnableTwoFactor(ctx context.Context, userID uuid.UUID, backupCodeHashes []string, step int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableTwoFactor", ctx, userID, backupCodeHashes, step)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableTwoFactor indicates an expected call of EnableTwoFactor.
+func (mr *MockUserRepositoryMockRecorder) EnableTwoFactor(ctx, userID, backupCodeHashes, step any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableTwoFactor", reflect.TypeOf((*MockUserRepository)(nil).EnableTwoFactor), ctx, userID, backupCodeHashes, step)
+}
+
 // ExistsByEmail mocks base method.
 func (m *MockUserRepository) ExistsByEmail(ctx context.Context, email string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +177,21 @@ func (mr *MockUserRepositoryMockRecorder) FindByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByID", reflect.TypeOf((*MockUserRepository)(nil).FindByID), ctx, id)
 }
 
+// FindTwoFactor mocks base method.
+func (m *MockUserRepository) FindTwoFactor(ctx context.Context, userID uuid.UUID) (*entity.UserTwoFactor, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindTwoFactor", ctx, userID)
+	ret0, _ := ret[0].(*entity.UserTwoFactor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindTwoFactor indicates an expected call of FindTwoFactor.
+func (mr *MockUserRepositoryMockRecorder) FindTwoFactor(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindTwoFactor", reflect.TypeOf((*MockUserRepository)(nil).FindTwoFactor), ctx, userID)
+}
+
 // HealthCheck mocks base method.
 func (m *MockUserRepository) HealthCheck(ctx context.Context) error {
 	m.ctrl.T.Helper()
@@ -131,9 +207,9 @@ func (mr *MockUserRepositoryMockRecorder) HealthCheck(ctx any) *gomock.Call {
 }
 
 // List mocks base method.
-func (m *MockUserRepository) List(ctx context.Context, offset, limit int) ([]*entity.User, int64, error) {
+func (m *MockUserRepository) List(ctx context.Context, filter repository.UserListFilter, offset, limit int) ([]*entity.User, int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "List", ctx, offset, limit)
+	ret := m.ctrl.Call(m, "List", ctx, filter, offset, limit)
 	ret0, _ := ret[0].([]*entity.User)
 	ret1, _ := ret[1].(int64)
 	ret2, _ := ret[2].(error)
@@ -141,9 +217,38 @@ func (m *MockUserRepository) List(ctx context.Context, offset, limit int) ([]*en
 }
 
 // List indicates an expected call of List.
-func (mr *MockUserRepositoryMockRecorder) List(ctx, offset, limit any) *gomock.Call {
+func (mr *MockUserRepositoryMockRecorder) List(ctx, filter, offset, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), ctx, offset, limit)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUserRepository)(nil).List), ctx, filter, offset, limit)
+}
+
+// MarkEmailVerified mocks base method.
+func (m *MockUserRepository) MarkEmailVerified(ctx context.Context, id uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkEmailVerified", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkEmailVerified indicates an expected call of MarkEmailVerified.
+func (mr *MockUserRepositoryMockRecorder) MarkEmailVerified(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkEmailVerified", reflect.TypeOf((*MockUserRepository)(nil).MarkEmailVerified), ctx, id)
+}
+
+// RecordTwoFactorFailure mocks base method.
+func (m *MockUserRepository) RecordTwoFactorFailure(ctx context.Context, userID uuid.UUID, windowStart time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordTwoFactorFailure", ctx, userID, windowStart)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordTwoFactorFailure indicates an expected call of RecordTwoFactorFailure.
+func (mr *MockUserRepositoryMockRecorder) RecordTwoFactorFailure(ctx, userID, windowStart any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordTwoFactorFailure", reflect.TypeOf((*MockUserRepository)(nil).RecordTwoFactorFailure), ctx, userID, windowStart)
 }
 
 // SoftDelete mocks base method.
@@ -160,6 +265,20 @@ func (mr *MockUserRepositoryMockRecorder) SoftDelete(ctx, id, deletedBy any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SoftDelete", reflect.TypeOf((*MockUserRepository)(nil).SoftDelete), ctx, id, deletedBy)
 }
 
+// StartTwoFactorEnrollment mocks base method.
+func (m *MockUserRepository) StartTwoFactorEnrollment(ctx context.Context, userID uuid.UUID, encryptedSecret string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTwoFactorEnrollment", ctx, userID, encryptedSecret)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartTwoFactorEnrollment indicates an expected call of StartTwoFactorEnrollment.
+func (mr *MockUserRepositoryMockRecorder) StartTwoFactorEnrollment(ctx, userID, encryptedSecret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTwoFactorEnrollment", reflect.TypeOf((*MockUserRepository)(nil).StartTwoFactorEnrollment), ctx, userID, encryptedSecret)
+}
+
 // Update mocks base method.
 func (m *MockUserRepository) Update(ctx context.Context, user *entity.User) error {
 	m.ctrl.T.Helper()
@@ -173,3 +292,17 @@ func (mr *MockUserRepositoryMockRecorder) Update(ctx, user any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUserRepository)(nil).Update), ctx, user)
 }
+
+// UpdateAccess mocks base method.
+func (m *MockUserRepository) UpdateAccess(ctx context.Context, user *entity.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccess", ctx, user)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAccess indicates an expected call of UpdateAccess.
+func (mr *MockUserRepositoryMockRecorder) UpdateAccess(ctx, user any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccess", reflect.TypeOf((*MockUserRepository)(nil).UpdateAccess), ctx, user)
+}
@@ -0,0 +1,41 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=mocks/mock_outbox_repository.go -package=mocks . OutboxRepository
+
+// OutboxRepository defines the interface for transactional outbox persistence operations.
+type OutboxRepository interface {
+	// Enqueue stores a message for delivery.
+	// It joins the transaction in ctx, if any, so the message is only stored
+	// when the state change it describes is committed.
+	Enqueue(ctx context.Context, msg *entity.OutboxMessage) error
+
+	// ClaimDue claims up to limit messages that are due for delivery at now.
+	// Claimed messages have their attempt count incremented and their next attempt
+	// pushed to now+lease, so a relay that crashes mid-delivery leaves them to be
+	// retried once the lease expires. Concurrent relays never claim the same message.
+	ClaimDue(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*entity.OutboxMessage, error)
+
+	// MarkDelivered records that a message was delivered.
+	MarkDelivered(ctx context.Context, id uuid.UUID, deliveredAt time.Time) error
+
+	// ScheduleRetry records a failed delivery attempt and when to try again, together with the
+	// destinations that accepted the message so far, which the retry skips.
+	ScheduleRetry(
+		ctx context.Context,
+		id uuid.UUID,
+		nextAttemptAt time.Time,
+		lastError string,
+		deliveredTo []string,
+	) error
+
+	// MarkFailed records that delivery of a message was abandoned.
+	MarkFailed(ctx context.Context, id uuid.UUID, failedAt time.Time, lastError string) error
+}
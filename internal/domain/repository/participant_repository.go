@@ -2,8 +2,10 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -13,7 +15,19 @@ import (
 type ParticipantListFilter struct {
 	EventID *uuid.UUID
 	Status  *entity.ParticipantStatus
-	Search  string // Search by name, email, or employee_id
+	Search  string  // Search by name, email, or employee_id
+	Group   *string // Participants seated at this group
+	// IncludeDeleted lists soft deleted participants along with live ones.
+	IncludeDeleted bool
+}
+
+// ParticipantTagFilter selects the participants of an event for a bulk tag update.
+// Set fields are combined with AND; an empty filter selects every participant of the event.
+type ParticipantTagFilter struct {
+	IDs           []uuid.UUID
+	Status        *entity.ParticipantStatus
+	PaymentStatus *entity.PaymentStatus
+	Tag           *string // Participants that already carry this tag
 }
 
 // ParticipantRepository defines the interface for participant data persistence operations.
@@ -23,6 +37,17 @@ type ParticipantRepository interface {
 	// Create creates a new participant in the database.
 	Create(ctx context.Context, participant *entity.Participant) error
 
+	// CreateOrWaitlist creates a participant like Create, unless the participant is confirmed
+	// and their event already has as many confirmed participants as its capacity: then they are
+	// created waitlisted instead, which participant.Status reflects. Concurrent creations never
+	// confirm more participants than the capacity.
+	CreateOrWaitlist(ctx context.Context, participant *entity.Participant) error
+
+	// CreateWithinCapacity creates a participant like Create, but returns a conflict error if
+	// the participant is confirmed and their event already has as many confirmed participants
+	// as its capacity. Concurrent creations never confirm more participants than the capacity.
+	CreateWithinCapacity(ctx context.Context, participant *entity.Participant) error
+
 	// BulkCreate creates multiple participants in the database with optimized performance.
 	BulkCreate(ctx context.Context, participants []*entity.Participant) error
 
@@ -30,6 +55,10 @@ type ParticipantRepository interface {
 	// Returns ErrNotFound if the participant does not exist.
 	FindByID(ctx context.Context, id uuid.UUID) (*entity.Participant, error)
 
+	// FindDeletedByID retrieves a soft deleted participant by its unique ID.
+	// Returns ErrNotFound if the participant does not exist or is not deleted.
+	FindDeletedByID(ctx context.Context, id uuid.UUID) (*entity.Participant, error)
+
 	// FindByIDs retrieves multiple participants by their IDs in a single query.
 	// IDs not found in the database are silently omitted from the result.
 	// The order of returned participants is not guaranteed.
@@ -39,6 +68,14 @@ type ParticipantRepository interface {
 	// Returns the participants and the total count of participants for the event.
 	FindByEventID(ctx context.Context, eventID uuid.UUID, offset, limit int) ([]*entity.Participant, int64, error)
 
+	// FindByFilter retrieves paginated participants matching every set field of filter.
+	// Returns the participants and the total count of matching participants.
+	FindByFilter(
+		ctx context.Context,
+		filter ParticipantListFilter,
+		offset, limit int,
+	) ([]*entity.Participant, int64, error)
+
 	// FindAllByEventID retrieves all participants for an event without pagination.
 	// Used for CSV export. Returns participants ordered by created_at ASC.
 	FindAllByEventID(ctx context.Context, eventID uuid.UUID) ([]*entity.Participant, error)
@@ -47,19 +84,130 @@ type ParticipantRepository interface {
 	// Returns ErrNotFound if no participant has the given QR code.
 	FindByQRCode(ctx context.Context, qrCode string) (*entity.Participant, error)
 
+	// ExistingQRCodes returns the subset of the given QR codes that are already assigned to participants.
+	ExistingQRCodes(ctx context.Context, qrCodes []string) ([]string, error)
+
 	// FindByEmployeeID retrieves a participant by their employee ID within an event.
 	// Returns ErrNotFound if no participant has the given employee ID in that event.
 	FindByEmployeeID(ctx context.Context, eventID uuid.UUID, employeeID string) (*entity.Participant, error)
 
-	// Update updates an existing participant's information.
+	// FindByEmailAcrossOrganizer retrieves the participants with the given email in every event
+	// of an organizer, at most one per event. A nil organizerID searches all events.
+	// Returns participants ordered by created_at DESC.
+	FindByEmailAcrossOrganizer(
+		ctx context.Context,
+		organizerID *uuid.UUID,
+		email string,
+	) ([]*entity.Participant, error)
+
+	// Update updates an existing participant's information. It joins the transaction in ctx, if any.
 	// Returns ErrNotFound if the participant does not exist.
 	Update(ctx context.Context, participant *entity.Participant) error
 
-	// Delete deletes a participant from the database.
+	// UpdateWithinCapacity updates a participant like Update, but returns a conflict error if
+	// the participant is moved to confirmed and their event already has as many confirmed
+	// participants as its capacity. Concurrent updates never confirm more participants than the
+	// capacity.
+	UpdateWithinCapacity(ctx context.Context, participant *entity.Participant) error
+
+	// UpdatePayment sets the payment status, amount and date of a participant, leaving their other
+	// fields as they are, if their payment status is still from. It joins the transaction in ctx,
+	// if any. Returns a conflict error if the payment status changed, as when a concurrent payment
+	// was recorded first.
+	UpdatePayment(ctx context.Context, participant *entity.Participant, from entity.PaymentStatus) error
+
+	// AcceptInvitation moves an invited participant to confirmed and assigns their QR code.
+	// Returns a conflict error if the participant is no longer in invited status or their event
+	// has as many confirmed participants as its capacity.
+	AcceptInvitation(ctx context.Context, id uuid.UUID, qrCode string, acceptedAt time.Time) error
+
+	// Promote moves a waitlisted participant to confirmed.
+	// Returns ErrNotFound if the participant does not exist, and a conflict error if they are
+	// no longer waitlisted or their event has as many confirmed participants as its capacity.
+	Promote(ctx context.Context, id uuid.UUID, promotedAt time.Time) error
+
+	// CountConfirmed returns the number of confirmed participants of an event, the places
+	// taken from its capacity.
+	CountConfirmed(ctx context.Context, eventID uuid.UUID) (int64, error)
+
+	// RecordConsent stamps the version of the consent terms a participant accepted and when.
+	// Returns ErrNotFound if the participant does not exist.
+	RecordConsent(ctx context.Context, id uuid.UUID, version string, acceptedAt time.Time) error
+
+	// MarkInviteSent stamps when a participant's QR code email was sent.
+	// Returns ErrNotFound if the participant does not exist.
+	MarkInviteSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error
+
+	// Delete soft deletes a participant together with their guests; they are left out of every
+	// read until restored.
 	// Returns ErrNotFound if the participant does not exist.
 	Delete(ctx context.Context, id uuid.UUID) error
 
-	// Search searches for participants within an event by name, email, or employee_id.
+	// Restore restores a deleted participant together with the guests deleted with them.
+	// Confirmed participants take places of their event's capacity again: those that no longer
+	// fit are restored waitlisted if waitlist is set, guests before their registrant.
+	// Returns ErrNotFound if the participant is not deleted or their event or registrant is,
+	// and ErrConflict if their email was registered for the event again since, or if waitlist
+	// is not set and the event has no places left for them.
+	Restore(ctx context.Context, id uuid.UUID, waitlist bool) error
+
+	// Merge folds a duplicate participant into the primary, of the same event: the duplicate's
+	// guests become the primary's and the duplicate is soft deleted. Check-ins are moved with
+	// CheckinRepository.Reassign.
+	// Returns ErrNotFound if either participant does not exist, and ErrConflict if the primary is
+	// a guest and the duplicate has guests.
+	Merge(ctx context.Context, primaryID, duplicateID uuid.UUID, mergedAt time.Time) error
+
+	// AnonymizeByEventID replaces the personal data of every participant of an event with
+	// placeholders, keeping their status, payment and check-ins for the event statistics.
+	// Returns the number of participants anonymized.
+	AnonymizeByEventID(ctx context.Context, eventID uuid.UUID) (int64, error)
+
+	// ExpireDue moves up to limit tentative or invited participants whose event's tentative
+	// expiry has passed at now to expired status, the longest waiting first, and returns them.
+	// Participants of completed or cancelled events are left alone. Concurrent callers never
+	// expire the same participant.
+	ExpireDue(ctx context.Context, now time.Time, limit int) ([]ParticipantExpiry, error)
+
+	// UpdateTags adds and removes tags on the participants of an event selected by filter in a
+	// single statement; added tags go after the existing ones and duplicates are skipped.
+	// If any participant would end up with more than maxTags tags, nothing is changed and an
+	// unprocessable error is returned. Returns the number of participants whose tags changed.
+	UpdateTags(
+		ctx context.Context,
+		eventID uuid.UUID,
+		filter ParticipantTagFilter,
+		add, remove []string,
+		maxTags int,
+	) (int64, error)
+
+	// AssignGroup sets the group of the participants of an event with the given IDs, or clears
+	// it when groupName is nil; IDs of other events are ignored. Returns the IDs of the
+	// participants whose group changed.
+	AssignGroup(ctx context.Context, eventID uuid.UUID, ids []uuid.UUID, groupName *string) ([]uuid.UUID, error)
+
+	// UpdateStatus moves the participants of an event with the given IDs to status in a single
+	// statement, leaving participants already in that status untouched; IDs of other events are
+	// ignored. Returns the IDs of the participants whose status changed.
+	UpdateStatus(
+		ctx context.Context,
+		eventID uuid.UUID,
+		ids []uuid.UUID,
+		status entity.ParticipantStatus,
+	) ([]uuid.UUID, error)
+
+	// UpdateStatusWithinCapacity moves participants to status like UpdateStatus, but when status
+	// is confirmed it returns a conflict error and changes no participant if the event has fewer
+	// places left than participants to confirm. Concurrent updates never confirm more
+	// participants than the capacity.
+	UpdateStatusWithinCapacity(
+		ctx context.Context,
+		eventID uuid.UUID,
+		ids []uuid.UUID,
+		status entity.ParticipantStatus,
+	) ([]uuid.UUID, error)
+
+	// Search searches for participants within an event by name, email, employee_id or notes.
 	// Returns the participants and the total count matching the search criteria.
 	Search(
 		ctx context.Context,
@@ -68,18 +216,84 @@ type ParticipantRepository interface {
 		offset, limit int,
 	) ([]*entity.Participant, int64, error)
 
+	// SuggestByName retrieves up to limit participants of an event whose name starts with
+	// prefix, ignoring case, for autocomplete at manual check-in. Participants who have not
+	// checked in come first, then by name. Cancelled, declined, expired and waitlisted
+	// participants, who cannot check in, are left out.
+	SuggestByName(ctx context.Context, eventID uuid.UUID, prefix string, limit int) ([]ParticipantSuggestion, error)
+
 	// ExistsByEmail checks if a participant with the given email exists for an event.
 	ExistsByEmail(ctx context.Context, eventID uuid.UUID, email string) (bool, error)
 
 	// GetPaymentStats retrieves payment statistics for participants in an event.
-	// Used for event deletion validation (Task 7.2).
+	// Used for event deletion validation (Task 7.2), the payment summary and participant statistics.
 	GetPaymentStats(ctx context.Context, eventID uuid.UUID) (*ParticipantPaymentStats, error)
+
+	// FindChangesSince retrieves the participants of an event created or updated after since,
+	// including check-in changes, and the participants deleted after since, read from a
+	// single snapshot.
+	FindChangesSince(ctx context.Context, eventID uuid.UUID, since time.Time) (*ParticipantChanges, error)
+
+	// GetListLastModified returns the latest modification time of an event's participant list.
+	// Participant deletions and check-in changes are reflected as well.
+	// Returns ErrNotFound if the event does not exist.
+	GetListLastModified(ctx context.Context, eventID uuid.UUID) (time.Time, error)
+}
+
+// ParticipantChanges is the delta of an event's participant list after a point in time.
+type ParticipantChanges struct {
+	// Participants created or updated, or whose check-in changed, ordered by that change
+	Participants []*entity.Participant
+	// Deleted participants, ordered by deletion time
+	Deleted []ParticipantDeletion
+	// Cursor is the time of the latest change included, or the requested time when nothing
+	// changed; passing it as the next since continues the feed.
+	Cursor time.Time
+}
+
+// ParticipantExpiry is a participant moved to expired status by ExpireDue.
+type ParticipantExpiry struct {
+	ParticipantID  uuid.UUID
+	EventID        uuid.UUID
+	PreviousStatus entity.ParticipantStatus // tentative or invited
+}
+
+// ParticipantSuggestion is a participant matched by SuggestByName.
+type ParticipantSuggestion struct {
+	ID        uuid.UUID
+	Name      string
+	Email     string // Empty when the participant has none
+	CheckedIn bool
+}
+
+// ParticipantDeletion is the tombstone of a deleted participant.
+type ParticipantDeletion struct {
+	ParticipantID uuid.UUID
+	DeletedAt     time.Time
 }
 
 // ParticipantPaymentStats represents payment statistics for event participants.
+// All amounts are denominated in Currency, the event's ISO 4217 code ("" if unset).
 type ParticipantPaymentStats struct {
 	TotalParticipants  int64
 	PaidParticipants   int64
 	UnpaidParticipants int64
-	TotalPaymentAmount float64
+	TotalPaymentAmount money.Amount // Collected: sum of amounts with payment_status = 'paid'
+	Currency           string
+
+	// ExpectedPaymentAmount is the sum of amounts owed by confirmed participants.
+	ExpectedPaymentAmount money.Amount
+	// OutstandingPaymentAmount is the sum of amounts owed by confirmed, unpaid participants.
+	OutstandingPaymentAmount money.Amount
+	// UnpricedParticipants counts confirmed participants without a payment amount,
+	// whose dues are therefore missing from the expected and outstanding totals.
+	UnpricedParticipants int64
+
+	// Counts of participants by registration status
+	ConfirmedParticipants int64
+	TentativeParticipants int64
+	DeclinedParticipants  int64
+
+	// RefundedPaymentAmount is the sum of the refunded payments of the participants.
+	RefundedPaymentAmount money.Amount
 }
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=mocks/mock_payment_repository.go -package=mocks . PaymentRepository
+
+// PaymentRepository defines the interface for payment persistence operations.
+// Its methods join the transaction in ctx, if any.
+type PaymentRepository interface {
+	// Create records a payment.
+	// Returns ErrConflict if the participant already has an unrefunded payment, or if an
+	// unrefunded payment of the event was recorded with the same reference.
+	Create(ctx context.Context, payment *entity.Payment) error
+
+	// FindActiveByParticipant finds the unrefunded payment of a participant.
+	// Returns ErrNotFound if the participant has none.
+	FindActiveByParticipant(ctx context.Context, participantID uuid.UUID) (*entity.Payment, error)
+
+	// Refund marks an unrefunded payment as refunded by refundedBy at refundedAt.
+	// Returns ErrNotFound if the payment does not exist or is already refunded.
+	Refund(ctx context.Context, id, refundedBy uuid.UUID, refundedAt time.Time) error
+}
@@ -12,8 +12,13 @@ import (
 var (
 	// ErrNotFound is returned when a requested resource is not found.
 	ErrNotFound = errors.New("not found")
+	// ErrEventFull is the cause of the conflict returned when confirming a participant would
+	// exceed their event's capacity, telling it apart from other conflicts such as duplicates.
+	ErrEventFull = errors.New("no confirmed places left")
 )
 
+//go:generate mockgen -destination=mocks/mock_transactor.go -package=mocks . Transactor
+
 // Transactor defines the interface for managing database transactions.
 // Repositories can use this to ensure atomic operations across multiple
 // repository calls.
@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=mocks/mock_session_repository.go -package=mocks . SessionRepository
+
+// SessionRepository defines the interface for login session persistence operations.
+// Sessions are kept until their refresh token expires.
+type SessionRepository interface {
+	// Save creates or replaces a session.
+	Save(ctx context.Context, session *entity.Session) error
+
+	// FindByID finds a session of a user.
+	// Returns ErrNotFound if the user has no such session or it has expired.
+	FindByID(ctx context.Context, userID, sessionID uuid.UUID) (*entity.Session, error)
+
+	// ListByUserID lists the sessions of a user that have not expired, most recently used first.
+	ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error)
+
+	// Delete removes a session of a user.
+	// Returns ErrNotFound if the user has no such session.
+	Delete(ctx context.Context, userID, sessionID uuid.UUID) error
+}
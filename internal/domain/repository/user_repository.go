@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/google/uuid"
@@ -9,6 +10,12 @@ import (
 
 //go:generate mockgen -destination=mocks/mock_user_repository.go -package=mocks . UserRepository
 
+// UserListFilter defines filter options for listing users.
+type UserListFilter struct {
+	Search string // partial match on email or name, case-insensitive
+	Role   *entity.UserRole
+}
+
 // UserRepository defines the interface for user data persistence operations.
 // Following Clean Architecture, this interface is defined in the domain layer
 // and implemented in the infrastructure layer.
@@ -38,14 +45,28 @@ type UserRepository interface {
 	// Returns ErrNotFound if the user does not exist.
 	Update(ctx context.Context, user *entity.User) error
 
-	// List retrieves a paginated list of users.
+	// UpdateAccess saves the role, deactivation and updated_at of a user, leaving the rest of
+	// the user unchanged.
+	// Returns ErrNotFound if the user does not exist or is deleted.
+	UpdateAccess(ctx context.Context, user *entity.User) error
+
+	// MarkEmailVerified records that a user has verified their email address.
+	// Returns ErrNotFound if the user does not exist or is deleted.
+	MarkEmailVerified(ctx context.Context, id uuid.UUID) error
+
+	// List retrieves a paginated list of users matching the filter, newest first.
 	// Returns the users and the total count of users matching the criteria.
-	// Excludes soft-deleted users from the results.
+	// Excludes soft-deleted users from the results; deactivated users are included.
 	// Excludes password_hash from the results for security.
-	List(ctx context.Context, offset, limit int) ([]*entity.User, int64, error)
+	List(ctx context.Context, filter UserListFilter, offset, limit int) ([]*entity.User, int64, error)
+
+	// CountActiveAdmins counts the admins who are neither deleted nor deactivated. Within a
+	// transaction it locks their rows until the transaction ends, so that two concurrent
+	// demotions or deactivations cannot both pass a last-admin check.
+	CountActiveAdmins(ctx context.Context) (int64, error)
 
 	// SoftDelete marks a user as deleted without removing from database.
-	// Sets deleted_at timestamp, deleted_by user ID, and anonymizes PII.
+	// Sets deleted_at timestamp, deleted_by user ID, anonymizes PII and removes the second factor.
 	// Returns ErrNotFound if the user does not exist.
 	SoftDelete(ctx context.Context, id uuid.UUID, deletedBy uuid.UUID) error
 
@@ -53,4 +74,33 @@ type UserRepository interface {
 	// Returns true if a user exists, false otherwise.
 	// Includes soft-deleted users in the check.
 	ExistsByEmail(ctx context.Context, email string) (bool, error)
+
+	// FindTwoFactor retrieves the TOTP second factor of a user, enabled or pending.
+	// Returns ErrNotFound if the user has never started a two-factor enrollment.
+	FindTwoFactor(ctx context.Context, userID uuid.UUID) (*entity.UserTwoFactor, error)
+
+	// StartTwoFactorEnrollment stores a new encrypted TOTP secret awaiting verification,
+	// replacing any earlier unverified one.
+	// Returns ErrNotFound if the user does not exist and ErrConflict if two-factor
+	// authentication is already enabled.
+	StartTwoFactorEnrollment(ctx context.Context, userID uuid.UUID, encryptedSecret string) error
+
+	// EnableTwoFactor completes a pending enrollment: it enables two-factor login, stores the
+	// backup code hashes and records step as the last accepted TOTP time step.
+	// Returns ErrNotFound if no enrollment is pending and ErrConflict if it is already enabled.
+	EnableTwoFactor(ctx context.Context, userID uuid.UUID, backupCodeHashes []string, step int64) error
+
+	// ConsumeTwoFactorStep records step as the last accepted TOTP time step and resets the
+	// failed attempts. Returns false if a code of this or a later step was already accepted,
+	// so each code can only be used once.
+	ConsumeTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error)
+
+	// ConsumeBackupCode removes the backup code with the given hash and resets the failed
+	// attempts. Returns false if the user has no such unused backup code.
+	ConsumeBackupCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error)
+
+	// RecordTwoFactorFailure counts an invalid two-factor code entered at login and returns
+	// the number of invalid codes entered since the last accepted one. Failures that happened
+	// before windowStart no longer count.
+	RecordTwoFactorFailure(ctx context.Context, userID uuid.UUID, windowStart time.Time) (int, error)
 }
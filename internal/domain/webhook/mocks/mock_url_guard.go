@@ -0,0 +1,55 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/fumkob/ezqrin-server/internal/domain/webhook (interfaces: URLGuard)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_url_guard.go -package=mocks . URLGuard
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockURLGuard is a mock of URLGuard interface.
+type MockURLGuard struct {
+	ctrl     *gomock.Controller
+	recorder *MockURLGuardMockRecorder
+	isgomock struct{}
+}
+
+// MockURLGuardMockRecorder is the mock recorder for MockURLGuard.
+type MockURLGuardMockRecorder struct {
+	mock *MockURLGuard
+}
+
+// NewMockURLGuard creates a new mock instance.
+func NewMockURLGuard(ctrl *gomock.Controller) *MockURLGuard {
+	mock := &MockURLGuard{ctrl: ctrl}
+	mock.recorder = &MockURLGuardMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockURLGuard) EXPECT() *MockURLGuardMockRecorder {
+	return m.recorder
+}
+
+// CheckURL mocks base method.
+func (m *MockURLGuard) CheckURL(ctx context.Context, rawURL string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckURL", ctx, rawURL)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckURL indicates an expected call of CheckURL.
+func (mr *MockURLGuardMockRecorder) CheckURL(ctx, rawURL any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckURL", reflect.TypeOf((*MockURLGuard)(nil).CheckURL), ctx, rawURL)
+}
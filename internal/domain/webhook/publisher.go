@@ -0,0 +1,19 @@
+//go:generate mockgen -destination=mocks/mock_publisher.go -package=mocks . Publisher
+
+// Package webhook defines the interface for delivering domain events to webhook subscribers.
+package webhook
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+)
+
+// Publisher delivers an outbox message to webhook subscribers.
+// Delivery is at-least-once: the same message may be published more than once,
+// so subscribers should deduplicate on the message ID.
+// Publish records every destination that accepts the message with msg.MarkDeliveredTo and skips
+// those that accepted it in an earlier attempt, so that a retry only reaches the ones that failed.
+type Publisher interface {
+	Publish(ctx context.Context, msg *entity.OutboxMessage) error
+}
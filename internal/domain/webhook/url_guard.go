@@ -0,0 +1,13 @@
+//go:generate mockgen -destination=mocks/mock_url_guard.go -package=mocks . URLGuard
+
+package webhook
+
+import "context"
+
+// URLGuard checks that a webhook URL configured by a user may be called by the server, so
+// that webhooks cannot reach services of the server's own network.
+type URLGuard interface {
+	// CheckURL returns an error if the URL's host does not resolve, or resolves to an address
+	// that is not public, such as a loopback, private or link-local address.
+	CheckURL(ctx context.Context, rawURL string) error
+}
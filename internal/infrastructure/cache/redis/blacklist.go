@@ -9,6 +9,12 @@ import (
 const (
 	// BlacklistKeyPrefix is the prefix for blacklisted token keys.
 	BlacklistKeyPrefix = "blacklist:token:"
+
+	// FamilyBlacklistKeyPrefix is the prefix for blacklisted token family keys.
+	FamilyBlacklistKeyPrefix = "blacklist:family:"
+
+	// UserBlacklistKeyPrefix is the prefix for blacklisted user keys.
+	UserBlacklistKeyPrefix = "blacklist:user:"
 )
 
 // TokenBlacklistRepository implements the domain token blacklist repository using Redis.
@@ -61,6 +67,85 @@ func (r *TokenBlacklistRepository) IsBlacklisted(ctx context.Context, token stri
 	return exists > 0, nil
 }
 
+// AddFamilyToBlacklist blacklists every token of a token family.
+// The family will be automatically removed from blacklist after TTL expires.
+func (r *TokenBlacklistRepository) AddFamilyToBlacklist(ctx context.Context, familyID string, ttl time.Duration) error {
+	if familyID == "" {
+		return fmt.Errorf("family id cannot be empty")
+	}
+
+	if ttl <= 0 {
+		return fmt.Errorf("ttl must be positive")
+	}
+
+	err := r.client.Set(ctx, FamilyBlacklistKeyPrefix+familyID, "1", ttl)
+	if err != nil {
+		return fmt.Errorf("failed to add token family to blacklist: %w", err)
+	}
+
+	return nil
+}
+
+// IsFamilyBlacklisted checks if a token family is in the blacklist.
+func (r *TokenBlacklistRepository) IsFamilyBlacklisted(ctx context.Context, familyID string) (bool, error) {
+	if familyID == "" {
+		return false, fmt.Errorf("family id cannot be empty")
+	}
+
+	exists, err := r.client.Exists(ctx, FamilyBlacklistKeyPrefix+familyID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check token family blacklist status: %w", err)
+	}
+
+	return exists > 0, nil
+}
+
+// AddUserToBlacklist blacklists every access token of a user.
+// The user will be automatically removed from blacklist after TTL expires.
+func (r *TokenBlacklistRepository) AddUserToBlacklist(ctx context.Context, userID string, ttl time.Duration) error {
+	if userID == "" {
+		return fmt.Errorf("user id cannot be empty")
+	}
+
+	if ttl <= 0 {
+		return fmt.Errorf("ttl must be positive")
+	}
+
+	err := r.client.Set(ctx, UserBlacklistKeyPrefix+userID, "1", ttl)
+	if err != nil {
+		return fmt.Errorf("failed to add user to blacklist: %w", err)
+	}
+
+	return nil
+}
+
+// RemoveUserFromBlacklist removes a user from the blacklist.
+func (r *TokenBlacklistRepository) RemoveUserFromBlacklist(ctx context.Context, userID string) error {
+	if userID == "" {
+		return fmt.Errorf("user id cannot be empty")
+	}
+
+	if err := r.client.Del(ctx, UserBlacklistKeyPrefix+userID); err != nil {
+		return fmt.Errorf("failed to remove user from blacklist: %w", err)
+	}
+
+	return nil
+}
+
+// IsUserBlacklisted checks if a user is in the blacklist.
+func (r *TokenBlacklistRepository) IsUserBlacklisted(ctx context.Context, userID string) (bool, error) {
+	if userID == "" {
+		return false, fmt.Errorf("user id cannot be empty")
+	}
+
+	exists, err := r.client.Exists(ctx, UserBlacklistKeyPrefix+userID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check user blacklist status: %w", err)
+	}
+
+	return exists > 0, nil
+}
+
 // makeKey creates a Redis key for a blacklisted token.
 func (r *TokenBlacklistRepository) makeKey(token string) string {
 	return BlacklistKeyPrefix + token
@@ -168,6 +168,83 @@ var _ = Describe("TokenBlacklistRepository", func() {
 		})
 	})
 
+	Describe("AddFamilyToBlacklist", func() {
+		It("should blacklist the token family", func() {
+			familyID := "9b2f6c1e-4d7a-4f3b-8a2e-1c5d9e7f3a60"
+			mock.ExpectSet(FamilyBlacklistKeyPrefix+familyID, "1", 7*24*time.Hour).SetVal("OK")
+
+			err := repo.AddFamilyToBlacklist(ctx, familyID, 7*24*time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mock.ExpectationsWereMet()).ToNot(HaveOccurred())
+		})
+
+		It("should reject an empty family id", func() {
+			err := repo.AddFamilyToBlacklist(ctx, "", time.Hour)
+			Expect(err).To(MatchError(ContainSubstring("family id cannot be empty")))
+		})
+	})
+
+	Describe("IsFamilyBlacklisted", func() {
+		It("should report whether the token family is blacklisted", func() {
+			familyID := "9b2f6c1e-4d7a-4f3b-8a2e-1c5d9e7f3a60"
+			mock.ExpectExists(FamilyBlacklistKeyPrefix + familyID).SetVal(1)
+			mock.ExpectExists(FamilyBlacklistKeyPrefix + familyID).SetVal(0)
+
+			isBlacklisted, err := repo.IsFamilyBlacklisted(ctx, familyID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isBlacklisted).To(BeTrue())
+
+			isBlacklisted, err = repo.IsFamilyBlacklisted(ctx, familyID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isBlacklisted).To(BeFalse())
+			Expect(mock.ExpectationsWereMet()).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("AddUserToBlacklist", func() {
+		It("should blacklist the user", func() {
+			userID := "4f1c7a2e-8b3d-4e6f-9a1b-2c3d4e5f6a7b"
+			mock.ExpectSet(UserBlacklistKeyPrefix+userID, "1", 15*time.Minute).SetVal("OK")
+
+			err := repo.AddUserToBlacklist(ctx, userID, 15*time.Minute)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mock.ExpectationsWereMet()).ToNot(HaveOccurred())
+		})
+
+		It("should reject an empty user id", func() {
+			err := repo.AddUserToBlacklist(ctx, "", time.Hour)
+			Expect(err).To(MatchError(ContainSubstring("user id cannot be empty")))
+		})
+	})
+
+	Describe("RemoveUserFromBlacklist", func() {
+		It("should remove the user from the blacklist", func() {
+			userID := "4f1c7a2e-8b3d-4e6f-9a1b-2c3d4e5f6a7b"
+			mock.ExpectDel(UserBlacklistKeyPrefix + userID).SetVal(1)
+
+			err := repo.RemoveUserFromBlacklist(ctx, userID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mock.ExpectationsWereMet()).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("IsUserBlacklisted", func() {
+		It("should report whether the user is blacklisted", func() {
+			userID := "4f1c7a2e-8b3d-4e6f-9a1b-2c3d4e5f6a7b"
+			mock.ExpectExists(UserBlacklistKeyPrefix + userID).SetVal(1)
+			mock.ExpectExists(UserBlacklistKeyPrefix + userID).SetVal(0)
+
+			isBlacklisted, err := repo.IsUserBlacklisted(ctx, userID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isBlacklisted).To(BeTrue())
+
+			isBlacklisted, err = repo.IsUserBlacklisted(ctx, userID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(isBlacklisted).To(BeFalse())
+			Expect(mock.ExpectationsWereMet()).ToNot(HaveOccurred())
+		})
+	})
+
 	Describe("Token expiry behavior", func() {
 		When("token TTL expires", func() {
 			Context("after the specified duration", func() {
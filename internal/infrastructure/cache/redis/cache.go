@@ -33,6 +33,19 @@ func (r *CacheRepository) Get(ctx context.Context, key string) (string, error) {
 	return val, nil
 }
 
+// GetAndDelete atomically retrieves a value from cache and removes its key.
+// Returns empty string if key doesn't exist.
+func (r *CacheRepository) GetAndDelete(ctx context.Context, key string) (string, error) {
+	val, err := r.client.GetDel(ctx, key)
+	if err == redis.Nil {
+		return "", nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to get and delete key %s: %w", key, err)
+	}
+	return val, nil
+}
+
 // Set stores a value in cache with the specified TTL.
 // If ttl is 0, the key will not expire.
 func (r *CacheRepository) Set(ctx context.Context, key string, value string, ttl time.Duration) error {
@@ -43,6 +56,34 @@ func (r *CacheRepository) Set(ctx context.Context, key string, value string, ttl
 	return nil
 }
 
+// SetIfNotExists atomically stores a value in cache with the specified TTL only if the key
+// does not exist yet. Returns false if the key already existed.
+func (r *CacheRepository) SetIfNotExists(
+	ctx context.Context,
+	key string,
+	value string,
+	ttl time.Duration,
+) (bool, error) {
+	stored, err := r.client.SetNX(ctx, key, value, ttl)
+	if err != nil {
+		return false, fmt.Errorf("failed to set key %s if not exists: %w", key, err)
+	}
+	return stored, nil
+}
+
+// Increment atomically adds one to the counter stored at key and returns the new count.
+// A new counter expires after ttl; incrementing it again keeps its TTL.
+func (r *CacheRepository) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	pipe := r.client.Pipeline()
+	incr := pipe.Incr(ctx, key)
+	pipe.ExpireNX(ctx, key, ttl)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, fmt.Errorf("failed to increment key %s: %w", key, err)
+	}
+	return incr.Val(), nil
+}
+
 // Delete removes a key from cache.
 // No error is returned if key doesn't exist.
 func (r *CacheRepository) Delete(ctx context.Context, key string) error {
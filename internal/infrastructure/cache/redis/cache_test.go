@@ -81,6 +81,39 @@ var _ = Describe("CacheRepository", func() {
 		})
 	})
 
+	Describe("GetAndDelete", func() {
+		When("the key exists", func() {
+			It("should return the value", func() {
+				mock.ExpectGetDel("test-key").SetVal("test-value")
+
+				value, err := repo.GetAndDelete(ctx, "test-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(Equal("test-value"))
+				Expect(mock.ExpectationsWereMet()).ToNot(HaveOccurred())
+			})
+		})
+
+		When("the key does not exist", func() {
+			It("should return empty string without error", func() {
+				mock.ExpectGetDel("non-existent").RedisNil()
+
+				value, err := repo.GetAndDelete(ctx, "non-existent")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(value).To(BeEmpty())
+				Expect(mock.ExpectationsWereMet()).ToNot(HaveOccurred())
+			})
+		})
+
+		When("Redis returns an error", func() {
+			It("should return the error", func() {
+				mock.ExpectGetDel("test-key").SetErr(errors.New("connection error"))
+
+				_, err := repo.GetAndDelete(ctx, "test-key")
+				Expect(err).To(MatchError(ContainSubstring("connection error")))
+			})
+		})
+	})
+
 	Describe("Set", func() {
 		When("setting a key-value pair", func() {
 			Context("with TTL", func() {
@@ -131,6 +164,64 @@ var _ = Describe("CacheRepository", func() {
 		})
 	})
 
+	Describe("SetIfNotExists", func() {
+		When("the key does not exist", func() {
+			It("should store the value and return true", func() {
+				mock.ExpectSetNX("test-key", "test-value", time.Minute).SetVal(true)
+
+				stored, err := repo.SetIfNotExists(ctx, "test-key", "test-value", time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored).To(BeTrue())
+				Expect(mock.ExpectationsWereMet()).ToNot(HaveOccurred())
+			})
+		})
+
+		When("the key already exists", func() {
+			It("should return false", func() {
+				mock.ExpectSetNX("test-key", "test-value", time.Minute).SetVal(false)
+
+				stored, err := repo.SetIfNotExists(ctx, "test-key", "test-value", time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stored).To(BeFalse())
+				Expect(mock.ExpectationsWereMet()).ToNot(HaveOccurred())
+			})
+		})
+
+		When("Redis returns an error", func() {
+			It("should return the error", func() {
+				mock.ExpectSetNX("test-key", "test-value", time.Minute).SetErr(errors.New("write error"))
+
+				_, err := repo.SetIfNotExists(ctx, "test-key", "test-value", time.Minute)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("write error"))
+			})
+		})
+	})
+
+	Describe("Increment", func() {
+		When("incrementing a counter", func() {
+			It("should return the new count and set the TTL of a new counter", func() {
+				mock.ExpectIncr("counter").SetVal(3)
+				mock.ExpectExpireNX("counter", time.Minute).SetVal(false)
+
+				count, err := repo.Increment(ctx, "counter", time.Minute)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(count).To(Equal(int64(3)))
+				Expect(mock.ExpectationsWereMet()).ToNot(HaveOccurred())
+			})
+		})
+
+		When("Redis returns an error", func() {
+			It("should return the error", func() {
+				mock.ExpectIncr("counter").SetErr(errors.New("write error"))
+
+				_, err := repo.Increment(ctx, "counter", time.Minute)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("write error"))
+			})
+		})
+	})
+
 	Describe("Delete", func() {
 		When("deleting an existing key", func() {
 			Context("with valid key", func() {
@@ -135,11 +135,23 @@ func (c *Client) Get(ctx context.Context, key string) (string, error) {
 	return c.client.Get(ctx, key).Result()
 }
 
+// GetDel retrieves a value from Redis by key and deletes the key in one command.
+// Returns (value, nil) on success, ("", redis.Nil) if key doesn't exist, or ("", error) on failure.
+func (c *Client) GetDel(ctx context.Context, key string) (string, error) {
+	return c.client.GetDel(ctx, key).Result()
+}
+
 // Set stores a value in Redis with the specified TTL.
 func (c *Client) Set(ctx context.Context, key string, value interface{}, ttl time.Duration) error {
 	return c.client.Set(ctx, key, value, ttl).Err()
 }
 
+// SetNX stores a value in Redis with the specified TTL only if the key does not exist.
+// Returns true if the value was stored.
+func (c *Client) SetNX(ctx context.Context, key string, value interface{}, ttl time.Duration) (bool, error) {
+	return c.client.SetNX(ctx, key, value, ttl).Result()
+}
+
 // Del deletes one or more keys from Redis.
 func (c *Client) Del(ctx context.Context, keys ...string) error {
 	return c.client.Del(ctx, keys...).Err()
@@ -161,6 +173,17 @@ func (c *Client) Pipeline() redis.Pipeliner {
 	return c.client.Pipeline()
 }
 
+// Publish posts a message to a Pub/Sub channel.
+func (c *Client) Publish(ctx context.Context, channel string, message interface{}) error {
+	return c.client.Publish(ctx, channel, message).Err()
+}
+
+// Subscribe subscribes to Pub/Sub channels.
+// The subscription is not confirmed until a message is received from it.
+func (c *Client) Subscribe(ctx context.Context, channels ...string) *redis.PubSub {
+	return c.client.Subscribe(ctx, channels...)
+}
+
 // Compile-time interface compliance check.
 // This ensures that Client implements cache.Service interface.
 // If Client doesn't implement a required method, compilation will fail.
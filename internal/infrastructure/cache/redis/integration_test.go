@@ -188,6 +188,24 @@ var _ = Describe("Redis Integration Tests", func() {
 		})
 	})
 
+	Describe("PubSubRepository Integration", func() {
+		When("publishing to a subscribed channel", func() {
+			It("should deliver the message to the subscriber until it is closed", func() {
+				pubSubRepo := redis.NewPubSubRepository(client)
+				channel := "integration:test:channel"
+
+				sub, err := pubSubRepo.Subscribe(ctx, channel)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(pubSubRepo.Publish(ctx, channel, "hello")).To(Succeed())
+				Eventually(sub.Messages(), 5*time.Second).Should(Receive(Equal("hello")))
+
+				Expect(sub.Close()).To(Succeed())
+				Eventually(sub.Messages(), 5*time.Second).Should(BeClosed())
+			})
+		})
+	})
+
 	Describe("TokenBlacklistRepository Integration", func() {
 		When("managing token blacklist", func() {
 			Context("with AddToBlacklist and IsBlacklisted", func() {
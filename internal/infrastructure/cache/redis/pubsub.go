@@ -0,0 +1,78 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/redis/go-redis/v9"
+)
+
+// PubSubRepository implements the domain publish/subscribe repository using Redis Pub/Sub.
+type PubSubRepository struct {
+	client *Client
+}
+
+// NewPubSubRepository creates a new Redis-based publish/subscribe repository.
+func NewPubSubRepository(client *Client) *PubSubRepository {
+	return &PubSubRepository{
+		client: client,
+	}
+}
+
+// Publish sends a message to every current subscriber of channel.
+func (r *PubSubRepository) Publish(ctx context.Context, channel string, message string) error {
+	if err := r.client.Publish(ctx, channel, message); err != nil {
+		return fmt.Errorf("failed to publish to channel %s: %w", channel, err)
+	}
+	return nil
+}
+
+// Subscribe subscribes to channel and waits for Redis to confirm the subscription, so that
+// every message published after it returns is delivered.
+func (r *PubSubRepository) Subscribe(ctx context.Context, channel string) (repository.Subscription, error) {
+	pubsub := r.client.Subscribe(ctx, channel)
+	if _, err := pubsub.Receive(ctx); err != nil {
+		_ = pubsub.Close()
+		return nil, fmt.Errorf("failed to subscribe to channel %s: %w", channel, err)
+	}
+
+	sub := &subscription{pubsub: pubsub, messages: make(chan string), closed: make(chan struct{})}
+	go sub.relay()
+	return sub, nil
+}
+
+// subscription adapts a Redis Pub/Sub subscription to repository.Subscription.
+type subscription struct {
+	pubsub    *redis.PubSub
+	messages  chan string
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+// relay forwards the payloads of received messages until the subscription is closed
+func (s *subscription) relay() {
+	defer close(s.messages)
+	for msg := range s.pubsub.Channel() {
+		select {
+		case s.messages <- msg.Payload:
+		case <-s.closed:
+			return
+		}
+	}
+}
+
+// Messages returns the payloads of the received messages.
+func (s *subscription) Messages() <-chan string {
+	return s.messages
+}
+
+// Close unsubscribes and closes the underlying connection.
+func (s *subscription) Close() error {
+	s.closeOnce.Do(func() { close(s.closed) })
+	return s.pubsub.Close()
+}
+
+// Compile-time interface compliance check.
+var _ repository.PubSubRepository = (*PubSubRepository)(nil)
@@ -0,0 +1,176 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// SessionKeyPrefix is the prefix for the hash of a user's sessions, keyed by session ID.
+	SessionKeyPrefix = "sessions:user:"
+)
+
+// SessionRepository implements the domain session repository using Redis.
+// The sessions of a user are kept in one hash, which expires with the user's last session.
+type SessionRepository struct {
+	client *Client
+	now    func() time.Time
+}
+
+// NewSessionRepository creates a new Redis-based session repository.
+func NewSessionRepository(client *Client) *SessionRepository {
+	return &SessionRepository{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+// sessionRecord is the stored form of a session.
+type sessionRecord struct {
+	ClientType   string    `json:"client_type"`
+	Device       string    `json:"device"`
+	RefreshToken string    `json:"refresh_token"`
+	IssuedAt     time.Time `json:"issued_at"`
+	LastUsedAt   time.Time `json:"last_used_at"`
+	ExpiresAt    time.Time `json:"expires_at"`
+}
+
+// Save creates or replaces a session.
+func (r *SessionRepository) Save(ctx context.Context, session *entity.Session) error {
+	ttl := session.ExpiresAt.Sub(r.now())
+	if ttl <= 0 {
+		return fmt.Errorf("session has already expired")
+	}
+
+	value, err := json.Marshal(sessionRecord{
+		ClientType:   session.ClientType,
+		Device:       session.Device,
+		RefreshToken: session.RefreshToken,
+		IssuedAt:     session.IssuedAt,
+		LastUsedAt:   session.LastUsedAt,
+		ExpiresAt:    session.ExpiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to encode session: %w", err)
+	}
+
+	key := r.makeKey(session.UserID)
+	rdb := r.client.GetClient()
+	if err := rdb.HSet(ctx, key, session.ID.String(), value).Err(); err != nil {
+		return fmt.Errorf("failed to save session: %w", err)
+	}
+	// The hash lives as long as its longest session: NX sets the expiry of a new hash and
+	// GT extends it for a session outliving the others
+	if err := rdb.ExpireNX(ctx, key, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set session expiry: %w", err)
+	}
+	if err := rdb.ExpireGT(ctx, key, ttl).Err(); err != nil {
+		return fmt.Errorf("failed to set session expiry: %w", err)
+	}
+	return nil
+}
+
+// FindByID finds a session of a user.
+// Returns ErrNotFound if the user has no such session or it has expired.
+func (r *SessionRepository) FindByID(ctx context.Context, userID, sessionID uuid.UUID) (*entity.Session, error) {
+	value, err := r.client.GetClient().HGet(ctx, r.makeKey(userID), sessionID.String()).Result()
+	if err == redis.Nil {
+		return nil, apperrors.NotFound("session not found")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get session: %w", err)
+	}
+
+	session, err := decodeSession(userID, sessionID.String(), value)
+	if err != nil {
+		return nil, err
+	}
+	if session.IsExpired(r.now()) {
+		return nil, apperrors.NotFound("session not found")
+	}
+	return session, nil
+}
+
+// ListByUserID lists the sessions of a user that have not expired, most recently used first.
+// Expired sessions found along the way are removed.
+func (r *SessionRepository) ListByUserID(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	key := r.makeKey(userID)
+	values, err := r.client.GetClient().HGetAll(ctx, key).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list sessions: %w", err)
+	}
+
+	now := r.now()
+	sessions := make([]*entity.Session, 0, len(values))
+	var expired []string
+	for id, value := range values {
+		session, err := decodeSession(userID, id, value)
+		if err != nil {
+			return nil, err
+		}
+		if session.IsExpired(now) {
+			expired = append(expired, id)
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+
+	if len(expired) > 0 {
+		if err := r.client.GetClient().HDel(ctx, key, expired...).Err(); err != nil {
+			return nil, fmt.Errorf("failed to remove expired sessions: %w", err)
+		}
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastUsedAt.After(sessions[j].LastUsedAt)
+	})
+	return sessions, nil
+}
+
+// Delete removes a session of a user.
+// Returns ErrNotFound if the user has no such session.
+func (r *SessionRepository) Delete(ctx context.Context, userID, sessionID uuid.UUID) error {
+	removed, err := r.client.GetClient().HDel(ctx, r.makeKey(userID), sessionID.String()).Result()
+	if err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+	if removed == 0 {
+		return apperrors.NotFound("session not found")
+	}
+	return nil
+}
+
+// decodeSession decodes the stored session id of a user.
+func decodeSession(userID uuid.UUID, id, value string) (*entity.Session, error) {
+	sessionID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session id %q: %w", id, err)
+	}
+	var record sessionRecord
+	if err := json.Unmarshal([]byte(value), &record); err != nil {
+		return nil, fmt.Errorf("failed to decode session %s: %w", id, err)
+	}
+	return &entity.Session{
+		ID:           sessionID,
+		UserID:       userID,
+		ClientType:   record.ClientType,
+		Device:       record.Device,
+		RefreshToken: record.RefreshToken,
+		IssuedAt:     record.IssuedAt,
+		LastUsedAt:   record.LastUsedAt,
+		ExpiresAt:    record.ExpiresAt,
+	}, nil
+}
+
+// makeKey creates the Redis key of the hash of a user's sessions.
+func (r *SessionRepository) makeKey(userID uuid.UUID) string {
+	return SessionKeyPrefix + userID.String()
+}
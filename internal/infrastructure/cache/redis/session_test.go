@@ -0,0 +1,156 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/go-redis/redismock/v9"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+var _ = Describe("SessionRepository", func() {
+	var (
+		mock    redismock.ClientMock
+		repo    *SessionRepository
+		ctx     context.Context
+		now     time.Time
+		userID  uuid.UUID
+		key     string
+		session *entity.Session
+	)
+
+	encode := func(s *entity.Session) string {
+		value, err := json.Marshal(sessionRecord{
+			ClientType:   s.ClientType,
+			Device:       s.Device,
+			RefreshToken: s.RefreshToken,
+			IssuedAt:     s.IssuedAt,
+			LastUsedAt:   s.LastUsedAt,
+			ExpiresAt:    s.ExpiresAt,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return string(value)
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		var mockClient *goredis.Client
+		mockClient, mock = redismock.NewClientMock()
+		repo = NewSessionRepository(newTestClient(mockClient))
+		now = time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+		repo.now = func() time.Time { return now }
+
+		userID = uuid.New()
+		key = SessionKeyPrefix + userID.String()
+		session = entity.NewSession(userID, "web", "Mozilla/5.0", now.Add(-time.Hour))
+		session.RefreshToken = "refresh.jwt.token"
+		session.ExpiresAt = now.Add(7 * 24 * time.Hour)
+	})
+
+	AfterEach(func() {
+		mock.ClearExpect()
+	})
+
+	Describe("Save", func() {
+		It("should store the session in the user's hash and keep the hash until it expires", func() {
+			mock.ExpectHSet(key, session.ID.String(), []byte(encode(session))).SetVal(1)
+			mock.ExpectExpireNX(key, 7*24*time.Hour).SetVal(true)
+			mock.ExpectExpireGT(key, 7*24*time.Hour).SetVal(false)
+
+			Expect(repo.Save(ctx, session)).To(Succeed())
+			Expect(mock.ExpectationsWereMet()).To(Succeed())
+		})
+
+		It("should reject an expired session", func() {
+			session.ExpiresAt = now
+
+			Expect(repo.Save(ctx, session)).To(MatchError(ContainSubstring("expired")))
+		})
+	})
+
+	Describe("FindByID", func() {
+		It("should return the stored session", func() {
+			mock.ExpectHGet(key, session.ID.String()).SetVal(encode(session))
+
+			found, err := repo.FindByID(ctx, userID, session.ID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found.ID).To(Equal(session.ID))
+			Expect(found.UserID).To(Equal(userID))
+			Expect(found.RefreshToken).To(Equal("refresh.jwt.token"))
+			Expect(found.ExpiresAt.Equal(session.ExpiresAt)).To(BeTrue())
+		})
+
+		It("should return not found for an unknown session", func() {
+			mock.ExpectHGet(key, session.ID.String()).RedisNil()
+
+			_, err := repo.FindByID(ctx, userID, session.ID)
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should return not found for an expired session", func() {
+			session.ExpiresAt = now.Add(-time.Minute)
+			mock.ExpectHGet(key, session.ID.String()).SetVal(encode(session))
+
+			_, err := repo.FindByID(ctx, userID, session.ID)
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("ListByUserID", func() {
+		It("should list the live sessions most recently used first and remove expired ones", func() {
+			recent := entity.NewSession(userID, "mobile", "ezQRin/1.0 (iPhone)", now.Add(-time.Minute))
+			recent.ExpiresAt = now.Add(90 * 24 * time.Hour)
+			expired := entity.NewSession(userID, "web", "Mozilla/5.0", now.Add(-8*24*time.Hour))
+			expired.ExpiresAt = now.Add(-24 * time.Hour)
+
+			mock.ExpectHGetAll(key).SetVal(map[string]string{
+				session.ID.String(): encode(session),
+				recent.ID.String():  encode(recent),
+				expired.ID.String(): encode(expired),
+			})
+			mock.ExpectHDel(key, expired.ID.String()).SetVal(1)
+
+			sessions, err := repo.ListByUserID(ctx, userID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sessions).To(HaveLen(2))
+			Expect(sessions[0].ID).To(Equal(recent.ID))
+			Expect(sessions[1].ID).To(Equal(session.ID))
+			Expect(mock.ExpectationsWereMet()).To(Succeed())
+		})
+
+		It("should return no sessions for a user without any", func() {
+			mock.ExpectHGetAll(key).SetVal(map[string]string{})
+
+			sessions, err := repo.ListByUserID(ctx, userID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sessions).To(BeEmpty())
+		})
+	})
+
+	Describe("Delete", func() {
+		It("should remove the session", func() {
+			mock.ExpectHDel(key, session.ID.String()).SetVal(1)
+
+			Expect(repo.Delete(ctx, userID, session.ID)).To(Succeed())
+		})
+
+		It("should return not found for an unknown session", func() {
+			mock.ExpectHDel(key, session.ID.String()).SetVal(0)
+
+			err := repo.Delete(ctx, userID, session.ID)
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
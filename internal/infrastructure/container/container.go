@@ -2,34 +2,58 @@ package container
 
 import (
 	"fmt"
+	"net"
+	"time"
 
 	"github.com/fumkob/ezqrin-server/config"
+	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/cache"
 	redisClient "github.com/fumkob/ezqrin-server/internal/infrastructure/cache/redis"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
 	infraemail "github.com/fumkob/ezqrin-server/internal/infrastructure/email"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/webhook"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
 	"github.com/fumkob/ezqrin-server/internal/usecase/auth"
 	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
 	"github.com/fumkob/ezqrin-server/internal/usecase/event"
+	"github.com/fumkob/ezqrin-server/internal/usecase/expiry"
+	"github.com/fumkob/ezqrin-server/internal/usecase/outbox"
 	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	"github.com/fumkob/ezqrin-server/internal/usecase/payment"
+	"github.com/fumkob/ezqrin-server/internal/usecase/qrtoken"
+	"github.com/fumkob/ezqrin-server/internal/usecase/retention"
+	"github.com/fumkob/ezqrin-server/internal/usecase/user"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
 )
 
 // Container holds all application dependencies
 type Container struct {
-	Repositories *RepositoryContainer
-	UseCases     *UseCaseContainer
+	Repositories       *RepositoryContainer
+	UseCases           *UseCaseContainer
+	OutboxRelay        *outbox.Relay
+	RetentionPurger    *retention.Purger
+	ParticipantExpirer *expiry.Expirer
+	QRGenerator        *qrcode.Generator
+	TokenSigner        *crypto.TokenSigner
 }
 
 // RepositoryContainer holds repository implementations
 type RepositoryContainer struct {
-	User        repository.UserRepository
-	Event       repository.EventRepository
-	Participant repository.ParticipantRepository
-	Checkin     repository.CheckinRepository
-	Blacklist   repository.TokenBlacklistRepository
+	User         repository.UserRepository
+	Event        repository.EventRepository
+	EventWebhook repository.EventWebhookRepository
+	Participant  repository.ParticipantRepository
+	Checkin      repository.CheckinRepository
+	Payment      repository.PaymentRepository
+	Outbox       repository.OutboxRepository
+	Audit        repository.AuditRepository
+	Blacklist    repository.TokenBlacklistRepository
+	Session      repository.SessionRepository
+	Cache        repository.CacheRepository
+	PubSub       repository.PubSubRepository
 }
 
 // UseCaseContainer holds use case orchestrators
@@ -38,14 +62,22 @@ type UseCaseContainer struct {
 	Event       event.Usecase
 	Participant participant.Usecase
 	Checkin     checkin.Usecase
+	Payment     payment.Usecase
+	User        user.Usecase
+	Audit       audit.Usecase
 }
 
 // AuthUseCases holds authentication-related use cases
 type AuthUseCases struct {
-	Register *auth.RegisterUseCase
-	Login    *auth.LoginUseCase
-	Refresh  *auth.RefreshTokenUseCase
-	Logout   *auth.LogoutUseCase
+	Register          *auth.RegisterUseCase
+	Login             *auth.LoginUseCase
+	Refresh           *auth.RefreshTokenUseCase
+	Logout            *auth.LogoutUseCase
+	TwoFactor         *auth.TwoFactorUseCase
+	ForgotPassword    *auth.ForgotPasswordUseCase
+	ResetPassword     *auth.ResetPasswordUseCase
+	Session           *auth.SessionUseCase
+	EmailVerification *auth.EmailVerificationUseCase
 }
 
 // NewContainer initializes and wires all application dependencies
@@ -57,57 +89,173 @@ func NewContainer(
 ) (*Container, error) {
 	// Initialize repositories
 	repos := &RepositoryContainer{
-		User:        database.NewUserRepository(db.GetPool(), logger),
-		Event:       database.NewEventRepository(db.GetPool(), logger),
-		Participant: database.NewParticipantRepository(db.GetPool(), logger),
-		Checkin:     database.NewCheckinRepository(db.GetPool()),
+		User:         database.NewUserRepository(db.GetPool(), logger),
+		Event:        database.NewEventRepository(db.GetPool(), logger),
+		EventWebhook: database.NewEventWebhookRepository(db.GetPool()),
+		Participant:  database.NewParticipantRepository(db.GetPool(), logger),
+		Checkin:      database.NewCheckinRepository(db.GetPool()),
+		Payment:      database.NewPaymentRepository(db.GetPool()),
+		Outbox:       database.NewOutboxRepository(db.GetPool()),
+		Audit:        database.NewAuditRepository(db.GetPool()),
 	}
 
-	// TokenBlacklistRepository comes from Redis client
+	// TokenBlacklistRepository, SessionRepository, CacheRepository and PubSubRepository come from Redis client
 	if redis, ok := cache.(*redisClient.Client); ok {
 		repos.Blacklist = redisClient.NewTokenBlacklistRepository(redis)
+		repos.Session = redisClient.NewSessionRepository(redis)
+		repos.Cache = redisClient.NewCacheRepository(redis)
+		repos.PubSub = redisClient.NewPubSubRepository(redis)
+	}
+
+	// Initialize the signer of the access, refresh and two-factor challenge tokens
+	tokenSigner, err := crypto.NewTokenSigner(cfg.JWT.SigningConfig())
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize JWT signer: %w", err)
 	}
 
 	// Initialize QR code generator
 	qrGenerator := qrcode.NewGenerator()
 
+	// Initialize QR token issuer
+	tokenGenerator, err := crypto.NewTokenGenerator(
+		cfg.QRCode.TokenStrategy, cfg.QRCode.HMACSecret, cfg.QRCode.TokenBytes,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize QR token generator: %w", err)
+	}
+	qrTokens := qrtoken.NewIssuer(tokenGenerator, repos.Participant, cfg.QRCode.TokenMaxAttempts)
+
 	// Initialize email sender
 	emailSender, err := infraemail.NewSenderFromConfig(cfg.Email, logger.Logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to initialize email sender: %w", err)
 	}
 
+	// Initialize the participant email domain check; without it domains are not checked
+	var emailDomains domainemail.DomainChecker
+	if cfg.Email.DomainCheck {
+		emailDomains = infraemail.NewMXChecker(
+			net.DefaultResolver, repos.Cache, cfg.Email.DomainCheckTimeout, cfg.Email.DomainCheckCacheTTL, logger.Logger,
+		)
+	}
+
+	// Initialize the email verification of new users
+	emailVerification := auth.NewEmailVerificationUseCase(
+		repos.User, repos.Cache, emailSender, cfg.JWT.Secret, cfg.EmailVerification.BaseURL,
+		cfg.EmailVerification.TokenExpiry, cfg.Email.PlainTextOnly, logger,
+	)
+
+	// Initialize the audit log of event, participant and check-in changes
+	auditor := audit.NewRecorder(repos.Audit, logger)
+
+	// Initialize the check keeping event webhooks away from the server's own network
+	webhookGuard := webhook.NewAddressGuard(net.DefaultResolver)
+
 	// Initialize use cases
 	useCases := &UseCaseContainer{
 		Auth: &AuthUseCases{
-			Register: auth.NewRegisterUseCase(repos.User, cfg.JWT.Secret, logger),
+			Register: auth.NewRegisterUseCase(
+				repos.User,
+				tokenSigner,
+				emailVerification,
+				cfg.JWT.AccessTokenExpiry,
+				cfg.JWT.RefreshTokenExpiryWeb,
+				cfg.JWT.RefreshTokenExpiryMobile,
+				logger,
+			),
 			Login: auth.NewLoginUseCase(
 				repos.User,
-				cfg.JWT.Secret,
+				repos.Session,
+				tokenSigner,
+				cfg.TwoFactor.EncryptionKey,
+				cfg.JWT.AccessTokenExpiry,
 				cfg.JWT.RefreshTokenExpiryWeb,
 				cfg.JWT.RefreshTokenExpiryMobile,
 				logger,
 			),
 			Refresh: auth.NewRefreshTokenUseCase(
 				repos.User,
+				repos.Session,
 				repos.Blacklist,
-				cfg.JWT.Secret,
+				tokenSigner,
+				cfg.JWT.AccessTokenExpiry,
 				cfg.JWT.RefreshTokenExpiryWeb,
 				cfg.JWT.RefreshTokenExpiryMobile,
 				logger,
 			),
-			Logout: auth.NewLogoutUseCase(repos.Blacklist, cfg.JWT.Secret, logger),
+			Logout: auth.NewLogoutUseCase(repos.Session, repos.Blacklist, tokenSigner, logger),
+			TwoFactor: auth.NewTwoFactorUseCase(
+				repos.User, qrGenerator, cfg.TwoFactor.EncryptionKey, cfg.TwoFactor.Issuer, logger,
+			),
+			// Until reset emails are sent, the token is returned outside production
+			ForgotPassword: auth.NewForgotPasswordUseCase(
+				repos.User, repos.Cache, cfg.JWT.Secret, !cfg.IsProduction(), logger,
+			),
+			ResetPassword:     auth.NewResetPasswordUseCase(repos.User, repos.Cache, cfg.JWT.Secret, logger),
+			Session:           auth.NewSessionUseCase(repos.Session, repos.Blacklist, logger),
+			EmailVerification: emailVerification,
 		},
-		Event: event.NewUsecase(repos.Event),
+		Event: event.NewUsecase(
+			repos.Event, repos.EventWebhook, repos.Outbox, db, cfg.Payment.DefaultCurrency,
+			event.StatsWarningThresholds{
+				NoShowRate:     cfg.Stats.NoShowRateWarning,
+				LowCheckinRate: cfg.Stats.LowCheckinRateWarning,
+				Capacity:       cfg.Stats.CapacityWarning,
+			},
+			cfg.Recurrence.MaxOccurrences,
+			webhookGuard,
+			auditor,
+		),
 		Participant: participant.NewUsecase(
-			repos.Participant, repos.Event, qrGenerator, cfg.QRCode.HMACSecret, cfg.QRCode.HostingBaseURL,
-			cfg.QRCode.WalletPassBaseURL, emailSender, cfg.Email.PlainTextOnly, logger,
+			repos.Participant, repos.Event, repos.Checkin, repos.Outbox, db, qrGenerator, cfg.QRCode.HMACSecret,
+			qrTokens, cfg.QRCode.HostingBaseURL, cfg.QRCode.WalletPassBaseURL, cfg.Invite.AcceptBaseURL,
+			cfg.Invite.TokenExpiry, emailSender, cfg.Email.PlainTextOnly, emailDomains, cfg.Email.DomainCheckReject,
+			cfg.Features.Waitlist, auditor, logger,
+		),
+		Checkin: checkin.NewUsecase(
+			repos.Checkin, repos.Participant, repos.Event, repos.Outbox, db, repos.Cache, repos.PubSub,
+			cfg.QRCode.HMACSecret, cfg.QRCode.TokenTTL, qrTokens, cfg.Checkin.UndoWindow, cfg.Checkin.DebounceWindow,
+			cfg.Checkin.WindowMargin, auditor, logger,
 		),
-		Checkin: checkin.NewUsecase(repos.Checkin, repos.Participant, repos.Event, cfg.QRCode.HMACSecret),
+		Payment: payment.NewUsecase(repos.Participant, repos.Event, repos.Payment, db, repos.Cache, auditor, logger),
+		User:    user.NewUsecase(repos.User, repos.Blacklist, db, cfg.JWT.AccessTokenExpiry, logger),
+		Audit:   audit.NewUsecase(repos.Audit),
 	}
 
+	// Initialize the outbox relay that delivers domain events to the configured and per-event webhooks
+	publisher := webhook.NewMultiPublisher(
+		webhook.NewHTTPPublisher(cfg.Webhook.URLs, cfg.Webhook.Secret, cfg.Webhook.Timeout),
+		webhook.NewEventPublisher(repos.EventWebhook, cfg.Webhook.Timeout, webhookGuard, logger),
+	)
+	relay := outbox.NewRelay(repos.Outbox, publisher, outbox.RelayConfig{
+		PollInterval:   cfg.Outbox.PollInterval,
+		BatchSize:      cfg.Outbox.BatchSize,
+		Lease:          cfg.Outbox.Lease,
+		MaxAttempts:    cfg.Outbox.MaxAttempts,
+		RetryBaseDelay: cfg.Outbox.RetryBaseDelay,
+		RetryMaxDelay:  cfg.Outbox.RetryMaxDelay,
+	}, logger)
+
+	// Initialize the retention purger that anonymizes participants of long-past events
+	purger := retention.NewPurger(repos.Event, repos.Participant, db, retention.PurgerConfig{
+		PurgeAfterDays: cfg.Retention.PurgeAfterDays,
+		Interval:       cfg.Retention.Interval,
+		BatchSize:      cfg.Retention.BatchSize,
+	}, time.Now, logger)
+
+	// Initialize the expirer of participants who stay tentative or invited for too long
+	expirer := expiry.NewExpirer(repos.Participant, repos.Outbox, db, expiry.ExpirerConfig{
+		Interval:  cfg.ParticipantExpiry.Interval,
+		BatchSize: cfg.ParticipantExpiry.BatchSize,
+	}, time.Now, logger)
+
 	return &Container{
-		Repositories: repos,
-		UseCases:     useCases,
+		Repositories:       repos,
+		UseCases:           useCases,
+		OutboxRelay:        relay,
+		RetentionPurger:    purger,
+		ParticipantExpirer: expirer,
+		QRGenerator:        qrGenerator,
+		TokenSigner:        tokenSigner,
 	}, nil
 }
@@ -0,0 +1,134 @@
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// auditRepository implements the AuditRepository interface.
+type auditRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewAuditRepository creates a new audit log repository.
+func NewAuditRepository(pool *pgxpool.Pool) repository.AuditRepository {
+	return &auditRepository{pool: pool}
+}
+
+// Record appends an entry to the audit log outside of any transaction in ctx.
+func (r *auditRepository) Record(ctx context.Context, entry *entity.AuditLog) error {
+	if err := entry.Validate(); err != nil {
+		return apperrors.Wrapf(err, "invalid audit log entry")
+	}
+
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to marshal audit log changes")
+	}
+
+	query := `
+		INSERT INTO audit_logs (id, actor_id, action, resource_type, resource_id, changes, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err = r.pool.Exec(ctx, query,
+		entry.ID,
+		entry.ActorID,
+		entry.Action,
+		entry.ResourceType,
+		entry.ResourceID,
+		changes,
+		entry.CreatedAt,
+	)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to record audit log entry")
+	}
+
+	return nil
+}
+
+// List returns the audit log entries matching the filter, newest first.
+func (r *auditRepository) List(
+	ctx context.Context,
+	filter repository.AuditLogFilter,
+	offset, limit int,
+) ([]*entity.AuditLog, int64, error) {
+	whereClause, args, argIdx := buildAuditFilterClauses(filter)
+
+	countQuery := fmt.Sprintf(`SELECT COUNT(*) FROM audit_logs WHERE %s`, whereClause)
+
+	var total int64
+	if err := r.pool.QueryRow(ctx, countQuery, args...).Scan(&total); err != nil {
+		return nil, 0, apperrors.Wrapf(err, "failed to count audit log entries")
+	}
+
+	query := fmt.Sprintf(`
+		SELECT id, actor_id, action, resource_type, resource_id, changes, created_at
+		FROM audit_logs
+		WHERE %s
+		ORDER BY created_at DESC, id
+		LIMIT $%d OFFSET $%d
+	`, whereClause, argIdx, argIdx+1)
+
+	rows, err := r.pool.Query(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, apperrors.Wrapf(err, "failed to list audit log entries")
+	}
+	defer rows.Close()
+
+	entries := make([]*entity.AuditLog, 0, limit)
+	for rows.Next() {
+		var entry entity.AuditLog
+		var changes []byte
+		err := rows.Scan(
+			&entry.ID,
+			&entry.ActorID,
+			&entry.Action,
+			&entry.ResourceType,
+			&entry.ResourceID,
+			&changes,
+			&entry.CreatedAt,
+		)
+		if err != nil {
+			return nil, 0, apperrors.Wrapf(err, "failed to scan audit log row")
+		}
+		if err := json.Unmarshal(changes, &entry.Changes); err != nil {
+			return nil, 0, apperrors.Wrapf(err, "failed to unmarshal audit log changes")
+		}
+		entries = append(entries, &entry)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, 0, apperrors.Wrapf(err, "error iterating audit log rows")
+	}
+
+	return entries, total, nil
+}
+
+// buildAuditFilterClauses builds the WHERE clause of an audit log list query and its arguments.
+// Returns the clause, the arguments and the index of the next placeholder.
+func buildAuditFilterClauses(filter repository.AuditLogFilter) (string, []interface{}, int) {
+	whereClauses := []string{"TRUE"}
+	args := []interface{}{}
+	argIdx := 1
+
+	if filter.ResourceType != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("resource_type = $%d", argIdx))
+		args = append(args, *filter.ResourceType)
+		argIdx++
+	}
+	if filter.ResourceID != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("resource_id = $%d", argIdx))
+		args = append(args, *filter.ResourceID)
+		argIdx++
+	}
+
+	return strings.Join(whereClauses, " AND "), args, argIdx
+}
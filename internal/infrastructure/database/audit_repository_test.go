@@ -0,0 +1,139 @@
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditRepository", func() {
+	var (
+		ctx     context.Context
+		db      *database.PostgresDB
+		repo    repository.AuditRepository
+		actorID uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		log, _ := logger.New(logger.Config{
+			Level:       "info",
+			Format:      "console",
+			Environment: "development",
+		})
+		cfg := &config.DatabaseConfig{
+			Host:            "postgres",
+			Port:            5432,
+			User:            "ezqrin",
+			Password:        "ezqrin_dev",
+			Name:            "ezqrin_test",
+			SSLMode:         "disable",
+			MaxConns:        25,
+			MinConns:        5,
+			MaxConnLifetime: time.Hour,
+			MaxConnIdleTime: 30 * time.Minute,
+		}
+
+		var err error
+		db, err = database.NewPostgresDB(ctx, cfg, log)
+		Expect(err).NotTo(HaveOccurred())
+		repo = database.NewAuditRepository(db.GetPool())
+		actorID = uuid.New()
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_, _ = db.GetPool().Exec(ctx, "TRUNCATE TABLE audit_logs")
+			db.Close()
+		}
+	})
+
+	// record records an entry about a resource, createdAt after a fixed time
+	record := func(resourceType entity.AuditResourceType, resourceID uuid.UUID, createdAt time.Duration) *entity.AuditLog {
+		before := entity.AuditFields{"name": "Tech Conf"}
+		after := entity.AuditFields{"name": "Tech Conf 2026"}
+		entry, err := entity.NewAuditLog(actorID, entity.AuditActionUpdate, resourceType, resourceID, before, after)
+		Expect(err).NotTo(HaveOccurred())
+		entry.CreatedAt = time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC).Add(createdAt)
+		Expect(repo.Record(ctx, entry)).To(Succeed())
+		return entry
+	}
+
+	When("recording entries", func() {
+		It("should list them newest first with their changes", func() {
+			eventID := uuid.New()
+			first := record(entity.AuditResourceEvent, eventID, 0)
+			second := record(entity.AuditResourceEvent, eventID, time.Minute)
+
+			entries, total, err := repo.List(ctx, repository.AuditLogFilter{}, 0, 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(Equal(int64(2)))
+			Expect(entries).To(HaveLen(2))
+			Expect(entries[0].ID).To(Equal(second.ID))
+			Expect(entries[1].ID).To(Equal(first.ID))
+			Expect(entries[0].ActorID).To(Equal(actorID))
+			Expect(entries[0].Action).To(Equal(entity.AuditActionUpdate))
+			Expect(entries[0].Changes["name"].Old).To(Equal("Tech Conf"))
+			Expect(entries[0].Changes["name"].New).To(Equal("Tech Conf 2026"))
+		})
+
+		It("should record entries inside a transaction that is rolled back", func() {
+			entry, err := entity.NewAuditLog(actorID, entity.AuditActionDelete, entity.AuditResourceCheckin, uuid.New(), nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			_ = database.WithTransaction(ctx, db.GetPool(), func(txCtx context.Context) error {
+				Expect(repo.Record(txCtx, entry)).To(Succeed())
+				return context.Canceled
+			})
+
+			_, total, err := repo.List(ctx, repository.AuditLogFilter{}, 0, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(Equal(int64(1)))
+		})
+	})
+
+	When("filtering entries", func() {
+		It("should only list the entries of the resource", func() {
+			eventID := uuid.New()
+			participantID := uuid.New()
+			record(entity.AuditResourceEvent, eventID, 0)
+			record(entity.AuditResourceParticipant, participantID, time.Minute)
+			record(entity.AuditResourceParticipant, uuid.New(), 2*time.Minute)
+
+			resourceType := entity.AuditResourceParticipant
+			entries, total, err := repo.List(ctx, repository.AuditLogFilter{
+				ResourceType: &resourceType,
+				ResourceID:   &participantID,
+			}, 0, 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(Equal(int64(1)))
+			Expect(entries[0].ResourceID).To(Equal(participantID))
+		})
+
+		It("should paginate the entries", func() {
+			eventID := uuid.New()
+			for i := range 3 {
+				record(entity.AuditResourceEvent, eventID, time.Duration(i)*time.Minute)
+			}
+
+			entries, total, err := repo.List(ctx, repository.AuditLogFilter{}, 2, 2)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(Equal(int64(3)))
+			Expect(entries).To(HaveLen(1))
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
@@ -31,6 +32,7 @@ func NewCheckinRepository(pool *pgxpool.Pool) repository.CheckinRepository {
 }
 
 // Create creates a new check-in record with duplicate prevention.
+// It joins the transaction in ctx, if any.
 func (r *checkinRepository) Create(ctx context.Context, checkin *entity.Checkin) error {
 	if err := checkin.Validate(); err != nil {
 		return fmt.Errorf("invalid checkin: %w", err)
@@ -39,13 +41,14 @@ func (r *checkinRepository) Create(ctx context.Context, checkin *entity.Checkin)
 	query := `
 		INSERT INTO checkins (
 			id, event_id, participant_id, checked_in_at, checked_in_by,
-			checkin_method, device_info
+			checkin_method, device_info, source, device_id
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7
+			$1, $2, $3, $4, $5, $6, $7, $8, $9
 		)
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	q := GetQueryable(ctx, r.pool)
+	_, err := q.Exec(ctx, query,
 		checkin.ID,
 		checkin.EventID,
 		checkin.ParticipantID,
@@ -53,6 +56,8 @@ func (r *checkinRepository) Create(ctx context.Context, checkin *entity.Checkin)
 		checkin.CheckedInBy,
 		checkin.Method,
 		checkin.DeviceInfo,
+		checkin.Source,
+		checkin.DeviceID,
 	)
 	if err != nil {
 		// Check for unique constraint violation (duplicate check-in)
@@ -67,15 +72,86 @@ func (r *checkinRepository) Create(ctx context.Context, checkin *entity.Checkin)
 	return nil
 }
 
+// BulkCreate creates check-ins for several participants in a single statement.
+// Conflicts with active check-ins are skipped rather than failing the statement.
+func (r *checkinRepository) BulkCreate(ctx context.Context, checkins []*entity.Checkin) ([]uuid.UUID, error) {
+	if len(checkins) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	ids := make([]uuid.UUID, len(checkins))
+	eventIDs := make([]uuid.UUID, len(checkins))
+	participantIDs := make([]uuid.UUID, len(checkins))
+	checkedInAts := make([]time.Time, len(checkins))
+	checkedInBys := make([]*uuid.UUID, len(checkins))
+	methods := make([]string, len(checkins))
+	deviceInfos := make([]*string, len(checkins))
+	sources := make([]*string, len(checkins))
+	deviceIDs := make([]*string, len(checkins))
+	for i, checkin := range checkins {
+		if err := checkin.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid checkin: %w", err)
+		}
+		ids[i] = checkin.ID
+		eventIDs[i] = checkin.EventID
+		participantIDs[i] = checkin.ParticipantID
+		checkedInAts[i] = checkin.CheckedInAt
+		checkedInBys[i] = checkin.CheckedInBy
+		methods[i] = string(checkin.Method)
+		if checkin.DeviceInfo != nil {
+			deviceInfo := string(*checkin.DeviceInfo)
+			deviceInfos[i] = &deviceInfo
+		}
+		sources[i] = checkin.Source
+		deviceIDs[i] = checkin.DeviceID
+	}
+
+	query := `
+		INSERT INTO checkins (
+			id, event_id, participant_id, checked_in_at, checked_in_by,
+			checkin_method, device_info, source, device_id
+		)
+		SELECT * FROM unnest(
+			$1::uuid[], $2::uuid[], $3::uuid[], $4::timestamp[], $5::uuid[], $6::varchar[], $7::jsonb[],
+			$8::varchar[], $9::varchar[]
+		)
+		ON CONFLICT DO NOTHING
+		RETURNING participant_id
+	`
+
+	q := GetQueryable(ctx, r.pool)
+	rows, err := q.Query(ctx, query,
+		ids, eventIDs, participantIDs, checkedInAts, checkedInBys, methods, deviceInfos, sources, deviceIDs,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert checkins: %w", err)
+	}
+	defer rows.Close()
+
+	created := make([]uuid.UUID, 0, len(checkins))
+	for rows.Next() {
+		var participantID uuid.UUID
+		if err := rows.Scan(&participantID); err != nil {
+			return nil, fmt.Errorf("failed to scan checked in participant: %w", err)
+		}
+		created = append(created, participantID)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to insert checkins: %w", err)
+	}
+
+	return created, nil
+}
+
 // FindByID finds a check-in by its unique ID.
 func (r *checkinRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Checkin, error) {
-	query := `
+	query := fmt.Sprintf(`
 		SELECT
 			id, event_id, participant_id, checked_in_at, checked_in_by,
-			checkin_method, device_info
-		FROM checkins
-		WHERE id = $1
-	`
+			checkin_method, device_info, source, device_id, cancelled_at, cancelled_by
+		FROM checkins c
+		WHERE id = $1 AND %s
+	`, liveCheckin("c"))
 
 	row := r.pool.QueryRow(ctx, query, id)
 	checkin, err := r.scanCheckinFromRow(row)
@@ -91,13 +167,13 @@ func (r *checkinRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity
 
 // FindByParticipant finds check-in for a participant.
 func (r *checkinRepository) FindByParticipant(ctx context.Context, participantID uuid.UUID) (*entity.Checkin, error) {
-	query := `
+	query := fmt.Sprintf(`
 		SELECT
 			id, event_id, participant_id, checked_in_at, checked_in_by,
-			checkin_method, device_info
-		FROM checkins
-		WHERE participant_id = $1
-	`
+			checkin_method, device_info, source, device_id, cancelled_at, cancelled_by
+		FROM checkins c
+		WHERE participant_id = $1 AND cancelled_at IS NULL AND %s
+	`, liveCheckin("c"))
 
 	row := r.pool.QueryRow(ctx, query, participantID)
 	checkin, err := r.scanCheckinFromRow(row)
@@ -111,6 +187,20 @@ func (r *checkinRepository) FindByParticipant(ctx context.Context, participantID
 	return checkin, nil
 }
 
+// GetHistory finds every check-in of a participant, including cancelled ones, oldest first.
+func (r *checkinRepository) GetHistory(ctx context.Context, participantID uuid.UUID) ([]*entity.Checkin, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			id, event_id, participant_id, checked_in_at, checked_in_by,
+			checkin_method, device_info, source, device_id, cancelled_at, cancelled_by
+		FROM checkins c
+		WHERE participant_id = $1 AND %s
+		ORDER BY checked_in_at ASC, id ASC
+	`, liveCheckin("c"))
+
+	return r.queryCheckins(ctx, query, participantID)
+}
+
 // FindByEvent finds all check-ins for an event with pagination.
 func (r *checkinRepository) FindByEvent(
 	ctx context.Context,
@@ -121,21 +211,21 @@ func (r *checkinRepository) FindByEvent(
 	int64,
 	error,
 ) {
-	query := `
+	query := fmt.Sprintf(`
 		SELECT
 			id, event_id, participant_id, checked_in_at, checked_in_by,
-			checkin_method, device_info
-		FROM checkins
-		WHERE event_id = $1
+			checkin_method, device_info, source, device_id, cancelled_at, cancelled_by
+		FROM checkins c
+		WHERE event_id = $1 AND cancelled_at IS NULL AND %s
 		ORDER BY checked_in_at DESC
 		LIMIT $2 OFFSET $3
-	`
+	`, liveCheckin("c"))
 
-	countQuery := `
+	countQuery := fmt.Sprintf(`
 		SELECT COUNT(*)
-		FROM checkins
-		WHERE event_id = $1
-	`
+		FROM checkins c
+		WHERE event_id = $1 AND cancelled_at IS NULL AND %s
+	`, liveCheckin("c"))
 
 	checkins, err := r.queryCheckins(ctx, query, eventID, limit, offset)
 	if err != nil {
@@ -152,14 +242,14 @@ func (r *checkinRepository) FindByEvent(
 
 // GetEventStats gets check-in statistics for an event.
 func (r *checkinRepository) GetEventStats(ctx context.Context, eventID uuid.UUID) (*repository.CheckinStats, error) {
-	query := `
+	query := fmt.Sprintf(`
 		SELECT
 			COUNT(DISTINCT p.id) as total_participants,
 			COUNT(DISTINCT c.id) as checked_in_count
 		FROM participants p
-		LEFT JOIN checkins c ON p.id = c.participant_id AND c.event_id = p.event_id
-		WHERE p.event_id = $1
-	`
+		LEFT JOIN checkins c ON p.id = c.participant_id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+		WHERE p.event_id = $1 AND %s
+	`, live("p"))
 
 	stats := &repository.CheckinStats{}
 	err := r.pool.QueryRow(ctx, query, eventID).Scan(
@@ -180,16 +270,198 @@ func (r *checkinRepository) GetEventStats(ctx context.Context, eventID uuid.UUID
 	return stats, nil
 }
 
-// Delete deletes a check-in (undo check-in operation).
-func (r *checkinRepository) Delete(ctx context.Context, id uuid.UUID) error {
+// GetEventProgress counts check-ins and active participants for an event.
+func (r *checkinRepository) GetEventProgress(
+	ctx context.Context,
+	eventID uuid.UUID,
+) (*repository.CheckinProgress, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			(SELECT COUNT(*) FROM checkins c
+			 WHERE event_id = $1 AND cancelled_at IS NULL AND %s) as checked_in_count,
+			(SELECT COUNT(*) FROM participants p
+			 WHERE event_id = $1 AND status IN ('tentative', 'confirmed') AND %s) as total_participants
+	`, liveCheckin("c"), live("p"))
+
+	progress := &repository.CheckinProgress{}
+	err := r.pool.QueryRow(ctx, query, eventID).Scan(
+		&progress.CheckedInCount,
+		&progress.TotalParticipants,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get checkin progress: %w", err)
+	}
+
+	return progress, nil
+}
+
+// CountByStaff counts an event's check-ins per checking-in user.
+// Self check-ins form their own group with a NULL user and are returned separately.
+func (r *checkinRepository) CountByStaff(
+	ctx context.Context,
+	eventID uuid.UUID,
+) (*repository.CheckinAttribution, error) {
+	query := fmt.Sprintf(`
+		SELECT c.checked_in_by, COALESCE(u.name, ''), COUNT(*) as checkin_count
+		FROM checkins c
+		LEFT JOIN users u ON u.id = c.checked_in_by
+		WHERE c.event_id = $1 AND c.cancelled_at IS NULL AND %s
+		GROUP BY c.checked_in_by, u.name
+		ORDER BY checkin_count DESC, u.name ASC
+	`, liveCheckin("c"))
+
+	rows, err := r.pool.Query(ctx, query, eventID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count checkins by staff: %w", err)
+	}
+	defer rows.Close()
+
+	attribution := &repository.CheckinAttribution{Staff: []repository.StaffCheckinCount{}}
+	for rows.Next() {
+		var (
+			userID *uuid.UUID
+			name   string
+			count  int64
+		)
+		if err := rows.Scan(&userID, &name, &count); err != nil {
+			return nil, fmt.Errorf("failed to scan staff checkin count: %w", err)
+		}
+		if userID == nil {
+			attribution.SelfCheckins = count
+			continue
+		}
+		attribution.Staff = append(attribution.Staff, repository.StaffCheckinCount{
+			UserID:       *userID,
+			Name:         name,
+			CheckinCount: count,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate staff checkin counts: %w", err)
+	}
+
+	return attribution, nil
+}
+
+// RecordScan records a QR scan attempt at check-in. Scans of events that no longer exist,
+// such as events deleted while the scan was being recorded, are skipped.
+func (r *checkinRepository) RecordScan(ctx context.Context, scan *entity.CheckinScan) error {
+	query := fmt.Sprintf(`
+		INSERT INTO checkin_scans (event_id, outcome, scanned_at)
+		SELECT $1, $2, $3
+		WHERE EXISTS (SELECT 1 FROM events e WHERE e.id = $1 AND %s)
+	`, live("e"))
+
+	if _, err := r.pool.Exec(ctx, query, scan.EventID, scan.Outcome, scan.ScannedAt); err != nil {
+		return fmt.Errorf("failed to record checkin scan: %w", err)
+	}
+
+	return nil
+}
+
+// CountByInterval counts an event's check-ins per device in time buckets of the given size,
+// aligned like CountScans. The buckets run from the event's start to its end (or start, without
+// an end date), widened to cover every check-in, so early arrivals and late check-ins are not cut off.
+func (r *checkinRepository) CountByInterval(
+	ctx context.Context,
+	eventID uuid.UUID,
+	interval time.Duration,
+) ([]repository.CheckinIntervalCount, error) {
+	query := fmt.Sprintf(`
+		WITH active AS (
+			SELECT c.checked_in_at, c.device_id
+			FROM checkins c
+			WHERE c.event_id = $1 AND c.cancelled_at IS NULL AND %s
+		), bounds AS (
+			SELECT
+				date_bin(make_interval(secs => $2),
+					LEAST(e.start_date, (SELECT MIN(checked_in_at) FROM active)), TIMESTAMP '2000-01-01') AS first_bucket,
+				GREATEST(COALESCE(e.end_date, e.start_date), (SELECT MAX(checked_in_at) FROM active)) AS last_time
+			FROM events e
+			WHERE e.id = $1
+		)
+		SELECT s.bucket_start, a.device_id, COUNT(a.checked_in_at)
+		FROM bounds b
+		CROSS JOIN LATERAL generate_series(b.first_bucket, b.last_time, make_interval(secs => $2)) AS s(bucket_start)
+		LEFT JOIN active a
+			ON a.checked_in_at >= s.bucket_start AND a.checked_in_at < s.bucket_start + make_interval(secs => $2)
+		GROUP BY s.bucket_start, a.device_id
+		ORDER BY s.bucket_start, a.device_id
+	`, liveCheckin("c"))
+
+	rows, err := r.pool.Query(ctx, query, eventID, interval.Seconds())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count checkins by interval: %w", err)
+	}
+	defer rows.Close()
+
+	counts := []repository.CheckinIntervalCount{}
+	for rows.Next() {
+		var count repository.CheckinIntervalCount
+		if err := rows.Scan(&count.BucketStart, &count.DeviceID, &count.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan checkin interval count: %w", err)
+		}
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate checkin interval counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// CountScans counts an event's QR scans per outcome in time buckets of the given size.
+// Buckets are aligned to whole multiples of the size since midnight, 2000-01-01.
+func (r *checkinRepository) CountScans(
+	ctx context.Context,
+	eventID uuid.UUID,
+	bucket time.Duration,
+) ([]repository.ScanOutcomeCount, error) {
 	query := `
-		DELETE FROM checkins
-		WHERE id = $1
+		SELECT date_bin(make_interval(secs => $2), scanned_at, TIMESTAMP '2000-01-01') as bucket_start,
+			outcome, COUNT(*)
+		FROM checkin_scans
+		WHERE event_id = $1
+		GROUP BY bucket_start, outcome
+		ORDER BY bucket_start, outcome
 	`
 
-	result, err := r.pool.Exec(ctx, query, id)
+	rows, err := r.pool.Query(ctx, query, eventID, bucket.Seconds())
 	if err != nil {
-		return fmt.Errorf("failed to delete checkin: %w", err)
+		return nil, fmt.Errorf("failed to count checkin scans: %w", err)
+	}
+	defer rows.Close()
+
+	counts := []repository.ScanOutcomeCount{}
+	for rows.Next() {
+		var count repository.ScanOutcomeCount
+		if err := rows.Scan(&count.BucketStart, &count.Outcome, &count.Count); err != nil {
+			return nil, fmt.Errorf("failed to scan checkin scan count: %w", err)
+		}
+		counts = append(counts, count)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate checkin scan counts: %w", err)
+	}
+
+	return counts, nil
+}
+
+// Cancel marks an active check-in as cancelled (undo check-in operation).
+func (r *checkinRepository) Cancel(
+	ctx context.Context,
+	id, cancelledBy uuid.UUID,
+	cancelledAt time.Time,
+) error {
+	query := `
+		UPDATE checkins
+		SET cancelled_at = $2, cancelled_by = $3, updated_at = NOW()
+		WHERE id = $1 AND cancelled_at IS NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, id, cancelledAt, cancelledBy)
+	if err != nil {
+		return fmt.Errorf("failed to cancel checkin: %w", err)
 	}
 
 	if result.RowsAffected() == 0 {
@@ -199,13 +471,80 @@ func (r *checkinRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Restore re-activates a cancelled check-in.
+func (r *checkinRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE checkins
+		SET cancelled_at = NULL, cancelled_by = NULL, updated_at = NOW()
+		WHERE id = $1 AND cancelled_at IS NOT NULL
+	`
+
+	result, err := r.pool.Exec(ctx, query, id)
+	if err != nil {
+		// The participant checked in again after the cancellation
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" &&
+			strings.Contains(pgErr.ConstraintName, "unique_event_participant_checkin") {
+			return entity.ErrCheckinAlreadyExists
+		}
+		return fmt.Errorf("failed to restore checkin: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperrors.NotFound("cancelled check-in not found")
+	}
+
+	return nil
+}
+
+// Reassign moves every check-in of a participant to another participant of the same event. If
+// both have an active check-in, the later one is ended first, as a participant has at most one.
+func (r *checkinRepository) Reassign(
+	ctx context.Context,
+	fromParticipantID, toParticipantID, endedBy uuid.UUID,
+	endedAt time.Time,
+) (int64, error) {
+	endQuery := `
+		UPDATE checkins
+		SET cancelled_at = $3, cancelled_by = $4, updated_at = NOW()
+		WHERE participant_id IN ($1, $2) AND cancelled_at IS NULL
+			AND id <> (
+				SELECT id FROM checkins
+				WHERE participant_id IN ($1, $2) AND cancelled_at IS NULL
+				ORDER BY checked_in_at, id
+				LIMIT 1
+			)
+	`
+	moveQuery := `
+		UPDATE checkins
+		SET participant_id = $2, updated_at = NOW()
+		WHERE participant_id = $1
+	`
+
+	var moved int64
+	err := inTransaction(ctx, r.pool, func(ctx context.Context) error {
+		q := GetQueryable(ctx, r.pool)
+		if _, err := q.Exec(ctx, endQuery, fromParticipantID, toParticipantID, endedAt, endedBy); err != nil {
+			return fmt.Errorf("failed to end duplicate checkin: %w", err)
+		}
+
+		result, err := q.Exec(ctx, moveQuery, fromParticipantID, toParticipantID)
+		if err != nil {
+			return fmt.Errorf("failed to reassign checkins: %w", err)
+		}
+		moved = result.RowsAffected()
+		return nil
+	})
+	return moved, err
+}
+
 // ExistsByParticipant checks if a participant has already checked in to an event.
 func (r *checkinRepository) ExistsByParticipant(ctx context.Context, eventID, participantID uuid.UUID) (bool, error) {
 	query := `
 		SELECT EXISTS(
 			SELECT 1
 			FROM checkins
-			WHERE event_id = $1 AND participant_id = $2
+			WHERE event_id = $1 AND participant_id = $2 AND cancelled_at IS NULL
 		)
 	`
 
@@ -234,6 +573,10 @@ func (r *checkinRepository) scanCheckinFromRow(row pgx.Row) (*entity.Checkin, er
 		&checkin.CheckedInBy,
 		&checkin.Method,
 		&checkin.DeviceInfo,
+		&checkin.Source,
+		&checkin.DeviceID,
+		&checkin.CancelledAt,
+		&checkin.CancelledBy,
 	)
 	if err != nil {
 		return nil, err
@@ -252,6 +595,10 @@ func (r *checkinRepository) scanCheckin(rows pgx.Rows) (*entity.Checkin, error)
 		&checkin.CheckedInBy,
 		&checkin.Method,
 		&checkin.DeviceInfo,
+		&checkin.Source,
+		&checkin.DeviceID,
+		&checkin.CancelledAt,
+		&checkin.CancelledBy,
 	)
 	if err != nil {
 		return nil, err
@@ -13,6 +13,7 @@ import (
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
@@ -185,6 +186,28 @@ var _ = Describe("CheckinRepository", func() {
 			})
 		})
 
+		Context("with source and device ID", func() {
+			It("should persist them", func() {
+				source, deviceID := "scanner-app", "gate-a-01"
+				checkin := &entity.Checkin{
+					ID:            uuid.New(),
+					EventID:       testEvent.ID,
+					ParticipantID: testParticipant.ID,
+					CheckedInAt:   time.Now(),
+					Method:        entity.CheckinMethodQRCode,
+					Source:        &source,
+					DeviceID:      &deviceID,
+				}
+
+				Expect(repo.Create(ctx, checkin)).To(Succeed())
+
+				found, err := repo.FindByParticipant(ctx, testParticipant.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found.Source).To(HaveValue(Equal("scanner-app")))
+				Expect(found.DeviceID).To(HaveValue(Equal("gate-a-01")))
+			})
+		})
+
 		Context("with nil checked_in_by (self-service)", func() {
 			It("should succeed", func() {
 				checkin := &entity.Checkin{
@@ -250,6 +273,73 @@ var _ = Describe("CheckinRepository", func() {
 		})
 	})
 
+	When("bulk creating check-ins", func() {
+		var secondParticipant *entity.Participant
+
+		BeforeEach(func() {
+			secondParticipant = &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           testEvent.ID,
+				Name:              "Second Participant",
+				Email:             "second@example.com",
+				QRCode:            "test-qr-code-" + uuid.New().String(),
+				QRCodeGeneratedAt: time.Now(),
+				Status:            entity.ParticipantStatusConfirmed,
+				PaymentStatus:     entity.PaymentUnpaid,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(participantRepo.Create(ctx, secondParticipant)).To(Succeed())
+		})
+
+		newManualCheckin := func(participantID uuid.UUID) *entity.Checkin {
+			return &entity.Checkin{
+				ID:            uuid.New(),
+				EventID:       testEvent.ID,
+				ParticipantID: participantID,
+				CheckedInAt:   time.Now(),
+				CheckedInBy:   &testUser.ID,
+				Method:        entity.CheckinMethodManual,
+			}
+		}
+
+		Context("with participants not yet checked in", func() {
+			It("should create every check-in", func() {
+				created, err := repo.BulkCreate(ctx, []*entity.Checkin{
+					newManualCheckin(testParticipant.ID),
+					newManualCheckin(secondParticipant.ID),
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(ConsistOf(testParticipant.ID, secondParticipant.ID))
+
+				exists, err := repo.ExistsByParticipant(ctx, testEvent.ID, secondParticipant.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(exists).To(BeTrue())
+			})
+		})
+
+		Context("with a participant already checked in", func() {
+			It("should skip that participant", func() {
+				Expect(repo.Create(ctx, newManualCheckin(testParticipant.ID))).To(Succeed())
+
+				created, err := repo.BulkCreate(ctx, []*entity.Checkin{
+					newManualCheckin(testParticipant.ID),
+					newManualCheckin(secondParticipant.ID),
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(ConsistOf(secondParticipant.ID))
+			})
+		})
+
+		Context("with no check-ins", func() {
+			It("should create nothing", func() {
+				created, err := repo.BulkCreate(ctx, nil)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created).To(BeEmpty())
+			})
+		})
+	})
+
 	When("finding check-in by ID", func() {
 		Context("with existing check-in", func() {
 			It("should return the check-in", func() {
@@ -460,6 +550,106 @@ var _ = Describe("CheckinRepository", func() {
 		})
 	})
 
+	When("getting event check-in progress", func() {
+		It("should count check-ins against active participants only", func() {
+			cancelled := &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           testEvent.ID,
+				Name:              "Cancelled Participant",
+				Email:             "progress-cancelled@example.com",
+				QRCode:            "qr-progress-" + uuid.New().String(),
+				QRCodeGeneratedAt: time.Now(),
+				Status:            entity.ParticipantStatusCancelled,
+				PaymentStatus:     entity.PaymentUnpaid,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(participantRepo.Create(ctx, cancelled)).To(Succeed())
+
+			checkin := &entity.Checkin{
+				ID:            uuid.New(),
+				EventID:       testEvent.ID,
+				ParticipantID: testParticipant.ID,
+				CheckedInAt:   time.Now(),
+				CheckedInBy:   &testUser.ID,
+				Method:        entity.CheckinMethodQRCode,
+			}
+			Expect(repo.Create(ctx, checkin)).To(Succeed())
+
+			progress, err := repo.GetEventProgress(ctx, testEvent.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress.CheckedInCount).To(Equal(int64(1)))
+			Expect(progress.TotalParticipants).To(Equal(int64(1)))
+		})
+
+		It("should return zero counts for an event without participants", func() {
+			progress, err := repo.GetEventProgress(ctx, uuid.New())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress.CheckedInCount).To(BeZero())
+			Expect(progress.TotalParticipants).To(BeZero())
+		})
+	})
+
+	When("counting check-ins by staff", func() {
+		It("should group check-ins by staff member and count self check-ins separately", func() {
+			staff := &entity.User{
+				ID:           uuid.New(),
+				Email:        "staff@example.com",
+				PasswordHash: "hash",
+				Name:         "Door Staff",
+				Role:         entity.RoleStaff,
+				CreatedAt:    time.Now(),
+				UpdatedAt:    time.Now(),
+			}
+			Expect(database.NewUserRepository(db.GetPool(), log).Create(ctx, staff)).To(Succeed())
+
+			checkedInBy := []*uuid.UUID{&staff.ID, &staff.ID, &testUser.ID, nil, nil, nil}
+			for i, by := range checkedInBy {
+				participant := &entity.Participant{
+					ID:                uuid.New(),
+					EventID:           testEvent.ID,
+					Name:              fmt.Sprintf("Staff Report Participant %d", i),
+					Email:             fmt.Sprintf("by-staff-%d@example.com", i),
+					QRCode:            "qr-by-staff-" + uuid.New().String(),
+					QRCodeGeneratedAt: time.Now(),
+					Status:            entity.ParticipantStatusConfirmed,
+					PaymentStatus:     entity.PaymentUnpaid,
+					CreatedAt:         time.Now(),
+					UpdatedAt:         time.Now(),
+				}
+				Expect(participantRepo.Create(ctx, participant)).To(Succeed())
+
+				method := entity.CheckinMethodManual
+				if by == nil {
+					method = entity.CheckinMethodQRCode
+				}
+				Expect(repo.Create(ctx, &entity.Checkin{
+					ID:            uuid.New(),
+					EventID:       testEvent.ID,
+					ParticipantID: participant.ID,
+					CheckedInAt:   time.Now(),
+					CheckedInBy:   by,
+					Method:        method,
+				})).To(Succeed())
+			}
+
+			attribution, err := repo.CountByStaff(ctx, testEvent.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attribution.Staff).To(Equal([]repository.StaffCheckinCount{
+				{UserID: staff.ID, Name: "Door Staff", CheckinCount: 2},
+				{UserID: testUser.ID, Name: "Test Organizer", CheckinCount: 1},
+			}))
+			Expect(attribution.SelfCheckins).To(Equal(int64(3)))
+		})
+
+		It("should return an empty breakdown for an event without check-ins", func() {
+			attribution, err := repo.CountByStaff(ctx, testEvent.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attribution.Staff).To(BeEmpty())
+			Expect(attribution.SelfCheckins).To(BeZero())
+		})
+	})
+
 	When("checking if participant has checked in", func() {
 		Context("with participant who has checked in", func() {
 			It("should return true", func() {
@@ -489,37 +679,289 @@ var _ = Describe("CheckinRepository", func() {
 		})
 	})
 
-	When("deleting a check-in (undo)", func() {
-		Context("with existing check-in", func() {
-			It("should succeed", func() {
-				checkin := &entity.Checkin{
+	When("cancelling a check-in (undo)", func() {
+		var checkin *entity.Checkin
+
+		BeforeEach(func() {
+			checkin = &entity.Checkin{
+				ID:            uuid.New(),
+				EventID:       testEvent.ID,
+				ParticipantID: testParticipant.ID,
+				CheckedInAt:   time.Now(),
+				CheckedInBy:   &testUser.ID,
+				Method:        entity.CheckinMethodQRCode,
+			}
+			Expect(repo.Create(ctx, checkin)).To(Succeed())
+		})
+
+		Context("with an active check-in", func() {
+			It("should keep the record but no longer count it as checked in", func() {
+				err := repo.Cancel(ctx, checkin.ID, testUser.ID, time.Now())
+				Expect(err).NotTo(HaveOccurred())
+
+				found, err := repo.FindByID(ctx, checkin.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found.IsCancelled()).To(BeTrue())
+				Expect(*found.CancelledBy).To(Equal(testUser.ID))
+
+				exists, err := repo.ExistsByParticipant(ctx, testEvent.ID, testParticipant.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(exists).To(BeFalse())
+
+				_, err = repo.FindByParticipant(ctx, testParticipant.ID)
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+
+				checkins, total, err := repo.FindByEvent(ctx, testEvent.ID, 10, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(checkins).To(BeEmpty())
+				Expect(total).To(BeZero())
+			})
+
+			It("should allow the participant to check in again", func() {
+				Expect(repo.Cancel(ctx, checkin.ID, testUser.ID, time.Now())).To(Succeed())
+
+				again := &entity.Checkin{
 					ID:            uuid.New(),
 					EventID:       testEvent.ID,
 					ParticipantID: testParticipant.ID,
 					CheckedInAt:   time.Now(),
-					CheckedInBy:   &testUser.ID,
-					Method:        entity.CheckinMethodQRCode,
+					Method:        entity.CheckinMethodManual,
 				}
-				err := repo.Create(ctx, checkin)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(repo.Create(ctx, again)).To(Succeed())
+			})
+		})
 
-				err = repo.Delete(ctx, checkin.ID)
-				Expect(err).NotTo(HaveOccurred())
+		Context("with an already cancelled check-in", func() {
+			It("should return not found", func() {
+				Expect(repo.Cancel(ctx, checkin.ID, testUser.ID, time.Now())).To(Succeed())
 
-				// Verify the check-in was deleted
-				_, err = repo.FindByID(ctx, checkin.ID)
-				Expect(err).To(HaveOccurred())
+				err := repo.Cancel(ctx, checkin.ID, testUser.ID, time.Now())
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
 			})
 		})
 
 		Context("with non-existent check-in", func() {
 			It("should return error", func() {
-				err := repo.Delete(ctx, uuid.New())
+				err := repo.Cancel(ctx, uuid.New(), testUser.ID, time.Now())
 				Expect(err).To(HaveOccurred())
 			})
 		})
 	})
 
+	When("restoring a cancelled check-in", func() {
+		var checkin *entity.Checkin
+
+		BeforeEach(func() {
+			checkin = &entity.Checkin{
+				ID:            uuid.New(),
+				EventID:       testEvent.ID,
+				ParticipantID: testParticipant.ID,
+				CheckedInAt:   time.Now(),
+				CheckedInBy:   &testUser.ID,
+				Method:        entity.CheckinMethodQRCode,
+			}
+			Expect(repo.Create(ctx, checkin)).To(Succeed())
+			Expect(repo.Cancel(ctx, checkin.ID, testUser.ID, time.Now())).To(Succeed())
+		})
+
+		Context("when the participant has not checked in again", func() {
+			It("should re-activate the check-in", func() {
+				Expect(repo.Restore(ctx, checkin.ID)).To(Succeed())
+
+				found, err := repo.FindByParticipant(ctx, testParticipant.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found.ID).To(Equal(checkin.ID))
+				Expect(found.IsCancelled()).To(BeFalse())
+				Expect(found.CancelledBy).To(BeNil())
+			})
+		})
+
+		Context("when the participant has checked in again", func() {
+			It("should return ErrCheckinAlreadyExists", func() {
+				again := &entity.Checkin{
+					ID:            uuid.New(),
+					EventID:       testEvent.ID,
+					ParticipantID: testParticipant.ID,
+					CheckedInAt:   time.Now(),
+					Method:        entity.CheckinMethodManual,
+				}
+				Expect(repo.Create(ctx, again)).To(Succeed())
+
+				err := repo.Restore(ctx, checkin.ID)
+				Expect(err).To(MatchError(entity.ErrCheckinAlreadyExists))
+			})
+		})
+
+		Context("with an active check-in", func() {
+			It("should return not found", func() {
+				Expect(repo.Restore(ctx, checkin.ID)).To(Succeed())
+
+				err := repo.Restore(ctx, checkin.ID)
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+	})
+
+	When("getting the check-in history of a participant", func() {
+		Context("with a participant who checked out and in again", func() {
+			It("should return every check-in, oldest first", func() {
+				first := &entity.Checkin{
+					ID:            uuid.New(),
+					EventID:       testEvent.ID,
+					ParticipantID: testParticipant.ID,
+					CheckedInAt:   time.Now().Add(-2 * time.Hour),
+					CheckedInBy:   &testUser.ID,
+					Method:        entity.CheckinMethodQRCode,
+				}
+				Expect(repo.Create(ctx, first)).To(Succeed())
+				Expect(repo.Cancel(ctx, first.ID, testUser.ID, time.Now().Add(-time.Hour))).To(Succeed())
+
+				second := &entity.Checkin{
+					ID:            uuid.New(),
+					EventID:       testEvent.ID,
+					ParticipantID: testParticipant.ID,
+					CheckedInAt:   time.Now(),
+					Method:        entity.CheckinMethodQRCode,
+				}
+				Expect(repo.Create(ctx, second)).To(Succeed())
+
+				history, err := repo.GetHistory(ctx, testParticipant.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(history).To(HaveLen(2))
+				Expect(history[0].ID).To(Equal(first.ID))
+				Expect(history[0].IsCancelled()).To(BeTrue())
+				Expect(history[1].ID).To(Equal(second.ID))
+				Expect(history[1].IsCancelled()).To(BeFalse())
+			})
+		})
+
+		Context("with a participant who has not checked in", func() {
+			It("should return an empty list", func() {
+				history, err := repo.GetHistory(ctx, testParticipant.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(history).To(BeEmpty())
+			})
+		})
+	})
+
+	When("recording and counting QR scans", func() {
+		It("should count the scans per outcome in time buckets", func() {
+			start := time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC)
+			scans := []*entity.CheckinScan{
+				{EventID: testEvent.ID, Outcome: entity.ScanOutcomeSuccess, ScannedAt: start.Add(time.Minute)},
+				{EventID: testEvent.ID, Outcome: entity.ScanOutcomeSuccess, ScannedAt: start.Add(14 * time.Minute)},
+				{EventID: testEvent.ID, Outcome: entity.ScanOutcomeNotFound, ScannedAt: start.Add(5 * time.Minute)},
+				{EventID: testEvent.ID, Outcome: entity.ScanOutcomeDuplicate, ScannedAt: start.Add(16 * time.Minute)},
+			}
+			for _, scan := range scans {
+				Expect(repo.RecordScan(ctx, scan)).To(Succeed())
+			}
+
+			counts, err := repo.CountScans(ctx, testEvent.ID, 15*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(HaveLen(3))
+			Expect(counts[0].BucketStart.Equal(start)).To(BeTrue())
+			Expect(counts[0].Outcome).To(Equal(entity.ScanOutcomeNotFound))
+			Expect(counts[0].Count).To(Equal(int64(1)))
+			Expect(counts[1].Outcome).To(Equal(entity.ScanOutcomeSuccess))
+			Expect(counts[1].Count).To(Equal(int64(2)))
+			Expect(counts[2].BucketStart.Equal(start.Add(15 * time.Minute))).To(BeTrue())
+			Expect(counts[2].Outcome).To(Equal(entity.ScanOutcomeDuplicate))
+		})
+
+		It("should return no counts for an event without scans", func() {
+			counts, err := repo.CountScans(ctx, testEvent.ID, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(BeEmpty())
+		})
+
+		It("should skip scans of events that no longer exist", func() {
+			Expect(eventRepo.Delete(ctx, testEvent.ID)).To(Succeed())
+			Expect(repo.RecordScan(ctx, &entity.CheckinScan{
+				EventID: testEvent.ID, Outcome: entity.ScanOutcomeSuccess, ScannedAt: time.Now(),
+			})).To(Succeed())
+			Expect(repo.RecordScan(ctx, &entity.CheckinScan{
+				EventID: uuid.New(), Outcome: entity.ScanOutcomeSuccess, ScannedAt: time.Now(),
+			})).To(Succeed())
+
+			counts, err := repo.CountScans(ctx, testEvent.ID, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(BeEmpty())
+		})
+	})
+
+	When("counting check-ins by interval", func() {
+		It("should return every bucket of the event window, counting empty buckets as zero", func() {
+			start := time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC)
+			end := start.Add(time.Hour)
+			event := &entity.Event{
+				ID:          uuid.New(),
+				OrganizerID: testUser.ID,
+				Name:        "Timed Event",
+				StartDate:   start,
+				EndDate:     &end,
+				Location:    "Location",
+				Timezone:    "UTC",
+				Status:      entity.StatusPublished,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+			}
+			Expect(eventRepo.Create(ctx, event)).To(Succeed())
+
+			// An early arrival before the start widens the window by a bucket; only it has a device ID
+			earlyDeviceID := "gate-a-01"
+			for _, checkedInAt := range []time.Time{start.Add(-10 * time.Minute), start.Add(5 * time.Minute)} {
+				var deviceID *string
+				if checkedInAt.Before(start) {
+					deviceID = &earlyDeviceID
+				}
+				p := &entity.Participant{
+					ID:                uuid.New(),
+					EventID:           event.ID,
+					Name:              "Timed Participant",
+					Email:             uuid.New().String() + "@example.com",
+					QRCode:            "test-qr-code-" + uuid.New().String(),
+					QRCodeGeneratedAt: time.Now(),
+					Status:            entity.ParticipantStatusConfirmed,
+					PaymentStatus:     entity.PaymentUnpaid,
+					CreatedAt:         time.Now(),
+					UpdatedAt:         time.Now(),
+				}
+				Expect(participantRepo.Create(ctx, p)).To(Succeed())
+				Expect(repo.Create(ctx, &entity.Checkin{
+					ID:            uuid.New(),
+					EventID:       event.ID,
+					ParticipantID: p.ID,
+					CheckedInAt:   checkedInAt,
+					Method:        entity.CheckinMethodQRCode,
+					DeviceID:      deviceID,
+				})).To(Succeed())
+			}
+
+			counts, err := repo.CountByInterval(ctx, event.ID, 15*time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(HaveLen(6))
+			Expect(counts[0].BucketStart.Equal(start.Add(-15 * time.Minute))).To(BeTrue())
+			Expect(counts[0].DeviceID).To(HaveValue(Equal("gate-a-01")))
+			Expect(counts[0].Count).To(Equal(int64(1)))
+			Expect(counts[1].BucketStart.Equal(start)).To(BeTrue())
+			Expect(counts[1].DeviceID).To(BeNil())
+			Expect(counts[1].Count).To(Equal(int64(1)))
+			for _, count := range counts[2:] {
+				Expect(count.DeviceID).To(BeNil())
+				Expect(count.Count).To(BeZero())
+			}
+			Expect(counts[5].BucketStart.Equal(end)).To(BeTrue())
+		})
+
+		It("should return the bucket of the start for an event without check-ins or end date", func() {
+			counts, err := repo.CountByInterval(ctx, testEvent.ID, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(counts).To(HaveLen(1))
+			Expect(counts[0].Count).To(BeZero())
+		})
+	})
+
 	When("performing health check", func() {
 		Context("with healthy database connection", func() {
 			It("should succeed", func() {
@@ -0,0 +1,141 @@
+package database
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// eventTimeSortKeys are the sort keys of timestamp columns, whose cursor values are
+// RFC 3339 timestamps.
+var eventTimeSortKeys = map[string]bool{
+	"created_at": true,
+	"updated_at": true,
+	"start_date": true,
+	"end_date":   true,
+}
+
+// eventNullableSortKeys are the sort keys of columns that may be NULL.
+var eventNullableSortKeys = map[string]bool{
+	"end_date": true,
+}
+
+// eventCursor is the position of an event in a sorted event list: its sort column value and
+// its ID, which breaks ties. The sort it was taken in is recorded too, since a position in one
+// sort means nothing in another. Cursors are handed to clients as base64url-encoded JSON.
+type eventCursor struct {
+	Sort  string    `json:"sort"`
+	Order string    `json:"order"`
+	Value *string   `json:"value"` // nil when the sort column is NULL
+	ID    uuid.UUID `json:"id"`
+
+	value any // Value decoded to the column's type
+}
+
+// newEventCursor returns the position of event in the list sorted by sortKey in order.
+func newEventCursor(sortKey, order string, event *entity.Event) eventCursor {
+	cursor := eventCursor{Sort: sortKey, Order: order, ID: event.ID}
+	formatTime := func(t time.Time) *string {
+		value := t.UTC().Format(time.RFC3339Nano)
+		return &value
+	}
+
+	switch sortKey {
+	case "created_at":
+		cursor.Value = formatTime(event.CreatedAt)
+	case "updated_at":
+		cursor.Value = formatTime(event.UpdatedAt)
+	case "start_date":
+		cursor.Value = formatTime(event.StartDate)
+	case "end_date":
+		if event.EndDate != nil {
+			cursor.Value = formatTime(*event.EndDate)
+		}
+	case "name":
+		cursor.Value = &event.Name
+	case "status":
+		status := string(event.Status)
+		cursor.Value = &status
+	}
+	return cursor
+}
+
+// encode returns the opaque string handed to clients.
+func (c eventCursor) encode() (string, error) {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return "", apperrors.Wrapf(err, "failed to encode event cursor")
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+// decodeEventCursor decodes a cursor returned by encode, checking that it was issued for the
+// list sorted by sortKey in order.
+func decodeEventCursor(cursor, sortKey, order string) (eventCursor, error) {
+	invalid := apperrors.BadRequest("invalid cursor")
+
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return eventCursor{}, invalid
+	}
+	var decoded eventCursor
+	if err := json.Unmarshal(data, &decoded); err != nil || decoded.ID == uuid.Nil {
+		return eventCursor{}, invalid
+	}
+	if decoded.Sort != sortKey || decoded.Order != order {
+		return eventCursor{}, apperrors.BadRequest("cursor does not match the requested sort and order")
+	}
+
+	switch {
+	case decoded.Value == nil:
+		if !eventNullableSortKeys[sortKey] {
+			return eventCursor{}, invalid
+		}
+	case eventTimeSortKeys[sortKey]:
+		t, err := time.Parse(time.RFC3339Nano, *decoded.Value)
+		if err != nil {
+			return eventCursor{}, invalid
+		}
+		decoded.value = t
+	default:
+		decoded.value = *decoded.Value
+	}
+	return decoded, nil
+}
+
+// seekClause returns the condition selecting the events that come after c in the order of
+// buildOrderByClause, with its arguments appended to args numbered from argIdx. PostgreSQL
+// sorts NULLs last ascending and first descending, so a NULL sort value is followed by the
+// remaining NULLs by ID, and then by nothing ascending or by every non-NULL value descending.
+func (c eventCursor) seekClause(args []interface{}, argIdx int) (string, []interface{}, int) {
+	col := allowedEventSortColumns[c.Sort]
+	idArg := argIdx
+	args = append(args, c.ID)
+	argIdx++
+
+	if c.Value == nil {
+		if c.Order == "asc" {
+			return fmt.Sprintf("(%s IS NULL AND e.id > $%d)", col, idArg), args, argIdx
+		}
+		return fmt.Sprintf("((%s IS NULL AND e.id > $%d) OR %s IS NOT NULL)", col, idArg, col), args, argIdx
+	}
+
+	valueArg := argIdx
+	args = append(args, c.value)
+	argIdx++
+
+	cmp := "<"
+	if c.Order == "asc" {
+		cmp = ">"
+	}
+	clause := fmt.Sprintf("%s %s $%d OR (%s = $%d AND e.id > $%d)", col, cmp, valueArg, col, valueArg, idArg)
+	if c.Order == "asc" && eventNullableSortKeys[c.Sort] {
+		clause += fmt.Sprintf(" OR %s IS NULL", col)
+	}
+	return "(" + clause + ")", args, argIdx
+}
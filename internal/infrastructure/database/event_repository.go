@@ -2,9 +2,12 @@ package database
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
@@ -26,6 +29,16 @@ var allowedEventSortColumns = map[string]string{
 	"status":     "e.status",
 }
 
+// eventCountColumns selects an event's active participant and check-in counts, leaving out
+// deleted participants and their check-ins.
+var eventCountColumns = fmt.Sprintf(`
+	(SELECT COUNT(*) FROM participants p
+	 WHERE p.event_id = e.id AND p.status IN ('tentative', 'confirmed') AND %s) AS participant_count,
+	(SELECT COUNT(*) FROM checkins c
+	 WHERE c.event_id = e.id AND c.cancelled_at IS NULL AND %s) AS checked_in_count`,
+	live("p"), liveCheckin("c"),
+)
+
 // EventRepository implements repository.EventRepository using PostgreSQL
 type EventRepository struct {
 	pool   *pgxpool.Pool
@@ -45,14 +58,26 @@ func (r *EventRepository) Create(ctx context.Context, event *entity.Event) error
 	query := `
 		INSERT INTO events (
 			id, organizer_id, name, description, start_date, end_date,
-			location, timezone, status, created_at, updated_at
+			location, timezone, currency, fee_type, fee_amount, fee_tiers,
+			requires_consent, consent_version, legal_hold, tentative_expiry_hours, capacity, custom_fields,
+			series_id, status, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+			$1, $2, $3, $4, $5, $6, $7, $8, NULLIF($9, ''), NULLIF($10, ''), NULLIF($11::BIGINT, 0), $12,
+			$13, NULLIF($14, ''), $15, NULLIF($16::INTEGER, 0), NULLIF($17::INTEGER, 0), $18, $19, $20, $21, $22
 		)
 	`
 
+	feeTiers, err := marshalFeeTiers(event.FeeTiers)
+	if err != nil {
+		return err
+	}
+	customFields, err := marshalCustomFields(event.CustomFields)
+	if err != nil {
+		return err
+	}
+
 	q := GetQueryable(ctx, r.pool)
-	_, err := q.Exec(ctx, query,
+	_, err = q.Exec(ctx, query,
 		event.ID,
 		event.OrganizerID,
 		event.Name,
@@ -61,6 +86,17 @@ func (r *EventRepository) Create(ctx context.Context, event *entity.Event) error
 		event.EndDate,
 		event.Location,
 		event.Timezone,
+		event.Currency,
+		event.FeeType,
+		event.FeeAmount,
+		feeTiers,
+		event.RequiresConsent,
+		event.ConsentVersion,
+		event.LegalHold,
+		event.TentativeExpiryHours,
+		event.Capacity,
+		customFields,
+		event.SeriesID,
 		event.Status,
 		event.CreatedAt,
 		event.UpdatedAt,
@@ -80,18 +116,30 @@ func (r *EventRepository) Create(ctx context.Context, event *entity.Event) error
 
 // FindByID retrieves an event by its unique ID
 func (r *EventRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
-	query := `
+	return r.findOne(ctx, id, live("e"))
+}
+
+// FindDeletedByID retrieves a soft deleted event by its unique ID
+func (r *EventRepository) FindDeletedByID(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+	return r.findOne(ctx, id, "e.deleted_at IS NOT NULL")
+}
+
+// findOne retrieves the event with the given ID if it also matches condition
+func (r *EventRepository) findOne(ctx context.Context, id uuid.UUID, condition string) (*entity.Event, error) {
+	query := fmt.Sprintf(`
 		SELECT
 			id, organizer_id, name, description, start_date, end_date,
-			location, timezone, status, created_at, updated_at,
-			(SELECT COUNT(*) FROM participants
-			 WHERE event_id = e.id AND status NOT IN ('cancelled', 'declined')) AS participant_count,
-			(SELECT COUNT(*) FROM checkins WHERE event_id = e.id) AS checked_in_count
+			location, timezone, COALESCE(currency, ''), COALESCE(fee_type, ''), COALESCE(fee_amount, 0), fee_tiers,
+			requires_consent, COALESCE(consent_version, ''), legal_hold, pii_purged_at,
+			COALESCE(tentative_expiry_hours, 0), COALESCE(capacity, 0), custom_fields, series_id, deleted_at, status,
+			created_at, updated_at,
+			%s
 		FROM events e
-		WHERE id = $1
-	`
+		WHERE id = $1 AND %s
+	`, eventCountColumns, condition)
 
 	var event entity.Event
+	var feeTiers, customFields []byte
 	q := GetQueryable(ctx, r.pool)
 	err := q.QueryRow(ctx, query, id).Scan(
 		&event.ID,
@@ -102,6 +150,19 @@ func (r *EventRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.E
 		&event.EndDate,
 		&event.Location,
 		&event.Timezone,
+		&event.Currency,
+		&event.FeeType,
+		&event.FeeAmount,
+		&feeTiers,
+		&event.RequiresConsent,
+		&event.ConsentVersion,
+		&event.LegalHold,
+		&event.PIIPurgedAt,
+		&event.TentativeExpiryHours,
+		&event.Capacity,
+		&customFields,
+		&event.SeriesID,
+		&event.DeletedAt,
 		&event.Status,
 		&event.CreatedAt,
 		&event.UpdatedAt,
@@ -114,10 +175,70 @@ func (r *EventRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.E
 		}
 		return nil, apperrors.Wrapf(err, "failed to find event by id")
 	}
+	if event.FeeTiers, err = unmarshalFeeTiers(feeTiers); err != nil {
+		return nil, err
+	}
+	if event.CustomFields, err = unmarshalCustomFields(customFields); err != nil {
+		return nil, err
+	}
 
 	return &event, nil
 }
 
+// FindByIDs retrieves the events with the given IDs in a single query.
+// IDs without an event are skipped; the order of the result is unspecified.
+func (r *EventRepository) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Event, error) {
+	if len(ids) == 0 {
+		return []*entity.Event{}, nil
+	}
+
+	query := fmt.Sprintf(`
+		SELECT
+			e.id, e.organizer_id, e.name, e.description, e.start_date, e.end_date,
+			e.location, e.timezone, COALESCE(e.currency, ''), COALESCE(e.fee_type, ''), COALESCE(e.fee_amount, 0),
+			e.fee_tiers, e.requires_consent, COALESCE(e.consent_version, ''), e.legal_hold, e.pii_purged_at,
+			COALESCE(e.tentative_expiry_hours, 0), COALESCE(e.capacity, 0), e.custom_fields, e.series_id,
+			e.deleted_at, e.status, e.created_at, e.updated_at,
+			%s
+		FROM events e
+		WHERE e.id = ANY($1) AND %s
+	`, eventCountColumns, live("e"))
+
+	q := GetQueryable(ctx, r.pool)
+	rows, err := q.Query(ctx, query, ids)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "failed to find events by ids")
+	}
+	defer rows.Close()
+
+	return r.scanEventRows(rows, len(ids))
+}
+
+// FindBySeriesID retrieves the occurrences of a recurring event series, ordered by start date
+func (r *EventRepository) FindBySeriesID(ctx context.Context, seriesID uuid.UUID) ([]*entity.Event, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			e.id, e.organizer_id, e.name, e.description, e.start_date, e.end_date,
+			e.location, e.timezone, COALESCE(e.currency, ''), COALESCE(e.fee_type, ''), COALESCE(e.fee_amount, 0),
+			e.fee_tiers, e.requires_consent, COALESCE(e.consent_version, ''), e.legal_hold, e.pii_purged_at,
+			COALESCE(e.tentative_expiry_hours, 0), COALESCE(e.capacity, 0), e.custom_fields, e.series_id,
+			e.deleted_at, e.status, e.created_at, e.updated_at,
+			%s
+		FROM events e
+		WHERE e.series_id = $1 AND %s
+		ORDER BY e.start_date, e.id
+	`, eventCountColumns, live("e"))
+
+	q := GetQueryable(ctx, r.pool)
+	rows, err := q.Query(ctx, query, seriesID)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "failed to find events by series id")
+	}
+	defer rows.Close()
+
+	return r.scanEventRows(rows, 0)
+}
+
 // List retrieves a paginated and filtered list of events
 func (r *EventRepository) List(
 	ctx context.Context,
@@ -127,7 +248,7 @@ func (r *EventRepository) List(
 	whereSQL, args, argIdx := r.buildListWhereClause(filter)
 
 	// Get total count
-	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM events WHERE %s", whereSQL)
+	countQuery := fmt.Sprintf("SELECT COUNT(*) FROM events e WHERE %s", whereSQL)
 	var total int64
 	q := GetQueryable(ctx, r.pool)
 	err := q.QueryRow(ctx, countQuery, args...).Scan(&total)
@@ -135,19 +256,27 @@ func (r *EventRepository) List(
 		return nil, 0, apperrors.Wrapf(err, "failed to count events")
 	}
 
+	rankSQL, args, argIdx := eventSearchRank(filter, args, argIdx)
+	orderBySQL := buildOrderByClause(filter)
+	if filter.Sort == "" && isEventFullTextSearch(filter) {
+		orderBySQL = "search_rank DESC, e.id ASC"
+	}
+
 	// Get paginated results
 	query := fmt.Sprintf(`
 		SELECT
 			e.id, e.organizer_id, e.name, e.description, e.start_date, e.end_date,
-			e.location, e.timezone, e.status, e.created_at, e.updated_at,
-			(SELECT COUNT(*) FROM participants
-			 WHERE event_id = e.id AND status NOT IN ('cancelled', 'declined')) AS participant_count,
-			(SELECT COUNT(*) FROM checkins WHERE event_id = e.id) AS checked_in_count
+			e.location, e.timezone, COALESCE(e.currency, ''), COALESCE(e.fee_type, ''), COALESCE(e.fee_amount, 0),
+			e.fee_tiers, e.requires_consent, COALESCE(e.consent_version, ''), e.legal_hold, e.pii_purged_at,
+			COALESCE(e.tentative_expiry_hours, 0), COALESCE(e.capacity, 0), e.custom_fields, e.series_id,
+			e.deleted_at, e.status, e.created_at, e.updated_at,
+			%s,
+			%s AS search_rank
 		FROM events e
 		WHERE %s
 		ORDER BY %s
 		LIMIT $%d OFFSET $%d
-	`, whereSQL, buildOrderByClause(filter), argIdx, argIdx+1)
+	`, eventCountColumns, rankSQL, whereSQL, orderBySQL, argIdx, argIdx+1)
 
 	args = append(args, limit, offset)
 	rows, err := q.Query(ctx, query, args...)
@@ -156,7 +285,7 @@ func (r *EventRepository) List(
 	}
 	defer rows.Close()
 
-	events, err := r.scanEventRows(rows, limit)
+	events, err := r.scanRankedEventRows(rows, limit)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -164,9 +293,70 @@ func (r *EventRepository) List(
 	return events, total, nil
 }
 
+// ListCursor retrieves a page of filtered events after a keyset cursor
+func (r *EventRepository) ListCursor(
+	ctx context.Context,
+	filter repository.EventListFilter,
+	cursor string,
+	limit int,
+) ([]*entity.Event, string, error) {
+	sortKey, order := eventSort(filter)
+	whereSQL, args, argIdx := r.buildListWhereClause(filter)
+
+	if cursor != "" {
+		after, err := decodeEventCursor(cursor, sortKey, order)
+		if err != nil {
+			return nil, "", err
+		}
+		var seekSQL string
+		seekSQL, args, argIdx = after.seekClause(args, argIdx)
+		whereSQL += " AND " + seekSQL
+	}
+	var rankSQL string
+	rankSQL, args, argIdx = eventSearchRank(filter, args, argIdx)
+
+	// Fetch one event more than the page holds to tell whether another page follows
+	query := fmt.Sprintf(`
+		SELECT
+			e.id, e.organizer_id, e.name, e.description, e.start_date, e.end_date,
+			e.location, e.timezone, COALESCE(e.currency, ''), COALESCE(e.fee_type, ''), COALESCE(e.fee_amount, 0),
+			e.fee_tiers, e.requires_consent, COALESCE(e.consent_version, ''), e.legal_hold, e.pii_purged_at,
+			COALESCE(e.tentative_expiry_hours, 0), COALESCE(e.capacity, 0), e.custom_fields, e.series_id,
+			e.deleted_at, e.status, e.created_at, e.updated_at,
+			%s,
+			%s AS search_rank
+		FROM events e
+		WHERE %s
+		ORDER BY %s
+		LIMIT $%d
+	`, eventCountColumns, rankSQL, whereSQL, buildOrderByClause(filter), argIdx)
+
+	args = append(args, limit+1)
+	rows, err := GetQueryable(ctx, r.pool).Query(ctx, query, args...)
+	if err != nil {
+		return nil, "", apperrors.Wrapf(err, "failed to list events")
+	}
+	defer rows.Close()
+
+	events, err := r.scanRankedEventRows(rows, limit+1)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(events) <= limit {
+		return events, "", nil
+	}
+
+	events = events[:limit]
+	next, err := newEventCursor(sortKey, order, events[limit-1]).encode()
+	if err != nil {
+		return nil, "", err
+	}
+	return events, next, nil
+}
+
 // Update updates an existing event's information
 func (r *EventRepository) Update(ctx context.Context, event *entity.Event) error {
-	query := `
+	query := fmt.Sprintf(`
 		UPDATE events
 		SET
 			name = $2,
@@ -175,10 +365,29 @@ func (r *EventRepository) Update(ctx context.Context, event *entity.Event) error
 			end_date = $5,
 			location = $6,
 			timezone = $7,
-			status = $8,
-			updated_at = $9
-		WHERE id = $1
-	`
+			currency = NULLIF($8, ''),
+			fee_type = NULLIF($9, ''),
+			fee_amount = NULLIF($10::BIGINT, 0),
+			fee_tiers = $11,
+			requires_consent = $12,
+			consent_version = NULLIF($13, ''),
+			legal_hold = $14,
+			tentative_expiry_hours = NULLIF($15::INTEGER, 0),
+			capacity = NULLIF($16::INTEGER, 0),
+			custom_fields = $17,
+			status = $18,
+			updated_at = $19
+		WHERE id = $1 AND %s
+	`, live("events"))
+
+	feeTiers, err := marshalFeeTiers(event.FeeTiers)
+	if err != nil {
+		return err
+	}
+	customFields, err := marshalCustomFields(event.CustomFields)
+	if err != nil {
+		return err
+	}
 
 	q := GetQueryable(ctx, r.pool)
 	commandTag, err := q.Exec(ctx, query,
@@ -189,6 +398,16 @@ func (r *EventRepository) Update(ctx context.Context, event *entity.Event) error
 		event.EndDate,
 		event.Location,
 		event.Timezone,
+		event.Currency,
+		event.FeeType,
+		event.FeeAmount,
+		feeTiers,
+		event.RequiresConsent,
+		event.ConsentVersion,
+		event.LegalHold,
+		event.TentativeExpiryHours,
+		event.Capacity,
+		customFields,
 		event.Status,
 		event.UpdatedAt,
 	)
@@ -209,20 +428,71 @@ func (r *EventRepository) Update(ctx context.Context, event *entity.Event) error
 	return nil
 }
 
-// Delete deletes an event from the database
-func (r *EventRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `DELETE FROM events WHERE id = $1`
+// UpdateLogo replaces the logo image of an event; nil removes it
+func (r *EventRepository) UpdateLogo(ctx context.Context, id uuid.UUID, logo []byte) error {
+	query := fmt.Sprintf(`
+		UPDATE events
+		SET logo = $2, updated_at = NOW()
+		WHERE id = $1 AND %s
+	`, live("events"))
 
-	q := GetQueryable(ctx, r.pool)
-	commandTag, err := q.Exec(ctx, query, id)
+	commandTag, err := GetQueryable(ctx, r.pool).Exec(ctx, query, id, logo)
 	if err != nil {
-		return apperrors.Wrapf(err, "failed to delete event")
+		return apperrors.Wrapf(err, "failed to update event logo")
 	}
 
 	if commandTag.RowsAffected() == 0 {
 		return apperrors.NotFound("event not found")
 	}
 
+	r.logger.WithContext(ctx).Info("event logo updated",
+		zap.String("event_id", id.String()),
+		zap.Bool("removed", logo == nil),
+	)
+
+	return nil
+}
+
+// FindLogo retrieves the logo image of an event, or nil if the event has none
+func (r *EventRepository) FindLogo(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	query := fmt.Sprintf(`SELECT logo FROM events WHERE id = $1 AND %s`, live("events"))
+
+	var logo []byte
+	err := GetQueryable(ctx, r.pool).QueryRow(ctx, query, id).Scan(&logo)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperrors.NotFound("event not found")
+	}
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "failed to find event logo")
+	}
+
+	return logo, nil
+}
+
+// Delete soft deletes an event together with its participants, stamping them with the same
+// deletion time so that Restore brings back exactly the participants deleted with the event.
+func (r *EventRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	deletedAt := time.Now()
+
+	err := inTransaction(ctx, r.pool, func(ctx context.Context) error {
+		q := GetQueryable(ctx, r.pool)
+		deleted, err := tombstone(ctx, q, "events", "id = $2", deletedAt, id)
+		if err != nil {
+			return apperrors.Wrapf(err, "failed to delete event")
+		}
+		if deleted == 0 {
+			return apperrors.NotFound("event not found")
+		}
+
+		if _, err := tombstone(ctx, q, "participants", "event_id = $2", deletedAt, id); err != nil {
+			return apperrors.Wrapf(err, "failed to delete event participants")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
 	r.logger.WithContext(ctx).Info("event deleted",
 		zap.String("event_id", id.String()),
 	)
@@ -230,64 +500,334 @@ func (r *EventRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Restore restores a deleted event together with the participants deleted with it. Participants
+// deleted individually before the event stay deleted.
+func (r *EventRepository) Restore(ctx context.Context, id uuid.UUID) error {
+	restoredAt := time.Now()
+
+	err := inTransaction(ctx, r.pool, func(ctx context.Context) error {
+		q := GetQueryable(ctx, r.pool)
+		var deletedAt *time.Time
+		err := q.QueryRow(ctx, `SELECT deleted_at FROM events WHERE id = $1 FOR UPDATE`, id).Scan(&deletedAt)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return apperrors.Wrapf(err, "failed to find deleted event")
+		}
+		if deletedAt == nil {
+			return apperrors.NotFound("deleted event not found")
+		}
+
+		if _, err := restore(ctx, q, "events", "id = $2", restoredAt, id); err != nil {
+			return apperrors.Wrapf(err, "failed to restore event")
+		}
+		_, err = restore(ctx, q, "participants", "event_id = $2 AND deleted_at = $3", restoredAt, id, *deletedAt)
+		if err != nil {
+			return apperrors.Wrapf(err, "failed to restore event participants")
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	r.logger.WithContext(ctx).Info("event restored",
+		zap.String("event_id", id.String()),
+	)
+
+	return nil
+}
+
 // GetStats retrieves basic statistics for an event
 func (r *EventRepository) GetStats(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
-	// First check if event exists
-	var exists bool
-	checkQuery := `SELECT EXISTS(SELECT 1 FROM events WHERE id = $1)`
-	q := GetQueryable(ctx, r.pool)
-	if err := q.QueryRow(ctx, checkQuery, id).Scan(&exists); err != nil {
-		return nil, apperrors.Wrapf(err, "failed to check event existence")
+	statsByEvent, err := r.GetStatsBatch(ctx, []uuid.UUID{id})
+	if err != nil {
+		return nil, err
 	}
-	if !exists {
+	stats, ok := statsByEvent[id]
+	if !ok {
 		return nil, apperrors.NotFound("event not found")
 	}
+	return stats, nil
+}
+
+// CountConfirmedParticipants returns the number of confirmed participants of an event
+func (r *EventRepository) CountConfirmedParticipants(ctx context.Context, id uuid.UUID) (int64, error) {
+	query := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM participants p
+		WHERE p.event_id = $1 AND p.status = 'confirmed' AND %s
+	`, live("p"))
+
+	var count int64
+	if err := GetQueryable(ctx, r.pool).QueryRow(ctx, query, id).Scan(&count); err != nil {
+		return 0, apperrors.Wrapf(err, "failed to count confirmed participants")
+	}
+	return count, nil
+}
 
-	// Get active participant count (tentative + confirmed) and checked-in count
-	statsQuery := `
+// GetStatsBatch retrieves basic statistics for several events with set-based queries:
+// one grouped query for the counts and totals and one each for the status and group
+// breakdowns, however many events are requested. IDs without an event are absent from the result.
+func (r *EventRepository) GetStatsBatch(
+	ctx context.Context,
+	ids []uuid.UUID,
+) (map[uuid.UUID]*repository.EventStats, error) {
+	statsByEvent := make(map[uuid.UUID]*repository.EventStats, len(ids))
+	if len(ids) == 0 {
+		return statsByEvent, nil
+	}
+
+	// Active participants are tentative + confirmed. Amounts are only summed within an event,
+	// so they always share the event's currency.
+	statsQuery := fmt.Sprintf(`
+		WITH participant_counts AS (
+			SELECT
+				event_id,
+				COUNT(*) FILTER (WHERE status IN ('tentative', 'confirmed')) AS total_participants,
+				COUNT(*) FILTER (WHERE walk_in AND status IN ('tentative', 'confirmed')) AS walk_in_count,
+				COUNT(*) FILTER (WHERE guest_of IS NOT NULL AND status IN ('tentative', 'confirmed')) AS guest_count,
+				COALESCE(SUM(payment_amount) FILTER (WHERE payment_status = 'paid'), 0)::BIGINT
+					AS total_payment_amount
+			FROM participants p
+			WHERE event_id = ANY($1) AND %s
+			GROUP BY event_id
+		),
+		checkin_counts AS (
+			SELECT event_id, COUNT(*) AS checked_in_count
+			FROM checkins c
+			WHERE event_id = ANY($1) AND cancelled_at IS NULL AND %s
+			GROUP BY event_id
+		)
 		SELECT
-			(SELECT COUNT(*) FROM participants
-			 WHERE event_id = $1 AND status NOT IN ('cancelled', 'declined')) as total_participants,
-			(SELECT COUNT(*) FROM checkins WHERE event_id = $1) as checked_in_count
-	`
+			e.id,
+			COALESCE(p.total_participants, 0),
+			COALESCE(c.checked_in_count, 0),
+			COALESCE(p.walk_in_count, 0),
+			COALESCE(p.guest_count, 0),
+			COALESCE(p.total_payment_amount, 0),
+			COALESCE(e.currency, '')
+		FROM events e
+		LEFT JOIN participant_counts p ON p.event_id = e.id
+		LEFT JOIN checkin_counts c ON c.event_id = e.id
+		WHERE e.id = ANY($1) AND %s
+	`, live("p"), liveCheckin("c"), live("e"))
 
-	stats := &repository.EventStats{}
-	err := q.QueryRow(ctx, statsQuery, id).Scan(
-		&stats.TotalParticipants,
-		&stats.CheckedInCount,
-	)
+	q := GetQueryable(ctx, r.pool)
+	rows, err := q.Query(ctx, statsQuery, ids)
 	if err != nil {
 		return nil, apperrors.Wrapf(err, "failed to get event statistics")
 	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var id uuid.UUID
+		stats := &repository.EventStats{
+			ByStatus: make(map[string]int64),
+			ByGroup:  make(map[string]repository.EventGroupStats),
+		}
+		if err := rows.Scan(
+			&id,
+			&stats.TotalParticipants,
+			&stats.CheckedInCount,
+			&stats.WalkInCount,
+			&stats.GuestCount,
+			&stats.TotalPaymentAmount,
+			&stats.Currency,
+		); err != nil {
+			return nil, apperrors.Wrapf(err, "failed to scan event statistics row")
+		}
+		statsByEvent[id] = stats
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.Wrapf(err, "failed to iterate event statistics rows")
+	}
+	rows.Close()
 
 	// Get participant count by status
-	byStatusQuery := `
-		SELECT status, COUNT(*) as count
-		FROM participants
-		WHERE event_id = $1
-		GROUP BY status
-	`
+	byStatusQuery := fmt.Sprintf(`
+		SELECT event_id, status, COUNT(*) as count
+		FROM participants p
+		WHERE event_id = ANY($1) AND %s
+		GROUP BY event_id, status
+	`, live("p"))
 
-	rows, err := q.Query(ctx, byStatusQuery, id)
+	statusRows, err := q.Query(ctx, byStatusQuery, ids)
 	if err != nil {
 		return nil, apperrors.Wrapf(err, "failed to get participant status breakdown")
 	}
-	defer rows.Close()
+	defer statusRows.Close()
 
-	stats.ByStatus = make(map[string]int64)
-	for rows.Next() {
+	for statusRows.Next() {
+		var eventID uuid.UUID
 		var status string
 		var count int64
-		if err := rows.Scan(&status, &count); err != nil {
+		if err := statusRows.Scan(&eventID, &status, &count); err != nil {
 			return nil, apperrors.Wrapf(err, "failed to scan status row")
 		}
-		stats.ByStatus[status] = count
+		if stats, ok := statsByEvent[eventID]; ok {
+			stats.ByStatus[status] = count
+		}
 	}
-	if err := rows.Err(); err != nil {
+	if err := statusRows.Err(); err != nil {
 		return nil, apperrors.Wrapf(err, "failed to iterate status rows")
 	}
+	statusRows.Close()
 
-	return stats, nil
+	if err := r.scanGroupStats(ctx, ids, statsByEvent); err != nil {
+		return nil, err
+	}
+
+	return statsByEvent, nil
+}
+
+// scanGroupStats fills in the group breakdowns of statsByEvent: the active participants
+// seated at each group and how many of them checked in.
+func (r *EventRepository) scanGroupStats(
+	ctx context.Context,
+	ids []uuid.UUID,
+	statsByEvent map[uuid.UUID]*repository.EventStats,
+) error {
+	byGroupQuery := fmt.Sprintf(`
+		SELECT p.event_id, p.group_name, COUNT(*), COUNT(c.id)
+		FROM participants p
+		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+		WHERE p.event_id = ANY($1) AND %s
+			AND p.group_name IS NOT NULL AND p.status IN ('tentative', 'confirmed')
+		GROUP BY p.event_id, p.group_name
+	`, live("p"))
+
+	rows, err := GetQueryable(ctx, r.pool).Query(ctx, byGroupQuery, ids)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to get participant group breakdown")
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var eventID uuid.UUID
+		var group string
+		var groupStats repository.EventGroupStats
+		if err := rows.Scan(&eventID, &group, &groupStats.TotalParticipants, &groupStats.CheckedInCount); err != nil {
+			return apperrors.Wrapf(err, "failed to scan group row")
+		}
+		if stats, ok := statsByEvent[eventID]; ok {
+			stats.ByGroup[group] = groupStats
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return apperrors.Wrapf(err, "failed to iterate group rows")
+	}
+
+	return nil
+}
+
+// GetListLastModified returns the latest modification time of the events in the filter's
+// organizer scope. Only the organizer filter is applied: events that move out of a status or
+// search filter would otherwise disappear from the calculation without advancing it.
+// Deleted events are accounted for through the event_deletions tombstone table.
+func (r *EventRepository) GetListLastModified(
+	ctx context.Context,
+	filter repository.EventListFilter,
+) (time.Time, error) {
+	eventsWhere := live("events")
+	deletionsWhere := "1=1"
+	args := []interface{}{}
+	if filter.OrganizerID != nil {
+		eventsWhere += " AND organizer_id = $1"
+		deletionsWhere = "organizer_id = $1"
+		args = append(args, *filter.OrganizerID)
+	}
+
+	query := fmt.Sprintf(`
+		SELECT GREATEST(
+			(SELECT MAX(GREATEST(updated_at, participants_modified_at)) FROM events WHERE %s),
+			(SELECT MAX(deleted_at) FROM event_deletions WHERE %s)
+		)
+	`, eventsWhere, deletionsWhere)
+
+	var lastModified *time.Time
+	q := GetQueryable(ctx, r.pool)
+	if err := q.QueryRow(ctx, query, args...).Scan(&lastModified); err != nil {
+		return time.Time{}, apperrors.Wrapf(err, "failed to get event list last modified")
+	}
+	if lastModified == nil {
+		return time.Time{}, nil
+	}
+
+	return *lastModified, nil
+}
+
+// FindPIIPurgeDue retrieves the completed events due for the retention purge, the longest
+// ended first. Deleted events are included: deleting an event keeps its participants' personal
+// data until the purge.
+func (r *EventRepository) FindPIIPurgeDue(
+	ctx context.Context,
+	endedBefore time.Time,
+	limit int,
+) ([]*entity.Event, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			e.id, e.organizer_id, e.name, e.description, e.start_date, e.end_date,
+			e.location, e.timezone, COALESCE(e.currency, ''), COALESCE(e.fee_type, ''), COALESCE(e.fee_amount, 0),
+			e.fee_tiers, e.requires_consent, COALESCE(e.consent_version, ''), e.legal_hold, e.pii_purged_at,
+			COALESCE(e.tentative_expiry_hours, 0), COALESCE(e.capacity, 0), e.custom_fields, e.series_id,
+			e.deleted_at, e.status, e.created_at, e.updated_at,
+			%s
+		FROM events e
+		WHERE e.status = 'completed'
+			AND e.pii_purged_at IS NULL
+			AND NOT e.legal_hold
+			AND COALESCE(e.end_date, e.start_date) < $1
+		ORDER BY COALESCE(e.end_date, e.start_date), e.id
+		LIMIT $2
+	`, eventCountColumns)
+
+	q := GetQueryable(ctx, r.pool)
+	rows, err := q.Query(ctx, query, endedBefore, limit)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "failed to find events due for PII purge")
+	}
+	defer rows.Close()
+
+	return r.scanEventRows(rows, limit)
+}
+
+// MarkPIIPurged stamps an event as purged. The legal hold is checked in the same statement,
+// so a hold placed while a purge is running either blocks the purge or waits for it.
+func (r *EventRepository) MarkPIIPurged(ctx context.Context, id uuid.UUID, purgedAt time.Time) (bool, error) {
+	query := `
+		UPDATE events
+		SET pii_purged_at = $2
+		WHERE id = $1 AND NOT legal_hold AND pii_purged_at IS NULL
+	`
+
+	q := GetQueryable(ctx, r.pool)
+	commandTag, err := q.Exec(ctx, query, id, purgedAt)
+	if err != nil {
+		return false, apperrors.Wrapf(err, "failed to mark event PII purged")
+	}
+
+	return commandTag.RowsAffected() == 1, nil
+}
+
+// RecordPIIPurge appends an audit entry for a retention purge
+func (r *EventRepository) RecordPIIPurge(ctx context.Context, purge *entity.PIIPurge) error {
+	query := `
+		INSERT INTO pii_purges (event_id, organizer_id, participants_anonymized, retention_days, purged_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	q := GetQueryable(ctx, r.pool)
+	_, err := q.Exec(ctx, query,
+		purge.EventID,
+		purge.OrganizerID,
+		purge.ParticipantsAnonymized,
+		purge.RetentionDays,
+		purge.PurgedAt,
+	)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to record PII purge")
+	}
+
+	return nil
 }
 
 // HealthCheck verifies the repository's database connection
@@ -297,10 +837,29 @@ func (r *EventRepository) HealthCheck(ctx context.Context) error {
 
 // scanEventRows scans query rows into a slice of Event entities.
 func (r *EventRepository) scanEventRows(rows pgx.Rows, capacity int) ([]*entity.Event, error) {
+	return r.scanEventRowsWith(rows, capacity, func(*entity.Event) []any { return nil })
+}
+
+// scanRankedEventRows scans query rows into a slice of Event entities, with the search_rank
+// column after the event columns.
+func (r *EventRepository) scanRankedEventRows(rows pgx.Rows, capacity int) ([]*entity.Event, error) {
+	return r.scanEventRowsWith(rows, capacity, func(event *entity.Event) []any {
+		return []any{&event.SearchRank}
+	})
+}
+
+// scanEventRowsWith scans query rows into a slice of Event entities, scanning the columns
+// after the event columns into the destinations returned by extra.
+func (r *EventRepository) scanEventRowsWith(
+	rows pgx.Rows,
+	capacity int,
+	extra func(event *entity.Event) []any,
+) ([]*entity.Event, error) {
 	events := make([]*entity.Event, 0, capacity)
 	for rows.Next() {
 		var event entity.Event
-		err := rows.Scan(
+		var feeTiers, customFields []byte
+		dest := []any{
 			&event.ID,
 			&event.OrganizerID,
 			&event.Name,
@@ -309,15 +868,35 @@ func (r *EventRepository) scanEventRows(rows pgx.Rows, capacity int) ([]*entity.
 			&event.EndDate,
 			&event.Location,
 			&event.Timezone,
+			&event.Currency,
+			&event.FeeType,
+			&event.FeeAmount,
+			&feeTiers,
+			&event.RequiresConsent,
+			&event.ConsentVersion,
+			&event.LegalHold,
+			&event.PIIPurgedAt,
+			&event.TentativeExpiryHours,
+			&event.Capacity,
+			&customFields,
+			&event.SeriesID,
+			&event.DeletedAt,
 			&event.Status,
 			&event.CreatedAt,
 			&event.UpdatedAt,
 			&event.ParticipantCount,
 			&event.CheckedInCount,
-		)
+		}
+		err := rows.Scan(append(dest, extra(&event)...)...)
 		if err != nil {
 			return nil, apperrors.Wrapf(err, "failed to scan event row")
 		}
+		if event.FeeTiers, err = unmarshalFeeTiers(feeTiers); err != nil {
+			return nil, err
+		}
+		if event.CustomFields, err = unmarshalCustomFields(customFields); err != nil {
+			return nil, err
+		}
 		events = append(events, &event)
 	}
 	if err := rows.Err(); err != nil {
@@ -326,25 +905,98 @@ func (r *EventRepository) scanEventRows(rows pgx.Rows, capacity int) ([]*entity.
 	return events, nil
 }
 
-// buildOrderByClause constructs a safe ORDER BY clause from filter.Sort and filter.Order.
-// Unknown sort values fall back to "e.created_at"; unknown order values fall back to "DESC".
-// A secondary sort on "e.id ASC" is appended for stable pagination.
-func buildOrderByClause(filter repository.EventListFilter) string {
-	col, ok := allowedEventSortColumns[strings.ToLower(filter.Sort)]
-	if !ok {
-		col = "e.created_at"
+// marshalFeeTiers encodes fee tiers for the fee_tiers JSONB column; no tiers are stored as NULL.
+func marshalFeeTiers(tiers []entity.FeeTier) ([]byte, error) {
+	if len(tiers) == 0 {
+		return nil, nil
 	}
+	data, err := json.Marshal(tiers)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "failed to encode event fee tiers")
+	}
+	return data, nil
+}
 
-	dir := "DESC"
+// unmarshalFeeTiers decodes the fee_tiers JSONB column; NULL yields no tiers.
+func unmarshalFeeTiers(data []byte) ([]entity.FeeTier, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var tiers []entity.FeeTier
+	if err := json.Unmarshal(data, &tiers); err != nil {
+		return nil, apperrors.Wrapf(err, "failed to decode event fee tiers")
+	}
+	return tiers, nil
+}
+
+// marshalCustomFields encodes custom fields for the custom_fields JSONB column; no fields are
+// stored as NULL.
+func marshalCustomFields(fields []entity.CustomField) ([]byte, error) {
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "failed to encode event custom fields")
+	}
+	return data, nil
+}
+
+// unmarshalCustomFields decodes the custom_fields JSONB column; NULL yields no fields.
+func unmarshalCustomFields(data []byte) ([]entity.CustomField, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+	var fields []entity.CustomField
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, apperrors.Wrapf(err, "failed to decode event custom fields")
+	}
+	return fields, nil
+}
+
+// eventSort resolves filter.Sort and filter.Order to an allowed sort key and order.
+// Unknown sort values fall back to "created_at"; unknown order values fall back to "desc".
+func eventSort(filter repository.EventListFilter) (sortKey, order string) {
+	sortKey = strings.ToLower(filter.Sort)
+	if _, ok := allowedEventSortColumns[sortKey]; !ok {
+		sortKey = "created_at"
+	}
+
+	order = "desc"
 	if strings.EqualFold(filter.Order, "asc") {
-		dir = "ASC"
+		order = "asc"
 	}
+	return sortKey, order
+}
+
+// buildOrderByClause constructs a safe ORDER BY clause from filter.Sort and filter.Order.
+// A secondary sort on "e.id ASC" is appended for stable pagination.
+func buildOrderByClause(filter repository.EventListFilter) string {
+	sortKey, order := eventSort(filter)
+	return fmt.Sprintf("%s %s, e.id ASC", allowedEventSortColumns[sortKey], strings.ToUpper(order))
+}
+
+// isEventFullTextSearch reports whether the filter searches events by full text.
+func isEventFullTextSearch(filter repository.EventListFilter) bool {
+	return utf8.RuneCountInString(strings.TrimSpace(filter.Search)) >= repository.MinEventFullTextSearchLength
+}
 
-	return fmt.Sprintf("%s %s, e.id ASC", col, dir)
+// eventSearchRank returns the column expression of the relevance of an event to the full-text
+// search of filter, NULL if the filter does not search by full text, with its arguments
+// appended to args and the index of the next placeholder.
+func eventSearchRank(filter repository.EventListFilter, args []interface{}, argIdx int) (string, []interface{}, int) {
+	if !isEventFullTextSearch(filter) {
+		return "NULL::float8", args, argIdx
+	}
+	rankSQL := fmt.Sprintf("ts_rank(e.search_vector, plainto_tsquery('simple', $%d))::float8", argIdx)
+	return rankSQL, append(args, filter.Search), argIdx + 1
 }
 
 func (r *EventRepository) buildListWhereClause(filter repository.EventListFilter) (string, []interface{}, int) {
-	whereClauses := []string{"1=1"}
+	whereClauses := []string{live("e")}
+	if filter.IncludeDeleted {
+		whereClauses = []string{"1=1"}
+	}
 	args := []interface{}{}
 	argIdx := 1
 
@@ -361,20 +1013,28 @@ func (r *EventRepository) buildListWhereClause(filter repository.EventListFilter
 	}
 
 	if filter.Search != "" {
-		whereClauses = append(whereClauses, fmt.Sprintf("(name ILIKE $%d OR description ILIKE $%d)", argIdx, argIdx))
+		// Terms also match anywhere in the text, as full-text search only matches whole words
+		// and does not split text without spaces, such as Japanese, into words
+		match := fmt.Sprintf("name ILIKE $%d OR description ILIKE $%d OR location ILIKE $%d", argIdx, argIdx, argIdx)
 		args = append(args, "%"+filter.Search+"%")
 		argIdx++
+		if isEventFullTextSearch(filter) {
+			match = fmt.Sprintf("e.search_vector @@ plainto_tsquery('simple', $%d) OR %s", argIdx, match)
+			args = append(args, filter.Search)
+			argIdx++
+		}
+		whereClauses = append(whereClauses, "("+match+")")
 	}
 
-	if filter.StartDate != nil {
+	if filter.StartDateFrom != nil {
 		whereClauses = append(whereClauses, fmt.Sprintf("start_date >= $%d", argIdx))
-		args = append(args, *filter.StartDate)
+		args = append(args, *filter.StartDateFrom)
 		argIdx++
 	}
 
-	if filter.EndDate != nil {
+	if filter.StartDateTo != nil {
 		whereClauses = append(whereClauses, fmt.Sprintf("start_date <= $%d", argIdx))
-		args = append(args, *filter.EndDate)
+		args = append(args, *filter.StartDateTo)
 		argIdx++
 	}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -80,6 +81,7 @@ var _ = Describe("EventRepository", func() {
 			pool := db.GetPool()
 			_, _ = pool.Exec(ctx, "TRUNCATE TABLE users CASCADE")
 			_, _ = pool.Exec(ctx, "TRUNCATE TABLE events CASCADE")
+			_, _ = pool.Exec(ctx, "TRUNCATE TABLE pii_purges")
 			db.Close()
 		}
 	})
@@ -111,6 +113,103 @@ var _ = Describe("EventRepository", func() {
 			Expect(found.OrganizerID).To(Equal(testUserID))
 		})
 
+		It("should persist the currency and read an unset currency as empty", func() {
+			event := createTestEvent(testEventID, "Priced Event", testUserID)
+			event.Currency = "JPY"
+			Expect(repo.Create(ctx, event)).To(Succeed())
+
+			noCurrencyID := uuid.New()
+			Expect(repo.Create(ctx, createTestEvent(noCurrencyID, "Free Event", testUserID))).To(Succeed())
+
+			found, err := repo.FindByID(ctx, testEventID)
+			Expect(err).To(BeNil())
+			Expect(found.Currency).To(Equal("JPY"))
+
+			found, err = repo.FindByID(ctx, noCurrencyID)
+			Expect(err).To(BeNil())
+			Expect(found.Currency).To(BeEmpty())
+		})
+
+		It("should persist a tiered fee model", func() {
+			event := createTestEvent(testEventID, "Tiered Event", testUserID)
+			event.Currency = "JPY"
+			event.FeeType = entity.FeeTypeTiered
+			event.FeeTiers = []entity.FeeTier{
+				{Name: "early_bird", Amount: money.FromMinorUnits(200000)},
+				{Name: "standard", Amount: money.FromMinorUnits(300000)},
+			}
+			Expect(repo.Create(ctx, event)).To(Succeed())
+
+			found, err := repo.FindByID(ctx, testEventID)
+			Expect(err).To(BeNil())
+			Expect(found.FeeType).To(Equal(entity.FeeTypeTiered))
+			Expect(found.FeeAmount).To(BeZero())
+			Expect(found.FeeTiers).To(Equal(event.FeeTiers))
+		})
+
+		It("should persist a fixed fee and read an unset fee model as empty", func() {
+			event := createTestEvent(testEventID, "Fixed Event", testUserID)
+			event.Currency = "JPY"
+			event.FeeType = entity.FeeTypeFixed
+			event.FeeAmount = money.FromMinorUnits(300000)
+			Expect(repo.Create(ctx, event)).To(Succeed())
+
+			noFeeID := uuid.New()
+			Expect(repo.Create(ctx, createTestEvent(noFeeID, "Ad Hoc Event", testUserID))).To(Succeed())
+
+			found, err := repo.FindByID(ctx, testEventID)
+			Expect(err).To(BeNil())
+			Expect(found.FeeType).To(Equal(entity.FeeTypeFixed))
+			Expect(found.FeeAmount).To(Equal(money.FromMinorUnits(300000)))
+			Expect(found.FeeTiers).To(BeEmpty())
+
+			found, err = repo.FindByID(ctx, noFeeID)
+			Expect(err).To(BeNil())
+			Expect(found.FeeType).To(BeEmpty())
+			Expect(found.FeeAmount).To(BeZero())
+		})
+
+		It("should persist the consent requirement", func() {
+			event := createTestEvent(testEventID, "Waiver Event", testUserID)
+			event.RequiresConsent = true
+			event.ConsentVersion = "2025-01"
+			Expect(repo.Create(ctx, event)).To(Succeed())
+
+			found, err := repo.FindByID(ctx, testEventID)
+			Expect(err).To(BeNil())
+			Expect(found.RequiresConsent).To(BeTrue())
+			Expect(found.ConsentVersion).To(Equal("2025-01"))
+
+			found.RequiresConsent = false
+			found.ConsentVersion = ""
+			Expect(repo.Update(ctx, found)).To(Succeed())
+
+			found, err = repo.FindByID(ctx, testEventID)
+			Expect(err).To(BeNil())
+			Expect(found.RequiresConsent).To(BeFalse())
+			Expect(found.ConsentVersion).To(BeEmpty())
+		})
+
+		It("should persist the custom participant fields", func() {
+			event := createTestEvent(testEventID, "Fields Event", testUserID)
+			event.CustomFields = []entity.CustomField{
+				{Key: "company", Label: "Company", Type: entity.CustomFieldTypeText, Required: true},
+				{Key: "tshirt_size", Label: "T-shirt size", Type: entity.CustomFieldTypeSelect, Options: []string{"S", "M"}},
+			}
+			Expect(repo.Create(ctx, event)).To(Succeed())
+
+			found, err := repo.FindByID(ctx, testEventID)
+			Expect(err).To(BeNil())
+			Expect(found.CustomFields).To(Equal(event.CustomFields))
+
+			found.CustomFields = nil
+			Expect(repo.Update(ctx, found)).To(Succeed())
+
+			found, err = repo.FindByID(ctx, testEventID)
+			Expect(err).To(BeNil())
+			Expect(found.CustomFields).To(BeEmpty())
+		})
+
 		It("should return error if organizer does not exist", func() {
 			event := createTestEvent(testEventID, "New Event", uuid.New())
 			err := repo.Create(ctx, event)
@@ -178,6 +277,55 @@ var _ = Describe("EventRepository", func() {
 			Expect(events[0].Name).To(Equal("Event 3"))
 		})
 
+		It("should rank events matching the search term in their name first", func() {
+			inDescription := createTestEvent(uuid.New(), "Networking Night", testUserID)
+			inDescription.Description = "Meet the Go community after the workshop"
+			Expect(repo.Create(ctx, inDescription)).To(Succeed())
+			inName := createTestEvent(uuid.New(), "Go Workshop", testUserID)
+			Expect(repo.Create(ctx, inName)).To(Succeed())
+
+			events, total, err := repo.List(ctx, repository.EventListFilter{Search: "workshop"}, 0, 10)
+			Expect(err).To(BeNil())
+			Expect(total).To(Equal(int64(2)))
+			Expect(events[0].ID).To(Equal(inName.ID))
+			Expect(events[1].ID).To(Equal(inDescription.ID))
+			Expect(events[0].SearchRank).NotTo(BeNil())
+			Expect(*events[0].SearchRank).To(BeNumerically(">", *events[1].SearchRank))
+		})
+
+		It("should match part of a word", func() {
+			conference := createTestEvent(uuid.New(), "Developer Conference", testUserID)
+			Expect(repo.Create(ctx, conference)).To(Succeed())
+
+			events, total, err := repo.List(ctx, repository.EventListFilter{Search: "conf"}, 0, 10)
+			Expect(err).To(BeNil())
+			Expect(total).To(Equal(int64(1)))
+			Expect(events[0].ID).To(Equal(conference.ID))
+		})
+
+		It("should match part of text without spaces, such as Japanese", func() {
+			conference := createTestEvent(uuid.New(), "技術カンファレンス2026", testUserID)
+			Expect(repo.Create(ctx, conference)).To(Succeed())
+
+			events, total, err := repo.List(ctx, repository.EventListFilter{Search: "カンファレンス"}, 0, 10)
+			Expect(err).To(BeNil())
+			Expect(total).To(Equal(int64(1)))
+			Expect(events[0].ID).To(Equal(conference.ID))
+		})
+
+		It("should match short search terms anywhere in the text without ranking", func() {
+			events, total, err := repo.List(ctx, repository.EventListFilter{Search: "nt"}, 0, 10)
+			Expect(err).To(BeNil())
+			Expect(total).To(Equal(int64(5)))
+			Expect(events[0].SearchRank).To(BeNil())
+		})
+
+		It("should not rank events listed without a search term", func() {
+			events, _, err := repo.List(ctx, repository.EventListFilter{}, 0, 10)
+			Expect(err).To(BeNil())
+			Expect(events[0].SearchRank).To(BeNil())
+		})
+
 		It("should handle pagination correctly", func() {
 			filter := repository.EventListFilter{}
 			events, total, err := repo.List(ctx, filter, 0, 3)
@@ -266,6 +414,161 @@ var _ = Describe("EventRepository", func() {
 		})
 	})
 
+	When("filtering events by start date", func() {
+		june := func(day int) time.Time { return time.Date(2030, 6, day, 10, 0, 0, 0, time.UTC) }
+
+		BeforeEach(func() {
+			for _, day := range []int{1, 15, 30} {
+				event := createTestEvent(uuid.New(), fmt.Sprintf("June %d", day), testUserID)
+				event.StartDate = june(day)
+				Expect(repo.Create(ctx, event)).To(Succeed())
+			}
+		})
+
+		It("should include events starting on either bound", func() {
+			from, to := june(1), june(15)
+			filter := repository.EventListFilter{
+				OrganizerID:   &testUserID,
+				StartDateFrom: &from,
+				StartDateTo:   &to,
+				Sort:          "start_date",
+				Order:         "asc",
+			}
+			events, total, err := repo.List(ctx, filter, 0, 10)
+			Expect(err).To(BeNil())
+			Expect(total).To(Equal(int64(2)))
+			Expect(events[0].Name).To(Equal("June 1"))
+			Expect(events[1].Name).To(Equal("June 15"))
+		})
+
+		It("should leave the range open when only one bound is set", func() {
+			from := june(2)
+			filter := repository.EventListFilter{OrganizerID: &testUserID, StartDateFrom: &from}
+			_, total, err := repo.List(ctx, filter, 0, 10)
+			Expect(err).To(BeNil())
+			Expect(total).To(Equal(int64(2)))
+		})
+	})
+
+	When("listing events by cursor", func() {
+		BeforeEach(func() {
+			// Two events share each name and start date, and two have no end date, to exercise
+			// the ID tie-break and NULL ordering across page boundaries
+			start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+			for i := 1; i <= 7; i++ {
+				event := createTestEvent(uuid.New(), fmt.Sprintf("Event %d", (i+1)/2), testUserID)
+				event.StartDate = start.Add(time.Duration((i+1)/2) * time.Hour)
+				if i > 2 {
+					endDate := event.StartDate.Add(time.Duration(i) * time.Hour)
+					event.EndDate = &endDate
+				}
+				event.CreatedAt = time.Now().Add(-time.Duration(10-i) * time.Hour)
+				Expect(repo.Create(ctx, event)).To(Succeed())
+			}
+		})
+
+		listAll := func(filter repository.EventListFilter) []uuid.UUID {
+			events, _, err := repo.List(ctx, filter, 0, 100)
+			Expect(err).To(BeNil())
+			ids := make([]uuid.UUID, len(events))
+			for i, e := range events {
+				ids[i] = e.ID
+			}
+			return ids
+		}
+
+		pageThrough := func(filter repository.EventListFilter, limit int) []uuid.UUID {
+			var ids []uuid.UUID
+			cursor := ""
+			for range 10 {
+				events, next, err := repo.ListCursor(ctx, filter, cursor, limit)
+				Expect(err).To(BeNil())
+				Expect(len(events)).To(BeNumerically("<=", limit))
+				for _, e := range events {
+					ids = append(ids, e.ID)
+				}
+				if next == "" {
+					return ids
+				}
+				cursor = next
+			}
+			Fail("cursor paging did not reach the last page")
+			return nil
+		}
+
+		DescribeTable("should page through the same events in the same order as List",
+			func(sort, order string) {
+				filter := repository.EventListFilter{OrganizerID: &testUserID, Sort: sort, Order: order}
+				Expect(pageThrough(filter, 2)).To(Equal(listAll(filter)))
+			},
+			Entry("default sort", "", ""),
+			Entry("created_at ascending", "created_at", "asc"),
+			Entry("name ascending", "name", "asc"),
+			Entry("name descending", "name", "desc"),
+			Entry("start_date descending", "start_date", "desc"),
+			Entry("end_date ascending", "end_date", "asc"),
+			Entry("end_date descending", "end_date", "desc"),
+		)
+
+		It("should return no next cursor when the page holds the rest", func() {
+			filter := repository.EventListFilter{OrganizerID: &testUserID}
+			events, next, err := repo.ListCursor(ctx, filter, "", 7)
+			Expect(err).To(BeNil())
+			Expect(events).To(HaveLen(7))
+			Expect(next).To(BeEmpty())
+		})
+
+		It("should reject a cursor issued for another sort or order", func() {
+			filter := repository.EventListFilter{OrganizerID: &testUserID, Sort: "name", Order: "asc"}
+			_, next, err := repo.ListCursor(ctx, filter, "", 2)
+			Expect(err).To(BeNil())
+			Expect(next).NotTo(BeEmpty())
+
+			filter.Order = "desc"
+			_, _, err = repo.ListCursor(ctx, filter, next, 2)
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+
+			filter.Sort, filter.Order = "start_date", "asc"
+			_, _, err = repo.ListCursor(ctx, filter, next, 2)
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+
+		It("should reject a malformed cursor", func() {
+			filter := repository.EventListFilter{OrganizerID: &testUserID}
+			_, _, err := repo.ListCursor(ctx, filter, "not a cursor", 2)
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+	})
+
+	When("finding events by series", func() {
+		It("should return the live occurrences of the series ordered by start date", func() {
+			seriesID := uuid.New()
+			start := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+			var ids []uuid.UUID
+			for i := 2; i >= 0; i-- {
+				occurrence := createTestEvent(uuid.New(), "Weekly Meetup", testUserID)
+				occurrence.SeriesID = &seriesID
+				occurrence.StartDate = start.AddDate(0, 0, 7*i)
+				Expect(repo.Create(ctx, occurrence)).To(Succeed())
+				ids = append([]uuid.UUID{occurrence.ID}, ids...)
+			}
+			Expect(repo.Create(ctx, createTestEvent(uuid.New(), "Standalone", testUserID))).To(Succeed())
+			Expect(repo.Delete(ctx, ids[2])).To(Succeed())
+
+			occurrences, err := repo.FindBySeriesID(ctx, seriesID)
+
+			Expect(err).To(BeNil())
+			Expect(occurrences).To(HaveLen(2))
+			Expect(occurrences[0].ID).To(Equal(ids[0]))
+			Expect(occurrences[1].ID).To(Equal(ids[1]))
+			Expect(occurrences[0].SeriesID).To(HaveValue(Equal(seriesID)))
+
+			found, err := repo.FindByID(ctx, ids[1])
+			Expect(err).To(BeNil())
+			Expect(found.SeriesID).To(HaveValue(Equal(seriesID)))
+		})
+	})
+
 	When("updating an event", func() {
 		BeforeEach(func() {
 			event := createTestEvent(testEventID, "Original Name", testUserID)
@@ -294,6 +597,37 @@ var _ = Describe("EventRepository", func() {
 		})
 	})
 
+	When("updating an event's logo", func() {
+		BeforeEach(func() {
+			event := createTestEvent(testEventID, "Logo Event", testUserID)
+			Expect(repo.Create(ctx, event)).To(Succeed())
+		})
+
+		It("should have no logo until one is uploaded", func() {
+			logo, err := repo.FindLogo(ctx, testEventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logo).To(BeNil())
+		})
+
+		It("should store and remove the logo", func() {
+			Expect(repo.UpdateLogo(ctx, testEventID, []byte("logo"))).To(Succeed())
+			logo, err := repo.FindLogo(ctx, testEventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logo).To(Equal([]byte("logo")))
+
+			Expect(repo.UpdateLogo(ctx, testEventID, nil)).To(Succeed())
+			logo, err = repo.FindLogo(ctx, testEventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(logo).To(BeNil())
+		})
+
+		It("should return not found error for non-existent event", func() {
+			Expect(apperrors.IsNotFound(repo.UpdateLogo(ctx, uuid.New(), []byte("logo")))).To(BeTrue())
+			_, err := repo.FindLogo(ctx, uuid.New())
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
 	When("deleting an event", func() {
 		BeforeEach(func() {
 			event := createTestEvent(testEventID, "Delete Me", testUserID)
@@ -315,6 +649,45 @@ var _ = Describe("EventRepository", func() {
 		})
 	})
 
+	When("getting the list last modified time", func() {
+		BeforeEach(func() {
+			event := createTestEvent(testEventID, "Tracked Event", testUserID)
+			Expect(repo.Create(ctx, event)).To(Succeed())
+		})
+
+		It("should return the same timestamp when nothing changed", func() {
+			filter := repository.EventListFilter{OrganizerID: &testUserID}
+			first, err := repo.GetListLastModified(ctx, filter)
+			Expect(err).To(BeNil())
+			Expect(first.IsZero()).To(BeFalse())
+
+			second, err := repo.GetListLastModified(ctx, filter)
+			Expect(err).To(BeNil())
+			Expect(second).To(Equal(first))
+		})
+
+		It("should advance the timestamp when an event is deleted", func() {
+			filter := repository.EventListFilter{OrganizerID: &testUserID}
+			before, err := repo.GetListLastModified(ctx, filter)
+			Expect(err).To(BeNil())
+
+			time.Sleep(10 * time.Millisecond)
+			Expect(repo.Delete(ctx, testEventID)).To(Succeed())
+
+			after, err := repo.GetListLastModified(ctx, filter)
+			Expect(err).To(BeNil())
+			Expect(after).To(BeTemporally(">", before))
+		})
+
+		It("should return zero time for an organizer without events", func() {
+			otherID := uuid.New()
+			filter := repository.EventListFilter{OrganizerID: &otherID}
+			lastModified, err := repo.GetListLastModified(ctx, filter)
+			Expect(err).To(BeNil())
+			Expect(lastModified.IsZero()).To(BeTrue())
+		})
+	})
+
 	When("getting event stats", func() {
 		BeforeEach(func() {
 			event := createTestEvent(testEventID, "Stats Event", testUserID)
@@ -369,6 +742,53 @@ var _ = Describe("EventRepository", func() {
 				Expect(stats.CheckedInCount).To(Equal(int64(0)))
 			})
 
+			It("should count active walk-ins", func() {
+				Expect(participantRepo.Create(ctx, &entity.Participant{
+					ID:                uuid.New(),
+					EventID:           testEventID,
+					Name:              "Walk-in",
+					Status:            entity.ParticipantStatusConfirmed,
+					WalkIn:            true,
+					QRCode:            "qr-status-walk-in",
+					QRCodeGeneratedAt: time.Now(),
+					PaymentStatus:     entity.PaymentUnpaid,
+					CreatedAt:         time.Now(),
+					UpdatedAt:         time.Now(),
+				})).To(Succeed())
+
+				stats, err := repo.GetStats(ctx, testEventID)
+				Expect(err).To(BeNil())
+				Expect(stats.TotalParticipants).To(Equal(int64(3)))
+				Expect(stats.WalkInCount).To(Equal(int64(1)))
+			})
+
+			It("should count active guests toward the total", func() {
+				registrant, err := participantRepo.FindByQRCode(ctx, "qr-status-0")
+				Expect(err).To(BeNil())
+				for i, status := range []entity.ParticipantStatus{
+					entity.ParticipantStatusConfirmed,
+					entity.ParticipantStatusCancelled,
+				} {
+					Expect(participantRepo.Create(ctx, &entity.Participant{
+						ID:                uuid.New(),
+						EventID:           testEventID,
+						GuestOf:           &registrant.ID,
+						Name:              fmt.Sprintf("Guest %d", i),
+						Status:            status,
+						QRCode:            fmt.Sprintf("qr-status-guest-%d", i),
+						QRCodeGeneratedAt: time.Now(),
+						PaymentStatus:     entity.PaymentUnpaid,
+						CreatedAt:         time.Now(),
+						UpdatedAt:         time.Now(),
+					})).To(Succeed())
+				}
+
+				stats, err := repo.GetStats(ctx, testEventID)
+				Expect(err).To(BeNil())
+				Expect(stats.TotalParticipants).To(Equal(int64(3)))
+				Expect(stats.GuestCount).To(Equal(int64(1)))
+			})
+
 			It("should return by_status breakdown", func() {
 				stats, err := repo.GetStats(ctx, testEventID)
 				Expect(err).To(BeNil())
@@ -376,6 +796,107 @@ var _ = Describe("EventRepository", func() {
 				Expect(stats.ByStatus).To(HaveKeyWithValue("tentative", int64(1)))
 				Expect(stats.ByStatus).To(HaveKeyWithValue("cancelled", int64(1)))
 			})
+
+			It("should return the same stats in a batch as per event", func() {
+				emptyEventID := uuid.New()
+				Expect(repo.Create(ctx, createTestEvent(emptyEventID, "Empty Stats Event", testUserID))).To(Succeed())
+				missingID := uuid.New()
+
+				batch, err := repo.GetStatsBatch(ctx, []uuid.UUID{testEventID, emptyEventID, missingID})
+				Expect(err).To(BeNil())
+				Expect(batch).To(HaveLen(2))
+				Expect(batch).NotTo(HaveKey(missingID))
+
+				for _, id := range []uuid.UUID{testEventID, emptyEventID} {
+					single, err := repo.GetStats(ctx, id)
+					Expect(err).To(BeNil())
+					Expect(batch[id]).To(Equal(single))
+				}
+			})
+		})
+	})
+
+	When("finding events by IDs", func() {
+		It("should return the existing events and skip unknown IDs", func() {
+			otherID := uuid.New()
+			Expect(repo.Create(ctx, createTestEvent(testEventID, "First", testUserID))).To(Succeed())
+			Expect(repo.Create(ctx, createTestEvent(otherID, "Second", testUserID))).To(Succeed())
+
+			events, err := repo.FindByIDs(ctx, []uuid.UUID{testEventID, otherID, uuid.New()})
+			Expect(err).To(BeNil())
+			Expect(events).To(HaveLen(2))
+			ids := []uuid.UUID{events[0].ID, events[1].ID}
+			Expect(ids).To(ConsistOf(testEventID, otherID))
+		})
+	})
+
+	When("purging participant data for retention", func() {
+		var now time.Time
+
+		createEndedEvent := func(id uuid.UUID, status entity.EventStatus, endedAgo time.Duration) *entity.Event {
+			event := createTestEvent(id, "Past Event", testUserID)
+			end := now.Add(-endedAgo)
+			event.StartDate = end.Add(-8 * time.Hour)
+			event.EndDate = &end
+			event.Status = status
+			Expect(repo.Create(ctx, event)).To(Succeed())
+			return event
+		}
+
+		BeforeEach(func() {
+			now = time.Now().UTC().Truncate(time.Microsecond)
+		})
+
+		It("should find only completed events past the cutoff that are not on legal hold", func() {
+			due := createEndedEvent(testEventID, entity.StatusCompleted, 40*24*time.Hour)
+			createEndedEvent(uuid.New(), entity.StatusCompleted, 10*24*time.Hour)
+			createEndedEvent(uuid.New(), entity.StatusCancelled, 40*24*time.Hour)
+			held := createEndedEvent(uuid.New(), entity.StatusCompleted, 40*24*time.Hour)
+			held.LegalHold = true
+			Expect(repo.Update(ctx, held)).To(Succeed())
+
+			events, err := repo.FindPIIPurgeDue(ctx, now.Add(-30*24*time.Hour), 10)
+			Expect(err).To(BeNil())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].ID).To(Equal(due.ID))
+		})
+
+		It("should mark the event purged once and record the audit entry", func() {
+			createEndedEvent(testEventID, entity.StatusCompleted, 40*24*time.Hour)
+
+			marked, err := repo.MarkPIIPurged(ctx, testEventID, now)
+			Expect(err).To(BeNil())
+			Expect(marked).To(BeTrue())
+			Expect(repo.RecordPIIPurge(ctx, &entity.PIIPurge{
+				EventID:                testEventID,
+				OrganizerID:            testUserID,
+				ParticipantsAnonymized: 3,
+				RetentionDays:          30,
+				PurgedAt:               now,
+			})).To(Succeed())
+
+			found, err := repo.FindByID(ctx, testEventID)
+			Expect(err).To(BeNil())
+			Expect(found.PIIPurgedAt).NotTo(BeNil())
+			Expect(found.PIIPurgedAt.Equal(now)).To(BeTrue())
+
+			marked, err = repo.MarkPIIPurged(ctx, testEventID, now)
+			Expect(err).To(BeNil())
+			Expect(marked).To(BeFalse())
+
+			events, err := repo.FindPIIPurgeDue(ctx, now, 10)
+			Expect(err).To(BeNil())
+			Expect(events).To(BeEmpty())
+		})
+
+		It("should not mark an event on legal hold", func() {
+			event := createEndedEvent(testEventID, entity.StatusCompleted, 40*24*time.Hour)
+			event.LegalHold = true
+			Expect(repo.Update(ctx, event)).To(Succeed())
+
+			marked, err := repo.MarkPIIPurged(ctx, testEventID, now)
+			Expect(err).To(BeNil())
+			Expect(marked).To(BeFalse())
 		})
 	})
 })
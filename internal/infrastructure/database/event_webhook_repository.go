@@ -0,0 +1,122 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// eventWebhookRepository implements the EventWebhookRepository interface.
+type eventWebhookRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewEventWebhookRepository creates a new event webhook repository.
+func NewEventWebhookRepository(pool *pgxpool.Pool) repository.EventWebhookRepository {
+	return &eventWebhookRepository{pool: pool}
+}
+
+// Upsert creates or replaces the webhook of an event, clearing the last delivery on replacement.
+func (r *eventWebhookRepository) Upsert(ctx context.Context, webhook *entity.EventWebhook) error {
+	if err := webhook.Validate(); err != nil {
+		return apperrors.Wrapf(err, "invalid event webhook")
+	}
+
+	query := `
+		INSERT INTO event_webhooks (event_id, url, secret, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (event_id) DO UPDATE SET
+			url = EXCLUDED.url,
+			secret = EXCLUDED.secret,
+			last_delivery_at = NULL,
+			last_delivery_status = NULL,
+			last_delivery_error = NULL,
+			updated_at = EXCLUDED.updated_at
+		RETURNING created_at
+	`
+
+	err := r.pool.QueryRow(ctx, query,
+		webhook.EventID,
+		webhook.URL,
+		webhook.Secret,
+		webhook.CreatedAt,
+		webhook.UpdatedAt,
+	).Scan(&webhook.CreatedAt)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to save event webhook")
+	}
+
+	webhook.LastDeliveryAt = nil
+	webhook.LastDeliveryStatus = nil
+	webhook.LastDeliveryError = ""
+	return nil
+}
+
+// FindByEventID finds the webhook of an event.
+func (r *eventWebhookRepository) FindByEventID(ctx context.Context, eventID uuid.UUID) (*entity.EventWebhook, error) {
+	query := `
+		SELECT
+			event_id, url, secret, last_delivery_at, last_delivery_status,
+			COALESCE(last_delivery_error, ''), created_at, updated_at
+		FROM event_webhooks
+		WHERE event_id = $1
+	`
+
+	var webhook entity.EventWebhook
+	err := r.pool.QueryRow(ctx, query, eventID).Scan(
+		&webhook.EventID,
+		&webhook.URL,
+		&webhook.Secret,
+		&webhook.LastDeliveryAt,
+		&webhook.LastDeliveryStatus,
+		&webhook.LastDeliveryError,
+		&webhook.CreatedAt,
+		&webhook.UpdatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.NotFound("event webhook not found")
+		}
+		return nil, apperrors.Wrapf(err, "failed to find event webhook")
+	}
+
+	return &webhook, nil
+}
+
+// Delete removes the webhook of an event.
+func (r *eventWebhookRepository) Delete(ctx context.Context, eventID uuid.UUID) error {
+	result, err := r.pool.Exec(ctx, `DELETE FROM event_webhooks WHERE event_id = $1`, eventID)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to delete event webhook")
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.NotFound("event webhook not found")
+	}
+	return nil
+}
+
+// RecordDelivery records the outcome of a delivery attempt to the webhook of an event.
+func (r *eventWebhookRepository) RecordDelivery(
+	ctx context.Context,
+	eventID uuid.UUID,
+	at time.Time,
+	status entity.WebhookDeliveryStatus,
+	deliveryErr string,
+) error {
+	query := `
+		UPDATE event_webhooks
+		SET last_delivery_at = $1, last_delivery_status = $2, last_delivery_error = NULLIF($3, '')
+		WHERE event_id = $4
+	`
+	if _, err := r.pool.Exec(ctx, query, at, status, deliveryErr, eventID); err != nil {
+		return apperrors.Wrapf(err, "failed to record event webhook delivery")
+	}
+	return nil
+}
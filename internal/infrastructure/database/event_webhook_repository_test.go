@@ -0,0 +1,156 @@
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EventWebhookRepository", func() {
+	var (
+		ctx     context.Context
+		db      *database.PostgresDB
+		repo    repository.EventWebhookRepository
+		eventID uuid.UUID
+		webhook *entity.EventWebhook
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		log, _ := logger.New(logger.Config{
+			Level:       "info",
+			Format:      "console",
+			Environment: "development",
+		})
+		cfg := &config.DatabaseConfig{
+			Host:            "postgres",
+			Port:            5432,
+			User:            "ezqrin",
+			Password:        "ezqrin_dev",
+			Name:            "ezqrin_test",
+			SSLMode:         "disable",
+			MaxConns:        25,
+			MinConns:        5,
+			MaxConnLifetime: time.Hour,
+			MaxConnIdleTime: 30 * time.Minute,
+		}
+
+		var err error
+		db, err = database.NewPostgresDB(ctx, cfg, log)
+		Expect(err).NotTo(HaveOccurred())
+		repo = database.NewEventWebhookRepository(db.GetPool())
+
+		organizerID := uuid.New()
+		Expect(database.NewUserRepository(db.GetPool(), log).Create(ctx, &entity.User{
+			ID:           organizerID,
+			Email:        fmt.Sprintf("organizer_%s@example.com", organizerID.String()[:8]),
+			PasswordHash: "hashed_password",
+			Name:         "Organizer User",
+			Role:         entity.RoleOrganizer,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		})).To(Succeed())
+
+		eventID = uuid.New()
+		Expect(database.NewEventRepository(db.GetPool(), log).Create(ctx, &entity.Event{
+			ID:          eventID,
+			OrganizerID: organizerID,
+			Name:        "Tech Conf",
+			StartDate:   time.Now().Add(24 * time.Hour),
+			Timezone:    "Asia/Tokyo",
+			Status:      entity.StatusDraft,
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		})).To(Succeed())
+
+		now := time.Now().UTC().Truncate(time.Microsecond)
+		webhook = &entity.EventWebhook{
+			EventID:   eventID,
+			URL:       "https://hooks.example.com/ezqrin",
+			Secret:    "whsec_0123456789",
+			CreatedAt: now,
+			UpdatedAt: now,
+		}
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			pool := db.GetPool()
+			_, _ = pool.Exec(ctx, "TRUNCATE TABLE users CASCADE")
+			_, _ = pool.Exec(ctx, "TRUNCATE TABLE events CASCADE")
+			db.Close()
+		}
+	})
+
+	When("saving a webhook", func() {
+		It("should store it for the event", func() {
+			Expect(repo.Upsert(ctx, webhook)).To(Succeed())
+
+			found, err := repo.FindByEventID(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found.URL).To(Equal(webhook.URL))
+			Expect(found.Secret).To(Equal(webhook.Secret))
+			Expect(found.LastDeliveryStatus).To(BeNil())
+		})
+
+		It("should replace the webhook and reset its last delivery", func() {
+			Expect(repo.Upsert(ctx, webhook)).To(Succeed())
+			Expect(repo.RecordDelivery(ctx, eventID, time.Now(), entity.WebhookDeliveryFailed, "timeout")).To(Succeed())
+
+			replacement := *webhook
+			replacement.URL = "https://crm.example.com/hooks"
+			replacement.UpdatedAt = webhook.UpdatedAt.Add(time.Minute)
+			Expect(repo.Upsert(ctx, &replacement)).To(Succeed())
+
+			found, err := repo.FindByEventID(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found.URL).To(Equal("https://crm.example.com/hooks"))
+			Expect(found.CreatedAt).To(Equal(webhook.CreatedAt))
+			Expect(found.LastDeliveryAt).To(BeNil())
+			Expect(found.LastDeliveryStatus).To(BeNil())
+			Expect(found.LastDeliveryError).To(BeEmpty())
+		})
+	})
+
+	When("recording a delivery", func() {
+		It("should keep the outcome of the latest attempt", func() {
+			Expect(repo.Upsert(ctx, webhook)).To(Succeed())
+			Expect(repo.RecordDelivery(ctx, eventID, time.Now(), entity.WebhookDeliveryFailed, "timeout")).To(Succeed())
+			Expect(repo.RecordDelivery(ctx, eventID, time.Now(), entity.WebhookDeliverySucceeded, "")).To(Succeed())
+
+			found, err := repo.FindByEventID(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found.LastDeliveryAt).NotTo(BeNil())
+			Expect(*found.LastDeliveryStatus).To(Equal(entity.WebhookDeliverySucceeded))
+			Expect(found.LastDeliveryError).To(BeEmpty())
+		})
+	})
+
+	When("deleting a webhook", func() {
+		It("should remove it", func() {
+			Expect(repo.Upsert(ctx, webhook)).To(Succeed())
+			Expect(repo.Delete(ctx, eventID)).To(Succeed())
+
+			_, err := repo.FindByEventID(ctx, eventID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should return not found for an event without a webhook", func() {
+			err := repo.Delete(ctx, eventID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
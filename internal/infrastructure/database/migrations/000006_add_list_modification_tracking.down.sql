@@ -0,0 +1,15 @@
+-- Drop triggers
+DROP TRIGGER IF EXISTS trg_events_record_deletion ON events;
+DROP TRIGGER IF EXISTS trg_checkins_touch_event ON checkins;
+DROP TRIGGER IF EXISTS trg_participants_touch_event ON participants;
+
+-- Drop trigger functions
+DROP FUNCTION IF EXISTS record_event_deletion();
+DROP FUNCTION IF EXISTS touch_event_participants_modified_at();
+
+-- Drop tombstone table
+DROP INDEX IF EXISTS idx_event_deletions_organizer_deleted_at;
+DROP TABLE IF EXISTS event_deletions;
+
+-- Drop modification marker
+ALTER TABLE events DROP COLUMN IF EXISTS participants_modified_at;
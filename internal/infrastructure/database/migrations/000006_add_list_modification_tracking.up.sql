@@ -0,0 +1,45 @@
+-- Track list modifications so list endpoints can answer conditional GETs.
+-- participants_modified_at is bumped whenever a participant or check-in of the
+-- event changes, including deletes that leave no updated_at behind.
+ALTER TABLE events ADD COLUMN IF NOT EXISTS participants_modified_at TIMESTAMP NOT NULL DEFAULT NOW();
+
+-- Tombstones for deleted events so the event list Last-Modified moves forward on delete.
+CREATE TABLE IF NOT EXISTS event_deletions (
+    event_id UUID PRIMARY KEY,
+    organizer_id UUID NOT NULL,
+    deleted_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_event_deletions_organizer_deleted_at ON event_deletions(organizer_id, deleted_at);
+
+CREATE OR REPLACE FUNCTION touch_event_participants_modified_at() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        UPDATE events SET participants_modified_at = NOW() WHERE id = OLD.event_id;
+        RETURN OLD;
+    END IF;
+    UPDATE events SET participants_modified_at = NOW() WHERE id = NEW.event_id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_participants_touch_event
+    AFTER INSERT OR UPDATE OR DELETE ON participants
+    FOR EACH ROW EXECUTE FUNCTION touch_event_participants_modified_at();
+
+CREATE TRIGGER trg_checkins_touch_event
+    AFTER INSERT OR UPDATE OR DELETE ON checkins
+    FOR EACH ROW EXECUTE FUNCTION touch_event_participants_modified_at();
+
+CREATE OR REPLACE FUNCTION record_event_deletion() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO event_deletions (event_id, organizer_id, deleted_at)
+    VALUES (OLD.id, OLD.organizer_id, NOW())
+    ON CONFLICT (event_id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_events_record_deletion
+    AFTER DELETE ON events
+    FOR EACH ROW EXECUTE FUNCTION record_event_deletion();
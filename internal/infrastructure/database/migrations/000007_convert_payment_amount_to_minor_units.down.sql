@@ -0,0 +1,5 @@
+-- Revert payment amounts to NUMERIC(10, 2) major units
+COMMENT ON COLUMN participants.payment_amount IS NULL;
+
+ALTER TABLE participants
+    ALTER COLUMN payment_amount TYPE NUMERIC(10, 2) USING payment_amount / 100.0;
@@ -0,0 +1,5 @@
+-- Store payment amounts as integer minor units (e.g. cents) to avoid rounding errors
+ALTER TABLE participants
+    ALTER COLUMN payment_amount TYPE BIGINT USING ROUND(payment_amount * 100)::BIGINT;
+
+COMMENT ON COLUMN participants.payment_amount IS 'Payment amount in minor units (1/100 of the major unit)';
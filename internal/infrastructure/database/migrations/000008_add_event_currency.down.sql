@@ -0,0 +1,3 @@
+-- Remove the event currency column
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_currency_format;
+ALTER TABLE events DROP COLUMN IF EXISTS currency;
@@ -0,0 +1,8 @@
+-- Add an ISO 4217 currency to events; participant payment amounts are interpreted in it.
+-- Existing events are left without a currency (NULL).
+ALTER TABLE events ADD COLUMN currency VARCHAR(3);
+
+ALTER TABLE events
+    ADD CONSTRAINT events_currency_format CHECK (currency IS NULL OR currency ~ '^[A-Z]{3}$');
+
+COMMENT ON COLUMN events.currency IS 'ISO 4217 currency code for participant payment amounts';
@@ -0,0 +1,6 @@
+-- Remove the event fee model columns
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_fee_amount_check;
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_fee_type_check;
+ALTER TABLE events DROP COLUMN IF EXISTS fee_tiers;
+ALTER TABLE events DROP COLUMN IF EXISTS fee_amount;
+ALTER TABLE events DROP COLUMN IF EXISTS fee_type;
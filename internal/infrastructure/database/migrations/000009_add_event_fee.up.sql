@@ -0,0 +1,15 @@
+-- Add an event-level fee model. Events without a fee type keep per-participant amounts.
+-- fee_amount holds the fixed fee in minor units; fee_tiers holds [{"name", "amount"}] for tiered fees.
+ALTER TABLE events ADD COLUMN fee_type VARCHAR(10);
+ALTER TABLE events ADD COLUMN fee_amount BIGINT;
+ALTER TABLE events ADD COLUMN fee_tiers JSONB;
+
+ALTER TABLE events
+    ADD CONSTRAINT events_fee_type_check CHECK (fee_type IS NULL OR fee_type IN ('free', 'fixed', 'tiered'));
+
+ALTER TABLE events
+    ADD CONSTRAINT events_fee_amount_check CHECK (fee_amount IS NULL OR fee_amount > 0);
+
+COMMENT ON COLUMN events.fee_type IS 'Fee model: free, fixed or tiered (NULL for per-participant amounts)';
+COMMENT ON COLUMN events.fee_amount IS 'Fixed fee in minor units of the event currency';
+COMMENT ON COLUMN events.fee_tiers IS 'Named fee tiers as a JSON array of {"name", "amount"}';
@@ -0,0 +1,7 @@
+-- Remove pending invitations, which have no QR code, and restore the NOT NULL constraint
+DELETE FROM participants WHERE qr_code IS NULL;
+
+ALTER TABLE participants DROP CONSTRAINT IF EXISTS participants_invited_qr_code;
+ALTER TABLE participants ALTER COLUMN qr_code SET NOT NULL;
+
+COMMENT ON COLUMN participants.qr_code IS NULL;
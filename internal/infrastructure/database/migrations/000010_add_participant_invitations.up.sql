@@ -0,0 +1,8 @@
+-- Allow participants in 'invited' status, who receive a QR code only when they accept
+-- the invitation. The QR code is therefore NULL exactly while the participant is invited.
+ALTER TABLE participants ALTER COLUMN qr_code DROP NOT NULL;
+
+ALTER TABLE participants
+    ADD CONSTRAINT participants_invited_qr_code CHECK ((status = 'invited') = (qr_code IS NULL));
+
+COMMENT ON COLUMN participants.qr_code IS 'Signed QR token; NULL while the participant is invited';
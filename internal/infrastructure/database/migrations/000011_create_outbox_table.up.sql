@@ -0,0 +1,21 @@
+-- Transactional outbox for webhook delivery. Domain events are inserted in the same
+-- transaction as the state change they describe and delivered afterwards by the relay,
+-- giving at-least-once delivery across crashes and restarts.
+CREATE TABLE IF NOT EXISTS outbox (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    event_type VARCHAR(100) NOT NULL,
+    aggregate_id UUID NOT NULL,
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    last_error TEXT,
+    delivered_at TIMESTAMP,
+    failed_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+-- The relay only scans messages that are neither delivered nor abandoned.
+CREATE INDEX IF NOT EXISTS idx_outbox_pending ON outbox(next_attempt_at)
+    WHERE delivered_at IS NULL AND failed_at IS NULL;
+
+COMMENT ON COLUMN outbox.next_attempt_at IS 'Earliest time the relay may (re)deliver the message; pushed forward while a delivery is in flight';
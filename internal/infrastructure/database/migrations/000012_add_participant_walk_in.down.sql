@@ -0,0 +1,9 @@
+-- Remove walk-ins registered without an email and restore the NOT NULL constraint
+DELETE FROM participants WHERE email IS NULL;
+
+ALTER TABLE participants DROP CONSTRAINT IF EXISTS participants_email_required;
+ALTER TABLE participants ALTER COLUMN email SET NOT NULL;
+
+COMMENT ON COLUMN participants.email IS NULL;
+
+ALTER TABLE participants DROP COLUMN IF EXISTS walk_in;
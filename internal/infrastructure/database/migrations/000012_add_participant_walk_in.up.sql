@@ -0,0 +1,11 @@
+-- Walk-in participants are registered at the door while being checked in.
+-- They may have no email address, so email is only required for other participants.
+ALTER TABLE participants ADD COLUMN walk_in BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE participants ALTER COLUMN email DROP NOT NULL;
+
+ALTER TABLE participants
+    ADD CONSTRAINT participants_email_required CHECK (walk_in OR email IS NOT NULL);
+
+COMMENT ON COLUMN participants.walk_in IS 'Registered on the spot at check-in rather than in advance';
+COMMENT ON COLUMN participants.email IS 'Contact email; NULL only for walk-ins registered without one';
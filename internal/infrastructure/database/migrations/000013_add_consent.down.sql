@@ -0,0 +1,7 @@
+-- Remove the consent columns
+ALTER TABLE participants DROP COLUMN IF EXISTS consent_version;
+ALTER TABLE participants DROP COLUMN IF EXISTS consent_accepted_at;
+
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_consent_version_required;
+ALTER TABLE events DROP COLUMN IF EXISTS consent_version;
+ALTER TABLE events DROP COLUMN IF EXISTS requires_consent;
@@ -0,0 +1,16 @@
+-- Events can require attendees to accept consent terms, such as a signed waiver, before check-in.
+-- consent_version identifies the terms text that participants accept.
+ALTER TABLE events ADD COLUMN requires_consent BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE events ADD COLUMN consent_version VARCHAR(50);
+
+ALTER TABLE events
+    ADD CONSTRAINT events_consent_version_required CHECK (NOT requires_consent OR consent_version IS NOT NULL);
+
+-- Participants record when they accepted the consent terms and which version they accepted
+ALTER TABLE participants ADD COLUMN consent_accepted_at TIMESTAMP;
+ALTER TABLE participants ADD COLUMN consent_version VARCHAR(50);
+
+COMMENT ON COLUMN events.requires_consent IS 'Participants must accept the consent terms before check-in';
+COMMENT ON COLUMN events.consent_version IS 'Version of the consent terms participants accept';
+COMMENT ON COLUMN participants.consent_accepted_at IS 'When the participant accepted the consent terms; NULL if not accepted';
+COMMENT ON COLUMN participants.consent_version IS 'Version of the consent terms the participant accepted';
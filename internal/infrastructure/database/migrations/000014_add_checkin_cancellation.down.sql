@@ -0,0 +1,8 @@
+-- Cancelled check-ins cannot be represented without the cancellation columns
+DELETE FROM checkins WHERE cancelled_at IS NOT NULL;
+
+DROP INDEX IF EXISTS unique_event_participant_checkin;
+ALTER TABLE checkins ADD CONSTRAINT unique_event_participant_checkin UNIQUE(event_id, participant_id);
+
+ALTER TABLE checkins DROP COLUMN IF EXISTS cancelled_by;
+ALTER TABLE checkins DROP COLUMN IF EXISTS cancelled_at;
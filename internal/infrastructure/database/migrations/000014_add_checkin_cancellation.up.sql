@@ -0,0 +1,13 @@
+-- Cancelling a check-in marks it instead of deleting it, so a cancellation can be undone
+-- within the configured window and leaves an audit trail.
+ALTER TABLE checkins ADD COLUMN cancelled_at TIMESTAMP;
+ALTER TABLE checkins ADD COLUMN cancelled_by UUID REFERENCES users(id);
+
+-- A participant may check in again after a cancellation, so only active check-ins are unique.
+-- The index keeps the constraint's name, which the repository uses to detect duplicates.
+ALTER TABLE checkins DROP CONSTRAINT IF EXISTS unique_event_participant_checkin;
+CREATE UNIQUE INDEX IF NOT EXISTS unique_event_participant_checkin
+    ON checkins(event_id, participant_id) WHERE cancelled_at IS NULL;
+
+COMMENT ON COLUMN checkins.cancelled_at IS 'When the check-in was cancelled; NULL for active check-ins';
+COMMENT ON COLUMN checkins.cancelled_by IS 'User who cancelled the check-in';
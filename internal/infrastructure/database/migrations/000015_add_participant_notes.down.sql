@@ -0,0 +1 @@
+ALTER TABLE participants DROP COLUMN IF EXISTS notes;
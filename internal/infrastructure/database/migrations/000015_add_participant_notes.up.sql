@@ -0,0 +1,4 @@
+-- Internal staff notes on a participant (e.g. accessibility needs); never shown to attendees
+ALTER TABLE participants ADD COLUMN notes VARCHAR(2000);
+
+COMMENT ON COLUMN participants.notes IS 'Internal notes by event staff; not exposed to attendees';
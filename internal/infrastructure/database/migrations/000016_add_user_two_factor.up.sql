@@ -0,0 +1,15 @@
+-- Optional TOTP second factor of a user. The secret is stored encrypted by the application;
+-- two-factor login is required once totp_enabled_at is set by a verified enrollment.
+ALTER TABLE users ADD COLUMN totp_secret TEXT;
+ALTER TABLE users ADD COLUMN totp_enabled_at TIMESTAMP;
+ALTER TABLE users ADD COLUMN totp_backup_codes TEXT[] NOT NULL DEFAULT '{}';
+ALTER TABLE users ADD COLUMN totp_last_step BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN totp_failed_attempts INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE users ADD COLUMN totp_failed_at TIMESTAMP;
+
+COMMENT ON COLUMN users.totp_secret IS 'AES-GCM encrypted TOTP secret; NULL when no enrollment was started';
+COMMENT ON COLUMN users.totp_enabled_at IS 'When two-factor login was enabled; NULL while the enrollment is unverified';
+COMMENT ON COLUMN users.totp_backup_codes IS 'SHA-256 hashes of the unused single-use backup codes';
+COMMENT ON COLUMN users.totp_last_step IS 'TOTP time step of the last accepted code, to reject replayed codes';
+COMMENT ON COLUMN users.totp_failed_attempts IS 'Invalid two-factor codes entered at login since the last accepted one';
+COMMENT ON COLUMN users.totp_failed_at IS 'When the last invalid two-factor code was entered';
@@ -0,0 +1,14 @@
+-- Every QR scan at check-in is recorded with its outcome, separately from the check-ins
+-- themselves, so organizers can compare scan attempts with successful check-ins.
+CREATE TABLE IF NOT EXISTS checkin_scans (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    outcome VARCHAR(20) NOT NULL,
+    scanned_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT checkin_scans_outcome_check CHECK (outcome IN ('success', 'duplicate', 'not_found', 'invalid'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_checkin_scans_event_scanned_at ON checkin_scans(event_id, scanned_at);
+
+COMMENT ON TABLE checkin_scans IS 'QR scan attempts at check-in, including failed and duplicate scans';
+COMMENT ON COLUMN checkin_scans.outcome IS 'Scan result: success, duplicate, not_found or invalid';
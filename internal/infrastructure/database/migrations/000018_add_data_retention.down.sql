@@ -0,0 +1,6 @@
+-- Remove the retention columns and audit log; anonymized participants are not restored
+DROP TABLE IF EXISTS pii_purges;
+
+DROP INDEX IF EXISTS idx_events_retention;
+ALTER TABLE events DROP COLUMN IF EXISTS pii_purged_at;
+ALTER TABLE events DROP COLUMN IF EXISTS legal_hold;
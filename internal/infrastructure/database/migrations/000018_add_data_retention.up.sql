@@ -0,0 +1,24 @@
+-- Participant personal data of completed events is anonymized once the retention period
+-- has passed. Events on legal hold are skipped; pii_purged_at marks purged events.
+ALTER TABLE events ADD COLUMN legal_hold BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE events ADD COLUMN pii_purged_at TIMESTAMP;
+
+CREATE INDEX IF NOT EXISTS idx_events_retention ON events(end_date)
+    WHERE status = 'completed' AND pii_purged_at IS NULL AND NOT legal_hold;
+
+-- Audit entries of retention purges. They have no foreign key so that they outlive the event.
+CREATE TABLE IF NOT EXISTS pii_purges (
+    id BIGSERIAL PRIMARY KEY,
+    event_id UUID NOT NULL,
+    organizer_id UUID NOT NULL,
+    participants_anonymized INTEGER NOT NULL,
+    retention_days INTEGER NOT NULL,
+    purged_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_pii_purges_event_id ON pii_purges(event_id);
+
+COMMENT ON COLUMN events.legal_hold IS 'Participant data is kept regardless of the retention period';
+COMMENT ON COLUMN events.pii_purged_at IS 'When the participants were anonymized by the retention purge; NULL if not purged';
+COMMENT ON TABLE pii_purges IS 'Audit log of retention purges that anonymized the participants of an event';
+COMMENT ON COLUMN pii_purges.retention_days IS 'Retention period in days after the event end that applied to the purge';
@@ -0,0 +1,11 @@
+-- Remove guests, whose rows cannot exist without the guest_of column
+DELETE FROM participants WHERE guest_of IS NOT NULL;
+
+ALTER TABLE participants DROP CONSTRAINT IF EXISTS participants_email_required;
+ALTER TABLE participants
+    ADD CONSTRAINT participants_email_required CHECK (walk_in OR email IS NOT NULL);
+
+COMMENT ON COLUMN participants.email IS 'Contact email; NULL only for walk-ins registered without one';
+
+DROP INDEX IF EXISTS idx_participants_guest_of;
+ALTER TABLE participants DROP COLUMN IF EXISTS guest_of;
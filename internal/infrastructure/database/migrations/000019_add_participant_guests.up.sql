@@ -0,0 +1,13 @@
+-- Guests of a registrant (companion tickets) are participants of the same event linked to the
+-- registrant. Each guest has its own QR code and check-in, and counts toward the event totals.
+ALTER TABLE participants ADD COLUMN guest_of UUID REFERENCES participants(id) ON DELETE CASCADE;
+
+CREATE INDEX IF NOT EXISTS idx_participants_guest_of ON participants(guest_of) WHERE guest_of IS NOT NULL;
+
+-- Guests may be registered without an email, like walk-ins
+ALTER TABLE participants DROP CONSTRAINT IF EXISTS participants_email_required;
+ALTER TABLE participants
+    ADD CONSTRAINT participants_email_required CHECK (walk_in OR guest_of IS NOT NULL OR email IS NOT NULL);
+
+COMMENT ON COLUMN participants.guest_of IS 'Registrant this participant is a guest of; NULL for registrants';
+COMMENT ON COLUMN participants.email IS 'Contact email; NULL only for walk-ins and guests registered without one';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_participants_tags;
+ALTER TABLE participants DROP COLUMN IF EXISTS tags;
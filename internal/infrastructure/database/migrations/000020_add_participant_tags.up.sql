@@ -0,0 +1,7 @@
+-- Organizer labels on participants (e.g. "vip", "follow-up"), unique per participant and kept
+-- in the order they were added. The cap and tag length are enforced by the application.
+ALTER TABLE participants ADD COLUMN tags TEXT[] NOT NULL DEFAULT '{}';
+
+CREATE INDEX IF NOT EXISTS idx_participants_tags ON participants USING GIN (tags);
+
+COMMENT ON COLUMN participants.tags IS 'Organizer labels; at most 20 per participant, 1-50 characters each';
@@ -0,0 +1,7 @@
+DROP INDEX IF EXISTS idx_checkins_event_updated_at;
+DROP INDEX IF EXISTS idx_participants_event_updated_at;
+ALTER TABLE checkins DROP COLUMN IF EXISTS updated_at;
+
+DROP TRIGGER IF EXISTS trg_participants_record_deletion ON participants;
+DROP FUNCTION IF EXISTS record_participant_deletion();
+DROP TABLE IF EXISTS participant_deletions;
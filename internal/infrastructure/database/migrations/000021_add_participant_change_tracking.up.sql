@@ -0,0 +1,33 @@
+-- Change tracking for the participant changes feed (GET /events/{id}/participants/changes).
+-- Deleted participants leave a tombstone, like deleted events, so the feed can report them.
+CREATE TABLE IF NOT EXISTS participant_deletions (
+    participant_id UUID PRIMARY KEY,
+    event_id UUID NOT NULL,
+    deleted_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_participant_deletions_event_deleted_at
+    ON participant_deletions(event_id, deleted_at);
+
+CREATE OR REPLACE FUNCTION record_participant_deletion() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO participant_deletions (participant_id, event_id, deleted_at)
+    VALUES (OLD.id, OLD.event_id, NOW())
+    ON CONFLICT (participant_id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_participants_record_deletion
+    AFTER DELETE ON participants
+    FOR EACH ROW EXECUTE FUNCTION record_participant_deletion();
+
+-- Check-ins record when they last changed, since restoring a cancelled check-in leaves no
+-- other timestamp behind
+ALTER TABLE checkins ADD COLUMN updated_at TIMESTAMP;
+UPDATE checkins SET updated_at = GREATEST(checked_in_at, cancelled_at);
+ALTER TABLE checkins ALTER COLUMN updated_at SET DEFAULT NOW();
+ALTER TABLE checkins ALTER COLUMN updated_at SET NOT NULL;
+
+CREATE INDEX IF NOT EXISTS idx_participants_event_updated_at ON participants(event_id, updated_at);
+CREATE INDEX IF NOT EXISTS idx_checkins_event_updated_at ON checkins(event_id, updated_at);
@@ -0,0 +1,14 @@
+-- Return expired participants to the status they expired from, and remove the expiry settings
+DROP INDEX IF EXISTS idx_participants_expirable;
+
+UPDATE participants SET status = 'invited' WHERE status = 'expired' AND qr_code IS NULL;
+UPDATE participants SET status = 'tentative' WHERE status = 'expired';
+
+ALTER TABLE participants DROP CONSTRAINT IF EXISTS participants_invited_qr_code;
+ALTER TABLE participants
+    ADD CONSTRAINT participants_invited_qr_code CHECK ((status = 'invited') = (qr_code IS NULL));
+
+COMMENT ON COLUMN participants.qr_code IS 'Signed QR token; NULL while the participant is invited';
+
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_tentative_expiry_hours_range;
+ALTER TABLE events DROP COLUMN IF EXISTS tentative_expiry_hours;
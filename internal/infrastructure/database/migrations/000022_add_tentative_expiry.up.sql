@@ -0,0 +1,17 @@
+-- Events can let participants who stay tentative or invited expire after a number of hours,
+-- freeing their place. NULL never expires them.
+ALTER TABLE events ADD COLUMN tentative_expiry_hours INTEGER;
+
+ALTER TABLE events
+    ADD CONSTRAINT events_tentative_expiry_hours_range CHECK (tentative_expiry_hours BETWEEN 1 AND 8760);
+
+-- Expired invitations keep having no QR code, while expired tentative participants keep theirs
+ALTER TABLE participants DROP CONSTRAINT IF EXISTS participants_invited_qr_code;
+ALTER TABLE participants
+    ADD CONSTRAINT participants_invited_qr_code CHECK (status = 'expired' OR (status = 'invited') = (qr_code IS NULL));
+
+CREATE INDEX IF NOT EXISTS idx_participants_expirable ON participants(created_at)
+    WHERE status IN ('tentative', 'invited');
+
+COMMENT ON COLUMN events.tentative_expiry_hours IS 'Hours after registration or invitation a tentative or invited participant expires; NULL if never';
+COMMENT ON COLUMN participants.qr_code IS 'Signed QR token; NULL while the participant is invited and after the invitation expired';
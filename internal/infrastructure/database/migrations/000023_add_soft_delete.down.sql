@@ -0,0 +1,30 @@
+DROP TRIGGER IF EXISTS trg_participants_record_soft_deletion ON participants;
+DROP TRIGGER IF EXISTS trg_events_record_soft_deletion ON events;
+
+CREATE OR REPLACE FUNCTION record_participant_deletion() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO participant_deletions (participant_id, event_id, deleted_at)
+    VALUES (OLD.id, OLD.event_id, NOW())
+    ON CONFLICT (participant_id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE FUNCTION record_event_deletion() RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO event_deletions (event_id, organizer_id, deleted_at)
+    VALUES (OLD.id, OLD.organizer_id, NOW())
+    ON CONFLICT (event_id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+-- Soft-deleted rows cannot be represented without the deleted_at columns
+DELETE FROM events WHERE deleted_at IS NOT NULL;
+DELETE FROM participants WHERE deleted_at IS NOT NULL;
+
+DROP INDEX IF EXISTS unique_event_email;
+ALTER TABLE participants ADD CONSTRAINT unique_event_email UNIQUE(event_id, email);
+
+ALTER TABLE participants DROP COLUMN IF EXISTS deleted_at;
+ALTER TABLE events DROP COLUMN IF EXISTS deleted_at;
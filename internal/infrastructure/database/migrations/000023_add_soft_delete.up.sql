@@ -0,0 +1,63 @@
+-- Events and participants are soft deleted: deleting one stamps deleted_at instead of removing
+-- the row, so it can be restored. Deleting an event stamps its participants with the same time.
+ALTER TABLE events ADD COLUMN deleted_at TIMESTAMP;
+ALTER TABLE participants ADD COLUMN deleted_at TIMESTAMP;
+
+-- A deleted participant no longer holds its email within the event. The index keeps the
+-- constraint's name, which the repository uses to detect duplicates.
+ALTER TABLE participants DROP CONSTRAINT IF EXISTS unique_event_email;
+CREATE UNIQUE INDEX IF NOT EXISTS unique_event_email
+    ON participants(event_id, email) WHERE deleted_at IS NULL;
+
+-- The deletion tombstones used by the event list Last-Modified and the participant changes feed
+-- follow soft deletes: deleting records a tombstone and restoring removes it.
+CREATE OR REPLACE FUNCTION record_event_deletion() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO event_deletions (event_id, organizer_id, deleted_at)
+        VALUES (OLD.id, OLD.organizer_id, NOW())
+        ON CONFLICT (event_id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at;
+        RETURN OLD;
+    END IF;
+    IF NEW.deleted_at IS NULL THEN
+        DELETE FROM event_deletions WHERE event_id = NEW.id;
+    ELSE
+        INSERT INTO event_deletions (event_id, organizer_id, deleted_at)
+        VALUES (NEW.id, NEW.organizer_id, NEW.deleted_at)
+        ON CONFLICT (event_id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_events_record_soft_deletion
+    AFTER UPDATE OF deleted_at ON events
+    FOR EACH ROW WHEN (OLD.deleted_at IS DISTINCT FROM NEW.deleted_at)
+    EXECUTE FUNCTION record_event_deletion();
+
+CREATE OR REPLACE FUNCTION record_participant_deletion() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO participant_deletions (participant_id, event_id, deleted_at)
+        VALUES (OLD.id, OLD.event_id, NOW())
+        ON CONFLICT (participant_id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at;
+        RETURN OLD;
+    END IF;
+    IF NEW.deleted_at IS NULL THEN
+        DELETE FROM participant_deletions WHERE participant_id = NEW.id;
+    ELSE
+        INSERT INTO participant_deletions (participant_id, event_id, deleted_at)
+        VALUES (NEW.id, NEW.event_id, NEW.deleted_at)
+        ON CONFLICT (participant_id) DO UPDATE SET deleted_at = EXCLUDED.deleted_at;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_participants_record_soft_deletion
+    AFTER UPDATE OF deleted_at ON participants
+    FOR EACH ROW WHEN (OLD.deleted_at IS DISTINCT FROM NEW.deleted_at)
+    EXECUTE FUNCTION record_participant_deletion();
+
+COMMENT ON COLUMN events.deleted_at IS 'When the event was deleted; NULL for live events';
+COMMENT ON COLUMN participants.deleted_at IS 'When the participant was deleted, directly or with their event or registrant; NULL for live participants';
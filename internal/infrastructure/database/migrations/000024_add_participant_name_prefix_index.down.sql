@@ -0,0 +1 @@
+DROP INDEX IF EXISTS idx_participants_name_prefix;
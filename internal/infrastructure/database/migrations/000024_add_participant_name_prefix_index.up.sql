@@ -0,0 +1,6 @@
+-- Case-insensitive name prefix matching for participant autocomplete at manual check-in
+-- (GET /events/{id}/participants/autocomplete). text_pattern_ops lets LIKE 'prefix%' use the
+-- index whatever the database collation.
+CREATE INDEX IF NOT EXISTS idx_participants_name_prefix
+    ON participants(event_id, lower(name) text_pattern_ops)
+    WHERE deleted_at IS NULL;
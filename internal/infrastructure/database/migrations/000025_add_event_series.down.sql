@@ -0,0 +1,4 @@
+-- Remove the recurring event series; the occurrences remain as standalone events
+DROP INDEX IF EXISTS idx_events_series_id;
+
+ALTER TABLE events DROP COLUMN IF EXISTS series_id;
@@ -0,0 +1,8 @@
+-- The occurrences of a recurring event are separate events linked by a shared series ID.
+-- NULL for events that do not recur.
+ALTER TABLE events ADD COLUMN series_id UUID;
+
+CREATE INDEX IF NOT EXISTS idx_events_series_id ON events(series_id, start_date)
+    WHERE series_id IS NOT NULL;
+
+COMMENT ON COLUMN events.series_id IS 'Series shared by the occurrences of a recurring event; NULL if the event does not recur';
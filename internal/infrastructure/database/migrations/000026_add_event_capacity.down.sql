@@ -0,0 +1,7 @@
+-- Return waitlisted participants to tentative, and remove the event capacity
+DROP INDEX IF EXISTS idx_participants_waitlisted;
+
+UPDATE participants SET status = 'tentative' WHERE status = 'waitlisted';
+
+ALTER TABLE events DROP CONSTRAINT IF EXISTS events_capacity_positive;
+ALTER TABLE events DROP COLUMN IF EXISTS capacity;
@@ -0,0 +1,11 @@
+-- Events can cap how many participants are confirmed; participants registering as confirmed
+-- beyond it are waitlisted until promoted. NULL has no capacity limit.
+ALTER TABLE events ADD COLUMN capacity INTEGER;
+
+ALTER TABLE events
+    ADD CONSTRAINT events_capacity_positive CHECK (capacity > 0);
+
+CREATE INDEX IF NOT EXISTS idx_participants_waitlisted ON participants(event_id, created_at)
+    WHERE status = 'waitlisted';
+
+COMMENT ON COLUMN events.capacity IS 'Maximum number of confirmed participants; NULL if unlimited';
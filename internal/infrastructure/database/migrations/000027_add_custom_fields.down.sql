@@ -0,0 +1,3 @@
+-- Remove custom participant fields and their values
+ALTER TABLE participants DROP COLUMN IF EXISTS custom_data;
+ALTER TABLE events DROP COLUMN IF EXISTS custom_fields;
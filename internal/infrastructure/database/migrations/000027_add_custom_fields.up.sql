@@ -0,0 +1,7 @@
+-- Events can define custom fields collecting data from participants beyond the built-in
+-- fields, e.g. a t-shirt size. Participants keep the values by field key. NULL has none.
+ALTER TABLE events ADD COLUMN custom_fields JSONB;
+ALTER TABLE participants ADD COLUMN custom_data JSONB;
+
+COMMENT ON COLUMN events.custom_fields IS 'Custom participant fields as a JSON array of {"key", "label", "type", "required", "options"}';
+COMMENT ON COLUMN participants.custom_data IS 'Values of the event custom fields as a JSON object keyed by field key';
@@ -0,0 +1,2 @@
+-- Remove the event logo
+ALTER TABLE events DROP COLUMN IF EXISTS logo;
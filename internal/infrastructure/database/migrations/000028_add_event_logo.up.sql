@@ -0,0 +1,5 @@
+-- Events can store a logo that is overlaid on their participants' PNG QR codes.
+-- The image is kept as uploaded (PNG or JPEG); NULL if the event has no logo.
+ALTER TABLE events ADD COLUMN logo BYTEA;
+
+COMMENT ON COLUMN events.logo IS 'Logo image overlaid on participant QR codes; NULL if none';
@@ -0,0 +1,19 @@
+-- Events can have their own webhook, which receives the event's check-in and registration
+-- notifications from the outbox relay in addition to the server-wide WEBHOOK_URLS.
+CREATE TABLE IF NOT EXISTS event_webhooks (
+    event_id UUID PRIMARY KEY REFERENCES events(id) ON DELETE CASCADE,
+    url VARCHAR(2048) NOT NULL,
+    secret VARCHAR(255) NOT NULL,
+    last_delivery_at TIMESTAMP,
+    last_delivery_status VARCHAR(20),
+    last_delivery_error TEXT,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT check_event_webhook_delivery_status
+        CHECK (last_delivery_status IS NULL OR last_delivery_status IN ('succeeded', 'failed'))
+);
+
+COMMENT ON COLUMN event_webhooks.secret IS 'HMAC secret signing the X-Ezqrin-Signature header of the deliveries';
+COMMENT ON COLUMN event_webhooks.last_delivery_status IS 'Outcome of the latest delivery attempt; NULL before the first one';
+COMMENT ON COLUMN event_webhooks.last_delivery_error IS 'Error of the latest delivery attempt; NULL if it succeeded';
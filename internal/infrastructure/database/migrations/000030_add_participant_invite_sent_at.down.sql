@@ -0,0 +1,2 @@
+-- Remove the QR code email timestamp
+ALTER TABLE participants DROP COLUMN IF EXISTS invite_sent_at;
@@ -0,0 +1,5 @@
+-- Participants record when their QR code was last emailed to them, so organizers can
+-- send it only to participants who have not received it yet. NULL if it was never sent.
+ALTER TABLE participants ADD COLUMN invite_sent_at TIMESTAMP;
+
+COMMENT ON COLUMN participants.invite_sent_at IS 'When the QR code email was last sent; NULL if never';
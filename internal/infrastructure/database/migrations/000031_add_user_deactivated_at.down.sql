@@ -0,0 +1,2 @@
+-- Remove user deactivation
+ALTER TABLE users DROP COLUMN IF EXISTS deactivated_at;
@@ -0,0 +1,5 @@
+-- Admins can deactivate a user account, which blocks its logins and tokens until it is
+-- reactivated. Unlike deletion, the account and its data are kept unchanged.
+ALTER TABLE users ADD COLUMN deactivated_at TIMESTAMP;
+
+COMMENT ON COLUMN users.deactivated_at IS 'When an admin deactivated the account; NULL while it is active';
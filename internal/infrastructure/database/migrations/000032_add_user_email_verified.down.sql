@@ -0,0 +1,2 @@
+-- Remove user email verification
+ALTER TABLE users DROP COLUMN IF EXISTS email_verified;
@@ -0,0 +1,6 @@
+-- Users verify their email address with a link sent on registration. Accounts that
+-- existed before verification was introduced are treated as verified.
+ALTER TABLE users ADD COLUMN email_verified BOOLEAN NOT NULL DEFAULT TRUE;
+ALTER TABLE users ALTER COLUMN email_verified SET DEFAULT FALSE;
+
+COMMENT ON COLUMN users.email_verified IS 'Whether the user has verified their email address';
@@ -0,0 +1,22 @@
+-- Audit trail of who created, changed or deleted events, participants and check-ins.
+-- Entries are appended after the change they record and never updated.
+CREATE TABLE IF NOT EXISTS audit_logs (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    actor_id UUID NOT NULL,
+    action VARCHAR(20) NOT NULL,
+    resource_type VARCHAR(50) NOT NULL,
+    resource_id UUID NOT NULL,
+    changes JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT check_audit_log_action
+        CHECK (action IN ('create', 'update', 'delete')),
+    CONSTRAINT check_audit_log_resource_type
+        CHECK (resource_type IN ('event', 'participant', 'checkin'))
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_resource ON audit_logs(resource_type, resource_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_created_at ON audit_logs(created_at DESC);
+
+COMMENT ON COLUMN audit_logs.actor_id IS 'User who made the change; not a foreign key, so that entries outlive deleted users';
+COMMENT ON COLUMN audit_logs.changes IS 'Changed fields by name as {"old": ..., "new": ...}; personal data only as {"redacted": true}';
@@ -0,0 +1,3 @@
+-- Remove event full-text search
+DROP INDEX IF EXISTS idx_events_search_vector;
+ALTER TABLE events DROP COLUMN IF EXISTS search_vector;
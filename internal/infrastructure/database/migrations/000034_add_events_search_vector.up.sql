@@ -0,0 +1,12 @@
+-- Full-text search over event names, descriptions and locations. The 'simple' configuration
+-- does not stem words, as events are named in several languages. Name matches rank highest,
+-- then description and location matches.
+ALTER TABLE events ADD COLUMN search_vector tsvector GENERATED ALWAYS AS (
+    setweight(to_tsvector('simple'::regconfig, COALESCE(name, '')), 'A') ||
+    setweight(to_tsvector('simple'::regconfig, COALESCE(description, '')), 'B') ||
+    setweight(to_tsvector('simple'::regconfig, COALESCE(location, '')), 'C')
+) STORED;
+
+CREATE INDEX IF NOT EXISTS idx_events_search_vector ON events USING GIN (search_vector);
+
+COMMENT ON COLUMN events.search_vector IS 'Weighted full-text search vector of name, description and location';
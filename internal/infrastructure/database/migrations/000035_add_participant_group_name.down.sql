@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_participants_event_group;
+ALTER TABLE participants DROP COLUMN IF EXISTS group_name;
@@ -0,0 +1,9 @@
+-- Table or group a participant is seated at (e.g. "Table 5" at a dinner). The length is
+-- enforced by the application; the index serves the group filter and the per-group stats.
+ALTER TABLE participants ADD COLUMN group_name VARCHAR(100);
+
+CREATE INDEX IF NOT EXISTS idx_participants_event_group
+    ON participants (event_id, group_name)
+    WHERE group_name IS NOT NULL AND deleted_at IS NULL;
+
+COMMENT ON COLUMN participants.group_name IS 'Table or group the participant is seated at; NULL if none';
@@ -0,0 +1,2 @@
+ALTER TABLE checkins DROP COLUMN IF EXISTS device_id;
+ALTER TABLE checkins DROP COLUMN IF EXISTS source;
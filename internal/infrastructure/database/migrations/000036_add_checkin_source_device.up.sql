@@ -0,0 +1,7 @@
+-- Where a check-in was made: the client or channel (e.g. "scanner-app", "kiosk") and the
+-- scanning station's device ID. Both are optional; lengths are enforced by the application.
+ALTER TABLE checkins ADD COLUMN source VARCHAR(50);
+ALTER TABLE checkins ADD COLUMN device_id VARCHAR(100);
+
+COMMENT ON COLUMN checkins.source IS 'Client or channel the check-in was made from; NULL if not reported';
+COMMENT ON COLUMN checkins.device_id IS 'Device or scanning station that made the check-in; NULL if not reported';
@@ -0,0 +1,30 @@
+-- Payments received from participants. Recording a payment marks the participant as paid and
+-- refunding it marks them unpaid again; refunded payments are kept for reconciliation.
+CREATE TABLE IF NOT EXISTS payments (
+    id UUID PRIMARY KEY,
+    event_id UUID NOT NULL REFERENCES events(id) ON DELETE CASCADE,
+    participant_id UUID NOT NULL REFERENCES participants(id) ON DELETE CASCADE,
+    amount BIGINT NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    method VARCHAR(20) NOT NULL,
+    reference VARCHAR(255),
+    recorded_by UUID NOT NULL,
+    recorded_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    refunded_by UUID,
+    refunded_at TIMESTAMP,
+
+    CONSTRAINT check_payment_amount CHECK (amount > 0),
+    CONSTRAINT check_payment_method CHECK (method IN ('cash', 'card', 'bank_transfer', 'other'))
+);
+
+-- A participant has at most one unrefunded payment, and a reference is recorded once per event
+CREATE UNIQUE INDEX IF NOT EXISTS unique_participant_active_payment
+    ON payments(participant_id) WHERE refunded_at IS NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS unique_event_active_payment_reference
+    ON payments(event_id, reference) WHERE reference IS NOT NULL AND refunded_at IS NULL;
+
+COMMENT ON COLUMN payments.amount IS 'Amount paid in minor units of currency';
+COMMENT ON COLUMN payments.currency IS 'ISO 4217 currency of the event when the payment was recorded';
+COMMENT ON COLUMN payments.reference IS 'Receipt or transaction number; NULL if none';
+COMMENT ON COLUMN payments.recorded_by IS 'User who recorded the payment; not a foreign key, so that payments outlive deleted users';
+COMMENT ON COLUMN payments.refunded_at IS 'When the payment was refunded; NULL unless refunded';
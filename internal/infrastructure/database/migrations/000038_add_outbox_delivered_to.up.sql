@@ -0,0 +1,5 @@
+-- Destinations that already accepted a message, so that a message failing for one webhook is
+-- retried for that webhook only instead of being sent to every webhook again.
+ALTER TABLE outbox ADD COLUMN delivered_to TEXT[] NOT NULL DEFAULT '{}';
+
+COMMENT ON COLUMN outbox.delivered_to IS 'Webhook destinations that accepted the message; skipped when it is retried';
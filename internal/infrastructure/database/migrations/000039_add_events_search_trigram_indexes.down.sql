@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_events_location_trgm;
+DROP INDEX IF EXISTS idx_events_description_trgm;
+DROP INDEX IF EXISTS idx_events_name_trgm;
+DROP EXTENSION IF EXISTS pg_trgm;
@@ -0,0 +1,7 @@
+-- Trigram indexes for event searches matching part of a word, e.g. "conf" for "Conference", or
+-- text without spaces such as Japanese, which full-text search only matches as a whole.
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX IF NOT EXISTS idx_events_name_trgm ON events USING GIN (name gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_events_description_trgm ON events USING GIN (description gin_trgm_ops);
+CREATE INDEX IF NOT EXISTS idx_events_location_trgm ON events USING GIN (location gin_trgm_ops);
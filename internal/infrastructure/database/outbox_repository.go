@@ -0,0 +1,158 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// outboxRepository implements the OutboxRepository interface.
+type outboxRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewOutboxRepository creates a new outbox repository.
+func NewOutboxRepository(pool *pgxpool.Pool) repository.OutboxRepository {
+	return &outboxRepository{pool: pool}
+}
+
+// Enqueue stores a message for delivery, joining the transaction in ctx if present.
+func (r *outboxRepository) Enqueue(ctx context.Context, msg *entity.OutboxMessage) error {
+	if err := msg.Validate(); err != nil {
+		return apperrors.Wrapf(err, "invalid outbox message")
+	}
+
+	query := `
+		INSERT INTO outbox (
+			id, event_type, aggregate_id, payload, attempts, next_attempt_at, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7
+		)
+	`
+
+	q := GetQueryable(ctx, r.pool)
+	_, err := q.Exec(ctx, query,
+		msg.ID,
+		msg.EventType,
+		msg.AggregateID,
+		msg.Payload,
+		msg.Attempts,
+		msg.NextAttemptAt,
+		msg.CreatedAt,
+	)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to enqueue outbox message")
+	}
+
+	return nil
+}
+
+// ClaimDue claims due messages by leasing them until now+lease.
+// FOR UPDATE SKIP LOCKED keeps concurrent relays from claiming the same rows.
+func (r *outboxRepository) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]*entity.OutboxMessage, error) {
+	query := `
+		UPDATE outbox
+		SET attempts = attempts + 1, next_attempt_at = $2
+		WHERE id IN (
+			SELECT id FROM outbox
+			WHERE delivered_at IS NULL AND failed_at IS NULL AND next_attempt_at <= $1
+			ORDER BY next_attempt_at, created_at
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING
+			id, event_type, aggregate_id, payload, attempts, next_attempt_at,
+			COALESCE(last_error, ''), delivered_at, delivered_to, failed_at, created_at
+	`
+
+	rows, err := r.pool.Query(ctx, query, now, now.Add(lease), limit)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "failed to claim outbox messages")
+	}
+	defer rows.Close()
+
+	messages := make([]*entity.OutboxMessage, 0, limit)
+	for rows.Next() {
+		var msg entity.OutboxMessage
+		if err := rows.Scan(
+			&msg.ID,
+			&msg.EventType,
+			&msg.AggregateID,
+			&msg.Payload,
+			&msg.Attempts,
+			&msg.NextAttemptAt,
+			&msg.LastError,
+			&msg.DeliveredAt,
+			&msg.DeliveredTo,
+			&msg.FailedAt,
+			&msg.CreatedAt,
+		); err != nil {
+			return nil, apperrors.Wrapf(err, "failed to scan outbox message")
+		}
+		messages = append(messages, &msg)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.Wrapf(err, "failed to iterate outbox messages")
+	}
+
+	return messages, nil
+}
+
+// MarkDelivered records that a message was delivered.
+func (r *outboxRepository) MarkDelivered(ctx context.Context, id uuid.UUID, deliveredAt time.Time) error {
+	query := `
+		UPDATE outbox
+		SET delivered_at = $1, last_error = NULL
+		WHERE id = $2
+	`
+	return r.exec(ctx, query, "failed to mark outbox message delivered", deliveredAt, id)
+}
+
+// ScheduleRetry records a failed delivery attempt, when to try again and the destinations
+// that accepted the message so far.
+func (r *outboxRepository) ScheduleRetry(
+	ctx context.Context,
+	id uuid.UUID,
+	nextAttemptAt time.Time,
+	lastError string,
+	deliveredTo []string,
+) error {
+	query := `
+		UPDATE outbox
+		SET next_attempt_at = $1, last_error = $2, delivered_to = COALESCE($3::text[], '{}')
+		WHERE id = $4
+	`
+	return r.exec(ctx, query, "failed to schedule outbox retry", nextAttemptAt, lastError, deliveredTo, id)
+}
+
+// MarkFailed records that delivery of a message was abandoned.
+func (r *outboxRepository) MarkFailed(ctx context.Context, id uuid.UUID, failedAt time.Time, lastError string) error {
+	query := `
+		UPDATE outbox
+		SET failed_at = $1, last_error = $2
+		WHERE id = $3
+	`
+	return r.exec(ctx, query, "failed to mark outbox message failed", failedAt, lastError, id)
+}
+
+// exec runs a single-row outbox update and returns NotFound if no row matched.
+func (r *outboxRepository) exec(ctx context.Context, query, failMsg string, args ...any) error {
+	result, err := r.pool.Exec(ctx, query, args...)
+	if err != nil {
+		return apperrors.Wrapf(err, "%s", failMsg)
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.NotFound("outbox message not found")
+	}
+	return nil
+}
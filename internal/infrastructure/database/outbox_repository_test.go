@@ -0,0 +1,192 @@
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutboxRepository", func() {
+	const lease = time.Minute
+
+	var (
+		repo repository.OutboxRepository
+		ctx  context.Context
+		db   *database.PostgresDB
+		now  time.Time
+	)
+
+	newMessage := func() *entity.OutboxMessage {
+		aggregateID := uuid.New()
+		msg, err := entity.NewOutboxMessage(
+			entity.OutboxEventEventPublished,
+			aggregateID,
+			entity.EventPublishedPayload{EventID: aggregateID, Name: "Tech Conf"},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		msg.NextAttemptAt = now
+		return msg
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		log, _ := logger.New(logger.Config{
+			Level:       "info",
+			Format:      "console",
+			Environment: "development",
+		})
+		cfg := &config.DatabaseConfig{
+			Host:            "postgres",
+			Port:            5432,
+			User:            "ezqrin",
+			Password:        "ezqrin_dev",
+			Name:            "ezqrin_test",
+			SSLMode:         "disable",
+			MaxConns:        25,
+			MinConns:        5,
+			MaxConnLifetime: time.Hour,
+			MaxConnIdleTime: 30 * time.Minute,
+		}
+
+		var err error
+		db, err = database.NewPostgresDB(ctx, cfg, log)
+		Expect(err).NotTo(HaveOccurred())
+
+		repo = database.NewOutboxRepository(db.GetPool())
+		now = time.Now().UTC().Truncate(time.Microsecond)
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			_, _ = db.GetPool().Exec(ctx, "TRUNCATE TABLE outbox")
+			db.Close()
+		}
+	})
+
+	Describe("Enqueue", func() {
+		It("should not store the message when the transaction rolls back", func() {
+			msg := newMessage()
+			rollback := errors.New("state change failed")
+
+			err := db.WithTransaction(ctx, func(txCtx context.Context) error {
+				Expect(repo.Enqueue(txCtx, msg)).To(Succeed())
+				return rollback
+			})
+			Expect(err).To(MatchError(rollback))
+
+			claimed, err := repo.ClaimDue(ctx, now, lease, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(BeEmpty())
+		})
+	})
+
+	Describe("ClaimDue", func() {
+		It("should claim due messages and count the attempt", func() {
+			msg := newMessage()
+			Expect(repo.Enqueue(ctx, msg)).To(Succeed())
+
+			claimed, err := repo.ClaimDue(ctx, now, lease, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(HaveLen(1))
+			Expect(claimed[0].ID).To(Equal(msg.ID))
+			Expect(claimed[0].Attempts).To(Equal(1))
+			Expect(claimed[0].Payload).To(MatchJSON(msg.Payload))
+		})
+
+		It("should not claim messages that are not yet due", func() {
+			msg := newMessage()
+			msg.NextAttemptAt = now.Add(time.Hour)
+			Expect(repo.Enqueue(ctx, msg)).To(Succeed())
+
+			claimed, err := repo.ClaimDue(ctx, now, lease, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(BeEmpty())
+		})
+
+		It("should retry an undelivered message after the relay crashed", func() {
+			msg := newMessage()
+			Expect(repo.Enqueue(ctx, msg)).To(Succeed())
+
+			// The relay claims the message and crashes before marking it delivered.
+			claimed, err := repo.ClaimDue(ctx, now, lease, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(HaveLen(1))
+
+			// While the lease holds, a restarted relay does not deliver it twice.
+			claimed, err = repo.ClaimDue(ctx, now.Add(lease/2), lease, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(BeEmpty())
+
+			// Once the lease expires the message is claimed again.
+			claimed, err = repo.ClaimDue(ctx, now.Add(lease), lease, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(HaveLen(1))
+			Expect(claimed[0].ID).To(Equal(msg.ID))
+			Expect(claimed[0].Attempts).To(Equal(2))
+		})
+	})
+
+	Describe("MarkDelivered", func() {
+		It("should stop the message from being claimed again", func() {
+			msg := newMessage()
+			Expect(repo.Enqueue(ctx, msg)).To(Succeed())
+			_, err := repo.ClaimDue(ctx, now, lease, 10)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(repo.MarkDelivered(ctx, msg.ID, now)).To(Succeed())
+
+			claimed, err := repo.ClaimDue(ctx, now.Add(2*lease), lease, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(BeEmpty())
+		})
+	})
+
+	Describe("ScheduleRetry", func() {
+		It("should make the message due again at the next attempt time", func() {
+			msg := newMessage()
+			Expect(repo.Enqueue(ctx, msg)).To(Succeed())
+			_, err := repo.ClaimDue(ctx, now, lease, 10)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(repo.ScheduleRetry(
+				ctx, msg.ID, now.Add(time.Second), "webhook returned 500", []string{"https://a.example.com/hook"},
+			)).To(Succeed())
+
+			claimed, err := repo.ClaimDue(ctx, now.Add(time.Second), lease, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(HaveLen(1))
+			Expect(claimed[0].LastError).To(Equal("webhook returned 500"))
+			Expect(claimed[0].DeliveredTo).To(ConsistOf("https://a.example.com/hook"))
+		})
+	})
+
+	Describe("MarkFailed", func() {
+		It("should stop the message from being claimed again", func() {
+			msg := newMessage()
+			Expect(repo.Enqueue(ctx, msg)).To(Succeed())
+
+			Expect(repo.MarkFailed(ctx, msg.ID, now, "gave up")).To(Succeed())
+
+			claimed, err := repo.ClaimDue(ctx, now.Add(2*lease), lease, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claimed).To(BeEmpty())
+		})
+
+		It("should return not found for an unknown message", func() {
+			err := repo.MarkFailed(ctx, uuid.New(), now, "gave up")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -3,6 +3,9 @@ package database
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
@@ -25,7 +28,7 @@ func NewParticipantRepository(pool *pgxpool.Pool, logger *logger.Logger) reposit
 	return &participantRepository{pool: pool, logger: logger}
 }
 
-// Create creates a new participant in the database.
+// Create creates a new participant in the database, joining the transaction in ctx if present.
 func (r *participantRepository) Create(ctx context.Context, participant *entity.Participant) error {
 	if err := participant.Validate(); err != nil {
 		return apperrors.Wrapf(err, "invalid participant")
@@ -35,13 +38,16 @@ func (r *participantRepository) Create(ctx context.Context, participant *entity.
 		INSERT INTO participants (
 			id, event_id, name, email, employee_id, phone, qr_email, status,
 			qr_code, qr_code_generated_at, metadata, payment_status, payment_amount,
-			payment_date, created_at, updated_at
+			payment_date, created_at, updated_at, walk_in, consent_accepted_at, consent_version, notes, guest_of,
+			tags, custom_data, group_name
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+			$1, $2, $3, NULLIF($4, ''), $5, $6, $7, $8, NULLIF($9, ''), $10, $11, $12, $13, $14, $15, $16, $17,
+			$18, NULLIF($19, ''), $20, $21, COALESCE($22::text[], '{}'), $23, $24
 		)
 	`
 
-	_, err := r.pool.Exec(ctx, query,
+	q := GetQueryable(ctx, r.pool)
+	_, err := q.Exec(ctx, query,
 		participant.ID,
 		participant.EventID,
 		participant.Name,
@@ -58,6 +64,14 @@ func (r *participantRepository) Create(ctx context.Context, participant *entity.
 		participant.PaymentDate,
 		participant.CreatedAt,
 		participant.UpdatedAt,
+		participant.WalkIn,
+		participant.ConsentAcceptedAt,
+		participant.ConsentVersion,
+		participant.Notes,
+		participant.GuestOf,
+		participant.Tags,
+		participant.CustomData,
+		participant.GroupName,
 	)
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -77,6 +91,105 @@ func (r *participantRepository) Create(ctx context.Context, participant *entity.
 	return nil
 }
 
+// CreateOrWaitlist creates a participant, waitlisting a confirmed participant when their event
+// is at capacity. The event row is locked while the confirmed participants are counted, so
+// that concurrent creations and promotions take places one at a time.
+func (r *participantRepository) CreateOrWaitlist(ctx context.Context, participant *entity.Participant) error {
+	return inTransaction(ctx, r.pool, func(ctx context.Context) error {
+		if participant.IsConfirmed() {
+			full, err := r.lockCapacity(ctx, participant.EventID)
+			if err != nil {
+				return err
+			}
+			if full {
+				participant.Status = entity.ParticipantStatusWaitlisted
+			}
+		}
+		return r.Create(ctx, participant)
+	})
+}
+
+// CreateWithinCapacity creates a participant, rejecting a confirmed participant when their
+// event is at capacity. The event row is locked as in CreateOrWaitlist.
+func (r *participantRepository) CreateWithinCapacity(ctx context.Context, participant *entity.Participant) error {
+	return inTransaction(ctx, r.pool, func(ctx context.Context) error {
+		if participant.IsConfirmed() {
+			full, err := r.lockCapacity(ctx, participant.EventID)
+			if err != nil {
+				return err
+			}
+			if full {
+				return errEventFull()
+			}
+		}
+		return r.Create(ctx, participant)
+	})
+}
+
+// errEventFull returns the conflict of a participant who cannot be confirmed in a full event.
+func errEventFull() error {
+	return apperrors.WrapAppError(apperrors.Conflict("event is at capacity"), repository.ErrEventFull)
+}
+
+// lockCapacity locks an event's row until the end of the transaction in ctx and reports
+// whether it has as many confirmed participants as its capacity. Events without a capacity
+// are never full.
+func (r *participantRepository) lockCapacity(ctx context.Context, eventID uuid.UUID) (bool, error) {
+	remaining, err := r.lockRemainingPlaces(ctx, eventID)
+	if err != nil {
+		return false, err
+	}
+	return remaining != nil && *remaining <= 0, nil
+}
+
+// lockRemainingPlaces locks an event's row as lockCapacity does and returns how many more
+// participants can be confirmed, or nil if the event has no capacity.
+func (r *participantRepository) lockRemainingPlaces(ctx context.Context, eventID uuid.UUID) (*int64, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			e.capacity - (
+				SELECT COUNT(*) FROM participants p
+				WHERE p.event_id = e.id AND p.status = 'confirmed' AND %s
+			)
+		FROM events e
+		WHERE e.id = $1 AND %s
+		FOR UPDATE OF e
+	`, live("p"), live("e"))
+
+	var remaining *int64
+	err := GetQueryable(ctx, r.pool).QueryRow(ctx, query, eventID).Scan(&remaining)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.NotFound("event not found")
+		}
+		return nil, apperrors.Wrapf(err, "failed to check event capacity")
+	}
+	return remaining, nil
+}
+
+// lockPlaceFor locks the event of a participant as lockCapacity does and returns a conflict
+// error if it has no place left to confirm them.
+func (r *participantRepository) lockPlaceFor(ctx context.Context, participantID uuid.UUID) error {
+	query := fmt.Sprintf(`SELECT event_id FROM participants WHERE id = $1 AND %s`, live("participants"))
+
+	var eventID uuid.UUID
+	if err := GetQueryable(ctx, r.pool).QueryRow(ctx, query, participantID).Scan(&eventID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperrors.NotFound("participant not found")
+		}
+		return apperrors.Wrapf(err, "failed to find participant")
+	}
+
+	full, err := r.lockCapacity(ctx, eventID)
+	if err != nil {
+		return err
+	}
+	if full {
+		return errEventFull()
+	}
+	return nil
+}
+
 // BulkCreate creates multiple participants in the database with optimized performance.
 func (r *participantRepository) BulkCreate(ctx context.Context, participants []*entity.Participant) error {
 	if len(participants) == 0 {
@@ -97,9 +210,11 @@ func (r *participantRepository) BulkCreate(ctx context.Context, participants []*
 		INSERT INTO participants (
 			id, event_id, name, email, employee_id, phone, qr_email, status,
 			qr_code, qr_code_generated_at, metadata, payment_status, payment_amount,
-			payment_date, created_at, updated_at
+			payment_date, created_at, updated_at, walk_in, consent_accepted_at, consent_version, notes, guest_of,
+			tags, custom_data, group_name
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16
+			$1, $2, $3, NULLIF($4, ''), $5, $6, $7, $8, NULLIF($9, ''), $10, $11, $12, $13, $14, $15, $16, $17,
+			$18, NULLIF($19, ''), $20, $21, COALESCE($22::text[], '{}'), $23, $24
 		)
 	`
 
@@ -121,6 +236,14 @@ func (r *participantRepository) BulkCreate(ctx context.Context, participants []*
 			p.PaymentDate,
 			p.CreatedAt,
 			p.UpdatedAt,
+			p.WalkIn,
+			p.ConsentAcceptedAt,
+			p.ConsentVersion,
+			p.Notes,
+			p.GuestOf,
+			p.Tags,
+			p.CustomData,
+			p.GroupName,
 		)
 	}
 
@@ -143,15 +266,31 @@ func (r *participantRepository) BulkCreate(ctx context.Context, participants []*
 
 // FindByID retrieves a participant by its unique ID with check-in status.
 func (r *participantRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Participant, error) {
-	query := `
+	return r.findOne(ctx, id, live("p"))
+}
+
+// FindDeletedByID retrieves a soft deleted participant by its unique ID with check-in status.
+func (r *participantRepository) FindDeletedByID(ctx context.Context, id uuid.UUID) (*entity.Participant, error) {
+	return r.findOne(ctx, id, "p.deleted_at IS NOT NULL")
+}
+
+// findOne retrieves the participant with the given ID if it also matches condition
+func (r *participantRepository) findOne(
+	ctx context.Context,
+	id uuid.UUID,
+	condition string,
+) (*entity.Participant, error) {
+	query := fmt.Sprintf(`
 		SELECT
-			p.id, p.event_id, p.name, p.email, p.employee_id, p.phone, p.qr_email, p.status,
-			p.qr_code, p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
-			p.payment_date, p.created_at, p.updated_at, c.checked_in_at
+			p.id, p.event_id, p.name, COALESCE(p.email, ''), p.employee_id, p.phone, p.qr_email, p.status, p.walk_in,
+			COALESCE(p.qr_code, ''), p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
+			p.payment_date, p.created_at, p.updated_at, p.consent_accepted_at, COALESCE(p.consent_version, ''),
+			p.notes, p.guest_of, p.tags, p.custom_data, p.invite_sent_at, p.group_name, p.deleted_at,
+			c.checked_in_at
 		FROM participants p
-		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id
-		WHERE p.id = $1
-	`
+		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+		WHERE p.id = $1 AND %s
+	`, condition)
 
 	row := r.pool.QueryRow(ctx, query, id)
 	participant, err := r.scanParticipantFromRow(row)
@@ -171,15 +310,17 @@ func (r *participantRepository) FindByIDs(ctx context.Context, ids []uuid.UUID)
 		return []*entity.Participant{}, nil
 	}
 
-	query := `
+	query := fmt.Sprintf(`
 		SELECT
-			p.id, p.event_id, p.name, p.email, p.employee_id, p.phone, p.qr_email, p.status,
-			p.qr_code, p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
-			p.payment_date, p.created_at, p.updated_at, c.checked_in_at
+			p.id, p.event_id, p.name, COALESCE(p.email, ''), p.employee_id, p.phone, p.qr_email, p.status, p.walk_in,
+			COALESCE(p.qr_code, ''), p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
+			p.payment_date, p.created_at, p.updated_at, p.consent_accepted_at, COALESCE(p.consent_version, ''),
+			p.notes, p.guest_of, p.tags, p.custom_data, p.invite_sent_at, p.group_name, p.deleted_at,
+			c.checked_in_at
 		FROM participants p
-		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id
-		WHERE p.id = ANY($1)
-	`
+		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+		WHERE p.id = ANY($1) AND %s
+	`, live("p"))
 
 	return r.queryParticipantsWithCheckin(ctx, query, ids)
 }
@@ -194,23 +335,25 @@ func (r *participantRepository) FindByEventID(
 	int64,
 	error,
 ) {
-	query := `
+	query := fmt.Sprintf(`
 		SELECT
-			p.id, p.event_id, p.name, p.email, p.employee_id, p.phone, p.qr_email, p.status,
-			p.qr_code, p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
-			p.payment_date, p.created_at, p.updated_at, c.checked_in_at
+			p.id, p.event_id, p.name, COALESCE(p.email, ''), p.employee_id, p.phone, p.qr_email, p.status, p.walk_in,
+			COALESCE(p.qr_code, ''), p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
+			p.payment_date, p.created_at, p.updated_at, p.consent_accepted_at, COALESCE(p.consent_version, ''),
+			p.notes, p.guest_of, p.tags, p.custom_data, p.invite_sent_at, p.group_name, p.deleted_at,
+			c.checked_in_at
 		FROM participants p
-		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id
-		WHERE p.event_id = $1
+		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+		WHERE p.event_id = $1 AND %s
 		ORDER BY p.created_at DESC
 		LIMIT $2 OFFSET $3
-	`
+	`, live("p"))
 
-	countQuery := `
+	countQuery := fmt.Sprintf(`
 		SELECT COUNT(*)
 		FROM participants
-		WHERE event_id = $1
-	`
+		WHERE event_id = $1 AND %s
+	`, live("participants"))
 
 	participants, err := r.queryParticipantsWithCheckin(ctx, query, eventID, limit, offset)
 	if err != nil {
@@ -225,35 +368,107 @@ func (r *participantRepository) FindByEventID(
 	return participants, total, nil
 }
 
+// FindByFilter retrieves paginated participants matching every set field of filter with
+// check-in status, newest first. Unset fields match every participant.
+func (r *participantRepository) FindByFilter(
+	ctx context.Context,
+	filter repository.ParticipantListFilter,
+	offset, limit int,
+) (
+	[]*entity.Participant,
+	int64,
+	error,
+) {
+	var searchPattern *string
+	if filter.Search != "" {
+		pattern := "%" + filter.Search + "%"
+		searchPattern = &pattern
+	}
+	liveCondition := live("p")
+	if filter.IncludeDeleted {
+		liveCondition = "1=1"
+	}
+
+	where := fmt.Sprintf(`
+		($1::uuid IS NULL OR p.event_id = $1) AND %s
+		AND ($2::text IS NULL OR p.status = $2)
+		AND ($3::text IS NULL OR p.group_name = $3)
+		AND (
+			$4::text IS NULL
+			OR p.name ILIKE $4
+			OR p.email ILIKE $4
+			OR p.employee_id ILIKE $4
+			OR p.notes ILIKE $4
+		)
+	`, liveCondition)
+
+	query := fmt.Sprintf(`
+		SELECT
+			p.id, p.event_id, p.name, COALESCE(p.email, ''), p.employee_id, p.phone, p.qr_email, p.status, p.walk_in,
+			COALESCE(p.qr_code, ''), p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
+			p.payment_date, p.created_at, p.updated_at, p.consent_accepted_at, COALESCE(p.consent_version, ''),
+			p.notes, p.guest_of, p.tags, p.custom_data, p.invite_sent_at, p.group_name, p.deleted_at,
+			c.checked_in_at
+		FROM participants p
+		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+		WHERE %s
+		ORDER BY p.created_at DESC
+		LIMIT $5 OFFSET $6
+	`, where)
+
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM participants p
+		WHERE %s
+	`, where)
+
+	args := []interface{}{filter.EventID, filter.Status, filter.Group, searchPattern}
+	participants, err := r.queryParticipantsWithCheckin(ctx, query, append(args, limit, offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total, err := r.countParticipants(ctx, countQuery, args...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return participants, total, nil
+}
+
 // FindAllByEventID retrieves all participants for an event without pagination.
 func (r *participantRepository) FindAllByEventID(
 	ctx context.Context,
 	eventID uuid.UUID,
 ) ([]*entity.Participant, error) {
-	query := `
+	query := fmt.Sprintf(`
 		SELECT
-			p.id, p.event_id, p.name, p.email, p.employee_id, p.phone, p.qr_email, p.status,
-			p.qr_code, p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
-			p.payment_date, p.created_at, p.updated_at, c.checked_in_at
+			p.id, p.event_id, p.name, COALESCE(p.email, ''), p.employee_id, p.phone, p.qr_email, p.status, p.walk_in,
+			COALESCE(p.qr_code, ''), p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
+			p.payment_date, p.created_at, p.updated_at, p.consent_accepted_at, COALESCE(p.consent_version, ''),
+			p.notes, p.guest_of, p.tags, p.custom_data, p.invite_sent_at, p.group_name, p.deleted_at,
+			c.checked_in_at
 		FROM participants p
-		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id
-		WHERE p.event_id = $1
+		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+		WHERE p.event_id = $1 AND %s
 		ORDER BY p.created_at ASC
-	`
+	`, live("p"))
 	return r.queryParticipantsWithCheckin(ctx, query, eventID)
 }
 
 // FindByQRCode retrieves a participant by their QR code with check-in status.
 func (r *participantRepository) FindByQRCode(ctx context.Context, qrCode string) (*entity.Participant, error) {
-	query := `
+	query := fmt.Sprintf(`
 		SELECT
-			p.id, p.event_id, p.name, p.email, p.employee_id, p.phone, p.qr_email, p.status,
-			p.qr_code, p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
-			p.payment_date, p.created_at, p.updated_at, c.checked_in_at
+			p.id, p.event_id, p.name, COALESCE(p.email, ''), p.employee_id, p.phone, p.qr_email, p.status, p.walk_in,
+			COALESCE(p.qr_code, ''), p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
+			p.payment_date, p.created_at, p.updated_at, p.consent_accepted_at, COALESCE(p.consent_version, ''),
+			p.notes, p.guest_of, p.tags, p.custom_data, p.invite_sent_at, p.group_name, p.deleted_at,
+			c.checked_in_at
 		FROM participants p
-		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id
-		WHERE p.qr_code = $1
-	`
+		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+		WHERE p.qr_code = $1 AND %s
+	`, live("p"))
 
 	row := r.pool.QueryRow(ctx, query, qrCode)
 	participant, err := r.scanParticipantFromRow(row)
@@ -267,21 +482,53 @@ func (r *participantRepository) FindByQRCode(ctx context.Context, qrCode string)
 	return participant, nil
 }
 
+// ExistingQRCodes returns the subset of qrCodes already assigned to participants. Deleted
+// participants keep their QR codes, so theirs are included.
+func (r *participantRepository) ExistingQRCodes(ctx context.Context, qrCodes []string) ([]string, error) {
+	if len(qrCodes) == 0 {
+		return nil, nil
+	}
+
+	query := `SELECT qr_code FROM participants WHERE qr_code = ANY($1)`
+
+	rows, err := GetQueryable(ctx, r.pool).Query(ctx, query, qrCodes)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "failed to check existing QR codes")
+	}
+	defer rows.Close()
+
+	var existing []string
+	for rows.Next() {
+		var qrCode string
+		if err := rows.Scan(&qrCode); err != nil {
+			return nil, apperrors.Wrapf(err, "failed to scan QR code")
+		}
+		existing = append(existing, qrCode)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.Wrapf(err, "failed to iterate QR codes")
+	}
+
+	return existing, nil
+}
+
 // FindByEmployeeID retrieves a participant by their employee ID within an event.
 func (r *participantRepository) FindByEmployeeID(
 	ctx context.Context,
 	eventID uuid.UUID,
 	employeeID string,
 ) (*entity.Participant, error) {
-	query := `
+	query := fmt.Sprintf(`
 		SELECT
-			p.id, p.event_id, p.name, p.email, p.employee_id, p.phone, p.qr_email, p.status,
-			p.qr_code, p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
-			p.payment_date, p.created_at, p.updated_at, c.checked_in_at
+			p.id, p.event_id, p.name, COALESCE(p.email, ''), p.employee_id, p.phone, p.qr_email, p.status, p.walk_in,
+			COALESCE(p.qr_code, ''), p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
+			p.payment_date, p.created_at, p.updated_at, p.consent_accepted_at, COALESCE(p.consent_version, ''),
+			p.notes, p.guest_of, p.tags, p.custom_data, p.invite_sent_at, p.group_name, p.deleted_at,
+			c.checked_in_at
 		FROM participants p
-		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id
-		WHERE p.event_id = $1 AND p.employee_id = $2
-	`
+		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+		WHERE p.event_id = $1 AND p.employee_id = $2 AND %s
+	`, live("p"))
 
 	row := r.pool.QueryRow(ctx, query, eventID, employeeID)
 	participant, err := r.scanParticipantFromRow(row)
@@ -295,17 +542,41 @@ func (r *participantRepository) FindByEmployeeID(
 	return participant, nil
 }
 
+// FindByEmailAcrossOrganizer retrieves the participants with an email across an organizer's events.
+// The unique (event_id, email) constraint guarantees at most one row per event.
+func (r *participantRepository) FindByEmailAcrossOrganizer(
+	ctx context.Context,
+	organizerID *uuid.UUID,
+	email string,
+) ([]*entity.Participant, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			p.id, p.event_id, p.name, COALESCE(p.email, ''), p.employee_id, p.phone, p.qr_email, p.status, p.walk_in,
+			COALESCE(p.qr_code, ''), p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
+			p.payment_date, p.created_at, p.updated_at, p.consent_accepted_at, COALESCE(p.consent_version, ''),
+			p.notes, p.guest_of, p.tags, p.custom_data, p.invite_sent_at, p.group_name, p.deleted_at,
+			c.checked_in_at
+		FROM participants p
+		JOIN events e ON e.id = p.event_id
+		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+		WHERE p.email = $1 AND ($2::uuid IS NULL OR e.organizer_id = $2) AND %s AND %s
+		ORDER BY p.created_at DESC
+	`, live("p"), live("e"))
+	return r.queryParticipantsWithCheckin(ctx, query, email, organizerID)
+}
+
 // Update updates an existing participant's information.
+// It joins the transaction in ctx, if any.
 func (r *participantRepository) Update(ctx context.Context, participant *entity.Participant) error {
 	if err := participant.Validate(); err != nil {
 		return apperrors.Wrapf(err, "invalid participant")
 	}
 
-	query := `
+	query := fmt.Sprintf(`
 		UPDATE participants
 		SET
 			name = $1,
-			email = $2,
+			email = NULLIF($2, ''),
 			employee_id = $3,
 			phone = $4,
 			qr_email = $5,
@@ -314,11 +585,17 @@ func (r *participantRepository) Update(ctx context.Context, participant *entity.
 			payment_status = $8,
 			payment_amount = $9,
 			payment_date = $10,
-			updated_at = $11
-		WHERE id = $12
-	`
+			consent_accepted_at = $11,
+			consent_version = NULLIF($12, ''),
+			notes = $13,
+			tags = COALESCE($14::text[], '{}'),
+			custom_data = $15,
+			group_name = $16,
+			updated_at = $17
+		WHERE id = $18 AND %s
+	`, live("participants"))
 
-	result, err := r.pool.Exec(ctx, query,
+	result, err := GetQueryable(ctx, r.pool).Exec(ctx, query,
 		participant.Name,
 		participant.Email,
 		participant.EmployeeID,
@@ -329,6 +606,12 @@ func (r *participantRepository) Update(ctx context.Context, participant *entity.
 		participant.PaymentStatus,
 		participant.PaymentAmount,
 		participant.PaymentDate,
+		participant.ConsentAcceptedAt,
+		participant.ConsentVersion,
+		participant.Notes,
+		participant.Tags,
+		participant.CustomData,
+		participant.GroupName,
 		participant.UpdatedAt,
 		participant.ID,
 	)
@@ -343,16 +626,167 @@ func (r *participantRepository) Update(ctx context.Context, participant *entity.
 	return nil
 }
 
-// Delete deletes a participant from the database.
-func (r *participantRepository) Delete(ctx context.Context, id uuid.UUID) error {
-	query := `
-		DELETE FROM participants
-		WHERE id = $1
-	`
+// UpdatePayment sets the payment fields of a participant whose payment status is still from.
+func (r *participantRepository) UpdatePayment(
+	ctx context.Context,
+	participant *entity.Participant,
+	from entity.PaymentStatus,
+) error {
+	query := fmt.Sprintf(`
+		UPDATE participants
+		SET payment_status = $1, payment_amount = $2, payment_date = $3, updated_at = $4
+		WHERE id = $5 AND payment_status = $6 AND %s
+	`, live("participants"))
+
+	result, err := GetQueryable(ctx, r.pool).Exec(ctx, query,
+		participant.PaymentStatus,
+		participant.PaymentAmount,
+		participant.PaymentDate,
+		participant.UpdatedAt,
+		participant.ID,
+		from,
+	)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to update participant payment")
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperrors.Conflict(fmt.Sprintf("participant payment status is no longer %s", from))
+	}
+
+	return nil
+}
+
+// UpdateWithinCapacity updates a participant, rejecting a participant moved to confirmed when
+// their event is at capacity. The event row is locked as in CreateOrWaitlist.
+func (r *participantRepository) UpdateWithinCapacity(ctx context.Context, participant *entity.Participant) error {
+	statusQuery := fmt.Sprintf(`SELECT status FROM participants WHERE id = $1 AND %s`, live("participants"))
+
+	return inTransaction(ctx, r.pool, func(ctx context.Context) error {
+		if participant.IsConfirmed() {
+			full, err := r.lockCapacity(ctx, participant.EventID)
+			if err != nil {
+				return err
+			}
+
+			// The event lock keeps the stored status from changing until the update
+			var stored entity.ParticipantStatus
+			err = GetQueryable(ctx, r.pool).QueryRow(ctx, statusQuery, participant.ID).Scan(&stored)
+			if err != nil {
+				if errors.Is(err, pgx.ErrNoRows) {
+					return apperrors.NotFound("participant not found")
+				}
+				return apperrors.Wrapf(err, "failed to find participant")
+			}
+			if full && stored != entity.ParticipantStatusConfirmed {
+				return errEventFull()
+			}
+		}
+		return r.Update(ctx, participant)
+	})
+}
+
+// AcceptInvitation confirms an invited participant and assigns their QR code if their event
+// has a place left. The event row is locked as in Promote, and only a participant still in
+// invited status is updated, so concurrent accepts neither exceed the capacity nor accept the
+// same invitation twice.
+func (r *participantRepository) AcceptInvitation(
+	ctx context.Context,
+	id uuid.UUID,
+	qrCode string,
+	acceptedAt time.Time,
+) error {
+	query := fmt.Sprintf(`
+		UPDATE participants
+		SET
+			status = 'confirmed',
+			qr_code = $1,
+			qr_code_generated_at = $2,
+			updated_at = $2
+		WHERE id = $3 AND status = 'invited' AND %s
+	`, live("participants"))
+
+	return inTransaction(ctx, r.pool, func(ctx context.Context) error {
+		if err := r.lockPlaceFor(ctx, id); err != nil {
+			return err
+		}
+
+		result, err := GetQueryable(ctx, r.pool).Exec(ctx, query, qrCode, acceptedAt, id)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgErrCodeUniqueViolation {
+				return apperrors.Conflict("QR code already exists")
+			}
+			return apperrors.Wrapf(err, "failed to accept invitation")
+		}
+		if result.RowsAffected() == 0 {
+			return apperrors.Conflict("invitation has already been accepted or withdrawn")
+		}
+		return nil
+	})
+}
+
+// Promote confirms a waitlisted participant if their event has a place left. The event row is
+// locked while the confirmed participants are counted, and only a participant still waitlisted
+// is updated, so concurrent promotions neither exceed the capacity nor promote twice.
+func (r *participantRepository) Promote(ctx context.Context, id uuid.UUID, promotedAt time.Time) error {
+	updateQuery := fmt.Sprintf(`
+		UPDATE participants
+		SET status = 'confirmed', updated_at = $1
+		WHERE id = $2 AND status = 'waitlisted' AND %s
+	`, live("participants"))
+
+	return inTransaction(ctx, r.pool, func(ctx context.Context) error {
+		if err := r.lockPlaceFor(ctx, id); err != nil {
+			return err
+		}
+
+		result, err := GetQueryable(ctx, r.pool).Exec(ctx, updateQuery, promotedAt, id)
+		if err != nil {
+			return apperrors.Wrapf(err, "failed to promote participant")
+		}
+		if result.RowsAffected() == 0 {
+			return apperrors.Conflict("participant is not waitlisted")
+		}
+		return nil
+	})
+}
+
+// CountConfirmed returns the number of confirmed participants of an event.
+func (r *participantRepository) CountConfirmed(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	query := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM participants
+		WHERE event_id = $1 AND status = 'confirmed' AND %s
+	`, live("participants"))
+
+	var count int64
+	if err := GetQueryable(ctx, r.pool).QueryRow(ctx, query, eventID).Scan(&count); err != nil {
+		return 0, apperrors.Wrapf(err, "failed to count confirmed participants")
+	}
+	return count, nil
+}
+
+// RecordConsent stamps the consent terms version a participant accepted and when,
+// replacing any earlier consent.
+func (r *participantRepository) RecordConsent(
+	ctx context.Context,
+	id uuid.UUID,
+	version string,
+	acceptedAt time.Time,
+) error {
+	query := fmt.Sprintf(`
+		UPDATE participants
+		SET
+			consent_accepted_at = $1,
+			consent_version = $2,
+			updated_at = $1
+		WHERE id = $3 AND %s
+	`, live("participants"))
 
-	result, err := r.pool.Exec(ctx, query, id)
+	result, err := r.pool.Exec(ctx, query, acceptedAt, version, id)
 	if err != nil {
-		return apperrors.Wrapf(err, "failed to delete participant")
+		return apperrors.Wrapf(err, "failed to record consent")
 	}
 
 	if result.RowsAffected() == 0 {
@@ -362,7 +796,462 @@ func (r *participantRepository) Delete(ctx context.Context, id uuid.UUID) error
 	return nil
 }
 
-// Search searches for participants within an event by name, email, or employee_id.
+// MarkInviteSent stamps when a participant's QR code email was sent.
+func (r *participantRepository) MarkInviteSent(ctx context.Context, id uuid.UUID, sentAt time.Time) error {
+	query := fmt.Sprintf(`
+		UPDATE participants
+		SET invite_sent_at = $1, updated_at = $1
+		WHERE id = $2 AND %s
+	`, live("participants"))
+
+	result, err := r.pool.Exec(ctx, query, sentAt, id)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to mark invite sent")
+	}
+
+	if result.RowsAffected() == 0 {
+		return apperrors.NotFound("participant not found")
+	}
+
+	return nil
+}
+
+// Delete soft deletes a participant together with their guests, stamping them with the same
+// deletion time so that Restore brings back exactly the guests deleted with the participant.
+func (r *participantRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	deletedAt := time.Now()
+
+	return inTransaction(ctx, r.pool, func(ctx context.Context) error {
+		q := GetQueryable(ctx, r.pool)
+		deleted, err := tombstone(ctx, q, "participants", "id = $2", deletedAt, id)
+		if err != nil {
+			return apperrors.Wrapf(err, "failed to delete participant")
+		}
+		if deleted == 0 {
+			return apperrors.NotFound("participant not found")
+		}
+
+		if _, err := tombstone(ctx, q, "participants", "guest_of = $2", deletedAt, id); err != nil {
+			return apperrors.Wrapf(err, "failed to delete participant guests")
+		}
+		return nil
+	})
+}
+
+// Restore restores a deleted participant together with the guests deleted with them. The
+// participant's event, and registrant for a guest, must not be deleted. Confirmed participants
+// that no longer fit the event's capacity are restored waitlisted, or rejected, as
+// waitlistPastCapacity describes.
+func (r *participantRepository) Restore(ctx context.Context, id uuid.UUID, waitlist bool) error {
+	restoredAt := time.Now()
+	findQuery := fmt.Sprintf(`
+		SELECT p.deleted_at, p.event_id
+		FROM participants p
+		JOIN events e ON e.id = p.event_id AND %s
+		LEFT JOIN participants g ON g.id = p.guest_of
+		WHERE p.id = $1 AND (p.guest_of IS NULL OR %s)
+		FOR UPDATE OF p
+	`, live("e"), live("g"))
+	const restoredRows = "id = $2 OR (guest_of = $2 AND deleted_at = $3)"
+
+	return inTransaction(ctx, r.pool, func(ctx context.Context) error {
+		q := GetQueryable(ctx, r.pool)
+		var (
+			deletedAt *time.Time
+			eventID   uuid.UUID
+		)
+		err := q.QueryRow(ctx, findQuery, id).Scan(&deletedAt, &eventID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return apperrors.Wrapf(err, "failed to find deleted participant")
+		}
+		if deletedAt == nil {
+			return apperrors.NotFound("deleted participant not found")
+		}
+
+		if err := r.waitlistPastCapacity(ctx, eventID, id, *deletedAt, waitlist); err != nil {
+			return err
+		}
+
+		_, err = restore(ctx, q, "participants", restoredRows, restoredAt, id, *deletedAt)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == pgErrCodeUniqueViolation {
+				return apperrors.Conflict("a participant with this email was registered for the event since the deletion")
+			}
+			return apperrors.Wrapf(err, "failed to restore participant")
+		}
+		return nil
+	})
+}
+
+// waitlistPastCapacity locks the event of a participant being restored as lockCapacity does and
+// makes sure restoring them and the guests deleted with them confirms no more participants than
+// the places left: the confirmed ones that do not fit, guests before their registrant, are
+// waitlisted, or a conflict error is returned if waitlist is false.
+func (r *participantRepository) waitlistPastCapacity(
+	ctx context.Context,
+	eventID, id uuid.UUID,
+	deletedAt time.Time,
+	waitlist bool,
+) error {
+	remaining, err := r.lockRemainingPlaces(ctx, eventID)
+	if err != nil || remaining == nil {
+		return err
+	}
+
+	confirmedQuery := `
+		SELECT id FROM participants
+		WHERE (id = $1 OR (guest_of = $1 AND deleted_at = $2)) AND deleted_at IS NOT NULL
+			AND status = 'confirmed'
+		ORDER BY guest_of NULLS FIRST, created_at, id
+	`
+	q := GetQueryable(ctx, r.pool)
+	rows, err := q.Query(ctx, confirmedQuery, id, deletedAt)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to find confirmed participants to restore")
+	}
+	defer rows.Close()
+
+	var confirmed []uuid.UUID
+	for rows.Next() {
+		var participantID uuid.UUID
+		if err := rows.Scan(&participantID); err != nil {
+			return apperrors.Wrapf(err, "failed to scan confirmed participant to restore")
+		}
+		confirmed = append(confirmed, participantID)
+	}
+	if err := rows.Err(); err != nil {
+		return apperrors.Wrapf(err, "failed to find confirmed participants to restore")
+	}
+
+	places := max(*remaining, 0)
+	if int64(len(confirmed)) <= places {
+		return nil
+	}
+	if !waitlist {
+		return errEventFull()
+	}
+
+	waitlistQuery := `UPDATE participants SET status = 'waitlisted' WHERE id = ANY($1)`
+	if _, err := q.Exec(ctx, waitlistQuery, confirmed[places:]); err != nil {
+		return apperrors.Wrapf(err, "failed to waitlist restored participants")
+	}
+	return nil
+}
+
+// Merge folds a duplicate participant into the primary: the duplicate's guests become the
+// primary's and the duplicate is soft deleted, both stamped at mergedAt like the primary itself.
+func (r *participantRepository) Merge(ctx context.Context, primaryID, duplicateID uuid.UUID, mergedAt time.Time) error {
+	lockQuery := fmt.Sprintf(`SELECT guest_of FROM participants WHERE id = $1 AND %s FOR UPDATE`, live("participants"))
+	moveGuestsQuery := fmt.Sprintf(`
+		UPDATE participants SET guest_of = $1, updated_at = $3
+		WHERE guest_of = $2 AND %s
+	`, live("participants"))
+	touchQuery := `UPDATE participants SET updated_at = $2 WHERE id = $1`
+
+	return inTransaction(ctx, r.pool, func(ctx context.Context) error {
+		q := GetQueryable(ctx, r.pool)
+		var primaryGuestOf *uuid.UUID
+		err := q.QueryRow(ctx, lockQuery, primaryID).Scan(&primaryGuestOf)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return apperrors.NotFound("participant not found")
+		}
+		if err != nil {
+			return apperrors.Wrapf(err, "failed to find primary participant")
+		}
+
+		result, err := q.Exec(ctx, moveGuestsQuery, primaryID, duplicateID, mergedAt)
+		if err != nil {
+			return apperrors.Wrapf(err, "failed to move participant guests")
+		}
+		if primaryGuestOf != nil && result.RowsAffected() > 0 {
+			return apperrors.Conflict("cannot merge a participant with guests into a guest")
+		}
+
+		deleted, err := tombstone(ctx, q, "participants", "id = $2", mergedAt, duplicateID)
+		if err != nil {
+			return apperrors.Wrapf(err, "failed to delete duplicate participant")
+		}
+		if deleted == 0 {
+			return apperrors.NotFound("participant not found")
+		}
+
+		if _, err := q.Exec(ctx, touchQuery, primaryID, mergedAt); err != nil {
+			return apperrors.Wrapf(err, "failed to update primary participant")
+		}
+		return nil
+	})
+}
+
+// AnonymizeByEventID replaces the personal data of every participant of an event with
+// placeholders, deleted participants included. Emails become unique placeholders so the
+// per-event email constraint holds; walk-ins registered without an email keep none.
+func (r *participantRepository) AnonymizeByEventID(ctx context.Context, eventID uuid.UUID) (int64, error) {
+	query := `
+		UPDATE participants
+		SET
+			name = $2,
+			email = CASE WHEN email IS NULL THEN NULL ELSE format($3, id) END,
+			employee_id = NULL,
+			phone = NULL,
+			qr_email = NULL,
+			metadata = NULL,
+			custom_data = NULL,
+			notes = NULL,
+			updated_at = NOW()
+		WHERE event_id = $1
+	`
+
+	result, err := GetQueryable(ctx, r.pool).Exec(ctx, query,
+		eventID,
+		entity.AnonymizedParticipantName,
+		entity.AnonymizedParticipantEmailFormat,
+	)
+	if err != nil {
+		return 0, apperrors.Wrapf(err, "failed to anonymize participants")
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// ExpireDue expires the tentative and invited participants whose event's tentative expiry has
+// passed, measured from when they were registered or invited. Due participants are locked with
+// SKIP LOCKED, so concurrent workers split them rather than expire one twice.
+func (r *participantRepository) ExpireDue(
+	ctx context.Context,
+	now time.Time,
+	limit int,
+) ([]repository.ParticipantExpiry, error) {
+	query := fmt.Sprintf(`
+		WITH due AS (
+			SELECT p.id, p.status
+			FROM participants p
+			JOIN events e ON e.id = p.event_id
+			WHERE p.status IN ('tentative', 'invited') AND %s
+				AND e.tentative_expiry_hours IS NOT NULL
+				AND e.status NOT IN ('completed', 'cancelled')
+				AND p.created_at <= $1 - make_interval(hours => e.tentative_expiry_hours)
+			ORDER BY p.created_at, p.id
+			LIMIT $2
+			FOR UPDATE OF p SKIP LOCKED
+		)
+		UPDATE participants p
+		SET status = 'expired', updated_at = $1
+		FROM due
+		WHERE p.id = due.id
+		RETURNING p.id, p.event_id, due.status
+	`, live("p"))
+
+	rows, err := GetQueryable(ctx, r.pool).Query(ctx, query, now, limit)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "failed to expire participants")
+	}
+	defer rows.Close()
+
+	expired := make([]repository.ParticipantExpiry, 0, limit)
+	for rows.Next() {
+		var e repository.ParticipantExpiry
+		if err := rows.Scan(&e.ParticipantID, &e.EventID, &e.PreviousStatus); err != nil {
+			return nil, apperrors.Wrapf(err, "failed to scan expired participant")
+		}
+		expired = append(expired, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.Wrapf(err, "error iterating expired participants")
+	}
+
+	return expired, nil
+}
+
+// UpdateTags adds and removes tags on the selected participants of an event. The new tag
+// arrays and the cap check are computed in the same statement as the update, so either every
+// selected participant is updated or, if one would exceed maxTags, none is.
+func (r *participantRepository) UpdateTags(
+	ctx context.Context,
+	eventID uuid.UUID,
+	filter repository.ParticipantTagFilter,
+	add, remove []string,
+	maxTags int,
+) (int64, error) {
+	query := fmt.Sprintf(`
+		WITH target AS (
+			SELECT p.id, p.tags, ARRAY(
+				SELECT t.tag
+				FROM unnest(p.tags || COALESCE($2::text[], '{}')) WITH ORDINALITY AS t(tag, ord)
+				WHERE t.tag <> ALL(COALESCE($3::text[], '{}'))
+				GROUP BY t.tag
+				ORDER BY MIN(t.ord)
+			) AS new_tags
+			FROM participants p
+			WHERE p.event_id = $1 AND %s
+				AND ($5::uuid[] IS NULL OR p.id = ANY($5))
+				AND ($6::text IS NULL OR p.status = $6)
+				AND ($7::text IS NULL OR p.payment_status = $7)
+				AND ($8::text IS NULL OR p.tags @> ARRAY[$8::text])
+			FOR UPDATE OF p
+		),
+		over_cap AS (
+			SELECT COUNT(*) AS n FROM target WHERE cardinality(new_tags) > $4
+		),
+		updated AS (
+			UPDATE participants p
+			SET tags = target.new_tags, updated_at = NOW()
+			FROM target
+			WHERE p.id = target.id
+				AND target.new_tags IS DISTINCT FROM target.tags
+				AND (SELECT n FROM over_cap) = 0
+			RETURNING p.id
+		)
+		SELECT (SELECT n FROM over_cap), (SELECT COUNT(*) FROM updated)
+	`, live("p"))
+
+	var overCap, updated int64
+	err := GetQueryable(ctx, r.pool).QueryRow(ctx, query,
+		eventID,
+		add,
+		remove,
+		maxTags,
+		filter.IDs,
+		filter.Status,
+		filter.PaymentStatus,
+		filter.Tag,
+	).Scan(&overCap, &updated)
+	if err != nil {
+		return 0, apperrors.Wrapf(err, "failed to update participant tags")
+	}
+
+	if overCap > 0 {
+		return 0, apperrors.Unprocessable(fmt.Sprintf(
+			"%d participants would have more than %d tags; remove some tags first", overCap, maxTags,
+		))
+	}
+
+	return updated, nil
+}
+
+// AssignGroup sets or clears the group of the participants of an event with the given IDs
+// in a single statement, leaving participants already in that group untouched.
+func (r *participantRepository) AssignGroup(
+	ctx context.Context,
+	eventID uuid.UUID,
+	ids []uuid.UUID,
+	groupName *string,
+) ([]uuid.UUID, error) {
+	query := fmt.Sprintf(`
+		UPDATE participants p
+		SET group_name = $3, updated_at = NOW()
+		WHERE p.event_id = $1 AND p.id = ANY($2) AND %s
+			AND p.group_name IS DISTINCT FROM $3
+		RETURNING p.id
+	`, live("p"))
+
+	rows, err := GetQueryable(ctx, r.pool).Query(ctx, query, eventID, ids, groupName)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "failed to assign participant group")
+	}
+	defer rows.Close()
+
+	updated := make([]uuid.UUID, 0, len(ids))
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, apperrors.Wrapf(err, "failed to scan updated participant")
+		}
+		updated = append(updated, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.Wrapf(err, "failed to assign participant group")
+	}
+
+	return updated, nil
+}
+
+// UpdateStatus moves the participants of an event with the given IDs to status in a single
+// statement, leaving participants already in that status untouched.
+func (r *participantRepository) UpdateStatus(
+	ctx context.Context,
+	eventID uuid.UUID,
+	ids []uuid.UUID,
+	status entity.ParticipantStatus,
+) ([]uuid.UUID, error) {
+	query := fmt.Sprintf(`
+		UPDATE participants p
+		SET status = $3, updated_at = NOW()
+		WHERE p.event_id = $1 AND p.id = ANY($2) AND %s
+			AND p.status <> $3
+		RETURNING p.id
+	`, live("p"))
+
+	rows, err := GetQueryable(ctx, r.pool).Query(ctx, query, eventID, ids, status)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "failed to update participant status")
+	}
+	defer rows.Close()
+
+	updated := make([]uuid.UUID, 0, len(ids))
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, apperrors.Wrapf(err, "failed to scan updated participant")
+		}
+		updated = append(updated, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.Wrapf(err, "failed to update participant status")
+	}
+
+	return updated, nil
+}
+
+// UpdateStatusWithinCapacity moves participants to status, rejecting the whole update when it
+// would confirm more participants than their event has places left. The event row is locked as
+// in CreateOrWaitlist while the participants to confirm are counted.
+func (r *participantRepository) UpdateStatusWithinCapacity(
+	ctx context.Context,
+	eventID uuid.UUID,
+	ids []uuid.UUID,
+	status entity.ParticipantStatus,
+) ([]uuid.UUID, error) {
+	countQuery := fmt.Sprintf(`
+		SELECT COUNT(*)
+		FROM participants p
+		WHERE p.event_id = $1 AND p.id = ANY($2) AND %s
+			AND p.status <> 'confirmed'
+	`, live("p"))
+
+	var updated []uuid.UUID
+	err := inTransaction(ctx, r.pool, func(ctx context.Context) error {
+		if status == entity.ParticipantStatusConfirmed {
+			remaining, err := r.lockRemainingPlaces(ctx, eventID)
+			if err != nil {
+				return err
+			}
+			if remaining != nil {
+				var confirming int64
+				err := GetQueryable(ctx, r.pool).QueryRow(ctx, countQuery, eventID, ids).Scan(&confirming)
+				if err != nil {
+					return apperrors.Wrapf(err, "failed to count participants to confirm")
+				}
+				if confirming > *remaining {
+					return apperrors.WrapAppError(apperrors.Conflict(fmt.Sprintf(
+						"event is at capacity: %d participants to confirm but %d places left",
+						confirming, max(*remaining, 0),
+					)), repository.ErrEventFull)
+				}
+			}
+		}
+
+		var err error
+		updated, err = r.UpdateStatus(ctx, eventID, ids, status)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Search searches for participants within an event by name, email, employee_id or notes.
 func (r *participantRepository) Search(
 	ctx context.Context,
 	eventID uuid.UUID,
@@ -375,33 +1264,37 @@ func (r *participantRepository) Search(
 ) {
 	searchPattern := "%" + query + "%"
 
-	sqlQuery := `
+	sqlQuery := fmt.Sprintf(`
 		SELECT
-			p.id, p.event_id, p.name, p.email, p.employee_id, p.phone, p.qr_email, p.status,
-			p.qr_code, p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
-			p.payment_date, p.created_at, p.updated_at, c.checked_in_at
+			p.id, p.event_id, p.name, COALESCE(p.email, ''), p.employee_id, p.phone, p.qr_email, p.status, p.walk_in,
+			COALESCE(p.qr_code, ''), p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
+			p.payment_date, p.created_at, p.updated_at, p.consent_accepted_at, COALESCE(p.consent_version, ''),
+			p.notes, p.guest_of, p.tags, p.custom_data, p.invite_sent_at, p.group_name, p.deleted_at,
+			c.checked_in_at
 		FROM participants p
-		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id
-		WHERE p.event_id = $1
+		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+		WHERE p.event_id = $1 AND %s
 		AND (
 			p.name ILIKE $2
 			OR p.email ILIKE $2
 			OR p.employee_id ILIKE $2
+			OR p.notes ILIKE $2
 		)
 		ORDER BY p.created_at DESC
 		LIMIT $3 OFFSET $4
-	`
+	`, live("p"))
 
-	countQuery := `
+	countQuery := fmt.Sprintf(`
 		SELECT COUNT(*)
 		FROM participants
-		WHERE event_id = $1
+		WHERE event_id = $1 AND %s
 		AND (
 			name ILIKE $2
 			OR email ILIKE $2
 			OR employee_id ILIKE $2
+			OR notes ILIKE $2
 		)
-	`
+	`, live("participants"))
 
 	participants, err := r.queryParticipantsWithCheckin(ctx, sqlQuery, eventID, searchPattern, limit, offset)
 	if err != nil {
@@ -416,18 +1309,66 @@ func (r *participantRepository) Search(
 	return participants, total, nil
 }
 
+// likeEscaper escapes the LIKE wildcards in a search term so that it matches literally
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// SuggestByName retrieves up to limit participants of an event whose name starts with prefix,
+// ignoring case. The match uses idx_participants_name_prefix.
+func (r *participantRepository) SuggestByName(
+	ctx context.Context,
+	eventID uuid.UUID,
+	prefix string,
+	limit int,
+) ([]repository.ParticipantSuggestion, error) {
+	query := fmt.Sprintf(`
+		SELECT
+			p.id, p.name, COALESCE(p.email, ''),
+			EXISTS (
+				SELECT 1 FROM checkins c
+				WHERE c.participant_id = p.id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+			) AS checked_in
+		FROM participants p
+		WHERE p.event_id = $1 AND %s
+		AND lower(p.name) LIKE $2
+		AND p.status NOT IN ('cancelled', 'declined', 'expired', 'waitlisted')
+		ORDER BY checked_in, lower(p.name), p.id
+		LIMIT $3
+	`, live("p"))
+
+	pattern := likeEscaper.Replace(strings.ToLower(prefix)) + "%"
+	rows, err := GetQueryable(ctx, r.pool).Query(ctx, query, eventID, pattern, limit)
+	if err != nil {
+		return nil, apperrors.Wrapf(err, "failed to suggest participants")
+	}
+	defer rows.Close()
+
+	suggestions := []repository.ParticipantSuggestion{}
+	for rows.Next() {
+		var suggestion repository.ParticipantSuggestion
+		if err := rows.Scan(&suggestion.ID, &suggestion.Name, &suggestion.Email, &suggestion.CheckedIn); err != nil {
+			return nil, apperrors.Wrapf(err, "failed to scan participant suggestion")
+		}
+		suggestions = append(suggestions, suggestion)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, apperrors.Wrapf(err, "failed to iterate participant suggestions")
+	}
+
+	return suggestions, nil
+}
+
 // ExistsByEmail checks if a participant with the given email exists for an event.
 func (r *participantRepository) ExistsByEmail(ctx context.Context, eventID uuid.UUID, email string) (bool, error) {
-	query := `
+	query := fmt.Sprintf(`
 		SELECT EXISTS(
 			SELECT 1
 			FROM participants
-			WHERE event_id = $1 AND email = $2
+			WHERE event_id = $1 AND email = $2 AND %s
 		)
-	`
+	`, live("participants"))
 
 	var exists bool
-	err := r.pool.QueryRow(ctx, query, eventID, email).Scan(&exists)
+	err := GetQueryable(ctx, r.pool).QueryRow(ctx, query, eventID, email).Scan(&exists)
 	if err != nil {
 		return false, apperrors.Wrapf(err, "failed to check participant existence")
 	}
@@ -436,6 +1377,9 @@ func (r *participantRepository) ExistsByEmail(ctx context.Context, eventID uuid.
 }
 
 // GetPaymentStats retrieves payment statistics for participants in an event.
+// Expected and outstanding totals only cover confirmed participants; amounts collected
+// from participants in any status are included in the collected total. Confirmed
+// participants without an amount are expected to pay the event's fixed fee, if any.
 func (r *participantRepository) GetPaymentStats(
 	ctx context.Context,
 	eventID uuid.UUID,
@@ -443,15 +1387,38 @@ func (r *participantRepository) GetPaymentStats(
 	*repository.ParticipantPaymentStats,
 	error,
 ) {
-	query := `
+	query := fmt.Sprintf(`
+		WITH fee AS (
+			SELECT CASE WHEN fee_type = 'fixed' THEN fee_amount END AS fixed_fee
+			FROM events WHERE id = $1
+		)
 		SELECT
 			COUNT(*) as total_participants,
 			COUNT(CASE WHEN payment_status = 'paid' THEN 1 END) as paid_participants,
 			COUNT(CASE WHEN payment_status = 'unpaid' THEN 1 END) as unpaid_participants,
-			COALESCE(SUM(CASE WHEN payment_status = 'paid' THEN payment_amount ELSE 0 END), 0) as total_payment_amount
+			COALESCE(SUM(CASE WHEN payment_status = 'paid' THEN payment_amount ELSE 0 END), 0)::BIGINT
+				as total_payment_amount,
+			COALESCE((SELECT currency FROM events WHERE id = $1), '') as currency,
+			COALESCE(SUM(CASE WHEN status = 'confirmed'
+				THEN COALESCE(payment_amount, (SELECT fixed_fee FROM fee)) ELSE 0 END), 0)::BIGINT
+				as expected_payment_amount,
+			COALESCE(SUM(CASE WHEN status = 'confirmed' AND payment_status = 'unpaid'
+				THEN COALESCE(payment_amount, (SELECT fixed_fee FROM fee)) ELSE 0 END), 0)::BIGINT
+				as outstanding_payment_amount,
+			COUNT(CASE WHEN status = 'confirmed'
+				AND COALESCE(payment_amount, (SELECT fixed_fee FROM fee)) IS NULL THEN 1 END)
+				as unpriced_participants,
+			COUNT(CASE WHEN status = 'confirmed' THEN 1 END) as confirmed_participants,
+			COUNT(CASE WHEN status = 'tentative' THEN 1 END) as tentative_participants,
+			COUNT(CASE WHEN status = 'declined' THEN 1 END) as declined_participants,
+			COALESCE((
+				SELECT SUM(pay.amount) FROM payments pay
+				JOIN participants p ON p.id = pay.participant_id AND %s
+				WHERE pay.event_id = $1 AND pay.refunded_at IS NOT NULL
+			), 0)::BIGINT as refunded_payment_amount
 		FROM participants
-		WHERE event_id = $1
-	`
+		WHERE event_id = $1 AND %s
+	`, live("p"), live("participants"))
 
 	stats := &repository.ParticipantPaymentStats{}
 	err := r.pool.QueryRow(ctx, query, eventID).Scan(
@@ -459,6 +1426,14 @@ func (r *participantRepository) GetPaymentStats(
 		&stats.PaidParticipants,
 		&stats.UnpaidParticipants,
 		&stats.TotalPaymentAmount,
+		&stats.Currency,
+		&stats.ExpectedPaymentAmount,
+		&stats.OutstandingPaymentAmount,
+		&stats.UnpricedParticipants,
+		&stats.ConfirmedParticipants,
+		&stats.TentativeParticipants,
+		&stats.DeclinedParticipants,
+		&stats.RefundedPaymentAmount,
 	)
 	if err != nil {
 		return nil, apperrors.Wrapf(err, "failed to get payment stats")
@@ -467,6 +1442,122 @@ func (r *participantRepository) GetPaymentStats(
 	return stats, nil
 }
 
+// FindChangesSince retrieves the delta of an event's participant list after since. The
+// participants, the tombstones and the cursor are read in one repeatable-read transaction so
+// that the cursor never skips a change that was not returned.
+func (r *participantRepository) FindChangesSince(
+	ctx context.Context,
+	eventID uuid.UUID,
+	since time.Time,
+) (*repository.ParticipantChanges, error) {
+	participantsQuery := fmt.Sprintf(`
+		SELECT
+			p.id, p.event_id, p.name, COALESCE(p.email, ''), p.employee_id, p.phone, p.qr_email, p.status, p.walk_in,
+			COALESCE(p.qr_code, ''), p.qr_code_generated_at, p.metadata, p.payment_status, p.payment_amount,
+			p.payment_date, p.created_at, p.updated_at, p.consent_accepted_at, COALESCE(p.consent_version, ''),
+			p.notes, p.guest_of, p.tags, p.custom_data, p.invite_sent_at, p.group_name, p.deleted_at,
+			c.checked_in_at
+		FROM participants p
+		LEFT JOIN checkins c ON c.participant_id = p.id AND c.event_id = p.event_id AND c.cancelled_at IS NULL
+		LEFT JOIN LATERAL (
+			SELECT MAX(updated_at) AS updated_at FROM checkins WHERE participant_id = p.id
+		) lc ON TRUE
+		WHERE p.event_id = $1 AND %s AND GREATEST(p.updated_at, lc.updated_at) > $2
+		ORDER BY GREATEST(p.updated_at, lc.updated_at), p.id
+	`, live("p"))
+	deletionsQuery := `
+		SELECT participant_id, deleted_at
+		FROM participant_deletions
+		WHERE event_id = $1 AND deleted_at > $2
+		ORDER BY deleted_at, participant_id
+	`
+	// Every change in the snapshot is at or before the latest timestamp of the event
+	cursorQuery := `
+		SELECT GREATEST(
+			$2::timestamp,
+			(SELECT MAX(updated_at) FROM participants WHERE event_id = $1),
+			(SELECT MAX(updated_at) FROM checkins WHERE event_id = $1),
+			(SELECT MAX(deleted_at) FROM participant_deletions WHERE event_id = $1)
+		)
+	`
+
+	changes := &repository.ParticipantChanges{
+		Participants: []*entity.Participant{},
+		Deleted:      []repository.ParticipantDeletion{},
+	}
+	txOptions := pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly}
+	err := pgx.BeginTxFunc(ctx, r.pool, txOptions, func(tx pgx.Tx) error {
+		rows, err := tx.Query(ctx, participantsQuery, eventID, since)
+		if err != nil {
+			return apperrors.Wrapf(err, "failed to query changed participants")
+		}
+		for rows.Next() {
+			participant, err := r.scanParticipantWithCheckin(rows)
+			if err != nil {
+				rows.Close()
+				return apperrors.Wrapf(err, "failed to scan participant")
+			}
+			changes.Participants = append(changes.Participants, participant)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return apperrors.Wrapf(err, "error iterating changed participants")
+		}
+
+		rows, err = tx.Query(ctx, deletionsQuery, eventID, since)
+		if err != nil {
+			return apperrors.Wrapf(err, "failed to query participant deletions")
+		}
+		for rows.Next() {
+			var deletion repository.ParticipantDeletion
+			if err := rows.Scan(&deletion.ParticipantID, &deletion.DeletedAt); err != nil {
+				rows.Close()
+				return apperrors.Wrapf(err, "failed to scan participant deletion")
+			}
+			changes.Deleted = append(changes.Deleted, deletion)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return apperrors.Wrapf(err, "error iterating participant deletions")
+		}
+
+		if err := tx.QueryRow(ctx, cursorQuery, eventID, since).Scan(&changes.Cursor); err != nil {
+			return apperrors.Wrapf(err, "failed to query participant changes cursor")
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// GetListLastModified returns the latest modification time of an event's participant list.
+// The event's participants_modified_at marker is bumped on every participant or check-in
+// change, so deletions move the timestamp forward even though deleted participants are left out.
+func (r *participantRepository) GetListLastModified(ctx context.Context, eventID uuid.UUID) (time.Time, error) {
+	query := fmt.Sprintf(`
+		SELECT GREATEST(
+			e.participants_modified_at,
+			(SELECT MAX(p.updated_at) FROM participants p WHERE p.event_id = e.id AND %s)
+		)
+		FROM events e
+		WHERE e.id = $1 AND %s
+	`, live("p"), live("e"))
+
+	var lastModified time.Time
+	err := r.pool.QueryRow(ctx, query, eventID).Scan(&lastModified)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return time.Time{}, apperrors.NotFound("event not found")
+		}
+		return time.Time{}, apperrors.Wrapf(err, "failed to get participant list last modified")
+	}
+
+	return lastModified, nil
+}
+
 // HealthCheck checks the database connection.
 func (r *participantRepository) HealthCheck(ctx context.Context) error {
 	return r.pool.Ping(ctx)
@@ -484,6 +1575,7 @@ func (r *participantRepository) scanParticipantFromRow(row pgx.Row) (*entity.Par
 		&participant.Phone,
 		&participant.QREmail,
 		&participant.Status,
+		&participant.WalkIn,
 		&participant.QRCode,
 		&participant.QRCodeGeneratedAt,
 		&participant.Metadata,
@@ -492,6 +1584,15 @@ func (r *participantRepository) scanParticipantFromRow(row pgx.Row) (*entity.Par
 		&participant.PaymentDate,
 		&participant.CreatedAt,
 		&participant.UpdatedAt,
+		&participant.ConsentAcceptedAt,
+		&participant.ConsentVersion,
+		&participant.Notes,
+		&participant.GuestOf,
+		&participant.Tags,
+		&participant.CustomData,
+		&participant.InviteSentAt,
+		&participant.GroupName,
+		&participant.DeletedAt,
 		&participant.CheckedInAt,
 	)
 	if err != nil {
@@ -513,6 +1614,7 @@ func (r *participantRepository) scanParticipantWithCheckin(rows pgx.Rows) (*enti
 		&participant.Phone,
 		&participant.QREmail,
 		&participant.Status,
+		&participant.WalkIn,
 		&participant.QRCode,
 		&participant.QRCodeGeneratedAt,
 		&participant.Metadata,
@@ -521,6 +1623,15 @@ func (r *participantRepository) scanParticipantWithCheckin(rows pgx.Rows) (*enti
 		&participant.PaymentDate,
 		&participant.CreatedAt,
 		&participant.UpdatedAt,
+		&participant.ConsentAcceptedAt,
+		&participant.ConsentVersion,
+		&participant.Notes,
+		&participant.GuestOf,
+		&participant.Tags,
+		&participant.CustomData,
+		&participant.InviteSentAt,
+		&participant.GroupName,
+		&participant.DeletedAt,
 		&participant.CheckedInAt,
 	)
 	if err != nil {
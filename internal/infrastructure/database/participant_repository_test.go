@@ -6,13 +6,16 @@ package database_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/fumkob/ezqrin-server/config"
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -146,6 +149,72 @@ var _ = Describe("ParticipantRepository", func() {
 			})
 		})
 
+		Context("with a walk-in participant without email", func() {
+			It("should store the participant with an empty email", func() {
+				participant := &entity.Participant{
+					ID:                uuid.New(),
+					EventID:           eventID,
+					Name:              "Walk-in Guest",
+					Status:            entity.ParticipantStatusConfirmed,
+					WalkIn:            true,
+					QRCode:            "qr_code_walk_in",
+					QRCodeGeneratedAt: time.Now(),
+					PaymentStatus:     entity.PaymentUnpaid,
+					CreatedAt:         time.Now(),
+					UpdatedAt:         time.Now(),
+				}
+
+				Expect(repo.Create(ctx, participant)).To(Succeed())
+
+				retrieved, err := repo.FindByID(ctx, participant.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(retrieved.Email).To(BeEmpty())
+				Expect(retrieved.WalkIn).To(BeTrue())
+			})
+		})
+
+		Context("with a guest without email", func() {
+			It("should store the guest linked to its registrant", func() {
+				registrant := &entity.Participant{
+					ID:                uuid.New(),
+					EventID:           eventID,
+					Name:              "Registrant",
+					Email:             "registrant@example.com",
+					Status:            entity.ParticipantStatusConfirmed,
+					QRCode:            "qr_code_registrant",
+					QRCodeGeneratedAt: time.Now(),
+					PaymentStatus:     entity.PaymentUnpaid,
+					CreatedAt:         time.Now(),
+					UpdatedAt:         time.Now(),
+				}
+				Expect(repo.Create(ctx, registrant)).To(Succeed())
+
+				guest := &entity.Participant{
+					ID:                uuid.New(),
+					EventID:           eventID,
+					GuestOf:           &registrant.ID,
+					Name:              "Guest",
+					Status:            entity.ParticipantStatusConfirmed,
+					QRCode:            "qr_code_guest",
+					QRCodeGeneratedAt: time.Now(),
+					PaymentStatus:     entity.PaymentUnpaid,
+					CreatedAt:         time.Now(),
+					UpdatedAt:         time.Now(),
+				}
+				Expect(repo.Create(ctx, guest)).To(Succeed())
+
+				retrieved, err := repo.FindByQRCode(ctx, "qr_code_guest")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(retrieved.Email).To(BeEmpty())
+				Expect(retrieved.GuestOf).To(HaveValue(Equal(registrant.ID)))
+
+				// Deleting the registrant deletes its guests
+				Expect(repo.Delete(ctx, registrant.ID)).To(Succeed())
+				_, err = repo.FindByID(ctx, guest.ID)
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
 		Context("with duplicate email for same event", func() {
 			It("should return error for duplicate email", func() {
 				email := "duplicate@example.com"
@@ -407,6 +476,8 @@ var _ = Describe("ParticipantRepository", func() {
 				participant.Name = "Jane Doe"
 				participant.Status = entity.ParticipantStatusConfirmed
 				participant.PaymentStatus = entity.PaymentPaid
+				participant.Tags = []string{"vip", "speaker"}
+				participant.CustomData = map[string]any{"company": "Acme", "age": float64(30)}
 				participant.UpdatedAt = time.Now()
 
 				err = repo.Update(ctx, participant)
@@ -418,6 +489,8 @@ var _ = Describe("ParticipantRepository", func() {
 				Expect(retrieved.Name).To(Equal("Jane Doe"))
 				Expect(retrieved.Status).To(Equal(entity.ParticipantStatusConfirmed))
 				Expect(retrieved.PaymentStatus).To(Equal(entity.PaymentPaid))
+				Expect(retrieved.Tags).To(Equal([]string{"vip", "speaker"}))
+				Expect(retrieved.CustomData).To(Equal(map[string]any{"company": "Acme", "age": float64(30)}))
 			})
 		})
 
@@ -478,6 +551,811 @@ var _ = Describe("ParticipantRepository", func() {
 		})
 	})
 
+	Describe("AcceptInvitation", func() {
+		var invited *entity.Participant
+
+		BeforeEach(func() {
+			invited = &entity.Participant{
+				ID:            uuid.New(),
+				EventID:       eventID,
+				Name:          "Invited Guest",
+				Email:         "guest@example.com",
+				Status:        entity.ParticipantStatusInvited,
+				PaymentStatus: entity.PaymentUnpaid,
+				CreatedAt:     time.Now(),
+				UpdatedAt:     time.Now(),
+			}
+			Expect(repo.Create(ctx, invited)).To(Succeed())
+		})
+
+		Context("with an invited participant", func() {
+			It("should store the participant without a QR code", func() {
+				retrieved, err := repo.FindByID(ctx, invited.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(retrieved.Status).To(Equal(entity.ParticipantStatusInvited))
+				Expect(retrieved.QRCode).To(BeEmpty())
+			})
+
+			It("should not count the participant in event stats", func() {
+				stats, err := eventRepo.GetStats(ctx, eventID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stats.TotalParticipants).To(BeZero())
+			})
+
+			It("should confirm the participant and assign the QR code", func() {
+				err := repo.AcceptInvitation(ctx, invited.ID, "qr_code_invited", time.Now())
+				Expect(err).NotTo(HaveOccurred())
+
+				retrieved, err := repo.FindByID(ctx, invited.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(retrieved.Status).To(Equal(entity.ParticipantStatusConfirmed))
+				Expect(retrieved.QRCode).To(Equal("qr_code_invited"))
+			})
+		})
+
+		Context("when the invitation was already accepted", func() {
+			It("should return a conflict error", func() {
+				Expect(repo.AcceptInvitation(ctx, invited.ID, "qr_code_first", time.Now())).To(Succeed())
+
+				err := repo.AcceptInvitation(ctx, invited.ID, "qr_code_second", time.Now())
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("RecordConsent", func() {
+		var participant *entity.Participant
+
+		BeforeEach(func() {
+			participant = &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           eventID,
+				Name:              "Consenting Guest",
+				Email:             "consent@example.com",
+				Status:            entity.ParticipantStatusConfirmed,
+				QRCode:            "qr_code_consent",
+				QRCodeGeneratedAt: time.Now(),
+				PaymentStatus:     entity.PaymentUnpaid,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(repo.Create(ctx, participant)).To(Succeed())
+		})
+
+		It("should store a participant without consent", func() {
+			retrieved, err := repo.FindByID(ctx, participant.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.HasConsent()).To(BeFalse())
+			Expect(retrieved.ConsentVersion).To(BeEmpty())
+		})
+
+		It("should stamp the consent version and time", func() {
+			acceptedAt := time.Now().UTC().Truncate(time.Microsecond)
+			Expect(repo.RecordConsent(ctx, participant.ID, "2025-01", acceptedAt)).To(Succeed())
+
+			retrieved, err := repo.FindByID(ctx, participant.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.HasConsent()).To(BeTrue())
+			Expect(retrieved.ConsentAcceptedAt.Equal(acceptedAt)).To(BeTrue())
+			Expect(retrieved.ConsentVersion).To(Equal("2025-01"))
+		})
+
+		It("should return not found for an unknown participant", func() {
+			err := repo.RecordConsent(ctx, uuid.New(), "2025-01", time.Now())
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("MarkInviteSent", func() {
+		var participant *entity.Participant
+
+		BeforeEach(func() {
+			participant = &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           eventID,
+				Name:              "Invite Guest",
+				Email:             "invite@example.com",
+				Status:            entity.ParticipantStatusConfirmed,
+				QRCode:            "qr_code_invite_sent",
+				QRCodeGeneratedAt: time.Now(),
+				PaymentStatus:     entity.PaymentUnpaid,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(repo.Create(ctx, participant)).To(Succeed())
+		})
+
+		It("should store a participant who has not been sent their QR code", func() {
+			retrieved, err := repo.FindByID(ctx, participant.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.InviteSentAt).To(BeNil())
+		})
+
+		It("should record when the QR code email was sent", func() {
+			sentAt := time.Now().UTC().Truncate(time.Microsecond)
+			Expect(repo.MarkInviteSent(ctx, participant.ID, sentAt)).To(Succeed())
+
+			retrieved, err := repo.FindByID(ctx, participant.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.InviteSentAt).NotTo(BeNil())
+			Expect(retrieved.InviteSentAt.Equal(sentAt)).To(BeTrue())
+		})
+
+		It("should return not found for an unknown participant", func() {
+			err := repo.MarkInviteSent(ctx, uuid.New(), time.Now())
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("UpdatePayment", func() {
+		var participant *entity.Participant
+
+		BeforeEach(func() {
+			participant = &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           eventID,
+				Name:              "Paying Guest",
+				Email:             "paying@example.com",
+				Status:            entity.ParticipantStatusConfirmed,
+				QRCode:            "qr_code_update_payment",
+				QRCodeGeneratedAt: time.Now(),
+				PaymentStatus:     entity.PaymentUnpaid,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(repo.Create(ctx, participant)).To(Succeed())
+		})
+
+		It("should set only the payment fields of the participant", func() {
+			// A concurrent edit of the participant, made after the payment was read
+			renamed := *participant
+			renamed.Name = "Renamed Guest"
+			Expect(repo.Update(ctx, &renamed)).To(Succeed())
+
+			amount := money.FromMinorUnits(150000)
+			paidAt := time.Now()
+			participant.PaymentStatus = entity.PaymentPaid
+			participant.PaymentAmount = &amount
+			participant.PaymentDate = &paidAt
+			participant.UpdatedAt = paidAt
+			Expect(repo.UpdatePayment(ctx, participant, entity.PaymentUnpaid)).To(Succeed())
+
+			retrieved, err := repo.FindByID(ctx, participant.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.Name).To(Equal("Renamed Guest"))
+			Expect(retrieved.PaymentStatus).To(Equal(entity.PaymentPaid))
+			Expect(retrieved.PaymentAmount).To(Equal(&amount))
+			Expect(retrieved.PaymentDate).NotTo(BeNil())
+		})
+
+		It("should return a conflict once the payment status changed", func() {
+			participant.PaymentStatus = entity.PaymentPaid
+			Expect(repo.UpdatePayment(ctx, participant, entity.PaymentUnpaid)).To(Succeed())
+
+			err := repo.UpdatePayment(ctx, participant, entity.PaymentUnpaid)
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+		})
+	})
+
+	Describe("event capacity", func() {
+		var newParticipant func(name string) *entity.Participant
+
+		BeforeEach(func() {
+			event, err := eventRepo.FindByID(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			event.Capacity = 1
+			Expect(eventRepo.Update(ctx, event)).To(Succeed())
+
+			newParticipant = func(name string) *entity.Participant {
+				id := uuid.New()
+				return &entity.Participant{
+					ID:                id,
+					EventID:           eventID,
+					Name:              name,
+					Email:             fmt.Sprintf("%s@example.com", strings.ToLower(name)),
+					Status:            entity.ParticipantStatusConfirmed,
+					QRCode:            "qr_code_" + id.String(),
+					QRCodeGeneratedAt: time.Now(),
+					PaymentStatus:     entity.PaymentUnpaid,
+					CreatedAt:         time.Now(),
+					UpdatedAt:         time.Now(),
+				}
+			}
+		})
+
+		It("should confirm participants until the capacity is reached, then waitlist them", func() {
+			first := newParticipant("First")
+			Expect(repo.CreateOrWaitlist(ctx, first)).To(Succeed())
+			Expect(first.Status).To(Equal(entity.ParticipantStatusConfirmed))
+
+			second := newParticipant("Second")
+			Expect(repo.CreateOrWaitlist(ctx, second)).To(Succeed())
+			Expect(second.Status).To(Equal(entity.ParticipantStatusWaitlisted))
+
+			retrieved, err := repo.FindByID(ctx, second.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.Status).To(Equal(entity.ParticipantStatusWaitlisted))
+
+			count, err := repo.CountConfirmed(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(int64(1)))
+		})
+
+		It("should reject confirmed participants beyond the capacity when not waitlisting", func() {
+			Expect(repo.CreateWithinCapacity(ctx, newParticipant("First"))).To(Succeed())
+
+			err := repo.CreateWithinCapacity(ctx, newParticipant("Second"))
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+			Expect(err).To(MatchError(repository.ErrEventFull))
+
+			tentative := newParticipant("Third")
+			tentative.Status = entity.ParticipantStatusTentative
+			Expect(repo.CreateWithinCapacity(ctx, tentative)).To(Succeed())
+		})
+
+		It("should reject a promotion while the event is at capacity", func() {
+			Expect(repo.CreateOrWaitlist(ctx, newParticipant("First"))).To(Succeed())
+			waitlisted := newParticipant("Second")
+			Expect(repo.CreateOrWaitlist(ctx, waitlisted)).To(Succeed())
+
+			err := repo.Promote(ctx, waitlisted.ID, time.Now())
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+		})
+
+		It("should promote a waitlisted participant once a place is free", func() {
+			first := newParticipant("First")
+			Expect(repo.CreateOrWaitlist(ctx, first)).To(Succeed())
+			waitlisted := newParticipant("Second")
+			Expect(repo.CreateOrWaitlist(ctx, waitlisted)).To(Succeed())
+
+			first.Status = entity.ParticipantStatusCancelled
+			Expect(repo.Update(ctx, first)).To(Succeed())
+
+			Expect(repo.Promote(ctx, waitlisted.ID, time.Now())).To(Succeed())
+
+			retrieved, err := repo.FindByID(ctx, waitlisted.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.Status).To(Equal(entity.ParticipantStatusConfirmed))
+
+			err = repo.Promote(ctx, waitlisted.ID, time.Now())
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+		})
+
+		It("should return not found when promoting an unknown participant", func() {
+			err := repo.Promote(ctx, uuid.New(), time.Now())
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should reject confirming a participant by update while the event is at capacity", func() {
+			first := newParticipant("First")
+			Expect(repo.CreateWithinCapacity(ctx, first)).To(Succeed())
+			tentative := newParticipant("Second")
+			tentative.Status = entity.ParticipantStatusTentative
+			Expect(repo.CreateWithinCapacity(ctx, tentative)).To(Succeed())
+
+			tentative.Status = entity.ParticipantStatusConfirmed
+			err := repo.UpdateWithinCapacity(ctx, tentative)
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+
+			first.Name = "First Renamed"
+			Expect(repo.UpdateWithinCapacity(ctx, first)).To(Succeed())
+		})
+
+		It("should reject a bulk confirmation exceeding the places left", func() {
+			tentatives := make([]uuid.UUID, 0, 2)
+			for _, name := range []string{"First", "Second"} {
+				p := newParticipant(name)
+				p.Status = entity.ParticipantStatusTentative
+				Expect(repo.Create(ctx, p)).To(Succeed())
+				tentatives = append(tentatives, p.ID)
+			}
+
+			_, err := repo.UpdateStatusWithinCapacity(ctx, eventID, tentatives, entity.ParticipantStatusConfirmed)
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+
+			count, err := repo.CountConfirmed(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(BeZero())
+
+			updated, err := repo.UpdateStatusWithinCapacity(
+				ctx, eventID, tentatives[:1], entity.ParticipantStatusConfirmed,
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(ConsistOf(tentatives[0]))
+		})
+
+		It("should reject accepting an invitation while the event is at capacity", func() {
+			Expect(repo.CreateWithinCapacity(ctx, newParticipant("First"))).To(Succeed())
+			invited := newParticipant("Invited")
+			invited.Status = entity.ParticipantStatusInvited
+			invited.QRCode = ""
+			Expect(repo.Create(ctx, invited)).To(Succeed())
+
+			err := repo.AcceptInvitation(ctx, invited.ID, "qr_code_invited", time.Now())
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+
+			retrieved, err := repo.FindByID(ctx, invited.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.Status).To(Equal(entity.ParticipantStatusInvited))
+		})
+	})
+
+	Describe("AnonymizeByEventID", func() {
+		It("should replace personal data and keep status and payment", func() {
+			phone := "+81312345678"
+			notes := "Needs wheelchair access"
+			participant := &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           eventID,
+				Name:              "Jane Doe",
+				Email:             "jane@example.com",
+				Phone:             &phone,
+				Notes:             &notes,
+				Status:            entity.ParticipantStatusConfirmed,
+				QRCode:            "qr_code_anonymize",
+				QRCodeGeneratedAt: time.Now(),
+				PaymentStatus:     entity.PaymentPaid,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(repo.Create(ctx, participant)).To(Succeed())
+
+			anonymized, err := repo.AnonymizeByEventID(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(anonymized).To(Equal(int64(1)))
+
+			retrieved, err := repo.FindByID(ctx, participant.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.Name).To(Equal(entity.AnonymizedParticipantName))
+			Expect(retrieved.Email).To(Equal(entity.AnonymizedParticipantEmail(participant.ID)))
+			Expect(retrieved.Phone).To(BeNil())
+			Expect(retrieved.Notes).To(BeNil())
+			Expect(retrieved.Status).To(Equal(entity.ParticipantStatusConfirmed))
+			Expect(retrieved.PaymentStatus).To(Equal(entity.PaymentPaid))
+		})
+
+		It("should anonymize nothing for an event without participants", func() {
+			anonymized, err := repo.AnonymizeByEventID(ctx, uuid.New())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(anonymized).To(BeZero())
+		})
+	})
+
+	Describe("ExpireDue", func() {
+		var now time.Time
+
+		createRegistered := func(status entity.ParticipantStatus, registeredAt time.Time) *entity.Participant {
+			id := uuid.New()
+			p := &entity.Participant{
+				ID:                id,
+				EventID:           eventID,
+				Name:              "Waiting Participant",
+				Email:             fmt.Sprintf("waiting_%s@example.com", id.String()[:8]),
+				Status:            status,
+				QRCodeGeneratedAt: registeredAt,
+				PaymentStatus:     entity.PaymentUnpaid,
+				CreatedAt:         registeredAt,
+				UpdatedAt:         registeredAt,
+			}
+			if status != entity.ParticipantStatusInvited {
+				p.QRCode = "qr_code_" + id.String()
+			}
+			Expect(repo.Create(ctx, p)).To(Succeed())
+			return p
+		}
+
+		setExpiryHours := func(hours int) {
+			event, err := eventRepo.FindByID(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			event.TentativeExpiryHours = hours
+			Expect(eventRepo.Update(ctx, event)).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			now = time.Now().UTC().Truncate(time.Microsecond)
+		})
+
+		It("should expire tentative and invited participants waiting longer than the event allows", func() {
+			setExpiryHours(48)
+			tentative := createRegistered(entity.ParticipantStatusTentative, now.Add(-49*time.Hour))
+			invited := createRegistered(entity.ParticipantStatusInvited, now.Add(-49*time.Hour))
+			recent := createRegistered(entity.ParticipantStatusTentative, now.Add(-time.Hour))
+			confirmed := createRegistered(entity.ParticipantStatusConfirmed, now.Add(-49*time.Hour))
+
+			expired, err := repo.ExpireDue(ctx, now, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expired).To(ConsistOf(
+				repository.ParticipantExpiry{
+					ParticipantID: tentative.ID, EventID: eventID, PreviousStatus: entity.ParticipantStatusTentative,
+				},
+				repository.ParticipantExpiry{
+					ParticipantID: invited.ID, EventID: eventID, PreviousStatus: entity.ParticipantStatusInvited,
+				},
+			))
+
+			retrieved, err := repo.FindByID(ctx, invited.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.Status).To(Equal(entity.ParticipantStatusExpired))
+			Expect(retrieved.QRCode).To(BeEmpty())
+			Expect(retrieved.UpdatedAt).To(BeTemporally("~", now, time.Millisecond))
+
+			for _, p := range []*entity.Participant{recent, confirmed} {
+				retrieved, err := repo.FindByID(ctx, p.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(retrieved.Status).To(Equal(p.Status))
+			}
+
+			expired, err = repo.ExpireDue(ctx, now, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expired).To(BeEmpty())
+		})
+
+		It("should expire at most limit participants, the longest waiting first", func() {
+			setExpiryHours(1)
+			oldest := createRegistered(entity.ParticipantStatusTentative, now.Add(-3*time.Hour))
+			createRegistered(entity.ParticipantStatusTentative, now.Add(-2*time.Hour))
+
+			expired, err := repo.ExpireDue(ctx, now, 1)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expired).To(HaveLen(1))
+			Expect(expired[0].ParticipantID).To(Equal(oldest.ID))
+		})
+
+		It("should not expire participants of events without a tentative expiry", func() {
+			createRegistered(entity.ParticipantStatusTentative, now.Add(-365*24*time.Hour))
+
+			expired, err := repo.ExpireDue(ctx, now, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expired).To(BeEmpty())
+		})
+	})
+
+	Describe("UpdateTags", func() {
+		var first, second *entity.Participant
+
+		createTagged := func(email string, paymentStatus entity.PaymentStatus, tags ...string) *entity.Participant {
+			p := &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           eventID,
+				Name:              "Tagged Participant",
+				Email:             email,
+				Status:            entity.ParticipantStatusConfirmed,
+				QRCode:            "qr_code_" + email,
+				QRCodeGeneratedAt: time.Now(),
+				PaymentStatus:     paymentStatus,
+				Tags:              tags,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(repo.Create(ctx, p)).To(Succeed())
+			return p
+		}
+
+		tagsOf := func(p *entity.Participant) []string {
+			retrieved, err := repo.FindByID(ctx, p.ID)
+			Expect(err).NotTo(HaveOccurred())
+			return retrieved.Tags
+		}
+
+		BeforeEach(func() {
+			first = createTagged("first@example.com", entity.PaymentUnpaid, "vip")
+			second = createTagged("second@example.com", entity.PaymentPaid)
+		})
+
+		It("should append added tags to the selected participants", func() {
+			filter := repository.ParticipantTagFilter{IDs: []uuid.UUID{first.ID, second.ID}}
+
+			updated, err := repo.UpdateTags(ctx, eventID, filter, []string{"follow-up"}, nil, entity.ParticipantMaxTags)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal(int64(2)))
+			Expect(tagsOf(first)).To(Equal([]string{"vip", "follow-up"}))
+			Expect(tagsOf(second)).To(Equal([]string{"follow-up"}))
+		})
+
+		It("should remove tags", func() {
+			filter := repository.ParticipantTagFilter{IDs: []uuid.UUID{first.ID, second.ID}}
+
+			updated, err := repo.UpdateTags(ctx, eventID, filter, nil, []string{"vip"}, entity.ParticipantMaxTags)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal(int64(1)))
+			Expect(tagsOf(first)).To(BeEmpty())
+		})
+
+		It("should not duplicate a tag that is added again", func() {
+			filter := repository.ParticipantTagFilter{IDs: []uuid.UUID{first.ID}}
+
+			updated, err := repo.UpdateTags(ctx, eventID, filter, []string{"vip"}, nil, entity.ParticipantMaxTags)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeZero())
+			Expect(tagsOf(first)).To(Equal([]string{"vip"}))
+		})
+
+		It("should select participants by filter", func() {
+			unpaid := entity.PaymentUnpaid
+			filter := repository.ParticipantTagFilter{PaymentStatus: &unpaid}
+
+			updated, err := repo.UpdateTags(ctx, eventID, filter, []string{"follow-up"}, nil, entity.ParticipantMaxTags)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal(int64(1)))
+			Expect(tagsOf(first)).To(Equal([]string{"vip", "follow-up"}))
+			Expect(tagsOf(second)).To(BeEmpty())
+		})
+
+		It("should select participants by an existing tag", func() {
+			tag := "vip"
+			filter := repository.ParticipantTagFilter{Tag: &tag}
+
+			updated, err := repo.UpdateTags(ctx, eventID, filter, []string{"speaker"}, nil, entity.ParticipantMaxTags)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal(int64(1)))
+			Expect(tagsOf(second)).To(BeEmpty())
+		})
+
+		It("should ignore participants of other events", func() {
+			filter := repository.ParticipantTagFilter{IDs: []uuid.UUID{first.ID}}
+
+			updated, err := repo.UpdateTags(ctx, uuid.New(), filter, []string{"follow-up"}, nil, entity.ParticipantMaxTags)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeZero())
+			Expect(tagsOf(first)).To(Equal([]string{"vip"}))
+		})
+
+		It("should change nothing when a participant would exceed the cap", func() {
+			filter := repository.ParticipantTagFilter{IDs: []uuid.UUID{first.ID, second.ID}}
+
+			_, err := repo.UpdateTags(ctx, eventID, filter, []string{"a", "b"}, nil, 2)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeUnprocessable))
+			Expect(tagsOf(first)).To(Equal([]string{"vip"}))
+			Expect(tagsOf(second)).To(BeEmpty())
+		})
+	})
+
+	Describe("UpdateStatus", func() {
+		var tentative, confirmed *entity.Participant
+
+		createWithStatus := func(email string, status entity.ParticipantStatus) *entity.Participant {
+			p := &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           eventID,
+				Name:              "Registered Participant",
+				Email:             email,
+				Status:            status,
+				QRCode:            "qr_code_" + email,
+				QRCodeGeneratedAt: time.Now(),
+				PaymentStatus:     entity.PaymentUnpaid,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(repo.Create(ctx, p)).To(Succeed())
+			return p
+		}
+
+		BeforeEach(func() {
+			tentative = createWithStatus("tentative@example.com", entity.ParticipantStatusTentative)
+			confirmed = createWithStatus("confirmed@example.com", entity.ParticipantStatusConfirmed)
+		})
+
+		It("should move the selected participants and return only those changed", func() {
+			updated, err := repo.UpdateStatus(ctx, eventID, []uuid.UUID{tentative.ID, confirmed.ID},
+				entity.ParticipantStatusConfirmed)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(ConsistOf(tentative.ID))
+			retrieved, err := repo.FindByID(ctx, tentative.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.Status).To(Equal(entity.ParticipantStatusConfirmed))
+		})
+
+		It("should ignore participants of other events", func() {
+			updated, err := repo.UpdateStatus(ctx, uuid.New(), []uuid.UUID{tentative.ID},
+				entity.ParticipantStatusConfirmed)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeEmpty())
+		})
+	})
+
+	Describe("AssignGroup and FindByFilter", func() {
+		var first, second *entity.Participant
+
+		createSeated := func(email string, groupName *string) *entity.Participant {
+			p := &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           eventID,
+				Name:              "Seated Participant",
+				Email:             email,
+				Status:            entity.ParticipantStatusConfirmed,
+				QRCode:            "qr_code_" + email,
+				QRCodeGeneratedAt: time.Now(),
+				PaymentStatus:     entity.PaymentUnpaid,
+				GroupName:         groupName,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(repo.Create(ctx, p)).To(Succeed())
+			return p
+		}
+
+		table := func(name string) *string { return &name }
+
+		BeforeEach(func() {
+			first = createSeated("first@example.com", table("Table 1"))
+			second = createSeated("second@example.com", nil)
+		})
+
+		It("should seat the selected participants and count only changes", func() {
+			updated, err := repo.AssignGroup(ctx, eventID, []uuid.UUID{first.ID, second.ID}, table("Table 1"))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal([]uuid.UUID{second.ID}))
+			retrieved, err := repo.FindByID(ctx, second.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.GroupName).To(Equal(table("Table 1")))
+		})
+
+		It("should clear the group with nil", func() {
+			updated, err := repo.AssignGroup(ctx, eventID, []uuid.UUID{first.ID}, nil)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal([]uuid.UUID{first.ID}))
+			retrieved, err := repo.FindByID(ctx, first.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retrieved.GroupName).To(BeNil())
+		})
+
+		It("should ignore participants of other events", func() {
+			updated, err := repo.AssignGroup(ctx, uuid.New(), []uuid.UUID{second.ID}, table("Table 2"))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeEmpty())
+		})
+
+		It("should list the participants of a group", func() {
+			participants, total, err := repo.FindByFilter(ctx, repository.ParticipantListFilter{
+				EventID: &eventID,
+				Group:   table("Table 1"),
+			}, 0, 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(Equal(int64(1)))
+			Expect(participants).To(HaveLen(1))
+			Expect(participants[0].ID).To(Equal(first.ID))
+		})
+
+		It("should combine the group with the other filters", func() {
+			cancelled := entity.ParticipantStatusCancelled
+
+			participants, total, err := repo.FindByFilter(ctx, repository.ParticipantListFilter{
+				EventID: &eventID,
+				Status:  &cancelled,
+				Group:   table("Table 1"),
+				Search:  "first",
+			}, 0, 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(BeZero())
+			Expect(participants).To(BeEmpty())
+		})
+	})
+
+	Describe("FindChangesSince", func() {
+		var first, second *entity.Participant
+
+		createSynced := func(email string) *entity.Participant {
+			p := &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           eventID,
+				Name:              "Synced Participant",
+				Email:             email,
+				Status:            entity.ParticipantStatusConfirmed,
+				QRCode:            "qr_code_" + email,
+				QRCodeGeneratedAt: time.Now(),
+				PaymentStatus:     entity.PaymentUnpaid,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(repo.Create(ctx, p)).To(Succeed())
+			return p
+		}
+
+		changedIDs := func(changes *repository.ParticipantChanges) []uuid.UUID {
+			ids := make([]uuid.UUID, len(changes.Participants))
+			for i, p := range changes.Participants {
+				ids[i] = p.ID
+			}
+			return ids
+		}
+
+		// sync returns the changes after cursor, asserting that a second call with the returned
+		// cursor finds nothing
+		sync := func(cursor time.Time) *repository.ParticipantChanges {
+			changes, err := repo.FindChangesSince(ctx, eventID, cursor)
+			Expect(err).NotTo(HaveOccurred())
+
+			again, err := repo.FindChangesSince(ctx, eventID, changes.Cursor)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(again.Participants).To(BeEmpty())
+			Expect(again.Deleted).To(BeEmpty())
+			Expect(again.Cursor).To(BeTemporally("==", changes.Cursor))
+			return changes
+		}
+
+		var cursor time.Time
+
+		BeforeEach(func() {
+			first = createSynced("first@example.com")
+			second = createSynced("second@example.com")
+			cursor = sync(time.Now().Add(-time.Hour)).Cursor
+		})
+
+		It("should return created participants", func() {
+			changes := sync(time.Now().Add(-time.Hour))
+
+			Expect(changedIDs(changes)).To(Equal([]uuid.UUID{first.ID, second.ID}))
+			Expect(changes.Deleted).To(BeEmpty())
+		})
+
+		It("should return only the participants changed after the cursor", func() {
+			third := createSynced("third@example.com")
+			first.Name = "Renamed Participant"
+			first.UpdatedAt = time.Now()
+			Expect(repo.Update(ctx, first)).To(Succeed())
+
+			changes := sync(cursor)
+
+			Expect(changedIDs(changes)).To(Equal([]uuid.UUID{third.ID, first.ID}))
+			Expect(changes.Participants[1].Name).To(Equal("Renamed Participant"))
+			Expect(changes.Cursor).To(BeTemporally(">", cursor))
+		})
+
+		It("should return deleted participants", func() {
+			Expect(repo.Delete(ctx, second.ID)).To(Succeed())
+
+			changes := sync(cursor)
+
+			Expect(changes.Participants).To(BeEmpty())
+			Expect(changes.Deleted).To(HaveLen(1))
+			Expect(changes.Deleted[0].ParticipantID).To(Equal(second.ID))
+		})
+
+		It("should return participants whose check-in changed", func() {
+			checkinRepo := database.NewCheckinRepository(db.GetPool())
+			checkin := &entity.Checkin{
+				ID:            uuid.New(),
+				EventID:       eventID,
+				ParticipantID: first.ID,
+				CheckedInAt:   time.Now(),
+				Method:        entity.CheckinMethodQRCode,
+			}
+			Expect(checkinRepo.Create(ctx, checkin)).To(Succeed())
+
+			changes := sync(cursor)
+			Expect(changedIDs(changes)).To(Equal([]uuid.UUID{first.ID}))
+			Expect(changes.Participants[0].CheckedInAt).NotTo(BeNil())
+
+			Expect(checkinRepo.Cancel(ctx, checkin.ID, organizerID, time.Now())).To(Succeed())
+
+			changes = sync(changes.Cursor)
+			Expect(changedIDs(changes)).To(Equal([]uuid.UUID{first.ID}))
+			Expect(changes.Participants[0].CheckedInAt).To(BeNil())
+		})
+
+		It("should ignore changes to other events", func() {
+			changes, err := repo.FindChangesSince(ctx, uuid.New(), time.Now().Add(-time.Hour))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes.Participants).To(BeEmpty())
+			Expect(changes.Deleted).To(BeEmpty())
+		})
+	})
+
 	Describe("Search", func() {
 		Context("with search results", func() {
 			It("should find participants by name", func() {
@@ -526,6 +1404,32 @@ var _ = Describe("ParticipantRepository", func() {
 				Expect(len(results)).To(Equal(1))
 				Expect(total).To(Equal(int64(1)))
 			})
+
+			It("should find participants by notes and return the notes", func() {
+				notes := "Needs wheelchair access"
+				participant := &entity.Participant{
+					ID:                uuid.New(),
+					EventID:           eventID,
+					Name:              "John Doe",
+					Email:             "john@example.com",
+					Status:            entity.ParticipantStatusTentative,
+					QRCode:            "qr_code_12345",
+					QRCodeGeneratedAt: time.Now(),
+					PaymentStatus:     entity.PaymentUnpaid,
+					Notes:             &notes,
+					CreatedAt:         time.Now(),
+					UpdatedAt:         time.Now(),
+				}
+
+				err := repo.Create(ctx, participant)
+				Expect(err).NotTo(HaveOccurred())
+
+				results, total, err := repo.Search(ctx, eventID, "wheelchair", 0, 10)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(len(results)).To(Equal(1))
+				Expect(total).To(Equal(int64(1)))
+				Expect(results[0].Notes).To(HaveValue(Equal(notes)))
+			})
 		})
 
 		Context("with no search results", func() {
@@ -538,6 +1442,113 @@ var _ = Describe("ParticipantRepository", func() {
 		})
 	})
 
+	Describe("SuggestByName", func() {
+		create := func(name string, status entity.ParticipantStatus) *entity.Participant {
+			participant := &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           eventID,
+				Name:              name,
+				Email:             strings.ReplaceAll(strings.ToLower(name), " ", ".") + "@example.com",
+				Status:            status,
+				QRCode:            "qr_code_" + uuid.NewString(),
+				QRCodeGeneratedAt: time.Now(),
+				PaymentStatus:     entity.PaymentUnpaid,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(repo.Create(ctx, participant)).To(Succeed())
+			return participant
+		}
+
+		names := func(suggestions []repository.ParticipantSuggestion) []string {
+			result := make([]string, len(suggestions))
+			for i, s := range suggestions {
+				result[i] = s.Name
+			}
+			return result
+		}
+
+		It("should match the name prefix ignoring case", func() {
+			taro := create("Taro Yamada", entity.ParticipantStatusConfirmed)
+			create("Takeshi Sato", entity.ParticipantStatusTentative)
+			create("Hanako Tanaka", entity.ParticipantStatusConfirmed)
+
+			suggestions, err := repo.SuggestByName(ctx, eventID, "tAR", 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(suggestions).To(Equal([]repository.ParticipantSuggestion{
+				{ID: taro.ID, Name: "Taro Yamada", Email: "taro.yamada@example.com"},
+			}))
+		})
+
+		It("should treat LIKE wildcards in the prefix literally", func() {
+			create("Ta_ro", entity.ParticipantStatusConfirmed)
+			create("Taxro", entity.ParticipantStatusConfirmed)
+			create("Tanaka", entity.ParticipantStatusConfirmed)
+
+			suggestions, err := repo.SuggestByName(ctx, eventID, "Ta_", 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names(suggestions)).To(Equal([]string{"Ta_ro"}))
+
+			suggestions, err = repo.SuggestByName(ctx, eventID, "%", 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(suggestions).To(BeEmpty())
+		})
+
+		It("should list participants who have not checked in first, then by name", func() {
+			checkinRepo := database.NewCheckinRepository(db.GetPool())
+			checkedIn := create("Taichi Ito", entity.ParticipantStatusConfirmed)
+			cancelledCheckin := create("Taiga Mori", entity.ParticipantStatusConfirmed)
+			create("Tadashi Kato", entity.ParticipantStatusConfirmed)
+			create("Taro Yamada", entity.ParticipantStatusInvited)
+			checkinIDs := make([]uuid.UUID, 0, 2)
+			for _, p := range []*entity.Participant{checkedIn, cancelledCheckin} {
+				checkin := &entity.Checkin{
+					ID:            uuid.New(),
+					EventID:       eventID,
+					ParticipantID: p.ID,
+					CheckedInAt:   time.Now(),
+					Method:        entity.CheckinMethodQRCode,
+				}
+				Expect(checkinRepo.Create(ctx, checkin)).To(Succeed())
+				checkinIDs = append(checkinIDs, checkin.ID)
+			}
+			Expect(checkinRepo.Cancel(ctx, checkinIDs[1], organizerID, time.Now())).To(Succeed())
+
+			suggestions, err := repo.SuggestByName(ctx, eventID, "Ta", 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names(suggestions)).To(Equal([]string{"Tadashi Kato", "Taiga Mori", "Taro Yamada", "Taichi Ito"}))
+			Expect(suggestions[3].CheckedIn).To(BeTrue())
+			Expect(suggestions[1].CheckedIn).To(BeFalse())
+		})
+
+		It("should leave out participants who cannot check in and deleted participants", func() {
+			create("Taro Cancelled", entity.ParticipantStatusCancelled)
+			create("Taro Declined", entity.ParticipantStatusDeclined)
+			create("Taro Expired", entity.ParticipantStatusExpired)
+			deleted := create("Taro Deleted", entity.ParticipantStatusConfirmed)
+			Expect(repo.Delete(ctx, deleted.ID)).To(Succeed())
+			create("Taro Tentative", entity.ParticipantStatusTentative)
+
+			suggestions, err := repo.SuggestByName(ctx, eventID, "Taro", 10)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names(suggestions)).To(Equal([]string{"Taro Tentative"}))
+		})
+
+		It("should return at most limit participants", func() {
+			for i := range 5 {
+				create(fmt.Sprintf("Taro %d", i), entity.ParticipantStatusConfirmed)
+			}
+
+			suggestions, err := repo.SuggestByName(ctx, eventID, "Taro", 3)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(names(suggestions)).To(Equal([]string{"Taro 0", "Taro 1", "Taro 2"}))
+		})
+	})
+
 	Describe("ExistsByEmail", func() {
 		Context("with existing email", func() {
 			It("should return true", func() {
@@ -573,6 +1584,105 @@ var _ = Describe("ParticipantRepository", func() {
 		})
 	})
 
+	Describe("FindByEmailAcrossOrganizer", func() {
+		var otherEventID uuid.UUID
+
+		createEvent := func(ownerID uuid.UUID, name string) uuid.UUID {
+			event := &entity.Event{
+				ID:          uuid.New(),
+				OrganizerID: ownerID,
+				Name:        name,
+				StartDate:   time.Now().Add(24 * time.Hour),
+				Status:      entity.StatusPublished,
+				CreatedAt:   time.Now(),
+				UpdatedAt:   time.Now(),
+			}
+			Expect(eventRepo.Create(ctx, event)).To(Succeed())
+			return event.ID
+		}
+
+		createParticipant := func(evtID uuid.UUID, email string) *entity.Participant {
+			participant := &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           evtID,
+				Name:              "Repeat Attendee",
+				Email:             email,
+				Status:            entity.ParticipantStatusConfirmed,
+				QRCode:            "qr_" + uuid.NewString(),
+				QRCodeGeneratedAt: time.Now(),
+				PaymentStatus:     entity.PaymentUnpaid,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(repo.Create(ctx, participant)).To(Succeed())
+			return participant
+		}
+
+		BeforeEach(func() {
+			otherOrganizer := &entity.User{
+				ID:           uuid.New(),
+				Email:        fmt.Sprintf("other_%s@example.com", uuid.NewString()[:8]),
+				PasswordHash: "hashed_password",
+				Name:         "Other Organizer",
+				Role:         entity.RoleOrganizer,
+				CreatedAt:    time.Now(),
+				UpdatedAt:    time.Now(),
+			}
+			Expect(userRepo.Create(ctx, otherOrganizer)).To(Succeed())
+			otherEventID = createEvent(otherOrganizer.ID, "Other Organizer Event")
+		})
+
+		It("should return one row per event of the organizer", func() {
+			secondEventID := createEvent(organizerID, "Second Event")
+			first := createParticipant(eventID, "repeat@example.com")
+			second := createParticipant(secondEventID, "repeat@example.com")
+			createParticipant(otherEventID, "repeat@example.com")
+			createParticipant(eventID, "someone-else@example.com")
+
+			found, err := repo.FindByEmailAcrossOrganizer(ctx, &organizerID, "repeat@example.com")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(HaveLen(2))
+			Expect([]uuid.UUID{found[0].ID, found[1].ID}).To(ConsistOf(first.ID, second.ID))
+		})
+
+		It("should search every organizer's events when no organizer is given", func() {
+			createParticipant(eventID, "repeat@example.com")
+			createParticipant(otherEventID, "repeat@example.com")
+
+			found, err := repo.FindByEmailAcrossOrganizer(ctx, nil, "repeat@example.com")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(HaveLen(2))
+		})
+
+		It("should return an empty list when no participant has the email", func() {
+			found, err := repo.FindByEmailAcrossOrganizer(ctx, &organizerID, "nobody@example.com")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeEmpty())
+		})
+	})
+
+	Describe("ExistingQRCodes", func() {
+		It("should return only the codes already assigned", func() {
+			participant := &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           eventID,
+				Name:              "John Doe",
+				Email:             "john@example.com",
+				Status:            entity.ParticipantStatusTentative,
+				QRCode:            "qr_code_taken",
+				QRCodeGeneratedAt: time.Now(),
+				PaymentStatus:     entity.PaymentUnpaid,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(repo.Create(ctx, participant)).To(Succeed())
+
+			existing, err := repo.ExistingQRCodes(ctx, []string{"qr_code_taken", "qr_code_free"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(existing).To(ConsistOf("qr_code_taken"))
+		})
+	})
+
 	Describe("GetPaymentStats", func() {
 		Context("with mixed payment statuses", func() {
 			It("should return correct payment statistics", func() {
@@ -592,7 +1702,7 @@ var _ = Describe("ParticipantRepository", func() {
 					}
 					if i == 0 {
 						participant.PaymentStatus = entity.PaymentPaid
-						amount := 1500.00
+						amount := money.FromMinorUnits(150000)
 						participant.PaymentAmount = &amount
 					}
 					err := repo.Create(ctx, participant)
@@ -604,7 +1714,153 @@ var _ = Describe("ParticipantRepository", func() {
 				Expect(stats.TotalParticipants).To(Equal(int64(3)))
 				Expect(stats.PaidParticipants).To(Equal(int64(1)))
 				Expect(stats.UnpaidParticipants).To(Equal(int64(2)))
-				Expect(stats.TotalPaymentAmount).To(BeNumerically("~", 1500.00))
+				Expect(stats.TotalPaymentAmount).To(Equal(money.FromMinorUnits(150000)))
+			})
+		})
+
+		Context("with confirmed participants owing mixed amounts", func() {
+			It("should compute expected, collected and outstanding totals", func() {
+				type row struct {
+					status        entity.ParticipantStatus
+					paymentStatus entity.PaymentStatus
+					amount        *int64
+				}
+				minor := func(v int64) *int64 { return &v }
+				rows := []row{
+					{entity.ParticipantStatusConfirmed, entity.PaymentPaid, minor(100000)},
+					{entity.ParticipantStatusConfirmed, entity.PaymentUnpaid, minor(250000)},
+					{entity.ParticipantStatusConfirmed, entity.PaymentUnpaid, nil},
+					{entity.ParticipantStatusTentative, entity.PaymentPaid, minor(50000)},
+					{entity.ParticipantStatusCancelled, entity.PaymentUnpaid, minor(70000)},
+				}
+				for i, r := range rows {
+					participant := &entity.Participant{
+						ID:                uuid.New(),
+						EventID:           eventID,
+						Name:              fmt.Sprintf("Participant %d", i),
+						Email:             fmt.Sprintf("mixed%d@example.com", i),
+						Status:            r.status,
+						QRCode:            fmt.Sprintf("qr_mixed_%d", i),
+						QRCodeGeneratedAt: time.Now(),
+						PaymentStatus:     r.paymentStatus,
+						CreatedAt:         time.Now(),
+						UpdatedAt:         time.Now(),
+					}
+					if r.amount != nil {
+						amount := money.FromMinorUnits(*r.amount)
+						participant.PaymentAmount = &amount
+					}
+					Expect(repo.Create(ctx, participant)).To(Succeed())
+				}
+
+				stats, err := repo.GetPaymentStats(ctx, eventID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stats.ExpectedPaymentAmount).To(Equal(money.FromMinorUnits(350000)))
+				Expect(stats.TotalPaymentAmount).To(Equal(money.FromMinorUnits(150000)))
+				Expect(stats.OutstandingPaymentAmount).To(Equal(money.FromMinorUnits(250000)))
+				Expect(stats.UnpricedParticipants).To(Equal(int64(1)))
+				Expect(stats.ConfirmedParticipants).To(Equal(int64(3)))
+				Expect(stats.TentativeParticipants).To(Equal(int64(1)))
+				Expect(stats.DeclinedParticipants).To(BeZero())
+			})
+		})
+
+		Context("with a fixed event fee", func() {
+			It("should expect the fixed fee from confirmed participants without an amount", func() {
+				event, err := eventRepo.FindByID(ctx, eventID)
+				Expect(err).NotTo(HaveOccurred())
+				event.Currency = "JPY"
+				event.FeeType = entity.FeeTypeFixed
+				event.FeeAmount = money.FromMinorUnits(300000)
+				Expect(eventRepo.Update(ctx, event)).To(Succeed())
+
+				discounted := money.FromMinorUnits(200000)
+				for i, amount := range []*money.Amount{nil, &discounted} {
+					participant := &entity.Participant{
+						ID:                uuid.New(),
+						EventID:           eventID,
+						Name:              fmt.Sprintf("Participant %d", i),
+						Email:             fmt.Sprintf("fixed%d@example.com", i),
+						Status:            entity.ParticipantStatusConfirmed,
+						QRCode:            fmt.Sprintf("qr_fixed_%d", i),
+						QRCodeGeneratedAt: time.Now(),
+						PaymentStatus:     entity.PaymentUnpaid,
+						PaymentAmount:     amount,
+						CreatedAt:         time.Now(),
+						UpdatedAt:         time.Now(),
+					}
+					Expect(repo.Create(ctx, participant)).To(Succeed())
+				}
+
+				stats, err := repo.GetPaymentStats(ctx, eventID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stats.ExpectedPaymentAmount).To(Equal(money.FromMinorUnits(500000)))
+				Expect(stats.OutstandingPaymentAmount).To(Equal(money.FromMinorUnits(500000)))
+				Expect(stats.UnpricedParticipants).To(BeZero())
+			})
+		})
+
+		Context("with no participants", func() {
+			It("should return zero totals", func() {
+				stats, err := repo.GetPaymentStats(ctx, eventID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stats.TotalParticipants).To(BeZero())
+				Expect(stats.ExpectedPaymentAmount).To(BeZero())
+				Expect(stats.TotalPaymentAmount).To(BeZero())
+				Expect(stats.OutstandingPaymentAmount).To(BeZero())
+			})
+		})
+	})
+
+	Describe("GetListLastModified", func() {
+		var participant *entity.Participant
+
+		BeforeEach(func() {
+			participant = &entity.Participant{
+				ID:                uuid.New(),
+				EventID:           eventID,
+				Name:              "John Doe",
+				Email:             "john@example.com",
+				Status:            entity.ParticipantStatusTentative,
+				QRCode:            "qr_code_last_modified",
+				QRCodeGeneratedAt: time.Now(),
+				PaymentStatus:     entity.PaymentUnpaid,
+				CreatedAt:         time.Now(),
+				UpdatedAt:         time.Now(),
+			}
+			Expect(repo.Create(ctx, participant)).To(Succeed())
+		})
+
+		Context("without intervening changes", func() {
+			It("should return the same timestamp on repeated calls", func() {
+				first, err := repo.GetListLastModified(ctx, eventID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(first.IsZero()).To(BeFalse())
+
+				second, err := repo.GetListLastModified(ctx, eventID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(second).To(Equal(first))
+			})
+		})
+
+		Context("when a participant is deleted", func() {
+			It("should advance the timestamp", func() {
+				before, err := repo.GetListLastModified(ctx, eventID)
+				Expect(err).NotTo(HaveOccurred())
+
+				time.Sleep(10 * time.Millisecond)
+				Expect(repo.Delete(ctx, participant.ID)).To(Succeed())
+
+				after, err := repo.GetListLastModified(ctx, eventID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(after).To(BeTemporally(">", before))
+			})
+		})
+
+		Context("with a non-existent event", func() {
+			It("should return not found error", func() {
+				_, err := repo.GetListLastModified(ctx, uuid.New())
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
 			})
 		})
 	})
@@ -0,0 +1,121 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// paymentRepository implements the PaymentRepository interface.
+type paymentRepository struct {
+	pool *pgxpool.Pool
+}
+
+// NewPaymentRepository creates a new payment repository.
+func NewPaymentRepository(pool *pgxpool.Pool) repository.PaymentRepository {
+	return &paymentRepository{pool: pool}
+}
+
+// Create records a payment, rejecting a second unrefunded payment of the participant or reference.
+func (r *paymentRepository) Create(ctx context.Context, payment *entity.Payment) error {
+	if err := payment.Validate(); err != nil {
+		return apperrors.Wrapf(err, "invalid payment")
+	}
+
+	query := `
+		INSERT INTO payments (
+			id, event_id, participant_id, amount, currency, method, reference, recorded_by, recorded_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, NULLIF($7, ''), $8, $9
+		)
+	`
+
+	q := GetQueryable(ctx, r.pool)
+	_, err := q.Exec(ctx, query,
+		payment.ID,
+		payment.EventID,
+		payment.ParticipantID,
+		payment.Amount,
+		payment.Currency,
+		payment.Method,
+		payment.Reference,
+		payment.RecordedBy,
+		payment.RecordedAt,
+	)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == pgErrCodeUniqueViolation {
+			switch pgErr.ConstraintName {
+			case "unique_participant_active_payment":
+				return apperrors.Conflict("participant has already paid")
+			case "unique_event_active_payment_reference":
+				return apperrors.Conflict("a payment with this reference was already recorded for the event")
+			}
+		}
+		return apperrors.Wrapf(err, "failed to record payment")
+	}
+
+	return nil
+}
+
+// FindActiveByParticipant finds the unrefunded payment of a participant.
+func (r *paymentRepository) FindActiveByParticipant(
+	ctx context.Context,
+	participantID uuid.UUID,
+) (*entity.Payment, error) {
+	query := `
+		SELECT
+			id, event_id, participant_id, amount, currency, method, COALESCE(reference, ''),
+			recorded_by, recorded_at, refunded_by, refunded_at
+		FROM payments
+		WHERE participant_id = $1 AND refunded_at IS NULL
+	`
+
+	var payment entity.Payment
+	err := GetQueryable(ctx, r.pool).QueryRow(ctx, query, participantID).Scan(
+		&payment.ID,
+		&payment.EventID,
+		&payment.ParticipantID,
+		&payment.Amount,
+		&payment.Currency,
+		&payment.Method,
+		&payment.Reference,
+		&payment.RecordedBy,
+		&payment.RecordedAt,
+		&payment.RefundedBy,
+		&payment.RefundedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.NotFound("payment not found")
+		}
+		return nil, apperrors.Wrapf(err, "failed to find payment")
+	}
+
+	return &payment, nil
+}
+
+// Refund marks an unrefunded payment as refunded.
+func (r *paymentRepository) Refund(ctx context.Context, id, refundedBy uuid.UUID, refundedAt time.Time) error {
+	query := `
+		UPDATE payments SET refunded_by = $2, refunded_at = $3
+		WHERE id = $1 AND refunded_at IS NULL
+	`
+
+	result, err := GetQueryable(ctx, r.pool).Exec(ctx, query, id, refundedBy, refundedAt)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to refund payment")
+	}
+	if result.RowsAffected() == 0 {
+		return apperrors.NotFound("payment not found")
+	}
+	return nil
+}
@@ -0,0 +1,187 @@
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PaymentRepository", func() {
+	var (
+		ctx             context.Context
+		db              *database.PostgresDB
+		repo            repository.PaymentRepository
+		participantRepo repository.ParticipantRepository
+		organizerID     uuid.UUID
+		eventID         uuid.UUID
+		participantID   uuid.UUID
+	)
+
+	newPayment := func(participantID uuid.UUID, reference string) *entity.Payment {
+		return &entity.Payment{
+			ID:            uuid.New(),
+			EventID:       eventID,
+			ParticipantID: participantID,
+			Amount:        money.FromMinorUnits(150000),
+			Currency:      "JPY",
+			Method:        entity.PaymentMethodBankTransfer,
+			Reference:     reference,
+			RecordedBy:    organizerID,
+			RecordedAt:    time.Now().UTC().Truncate(time.Microsecond),
+		}
+	}
+
+	createParticipant := func(email string) uuid.UUID {
+		p := &entity.Participant{
+			ID:                uuid.New(),
+			EventID:           eventID,
+			Name:              "Participant " + email,
+			Email:             email,
+			Status:            entity.ParticipantStatusConfirmed,
+			QRCode:            "qr_code_" + uuid.NewString(),
+			QRCodeGeneratedAt: time.Now(),
+			PaymentStatus:     entity.PaymentUnpaid,
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+		}
+		Expect(participantRepo.Create(ctx, p)).To(Succeed())
+		return p.ID
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		log, _ := logger.New(logger.Config{
+			Level:       "info",
+			Format:      "console",
+			Environment: "development",
+		})
+		cfg := &config.DatabaseConfig{
+			Host:            "postgres",
+			Port:            5432,
+			User:            "ezqrin",
+			Password:        "ezqrin_dev",
+			Name:            "ezqrin_test",
+			SSLMode:         "disable",
+			MaxConns:        25,
+			MinConns:        5,
+			MaxConnLifetime: time.Hour,
+			MaxConnIdleTime: 30 * time.Minute,
+		}
+
+		var err error
+		db, err = database.NewPostgresDB(ctx, cfg, log)
+		Expect(err).NotTo(HaveOccurred())
+		repo = database.NewPaymentRepository(db.GetPool())
+		participantRepo = database.NewParticipantRepository(db.GetPool(), log)
+
+		organizerID = uuid.New()
+		Expect(database.NewUserRepository(db.GetPool(), log).Create(ctx, &entity.User{
+			ID:           organizerID,
+			Email:        fmt.Sprintf("organizer_%s@example.com", organizerID.String()[:8]),
+			PasswordHash: "hashed_password",
+			Name:         "Organizer User",
+			Role:         entity.RoleOrganizer,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		})).To(Succeed())
+
+		eventID = uuid.New()
+		Expect(database.NewEventRepository(db.GetPool(), log).Create(ctx, &entity.Event{
+			ID:          eventID,
+			OrganizerID: organizerID,
+			Name:        "Paid Workshop",
+			StartDate:   time.Now().Add(24 * time.Hour),
+			Timezone:    "Asia/Tokyo",
+			Status:      entity.StatusPublished,
+			Currency:    "JPY",
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		})).To(Succeed())
+
+		participantID = createParticipant("jane@example.com")
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			pool := db.GetPool()
+			_, _ = pool.Exec(ctx, "TRUNCATE TABLE users CASCADE")
+			_, _ = pool.Exec(ctx, "TRUNCATE TABLE events CASCADE")
+			db.Close()
+		}
+	})
+
+	When("recording a payment", func() {
+		It("should store it as the participant's active payment", func() {
+			payment := newPayment(participantID, "TX-001")
+			Expect(repo.Create(ctx, payment)).To(Succeed())
+
+			found, err := repo.FindActiveByParticipant(ctx, participantID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found.ID).To(Equal(payment.ID))
+			Expect(found.Amount).To(Equal(payment.Amount))
+			Expect(found.Method).To(Equal(entity.PaymentMethodBankTransfer))
+			Expect(found.Reference).To(Equal("TX-001"))
+			Expect(found.RefundedAt).To(BeNil())
+		})
+
+		It("should reject a second payment of the participant", func() {
+			Expect(repo.Create(ctx, newPayment(participantID, ""))).To(Succeed())
+
+			err := repo.Create(ctx, newPayment(participantID, ""))
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+		})
+
+		It("should reject a reference already recorded for the event", func() {
+			Expect(repo.Create(ctx, newPayment(participantID, "TX-001"))).To(Succeed())
+
+			err := repo.Create(ctx, newPayment(createParticipant("john@example.com"), "TX-001"))
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+		})
+	})
+
+	When("refunding a payment", func() {
+		It("should free the participant and the reference for a new payment", func() {
+			payment := newPayment(participantID, "TX-001")
+			Expect(repo.Create(ctx, payment)).To(Succeed())
+
+			Expect(repo.Refund(ctx, payment.ID, organizerID, time.Now())).To(Succeed())
+
+			_, err := repo.FindActiveByParticipant(ctx, participantID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			Expect(repo.Create(ctx, newPayment(participantID, "TX-001"))).To(Succeed())
+		})
+
+		It("should count the refund in the payment stats", func() {
+			payment := newPayment(participantID, "")
+			Expect(repo.Create(ctx, payment)).To(Succeed())
+			Expect(repo.Refund(ctx, payment.ID, organizerID, time.Now())).To(Succeed())
+
+			stats, err := participantRepo.GetPaymentStats(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats.RefundedPaymentAmount).To(Equal(money.FromMinorUnits(150000)))
+		})
+
+		It("should return not found for a payment already refunded", func() {
+			payment := newPayment(participantID, "")
+			Expect(repo.Create(ctx, payment)).To(Succeed())
+			Expect(repo.Refund(ctx, payment.ID, organizerID, time.Now())).To(Succeed())
+
+			err := repo.Refund(ctx, payment.ID, organizerID, time.Now())
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -17,6 +17,7 @@
 //		MinConns:        5,
 //		ConnMaxLifetime: time.Hour,
 //		ConnMaxIdleTime: 30 * time.Minute,
+//		QueryTimeout:    30 * time.Second,
 //	}
 //
 //	db, err := database.NewPostgresDB(ctx, cfg, logger)
@@ -33,12 +34,15 @@ package database
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/exaring/otelpgx"
 	"github.com/fumkob/ezqrin-server/config"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/multitracer"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"go.uber.org/zap"
 )
@@ -77,7 +81,7 @@ func NewPostgresDB(ctx context.Context, cfg *config.DatabaseConfig, log *logger.
 	poolConfig.MinConns = int32(cfg.MinConns)
 	poolConfig.MaxConnLifetime = cfg.MaxConnLifetime
 	poolConfig.MaxConnIdleTime = cfg.MaxConnIdleTime
-	poolConfig.ConnConfig.Tracer = otelpgx.NewTracer()
+	poolConfig.ConnConfig.Tracer = newTracer(cfg.QueryTimeout)
 
 	// Create connection pool
 	pool, err := pgxpool.NewWithConfig(ctx, poolConfig)
@@ -105,6 +109,16 @@ func NewPostgresDB(ctx context.Context, cfg *config.DatabaseConfig, log *logger.
 	}, nil
 }
 
+// newTracer returns the tracer of the pool connections: OpenTelemetry spans for every statement,
+// preceded by the per-statement timeout when queryTimeout is positive.
+func newTracer(queryTimeout time.Duration) pgx.QueryTracer {
+	if queryTimeout <= 0 {
+		return otelpgx.NewTracer()
+	}
+	// The timeout tracer comes first so the spans are started from the bounded context
+	return multitracer.New(&queryTimeoutTracer{timeout: queryTimeout}, otelpgx.NewTracer())
+}
+
 // buildConnectionString constructs a PostgreSQL connection string from config
 func buildConnectionString(cfg *config.DatabaseConfig) string {
 	return fmt.Sprintf(
@@ -5,6 +5,8 @@ package database_test
 
 import (
 	"context"
+	"errors"
+	"net/http"
 	"os"
 	"time"
 
@@ -127,6 +129,50 @@ var _ = Describe("PostgresDB", func() {
 				Expect(err).To(BeNil())
 			})
 		})
+
+		// Integration test
+		Describe("with a query timeout", func() {
+			var db *database.PostgresDB
+
+			BeforeEach(func() {
+				timeoutCfg := *cfg
+				timeoutCfg.QueryTimeout = 100 * time.Millisecond
+				var err error
+				db, err = database.NewPostgresDB(ctx, &timeoutCfg, log)
+				Expect(err).To(BeNil())
+			})
+
+			AfterEach(func() {
+				if db != nil {
+					db.Close()
+				}
+			})
+
+			It("should fail queries running longer with a timeout error", func() {
+				_, err := db.GetPool().Exec(ctx, "SELECT pg_sleep(2)")
+
+				Expect(apperrors.IsTimeout(err)).To(BeTrue())
+				Expect(apperrors.GetStatusCode(err)).To(Equal(http.StatusServiceUnavailable))
+			})
+
+			It("should run queries within the timeout, each with its own deadline", func() {
+				for range 3 {
+					var one int
+					Expect(db.GetPool().QueryRow(ctx, "SELECT 1 FROM pg_sleep(0.05)").Scan(&one)).To(Succeed())
+					Expect(one).To(Equal(1))
+				}
+			})
+
+			It("should still report cancellations of the caller as such", func() {
+				cancelled, cancel := context.WithCancel(ctx)
+				cancel()
+
+				_, err := db.GetPool().Exec(cancelled, "SELECT 1")
+
+				Expect(errors.Is(err, context.Canceled)).To(BeTrue())
+				Expect(apperrors.IsTimeout(err)).To(BeFalse())
+			})
+		})
 	})
 
 	When("closing database connection", func() {
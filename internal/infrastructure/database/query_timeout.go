@@ -0,0 +1,75 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// queryCancelKey is the context key of the function releasing the timeout of a query
+type queryCancelKey struct{}
+
+// queryTimeoutTracer bounds every query, batch and copy with its own timeout, so a hung
+// statement fails with context.DeadlineExceeded instead of holding its connection forever.
+// pgx runs each statement with the context returned by the start hook and calls the end hook
+// once the statement is done, i.e. after the rows of a query are closed; the timeout therefore
+// covers reading the rows too. Cancellation of the caller's context still applies.
+type queryTimeoutTracer struct {
+	timeout time.Duration
+}
+
+// Verify that queryTimeoutTracer traces every kind of statement at compile time
+var (
+	_ pgx.QueryTracer    = (*queryTimeoutTracer)(nil)
+	_ pgx.BatchTracer    = (*queryTimeoutTracer)(nil)
+	_ pgx.CopyFromTracer = (*queryTimeoutTracer)(nil)
+)
+
+// TraceQueryStart returns the context of the query, limited to the timeout.
+func (t *queryTimeoutTracer) TraceQueryStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryStartData) context.Context {
+	return t.start(ctx)
+}
+
+// TraceQueryEnd releases the timeout of the query.
+func (t *queryTimeoutTracer) TraceQueryEnd(ctx context.Context, _ *pgx.Conn, _ pgx.TraceQueryEndData) {
+	t.end(ctx)
+}
+
+// TraceBatchStart returns the context of the batch, limited to the timeout.
+func (t *queryTimeoutTracer) TraceBatchStart(ctx context.Context, _ *pgx.Conn, _ pgx.TraceBatchStartData) context.Context {
+	return t.start(ctx)
+}
+
+// TraceBatchQuery does nothing: the timeout covers the batch as a whole.
+func (t *queryTimeoutTracer) TraceBatchQuery(context.Context, *pgx.Conn, pgx.TraceBatchQueryData) {}
+
+// TraceBatchEnd releases the timeout of the batch.
+func (t *queryTimeoutTracer) TraceBatchEnd(ctx context.Context, _ *pgx.Conn, _ pgx.TraceBatchEndData) {
+	t.end(ctx)
+}
+
+// TraceCopyFromStart returns the context of the copy, limited to the timeout.
+func (t *queryTimeoutTracer) TraceCopyFromStart(
+	ctx context.Context,
+	_ *pgx.Conn,
+	_ pgx.TraceCopyFromStartData,
+) context.Context {
+	return t.start(ctx)
+}
+
+// TraceCopyFromEnd releases the timeout of the copy.
+func (t *queryTimeoutTracer) TraceCopyFromEnd(ctx context.Context, _ *pgx.Conn, _ pgx.TraceCopyFromEndData) {
+	t.end(ctx)
+}
+
+func (t *queryTimeoutTracer) start(ctx context.Context) context.Context {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	return context.WithValue(ctx, queryCancelKey{}, cancel)
+}
+
+func (t *queryTimeoutTracer) end(ctx context.Context) {
+	if cancel, ok := ctx.Value(queryCancelKey{}).(context.CancelFunc); ok {
+		cancel()
+	}
+}
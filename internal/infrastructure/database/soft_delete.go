@@ -0,0 +1,70 @@
+package database
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Events and participants are soft deleted: deleting a row stamps its deleted_at column instead
+// of removing it, and restoring the row clears the stamp again. Every read path of these tables
+// selects live rows only, through live, so a deleted row never shows up outside the tombstone
+// helpers below. Check-ins are not soft deleted themselves; they are hidden while their
+// participant is deleted, see liveCheckin.
+
+// live returns the condition that selects the rows of alias, a soft-deletable table or its
+// alias in the query, that are not deleted.
+func live(alias string) string {
+	return alias + ".deleted_at IS NULL"
+}
+
+// liveCheckin returns the condition that selects the check-ins of alias whose participant is not
+// deleted. Check-ins are kept when their participant is deleted, so restoring the participant
+// brings its check-in back too.
+func liveCheckin(alias string) string {
+	return fmt.Sprintf(
+		"EXISTS (SELECT 1 FROM participants lp WHERE lp.id = %s.participant_id AND %s)", alias, live("lp"),
+	)
+}
+
+// tombstone soft deletes the live rows of table selected by where, stamping them with deletedAt.
+// where refers to the table's columns unqualified; its args are numbered from $2. It returns the
+// number of rows deleted.
+func tombstone(
+	ctx context.Context,
+	q Queryable,
+	table, where string,
+	deletedAt time.Time,
+	args ...interface{},
+) (int64, error) {
+	query := fmt.Sprintf(`UPDATE %s SET deleted_at = $1 WHERE (%s) AND %s`, table, where, live(table))
+
+	result, err := q.Exec(ctx, query, append([]interface{}{deletedAt}, args...)...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+// restore clears the tombstones of the deleted rows of table selected by where. Restored rows
+// are stamped as updated at restoredAt, so change tracking reports them again. where refers to
+// the table's columns unqualified; its args are numbered from $2. It returns the number of rows
+// restored.
+func restore(
+	ctx context.Context,
+	q Queryable,
+	table, where string,
+	restoredAt time.Time,
+	args ...interface{},
+) (int64, error) {
+	query := fmt.Sprintf(`
+		UPDATE %s SET deleted_at = NULL, updated_at = $1
+		WHERE (%s) AND %s.deleted_at IS NOT NULL
+	`, table, where, table)
+
+	result, err := q.Exec(ctx, query, append([]interface{}{restoredAt}, args...)...)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
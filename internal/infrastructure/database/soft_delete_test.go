@@ -0,0 +1,508 @@
+//go:build integration
+// +build integration
+
+package database_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Soft delete", func() {
+	var (
+		ctx             context.Context
+		db              *database.PostgresDB
+		eventRepo       repository.EventRepository
+		participantRepo repository.ParticipantRepository
+		checkinRepo     repository.CheckinRepository
+		organizerID     uuid.UUID
+		eventID         uuid.UUID
+		registrant      *entity.Participant
+		guest           *entity.Participant
+		other           *entity.Participant
+		checkin         *entity.Checkin
+	)
+
+	createParticipant := func(name, email string, guestOf *uuid.UUID) *entity.Participant {
+		employeeID := "EMP-" + name
+		p := &entity.Participant{
+			ID:                uuid.New(),
+			EventID:           eventID,
+			Name:              name,
+			Email:             email,
+			EmployeeID:        &employeeID,
+			Status:            entity.ParticipantStatusConfirmed,
+			QRCode:            "qr_code_" + uuid.NewString(),
+			QRCodeGeneratedAt: time.Now(),
+			PaymentStatus:     entity.PaymentUnpaid,
+			GuestOf:           guestOf,
+			CreatedAt:         time.Now(),
+			UpdatedAt:         time.Now(),
+		}
+		Expect(participantRepo.Create(ctx, p)).To(Succeed())
+		return p
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		log, _ := logger.New(logger.Config{
+			Level:       "info",
+			Format:      "console",
+			Environment: "development",
+		})
+		cfg := &config.DatabaseConfig{
+			Host:            "postgres",
+			Port:            5432,
+			User:            "ezqrin",
+			Password:        "ezqrin_dev",
+			Name:            "ezqrin_test",
+			SSLMode:         "disable",
+			MaxConns:        25,
+			MinConns:        5,
+			MaxConnLifetime: time.Hour,
+			MaxConnIdleTime: 30 * time.Minute,
+		}
+
+		var err error
+		db, err = database.NewPostgresDB(ctx, cfg, log)
+		Expect(err).To(BeNil())
+
+		eventRepo = database.NewEventRepository(db.GetPool(), log)
+		participantRepo = database.NewParticipantRepository(db.GetPool(), log)
+		checkinRepo = database.NewCheckinRepository(db.GetPool())
+		userRepo := database.NewUserRepository(db.GetPool(), log)
+
+		organizerID = uuid.New()
+		Expect(userRepo.Create(ctx, &entity.User{
+			ID:           organizerID,
+			Email:        fmt.Sprintf("organizer_%s@example.com", organizerID.String()[:8]),
+			PasswordHash: "hashed_password",
+			Name:         "Organizer User",
+			Role:         entity.RoleOrganizer,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		})).To(Succeed())
+
+		eventID = uuid.New()
+		Expect(eventRepo.Create(ctx, &entity.Event{
+			ID:          eventID,
+			OrganizerID: organizerID,
+			Name:        "Soft Delete Event",
+			StartDate:   time.Now().Add(24 * time.Hour),
+			Status:      entity.StatusPublished,
+			CreatedAt:   time.Now(),
+			UpdatedAt:   time.Now(),
+		})).To(Succeed())
+
+		registrant = createParticipant("Registrant", "registrant@example.com", nil)
+		guest = createParticipant("Guest", "", &registrant.ID)
+		other = createParticipant("Other", "other@example.com", nil)
+
+		checkin = &entity.Checkin{
+			ID:            uuid.New(),
+			EventID:       eventID,
+			ParticipantID: registrant.ID,
+			CheckedInAt:   time.Now(),
+			Method:        entity.CheckinMethodQRCode,
+		}
+		Expect(checkinRepo.Create(ctx, checkin)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		if db != nil {
+			pool := db.GetPool()
+			_, _ = pool.Exec(ctx, "TRUNCATE TABLE users CASCADE")
+			_, _ = pool.Exec(ctx, "TRUNCATE TABLE events CASCADE")
+			_, _ = pool.Exec(ctx, "TRUNCATE TABLE participant_deletions, event_deletions")
+			db.Close()
+		}
+	})
+
+	expectParticipantHidden := func(p *entity.Participant) {
+		_, err := participantRepo.FindByID(ctx, p.ID)
+		Expect(apperrors.IsNotFound(err)).To(BeTrue())
+
+		_, err = participantRepo.FindByQRCode(ctx, p.QRCode)
+		Expect(apperrors.IsNotFound(err)).To(BeTrue())
+
+		_, err = participantRepo.FindByEmployeeID(ctx, eventID, *p.EmployeeID)
+		Expect(apperrors.IsNotFound(err)).To(BeTrue())
+
+		found, err := participantRepo.FindByIDs(ctx, []uuid.UUID{p.ID})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeEmpty())
+	}
+
+	participantIDs := func(participants []*entity.Participant) []uuid.UUID {
+		ids := make([]uuid.UUID, 0, len(participants))
+		for _, p := range participants {
+			ids = append(ids, p.ID)
+		}
+		return ids
+	}
+
+	Describe("deleting a participant", func() {
+		BeforeEach(func() {
+			Expect(participantRepo.Delete(ctx, registrant.ID)).To(Succeed())
+		})
+
+		It("should hide the participant and their guests from every read path", func() {
+			expectParticipantHidden(registrant)
+			expectParticipantHidden(guest)
+
+			listed, total, err := participantRepo.FindByEventID(ctx, eventID, 0, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(participantIDs(listed)).To(ConsistOf(other.ID))
+			Expect(total).To(BeEquivalentTo(1))
+
+			all, err := participantRepo.FindAllByEventID(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(participantIDs(all)).To(ConsistOf(other.ID))
+
+			searched, total, err := participantRepo.Search(ctx, eventID, "Registrant", 0, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(searched).To(BeEmpty())
+			Expect(total).To(BeZero())
+
+			byEmail, err := participantRepo.FindByEmailAcrossOrganizer(ctx, &organizerID, registrant.Email)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(byEmail).To(BeEmpty())
+
+			exists, err := participantRepo.ExistsByEmail(ctx, eventID, registrant.Email)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeFalse())
+
+			payments, err := participantRepo.GetPaymentStats(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(payments.TotalParticipants).To(BeEquivalentTo(1))
+
+			tagged, err := participantRepo.UpdateTags(ctx, eventID, repository.ParticipantTagFilter{
+				IDs: []uuid.UUID{registrant.ID, other.ID},
+			}, []string{"vip"}, nil, entity.ParticipantMaxTags)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tagged).To(BeEquivalentTo(1))
+
+			registrant.Name = "Renamed"
+			Expect(apperrors.IsNotFound(participantRepo.Update(ctx, registrant))).To(BeTrue())
+			Expect(apperrors.IsNotFound(
+				participantRepo.RecordConsent(ctx, registrant.ID, "v1", time.Now()),
+			)).To(BeTrue())
+		})
+
+		It("should hide the participant's check-in", func() {
+			_, err := checkinRepo.FindByID(ctx, checkin.ID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+
+			_, err = checkinRepo.FindByParticipant(ctx, registrant.ID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+
+			checkins, total, err := checkinRepo.FindByEvent(ctx, eventID, 10, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(checkins).To(BeEmpty())
+			Expect(total).To(BeZero())
+
+			progress, err := checkinRepo.GetEventProgress(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(progress.CheckedInCount).To(BeZero())
+			Expect(progress.TotalParticipants).To(BeEquivalentTo(1))
+
+			attribution, err := checkinRepo.CountByStaff(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(attribution.SelfCheckins).To(BeZero())
+
+			event, err := eventRepo.FindByID(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.ParticipantCount).To(BeEquivalentTo(1))
+			Expect(event.CheckedInCount).To(BeZero())
+
+			stats, err := eventRepo.GetStats(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stats.TotalParticipants).To(BeEquivalentTo(1))
+			Expect(stats.GuestCount).To(BeZero())
+			Expect(stats.CheckedInCount).To(BeZero())
+		})
+
+		It("should report the participant as deleted in the changes feed", func() {
+			changes, err := participantRepo.FindChangesSince(ctx, eventID, time.Now().Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(participantIDs(changes.Participants)).To(ConsistOf(other.ID))
+
+			deleted := make([]uuid.UUID, 0, len(changes.Deleted))
+			for _, d := range changes.Deleted {
+				deleted = append(deleted, d.ParticipantID)
+			}
+			Expect(deleted).To(ConsistOf(registrant.ID, guest.ID))
+		})
+
+		It("should free the participant's email for a new registration", func() {
+			replacement := createParticipant("Replacement", registrant.Email, nil)
+
+			err := participantRepo.Restore(ctx, registrant.ID, true)
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+
+			Expect(participantRepo.Delete(ctx, replacement.ID)).To(Succeed())
+			Expect(participantRepo.Restore(ctx, registrant.ID, true)).To(Succeed())
+		})
+
+		It("should restore the participant with their guests and check-in", func() {
+			Expect(participantRepo.Restore(ctx, registrant.ID, true)).To(Succeed())
+
+			restored, err := participantRepo.FindByID(ctx, registrant.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored.CheckedIn).To(BeTrue())
+
+			_, err = participantRepo.FindByID(ctx, guest.ID)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = checkinRepo.FindByID(ctx, checkin.ID)
+			Expect(err).NotTo(HaveOccurred())
+
+			changes, err := participantRepo.FindChangesSince(ctx, eventID, time.Now().Add(-time.Hour))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(participantIDs(changes.Participants)).To(ConsistOf(registrant.ID, guest.ID, other.ID))
+			Expect(changes.Deleted).To(BeEmpty())
+
+			err = participantRepo.Restore(ctx, registrant.ID, true)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should find the deleted participant only as deleted", func() {
+			deleted, err := participantRepo.FindDeletedByID(ctx, registrant.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted.ID).To(Equal(registrant.ID))
+			Expect(deleted.DeletedAt).NotTo(BeNil())
+
+			Expect(participantRepo.Restore(ctx, registrant.ID, true)).To(Succeed())
+
+			_, err = participantRepo.FindDeletedByID(ctx, registrant.ID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should list the deleted participant when deleted participants are included", func() {
+			filter := repository.ParticipantListFilter{EventID: &eventID, IncludeDeleted: true}
+			listed, total, err := participantRepo.FindByFilter(ctx, filter, 0, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(BeEquivalentTo(3))
+			Expect(participantIDs(listed)).To(ConsistOf(registrant.ID, guest.ID, other.ID))
+
+			filter.IncludeDeleted = false
+			listed, _, err = participantRepo.FindByFilter(ctx, filter, 0, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(participantIDs(listed)).To(ConsistOf(other.ID))
+		})
+
+		Context("when the event has filled up since the deletion", func() {
+			BeforeEach(func() {
+				_, err := db.GetPool().Exec(ctx, "UPDATE events SET capacity = 2 WHERE id = $1", eventID)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should waitlist the confirmed guests that no longer fit", func() {
+				Expect(participantRepo.Restore(ctx, registrant.ID, true)).To(Succeed())
+
+				restored, err := participantRepo.FindByID(ctx, registrant.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(restored.Status).To(Equal(entity.ParticipantStatusConfirmed))
+
+				restoredGuest, err := participantRepo.FindByID(ctx, guest.ID)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(restoredGuest.Status).To(Equal(entity.ParticipantStatusWaitlisted))
+			})
+
+			It("should reject the restore without waitlisting", func() {
+				err := participantRepo.Restore(ctx, registrant.ID, false)
+				Expect(err).To(MatchError(repository.ErrEventFull))
+
+				expectParticipantHidden(registrant)
+				expectParticipantHidden(guest)
+			})
+		})
+
+		It("should not restore a guest while their registrant is deleted", func() {
+			err := participantRepo.Restore(ctx, guest.ID, true)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should keep the participant's personal data in the retention purge", func() {
+			anonymized, err := participantRepo.AnonymizeByEventID(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(anonymized).To(BeEquivalentTo(3))
+
+			Expect(participantRepo.Restore(ctx, registrant.ID, true)).To(Succeed())
+			restored, err := participantRepo.FindByID(ctx, registrant.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored.Name).To(Equal(entity.AnonymizedParticipantName))
+		})
+	})
+
+	Describe("deleting a guest before their registrant", func() {
+		It("should keep the guest deleted when the registrant is restored", func() {
+			Expect(participantRepo.Delete(ctx, guest.ID)).To(Succeed())
+			Expect(participantRepo.Delete(ctx, registrant.ID)).To(Succeed())
+
+			Expect(participantRepo.Restore(ctx, registrant.ID, true)).To(Succeed())
+
+			_, err := participantRepo.FindByID(ctx, registrant.ID)
+			Expect(err).NotTo(HaveOccurred())
+			_, err = participantRepo.FindByID(ctx, guest.ID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("merging participants", func() {
+		It("should move the duplicate's check-ins and guests to the primary and delete the duplicate", func() {
+			later := &entity.Checkin{
+				ID:            uuid.New(),
+				EventID:       eventID,
+				ParticipantID: registrant.ID,
+				CheckedInAt:   checkin.CheckedInAt.Add(time.Minute),
+				Method:        entity.CheckinMethodManual,
+			}
+			Expect(checkinRepo.Cancel(ctx, checkin.ID, organizerID, time.Now())).To(Succeed())
+			Expect(checkinRepo.Create(ctx, later)).To(Succeed())
+			otherCheckin := &entity.Checkin{
+				ID:            uuid.New(),
+				EventID:       eventID,
+				ParticipantID: other.ID,
+				CheckedInAt:   checkin.CheckedInAt.Add(-time.Minute),
+				Method:        entity.CheckinMethodQRCode,
+			}
+			Expect(checkinRepo.Create(ctx, otherCheckin)).To(Succeed())
+
+			mergedAt := time.Now()
+			moved, err := checkinRepo.Reassign(ctx, registrant.ID, other.ID, organizerID, mergedAt)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(moved).To(BeEquivalentTo(2))
+			Expect(participantRepo.Merge(ctx, other.ID, registrant.ID, mergedAt)).To(Succeed())
+
+			history, err := checkinRepo.GetHistory(ctx, other.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(history).To(HaveLen(3))
+			Expect(history[0].ID).To(Equal(otherCheckin.ID))
+			Expect(history[0].CancelledAt).To(BeNil())
+			Expect(history[2].ID).To(Equal(later.ID))
+			Expect(history[2].CancelledBy).To(Equal(&organizerID))
+
+			movedGuest, err := participantRepo.FindByID(ctx, guest.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(movedGuest.GuestOf).To(Equal(&other.ID))
+
+			_, err = participantRepo.FindByID(ctx, registrant.ID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			_, err = participantRepo.FindDeletedByID(ctx, registrant.ID)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not merge a participant with guests into a guest", func() {
+			err := participantRepo.Merge(ctx, guest.ID, registrant.ID, time.Now())
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+
+			unchanged, err := participantRepo.FindByID(ctx, guest.ID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(unchanged.GuestOf).To(Equal(&registrant.ID))
+			_, err = participantRepo.FindByID(ctx, registrant.ID)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("deleting an event", func() {
+		var earlier *entity.Participant
+
+		BeforeEach(func() {
+			earlier = createParticipant("Earlier", "earlier@example.com", nil)
+			Expect(participantRepo.Delete(ctx, earlier.ID)).To(Succeed())
+
+			Expect(eventRepo.Delete(ctx, eventID)).To(Succeed())
+		})
+
+		It("should hide the event and its participants from every read path", func() {
+			_, err := eventRepo.FindByID(ctx, eventID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+
+			events, err := eventRepo.FindByIDs(ctx, []uuid.UUID{eventID})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(BeEmpty())
+
+			listed, total, err := eventRepo.List(ctx, repository.EventListFilter{OrganizerID: &organizerID}, 0, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(listed).To(BeEmpty())
+			Expect(total).To(BeZero())
+
+			_, err = eventRepo.GetStats(ctx, eventID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+
+			Expect(apperrors.IsNotFound(eventRepo.Update(ctx, &entity.Event{
+				ID:          eventID,
+				OrganizerID: organizerID,
+				Name:        "Renamed",
+				StartDate:   time.Now(),
+				Status:      entity.StatusPublished,
+				UpdatedAt:   time.Now(),
+			}))).To(BeTrue())
+
+			_, err = participantRepo.GetListLastModified(ctx, eventID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+
+			expectParticipantHidden(registrant)
+			expectParticipantHidden(other)
+		})
+
+		It("should restore the event with the participants deleted with it", func() {
+			Expect(eventRepo.Restore(ctx, eventID)).To(Succeed())
+
+			event, err := eventRepo.FindByID(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(event.ParticipantCount).To(BeEquivalentTo(3))
+			Expect(event.CheckedInCount).To(BeEquivalentTo(1))
+
+			all, err := participantRepo.FindAllByEventID(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(participantIDs(all)).To(ConsistOf(registrant.ID, guest.ID, other.ID))
+
+			err = eventRepo.Restore(ctx, eventID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should find the deleted event only as deleted", func() {
+			deleted, err := eventRepo.FindDeletedByID(ctx, eventID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deleted.ID).To(Equal(eventID))
+			Expect(deleted.DeletedAt).NotTo(BeNil())
+
+			Expect(eventRepo.Restore(ctx, eventID)).To(Succeed())
+
+			_, err = eventRepo.FindDeletedByID(ctx, eventID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should list the deleted event when deleted events are included", func() {
+			filter := repository.EventListFilter{OrganizerID: &organizerID, IncludeDeleted: true}
+			listed, total, err := eventRepo.List(ctx, filter, 0, 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(total).To(BeEquivalentTo(1))
+			Expect(listed[0].ID).To(Equal(eventID))
+			Expect(listed[0].DeletedAt).NotTo(BeNil())
+		})
+
+		It("should not restore a participant while their event is deleted", func() {
+			err := participantRepo.Restore(ctx, other.ID, true)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should return not found when deleting it again", func() {
+			err := eventRepo.Delete(ctx, eventID)
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -44,8 +44,9 @@ func WithTransaction(ctx context.Context, pool *pgxpool.Pool, fn func(context.Co
 	// Execute function and handle commit/rollback
 	defer func() {
 		if p := recover(); p != nil {
-			// Rollback on panic using background context (original context may be cancelled)
-			rollbackCtx, rollbackCancel := context.WithTimeout(context.Background(), rollbackTimeout)
+			// Rollback on panic detached from cancellation (original context may be cancelled),
+			// keeping its values so the rollback stays in the request trace
+			rollbackCtx, rollbackCancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
 			_ = tx.Rollback(rollbackCtx)
 			rollbackCancel()
 			panic(p) // Re-throw panic after rollback
@@ -54,8 +55,8 @@ func WithTransaction(ctx context.Context, pool *pgxpool.Pool, fn func(context.Co
 
 	// Execute the provided function
 	if err := fn(txCtx); err != nil {
-		// Rollback on error using background context (original context may be cancelled)
-		rollbackCtx, rollbackCancel := context.WithTimeout(context.Background(), rollbackTimeout)
+		// Rollback on error detached from cancellation (original context may be cancelled)
+		rollbackCtx, rollbackCancel := context.WithTimeout(context.WithoutCancel(ctx), rollbackTimeout)
 		rbErr := tx.Rollback(rollbackCtx)
 		rollbackCancel()
 		if rbErr != nil {
@@ -73,6 +74,15 @@ func WithTransaction(ctx context.Context, pool *pgxpool.Pool, fn func(context.Co
 	return nil
 }
 
+// inTransaction runs fn in the transaction in ctx, or in a new one when ctx has none, so that a
+// repository method issuing several statements is atomic either way.
+func inTransaction(ctx context.Context, pool *pgxpool.Pool, fn func(context.Context) error) error {
+	if GetTx(ctx) != nil {
+		return fn(ctx)
+	}
+	return WithTransaction(ctx, pool, fn)
+}
+
 // GetTx retrieves the pgx.Tx from context if present, otherwise returns nil.
 // This allows repository methods to use the transaction if one is active.
 func GetTx(ctx context.Context) pgx.Tx {
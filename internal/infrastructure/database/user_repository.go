@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
@@ -42,12 +43,12 @@ func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
 	query := `
 		INSERT INTO users (
 			id, email, password_hash, name, role,
-			deleted_at, deleted_by, is_anonymized,
+			deleted_at, deleted_by, is_anonymized, email_verified,
 			created_at, updated_at
 		) VALUES (
 			$1, $2, $3, $4, $5,
-			$6, $7, $8,
-			$9, $10
+			$6, $7, $8, $9,
+			$10, $11
 		)
 	`
 
@@ -61,6 +62,7 @@ func (r *UserRepository) Create(ctx context.Context, user *entity.User) error {
 		user.DeletedAt,
 		user.DeletedBy,
 		user.IsAnonymized,
+		user.EmailVerified,
 		user.CreatedAt,
 		user.UpdatedAt,
 	)
@@ -89,6 +91,7 @@ func (r *UserRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Us
 		SELECT
 			id, email, name, role,
 			deleted_at, deleted_by, is_anonymized,
+			totp_enabled_at IS NOT NULL, deactivated_at, email_verified,
 			created_at, updated_at
 		FROM users
 		WHERE id = $1
@@ -104,6 +107,9 @@ func (r *UserRepository) FindByID(ctx context.Context, id uuid.UUID) (*entity.Us
 		&user.DeletedAt,
 		&user.DeletedBy,
 		&user.IsAnonymized,
+		&user.TwoFactorEnabled,
+		&user.DeactivatedAt,
+		&user.EmailVerified,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -124,6 +130,7 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*entity
 		SELECT
 			id, email, name, role,
 			deleted_at, deleted_by, is_anonymized,
+			totp_enabled_at IS NOT NULL, deactivated_at, email_verified,
 			created_at, updated_at
 		FROM users
 		WHERE email = $1
@@ -139,6 +146,9 @@ func (r *UserRepository) FindByEmail(ctx context.Context, email string) (*entity
 		&user.DeletedAt,
 		&user.DeletedBy,
 		&user.IsAnonymized,
+		&user.TwoFactorEnabled,
+		&user.DeactivatedAt,
+		&user.EmailVerified,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -159,6 +169,7 @@ func (r *UserRepository) FindByEmailWithPassword(ctx context.Context, email stri
 		SELECT
 			id, email, password_hash, name, role,
 			deleted_at, deleted_by, is_anonymized,
+			totp_enabled_at IS NOT NULL, deactivated_at, email_verified,
 			created_at, updated_at
 		FROM users
 		WHERE email = $1
@@ -175,6 +186,9 @@ func (r *UserRepository) FindByEmailWithPassword(ctx context.Context, email stri
 		&user.DeletedAt,
 		&user.DeletedBy,
 		&user.IsAnonymized,
+		&user.TwoFactorEnabled,
+		&user.DeactivatedAt,
+		&user.EmailVerified,
 		&user.CreatedAt,
 		&user.UpdatedAt,
 	)
@@ -200,7 +214,9 @@ func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 			deleted_at = $6,
 			deleted_by = $7,
 			is_anonymized = $8,
-			updated_at = $9
+			deactivated_at = $9,
+			email_verified = $10,
+			updated_at = $11
 		WHERE id = $1
 	`
 
@@ -214,6 +230,8 @@ func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 		user.DeletedAt,
 		user.DeletedBy,
 		user.IsAnonymized,
+		user.DeactivatedAt,
+		user.EmailVerified,
 		user.UpdatedAt,
 	)
 	if err != nil {
@@ -237,36 +255,101 @@ func (r *UserRepository) Update(ctx context.Context, user *entity.User) error {
 	return nil
 }
 
-// List retrieves a paginated list of users
+// UpdateAccess updates the role and deactivation of a user
+func (r *UserRepository) UpdateAccess(ctx context.Context, user *entity.User) error {
+	query := `
+		UPDATE users
+		SET
+			role = $2,
+			deactivated_at = $3,
+			updated_at = $4
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	q := GetQueryable(ctx, r.pool)
+	commandTag, err := q.Exec(ctx, query,
+		user.ID,
+		user.Role,
+		user.DeactivatedAt,
+		user.UpdatedAt,
+	)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to update user access")
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return apperrors.NotFound("user not found")
+	}
+
+	r.logger.WithContext(ctx).Info("user access updated",
+		zap.String("user_id", user.ID.String()),
+		zap.String("role", string(user.Role)),
+		zap.Bool("deactivated", user.IsDeactivated()),
+	)
+
+	return nil
+}
+
+// MarkEmailVerified records that a user has verified their email address
+func (r *UserRepository) MarkEmailVerified(ctx context.Context, id uuid.UUID) error {
+	query := `
+		UPDATE users
+		SET email_verified = TRUE, updated_at = NOW()
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	q := GetQueryable(ctx, r.pool)
+	commandTag, err := q.Exec(ctx, query, id)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to mark user email as verified")
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return apperrors.NotFound("user not found")
+	}
+
+	r.logger.WithContext(ctx).Info("user email verified", zap.String("user_id", id.String()))
+
+	return nil
+}
+
+// List retrieves a paginated list of users matching the filter
 // Excludes soft-deleted users and password_hash for security
-func (r *UserRepository) List(ctx context.Context, offset, limit int) ([]*entity.User, int64, error) {
+func (r *UserRepository) List(
+	ctx context.Context,
+	filter repository.UserListFilter,
+	offset, limit int,
+) ([]*entity.User, int64, error) {
+	whereClause, args, argIdx := buildUserFilterClauses(filter)
+
 	// Get total count
-	countQuery := `
+	countQuery := fmt.Sprintf(`
 		SELECT COUNT(*)
 		FROM users
-		WHERE deleted_at IS NULL
-	`
+		WHERE %s
+	`, whereClause)
 
 	var total int64
 	q := GetQueryable(ctx, r.pool)
-	err := q.QueryRow(ctx, countQuery).Scan(&total)
+	err := q.QueryRow(ctx, countQuery, args...).Scan(&total)
 	if err != nil {
 		return nil, 0, apperrors.Wrapf(err, "failed to count users")
 	}
 
 	// Get paginated results
-	query := `
+	query := fmt.Sprintf(`
 		SELECT
 			id, email, name, role,
 			deleted_at, deleted_by, is_anonymized,
+			totp_enabled_at IS NOT NULL, deactivated_at, email_verified,
 			created_at, updated_at
 		FROM users
-		WHERE deleted_at IS NULL
+		WHERE %s
 		ORDER BY created_at DESC
-		LIMIT $1 OFFSET $2
-	`
+		LIMIT $%d OFFSET $%d
+	`, whereClause, argIdx, argIdx+1)
 
-	rows, err := q.Query(ctx, query, limit, offset)
+	rows, err := q.Query(ctx, query, append(args, limit, offset)...)
 	if err != nil {
 		return nil, 0, apperrors.Wrapf(err, "failed to list users")
 	}
@@ -283,6 +366,9 @@ func (r *UserRepository) List(ctx context.Context, offset, limit int) ([]*entity
 			&user.DeletedAt,
 			&user.DeletedBy,
 			&user.IsAnonymized,
+			&user.TwoFactorEnabled,
+			&user.DeactivatedAt,
+			&user.EmailVerified,
 			&user.CreatedAt,
 			&user.UpdatedAt,
 		)
@@ -299,7 +385,52 @@ func (r *UserRepository) List(ctx context.Context, offset, limit int) ([]*entity
 	return users, total, nil
 }
 
-// SoftDelete marks a user as deleted and anonymizes their PII
+// buildUserFilterClauses builds the WHERE clause of a user list query and its arguments.
+// Returns the clause, the arguments and the index of the next placeholder.
+func buildUserFilterClauses(filter repository.UserListFilter) (string, []interface{}, int) {
+	whereClauses := []string{"deleted_at IS NULL"}
+	args := []interface{}{}
+	argIdx := 1
+
+	if filter.Search != "" {
+		whereClauses = append(whereClauses, fmt.Sprintf("(email ILIKE $%d OR name ILIKE $%d)", argIdx, argIdx))
+		args = append(args, "%"+filter.Search+"%")
+		argIdx++
+	}
+
+	if filter.Role != nil {
+		whereClauses = append(whereClauses, fmt.Sprintf("role = $%d", argIdx))
+		args = append(args, *filter.Role)
+		argIdx++
+	}
+
+	return strings.Join(whereClauses, " AND "), args, argIdx
+}
+
+// CountActiveAdmins counts the admins who are neither deleted nor deactivated, locking their
+// rows for the rest of the transaction
+func (r *UserRepository) CountActiveAdmins(ctx context.Context) (int64, error) {
+	// FOR UPDATE cannot be combined with COUNT, so the admins are locked in a subquery
+	query := `
+		SELECT COUNT(*)
+		FROM (
+			SELECT id
+			FROM users
+			WHERE role = $1 AND deleted_at IS NULL AND deactivated_at IS NULL
+			FOR UPDATE
+		) AS admins
+	`
+
+	var count int64
+	q := GetQueryable(ctx, r.pool)
+	if err := q.QueryRow(ctx, query, entity.RoleAdmin).Scan(&count); err != nil {
+		return 0, apperrors.Wrapf(err, "failed to count active admins")
+	}
+
+	return count, nil
+}
+
+// SoftDelete marks a user as deleted, anonymizes their PII and removes their second factor
 func (r *UserRepository) SoftDelete(ctx context.Context, id uuid.UUID, deletedBy uuid.UUID) error {
 	now := time.Now()
 	anonymizedEmail := fmt.Sprintf("deleted_%s@anonymized.local", id.String())
@@ -313,7 +444,10 @@ func (r *UserRepository) SoftDelete(ctx context.Context, id uuid.UUID, deletedBy
 			deleted_at = $4,
 			deleted_by = $5,
 			is_anonymized = $6,
-			updated_at = $7
+			updated_at = $7,
+			totp_secret = NULL,
+			totp_enabled_at = NULL,
+			totp_backup_codes = '{}'
 		WHERE id = $1 AND deleted_at IS NULL
 	`
 
@@ -357,6 +491,199 @@ func (r *UserRepository) ExistsByEmail(ctx context.Context, email string) (bool,
 	return exists, nil
 }
 
+// FindTwoFactor retrieves the TOTP second factor of a user, enabled or pending
+func (r *UserRepository) FindTwoFactor(ctx context.Context, userID uuid.UUID) (*entity.UserTwoFactor, error) {
+	query := `
+		SELECT
+			id, totp_secret, totp_enabled_at, totp_backup_codes,
+			totp_last_step, totp_failed_attempts, totp_failed_at
+		FROM users
+		WHERE id = $1 AND deleted_at IS NULL AND totp_secret IS NOT NULL
+	`
+
+	var twoFactor entity.UserTwoFactor
+	q := GetQueryable(ctx, r.pool)
+	err := q.QueryRow(ctx, query, userID).Scan(
+		&twoFactor.UserID,
+		&twoFactor.EncryptedSecret,
+		&twoFactor.EnabledAt,
+		&twoFactor.BackupCodeHashes,
+		&twoFactor.LastUsedStep,
+		&twoFactor.FailedAttempts,
+		&twoFactor.LastFailedAt,
+	)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, apperrors.NotFound("two-factor authentication not found")
+		}
+		return nil, apperrors.Wrapf(err, "failed to find two-factor authentication")
+	}
+
+	return &twoFactor, nil
+}
+
+// StartTwoFactorEnrollment stores a new encrypted TOTP secret awaiting verification
+func (r *UserRepository) StartTwoFactorEnrollment(ctx context.Context, userID uuid.UUID, encryptedSecret string) error {
+	query := `
+		UPDATE users
+		SET
+			totp_secret = $2,
+			totp_backup_codes = '{}',
+			totp_last_step = 0,
+			totp_failed_attempts = 0,
+			totp_failed_at = NULL,
+			updated_at = $3
+		WHERE id = $1 AND deleted_at IS NULL AND totp_enabled_at IS NULL
+	`
+
+	q := GetQueryable(ctx, r.pool)
+	commandTag, err := q.Exec(ctx, query, userID, encryptedSecret, time.Now())
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to start two-factor enrollment")
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return r.twoFactorUpdateError(ctx, userID, "user not found")
+	}
+
+	r.logger.WithContext(ctx).Info("two-factor enrollment started",
+		zap.String("user_id", userID.String()),
+	)
+
+	return nil
+}
+
+// EnableTwoFactor completes a pending enrollment and stores the backup code hashes
+func (r *UserRepository) EnableTwoFactor(
+	ctx context.Context,
+	userID uuid.UUID,
+	backupCodeHashes []string,
+	step int64,
+) error {
+	query := `
+		UPDATE users
+		SET
+			totp_enabled_at = $2,
+			totp_backup_codes = $3,
+			totp_last_step = $4,
+			updated_at = $2
+		WHERE id = $1 AND deleted_at IS NULL AND totp_secret IS NOT NULL AND totp_enabled_at IS NULL
+	`
+
+	q := GetQueryable(ctx, r.pool)
+	commandTag, err := q.Exec(ctx, query, userID, time.Now(), backupCodeHashes, step)
+	if err != nil {
+		return apperrors.Wrapf(err, "failed to enable two-factor authentication")
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return r.twoFactorUpdateError(ctx, userID, "two-factor enrollment not found")
+	}
+
+	r.logger.WithContext(ctx).Info("two-factor authentication enabled",
+		zap.String("user_id", userID.String()),
+	)
+
+	return nil
+}
+
+// twoFactorUpdateError explains why a two-factor update matched no user: Conflict if
+// two-factor authentication is already enabled, otherwise NotFound with notFoundMessage.
+func (r *UserRepository) twoFactorUpdateError(ctx context.Context, userID uuid.UUID, notFoundMessage string) error {
+	query := `SELECT EXISTS(SELECT 1 FROM users WHERE id = $1 AND deleted_at IS NULL AND totp_enabled_at IS NOT NULL)`
+
+	var enabled bool
+	q := GetQueryable(ctx, r.pool)
+	if err := q.QueryRow(ctx, query, userID).Scan(&enabled); err != nil {
+		return apperrors.Wrapf(err, "failed to check two-factor authentication")
+	}
+	if enabled {
+		return apperrors.Conflict("two-factor authentication is already enabled")
+	}
+	return apperrors.NotFound(notFoundMessage)
+}
+
+// ConsumeTwoFactorStep records step as the last accepted TOTP time step, unless a code of
+// this or a later step was already accepted
+func (r *UserRepository) ConsumeTwoFactorStep(ctx context.Context, userID uuid.UUID, step int64) (bool, error) {
+	query := `
+		UPDATE users
+		SET
+			totp_last_step = $2,
+			totp_failed_attempts = 0,
+			totp_failed_at = NULL
+		WHERE id = $1 AND totp_enabled_at IS NOT NULL AND totp_last_step < $2
+	`
+
+	q := GetQueryable(ctx, r.pool)
+	commandTag, err := q.Exec(ctx, query, userID, step)
+	if err != nil {
+		return false, apperrors.Wrapf(err, "failed to consume two-factor code")
+	}
+
+	return commandTag.RowsAffected() > 0, nil
+}
+
+// ConsumeBackupCode removes the backup code with the given hash, if the user has it
+func (r *UserRepository) ConsumeBackupCode(ctx context.Context, userID uuid.UUID, codeHash string) (bool, error) {
+	query := `
+		UPDATE users
+		SET
+			totp_backup_codes = array_remove(totp_backup_codes, $2),
+			totp_failed_attempts = 0,
+			totp_failed_at = NULL
+		WHERE id = $1 AND totp_enabled_at IS NOT NULL AND $2 = ANY(totp_backup_codes)
+	`
+
+	q := GetQueryable(ctx, r.pool)
+	commandTag, err := q.Exec(ctx, query, userID, codeHash)
+	if err != nil {
+		return false, apperrors.Wrapf(err, "failed to consume backup code")
+	}
+
+	if commandTag.RowsAffected() == 0 {
+		return false, nil
+	}
+
+	r.logger.WithContext(ctx).Info("two-factor backup code used",
+		zap.String("user_id", userID.String()),
+	)
+
+	return true, nil
+}
+
+// RecordTwoFactorFailure counts an invalid two-factor code; failures before windowStart
+// are forgotten
+func (r *UserRepository) RecordTwoFactorFailure(
+	ctx context.Context,
+	userID uuid.UUID,
+	windowStart time.Time,
+) (int, error) {
+	query := `
+		UPDATE users
+		SET
+			totp_failed_attempts = CASE
+				WHEN totp_failed_at >= $2 THEN totp_failed_attempts + 1
+				ELSE 1
+			END,
+			totp_failed_at = $3
+		WHERE id = $1
+		RETURNING totp_failed_attempts
+	`
+
+	var attempts int
+	q := GetQueryable(ctx, r.pool)
+	err := q.QueryRow(ctx, query, userID, windowStart, time.Now()).Scan(&attempts)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, apperrors.NotFound("user not found")
+		}
+		return 0, apperrors.Wrapf(err, "failed to record two-factor failure")
+	}
+
+	return attempts, nil
+}
+
 // HealthCheck verifies the repository's database connection
 func (r *UserRepository) HealthCheck(ctx context.Context) error {
 	return r.pool.Ping(ctx)
@@ -10,6 +10,7 @@ import (
 
 	"github.com/fumkob/ezqrin-server/config"
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
@@ -336,6 +337,49 @@ var _ = Describe("UserRepository", func() {
 				Expect(err).To(BeNil())
 				Expect(found.PasswordHash).To(Equal(newPasswordHash))
 			})
+
+			It("should deactivate and reactivate the user", func() {
+				deactivatedAt := time.Now()
+				createdUser.DeactivatedAt = &deactivatedAt
+
+				Expect(repo.Update(ctx, createdUser)).To(Succeed())
+
+				found, err := repo.FindByID(ctx, createdUser.ID)
+				Expect(err).To(BeNil())
+				Expect(found.IsDeactivated()).To(BeTrue())
+
+				createdUser.DeactivatedAt = nil
+				Expect(repo.Update(ctx, createdUser)).To(Succeed())
+
+				found, err = repo.FindByEmailWithPassword(ctx, createdUser.Email)
+				Expect(err).To(BeNil())
+				Expect(found.IsDeactivated()).To(BeFalse())
+			})
+		})
+
+		Context("with access changes", func() {
+			It("should update the role and deactivation but keep the password", func() {
+				deactivatedAt := time.Now()
+				createdUser.Role = entity.RoleStaff
+				createdUser.DeactivatedAt = &deactivatedAt
+				createdUser.PasswordHash = ""
+
+				Expect(repo.UpdateAccess(ctx, createdUser)).To(Succeed())
+
+				found, err := repo.FindByEmailWithPassword(ctx, createdUser.Email)
+				Expect(err).To(BeNil())
+				Expect(found.Role).To(Equal(entity.RoleStaff))
+				Expect(found.IsDeactivated()).To(BeTrue())
+				Expect(found.PasswordHash).To(Equal("hashed_password_update"))
+			})
+
+			It("should return not found for a deleted user", func() {
+				Expect(repo.SoftDelete(ctx, createdUser.ID, createdUser.ID)).To(Succeed())
+
+				err := repo.UpdateAccess(ctx, createdUser)
+
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			})
 		})
 
 		Context("with duplicate email", func() {
@@ -403,7 +447,7 @@ var _ = Describe("UserRepository", func() {
 
 		Context("with pagination", func() {
 			It("should return paginated results with total count", func() {
-				users, total, err := repo.List(ctx, 0, 3)
+				users, total, err := repo.List(ctx, repository.UserListFilter{}, 0, 3)
 
 				Expect(err).To(BeNil())
 				Expect(total).To(BeNumerically(">=", 5))
@@ -415,7 +459,7 @@ var _ = Describe("UserRepository", func() {
 			})
 
 			It("should return second page correctly", func() {
-				users, total, err := repo.List(ctx, 3, 2)
+				users, total, err := repo.List(ctx, repository.UserListFilter{}, 3, 2)
 
 				Expect(err).To(BeNil())
 				Expect(total).To(BeNumerically(">=", 5))
@@ -423,7 +467,7 @@ var _ = Describe("UserRepository", func() {
 			})
 
 			It("should return empty list beyond available pages", func() {
-				users, total, err := repo.List(ctx, 100, 10)
+				users, total, err := repo.List(ctx, repository.UserListFilter{}, 100, 10)
 
 				Expect(err).To(BeNil())
 				Expect(total).To(BeNumerically(">=", 5))
@@ -463,7 +507,7 @@ var _ = Describe("UserRepository", func() {
 				Expect(err).To(BeNil())
 
 				// List should not include deleted user
-				users, _, err := repo.List(ctx, 0, 100)
+				users, _, err := repo.List(ctx, repository.UserListFilter{}, 0, 100)
 				Expect(err).To(BeNil())
 
 				for _, user := range users {
@@ -475,7 +519,7 @@ var _ = Describe("UserRepository", func() {
 
 		Context("with ordering", func() {
 			It("should return users ordered by created_at DESC", func() {
-				users, _, err := repo.List(ctx, 0, 10)
+				users, _, err := repo.List(ctx, repository.UserListFilter{}, 0, 10)
 
 				Expect(err).To(BeNil())
 				Expect(users).NotTo(BeEmpty())
@@ -487,6 +531,67 @@ var _ = Describe("UserRepository", func() {
 				}
 			})
 		})
+
+		Context("with a filter", func() {
+			It("should search by email or name, case-insensitively", func() {
+				users, total, err := repo.List(ctx, repository.UserListFilter{Search: "TESTLIST3"}, 0, 10)
+
+				Expect(err).To(BeNil())
+				Expect(total).To(Equal(int64(1)))
+				Expect(users[0].Email).To(Equal("testlist3@example.com"))
+
+				users, total, err = repo.List(ctx, repository.UserListFilter{Search: "list user"}, 0, 10)
+
+				Expect(err).To(BeNil())
+				Expect(total).To(Equal(int64(5)))
+				Expect(users).To(HaveLen(5))
+			})
+
+			It("should filter by role", func() {
+				admin := &entity.User{
+					ID:           uuid.New(),
+					Email:        "testlistadmin@example.com",
+					PasswordHash: "hashed_password_admin",
+					Name:         "List Admin",
+					Role:         entity.RoleAdmin,
+					CreatedAt:    time.Now(),
+					UpdatedAt:    time.Now(),
+				}
+				Expect(repo.Create(ctx, admin)).To(Succeed())
+
+				role := entity.RoleAdmin
+				users, total, err := repo.List(ctx, repository.UserListFilter{Role: &role}, 0, 10)
+
+				Expect(err).To(BeNil())
+				Expect(total).To(Equal(int64(1)))
+				Expect(users[0].ID).To(Equal(admin.ID))
+			})
+		})
+	})
+
+	When("counting active admins", func() {
+		It("should count only admins who are neither deleted nor deactivated", func() {
+			deactivatedAt := time.Now()
+			for i, deactivated := range []*time.Time{nil, nil, &deactivatedAt} {
+				admin := &entity.User{
+					ID:           uuid.New(),
+					Email:        fmt.Sprintf("testadmin%d@example.com", i),
+					PasswordHash: "hashed_password_admin",
+					Name:         fmt.Sprintf("Admin %d", i),
+					Role:         entity.RoleAdmin,
+					CreatedAt:    time.Now(),
+					UpdatedAt:    time.Now(),
+				}
+				Expect(repo.Create(ctx, admin)).To(Succeed())
+				admin.DeactivatedAt = deactivated
+				Expect(repo.UpdateAccess(ctx, admin)).To(Succeed())
+			}
+
+			count, err := repo.CountActiveAdmins(ctx)
+
+			Expect(err).To(BeNil())
+			Expect(count).To(Equal(int64(2)))
+		})
 	})
 
 	When("soft deleting user", func() {
@@ -648,6 +753,148 @@ var _ = Describe("UserRepository", func() {
 		})
 	})
 
+	When("managing two-factor authentication", func() {
+		var user *entity.User
+
+		BeforeEach(func() {
+			user = &entity.User{
+				ID:           testUserID,
+				Email:        "twofactor@example.com",
+				PasswordHash: "hashed_password_123",
+				Name:         "Two Factor User",
+				Role:         entity.RoleOrganizer,
+				CreatedAt:    time.Now(),
+				UpdatedAt:    time.Now(),
+			}
+			Expect(repo.Create(ctx, user)).To(Succeed())
+		})
+
+		Context("before any enrollment", func() {
+			It("should not find a second factor", func() {
+				_, err := repo.FindTwoFactor(ctx, user.ID)
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+
+				found, err := repo.FindByID(ctx, user.ID)
+				Expect(err).To(BeNil())
+				Expect(found.TwoFactorEnabled).To(BeFalse())
+			})
+		})
+
+		Context("with a verified enrollment", func() {
+			BeforeEach(func() {
+				Expect(repo.StartTwoFactorEnrollment(ctx, user.ID, "sealed-secret")).To(Succeed())
+				Expect(repo.EnableTwoFactor(ctx, user.ID, []string{"hash-1", "hash-2"}, 100)).To(Succeed())
+			})
+
+			It("should report two-factor authentication as enabled", func() {
+				twoFactor, err := repo.FindTwoFactor(ctx, user.ID)
+				Expect(err).To(BeNil())
+				Expect(twoFactor.EncryptedSecret).To(Equal("sealed-secret"))
+				Expect(twoFactor.IsEnabled()).To(BeTrue())
+				Expect(twoFactor.BackupCodeHashes).To(ConsistOf("hash-1", "hash-2"))
+				Expect(twoFactor.LastUsedStep).To(Equal(int64(100)))
+
+				found, err := repo.FindByEmailWithPassword(ctx, user.Email)
+				Expect(err).To(BeNil())
+				Expect(found.TwoFactorEnabled).To(BeTrue())
+			})
+
+			It("should refuse a new enrollment", func() {
+				err := repo.StartTwoFactorEnrollment(ctx, user.ID, "another-secret")
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+
+			It("should accept each time step only once", func() {
+				consumed, err := repo.ConsumeTwoFactorStep(ctx, user.ID, 101)
+				Expect(err).To(BeNil())
+				Expect(consumed).To(BeTrue())
+
+				consumed, err = repo.ConsumeTwoFactorStep(ctx, user.ID, 101)
+				Expect(err).To(BeNil())
+				Expect(consumed).To(BeFalse())
+			})
+
+			It("should accept each backup code only once", func() {
+				consumed, err := repo.ConsumeBackupCode(ctx, user.ID, "hash-1")
+				Expect(err).To(BeNil())
+				Expect(consumed).To(BeTrue())
+
+				consumed, err = repo.ConsumeBackupCode(ctx, user.ID, "hash-1")
+				Expect(err).To(BeNil())
+				Expect(consumed).To(BeFalse())
+
+				twoFactor, err := repo.FindTwoFactor(ctx, user.ID)
+				Expect(err).To(BeNil())
+				Expect(twoFactor.BackupCodeHashes).To(ConsistOf("hash-2"))
+			})
+
+			It("should count failures within the window and reset them on success", func() {
+				windowStart := time.Now().Add(-time.Minute)
+				attempts, err := repo.RecordTwoFactorFailure(ctx, user.ID, windowStart)
+				Expect(err).To(BeNil())
+				Expect(attempts).To(Equal(1))
+				attempts, err = repo.RecordTwoFactorFailure(ctx, user.ID, windowStart)
+				Expect(err).To(BeNil())
+				Expect(attempts).To(Equal(2))
+
+				// Failures before the window start over
+				attempts, err = repo.RecordTwoFactorFailure(ctx, user.ID, time.Now().Add(time.Minute))
+				Expect(err).To(BeNil())
+				Expect(attempts).To(Equal(1))
+
+				_, err = repo.ConsumeTwoFactorStep(ctx, user.ID, 102)
+				Expect(err).To(BeNil())
+				twoFactor, err := repo.FindTwoFactor(ctx, user.ID)
+				Expect(err).To(BeNil())
+				Expect(twoFactor.FailedAttempts).To(BeZero())
+			})
+		})
+
+		Context("when enabling without a pending enrollment", func() {
+			It("should return not found", func() {
+				err := repo.EnableTwoFactor(ctx, user.ID, nil, 1)
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+	})
+
+	When("verifying an email address", func() {
+		var user *entity.User
+
+		BeforeEach(func() {
+			user = &entity.User{
+				ID:           testUserID,
+				Email:        "verify@example.com",
+				PasswordHash: "hashed_password_123",
+				Name:         "Unverified User",
+				Role:         entity.RoleOrganizer,
+				CreatedAt:    time.Now(),
+				UpdatedAt:    time.Now(),
+			}
+			Expect(repo.Create(ctx, user)).To(Succeed())
+		})
+
+		It("should mark the email as verified", func() {
+			found, err := repo.FindByID(ctx, user.ID)
+			Expect(err).To(BeNil())
+			Expect(found.EmailVerified).To(BeFalse())
+
+			Expect(repo.MarkEmailVerified(ctx, user.ID)).To(Succeed())
+
+			found, err = repo.FindByID(ctx, user.ID)
+			Expect(err).To(BeNil())
+			Expect(found.EmailVerified).To(BeTrue())
+		})
+
+		It("should return not found for a deleted user", func() {
+			Expect(repo.SoftDelete(ctx, user.ID, user.ID)).To(Succeed())
+
+			err := repo.MarkEmailVerified(ctx, user.ID)
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
 	When("performing health check", func() {
 		Context("with active connection", func() {
 			It("should return no error", func() {
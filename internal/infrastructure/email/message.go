@@ -24,7 +24,7 @@ const (
 // Shared by SMTPSender and GmailSender.
 //
 //   - TextBody == "": legacy multipart/related (HTML + optional inline attachments).
-//   - TextBody != "" and Body == "": plain text only (no HTML).
+//   - TextBody != "" and Body == "": plain text only (no HTML), in a multipart/mixed with any attachments.
 //   - TextBody != "" and Body != "": multipart/alternative (text/plain fallback + text/html).
 func buildRFCMessage(fromAddress, fromName string, msg domainemail.Message) ([]byte, error) {
 	from := mime.QEncoding.Encode("utf-8", fromName) + " <" + fromAddress + ">"
@@ -33,6 +33,9 @@ func buildRFCMessage(fromAddress, fromName string, msg domainemail.Message) ([]b
 	if msg.TextBody == "" {
 		return buildRelatedMessage(from, subject, msg)
 	}
+	if msg.Body == "" && len(msg.Attachments) > 0 {
+		return buildMixedTextMessage(from, subject, msg)
+	}
 	if msg.Body == "" {
 		return buildPlainTextMessage(from, subject, msg)
 	}
@@ -55,6 +58,33 @@ func buildPlainTextMessage(from, subject string, msg domainemail.Message) ([]byt
 	return buf.Bytes(), nil
 }
 
+// buildMixedTextMessage constructs a multipart/mixed message with a plain-text body followed by the
+// attachments, which have no HTML to be embedded in.
+func buildMixedTextMessage(from, subject string, msg domainemail.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+
+	headers := []string{
+		"MIME-Version: 1.0",
+		"From: " + from,
+		"To: " + msg.To,
+		"Subject: " + subject,
+		"Content-Type: multipart/mixed; boundary=" + mw.Boundary(),
+	}
+	buf.WriteString(strings.Join(headers, "\r\n") + "\r\n\r\n")
+
+	if err := writeTextPart(mw, msg.TextBody); err != nil {
+		return nil, err
+	}
+	if err := writeAttachmentParts(mw, msg.Attachments); err != nil {
+		return nil, err
+	}
+	if err := mw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
 // buildRelatedMessage constructs a legacy multipart/related message (HTML + optional inline attachments).
 func buildRelatedMessage(from, subject string, msg domainemail.Message) ([]byte, error) {
 	var buf bytes.Buffer
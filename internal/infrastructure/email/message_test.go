@@ -286,6 +286,43 @@ var _ = Describe("buildRFCMessage", func() {
 		})
 	})
 
+	// ── plain text with an attachment ───────────────────────────────────────
+
+	When("building a plain-text message with an attachment", func() {
+		Context("with TextBody and one Attachment but no HTML Body", func() {
+			var output string
+
+			BeforeEach(func() {
+				msg := domainemail.Message{
+					To:       testTo,
+					Subject:  testSubject,
+					TextBody: testTextBody,
+					Attachments: []domainemail.Attachment{{
+						Filename:    "qr.png",
+						ContentType: "image/png",
+						Data:        []byte("qr-image-bytes"),
+						ContentID:   "qrcode",
+					}},
+				}
+				output = msgStr(testFromAddress, testFromName, msg)
+			})
+
+			It("should use multipart/mixed as the outer content type", func() {
+				Expect(output).To(ContainSubstring("Content-Type: multipart/mixed;"))
+			})
+
+			It("should include the text/plain part and no text/html part", func() {
+				Expect(output).To(ContainSubstring("Content-Type: text/plain; charset=utf-8"))
+				Expect(output).NotTo(ContainSubstring("text/html"))
+			})
+
+			It("should contain the base64-encoded attachment data", func() {
+				encoded := base64.StdEncoding.EncodeToString([]byte("qr-image-bytes"))
+				Expect(output).To(ContainSubstring(encoded))
+			})
+		})
+	})
+
 	// ── attachment without ContentID ─────────────────────────────────────────
 
 	When("building a message with an attachment that has no ContentID", func() {
@@ -0,0 +1,108 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"time"
+
+	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"go.uber.org/zap"
+)
+
+// mxCacheKeyPrefix prefixes the cached check result of each domain
+const mxCacheKeyPrefix = "email_domain:"
+
+// Resolver looks up the DNS records an MX check needs. *net.Resolver implements it.
+type Resolver interface {
+	LookupMX(ctx context.Context, name string) ([]*net.MX, error)
+	LookupHost(ctx context.Context, host string) ([]string, error)
+}
+
+// MXChecker checks email domains by their MX records, caching the results.
+type MXChecker struct {
+	resolver Resolver
+	cache    repository.CacheRepository
+	timeout  time.Duration
+	cacheTTL time.Duration
+	logger   *zap.Logger
+}
+
+// NewMXChecker creates a new MXChecker. Each check gives up after timeout; cache may be nil to
+// look every domain up again.
+func NewMXChecker(
+	resolver Resolver,
+	cache repository.CacheRepository,
+	timeout, cacheTTL time.Duration,
+	logger *zap.Logger,
+) *MXChecker {
+	return &MXChecker{
+		resolver: resolver,
+		cache:    cache,
+		timeout:  timeout,
+		cacheTTL: cacheTTL,
+		logger:   logger,
+	}
+}
+
+// CheckDomain reports whether domain can receive mail. A domain without MX records is
+// deliverable if it has an address, which mail servers then use instead (RFC 5321); a domain
+// whose only MX record is "." accepts no mail (RFC 7505). Lookups that fail or time out report
+// DomainUnknown and are not cached.
+func (c *MXChecker) CheckDomain(ctx context.Context, domain string) domainemail.DomainStatus {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	cacheKey := mxCacheKeyPrefix + domain
+
+	if c.cache != nil {
+		cached, err := c.cache.Get(ctx, cacheKey)
+		if err != nil {
+			c.logger.Warn("failed to read cached email domain check", zap.String("domain", domain), zap.Error(err))
+		}
+		if status := domainemail.DomainStatus(cached); status == domainemail.DomainDeliverable ||
+			status == domainemail.DomainUndeliverable {
+			return status
+		}
+	}
+
+	lookupCtx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	status := c.lookup(lookupCtx, domain)
+	if status != domainemail.DomainUnknown && c.cache != nil {
+		if err := c.cache.Set(ctx, cacheKey, string(status), c.cacheTTL); err != nil {
+			c.logger.Warn("failed to cache email domain check", zap.String("domain", domain), zap.Error(err))
+		}
+	}
+	return status
+}
+
+// lookup resolves the mail servers of domain
+func (c *MXChecker) lookup(ctx context.Context, domain string) domainemail.DomainStatus {
+	records, err := c.resolver.LookupMX(ctx, domain)
+	switch {
+	case err == nil && len(records) == 1 && records[0].Host == ".":
+		return domainemail.DomainUndeliverable
+	case err == nil && len(records) > 0:
+		return domainemail.DomainDeliverable
+	case err != nil && !isNotFound(err):
+		c.logger.Debug("email domain MX lookup failed", zap.String("domain", domain), zap.Error(err))
+		return domainemail.DomainUnknown
+	}
+
+	if _, err := c.resolver.LookupHost(ctx, domain); err != nil {
+		if isNotFound(err) {
+			return domainemail.DomainUndeliverable
+		}
+		c.logger.Debug("email domain address lookup failed", zap.String("domain", domain), zap.Error(err))
+		return domainemail.DomainUnknown
+	}
+	return domainemail.DomainDeliverable
+}
+
+// isNotFound reports whether err is a DNS answer that the name or record does not exist
+func isNotFound(err error) bool {
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr) && dnsErr.IsNotFound
+}
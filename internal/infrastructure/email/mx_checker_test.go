@@ -0,0 +1,147 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+// fakeResolver answers DNS lookups from fixed records; a domain listed in slow blocks until
+// the lookup's context is done
+type fakeResolver struct {
+	mx      map[string][]*net.MX
+	hosts   map[string][]string
+	slow    map[string]bool
+	lookups int
+}
+
+func (r *fakeResolver) LookupMX(ctx context.Context, name string) ([]*net.MX, error) {
+	r.lookups++
+	if r.slow[name] {
+		<-ctx.Done()
+		return nil, &net.DNSError{Err: ctx.Err().Error(), Name: name, IsTimeout: true}
+	}
+	if records, ok := r.mx[name]; ok {
+		return records, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: name, IsNotFound: true}
+}
+
+func (r *fakeResolver) LookupHost(_ context.Context, host string) ([]string, error) {
+	if addrs, ok := r.hosts[host]; ok {
+		return addrs, nil
+	}
+	return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+}
+
+var _ = Describe("MXChecker", func() {
+	const cacheTTL = 24 * time.Hour
+
+	var (
+		ctrl     *gomock.Controller
+		cache    *mocks.MockCacheRepository
+		resolver *fakeResolver
+		checker  *MXChecker
+		ctx      context.Context
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		cache = mocks.NewMockCacheRepository(ctrl)
+		resolver = &fakeResolver{
+			mx: map[string][]*net.MX{
+				"gmail.com":    {{Host: "gmail-smtp-in.l.google.com.", Pref: 5}},
+				"no-mail.test": {{Host: ".", Pref: 0}},
+			},
+			hosts: map[string][]string{"example.org": {"93.184.215.14"}},
+			slow:  map[string]bool{"slow.test": true},
+		}
+		checker = NewMXChecker(resolver, cache, 20*time.Millisecond, cacheTTL, zap.NewNop())
+		ctx = context.Background()
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	When("the domain has MX records", func() {
+		It("should report it deliverable and cache the result", func() {
+			cache.EXPECT().Get(ctx, "email_domain:gmail.com").Return("", nil)
+			cache.EXPECT().Set(ctx, "email_domain:gmail.com", "deliverable", cacheTTL).Return(nil)
+
+			Expect(checker.CheckDomain(ctx, "Gmail.com")).To(Equal(domainemail.DomainDeliverable))
+		})
+	})
+
+	When("the domain has no MX records but an address", func() {
+		It("should report it deliverable", func() {
+			cache.EXPECT().Get(ctx, gomock.Any()).Return("", nil)
+			cache.EXPECT().Set(ctx, "email_domain:example.org", "deliverable", cacheTTL).Return(nil)
+
+			Expect(checker.CheckDomain(ctx, "example.org")).To(Equal(domainemail.DomainDeliverable))
+		})
+	})
+
+	When("the domain does not exist", func() {
+		It("should report it undeliverable and cache the result", func() {
+			cache.EXPECT().Get(ctx, "email_domain:gmial.com").Return("", nil)
+			cache.EXPECT().Set(ctx, "email_domain:gmial.com", "undeliverable", cacheTTL).Return(nil)
+
+			Expect(checker.CheckDomain(ctx, "gmial.com")).To(Equal(domainemail.DomainUndeliverable))
+		})
+	})
+
+	When("the domain publishes a null MX record", func() {
+		It("should report it undeliverable", func() {
+			cache.EXPECT().Get(ctx, gomock.Any()).Return("", nil)
+			cache.EXPECT().Set(ctx, "email_domain:no-mail.test", "undeliverable", cacheTTL).Return(nil)
+
+			Expect(checker.CheckDomain(ctx, "no-mail.test")).To(Equal(domainemail.DomainUndeliverable))
+		})
+	})
+
+	When("the lookup times out", func() {
+		It("should report the domain unknown without caching it", func() {
+			cache.EXPECT().Get(ctx, gomock.Any()).Return("", nil)
+
+			start := time.Now()
+			status := checker.CheckDomain(ctx, "slow.test")
+
+			Expect(status).To(Equal(domainemail.DomainUnknown))
+			Expect(time.Since(start)).To(BeNumerically("<", time.Second))
+		})
+	})
+
+	When("the result is cached", func() {
+		It("should not look the domain up", func() {
+			cache.EXPECT().Get(ctx, "email_domain:gmial.com").Return("undeliverable", nil)
+
+			Expect(checker.CheckDomain(ctx, "gmial.com")).To(Equal(domainemail.DomainUndeliverable))
+			Expect(resolver.lookups).To(BeZero())
+		})
+	})
+
+	When("the cache is unavailable", func() {
+		It("should still check the domain", func() {
+			cache.EXPECT().Get(ctx, gomock.Any()).Return("", errors.New("connection refused"))
+			cache.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+
+			Expect(checker.CheckDomain(ctx, "gmail.com")).To(Equal(domainemail.DomainDeliverable))
+		})
+	})
+
+	When("no cache is configured", func() {
+		It("should look the domain up", func() {
+			checker = NewMXChecker(resolver, nil, time.Second, cacheTTL, zap.NewNop())
+
+			Expect(checker.CheckDomain(ctx, "gmail.com")).To(Equal(domainemail.DomainDeliverable))
+			Expect(resolver.lookups).To(Equal(1))
+		})
+	})
+})
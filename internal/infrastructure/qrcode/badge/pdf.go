@@ -0,0 +1,200 @@
+package badge
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// pdfWriter writes a PDF 1.4 document object by object, so pages can be streamed as they are
+// laid out. It records the offset of every object for the cross-reference table written by close.
+// The first write error is kept and returned by every later call.
+type pdfWriter struct {
+	w       io.Writer
+	offset  int64
+	offsets []int64 // offsets[n-1] is the offset of object n
+	err     error
+}
+
+// newPDFWriter writes the PDF header to w.
+func newPDFWriter(w io.Writer) *pdfWriter {
+	p := &pdfWriter{w: w}
+	// The binary comment marks the file as binary for transfer tools
+	p.printf("%%PDF-1.4\n%%\xe2\xe3\xcf\xd3\n")
+	return p
+}
+
+// allocate reserves the number of an object to be written later.
+func (p *pdfWriter) allocate() int {
+	p.offsets = append(p.offsets, -1)
+	return len(p.offsets)
+}
+
+// object writes object n with the given body.
+func (p *pdfWriter) object(n int, body string) {
+	p.offsets[n-1] = p.offset
+	p.printf("%d 0 obj\n%s\nendobj\n", n, body)
+}
+
+// stream writes object n as a stream with the given dictionary entries.
+func (p *pdfWriter) stream(n int, dict string, data []byte) {
+	p.offsets[n-1] = p.offset
+	if dict != "" {
+		dict += " "
+	}
+	p.printf("%d 0 obj\n<< %s/Length %d >>\nstream\n", n, dict, len(data))
+	p.write(data)
+	p.printf("\nendstream\nendobj\n")
+}
+
+// image writes img as an 8-bit grayscale image XObject, compressed with Flate.
+func (p *pdfWriter) image(n int, img image.Image) error {
+	bounds := img.Bounds()
+	var data bytes.Buffer
+	zw := zlib.NewWriter(&data)
+	row := make([]byte, bounds.Dx())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			row[x-bounds.Min.X] = color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y
+		}
+		if _, err := zw.Write(row); err != nil {
+			return err
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return err
+	}
+
+	p.stream(n, fmt.Sprintf(
+		"/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray "+
+			"/BitsPerComponent 8 /Filter /FlateDecode",
+		bounds.Dx(), bounds.Dy(),
+	), data.Bytes())
+	return nil
+}
+
+// close writes the cross-reference table and the trailer pointing at the catalog object.
+func (p *pdfWriter) close(catalog int) error {
+	xref := p.offset
+	p.printf("xref\n0 %d\n0000000000 65535 f \n", len(p.offsets)+1)
+	for n, offset := range p.offsets {
+		if offset < 0 {
+			return fmt.Errorf("PDF object %d was never written", n+1)
+		}
+		p.printf("%010d 00000 n \n", offset)
+	}
+	p.printf("trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(p.offsets)+1, catalog, xref)
+	return p.err
+}
+
+func (p *pdfWriter) printf(format string, args ...any) {
+	p.write(fmt.Appendf(nil, format, args...))
+}
+
+func (p *pdfWriter) write(data []byte) {
+	if p.err != nil {
+		return
+	}
+	n, err := p.w.Write(data)
+	p.offset += int64(n)
+	p.err = err
+}
+
+// helveticaWidths are the widths of the printable ASCII characters of Helvetica, from space
+// to tilde, in thousandths of the font size.
+var helveticaWidths = [...]int{
+	278, 278, 355, 556, 556, 889, 667, 191, 333, 333, 389, 584, 278, 333, 278, 278, // space to /
+	556, 556, 556, 556, 556, 556, 556, 556, 556, 556, 278, 278, 584, 584, 584, 556, // 0 to ?
+	1015, 667, 667, 722, 722, 667, 611, 778, 722, 278, 500, 667, 556, 833, 722, 778, // @ to O
+	667, 778, 722, 667, 611, 722, 667, 944, 667, 667, 611, 278, 278, 278, 469, 556, // P to _
+	333, 556, 556, 500, 556, 556, 278, 556, 556, 222, 222, 500, 222, 833, 556, 556, // ` to o
+	556, 556, 333, 500, 278, 556, 500, 722, 500, 500, 500, 334, 260, 334, 584, // p to ~
+}
+
+// defaultCharWidth is used for the characters outside printable ASCII.
+const defaultCharWidth = 556
+
+// winAnsi converts text to the WinAnsi encoding of the standard PDF fonts. Characters outside
+// Latin-1 cannot be shown with them and become question marks.
+func winAnsi(text string) []byte {
+	encoded := make([]byte, 0, len(text))
+	for _, r := range text {
+		if isWinAnsi(r) {
+			encoded = append(encoded, byte(r))
+		} else {
+			encoded = append(encoded, '?')
+		}
+	}
+	return encoded
+}
+
+// isWinAnsi reports whether the standard PDF fonts can show r.
+func isWinAnsi(r rune) bool {
+	return (r >= ' ' && r <= '~') || (r >= 0xa0 && r <= 0xff)
+}
+
+// CanRender reports whether every character of text can be printed on a badge; the others
+// are printed as question marks.
+func CanRender(text string) bool {
+	for _, r := range text {
+		if !isWinAnsi(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// textWidth returns the width of WinAnsi encoded text set in Helvetica at size points.
+func textWidth(text []byte, size float64) float64 {
+	total := 0
+	for _, c := range text {
+		if c >= ' ' && c <= '~' {
+			total += helveticaWidths[c-' ']
+		} else {
+			total += defaultCharWidth
+		}
+	}
+	return float64(total) * size / 1000
+}
+
+// fitText encodes text and shortens it with an ellipsis until it fits maxWidth at size points.
+func fitText(text string, size, maxWidth float64) []byte {
+	encoded := winAnsi(text)
+	if textWidth(encoded, size) <= maxWidth {
+		return encoded
+	}
+	for len(encoded) > 0 {
+		encoded = encoded[:len(encoded)-1]
+		shortened := append(bytes.Clone(bytes.TrimRight(encoded, " ")), "..."...)
+		if textWidth(shortened, size) <= maxWidth {
+			return shortened
+		}
+	}
+	return nil
+}
+
+// pdfString formats encoded text as a PDF literal string.
+func pdfString(text []byte) string {
+	var b strings.Builder
+	b.WriteByte('(')
+	for _, c := range text {
+		if c == '(' || c == ')' || c == '\\' {
+			b.WriteByte('\\')
+		}
+		b.WriteByte(c)
+	}
+	b.WriteByte(')')
+	return b.String()
+}
+
+// num formats a coordinate with at most two decimals.
+func num(f float64) string {
+	s := strconv.FormatFloat(f, 'f', 2, 64)
+	s = strings.TrimRight(s, "0")
+	return strings.TrimSuffix(s, ".")
+}
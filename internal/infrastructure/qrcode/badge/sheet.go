@@ -0,0 +1,181 @@
+// Package badge lays out printable participant badges, each with a name, an email and a QR
+// code from the qrcode generator, on the A4 pages of a PDF document.
+package badge
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image/png"
+	"io"
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+)
+
+const (
+	// DefaultPerPage is the default number of badges per page, in a 2x4 grid.
+	DefaultPerPage = 8
+
+	// MaxPerPage is the largest number of badges per page, in a 2x5 grid.
+	MaxPerPage = 10
+)
+
+// Page layout in PDF points (1/72 inch)
+const (
+	pageWidth    = 595.28 // A4
+	pageHeight   = 841.89 // A4
+	pageMargin   = 36
+	cellPadding  = 12
+	nameSize     = 12
+	emailSize    = 9
+	textBlock    = 34 // space below the QR code for the name and the email
+	qrCodePixels = 300
+)
+
+// ErrInvalidPerPage indicates the number of badges per page is out of range.
+var ErrInvalidPerPage = errors.New("badges per page must be between 1 and 10")
+
+// Badge is the content of a participant's badge.
+type Badge struct {
+	Name   string
+	Email  string
+	QRCode string
+}
+
+// Sheet is a PDF document of badges laid out in a grid: a single column for one badge per page,
+// two columns otherwise. Names and emails are set in Helvetica, which only covers Latin-1;
+// other characters are printed as question marks, and Unrenderable counts the badges affected.
+type Sheet struct {
+	generator *qrcode.Generator
+	badges    []Badge
+	perPage   int
+	columns   int
+	rows      int
+}
+
+// NewSheet creates a sheet of badges with perPage badges on each page.
+// Returns ErrInvalidPerPage if perPage is not between 1 and MaxPerPage.
+func NewSheet(generator *qrcode.Generator, badges []Badge, perPage int) (*Sheet, error) {
+	if perPage < 1 || perPage > MaxPerPage {
+		return nil, ErrInvalidPerPage
+	}
+	columns := min(perPage, 2)
+	return &Sheet{
+		generator: generator,
+		badges:    badges,
+		perPage:   perPage,
+		columns:   columns,
+		rows:      (perPage + columns - 1) / columns,
+	}, nil
+}
+
+// Unrenderable returns the number of badges whose name or email has characters that cannot
+// be printed, e.g. Japanese names, so that callers can warn before the sheet is printed.
+func (s *Sheet) Unrenderable() int {
+	count := 0
+	for _, b := range s.badges {
+		if !CanRender(b.Name) || !CanRender(b.Email) {
+			count++
+		}
+	}
+	return count
+}
+
+// Pages returns the number of pages of the sheet. A sheet without badges has one blank page.
+func (s *Sheet) Pages() int {
+	return max(1, (len(s.badges)+s.perPage-1)/s.perPage)
+}
+
+// Render writes the sheet to w as a PDF document. Pages are written as they are laid out, so
+// the document is streamed rather than built in memory. Rendering stops when ctx is cancelled.
+func (s *Sheet) Render(ctx context.Context, w io.Writer) error {
+	p := newPDFWriter(w)
+	catalog := p.allocate()
+	pages := p.allocate()
+	font := p.allocate()
+	p.object(font, "<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>")
+
+	kids := make([]string, 0, s.Pages())
+	for page := range s.Pages() {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		start := page * s.perPage
+		end := min(start+s.perPage, len(s.badges))
+		pageObject, err := s.renderPage(ctx, p, pages, font, s.badges[start:end])
+		if err != nil {
+			return err
+		}
+		kids = append(kids, fmt.Sprintf("%d 0 R", pageObject))
+	}
+
+	p.object(pages, fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(kids)))
+	p.object(catalog, fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R >>", pages))
+	return p.close(catalog)
+}
+
+// renderPage writes the QR code images, the content and the page object of one page and
+// returns the number of the page object.
+func (s *Sheet) renderPage(ctx context.Context, p *pdfWriter, pages, font int, badges []Badge) (int, error) {
+	cellWidth := (pageWidth - 2*pageMargin) / float64(s.columns)
+	cellHeight := (pageHeight - 2*pageMargin) / float64(s.rows)
+	qrSize := min(cellWidth-2*cellPadding, cellHeight-2*cellPadding-textBlock)
+
+	var content strings.Builder
+	var images []string
+	for i, badge := range badges {
+		qrPNG, err := s.generator.GeneratePNG(ctx, badge.QRCode, qrCodePixels)
+		if err != nil {
+			return 0, err
+		}
+		qrImage, err := png.Decode(bytes.NewReader(qrPNG))
+		if err != nil {
+			return 0, fmt.Errorf("failed to decode QR code: %w", err)
+		}
+		imageObject := p.allocate()
+		if err := p.image(imageObject, qrImage); err != nil {
+			return 0, fmt.Errorf("failed to encode QR code: %w", err)
+		}
+		images = append(images, fmt.Sprintf("/Im%d %d 0 R", i, imageObject))
+
+		left := pageMargin + float64(i%s.columns)*cellWidth
+		top := pageHeight - pageMargin - float64(i/s.columns)*cellHeight
+
+		// Dashed cut lines around the badge
+		fmt.Fprintf(&content, "0.75 G 0.5 w [4 4] 0 d %s %s %s %s re S [] 0 d\n",
+			num(left), num(top-cellHeight), num(cellWidth), num(cellHeight))
+
+		qrLeft := left + (cellWidth-qrSize)/2
+		qrBottom := top - cellPadding - qrSize
+		fmt.Fprintf(&content, "q %s 0 0 %s %s %s cm /Im%d Do Q\n",
+			num(qrSize), num(qrSize), num(qrLeft), num(qrBottom), i)
+
+		maxTextWidth := cellWidth - 2*cellPadding
+		writeCenteredText(&content, fitText(badge.Name, nameSize, maxTextWidth), nameSize, left, cellWidth,
+			qrBottom-18)
+		writeCenteredText(&content, fitText(badge.Email, emailSize, maxTextWidth), emailSize, left, cellWidth,
+			qrBottom-32)
+	}
+
+	contentObject := p.allocate()
+	p.stream(contentObject, "", []byte(content.String()))
+
+	pageObject := p.allocate()
+	p.object(pageObject, fmt.Sprintf(
+		"<< /Type /Page /Parent %d 0 R /MediaBox [0 0 %s %s] "+
+			"/Resources << /Font << /F1 %d 0 R >> /XObject << %s >> >> /Contents %d 0 R >>",
+		pages, num(pageWidth), num(pageHeight), font, strings.Join(images, " "), contentObject,
+	))
+	return pageObject, p.err
+}
+
+// writeCenteredText writes the operators setting encoded text centered in a cell at baseline y.
+func writeCenteredText(content *strings.Builder, text []byte, size, left, cellWidth, y float64) {
+	if len(text) == 0 {
+		return
+	}
+	x := left + (cellWidth-textWidth(text, size))/2
+	fmt.Fprintf(content, "0 g BT /F1 %s Tf %s %s Td %s Tj ET\n", num(size), num(x), num(y), pdfString(text))
+}
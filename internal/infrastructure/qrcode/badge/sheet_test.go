@@ -0,0 +1,152 @@
+package badge_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode/badge"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sheet", func() {
+	var (
+		ctx       context.Context
+		generator *qrcode.Generator
+	)
+
+	badges := func(n int) []badge.Badge {
+		result := make([]badge.Badge, n)
+		for i := range result {
+			result[i] = badge.Badge{
+				Name:   fmt.Sprintf("Participant %d", i+1),
+				Email:  fmt.Sprintf("participant%d@example.com", i+1),
+				QRCode: fmt.Sprintf("evt_12345678_prt_%08d_token", i+1),
+			}
+		}
+		return result
+	}
+
+	render := func(sheet *badge.Sheet) []byte {
+		var buf bytes.Buffer
+		Expect(sheet.Render(ctx, &buf)).To(Succeed())
+		return buf.Bytes()
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		generator = qrcode.NewGenerator()
+	})
+
+	Describe("NewSheet", func() {
+		It("should reject a number of badges per page out of range", func() {
+			_, err := badge.NewSheet(generator, nil, 0)
+			Expect(err).To(MatchError(badge.ErrInvalidPerPage))
+
+			_, err = badge.NewSheet(generator, nil, badge.MaxPerPage+1)
+			Expect(err).To(MatchError(badge.ErrInvalidPerPage))
+		})
+	})
+
+	Describe("Unrenderable", func() {
+		It("should count the badges whose name or email the font cannot show", func() {
+			sheet := must(badge.NewSheet(generator, []badge.Badge{
+				{Name: "José Müller", Email: "jose@example.com", QRCode: "token-1"},
+				{Name: "山田太郎", Email: "taro@example.com", QRCode: "token-2"},
+				{Name: "Taro Yamada", Email: "太郎@example.jp", QRCode: "token-3"},
+			}, badge.DefaultPerPage))
+
+			Expect(sheet.Unrenderable()).To(Equal(2))
+		})
+	})
+
+	Describe("CanRender", func() {
+		It("should accept Latin-1 text and reject other characters", func() {
+			Expect(badge.CanRender("Zoë O'Brien")).To(BeTrue())
+			Expect(badge.CanRender("")).To(BeTrue())
+			Expect(badge.CanRender("カンファレンス")).To(BeFalse())
+			Expect(badge.CanRender("Łukasz")).To(BeFalse())
+		})
+	})
+
+	Describe("Render", func() {
+		It("should write a PDF document with a page per started group of badges", func() {
+			sheet, err := badge.NewSheet(generator, badges(9), badge.DefaultPerPage)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sheet.Pages()).To(Equal(2))
+
+			pdf := render(sheet)
+
+			Expect(pdf).To(HavePrefix("%PDF-1.4\n"))
+			Expect(pdf).To(HaveSuffix("%%EOF\n"))
+			Expect(string(pdf)).To(ContainSubstring("/Type /Pages /Kids ["))
+			Expect(string(pdf)).To(ContainSubstring("/Count 2 >>"))
+			Expect(regexp.MustCompile(`/Subtype /Image`).FindAll(pdf, -1)).To(HaveLen(9))
+			Expect(string(pdf)).To(ContainSubstring("(Participant 9) Tj"))
+			Expect(string(pdf)).To(ContainSubstring("(participant9@example.com) Tj"))
+		})
+
+		It("should point the cross-reference table at every object", func() {
+			pdf := render(must(badge.NewSheet(generator, badges(3), 2)))
+
+			startxref := regexp.MustCompile(`startxref\n(\d+)\n`).FindSubmatch(pdf)
+			Expect(startxref).NotTo(BeNil())
+			xrefOffset, _ := strconv.Atoi(string(startxref[1]))
+			Expect(pdf[xrefOffset:]).To(HavePrefix("xref\n"))
+
+			entries := regexp.MustCompile(`(\d{10}) 00000 n \n`).FindAllSubmatch(pdf[xrefOffset:], -1)
+			Expect(entries).NotTo(BeEmpty())
+			for i, entry := range entries {
+				offset, _ := strconv.Atoi(string(entry[1]))
+				Expect(pdf[offset:]).To(HavePrefix(fmt.Sprintf("%d 0 obj\n", i+1)))
+			}
+		})
+
+		It("should write a blank page without badges", func() {
+			pdf := render(must(badge.NewSheet(generator, nil, badge.DefaultPerPage)))
+
+			Expect(string(pdf)).To(ContainSubstring("/Count 1 >>"))
+			Expect(string(pdf)).NotTo(ContainSubstring("/Subtype /Image"))
+		})
+
+		It("should escape names and replace characters the font cannot show", func() {
+			sheet := must(badge.NewSheet(generator, []badge.Badge{
+				{Name: `Jane (Jay) Smith\`, Email: "jane@example.com", QRCode: "token-1"},
+				{Name: "山田太郎", Email: "taro@example.com", QRCode: "token-2"},
+			}, 2))
+
+			pdf := string(render(sheet))
+
+			Expect(pdf).To(ContainSubstring(`(Jane \(Jay\) Smith\\) Tj`))
+			Expect(pdf).To(ContainSubstring("(????) Tj"))
+		})
+
+		It("should shorten names too long for the badge", func() {
+			long := "Participant With An Exceptionally Long Name That Does Not Fit On A Badge"
+			sheet := must(badge.NewSheet(generator, []badge.Badge{{Name: long, QRCode: "token"}}, badge.MaxPerPage))
+
+			pdf := string(render(sheet))
+
+			Expect(pdf).NotTo(ContainSubstring(long))
+			Expect(pdf).To(MatchRegexp(`\(Participant With An[^)]*\.\.\.\) Tj`))
+		})
+
+		It("should stop when the context is cancelled", func() {
+			cancelled, cancel := context.WithCancel(ctx)
+			cancel()
+
+			err := must(badge.NewSheet(generator, badges(1), 1)).Render(cancelled, &bytes.Buffer{})
+
+			Expect(err).To(MatchError(context.Canceled))
+		})
+	})
+})
+
+func must(sheet *badge.Sheet, err error) *badge.Sheet {
+	Expect(err).NotTo(HaveOccurred())
+	return sheet
+}
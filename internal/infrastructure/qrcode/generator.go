@@ -1,10 +1,15 @@
 package qrcode
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
 
 	"github.com/skip2/go-qrcode"
 )
@@ -37,8 +42,24 @@ const (
 	// DEFAULT_ERROR_CORRECTION is the default error correction level.
 	// Medium provides good balance between data capacity and error recovery.
 	DEFAULT_ERROR_CORRECTION = ErrorCorrectionMedium
+
+	// DEFAULT_LOGO_RATIO is the default share of the QR code width a logo is scaled to.
+	DEFAULT_LOGO_RATIO = 0.2
+
+	// MAX_LOGO_RATIO caps the logo width so the covered modules stay well within what the
+	// highest error correction level can recover.
+	MAX_LOGO_RATIO = 0.25
+
+	// logoPadding is the white margin in pixels kept around a logo to separate it from the modules.
+	logoPadding = 2
+
+	// healthCheckToken is the throwaway token encoded by HealthCheck.
+	healthCheckToken = "ezqrin-health"
 )
 
+// pngSignature is the 8-byte header every PNG file starts with.
+var pngSignature = []byte{0x89, 'P', 'N', 'G', '\r', '\n', 0x1a, '\n'}
+
 // QR code generation errors
 var (
 	// ErrEmptyToken indicates the token string is empty.
@@ -49,13 +70,22 @@ var (
 
 	// ErrGenerationFailed indicates QR code generation failed.
 	ErrGenerationFailed = errors.New("failed to generate QR code")
+
+	// ErrInvalidLogo indicates the logo image is missing or empty.
+	ErrInvalidLogo = errors.New("logo image cannot be empty")
 )
 
+// HealthChecker defines the interface for QR code generator health checking.
+type HealthChecker interface {
+	HealthCheck(ctx context.Context) error
+}
+
 // Generator provides QR code generation functionality.
 // It supports multiple output formats (PNG, SVG, Base64) with configurable
 // size and error correction levels.
 type Generator struct {
 	errorCorrection qrcode.RecoveryLevel
+	logoRatio       float64
 }
 
 // NewGenerator creates a new QR code generator with default settings.
@@ -63,6 +93,7 @@ type Generator struct {
 func NewGenerator() *Generator {
 	return &Generator{
 		errorCorrection: qrcode.Medium,
+		logoRatio:       DEFAULT_LOGO_RATIO,
 	}
 }
 
@@ -70,6 +101,7 @@ func NewGenerator() *Generator {
 func NewGeneratorWithErrorCorrection(level ErrorCorrectionLevel) *Generator {
 	return &Generator{
 		errorCorrection: mapErrorCorrectionLevel(level),
+		logoRatio:       DEFAULT_LOGO_RATIO,
 	}
 }
 
@@ -106,6 +138,49 @@ func (g *Generator) GeneratePNG(ctx context.Context, token string, size int) ([]
 	return png, nil
 }
 
+// GeneratePNGWithLogo generates a QR code as PNG binary data with a logo overlaid in its center.
+// The logo is scaled to the generator's logo ratio of the QR code width, keeping its aspect
+// ratio, on a white background. The QR code always uses the highest error correction level
+// regardless of the generator's setting, so it still scans with the covered modules.
+//
+// Parameters:
+//   - ctx: Context for cancellation (reserved for future async support)
+//   - token: The token string to encode in the QR code
+//   - size: The QR code size in pixels (must be between 64 and 2048)
+//   - logo: The logo image to overlay
+//
+// Returns PNG binary data or an error if generation fails.
+func (g *Generator) GeneratePNGWithLogo(ctx context.Context, token string, size int, logo image.Image) ([]byte, error) {
+	if token == "" {
+		return nil, ErrEmptyToken
+	}
+
+	if err := validateSize(size); err != nil {
+		return nil, err
+	}
+
+	if logo == nil || logo.Bounds().Empty() {
+		return nil, ErrInvalidLogo
+	}
+
+	qr, err := qrcode.New(token, qrcode.Highest)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrGenerationFailed, err)
+	}
+
+	qrImage := qr.Image(size)
+	canvas := image.NewRGBA(qrImage.Bounds())
+	draw.Draw(canvas, canvas.Bounds(), qrImage, qrImage.Bounds().Min, draw.Src)
+	overlayLogo(canvas, logo, g.logoRatio)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, canvas); err != nil {
+		return nil, fmt.Errorf("%w: PNG encoding failed: %w", ErrGenerationFailed, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
 // GeneratePNGBase64 generates a QR code as a base64-encoded PNG string.
 // The output can be directly used in HTML img src attributes (data:image/png;base64,...)
 //
@@ -164,12 +239,67 @@ func (g *Generator) GenerateSVG(ctx context.Context, token string, size int) (st
 	return svg, nil
 }
 
+// HealthCheck verifies that the generator can produce a QR code by encoding a short throwaway
+// token at the minimum size and checking the output is a PNG image.
+func (g *Generator) HealthCheck(ctx context.Context) error {
+	png, err := g.GeneratePNG(ctx, healthCheckToken, MIN_SIZE)
+	if err != nil {
+		return err
+	}
+	if !bytes.HasPrefix(png, pngSignature) {
+		return fmt.Errorf("%w: output is not a PNG image", ErrGenerationFailed)
+	}
+	return nil
+}
+
 // SetErrorCorrection sets the error correction level for the generator.
 // This affects all subsequent QR code generations.
 func (g *Generator) SetErrorCorrection(level ErrorCorrectionLevel) {
 	g.errorCorrection = mapErrorCorrectionLevel(level)
 }
 
+// SetLogoRatio sets the share of the QR code width logos are scaled to.
+// Ratios above MAX_LOGO_RATIO are capped, and non-positive ratios restore DEFAULT_LOGO_RATIO.
+func (g *Generator) SetLogoRatio(ratio float64) {
+	switch {
+	case ratio <= 0:
+		g.logoRatio = DEFAULT_LOGO_RATIO
+	case ratio > MAX_LOGO_RATIO:
+		g.logoRatio = MAX_LOGO_RATIO
+	default:
+		g.logoRatio = ratio
+	}
+}
+
+// overlayLogo scales logo to fit a centered square of ratio times the canvas width and draws it
+// over a white padding box. Scaling uses nearest-neighbour sampling.
+func overlayLogo(canvas *image.RGBA, logo image.Image, ratio float64) {
+	bounds := canvas.Bounds()
+	src := logo.Bounds()
+	box := int(float64(bounds.Dx()) * ratio)
+
+	width, height := box, box
+	if src.Dx() > src.Dy() {
+		height = max(1, box*src.Dy()/src.Dx())
+	} else {
+		width = max(1, box*src.Dx()/src.Dy())
+	}
+	left := bounds.Min.X + (bounds.Dx()-width)/2
+	top := bounds.Min.Y + (bounds.Dy()-height)/2
+	target := image.Rect(left, top, left+width, top+height)
+
+	padded := target.Inset(-logoPadding).Intersect(bounds)
+	draw.Draw(canvas, padded, image.NewUniform(color.White), image.Point{}, draw.Src)
+
+	scaled := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			scaled.Set(x, y, logo.At(src.Min.X+x*src.Dx()/width, src.Min.Y+y*src.Dy()/height))
+		}
+	}
+	draw.Draw(canvas, target, scaled, image.Point{}, draw.Over)
+}
+
 // validateSize validates the QR code size is within acceptable range.
 func validateSize(size int) error {
 	if size < MIN_SIZE || size > MAX_SIZE {
@@ -1,10 +1,15 @@
 package qrcode_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
 	"strings"
 
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
@@ -226,6 +231,96 @@ var _ = Describe("QR Code Generator", func() {
 		})
 	})
 
+	Describe("GeneratePNGWithLogo", func() {
+		var logo *image.RGBA
+
+		red := color.RGBA{R: 255, A: 255}
+
+		// redPixels decodes a PNG and counts its pure red pixels, i.e. the ones covered by the logo.
+		redPixels := func(data []byte) int {
+			img, err := png.Decode(bytes.NewReader(data))
+			Expect(err).NotTo(HaveOccurred())
+			count := 0
+			for y := img.Bounds().Min.Y; y < img.Bounds().Max.Y; y++ {
+				for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+					if color.RGBAModel.Convert(img.At(x, y)) == red {
+						count++
+					}
+				}
+			}
+			return count
+		}
+
+		BeforeEach(func() {
+			logo = image.NewRGBA(image.Rect(0, 0, 40, 40))
+			draw.Draw(logo, logo.Bounds(), image.NewUniform(red), image.Point{}, draw.Src)
+		})
+
+		When("generating a QR code with a logo", func() {
+			It("should overlay the logo in the center at the default ratio", func() {
+				data, err := generator.GeneratePNGWithLogo(ctx, token, 500, logo)
+
+				Expect(err).NotTo(HaveOccurred())
+				img, err := png.Decode(bytes.NewReader(data))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(img.Bounds().Dx()).To(Equal(500))
+				Expect(color.RGBAModel.Convert(img.At(250, 250))).To(Equal(red))
+				Expect(redPixels(data)).To(Equal(100 * 100))
+			})
+
+			It("should keep the aspect ratio of the logo", func() {
+				wide := image.NewRGBA(image.Rect(0, 0, 80, 40))
+				draw.Draw(wide, wide.Bounds(), image.NewUniform(red), image.Point{}, draw.Src)
+
+				data, err := generator.GeneratePNGWithLogo(ctx, token, 500, wide)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(redPixels(data)).To(Equal(100 * 50))
+			})
+
+			It("should cap the logo ratio", func() {
+				generator.SetLogoRatio(0.9)
+
+				data, err := generator.GeneratePNGWithLogo(ctx, token, 400, logo)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(redPixels(data)).To(Equal(100 * 100))
+			})
+
+			It("should use the highest error correction regardless of the generator's setting", func() {
+				low := qrcode.NewGeneratorWithErrorCorrection(qrcode.ErrorCorrectionLow)
+				highest := qrcode.NewGeneratorWithErrorCorrection(qrcode.ErrorCorrectionHighest)
+
+				lowData, err := low.GeneratePNGWithLogo(ctx, token, 256, logo)
+				Expect(err).NotTo(HaveOccurred())
+				highestData, err := highest.GeneratePNGWithLogo(ctx, token, 256, logo)
+				Expect(err).NotTo(HaveOccurred())
+				plain, err := low.GeneratePNG(ctx, token, 256)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(lowData).To(Equal(highestData))
+				Expect(lowData).NotTo(Equal(plain))
+			})
+		})
+
+		When("the input is invalid", func() {
+			It("should return ErrInvalidLogo without a logo", func() {
+				_, err := generator.GeneratePNGWithLogo(ctx, token, 256, nil)
+				Expect(errors.Is(err, qrcode.ErrInvalidLogo)).To(BeTrue())
+			})
+
+			It("should return ErrEmptyToken", func() {
+				_, err := generator.GeneratePNGWithLogo(ctx, "", 256, logo)
+				Expect(errors.Is(err, qrcode.ErrEmptyToken)).To(BeTrue())
+			})
+
+			It("should return ErrInvalidSize", func() {
+				_, err := generator.GeneratePNGWithLogo(ctx, token, 5000, logo)
+				Expect(errors.Is(err, qrcode.ErrInvalidSize)).To(BeTrue())
+			})
+		})
+	})
+
 	Describe("GeneratePNGBase64", func() {
 		When("generating base64-encoded PNG QR codes", func() {
 			Context("with valid input", func() {
@@ -666,4 +761,19 @@ var _ = Describe("QR Code Generator", func() {
 			})
 		})
 	})
+
+	Describe("HealthCheck", func() {
+		It("should pass for a normally-constructed generator", func() {
+			Expect(generator.HealthCheck(ctx)).To(Succeed())
+		})
+
+		It("should pass at the lowest and highest error correction levels", func() {
+			for _, level := range []qrcode.ErrorCorrectionLevel{
+				qrcode.ErrorCorrectionLow,
+				qrcode.ErrorCorrectionHighest,
+			} {
+				Expect(qrcode.NewGeneratorWithErrorCorrection(level).HealthCheck(ctx)).To(Succeed())
+			}
+		})
+	})
 })
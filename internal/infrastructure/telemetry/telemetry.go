@@ -7,6 +7,7 @@ import (
 
 	"github.com/fumkob/ezqrin-server/config"
 	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 	sdklog "go.opentelemetry.io/otel/sdk/log"
 	"go.opentelemetry.io/otel/sdk/metric"
 	"go.opentelemetry.io/otel/sdk/resource"
@@ -63,6 +64,11 @@ func Init(ctx context.Context, cfg config.TelemetryConfig) (*Providers, Shutdown
 	// Register globally
 	otel.SetTracerProvider(tp)
 	otel.SetMeterProvider(mp)
+	// Continue traces started by callers from their W3C traceparent and baggage headers
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
 
 	providers := &Providers{
 		TracerProvider: tp,
@@ -216,6 +216,7 @@ var _ = Describe("Init", func() {
 			// Verify global registration
 			Expect(otel.GetTracerProvider()).To(Equal(providers.TracerProvider))
 			Expect(otel.GetMeterProvider()).To(Equal(providers.MeterProvider))
+			Expect(otel.GetTextMapPropagator().Fields()).To(ContainElements("traceparent", "baggage"))
 
 			// Shutdown should succeed (graceful drain even without collector)
 			shutdownCtx, cancel := context.WithTimeout(ctx, 1*time.Second)
@@ -0,0 +1,113 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+
+	domainwebhook "github.com/fumkob/ezqrin-server/internal/domain/webhook"
+)
+
+// ErrAddressNotAllowed is returned for webhook hosts that are not public addresses.
+var ErrAddressNotAllowed = errors.New("webhook: destination address is not allowed")
+
+// nonPublicPrefixes are the special-purpose ranges not covered by the netip.Addr predicates
+// checked in isPublic.
+var nonPublicPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),       // "This" network
+	netip.MustParsePrefix("100.64.0.0/10"),   // Carrier-grade NAT
+	netip.MustParsePrefix("192.0.0.0/24"),    // IETF protocol assignments
+	netip.MustParsePrefix("192.0.2.0/24"),    // TEST-NET-1
+	netip.MustParsePrefix("198.18.0.0/15"),   // Benchmarking
+	netip.MustParsePrefix("198.51.100.0/24"), // TEST-NET-2
+	netip.MustParsePrefix("203.0.113.0/24"),  // TEST-NET-3
+	netip.MustParsePrefix("240.0.0.0/4"),     // Reserved, broadcast included
+	netip.MustParsePrefix("64:ff9b::/96"),    // NAT64, may translate to private IPv4 addresses
+	netip.MustParsePrefix("2001:db8::/32"),   // Documentation
+}
+
+// AddressGuard keeps webhooks configured by users away from the server's own network. It
+// checks their URLs when they are set and every address their requests connect to, so that a
+// host resolving to another address by the time of delivery, or a redirect, is caught too.
+type AddressGuard struct {
+	resolver *net.Resolver
+}
+
+// NewAddressGuard creates a new AddressGuard resolving hosts with resolver.
+func NewAddressGuard(resolver *net.Resolver) *AddressGuard {
+	return &AddressGuard{resolver: resolver}
+}
+
+// CheckURL resolves the host of a webhook URL and fails unless all of its addresses are public.
+func (g *AddressGuard) CheckURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("webhook: invalid URL: %w", err)
+	}
+	host := u.Hostname()
+
+	if addr, err := netip.ParseAddr(host); err == nil {
+		return checkAddr(addr)
+	}
+
+	addrs, err := g.resolver.LookupNetIP(ctx, "ip", host)
+	if err != nil {
+		return fmt.Errorf("webhook: host %s could not be resolved", host)
+	}
+	for _, addr := range addrs {
+		if err := checkAddr(addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Client returns an HTTP client with the given timeout that refuses to connect to addresses
+// that are not public. It does not use a proxy, so that the check applies to the webhook itself.
+func (g *AddressGuard) Client(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout, Control: g.control}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// control is the net.Dialer hook checking the resolved address of every connection.
+func (g *AddressGuard) control(_, address string, _ syscall.RawConn) error {
+	addrPort, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return ErrAddressNotAllowed
+	}
+	return checkAddr(addrPort.Addr())
+}
+
+// checkAddr returns ErrAddressNotAllowed unless addr is public.
+func checkAddr(addr netip.Addr) error {
+	if !isPublic(addr) {
+		return ErrAddressNotAllowed
+	}
+	return nil
+}
+
+// isPublic reports whether addr is a global unicast address outside the private and
+// special-purpose ranges.
+func isPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return false
+	}
+	for _, prefix := range nonPublicPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+var _ domainwebhook.URLGuard = (*AddressGuard)(nil)
@@ -0,0 +1,44 @@
+package webhook
+
+import (
+	"context"
+	"net"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddressGuard", func() {
+	var guard *AddressGuard
+
+	BeforeEach(func() {
+		guard = NewAddressGuard(net.DefaultResolver)
+	})
+
+	DescribeTable("CheckURL",
+		func(rawURL string, allowed bool) {
+			err := guard.CheckURL(context.Background(), rawURL)
+			if allowed {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(MatchError(ErrAddressNotAllowed))
+			}
+		},
+		Entry("public IPv4 address", "https://93.184.215.14/hook", true),
+		Entry("public IPv6 address", "https://[2606:4700::1111]/hook", true),
+		Entry("loopback address", "http://127.0.0.1:8080/hook", false),
+		Entry("private address", "http://10.0.0.5/hook", false),
+		Entry("cloud metadata address", "http://169.254.169.254/latest/meta-data", false),
+		Entry("carrier-grade NAT address", "http://100.64.0.1/hook", false),
+		Entry("unspecified address", "http://0.0.0.0/hook", false),
+		Entry("IPv6 loopback address", "http://[::1]/hook", false),
+		Entry("IPv6 unique local address", "http://[fd00::1]/hook", false),
+		Entry("IPv4-mapped private address", "http://[::ffff:192.168.1.1]/hook", false),
+	)
+
+	It("should refuse connections to addresses that are not public", func() {
+		Expect(guard.control("tcp4", "127.0.0.1:443", nil)).To(MatchError(ErrAddressNotAllowed))
+		Expect(guard.control("tcp6", "[fe80::1]:443", nil)).To(MatchError(ErrAddressNotAllowed))
+		Expect(guard.control("tcp4", "93.184.215.14:443", nil)).To(Succeed())
+	})
+})
@@ -0,0 +1,154 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	domainwebhook "github.com/fumkob/ezqrin-server/internal/domain/webhook"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// eventWebhookTypes are the outbox event types delivered to event webhooks.
+var eventWebhookTypes = map[entity.OutboxEventType]bool{
+	entity.OutboxEventCheckinCreated:     true,
+	entity.OutboxEventParticipantCreated: true,
+}
+
+// EventPublisher posts check-in and registration messages to the webhook configured on the
+// event they belong to, signed with that webhook's secret, and records the outcome on it.
+type EventPublisher struct {
+	webhooks repository.EventWebhookRepository
+	client   *http.Client
+	logger   *logger.Logger
+}
+
+// NewEventPublisher creates a new EventPublisher. Webhooks are configured by users, so their
+// requests only connect to addresses guard allows; a nil guard allows any address.
+func NewEventPublisher(
+	webhooks repository.EventWebhookRepository,
+	timeout time.Duration,
+	guard *AddressGuard,
+	logger *logger.Logger,
+) *EventPublisher {
+	client := &http.Client{Timeout: timeout}
+	if guard != nil {
+		client = guard.Client(timeout)
+	}
+	return &EventPublisher{
+		webhooks: webhooks,
+		client:   client,
+		logger:   logger,
+	}
+}
+
+// Publish posts the message to its event's webhook, unless that webhook's URL already accepted it.
+// Messages of other types and messages of events without a webhook succeed without sending anything.
+func (p *EventPublisher) Publish(ctx context.Context, msg *entity.OutboxMessage) error {
+	if !eventWebhookTypes[msg.EventType] {
+		return nil
+	}
+
+	var ref struct {
+		EventID uuid.UUID `json:"event_id"`
+	}
+	if err := json.Unmarshal(msg.Payload, &ref); err != nil {
+		return fmt.Errorf("webhook: failed to read event ID of message: %w", err)
+	}
+
+	hook, err := p.webhooks.FindByEventID(ctx, ref.EventID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+
+	// Keyed apart from the server-wide webhooks, which may share the URL but not the secret
+	destination := "event:" + hook.URL
+	if msg.IsDeliveredTo(destination) {
+		return nil
+	}
+
+	body, err := marshalEnvelope(msg)
+	if err != nil {
+		return err
+	}
+
+	deliveryErr := post(ctx, p.client, hook.URL, hook.Secret, msg, body)
+	if deliveryErr == nil {
+		msg.MarkDeliveredTo(destination)
+	}
+
+	status, lastError := entity.WebhookDeliverySucceeded, ""
+	if deliveryErr != nil {
+		status, lastError = entity.WebhookDeliveryFailed, deliveryFailure(deliveryErr)
+	}
+	// The delivery outcome alone decides whether the message is retried, so a delivered
+	// message is not sent again just because its status could not be recorded.
+	if err := p.webhooks.RecordDelivery(ctx, ref.EventID, time.Now(), status, lastError); err != nil {
+		p.logger.WithContext(ctx).Error("failed to record event webhook delivery",
+			zap.String("event_id", ref.EventID.String()),
+			zap.String("message_id", msg.ID.String()),
+			zap.Error(err),
+		)
+	}
+	return deliveryErr
+}
+
+// deliveryFailure describes why a delivery failed for the event's organizer without revealing
+// what the request ran into, e.g. the response body or network error of an internal service.
+func deliveryFailure(err error) string {
+	var statusErr *statusError
+	var netErr net.Error
+	var dnsErr *net.DNSError
+	switch {
+	case errors.As(err, &statusErr):
+		return fmt.Sprintf("webhook returned HTTP %d", statusErr.statusCode)
+	case errors.Is(err, ErrAddressNotAllowed):
+		return "webhook address is not allowed"
+	case errors.As(err, &dnsErr):
+		return "webhook host could not be resolved"
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		return "webhook request timed out"
+	default:
+		return "webhook could not be reached"
+	}
+}
+
+// MultiPublisher publishes each message through several publishers.
+type MultiPublisher struct {
+	publishers []domainwebhook.Publisher
+}
+
+// NewMultiPublisher creates a new MultiPublisher.
+func NewMultiPublisher(publishers ...domainwebhook.Publisher) *MultiPublisher {
+	return &MultiPublisher{publishers: publishers}
+}
+
+// Publish hands the message to every publisher.
+// It fails if any publisher fails, in which case the message is retried later; destinations
+// that accepted it are recorded on the message, so the retry only reaches the ones that failed.
+func (p *MultiPublisher) Publish(ctx context.Context, msg *entity.OutboxMessage) error {
+	var errs []error
+	for _, publisher := range p.publishers {
+		if err := publisher.Publish(ctx, msg); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+var (
+	_ domainwebhook.Publisher = (*EventPublisher)(nil)
+	_ domainwebhook.Publisher = (*MultiPublisher)(nil)
+)
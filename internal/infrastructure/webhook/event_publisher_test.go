@@ -0,0 +1,192 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	webhookMocks "github.com/fumkob/ezqrin-server/internal/domain/webhook/mocks"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("EventPublisher", func() {
+	var (
+		ctrl      *gomock.Controller
+		webhooks  *mocks.MockEventWebhookRepository
+		publisher *EventPublisher
+		eventID   uuid.UUID
+		msg       *entity.OutboxMessage
+		server    *httptest.Server
+		received  []*http.Request
+		bodies    [][]byte
+		status    int
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		webhooks = mocks.NewMockEventWebhookRepository(ctrl)
+		publisher = NewEventPublisher(webhooks, time.Second, nil, &logger.Logger{Logger: zap.NewNop()})
+
+		eventID = uuid.New()
+		participantID := uuid.New()
+		var err error
+		msg, err = entity.NewOutboxMessage(
+			entity.OutboxEventParticipantCreated,
+			participantID,
+			entity.ParticipantCreatedPayload{ParticipantID: participantID, EventID: eventID, Name: "Taro Yamada"},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		received = nil
+		bodies = nil
+		status = http.StatusOK
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			received = append(received, r)
+			bodies = append(bodies, body)
+			w.WriteHeader(status)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+		ctrl.Finish()
+	})
+
+	hookFor := func(url string) *entity.EventWebhook {
+		return &entity.EventWebhook{EventID: eventID, URL: url, Secret: "event-secret"}
+	}
+
+	When("the event has a webhook", func() {
+		It("should post the message signed with the event's secret and record the success", func() {
+			webhooks.EXPECT().FindByEventID(gomock.Any(), eventID).Return(hookFor(server.URL), nil)
+			webhooks.EXPECT().
+				RecordDelivery(gomock.Any(), eventID, gomock.Any(), entity.WebhookDeliverySucceeded, "").
+				Return(nil)
+
+			Expect(publisher.Publish(context.Background(), msg)).To(Succeed())
+
+			Expect(received).To(HaveLen(1))
+			Expect(received[0].Header.Get(HeaderEvent)).To(Equal("participant.created"))
+			Expect(received[0].Header.Get(HeaderSignature)).To(Equal(Sign("event-secret", bodies[0])))
+		})
+
+		It("should record the failure and return an error so the message is retried", func() {
+			status = http.StatusServiceUnavailable
+			webhooks.EXPECT().FindByEventID(gomock.Any(), eventID).Return(hookFor(server.URL), nil)
+			var recorded string
+			webhooks.EXPECT().
+				RecordDelivery(gomock.Any(), eventID, gomock.Any(), entity.WebhookDeliveryFailed, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ uuid.UUID, _ time.Time, _ entity.WebhookDeliveryStatus, e string) error {
+					recorded = e
+					return nil
+				})
+
+			Expect(publisher.Publish(context.Background(), msg)).To(MatchError(ContainSubstring("503")))
+			Expect(recorded).To(Equal("webhook returned HTTP 503"))
+		})
+
+		It("should not connect to a private address and record only why", func() {
+			guarded := NewEventPublisher(
+				webhooks, time.Second, NewAddressGuard(net.DefaultResolver), &logger.Logger{Logger: zap.NewNop()},
+			)
+			webhooks.EXPECT().FindByEventID(gomock.Any(), eventID).Return(hookFor(server.URL), nil)
+			webhooks.EXPECT().
+				RecordDelivery(gomock.Any(), eventID, gomock.Any(), entity.WebhookDeliveryFailed,
+					"webhook address is not allowed").
+				Return(nil)
+
+			Expect(guarded.Publish(context.Background(), msg)).To(MatchError(ErrAddressNotAllowed))
+			Expect(received).To(BeEmpty())
+		})
+
+		It("should not post again to a webhook that accepted the message in an earlier attempt", func() {
+			webhooks.EXPECT().FindByEventID(gomock.Any(), eventID).Return(hookFor(server.URL), nil).Times(2)
+			webhooks.EXPECT().
+				RecordDelivery(gomock.Any(), eventID, gomock.Any(), entity.WebhookDeliverySucceeded, "").
+				Return(nil)
+
+			Expect(publisher.Publish(context.Background(), msg)).To(Succeed())
+			Expect(publisher.Publish(context.Background(), msg)).To(Succeed())
+
+			Expect(received).To(HaveLen(1))
+		})
+
+		It("should not fail a delivered message when recording the status fails", func() {
+			webhooks.EXPECT().FindByEventID(gomock.Any(), eventID).Return(hookFor(server.URL), nil)
+			webhooks.EXPECT().
+				RecordDelivery(gomock.Any(), eventID, gomock.Any(), entity.WebhookDeliverySucceeded, "").
+				Return(errors.New("connection reset"))
+
+			Expect(publisher.Publish(context.Background(), msg)).To(Succeed())
+		})
+	})
+
+	When("the event has no webhook", func() {
+		It("should succeed without sending anything", func() {
+			webhooks.EXPECT().FindByEventID(gomock.Any(), eventID).Return(nil, apperrors.NotFound("event webhook not found"))
+
+			Expect(publisher.Publish(context.Background(), msg)).To(Succeed())
+			Expect(received).To(BeEmpty())
+		})
+	})
+
+	When("the message is not a check-in or registration", func() {
+		It("should ignore it", func() {
+			published, err := entity.NewOutboxMessage(
+				entity.OutboxEventEventPublished,
+				eventID,
+				entity.EventPublishedPayload{EventID: eventID},
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(publisher.Publish(context.Background(), published)).To(Succeed())
+			Expect(received).To(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("MultiPublisher", func() {
+	var (
+		ctrl   *gomock.Controller
+		first  *webhookMocks.MockPublisher
+		second *webhookMocks.MockPublisher
+		msg    *entity.OutboxMessage
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		first = webhookMocks.NewMockPublisher(ctrl)
+		second = webhookMocks.NewMockPublisher(ctrl)
+		msg = &entity.OutboxMessage{ID: uuid.New(), EventType: entity.OutboxEventCheckinCreated}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("should publish through every publisher", func() {
+		first.EXPECT().Publish(gomock.Any(), msg).Return(nil)
+		second.EXPECT().Publish(gomock.Any(), msg).Return(nil)
+
+		Expect(NewMultiPublisher(first, second).Publish(context.Background(), msg)).To(Succeed())
+	})
+
+	It("should still publish through the others and fail when one fails", func() {
+		first.EXPECT().Publish(gomock.Any(), msg).Return(errors.New("webhook down"))
+		second.EXPECT().Publish(gomock.Any(), msg).Return(nil)
+
+		err := NewMultiPublisher(first, second).Publish(context.Background(), msg)
+		Expect(err).To(MatchError(ContainSubstring("webhook down")))
+	})
+})
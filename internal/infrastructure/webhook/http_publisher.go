@@ -0,0 +1,148 @@
+// Package webhook delivers outbox messages to subscriber endpoints over HTTP.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	domainwebhook "github.com/fumkob/ezqrin-server/internal/domain/webhook"
+)
+
+// Webhook request headers
+const (
+	// HeaderEvent carries the outbox event type, e.g. "checkin.created".
+	HeaderEvent = "X-Ezqrin-Event"
+	// HeaderDelivery carries the outbox message ID. It is stable across retries,
+	// so subscribers can use it to discard duplicate deliveries.
+	HeaderDelivery = "X-Ezqrin-Delivery"
+	// HeaderSignature carries "sha256=" followed by the hex HMAC-SHA256 of the body.
+	HeaderSignature = "X-Ezqrin-Signature"
+)
+
+// maxErrorBodyBytes limits how much of a failed response body is kept in the error.
+const maxErrorBodyBytes = 512
+
+// envelope is the JSON body posted to webhook subscribers.
+type envelope struct {
+	ID        string                 `json:"id"`
+	Type      entity.OutboxEventType `json:"type"`
+	CreatedAt time.Time              `json:"created_at"`
+	Data      json.RawMessage        `json:"data"`
+}
+
+// HTTPPublisher posts outbox messages as JSON to every configured webhook URL.
+type HTTPPublisher struct {
+	urls   []string
+	secret string
+	client *http.Client
+}
+
+// NewHTTPPublisher creates a new HTTPPublisher.
+// When secret is non-empty, every request is signed in the X-Ezqrin-Signature header.
+// With no URLs, Publish succeeds without sending anything.
+func NewHTTPPublisher(urls []string, secret string, timeout time.Duration) *HTTPPublisher {
+	return &HTTPPublisher{
+		urls:   urls,
+		secret: secret,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Publish posts the message to all webhook URLs that have not accepted it yet.
+// It fails if any URL fails, in which case the message is retried later for the URLs that failed.
+func (p *HTTPPublisher) Publish(ctx context.Context, msg *entity.OutboxMessage) error {
+	body, err := marshalEnvelope(msg)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, url := range p.urls {
+		if msg.IsDeliveredTo(url) {
+			continue
+		}
+		if err := post(ctx, p.client, url, p.secret, msg, body); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		msg.MarkDeliveredTo(url)
+	}
+	return errors.Join(errs...)
+}
+
+// marshalEnvelope encodes the JSON body posted for a message.
+func marshalEnvelope(msg *entity.OutboxMessage) ([]byte, error) {
+	body, err := json.Marshal(envelope{
+		ID:        msg.ID.String(),
+		Type:      msg.EventType,
+		CreatedAt: msg.CreatedAt.UTC(),
+		Data:      msg.Payload,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("webhook: failed to marshal message: %w", err)
+	}
+	return body, nil
+}
+
+// post sends the webhook body to a single URL, signed with secret unless it is empty, and treats
+// any non-2xx status as a failure.
+func post(
+	ctx context.Context,
+	client *http.Client,
+	url, secret string,
+	msg *entity.OutboxMessage,
+	body []byte,
+) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("webhook: failed to build request for %s: %w", url, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, string(msg.EventType))
+	req.Header.Set(HeaderDelivery, msg.ID.String())
+	if secret != "" {
+		req.Header.Set(HeaderSignature, Sign(secret, body))
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("webhook: request to %s failed: %w", url, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return &statusError{url: url, statusCode: resp.StatusCode, body: bytes.TrimSpace(snippet)}
+	}
+	return nil
+}
+
+// statusError is the error of a webhook that answered with a non-2xx status.
+type statusError struct {
+	url        string
+	statusCode int
+	body       []byte
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("webhook: %s returned %d: %s", e.url, e.statusCode, e.body)
+}
+
+// Sign returns the X-Ezqrin-Signature header value for a webhook body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+var _ domainwebhook.Publisher = (*HTTPPublisher)(nil)
@@ -0,0 +1,125 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HTTPPublisher", func() {
+	const secret = "webhook-secret"
+
+	var (
+		msg      *entity.OutboxMessage
+		server   *httptest.Server
+		received []*http.Request
+		bodies   [][]byte
+		status   int
+	)
+
+	BeforeEach(func() {
+		aggregateID := uuid.New()
+		var err error
+		msg, err = entity.NewOutboxMessage(
+			entity.OutboxEventCheckinCreated,
+			aggregateID,
+			entity.CheckinCreatedPayload{CheckinID: aggregateID, Method: entity.CheckinMethodQRCode},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		received = nil
+		bodies = nil
+		status = http.StatusNoContent
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			received = append(received, r)
+			bodies = append(bodies, body)
+			w.WriteHeader(status)
+		}))
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	When("the subscriber accepts the webhook", func() {
+		It("should post a signed JSON envelope", func() {
+			publisher := NewHTTPPublisher([]string{server.URL}, secret, time.Second)
+
+			Expect(publisher.Publish(context.Background(), msg)).To(Succeed())
+
+			Expect(received).To(HaveLen(1))
+			Expect(received[0].Header.Get(HeaderEvent)).To(Equal("checkin.created"))
+			Expect(received[0].Header.Get(HeaderDelivery)).To(Equal(msg.ID.String()))
+			Expect(received[0].Header.Get(HeaderSignature)).To(Equal(Sign(secret, bodies[0])))
+
+			var env map[string]any
+			Expect(json.Unmarshal(bodies[0], &env)).To(Succeed())
+			Expect(env).To(HaveKeyWithValue("id", msg.ID.String()))
+			Expect(env).To(HaveKeyWithValue("type", "checkin.created"))
+			Expect(env["data"]).To(HaveKeyWithValue("method", "qrcode"))
+		})
+	})
+
+	When("no secret is configured", func() {
+		It("should not sign the request", func() {
+			publisher := NewHTTPPublisher([]string{server.URL}, "", time.Second)
+
+			Expect(publisher.Publish(context.Background(), msg)).To(Succeed())
+			Expect(received[0].Header.Get(HeaderSignature)).To(BeEmpty())
+		})
+	})
+
+	When("the subscriber responds with an error status", func() {
+		It("should return an error including the status", func() {
+			status = http.StatusBadGateway
+			publisher := NewHTTPPublisher([]string{server.URL}, secret, time.Second)
+
+			err := publisher.Publish(context.Background(), msg)
+			Expect(err).To(MatchError(ContainSubstring("502")))
+		})
+	})
+
+	When("one of several subscribers is unreachable", func() {
+		It("should still deliver to the others and return an error", func() {
+			unreachable := httptest.NewServer(http.NotFoundHandler())
+			unreachable.Close()
+			publisher := NewHTTPPublisher([]string{unreachable.URL, server.URL}, secret, time.Second)
+
+			err := publisher.Publish(context.Background(), msg)
+			Expect(err).To(HaveOccurred())
+			Expect(received).To(HaveLen(1))
+			Expect(msg.DeliveredTo).To(ConsistOf(server.URL))
+		})
+
+		It("should only retry the subscribers that failed", func() {
+			failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer failing.Close()
+			publisher := NewHTTPPublisher([]string{failing.URL, server.URL}, secret, time.Second)
+
+			Expect(publisher.Publish(context.Background(), msg)).NotTo(Succeed())
+			Expect(publisher.Publish(context.Background(), msg)).NotTo(Succeed())
+
+			Expect(received).To(HaveLen(1))
+		})
+	})
+
+	When("no webhook URLs are configured", func() {
+		It("should succeed without sending anything", func() {
+			publisher := NewHTTPPublisher(nil, secret, time.Second)
+
+			Expect(publisher.Publish(context.Background(), msg)).To(Succeed())
+			Expect(received).To(BeEmpty())
+		})
+	})
+})
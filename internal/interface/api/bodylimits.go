@@ -0,0 +1,36 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// routeBodyLimits returns the routes whose body size limit differs from the server's, keyed by
+// "METHOD path" with the path relative to the API base path as registered by the generated
+// code. File uploads carry whole files, so they get the larger upload limit; the logo upload
+// still checks the size of the image itself.
+func routeBodyLimits(server config.ServerConfig) map[string]int64 {
+	return map[string]int64{
+		http.MethodPost + " /events/:id/participants/import": server.MaxUploadSize,
+		http.MethodPut + " /events/:id/logo":                 server.MaxUploadSize,
+	}
+}
+
+// NewBodyLimitRouter wraps router so that every route runs under middleware.MaxBodySize, with
+// the server's body size limit unless the route has its own in routeBodyLimits.
+func NewBodyLimitRouter(router gin.IRouter, server config.ServerConfig) gin.IRouter {
+	overrides := routeBodyLimits(server)
+	return &hookedRouter{
+		IRouter: router,
+		hook: func(method, path string, handlers []gin.HandlerFunc) []gin.HandlerFunc {
+			limit, ok := overrides[method+" "+path]
+			if !ok {
+				limit = server.MaxBodySize
+			}
+			return append([]gin.HandlerFunc{middleware.MaxBodySize(limit)}, handlers...)
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package api_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/interface/api"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewBodyLimitRouter", func() {
+	var r *gin.Engine
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+
+		r = gin.New()
+		routes := api.NewBodyLimitRouter(r.Group("/api/v1"), config.ServerConfig{
+			MaxBodySize:   16,
+			MaxUploadSize: 64,
+		})
+
+		read := func(c *gin.Context) {
+			if _, err := io.ReadAll(c.Request.Body); err != nil {
+				c.Status(http.StatusBadRequest)
+				return
+			}
+			c.Status(http.StatusOK)
+		}
+		routes.POST("/events", read)
+		routes.POST("/events/:id/participants/import", read)
+	})
+
+	call := func(path string, size int) int {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, strings.NewReader(strings.Repeat("a", size))))
+		return w.Code
+	}
+
+	It("should apply the body size limit to ordinary routes", func() {
+		Expect(call("/api/v1/events", 16)).To(Equal(http.StatusOK))
+		Expect(call("/api/v1/events", 17)).To(Equal(http.StatusRequestEntityTooLarge))
+	})
+
+	It("should apply the larger upload limit to CSV imports", func() {
+		Expect(call("/api/v1/events/1/participants/import", 64)).To(Equal(http.StatusOK))
+		Expect(call("/api/v1/events/1/participants/import", 65)).To(Equal(http.StatusRequestEntityTooLarge))
+	})
+})
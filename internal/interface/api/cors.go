@@ -0,0 +1,33 @@
+package api
+
+import (
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+// NewCORSRouter wraps router so that each route answers CORS under the policy of its group:
+// routes listed in publicRoutes under public, every other route under api. Each path also
+// gets an OPTIONS route answering preflights under the policy of the requested method.
+func NewCORSRouter(router gin.IRouter, publicRoutes map[string]bool, api, public config.CORSConfig) gin.IRouter {
+	apiCORS := middleware.CORS(&api)
+	publicCORS := middleware.CORS(&public)
+	preflights := make(map[string]map[string]*config.CORSConfig)
+	return &hookedRouter{
+		IRouter: router,
+		hook: func(method, path string, handlers []gin.HandlerFunc) []gin.HandlerFunc {
+			policy, cors := &api, apiCORS
+			if _, ok := publicRoutes[method+" "+path]; ok {
+				policy, cors = &public, publicCORS
+			}
+			policies, registered := preflights[path]
+			if !registered {
+				policies = make(map[string]*config.CORSConfig)
+				preflights[path] = policies
+				router.OPTIONS(path, middleware.CORSPreflight(policies))
+			}
+			policies[method] = policy
+			return append([]gin.HandlerFunc{cors}, handlers...)
+		},
+	}
+}
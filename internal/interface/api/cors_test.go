@@ -0,0 +1,93 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/interface/api"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NewCORSRouter", func() {
+	const (
+		dashboard = "https://dashboard.example.com"
+		widget    = "https://venue.example.org"
+	)
+
+	var r *gin.Engine
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		r = gin.New()
+		routes := api.NewCORSRouter(r.Group("/api/v1"), map[string]bool{
+			http.MethodPost + " /events/:id/checkin": false,
+		}, config.CORSConfig{
+			AllowedOrigins:   []string{dashboard},
+			AllowedMethods:   []string{http.MethodGet, http.MethodPost, http.MethodDelete},
+			AllowCredentials: true,
+		}, config.CORSConfig{
+			AllowedOrigins: []string{"*"},
+			AllowedMethods: []string{http.MethodPost},
+		})
+
+		ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+		routes.POST("/events/:id/checkin", ok)
+		routes.GET("/events/:id/checkin", ok)
+		routes.GET("/events", ok)
+	})
+
+	send := func(method, path, origin string, header ...string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("Origin", origin)
+		if len(header) == 2 {
+			req.Header.Set(header[0], header[1])
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	It("should answer a public route under the public policy", func() {
+		w := send(http.MethodPost, "/api/v1/events/1/checkin", widget)
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Header().Get("Access-Control-Allow-Origin")).To(Equal("*"))
+		Expect(w.Header().Get("Access-Control-Allow-Credentials")).To(BeEmpty())
+	})
+
+	It("should answer other routes under the API policy", func() {
+		w := send(http.MethodGet, "/api/v1/events", dashboard)
+
+		Expect(w.Header().Get("Access-Control-Allow-Origin")).To(Equal(dashboard))
+		Expect(w.Header().Get("Access-Control-Allow-Credentials")).To(Equal("true"))
+
+		w = send(http.MethodGet, "/api/v1/events/1/checkin", widget)
+
+		Expect(w.Header().Get("Access-Control-Allow-Origin")).To(BeEmpty())
+	})
+
+	It("should answer preflights under the policy of the requested method", func() {
+		w := send(http.MethodOptions, "/api/v1/events/1/checkin", widget, "Access-Control-Request-Method", "POST")
+
+		Expect(w.Code).To(Equal(http.StatusNoContent))
+		Expect(w.Header().Get("Access-Control-Allow-Origin")).To(Equal("*"))
+		Expect(w.Header().Get("Access-Control-Allow-Methods")).To(Equal(http.MethodPost))
+
+		w = send(http.MethodOptions, "/api/v1/events/1/checkin", dashboard, "Access-Control-Request-Method", "GET")
+
+		Expect(w.Code).To(Equal(http.StatusNoContent))
+		Expect(w.Header().Get("Access-Control-Allow-Origin")).To(Equal(dashboard))
+		Expect(w.Header().Get("Access-Control-Allow-Methods")).To(Equal("GET, POST, DELETE"))
+		Expect(w.Header().Get("Access-Control-Allow-Credentials")).To(Equal("true"))
+	})
+
+	It("should not allow a preflight for a method the path does not serve", func() {
+		w := send(http.MethodOptions, "/api/v1/events", dashboard, "Access-Control-Request-Method", "DELETE")
+
+		Expect(w.Code).To(Equal(http.StatusNoContent))
+		Expect(w.Header().Get("Access-Control-Allow-Origin")).To(BeEmpty())
+	})
+})
@@ -0,0 +1,48 @@
+package api
+
+import (
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// RouteDeprecation declares when a legacy route will be removed and where its migration
+// is documented.
+type RouteDeprecation struct {
+	Sunset time.Time
+	Link   string
+}
+
+// deprecatedRoutes lists the legacy routes, keyed by "METHOD path" with the path relative to
+// the API base path as registered by the generated code. A route is flagged by adding an
+// entry, for example:
+//
+//	http.MethodGet + " /events/:id/participants/:pid": {
+//		Sunset: time.Date(2027, 6, 30, 0, 0, 0, 0, time.UTC),
+//		Link:   "https://docs.ezqrin.com/api/participants#get-participant",
+//	},
+var deprecatedRoutes = map[string]RouteDeprecation{}
+
+// NewDeprecatingRouter wraps router so that each route listed in deprecations answers with
+// deprecation headers and logs its usage; see middleware.Deprecation.
+func NewDeprecatingRouter(
+	router gin.IRouter,
+	deprecations map[string]RouteDeprecation,
+	log *logger.Logger,
+) gin.IRouter {
+	return &hookedRouter{
+		IRouter: router,
+		hook: func(method, path string, handlers []gin.HandlerFunc) []gin.HandlerFunc {
+			deprecation, ok := deprecations[method+" "+path]
+			if !ok {
+				return handlers
+			}
+			return append(
+				[]gin.HandlerFunc{middleware.Deprecation(deprecation.Sunset, deprecation.Link, log)},
+				handlers...,
+			)
+		},
+	}
+}
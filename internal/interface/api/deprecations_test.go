@@ -0,0 +1,90 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+var _ = Describe("NewDeprecatingRouter", func() {
+	var (
+		r      *gin.Engine
+		logs   *observer.ObservedLogs
+		userID uuid.UUID
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		core, observed := observer.New(zapcore.InfoLevel)
+		logs = observed
+		userID = uuid.New()
+
+		r = gin.New()
+		v1 := r.Group("/api/v1")
+		routes := api.NewDeprecatingRouter(v1, map[string]api.RouteDeprecation{
+			http.MethodGet + " /legacy/:id": {
+				Sunset: time.Date(2027, 6, 30, 0, 0, 0, 0, time.UTC),
+				Link:   "https://docs.example.com/migrate",
+			},
+		}, &logger.Logger{Logger: zap.New(core)})
+
+		authenticated := func(c *gin.Context) {
+			c.Set(middleware.ContextKeyUserID, userID)
+			c.Status(http.StatusOK)
+		}
+		routes.GET("/legacy/:id", authenticated)
+		routes.GET("/current/:id", authenticated)
+	})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	When("a flagged route is called", func() {
+		It("should announce the deprecation in the headers", func() {
+			w := get("/api/v1/legacy/1")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get(middleware.DeprecationHeader)).To(Equal("true"))
+			Expect(w.Header().Get(middleware.SunsetHeader)).To(Equal("Wed, 30 Jun 2027 00:00:00 GMT"))
+			Expect(w.Header().Get(middleware.LinkHeader)).
+				To(Equal(`<https://docs.example.com/migrate>; rel="deprecation"; type="text/html"`))
+		})
+
+		It("should log the route and the calling user", func() {
+			get("/api/v1/legacy/1")
+
+			entries := logs.FilterMessage("deprecated endpoint called").All()
+			Expect(entries).To(HaveLen(1))
+			fields := entries[0].ContextMap()
+			Expect(fields).To(HaveKeyWithValue("route", "/api/v1/legacy/:id"))
+			Expect(fields).To(HaveKeyWithValue("user_id", userID.String()))
+		})
+	})
+
+	When("a route that is not flagged is called", func() {
+		It("should not add deprecation headers or log the call", func() {
+			w := get("/api/v1/current/1")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get(middleware.DeprecationHeader)).To(BeEmpty())
+			Expect(w.Header().Get(middleware.SunsetHeader)).To(BeEmpty())
+			Expect(w.Header().Get(middleware.LinkHeader)).To(BeEmpty())
+			Expect(logs.FilterMessage("deprecated endpoint called").Len()).To(BeZero())
+		})
+	})
+})
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/gin-gonic/gin"
+)
+
+// featureRoutes returns the routes of each disabled feature, keyed by "METHOD path" with the
+// path relative to the API base path as registered by the generated code.
+func featureRoutes(features config.FeaturesConfig) map[string]bool {
+	disabled := make(map[string]bool)
+	if !features.Invitations {
+		disabled[http.MethodPost+" /events/:id/participants/invite"] = true
+		disabled[http.MethodPost+" /participants/accept-invite"] = true
+	}
+	if !features.WalkInCheckin {
+		disabled[http.MethodPost+" /events/:id/checkin/walk-in"] = true
+	}
+	return disabled
+}
+
+// NewFeatureGatedRouter wraps router so that the routes of disabled features are skipped and
+// answer 404 like any unknown route.
+func NewFeatureGatedRouter(router gin.IRouter, features config.FeaturesConfig) gin.IRouter {
+	disabled := featureRoutes(features)
+	return &hookedRouter{
+		IRouter: router,
+		hook: func(method, path string, handlers []gin.HandlerFunc) []gin.HandlerFunc {
+			if disabled[method+" "+path] {
+				return nil
+			}
+			return handlers
+		},
+	}
+}
@@ -0,0 +1,88 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/interface/api"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/handler"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var allFeatures = config.FeaturesConfig{Webhooks: true, Invitations: true, WalkInCheckin: true}
+
+// newFeatureRouter registers the generated routes under /api/v1 with the given feature flags.
+func newFeatureRouter(features config.FeaturesConfig) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	v1 := r.Group("/api/v1")
+	generated.RegisterHandlersWithOptions(
+		api.NewFeatureGatedRouter(v1, features), &handler.Handler{}, generated.GinServerOptions{},
+	)
+	return r
+}
+
+// registered reports whether the router has a route for method and path.
+func registered(r *gin.Engine, method, path string) bool {
+	for _, route := range r.Routes() {
+		if route.Method == method && route.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+var _ = Describe("NewFeatureGatedRouter", func() {
+	When("all features are enabled", func() {
+		It("should register every feature route", func() {
+			r := newFeatureRouter(allFeatures)
+
+			Expect(registered(r, http.MethodPost, "/api/v1/events/:id/participants/invite")).To(BeTrue())
+			Expect(registered(r, http.MethodPost, "/api/v1/participants/accept-invite")).To(BeTrue())
+			Expect(registered(r, http.MethodPost, "/api/v1/events/:id/checkin/walk-in")).To(BeTrue())
+		})
+	})
+
+	When("invitations are disabled", func() {
+		var r *gin.Engine
+
+		BeforeEach(func() {
+			features := allFeatures
+			features.Invitations = false
+			r = newFeatureRouter(features)
+		})
+
+		It("should not register the invitation routes", func() {
+			Expect(registered(r, http.MethodPost, "/api/v1/events/:id/participants/invite")).To(BeFalse())
+			Expect(registered(r, http.MethodPost, "/api/v1/participants/accept-invite")).To(BeFalse())
+		})
+
+		It("should keep the other routes", func() {
+			Expect(registered(r, http.MethodPost, "/api/v1/events/:id/participants")).To(BeTrue())
+			Expect(registered(r, http.MethodPost, "/api/v1/events/:id/checkin/walk-in")).To(BeTrue())
+		})
+
+		It("should answer 404 Not Found", func() {
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/participants/accept-invite", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	When("walk-in check-in is disabled", func() {
+		It("should not register the walk-in route", func() {
+			features := allFeatures
+			features.WalkInCheckin = false
+			r := newFeatureRouter(features)
+
+			Expect(registered(r, http.MethodPost, "/api/v1/events/:id/checkin/walk-in")).To(BeFalse())
+			Expect(registered(r, http.MethodPost, "/api/v1/events/:id/checkin")).To(BeTrue())
+		})
+	})
+})
@@ -14,6 +14,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gin-gonic/gin"
 	"github.com/oapi-codegen/runtime"
@@ -24,6 +25,48 @@ const (
 	BearerAuthScopes bearerAuthContextKey = "bearerAuth.Scopes"
 )
 
+// Defines values for AuditAction.
+const (
+	Create AuditAction = "create"
+	Delete AuditAction = "delete"
+	Update AuditAction = "update"
+)
+
+// Valid indicates whether the value is a known member of the AuditAction enum.
+func (e AuditAction) Valid() bool {
+	switch e {
+	case Create:
+		return true
+	case Delete:
+		return true
+	case Update:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for AuditResourceType.
+const (
+	AuditResourceTypeCheckin     AuditResourceType = "checkin"
+	AuditResourceTypeEvent       AuditResourceType = "event"
+	AuditResourceTypeParticipant AuditResourceType = "participant"
+)
+
+// Valid indicates whether the value is a known member of the AuditResourceType enum.
+func (e AuditResourceType) Valid() bool {
+	switch e {
+	case AuditResourceTypeCheckin:
+		return true
+	case AuditResourceTypeEvent:
+		return true
+	case AuditResourceTypeParticipant:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for CheckInMethod.
 const (
 	Manual CheckInMethod = "manual"
@@ -42,6 +85,96 @@ func (e CheckInMethod) Valid() bool {
 	}
 }
 
+// Defines values for CheckInOutcome.
+const (
+	CheckInOutcomeAlreadyCheckedIn CheckInOutcome = "already_checked_in"
+	CheckInOutcomeCheckedIn        CheckInOutcome = "checked_in"
+	CheckInOutcomeNotFound         CheckInOutcome = "not_found"
+	CheckInOutcomeOutsideWindow    CheckInOutcome = "outside_window"
+	CheckInOutcomeWrongEvent       CheckInOutcome = "wrong_event"
+)
+
+// Valid indicates whether the value is a known member of the CheckInOutcome enum.
+func (e CheckInOutcome) Valid() bool {
+	switch e {
+	case CheckInOutcomeAlreadyCheckedIn:
+		return true
+	case CheckInOutcomeCheckedIn:
+		return true
+	case CheckInOutcomeNotFound:
+		return true
+	case CheckInOutcomeOutsideWindow:
+		return true
+	case CheckInOutcomeWrongEvent:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CheckInStatusBadge.
+const (
+	Error   CheckInStatusBadge = "error"
+	Success CheckInStatusBadge = "success"
+	Warning CheckInStatusBadge = "warning"
+)
+
+// Valid indicates whether the value is a known member of the CheckInStatusBadge enum.
+func (e CheckInStatusBadge) Valid() bool {
+	switch e {
+	case Error:
+		return true
+	case Success:
+		return true
+	case Warning:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for ClientPlatform.
+const (
+	ClientPlatformMobile ClientPlatform = "mobile"
+	ClientPlatformWeb    ClientPlatform = "web"
+)
+
+// Valid indicates whether the value is a known member of the ClientPlatform enum.
+func (e ClientPlatform) Valid() bool {
+	switch e {
+	case ClientPlatformMobile:
+		return true
+	case ClientPlatformWeb:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for CustomFieldType.
+const (
+	Boolean CustomFieldType = "boolean"
+	Number  CustomFieldType = "number"
+	Select  CustomFieldType = "select"
+	Text    CustomFieldType = "text"
+)
+
+// Valid indicates whether the value is a known member of the CustomFieldType enum.
+func (e CustomFieldType) Valid() bool {
+	switch e {
+	case Boolean:
+		return true
+	case Number:
+		return true
+	case Select:
+		return true
+	case Text:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for EventStatus.
 const (
 	EventStatusCancelled EventStatus = "cancelled"
@@ -69,12 +202,54 @@ func (e EventStatus) Valid() bool {
 	}
 }
 
+// Defines values for EventWebhookResponseLastDeliveryStatus.
+const (
+	Failed    EventWebhookResponseLastDeliveryStatus = "failed"
+	Succeeded EventWebhookResponseLastDeliveryStatus = "succeeded"
+)
+
+// Valid indicates whether the value is a known member of the EventWebhookResponseLastDeliveryStatus enum.
+func (e EventWebhookResponseLastDeliveryStatus) Valid() bool {
+	switch e {
+	case Failed:
+		return true
+	case Succeeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for FeeType.
+const (
+	Fixed  FeeType = "fixed"
+	Free   FeeType = "free"
+	Tiered FeeType = "tiered"
+)
+
+// Valid indicates whether the value is a known member of the FeeType enum.
+func (e FeeType) Valid() bool {
+	switch e {
+	case Fixed:
+		return true
+	case Free:
+		return true
+	case Tiered:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ParticipantStatus.
 const (
-	ParticipantStatusCancelled ParticipantStatus = "cancelled"
-	ParticipantStatusConfirmed ParticipantStatus = "confirmed"
-	ParticipantStatusDeclined  ParticipantStatus = "declined"
-	ParticipantStatusTentative ParticipantStatus = "tentative"
+	ParticipantStatusCancelled  ParticipantStatus = "cancelled"
+	ParticipantStatusConfirmed  ParticipantStatus = "confirmed"
+	ParticipantStatusDeclined   ParticipantStatus = "declined"
+	ParticipantStatusExpired    ParticipantStatus = "expired"
+	ParticipantStatusInvited    ParticipantStatus = "invited"
+	ParticipantStatusTentative  ParticipantStatus = "tentative"
+	ParticipantStatusWaitlisted ParticipantStatus = "waitlisted"
 )
 
 // Valid indicates whether the value is a known member of the ParticipantStatus enum.
@@ -86,8 +261,38 @@ func (e ParticipantStatus) Valid() bool {
 		return true
 	case ParticipantStatusDeclined:
 		return true
+	case ParticipantStatusExpired:
+		return true
+	case ParticipantStatusInvited:
+		return true
 	case ParticipantStatusTentative:
 		return true
+	case ParticipantStatusWaitlisted:
+		return true
+	default:
+		return false
+	}
+}
+
+// Defines values for PaymentMethod.
+const (
+	BankTransfer PaymentMethod = "bank_transfer"
+	Card         PaymentMethod = "card"
+	Cash         PaymentMethod = "cash"
+	Other        PaymentMethod = "other"
+)
+
+// Valid indicates whether the value is a known member of the PaymentMethod enum.
+func (e PaymentMethod) Valid() bool {
+	switch e {
+	case BankTransfer:
+		return true
+	case Card:
+		return true
+	case Cash:
+		return true
+	case Other:
+		return true
 	default:
 		return false
 	}
@@ -111,6 +316,27 @@ func (e PaymentStatus) Valid() bool {
 	}
 }
 
+// Defines values for RecurrenceFrequency.
+const (
+	Daily   RecurrenceFrequency = "daily"
+	Monthly RecurrenceFrequency = "monthly"
+	Weekly  RecurrenceFrequency = "weekly"
+)
+
+// Valid indicates whether the value is a known member of the RecurrenceFrequency enum.
+func (e RecurrenceFrequency) Valid() bool {
+	switch e {
+	case Daily:
+		return true
+	case Monthly:
+		return true
+	case Weekly:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for SendQRCodesRequestEmailTemplate.
 const (
 	Default  SendQRCodesRequestEmailTemplate = "default"
@@ -132,6 +358,24 @@ func (e SendQRCodesRequestEmailTemplate) Valid() bool {
 	}
 }
 
+// Defines values for SessionClientType.
+const (
+	SessionClientTypeMobile SessionClientType = "mobile"
+	SessionClientTypeWeb    SessionClientType = "web"
+)
+
+// Valid indicates whether the value is a known member of the SessionClientType enum.
+func (e SessionClientType) Valid() bool {
+	switch e {
+	case SessionClientTypeMobile:
+		return true
+	case SessionClientTypeWeb:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for UserRole.
 const (
 	Admin     UserRole = "admin"
@@ -225,6 +469,27 @@ func (e ListCheckInsParamsOrder) Valid() bool {
 	}
 }
 
+// Defines values for GetCheckInTimeseriesParamsInterval.
+const (
+	N15m GetCheckInTimeseriesParamsInterval = "15m"
+	N1h  GetCheckInTimeseriesParamsInterval = "1h"
+	N5m  GetCheckInTimeseriesParamsInterval = "5m"
+)
+
+// Valid indicates whether the value is a known member of the GetCheckInTimeseriesParamsInterval enum.
+func (e GetCheckInTimeseriesParamsInterval) Valid() bool {
+	switch e {
+	case N15m:
+		return true
+	case N1h:
+		return true
+	case N5m:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for ListParticipantsParamsOrder.
 const (
 	ListParticipantsParamsOrderAsc  ListParticipantsParamsOrder = "asc"
@@ -261,6 +526,24 @@ func (e ExportParticipantsCSVParamsStatusFormat) Valid() bool {
 	}
 }
 
+// Defines values for ImportParticipantsCSVParamsHeaderMapping.
+const (
+	Aliases ImportParticipantsCSVParamsHeaderMapping = "aliases"
+	Strict  ImportParticipantsCSVParamsHeaderMapping = "strict"
+)
+
+// Valid indicates whether the value is a known member of the ImportParticipantsCSVParamsHeaderMapping enum.
+func (e ImportParticipantsCSVParamsHeaderMapping) Valid() bool {
+	switch e {
+	case Aliases:
+		return true
+	case Strict:
+		return true
+	default:
+		return false
+	}
+}
+
 // Defines values for DownloadParticipantQRCodeParamsFormat.
 const (
 	Png DownloadParticipantQRCodeParamsFormat = "png"
@@ -279,6 +562,129 @@ func (e DownloadParticipantQRCodeParamsFormat) Valid() bool {
 	}
 }
 
+// Defines values for DownloadParticipantQRCodesParamsFormat.
+const (
+	DownloadParticipantQRCodesParamsFormatPng DownloadParticipantQRCodesParamsFormat = "png"
+	DownloadParticipantQRCodesParamsFormatSvg DownloadParticipantQRCodesParamsFormat = "svg"
+)
+
+// Valid indicates whether the value is a known member of the DownloadParticipantQRCodesParamsFormat enum.
+func (e DownloadParticipantQRCodesParamsFormat) Valid() bool {
+	switch e {
+	case DownloadParticipantQRCodesParamsFormatPng:
+		return true
+	case DownloadParticipantQRCodesParamsFormatSvg:
+		return true
+	default:
+		return false
+	}
+}
+
+// AcceptInviteRequest defines model for AcceptInviteRequest.
+type AcceptInviteRequest struct {
+	// ConsentAccepted Explicit acceptance of the event's consent terms; required for events that require consent
+	ConsentAccepted *bool `json:"consent_accepted,omitempty"`
+
+	// Token Signed invitation token from the accept link in the invitation email
+	Token string `json:"token"`
+}
+
+// AcceptInviteResponse defines model for AcceptInviteResponse.
+type AcceptInviteResponse struct {
+	EventId       openapi_types.UUID `json:"event_id"`
+	Name          string             `json:"name"`
+	ParticipantId openapi_types.UUID `json:"participant_id"`
+
+	// QrCode QR code token issued on acceptance
+	QrCode string `json:"qr_code"`
+
+	// QrDistributionUrl QR code hosting URL (only when QR hosting is configured)
+	QrDistributionUrl *string `json:"qr_distribution_url,omitempty"`
+
+	// Status Participant status (`invited` is set only by invitations and has no QR code until accepted; `expired` is set only when a tentative or invited participant passes the event's tentative expiry)
+	Status ParticipantStatus `json:"status"`
+}
+
+// AddGuestRequest defines model for AddGuestRequest.
+type AddGuestRequest struct {
+	// Email Guest email address; optional, since guests are reached through their registrant
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// Name Guest full name
+	Name string `json:"name"`
+}
+
+// AdminConfigResponse Effective configuration grouped by section (server, database, redis, jwt, ...). Keys use the
+// snake_case names of the YAML configuration files and durations are strings such as "15m0s".
+type AdminConfigResponse map[string]interface{}
+
+// AssignParticipantGroupsRequest defines model for AssignParticipantGroupsRequest.
+type AssignParticipantGroupsRequest struct {
+	// GroupName Group or table to assign the participants to; null clears their assignment
+	GroupName *string `json:"group_name"`
+
+	// ParticipantIds Participants to assign (max 1000)
+	ParticipantIds []openapi_types.UUID `json:"participant_ids"`
+}
+
+// AssignParticipantGroupsResponse defines model for AssignParticipantGroupsResponse.
+type AssignParticipantGroupsResponse struct {
+	// UpdatedCount Number of participants whose group changed
+	UpdatedCount int64 `json:"updated_count"`
+}
+
+// AuditAction Change recorded in the audit log. Cancelling a check-in is recorded as `delete`, and
+// restoring it as `update`.
+type AuditAction string
+
+// AuditChange Change of one field. Personal data of participants (name, contact details, notes, metadata
+// and custom data) is only marked as `redacted`, without its values.
+type AuditChange struct {
+	// New Value after the change; omitted for deleted resources
+	New *interface{} `json:"new,omitempty"`
+
+	// Old Value before the change; omitted for created resources
+	Old *interface{} `json:"old,omitempty"`
+
+	// Redacted Whether the field holds personal data whose values are not recorded
+	Redacted *bool `json:"redacted,omitempty"`
+}
+
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	// Action Change recorded in the audit log. Cancelling a check-in is recorded as `delete`, and
+	// restoring it as `update`.
+	Action AuditAction `json:"action"`
+
+	// ActorId User who made the change
+	ActorId openapi_types.UUID `json:"actor_id"`
+
+	// Changes Changed fields by name. Created resources list every field with its new value only,
+	// deleted ones with their old value only.
+	Changes map[string]AuditChange `json:"changes"`
+
+	// CreatedAt When the change was made (ISO 8601)
+	CreatedAt time.Time `json:"created_at"`
+
+	// Id Audit log entry unique identifier
+	Id openapi_types.UUID `json:"id"`
+
+	// ResourceId Identifier of the changed resource
+	ResourceId openapi_types.UUID `json:"resource_id"`
+
+	// ResourceType Kind of resource an audit log entry is about
+	ResourceType AuditResourceType `json:"resource_type"`
+}
+
+// AuditLogListResponse defines model for AuditLogListResponse.
+type AuditLogListResponse struct {
+	Data []AuditLog     `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
+// AuditResourceType Kind of resource an audit log entry is about
+type AuditResourceType string
+
 // AuthResponse defines model for AuthResponse.
 type AuthResponse struct {
 	// AccessToken JWT access token for API authentication
@@ -295,6 +701,48 @@ type AuthResponse struct {
 	User      User   `json:"user"`
 }
 
+// BatchEventStatsRequest defines model for BatchEventStatsRequest.
+type BatchEventStatsRequest struct {
+	// Ids IDs of the events whose statistics are requested
+	Ids []openapi_types.UUID `json:"ids"`
+}
+
+// BatchEventStatsResponse defines model for BatchEventStatsResponse.
+type BatchEventStatsResponse struct {
+	// InaccessibleIds Requested event IDs that do not exist or that the caller does not manage
+	InaccessibleIds []openapi_types.UUID `json:"inaccessible_ids"`
+
+	// Stats Statistics keyed by event ID, for the requested events the caller manages
+	Stats map[string]EventStatsResponse `json:"stats"`
+}
+
+// BulkCheckInError defines model for BulkCheckInError.
+type BulkCheckInError struct {
+	// ParticipantId Participant that could not be checked in
+	ParticipantId openapi_types.UUID `json:"participant_id"`
+
+	// Reason Why the participant could not be checked in
+	Reason string `json:"reason"`
+}
+
+// BulkCheckInRequest defines model for BulkCheckInRequest.
+type BulkCheckInRequest struct {
+	// ParticipantIds IDs of the participants to check in (max 1000); duplicates are ignored
+	ParticipantIds []openapi_types.UUID `json:"participant_ids"`
+}
+
+// BulkCheckInResponse defines model for BulkCheckInResponse.
+type BulkCheckInResponse struct {
+	// CheckedInCount Number of participants checked in
+	CheckedInCount int `json:"checked_in_count"`
+
+	// Errors Participants that could not be checked in, in request order
+	Errors []BulkCheckInError `json:"errors"`
+
+	// SkippedCount Number of participants skipped because they had already checked in
+	SkippedCount int `json:"skipped_count"`
+}
+
 // BulkCreateParticipantsRequest defines model for BulkCreateParticipantsRequest.
 type BulkCreateParticipantsRequest struct {
 	// Participants Array of participants to create (max 1000)
@@ -325,6 +773,24 @@ type BulkCreateParticipantsResponse struct {
 	Participants []Participant `json:"participants"`
 }
 
+// BulkUpdateParticipantStatusRequest defines model for BulkUpdateParticipantStatusRequest.
+type BulkUpdateParticipantStatusRequest struct {
+	// ParticipantIds Participants of the event to update (max 1000)
+	ParticipantIds []openapi_types.UUID `json:"participant_ids"`
+
+	// Status Participant status (`invited` is set only by invitations and has no QR code until accepted; `expired` is set only when a tentative or invited participant passes the event's tentative expiry)
+	Status ParticipantStatus `json:"status"`
+}
+
+// BulkUpdateParticipantStatusResponse defines model for BulkUpdateParticipantStatusResponse.
+type BulkUpdateParticipantStatusResponse struct {
+	// SkippedCount Number of participants left unchanged, e.g. because they already had the status
+	SkippedCount int64 `json:"skipped_count"`
+
+	// UpdatedCount Number of participants moved to the status
+	UpdatedCount int64 `json:"updated_count"`
+}
+
 // CheckIn defines model for CheckIn.
 type CheckIn struct {
 	// CheckedInAt Check-in timestamp (ISO 8601, default NOW())
@@ -352,6 +818,36 @@ type CheckIn struct {
 	ParticipantId *openapi_types.UUID `json:"participant_id,omitempty"`
 }
 
+// CheckInHistoryEntry defines model for CheckInHistoryEntry.
+type CheckInHistoryEntry struct {
+	// CancelledAt When the check-in was ended by a check-out or cancellation (null while it is active)
+	CancelledAt *time.Time `json:"cancelled_at"`
+
+	// CancelledBy User who checked the participant out or cancelled the check-in
+	CancelledBy *openapi_types.UUID `json:"cancelled_by"`
+
+	// CheckedInAt Check-in timestamp (ISO 8601)
+	CheckedInAt time.Time `json:"checked_in_at"`
+
+	// CheckedInBy User who performed the check-in (null for self check-ins)
+	CheckedInBy *openapi_types.UUID `json:"checked_in_by"`
+
+	// CheckinMethod Check-in method
+	CheckinMethod CheckInMethod `json:"checkin_method"`
+
+	// Id Check-in unique identifier
+	Id openapi_types.UUID `json:"id"`
+}
+
+// CheckInHistoryResponse defines model for CheckInHistoryResponse.
+type CheckInHistoryResponse struct {
+	// Checkins Every check-in of the participant, oldest first, including ended ones
+	Checkins []CheckInHistoryEntry `json:"checkins"`
+
+	// ParticipantId Participant unique identifier
+	ParticipantId openapi_types.UUID `json:"participant_id"`
+}
+
 // CheckInListResponse defines model for CheckInListResponse.
 type CheckInListResponse struct {
 	// Checkins List of check-ins with participant information
@@ -371,6 +867,9 @@ type CheckInListResponse struct {
 		// CheckinMethod Check-in method
 		CheckinMethod CheckInMethod `json:"checkin_method"`
 
+		// DeviceId Device or scanning station that made the check-in; omitted if not reported
+		DeviceId *string `json:"device_id,omitempty"`
+
 		// DeviceInfo Device metadata
 		DeviceInfo *map[string]interface{} `json:"device_info,omitempty"`
 
@@ -382,18 +881,24 @@ type CheckInListResponse struct {
 
 		// Participant Participant information
 		Participant struct {
-			// Email Participant email
-			Email openapi_types.Email `json:"email"`
+			// Email Participant email (empty for walk-ins and guests registered without one)
+			Email string `json:"email"`
 
 			// EmployeeId Employee ID
 			EmployeeId *string `json:"employee_id,omitempty"`
 
 			// Name Participant full name
 			Name string `json:"name"`
+
+			// WalkIn Whether the participant was registered at the door
+			WalkIn bool `json:"walk_in"`
 		} `json:"participant"`
 
 		// ParticipantId Checked-in participant ID
 		ParticipantId openapi_types.UUID `json:"participant_id"`
+
+		// Source Client or channel the check-in was made from; omitted if not reported
+		Source *string `json:"source,omitempty"`
 	} `json:"checkins"`
 	Pagination PaginationMeta `json:"pagination"`
 }
@@ -401,8 +906,35 @@ type CheckInListResponse struct {
 // CheckInMethod Check-in method
 type CheckInMethod string
 
-// CheckInRequest defines model for CheckInRequest.
-type CheckInRequest struct {
+// CheckInOutcome Outcome of a check-in:
+// - `checked_in`: the participant was checked in
+// - `already_checked_in`: the participant had already checked in
+// - `not_found`: the code or participant is unknown
+// - `wrong_event`: the participant belongs to another event
+// - `outside_window`: the event's check-in window (see `CHECKIN_WINDOW_MARGIN`) is not open
+type CheckInOutcome string
+
+// CheckInProgressResponse defines model for CheckInProgressResponse.
+type CheckInProgressResponse struct {
+	// CheckedIn Number of participants checked in
+	CheckedIn int `json:"checked_in"`
+
+	// Percentage Share of participants checked in (0-100, one decimal place)
+	Percentage float64 `json:"percentage"`
+
+	// Total Number of active (tentative or confirmed) participants
+	Total int `json:"total"`
+
+	// UpdatedAt When the counts were taken (ISO 8601)
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CheckInRequest defines model for CheckInRequest.
+type CheckInRequest struct {
+	// DeviceId Device or scanning station making the check-in (optional). Defaults to the
+	// `X-Device-Id` header when omitted.
+	DeviceId *string `json:"device_id,omitempty"`
+
 	// DeviceInfo Device metadata for check-in tracking (max 5KB JSON, optional)
 	DeviceInfo *map[string]interface{} `json:"device_info,omitempty"`
 
@@ -417,50 +949,82 @@ type CheckInRequest struct {
 
 	// QrCode QR code token (required when method is qrcode)
 	QrCode *string `json:"qr_code,omitempty"`
+
+	// Source Client or channel the check-in is made from (optional)
+	Source *string `json:"source,omitempty"`
 }
 
-// CheckInResponse defines model for CheckInResponse.
+// CheckInResponse Result of a check-in. `outcome` tells scanner apps what happened without parsing `message`;
+// the check-in itself (`id` to `device_id`) is only present when `outcome` is `checked_in`.
 type CheckInResponse struct {
 	// CheckedInAt Check-in timestamp (ISO 8601)
-	CheckedInAt time.Time `json:"checked_in_at"`
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty"`
 
 	// CheckedInBy User who performed the check-in
-	CheckedInBy struct {
+	CheckedInBy *struct {
 		// Id User ID
 		Id openapi_types.UUID `json:"id"`
 
 		// Name User full name
 		Name string `json:"name"`
-	} `json:"checked_in_by"`
+	} `json:"checked_in_by,omitempty"`
 
 	// CheckinMethod Check-in method
-	CheckinMethod CheckInMethod `json:"checkin_method"`
+	CheckinMethod *CheckInMethod `json:"checkin_method,omitempty"`
+
+	// DeviceId Device or scanning station that made the check-in; omitted if not reported
+	DeviceId *string `json:"device_id,omitempty"`
 
 	// DeviceInfo Device metadata captured during check-in
 	DeviceInfo *map[string]interface{} `json:"device_info,omitempty"`
 
+	// DisplayName Name of the participant; present for `checked_in` and `already_checked_in`
+	DisplayName *string `json:"display_name,omitempty"`
+
 	// EventId Associated event ID
-	EventId openapi_types.UUID `json:"event_id"`
+	EventId *openapi_types.UUID `json:"event_id,omitempty"`
 
 	// Id Check-in unique identifier
-	Id openapi_types.UUID `json:"id"`
+	Id *openapi_types.UUID `json:"id,omitempty"`
 
-	// Message Success message
+	// Message Human-readable description of the outcome; use `outcome` to drive apps
 	Message string `json:"message"`
 
+	// Outcome Outcome of a check-in:
+	// - `checked_in`: the participant was checked in
+	// - `already_checked_in`: the participant had already checked in
+	// - `not_found`: the code or participant is unknown
+	// - `wrong_event`: the participant belongs to another event
+	// - `outside_window`: the event's check-in window (see `CHECKIN_WINDOW_MARGIN`) is not open
+	Outcome CheckInOutcome `json:"outcome"`
+
 	// Participant Participant basic information
-	Participant struct {
-		// Email Participant email
-		Email openapi_types.Email `json:"email"`
+	Participant *struct {
+		// Email Participant email (empty for walk-ins and guests registered without one)
+		Email string `json:"email"`
 
 		// Name Participant full name
 		Name string `json:"name"`
-	} `json:"participant"`
+
+		// WalkIn Whether the participant was registered at the door
+		WalkIn bool `json:"walk_in"`
+	} `json:"participant,omitempty"`
 
 	// ParticipantId Checked-in participant ID
-	ParticipantId openapi_types.UUID `json:"participant_id"`
+	ParticipantId *openapi_types.UUID `json:"participant_id,omitempty"`
+
+	// Source Client or channel the check-in was made from; omitted if not reported
+	Source *string `json:"source,omitempty"`
+
+	// StatusBadge Feedback a scanner app shows for an outcome: `success` for `checked_in`, `warning` for
+	// `already_checked_in`, `error` for `not_found`, `wrong_event` and `outside_window`
+	StatusBadge CheckInStatusBadge `json:"status_badge"`
 }
 
+// CheckInStatusBadge Feedback a scanner app shows for an outcome: `success` for `checked_in`, `warning` for
+// `already_checked_in`, `error` for `not_found`, `wrong_event` and `outside_window`
+type CheckInStatusBadge string
+
 // CheckInStatusResponse defines model for CheckInStatusResponse.
 type CheckInStatusResponse struct {
 	// CheckedIn Whether the participant has been checked in
@@ -506,46 +1070,179 @@ type CheckInStatusResponse struct {
 	ParticipantName string `json:"participant_name"`
 }
 
+// CheckInTimeseriesBucket defines model for CheckInTimeseriesBucket.
+type CheckInTimeseriesBucket struct {
+	// Count Check-ins in the bucket
+	Count int `json:"count"`
+
+	// Start Start of the bucket (ISO 8601, UTC)
+	Start time.Time `json:"start"`
+}
+
+// CheckInTimeseriesDevice defines model for CheckInTimeseriesDevice.
+type CheckInTimeseriesDevice struct {
+	// Buckets Check-ins of the device per bucket, with the same buckets as the whole series
+	Buckets []CheckInTimeseriesBucket `json:"buckets"`
+
+	// DeviceId Device or scanning station; null for check-ins without a device ID
+	DeviceId *string `json:"device_id"`
+
+	// Total Check-ins the device made across all buckets
+	Total int `json:"total"`
+}
+
+// CheckInTimeseriesResponse defines model for CheckInTimeseriesResponse.
+type CheckInTimeseriesResponse struct {
+	// Buckets Check-ins per bucket, oldest first, including buckets without check-ins
+	Buckets []CheckInTimeseriesBucket `json:"buckets"`
+
+	// ByDevice Check-ins per device that made any, ordered by device ID, with check-ins without a
+	// device ID last
+	ByDevice []CheckInTimeseriesDevice `json:"by_device"`
+
+	// EventId Event identifier
+	EventId openapi_types.UUID `json:"event_id"`
+
+	// Interval Size of the buckets
+	Interval string `json:"interval"`
+
+	// Total Check-ins counted across all buckets
+	Total int `json:"total"`
+}
+
+// CheckInsByStaffResponse defines model for CheckInsByStaffResponse.
+type CheckInsByStaffResponse struct {
+	// SelfCheckins Check-ins without a checking-in user, e.g. QR self-service
+	SelfCheckins int `json:"self_checkins"`
+
+	// Staff Check-in counts per staff member, highest first
+	Staff []StaffCheckInCount `json:"staff"`
+
+	// Total Total number of check-ins for the event
+	Total int `json:"total"`
+}
+
+// CheckOutRequest Exactly one of `qr_code` and `participant_id` is required
+type CheckOutRequest struct {
+	// ParticipantId Participant ID
+	ParticipantId *openapi_types.UUID `json:"participant_id,omitempty"`
+
+	// QrCode QR code token of the participant
+	QrCode *string `json:"qr_code,omitempty"`
+}
+
+// ClientPlatform Platform of the client, which decides how long its refresh tokens are valid:
+// `JWT_REFRESH_TOKEN_EXPIRY_WEB` or `JWT_REFRESH_TOKEN_EXPIRY_MOBILE`
+type ClientPlatform string
+
+// CloneEventRequest Fields of the copy that differ from the source event; omitted fields are copied. When only
+// `start_date` is set, `end_date` moves along with it so that the event keeps its duration.
+type CloneEventRequest struct {
+	// EndDate Event end date and time (ISO 8601, must be after start_date). Normalized to UTC by server.
+	EndDate *time.Time `json:"end_date,omitempty"`
+
+	// Name Event name
+	Name *string `json:"name,omitempty"`
+
+	// StartDate Event start date and time (ISO 8601). Normalized to UTC by server.
+	StartDate *time.Time `json:"start_date,omitempty"`
+}
+
+// ConfirmationEmailPreview defines model for ConfirmationEmailPreview.
+type ConfirmationEmailPreview struct {
+	// HtmlBody HTML body; omitted when emails are sent as plain text only (EMAIL_PLAIN_TEXT_ONLY)
+	HtmlBody *string `json:"html_body,omitempty"`
+
+	// QrCodePng Base64-encoded PNG of the participant's QR code
+	QrCodePng []byte `json:"qr_code_png"`
+	Subject   string `json:"subject"`
+
+	// TextBody Plain-text body
+	TextBody string `json:"text_body"`
+
+	// To Address the email would be sent to (qr_email if set, otherwise email)
+	To string `json:"to"`
+}
+
 // CreateEventRequest defines model for CreateEventRequest.
 type CreateEventRequest struct {
+	// Capacity How many participants can be confirmed. Confirmed participants added once the event is full are waitlisted. Omit for no limit.
+	Capacity *int `json:"capacity,omitempty"`
+
+	// ConsentVersion Version of the consent terms participants accept, e.g. a waiver revision. Required when requires_consent is true.
+	ConsentVersion *string `json:"consent_version,omitempty"`
+
+	// Currency ISO 4217 currency code for participant payment amounts. Defaults to the server's configured currency.
+	Currency *string `json:"currency,omitempty"`
+
 	// Description Event description
 	Description *string `json:"description,omitempty"`
 
 	// EndDate Event end date and time (ISO 8601, must be after start_date). Normalized to UTC by server.
 	EndDate *time.Time `json:"end_date,omitempty"`
 
+	// Fee Event fee model. Free events record every participant as paid with a zero amount.
+	// Fixed and tiered fees default a new participant's payment_amount to the fixed amount
+	// or to the amount of the participant's fee_tier. Charged fees require an event currency.
+	Fee *EventFee `json:"fee,omitempty"`
+
 	// Location Venue or location
 	Location *string `json:"location,omitempty"`
 
 	// Name Event name
 	Name string `json:"name"`
 
+	// Recurrence Repeats the event, creating an occurrence for each repetition linked by `series_id`. Set
+	// exactly one of `count` and `until`. Occurrences start at the same local time in the event's
+	// timezone and last as long as the first; monthly occurrences skip months without the day of
+	// the month of `start_date`. At most `RECURRENCE_MAX_OCCURRENCES` (365 unless changed)
+	// occurrences are created.
+	Recurrence *RecurrenceRule `json:"recurrence,omitempty"`
+
+	// RequiresConsent Participants must accept the consent terms before they can check in
+	RequiresConsent *bool `json:"requires_consent,omitempty"`
+
 	// StartDate Event start date and time (ISO 8601). Normalized to UTC by server.
 	StartDate time.Time `json:"start_date"`
 
 	// Status Event status
 	Status EventStatus `json:"status"`
 
+	// TentativeExpiryHours Participants who stay tentative or invited this many hours after registering or being invited expire. Omit to never expire them.
+	TentativeExpiryHours *int `json:"tentative_expiry_hours,omitempty"`
+
 	// Timezone IANA timezone identifier (e.g. America/New_York, Asia/Tokyo). Used as display metadata.
 	Timezone *string `json:"timezone,omitempty"`
 }
 
 // CreateParticipantRequest defines model for CreateParticipantRequest.
 type CreateParticipantRequest struct {
+	// CustomData Values of the event's custom fields by key. Must match the event's field definitions; required fields must be set.
+	CustomData *map[string]interface{} `json:"custom_data,omitempty"`
+
 	// Email Email address (must be unique within the event)
 	Email openapi_types.Email `json:"email"`
 
 	// EmployeeId Employee or staff ID
 	EmployeeId *string `json:"employee_id,omitempty"`
 
+	// FeeTier Fee tier of an event with a tiered fee. Defaults payment_amount to the tier's amount.
+	FeeTier *string `json:"fee_tier,omitempty"`
+
+	// GroupName Group or table the participant is seated at
+	GroupName *string `json:"group_name,omitempty"`
+
 	// Metadata Custom participant data (max 10KB JSON)
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 
 	// Name Participant full name
 	Name string `json:"name"`
 
-	// PaymentAmount Payment amount (decimal, 2 places)
-	PaymentAmount *float64 `json:"payment_amount,omitempty"`
+	// Notes Internal staff notes, visible only to the event organizer and admins
+	Notes *string `json:"notes,omitempty"`
+
+	// PaymentAmount Payment amount as a decimal string with up to 2 decimal places. JSON numbers are also accepted on input.
+	PaymentAmount *money.Amount `json:"payment_amount,omitempty"`
 
 	// PaymentDate Payment date/time (ISO 8601)
 	PaymentDate *time.Time `json:"payment_date,omitempty"`
@@ -561,25 +1258,81 @@ type CreateParticipantRequest struct {
 
 	// Status Participant status
 	Status *ParticipantStatus `json:"status,omitempty"`
+
+	// Tags Organizer labels (e.g. "vip"); duplicates are dropped
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// CustomField A piece of data the event collects from its participants beyond the built-in fields
+type CustomField struct {
+	// Key Key of the value in participant custom_data and CSV column name. Must not be the name of a built-in participant field.
+	Key string `json:"key"`
+
+	// Label Name shown to people filling in the field
+	Label string `json:"label"`
+
+	// Options Allowed values of a select field (1-100 unique values; select fields only)
+	Options *[]string `json:"options,omitempty"`
+
+	// Required Participants must have a value when created or when their custom_data is replaced
+	Required *bool `json:"required,omitempty"`
+
+	// Type Kind of value a custom participant field holds
+	Type CustomFieldType `json:"type"`
+}
+
+// CustomFieldType Kind of value a custom participant field holds
+type CustomFieldType string
+
+// DeletedParticipant defines model for DeletedParticipant.
+type DeletedParticipant struct {
+	// DeletedAt Deletion timestamp (ISO 8601)
+	DeletedAt time.Time `json:"deleted_at"`
+
+	// Id ID of the deleted participant
+	Id openapi_types.UUID `json:"id"`
 }
 
 // Event defines model for Event.
 type Event struct {
+	// Capacity How many participants can be confirmed; further confirmed participants are waitlisted (omitted if the event has no capacity limit)
+	Capacity *int `json:"capacity,omitempty"`
+
 	// CheckedInCount Number of checked-in participants
 	CheckedInCount *int `json:"checked_in_count,omitempty"`
 
+	// ConsentVersion Version of the consent terms participants accept (omitted if not set)
+	ConsentVersion *string `json:"consent_version,omitempty"`
+
 	// CreatedAt Creation timestamp (ISO 8601)
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 
+	// Currency ISO 4217 currency code for participant payment amounts (omitted if not set)
+	Currency *string `json:"currency,omitempty"`
+
+	// CustomFields Data collected from participants beyond the built-in fields, stored in their custom_data (omitted if none)
+	CustomFields *[]CustomField `json:"custom_fields,omitempty"`
+
+	// DeletedAt When the event was deleted (ISO 8601); only set on deleted events listed with include_deleted
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
 	// Description Event description
 	Description *string `json:"description,omitempty"`
 
 	// EndDate Event end date and time (ISO 8601, must be after start_date)
 	EndDate *time.Time `json:"end_date,omitempty"`
 
+	// Fee Event fee model. Free events record every participant as paid with a zero amount.
+	// Fixed and tiered fees default a new participant's payment_amount to the fixed amount
+	// or to the amount of the participant's fee_tier. Charged fees require an event currency.
+	Fee *EventFee `json:"fee,omitempty"`
+
 	// Id Event unique identifier
 	Id *openapi_types.UUID `json:"id,omitempty"`
 
+	// LegalHold Participant data is kept regardless of the retention period
+	LegalHold *bool `json:"legal_hold,omitempty"`
+
 	// Location Venue or location
 	Location *string `json:"location,omitempty"`
 
@@ -599,12 +1352,27 @@ type Event struct {
 	// ParticipantCount Total registered participants
 	ParticipantCount *int `json:"participant_count,omitempty"`
 
+	// PiiPurgedAt When the participants were anonymized by the data retention purge (omitted if not purged)
+	PiiPurgedAt *time.Time `json:"pii_purged_at,omitempty"`
+
+	// RequiresConsent Participants must accept the consent terms before they can check in
+	RequiresConsent *bool `json:"requires_consent,omitempty"`
+
+	// SeriesId Series shared by the occurrences of a recurring event (omitted if the event does not recur)
+	SeriesId *openapi_types.UUID `json:"series_id,omitempty"`
+
+	// SpotsRemaining Places left for confirmed participants (only returned by GET /events/{id}, omitted if the event has no capacity limit)
+	SpotsRemaining *int `json:"spots_remaining,omitempty"`
+
 	// StartDate Event start date and time (ISO 8601)
 	StartDate time.Time `json:"start_date"`
 
 	// Status Event status
 	Status EventStatus `json:"status"`
 
+	// TentativeExpiryHours Hours after registering or being invited that tentative and invited participants expire (omitted if they never expire)
+	TentativeExpiryHours *int `json:"tentative_expiry_hours,omitempty"`
+
 	// Timezone IANA timezone identifier
 	Timezone *string `json:"timezone,omitempty"`
 
@@ -612,24 +1380,139 @@ type Event struct {
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// EventFee Event fee model. Free events record every participant as paid with a zero amount.
+// Fixed and tiered fees default a new participant's payment_amount to the fixed amount
+// or to the amount of the participant's fee_tier. Charged fees require an event currency.
+type EventFee struct {
+	// Amount Fixed fee amount (required for fixed fees)
+	Amount *money.Amount `json:"amount,omitempty"`
+
+	// Tiers Named fee tiers (required for tiered fees)
+	Tiers *[]FeeTier `json:"tiers,omitempty"`
+
+	// Type Event fee model
+	Type FeeType `json:"type"`
+}
+
+// EventGroupStats defines model for EventGroupStats.
+type EventGroupStats struct {
+	// CheckedInParticipants Participants of the group who checked in
+	CheckedInParticipants int `json:"checked_in_participants"`
+
+	// TotalParticipants Active participants seated at the group
+	TotalParticipants int `json:"total_participants"`
+}
+
+// EventListMeta Pagination of an event list. Page numbers and totals are reported when paging by page.
+type EventListMeta struct {
+	// NextCursor Cursor of the next page when paging by cursor; absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+
+	// Page Current page number
+	Page *int `json:"page,omitempty"`
+
+	// PerPage Items per page
+	PerPage int `json:"per_page"`
+
+	// Relevance Relevance of each listed event to the full-text search by `name`, by event ID; higher is a better match. Present only when the list was searched by full text
+	Relevance *map[string]float64 `json:"relevance,omitempty"`
+
+	// Total Total number of events
+	Total *int `json:"total,omitempty"`
+
+	// TotalPages Total number of pages
+	TotalPages *int `json:"total_pages,omitempty"`
+}
+
 // EventListResponse defines model for EventListResponse.
 type EventListResponse struct {
-	Data []Event        `json:"data"`
-	Meta PaginationMeta `json:"meta"`
+	Data []Event       `json:"data"`
+	Meta EventListMeta `json:"meta"`
+}
+
+// EventOccurrencesResponse defines model for EventOccurrencesResponse.
+type EventOccurrencesResponse struct {
+	// Data Occurrences of the series, ordered by start date
+	Data []Event `json:"data"`
 }
 
 // EventStatsResponse defines model for EventStatsResponse.
 type EventStatsResponse struct {
-	ByStatus              *map[string]int    `json:"by_status,omitempty"`
-	CheckedInParticipants int                `json:"checked_in_participants"`
-	CheckinRate           float32            `json:"checkin_rate"`
-	EventId               openapi_types.UUID `json:"event_id"`
-	TotalParticipants     int                `json:"total_participants"`
+	// ByGroup Active participants by the group or table they are seated at; unassigned participants are left out
+	ByGroup               *map[string]EventGroupStats `json:"by_group,omitempty"`
+	ByStatus              *map[string]int             `json:"by_status,omitempty"`
+	CheckedInParticipants int                         `json:"checked_in_participants"`
+	CheckinRate           float32                     `json:"checkin_rate"`
+
+	// Currency ISO 4217 currency code of total_payment_amount (omitted if the event has no currency)
+	Currency *string            `json:"currency,omitempty"`
+	EventId  openapi_types.UUID `json:"event_id"`
+
+	// GuestParticipants Active guests registered under another participant, included in total_participants
+	GuestParticipants int `json:"guest_participants"`
+
+	// SpotsRemaining Places left for confirmed participants (omitted if the event has no capacity limit)
+	SpotsRemaining    *int64 `json:"spots_remaining,omitempty"`
+	TotalParticipants int    `json:"total_participants"`
+
+	// TotalPaymentAmount Sum of paid participants' payment amounts, in the event's currency
+	TotalPaymentAmount *money.Amount `json:"total_payment_amount,omitempty"`
+
+	// WalkInParticipants Active participants registered at the door through walk-in check-in
+	WalkInParticipants int `json:"walk_in_participants"`
+
+	// Warnings Alerts for stats that crossed their configured thresholds (empty when none apply)
+	Warnings []string `json:"warnings"`
 }
 
 // EventStatus Event status
 type EventStatus string
 
+// EventWebhookResponse defines model for EventWebhookResponse.
+type EventWebhookResponse struct {
+	CreatedAt time.Time `json:"created_at"`
+
+	// LastDeliveryAt When the latest delivery was attempted. Null until the first attempt.
+	LastDeliveryAt *time.Time `json:"last_delivery_at"`
+
+	// LastDeliveryError Why the latest delivery attempt failed. Null unless it failed.
+	LastDeliveryError *string `json:"last_delivery_error"`
+
+	// LastDeliveryStatus Outcome of the latest delivery attempt. Null until the first attempt.
+	LastDeliveryStatus *EventWebhookResponseLastDeliveryStatus `json:"last_delivery_status"`
+	UpdatedAt          time.Time                               `json:"updated_at"`
+
+	// Url URL that receives the event's check-in and registration notifications
+	Url string `json:"url"`
+}
+
+// EventWebhookResponseLastDeliveryStatus Outcome of the latest delivery attempt. Null until the first attempt.
+type EventWebhookResponseLastDeliveryStatus string
+
+// FeeTier defines model for FeeTier.
+type FeeTier struct {
+	Amount money.Amount `json:"amount"`
+	Name   string       `json:"name"`
+}
+
+// FeeType Event fee model
+type FeeType string
+
+// ForgotPasswordRequest defines model for ForgotPasswordRequest.
+type ForgotPasswordRequest struct {
+	// Email Email address of the account
+	Email openapi_types.Email `json:"email"`
+}
+
+// ForgotPasswordResponse defines model for ForgotPasswordResponse.
+type ForgotPasswordResponse struct {
+	// Message Confirmation message, the same whether or not the email is registered
+	Message string `json:"message"`
+
+	// ResetToken Password reset token; only returned outside production, when the email is registered
+	ResetToken *string `json:"reset_token,omitempty"`
+}
+
 // ImportParticipantsCSVResponse defines model for ImportParticipantsCSVResponse.
 type ImportParticipantsCSVResponse struct {
 	// Errors List of row-level errors
@@ -647,6 +1530,9 @@ type ImportParticipantsCSVResponse struct {
 	// FailedCount Number of rows that failed to import
 	FailedCount int `json:"failed_count"`
 
+	// IgnoredColumns Header columns that were not mapped to a participant column and were ignored
+	IgnoredColumns *[]string `json:"ignored_columns,omitempty"`
+
 	// ImportedCount Number of successfully imported participants
 	ImportedCount int `json:"imported_count"`
 
@@ -664,6 +1550,82 @@ type ImportParticipantsCSVResponse struct {
 		// Row 1-based row number in the CSV (excluding header)
 		Row int `json:"row"`
 	} `json:"skipped_rows,omitempty"`
+
+	// Warnings Imported rows with data-quality warnings, such as an email domain that cannot receive mail
+	Warnings *[]struct {
+		// Email Email of the row
+		Email *string `json:"email,omitempty"`
+
+		// Message Warning message
+		Message string `json:"message"`
+
+		// Row 1-based row number in the CSV (excluding header)
+		Row int `json:"row"`
+	} `json:"warnings,omitempty"`
+}
+
+// InvitationEmailFailure defines model for InvitationEmailFailure.
+type InvitationEmailFailure struct {
+	Email         openapi_types.Email `json:"email"`
+	ParticipantId openapi_types.UUID  `json:"participant_id"`
+	Reason        string              `json:"reason"`
+}
+
+// InviteParticipantRequest defines model for InviteParticipantRequest.
+type InviteParticipantRequest struct {
+	// Email Email address the invitation is sent to (must be unique within the event)
+	Email openapi_types.Email `json:"email"`
+
+	// EmployeeId Employee or staff ID
+	EmployeeId *string `json:"employee_id,omitempty"`
+
+	// FeeTier Fee tier of an event with a tiered fee. Defaults payment_amount to the tier's amount.
+	FeeTier *string `json:"fee_tier,omitempty"`
+
+	// Metadata Custom participant data (max 10KB JSON)
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+
+	// Name Invitee full name
+	Name string `json:"name"`
+
+	// Phone Phone number (preferably E.164 format)
+	Phone *string `json:"phone,omitempty"`
+
+	// QrEmail Alternative email for QR code distribution after acceptance
+	QrEmail *openapi_types.Email `json:"qr_email,omitempty"`
+}
+
+// InviteParticipantsRequest defines model for InviteParticipantsRequest.
+type InviteParticipantsRequest struct {
+	// Participants People to invite (max 1000)
+	Participants []InviteParticipantRequest `json:"participants"`
+}
+
+// InviteParticipantsResponse defines model for InviteParticipantsResponse.
+type InviteParticipantsResponse struct {
+	// EmailFailures Invited participants whose invitation email could not be sent. Empty array when all succeeded.
+	EmailFailures []InvitationEmailFailure `json:"email_failures"`
+
+	// Errors List of errors for invitees that could not be created
+	Errors *[]struct {
+		// Email Email of the failed invitee
+		Email openapi_types.Email `json:"email"`
+
+		// Error Error message
+		Error string `json:"error"`
+
+		// Index Index in the original request array
+		Index int `json:"index"`
+	} `json:"errors,omitempty"`
+
+	// FailedCount Number of invitees that could not be created
+	FailedCount int `json:"failed_count"`
+
+	// InvitedCount Number of participants created in invited status
+	InvitedCount int `json:"invited_count"`
+
+	// Participants Participants created in invited status (without QR codes)
+	Participants []Participant `json:"participants"`
 }
 
 // ListResponse defines model for ListResponse.
@@ -680,6 +1642,15 @@ type LoginRequest struct {
 
 	// Password User password
 	Password string `json:"password"`
+
+	// Platform Platform of the client, which decides how long its refresh tokens are valid:
+	// `JWT_REFRESH_TOKEN_EXPIRY_WEB` or `JWT_REFRESH_TOKEN_EXPIRY_MOBILE`
+	Platform *ClientPlatform `json:"platform,omitempty"`
+
+	// TotpCode Second factor for users with two-factor authentication: the current code from the
+	// authenticator app or an unused backup code. When given, the login completes without a
+	// challenge; when omitted, such users get a challenge to complete with `POST /auth/2fa/login`.
+	TotpCode *string `json:"totp_code,omitempty"`
 }
 
 // LogoutResponse defines model for LogoutResponse.
@@ -688,6 +1659,22 @@ type LogoutResponse struct {
 	Message string `json:"message"`
 }
 
+// MergeParticipantsRequest defines model for MergeParticipantsRequest.
+type MergeParticipantsRequest struct {
+	// DuplicateId Participant of the same event that is merged into the primary and deleted
+	DuplicateId openapi_types.UUID `json:"duplicate_id"`
+
+	// PrimaryId Participant that keeps its identity and receives the duplicate's check-ins
+	PrimaryId openapi_types.UUID `json:"primary_id"`
+}
+
+// MergeParticipantsResponse defines model for MergeParticipantsResponse.
+type MergeParticipantsResponse struct {
+	// Checkins The combined check-in history of the merged participant, oldest first
+	Checkins    []CheckInHistoryEntry `json:"checkins"`
+	Participant Participant           `json:"participant"`
+}
+
 // PaginationMeta defines model for PaginationMeta.
 type PaginationMeta struct {
 	// Page Current page number
@@ -711,11 +1698,23 @@ type Participant struct {
 	// CheckedInAt Check-in timestamp (ISO 8601)
 	CheckedInAt *time.Time `json:"checked_in_at,omitempty"`
 
+	// ConsentAcceptedAt When the participant accepted the event's consent terms (ISO 8601); null if not accepted
+	ConsentAcceptedAt *time.Time `json:"consent_accepted_at,omitempty"`
+
+	// ConsentVersion Version of the consent terms the participant accepted
+	ConsentVersion *string `json:"consent_version,omitempty"`
+
 	// CreatedAt Creation timestamp (ISO 8601)
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 
-	// Email Email address (unique per event)
-	Email openapi_types.Email `json:"email"`
+	// CustomData Values of the event's custom fields by key (omitted if none)
+	CustomData *map[string]interface{} `json:"custom_data,omitempty"`
+
+	// DeletedAt When the participant was deleted (ISO 8601); only set on deleted participants listed with include_deleted
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
+
+	// Email Email address (unique per event; empty for walk-ins and guests registered without one)
+	Email string `json:"email"`
 
 	// EmployeeId Employee or staff ID
 	EmployeeId *string `json:"employee_id,omitempty"`
@@ -723,17 +1722,29 @@ type Participant struct {
 	// EventId Associated event ID
 	EventId *openapi_types.UUID `json:"event_id,omitempty"`
 
+	// GroupName Group or table the participant is seated at (null when unassigned)
+	GroupName *string `json:"group_name,omitempty"`
+
+	// GuestOf Registrant this participant is a guest of; guests have their own QR code and check-in
+	GuestOf *openapi_types.UUID `json:"guest_of,omitempty"`
+
 	// Id Participant unique identifier
 	Id *openapi_types.UUID `json:"id,omitempty"`
 
+	// InviteSentAt When the participant was last emailed their QR code with the event details (ISO 8601); null if never
+	InviteSentAt *time.Time `json:"invite_sent_at,omitempty"`
+
 	// Metadata Custom participant data (max 10KB JSON, not included in list responses)
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 
 	// Name Participant full name
 	Name string `json:"name"`
 
-	// PaymentAmount Payment amount (decimal, 2 places)
-	PaymentAmount *float64 `json:"payment_amount,omitempty"`
+	// Notes Internal staff notes; only returned to the event organizer and admins
+	Notes *string `json:"notes,omitempty"`
+
+	// PaymentAmount Payment amount as a decimal string with up to 2 decimal places. JSON numbers are also accepted on input.
+	PaymentAmount *money.Amount `json:"payment_amount,omitempty"`
 
 	// PaymentDate Payment date/time (ISO 8601)
 	PaymentDate *time.Time `json:"payment_date,omitempty"`
@@ -759,51 +1770,285 @@ type Participant struct {
 	// Status Participant status
 	Status ParticipantStatus `json:"status"`
 
+	// Tags Organizer labels, in the order they were added
+	Tags *[]string `json:"tags,omitempty"`
+
 	// UpdatedAt Last update timestamp (ISO 8601)
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+
+	// WalkIn Whether the participant was registered at the door through walk-in check-in
+	WalkIn *bool `json:"walk_in,omitempty"`
+
+	// Warnings Data-quality warnings found when the participant was created, e.g. an email domain that cannot receive mail. Only returned when creating participants.
+	Warnings *[]string `json:"warnings,omitempty"`
 }
 
-// ParticipantListResponse defines model for ParticipantListResponse.
-type ParticipantListResponse struct {
-	Data []Participant  `json:"data"`
-	Meta PaginationMeta `json:"meta"`
+// ParticipantAutocompleteResponse defines model for ParticipantAutocompleteResponse.
+type ParticipantAutocompleteResponse struct {
+	// Data Matching participants, those not yet checked in first
+	Data []ParticipantSuggestion `json:"data"`
 }
 
-// ParticipantStatus Participant status
-type ParticipantStatus string
+// ParticipantChangesResponse defines model for ParticipantChangesResponse.
+type ParticipantChangesResponse struct {
+	// Deleted Participants deleted after `since`
+	Deleted []DeletedParticipant `json:"deleted"`
 
-// PaymentStatus Payment status
-type PaymentStatus string
+	// Participants Participants created or updated after `since`, in the order they changed
+	Participants []Participant `json:"participants"`
 
-// ProblemDetails RFC 9457 Problem Details - all fields are optional
-type ProblemDetails struct {
-	// Code Machine-readable error code (extension for backward compatibility)
-	Code *string `json:"code,omitempty"`
+	// Since Cursor to send as `since` in the next request
+	Since time.Time `json:"since"`
+}
 
-	// Detail Human-readable explanation specific to this occurrence
-	Detail *string `json:"detail,omitempty"`
+// ParticipantListMeta Pagination of a participant list, and the event's capacity if it has one
+type ParticipantListMeta struct {
+	// Capacity How many participants the event can confirm (omitted if the event has no capacity limit)
+	Capacity *int `json:"capacity,omitempty"`
 
-	// Errors Validation errors (extension for validation problems)
-	Errors *[]ValidationError `json:"errors,omitempty"`
+	// CapacityRemaining Places left for confirmed participants (omitted if the event has no capacity limit)
+	CapacityRemaining *int `json:"capacity_remaining,omitempty"`
 
-	// Instance URI reference identifying the specific occurrence of the problem
-	Instance *string `json:"instance,omitempty"`
+	// Page Current page number
+	Page int `json:"page"`
 
-	// Status HTTP status code
-	Status *int `json:"status,omitempty"`
+	// PerPage Items per page
+	PerPage int `json:"per_page"`
 
-	// Title Short, human-readable summary of the problem type
-	Title *string `json:"title,omitempty"`
+	// Total Total number of participants
+	Total int `json:"total"`
 
-	// Type URI reference identifying the problem type
-	Type *string `json:"type,omitempty"`
+	// TotalPages Total number of pages
+	TotalPages int `json:"total_pages"`
 }
 
-// RefreshTokenRequest defines model for RefreshTokenRequest.
-type RefreshTokenRequest struct {
-	// RefreshToken Valid refresh token obtained from login or previous refresh
-	RefreshToken string `json:"refresh_token"`
-}
+// ParticipantListResponse defines model for ParticipantListResponse.
+type ParticipantListResponse struct {
+	Data []Participant       `json:"data"`
+	Meta ParticipantListMeta `json:"meta"`
+}
+
+// ParticipantLookupResponse defines model for ParticipantLookupResponse.
+type ParticipantLookupResponse struct {
+	// Data Participants registered with the email, at most one per event, newest first
+	Data []Participant `json:"data"`
+}
+
+// ParticipantStatsResponse defines model for ParticipantStatsResponse.
+type ParticipantStatsResponse struct {
+	// ConfirmedParticipants Number of confirmed participants
+	ConfirmedParticipants int `json:"confirmed_participants"`
+
+	// Currency ISO 4217 currency code of the revenue (omitted if the event has no currency)
+	Currency *string `json:"currency,omitempty"`
+
+	// DeclinedParticipants Number of participants who declined
+	DeclinedParticipants int                `json:"declined_participants"`
+	EventId              openapi_types.UUID `json:"event_id"`
+
+	// PaidParticipants Number of participants who have paid
+	PaidParticipants int `json:"paid_participants"`
+
+	// TentativeParticipants Number of tentative participants
+	TentativeParticipants int `json:"tentative_participants"`
+
+	// TotalParticipants Number of participants of the event
+	TotalParticipants int `json:"total_participants"`
+
+	// TotalRefunded Sum of the payments refunded to participants
+	TotalRefunded money.Amount `json:"total_refunded"`
+
+	// TotalRevenue Sum of payment amounts of paid participants
+	TotalRevenue money.Amount `json:"total_revenue"`
+
+	// UnpaidParticipants Number of participants who have not paid
+	UnpaidParticipants int `json:"unpaid_participants"`
+}
+
+// ParticipantStatus Participant status
+type ParticipantStatus string
+
+// ParticipantSuggestion defines model for ParticipantSuggestion.
+type ParticipantSuggestion struct {
+	// CheckedIn Whether the participant has already checked in
+	CheckedIn bool `json:"checked_in"`
+
+	// EmailMasked Participant email with the local part masked; omitted when the participant has no email
+	EmailMasked *string `json:"email_masked,omitempty"`
+
+	// Id Participant ID
+	Id openapi_types.UUID `json:"id"`
+
+	// Name Participant name
+	Name string `json:"name"`
+}
+
+// ParticipantTagFilter Selects participants of the event; unset fields match every participant, so an empty filter selects all of them
+type ParticipantTagFilter struct {
+	// PaymentStatus Payment status
+	PaymentStatus *PaymentStatus `json:"payment_status,omitempty"`
+
+	// Status Participant status
+	Status *ParticipantStatus `json:"status,omitempty"`
+
+	// Tag Only participants that already have this tag
+	Tag *string `json:"tag,omitempty"`
+}
+
+// ParticipantValidationResult defines model for ParticipantValidationResult.
+type ParticipantValidationResult struct {
+	// DuplicateEmail Whether the email is already registered for the event or appears earlier in the
+	// same request. Such payloads are invalid.
+	DuplicateEmail bool `json:"duplicate_email"`
+
+	// Email Email of the payload as submitted
+	Email string `json:"email"`
+
+	// Errors Reasons the payload would be rejected (empty when valid)
+	Errors []ValidationError `json:"errors"`
+
+	// Index Index in the original request array
+	Index int `json:"index"`
+
+	// Valid Whether the payload would be created
+	Valid bool `json:"valid"`
+}
+
+// Payment defines model for Payment.
+type Payment struct {
+	// Amount Amount paid
+	Amount money.Amount `json:"amount"`
+
+	// Currency ISO 4217 currency code of the amount, the event's currency when recorded
+	Currency string             `json:"currency"`
+	EventId  openapi_types.UUID `json:"event_id"`
+
+	// Id Payment unique identifier
+	Id openapi_types.UUID `json:"id"`
+
+	// Method How a participant paid
+	Method        PaymentMethod      `json:"method"`
+	ParticipantId openapi_types.UUID `json:"participant_id"`
+
+	// RecordedAt When the payment was recorded (ISO 8601)
+	RecordedAt time.Time `json:"recorded_at"`
+
+	// RecordedBy User who recorded the payment
+	RecordedBy openapi_types.UUID `json:"recorded_by"`
+
+	// Reference Receipt or transaction number (omitted if none)
+	Reference *string `json:"reference,omitempty"`
+
+	// RefundedAt When the payment was refunded (ISO 8601); null unless refunded
+	RefundedAt *time.Time `json:"refunded_at"`
+
+	// RefundedBy User who refunded the payment; null unless refunded
+	RefundedBy *openapi_types.UUID `json:"refunded_by"`
+}
+
+// PaymentMethod How a participant paid
+type PaymentMethod string
+
+// PaymentStatus Payment status
+type PaymentStatus string
+
+// PaymentSummaryResponse defines model for PaymentSummaryResponse.
+type PaymentSummaryResponse struct {
+	// CollectedAmount Sum of payment amounts of paid participants
+	CollectedAmount money.Amount `json:"collected_amount"`
+
+	// Currency ISO 4217 currency code of all amounts (omitted if the event has no currency)
+	Currency *string            `json:"currency,omitempty"`
+	EventId  openapi_types.UUID `json:"event_id"`
+
+	// ExpectedAmount Sum of payment amounts owed by confirmed participants
+	ExpectedAmount money.Amount `json:"expected_amount"`
+
+	// OutstandingAmount Sum of payment amounts owed by confirmed, unpaid participants
+	OutstandingAmount  money.Amount `json:"outstanding_amount"`
+	PaidParticipants   int          `json:"paid_participants"`
+	TotalParticipants  int          `json:"total_participants"`
+	UnpaidParticipants int          `json:"unpaid_participants"`
+
+	// UnpricedParticipants Confirmed participants without a payment amount (not included in expected or outstanding)
+	UnpricedParticipants int `json:"unpriced_participants"`
+}
+
+// ProblemDetails RFC 9457 Problem Details - all fields are optional
+type ProblemDetails struct {
+	// Code Machine-readable error code (extension for backward compatibility)
+	Code *string `json:"code,omitempty"`
+
+	// Detail Human-readable explanation specific to this occurrence
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Validation errors (extension for validation problems)
+	Errors *[]ValidationError `json:"errors,omitempty"`
+
+	// Instance URI reference identifying the specific occurrence of the problem
+	Instance *string `json:"instance,omitempty"`
+
+	// Status HTTP status code
+	Status *int `json:"status,omitempty"`
+
+	// Title Short, human-readable summary of the problem type
+	Title *string `json:"title,omitempty"`
+
+	// Type URI reference identifying the problem type
+	Type *string `json:"type,omitempty"`
+}
+
+// RecordPaymentRequest defines model for RecordPaymentRequest.
+type RecordPaymentRequest struct {
+	// Amount Amount paid, in the event's currency, as a decimal string with up to 2 decimal places. JSON numbers are also accepted.
+	Amount money.Amount `json:"amount"`
+
+	// Method How a participant paid
+	Method PaymentMethod `json:"method"`
+
+	// Reference Receipt or transaction number; a reference can only be recorded once per event
+	Reference *string `json:"reference,omitempty"`
+}
+
+// RecurrenceFrequency Unit of time between the occurrences of a recurring event
+type RecurrenceFrequency string
+
+// PublicCheckInStatusResponse Check-in status of a participant as shown to the participant, without personal data beyond the name
+type PublicCheckInStatusResponse struct {
+	// CheckedIn Whether the participant has checked in
+	CheckedIn bool `json:"checked_in"`
+
+	// EventName Event name
+	EventName string `json:"event_name"`
+
+	// ParticipantName Participant full name
+	ParticipantName string `json:"participant_name"`
+}
+
+// RecurrenceRule Repeats the event, creating an occurrence for each repetition linked by `series_id`. Set
+// exactly one of `count` and `until`. Occurrences start at the same local time in the event's
+// timezone and last as long as the first; monthly occurrences skip months without the day of
+// the month of `start_date`. At most `RECURRENCE_MAX_OCCURRENCES` (365 unless changed)
+// occurrences are created.
+type RecurrenceRule struct {
+	// Count Number of occurrences, the first included
+	Count *int `json:"count,omitempty"`
+
+	// Frequency Unit of time between the occurrences of a recurring event
+	Frequency RecurrenceFrequency `json:"frequency"`
+
+	// Interval Number of days, weeks or months between occurrences
+	Interval *int `json:"interval,omitempty"`
+
+	// Until Latest start of an occurrence, inclusive (ISO 8601)
+	Until *time.Time `json:"until,omitempty"`
+}
+
+// RefreshTokenRequest defines model for RefreshTokenRequest.
+type RefreshTokenRequest struct {
+	// RefreshToken Valid refresh token obtained from login or previous refresh
+	RefreshToken string `json:"refresh_token"`
+}
 
 // RegisterRequest defines model for RegisterRequest.
 type RegisterRequest struct {
@@ -816,10 +2061,74 @@ type RegisterRequest struct {
 	// Password Password (minimum 8 characters)
 	Password string `json:"password"`
 
+	// Platform Platform of the client, which decides how long its refresh tokens are valid:
+	// `JWT_REFRESH_TOKEN_EXPIRY_WEB` or `JWT_REFRESH_TOKEN_EXPIRY_MOBILE`
+	Platform *ClientPlatform `json:"platform,omitempty"`
+
 	// Role User role
 	Role UserRole `json:"role"`
 }
 
+// ResetPasswordRequest defines model for ResetPasswordRequest.
+type ResetPasswordRequest struct {
+	// NewPassword New password (minimum 8 characters)
+	NewPassword string `json:"new_password"`
+
+	// Token Password reset token from forgot-password
+	Token string `json:"token"`
+}
+
+// RevokeSessionsResponse defines model for RevokeSessionsResponse.
+type RevokeSessionsResponse struct {
+	// RevokedCount Number of sessions revoked
+	RevokedCount int `json:"revoked_count"`
+}
+
+// ScanAnalyticsBucket defines model for ScanAnalyticsBucket.
+type ScanAnalyticsBucket struct {
+	Counts ScanOutcomeCounts `json:"counts"`
+
+	// Start Start of the bucket (ISO 8601, UTC)
+	Start time.Time `json:"start"`
+}
+
+// ScanAnalyticsResponse defines model for ScanAnalyticsResponse.
+type ScanAnalyticsResponse struct {
+	// EventId Event identifier
+	EventId openapi_types.UUID `json:"event_id"`
+
+	// IntervalMinutes Size of the timeline buckets in minutes
+	IntervalMinutes int `json:"interval_minutes"`
+
+	// Timeline Scans per bucket, oldest first; buckets without scans are omitted
+	Timeline []ScanAnalyticsBucket `json:"timeline"`
+	Totals   ScanOutcomeCounts     `json:"totals"`
+}
+
+// ScanOutcomeCounts defines model for ScanOutcomeCounts.
+type ScanOutcomeCounts struct {
+	// Duplicate Scans of participants who had already checked in
+	Duplicate int `json:"duplicate"`
+
+	// Invalid Scans of QR codes not issued by this server, or of participants who may not check in
+	Invalid int `json:"invalid"`
+
+	// NotFound Scans of QR codes matching no participant of the event
+	NotFound int `json:"not_found"`
+
+	// Success Scans that checked the participant in
+	Success int `json:"success"`
+
+	// Total All scans
+	Total int `json:"total"`
+}
+
+// SendInvitesRequest defines model for SendInvitesRequest.
+type SendInvitesRequest struct {
+	// ParticipantIds Participants to email. When omitted or empty, every participant with a QR code not sent one yet.
+	ParticipantIds *[]openapi_types.UUID `json:"participant_ids,omitempty"`
+}
+
 // SendQRCodeFailure defines model for SendQRCodeFailure.
 type SendQRCodeFailure struct {
 	Email         openapi_types.Email `json:"email"`
@@ -844,6 +2153,15 @@ type SendQRCodesRequest struct {
 // SendQRCodesRequestEmailTemplate Email template to use
 type SendQRCodesRequestEmailTemplate string
 
+// SetEventWebhookRequest defines model for SetEventWebhookRequest.
+type SetEventWebhookRequest struct {
+	// Secret HMAC-SHA256 secret used to sign the notifications in the `X-Ezqrin-Signature` header. Never returned.
+	Secret string `json:"secret"`
+
+	// Url Absolute http(s) URL that receives the event's check-in and registration notifications
+	Url string `json:"url"`
+}
+
 // SendQRCodesResponse defines model for SendQRCodesResponse.
 type SendQRCodesResponse struct {
 	FailedCount int `json:"failed_count"`
@@ -854,53 +2172,178 @@ type SendQRCodesResponse struct {
 	Total     int                 `json:"total"`
 }
 
+// Session defines model for Session.
+type Session struct {
+	// ClientType Client type the session was started from
+	ClientType SessionClientType `json:"client_type"`
+
+	// Current Whether this is the session of the access token of the request
+	Current bool `json:"current"`
+
+	// Device User-Agent of the client that started the session; empty when not sent
+	Device string `json:"device"`
+
+	// ExpiresAt When the current refresh token of the session expires
+	ExpiresAt time.Time `json:"expires_at"`
+
+	// Id Session unique identifier
+	Id openapi_types.UUID `json:"id"`
+
+	// IssuedAt When the session started
+	IssuedAt time.Time `json:"issued_at"`
+
+	// LastUsedAt When tokens were last issued for the session, at login or refresh
+	LastUsedAt time.Time `json:"last_used_at"`
+}
+
+// SessionClientType Client type the session was started from
+type SessionClientType string
+
+// SessionListResponse defines model for SessionListResponse.
+type SessionListResponse struct {
+	// Sessions Active sessions, most recently used first
+	Sessions []Session `json:"sessions"`
+}
+
+// StaffCheckInCount defines model for StaffCheckInCount.
+type StaffCheckInCount struct {
+	// CheckinCount Number of check-ins recorded by the staff member
+	CheckinCount int `json:"checkin_count"`
+
+	// Name Staff member's name
+	Name string `json:"name"`
+
+	// UserId Staff member who recorded the check-ins
+	UserId openapi_types.UUID `json:"user_id"`
+}
+
+// TwoFactorChallengeResponse defines model for TwoFactorChallengeResponse.
+type TwoFactorChallengeResponse struct {
+	// Challenge Short-lived challenge token to send with the code
+	Challenge string `json:"challenge"`
+
+	// ExpiresIn Challenge expiration time in seconds (300 = 5 minutes)
+	ExpiresIn int `json:"expires_in"`
+
+	// TwoFactorRequired Always true; the login must be completed at POST /auth/2fa/login
+	TwoFactorRequired bool `json:"two_factor_required"`
+}
+
+// TwoFactorEnrollmentResponse defines model for TwoFactorEnrollmentResponse.
+type TwoFactorEnrollmentResponse struct {
+	// OtpauthUri otpauth:// URI carrying the secret
+	OtpauthUri string `json:"otpauth_uri"`
+
+	// QrCode Base64-encoded PNG QR code of otpauth_uri
+	QrCode string `json:"qr_code"`
+
+	// Secret Base32-encoded TOTP secret for manual entry in an authenticator app
+	Secret string `json:"secret"`
+}
+
+// TwoFactorLoginRequest defines model for TwoFactorLoginRequest.
+type TwoFactorLoginRequest struct {
+	// Challenge Challenge token returned by POST /auth/login
+	Challenge string `json:"challenge"`
+
+	// Code Current TOTP code from the authenticator app, or an unused backup code
+	Code string `json:"code"`
+}
+
+// TwoFactorVerifyRequest defines model for TwoFactorVerifyRequest.
+type TwoFactorVerifyRequest struct {
+	// Code Current TOTP code from the authenticator app
+	Code string `json:"code"`
+}
+
+// TwoFactorVerifyResponse defines model for TwoFactorVerifyResponse.
+type TwoFactorVerifyResponse struct {
+	// BackupCodes Single-use backup codes; shown only once
+	BackupCodes []string `json:"backup_codes"`
+}
+
 // UpdateEventRequest defines model for UpdateEventRequest.
 type UpdateEventRequest struct {
+	// Capacity How many participants can be confirmed. 0 removes the limit; lowering it does not waitlist participants already confirmed.
+	Capacity *int `json:"capacity,omitempty"`
+
+	// ConsentVersion Version of the consent terms participants accept
+	ConsentVersion *string `json:"consent_version,omitempty"`
+
+	// Currency ISO 4217 currency code for participant payment amounts
+	Currency *string `json:"currency,omitempty"`
+
 	// Description Event description
 	Description *string `json:"description,omitempty"`
 
 	// EndDate Event end date and time. Normalized to UTC by server.
 	EndDate *time.Time `json:"end_date,omitempty"`
 
+	// Fee Event fee model. Free events record every participant as paid with a zero amount.
+	// Fixed and tiered fees default a new participant's payment_amount to the fixed amount
+	// or to the amount of the participant's fee_tier. Charged fees require an event currency.
+	Fee *EventFee `json:"fee,omitempty"`
+
+	// LegalHold Exempt the participant data from the retention purge. Only admins can change it.
+	LegalHold *bool `json:"legal_hold,omitempty"`
+
 	// Location Venue or location
 	Location *string `json:"location,omitempty"`
 
 	// Name Event name
 	Name *string `json:"name,omitempty"`
 
+	// RequiresConsent Participants must accept the consent terms before they can check in
+	RequiresConsent *bool `json:"requires_consent,omitempty"`
+
 	// StartDate Event start date and time. Normalized to UTC by server.
 	StartDate *time.Time `json:"start_date,omitempty"`
 
 	// Status Event status
 	Status *EventStatus `json:"status,omitempty"`
 
+	// TentativeExpiryHours Hours after registering or being invited that tentative and invited participants expire. 0 turns expiry off.
+	TentativeExpiryHours *int `json:"tentative_expiry_hours,omitempty"`
+
 	// Timezone IANA timezone identifier (e.g. America/New_York, Asia/Tokyo). Used as display metadata.
 	Timezone *string `json:"timezone,omitempty"`
 }
 
+// UpdateParticipantFieldsRequest defines model for UpdateParticipantFieldsRequest.
+type UpdateParticipantFieldsRequest struct {
+	// Fields Custom fields of the event, replacing the current ones. Send an empty array to remove every field.
+	Fields []CustomField `json:"fields"`
+}
+
 // UpdateParticipantRequest defines model for UpdateParticipantRequest.
 type UpdateParticipantRequest struct {
+	// CustomData Values of the event's custom fields by key, replacing the current values. Must match the event's field definitions; required fields must be set.
+	CustomData *map[string]interface{} `json:"custom_data,omitempty"`
+
 	// Email Email address (must be unique within the event)
 	Email *openapi_types.Email `json:"email,omitempty"`
 
 	// EmployeeId Employee or staff ID
 	EmployeeId *string `json:"employee_id,omitempty"`
 
+	// GroupName Group or table the participant is seated at. An empty string clears the assignment.
+	GroupName *string `json:"group_name,omitempty"`
+
 	// Metadata Custom participant data (max 10KB JSON)
 	Metadata *map[string]interface{} `json:"metadata,omitempty"`
 
 	// Name Participant full name
 	Name *string `json:"name,omitempty"`
 
-	// PaymentAmount Payment amount (decimal, 2 places)
-	PaymentAmount *float64 `json:"payment_amount,omitempty"`
+	// Notes Internal staff notes, visible only to the event organizer and admins. An empty string clears the notes.
+	Notes *string `json:"notes,omitempty"`
+
+	// PaymentAmount Payment amount as a decimal string with up to 2 decimal places. JSON numbers are also accepted on input.
+	PaymentAmount *money.Amount `json:"payment_amount,omitempty"`
 
 	// PaymentDate Payment date/time (ISO 8601)
 	PaymentDate *time.Time `json:"payment_date,omitempty"`
 
-	// PaymentStatus Payment status
-	PaymentStatus *PaymentStatus `json:"payment_status,omitempty"`
-
 	// Phone Phone number (preferably E.164 format)
 	Phone *string `json:"phone,omitempty"`
 
@@ -909,6 +2352,39 @@ type UpdateParticipantRequest struct {
 
 	// Status Participant status
 	Status *ParticipantStatus `json:"status,omitempty"`
+
+	// Tags Replaces the participant's tags; an empty list clears them
+	Tags *[]string `json:"tags,omitempty"`
+}
+
+// UpdateParticipantTagsRequest defines model for UpdateParticipantTagsRequest.
+type UpdateParticipantTagsRequest struct {
+	// Add Tags to add
+	Add *[]string `json:"add,omitempty"`
+
+	// Filter Selects participants of the event; unset fields match every participant, so an empty filter selects all of them
+	Filter *ParticipantTagFilter `json:"filter,omitempty"`
+
+	// ParticipantIds Participants to update (max 1000); omit when using `filter`
+	ParticipantIds *[]openapi_types.UUID `json:"participant_ids,omitempty"`
+
+	// Remove Tags to remove
+	Remove *[]string `json:"remove,omitempty"`
+}
+
+// UpdateParticipantTagsResponse defines model for UpdateParticipantTagsResponse.
+type UpdateParticipantTagsResponse struct {
+	// UpdatedCount Number of participants whose tags changed
+	UpdatedCount int64 `json:"updated_count"`
+}
+
+// UpdateUserRequest At least one field must be provided. Omitted fields are left unchanged.
+type UpdateUserRequest struct {
+	// Deactivated Deactivate the user when true, reactivate them when false
+	Deactivated *bool `json:"deactivated,omitempty"`
+
+	// Role User role
+	Role *UserRole `json:"role,omitempty"`
 }
 
 // User defines model for User.
@@ -916,9 +2392,15 @@ type User struct {
 	// CreatedAt Creation timestamp (ISO 8601)
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 
+	// DeactivatedAt When an admin deactivated the account (ISO 8601); only set on deactivated users
+	DeactivatedAt *time.Time `json:"deactivated_at,omitempty"`
+
 	// Email Email address (unique)
 	Email openapi_types.Email `json:"email"`
 
+	// EmailVerified Whether the user has verified their email address with the link sent on registration
+	EmailVerified *bool `json:"email_verified,omitempty"`
+
 	// Id User unique identifier
 	Id *openapi_types.UUID `json:"id,omitempty"`
 
@@ -928,13 +2410,40 @@ type User struct {
 	// Role User role
 	Role UserRole `json:"role"`
 
+	// TwoFactorEnabled Whether login requires a TOTP code or backup code
+	TwoFactorEnabled *bool `json:"two_factor_enabled,omitempty"`
+
 	// UpdatedAt Last update timestamp (ISO 8601)
 	UpdatedAt *time.Time `json:"updated_at,omitempty"`
 }
 
+// UserListResponse defines model for UserListResponse.
+type UserListResponse struct {
+	Data []User         `json:"data"`
+	Meta PaginationMeta `json:"meta"`
+}
+
 // UserRole User role
 type UserRole string
 
+// ValidateParticipantsRequest defines model for ValidateParticipantsRequest.
+type ValidateParticipantsRequest struct {
+	// Participants Array of participant payloads to validate (max 1000)
+	Participants []CreateParticipantRequest `json:"participants"`
+}
+
+// ValidateParticipantsResponse defines model for ValidateParticipantsResponse.
+type ValidateParticipantsResponse struct {
+	// InvalidCount Number of payloads that would be rejected
+	InvalidCount int `json:"invalid_count"`
+
+	// Results Validation result for each payload, in request order
+	Results []ParticipantValidationResult `json:"results"`
+
+	// ValidCount Number of payloads that would be created
+	ValidCount int `json:"valid_count"`
+}
+
 // ValidationError defines model for ValidationError.
 type ValidationError struct {
 	// Field Field name that caused the error
@@ -944,6 +2453,27 @@ type ValidationError struct {
 	Message string `json:"message"`
 }
 
+// VerifyEmailRequest defines model for VerifyEmailRequest.
+type VerifyEmailRequest struct {
+	// Token Verification token from the link in the verification email
+	Token string `json:"token"`
+}
+
+// WalkInCheckInRequest defines model for WalkInCheckInRequest.
+type WalkInCheckInRequest struct {
+	// ConsentAccepted The walk-in accepted the event's consent terms; required for events that require consent
+	ConsentAccepted *bool `json:"consent_accepted,omitempty"`
+
+	// DeviceInfo Device metadata for check-in tracking (max 5KB JSON, optional)
+	DeviceInfo *map[string]interface{} `json:"device_info,omitempty"`
+
+	// Email Walk-in participant email (optional; must be unique per event when given)
+	Email *openapi_types.Email `json:"email,omitempty"`
+
+	// Name Walk-in participant full name
+	Name string `json:"name"`
+}
+
 // CheckInIDParam defines model for CheckInIDParam.
 type CheckInIDParam = openapi_types.UUID
 
@@ -962,9 +2492,15 @@ type ParticipantIDParam = openapi_types.UUID
 // PerPageParam defines model for PerPageParam.
 type PerPageParam = int
 
+// SessionIDParam defines model for SessionIDParam.
+type SessionIDParam = openapi_types.UUID
+
 // SortParam defines model for SortParam.
 type SortParam = string
 
+// UserIDParam defines model for UserIDParam.
+type UserIDParam = openapi_types.UUID
+
 // BadRequest RFC 9457 Problem Details - all fields are optional
 type BadRequest = ProblemDetails
 
@@ -995,6 +2531,21 @@ type ValidationErrorResponse = ProblemDetails
 // bearerAuthContextKey is the context key for bearerAuth security scheme
 type bearerAuthContextKey string
 
+// ListAuditLogsParams defines parameters for ListAuditLogs.
+type ListAuditLogsParams struct {
+	// Page Page number (min 1)
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Items per page (min 1, max 100)
+	PerPage *PerPageParam `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// ResourceType Filter by resource type
+	ResourceType *AuditResourceType `form:"resource_type,omitempty" json:"resource_type,omitempty"`
+
+	// ResourceId Filter by resource ID
+	ResourceId *openapi_types.UUID `form:"resource_id,omitempty" json:"resource_id,omitempty"`
+}
+
 // GetEventsParams defines parameters for GetEvents.
 type GetEventsParams struct {
 	// Page Page number (min 1)
@@ -1009,11 +2560,25 @@ type GetEventsParams struct {
 	// Order Sort order (asc or desc)
 	Order *GetEventsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
 
-	// Name Filter by event name (partial match)
+	// Cursor Page by cursor instead of page number. Send it empty for the first page, then pass
+	// `meta.next_cursor` from the previous page until it is absent. `page` is ignored and no
+	// total is reported. A cursor only continues the sort and order it was issued for.
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Name Search events by name, description and location. Terms of 3 or more characters are matched by full-text search and, unless `sort` is given, listed by relevance; shorter terms match as substrings
 	Name *string `form:"name,omitempty" json:"name,omitempty"`
 
 	// Status Filter by event status
 	Status *EventStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// StartFrom Only events starting at or after this time (RFC 3339)
+	StartFrom *time.Time `form:"start_from,omitempty" json:"start_from,omitempty"`
+
+	// StartTo Only events starting at or before this time (RFC 3339); must not be before start_from
+	StartTo *time.Time `form:"start_to,omitempty" json:"start_to,omitempty"`
+
+	// IncludeDeleted List deleted events along with live ones, marked by `deleted_at`. Admin only.
+	IncludeDeleted *bool `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
 }
 
 // GetEventsParamsOrder defines parameters for GetEvents.
@@ -1040,6 +2605,15 @@ type ListCheckInsParamsSort string
 // ListCheckInsParamsOrder defines parameters for ListCheckIns.
 type ListCheckInsParamsOrder string
 
+// GetCheckInTimeseriesParams defines parameters for GetCheckInTimeseries.
+type GetCheckInTimeseriesParams struct {
+	// Interval Size of the buckets
+	Interval *GetCheckInTimeseriesParamsInterval `form:"interval,omitempty" json:"interval,omitempty"`
+}
+
+// GetCheckInTimeseriesParamsInterval defines parameters for GetCheckInTimeseries.
+type GetCheckInTimeseriesParamsInterval string
+
 // ListParticipantsParams defines parameters for ListParticipants.
 type ListParticipantsParams struct {
 	// Page Page number (min 1)
@@ -1059,11 +2633,29 @@ type ListParticipantsParams struct {
 
 	// Status Filter by participant status
 	Status *ParticipantStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Group Filter by the group or table participants are seated at (exact match)
+	Group *string `form:"group,omitempty" json:"group,omitempty"`
+
+	// IncludeDeleted List deleted participants along with live ones, marked by `deleted_at`. Admin only.
+	IncludeDeleted *bool `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
 }
 
 // ListParticipantsParamsOrder defines parameters for ListParticipants.
 type ListParticipantsParamsOrder string
 
+// ListParticipantChangesParams defines parameters for ListParticipantChanges.
+type ListParticipantChangesParams struct {
+	// Since Return changes after this time (ISO 8601)
+	Since time.Time `form:"since" json:"since"`
+}
+
+// AutocompleteParticipantsParams defines parameters for AutocompleteParticipants.
+type AutocompleteParticipantsParams struct {
+	// Q Name prefix to match
+	Q string `form:"q" json:"q"`
+}
+
 // ExportParticipantsCSVParams defines parameters for ExportParticipantsCSV.
 type ExportParticipantsCSVParams struct {
 	// StatusFormat Format for the status column. "english" outputs English strings (default); "japanese" outputs ○/△/× symbols.
@@ -1073,9 +2665,31 @@ type ExportParticipantsCSVParams struct {
 // ExportParticipantsCSVParamsStatusFormat defines parameters for ExportParticipantsCSV.
 type ExportParticipantsCSVParamsStatusFormat string
 
+// DownloadParticipantQRCodesParams defines parameters for DownloadParticipantQRCodes.
+type DownloadParticipantQRCodesParams struct {
+	// Format QR code format of the entries
+	Format *DownloadParticipantQRCodesParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// DownloadParticipantQRCodesParamsFormat defines parameters for DownloadParticipantQRCodes.
+type DownloadParticipantQRCodesParamsFormat string
+
+// PrintParticipantBadgesParams defines parameters for PrintParticipantBadges.
+type PrintParticipantBadgesParams struct {
+	// PerPage Badges per page, in two columns (one column for a single badge per page)
+	PerPage *int `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Status Only print participants with this status, e.g. confirmed
+	Status *ParticipantStatus `form:"status,omitempty" json:"status,omitempty"`
+}
+
 // ImportParticipantsCSVMultipartBody defines parameters for ImportParticipantsCSV.
 type ImportParticipantsCSVMultipartBody struct {
-	// File CSV file (max 10MB). Required columns: name, email
+	// ColumnMapping JSON object mapping header names of the file to participant columns, matched
+	// case-insensitively; takes precedence over header_mapping.
+	ColumnMapping *string `json:"column_mapping,omitempty"`
+
+	// File CSV file (max 10MB by default). Required columns: name, email
 	File openapi_types.File `json:"file"`
 }
 
@@ -1083,7 +2697,28 @@ type ImportParticipantsCSVMultipartBody struct {
 type ImportParticipantsCSVParams struct {
 	// SkipDuplicates When true, rows with duplicate email are skipped instead of treated as errors
 	SkipDuplicates *bool `form:"skip_duplicates,omitempty" json:"skip_duplicates,omitempty"`
-}
+
+	// HeaderMapping How header names are matched to participant columns. "strict" requires the exact column names (default); "aliases" also accepts common aliases, case-insensitively.
+	HeaderMapping *ImportParticipantsCSVParamsHeaderMapping `form:"header_mapping,omitempty" json:"header_mapping,omitempty"`
+
+	// Atomic When true, all rows are imported or none are; the first invalid row fails the import with 422
+	Atomic *bool `form:"atomic,omitempty" json:"atomic,omitempty"`
+}
+
+// ImportParticipantsCSVParamsHeaderMapping defines parameters for ImportParticipantsCSV.
+type ImportParticipantsCSVParamsHeaderMapping string
+
+// LookupParticipantsParams defines parameters for LookupParticipants.
+type LookupParticipantsParams struct {
+	// Email Exact email address to look up
+	Email string `form:"email" json:"email"`
+}
+
+// GetScanAnalyticsParams defines parameters for GetScanAnalytics.
+type GetScanAnalyticsParams struct {
+	// IntervalMinutes Size of the timeline buckets in minutes
+	IntervalMinutes *int `form:"interval_minutes,omitempty" json:"interval_minutes,omitempty"`
+}
 
 // DownloadParticipantQRCodeParams defines parameters for DownloadParticipantQRCode.
 type DownloadParticipantQRCodeParams struct {
@@ -1097,6 +2732,36 @@ type DownloadParticipantQRCodeParams struct {
 // DownloadParticipantQRCodeParamsFormat defines parameters for DownloadParticipantQRCode.
 type DownloadParticipantQRCodeParamsFormat string
 
+// GetPublicCheckInStatusParams defines parameters for GetPublicCheckInStatus.
+type GetPublicCheckInStatusParams struct {
+	// Token Signed QR token of the participant
+	Token string `form:"token" json:"token"`
+}
+
+// ListUsersParams defines parameters for ListUsers.
+type ListUsersParams struct {
+	// Page Page number (min 1)
+	Page *PageParam `form:"page,omitempty" json:"page,omitempty"`
+
+	// PerPage Items per page (min 1, max 100)
+	PerPage *PerPageParam `form:"per_page,omitempty" json:"per_page,omitempty"`
+
+	// Search Search by email or name (partial match, case-insensitive, minimum 3 characters)
+	Search *string `form:"search,omitempty" json:"search,omitempty"`
+
+	// Role Filter by role
+	Role *UserRole `form:"role,omitempty" json:"role,omitempty"`
+}
+
+// LoginTwoFactorJSONRequestBody defines body for LoginTwoFactor for application/json ContentType.
+type LoginTwoFactorJSONRequestBody = TwoFactorLoginRequest
+
+// VerifyTwoFactorJSONRequestBody defines body for VerifyTwoFactor for application/json ContentType.
+type VerifyTwoFactorJSONRequestBody = TwoFactorVerifyRequest
+
+// ForgotPasswordJSONRequestBody defines body for ForgotPassword for application/json ContentType.
+type ForgotPasswordJSONRequestBody = ForgotPasswordRequest
+
 // LoginUserJSONRequestBody defines body for LoginUser for application/json ContentType.
 type LoginUserJSONRequestBody = LoginRequest
 
@@ -1106,32 +2771,110 @@ type RefreshTokenJSONRequestBody = RefreshTokenRequest
 // RegisterUserJSONRequestBody defines body for RegisterUser for application/json ContentType.
 type RegisterUserJSONRequestBody = RegisterRequest
 
+// ResetPasswordJSONRequestBody defines body for ResetPassword for application/json ContentType.
+type ResetPasswordJSONRequestBody = ResetPasswordRequest
+
+// VerifyEmailJSONRequestBody defines body for VerifyEmail for application/json ContentType.
+type VerifyEmailJSONRequestBody = VerifyEmailRequest
+
 // PostEventsJSONRequestBody defines body for PostEvents for application/json ContentType.
 type PostEventsJSONRequestBody = CreateEventRequest
 
+// PostEventsStatsBatchJSONRequestBody defines body for PostEventsStatsBatch for application/json ContentType.
+type PostEventsStatsBatchJSONRequestBody = BatchEventStatsRequest
+
 // PutEventsIdJSONRequestBody defines body for PutEventsId for application/json ContentType.
 type PutEventsIdJSONRequestBody = UpdateEventRequest
 
 // CheckInParticipantJSONRequestBody defines body for CheckInParticipant for application/json ContentType.
 type CheckInParticipantJSONRequestBody = CheckInRequest
 
+// BulkCheckInJSONRequestBody defines body for BulkCheckIn for application/json ContentType.
+type BulkCheckInJSONRequestBody = BulkCheckInRequest
+
+// CheckInWalkInJSONRequestBody defines body for CheckInWalkIn for application/json ContentType.
+type CheckInWalkInJSONRequestBody = WalkInCheckInRequest
+
+// CheckOutParticipantJSONRequestBody defines body for CheckOutParticipant for application/json ContentType.
+type CheckOutParticipantJSONRequestBody = CheckOutRequest
+
+// PostEventsIdCloneJSONRequestBody defines body for PostEventsIdClone for application/json ContentType.
+type PostEventsIdCloneJSONRequestBody = CloneEventRequest
+
+// PutEventsIdParticipantFieldsJSONRequestBody defines body for PutEventsIdParticipantFields for application/json ContentType.
+type PutEventsIdParticipantFieldsJSONRequestBody = UpdateParticipantFieldsRequest
+
+// PutEventsIdWebhooksJSONRequestBody defines body for PutEventsIdWebhooks for application/json ContentType.
+type PutEventsIdWebhooksJSONRequestBody = SetEventWebhookRequest
+
 // CreateParticipantJSONRequestBody defines body for CreateParticipant for application/json ContentType.
 type CreateParticipantJSONRequestBody = CreateParticipantRequest
 
 // BulkCreateParticipantsJSONRequestBody defines body for BulkCreateParticipants for application/json ContentType.
 type BulkCreateParticipantsJSONRequestBody = BulkCreateParticipantsRequest
 
+// BulkUpdateParticipantStatusJSONRequestBody defines body for BulkUpdateParticipantStatus for application/json ContentType.
+type BulkUpdateParticipantStatusJSONRequestBody = BulkUpdateParticipantStatusRequest
+
+// AssignParticipantGroupsJSONRequestBody defines body for AssignParticipantGroups for application/json ContentType.
+type AssignParticipantGroupsJSONRequestBody = AssignParticipantGroupsRequest
+
 // ImportParticipantsCSVMultipartRequestBody defines body for ImportParticipantsCSV for multipart/form-data ContentType.
 type ImportParticipantsCSVMultipartRequestBody ImportParticipantsCSVMultipartBody
 
+// InviteParticipantsJSONRequestBody defines body for InviteParticipants for application/json ContentType.
+type InviteParticipantsJSONRequestBody = InviteParticipantsRequest
+
+// SendEventInvitesJSONRequestBody defines body for SendEventInvites for application/json ContentType.
+type SendEventInvitesJSONRequestBody = SendInvitesRequest
+
+// UpdateParticipantTagsJSONRequestBody defines body for UpdateParticipantTags for application/json ContentType.
+type UpdateParticipantTagsJSONRequestBody = UpdateParticipantTagsRequest
+
+// ValidateParticipantsJSONRequestBody defines body for ValidateParticipants for application/json ContentType.
+type ValidateParticipantsJSONRequestBody = ValidateParticipantsRequest
+
 // SendEventQRCodesJSONRequestBody defines body for SendEventQRCodes for application/json ContentType.
 type SendEventQRCodesJSONRequestBody = SendQRCodesRequest
 
+// AcceptInviteJSONRequestBody defines body for AcceptInvite for application/json ContentType.
+type AcceptInviteJSONRequestBody = AcceptInviteRequest
+
+// MergeParticipantsJSONRequestBody defines body for MergeParticipants for application/json ContentType.
+type MergeParticipantsJSONRequestBody = MergeParticipantsRequest
+
 // UpdateParticipantJSONRequestBody defines body for UpdateParticipant for application/json ContentType.
 type UpdateParticipantJSONRequestBody = UpdateParticipantRequest
 
+// AddParticipantGuestJSONRequestBody defines body for AddParticipantGuest for application/json ContentType.
+type AddParticipantGuestJSONRequestBody = AddGuestRequest
+
+// RecordParticipantPaymentJSONRequestBody defines body for RecordParticipantPayment for application/json ContentType.
+type RecordParticipantPaymentJSONRequestBody = RecordPaymentRequest
+
+// UpdateUserJSONRequestBody defines body for UpdateUser for application/json ContentType.
+type UpdateUserJSONRequestBody = UpdateUserRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Get effective configuration
+	// (GET /admin/config)
+	GetAdminConfig(c *gin.Context)
+	// List audit log entries
+	// (GET /audit-logs)
+	ListAuditLogs(c *gin.Context, params ListAuditLogsParams)
+	// Start two-factor enrollment
+	// (POST /auth/2fa/enroll)
+	EnrollTwoFactor(c *gin.Context)
+	// Complete a two-factor login
+	// (POST /auth/2fa/login)
+	LoginTwoFactor(c *gin.Context)
+	// Confirm two-factor enrollment
+	// (POST /auth/2fa/verify)
+	VerifyTwoFactor(c *gin.Context)
+	// Request a password reset
+	// (POST /auth/forgot-password)
+	ForgotPassword(c *gin.Context)
 	// Authenticate user
 	// (POST /auth/login)
 	LoginUser(c *gin.Context)
@@ -1144,12 +2887,33 @@ type ServerInterface interface {
 	// Register a new user
 	// (POST /auth/register)
 	RegisterUser(c *gin.Context)
+	// Resend verification email
+	// (POST /auth/resend-verification)
+	ResendVerification(c *gin.Context)
+	// Reset password
+	// (POST /auth/reset-password)
+	ResetPassword(c *gin.Context)
+	// Revoke all other sessions
+	// (DELETE /auth/sessions)
+	RevokeOtherSessions(c *gin.Context)
+	// List active sessions
+	// (GET /auth/sessions)
+	ListSessions(c *gin.Context)
+	// Revoke a session
+	// (DELETE /auth/sessions/{id})
+	RevokeSession(c *gin.Context, id SessionIDParam)
+	// Verify email address
+	// (POST /auth/verify-email)
+	VerifyEmail(c *gin.Context)
 	// List events
 	// (GET /events)
 	GetEvents(c *gin.Context, params GetEventsParams)
 	// Create event
 	// (POST /events)
 	PostEvents(c *gin.Context)
+	// Get statistics for multiple events
+	// (POST /events/stats/batch)
+	PostEventsStatsBatch(c *gin.Context)
 	// Delete event
 	// (DELETE /events/{id})
 	DeleteEventsId(c *gin.Context, id EventIDParam)
@@ -1162,33 +2926,129 @@ type ServerInterface interface {
 	// Check in a participant
 	// (POST /events/{id}/checkin)
 	CheckInParticipant(c *gin.Context, id EventIDParam)
+	// Get check-in progress
+	// (GET /events/{id}/checkin-progress)
+	GetCheckInProgress(c *gin.Context, id EventIDParam)
+	// Check in several participants
+	// (POST /events/{id}/checkin/bulk)
+	BulkCheckIn(c *gin.Context, id EventIDParam)
+	// Check in a walk-in participant
+	// (POST /events/{id}/checkin/walk-in)
+	CheckInWalkIn(c *gin.Context, id EventIDParam)
 	// List check-ins for an event
 	// (GET /events/{id}/checkins)
 	ListCheckIns(c *gin.Context, id EventIDParam, params ListCheckInsParams)
+	// Get check-ins by staff
+	// (GET /events/{id}/checkins/by-staff)
+	GetCheckInsByStaff(c *gin.Context, id EventIDParam)
+	// Stream check-ins in real time
+	// (GET /events/{id}/checkins/stream)
+	StreamCheckIns(c *gin.Context, id EventIDParam)
+	// Get check-ins over time
+	// (GET /events/{id}/checkins/timeseries)
+	GetCheckInTimeseries(c *gin.Context, id EventIDParam, params GetCheckInTimeseriesParams)
 	// Cancel a check-in
 	// (DELETE /events/{id}/checkins/{cid})
 	CancelCheckIn(c *gin.Context, id EventIDParam, cid openapi_types.UUID)
+	// Restore a cancelled check-in
+	// (POST /events/{id}/checkins/{cid}/restore)
+	RestoreCheckIn(c *gin.Context, id EventIDParam, cid openapi_types.UUID)
+	// Check out a participant
+	// (POST /events/{id}/checkout)
+	CheckOutParticipant(c *gin.Context, id EventIDParam)
+	// Clone event
+	// (POST /events/{id}/clone)
+	PostEventsIdClone(c *gin.Context, id EventIDParam)
+	// Delete event logo
+	// (DELETE /events/{id}/logo)
+	DeleteEventsIdLogo(c *gin.Context, id EventIDParam)
+	// Upload event logo
+	// (PUT /events/{id}/logo)
+	PutEventsIdLogo(c *gin.Context, id EventIDParam)
+	// List event occurrences
+	// (GET /events/{id}/occurrences)
+	GetEventsIdOccurrences(c *gin.Context, id EventIDParam)
+	// Cancel event series
+	// (POST /events/{id}/occurrences/cancel)
+	PostEventsIdOccurrencesCancel(c *gin.Context, id EventIDParam)
+	// Update participant fields
+	// (PUT /events/{id}/participant-fields)
+	PutEventsIdParticipantFields(c *gin.Context, id EventIDParam)
 	// List participants for an event
 	// (GET /events/{id}/participants)
 	ListParticipants(c *gin.Context, id EventIDParam, params ListParticipantsParams)
 	// Add a participant to an event
 	// (POST /events/{id}/participants)
 	CreateParticipant(c *gin.Context, id EventIDParam)
+	// Assign many participants to a group
+	// (POST /events/{id}/participants/assign-groups)
+	AssignParticipantGroups(c *gin.Context, id EventIDParam)
+	// Suggest participants by name prefix
+	// (GET /events/{id}/participants/autocomplete)
+	AutocompleteParticipants(c *gin.Context, id EventIDParam, params AutocompleteParticipantsParams)
+	// Print participant badges
+	// (GET /events/{id}/participants/badges)
+	PrintParticipantBadges(c *gin.Context, id EventIDParam, params PrintParticipantBadgesParams)
 	// Bulk import participants
 	// (POST /events/{id}/participants/bulk)
 	BulkCreateParticipants(c *gin.Context, id EventIDParam)
+	// Update the status of many participants
+	// (PATCH /events/{id}/participants/bulk-status)
+	BulkUpdateParticipantStatus(c *gin.Context, id EventIDParam)
+	// List participant changes since a point in time
+	// (GET /events/{id}/participants/changes)
+	ListParticipantChanges(c *gin.Context, id EventIDParam, params ListParticipantChangesParams)
 	// Export participants to CSV
 	// (GET /events/{id}/participants/export)
 	ExportParticipantsCSV(c *gin.Context, id EventIDParam, params ExportParticipantsCSVParams)
 	// Import participants from CSV
 	// (POST /events/{id}/participants/import)
 	ImportParticipantsCSV(c *gin.Context, id EventIDParam, params ImportParticipantsCSVParams)
+	// Invite participants
+	// (POST /events/{id}/participants/invite)
+	InviteParticipants(c *gin.Context, id EventIDParam)
+	// Download participant QR codes as a ZIP archive
+	// (GET /events/{id}/participants/qrcodes.zip)
+	DownloadParticipantQRCodes(c *gin.Context, id EventIDParam, params DownloadParticipantQRCodesParams)
+	// Email participants their QR codes
+	// (POST /events/{id}/participants/send-invites)
+	SendEventInvites(c *gin.Context, id EventIDParam)
+	// Get participant statistics
+	// (GET /events/{id}/participants/stats)
+	GetParticipantStats(c *gin.Context, id EventIDParam)
+	// Add or remove tags on many participants
+	// (PATCH /events/{id}/participants/tags)
+	UpdateParticipantTags(c *gin.Context, id EventIDParam)
+	// Validate participants
+	// (POST /events/{id}/participants/validate)
+	ValidateParticipants(c *gin.Context, id EventIDParam)
+	// Email a participant their QR code
+	// (POST /events/{id}/participants/{pid}/send-invite)
+	SendParticipantInvite(c *gin.Context, id EventIDParam, pid openapi_types.UUID)
+	// Get payment summary
+	// (GET /events/{id}/payments/summary)
+	GetPaymentSummary(c *gin.Context, id EventIDParam)
 	// Send QR codes to participants via email
 	// (POST /events/{id}/qrcodes/send)
 	SendEventQRCodes(c *gin.Context, id EventIDParam)
+	// Restore event
+	// (POST /events/{id}/restore)
+	PostEventsIdRestore(c *gin.Context, id EventIDParam)
+	// Get QR scan analytics
+	// (GET /events/{id}/scan-analytics)
+	GetScanAnalytics(c *gin.Context, id EventIDParam, params GetScanAnalyticsParams)
 	// Get event statistics
 	// (GET /events/{id}/stats)
 	GetEventsIdStats(c *gin.Context, id EventIDParam)
+	// Delete event webhook
+	// (DELETE /events/{id}/webhooks)
+	DeleteEventsIdWebhooks(c *gin.Context, id EventIDParam)
+	// Get event webhook
+	// (GET /events/{id}/webhooks)
+	GetEventsIdWebhooks(c *gin.Context, id EventIDParam)
+	// Set event webhook
+	// (PUT /events/{id}/webhooks)
+	PutEventsIdWebhooks(c *gin.Context, id EventIDParam)
 	// Basic health check
 	// (GET /health)
 	GetHealth(c *gin.Context)
@@ -1198,6 +3058,15 @@ type ServerInterface interface {
 	// Readiness probe
 	// (GET /health/ready)
 	GetHealthReady(c *gin.Context)
+	// Accept an invitation
+	// (POST /participants/accept-invite)
+	AcceptInvite(c *gin.Context)
+	// Look up participants by email
+	// (GET /participants/lookup)
+	LookupParticipants(c *gin.Context, params LookupParticipantsParams)
+	// Merge duplicate participants
+	// (POST /participants/merge)
+	MergeParticipants(c *gin.Context)
 	// Delete a participant
 	// (DELETE /participants/{id})
 	DeleteParticipant(c *gin.Context, id ParticipantIDParam)
@@ -1207,12 +3076,48 @@ type ServerInterface interface {
 	// Update participant information
 	// (PUT /participants/{id})
 	UpdateParticipant(c *gin.Context, id ParticipantIDParam)
+	// Get participant check-in history
+	// (GET /participants/{id}/checkin-history)
+	GetCheckInHistory(c *gin.Context, id ParticipantIDParam)
 	// Get participant check-in status
 	// (GET /participants/{id}/checkin-status)
 	GetCheckInStatus(c *gin.Context, id ParticipantIDParam)
+	// Preview participant QR code email
+	// (GET /participants/{id}/confirmation-preview)
+	PreviewParticipantConfirmationEmail(c *gin.Context, id ParticipantIDParam)
+	// Record participant consent
+	// (POST /participants/{id}/consent)
+	RecordParticipantConsent(c *gin.Context, id ParticipantIDParam)
+	// Add a guest to a participant
+	// (POST /participants/{id}/guests)
+	AddParticipantGuest(c *gin.Context, id ParticipantIDParam)
+	// Record a participant's payment
+	// (POST /participants/{id}/payment)
+	RecordParticipantPayment(c *gin.Context, id ParticipantIDParam)
+	// Refund a participant's payment
+	// (POST /participants/{id}/payment/refund)
+	RefundParticipantPayment(c *gin.Context, id ParticipantIDParam)
+	// Promote a waitlisted participant
+	// (POST /participants/{id}/promote)
+	PromoteParticipant(c *gin.Context, id ParticipantIDParam)
 	// Download participant QR code
 	// (GET /participants/{id}/qrcode)
 	DownloadParticipantQRCode(c *gin.Context, id ParticipantIDParam, params DownloadParticipantQRCodeParams)
+	// Restore a deleted participant
+	// (POST /participants/{id}/restore)
+	RestoreParticipant(c *gin.Context, id ParticipantIDParam)
+	// Get own check-in status
+	// (GET /public/checkin-status)
+	GetPublicCheckInStatus(c *gin.Context, params GetPublicCheckInStatusParams)
+	// List users
+	// (GET /users)
+	ListUsers(c *gin.Context, params ListUsersParams)
+	// Get user
+	// (GET /users/{id})
+	GetUser(c *gin.Context, id UserIDParam)
+	// Update user role or status
+	// (PATCH /users/{id})
+	UpdateUser(c *gin.Context, id UserIDParam)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -1224,6 +3129,130 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
+// GetAdminConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminConfig(c *gin.Context) {
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminConfig(c)
+}
+
+// ListAuditLogs operation middleware
+func (siw *ServerInterfaceWrapper) ListAuditLogs(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAuditLogsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", c.Request.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "resource_type" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "resource_type", c.Request.URL.Query(), &params.ResourceType, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource_type: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "resource_id" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "resource_id", c.Request.URL.Query(), &params.ResourceId, runtime.BindQueryParameterOptions{Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter resource_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListAuditLogs(c, params)
+}
+
+// EnrollTwoFactor operation middleware
+func (siw *ServerInterfaceWrapper) EnrollTwoFactor(c *gin.Context) {
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.EnrollTwoFactor(c)
+}
+
+// LoginTwoFactor operation middleware
+func (siw *ServerInterfaceWrapper) LoginTwoFactor(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.LoginTwoFactor(c)
+}
+
+// VerifyTwoFactor operation middleware
+func (siw *ServerInterfaceWrapper) VerifyTwoFactor(c *gin.Context) {
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.VerifyTwoFactor(c)
+}
+
+// ForgotPassword operation middleware
+func (siw *ServerInterfaceWrapper) ForgotPassword(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ForgotPassword(c)
+}
+
 // LoginUser operation middleware
 func (siw *ServerInterfaceWrapper) LoginUser(c *gin.Context) {
 
@@ -1278,6 +3307,104 @@ func (siw *ServerInterfaceWrapper) RegisterUser(c *gin.Context) {
 	siw.Handler.RegisterUser(c)
 }
 
+// ResendVerification operation middleware
+func (siw *ServerInterfaceWrapper) ResendVerification(c *gin.Context) {
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ResendVerification(c)
+}
+
+// ResetPassword operation middleware
+func (siw *ServerInterfaceWrapper) ResetPassword(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ResetPassword(c)
+}
+
+// RevokeOtherSessions operation middleware
+func (siw *ServerInterfaceWrapper) RevokeOtherSessions(c *gin.Context) {
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevokeOtherSessions(c)
+}
+
+// ListSessions operation middleware
+func (siw *ServerInterfaceWrapper) ListSessions(c *gin.Context) {
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListSessions(c)
+}
+
+// RevokeSession operation middleware
+func (siw *ServerInterfaceWrapper) RevokeSession(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id SessionIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RevokeSession(c, id)
+}
+
+// VerifyEmail operation middleware
+func (siw *ServerInterfaceWrapper) VerifyEmail(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.VerifyEmail(c)
+}
+
 // GetEvents operation middleware
 func (siw *ServerInterfaceWrapper) GetEvents(c *gin.Context) {
 
@@ -1321,6 +3448,14 @@ func (siw *ServerInterfaceWrapper) GetEvents(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	// ------------- Optional query parameter "name" -------------
 
 	err = runtime.BindQueryParameterWithOptions("form", true, false, "name", c.Request.URL.Query(), &params.Name, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
@@ -1337,6 +3472,30 @@ func (siw *ServerInterfaceWrapper) GetEvents(c *gin.Context) {
 		return
 	}
 
+	// ------------- Optional query parameter "start_from" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "start_from", c.Request.URL.Query(), &params.StartFrom, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start_from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "start_to" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "start_to", c.Request.URL.Query(), &params.StartTo, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter start_to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_deleted", c.Request.URL.Query(), &params.IncludeDeleted, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_deleted: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1362,6 +3521,21 @@ func (siw *ServerInterfaceWrapper) PostEvents(c *gin.Context) {
 	siw.Handler.PostEvents(c)
 }
 
+// PostEventsStatsBatch operation middleware
+func (siw *ServerInterfaceWrapper) PostEventsStatsBatch(c *gin.Context) {
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostEventsStatsBatch(c)
+}
+
 // DeleteEventsId operation middleware
 func (siw *ServerInterfaceWrapper) DeleteEventsId(c *gin.Context) {
 
@@ -1470,8 +3644,8 @@ func (siw *ServerInterfaceWrapper) CheckInParticipant(c *gin.Context) {
 	siw.Handler.CheckInParticipant(c, id)
 }
 
-// ListCheckIns operation middleware
-func (siw *ServerInterfaceWrapper) ListCheckIns(c *gin.Context) {
+// GetCheckInProgress operation middleware
+func (siw *ServerInterfaceWrapper) GetCheckInProgress(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -1487,7 +3661,88 @@ func (siw *ServerInterfaceWrapper) ListCheckIns(c *gin.Context) {
 
 	c.Set(string(BearerAuthScopes), []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCheckInProgress(c, id)
+}
+
+// BulkCheckIn operation middleware
+func (siw *ServerInterfaceWrapper) BulkCheckIn(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BulkCheckIn(c, id)
+}
+
+// CheckInWalkIn operation middleware
+func (siw *ServerInterfaceWrapper) CheckInWalkIn(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CheckInWalkIn(c, id)
+}
+
+// ListCheckIns operation middleware
+func (siw *ServerInterfaceWrapper) ListCheckIns(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
 	var params ListCheckInsParams
 
 	// ------------- Optional query parameter "page" -------------
@@ -1532,6 +3787,98 @@ func (siw *ServerInterfaceWrapper) ListCheckIns(c *gin.Context) {
 	siw.Handler.ListCheckIns(c, id, params)
 }
 
+// GetCheckInsByStaff operation middleware
+func (siw *ServerInterfaceWrapper) GetCheckInsByStaff(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCheckInsByStaff(c, id)
+}
+
+// StreamCheckIns operation middleware
+func (siw *ServerInterfaceWrapper) StreamCheckIns(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.StreamCheckIns(c, id)
+}
+
+// GetCheckInTimeseries operation middleware
+func (siw *ServerInterfaceWrapper) GetCheckInTimeseries(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCheckInTimeseriesParams
+
+	// ------------- Optional query parameter "interval" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "interval", c.Request.URL.Query(), &params.Interval, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter interval: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetCheckInTimeseries(c, id, params)
+}
+
 // CancelCheckIn operation middleware
 func (siw *ServerInterfaceWrapper) CancelCheckIn(c *gin.Context) {
 
@@ -1539,7 +3886,1291 @@ func (siw *ServerInterfaceWrapper) CancelCheckIn(c *gin.Context) {
 	_ = err
 
 	// ------------- Path parameter "id" -------------
-	var id EventIDParam
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "cid" -------------
+	var cid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cid", c.Param("cid"), &cid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CancelCheckIn(c, id, cid)
+}
+
+// RestoreCheckIn operation middleware
+func (siw *ServerInterfaceWrapper) RestoreCheckIn(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "cid" -------------
+	var cid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "cid", c.Param("cid"), &cid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RestoreCheckIn(c, id, cid)
+}
+
+// CheckOutParticipant operation middleware
+func (siw *ServerInterfaceWrapper) CheckOutParticipant(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CheckOutParticipant(c, id)
+}
+
+// PostEventsIdClone operation middleware
+func (siw *ServerInterfaceWrapper) PostEventsIdClone(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostEventsIdClone(c, id)
+}
+
+// DeleteEventsIdLogo operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEventsIdLogo(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteEventsIdLogo(c, id)
+}
+
+// PutEventsIdLogo operation middleware
+func (siw *ServerInterfaceWrapper) PutEventsIdLogo(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutEventsIdLogo(c, id)
+}
+
+// GetEventsIdOccurrences operation middleware
+func (siw *ServerInterfaceWrapper) GetEventsIdOccurrences(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEventsIdOccurrences(c, id)
+}
+
+// PostEventsIdOccurrencesCancel operation middleware
+func (siw *ServerInterfaceWrapper) PostEventsIdOccurrencesCancel(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostEventsIdOccurrencesCancel(c, id)
+}
+
+// PutEventsIdParticipantFields operation middleware
+func (siw *ServerInterfaceWrapper) PutEventsIdParticipantFields(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutEventsIdParticipantFields(c, id)
+}
+
+// ListParticipants operation middleware
+func (siw *ServerInterfaceWrapper) ListParticipants(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListParticipantsParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", c.Request.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "sort", c.Request.URL.Query(), &params.Sort, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "order", c.Request.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "search", c.Request.URL.Query(), &params.Search, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", c.Request.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "group" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "group", c.Request.URL.Query(), &params.Group, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter group: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "include_deleted", c.Request.URL.Query(), &params.IncludeDeleted, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter include_deleted: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListParticipants(c, id, params)
+}
+
+// CreateParticipant operation middleware
+func (siw *ServerInterfaceWrapper) CreateParticipant(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateParticipant(c, id)
+}
+
+// AssignParticipantGroups operation middleware
+func (siw *ServerInterfaceWrapper) AssignParticipantGroups(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AssignParticipantGroups(c, id)
+}
+
+// AutocompleteParticipants operation middleware
+func (siw *ServerInterfaceWrapper) AutocompleteParticipants(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AutocompleteParticipantsParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := c.Query("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument q is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "q", c.Request.URL.Query(), &params.Q, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AutocompleteParticipants(c, id, params)
+}
+
+// PrintParticipantBadges operation middleware
+func (siw *ServerInterfaceWrapper) PrintParticipantBadges(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PrintParticipantBadgesParams
+
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", c.Request.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PrintParticipantBadges(c, id, params)
+}
+
+// BulkCreateParticipants operation middleware
+func (siw *ServerInterfaceWrapper) BulkCreateParticipants(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BulkCreateParticipants(c, id)
+}
+
+// BulkUpdateParticipantStatus operation middleware
+func (siw *ServerInterfaceWrapper) BulkUpdateParticipantStatus(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.BulkUpdateParticipantStatus(c, id)
+}
+
+// ListParticipantChanges operation middleware
+func (siw *ServerInterfaceWrapper) ListParticipantChanges(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListParticipantChangesParams
+
+	// ------------- Required query parameter "since" -------------
+
+	if paramValue := c.Query("since"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument since is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "since", c.Request.URL.Query(), &params.Since, runtime.BindQueryParameterOptions{Type: "string", Format: "date-time"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter since: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ListParticipantChanges(c, id, params)
+}
+
+// ExportParticipantsCSV operation middleware
+func (siw *ServerInterfaceWrapper) ExportParticipantsCSV(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportParticipantsCSVParams
+
+	// ------------- Optional query parameter "status_format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "status_format", c.Request.URL.Query(), &params.StatusFormat, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status_format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ExportParticipantsCSV(c, id, params)
+}
+
+// ImportParticipantsCSV operation middleware
+func (siw *ServerInterfaceWrapper) ImportParticipantsCSV(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ImportParticipantsCSVParams
+
+	// ------------- Optional query parameter "skip_duplicates" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "skip_duplicates", c.Request.URL.Query(), &params.SkipDuplicates, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter skip_duplicates: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "header_mapping" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "header_mapping", c.Request.URL.Query(), &params.HeaderMapping, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter header_mapping: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "atomic" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "atomic", c.Request.URL.Query(), &params.Atomic, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter atomic: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ImportParticipantsCSV(c, id, params)
+}
+
+// InviteParticipants operation middleware
+func (siw *ServerInterfaceWrapper) InviteParticipants(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.InviteParticipants(c, id)
+}
+
+// DownloadParticipantQRCodes operation middleware
+func (siw *ServerInterfaceWrapper) DownloadParticipantQRCodes(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadParticipantQRCodesParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", c.Request.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DownloadParticipantQRCodes(c, id, params)
+}
+
+// SendEventInvites operation middleware
+func (siw *ServerInterfaceWrapper) SendEventInvites(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SendEventInvites(c, id)
+}
+
+// GetParticipantStats operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantStats(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetParticipantStats(c, id)
+}
+
+// UpdateParticipantTags operation middleware
+func (siw *ServerInterfaceWrapper) UpdateParticipantTags(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateParticipantTags(c, id)
+}
+
+// ValidateParticipants operation middleware
+func (siw *ServerInterfaceWrapper) ValidateParticipants(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ValidateParticipants(c, id)
+}
+
+// SendParticipantInvite operation middleware
+func (siw *ServerInterfaceWrapper) SendParticipantInvite(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "pid" -------------
+	var pid openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "pid", c.Param("pid"), &pid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pid: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SendParticipantInvite(c, id, pid)
+}
+
+// GetPaymentSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetPaymentSummary(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPaymentSummary(c, id)
+}
+
+// SendEventQRCodes operation middleware
+func (siw *ServerInterfaceWrapper) SendEventQRCodes(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SendEventQRCodes(c, id)
+}
+
+// PostEventsIdRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostEventsIdRestore(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostEventsIdRestore(c, id)
+}
+
+// GetScanAnalytics operation middleware
+func (siw *ServerInterfaceWrapper) GetScanAnalytics(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetScanAnalyticsParams
+
+	// ------------- Optional query parameter "interval_minutes" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "interval_minutes", c.Request.URL.Query(), &params.IntervalMinutes, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter interval_minutes: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetScanAnalytics(c, id, params)
+}
+
+// GetEventsIdStats operation middleware
+func (siw *ServerInterfaceWrapper) GetEventsIdStats(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEventsIdStats(c, id)
+}
+
+// DeleteEventsIdWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) DeleteEventsIdWebhooks(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteEventsIdWebhooks(c, id)
+}
+
+// GetEventsIdWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) GetEventsIdWebhooks(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEventsIdWebhooks(c, id)
+}
+
+// PutEventsIdWebhooks operation middleware
+func (siw *ServerInterfaceWrapper) PutEventsIdWebhooks(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id EventIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutEventsIdWebhooks(c, id)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetHealth(c)
+}
+
+// GetHealthLive operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthLive(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetHealthLive(c)
+}
+
+// GetHealthReady operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthReady(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetHealthReady(c)
+}
+
+// AcceptInvite operation middleware
+func (siw *ServerInterfaceWrapper) AcceptInvite(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AcceptInvite(c)
+}
+
+// LookupParticipants operation middleware
+func (siw *ServerInterfaceWrapper) LookupParticipants(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params LookupParticipantsParams
+
+	// ------------- Required query parameter "email" -------------
+
+	if paramValue := c.Query("email"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument email is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "email", c.Request.URL.Query(), &params.Email, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter email: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.LookupParticipants(c, params)
+}
+
+// MergeParticipants operation middleware
+func (siw *ServerInterfaceWrapper) MergeParticipants(c *gin.Context) {
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.MergeParticipants(c)
+}
+
+// DeleteParticipant operation middleware
+func (siw *ServerInterfaceWrapper) DeleteParticipant(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id ParticipantIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteParticipant(c, id)
+}
+
+// GetParticipant operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipant(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id ParticipantIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(string(BearerAuthScopes), []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetParticipant(c, id)
+}
+
+// UpdateParticipant operation middleware
+func (siw *ServerInterfaceWrapper) UpdateParticipant(c *gin.Context) {
+
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id ParticipantIDParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
@@ -1547,15 +5178,6 @@ func (siw *ServerInterfaceWrapper) CancelCheckIn(c *gin.Context) {
 		return
 	}
 
-	// ------------- Path parameter "cid" -------------
-	var cid openapi_types.UUID
-
-	err = runtime.BindStyledParameterWithOptions("simple", "cid", c.Param("cid"), &cid, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cid: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	c.Set(string(BearerAuthScopes), []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -1565,17 +5187,17 @@ func (siw *ServerInterfaceWrapper) CancelCheckIn(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CancelCheckIn(c, id, cid)
+	siw.Handler.UpdateParticipant(c, id)
 }
 
-// ListParticipants operation middleware
-func (siw *ServerInterfaceWrapper) ListParticipants(c *gin.Context) {
+// GetCheckInHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetCheckInHistory(c *gin.Context) {
 
 	var err error
 	_ = err
 
 	// ------------- Path parameter "id" -------------
-	var id EventIDParam
+	var id ParticipantIDParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
@@ -1585,56 +5207,32 @@ func (siw *ServerInterfaceWrapper) ListParticipants(c *gin.Context) {
 
 	c.Set(string(BearerAuthScopes), []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListParticipantsParams
-
-	// ------------- Optional query parameter "page" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", c.Request.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "per_page" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
-		return
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
 	}
 
-	// ------------- Optional query parameter "sort" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "sort", c.Request.URL.Query(), &params.Sort, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
-		return
-	}
+	siw.Handler.GetCheckInHistory(c, id)
+}
 
-	// ------------- Optional query parameter "order" -------------
+// GetCheckInStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetCheckInStatus(c *gin.Context) {
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "order", c.Request.URL.Query(), &params.Order, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
-		return
-	}
+	var err error
+	_ = err
 
-	// ------------- Optional query parameter "search" -------------
+	// ------------- Path parameter "id" -------------
+	var id ParticipantIDParam
 
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "search", c.Request.URL.Query(), &params.Search, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "status" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "status", c.Request.URL.Query(), &params.Status, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
-		return
-	}
+	c.Set(string(BearerAuthScopes), []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1643,17 +5241,17 @@ func (siw *ServerInterfaceWrapper) ListParticipants(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListParticipants(c, id, params)
+	siw.Handler.GetCheckInStatus(c, id)
 }
 
-// CreateParticipant operation middleware
-func (siw *ServerInterfaceWrapper) CreateParticipant(c *gin.Context) {
+// PreviewParticipantConfirmationEmail operation middleware
+func (siw *ServerInterfaceWrapper) PreviewParticipantConfirmationEmail(c *gin.Context) {
 
 	var err error
 	_ = err
 
 	// ------------- Path parameter "id" -------------
-	var id EventIDParam
+	var id ParticipantIDParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
@@ -1670,17 +5268,17 @@ func (siw *ServerInterfaceWrapper) CreateParticipant(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.CreateParticipant(c, id)
+	siw.Handler.PreviewParticipantConfirmationEmail(c, id)
 }
 
-// BulkCreateParticipants operation middleware
-func (siw *ServerInterfaceWrapper) BulkCreateParticipants(c *gin.Context) {
+// RecordParticipantConsent operation middleware
+func (siw *ServerInterfaceWrapper) RecordParticipantConsent(c *gin.Context) {
 
 	var err error
 	_ = err
 
 	// ------------- Path parameter "id" -------------
-	var id EventIDParam
+	var id ParticipantIDParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
@@ -1697,17 +5295,17 @@ func (siw *ServerInterfaceWrapper) BulkCreateParticipants(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.BulkCreateParticipants(c, id)
+	siw.Handler.RecordParticipantConsent(c, id)
 }
 
-// ExportParticipantsCSV operation middleware
-func (siw *ServerInterfaceWrapper) ExportParticipantsCSV(c *gin.Context) {
+// AddParticipantGuest operation middleware
+func (siw *ServerInterfaceWrapper) AddParticipantGuest(c *gin.Context) {
 
 	var err error
 	_ = err
 
 	// ------------- Path parameter "id" -------------
-	var id EventIDParam
+	var id ParticipantIDParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
@@ -1717,17 +5315,6 @@ func (siw *ServerInterfaceWrapper) ExportParticipantsCSV(c *gin.Context) {
 
 	c.Set(string(BearerAuthScopes), []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ExportParticipantsCSVParams
-
-	// ------------- Optional query parameter "status_format" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "status_format", c.Request.URL.Query(), &params.StatusFormat, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status_format: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1735,17 +5322,17 @@ func (siw *ServerInterfaceWrapper) ExportParticipantsCSV(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ExportParticipantsCSV(c, id, params)
+	siw.Handler.AddParticipantGuest(c, id)
 }
 
-// ImportParticipantsCSV operation middleware
-func (siw *ServerInterfaceWrapper) ImportParticipantsCSV(c *gin.Context) {
+// RecordParticipantPayment operation middleware
+func (siw *ServerInterfaceWrapper) RecordParticipantPayment(c *gin.Context) {
 
 	var err error
 	_ = err
 
 	// ------------- Path parameter "id" -------------
-	var id EventIDParam
+	var id ParticipantIDParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
@@ -1755,17 +5342,6 @@ func (siw *ServerInterfaceWrapper) ImportParticipantsCSV(c *gin.Context) {
 
 	c.Set(string(BearerAuthScopes), []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ImportParticipantsCSVParams
-
-	// ------------- Optional query parameter "skip_duplicates" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "skip_duplicates", c.Request.URL.Query(), &params.SkipDuplicates, runtime.BindQueryParameterOptions{Type: "boolean", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter skip_duplicates: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1773,17 +5349,17 @@ func (siw *ServerInterfaceWrapper) ImportParticipantsCSV(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ImportParticipantsCSV(c, id, params)
+	siw.Handler.RecordParticipantPayment(c, id)
 }
 
-// SendEventQRCodes operation middleware
-func (siw *ServerInterfaceWrapper) SendEventQRCodes(c *gin.Context) {
+// RefundParticipantPayment operation middleware
+func (siw *ServerInterfaceWrapper) RefundParticipantPayment(c *gin.Context) {
 
 	var err error
 	_ = err
 
 	// ------------- Path parameter "id" -------------
-	var id EventIDParam
+	var id ParticipantIDParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
@@ -1800,17 +5376,17 @@ func (siw *ServerInterfaceWrapper) SendEventQRCodes(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SendEventQRCodes(c, id)
+	siw.Handler.RefundParticipantPayment(c, id)
 }
 
-// GetEventsIdStats operation middleware
-func (siw *ServerInterfaceWrapper) GetEventsIdStats(c *gin.Context) {
+// PromoteParticipant operation middleware
+func (siw *ServerInterfaceWrapper) PromoteParticipant(c *gin.Context) {
 
 	var err error
 	_ = err
 
 	// ------------- Path parameter "id" -------------
-	var id EventIDParam
+	var id ParticipantIDParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
@@ -1827,37 +5403,44 @@ func (siw *ServerInterfaceWrapper) GetEventsIdStats(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetEventsIdStats(c, id)
+	siw.Handler.PromoteParticipant(c, id)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
+// DownloadParticipantQRCode operation middleware
+func (siw *ServerInterfaceWrapper) DownloadParticipantQRCode(c *gin.Context) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	var err error
+	_ = err
+
+	// ------------- Path parameter "id" -------------
+	var id ParticipantIDParam
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.GetHealth(c)
-}
+	c.Set(string(BearerAuthScopes), []string{})
 
-// GetHealthLive operation middleware
-func (siw *ServerInterfaceWrapper) GetHealthLive(c *gin.Context) {
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DownloadParticipantQRCodeParams
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", c.Request.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.GetHealthLive(c)
-}
+	// ------------- Optional query parameter "size" -------------
 
-// GetHealthReady operation middleware
-func (siw *ServerInterfaceWrapper) GetHealthReady(c *gin.Context) {
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "size", c.Request.URL.Query(), &params.Size, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1866,11 +5449,11 @@ func (siw *ServerInterfaceWrapper) GetHealthReady(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetHealthReady(c)
+	siw.Handler.DownloadParticipantQRCode(c, id, params)
 }
 
-// DeleteParticipant operation middleware
-func (siw *ServerInterfaceWrapper) DeleteParticipant(c *gin.Context) {
+// RestoreParticipant operation middleware
+func (siw *ServerInterfaceWrapper) RestoreParticipant(c *gin.Context) {
 
 	var err error
 	_ = err
@@ -1893,25 +5476,32 @@ func (siw *ServerInterfaceWrapper) DeleteParticipant(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DeleteParticipant(c, id)
+	siw.Handler.RestoreParticipant(c, id)
 }
 
-// GetParticipant operation middleware
-func (siw *ServerInterfaceWrapper) GetParticipant(c *gin.Context) {
+// GetPublicCheckInStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetPublicCheckInStatus(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "id" -------------
-	var id ParticipantIDParam
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPublicCheckInStatusParams
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+	// ------------- Required query parameter "token" -------------
+
+	if paramValue := c.Query("token"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument token is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(string(BearerAuthScopes), []string{})
+	err = runtime.BindQueryParameterWithOptions("form", true, true, "token", c.Request.URL.Query(), &params.Token, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter token: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1920,25 +5510,51 @@ func (siw *ServerInterfaceWrapper) GetParticipant(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetParticipant(c, id)
+	siw.Handler.GetPublicCheckInStatus(c, params)
 }
 
-// UpdateParticipant operation middleware
-func (siw *ServerInterfaceWrapper) UpdateParticipant(c *gin.Context) {
+// ListUsers operation middleware
+func (siw *ServerInterfaceWrapper) ListUsers(c *gin.Context) {
 
 	var err error
 	_ = err
 
-	// ------------- Path parameter "id" -------------
-	var id ParticipantIDParam
+	c.Set(string(BearerAuthScopes), []string{})
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListUsersParams
+
+	// ------------- Optional query parameter "page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "page", c.Request.URL.Query(), &params.Page, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter id: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter page: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	c.Set(string(BearerAuthScopes), []string{})
+	// ------------- Optional query parameter "per_page" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "per_page", c.Request.URL.Query(), &params.PerPage, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter per_page: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "search" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "search", c.Request.URL.Query(), &params.Search, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter search: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "role" -------------
+
+	err = runtime.BindQueryParameterWithOptions("form", true, false, "role", c.Request.URL.Query(), &params.Role, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter role: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1947,17 +5563,17 @@ func (siw *ServerInterfaceWrapper) UpdateParticipant(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateParticipant(c, id)
+	siw.Handler.ListUsers(c, params)
 }
 
-// GetCheckInStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetCheckInStatus(c *gin.Context) {
+// GetUser operation middleware
+func (siw *ServerInterfaceWrapper) GetUser(c *gin.Context) {
 
 	var err error
 	_ = err
 
 	// ------------- Path parameter "id" -------------
-	var id ParticipantIDParam
+	var id UserIDParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
@@ -1974,17 +5590,17 @@ func (siw *ServerInterfaceWrapper) GetCheckInStatus(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCheckInStatus(c, id)
+	siw.Handler.GetUser(c, id)
 }
 
-// DownloadParticipantQRCode operation middleware
-func (siw *ServerInterfaceWrapper) DownloadParticipantQRCode(c *gin.Context) {
+// UpdateUser operation middleware
+func (siw *ServerInterfaceWrapper) UpdateUser(c *gin.Context) {
 
 	var err error
 	_ = err
 
 	// ------------- Path parameter "id" -------------
-	var id ParticipantIDParam
+	var id UserIDParam
 
 	err = runtime.BindStyledParameterWithOptions("simple", "id", c.Param("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true, Type: "string", Format: "uuid"})
 	if err != nil {
@@ -1994,25 +5610,6 @@ func (siw *ServerInterfaceWrapper) DownloadParticipantQRCode(c *gin.Context) {
 
 	c.Set(string(BearerAuthScopes), []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DownloadParticipantQRCodeParams
-
-	// ------------- Optional query parameter "format" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "format", c.Request.URL.Query(), &params.Format, runtime.BindQueryParameterOptions{Type: "string", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
-		return
-	}
-
-	// ------------- Optional query parameter "size" -------------
-
-	err = runtime.BindQueryParameterWithOptions("form", true, false, "size", c.Request.URL.Query(), &params.Size, runtime.BindQueryParameterOptions{Type: "integer", Format: ""})
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter size: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -2020,7 +5617,7 @@ func (siw *ServerInterfaceWrapper) DownloadParticipantQRCode(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DownloadParticipantQRCode(c, id, params)
+	siw.Handler.UpdateUser(c, id)
 }
 
 // GinServerOptions provides options for the Gin server.
@@ -2050,33 +5647,93 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/admin/config", wrapper.GetAdminConfig)
+	router.GET(options.BaseURL+"/audit-logs", wrapper.ListAuditLogs)
+	router.POST(options.BaseURL+"/auth/2fa/enroll", wrapper.EnrollTwoFactor)
+	router.POST(options.BaseURL+"/auth/2fa/login", wrapper.LoginTwoFactor)
+	router.POST(options.BaseURL+"/auth/2fa/verify", wrapper.VerifyTwoFactor)
+	router.POST(options.BaseURL+"/auth/forgot-password", wrapper.ForgotPassword)
 	router.POST(options.BaseURL+"/auth/login", wrapper.LoginUser)
 	router.POST(options.BaseURL+"/auth/logout", wrapper.LogoutUser)
 	router.POST(options.BaseURL+"/auth/refresh", wrapper.RefreshToken)
 	router.POST(options.BaseURL+"/auth/register", wrapper.RegisterUser)
+	router.POST(options.BaseURL+"/auth/resend-verification", wrapper.ResendVerification)
+	router.POST(options.BaseURL+"/auth/reset-password", wrapper.ResetPassword)
+	router.DELETE(options.BaseURL+"/auth/sessions", wrapper.RevokeOtherSessions)
+	router.GET(options.BaseURL+"/auth/sessions", wrapper.ListSessions)
+	router.DELETE(options.BaseURL+"/auth/sessions/:id", wrapper.RevokeSession)
+	router.POST(options.BaseURL+"/auth/verify-email", wrapper.VerifyEmail)
 	router.GET(options.BaseURL+"/events", wrapper.GetEvents)
 	router.POST(options.BaseURL+"/events", wrapper.PostEvents)
+	router.POST(options.BaseURL+"/events/stats/batch", wrapper.PostEventsStatsBatch)
 	router.DELETE(options.BaseURL+"/events/:id", wrapper.DeleteEventsId)
 	router.GET(options.BaseURL+"/events/:id", wrapper.GetEventsId)
 	router.PUT(options.BaseURL+"/events/:id", wrapper.PutEventsId)
 	router.POST(options.BaseURL+"/events/:id/checkin", wrapper.CheckInParticipant)
+	router.GET(options.BaseURL+"/events/:id/checkin-progress", wrapper.GetCheckInProgress)
+	router.POST(options.BaseURL+"/events/:id/checkin/bulk", wrapper.BulkCheckIn)
+	router.POST(options.BaseURL+"/events/:id/checkin/walk-in", wrapper.CheckInWalkIn)
 	router.GET(options.BaseURL+"/events/:id/checkins", wrapper.ListCheckIns)
+	router.GET(options.BaseURL+"/events/:id/checkins/by-staff", wrapper.GetCheckInsByStaff)
+	router.GET(options.BaseURL+"/events/:id/checkins/stream", wrapper.StreamCheckIns)
+	router.GET(options.BaseURL+"/events/:id/checkins/timeseries", wrapper.GetCheckInTimeseries)
 	router.DELETE(options.BaseURL+"/events/:id/checkins/:cid", wrapper.CancelCheckIn)
+	router.POST(options.BaseURL+"/events/:id/checkins/:cid/restore", wrapper.RestoreCheckIn)
+	router.POST(options.BaseURL+"/events/:id/checkout", wrapper.CheckOutParticipant)
+	router.POST(options.BaseURL+"/events/:id/clone", wrapper.PostEventsIdClone)
+	router.DELETE(options.BaseURL+"/events/:id/logo", wrapper.DeleteEventsIdLogo)
+	router.PUT(options.BaseURL+"/events/:id/logo", wrapper.PutEventsIdLogo)
+	router.GET(options.BaseURL+"/events/:id/occurrences", wrapper.GetEventsIdOccurrences)
+	router.POST(options.BaseURL+"/events/:id/occurrences/cancel", wrapper.PostEventsIdOccurrencesCancel)
+	router.PUT(options.BaseURL+"/events/:id/participant-fields", wrapper.PutEventsIdParticipantFields)
 	router.GET(options.BaseURL+"/events/:id/participants", wrapper.ListParticipants)
 	router.POST(options.BaseURL+"/events/:id/participants", wrapper.CreateParticipant)
+	router.POST(options.BaseURL+"/events/:id/participants/assign-groups", wrapper.AssignParticipantGroups)
+	router.GET(options.BaseURL+"/events/:id/participants/autocomplete", wrapper.AutocompleteParticipants)
+	router.GET(options.BaseURL+"/events/:id/participants/badges", wrapper.PrintParticipantBadges)
 	router.POST(options.BaseURL+"/events/:id/participants/bulk", wrapper.BulkCreateParticipants)
+	router.PATCH(options.BaseURL+"/events/:id/participants/bulk-status", wrapper.BulkUpdateParticipantStatus)
+	router.GET(options.BaseURL+"/events/:id/participants/changes", wrapper.ListParticipantChanges)
 	router.GET(options.BaseURL+"/events/:id/participants/export", wrapper.ExportParticipantsCSV)
 	router.POST(options.BaseURL+"/events/:id/participants/import", wrapper.ImportParticipantsCSV)
+	router.POST(options.BaseURL+"/events/:id/participants/invite", wrapper.InviteParticipants)
+	router.GET(options.BaseURL+"/events/:id/participants/qrcodes.zip", wrapper.DownloadParticipantQRCodes)
+	router.POST(options.BaseURL+"/events/:id/participants/send-invites", wrapper.SendEventInvites)
+	router.GET(options.BaseURL+"/events/:id/participants/stats", wrapper.GetParticipantStats)
+	router.PATCH(options.BaseURL+"/events/:id/participants/tags", wrapper.UpdateParticipantTags)
+	router.POST(options.BaseURL+"/events/:id/participants/validate", wrapper.ValidateParticipants)
+	router.POST(options.BaseURL+"/events/:id/participants/:pid/send-invite", wrapper.SendParticipantInvite)
+	router.GET(options.BaseURL+"/events/:id/payments/summary", wrapper.GetPaymentSummary)
 	router.POST(options.BaseURL+"/events/:id/qrcodes/send", wrapper.SendEventQRCodes)
+	router.POST(options.BaseURL+"/events/:id/restore", wrapper.PostEventsIdRestore)
+	router.GET(options.BaseURL+"/events/:id/scan-analytics", wrapper.GetScanAnalytics)
 	router.GET(options.BaseURL+"/events/:id/stats", wrapper.GetEventsIdStats)
+	router.DELETE(options.BaseURL+"/events/:id/webhooks", wrapper.DeleteEventsIdWebhooks)
+	router.GET(options.BaseURL+"/events/:id/webhooks", wrapper.GetEventsIdWebhooks)
+	router.PUT(options.BaseURL+"/events/:id/webhooks", wrapper.PutEventsIdWebhooks)
 	router.GET(options.BaseURL+"/health", wrapper.GetHealth)
 	router.GET(options.BaseURL+"/health/live", wrapper.GetHealthLive)
 	router.GET(options.BaseURL+"/health/ready", wrapper.GetHealthReady)
+	router.POST(options.BaseURL+"/participants/accept-invite", wrapper.AcceptInvite)
+	router.GET(options.BaseURL+"/participants/lookup", wrapper.LookupParticipants)
+	router.POST(options.BaseURL+"/participants/merge", wrapper.MergeParticipants)
 	router.DELETE(options.BaseURL+"/participants/:id", wrapper.DeleteParticipant)
 	router.GET(options.BaseURL+"/participants/:id", wrapper.GetParticipant)
 	router.PUT(options.BaseURL+"/participants/:id", wrapper.UpdateParticipant)
+	router.GET(options.BaseURL+"/participants/:id/checkin-history", wrapper.GetCheckInHistory)
 	router.GET(options.BaseURL+"/participants/:id/checkin-status", wrapper.GetCheckInStatus)
+	router.GET(options.BaseURL+"/participants/:id/confirmation-preview", wrapper.PreviewParticipantConfirmationEmail)
+	router.POST(options.BaseURL+"/participants/:id/consent", wrapper.RecordParticipantConsent)
+	router.POST(options.BaseURL+"/participants/:id/guests", wrapper.AddParticipantGuest)
+	router.POST(options.BaseURL+"/participants/:id/payment", wrapper.RecordParticipantPayment)
+	router.POST(options.BaseURL+"/participants/:id/payment/refund", wrapper.RefundParticipantPayment)
+	router.POST(options.BaseURL+"/participants/:id/promote", wrapper.PromoteParticipant)
 	router.GET(options.BaseURL+"/participants/:id/qrcode", wrapper.DownloadParticipantQRCode)
+	router.POST(options.BaseURL+"/participants/:id/restore", wrapper.RestoreParticipant)
+	router.GET(options.BaseURL+"/public/checkin-status", wrapper.GetPublicCheckInStatus)
+	router.GET(options.BaseURL+"/users", wrapper.ListUsers)
+	router.GET(options.BaseURL+"/users/:id", wrapper.GetUser)
+	router.PATCH(options.BaseURL+"/users/:id", wrapper.UpdateUser)
 }
 
 // Base64 encoded, compressed with deflate, json marshaled OpenAPI spec.
@@ -2084,188 +5741,650 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 // const string: with thousands of chunks the chained `+` fold is several
 // times slower for the Go compiler than parsing a slice literal.
 var swaggerSpec = []string{
-	"7L35cts22yh+Kxi9v5na/WRZcuzE8W/emVexnVatt9hyujmjQiQkISYBBgBtK51cwfn/fBdyLuHcyXcl",
-	"Z7CQBEhQiy07SZt/2lgksTx4NjzrX42AxgkliAje2PurkUAGYyQQU3/tT1Bw3SO9gzP5s/wlRDxgOBGY",
-	"ksaefr6BCUgJ/pAigENEBB5hxMDa5WXvYL3RbGD5YgLFpNFsEBijxl4Dh41mg6EPKWYobOwJlqJmgwcT",
-	"FEM5B7qDcRLJF3d322h3u93eQFsvhxvbnXB7A77oPN/Y3n7+fGdne7vdbrcbzcaIshiKxl4jTdXQYprI",
-	"r7lgmIwbnz41G4c3iIjabainj7WHnZ0V7eGUhYjV7OCCMgGofAGsQR4AyoB8IV/7hxSxabF49WbDXm+I",
-	"RjCN5Pzyu0Zz9viIhJiMs1n0X3IuRNK4sfdHA+ZDNN41LViYsat7O4NjVLM1+QiQNB7KuWNMQKduVwkc",
-	"I/+mOtYiOs1GjAmO5Uo7+VowEWiMmFkMEzjACZyBMtY7j4U4L16sCHHOJNrUwrcnUMxBghiQ8DMgboIY",
-	"3oFOu10La8QG9fDealsAl3/E8M5AvN2eC3+JbLPwfIRRFAK1EP/iOGWiBrsDhqBA4QDKFwpYOz+XIfhJ",
-	"nhdPKOFIccVXMDxHH1LEhfwroEQgov4JkyTCAZRr3XzP5YKt85RvhnLcV92Dwfnhm8vDi74iEgFx1Nhr",
-	"9CcIMD0sCGgqd0gFGCKQkhAxLigNQZgiICjA5AZGOAR8SgS8U0DgApJAjr4JE7x509lEN4qlNxtcQJHy",
-	"xt62hLzAQu33FQxBtod8wxMhEr63KUdooY8fGCatgMabCaPDCMV8cwjDDbPCxicbvP8fQ6PGXuNfm4Us",
-	"2dRP+eaZ/vpAbZNraLpnKteSbXwj3xsmSSpZDohhJFEc5S/JufcpGUU4uN8B7J+evD7q7TvQ74LEouhb",
-	"LCZATDAHKIY4ApgDGDEEwylgaIy5QAyFYESZeUnCetYxbHa2nm1aE7jn8rI4l3xfCx9KkH2xwhM5R5ym",
-	"LEAgGxyshamGLGrKH7lgEBMBbjCNFLTX5fSvKRviMETkXqfy+vT8Ve/g4PDEPpbfaApCqihhAm+QZFMx",
-	"5hxTIukABgHiXJ8BM2uedwwO5J8VkC8WvzDoR/knK4R9j/B0NMIBlipJsV0u95sgJklBbxgG6otPzUaP",
-	"CMQIjA4Zo+xesO+d9A/PT7pHg8Pz89Nzhy6kbofuEhQIFAIkZwA0CFLGUNgCZxGCHAHBpgCOISYgggKx",
-	"1oIcacfmSNkmwAViN4gBvZmFzwKbzzfUEld7IGZhXC8sn+CEitc0JeG9IH5y2h+8Pr08OagRARLYSiu9",
-	"hVyh/0hNtQxybxfAzQn6hArw2oy0IGQJFRt68hUC1d1pRrulzX5qNs6hQEc4xuLwLkAoRPcDdv/0dHDc",
-	"PfktE7sXNtDlFCCScwBkJlkSsWEqJpsRHWNiw3/LYut9SsExJNNM5vLFwS8o3YghmWaSl6+U0Vf33mg2",
-	"JgiG5gL460Z+Ahvqv1WV7FirdtlxalXyFpOQ3ja8iq1SAT1qnz3XuZS7RKpflfnyR8WMmADFkZTkrp94",
-	"kWk58mzxkuA7IHCMuIBxAm4niBioMfkBr9nn82fPn73Y2vVuV+m5iN3gAF0SeANxBIcZzi6J3ReH5297",
-	"+4eDy5Pu227vqPvq6LDMVLieSeoxAsUJZZDhaArSYuYlUX6CYCQmm0olcji6JVHN9oC9v4XR3qx4w1ri",
-	"KhE/W1sNNORUl0TSNWX44z25zuVJ97L/4+l57/dDh8v3jIZLGUB3ibwFAjmTvDzqMYGg10oPWUyt7xQg",
-	"d9a8MKxT+6sVArnr7iq788qNqx1mur6c8638h3pPCf5zc9+6F+Dfdo96B91+7/Skqs+cEqQuFZQhcJPP",
-	"qYU6zzUbeTdUvzT2/viroe6b6kIImRiEUEg8jhHn8v6717iQPwP5M4hTrq5smAAxQWCUipRJZCrGMLfW",
-	"4usTGCu6zC0Cn97d4z5XgG9ZxakAwupVJyPtbECPII7kJvNZlJiRmGIfecJogpjA+r6t1fyBpooKc/7p",
-	"l35+EVBYJa9l3bNeiaic6z6a/jQZ/hDgU/xT7/Jjr3OCe7xHzneC/d7z3nXy69v9n1620PSnj+EvPXyK",
-	"e52T/qvo9ODN7fF+Jzp+H+Gj/pu73w/eiN/6wd0JbrdPDn7bOulftk8OurfHB118tP/TdLh1F/XeUzx8",
-	"9hP57ZedBMVvpz18i3//dXLbe0/vTt6/uT3tX3eO33dvR29acBh0tp6FaLS983w8wS92X76/jtqdrZjQ",
-	"Z9s7yQf2/MUuF+nLdufm9m7r2fb0Y9VUIfcoOQofYOLYPV5KZClRpw0z9ZlhPjhWCMxRQEnIwdrLdhv8",
-	"G3R2QIxJKhBft0H50ifdmg2GRgzxSd2ZnevH1oHRoTBSnaBb5zz5k59cG/36Sp1cEL+Ng/jtR7jf4734",
-	"7bac5Lj/W/v44HrnpN+7Pf6x3bp78X735w+/bv327PdtuDN8HrwId9HLUXvcmWzhZ++3r3ei5/ELsktf",
-	"Jm3fgak9DvTPtqHqFYJM2WhLirOCmHwdrMHoFk45uDLvXjWckylGqMyZcsTmUfclN/pRYar8w6XE8ik7",
-	"e3Ew0cz4Ll8KHb5H2mTxKo2u95XxzbKocsu85rICx4ZSQasuY3AK6Mi25aiLszbvgTVj1Gw7gPrjr4ay",
-	"8jT2Gu/phPzHPJBssjAp/kQnBBxQZDFgKZhGmMVKaFpjQIJKY6A4iegUoQGWIuDw+Kzd7lhDQ4LARYyV",
-	"kdg3uBQIAsV83pFV4HheGMxieNfTY8j9KxNs9nd+KlCCr3LmDsiXOcI6dp7ZWgOaEo+yfaJN/eVT5KnC",
-	"vVEaRVNznqHDiHYtu7KXJ2USvTzhEeZCTmd0AMmNtJQCJYtdfgjufszBV5xKynJIR1oTqAzokGpuXSsh",
-	"Tm7b13P4+H1m8ylNrgw1mZZhT6WXtYg1szIXJiG68zgQ5M+ZykMZHmMCo9yiq5HKWsGO9xZmY5yep5lv",
-	"Wu/Rh3ou4jYbGsxLYpaYQJEdUM4r7BVvzcOs2Vwpwy8fBtei2EzFy8KiT3Oo1yW2EoSa84nbeIA9VCwf",
-	"oHCAyQCKGZ7h4tq81rs4BbvP250mMGIOnJz+srbuSq2t9tbORmdro7PTb7/c6+zstdu/25Qg9ewNOaiS",
-	"PzA8JdE086JVMNZa5HDquddzxMDtJDesohAEZt0SNs5+ceh6554/X4V3LhMC9sgXAo5GQMlfnzevZtPF",
-	"kaktYDKIkZjQcK7Q0Ad8rF9WKry8GQ8wGVGlfIchluCC0ZkFDz21C80D9SGIkYAhFFBL252fX4GfLk5P",
-	"nENWF7nBDWJcf9lptVvtRj612VFMh1iZDKiUh/j0wkL2YreKWw306ZS0Ac5pgGFhSu0dOJh2T8f8XKTz",
-	"raU+UMJZ0j3jHeYuySLzQe3yUCgXaLvBSgC7p0N6zurKzF9J9exQK0tvlhhPBd1nMDHJiGeoJXqcGQw8",
-	"4w1c+wdtSElqkbvWF80aReEBLPP+PHIFPFHK9Tl80TNGCXlWzS89M0rJmoUFLMFOS7inBnj35fJVl5F+",
-	"FSzzC2CRs1ji7Ogel7QXUv3tz3MlNt+B54K4gJ5vXyGrVw39sHxcxU0zjYx/oUZA+KnK3oefuJy762zi",
-	"yr5VO/TR12cVUg8USu7Fziuicpa7kMgq32wSKK9VGhLzbgfZm8dIwOqFIBNzzpgzpOZxzu4K49QHpkzt",
-	"zToSNhsrAgLzD2JIUhi5UYH5wwpamiVY5qAq68sY6gKcMOPcxYwf2MA4DdCNGGTsbZAwMcgQaWAbZtVR",
-	"OCxgZeqyvH8HuQrAoDylsatENwFN9OBz1OktW52OaYgiCYSzCSUIdHbAGaMLaNvyn/aoL1o7fnGyIGsC",
-	"a7kHSHlQ9WkAzIFGCgBJCFIud42sryJKr9Nk3c/YYnh3hMhYTIrIwvxvDz7dU3TP40xnDjuat8/1R+BX",
-	"FiaXF/fmHMgHxtZeuzZNEu7aFqQJ5xh25h5DiSHN19vn6OzfNOpvGvXDWG8AE5FKmghTuQv7aBZlst8U",
-	"8GWXkLvAKzHe2k7qtV7bnNa1p2oaBJg8SNkfQo6DL0Tl/6aTf0advMDPGYLpQjnrFhFPVcj9MkFigpgS",
-	"BTboJpCDIULExegcls79bUhphCCxWOkMqtYhMBysycsgwCMVaFlMsu65Jn4Ttt+E7ZdnvvrssssH9hVc",
-	"+T+/VqBX4EdRnSNZQc8+CiZgn5IRYogECEjaniOBB08nQpe5uM3GnFVd0+wVPYGAnyUUB9nAhQy1EMBG",
-	"Yb8MVJ5lhRa14TrOzvwYZf9mb7JLlE1AoGBCaETHU5WMpLGscuNs+5CZhDpWs2ZiREIdtAlJqIPeLAd1",
-	"FsgJRwIxUAR+rrfAiTziCH/U0QKX/X0wnJrckFadmOvs7rXbS4m5iAbQD7a3iKQqhjV/xREXkIDXDJIA",
-	"84BKwpR7xZSAfUSEwunyXX1BEbUc/VuTbO3szLXLWJG1NRPzIsi2el73PJX2y2VPJYvOmi071Yq1bqgE",
-	"GI7RR0pK8X2X/f2K/bTXPemC7HUnnxi1xi3QjRHDAdw8QbeD3yi7boIux3CzT6+ndL0ldYQQQA5CzJMI",
-	"TnOJ6+4/G+SI8kGXjFGE+KLXBCf+2YCinjN44tCWC52CYcjkPXAtI0bDoW+xmJhoI8Wv1peWE0ti52IG",
-	"Tqr4xGhU64QpzzrXKZOrTEvpW/spFzR27hRFOEan7Y/HkFgMybQgaJZI7MRIQDYdMCQXpfINucSHGzSW",
-	"DzBUkoFRvU8yxgTpgKiarRUoshLRt+QxJnAaS/EGY3942Jl+DvRzsBaiAMcwaoItkEQwKAU+d3baNteg",
-	"qU5vsQPFaqCgaxnYK/Izvmw98ulmieF5WFpno73b72ztPZvJ0uYiXbamxVidWWPB7JJJzumcvSjXQ1bF",
-	"IWFohBgcRlNw2Oo83wZ6qe6u/quzsbOzs9HWaY2O1FpgGx9YnZrZjVQ+p8A3yGR3jygDmZU8xHKMYVoR",
-	"rJKvtG4pu16Wucxd6qKQzmkjg/ayxhwll2Ya0ecGTgZee48Tot92iaAm+seKnrRqL1TvdfJZlpWwgFFB",
-	"E0F7jlyfGy/199NbV6eZeoWgv4DOk4Tb/bM0ZcrGkOCPiNXNS28JYiDliBUWP0yCKA2Vmc/8CG4wuuWA",
-	"kmi6Xm/itrhfNTFi/u376UJmreyMewTM5jAd1OO2BdbVWAaXitms4ct9KlSkfR7DX8eTlbKyHFd+6JXs",
-	"q790LX9rajbSJKwVZUeQC6BfeFJp5rNWOhjfXPZ+p0BdDqaFUXQ6UslUs07J+epTs2IvMredhdIgtDrj",
-	"S4AorfhdtmaJHjPcNcOppfX6L1x/+fKKrGsUJAGKIgnpnaaVwrW3K8UHIkKpnZIeP9VZ6LUiVs4oyed4",
-	"seNVoYx1mRlyLZSxlvwgR5tRRO0KV8VNxLY9r96uLCSfqt9TXUEIG20tI6VntHrYlWBTi84X+cHXsDr5",
-	"tIhnCxkcqfyZdBhhPlGpaJSMqdxwU92mI6QT1AqUcGLe7A8r8OrFiS6Blu9j/+JtPd7OS2xj9HYjQjco",
-	"MiluK0llY/QWrOERyGsmuAxsCMOSvrB4AEB98lolkXxP6VlO/rxnJkZvq7N0NoaQm42Yi6mxKu1fvAVr",
-	"6E7qTJiMgS6H4mzv2Vx8ZaoIySwf8n1z1xi9reSsYYUwjZoqh96cNf3JIhM6cRbZZ/WqxvbcREx+jZNk",
-	"4a2at7Pad3mqpLm8r8nng/xX/m8pBNeXSt/L1iOnm0lF8xbzMMLKxtao4ySHziMlhiCn3jx7+bsycKjR",
-	"NXuqSwZFd5gLvkAi6MrpaWdBejL7nE9OZaXHRfYyCpaIzzf87NyhTG+pSUfXSGEhx1xmECMBHxjobZzt",
-	"aiTvjugYkwdZ5h1UylXKezhpOb+lrC4QI3/sXN5RkDJ09h/Ob9vqUT6N9fpsRThbT/5BDZBoOuPga2XY",
-	"vtb9tKzyibILm6tGdDxGIaCpaMwPl60XKSWM8FRP8C7VlM5KikK7jcXr5TaLUrBzSss27l0T1uh8dRdg",
-	"kguMjNDqL741Q6sN8PkT6NesCeYIu4o7XoHBKp6rN+auwn+0TgTj4nFmeWRMocNmax/BiKPa+2M5uOyp",
-	"I7/KdvP5yd5fnh15Mf+msZtKOvl7OzS/jlztRw8Kmruqx3T8NlXcp20RjqSCmxe4flzH8D/HEfzN+et3",
-	"/mLi+HxnuHwX8fEulPKkifieqU1zidWsYjBGBLFaAZQtybz19KLoAxvYvu1ByjyC6cB6A1yeH5m7IMrd",
-	"42uURNPC5qOTyN6cD348vej3Tn4YvOpeHA7kh5grvycepwyF7rayioQfWMsSa5sf2Obvv/7e/vXjZef4",
-	"h8vtk4Pu7a/PXk3D17vPTj6aGm6vW62Ww1AZvo+m8E8IDvh6nBGWadcJYcg3X1D6HM348/sk5pVm8ngm",
-	"qmfnuKwKr0FzhpCsGKjtzwo/hG2OlsMFESZly7T9dgUdXZbvLDQlCcShZ5VakFVWmL+v/ucsIX9Und+t",
-	"OVo1eL3eBy+3d14A8yIwb4INAKNIdw7hADKUZ1JXvN9+kXIMgwkmaEPit+J8uhS+ZoroTiCimhJIbjGE",
-	"wfUtZCFQupPAQxxhMXVJyi7/7ok+EV7m9GMaQ2Kt4C6JoL7uA56gAI9wAATVtjtTyVYHnVhBCAtUmPfX",
-	"mPMA+22lfm4JEpa5Pqs3u75okbNSQWCfkayoklsxHJ33gIoxU5EWRnufYjLWhtYMWAWQMhusWaYDM2+Z",
-	"fVsEbeRTzXZfl06z3z8zVAFMNYJ8Tl28v2qq0NV+KwmTE8pEE0xc9OBpHEM2Le0M5NU5s+3N6g1gufDy",
-	"AqWLw7l2ysVbDlRkfVWeVASCKS6rKqXW2jfnFKhV2AeYU6ZWl6hFIRgxGgNV71/ekROGbjBNefb237lc",
-	"bdko7wDxnfcsdGzKSiPA1+9neF7ylui/mb7230aL+KMZs2wtZfw+M09URy7VYWEXBBPIYCAQc6+gi5nD",
-	"Z6xs1+vk0bxmXpHgc/neXON6rtupYX2ocoFI+OZ8n4boNcRRytAMZLlP5tfcKIXCibZkTlW2iBneqWJz",
-	"dm3jEs5jlRCcMHqDQycpeIBDrsxxSACOSDgQdACjSLk6W1ekNwJDKiZKrTFfh037RSDgNeKSUwUolKxa",
-	"f0SQnhFz6zNhtSFjSKSMcLDdbgOrY1jrqiYtfiBQnES5PaPoKKj/1fQSefaNVF1Sjuwgi/w7RQFKV9O6",
-	"EbK9NXWHPsOX6+aqqxLRElzZRU/+0AK9MaF5oZQK2G09Zn4ATElzsUZzQGUs8uVeFUQ5+eVBOoVrjWRX",
-	"ekkL9EtnDOiNcrw4IGk1qvb9T/Pwtc75VY5YsD3uVeVlpKl6xqmYeAZ975Yg4i1wGCdiqosI64OQUFAR",
-	"CapRz6LaZJW5+E9FeHazvTvTI1U4sef7f6wZKqV4M09QDicfH7lUV/YVJ10+Qkz63IS8x8iCXEW89lMk",
-	"Lq4IOCuIi32a5MMFdHaN199SBv/OKYOOU+gCEUwZ+JY0+C1p8FvS4BMnDVa5r+kH4+/T8ZXGU7jLSPnK",
-	"DQS1pYM+Tx7c6u0VnQdbBb4iJ5TBgXlWinxv/qNX31l98MNY1ZwqsvYU5Y5Grr/DflyBeNkQXr2G6dZy",
-	"lZPPu6TraPEAptxUw0KmRZxlp6yzo9QGOKrhN3JTOqqN2c/aHeZsM4bzoxz1nmYF0Kv7WpAyLKYXEu9M",
-	"Co/qedVNJfpmf73OUOSnX/oVG0Sle5xr5ZXQ0pZeRMKEYiJaV6Sng2ey8OauaZ6oGaKObgaQ74E/dQcu",
-	"cJW2288CNbz6J/pTGVAUuaibeKlR10SIRLfTy6ptBZQIqLudZ22meJoklIn/FPbzoqMU+vjmHBNwoV+p",
-	"JDSbm0kMCRwjrb0Y20ceKzjlAsWge9a7IlfkX/8CpzeI3WB0K//sSwzSM3TPeqo3unJ1MTRBhCsJWR4/",
-	"iaAwnauhvC1K0cZBjvYS9ntXZAPoyiFqOfprPRSXzzJTsmsDka/m4jePU1Af9BkMrq2mDfLVLEgDMCRB",
-	"o9471jOp3E4YqOu4ftltIGgg0a38KOEhAZFyxIHEJ3PsunudUhTckVogwyCJPlkrzFpc2pOT/Pnnn1fE",
-	"eboHHPSyW7NpLDMfXZHvv9dd4/rTBPG977+Xmzbd/9SDPaDdHXKlRZs/DXPtAKm89gKEcMozkJz1Nl5j",
-	"xgU4QDcoook8cw0ZzMFpgogET8YqjMNSXna5vDHKbX///QUm4wiBC+2KoiPQZ6mYgLWLi9P++vffayhG",
-	"kQK0pAYGA8FbV8R0C5eH3gRBpLqmXxz8zJvqBC0HpBFOykiah+pkRI55aXm6DPWfFCZ4Q449RuTPltmu",
-	"apqs+gVjMpa/yTXlvYjl+HLsDdUgWF/wE6YpAg5TjlrA6rpsVRqVhGSH5WUReQYLuCKQPysNmf/cAzM6",
-	"MFe+ybsn/7kHFumk7BmAIznpvJ7IEjCvaZaEjkIFFP0GbwKONPL/4QAThDRIYx25QMm7tdZmSAOu/K/y",
-	"64H+uhWH69oEHeEAGcuk4XzHPcniVWxT7mWkCSLaxdmibLxpPuKb8t3CqdooWFqj2Sj3JvrUbMhhYIIb",
-	"e41nrXbrmXJuiImSOnYHcCmeqc+8bzEOifia36hGMloySnzN3CUgYEhpjzDSrCizxEv2ovlKy6bsIzxC",
-	"8iy8xF2QtO7laRp7rnsIXJM1WHve3t513pRTXRhxayYpsNhF8iGTjHhEJR1DIWBwrVjJa40FUAgUJ4ZO",
-	"TNqDSk8yg4OYEiwoU6S1ATInmH5fmRwYyqrEDwM2TYTCBKkPKaTphVKxlCdhSmYa1H5Fw+lyzYQzQVvn",
-	"Xyw8d2X328IddJ0UnE+uDiQ1V/WDicuVY22128vtwe2c+wW3vXX7hequsiUX/ZfnS89jRO0erkXb1azL",
-	"qn2PrrvGzEM21Th0wZtjtWOpsbnZ1wz7XuZf1KeF0djp3uxpA63Q3EojlQSyrVHZN2yO8puvYGi1MN1u",
-	"d+7VDrx3ohqCD/bPDw8OT/q97tGFrx275sLUSTjz9OG2WP1X0YQ9254lURQwt17Oh38u9Q/vtL9Nfrmz",
-	"yMn1iLKXRSZ2y7qsNfb+eNdsmOCkklhUMlGCDI6V0VhCpPFOfp2DnaaiXsSavSoBq6o+G1VGDvsdd+95",
-	"Wqpa8T0trckbrV2KGxiGWrRBwNCN8TPpdAn5dQAJIBRElIwRU84GjkJHLJ/nX7mC2aj8HOA4RiGGAkXT",
-	"rBm+pEpbMhfvus/7nnWeoxDzjSFUJb1LS9Zj3tBr9ar6lin9DwwjGFzLV6RgJQJHEnaYAQJFymBk9QbX",
-	"e9vXWraheB3eiPOLhXnKJzSNQhCiCAkEuFCedD1v9S2GQsxQIOQe9G07gWNUfY9QFZPAplpvUrF8yr5h",
-	"xvUpAjQVuSbwEFFq9fuvydxcRuzbSaV+jklTUWGZnfmEd1liIw+nVteo8se7Tw75mpXOIdwsNK6Wcg/v",
-	"ggkkY6UY33hi79T1r9IavkrEIIGYtczNMzPZZOgzRCCAUZSFdEj0gZUm+CjTrB3VGBRWOIPnx1mlNbPe",
-	"n37p5z9LPB0iM15Y/tlc9Sv0afENKuypXqngHr3Syo7NjVN+oac6jcowqTKPE3SbfT2BNwjotwtC1xc7",
-	"D0HZsZUPUa6/Ft1uYZr2BZ3+QzX68QS/2H35VWr076+jdmfrm0Y/T6PXbMocJwqdEjGfSbs/P3x9fnjx",
-	"46B/+vPhiU+/pyxjyC57nKHmFxHdX5GiX7vPL0nrz4SrLX9n6g/a9l+vQGjPATdKgm3Lt3RFbeJVNWoi",
-	"1AJdXSQ1x11TSVGLu+YVkd+okRgKEFbmaseOnwtgcxmwtfmUawNn96xn9Ik8nvtcS4RYAi9TJjwR3vL3",
-	"C624KPcPJiBNEsQCyFETRPQ2+6cOkDAWb7VHGDnjyNm1i/xSeaYJ4tnE+udStBQMGJWqRhSp7RtPQBYK",
-	"/FIVMY1wIAAeZbZLp4KQV2/QB/jYRrkqp6w303m46BLi3s1rWEjUd74Z774Z7742Ua/DGooSs/cS9aUY",
-	"BivbtrHdfnkvuX943O0dDbpH54fdg98Gh7/2LvqOWa9rOVh0NTMPp5op+43IsYX/y0L4Z0xwccEfZF+s",
-	"UOj7Crh9YYLeeO0LweyX89rRL6ceI498/wEJALXtjY5MVIA+3BGOBFJtO7UHLSuB1QKnRXyB8TdiBugt",
-	"MZ83gQrP0Q9hFLUqUusHJA71slRuCYyRFKi1CeXFK5tncIzO5J8qoXzey4gt9f6FLg662MunLJTDZ2+X",
-	"g3gk7MBwakI2VLTQmgqwgBGIoQgmKmFXvvshRWxacKisZnCOyJWAnnmT5RnhvuHzh4tRihPl/undvW7Z",
-	"S8zklgyokqVjImRIMIxuUFhC3y/XpieXiTLEz2jV/PDuU3OmCm4I3SQlGV2XF7Qo7yddSXdaCa/Q3Bnl",
-	"BdEtpycudoCevmUr096WQCEvN9d04fS11frHwy7Vy6HXdvvZ/I9eUzbEYaivlY+NkAaz8nqkZYws5Mfm",
-	"Xzj8pFEzQr74+APtkIAkw9BTXbU5EykBJMZMq0cIqxiqh9A42gurjoXt+qwrNaJHhXqSU9rWK5v9xQkV",
-	"ugbAwqrZqlSZ/Fa5MfdMngLnDKLU4lyzXk/JQ57s6C44pKlUYYqIWYV/9TqHD7XaT8WDstYhhez6WpD2",
-	"sfFCHjCyYVQjIpdSFxXQewdGTXvXbCSpB7d0kpziXVLRzylEMrFoqsxN1BazkGgNVxmn9J3RI29TF99W",
-	"L3A9Oasr84ysBNnNdXqFVux/HFUY1FxUQm9a7cofSil+XVSODzAB0MlqHGmq0PSrgwhNDYQslYtLYSN/",
-	"pwzEULXyyiLIW1cke0t3cQZutMmbc20QbmYfmrdYpgO7VQdaV+QgLx9fRKlnFfdUYIP9hXLzovcokKiq",
-	"br62LbZ1RXJdGxUdi5o6wbSp2IHiBQliMeYcUxVCWmEHpu/3mdM7/1HUcD3RZ+II+ez1dzinvJqjkheN",
-	"+Qsmcf+otB8P93/unQzOD99cHl70bROWqXpnd7fQkQsGsTAHH5gpHeUxYxX1qnJys21Z7cKWZdX2WNyc",
-	"NYThBis436rUQLmWrP7IRha3kO1YEqVEXhOyriCia7o9PfNd+sTPuuf93n7vrHvSH9j13yqeyozLUCfX",
-	"xanRtvxxbxfHPavi1+KluVZpxdQMq2a7inllMDEI8RDLcWYzVpR3eDDoOe5iFTlkr2MCeW5gHSJELPqv",
-	"tspY/ly+OJOydQ+zWWAGghL329p6kPX/0S0HXj3A0lCyI6lVUeaZpI3B2bLuwSgqyfNc5VBi20Yu64bY",
-	"uiImYY8DTplS74fTIidPVw7xCfmFhfsR5sJIvqc2aZfso5QJzbiz3GSvDVj3NCoQuygp5XYkKBJuy7/b",
-	"5Y/UqG6p09LbHhP2Etb1d4+vrzzQ7pxj5RcnLVcrSQpJ+VTWcj+9e/nMA20DdWxq869gjunzHMX0BgFY",
-	"sBSGAsrCpuRY9Dav2mmxJ0FV0Gwh8OAYYpLF10JV3sUyz6UkpOShTGpfVSg2CL+QdbVoceKo6Xml478r",
-	"suf7flJ81+djodEjYHmz9ogrtTXA2uVl7yD3UiZQVfkxEiTAmVGruFbaAqUQBbu7q2h1WSXPctPLpTQJ",
-	"J9O9qkhwBFkwUW7vwgkewARmKRk1RgE/KYILVYrKROTd4ihS4e2hSUc+m0COwIs6teLMbQf4t/SWX2h4",
-	"D6dKaWnqiBJ1O7HKfXnc524b7TplRw3uqDtLlWaZ5W9PfJXiV+B195UXekwtqK7U//KakEOW/2C7rVJe",
-	"atmMxdkdgK3CweE125aihepMt63CHKBicqm8QQVQHnVe2mEp3udTQ5TP+QnsoOV5PlNQgtO5YhlrqA5Q",
-	"0BLJHMtX4Tz5fDeS+xquDi7Pjnr73f7hQAU/utGONq2Ugx5xYcGyIjmXNF6VGv5+HRYsNz6yfvNfgSmr",
-	"G4Ylb5agC3DqWQrp5jCNrh/NB5cz8ziNBE4iNEOfVRY6rmsAZdb/tTSRW+y0223ny/XCEWfyn/0SIK8Z",
-	"ZH/8ULHwKo2uKyz7sULV/JN9JgFRt5iFPGj8K4xq+7vICScs/sDtWM71bLrelCY7ylTx3yHUtfhN758/",
-	"8jqDDoP5Y+tdKy+fmWfIL851a0bd8Y1aWrq1ZsWEFpdemu19LSKscmLuWVWh/DUIM8lMgO7KDkqKxX3k",
-	"GLpThQ7r7CuH6nG1gUIWa2Gqs3EAVa/6EY7QQ+WEntLmgPsXb6uGkdKNXVmXihCQrDlUlMakBa4aiIwj",
-	"zCdXDUBTkaSCg0P9C9BWAA7WjItk/f8HV433MIEEcWS9/z///b82/+d//5/N//vfgE/jIY1UR4h6U8Ag",
-	"r9fp88KY9Vj+l+KXbHJPo44FbAQC3YnNgN+4HDa3vg0xgWqx5ZGrhGTOE4T0lkQUhv/k276hA4cGBAUa",
-	"Mx/npj+TbDUDeDQFtI7J6KqPDq33J0j9qTJhVUkMmFVyZfRWX6igABGCXIDvJIl8p+yu3yme/J2hUckJ",
-	"9tW/AGWh7qwzitAdHkaoBRbRWR/KdnrxPdjOL6oaCktRU27WpFWFJWkrF82vcZIoczAXCIaqAY25/kNu",
-	"egDWsZNrnAzyMbmfoZj2N5UGNe9mqdf6dgGZ2JTsYSPrflAMX66W7CvenLMJ0/Lg+NV67sYKs9Pds+2+",
-	"tjOglh2Vixp7i0o/beSbF0NmafH6A1V7Uacv5AZuo9Kr/lGUc4nl699U+tkqfefZEy7gDE6lyAN9SsER",
-	"ZGMENnKmB5BK+uQK2Z9C+PTqGPFM8VOWHzrgkW9yRMJHExwXpV5gVuUItwEX8zblyq0aNxhqZtG6Ill3",
-	"N19XN7e1WF6OIWvK9lChILejdm46ej2StcLT4+6JeZuva5mHLLpRVJwuL6fcSVrYar946kWdlZjqBuA0",
-	"zu98cpVN/YtuG/YtP2Ep5lOhaIdmczq1+JCJrK5yIHkxmu3Kt8vI6OIweYCKgAJzgQPX/DkzC+xCzffY",
-	"2TFqlln4mXczyzbwLTWsPjWsANMjZIdJhJwgGOkeF14szKr1cCyVNqDfzuwJpm2kqsmvquf7sO9HPcED",
-	"0c5VvYueRVYraDXP1Ns0Mysu736xTCOY2fq4WY9fIy9rBOwGB8rfnK1YIdQCOGs+vSTwBmLd1GlWwYxX",
-	"kOMgOzHFOCwUMseumZL+YzPCN6gWEX5Oh4gRJBAH8j0iJUvC6BAV/Uzy0k5b7XZRGJObDSeMZjo+lCO0",
-	"rohq9ScoCJFAugiU/QFRSqUOHGRIdT9UGkw9kh3JDTw6oqnV+9AsTSSyDEyte+ejZ8/bC/T0vBcW6eU8",
-	"Eg4dOUc9B3+U+XgRBJIv4uUxCOsvp8pdGQQoEUAwOBrhoHVFVFwfzx0OIKCEoEDgGyymptapuYOHKEEk",
-	"RCTQcW316HSu9rNSfFJkyD2dVjM/iYNp9NrfXTrEfP6LvvZsPnRmZpcLcbhmtoMlkVRPUiDp0p6oi8Pz",
-	"t739w8HlSfdtt3fUfXV0aDujrKl0aWcvmvgjExzsLWC0035W+HKy8W26WditY/B3I7WJbnUeHt/e51RS",
-	"csivjqodA+ui1TDcSCtlHc1DrWaGej/wZqrnLwdZzYv3tmORvraaGk9UtqIu3ali3H9gEQtrvIfiwg9I",
-	"zESE9ucIdftWB2PWZSepQmp1jiTrGO5TGcOa/TteyrPLokcLdqarz4dATBhNx1nwXNHy70GYXWlu/aiF",
-	"Nu4bSvpZ6OsfUHrjs1VRcqNwVPPPoVSqadkQ/TUEjBgKr8mdne0/qKhEWdLcRqFZe+Wgzh6+nSAFMVhJ",
-	"C/engyPMjO4k+Ya+GTmFPEjoVhACMKJkrN2uuanQ6Qw4smZZVaWPH1CWC3yR3RIeO31VTzTLyrhvW0rT",
-	"R5C720/qfvMVb/gMsjlwobqShD2vePZTmzGl11HZgYnMyYroKNGsG7645G7apiiOr4MJYyjA2tnJDxLp",
-	"L97+sP7g+4hZirU57bqZF0JhLVuHSxU39YSMa2IiZsZW6c+yuCr9F78Z+8KpmnWr4fijajeT4DsUcQMp",
-	"Ek2bQMKi0243QQzvwFa73Xby1HY6WzVRHPgj8q9XfRLrRqSNPTmiylfTf3a8ZrT5UWA4hmO0KffuUGWJ",
-	"yk5+AOpFsKZsTxqq/07IeH3BGA09Db8Z/9ddHM2a6uKtdyp+o6aaG4uWJy3JIRavHbTqBhGGbijT+JHj",
-	"9T/62pzxIJvjZNlMNcpFs/ARroZ5LrBg5a/xMSCr83Lh1Sn64O5tbkY0gNGEcrG3295tGzOaJ8v1jNEw",
-	"DYomzazSULdkMZOjvMthVB7uR8uToTtU6N7iRsBndyxeMBljzqquzG29nbfozjtiF0OouuLVAS7ttiBF",
-	"e/LiO9XuwvOhdn5GeISCaRAh77d5UedZ5qqKa9g3Uik5tY67m6DBbKS8C7oDCYOiMzLqcwmoG5kIBqVG",
-	"MLaS6I2O8Ondp/8XAAD//w==",
+	"7H0Jc9tGtu5fQeneVyPlkhSpzVtNvStLcqJEiyNRXhL5kSAJkrBAgAFIyUzK//2dpRvoBhoLJcp2Mp6a",
+	"JCLZQG+nT5/1O3+t9YPJNPAdfxatPf9rbWqH9sSZOSF9Ohg7/Ztj//jwNX6N3wycqB+605kb+GvP+fe6",
+	"61tz3/1j7ljuAN7jDl0ntNavro4PN9Zqay42nNqzMfztw7vhkzuAv0Pnj7kbOoO157Nw7tTWov7YmdjY",
+	"h/PJnkw9bPj0adN5utNs1p2tZ736TmuwU7eftPbqOzt7e7u7O/BLswmvGgbhxJ5B+/mcXj1bTPHpaBa6",
+	"/mjt8+fa2tEtDCx3GvTrY81hd3dFczgPB06YM4PLIJxZATaw1u2oD39a2CAeO0wsXCSDp5Zr6ngHztCe",
+	"e9g/Pgc/Fb7f8QcwKtkLf8K+HH8Og/t9zY5fsfahpqyFeHd2bq/tkZMzNfzJgvf2sO8J0Forb1ZTaGme",
+	"VEsZBPwNb3EnONJWPBbXnzkjWBMeTDhz++7ULiAZpc1jEc6TJysinNdINrnrezxzJpE1hVHj+jWs9tix",
+	"xMJZtj+wZvB5Yn/CBbPs0LH6gT90R3MYPD0Emz8NYPWu/fWtJj3QajZhSTwniqz+2PZHzmDjheXZISyv",
+	"dWt7cyfi93gwUXjJLFC7aFz7ebvrhJ38Hd5qKluMH0r2+BKGB/PP3V/x+2Pt7bPe1nCv33LqO4Mndn1n",
+	"uN2rP7W3nHqrvzt45jwZbtt71fYWD2YRT4AhewOLhmde1gha5XCCfujYM2fQsbFBMnbt6+yIriInzF1W",
+	"/PEbZ7SfsbcIrsTIoTvwpT24gN6daIafgPpnMGz8055OPbdv48w2P0Y4PWU02HKA7325f9i5OPr16uiy",
+	"TSxxZrsefI2nLOTXwoma4x4FM6vnwOIAk41mQTCwBrBIcDpcH06NO7CihT+zP9EiRTPb7+PbN+2pu3nb",
+	"2nRu6QKHhZnZszmMG2YLU3NntDIwBUvOIZ7weDabRs838Q0N588/YPYNEAU2p2HQ84AjbPbsQV2McO2z",
+	"uuL/HTpDeP6/NhPJYZN/jTZf89OHNM2IV1OnAByLnHg9npvrT+d4wQAb8HCDnLgR9n0ALAeW+n4bcHB+",
+	"9urk+EBb/X3gdQn/vnNnY+BBbmTBHFzPgj9sD4h8sIBBjNwIpCEYDwxLNMK1LtqGzdbW9qbSgb4vz5J9",
+	"iedVeVP68okV7siFEwXzsM+cHV9urQ/mvLJODb+Eo2ED77Ru3cCj1d7A7l8FYc8dwBm+1668Or94eXx4",
+	"eHSmbsv7YG4NAjoJY/vWwftl4jIfhnNg9/t4p9AehGLMZdugrfx2svLJ4Csv/TB+ZIVrf+xH8+EQ6AQF",
+	"0GS6Ec4XPuJR4AnbfXoCXnAMKx36tncUhkF4r7U/PmsfXZztn3SOLi7OL7RzgRee82nq9IHBWw72YAX9",
+	"/jyEA9CwXnuOHQFLCheWPQKKgEsdhtKoyJF2VY4kJ2FdOuEtXAA8mcp74YrH6zTE1W6IGFjEA4s7OAtm",
+	"rwJgzvda8bPzdufV+dXZYc4VgItNOsidHRH5D6mrZYh7J1nc+EDDmK1X4k0VVxY6r3PnK1xUfaby7KYm",
+	"C09dAD2duBN3dvSp7zgD536L3T4/75zun72X1+6luujYheVhH5YjOlmSsO35bLzpBSPXV9d/S2Hr7SCw",
+	"Tm1/Ie/cqPryw71fn8Cj8uaNVsros3OHkY3hohPq/rt6vAN1+ndWgDsVmoAcH+kAd64/CO7WjGJZi459",
+	"VgBX+7rAe9dH8SvTX/xT0iPsD3EkurnzO67SbeQYpnjlu5+smTuBzuBV1t3Y8cWqhfhAlDPPve297Sdb",
+	"T43TZY0jvHX7zpVv38IG2T1Js0tS9+XRxZvjg6PO1dn+m/3jk/2XJ0dpphJxTyjHgG43DUI7dL0FcPa4",
+	"5yVJHkjEA6InkUjj6MqNKqZnqfOrTPZixHVliKskfDm2nNXArmDYcK6D0P3znlwH9uOq/dP5xfFvRxqX",
+	"PxYSLtykcLGiDmNhT6j68Dvhqr9x/MpifStZcm3Mldd6rj61wkXe12clNTacOM1QyvrY5xv8g9rRxX8h",
+	"9K17Lfyb/ZPjw/328flZVp459x1SKoLQsW7jPvlSj2LJBrVb+mbt+e9/rZHGTAohSPAdeALpGJhBhLYH",
+	"oCX82sKvrck8IpUNTg9aMIbz2TxEYkreIfTu5Okz+MIi+VXos58/3EOfS5ZvWcEpWYTVi07itlMXeght",
+	"cZJxL3TN7IMgP53BwXBnjqJawyDhMpm5rHaj3gED6NjUmA9lymr7CckD2DI3wRW0giFtBS3fvyJLvAQO",
+	"fjiJXiQ0ibocLzE0t2fyB9k+Wc9eEACjJLmbj2nWyuKOfAcVWJiNcp6tYRhMaCw8OrhB/BtJKUpj0jjX",
+	"yFx14vij2Vg1WClWlcQA8rsYyYe4WdD76LBKqK9scqj0paWZd1xa0hJriLTCqHaWn204VZdwH45N7RW9",
+	"t2oXf4QdPsvptf31wsIfJP+IojnyE1/ZcM0w5dzOOtII1JnC4ZUW1I7d6m31twc7zu5wrxHBjtl0VM1j",
+	"Gbj4sTfHQXTmoZc/rnEQzVA0ubo4sdYDH24VEhbgZ/mLGyn20g1ttPKo/hE2xJd0VP8IN39791vz3Z9X",
+	"rdMfr3bODvfvNKNV6JqGLdlEyRlO9uaSH0iTVmr3agmt1CQzE10l22YkxAEQ9AHNXKVDezBwcQ1t77VC",
+	"kWzSSx3u4RBe5d4m9mY+L6MwmKPVuLcAMYd0YmudVbUaMmW7B1JNDc4zbGLN+ng3q1mNRmOjYf3iLCJr",
+	"jhLP2Ln2I9++cTp9lIBwVpHkG+/3T09SHQ6Bg0Vk1x6Ir9h8zWsfWdG8P7ZAkblea+1OmtH1GluwlXtK",
+	"Dgv/RrpACyf8ZwTSJB58+xMso+/DOmztEh+QH3fxMEXRXRDiVfL7xdHh/kH76PADPDRFo+3z3Z3tLVhr",
+	"mCWtLZlHOnRWOiRqLOAxGhTumtMPUdhV34Obn925OWzRPlsbDP4+tOfD8vbRFzSQ/MzGZyzQiRrWAZ5K",
+	"z0Pat62+dA/SjSeegbXqDhzPmTndGq7rtQ8LMQtCOi4z+nk+xfu1K1ZS+JTY7Axf8K90zeNbdA9T/GPm",
+	"iNDEeAK5EwMygCPDRnOQkUEtQlolwsLfVJuetY6UQ/axmd0HiYAvxhpqtA78ZwKf8blrH2mnD6IC3Af4",
+	"xQauBjGLiR3eiAUBgrXR5gJLgtbIYD6DtYiEu4TXQefhvnOXncUbbG7ZQ7juaF/Y/fLCCoBZz8S1x4uW",
+	"aOGRbtvn7WPJMPAGeX30nCHKVHmdCBdBXid4wNDGu0bch2ee7ent2IH380zYjTGGEZHGqWzLHRwpR/Ur",
+	"oUVBEpva7dD2gDVkLvbcM3ASjLJXpx0fjCI+q54heB08FITiMjS4Q2AGQAoDdTW15VqNX6O2xq+O8vlw",
+	"hUmJ85OR/fj7Ae9ThNwZTwewgzQhgBwEIiLcKqB48qaS9R2JHUia95FOR+3al6QKA4mkkd5xQwuoQGmY",
+	"4bcsUsEfCWnhDaPdknR8FGoXxK6SpokwFNeXiVx9ZQvJukXbun58eW493Wu29Pt/q7m1W2+16lvNdqv5",
+	"vIn//03dRuRjdTRDmPbSREz7kgtbsG/hIutm07rfG7b6W/Y2ENRg16nvDPea9af2k179Wb85aDlbw217",
+	"p1eFquTOGun7OHHxiRtWeIRVA77i8e4/c/b2njyrP9mBtdlpDpz6s52dXt1pPhn2W8NnTdt5stSY+JcK",
+	"dC1Npm18IC0UUSfxIa5JJpDuR1+L5LxpZPOhgN2cwNHIl9qR2+F/XfTXV5oUcrCEiu0wtBf4GW+mcklx",
+	"5Pok7Zxi6/SK0FjEm3JnpK1phjR+ceFaBKKIrcG2n8gRgoLR79GDu1CRAqTzTbmKaalB0HB9XRTQmxjk",
+	"gdk4f7VVaSo7+J/ftmN3FGt7cOntvz5OmXZ07WTx87j3Y989d38+vvrzuHXmHkfH/sVu/+B47/hm+u7N",
+	"wc/PGtDoz8HbY2gEDdovvfPDX+9OD1re6UfPPWn/+um3w19n79v9T2dus3l2+H7rrH3VRBXh9HDfPTn4",
+	"edHb+uQdfwzc3vbP/vu3u1Nn8mZx7N65v70b38H3n84+/np33r5pnX7cvxv+2rB7/dbW9sAZ7uzujcbu",
+	"k6fPPt54zdbWxA+2d3anf4R7T55Gs/mzZuv27tPW9s7iT9NKsl0r6ri+Fj/wDE0WKRalrhk9JlRmd0Jm",
+	"FJBSAx/uj3V41vq31dq1QB6egzylsc5nJhsrEugQRjHO27ML/lnZsKA3E7ZlvHrU/Yy++M41nXcvaef6",
+	"kzcT+OdP+wA6mbzZwU5O2++bp4c3u2ft47vTn5qNT08+Pv3lj3db77d/27F3e3v9J4OnzrNhc9Qab7nb",
+	"H3dudr29yRP/afBs2jRtGOsIs/hcyoCPlw4IUGEm+KtNK4bNrXXbu7MXqO1w2+s1/VKL35DpE3SvsIzr",
+	"oDiU4TXaSUzvsjYXjRJFjybu9NKe9ccU84dacJRrgnIHkeFKO4w0K1MkJFCULYB/u32WQmN3l7o8v1eV",
+	"5fb2KjRDy6G8C0qvRFAzj7kxOWTgWMmP6Qsic/lF1RYxj5PCPUI76PY8vBgj08nUnaC4xGSWE7EAzieU",
+	"GSn8Ar4kKcIGqQ10mcBhD+LE9m1dav79EdYwfZHilt9bnDYsXdZvkdDUjbNgq4dcopoISMn4kCN1hXhh",
+	"FAek3MDULvNUatnNMm793LsRkcFxEIK+51kjYH70JG2qFgJFtzlZFzTe8uzZavQgEMYik3Hj7XhBS6eG",
+	"BlUZl9qeNQwy+ym6RbE5N2NzEwMsWfpctqW/r5iFaRaNWcBTxJt4HRgGRnI2N15YcTQQszZ35AdhmrFV",
+	"DFatFNB9f8a2FGdLr1PpeudxOEEXHTLdzX2DbnjG4ctpE5KZoHaemqQb6aEqOEpR4Vmq4bbKyDsZAF5J",
+	"mcicdxMvvHGnU1iD5RZAPAUD7dvCOLuwxvYgjr8zr9CW0bWv7m1mS9IjjBc0d9dJZ1NXt8qBM2zQPi5R",
+	"ZuZ41qgH5aRpJyq2Y6x9DMb+/yougiQ09mf4xToMFKu8blxT3mH7TuodDvwdLBxW3NeOTl83my3l1aqT",
+	"x/TyDxWJJ7OOF0lc50rO7nJbmHuGhYq+JP3O6bYczj1vIY2emqbyVAlEby5zrE9I5BlKVzXe9exMtVKB",
+	"pfEmpHx80giWcqtQgKtg/tkXavdazPZThBOzZOm7zCqEUipIdU7xhNIZrnbFw6oSdJu1hPkD55PhjsOv",
+	"pX8iCF00Z3gx+2OiUkawW8pRuJ9aPGmeo4n00qyRl3lJyiJOLjYo5hUpHlhMWcVcSdKXiYJzSayibzG7",
+	"CGnurB221ArVqh3uK3L0ZDya95eKtItU1fBw/dmtpPPqR5FRHsuVq8SSLL2ieTzzfne+5wwxZUoYgmuW",
+	"0xg1dAFA8gEUBHAXYo9zTPzbChcCkt/bWSs7DbyBy451EtwmGUrZYbS2lhxHaov0QaVFFNM+CTGsUAY1",
+	"OSfiRM0krjF2S9TidK+z87frGyYvxVa9tdtuPnve2i3yUuCunfveQnr0DR6oeJC9RYE3TES+w9JLD3LG",
+	"/8lapeLO2FuNcpgNd4FTMBxaZJoya3LGSSs+I7ZNdybObBwMSsUl3uBTbkwWAQxdhCUbBstFUBzSg7Ef",
+	"mnnX7i8vrZ8vz882dJeZPZ12bp0w4idbjWajuRZ3LWY0CXouxXQGKAm655drJg+ZGluUkoOjKOi7tmrm",
+	"WYWbs5ToTGPJz1vWhnTP9OPSIZWZRw74nOAAVeNCasHumR9aMjqT70sJAsoYK3TGkyH3Aib2k4thH4sj",
+	"dPUYGJq0n5Q5W8VOorvV8QdsJJOhJwEnlYl3sa9h3QdZB9gMEDPGm4jcmlsnl++1tp5vF3pn8YUcz53H",
+	"9+K5FLI9qeymjVD6LEQDhTM+mAuWT+D+t8v9r5MVXB8ahfDGo0YROd4w/l73LT3uEt7/GvjyXKwCXzCe",
+	"/XiDMnOu6Yc6dS7KOUWJBc71IyO0Q7hIaCBr9qxhOAnqhEM3jGZoJOt7c0I3YG6CwSdVdSATYzMohMtY",
+	"x4u3dmUQAYX26Hh1C7aoOHYhf3+kHhqfRg70Udkfij44fnao59g7/gkcKkfINbwjJQmsWvg19IgGAgkj",
+	"sIRsnGIY9IIPjyckG9ZLiMHI9YHdkLc/koH6aGNRIu94F5JIRncoggkx3FY37K2NkAzsOplMM+u4Kpk9",
+	"FUf8dxDHvwHxu0jcLma2OqepZFBVH2f0gHVnMp0tSM64sz3maRgCPOLsRcW4KSN9gbp1HmSw1pcYXWtr",
+	"n+qjoJ61xKpG/qwxmH9Mb33iCygTaswMQ10TM98oTiHBZYuDifIigjUAB1tbVxEkMAiCsFoAsMqn5FjF",
+	"MsuxmJjWV1XjsikgHE+ZHYVH4AKoQIyBATpeVm8iHogJS9V4HzFSJ6zb0+nag/VHQyBfdYmyivl9Gocy",
+	"PjDoMRZitHcWyESn8WWWhFj9EVLOTC2PI8aisgx4jB+Y2P7c9vQQx/jHDDWIIZzPZzBRA1WIH1D0SvI0",
+	"nl/7daubrHn3ufGoJU5Zai/MtZ3C58xOXXoe6KxDEADiMUqtCkJdBozgrrjxgzt+5C4M/FGHyMrQV8/x",
+	"AkzNQcwQeDlyDGpKjwLDjeC+6XDquHg6zheMTwX9iqlFjtU9+Ono4Jfjs87b47PD87ed0/2LH4/PupTH",
+	"gWcEbglfz1SJFwKDhTOrg4xVThk5TDIXvM214em7rb0ib8dBxMDMoqhKuMIDAxW2d7aMjicH+JA/s015",
+	"NpdjjCDJf7213qxjgBql4QycvjuxPWvq2X39htx72thRZfJgrqWbM1ocRzrObK9ommz7sdYx59imP5FR",
+	"Sjf3RtoVljgMm4VuhmKbFZr3QdFxMIPGxtjK1esiufEQa3JRtI3SRl7A0hT3WlYypUACVTBOycEVBFgp",
+	"/yecLU4OLUrvVMKY15KbULuoPqcFuvvpDBP7Bj/qhqVgyuL9RsM6ZE4fCafRtd99V+fX1Y8HXYvBNjhN",
+	"VFy1qfwVbQEn9qc4K1g4DvOzhFfoK6AcrlhlDu0+TVrzIMARlbMu9iVsqb6ECWwlxqO4r8d4wlu7Fgyt",
+	"gqsB/1Tf+qSxa9Z3Ksq71nqcC057wXSHHJ2vWZLY5xGZgZSnvCC4mU83zNLykpt1T1V3GeNR+Tw3HkUU",
+	"rZjQnTs2PvwbVZO79dOvbMNuhW24p9jsKlKzwgAK5GRtXMsl/Jf7UtTbPoPuhq5dTc5rkBiE8l/Xmjme",
+	"F1liqBYMFQPabRTYpiDXKFoqEB6dh64I4Om+uPb1JZmRXX296wKjA/bXjTlsN8l5nSKGDsqRuOPJKOBn",
+	"Ve40Zbx+t/B9t/D94yx8Vt+ezghLdzCnDHiTW6/kTl3KIDhwIxCnFx0zIRA8TdZH8iI+tigYqAeV7kmT",
+	"BriEuee7jfKzAhGUHsdPc7ip67i+aImzlB/lRgkm+oLgLRTGHliDEBUa5OnamOOpJcF/piEFie2gwlGV",
+	"loZl7K09O3L7/0Cr63ez6HezaA5ET6dnD0ZVzxQHYL6kJ9IbIk9n6sUJJymQFtX3ZhbmleMMeqDxgbyo",
+	"SIVWNA7uOA7d9iXLeW51BQvpZm6GmtW9s0O8jek3UIQNtwQ0ojhq8XhiCazpNj6+ZlKmO83mJsZBpEm9",
+	"qiHayh7FzfLsZ2VBr0XWs7yDOAZi6jkg8JrtaJpzRUEck1nt+XeTAJ0RoS2COJNONgw+nO9C9XehetWx",
+	"pQ/3U/8Twps+fJP+dx6BmUS57k2GPNtOf2wheBuIDQiqiGe7DOqvqqD2QInrG4ye0kf0GKJfWXxWpn/N",
+	"46oQgErCRfJBG7m9E8LxfzmH5kZ0TmMaxUEcxSUyr3r8vJq3tGvymxDYqsFdRGCrQs/hd6l5C1ftg6Jb",
+	"aClMpWxCPNVFKU3FSNaKmWJ2rXjcUdFqiRky5yRIbX6oFsNeWRGq5uJVCBmH38Gd6BHcs7t0jGJmiw2e",
+	"83vZXF5YcZitHtOHOpctp5hidqqtpTToJMenl6ymspQkwtv9MIiwpocnF1BLty7PJk4WInGeyTdVIo18",
+	"cbICcajkkBerKglDLnS89I9AFr1FZxCTetGgxR4kRjjbX9Q49ZzD9GNiEHRuIBhEgRONLM+OZtf+vWck",
+	"zqdhRvm3NN+QOffHysxPWHPi1kTTl+6fjs7+dEtOa3eydp8TQlwNTQKFJ+Ppk9KTodwz8SyyZ0SlmYLz",
+	"Er1ckGRckHHoeMNOfjDxgYHhiNYjEiQjhImlhMNfLygLoC6Q58sYAt1Qw2GBkCqc+kj31BSEY4wxqFlj",
+	"dzSOz2xV4qV1EMtyQFeQgWxztrmNX8uKeVpotUSMkcEmyaVcgQnyAtRSeyBHkbut5/OZEjSQRvC2+zNv",
+	"QcEeMNCu8BsKdV+Xc7oabLqmcCwdIpCJBVjOqfo1faZZ4/wX8JKaNDa2n7327BlOzbBk4pcY+JHa1zDx",
+	"CrQLDO2B9tY4uLMwWIsAQEMVoY2xZghL/vm13/35bbtzcfTq4ujyp077/Jejs87Ru9fHF+87b49edlEO",
+	"yW9xev7y+ORINxfdOQhuKXRTzUIU66tZAxEM1KELIZegXzH8qZxyMF0IICt3OERrgMSEF5CHdA4V5Fx+",
+	"mossTl2sTEMxQ+g4hSVIChLQWYhQHug6/kB8hbnKyMpxNQWmKvSTYGaxlnnjONOIVlvCWZt8rfKteRei",
+	"g3DYmBBPBSIRvE+RymVZBMYhTka90bDO8BR4WHkC3RMgvjOON8J3N9KC/J5Mv3u6LDjqQ5Xd1PnY2t0t",
+	"jyJIikXkdBwldSOyi3bPpbmHjpM9xxzrxsU4UOd+HcJFzejSOlGMZxOv0wsGBgvdT+3TEwt/SoiZHPyk",
+	"xAu8dFwE0FumHlabmTmfZhwQsH50un980nl9sn981mkfvWt3zs9O3m8UsMjO1FQo6KUdOXs7ddjDANO2",
+	"Xp/9aOCV/4oswU7VFestzIjh0ZxXSUsHfw9HF19ygDwZL9Sq1hKccs7yvcY1qdOaUAOjSGcwIQ0GIVfE",
+	"c4QX7I6gn3pitYGO1mHNRFHDIXMMioe9cyPxSKkLrKwUxVqyTuoc9d0ySgcEApLmp+kk4Kndd2cmioOL",
+	"AwtlpQJJbZ9Ar2T8ZsM6kH/qDe0B5/b1HYU3AlMl4wyS653tzhCqGhEqYJND5yPXxHOZpuTP1tChUhL4",
+	"7MCNUG+FTs+xWBTShh9w5SjtBMvgsNzytLW4+Ens88+gsPMPyU2j1DlJzZRqZQip18aBY1k7POP4ggbV",
+	"KEtisMTeRh35RqwhBWp4I2tmSce87TZNln0q1NU3bCCyvp2t1hNLNmEpZ5gK/J7aiwlxjgnJ15kAS8Ei",
+	"/6XW2YhfqY/659fvyVg2wwp/8Pn//b5f/+3DX9uf/9sc4aGM1szS1e/UjvZ9CiGcAWPwAy8YLWhszB4y",
+	"opdp1b6F63f33tfv0HEqgV++ckgZ94K+Pcshcn9ONqa4ieZEgbP+KrT9vhv1Azzn+E48EwcOaqIGGXfl",
+	"gsLu8oJC6AjiLF2ji7jlxZxrlKUPp5ZZItz4BZhGRBiiGlGWaSQVHRbERiUSpLEW0pcVd+5r0q2KpRRD",
+	"sc4jvqhFKoCootIZw5VfBhiF7knoDeR9NZGACj2Ru5IjbhcWvUucTRmbgQY8aNxzqASKeIShjMVdAkvk",
+	"O1SOlL7FXZpoy/RkiyiRr5SnT/ZKbxhcsT9hHfTsJNiHTGrS8f7ZviWba0W76UrZn8AE+vbmmXPXeR+E",
+	"NzVrP3LtzXZwswhgn68irnAi4s5il6G+yfIlJ0HU2fdHjudEpaJHUo4oKdNWgHCVi0OYFTqoVktH4u1X",
+	"94q+4SIk6RpkXPolqYxx4ywa1ikexgliKGuNuSQGbAhsHtUaUouW8RskfwdprqHbQZC0gcYSXhVSIEo0",
+	"dmGFIjhrWL+T+J7ZxK6G0RegB9pC7FyXIxFeNlQ6hduHprN8dNWSvLRasH8gDXJ5Wa7pXksdEHDBdWau",
+	"ExpjZ6yZqHYBPFTU9EVl3KbvcRcdRxFihHjTYfFGyjTYFIiBv9RPimOH3qLTc8OBIeMgM1KqlpXjk/wR",
+	"fyNUbQosTMeskIWB3NO2bmJqU/Pd0oSH0mWMoweWOmQHfJzUoSbwVq2mGd/KfDIGLowA+DvWnwL2Q+cN",
+	"OcstMEn4wbXJSRoGTC7+yPUdZp6l52clXuAlTwPVnTIBYcqC1nQIRHUqlP5xG0kFF1TH1BqEI9sHXhHS",
+	"vW1jHTfd53DmOAO87xzH649tNxSFE1IDJsG2lAR08jetmCr94z1ix1mB/BY+XUDIMIktPWMQlAUkBWEJ",
+	"ZysECEmBJUtKYilB15/OU0estdtskM02EzqVqA7X14P/Wb++bsB//2rVtj5v/N//LokOncDtuWjsTwTU",
+	"X/xT3Z1wNTe0QuPSrY1gRvMeFQMczic3QW+TK3nWWTzanN6MNultdCXKJTQLY3IB8dfNlBBmrEbUfLoC",
+	"vCs5pqpQltQ6EcCm41gw0eZCKWPCr7E+hTc6IQxjYR01Wns7Fg9Vn9X/tOq7u6iqUrH0lLJaOg1pOzFY",
+	"Xjw6VCTmsXkF9VZpqVcLSGbuwMYdCEnLXoSlQ703aCi8yx4Z2MZ5zAagYwdDDEnau167dafXa1lQ+AGw",
+	"7WkaFB7aamDuy6RAqaipW80SQFktOtkk/ZGIv3r70gvg4yGFfPZz7EyaKclaV+KIE5aL4aF+YMnBsMlo",
+	"I2MyMpiJlgCe7xujrjXW3tTBS3OAAh/TTKUtEMaygpC7kWN6ytqaCoqzkfQvKw1VCGRlRlhWlq0c8HTF",
+	"5q/y9WEjl2EgpNOwCmEI56FUpMDz2MhJfipte3rOIvAHIg7B9WZIRvyymoVgZ3EtUJAHFP0pNV7OuIjZ",
+	"QYqnWlPX4RLO9GhyPsTAIh6XO4uqji3j1wLVy1CJzFlIAuWqgqnEBHU+KBMdXL7BIc0nvihySLqcqLeA",
+	"b/FtCawRj0d9H1cX1XZN1dEy95RqsbTrf37AfzXrzzoffjAaLolf56R2YRA/Fqi2pk4APWNxW4+NDkmV",
+	"TV3Yr9PIrOzIqoiknBZr2mvPC+6cgSzbSWsVObjJQgNebyEMhNQsudkLrQnnk+o1E9YwUfwU/jnJu3aq",
+	"jDpVKikddZHcO3+VWtvGNuZfCbIiA7uEWA8ECED2yFCYAwmwVcqXym/yyutx17a0QmTokGuqKp5xdOGQ",
+	"jEcQGrW4Jwr8wNtUz6fg78psNXjuJGWKtlWge0QB0GI0DaFko41J1AtNOPsLVnCAS6KsL38XZZLEncy+",
+	"coqkczqiidECea9Cnf88N8JXcxTkx+cVh3k/FrC054xsrzM2Vkl+nTZPuFjCa4qZYyM7HHhoPxN3TujM",
+	"hOMCLio3qHbo/7OcJrFNIq9fuNWASDG8L0mJEkeaBBP+0sK4huTeyMk0VdS1bOGd8vSELwdMr1T/uQcs",
+	"fbymBXGvyrKuJnVqKWT0HJWGwxuVvNY8daa1u7RCM3XdznQejsruHE3+JBwnG6TbxYT8WT0uI0fHXjnc",
+	"+NqM/M6dbWQDfJo7oOW0m9sP1UDMPsPV+wjLWRYHYRup7ZJ+AunUDpP1C/rS/ykERHadEiQPUadZmY5r",
+	"QlLzx8G3iabBLOqEyAMo1dQU0EO1zrHUyTDItQ6sk3QCNDIPfZ74j0dta5Plk82/3MHnmnVfi8HWsrT/",
+	"UJ/u38Rr+1NVBywHTsbeXJyx/EnbROGSTVHjQnPYbqR9tY/hkF3eo1qMV3diAy8QhY++pNnElIqp3Va1",
+	"ZX2/sRSZQ9ggiFqETNYA4ciJC/sCBwlCUhdCzQ5IYYy2O5CePRhWIJ111/4r9xM6zeiASI9fFJfYsanW",
+	"tB6SaHYCDvk99N21j346/l60MgY3Ss9kwzoAXjqSnYvlTFyScYCUIfY3zxfD88KlEiNI4LuoOp38OVVP",
+	"YRt4KrtTvkn3Ca5WZLaW8GSpQWquysZuVM3oAPJru3zUC8t/SXW+7F3YLBONmadYE5kj1D4B2xpEAAln",
+	"qzmuUUFuWPCjk/jQkKxRAJNVrhkHg20ZhIo7wmsM/qKQQZ2yfIwOBdKLTMUBD+h7SdbYlN6SfjM//sKy",
+	"eySXBCyPYWYYNTen55pgLg7oCIhOYmtHcn+WBdDAvDrmN9PeUiLQNFXncKs0LCcERn1ri+iwvLLSFXBP",
+	"0xhw4q1UUdIGlUzYPuL6dsRw5p7HQciRY4fQCJa7i5y2W1MLT7/gjKaQKufAnYmHmiNZgFYETBTJNdK+",
+	"RZ2RgYbfy5IOOcCFsUnx1Le2tp2d3b0ndecpiGitrcF23YbP9Z2tvb3WTusJymgg0DT2WqZg9ooZUczf",
+	"C3UFwwWNL6Etj8p7mIqq20ku3VKF4WLiKjzM+XlyMqyiEmdi35jB/DYRzKL04ZizZBJnGRyCXpQ7lfNE",
+	"0C+fUcpLqesIIh7YxQgHJc00kVurMuucJTHNLndaem33bOLvokPROUUHPRerpbi2p6mSJfWlVZnS1ba9",
+	"IpIvLG/MAMp6WVwZOJR0nToLJfRv6LiWO3/TDpSPUWiZo0wU1EKkq4gpvLDmvh1F7sg3+XZJwwvmKSbG",
+	"IVKtwk3bMy/vU2OSDhBLohTlUYuhuLASARWXo8dat0kd5+dPm4ruhIzwcx7OjJn0ErVmN9dFDY+FQq9M",
+	"nM0NfCC+yoZeYM9MN9nSLlQkeLGwmlhd7HsXr6jkTFXTxVefDU6IddUOXRbcbu4PKHKLUe+1MleqPdR4",
+	"vorO5yptHvczaKDstGTNVzP7KguqMBGPwWQ1n/A97+rz+1faZV+z1JhYigcWBK3FnG3FatLX0IIEnN89",
+	"WL0ZXxD+AL46GkssRiOsactoBxHwbUanMfA6TmJHZihqZBOCASOCuaGaBARDcCLybkpwSBJIMQoBAe0y",
+	"vuMY+g9ZFQq327v/p0a1JO54/z5NOTpit/l/NPdyNlevSGhQEBOWuuVSrDRnz4zsQ1nUQmllHhVY/kTp",
+	"Y+ElHoT2kEp1gwLiRmPymAb+KGCSRYlK+lGTi0dzHKsPZhaQOn3r9MZBcFOAxKfF+2QTZJute/hrUZNE",
+	"LzBmyS2KnQAeBr+h+5Ybk4aDJo4JxpY2rDNUcOCcup4w54RoWuffCzN6dx8Ue6lPgCEQDXNYGKcghicK",
+	"z9c44BaD/EYB/BrNQSmEr7p3vDWJ5fqndvs1nIvtLhmqlN8pxYKshwMUk7rxspAH1JU9pWog4CSXnmqU",
+	"Q8FKpZuCKVfYLxVp0hkQ5fLoDRCT4vfSKej21xXR8Dw06MBXFyfMMEOn77gIFWAsfIMGHubpjA6ArhR3",
+	"6LIrWQ8EH89m0+j55iZuddRQ/KTiqtGEndAtDRLBYWtRfKVFSaRNLcMakltbXdJv2hCZ9e8WpnosUz5A",
+	"WMvFouQtpDGMKGUfV47BMHQoiR7NvmtsR02fhPi3NIG+CsJRMHsNatUdqOm5iViVspDEsbb7fbEjSf+x",
+	"02BJJ376xs6NKk7PI++mygX3VsEXLNGqlsC93Qk8Wcoknyl5/q4qeGlzPib7rVgNiR4Hzfk55xM8AyKp",
+	"DUIcD9pCi91MgMvEOLVuFM3NW0fNO9TcZHjIvlSEYMVXhQDxtWCFBnPKuakllsKy2Q1+HE/7i5f4z/j4",
+	"p5/HvcnFbe/yZbO3NfN6o0Z/y/N7k1fNwbufy7e1CCf5mM6yakc5uHyTv790yxYUtw2Du7oHrBY2gFvm",
+	"lrEtJHlB6nzp4EutdVCi7Fv4jJeMrrv27EExkkMB5vwRjlLSo/ZWwuNhcuVhPOdoVx2bKUs1wV22l1a9",
+	"Z0diIsJyKlQljLBddz5JcDsumqRNb7vUhIRdFoNhp82dPKHySPoQgbDpKhU7MQsswfxzHAhGPdMd+Rg3",
+	"3eFQYpOnmmtFid+5R4o1QV4wwfIs1LWtBytzYDJe49RW9KKrOocOPoJa6jKKDLScsLunfI2SygJw9uVj",
+	"+ZE6O0/LViu6cXHCFXdHtLYGc4fqIMhcFVksAH/vJBks/0bpTLc2VB0Pdld48MsG8zBeIN/N1K4wyvm0",
+	"7PSDnBWZAggv6HtWs/HtAks9yZvl61fUcuQbRSDL4T0joOUenwXsVmQBYp5VOEC+7eFYkjDtKF2raP2v",
+	"/zEHhoimBfFkLVaSbIF+ZA2CCSEekbHC9kVoEorg1sP3P73vMzsM/nc0cW1vaab/lqdgZPvaTK7X4g6u",
+	"19JTopYvRGQYCWZCTiMKWUyD6IsQx5OV3w/pqBSdFaYZVOo2McoYGEyUwG69gn/moVNABveBxi61Nidc",
+	"YEnQaTmIguNFM6wEuVBJ0sedd+NF4wR1gXL1HYngW0cieOxk/3um5DOJOo+Qjv9PSmIW4ZIcF2z7Olzu",
+	"o2U1L5vjm2E3US6/KfGfc3IcivX0SkluzWblaK9c1pfOL2sWhoPlM+Go8hLkKq24kJ0hXztR3tFIee/u",
+	"xkGkcWEmnD7hDooUSOTKDeuIXC40D9bvEWQ6to02llrI7C1pAvEuUcL5d6Jx3lZHepDUwQvz40o0dNFN",
+	"WjBn6X/pxJIcU36+rq6VAksZgpYW311/4HwyEQl8LcWyIHQxjtCL7f68N0vJ7NxPIl7ENZxWpr5X2vzq",
+	"iqCICS/vVwcSEGmgWDZVnLPExxYDkZdqxUvEAeX2aK1LsHTB+qvHtCodlAvM2jqltis1k1qaOZn2v1oE",
+	"XOrKI3aEREDTy9o+8smrSjBcEkd7r2i4kwAef5CMvBLzN24G23FzilrFP2uZhZhu47z+X/ipST/F3SjN",
+	"sz0p+OGFxRx0tHEO05jmIKbDUBAQYGj3ZxiYHnAKm1DbZ3dBXfxiz4Fr+TPh3HrOCU8iLJjRGARe97Wv",
+	"NA24Hh4Xwpv7c1RRsV7efEoPCczuEUhVPpvyPdxWS/rEtdoW/THciiASOS+0kvDCisCjHjlcxkC0RLFE",
+	"votn1H19ftm2NnGIm1tDe5P666bLyoP0yMjvVZwdCgnkEGown63I36FTkWo3hImM2GPwIGP+qROOqomF",
+	"8eVcWgRAmt/Q9i2CqPE2QZRHh/IugEmzBjUN3YmNfmZMtjZkl6+qgKzop3TkNM4EBZ7Th2YL4fhVXMPx",
+	"Yiju4egR0unSMm4yj5q+IRX3trBwo7FsSJsSHSc9F/1TsSccxKEZRj2IrRb7qoXUqdV4lixL8xO//cif",
+	"hQvTdZOqoVv5Fs7XGJIIIvN9mrq8DDrTN5VGIcFOK6BpV8wKkDLBN5sUwMsQr1hSZ0cdhXlrNWKqXtA0",
+	"qRSdEU5Fnm9OLl+6iumXLjGaNi2UIzUJKCuJDVg5/ztBE9TiabS8aRWQRK3UKh81ZlK2mu1mGaTGvad5",
+	"P8SuvKnnIHSVj+ZbROx6dPBfIyjWfWB8DVkPFfBy0hXCq6LmaPrrY2DnlO5NNVRi4QPAi0PU1/kypeHT",
+	"1s1qpeK/lufgixemLd3dFWEVi1rYpE4lmTsbFSGMS9eNw6uDoclvzlGTJGO7UXqANtMbMIgXkvIIHYzj",
+	"1hGWTVrekTZN8fL3lbeXviS+SlXd8lGRAanDt/QyDI6Sg+mUxmkCcqnj6qoCO0RiB5nua4QBMPI2xGl5",
+	"tuqL+jH9ZTUSPtR8IMrQDYXqFD0uePbfDSw7HcD4HS37O1r23w0tG4646l8ucC9X8SdXqVMpxLCN+1Wn",
+	"LGWPsrjYyPGdMFeHkEMSrb68NgHDVP3oHWNmxqHqacc0DVmmVQ6fYaLiOF+WbX696Px0ftk+Pvux83L/",
+	"8qiDD7pqFawNY7LGH6GWqfFHuPnbu9+a7/68ap3+eLVzdrh/92775WLw6un22Z8vvfPDX+9OXzUajWwu",
+	"x9I32nc09QRNvZa4TDHEl7LOGUZuMCgDUS+N0v0WMZ3idEWj3EZJDibR7QHZpZXtU/lBn4emCE+gzbk/",
+	"SFIW0kMWNg1ZcK9iFGjDOteEjAQrGG9tVfVu6NSx0sjMQjLLWckcby8RcZLqqgXvxAcsuU1KrJb781kg",
+	"nV7L+nxPEZomvYromcNIFlyghTNTQDGWs+arPGA+GsF5wk5TQT73hRFRXn4wtv1RIT6KML4UBwFIWw7H",
+	"c3Uj0AGcbn6sS5E96RB/W+JGje22y+UymnTR40NpZjPYph7fQ8WeqWRpqgSn3CNQA93WzMn17TLdHX0i",
+	"j8FK4jbgdLoCgMqI0AWqQ4TI0ZgIzCOSAyLQLhH7U2a5b7A/eonKxbmBcPFmrMmhlxymFWInlazkpArc",
+	"mnaFoCZeY5g11YwscTFcdFNRnh4K/bUHljNRSiwgmis751depEQ88RWxQ1pb5YFU93Nurs6heW+3ZSH8",
+	"8SN4LB/JS7lkrJR6nIPgZj5dVix4nYNikpgEUVapodw5CSLyCSQ+hRqieC7t+l8mXK6KUFCCMBYfohJY",
+	"F6VGkPHYVTnj9wFpIuz7W4KrXx0208DpexjHUXnO6fBmS76hJG71sWGgEFno/pMg1wK+Qju8ZoYQAydX",
+	"7S0BRc7nPfeFk8uZk+pW1Tl7AZODo4h4WINc9CbW38iehjyAW1N5nJx5obXza+I0yXnRqSkApdKLRplw",
+	"qrRZEVF+xWnN/RVQO8Hppyh+pzyqphSPycxFc89NHgsynWjzzNPbnCHnCteCBMKROOIJwl9BifA4ILwr",
+	"grW77FkVeK69hZL4wS50waGlqY7Rc6TD4IXVZfTz1Hs4G8RcKFuvfRZFKZia5BkGeYcukup6cS+uDx9t",
+	"Kn/Ujbevq6BM0O2CPtlZLDWyzE0CKOIXh8EkINNLysSzoVVKStY0AVRUEa8SWliLEwWIPKcCKCEZvI6c",
+	"or4uczOYbQ5LxXXl2dxwP2VqiBknNLe8A0fsg5hzU2KFEAaxWM7COjUejcLip1/ENQ+MRjZBc3GCSJL7",
+	"/MMPP5QlvZe5tlOxDqsqGFHu4sxWzrHDwHpvT+yBXc0iId6gbHsJn3gTY3mADElsIj8eOsdyr9JRjN0i",
+	"CUgRqqVHQzpNMYLescPIwiRSN07svvYpoFqYEBrWJUbBw23mBfaAPZJwiHDUqeD2YqIsSdYS70d7RjTv",
+	"MeVpOwEXS93268WJWVEekEKkdXJH6UY9nONHRhNUsQlpbjos4V9rXJRP8WbI4HotwSv2m0w4DF0s1Nrn",
+	"DxWVk4QaKKPMCP+xkhwwo9bMgy3hU6klNGRr5RBCSY4Zd17LkHu8teaDREJWEfZYysvFXvm0iJI40L+G",
+	"5HVfxY0nWTMCmzItc/GM1FH6KoC6Zp7PInJxKJNtr2YEE6DiYFDRs3/KjY1wDqu/meQ2lYVU8XKxT46f",
+	"WEWYdv5weouc5DOU9+MhKGNbRXkyw3BkvUUDd+878IGCEkMbLq4+wyWKzP6iEN+19rs6rlJrq7Vbb5qL",
+	"GUthf5l9EfprJnpNoG3G+kMGafN+cSvxEEv2SqrVyXiXHldOJGMFqUhR7zIQJrZkpwoksziqOiXqx0Tf",
+	"HH0dCm6K05gJZE3zdkrx4aELPaNvR2NSKyiFsmf7Nx2iuCFxK0L61rWHdBODBqHGEGmKIiukBi2RCS0D",
+	"BBy3p/9ow4h/yu1/PsFUryILpqhIXQ7DvaTFY/vr2nHuc+0ifoKpDPi3jGUvMbOX3r87rtxRbpBe2/26",
+	"piuEs5zZPuJQPXiSNYuPzDdrfjRa6Yowt8ox+I0Y8Dn2wGJzPDwUuv1Sy/+B2bUYJ2WntslaT8dKxzjw",
+	"WK0q2f00fGB1s2P6kNSyfM9IZzkGy+pmRvOKGa+wMIBrd3LI8fEGYejVgfVsZ/eJJRpaoqVVJ7Yl0p9Q",
+	"geeS8OQPTPN6U1DpqY2xO04dLQoU+0gamQiLdD7NHJ+y1dC+gDn4d3BHUnY8KLI9F+O2dCZ4dt7uvDq/",
+	"Ojs0u45mRmvBT/MJqP/JCD5NPVu47yPYOUTH5qBwULuToqW6wDeOrRpxWs2dzXVKKZ5sGbtCoqlLTJvU",
+	"SiggrVPej+qQHpXMANHMNsrEVxfHViwyS6VqIc2o8WIlixTbYHiY2ppt2lN387Ylq6FyeLIahFpPpPPC",
+	"GM7UbiJUvTB0E81p7oIdc2nOmWfytoyBl9assU4eEQs1qZlZ9FZ1eiD1BPMQluAMaOBVHg3MjKjcxeuc",
+	"26WMAYaFbTCbJ8YvaWQTDV2SGkuR27M8Ais69EV6O4uWqmRXmFicjYNBa9wYs6IkjIKady+ZNbCPCJkJ",
+	"J7n0nEUg4maELXQ1BvFlDeHE2VdRJb0Q33ElWTMlWCDJTAx9l5qaL0hnErJ+LuZGBXNZbgmd2qpzXHJS",
+	"W77JVJZ7GpXua9J4QRW4JYVivBg7BZ3EGhPgL3GMTInFozhdNk2KscgjZp1Db+JaeUV3rVG/uvJdRm/B",
+	"7J6eM7tzhCmlrNy4WvwGxAQ0CsCzN/QHbMps7C109Tf+NXOMk4FezD3jPkwdWw3PqyWh4LjuyfWJtz3V",
+	"8wzhkRklCVqe69+wYtGNS653G9alM7v2YXT9GewaBjOxdxRWtUuuzy45b6GhWlORKyeK0HvyzbC3jlZP",
+	"P5TXflySmjypmGiAyZcBDjpKSqq8sMRqaSuO6Ln8QyKKU4AvwXXBuxGBBX+mYSd1n2G8+yJAq3txdHB1",
+	"cXF0dnDUOd1/1zk/kB8vu9b69t6utDeJYNmNa18dAfIC4VEwVUUuhXdT3lVTCshIzUEPoipDLBmqBFx0",
+	"vE00TyIa8KtbGT0obDutWu7gYZlh1EixEZ5+sRHyeChTWwrbhSjKlINCFXiYthieNulB1KmL0Mefb2De",
+	"qze369ut9tb2891n8P97hhEny2zmJ0OsINbGfLbc6yvkRnkVMUictkQjkRoX9EDPwCQPghdjdDBY9Gno",
+	"3LrBPJKt9cy5xc/j3o9999z9+fjqz+PWmXscHfsXu/2D473jm+m7Nwc/P2tAoz8Hb4+hETRoi+ytg5Z3",
+	"+tFzT9q/fvrt8NfZ+3b/05nbbJ4dvt86a181MePr9HDfPTn4uem8e+kdfwzc/uTNBP750z6ATiZvdrCT",
+	"0/b75unhze5Z+/ju9Kdm49OTj09/+ePd1vvt33bs3d5e/8ngqfNs2By1xlvu9sedm11vb/LEfxo8mzZL",
+	"90FfRPNesC/5YQjSKZzojfvB5S2bamwU1F6ZhbNg7FuHgVPcy9ZSkH1xUZZ1cVqtp8gCQ7gJQAbaWB7E",
+	"r2BkT1cK8cfJ48VPoZ/hAtuVAtnFERL0WjORRU55VSLfuevkr/aZc5fU1qmw4tD+MRZ9iQI9zIaGVMqo",
+	"boR2XK7qzjKVqXiYNX1NzVtzC00v4RBj7Fm+xyCkdlUKlIhXWeKJ5ax3ejemAV+ChLwPqukClKbo5Rz0",
+	"JBP0FhmCy0gcXyWK2B3wA2zeCE2mZnmpogTSo26Ta7RmXbUPipy1S9WZSy0JD6gm51S6JgXA1LnQNKw+",
+	"5zjrVxYuIGSnDhDy3IgScQm3hFxjXBuM8BOLjYE3lnywPCBaPGzoApaKc0X4vTrW4Iu4NykrR9SeDKxx",
+	"BFMle5+JTg02P7I034dS883emXWOe1EWJo+M9F7yA9fyVtYcRTwoCX7cygGANgcvxT1JXGWGXaHSalx+",
+	"nOJVUbWvkTPBMKYJ6D/4FGftpurnmkYDjTtsw6swnolMjfW1kPeCaHtjKSTGbM3rkFOexXpmkJu0GT1Z",
+	"Jg9qH/HksQctxaEcYVwOV4n3WlPXLdlR2bWRCB1/wAj5laoMAMmX5X3OREyrABCWHl7U8DFCsIbbES6s",
+	"DKq7HYdfI6EQWh8q4QtnpoHsl/K9TDqScc6/XhxAV//AYjXJ5NQNTd0/LpdVD4NbKmGo7y+htDm0BQNQ",
+	"ZTq251Fhsca1fzy0egHuVejIpxHkOWlozewbOJBTTNYfoB7MD/kO94hwYvFjs8SZJAADIgtuN+sl8C8x",
+	"dJMFgwO0sZytFzNGGfUh/6oZ1Sf5DJLoPHJUS1j8HEm65NZjN5qWppC36QXVGqZaUHYUZx3HvGsWNKxj",
+	"Lm7HAYeZZX8A9QNTS96mLZUw+6cBx30uxed5+WlLDaud2mMruKVkUG1JGmvG+NVies0TpdI1EYpvsvxa",
+	"IHJXRGELDjbGJYpWVugjy1zMuzIzzGbnaeG9kcQNlKcDKT1kahTIRNbCsgRCRzEI+6TfdswePVZ+yWXH",
+	"tlZ+CzmJSbIW5iLl7N05PTI991xWZ1XLc2/NCG1K2cxFzi8MHI+0ASTldakEFxuwhioPUq/fPIyVgXPr",
+	"Gh3GIP7U90dOInP0xUKg0CAnroxHgnkSocn7TlMDToM/Xc+zN3cbTWv91O4jEHs0fmEhtJtnwRfW+aX1",
+	"zmo1O63dzpMNa38Kz711er+4s8295m6j1Wjt5oQyAY1ExfGYsnpAyuA31JZUvMlQAr4pQH93dh+MkSHI",
+	"sCTA+Vlva7jXbzn1ncETu74z3O7Vn9pbTr3V3x08c54Mt+29ajoTCbXFayOnL3bVOP0qUIrmMvBYhqGg",
+	"f9yHiCGWyDMhpHCZlyLGRtnesUHWZIeNB7q99D6ZolNVnhCfEnU5U7PTyDA50QV8qBjrQlpBDNJ1n9Ls",
+	"ZIMa+1jw6vLRgURVL5ZKfpd8sSzxPR6SeVIzsgHAecWq87mSd+T0Q8dADD+d7h/UL3/a39rds7gNzwSl",
+	"C3ckUEzUgvfSydV9Vz9iX+wltLNB6HK6ou5kwzpDyTzGbtJ9yHdj6KfTZLSTJ0+fLW8/NmLG7feiwAOt",
+	"2cKYjvVog3DjiGlqNRxih7kMt+AqDwxUy85ddbbGaBFc6EgDjWOvdDZIREO03HladgJwYjW5VcbdRhBO",
+	"EVByIC99Y1GHcntfXMEicVeTCu4IqM+Jk0H3MKeWm835l8pLYL0zhv19UDQdi1qZWBhWejFavNT3ZlMf",
+	"zGU5VmMIS2+WGKGeZxivvGn72nfBKyq0cyBr1xQV5xBNcsKs6h7Q9ECrgnNDfJ21gjiZNB3R9WUdZ6eT",
+	"38bvts6C928/Rb+93fV/u4SXT/wAzn6RSGGuvCBnSq0S8ErkSBEVOIqs9W1Q+/5t7UqLo14i3QzVMbsL",
+	"Olz/qJPsb9a2cmcvgIWAOPdCqWEk3WcSio1Q+UzVh8plwrQjwDCqmkIV2mIVEtuRHwaexyFHedQWzKY4",
+	"3g6yrczcxY/A+SyMs+vDNZWEMDKz0tyGcXOsSAW88dcL139udCb+X9sbBSGQ6uTfcAe1rudNkCYG7sid",
+	"Rf/e409084f/5rfwVzBuNxj8e7vJH3kI//755eXb99uHr49+ev3L9ut3r9Of15aBbn1pR87eTh100gBZ",
+	"y+uzH2ObEkY2KKulztx98/L84q75y4+jYB/+d3Z5NT66GsFfv+LHI/jvKfz35eT2MPDwm5fey9M3R+82",
+	"Nzef4qc3d7Oz/8HvjdGbORc4jnR7Kx5p+xyDOfkiR1luYvtz27McrKpj0XVnZSp36Q7XpZcxI64IitBX",
+	"qQjXMCbV4npvBSzxIMUGY9hIuNKU45g5it80NzSTpoTgop3WirJld7aWW5RNl+GfPmk+3dLlle2tso1W",
+	"eVH51r6BQztc5O/tg+daOqM9TbDcK51e5SnlMVVebiJ7o8/MH3lOHTZG3ZfohYjypVjCIBU2//ua3esP",
+	"nPpwNHY/wg83HlBPffoHah1LIOKmZqqN0zTjK0JdJD0jfwOXRNrDeEm6OEXyScNqwqmdBFJQJ8y6F4jt",
+	"J1BbqY4u/seD96W8JgpGR/y+DEhXMd7dw+oA6WOh2NmcEkCpatU5NqklEuGQy+u5klpSlSHlTQne/X2/",
+	"/tuHv7Y//7c5/UPp3ux5Vr9Lzc1Y9BxtyGakeX4fiq4ExUx4jyDcgS6JUrkHkgMppVftA+Tp7CRsVLaI",
+	"DJ3SuBkawCuHrKyeM7K9zjjwTC73T2hwy/jtKOA+5k5w/yBzwnyTeThyBJgxV0NgaEkKuQTyNlm3YQAB",
+	"K6AmMkR4ONjzuEl63StHXGmx90vq4IJ/RB1xDkpceSQn87kwnJ6eA7voCOBW21d9u9mlSUJd82bEcZSP",
+	"QUbVYNBpFAoAegyLxRBNQFdzUxbTT/i1QLaVSDEoZmMml4N/SEgosmokwE84R9dUmpwVBGSs7BLj7oGB",
+	"DTXm+GRLKR749MleeYk/EdZsYFH7Z/tWHPWcmFitdcL+3p/AjPr25plz13kfhDc1az9y7c12cLMINhrW",
+	"FcooWH/LjaaevbBkxZdGtXQbvqUMFeazd9XjlzSrYQC6h8b2kWYHv6U3NKxTPBAUbaC9it4BXHUIG0AG",
+	"qBeWvKnl+6XKGTl63ZFqZdJOiB2YUQOSpbxP7CiZHNQI+IcXDCvhQV+rUtiK6nLBefCF80bk6fQ9goAi",
+	"8ZbKdOFF3lhVoa7HLKD00AJJWm2kS8d3YQXVEkmlFPuNl0yqWbdu5OLWkVxfWjGpkDbojY3vRZW+F1Va",
+	"qqhShapI61NKaIP3LLTySBsF9ZFSCk2Vckn/sVVvLhw+BOl7AYE84YEXXB1lSpDtcLcmZ36SrYCDE/RA",
+	"F67P9Wo4qf0o4WFJVY6tZpWIt4yQ1YZx56eyDgw3Mz5BsUGDVF2fx54PrJiLJGaKDUB0iSg/VOkFCDlo",
+	"fZAyGAlumfDDmoWMTG4hdwb3Pb8bg4D4lZNMJNoDC549jE4NxZnwjkrVRQCVQ9pYRJVOl4hWO4pMlxVk",
+	"9aUDQUWFJiF1NJsbjIkqqppGeOl0ecG7S0W5qVVpmgaKYZNUPhGL3zU6BuV2hpktg69/LvNMk7IiVqmX",
+	"OB1/HTnEqZT6KknM2ZbCc0Fd3NtZW6qauz6mfDskJTjlBaTuzyxgmqI6AWtTUk2R8aIN61xEEisoLIRz",
+	"PPfFtBqZEzpwMPf71jZWFDqMfySOMWekN/TG4r0CNKL+POGfKGyySqTY0jlf2WWLmOellOBvr9S4ssj5",
+	"kUvowUIZ2VJay4g8xifKLdudtMctiowT+eJ1ufX1xIGtXE3GMOdbdFa4TgmkLJEuQnzI5qI8sKONPEGq",
+	"dv0bGWCvRdFkCbu87JxJiyfQxOKIvceqvr36LFWjHXWp060FKzg+Cq8FG8ohCtJSC1pa4kYT6FAGZ2Dl",
+	"KoHfYlFFI96lODVlua64yiusg0VMt6AAVrGkJsthnWLrZSsBxfRiPk60AknUNDFTdNdLMwRXIxwO9RBq",
+	"9ecMFQuMLFX+qJQEZAqzpLD5VL5DjHgOEpfA8lJlwRQ+uOC+ax/hVKZ4KR9q9bxK2VmpMPC5lrwjBXUu",
+	"n0+sRpXhxOlONZmnDVIo7Ij8XOK7LQWqM29NHomLRK8qcqHcEdQMMiDueagYRn9CSGD7xbhu3CaBdxH9",
+	"ExCSTP2h8n/3KD2Vgf03HNuHLYsBmL21lGysdl9L7VKygAX7H8PXZTNiGE0/c9GR7Iz0Lku0clzwWCAO",
+	"6lE0eSltMTS/8fX1GACPQQxla/XVxzxXDc6/HMaE5pR0b1wYit4gkSyXVeVhmJBw1BcicgIZEItEwg1y",
+	"q7bLlud4bBQB06zf2h7GDnMIsTJvxXjPQfcd1x8GykfxJnJrKBZ3jRVmIYI4pkLadg1qNKySrFkcW4A1",
+	"35rqKFadYYEA1YpkkDf9INuv5efcxBOr7gM5pAdjfyQXZJQB5CDxYtjviO+jXeEQqcW4ninXiHE54fbF",
+	"K8h9DdLN5drn6h65t2LtsoUv1mX/L6yUny7GI2NFdOTC3w/31VWUn00DXrVnJnUY6M3G8ooR4oy4s8Ul",
+	"3gkiZsuxQyfcn+Ob5adXcu4/v21nEkLhu1QumAZmlIQKO/5gGgB/xzxWhsCSbAJ7C0L3T+YTnENh2dFz",
+	"q/uS+rcwznW7T6+nP50uZbPSVUY0Ts0SmsdEBZggpeIzrQuTlJKQvBbNp+jj+N8E9zKRbzja1rrkJplY",
+	"IBFnMbF9YK7sCxKJqDGI5SKCS9jaf3187V/7//Vf1jnwwlvXucOPeOhFD9CACuRQAHXojBGz9VY6xpT3",
+	"S8gcPuys+USJ5wzX/vm1X7dYyKLh8NOCSeBvEjEpFayFEUfSteDE2bT4QBtPtpIngU1Hju+E2EXo4NJQ",
+	"u1PuiXRnYYTgxkqMIqybWIn9zJe4HrgQcyzuhfQktl3kaCG30d/UsCQFEeAGkV0BLT3HTrpdIBrt1+eW",
+	"Rl5MxB2FysRD1/4PPxDkl9UG8oqe//ADTnqfaZ5+eG4xqheOtBXH3vOac9pfptkTQliTS/L6uP6KwOGA",
+	"0zpeMMU955UB4jifOj4ujxQWBNAw+t0iCaT3ww8cTmldMoQsiGLtECZrrV9enrc3fviBVxH4DL4JTwNi",
+	"D0VwFi/Jf0ebXpO5lpeHv0Rc/kwBDhaCI1kLJRXEhxz9+trwhEk6sKduHd8NT3QbYroXSD8nGN8IbfA7",
+	"HJMQYvn9+O46RUCK6oshnwi7BzTS4BfQzxYecORO2CcVOUpguUMh5QsqiOiAdN/V8WnqvU7/7j4HAqbo",
+	"n2QMeEXcuf4guMs8cyFrFsNz8d/Jk9CvDHXJfUHkYKdXvvtJMQ7QXcRzIigmog3gvJbMnqdF4RYRIgUw",
+	"8f+uLaY1CPrzCUdGBf6H9cYmfBERbjI+3eGnG5PBBuMBYA6S0IME5zs9RhZPGWZxvhcIBz5DEzeA42yK",
+	"h6JNbJuAIa8lLA0rKMkw0rVWo9loYjt8DYwEay3AV9sciDmmW2eTlPBNUkHJHzMyhfr/6MTBc9BsLlNg",
+	"KAuDiBhIeg40vrBQB0FgBA4mmzjhSMYhvd8/PUHPlEMc6hp0ols3DCjQBIg9dImxIjYmBvFj/S/QtcQZ",
+	"Q87Ewf01CgHp2RFz2gtngHAMAq0qqjE4JXBSTC6MH2GxBP4mM57tRXFN7zvOXZRpC3QA2FHKeUzAh36/",
+	"ODrcP2gfHX7ovhDtpFMqlDAf8kkR+U9OuAbeCHGHmDQ24NNx7ctery5O+NBxrT04bkHDakt0T7yz8GDB",
+	"HT7i7B6KLpxPgYAuYssa2aPRrsJkhdIkbc7xgLdtHxsc8O6SukYHk7Z+q9mUF7QIo7SnDMICz29+FOge",
+	"zHzKdFqlm1jFJzEg7YUekHvKcoZDh7NaNZJCYt1ptvJ6i4e/eeXb4kIhqwk8tF3+EJzpngu7QN3s8uyL",
+	"n5ABNQJ/XRHcyNyjimy/f0B7jEAcF0cmb5bSSS9NYB/wzZv2HLSCOmx3VHgOEZubjHSwjJ7AguC0BHgc",
+	"qUUviN2wjshZ3BeOlZoMACbxwwEdgGQBxv4UCLca/pCicLhKxmZsiZ/L6kITGwXLWXy42MeFR5JAh9i9",
+	"Zb1i3zRC4oYCdp1UEoF8G3/nDrp4/4wE54F7bhYwlDv612Sz6mcBDaz7uEQnuMDkB4ZThiCAtJUmOkia",
+	"oF0U7Vj2hEx0ZY2dUG+fNkDIFZCzkPDwmHy4BtdZuEgEYm2RpOhdfh5xphLWHoUnJN4KA6FIxcJhkGU7",
+	"GURZ5uqHx2Q6Yjs127mB61wyyhQqezhVGJqDCazxgaEcNVTBiZFUYAsv7YFiQv2HMCzClcmuSR6vEimm",
+	"DqV4kv0qiIwci+VV1LSALWWyBNUIZVZjKCuew8ZdhMUcsUuJ8bVQnUiyPLuUFUr6jpokSQwHf0npL5yt",
+	"FTVYsUiSU4VewYkStyArcABtoGp5SYAk6Wd3QZ19YULFdhlQLjYScfVlMifF3WCjpIgSDLGbStclo92i",
+	"ix3w4AhkfASCrsxT4BYs9qrxXA4VoxHrSgOME2Qp8HdG0EyO3w8XaOdi/YjXeLe5baEmgmYmINJ4+lQs",
+	"Tz6C0t6Ns4hnADcZviXDZHnYcZra/SQOxWSlJQd/w+m9cTbvKhNxZd5teWLs56rXQlFmtoFxJq1ipJgv",
+	"x+92ms/Kn0CREwhodl8GiU9VGJg4IMr5WI63MhTsLOEaCVdQGSw+m+KvnDecy14PRPY/slfmRMImLVQR",
+	"W+00QWwg20E3nZ6MZoJz3xKgjLWk0IAwB1G8deiM5p4t+Z6q9wi+SjBogqW2Fe6+V6fzl4QC1NTIa5Fy",
+	"Snw4J3G4BlKmC0ohMyFYXGRB2ONJ0L8J5pKN75PmuSurLguIOpEiktiIatZwHtLNgmHcoLFFYiLWztYz",
+	"qx0EaFxbSBC/yCRR4grovI7avgwGi+XYnJJe/i2lhQuWJjKal2cyWk79Z904js6Oz48qG87GRayNxiZJ",
+	"HSTDyrJfyquZ9BEzxiX2nRf46mz/qv3T+cXxb0eHa0ntM+ls1Y4wx8wkZb/i0lwZ0A8ZXgCjSixFGlvW",
+	"rPZFxajmKWZebQtSheoMmyA9rMgQKWNQQZUhAAH1DNMKb1W4E2KD39Enxj1cjfSsMXTJd3UGK5e+iKGz",
+	"CFfE0UlC1AU7RYgUOLMlkAQkrwpnBWjgaXHVJHkL9v2SGW6ai8cmXfLnoE+i1bQiM5CAeGZBt0MKU0AE",
+	"RnJs39iOxqLcC4uoJPpikIXI0acrAIndsQdcCSgJJDMI0HyLGVg1e9xXw6sfyBR1NIqVcUVlhDr4QyFw",
+	"w/2Hn89ZFd1I9x1ZMmxwdax2WRl0p/yhs2DGFQDvI4J+Wf70hYRXwZGWFl/TZSxyWd4xamIoWyr8ZGqs",
+	"jhEoscKxAZLCCNjIX2O3GTa89rebUtZr6CxMwqqiaHsnIlbhzajA20kcMinv/NIoSNBArn3Jl2aBNXSB",
+	"zSLmP0um1Fz40YTLV4jF5/NZRDDVYTCY92PviXCgRonADsy5S1Nmd2j3BX6jPOWSQu9jAtC1r0jeGZb3",
+	"ilb/dVJDZDmOV40t6J18JVEvPYh81pQquRJXgb2v4e/vedq1wy2mQ14FdXEKznWJSqpEGdChTg6rCOX3",
+	"B0lfaaectPyhx4+1zoYaBnDiDh103BojARLdzlp/1mxKZL4NQzQAxwBY63vNnadaS+zqUiyV6CRxeese",
+	"8V6IURvAafooi8zg0iXB5xW7jGOlklJ82IU3JFB6fjnWdXOBlZIfvm5JyhS17zCJncyI5MvvkQ1OrAMw",
+	"Yb6JU+EcYrTHQz2bYlZ2G9fQzCcV/NARaLz0Kpa7algClZaaVHW5Q7YKAEmhMQwKKDy/WixGLC0nMUkJ",
+	"SmT8FrbjLi3bkSZHMe8PkOpkaFJe4bHkEkvX5aosQj2ONqzMQQ2j+UKGhEVv6xMZEnrbP/vv3+5Oncmb",
+	"xbF75/72bnwH3386+/jr3Xn7pnX6cf9u+GsDRFFO3VbhNp9h3Hmqdt+3V2Rv4Ax3dvfWRDkvGUX5Usa/",
+	"zUWmm5rblpdfUkZsmI5UNbkom1YgoCzUrAk1YcY8qM+fa49oWIEu/xEGMZVqGdLVBOAqDvOSilUWmLdI",
+	"gJGW0xpKzXR7WYLLkzAphvIgf+bSKvHx2Zv9k+PDzsHF0eERHJv9k0vVmqWH0xN0XCyb5tmz/oa2LEWi",
+	"+aYsVqpYRuJBsYQHSk2BwubLVKgoY0b6V6QHJbNUp5RgaHDYqSJy2BQZhbgMVAFQyCcU24JPoy0IZBSs",
+	"XozRCqx9aWLhRfyULhjG6pU7mTgDF8brLaRN0Y79oGp5CApk1H5vG8ZJYWd1tLOQQKQNmd9JJQnlHEMK",
+	"VrR6HjyATVT/sAt6J0LYI1JuDC4tHCkcEir4gdtzPRcTBcQUxa/RmDJ9KJBHWtFEv9lW8BvwhT6q00IM",
+	"m9ojJ9uOXdmI2xuLaYofyCyDAcHEQthDpJg4b0cP2xAiNJLlMhIXtC+5rKhiX8oNcA/b0qNHZ/BISw6u",
+	"LJORe3KBwVAgFsrvt4Z6yBQxQYEaxYcYCMcNGyJMWuYXSPLBtDO8zEQxqEzNGnGNiiOsqWZWYvMTdH4q",
+	"UkjkeEEzjL8W4KzCd5D+WsSlZ86nwjeCmdrVSyoLxiPNzFiYdfAJ7urcS69JlnlgBVrxNEGXcOsUinwk",
+	"1wHtXnDDOH1lTAqrJPu3rNxCKQm23DV74noL0iNRefe5On1qdJwXaCeAtWIuouoqc/K7MUiP4n01EX57",
+	"7VviFVwBCVpQTRbPsW8Ej1SqoA3JBEZ8Aw5SHAzIYoB1vaYPKqRJD2jSsp6anCKor9h1z6F2xFFfWFPE",
+	"2CAlkjDFMTjmeu2FAMQxlviBAU9hQEFIqVJyPHiQ8O2UoaS9Lcvd1OLjD1Ey/y46TmUGa6rK/h+q2Y7G",
+	"LleU+ftpth9vvGZr67tmW6bZtgXHou0Evhkp8slXUrUujl5dHF3+1Gmf/3J0ZlK2FM+6xngLdK6k1Nbf",
+	"M4JAn+fqFSkpr6giTaFIxo6gAo8/HSwZIKvm8iniN6d44fQwvl0EOSUUqEHgiDQYelNcgipl/ZUyjdCv",
+	"VAUJb+QZJwYKES02O4hYe3T8ST3klKEErKdoycXUNickzeOSZUERLmDNp3Cl9uHqrsFteyf/FFicnPFG",
+	"cwQ9SH0PBeqSweCKUoh9mK7omL9OZRjb/TCIGPGOgJbUSNed5jNLOmsxvFW4I4Q05Hxyo5nWo5prr0hj",
+	"tKwo9U6EpZ8T7xEfhHzjQqsTiEUvrK6OgtTFUMpFxChciR64sAZBkioqpENRuTOivAwccuyT1EoT+HG1",
+	"AgbCiOI4EdWITt8P6ioyAIUaE7RV9+h0//ik8+bo4vjV8cF++/j8rHN6fnjUxZl2YUMG3RoMNsZmosWV",
+	"g+CbgTJFPEzOkImvBkGKz8JjW+uzV0e+/d5wrSwh//B8lpJ9Wt+t+t+t+n832Yfhm+KYhvvJPoXxPM/u",
+	"JQgx29o/uTjaP3zfOXp3fNnWjM77WqyI5Noppl8oDInbW5WGniXSUBz8U1kS6ivhQquSgo5Mk/qWjM8x",
+	"AEIi4xSKTJmbqsCixTE32Twi6knDwcF7umGdwL8jhg6EY+5hjQi8kYV5KbmQr31RxQJlgjwZIrmQJUKh",
+	"mxhY5G3J4k1Oos21D6/JwvVEcXpxknDTMF6puFaqqJK1wO6U4AjFJcQfks72z0nW4CU1QygVkWyVULdL",
+	"zB5n0oxjYEREr4LmpIptqSi6LseyqS+49hm+WolqC+ceI1RQLkgiUTYSe2LKWokwmpQvjKcnj9AeO5xM",
+	"62MpoWrHhOOsxTP9J4d4YbCb4m3NI2K9AjU6lEw48mwsZoNrqjK9xoCdT3HNJVQK9EL1tcQbRhFQrDNJ",
+	"1Z0igRT1OnEVpPx8bzkpVHcviHriImVSTooLjhNkFnJkffzkNCcLs5Hy8ZdzDBO9lCv0UIum6E3C+m0t",
+	"oXLgg3IcRSIbDTiZvujxm3Vw8cQYq14beZZga2ZwBExgFiVs9ILpJvLML6LesPYV34FNSEtxKXqkTKwC",
+	"T8wVPdL4X5dyx1KeIOldEWTozlJuL7mFgpK7jM7WlcHJKHDX90cEcCxGzy5acuMIbyh6K+BRtSw9vUBo",
+	"BsjTVceIGCM9I9aia8Hy30Saw0TiSWjQYtrpzYFXWNnpSBgREA3PW6pmd05vTZYqnCWYebh2iKET/Ol6",
+	"nr2522ha66dYA2sWROMXFtKlZ8EX1vml9c5qNTut3c6TDWsfxuG8dXq/uLPNveZuo9VoqXE+UrPaq7ea",
+	"8P928+nznV2h7pE696y3Ndzrt5z6zuCJXd8ZbvfqT+0tp97q7w6eOU+G2/YeqnPMkfTXNVvt5rNEe1T3",
+	"UG21rXT6uXq+htiKMmSEff2gfLveb4oFSQ22/CLb/MsdfK5ym9lFN5l+VxlOu/Qp8omBy4xtq+IeQpe6",
+	"O2vkXixiq5aGJBHPHR8KnJEPVYQi8dCDb4OlU2m+1PUhN7KAOthMW49xLc2S+mnMF3X9jrzwrGEqWPWx",
+	"od6EySotxRjYo0rjhIW+KDXSPkBoV5BmH0lkN2DZ3ldg12sUxFUD7ie4fzviN6+QTkVm6mS7eQn4k4R8",
+	"EhC0RHhcfkaGN0xjDPeGdZ4gmAjcOhCzMSGTH6/JwrD4I4heJJsgdpFIyqQCQlzLvIuhYt2apFmYaxSE",
+	"XZmTzz6NiEsPwlee9AJwPgObT0GOkphTJBjN7ji0gzPshZ1FrLLVt0MQXGyri4j+9dNgILwnDCmIOHFY",
+	"fHRGiad4FLvHw7hV/dL1UZaiKjnk/7r2u9vNHQs4kpW8iqKT/CAukFeCfoXpFALGCjPV+nmQa0e8jV8W",
+	"YqrssgjCWeXG54hmnodehd0iBTABqMm5SCDCo5dsjyhIJRL3mFlhQwr18Ukrhb1BfOOG73yadQRdJRwU",
+	"023cYB7x69k8x0lwdg9NVg1BmcQaRz7FPyKZ+QFexDPbY+UOIVMRfmxfDpzSkJEFuv5cBD/B1xxxSnDu",
+	"2AsGPCXXOO+3CR2L36kBY2WQgbNXsR1yKa+kwBG+rqaWtlbLLSep13TROOGkxgUMqSaYFE04i4hUlYWY",
+	"COwWGurrM1hgLAIK3TYsBpxn3YhKIcMf29jdBNWVxAeLBxhdfx4y5S4uES01JV3WLBHOShBinnNrU4Z3",
+	"NMbFDsV7kQHM/dD2b5xBzvIJBOYlFi+BLnNk4WU0zptfH/9Y7UrTiihnuyaYKLFrpB8S3yVWwalPXJ+M",
+	"iitevDqwtre3n5nKjmyRCqA4kHKGHs46eBjMqGsFNaOXGnlcBzs7dIHiLbzN0qqijis7s+1We2v7+e4z",
+	"+H/xzGbBCuZF2oG8VuS1Q8o53Y4eag2wx3DbobIrrjPRHlVm4AoEHYgsoZEzXAFv2xGPaaMeOEMbizfI",
+	"CjZp/PdHBcEjar0nAp4uS8CcBF4v9qldu6Y6OzOM9pxQg34SzBorUBgAjMkAYj+Inp5sbbesn9rt13Xc",
+	"343CI4+T2DaKiXTgaeh4I5OLRL2VqfuMMEAlT78D/CVbLeVP8QWaForCk4TTglo3rBhzM5YvkYnsJwCc",
+	"5VElcOuLsJKEXJjT0J1G4dJFpcBIXLwEkbgbOqytC4mvL8fL3xN8Co76ORqKg75sSzBSLJcmtV1ATnBm",
+	"roihQt1NMAtQ91yMa8DhJvCmoSj2p+Tvo3sxmoliewQg/Huy7Erv0Yf1/4IdECL/5o9Hbfknmiw2lYYb",
+	"YqJoEocVPR6ARBUA2+gv6r84CykPW+v2jC2aW7u7yu1ds5zGqAGLcXV1fKiAh8eB+chxr32YAWJEO4MN",
+	"XMGJfeOo5j4rsocOC9OzcPGcVskWtpBUhgiCBOIC9YLBQqYKczQanAxUSzyru9VsdWMwhpgnc7xSPD1E",
+	"65569sIZPKdaht2aKmoymC3eXte+SH8TlAlrInSXPsxoIOtdx9CSN+iTwP3uXh5dAGF2jg+PTl+ft4/O",
+	"Dt53fjl632m3T7ovKIodFRYNGx1ZDT3PIP8LjrZCZjrILoNJOXgNGxRrB4+hjfNZpS5WHpO0xHVkjFFg",
+	"OU29iJLaRMq9Y6AAoxsVd7ZLlJEQs4rwEYqHRUoL0yx8TB2g0jvo270vqsXMrCrGZD/mBjqpp9aT4UZd",
+	"zxO4JaOQoASF/pdufIcpHjJcgl0wYreUrmwLLvKhQ/ZY5CvXX+S2FNcesRXTdZkYbDZRv4g2e6iFFQHh",
+	"si8UG6MluU8aV4T+Gcz9YymJhFDFSUrMm5dkYEfjXgA3ZoMRshCfF+Gw4R6l/rsxaA1tSzS2pw7aRX4n",
+	"IPJYSeKui28fet+GsMcIiBSt5NEgIF5IgUa8tfZMvcYHgcOCmaiEQhgYrCmiy6oL9wCxAbS6YBHmrsrb",
+	"kfXKmgFiIRrW4ZwpGxGqBU4F6/owyn1x87WaTTFRbBNHw8oJoKKTY7RJ+DKqfdFL2snH4dD07ljDjL4S",
+	"ZE5mFAUYsCnSUdSH1SVAfFveJjwxyoTx/E1Av3OnsVWzhCGUuZ0OOVtXAu43rHN/FMSCaqQEdwt1sxEX",
+	"L7qVNY/cdNF4EnqCYaIJy9h+1NrDYD4aC2OpkEth0zFbmF+pMwR0SGgcIeS2G6bDw5Ph43M8qBR9xjQl",
+	"x5kloy90fd4PDe4L3bdxlGG9lDq+xJkQJJt7HdbyfRZxGR21YpDdw3Rm20rqMNJJyLenm0ir+aXEVp5C",
+	"Me/7Non2i5Q5Udcox7SwlCuEFl11bE/nBtq64irPyEU/Cd98zE4ZYjQB8CfrrCzfjkyRQ2QEGqn2iyiP",
+	"Qt5bZwSy2TjwBlnCfD3XCXP1ogLPb3ll7oudChlm9LXkgH/A8RE0XEXLoIuYnJECfe+BR8ps7MP3k/dI",
+	"r5PJx4cPOoPSOS5F6cmyGxHeSvg9Cku2P6c8PnacgtAgW8FgxkFc20643eBHCm6oyQdFq7h8vDqS40OB",
+	"H5PUmCNY+omNLjCnjqokOaG7cL/AUsi6QuwD6vTsAfoKSWGhITtcE4R5QuSgz9AahOgxYJvj0HEGJDWt",
+	"9wMvCBELFEhjg4QovMFgbLwcahnhqGEdxLUbqS8ECr3Ga5v8dGiljBcagWX86I7SauDwxuo1Oup5Cs/Z",
+	"EaqsglSZ6S1Cq1rvim874tuO63c3aoylf+Oj058LpGhlKK31LoyqM8RZUWvd/T1Enz3HY/Lmr3fvwsAf",
+	"degTPkDKaewjDwTOqVZIV/4oy/Ktd0WzDn+DUQXIhtkEePDT0cEvx2edt8dnh+dvO6f7Fz8en3WxytoG",
+	"um/ZkCPw/EGXlM0Pj16eX50dHInnutb6rsR1RBOu8NFsSP+EMktliLW47Jx9DSObAyHVmUywEsEA3dYS",
+	"/1BUtRJTwwAEZdmBRrnqFi8dqrTzEM2CzpTDRZlcmCiVTq2JG0XxRWatA7/K7MndOLj20frIng9BRtC0",
+	"CZMDAi2pT2x4nfSjXPtaIWStADJ0sLWF8v+lPOgRY6fw0XEJ84qTXyeR492Kkgv0ou67Otcxrh8PEoM1",
+	"2mBlXeRBt3btcyTJzMaAvCnGbQzQAL7xQuCHUOLM8aE4zlSMqiugdtMlyRTOE3sqhAX+zseAPhj7cMi0",
+	"S/c+3Oy08oEfPYq5HataIh2heR03b6X2dUs1r8OJACbyUPu6iZUwqLJOPIkfRE4l4UjqYaWAoGzjcO5H",
+	"goPgxAgMBtik0epXw1JwcMpAC+uWm/lnqq587S9l9beWMvqzd6PI6i9Knr9O1u2xrP96bfUvLCzGvefb",
+	"kuSliIZhlBgR0Acjz+K7+m/hCrg/MKK4qi6Ofr06umyreaqigJSK3shzEbIQfP9HmF/8Q4iIra3tWEJU",
+	"E1abScIqiN2ypk31nFW4+uphIqyvysSBY5GsoB7X+hAzRmlKXIbxinBFyS+vL3whkw9rVchwhl/d5lTZ",
+	"3VPBy8N83ezM4SS7rXvWzXl9cX5wdHm5//LkqIOQo+336qFSbyoZKlIg4SRkFoggLqmWLX3itrZUwBzB",
+	"7UgtOQIxabZYBjhHebru8NMrPIMqdBvMmI9gbz5TrIwYiesy9qLE1JPpEThhJbWPpbIv4p0z6qmKBi03",
+	"JVeFrkuyLS16TfFjsaRPGXso0ysqsRD7rte2d7asTQsmr2pmaximb1vQcA76JfTgOJSI63KyODa1UUC0",
+	"PVoOSnIR8kzab0e9Dmm/4Pspl4h8ce3ToESsNSjCgobnU3xPogYpmIY4MgXyhwMXVT0ORL0+krzncSS5",
+	"MTgbI3za9qg4KPsM9r1+ig6nCgHZUk5UJjT3Ze1gszSfJ8WbTMlSFJNb//jikOyqqlhEjfPszJp0hCuf",
+	"Jdq3jn0j7StBmBR6YnKsixRGDozmRb5fFN4BbxCFaeWG4CVbb9Fo/8Pt5P30Phv51QON5TnsbrM3924e",
+	"zWx4SoY7b5HYI5j3tJrACjUvpbgrhG7GLvlYax6FATynZJ1c+6SpN6zX6jvYZHHrGMxgrBPeuNOpdIAS",
+	"u+a6OuJ7TrpGG03mrVLhI/mrBpcfRREjMrVqBKsJRkvsEZPJB04fGDFekaFEDRmQNBQfjwKxQ6m8rhls",
+	"orgd9IbRiJGcBxmPom4imcEqAG9iQwmNU9ZUwzgcRSO/9ve9xC4b6dYTuky5AhOmyfuR3Rec/0Fc9yXQ",
+	"neCFjxVSkfTwtcIp1BHk83lspjEB1oG/u0zuJ/rJ2CmVvywjAW7e2R7uxKNxRQX1KG1xRZH6VuhEKFdL",
+	"nCmZw8d8lMKI0Sot4sKgxVS4P9QXKpHMtlZiHKtCeDZBBZB1WUz4hYikpqhfHKuNiX+iclAi6MYKQMwi",
+	"ZtmOE/w/Sq4SPQgrLuN+sIVMlCtXuZ0sZWlP7T7sPNVZEy+QvOkj3wQCTlrBXgqEmHHt87tgKCgjvWAs",
+	"ReLjSB7wPlDDiHXCFF+Vmca74q+O5NfdGGBSGJiEPJWoTBgjrI1ya8syqXwP5aOCv7yF9Xk0Tsovvw8v",
+	"bX1JM+JbQWQqKSrYdPH5oUvtH8Zd71GS8rtx5btx5b7GlbvsUVvmii1LZhep6koqmq0JqFrcAbFX7fpJ",
+	"4snQMTmfYpJvRGm8VDZvkVxmmJqmpC3dhwFjHo9gTl86uTvl8cA0ZTKFWyJx1phfCa3MWYpriVMPwWZq",
+	"a44/n8TbqXyvrHWH3vpBzfVMtzakaS6RZ/7h8U0xD0ySjKnyP8n/8EVyEs3n/QsaR6LN3qJOloZcfkX2",
+	"rnhsSshIxM59fNiaOAh+QAK+KjNPatbYHY2pOgohjF37SXiQ1ACE8RXODvIrxh2ScSG/XqBRZFiPBHxo",
+	"3HeNbQMS3gBa4dzZpIvZ+vBQR86xuzr7afRycUmr9fiHVnZVyX5qz+DQwv3KCEffQ3XzTZAUExWJPfxy",
+	"xwwuBwda5B2y8ynVJCCYXCesXyKNHkm8AnyS1bbpPBoTaFdXvLgr6DnO402UWIk8Iw3hoiGii/jBHaiR",
+	"qFxjaj9KDFL5rCXIeRENxZJCKmMxDeyZTXnz0JeigMKAUtpL1/r58vzMCnqoIaJ+3H1OQWB1G51KXSz0",
+	"OxEPMyAx9dmKXTbXfhSIOYfBJxcmjU9L8drnSlGUussDE6uEcTUJkrFEDhy4kXgo4qgYob5Hcxqe9DlJ",
+	"gRVFJmBMIpWbAQJpLaLxHN4wAOYh6jVxpw66mFilx5w0aIRNxNa8EIOIklJ0YiwSQSjExcRdFZbWeMUf",
+	"yLUuaXSK4FbCsRAkhYm3nlBrIvWIVHJBeNc+ksJz669rXRy6Xnt+HYNhtHYR6a9FGH7XazWtaW8BTeFp",
+	"d0CP7O2Vw8PTK1AcoyeIOV4D+7iWx7fDART0KwfU0hM08I7opwoMPT0l2j99WrG9CIClh2K2nDBgaqOI",
+	"lTx5skbRIx+Dsa/C5utzlXD49C2e1Q56Vxl147P+YjnRJ0+qDPwz0U2BFywjKjLJy0x0Z/BdOlwaZO0L",
+	"DfuEMCNpvyiiEhljDNwGGnjfxno3vijqqbJVN1Kw15e7agV9JLct4mI4tmdJSJsvduNSgUFCrygRbU0R",
+	"2zTu3hyYFXvuui7u5a3tddXUZJ+t2bZXR9GTomFnwkotn4ULKUH0kr0QAhDeOhQQ7Q8oJNiV2ES1RDL2",
+	"6VeCjMEwYYw15pDPfoD3kSLTSBQjAVCkYjI5+BJG76CQe88dibdQzcNrX6Z4xj5KOVlchKv2QcN6KWYj",
+	"B6Y70gRCUBzn9KcTBiIyo2EdSGdh6iEG+CcXZcPq9hYdCQPcA3KJcXlx++jCJV2Dm6BVHYOQ7YGmD0g5",
+	"ZzamlM/pfCYFlSRFQ0RuU0zHvnz9mNHiZSZ1U+7dC1l4l2P1JdSJBayTcdYcroHEjeSarU7XaCcEnDkj",
+	"KaYMnFTunRh8LpgTjzLHPtLanShWEfrAX7XGugmEv03fFl/AlJEsSiW9iICZxD4/MH27sIbLd70KmAey",
+	"pC/O5v/ql6ScMwNSQrwQO9AL7iTSghbVH5BlWgmqwMKogqXHqpUbibj4KAmFUHPORc74QKTqSKVF3Kdw",
+	"Cw+CJCNIhkZfnR2eiwyejUpckwASpX/vYQ426kwNVShLYzcIuBgCIwf9j5UE43nHwiAtsgzGUef/6K6K",
+	"NFk/wqmr5e67KDookp+wktA6Jv5syGtnas/GCkioKzOFE0+qegEl90oVdQteFaMlzufuwHQRFbMLierw",
+	"0PiHv+/65Adu1AlsnnHk+hkuVCMzjERcjZHNNG8T5wuRKg7chjLSoTMGGi7liJaRISqyrBqbW2NcZUqh",
+	"dDPZjbFzlupop5l6DGT8INYpoERyeefXSTKSF9Ba5dSdVfp91d3ELUCk469xJ3yr+CZpWYLu9CSkUqgx",
+	"aUI20++XKTDAcDkmflDdJR4wkMXj5O7zXagUwEnC+YepGLSYJTO+pUg/o/Cmrm5Go8xcofBiFrkUGON3",
+	"Hx82rLcBFkvw3BvH6h4enRy1j6yCi6f7nCO3HkGUXEmU1fl89oWSNaGnh5c5S3ZVl0PFUUGS+zvEQH1R",
+	"M2YKBEGJ+TYwHsmdtJiyLxOEw57hpaNvsAzz4/GZYLpIonKwxJIAB+4OQpBQusrBI+6SAAMKqyE8gBXp",
+	"5lMLIf+tBawCAkUPXBnJg+a+7l+fGc4Xe1NSBjBpinYJFe0QsSZQBkMRkjgGwYqQZSsGAZL2RrSfQb8Y",
+	"GyRSpYIpVeeUbnZ8kQTbr5EQ9ycsUg0xQEQuAUW0MSqsKIOupybUVEUVS5pTWADc/O7In8TlMICYBOox",
+	"zi0GS/wYoEOJCxFgqNe/IgXCmK03NQH9JgqTKcEDClQRTr56Ue80+nIV4OUCAMXjwQER3yMxTXz33wbe",
+	"Fgf7Pbp/SbaXVJCvgofkBaOguMDXJLjV4WjwEQo1TyEoSigjPqMKVLY4YzCuRgn44QmOpsqljQ11YlGg",
+	"/P5Tt16FGKRd+pJQcl5gsxtjJAIuXDblerZLZbaITTqU+aumsP0rh4QkDsUCdHOgJvTdYA+ciPH67MeG",
+	"0pTdRdQzuiLx3TJcjGGC+kEYCp+kB716RL38ck7xQs8ORreKHAutbA6+N4YPlbEdBB8jKk26E4TohcPg",
+	"eEMEB4LR4fX68+ujH0lvkD6h05d0+QA9P/2E/7Km7ifHM18HCTZefCTyLgPqfvPj1BnpXDg23vRc3w4X",
+	"pgBT8ezUX/rR+4na2VM7n/KufmfyS6Le0XErPulpVq/URMh1ZcviC2qlhSSCXpelFKGS8zyx1mmNSyax",
+	"4Mo+ZJQoMXNSy9GMhTZ6q1oBQgqSZI5LhlFYK+x4cK5M7rEBHZW+CusHK0v4PZgyv5iKSmuPcGMVHINN",
+	"NpY8tkGJgxKVyinLHCg+LtIGTScKVK5r3204jaRWgNQcyfw073kuIlR0Y1zsGgZKThNU64yrSd0DvnE9",
+	"Z0jKokwHbFjtQLRP0pyTp2oCzFTGn8zmNPhu3EO3TO1Rjguv27dyji95c+KZvMhuqMq+SBzBVVDTkObR",
+	"9xvuPn5Jgd8iw1dK7zhFlqwLsKsVHO650cVFwqJIOItmwUSgaymnuB94HkULU+hWGnA+Rm3AYWCxQbLB",
+	"MEbgrB6NXbg7I9jSFHgDW9Gxk1vbw8qLiGjAI+hgMG1cUlQmzHFeMxr7sfA4gwjSQO+4gGQKAl8GZLId",
+	"zw31l8dwcH0EPpS4qDfOQrANmctbS4OOxXnAaLlCHiRGT19TAUYJGIvNQS1AkZPk7jfcUB+oYGCMYkHI",
+	"oNOZUpSJu2RMlYZ1cPkGpHRObpvYU9yX+QTrKuGKD2LwHtkzQimKWG76qkRCV3bnFZPc/W030xC7mYlo",
+	"w4SCU/eKRm+qOlUTmxM7GQQPwup9Al0IDY9UU9QOQ3vBiEKk4zNX4xmjg3nmTAx974Pa4vAdRlH8Vakd",
+	"tn4RCGDJ3tz1Zui3GMr10ucNG5DtGIHQxFSJdKxUArNCpURfuOm80XSwGtapUoUR38LHDR078Xg0yGa5",
+	"EInbfEaHsoOHEr6f2J9OHH+E3Gu3iULKDLkdNPt/v9v1Pz/gv5r1Z50PP/x3VoHCevU9ljz0WZ5x7Rk8",
+	"VLAzUyfAmhlDl3CqZH4rjUwbWFthF/rItnZ34bPry88tw1AYw8C01xjg5MRHldaKwWVE+uR6q45FY0SY",
+	"Ajd7oTVhOV4r3/n72iV8PIV/TjAaMKazJUcNzY/50RbhM/LvRNRrmnpa4PCJFJOtLciKmIjCBCVTAdak",
+	"kpjCB9XJ5VSwlN9kiNr1CS+Wu7blTZKhQzJMR0pQJWZZYOQHVQuGP2RPeMXi6utxluI7kwkgWaff6dxJ",
+	"yhRtP8TPcA6OvvK7mYVPvVEc8OxbvhEc/tfphY7S9gm6Pr8Lb/cB5c9Q8bIi3PLZ79qNk01+59rNjOsS",
+	"l3xH+JSe67l4+yzl/rYu2TtF9AIdgB4GV4s9EGBQr0Fpc6wnX68se14ddg1CrFJRdlTWX+soQf/I2uyi",
+	"pHhvIQqJk9+OnKUgMnnBwkGodWudFhbrLKDYqpemxgSsPEABerkWMr/UlVdUxFvd6lWW8lY2Pb+gdzIM",
+	"JCvGoEPtgRKEsiW2BNDSzFqHZevPTKvYpkd3c+ZAPeStIwkDS66jVv86pWf8h1TBVjb6gTAPKTSx1VXE",
+	"NvmLqNPVlcZ+nX71/Qpk/wdbdHMvYOXq1yhkFR7JMvA4jK3Jq8YTOxIpAX0O0jdQXp+wOGMX9pJBcZgU",
+	"1o1R5LqpgjCJSIJOUipHx/BtKgxdN4Fd6yYJ5Ne+/NoaQjusiALPDFyCX4J7qfvqaL99dXHUebt/3D45",
+	"vmz/m1gJVpnRMddSwHBcE+JRymQLhM24EPTftz62sov3rcyRQXC/9h9YmSMuxw2C4Morc5SW4+YSv18g",
+	"1DPdz1cKXlJnWqJMmup086IKdvO9aPc/uqJEFg/w8Or1CQYRHnUoqlCFAtzX4U356CHCn0QZleZuBaRx",
+	"SRjAlFSWlDZ5lmABxqiHlfH/+gpO4iPU0DjisMnM5GsKE3Qp6zq+Q2O+Fd+U8nqspcuif4mSHPfOBX5s",
+	"YW1/MEhlNBBId5msVmSs2eQw3TqpZ9GjOdUvHcra9xfFuOh2WhHVsdJFTXiXChhGfNlf+8A8BRAQP0wu",
+	"AgzzCqnYA1+Uwo/Fpu//3961LbdxpOdXmeJeiNwMKJImZa9YroSWKJu7WosrUrvZGC5iCAzJWQEz8AxA",
+	"iuvyE6RSyVX2NVKVR8ibbFXyHPmPPd1zAkCcpBKvbAoz3T19+Ps/fl+uAh2iUycGed+xX+6C7ZaKhmKP",
+	"94mV1LxNfOvwARZEusvR7nXEESnQ60rvQJrVsADCnunoxWihbLc0bHGHpNQUFx7VUPsdMnqQaXHecpAj",
+	"6tO6GL/lzbGoCBkNmrEVyz5Cd/1xf9NoiuUJmKhw6OHKyWqJtz9foBrfRLPXARvER7UOsxTAcMuEsgmB",
+	"inz4m9AvIUxs2VG6CbWahXgJj1b/nuDELw7Ut6d9Fa59d82do1ARQaOQSMkZXHEw7GX9yqp2jeLRs/0N",
+	"mqFogOGWPMqBwBfXYVqaIndM1XNSsTszWdNPJMKwRqXrY79O+WiWryWq7VGH5YMu1fEo0eSt2nDI2fj6",
+	"msw6ZQ1puBv5INDlZBXBeJ2f0ALG+4ZjImA5M+hfP0kQVBObDkp6KtfOqAeFL2aHurhEPNKOLcobU4t6",
+	"jxAgyUCsaNLvYnXDWzAWFlMIXsXCFFK4Ak32GoHvkQ7J/wi77z22QQpAZ/TrX//ahkqDzzfePTD6eUaJ",
+	"n5WMcQrJJ7HH9FP4qZSJgJxUc1+S1hI3R1kqcgWGsKWjD+xVGVF4wb6q0ho/9E+NQAGzudNX5Ja2Z6nJ",
+	"PU10SbhhnVP2KF7XiVIs8qmQECQZb7yDl+YfbpSuxEldH2Z+KWUnKPlgt7Miefryldp99Dphilmt+oIW",
+	"dlMJDvSEay1BuEpRIMgxkZ++x8UyjGSP1waXzhztY6RbK116AbHPdccYoqC0Lltm5LXmuVjejOLbqBTa",
+	"MiRQDvEACOItV4K2Y5QzXI4Z5sKU5oOF93dIV41Oc7I0covIkJm/RvOqtYtE8bBJMKrd3vjH9sYhm0X/",
+	"3Dom10LrXQwmdA8kI5IJfEMro27n3N7hOYcxgMXdZd/8OXlf+yGnQyNwIJG/0r+Qg+Chjvxy1h9+tDXd",
+	"PMhJclo+hbcJUqBjHtddommI3iZW7EmqGtulkl5tb6/rcKtGlsPvF/h7dTDxK9L8WY/d3bGU2t0qpbYE",
+	"KEywK/jVhc1jfFRKwU6Xqgl6LC/6PNttM+xdPaC0qWCGwXmn7aVFaK7rtmn7Nlknso8tdSzRQmHJydBj",
+	"pEdNz41M9m8DmJgwCzmNFeTAm0E0GinvMWdz8g4SFAirnUi2d8kLnO+FXx5znma54k6Lh0QWeE332jLJ",
+	"Ck1kVRE/G9KubFI89b5u1nAbbsGedGqIa8Kx3EPfJS5bCM1eMc6ULZNxr9TZmqJadYOpV7HdeoSKSNej",
+	"ur2KENKZHUN6OeYWQlXSGFeQYLz42MFJwMzlSzDt0UokDkySCpxP7jBSZT/s/bhNDaEfjDHJ8VtqAjLF",
+	"aBQqEpWtHlS1Whi6NWYSQtMHtljsfSrRrdKKuWtVnuVPwRFGFJ1ca1PHKzmL9wsXtKXLOf91Rh6SMvku",
+	"Vr9MiCiRH0/qGt1IEkHskNpLBiEWMuGb8BIxkWPmCy5gSpcUsnGAWnbyUsNIhh+3mCVkui640HRbRJIR",
+	"pPp3lY3nW+yNJbekDnoT9vsgGYVKlkk5MFtMcCEONm48sLxhTATMhLkdeaqj85OTs2UhQndzR2gLD5K0",
+	"GLAa3SD+eQ2jJQP/MSgDGaSl1CU84gyjnTgNE049O+UXoRlwRnvZHlmeelDT4xoJemtH1JAqSk+E2SdV",
+	"R/Gx6wWLBJDMawCuwjv0M1hnjU5mjdT4FK4iqUIRMgMUTCBWS2L+gReTxMlrPYcncZfxzYK+l93HXcRA",
+	"YzWJLOxUhCkV93eRuKeUwk90i5TfWBHmt+rT5GhJFWuHYhgdm5TJRVSj2HY7dvBvtbCQoDu44t/kDmjK",
+	"oaQoOKPQlHnqGjmLqG+8sLJMUtOkKF5+EkABhq0TyfkkM7/mdS2M/hHjnkSYS5rrQ/HmETuDlRfe57xx",
+	"fsqwSPgFpmFrEOgfaceS4aBfuu29SrgIGNM40ZqhdfORVwr9v/qyRZEhV1ZglmPem6ZQZvNC9tgE9yLv",
+	"EpO5oRjHOFM4S5snZ2+8r57t7LolFi650s4OkivVee0IIrYpYGS8argVWwrev544kcxaM8KxPVWyso93",
+	"0/qJLJ16blkkDsqCfpxE7E8qcEOs0KsGii6SwtbJ/GP6ueSZ8lzqPCKSx+J09CnPKzG4S9tAgJYnCYxX",
+	"dFrNsFRrpwDEttfeCONrBJBpb2AAZ4hcdcf8Lx6f5czblPDC1iE8/hf1Q+fP//1v//r07//530//528g",
+	"RAeXST/bbowIXIgAqaaTkfFY1c/5v2jnVs7NDAKHuOq62e3cMQJdzzxG8Bm7wuUcFFVH3plrOLbsjlia",
+	"O7zO5cE4GM5ZR5Ub/7RRByTCmSZ3Yv2OQP0O4PcneESekAL2hDxETzRiiPy3HC5kjQ2u+qt++AGpEba9",
+	"aTzo0MA3CJlJJ0xHQFGkAh6LjaABEgrrNfv3h16HX7kYwCUEJ+JrUPID2DSdNmyYLJFockbsnEnsya+U",
+	"VIR6aBhnEbpGYESb5EJpi2PxiAFyMcLV3vi///r3//2Pf2tvbPnsi+jwULTPDiK54EdeRqMU9p37FSCp",
+	"4XKMYLBYqyM/aWRctULOHdIobIVrYcenKkCN4pMgRo1R3lDVWLJ0DRoOga2AYEeyL8riHQi7MMZ5L+/1",
+	"PzL9FDQPTIEObAODjZeNEuYqpcTg7BAmIYD1jLpfcwmIYtqI5yaPtKC3KQuEhY89Oj40LSgiWlw+yuuW",
+	"2jF2TNbIFYf4455o7/gKmHo4sTwR8Ew6P1D8yeABNxcXwqHyiUPQhIiC+9hyUNklXSMxmeD+Fd93zY0E",
+	"r16YNrPZSnRLAfTvkL3C3pmciAbHi0u7HI1HTjdsfrxpuiO4UVOdY1IgqF7aOZLOTSwHDV5rOIZ+xTms",
+	"u53dY15zPfNYrdvZ/IP0WHU3+w1LiwpUWj48MIN4cuAfD62CuygmkCs6OLx1GZOVxDGf4729ms/jw/SA",
+	"Iuw6lx8HRGFJn6IOgZXIQVMmsyvRyjeLxX3syUPuZhILmDSP6s3k62ZDk7+47DCPwXvMTEGru8fQhMi+",
+	"5i47n97ccvy5vfEKzePvmVbWU4JZFNrIY8Epl/yLMNP+UpWQjqOuwLZSTUrS3H//jSUrt9AnIrBi8oHP",
+	"bagGm7OoAfbWRajph2vHp6kUhk0WLL9goToaTAoJx+J1pnRJW4+mbbPbdXeVfLqnwT2lFJ4nifc6SK9D",
+	"r2VURJDw3TAUDEHGRgYNZAA39mbxJFge2JnjyO++P3375sXx2dnRN6+PL46/Pz85/7MdS0ZZeoA5d/2e",
+	"AqapGCZtheTwXZiGzxVhEBUN1mCeG4nMt7Gx7CoCztSPEwqetrkZ48JiAViR4b29PDL8LgaxjIeG0juP",
+	"4xEaOVNHicf2262Q315gxPiILim90VCJQ+gRVOBarMnCJCIEJM9vZ5plW4UReFJnEDWagc12HIU4l4fW",
+	"SwqiO2KcWImsmvCmSYTlPF++gwdE40wKF6blvmecJ0UQoBIfn+sVSIlO3oO2Y+dBcSdhBjfcSVW2rmTq",
+	"5qUWSNWCSzoSimRo6S5IbfjRJwUARngo6gueLw/0Ngq8zumbs3OvUHNCP7d4TAgMcSKj03iFxnc1DMHI",
+	"n6KoWZvx0Ioca5aDMTLwLYyO26/hIxf44xg2YcdYWIXYyH2m4e65rRBqZgUZX+WO1pTtVTWQBj3DCvyL",
+	"mHuM4i7BU45vrVIJOSkkdDAhsCGEI74OTO4OU7QHNmM1Jr13b19vzXgR0IZbRMz1p5QcW9t/jYaTKzZQ",
+	"bBhXGKLuFL3yNhIP+U7+5eTUQ4Q2DD/awMAUfRV0XfbQwWvxKL33Oj/bpbH4zi8tHPb2z6ym/NIp1oZs",
+	"EzuI7aJrxwe7e0IFIpR9CBBoS/EfkOHhx81fwZzp5Jy+Oy/R+Gz5yF4acTgfyXba8WkxZ3+xhSHoztQZ",
+	"K9deOCvgUALNK7V1ka3P+8PbF9jPJAeSfjmvj8mzikcCMJ5buUPyd1R5DRpjFfyaekL4r+z2+mHhCVsC",
+	"yKafK0ph73A3UPGY5j8tx5LKl2riq8yVI3WCzt9gWbbkwAemLogWtzw8DnbdZ1hvEhRELOMoGJU1Y+zx",
+	"y5AQUlEQMnWSQWNhWcBmaOa3Y+JDpRAlu7Wt74Ez26OI8Lb3J5FrBZgCX0pgStA6KP9Qae6FIuoseudM",
+	"wNKpIFnE4wX+4wUzWiGow5an0DxiV9lIZqAbt2PC0RLkJb5DEIFO80BHItbmEoEI1MYLJMu7HLUVu5Ee",
+	"1pR5iCMQ4d6kqB7B9a4bTQyNrEgoiPJhb+fLVQ/ttOCZa8GeGTij9Plf2OPxKJBnCzaT+KkXO7ayaYRu",
+	"s9QExbg+sc/KyfPiaqgRJ3H88t45+LYTgXB77weEA6hp3GrxpiFBCyipAuYOpgQMH/UWWvb0bTgqZPQu",
+	"lT+r2NeUVUY0axiJ7WaPluTizg6iqA+rZ3ktiRrc5bLKPAjyjMLaTGkKnaGhOxlKzC72CK5FrRjHeBbd",
+	"moM2KOVImdEaD9sbCOVOPBgluKkwIpwvhI8u6Cwd2ye41Y4Tfoqx6js+6ynJ6OZwMmLYeSAkzYJd5gsw",
+	"vZDdOANH8ugPmuhA72FBBX5nHlzPYcR6PcYQw7kow4W5WIZYecx5LgobuyMTf+Xttg5c/LToSuIelLd9",
+	"R37sa7RVYIUQhlUEod3+gHNxoRdp2NehEJquoDbj52AKg0XTBSOtx1SrQo6iUWuu8JxCt1RJgYu1JA2u",
+	"sq816XI1Y6m/AmgTP9aOzICQtaIBHHmVx5HPLB340slcFaolHMJJEv6B/kdlU1teBAorNcj8hI+gCbQn",
+	"d8iB40y53HIA03TcZ+eDI3oJwjqJc0SYHNQ6vmcZ6Vbic/Nb294xxrXkb0EV5hhNIJRyxFKngVlDeMhM",
+	"RRz62fY65uq4IFOn4131cUEUQ6auiFidrbyQYkXDmvcjzHSMbWt7XjksxUmriP9UdbUmKVw9lHohnJdw",
+	"IdT0uP+IprVmtV0XcBEy7ech/pPlWJtXuPlNnBpCFBf1ME3iCg/0JhIGGGQj0NxvLGAjAjKtrzrKnfVf",
+	"frkTfgU7shXu/eaytb/b228FX+4+a+3vP3t2cLAPv8CS+JMwUif5OAuwuVM5N32Qr9chaemum/OJ0E/6",
+	"xBuSobPRCfpQ7dZfYbapFNvrJ7x/GZ2Y0w+uMU9Y6AEcbb4d/5ReCJbCFRZh+2wo3EVZKC9EqZIbBbHU",
+	"d+eOzjTsJqkShEZc4oa/lSJKlZEibt0K/BdzF+7D0SLcn9ZQ2EW5IsdFlRRwXI80Vx+zT07qjJtfeGGh",
+	"X8wV0m5+6yxMb6NuCLNwC1NHgMkP8P81HM3p/H/khoPl1nYbeOf4uNELdFLibtSPxLnHrzsQSM/phWBA",
+	"STrhh2HuzcMghOClFWAbrDcmOQCNy7AdI9zeCP5Cze4y6AfktnDFj5shPBYYRv0adkISEcyxDhRbtxvm",
+	"YYlrARXQ8QCNeUqFqvwcFEfaAb9MngTxWXAGP8HnE2ZnmLbsMTq9JaMAk98QloLqUAy0RCmCrZHuWGex",
+	"kKCExn88TGHf9S7sN5HDq993ei2IZaH8vqdJOufvpyVHd0NAWf1XVJSNcvaLHY/JY+pdrzQvZ7Lrliq/",
+	"7J6a3a6yGeTDcoavYizlcw05sNvUmSVH+2JZsnhfqeS/kLa2RHqFHJCUtIsMBAHRnBWcpGlzSg3mFtJt",
+	"CFv/mB2eBWcnNoGfcjFKLqApqmky7ArDNLkFNbG3sDhpniCyrDipCQV+InHSxwjp5yGuSifaObPmnE6j",
+	"J8F4RkkaLhFZktpHZhaBFlH0raINVYlDIp4o34I8UTiQoumiryTK7Y2FmyVsD8w11kcr8YDhK+jTspOe",
+	"jH1jHTTW7ISQ1Vn3Rb0exwvXBxoa11UcLN2tRc6kWmrrrBvErQA6u6cYaz2bA/YA66AplPge01wphTNW",
+	"EY9HyJjA4D2oGrOqjkE79B2g5g+KdXAdJwgRrOUqWvV7TQBDjEanxqtpPRiNwsFQ0NbQFYDRvxxQiKVw",
+	"O6YaBFN8S4N8jmpxy+vIDuxIjYoTIyBwY2V+oKdNI1XP3yD8ujiLC+/Bel/Q4ut7+iVc85iVSLCvLJDK",
+	"Q44LcLAXp8jzhPQBicISBriNMmqKehN/d7GvO8kfAyVkzKTAVuq0QSFygpYgVKhww+MPwhXkQvhuBdFF",
+	"kheud3HLxqMttT1wnfEhRkvCc4eBAe9yDJOktpWBE8CwAq7RAjJGzqCZI7ONJ6TcnuFGlpC6GbAOEbqD",
+	"LsecwFZNmAzNwrxf5I9VpN3uHlipu/hHDnm+vz8J9HyZuETOTDUi5XUJBlGebDS6duZCXfucjTYRpfk8",
+	"W0KbTiLswIVbbc3pZDgsJz+MSgBUEOcJOS5ncdWhVD1k6Tlc1NHE7K1jVaD0Ax79CFVbMixMU5UaseAN",
+	"eRde3iTJeyEnUF6poiLOEXTL8yWvbXswRYagPVOdK7qlEC6mTaPXrJcmiL9R3qgvqUPdq3/SoZS2awU5",
+	"uzzsAo5b6t5nW5JAU6BohDxJ1duo0aVdWGeTiSp6Jl7hGOQXtEVZ8nvVFRtFUv0yL1gqSUdNckl30aM4",
+	"qhdHjZtoXsN/XJX4oqWHTN0rS8RQQwwI6tY1d+S23pYCZK4UtrMpzS8E9JnLK58psruK6cT+fNKOi5KN",
+	"5JiRbBIHwMJ7pPOL2duA9ctUO+Z740zbpJycML4N+3AiaGRCF6Pwp2wbtO6QG0mlMcdxCLuIqsU5bYdt",
+	"CXnmCVoV8F0jHUzHcM+cwTvBCCawI32Z+MS7t6/hvRvMvSTfKmyVpH/LcDHjSzhsmBeKqFtYJhkjJkw/",
+	"SYb4mT4SxtzCJPpU0d7CEHSfsbg47ZQ8w0G/NRynQ0yRzBvicEsJTIsMRp7RCK0zyjCF0x9TmizFpQc0",
+	"8LfOGul+EHZWlkKWDOKYuE6Ntmtlepb9NuNq2bQMx/DIlUxrcQ7PKhydKzYLR48e1xk9rlNIUlTL4Kz2",
+	"Rzf1tSZjKTQhiUIyz8gQdIgg/RvV5V+CLJDGnpKnpON777FcG60GPMsEDYHx5sEQ9s9l1IfP2PZOYQIQ",
+	"AFdfxeMkotRtjdr53fgSJiQchdJlnXfgO/6ohXL+8rfTW71ehK8E/VPniRK8VBUSvYlU98IhcnXF3Xu7",
+	"6PfnDUOQ8nyDVk084fIXnNUo4z9+KSFG5eAydmYST+N9Ff4VOkPglcHQfYOBmXdbO1+d7+7kwMxTQSy7",
+	"0FYynmnYiCULA6WnjnhqTAIbX8gs03QTOY6t3uSBs+O3fzx5cXzx7vujPx6dvEaIIhubyBopmh7sFxwZ",
+	"fAIwo6+uCNOtAh7I3tMWGBB8Zg4GpO3bCSlTYwFl/HJrbGez2B6roN9/c1WrN9U5v/3VHQcEcUveI5Rv",
+	"Cv9n1qe9sVHeRaV/+bF5Z5n1KkpTl0EmyEAp4X5Z5lnSUwSmLT1JatWKUEto4XOWwMwLiLWkD0QUp7yp",
+	"jobjFtQTHH+ALfhWPjUroayk6Ib3oTnY3Dh/rCWZuSUtkcqFA0pNgSaw+hmreNCFe0VsmIMc/fNaDDMd",
+	"CYZ3Akxg2fbeZYweCacCVScpnNEHY4YQS7xL+6UmYf2aa9UXKLCrZCHNX5UkHA9Rnl1IrkxVTgL9kPMz",
+	"KzCJfJstw794tjOZrv1hkpHHv7jEtgL+ub07J2x5PkVT7PmiljDlpo+yOsGK0MOsh+iGJ32B97yo8tEt",
+	"Uvew/yCw0BGEhtLAgPgWwixMaTuOMH9EojwM5rntfQOnyDOzKklcBVIPTj7Dtxp3+VuR+0vRS9x/z68/",
+	"+wCwIljc/Xo1Tn5Srs1JD06rm+g9OJUW4eu3znhoROA/KhOPysQalIm3rvyrE6v16Hd0tCtzVY54jwSx",
+	"nepuOYHIiyNIf5S6S7jEBTg8dl24Fa23IVhq9EYOPQh7smOyazs5kY+JQkeZBKDRi4Ww7zdYYsGHi48h",
+	"n+hDThXmYQmZTjcNqSAjgOEcC+Mbhq3hJJLrix5udOq4k0AYKBSSBmlvygKwinrb41njQuP35HIywWHh",
+	"nrKTCAt0cKQ+RSO4KCpo4CzUKQH1yojGhxxKyO0jGcfiVaTp0Ii6T7PL5Q8y3o5JRewQ2DpjTvOvKAmg",
+	"eUJtGAx1DIaXSD3nbs6wCd97oLlkVMqhyDXRqLA5qr5wb4++5IgKTdDrDmcsbF2xm0xceXq9C1MS3rKM",
+	"H4vZm+hr60c4AAQoolXL7tArub/3G/E2dkArSO9bR1gj3qEVY4YlgqBlYY4pztvey3DYTzjPVRfpxdHp",
+	"+YvvjjRzMyUMcvSP8kzT7NCuw//Th++i3nVocidyt+aLYDjq3gStc3xDfZpSvY+zwszxCrojW+ALC+JM",
+	"yhQJBa2kEfAmtIpGFu/3s7tYk9PPHcI0GI/msD7Y37dKDEPdQ+hNVq+9kyG6vxZARSslbbMq94mLk3tb",
+	"qyf8sxdaEqp0wU2ekhGdhPPKYneZUNNupldWqiQzQhMk0iC/xoqEw5PQoDW745NEgD63RF2kFdiXYweI",
+	"N0hTTNGn2yR2IlvEJah3LEZ/xmmXM4H2Vrn53pq7SEDOyWyXwBg2b1099R4Ag2Fs3W0g9eNecoeXn5se",
+	"Znbj/l6FR+CXhQQCXPiBCpWwuS7XUTz7SfJ+XA9t+ioqoyFndv28ASflkrhummRyPjKfFC2ECh8qXgwm",
+	"iOL13E2uY0zR41p7o1mA6f0mvQ7wpxTjjohoyHmGRkxkDCad3MWHnDqoz1EFf3qv/MWohLfwVcPegO4x",
+	"aTvPO0yTfuVt/ZqmpVCj35hyeEw8KTINHJREDRrn14MJrs401CT4aeqN/xLE4T/JnygL1sd2yJPTdL3/",
+	"HhNYSUe0t80mJm3cs+eHcOEpz/UjZ4pYOgchbxB3pi7vS/URkw7yIEyvGyzH3+PPWONgcqcd4upYS7rT",
+	"iArwKojBOcNAoYthCkAfzimfxKiU19+H4ZCD5lx2D6u+aVGm+GpCbrGFYwb1JMvLKDjX+xqXO/M4M8wd",
+	"o5o8+SdFpsZCrDZy/2Ugvvvql2agqpOrdoyYVu6so7GZZ3v7hug1zZMj8fKPe5pyba5I3OrjjDIgXlH9",
+	"kDEQxU2uY0Ylh7+pYvioi/D3zpsvTau9AoSRUj9rMjsqxjEVxh9sLHzzgRnPn0biwUoK72eReCyKKgVR",
+	"NoPAw7zSpnRSSVF0q/SZHlFTmkFm4YHsqgdJFFuYODbi5wMMp/5tDIdpEk5t5BKtF3tMFnSSTp0VbQKN",
+	"rE09ZUuQpDynOJB5esleHKk97joQ+gtFPF0jZoi7vchwesxKnYhRKjO1OIBSG0ZmUp4qAxiS0FK8TBdG",
+	"09rEsPdMYV1upwsi5egmTcbXkt6pXlvErkxJTbIhRhQZ+O4mAguMKstGoDHdS2kejoGQ0q7GAtChbxp4",
+	"DP1XR400PnYENkeSSHH2VDvXF454uSq0yzUpQTMcfYG3/AzUnlX5hUV/8lqegAOJs3OcsbEQSP6ydSKm",
+	"9X8+uOxu2WJSJJNrSBpZNKMqp+7SFkgnsNLqM01ew++Ch2zMMpQthant99DIIr5Wqjk2YbgA0ZIRVJj5",
+	"dFiiITgx8iWYFimqTJG1oj8dC1MyYTNmXGN5C9QHQ80gH9GOsxsklaXWuD8w+3wjijum+PYiGHV8A35G",
+	"NibIUgMpQCNHGj2kVJVXcFuB/MOYpgd7GxYqt9PvgnvhynFdsrjJNLCfR4id2r/xIvDdaeQn8XeylkuU",
+	"em5PTXafzqYuzqPiM1Hx6RambCG1q5XKT7NMyDOJKkUCY9jC4SERG3jFAA/RrOSeHQV9jcQrTfudM9Mc",
+	"HLC457LDgOhIQK0h/ST3CFkmRHRl9VJzinw8ZFdX/gNO05lmRS37MHFHU50lSYhb+FHaXyk7br7oa+VC",
+	"KMjhlZ82Vt1pklvDNLyNwrsGTpK4x0R1LgdOGXuD8LmlpI7vHOH/rMMZ61j5zvi3DR/tN+PygDlkspMQ",
+	"CnXeq+yUZ8GaxBfWJB2b8M2yzmOxMxlPZbCTFiQUnthHKNDVQIHKglRRszWHcOagY5vtSGeyCxfjoaiB",
+	"0CIdmtVr9w610ypMbpqdXiEkJwGorBGl13MOI+ajDQM4ixxYcnLgvFIKnCq51alwbgqcT/y/UuqJqd+K",
+	"8573se29waBzQ/Keyda9kTzHBSDD8yy6oiYL1+oulBEYRKbHmswZwbroXLjWo67pTMYxhwWXfow5rcOE",
+	"KMd0xRcsazmuVNYZo9ZLxjfWpw8dVBk+uNQQ+feCCUFlQ+mAqHg25bhqRb6m794qQa52R8fcIjlTVh1i",
+	"J68lJ5dTjocIBnxLBBgm39nhKspyTiFeCM5Alti0eFqtn+XrGOFv2zuyQIplPvhLAs4/dsifnuRu0ed5",
+	"NqyTXcxgapqaJRRLWTvugAgd9XEJQYViB63tUu3AvdtBG0UfE8aiXkRJZHPDnh71bOH1LR3+h3tc3XoB",
+	"vkrLwRyaBCfp5tBLhlxP4EvtlqwJp5IzXHLuCsdFyreRw8b7l+QmLiTcmIJYvdoHwYfXYXyNh3/v4KCi",
+	"fIYTfarHTWtJD9jd/ha69c4GEZUzF9uHZdC/dycV0VDL1ZUzq6N+n3DB8ETQFn4Muy+fp0gFO5YbTRdF",
+	"rb6OJNqzGrUysJHv0YjsmTA+E+K5ZOfnVhyLdMUktVRFIfqxUNWNvuj4sTMPpg0dOR3soZPfTgrujrAi",
+	"hFNSmQz11sTJ8tETmpprGgsVsAYLsCtfwRfRJU1YCSEKsZy6iDUx9WFpXuWVk3YkjB0kxKmkhPnwbCT5",
+	"xaqqAuC+pAibdkd9zBRd2100mH0Ter1Zm8dsotWJNUtI2F6godmQFRj5jULtKYe1ly7bsJMSr4c52kHJ",
+	"FY3yiNCKvA6LvQ6TsvkN6ZhWE5ySyQpPkRqDw/ySWB1qs4bjgyeEwPiNWH3PSeeia7NbvUOQwBf6eMcU",
+	"2VXSvc4vg7CbGhm0XEaLZiEgk/VZAGJXus59xsU224UrkNx7a7Aa2UCHbBGyARSO5CFEZTMJBXH3VmgJ",
+	"OIW5deddht1gnIV2EIsesRIISAIQBjcMHM30utrXfng10nRpJw2nXNrKmdS4o33Qo9APwLUxQTULj0BL",
+	"Z5rnoEPjMtEom9/kPOVl+fhy68x8PN7lMznTaTnZGax7fQ4zJUe5qAxhvYTNRnSjdhCrfPm6/J8swgZw",
+	"0jaRcA+ePvvjt1tI9QB/GdgRxCW4tSuVf+gn18mPm7+C8Wvc6/TduRMCwye2WFOHo4herj6xfUdKF9EN",
+	"cWrnzkuWr7a2K9OlTKpwsmYInSC2z2IYX9dUOJmHKxDU5bUwRpT0H+Sv7Pbaclrkro260WRYR4ZfHX1A",
+	"UcOLEvfvfQR5R5ZZ+J/gA0LO7GzZYz7Y3aseMTZYPV56xaC8Y4s2ynslBNDkiiwKUz7Fb3fkUEG0KLWj",
+	"t0kpQjyrX8NbW7ZX6jKKhReq5BjibmBy/+HDoN/UFezmqq7gza2KhmtJCakJy/ZZXbkxVarKEUW2J9wf",
+	"Zl9/1unzKu4qYpXrjVI+mOJnRpOrSPTj8DeW0grEb+wQ/aDm6ts1aobrxysXflXkfDqufvL9FGtpyeLi",
+	"sAjzehr8MRoI2XY1FIQUqIyx5jQG6eNdRaPKsEI7Zle4eMZgDBMCB/I5dWEDzy6oascUDnKplJz+XEra",
+	"+a1Aavbj0wA/L2KkSjuwQI+k+c7GYkns0ItVvPmR+pkapMcExZSAcqZNZnwdWgXxakgUIlVl1g6bfrTb",
+	"ZbeOAYYCgcJZFSDtXXCoyIQ8LYAo8kAj7Slmp2WgU3X+8Pbi/M3vjr+/ODt/e3R+/O2fv+YGO6j4TsZ+",
+	"8mqhnxhmgpeHQmEW5joXABcdSIzHg4B8mcn4JM8VKc52+S4+iRIYJwFUw/A5ykauP87ZYQ1kJkiVDKOG",
+	"hDHISOYMQcVZLSkTThfmEUtkQgQaUtgqEm4ou+h5lcS0BNVZavggYyLB23BmDxX5ChPY8U1Y0Hz6z89f",
+	"d4qv7Gx/JABNNXVvNIhiBusE9iZ3s5bdiDXGBj0+JZzCT+no4uCAKdwvlMv9YvfLZ1/u7R08g1kNLru7",
+	"e1+A8r9/8MyNxB6I4t8QiV0qEkN5RheVqvuAMMX+GrR72RauZuVk7j7izCwaZwZzlTEzZ5ocZbz0EA6h",
+	"mYIKvSzXYLGiHEK9Us85vSmx0VsWu5TAb9SIOLyzantehhjyQM6IXjvmd1FCivdItU246Dq9/Ekst7GQ",
+	"YSaAwUBT7+hzZreBCJYAzRJ/8sNh6j5f3CYEh6PQIKRcoZmwORQmW0JTAeMkyEK0TeASiTCtiRwh6KPw",
+	"vsBS0BTmAHrbqhGhDKbjbDVL0n0xhWPmVdTHHDIsFk0IerSqG/lpygJLmPu3CWXmLlOuYje41I2UeTZJ",
+	"RS5Jdfvypv+88WxwKsZyWlQ08N+WYDDoDQ3SgQBVPDp4lH8n2OSDLOzfhpmBf9KfMJVXMFiq9BBsZ+bz",
+	"iy/ZjoWl7r0Z9ts4+7xdWbhBxrygxS2GSwxysKpIDMvg+X4FYcJpq/gW2aX53cBmqfmL+IFqbgpQvK0X",
+	"eVk0HbSfXFNgnsrk4c0bYzGoVRMw8fhIzQywYSTLpx3fgPrvgt+m0fUNbHIs7ERwM+5T7LuB1w8ZGW2g",
+	"/VIM/1BuPjRpCDuWovbjWBEBBmEQU5jPMDcRxxE1HsqnCoB6HEbCLIARmh6hBlifXl9/v6Bzt6yyfbpb",
+	"1lOvX3fm8d9dNiQp13+s0J8T0JLOp3ghSjt9hRXzNA4WQmlZjzaX5RQtEyVFlQ39MiQqOEbzoKegh3Ha",
+	"F2jR50+fErUacrQ9/2rnqx3BL63QO2Fqe2OGSKpoqAKjFFv50XxOsbnvLJIVEoXZPSjqA7VO1VmR5bqi",
+	"oKaXR3bkep3IeyGbWBEIpAn854oG6KSJuwyZuUH7lsQQeU/1uZ8riWb70VXYve/2w8p3hXSrYkIdL3HB",
+	"oVfVkuNSrI+ECseFttTDhqPLsTsTEs4pt2LcBEaIcy0FDI4IZ/Im1M6r+jJ2quk74qyDE96N+lFhTUzO",
+	"TZWpQ0wrdCzN9Firycf1x1/+Hw==",
 }
 
 // decodeSpec returns the embedded OpenAPI spec as raw JSON bytes,
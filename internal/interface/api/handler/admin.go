@@ -0,0 +1,39 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// AdminHandler handles server administration endpoints.
+// Implements generated.ServerInterface for OpenAPI compliance.
+type AdminHandler struct {
+	cfg    *config.Config
+	logger *logger.Logger
+}
+
+// NewAdminHandler creates a new AdminHandler
+func NewAdminHandler(cfg *config.Config, logger *logger.Logger) *AdminHandler {
+	return &AdminHandler{
+		cfg:    cfg,
+		logger: logger,
+	}
+}
+
+// GetAdminConfig handles getting the redacted effective configuration (GET /admin/config).
+func (h *AdminHandler) GetAdminConfig(c *gin.Context) {
+	if middleware.GetUserRole(c) != string(entity.RoleAdmin) {
+		response.ProblemFromError(c, apperrors.Forbidden("you do not have permission to view the server configuration"))
+		return
+	}
+
+	response.Data(c, http.StatusOK, generated.AdminConfigResponse(h.cfg.Redacted()))
+}
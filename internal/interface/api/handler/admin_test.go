@@ -0,0 +1,83 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/handler"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newAdminHandlerRouter creates a Gin router with AdminHandler routes, injecting auth context.
+func newAdminHandlerRouter(cfg *config.Config, role string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, uuid.New())
+		c.Set(middleware.ContextKeyUserRole, role)
+		c.Next()
+	})
+
+	h := handler.NewAdminHandler(cfg, newTestLogger())
+	r.GET("/admin/config", h.GetAdminConfig)
+
+	return r
+}
+
+var _ = Describe("AdminHandler", func() {
+	var cfg *config.Config
+
+	BeforeEach(func() {
+		cfg = &config.Config{
+			Database: config.DatabaseConfig{Host: "db.internal", Password: "db-password", MaxConns: 25},
+			JWT:      config.JWTConfig{Secret: "jwt-secret-value", AccessTokenExpiry: 15 * time.Minute},
+			QRCode:   config.QRCodeConfig{HMACSecret: "qr-hmac-secret-value"},
+		}
+	})
+
+	get := func(role string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/admin/config", nil)
+		w := httptest.NewRecorder()
+		newAdminHandlerRouter(cfg, role).ServeHTTP(w, req)
+		return w
+	}
+
+	Describe("GetAdminConfig", func() {
+		When("an admin requests the configuration", func() {
+			It("should return the effective configuration with secrets redacted", func() {
+				w := get("admin")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				body := w.Body.String()
+				Expect(body).NotTo(ContainSubstring("db-password"))
+				Expect(body).NotTo(ContainSubstring("jwt-secret-value"))
+				Expect(body).NotTo(ContainSubstring("qr-hmac-secret-value"))
+
+				var resp map[string]map[string]any
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp["database"]).To(HaveKeyWithValue("host", "db.internal"))
+				Expect(resp["database"]).To(HaveKeyWithValue("password", "[REDACTED]"))
+				Expect(resp["database"]).To(HaveKeyWithValue("max_conns", BeNumerically("==", 25)))
+				Expect(resp["jwt"]).To(HaveKeyWithValue("secret", "[REDACTED]"))
+				Expect(resp["jwt"]).To(HaveKeyWithValue("access_token_expiry", "15m0s"))
+			})
+		})
+
+		When("an organizer requests the configuration", func() {
+			It("should return 403 Forbidden", func() {
+				w := get("organizer")
+
+				Expect(w.Code).To(Equal(http.StatusForbidden))
+				Expect(w.Body.String()).NotTo(ContainSubstring("db.internal"))
+			})
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// AuditHandler handles audit log endpoints.
+// Implements generated.ServerInterface for OpenAPI compliance.
+type AuditHandler struct {
+	usecase  audit.Usecase
+	pageSize config.PageSizeConfig
+	logger   *logger.Logger
+}
+
+// NewAuditHandler creates a new AuditHandler
+func NewAuditHandler(
+	usecase audit.Usecase,
+	pageSize config.PageSizeConfig,
+	logger *logger.Logger,
+) *AuditHandler {
+	return &AuditHandler{
+		usecase:  usecase,
+		pageSize: pageSize,
+		logger:   logger,
+	}
+}
+
+// ListAuditLogs handles listing audit log entries (GET /audit-logs).
+func (h *AuditHandler) ListAuditLogs(c *gin.Context, params generated.ListAuditLogsParams) {
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	input := audit.ListAuditLogsInput{}
+	input.Page, input.PerPage = parsePagination(params.Page, params.PerPage, h.pageSize)
+	if params.ResourceType != nil {
+		resourceType := entity.AuditResourceType(*params.ResourceType)
+		input.ResourceType = &resourceType
+	}
+	if params.ResourceId != nil {
+		resourceID := uuid.UUID(*params.ResourceId)
+		input.ResourceID = &resourceID
+	}
+
+	output, err := h.usecase.List(c.Request.Context(), isAdmin, input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	entries := make([]generated.AuditLog, len(output.Entries))
+	for i, entry := range output.Entries {
+		entries[i] = toGeneratedAuditLog(entry)
+	}
+
+	response.Data(c, http.StatusOK, generated.AuditLogListResponse{
+		Data: entries,
+		Meta: generated.PaginationMeta{
+			Page:       input.Page,
+			PerPage:    input.PerPage,
+			Total:      int(output.TotalCount),
+			TotalPages: int((output.TotalCount + int64(input.PerPage) - 1) / int64(input.PerPage)),
+		},
+	})
+}
+
+// toGeneratedAuditLog maps an audit log entry to the generated AuditLog
+func toGeneratedAuditLog(entry *entity.AuditLog) generated.AuditLog {
+	changes := make(map[string]generated.AuditChange, len(entry.Changes))
+	for name, change := range entry.Changes {
+		var result generated.AuditChange
+		if change.Redacted {
+			redacted := true
+			result.Redacted = &redacted
+		}
+		if change.Old != nil {
+			result.Old = &change.Old
+		}
+		if change.New != nil {
+			result.New = &change.New
+		}
+		changes[name] = result
+	}
+
+	return generated.AuditLog{
+		Id:           openapi_types.UUID(entry.ID),
+		ActorId:      openapi_types.UUID(entry.ActorID),
+		Action:       generated.AuditAction(entry.Action),
+		ResourceType: generated.AuditResourceType(entry.ResourceType),
+		ResourceId:   openapi_types.UUID(entry.ResourceID),
+		Changes:      changes,
+		CreatedAt:    entry.CreatedAt.UTC(),
+	}
+}
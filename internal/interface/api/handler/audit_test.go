@@ -0,0 +1,121 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/handler"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
+	auditMocks "github.com/fumkob/ezqrin-server/internal/usecase/audit/mocks"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+// newAuditHandlerRouter creates a Gin router with AuditHandler routes, injecting auth context.
+func newAuditHandlerRouter(uc audit.Usecase, userID uuid.UUID, role string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		c.Next()
+	})
+
+	h := handler.NewAuditHandler(uc, testPagination.AuditLogs, newTestLogger())
+	r.GET("/audit-logs", func(c *gin.Context) {
+		var params generated.ListAuditLogsParams
+		if resourceType, ok := c.GetQuery("resource_type"); ok {
+			t := generated.AuditResourceType(resourceType)
+			params.ResourceType = &t
+		}
+		if resourceID, ok := c.GetQuery("resource_id"); ok {
+			id := uuid.MustParse(resourceID)
+			params.ResourceId = &id
+		}
+		h.ListAuditLogs(c, params)
+	})
+
+	return r
+}
+
+var _ = Describe("AuditHandler", func() {
+	var (
+		ctrl   *gomock.Controller
+		mockUC *auditMocks.MockUsecase
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockUC = auditMocks.NewMockUsecase(ctrl)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	serve := func(role, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		w := httptest.NewRecorder()
+		newAuditHandlerRouter(mockUC, uuid.New(), role).ServeHTTP(w, req)
+		return w
+	}
+
+	Describe("ListAuditLogs", func() {
+		It("should pass the filters and return the paginated entries with their changes", func() {
+			resourceType := entity.AuditResourceParticipant
+			resourceID := uuid.New()
+			entry, err := entity.NewAuditLog(uuid.New(), entity.AuditActionUpdate, resourceType, resourceID,
+				entity.AuditFields{"status": entity.ParticipantStatusConfirmed, "email": entity.RedactedAuditValue("a@b.c")},
+				entity.AuditFields{"status": entity.ParticipantStatusCancelled, "email": entity.RedactedAuditValue("d@e.f")},
+			)
+			Expect(err).NotTo(HaveOccurred())
+			entry.CreatedAt = time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+			mockUC.EXPECT().
+				List(gomock.Any(), true, audit.ListAuditLogsInput{
+					ResourceType: &resourceType,
+					ResourceID:   &resourceID,
+					Page:         1,
+					PerPage:      20,
+				}).
+				Return(audit.ListAuditLogsOutput{Entries: []*entity.AuditLog{entry}, TotalCount: 1}, nil)
+
+			w := serve("admin", "/audit-logs?resource_type=participant&resource_id="+resourceID.String())
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp struct {
+				Data []map[string]any         `json:"data"`
+				Meta generated.PaginationMeta `json:"meta"`
+			}
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Data).To(HaveLen(1))
+			Expect(resp.Data[0]["action"]).To(Equal("update"))
+			Expect(resp.Data[0]["resource_type"]).To(Equal("participant"))
+			Expect(resp.Data[0]["resource_id"]).To(Equal(resourceID.String()))
+			Expect(resp.Data[0]["created_at"]).To(Equal("2026-10-01T09:00:00Z"))
+			Expect(resp.Data[0]["changes"]).To(Equal(map[string]any{
+				"status": map[string]any{"old": "confirmed", "new": "cancelled"},
+				"email":  map[string]any{"redacted": true},
+			}))
+			Expect(resp.Meta).To(Equal(generated.PaginationMeta{Page: 1, PerPage: 20, Total: 1, TotalPages: 1}))
+		})
+
+		It("should return 403 Forbidden for non-admins", func() {
+			mockUC.EXPECT().List(gomock.Any(), false, gomock.Any()).
+				Return(audit.ListAuditLogsOutput{}, apperrors.Forbidden("only admins can view the audit log"))
+
+			w := serve("organizer", "/audit-logs")
+
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+		})
+	})
+})
@@ -18,11 +18,16 @@ import (
 // AuthHandler handles authentication endpoints.
 // Implements generated.ServerInterface for OpenAPI compliance.
 type AuthHandler struct {
-	registerUC     *auth.RegisterUseCase
-	loginUC        *auth.LoginUseCase
-	refreshTokenUC *auth.RefreshTokenUseCase
-	logoutUC       *auth.LogoutUseCase
-	logger         *logger.Logger
+	registerUC       *auth.RegisterUseCase
+	loginUC          *auth.LoginUseCase
+	refreshTokenUC   *auth.RefreshTokenUseCase
+	logoutUC         *auth.LogoutUseCase
+	twoFactorUC      *auth.TwoFactorUseCase
+	forgotPasswordUC *auth.ForgotPasswordUseCase
+	resetPasswordUC  *auth.ResetPasswordUseCase
+	sessionUC        *auth.SessionUseCase
+	verificationUC   *auth.EmailVerificationUseCase
+	logger           *logger.Logger
 }
 
 // NewAuthHandler creates a new AuthHandler
@@ -31,14 +36,24 @@ func NewAuthHandler(
 	loginUC *auth.LoginUseCase,
 	refreshTokenUC *auth.RefreshTokenUseCase,
 	logoutUC *auth.LogoutUseCase,
+	twoFactorUC *auth.TwoFactorUseCase,
+	forgotPasswordUC *auth.ForgotPasswordUseCase,
+	resetPasswordUC *auth.ResetPasswordUseCase,
+	sessionUC *auth.SessionUseCase,
+	verificationUC *auth.EmailVerificationUseCase,
 	logger *logger.Logger,
 ) *AuthHandler {
 	return &AuthHandler{
-		registerUC:     registerUC,
-		loginUC:        loginUC,
-		refreshTokenUC: refreshTokenUC,
-		logoutUC:       logoutUC,
-		logger:         logger,
+		registerUC:       registerUC,
+		loginUC:          loginUC,
+		refreshTokenUC:   refreshTokenUC,
+		logoutUC:         logoutUC,
+		twoFactorUC:      twoFactorUC,
+		forgotPasswordUC: forgotPasswordUC,
+		resetPasswordUC:  resetPasswordUC,
+		sessionUC:        sessionUC,
+		verificationUC:   verificationUC,
+		logger:           logger,
 	}
 }
 
@@ -54,10 +69,11 @@ func (h *AuthHandler) RegisterUser(c *gin.Context) {
 
 	// Execute use case
 	result, err := h.registerUC.Execute(c.Request.Context(), &auth.RegisterRequest{
-		Email:    string(req.Email),
-		Password: req.Password,
-		Name:     req.Name,
-		Role:     string(req.Role),
+		Email:      string(req.Email),
+		Password:   req.Password,
+		Name:       req.Name,
+		Role:       string(req.Role),
+		ClientType: resolveClientType(req.Platform, c.GetHeader("User-Agent")),
 	})
 	if err != nil {
 		response.ProblemFromError(c, err)
@@ -79,17 +95,33 @@ func (h *AuthHandler) LoginUser(c *gin.Context) {
 		return
 	}
 
-	// Execute use case
-	result, err := h.loginUC.Execute(c.Request.Context(), &auth.LoginRequest{
+	loginReq := &auth.LoginRequest{
 		Email:      string(req.Email),
 		Password:   req.Password,
-		ClientType: detectClientType(c.GetHeader("User-Agent")),
-	})
+		ClientType: resolveClientType(req.Platform, c.GetHeader("User-Agent")),
+		Device:     c.GetHeader("User-Agent"),
+	}
+	if req.TotpCode != nil {
+		loginReq.TOTPCode = *req.TotpCode
+	}
+
+	// Execute use case
+	result, err := h.loginUC.Execute(c.Request.Context(), loginReq)
 	if err != nil {
 		response.ProblemFromError(c, err)
 		return
 	}
 
+	// The password was correct, but the login must be completed with a second factor
+	if result.TwoFactorChallenge != nil {
+		response.Data(c, http.StatusAccepted, generated.TwoFactorChallengeResponse{
+			TwoFactorRequired: true,
+			Challenge:         result.TwoFactorChallenge.Token,
+			ExpiresIn:         result.TwoFactorChallenge.ExpiresIn,
+		})
+		return
+	}
+
 	// Map to generated response type
 	authResponse := h.toAuthResponse(result)
 	response.Data(c, http.StatusOK, authResponse)
@@ -108,6 +140,7 @@ func (h *AuthHandler) RefreshToken(c *gin.Context) {
 	// Execute use case
 	result, err := h.refreshTokenUC.Execute(c.Request.Context(), &auth.RefreshRequest{
 		RefreshToken: req.RefreshToken,
+		Device:       c.GetHeader("User-Agent"),
 	})
 	if err != nil {
 		response.ProblemFromError(c, err)
@@ -149,25 +182,223 @@ func (h *AuthHandler) LogoutUser(c *gin.Context) {
 	response.Data(c, http.StatusOK, logoutResponse)
 }
 
+// ForgotPassword issues a password reset token (POST /auth/forgot-password).
+// Implements generated.ServerInterface.ForgotPassword
+func (h *AuthHandler) ForgotPassword(c *gin.Context) {
+	var req generated.ForgotPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	result, err := h.forgotPasswordUC.Execute(c.Request.Context(), &auth.ForgotPasswordRequest{
+		Email: string(req.Email),
+	})
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	resp := generated.ForgotPasswordResponse{Message: result.Message}
+	if result.ResetToken != "" {
+		resp.ResetToken = &result.ResetToken
+	}
+	response.Data(c, http.StatusOK, resp)
+}
+
+// ResetPassword sets a new password with a password reset token (POST /auth/reset-password).
+// Implements generated.ServerInterface.ResetPassword
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req generated.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	err := h.resetPasswordUC.Execute(c.Request.Context(), &auth.ResetPasswordRequest{
+		Token:       req.Token,
+		NewPassword: req.NewPassword,
+	})
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// VerifyEmail marks the email address of a user as verified (POST /auth/verify-email).
+// Implements generated.ServerInterface.VerifyEmail
+func (h *AuthHandler) VerifyEmail(c *gin.Context) {
+	var req generated.VerifyEmailRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	if err := h.verificationUC.Verify(c.Request.Context(), req.Token); err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// ResendVerification emails the current user a new verification link (POST /auth/resend-verification).
+// Implements generated.ServerInterface.ResendVerification
+func (h *AuthHandler) ResendVerification(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.verificationUC.Resend(c.Request.Context(), userID); err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// EnrollTwoFactor starts a TOTP enrollment for the current user (POST /auth/2fa/enroll).
+// Implements generated.ServerInterface.EnrollTwoFactor
+func (h *AuthHandler) EnrollTwoFactor(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	enrollment, err := h.twoFactorUC.Enroll(c.Request.Context(), userID)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, generated.TwoFactorEnrollmentResponse{
+		Secret:     enrollment.Secret,
+		OtpauthUri: enrollment.OTPAuthURI,
+		QrCode:     enrollment.QRCode,
+	})
+}
+
+// VerifyTwoFactor confirms the pending TOTP enrollment of the current user (POST /auth/2fa/verify).
+// Implements generated.ServerInterface.VerifyTwoFactor
+func (h *AuthHandler) VerifyTwoFactor(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+
+	var req generated.VerifyTwoFactorJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	activation, err := h.twoFactorUC.Verify(c.Request.Context(), userID, req.Code)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, generated.TwoFactorVerifyResponse{
+		BackupCodes: activation.BackupCodes,
+	})
+}
+
+// LoginTwoFactor completes a login that returned a two-factor challenge (POST /auth/2fa/login).
+// Implements generated.ServerInterface.LoginTwoFactor
+func (h *AuthHandler) LoginTwoFactor(c *gin.Context) {
+	var req generated.LoginTwoFactorJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	result, err := h.loginUC.LoginWithTwoFactor(c.Request.Context(), &auth.TwoFactorLoginRequest{
+		ChallengeToken: req.Challenge,
+		Code:           req.Code,
+		Device:         c.GetHeader("User-Agent"),
+	})
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, h.toAuthResponse(result))
+}
+
+// ListSessions lists the active sessions of the current user (GET /auth/sessions).
+// Implements generated.ServerInterface.ListSessions
+func (h *AuthHandler) ListSessions(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+	currentID, _ := middleware.GetSessionID(c)
+
+	sessions, err := h.sessionUC.List(c.Request.Context(), userID)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	items := make([]generated.Session, len(sessions))
+	for i, session := range sessions {
+		items[i] = generated.Session{
+			Id:         openapi_types.UUID(session.ID),
+			ClientType: generated.SessionClientType(session.ClientType),
+			Device:     session.Device,
+			IssuedAt:   session.IssuedAt.UTC(),
+			LastUsedAt: session.LastUsedAt.UTC(),
+			ExpiresAt:  session.ExpiresAt.UTC(),
+			Current:    session.ID == currentID,
+		}
+	}
+	response.Data(c, http.StatusOK, generated.SessionListResponse{Sessions: items})
+}
+
+// RevokeSession revokes a session of the current user (DELETE /auth/sessions/{id}).
+// Implements generated.ServerInterface.RevokeSession
+func (h *AuthHandler) RevokeSession(c *gin.Context, id generated.SessionIDParam) {
+	userID, _ := middleware.GetUserID(c)
+
+	if err := h.sessionUC.Revoke(c.Request.Context(), userID, id); err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// RevokeOtherSessions revokes every session of the current user but the one of the request
+// (DELETE /auth/sessions). With an access token issued before sessions were tracked, the
+// session of the request is unknown and every session is revoked.
+// Implements generated.ServerInterface.RevokeOtherSessions
+func (h *AuthHandler) RevokeOtherSessions(c *gin.Context) {
+	userID, _ := middleware.GetUserID(c)
+	currentID, _ := middleware.GetSessionID(c)
+
+	revoked, err := h.sessionUC.RevokeOthers(c.Request.Context(), userID, currentID)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, generated.RevokeSessionsResponse{RevokedCount: revoked})
+}
+
 // toAuthResponse maps use case AuthResponse to generated AuthResponse
 func (h *AuthHandler) toAuthResponse(result *auth.AuthResponse) generated.AuthResponse {
-	userID := openapi_types.UUID(result.User.ID)
-	userEmail := openapi_types.Email(result.User.Email)
-
 	return generated.AuthResponse{
 		AccessToken:  result.AccessToken,
 		RefreshToken: result.RefreshToken,
 		TokenType:    result.TokenType,
 		ExpiresIn:    result.ExpiresIn,
-		User: generated.User{
-			Id:        &userID,
-			Email:     userEmail,
-			Name:      result.User.Name,
-			Role:      generated.UserRole(result.User.Role),
-			CreatedAt: &result.User.CreatedAt,
-			UpdatedAt: &result.User.UpdatedAt,
-		},
+		User:         toGeneratedUser(result.User),
+	}
+}
+
+// resolveClientType returns the platform requested by the client, which the use cases validate,
+// or else the client type detected from the User-Agent header.
+func resolveClientType(platform *generated.ClientPlatform, userAgent string) string {
+	if platform != nil {
+		return string(*platform)
 	}
+	return detectClientType(userAgent)
 }
 
 // detectClientType resolves the client type from the User-Agent header.
@@ -19,6 +19,7 @@ import (
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/cache"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/cache/redis"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/handler"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
@@ -141,32 +142,57 @@ var _ = Describe("Authentication API Integration", func() {
 		// Initialize repositories
 		userRepo := database.NewUserRepository(db.GetPool(), log)
 		blacklistRepo := redis.NewTokenBlacklistRepository(redisClient)
+		sessionRepo := redis.NewSessionRepository(redisClient)
+		cacheRepo := redis.NewCacheRepository(redisClient)
+
+		signer, err := crypto.NewTokenSigner(cfg.JWT.SigningConfig())
+		Expect(err).NotTo(HaveOccurred())
 
 		// Initialize use cases
-		registerUC := auth.NewRegisterUseCase(userRepo, jwtSecret, log)
+		// Verification emails are covered by the use case tests
+		verificationUC := auth.NewEmailVerificationUseCase(
+			userRepo, cacheRepo, nil, jwtSecret, "", 24*time.Hour, false, log,
+		)
+		registerUC := auth.NewRegisterUseCase(
+			userRepo, signer, verificationUC,
+			auth.AccessTokenExpiry, auth.RefreshTokenExpiryWeb, auth.RefreshTokenExpiryMobile,
+			log,
+		)
 		loginUC := auth.NewLoginUseCase(
 			userRepo,
-			jwtSecret,
+			sessionRepo,
+			signer,
+			"", // two-factor login is covered by the use case tests
+			auth.AccessTokenExpiry,
 			auth.RefreshTokenExpiryWeb,
 			auth.RefreshTokenExpiryMobile,
 			log,
 		)
 		refreshTokenUC := auth.NewRefreshTokenUseCase(
 			userRepo,
+			sessionRepo,
 			blacklistRepo,
-			jwtSecret,
+			signer,
+			auth.AccessTokenExpiry,
 			auth.RefreshTokenExpiryWeb,
 			auth.RefreshTokenExpiryMobile,
 			log,
 		)
-		logoutUC := auth.NewLogoutUseCase(blacklistRepo, jwtSecret, log)
+		logoutUC := auth.NewLogoutUseCase(sessionRepo, blacklistRepo, signer, log)
+		twoFactorUC := auth.NewTwoFactorUseCase(userRepo, qrcode.NewGenerator(), "", "ezQRin", log)
+		forgotPasswordUC := auth.NewForgotPasswordUseCase(userRepo, cacheRepo, jwtSecret, true, log)
+		resetPasswordUC := auth.NewResetPasswordUseCase(userRepo, cacheRepo, jwtSecret, log)
+		sessionUC := auth.NewSessionUseCase(sessionRepo, blacklistRepo, log)
 
 		// Create handlers
-		authHandler = handler.NewAuthHandler(registerUC, loginUC, refreshTokenUC, logoutUC, log)
-		healthHandler = handler.NewHealthHandler(db, cacheService, log)
+		authHandler = handler.NewAuthHandler(
+			registerUC, loginUC, refreshTokenUC, logoutUC, twoFactorUC, forgotPasswordUC, resetPasswordUC, sessionUC,
+			verificationUC, log,
+		)
+		healthHandler = handler.NewHealthHandler(db, cacheService, qrcode.NewGenerator(), log)
 
 		// Initialize authentication middleware
-		authMiddleware := middleware.NewAuthMiddleware(blacklistRepo, jwtSecret, log)
+		authMiddleware := middleware.NewAuthMiddleware(blacklistRepo, signer, log)
 
 		// Setup router
 		gin.SetMode(gin.TestMode)
@@ -176,9 +202,9 @@ var _ = Describe("Authentication API Integration", func() {
 		// Register routes using generated handler registration with middleware
 		// Placeholder for event use case since we don't need it for auth tests
 		// In a real scenario, we might want to mock it or initialize it
-		eventHandler := handler.NewEventHandler(nil, log)
-		participantHandler := handler.NewParticipantHandler(nil, log)
-		checkinHandler := handler.NewCheckinHandler(nil, log)
+		eventHandler := handler.NewEventHandler(nil, testPagination.Events, log)
+		participantHandler := handler.NewParticipantHandler(nil, testPagination.Participants, log)
+		checkinHandler := handler.NewCheckinHandler(nil, testPagination.Checkins, log)
 
 		combinedHandler := handler.NewHandler(
 			healthHandler,
@@ -187,6 +213,10 @@ var _ = Describe("Authentication API Integration", func() {
 			participantHandler,
 			checkinHandler,
 			nil, // QRCodeHandler not needed for auth tests
+			nil, // PaymentHandler not needed for auth tests
+			nil, // AdminHandler not needed for auth tests
+			nil, // UserHandler not needed for auth tests
+			nil, // AuditHandler not needed for auth tests
 		)
 		options := generated.GinServerOptions{
 			Middlewares: []generated.MiddlewareFunc{
@@ -563,6 +593,47 @@ var _ = Describe("Authentication API Integration", func() {
 			})
 		})
 
+		When("logging in with an explicit platform", func() {
+			It("should issue refresh token for the requested platform regardless of the User-Agent", func() {
+				platform := generated.ClientPlatformMobile
+				reqBody := generated.LoginRequest{
+					Email:    openapi_types.Email(testUserEmail),
+					Password: testUserPass,
+					Platform: &platform,
+				}
+
+				body, _ := json.Marshal(reqBody)
+				req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewReader(body))
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7)")
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var response generated.AuthResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+				refreshClaims, err := crypto.ParseToken(response.RefreshToken, jwtSecret)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(refreshClaims.ClientType).To(Equal("mobile"))
+				expected := time.Now().Add(90 * 24 * time.Hour)
+				Expect(refreshClaims.ExpiresAt.Time).To(BeTemporally("~", expected, 5*time.Second))
+			})
+
+			It("should return 400 for an unknown platform", func() {
+				body := `{"email":"` + testUserEmail + `","password":"` + testUserPass + `","platform":"desktop"}`
+				req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewBufferString(body))
+				req.Header.Set("Content-Type", "application/json")
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+				Expect(w.Body.String()).To(ContainSubstring("platform"))
+			})
+		})
+
 		When("logging in from web browser (no CFNetwork UA)", func() {
 			Context("with valid credentials", func() {
 				It("should issue refresh token with web client_type and 7-day expiry", func() {
@@ -905,6 +976,156 @@ var _ = Describe("Authentication API Integration", func() {
 		})
 	})
 
+	When("managing sessions", func() {
+		var (
+			laptop *generated.AuthResponse
+			phone  *generated.AuthResponse
+		)
+
+		sendAuthorized := func(method, path, accessToken string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(method, path, nil)
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", accessToken))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		refresh := func(refreshToken string) *httptest.ResponseRecorder {
+			body, _ := json.Marshal(generated.RefreshTokenRequest{RefreshToken: refreshToken})
+			req := httptest.NewRequest(http.MethodPost, "/auth/refresh", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		sessionIDOf := func(tokens *generated.AuthResponse) string {
+			claims, err := crypto.ParseToken(tokens.AccessToken, jwtSecret)
+			Expect(err).NotTo(HaveOccurred())
+			return claims.SessionID
+		}
+
+		BeforeEach(func() {
+			createTestUser(router, testUserEmail, testUserPass, testUserName, testUserRole)
+			laptop = loginTestUser(router, testUserEmail, testUserPass)
+			phone = loginTestUser(router, testUserEmail, testUserPass)
+		})
+
+		It("should list each login as a session and mark the current one", func() {
+			w := sendAuthorized(http.MethodGet, "/auth/sessions", laptop.AccessToken)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var response generated.SessionListResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response.Sessions).To(HaveLen(2))
+			for _, session := range response.Sessions {
+				Expect(session.Current).To(Equal(session.Id.String() == sessionIDOf(laptop)))
+			}
+		})
+
+		It("should revoke a session so its refresh token can no longer be used", func() {
+			w := sendAuthorized(http.MethodDelete, "/auth/sessions/"+sessionIDOf(phone), laptop.AccessToken)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+
+			Expect(refresh(phone.RefreshToken).Code).To(Equal(http.StatusUnauthorized))
+			Expect(refresh(laptop.RefreshToken).Code).To(Equal(http.StatusOK))
+		})
+
+		It("should return 404 Not Found for a session of another user", func() {
+			w := sendAuthorized(http.MethodDelete, "/auth/sessions/"+uuid.New().String(), laptop.AccessToken)
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+
+		It("should revoke the whole session when a rotated refresh token is reused", func() {
+			w := refresh(phone.RefreshToken)
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var rotated generated.AuthResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &rotated)).To(Succeed())
+
+			reused := refresh(phone.RefreshToken)
+			Expect(reused.Code).To(Equal(http.StatusUnauthorized))
+			Expect(reused.Body.String()).To(ContainSubstring("reuse detected"))
+
+			Expect(refresh(rotated.RefreshToken).Code).To(Equal(http.StatusUnauthorized))
+			Expect(sendAuthorized(http.MethodGet, "/auth/sessions", rotated.AccessToken).Code).
+				To(Equal(http.StatusUnauthorized))
+		})
+
+		It("should revoke every other session", func() {
+			w := sendAuthorized(http.MethodDelete, "/auth/sessions", laptop.AccessToken)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var response generated.RevokeSessionsResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response.RevokedCount).To(Equal(1))
+			Expect(refresh(phone.RefreshToken).Code).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	When("resetting a forgotten password", func() {
+		const newPassword = "NewSecurePassword456!"
+
+		postJSON := func(path string, reqBody any) *httptest.ResponseRecorder {
+			body, err := json.Marshal(reqBody)
+			Expect(err).NotTo(HaveOccurred())
+			req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		requestResetToken := func() string {
+			w := postJSON("/auth/forgot-password", generated.ForgotPasswordRequest{
+				Email: openapi_types.Email(testUserEmail),
+			})
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var response generated.ForgotPasswordResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+			Expect(response.ResetToken).NotTo(BeNil())
+			return *response.ResetToken
+		}
+
+		BeforeEach(func() {
+			createTestUser(router, testUserEmail, testUserPass, testUserName, testUserRole)
+		})
+
+		Context("with a valid reset token", func() {
+			It("should set the new password and invalidate the token", func() {
+				token := requestResetToken()
+
+				w := postJSON("/auth/reset-password", generated.ResetPasswordRequest{
+					Token:       token,
+					NewPassword: newPassword,
+				})
+				Expect(w.Code).To(Equal(http.StatusNoContent))
+
+				tokens := loginTestUser(router, testUserEmail, newPassword)
+				Expect(tokens.AccessToken).NotTo(BeEmpty())
+
+				w = postJSON("/auth/reset-password", generated.ResetPasswordRequest{
+					Token:       token,
+					NewPassword: "AnotherPassword789!",
+				})
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("with an unknown email", func() {
+			It("should return 200 OK without a token", func() {
+				w := postJSON("/auth/forgot-password", generated.ForgotPasswordRequest{
+					Email: openapi_types.Email("unknown@example.com"),
+				})
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var response generated.ForgotPasswordResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.ResetToken).To(BeNil())
+			})
+		})
+	})
+
 	When("using authentication middleware", func() {
 		var (
 			accessToken string
@@ -1,9 +1,12 @@
 package handler
 
 import (
+	"errors"
+	"fmt"
 	"net/http"
 	"time"
 
+	"github.com/fumkob/ezqrin-server/config"
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
@@ -18,31 +21,59 @@ import (
 )
 
 const (
-	defaultCheckinPerPage = 20
+	// checkinStreamKeepAlive is the interval of the keep-alive comments on check-in streams, short
+	// enough for proxies not to close idle connections
+	checkinStreamKeepAlive = 15 * time.Second
+
+	// deviceIDHeader identifies the scanning station making a check-in when the request body does not
+	deviceIDHeader = "X-Device-Id"
 )
 
 // CheckinHandler handles check-in-related endpoints.
 // Implements generated.ServerInterface for OpenAPI compliance.
 type CheckinHandler struct {
-	usecase checkin.Usecase
-	logger  *logger.Logger
+	usecase  checkin.Usecase
+	pageSize config.PageSizeConfig
+	logger   *logger.Logger
 }
 
 // NewCheckinHandler creates a new CheckinHandler
-func NewCheckinHandler(usecase checkin.Usecase, logger *logger.Logger) *CheckinHandler {
+func NewCheckinHandler(
+	usecase checkin.Usecase,
+	pageSize config.PageSizeConfig,
+	logger *logger.Logger,
+) *CheckinHandler {
 	return &CheckinHandler{
-		usecase: usecase,
-		logger:  logger,
+		usecase:  usecase,
+		pageSize: pageSize,
+		logger:   logger,
 	}
 }
 
-// CheckInParticipant handles participant check-in (POST /events/{id}/checkin).
+// CheckInParticipant handles participant check-in (POST /events/{id}/checkin). Check-ins that
+// find nothing to check in are answered 200 with their outcome instead of an error status, so
+// that scanner apps need not parse error messages.
 func (h *CheckinHandler) CheckInParticipant(c *gin.Context, eventID generated.EventIDParam) {
+	result, err := h.checkIn(c, eventID)
+	if err == nil {
+		response.Data(c, http.StatusOK, h.toCheckInResponse(result))
+		return
+	}
+
+	resp, ok := toCheckInOutcomeResponse(err)
+	if !ok {
+		response.ProblemFromError(c, err)
+		return
+	}
+	response.Data(c, http.StatusOK, resp)
+}
+
+// checkIn binds a check-in request and checks the participant in
+func (h *CheckinHandler) checkIn(c *gin.Context, eventID generated.EventIDParam) (*checkin.CheckInOutput, error) {
 	var req generated.CheckInRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
 		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
-		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
-		return
+		return nil, apperrors.BadRequest("invalid request body")
 	}
 
 	userID, _ := middleware.GetUserID(c)
@@ -57,8 +88,7 @@ func (h *CheckinHandler) CheckInParticipant(c *gin.Context, eventID generated.Ev
 
 	// Set QR code or participant ID based on method
 	if err := h.setCheckinMethodFields(&input, &req); err != nil {
-		response.ProblemFromError(c, err)
-		return
+		return nil, err
 	}
 
 	// Set device info if provided
@@ -66,16 +96,88 @@ func (h *CheckinHandler) CheckInParticipant(c *gin.Context, eventID generated.Ev
 		input.DeviceInfo = *req.DeviceInfo
 	}
 
-	// Execute check-in
-	result, err := h.usecase.CheckIn(c.Request.Context(), userID, isAdmin, input)
+	// Record where the check-in was made; the body's device ID takes precedence over the header
+	input.Source = req.Source
+	input.DeviceID = req.DeviceId
+	if deviceID := c.GetHeader(deviceIDHeader); input.DeviceID == nil && deviceID != "" {
+		input.DeviceID = &deviceID
+	}
+
+	return h.usecase.CheckIn(c.Request.Context(), userID, isAdmin, input)
+}
+
+// CheckInWalkIn handles registering and checking in a walk-in participant
+// (POST /events/{id}/checkin/walk-in).
+func (h *CheckinHandler) CheckInWalkIn(c *gin.Context, eventID generated.EventIDParam) {
+	var req generated.WalkInCheckInRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	input := checkin.WalkInInput{
+		EventID:         uuid.UUID(eventID),
+		Name:            req.Name,
+		CheckedInBy:     userID,
+		ConsentAccepted: req.ConsentAccepted != nil && *req.ConsentAccepted,
+	}
+	if req.Email != nil {
+		input.Email = string(*req.Email)
+	}
+	if req.DeviceInfo != nil {
+		input.DeviceInfo = *req.DeviceInfo
+	}
+
+	result, err := h.usecase.CheckInWalkIn(c.Request.Context(), userID, isAdmin, input)
 	if err != nil {
 		response.ProblemFromError(c, err)
 		return
 	}
 
-	// Convert to response
-	resp := h.toCheckInResponse(result)
-	response.Data(c, http.StatusOK, resp)
+	response.Data(c, http.StatusCreated, h.toCheckInResponse(result))
+}
+
+// BulkCheckIn handles manually checking in several participants at once
+// (POST /events/{id}/checkin/bulk).
+func (h *CheckinHandler) BulkCheckIn(c *gin.Context, eventID generated.EventIDParam) {
+	var req generated.BulkCheckInRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	participantIDs := make([]uuid.UUID, len(req.ParticipantIds))
+	for i, id := range req.ParticipantIds {
+		participantIDs[i] = uuid.UUID(id)
+	}
+
+	result, err := h.usecase.BulkCheckIn(c.Request.Context(), userID, isAdmin, checkin.BulkCheckInInput{
+		EventID:        uuid.UUID(eventID),
+		ParticipantIDs: participantIDs,
+		CheckedInBy:    userID,
+	})
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	errs := make([]generated.BulkCheckInError, len(result.Errors))
+	for i, e := range result.Errors {
+		errs[i] = generated.BulkCheckInError{ParticipantId: e.ParticipantID, Reason: e.Reason}
+	}
+	response.Data(c, http.StatusOK, generated.BulkCheckInResponse{
+		CheckedInCount: result.CheckedInCount,
+		SkippedCount:   result.SkippedCount,
+		Errors:         errs,
+	})
 }
 
 // ListCheckIns handles listing check-ins for an event (GET /events/{id}/checkins).
@@ -115,6 +217,56 @@ func (h *CheckinHandler) GetCheckInStatus(c *gin.Context, participantID generate
 	response.Data(c, http.StatusOK, resp)
 }
 
+// GetPublicCheckInStatus handles a participant looking up their own check-in status
+// (GET /public/checkin-status). This endpoint is public; the signed QR token is the credential.
+func (h *CheckinHandler) GetPublicCheckInStatus(c *gin.Context, params generated.GetPublicCheckInStatusParams) {
+	output, err := h.usecase.GetPublicStatus(c.Request.Context(), params.Token)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, generated.PublicCheckInStatusResponse{
+		EventName:       output.EventName,
+		ParticipantName: output.ParticipantName,
+		CheckedIn:       output.IsCheckedIn,
+	})
+}
+
+// GetCheckInHistory handles listing every check-in of a participant
+// (GET /participants/{id}/checkin-history).
+func (h *CheckinHandler) GetCheckInHistory(c *gin.Context, participantID generated.ParticipantIDParam) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	history, err := h.usecase.GetHistory(c.Request.Context(), userID, isAdmin, uuid.UUID(participantID))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	resp := generated.CheckInHistoryResponse{
+		ParticipantId: participantID,
+		Checkins:      make([]generated.CheckInHistoryEntry, len(history)),
+	}
+	for i, checkin := range history {
+		resp.Checkins[i] = toCheckInHistoryEntry(checkin)
+	}
+	response.Data(c, http.StatusOK, resp)
+}
+
+// toCheckInHistoryEntry converts a check-in, active or ended, to its history entry.
+func toCheckInHistoryEntry(checkin *entity.Checkin) generated.CheckInHistoryEntry {
+	return generated.CheckInHistoryEntry{
+		Id:            checkin.ID,
+		CheckedInAt:   checkin.CheckedInAt,
+		CheckedInBy:   checkin.CheckedInBy,
+		CheckinMethod: generated.CheckInMethod(checkin.Method),
+		CancelledAt:   checkin.CancelledAt,
+		CancelledBy:   checkin.CancelledBy,
+	}
+}
+
 // CancelCheckIn handles canceling a check-in (DELETE /events/{id}/checkins/{cid}).
 func (h *CheckinHandler) CancelCheckIn(c *gin.Context, id generated.EventIDParam, cid openapi_types.UUID) {
 	userID, _ := middleware.GetUserID(c)
@@ -129,30 +281,334 @@ func (h *CheckinHandler) CancelCheckIn(c *gin.Context, id generated.EventIDParam
 	response.NoContent(c)
 }
 
+// CheckOutParticipant handles checking out a participant by QR code or participant ID
+// (POST /events/{id}/checkout).
+func (h *CheckinHandler) CheckOutParticipant(c *gin.Context, id generated.EventIDParam) {
+	var req generated.CheckOutRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	input := checkin.CheckOutInput{EventID: uuid.UUID(id), QRCode: req.QrCode}
+	if req.ParticipantId != nil {
+		participantID := uuid.UUID(*req.ParticipantId)
+		input.ParticipantID = &participantID
+	}
+
+	if err := h.usecase.CheckOut(c.Request.Context(), userID, isAdmin, input); err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.NoContent(c)
+}
+
+// RestoreCheckIn handles restoring a cancelled check-in (POST /events/{id}/checkins/{cid}/restore).
+func (h *CheckinHandler) RestoreCheckIn(c *gin.Context, id generated.EventIDParam, cid openapi_types.UUID) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	result, err := h.usecase.Restore(c.Request.Context(), userID, isAdmin, uuid.UUID(id), uuid.UUID(cid))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	resp := h.toCheckInResponse(result)
+	resp.Message = "Check-in restored"
+	response.Data(c, http.StatusOK, resp)
+}
+
+// StreamCheckIns handles the live check-in stream (GET /events/{id}/checkins/stream).
+// Each created check-in is sent as a Server-Sent Event until the client disconnects, or until
+// the server shuts down, which ends the stream with a shutdown event.
+func (h *CheckinHandler) StreamCheckIns(c *gin.Context, id generated.EventIDParam) {
+	ctx := c.Request.Context()
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	stream, err := h.usecase.StreamCheckIns(ctx, userID, isAdmin, uuid.UUID(id))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+	defer func() {
+		if err := stream.Close(); err != nil {
+			h.logger.WithContext(ctx).Warn("failed to close check-in stream", zap.Error(err))
+		}
+	}()
+
+	// The stream outlives the server write timeout; writers that cannot lift it (e.g. in tests)
+	// keep theirs
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.Flush()
+
+	keepAlive := time.NewTicker(checkinStreamKeepAlive)
+	defer keepAlive.Stop()
+	shutdown := middleware.ShutdownSignal(c)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-shutdown:
+			c.SSEvent("shutdown", gin.H{"message": "server is shutting down, reconnect to resume the stream"})
+			c.Writer.Flush()
+			return
+		case output, ok := <-stream.CheckIns:
+			if !ok {
+				return
+			}
+			c.SSEvent("checkin", h.toCheckInResponse(output))
+		case <-keepAlive.C:
+			_, _ = fmt.Fprint(c.Writer, ": keep-alive\n\n")
+		}
+		c.Writer.Flush()
+	}
+}
+
+// GetCheckInProgress handles getting the live check-in counter (GET /events/{id}/checkin-progress).
+func (h *CheckinHandler) GetCheckInProgress(c *gin.Context, id generated.EventIDParam) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	output, err := h.usecase.GetProgress(c.Request.Context(), userID, isAdmin, uuid.UUID(id))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	// The ETag covers only the counts, so polls answered from a newer computation still get 304.
+	etag := fmt.Sprintf(`W/"%d-%d"`, output.CheckedIn, output.Total)
+	if respondNotModifiedETag(c, etag) {
+		return
+	}
+
+	response.Data(c, http.StatusOK, generated.CheckInProgressResponse{
+		CheckedIn:  int(output.CheckedIn),
+		Total:      int(output.Total),
+		Percentage: output.Percentage,
+		UpdatedAt:  output.UpdatedAt.UTC(),
+	})
+}
+
+// GetCheckInsByStaff handles counting check-ins per staff member (GET /events/{id}/checkins/by-staff).
+func (h *CheckinHandler) GetCheckInsByStaff(c *gin.Context, id generated.EventIDParam) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	output, err := h.usecase.GetStaffAttribution(c.Request.Context(), userID, isAdmin, uuid.UUID(id))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	staff := make([]generated.StaffCheckInCount, len(output.Staff))
+	for i, s := range output.Staff {
+		staff[i] = generated.StaffCheckInCount{
+			UserId:       openapi_types.UUID(s.UserID),
+			Name:         s.Name,
+			CheckinCount: int(s.CheckinCount),
+		}
+	}
+
+	response.Data(c, http.StatusOK, generated.CheckInsByStaffResponse{
+		Staff:        staff,
+		SelfCheckins: int(output.SelfCheckins),
+		Total:        int(output.Total),
+	})
+}
+
+// GetScanAnalytics handles summarizing QR scans by outcome (GET /events/{id}/scan-analytics).
+func (h *CheckinHandler) GetScanAnalytics(
+	c *gin.Context,
+	id generated.EventIDParam,
+	params generated.GetScanAnalyticsParams,
+) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	var bucket time.Duration
+	if params.IntervalMinutes != nil {
+		bucket = time.Duration(*params.IntervalMinutes) * time.Minute
+	}
+
+	output, err := h.usecase.GetScanAnalytics(c.Request.Context(), userID, isAdmin, uuid.UUID(id), bucket)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	timeline := make([]generated.ScanAnalyticsBucket, len(output.Timeline))
+	for i, b := range output.Timeline {
+		timeline[i] = generated.ScanAnalyticsBucket{
+			Start:  b.Start.UTC(),
+			Counts: toScanOutcomeCounts(b.Counts),
+		}
+	}
+
+	response.Data(c, http.StatusOK, generated.ScanAnalyticsResponse{
+		EventId:         openapi_types.UUID(output.EventID),
+		IntervalMinutes: int(output.Bucket / time.Minute),
+		Totals:          toScanOutcomeCounts(output.Totals),
+		Timeline:        timeline,
+	})
+}
+
+// GetCheckInTimeseries handles counting check-ins over time (GET /events/{id}/checkins/timeseries).
+func (h *CheckinHandler) GetCheckInTimeseries(
+	c *gin.Context,
+	id generated.EventIDParam,
+	params generated.GetCheckInTimeseriesParams,
+) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	var interval time.Duration
+	if params.Interval != nil {
+		if !params.Interval.Valid() {
+			response.ProblemFromError(c, apperrors.Validation("interval must be one of 5m, 15m or 1h"))
+			return
+		}
+		// The allowed values are all valid Go durations
+		interval, _ = time.ParseDuration(string(*params.Interval))
+	}
+
+	output, err := h.usecase.GetTimeseries(c.Request.Context(), userID, isAdmin, uuid.UUID(id), interval)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	byDevice := make([]generated.CheckInTimeseriesDevice, len(output.ByDevice))
+	for i, device := range output.ByDevice {
+		byDevice[i] = generated.CheckInTimeseriesDevice{
+			DeviceId: device.DeviceID,
+			Total:    int(device.Total),
+			Buckets:  toCheckInTimeseriesBuckets(device.Buckets),
+		}
+	}
+
+	response.Data(c, http.StatusOK, generated.CheckInTimeseriesResponse{
+		EventId:  openapi_types.UUID(output.EventID),
+		Interval: formatInterval(output.Interval),
+		Total:    int(output.Total),
+		Buckets:  toCheckInTimeseriesBuckets(output.Buckets),
+		ByDevice: byDevice,
+	})
+}
+
+// toCheckInTimeseriesBuckets maps use case time series buckets to the generated type
+func toCheckInTimeseriesBuckets(buckets []checkin.TimeseriesBucket) []generated.CheckInTimeseriesBucket {
+	resp := make([]generated.CheckInTimeseriesBucket, len(buckets))
+	for i, b := range buckets {
+		resp[i] = generated.CheckInTimeseriesBucket{Start: b.Start.UTC(), Count: int(b.Count)}
+	}
+	return resp
+}
+
+// formatInterval formats a time series interval the way it is requested, e.g. "15m" or "1h"
+func formatInterval(interval time.Duration) string {
+	if interval%time.Hour == 0 {
+		return fmt.Sprintf("%dh", interval/time.Hour)
+	}
+	return fmt.Sprintf("%dm", interval/time.Minute)
+}
+
+// toScanOutcomeCounts maps use case scan counts to the generated type
+func toScanOutcomeCounts(counts checkin.ScanOutcomeCounts) generated.ScanOutcomeCounts {
+	return generated.ScanOutcomeCounts{
+		Success:   int(counts.Success),
+		Duplicate: int(counts.Duplicate),
+		NotFound:  int(counts.NotFound),
+		Invalid:   int(counts.Invalid),
+		Total:     int(counts.Total),
+	}
+}
+
 // Helper functions
 
+// toCheckInOutcomeResponse maps a failed check-in to its outcome. It reports false for errors
+// that are not an outcome, which keep their error status.
+func toCheckInOutcomeResponse(err error) (generated.CheckInResponse, bool) {
+	var alreadyErr *checkin.AlreadyCheckedInError
+	switch {
+	case errors.As(err, &alreadyErr):
+		return generated.CheckInResponse{
+			Outcome:     generated.CheckInOutcomeAlreadyCheckedIn,
+			StatusBadge: generated.Warning,
+			DisplayName: &alreadyErr.ParticipantName,
+			Message:     "Participant has already checked in",
+		}, true
+	case errors.Is(err, checkin.ErrParticipantNotFound):
+		return generated.CheckInResponse{
+			Outcome:     generated.CheckInOutcomeNotFound,
+			StatusBadge: generated.Error,
+			Message:     "Participant not found",
+		}, true
+	case errors.Is(err, checkin.ErrParticipantNotInEvent):
+		return generated.CheckInResponse{
+			Outcome:     generated.CheckInOutcomeWrongEvent,
+			StatusBadge: generated.Error,
+			Message:     "Participant belongs to another event",
+		}, true
+	case errors.Is(err, checkin.ErrOutsideCheckinWindow):
+		return generated.CheckInResponse{
+			Outcome:     generated.CheckInOutcomeOutsideWindow,
+			StatusBadge: generated.Error,
+			Message:     "Check-in for this event is not open",
+		}, true
+	}
+	return generated.CheckInResponse{}, false
+}
+
 func (h *CheckinHandler) toCheckInResponse(output *checkin.CheckInOutput) generated.CheckInResponse {
+	id := openapi_types.UUID(output.ID)
+	eventID := openapi_types.UUID(output.EventID)
+	participantID := openapi_types.UUID(output.ParticipantID)
+	method := generated.CheckInMethod(output.Method)
+	checkedInAt := output.CheckedInAt.UTC()
 	resp := generated.CheckInResponse{
-		Id:            openapi_types.UUID(output.ID),
-		EventId:       openapi_types.UUID(output.EventID),
-		ParticipantId: openapi_types.UUID(output.ParticipantID),
-		CheckinMethod: generated.CheckInMethod(output.Method),
-		CheckedInAt:   output.CheckedInAt,
+		Outcome:       generated.CheckInOutcomeCheckedIn,
+		StatusBadge:   generated.Success,
+		DisplayName:   &output.ParticipantName,
+		Id:            &id,
+		EventId:       &eventID,
+		ParticipantId: &participantID,
+		CheckinMethod: &method,
+		CheckedInAt:   &checkedInAt,
+		Source:        output.Source,
+		DeviceId:      output.DeviceID,
 		Message:       "Check-in successful",
-		Participant: struct {
-			Email openapi_types.Email `json:"email"`
-			Name  string              `json:"name"`
+		Participant: &struct {
+			Email  string `json:"email"`
+			Name   string `json:"name"`
+			WalkIn bool   `json:"walk_in"`
 		}{
-			Name:  output.ParticipantName,
-			Email: openapi_types.Email(output.ParticipantEmail),
+			Name:   output.ParticipantName,
+			Email:  output.ParticipantEmail,
+			WalkIn: output.ParticipantWalkIn,
 		},
 	}
 
 	// Set CheckedInBy if present (for manual check-ins)
 	if output.CheckedInBy != nil {
-		resp.CheckedInBy.Id = openapi_types.UUID(*output.CheckedInBy)
 		// TODO: Get user name from user repository
-		resp.CheckedInBy.Name = "Staff"
+		resp.CheckedInBy = &struct {
+			Id   openapi_types.UUID `json:"id"`
+			Name string             `json:"name"`
+		}{Id: openapi_types.UUID(*output.CheckedInBy), Name: "Staff"}
 	}
 
 	return resp
@@ -194,18 +650,11 @@ func (h *CheckinHandler) buildListCheckInsInput(
 ) checkin.ListCheckInsInput {
 	input := checkin.ListCheckInsInput{
 		EventID: uuid.UUID(eventID),
-		Page:    1,
-		PerPage: defaultCheckinPerPage,
 		Sort:    "checked_in_at",
 		Order:   "desc",
 	}
+	input.Page, input.PerPage = parsePagination(params.Page, params.PerPage, h.pageSize)
 
-	if params.Page != nil {
-		input.Page = int(*params.Page)
-	}
-	if params.PerPage != nil {
-		input.PerPage = int(*params.PerPage)
-	}
 	if params.Sort != nil {
 		input.Sort = string(*params.Sort)
 	}
@@ -242,15 +691,18 @@ func (h *CheckinHandler) convertCheckInsToItems(checkIns []*checkin.CheckInOutpu
 		Name string             `json:"name"`
 	} `json:"checked_in_by"`
 	CheckinMethod generated.CheckInMethod `json:"checkin_method"`
+	DeviceId      *string                 `json:"device_id,omitempty"`
 	DeviceInfo    *map[string]any         `json:"device_info,omitempty"`
 	EventId       openapi_types.UUID      `json:"event_id"`
 	Id            openapi_types.UUID      `json:"id"`
 	Participant   struct {
-		Email      openapi_types.Email `json:"email"`
-		EmployeeId *string             `json:"employee_id,omitempty"`
-		Name       string              `json:"name"`
+		Email      string  `json:"email"`
+		EmployeeId *string `json:"employee_id,omitempty"`
+		Name       string  `json:"name"`
+		WalkIn     bool    `json:"walk_in"`
 	} `json:"participant"`
 	ParticipantId openapi_types.UUID `json:"participant_id"`
+	Source        *string            `json:"source,omitempty"`
 } {
 	items := make([]struct {
 		CheckedInAt time.Time `json:"checked_in_at"`
@@ -259,15 +711,18 @@ func (h *CheckinHandler) convertCheckInsToItems(checkIns []*checkin.CheckInOutpu
 			Name string             `json:"name"`
 		} `json:"checked_in_by"`
 		CheckinMethod generated.CheckInMethod `json:"checkin_method"`
+		DeviceId      *string                 `json:"device_id,omitempty"`
 		DeviceInfo    *map[string]any         `json:"device_info,omitempty"`
 		EventId       openapi_types.UUID      `json:"event_id"`
 		Id            openapi_types.UUID      `json:"id"`
 		Participant   struct {
-			Email      openapi_types.Email `json:"email"`
-			EmployeeId *string             `json:"employee_id,omitempty"`
-			Name       string              `json:"name"`
+			Email      string  `json:"email"`
+			EmployeeId *string `json:"employee_id,omitempty"`
+			Name       string  `json:"name"`
+			WalkIn     bool    `json:"walk_in"`
 		} `json:"participant"`
 		ParticipantId openapi_types.UUID `json:"participant_id"`
+		Source        *string            `json:"source,omitempty"`
 	}, len(checkIns))
 
 	for i, ci := range checkIns {
@@ -275,10 +730,13 @@ func (h *CheckinHandler) convertCheckInsToItems(checkIns []*checkin.CheckInOutpu
 		items[i].EventId = openapi_types.UUID(ci.EventID)
 		items[i].ParticipantId = openapi_types.UUID(ci.ParticipantID)
 		items[i].Participant.Name = ci.ParticipantName
-		items[i].Participant.Email = openapi_types.Email(ci.ParticipantEmail)
+		items[i].Participant.Email = ci.ParticipantEmail
 		items[i].Participant.EmployeeId = ci.ParticipantEmployeeID
-		items[i].CheckedInAt = ci.CheckedInAt
+		items[i].Participant.WalkIn = ci.ParticipantWalkIn
+		items[i].CheckedInAt = ci.CheckedInAt.UTC()
 		items[i].CheckinMethod = generated.CheckInMethod(ci.Method)
+		items[i].Source = ci.Source
+		items[i].DeviceId = ci.DeviceID
 
 		// Set CheckedInBy if present (for manual check-ins)
 		if ci.CheckedInBy != nil {
@@ -325,7 +783,7 @@ func (h *CheckinHandler) buildCheckInStatusResponse(
 			Id            openapi_types.UUID      `json:"id"`
 		}{
 			Id:            openapi_types.UUID(output.CheckIn.ID),
-			CheckedInAt:   output.CheckIn.CheckedInAt,
+			CheckedInAt:   output.CheckIn.CheckedInAt.UTC(),
 			CheckinMethod: generated.CheckInMethod(output.CheckIn.Method),
 		}
 
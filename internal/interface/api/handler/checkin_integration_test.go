@@ -102,6 +102,7 @@ var _ = Describe("Check-in API Integration", func() {
 			CORS: config.CORSConfig{
 				AllowedOrigins: []string{"*"},
 			},
+			Pagination: testPagination,
 		}
 
 		log, _ = logger.New(logger.Config{
@@ -234,12 +235,12 @@ var _ = Describe("Check-in API Integration", func() {
 					Expect(err).NotTo(HaveOccurred())
 					Expect(response.ParticipantId.String()).To(Equal(participant1.Id.String()))
 					Expect(response.Participant.Name).To(Equal("Participant 1"))
-					Expect(string(response.CheckinMethod)).To(Equal("qrcode"))
+					Expect(response.CheckinMethod).To(HaveValue(BeEquivalentTo("qrcode")))
 				})
 			})
 
 			Context("with invalid QR code", func() {
-				It("should return 404 Not Found", func() {
+				It("should return the not_found outcome", func() {
 					checkinReq := map[string]interface{}{
 						"method":  "qrcode",
 						"qr_code": "invalid-qr-code-12345",
@@ -257,7 +258,10 @@ var _ = Describe("Check-in API Integration", func() {
 					w := httptest.NewRecorder()
 					router.ServeHTTP(w, req)
 
-					Expect(w.Code).To(Equal(http.StatusNotFound))
+					Expect(w.Code).To(Equal(http.StatusOK))
+					var response generated.CheckInResponse
+					Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+					Expect(response.Outcome).To(Equal(generated.CheckInOutcomeNotFound))
 				})
 			})
 
@@ -311,7 +315,7 @@ var _ = Describe("Check-in API Integration", func() {
 					err := json.Unmarshal(w.Body.Bytes(), &response)
 					Expect(err).NotTo(HaveOccurred())
 					Expect(response.ParticipantId.String()).To(Equal(participant1.Id.String()))
-					Expect(string(response.CheckinMethod)).To(Equal("manual"))
+					Expect(response.CheckinMethod).To(HaveValue(BeEquivalentTo("manual")))
 					Expect(response.CheckedInBy).NotTo(BeNil())
 				})
 			})
@@ -367,7 +371,7 @@ var _ = Describe("Check-in API Integration", func() {
 			})
 
 			Context("with invalid participant ID", func() {
-				It("should return 404 Not Found", func() {
+				It("should return the not_found outcome", func() {
 					checkinReq := map[string]interface{}{
 						"method":         "manual",
 						"participant_id": "00000000-0000-0000-0000-000000000000",
@@ -385,13 +389,16 @@ var _ = Describe("Check-in API Integration", func() {
 					w := httptest.NewRecorder()
 					router.ServeHTTP(w, req)
 
-					Expect(w.Code).To(Equal(http.StatusNotFound))
+					Expect(w.Code).To(Equal(http.StatusOK))
+					var response generated.CheckInResponse
+					Expect(json.Unmarshal(w.Body.Bytes(), &response)).To(Succeed())
+					Expect(response.Outcome).To(Equal(generated.CheckInOutcomeNotFound))
 				})
 			})
 		})
 
 		When("participant already checked in", func() {
-			It("should return 409 Conflict", func() {
+			It("should return the already_checked_in outcome", func() {
 				checkinReq := map[string]interface{}{
 					"method":  "qrcode",
 					"qr_code": participant1.QrCode,
@@ -420,7 +427,11 @@ var _ = Describe("Check-in API Integration", func() {
 				req2.Header.Set("Authorization", "Bearer "+organizerAuth.AccessToken)
 				w2 := httptest.NewRecorder()
 				router.ServeHTTP(w2, req2)
-				Expect(w2.Code).To(Equal(http.StatusConflict))
+				Expect(w2.Code).To(Equal(http.StatusOK))
+				var response generated.CheckInResponse
+				Expect(json.Unmarshal(w2.Body.Bytes(), &response)).To(Succeed())
+				Expect(response.Outcome).To(Equal(generated.CheckInOutcomeAlreadyCheckedIn))
+				Expect(response.DisplayName).To(HaveValue(Equal("Participant 1")))
 			})
 		})
 
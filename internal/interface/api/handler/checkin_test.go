@@ -0,0 +1,963 @@
+package handler_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/handler"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
+	checkinMocks "github.com/fumkob/ezqrin-server/internal/usecase/checkin/mocks"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+// newCheckinHandlerRouter creates a Gin router with the check-in, check-in progress, walk-in,
+// bulk, by-staff, scan analytics, restore, checkout, stream, history and public status routes, injecting
+// auth context and applying the given middlewares.
+func newCheckinHandlerRouter(
+	uc checkin.Usecase,
+	userID uuid.UUID,
+	log *logger.Logger,
+	middlewares ...gin.HandlerFunc,
+) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, "organizer")
+		c.Next()
+	})
+	r.Use(middlewares...)
+
+	h := handler.NewCheckinHandler(uc, testPagination.Checkins, log)
+
+	r.GET("/events/:id/checkin-progress", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.GetCheckInProgress(c, generated.EventIDParam(id))
+	})
+
+	r.POST("/events/:id/checkin/walk-in", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.CheckInWalkIn(c, generated.EventIDParam(id))
+	})
+
+	r.POST("/events/:id/checkin", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.CheckInParticipant(c, generated.EventIDParam(id))
+	})
+
+	r.POST("/events/:id/checkin/bulk", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.BulkCheckIn(c, generated.EventIDParam(id))
+	})
+
+	r.GET("/events/:id/checkins/by-staff", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.GetCheckInsByStaff(c, generated.EventIDParam(id))
+	})
+
+	r.GET("/events/:id/scan-analytics", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		var params generated.GetScanAnalyticsParams
+		_ = c.ShouldBindQuery(&params)
+		h.GetScanAnalytics(c, generated.EventIDParam(id), params)
+	})
+
+	r.GET("/events/:id/checkins/timeseries", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		var params generated.GetCheckInTimeseriesParams
+		_ = c.ShouldBindQuery(&params)
+		h.GetCheckInTimeseries(c, generated.EventIDParam(id), params)
+	})
+
+	r.POST("/events/:id/checkins/:cid/restore", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		cid, _ := uuid.Parse(c.Param("cid"))
+		h.RestoreCheckIn(c, generated.EventIDParam(id), cid)
+	})
+
+	r.GET("/events/:id/checkins/stream", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.StreamCheckIns(c, generated.EventIDParam(id))
+	})
+
+	r.POST("/events/:id/checkout", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.CheckOutParticipant(c, generated.EventIDParam(id))
+	})
+
+	r.GET("/participants/:id/checkin-history", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.GetCheckInHistory(c, generated.ParticipantIDParam(id))
+	})
+
+	r.GET("/public/checkin-status", func(c *gin.Context) {
+		h.GetPublicCheckInStatus(c, generated.GetPublicCheckInStatusParams{Token: c.Query("token")})
+	})
+
+	return r
+}
+
+var _ = Describe("CheckinHandler", func() {
+	var (
+		ctrl    *gomock.Controller
+		mockUC  *checkinMocks.MockUsecase
+		router  *gin.Engine
+		eventID uuid.UUID
+		userID  uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockUC = checkinMocks.NewMockUsecase(ctrl)
+		eventID = uuid.New()
+		userID = uuid.New()
+		router = newCheckinHandlerRouter(mockUC, userID, newTestLogger())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	get := func(ifNoneMatch string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/checkin-progress", nil)
+		if ifNoneMatch != "" {
+			req.Header.Set("If-None-Match", ifNoneMatch)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	Describe("GetCheckInProgress", func() {
+		BeforeEach(func() {
+			mockUC.EXPECT().GetProgress(gomock.Any(), userID, false, eventID).Return(&checkin.ProgressOutput{
+				EventID:    eventID,
+				CheckedIn:  342,
+				Total:      500,
+				Percentage: 68.4,
+				UpdatedAt:  time.Date(2025, 12, 15, 9, 15, 0, 0, time.UTC),
+			}, nil).AnyTimes()
+		})
+
+		When("polling without a validator", func() {
+			It("should return the counts with an ETag", func() {
+				w := get("")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get("ETag")).To(Equal(`W/"342-500"`))
+
+				var resp generated.CheckInProgressResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.CheckedIn).To(Equal(342))
+				Expect(resp.Total).To(Equal(500))
+				Expect(resp.Percentage).To(Equal(68.4))
+				Expect(resp.UpdatedAt).To(Equal(time.Date(2025, 12, 15, 9, 15, 0, 0, time.UTC)))
+			})
+		})
+
+		When("the client's ETag matches", func() {
+			It("should return 304 Not Modified with an empty body", func() {
+				w := get(`W/"342-500"`)
+
+				Expect(w.Code).To(Equal(http.StatusNotModified))
+				Expect(w.Header().Get("ETag")).To(Equal(`W/"342-500"`))
+				Expect(w.Body.Len()).To(BeZero())
+			})
+		})
+
+		When("the client's ETag is outdated", func() {
+			It("should return 200 with the new counts", func() {
+				w := get(`W/"341-500"`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+		})
+	})
+
+	Describe("CheckInWalkIn", func() {
+		post := func(body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(
+				http.MethodPost, "/events/"+eventID.String()+"/checkin/walk-in", bytes.NewBufferString(body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the walk-in is registered", func() {
+			It("should return 201 with the walk-in participant", func() {
+				participantID := uuid.New()
+				mockUC.EXPECT().CheckInWalkIn(gomock.Any(), userID, false, checkin.WalkInInput{
+					EventID:     eventID,
+					Name:        "Walk-in Guest",
+					Email:       "guest@example.com",
+					CheckedInBy: userID,
+				}).Return(&checkin.CheckInOutput{
+					ID:                uuid.New(),
+					EventID:           eventID,
+					ParticipantID:     participantID,
+					ParticipantName:   "Walk-in Guest",
+					ParticipantEmail:  "guest@example.com",
+					ParticipantWalkIn: true,
+					CheckedInAt:       time.Now(),
+					CheckedInBy:       &userID,
+					Method:            "manual",
+				}, nil)
+
+				w := post(`{"name":"Walk-in Guest","email":"guest@example.com"}`)
+
+				Expect(w.Code).To(Equal(http.StatusCreated))
+				var resp generated.CheckInResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.Outcome).To(Equal(generated.CheckInOutcomeCheckedIn))
+				Expect(resp.ParticipantId).To(HaveValue(Equal(participantID)))
+				Expect(resp.Participant.WalkIn).To(BeTrue())
+				Expect(resp.CheckinMethod).To(HaveValue(Equal(generated.Manual)))
+			})
+		})
+
+		When("the request body is malformed", func() {
+			It("should return 400 Bad Request", func() {
+				w := post(`{"name":`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the event requires consent and the walk-in did not accept it", func() {
+			It("should return 422 Unprocessable Entity", func() {
+				mockUC.EXPECT().CheckInWalkIn(gomock.Any(), userID, false, checkin.WalkInInput{
+					EventID:     eventID,
+					Name:        "Walk-in Guest",
+					CheckedInBy: userID,
+				}).Return(nil, apperrors.Unprocessable(
+					"cannot check in: this event requires the walk-in to accept its consent terms",
+				))
+
+				w := post(`{"name":"Walk-in Guest","consent_accepted":false}`)
+
+				Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(w.Body.String()).To(ContainSubstring("consent"))
+			})
+		})
+
+		When("the email is already registered for the event", func() {
+			It("should return 409 Conflict", func() {
+				mockUC.EXPECT().CheckInWalkIn(gomock.Any(), userID, false, gomock.Any()).
+					Return(nil, apperrors.Conflict("participant with this email already exists for this event"))
+
+				w := post(`{"name":"Walk-in Guest","email":"guest@example.com"}`)
+
+				Expect(w.Code).To(Equal(http.StatusConflict))
+			})
+		})
+	})
+
+	Describe("CheckInParticipant", func() {
+		const qrCode = "evt_qr_token"
+
+		scan := func() (*httptest.ResponseRecorder, generated.CheckInResponse) {
+			req := httptest.NewRequest(
+				http.MethodPost, "/events/"+eventID.String()+"/checkin",
+				bytes.NewBufferString(`{"method":"qrcode","qr_code":"`+qrCode+`"}`),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			var resp generated.CheckInResponse
+			if w.Code == http.StatusOK {
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			}
+			return w, resp
+		}
+
+		expectCheckIn := func() *gomock.Call {
+			code := qrCode
+			return mockUC.EXPECT().CheckIn(gomock.Any(), userID, false, checkin.CheckInInput{
+				EventID:     eventID,
+				Method:      "qrcode",
+				QRCode:      &code,
+				CheckedInBy: userID,
+			})
+		}
+
+		When("the participant is checked in", func() {
+			It("should return the checked_in outcome with the check-in", func() {
+				checkinID := uuid.New()
+				expectCheckIn().Return(&checkin.CheckInOutput{
+					ID:              checkinID,
+					EventID:         eventID,
+					ParticipantID:   uuid.New(),
+					ParticipantName: "Jane Smith",
+					CheckedInAt:     time.Now(),
+					Method:          "qrcode",
+				}, nil)
+
+				w, resp := scan()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(resp.Outcome).To(Equal(generated.CheckInOutcomeCheckedIn))
+				Expect(resp.StatusBadge).To(Equal(generated.Success))
+				Expect(resp.DisplayName).To(HaveValue(Equal("Jane Smith")))
+				Expect(resp.Id).To(HaveValue(Equal(checkinID)))
+			})
+		})
+
+		When("the participant has already checked in", func() {
+			It("should return the already_checked_in outcome with the participant's name", func() {
+				expectCheckIn().Return(nil, apperrors.WrapAppError(
+					apperrors.Conflict("participant has already checked in"),
+					&checkin.AlreadyCheckedInError{ParticipantName: "Jane Smith"},
+				))
+
+				w, resp := scan()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(resp.Outcome).To(Equal(generated.CheckInOutcomeAlreadyCheckedIn))
+				Expect(resp.StatusBadge).To(Equal(generated.Warning))
+				Expect(resp.DisplayName).To(HaveValue(Equal("Jane Smith")))
+				Expect(resp.Id).To(BeNil())
+			})
+		})
+
+		DescribeTable("recording the scanning station",
+			func(body, header, wantDeviceID string) {
+				code, source := qrCode, "scanner-app"
+				mockUC.EXPECT().CheckIn(gomock.Any(), userID, false, checkin.CheckInInput{
+					EventID:     eventID,
+					Method:      "qrcode",
+					QRCode:      &code,
+					CheckedInBy: userID,
+					Source:      &source,
+					DeviceID:    &wantDeviceID,
+				}).Return(&checkin.CheckInOutput{
+					ID:          uuid.New(),
+					EventID:     eventID,
+					CheckedInAt: time.Now(),
+					Method:      "qrcode",
+					Source:      &source,
+					DeviceID:    &wantDeviceID,
+				}, nil)
+
+				req := httptest.NewRequest(
+					http.MethodPost, "/events/"+eventID.String()+"/checkin", bytes.NewBufferString(body),
+				)
+				req.Header.Set("Content-Type", "application/json")
+				req.Header.Set("X-Device-Id", header)
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.CheckInResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.Source).To(HaveValue(Equal("scanner-app")))
+				Expect(resp.DeviceId).To(HaveValue(Equal(wantDeviceID)))
+			},
+			Entry("from the X-Device-Id header",
+				`{"method":"qrcode","qr_code":"`+qrCode+`","source":"scanner-app"}`, "gate-a-01", "gate-a-01"),
+			Entry("from the body in preference to the header",
+				`{"method":"qrcode","qr_code":"`+qrCode+`","source":"scanner-app","device_id":"gate-b-02"}`,
+				"gate-a-01", "gate-b-02"),
+		)
+
+		When("the code matches no participant", func() {
+			It("should return the not_found outcome", func() {
+				expectCheckIn().Return(nil, fmt.Errorf(
+					"%w: %w", checkin.ErrParticipantNotFound, apperrors.NotFound("invalid QR code or participant not found"),
+				))
+
+				w, resp := scan()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(resp.Outcome).To(Equal(generated.CheckInOutcomeNotFound))
+				Expect(resp.StatusBadge).To(Equal(generated.Error))
+				Expect(resp.DisplayName).To(BeNil())
+			})
+		})
+
+		When("the participant belongs to another event", func() {
+			It("should return the wrong_event outcome", func() {
+				expectCheckIn().Return(nil, apperrors.WrapAppError(
+					apperrors.BadRequest("participant does not belong to this event"), checkin.ErrParticipantNotInEvent,
+				))
+
+				w, resp := scan()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(resp.Outcome).To(Equal(generated.CheckInOutcomeWrongEvent))
+				Expect(resp.StatusBadge).To(Equal(generated.Error))
+			})
+		})
+
+		When("the event's check-in window is closed", func() {
+			It("should return the outside_window outcome", func() {
+				expectCheckIn().Return(nil, apperrors.WrapAppError(
+					apperrors.Unprocessable("cannot check in: check-in for this event is open from ..."),
+					checkin.ErrOutsideCheckinWindow,
+				))
+
+				w, resp := scan()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(resp.Outcome).To(Equal(generated.CheckInOutcomeOutsideWindow))
+				Expect(resp.StatusBadge).To(Equal(generated.Error))
+				Expect(resp.DisplayName).To(BeNil())
+			})
+		})
+
+		When("the event does not exist", func() {
+			It("should keep the 404 Not Found status", func() {
+				expectCheckIn().Return(nil, apperrors.NotFound("event not found"))
+
+				w, _ := scan()
+
+				Expect(w.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the participant may not check in", func() {
+			It("should keep the 422 Unprocessable Entity status", func() {
+				expectCheckIn().Return(nil, apperrors.Unprocessable(
+					"cannot check in: participant has not accepted the consent terms for this event",
+				))
+
+				w, _ := scan()
+
+				Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+			})
+		})
+	})
+
+	Describe("GetScanAnalytics", func() {
+		getScanAnalytics := func(query string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/scan-analytics"+query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("scans were recorded", func() {
+			It("should return the totals and timeline for the requested interval", func() {
+				start := time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC)
+				counts := checkin.ScanOutcomeCounts{Success: 40, Duplicate: 2, NotFound: 12, Invalid: 1, Total: 55}
+				mockUC.EXPECT().GetScanAnalytics(gomock.Any(), userID, false, eventID, 5*time.Minute).
+					Return(&checkin.ScanAnalyticsOutput{
+						EventID:  eventID,
+						Bucket:   5 * time.Minute,
+						Totals:   counts,
+						Timeline: []checkin.ScanBucket{{Start: start, Counts: counts}},
+					}, nil)
+
+				w := getScanAnalytics("?interval_minutes=5")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.ScanAnalyticsResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.IntervalMinutes).To(Equal(5))
+				Expect(resp.Totals).To(Equal(generated.ScanOutcomeCounts{
+					Success: 40, Duplicate: 2, NotFound: 12, Invalid: 1, Total: 55,
+				}))
+				Expect(resp.Timeline).To(HaveLen(1))
+				Expect(resp.Timeline[0].Start.Equal(start)).To(BeTrue())
+				Expect(resp.Timeline[0].Counts.NotFound).To(Equal(12))
+			})
+		})
+
+		When("no interval is given and no scans were recorded", func() {
+			It("should use the default interval and return an empty timeline rather than null", func() {
+				mockUC.EXPECT().GetScanAnalytics(gomock.Any(), userID, false, eventID, time.Duration(0)).
+					Return(&checkin.ScanAnalyticsOutput{
+						EventID:  eventID,
+						Bucket:   checkin.DefaultScanBucket,
+						Timeline: []checkin.ScanBucket{},
+					}, nil)
+
+				w := getScanAnalytics("")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(`"timeline":[]`))
+				Expect(w.Body.String()).To(ContainSubstring(`"interval_minutes":15`))
+			})
+		})
+	})
+
+	Describe("GetCheckInTimeseries", func() {
+		getTimeseries := func(query string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/checkins/timeseries"+query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("an interval is requested", func() {
+			It("should return the buckets for the interval", func() {
+				start := time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC)
+				buckets := []checkin.TimeseriesBucket{{Start: start, Count: 25}, {Start: start.Add(time.Hour)}}
+				gateA := "gate-a-01"
+				mockUC.EXPECT().GetTimeseries(gomock.Any(), userID, false, eventID, time.Hour).
+					Return(&checkin.TimeseriesOutput{
+						EventID:  eventID,
+						Interval: time.Hour,
+						Total:    25,
+						Buckets:  buckets,
+						ByDevice: []checkin.TimeseriesDevice{{DeviceID: &gateA, Total: 25, Buckets: buckets}},
+					}, nil)
+
+				w := getTimeseries("?interval=1h")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.CheckInTimeseriesResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.Interval).To(Equal("1h"))
+				Expect(resp.Total).To(Equal(25))
+				Expect(resp.Buckets).To(HaveLen(2))
+				Expect(resp.Buckets[0].Start.Equal(start)).To(BeTrue())
+				Expect(resp.Buckets[1].Count).To(BeZero())
+				Expect(resp.ByDevice).To(HaveLen(1))
+				Expect(resp.ByDevice[0].DeviceId).To(HaveValue(Equal("gate-a-01")))
+				Expect(resp.ByDevice[0].Total).To(Equal(25))
+				Expect(resp.ByDevice[0].Buckets).To(HaveLen(2))
+			})
+		})
+
+		When("no interval is given", func() {
+			It("should use the default interval", func() {
+				mockUC.EXPECT().GetTimeseries(gomock.Any(), userID, false, eventID, time.Duration(0)).
+					Return(&checkin.TimeseriesOutput{
+						EventID:  eventID,
+						Interval: checkin.DefaultTimeseriesInterval,
+						Buckets:  []checkin.TimeseriesBucket{},
+					}, nil)
+
+				w := getTimeseries("")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(`"interval":"15m"`))
+			})
+		})
+
+		When("the interval is not supported", func() {
+			It("should return 400 Bad Request", func() {
+				w := getTimeseries("?interval=2h")
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("GetCheckInsByStaff", func() {
+		getByStaff := func() *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/checkins/by-staff", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("check-ins were recorded by staff and by participants themselves", func() {
+			It("should return the per-staff counts and self check-ins", func() {
+				staffID := uuid.New()
+				mockUC.EXPECT().GetStaffAttribution(gomock.Any(), userID, false, eventID).
+					Return(&checkin.StaffAttributionOutput{
+						EventID:      eventID,
+						Staff:        []checkin.StaffCheckinCount{{UserID: staffID, Name: "Alice", CheckinCount: 120}},
+						SelfCheckins: 42,
+						Total:        162,
+					}, nil)
+
+				w := getByStaff()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.CheckInsByStaffResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.Staff).To(HaveLen(1))
+				Expect(uuid.UUID(resp.Staff[0].UserId)).To(Equal(staffID))
+				Expect(resp.Staff[0].Name).To(Equal("Alice"))
+				Expect(resp.Staff[0].CheckinCount).To(Equal(120))
+				Expect(resp.SelfCheckins).To(Equal(42))
+				Expect(resp.Total).To(Equal(162))
+			})
+		})
+
+		When("the event has no check-ins", func() {
+			It("should return an empty staff list rather than null", func() {
+				mockUC.EXPECT().GetStaffAttribution(gomock.Any(), userID, false, eventID).
+					Return(&checkin.StaffAttributionOutput{EventID: eventID}, nil)
+
+				w := getByStaff()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(`"staff":[]`))
+			})
+		})
+
+		When("the user does not manage the event", func() {
+			It("should return 403 Forbidden", func() {
+				mockUC.EXPECT().GetStaffAttribution(gomock.Any(), userID, false, eventID).
+					Return(nil, apperrors.Forbidden("you do not have permission to view check-in attribution for this event"))
+
+				w := getByStaff()
+
+				Expect(w.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("RestoreCheckIn", func() {
+		var checkinID uuid.UUID
+
+		restore := func() *httptest.ResponseRecorder {
+			req := httptest.NewRequest(
+				http.MethodPost, "/events/"+eventID.String()+"/checkins/"+checkinID.String()+"/restore", nil,
+			)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		BeforeEach(func() {
+			checkinID = uuid.New()
+		})
+
+		When("the check-in is restored", func() {
+			It("should return 200 with the restored check-in", func() {
+				checkedInAt := time.Date(2025, 12, 15, 9, 15, 0, 0, time.UTC)
+				mockUC.EXPECT().Restore(gomock.Any(), userID, false, eventID, checkinID).Return(&checkin.CheckInOutput{
+					ID:               checkinID,
+					EventID:          eventID,
+					ParticipantID:    uuid.New(),
+					ParticipantName:  "Alice",
+					ParticipantEmail: "alice@example.com",
+					CheckedInAt:      checkedInAt,
+					Method:           "qrcode",
+				}, nil)
+
+				w := restore()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.CheckInResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.Id).To(HaveValue(Equal(checkinID)))
+				Expect(resp.CheckedInAt).To(HaveValue(Equal(checkedInAt)))
+			})
+		})
+
+		When("the undo window has passed", func() {
+			It("should return 400 Bad Request", func() {
+				mockUC.EXPECT().Restore(gomock.Any(), userID, false, eventID, checkinID).
+					Return(nil, apperrors.BadRequest("check-in can no longer be restored: the undo window has passed"))
+
+				w := restore()
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+				Expect(w.Body.String()).To(ContainSubstring("undo window"))
+			})
+		})
+	})
+
+	Describe("BulkCheckIn", func() {
+		bulkCheckIn := func(body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(
+				http.MethodPost, "/events/"+eventID.String()+"/checkin/bulk", bytes.NewBufferString(body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the participants are checked in", func() {
+			It("should return 200 with the counts and errors", func() {
+				first, second := uuid.New(), uuid.New()
+				mockUC.EXPECT().BulkCheckIn(gomock.Any(), userID, false, checkin.BulkCheckInInput{
+					EventID:        eventID,
+					ParticipantIDs: []uuid.UUID{first, second},
+					CheckedInBy:    userID,
+				}).Return(&checkin.BulkCheckInOutput{
+					CheckedInCount: 1,
+					Errors: []checkin.BulkCheckInError{
+						{ParticipantID: second, Reason: "participant not found"},
+					},
+				}, nil)
+
+				w := bulkCheckIn(`{"participant_ids":["` + first.String() + `","` + second.String() + `"]}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.BulkCheckInResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.CheckedInCount).To(Equal(1))
+				Expect(resp.SkippedCount).To(BeZero())
+				Expect(resp.Errors).To(HaveLen(1))
+				Expect(uuid.UUID(resp.Errors[0].ParticipantId)).To(Equal(second))
+				Expect(resp.Errors[0].Reason).To(Equal("participant not found"))
+			})
+		})
+
+		When("the usecase rejects the request", func() {
+			It("should return the error status", func() {
+				mockUC.EXPECT().BulkCheckIn(gomock.Any(), userID, false, gomock.Any()).
+					Return(nil, apperrors.Forbidden("forbidden"))
+
+				w := bulkCheckIn(`{"participant_ids":["` + uuid.New().String() + `"]}`)
+
+				Expect(w.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("the request body is invalid", func() {
+			It("should return 400 Bad Request", func() {
+				w := bulkCheckIn(`{`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("CheckOutParticipant", func() {
+		checkOut := func(body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(
+				http.MethodPost, "/events/"+eventID.String()+"/checkout", bytes.NewBufferString(body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the participant is checked out by ID", func() {
+			It("should return 204 No Content", func() {
+				participantID := uuid.New()
+				mockUC.EXPECT().CheckOut(gomock.Any(), userID, false, checkin.CheckOutInput{
+					EventID:       eventID,
+					ParticipantID: &participantID,
+				}).Return(nil)
+
+				w := checkOut(`{"participant_id":"` + participantID.String() + `"}`)
+
+				Expect(w.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		When("the participant is checked out by QR code", func() {
+			It("should pass the QR code to the usecase", func() {
+				qrCode := "qr-token"
+				mockUC.EXPECT().CheckOut(gomock.Any(), userID, false, checkin.CheckOutInput{
+					EventID: eventID,
+					QRCode:  &qrCode,
+				}).Return(nil)
+
+				w := checkOut(`{"qr_code":"qr-token"}`)
+
+				Expect(w.Code).To(Equal(http.StatusNoContent))
+			})
+		})
+
+		When("the participant is not checked in", func() {
+			It("should return 404 Not Found", func() {
+				mockUC.EXPECT().CheckOut(gomock.Any(), userID, false, gomock.Any()).
+					Return(apperrors.NotFound("participant is not checked in"))
+
+				w := checkOut(`{"qr_code":"qr-token"}`)
+
+				Expect(w.Code).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("the request body is invalid", func() {
+			It("should return 400 Bad Request", func() {
+				w := checkOut(`{`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("StreamCheckIns", func() {
+		stream := func() *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/checkins/stream", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("check-ins are created while streaming", func() {
+			It("should send each check-in as a Server-Sent Event until the stream ends", func() {
+				checkIns := make(chan *checkin.CheckInOutput, 1)
+				checkinID := uuid.New()
+				checkIns <- &checkin.CheckInOutput{
+					ID:              checkinID,
+					EventID:         eventID,
+					ParticipantID:   uuid.New(),
+					ParticipantName: "Alice",
+					CheckedInAt:     time.Date(2025, 12, 15, 9, 15, 0, 0, time.UTC),
+					Method:          "qrcode",
+				}
+				close(checkIns)
+				mockUC.EXPECT().StreamCheckIns(gomock.Any(), userID, false, eventID).
+					Return(&checkin.CheckInStream{CheckIns: checkIns}, nil)
+
+				w := stream()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get("Content-Type")).To(HavePrefix("text/event-stream"))
+				Expect(w.Body.String()).To(HavePrefix("event:checkin\ndata:"))
+				Expect(w.Body.String()).To(ContainSubstring(`"id":"` + checkinID.String() + `"`))
+				Expect(w.Body.String()).To(ContainSubstring(`"name":"Alice"`))
+			})
+		})
+
+		When("the server shuts down while streaming", func() {
+			It("should end the stream with a shutdown event", func() {
+				drain := middleware.NewDrain()
+				router = newCheckinHandlerRouter(mockUC, userID, newTestLogger(), drain.Middleware())
+				mockUC.EXPECT().StreamCheckIns(gomock.Any(), userID, false, eventID).DoAndReturn(
+					func(context.Context, uuid.UUID, bool, uuid.UUID) (*checkin.CheckInStream, error) {
+						drain.Start()
+						return &checkin.CheckInStream{CheckIns: make(chan *checkin.CheckInOutput)}, nil
+					})
+
+				w := stream()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(HavePrefix("event:shutdown\ndata:"))
+				Expect(w.Body.String()).To(ContainSubstring("server is shutting down"))
+			})
+		})
+
+		When("the user does not manage the event", func() {
+			It("should return 403 Forbidden", func() {
+				mockUC.EXPECT().StreamCheckIns(gomock.Any(), userID, false, eventID).
+					Return(nil, apperrors.Forbidden("you do not have permission to stream check-ins for this event"))
+
+				Expect(stream().Code).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("GetCheckInProgress errors", func() {
+		When("the user does not manage the event", func() {
+			It("should return 403 Forbidden", func() {
+				mockUC.EXPECT().GetProgress(gomock.Any(), userID, false, eventID).
+					Return(nil, apperrors.Forbidden("you do not have permission to view check-in progress for this event"))
+
+				w := get("")
+
+				Expect(w.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+	Describe("GetCheckInHistory", func() {
+		var participantID uuid.UUID
+
+		history := func() *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/participants/"+participantID.String()+"/checkin-history", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		BeforeEach(func() { participantID = uuid.New() })
+
+		When("the participant checked out and in again", func() {
+			It("should return both check-ins with the end of the first", func() {
+				firstID, secondID := uuid.New(), uuid.New()
+				checkedInAt := time.Date(2025, 12, 15, 9, 0, 0, 0, time.UTC)
+				checkedOutAt := time.Date(2025, 12, 15, 12, 0, 0, 0, time.UTC)
+				mockUC.EXPECT().GetHistory(gomock.Any(), userID, false, participantID).Return([]*entity.Checkin{
+					{ID: firstID, CheckedInAt: checkedInAt, CheckedInBy: &userID, Method: entity.CheckinMethodQRCode,
+						CancelledAt: &checkedOutAt, CancelledBy: &userID},
+					{ID: secondID, CheckedInAt: checkedOutAt.Add(time.Hour), Method: entity.CheckinMethodQRCode},
+				}, nil)
+
+				w := history()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.CheckInHistoryResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.ParticipantId).To(Equal(participantID))
+				Expect(resp.Checkins).To(HaveLen(2))
+				Expect(resp.Checkins[0].Id).To(Equal(firstID))
+				Expect(*resp.Checkins[0].CancelledAt).To(Equal(checkedOutAt))
+				Expect(*resp.Checkins[0].CancelledBy).To(Equal(userID))
+				Expect(resp.Checkins[1].Id).To(Equal(secondID))
+				Expect(resp.Checkins[1].CheckedInBy).To(BeNil())
+				Expect(resp.Checkins[1].CancelledAt).To(BeNil())
+			})
+		})
+
+		When("the participant has never checked in", func() {
+			It("should return an empty list", func() {
+				mockUC.EXPECT().GetHistory(gomock.Any(), userID, false, participantID).Return([]*entity.Checkin{}, nil)
+
+				w := history()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(`"checkins":[]`))
+			})
+		})
+
+		When("the user does not manage the participant's event", func() {
+			It("should return 403 Forbidden", func() {
+				mockUC.EXPECT().GetHistory(gomock.Any(), userID, false, participantID).
+					Return(nil, apperrors.Forbidden("you do not have permission to view check-in history for this event"))
+
+				Expect(history().Code).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("GetPublicCheckInStatus", func() {
+		status := func(token string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/public/checkin-status?token="+token, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the token is valid", func() {
+			It("should return the event name, participant name and check-in state only", func() {
+				mockUC.EXPECT().GetPublicStatus(gomock.Any(), "qrt_token.sig").Return(&checkin.PublicCheckInStatusOutput{
+					EventName:       "Tech Conference 2025",
+					ParticipantName: "Jane Smith",
+					IsCheckedIn:     true,
+				}, nil)
+
+				w := status("qrt_token.sig")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var body map[string]any
+				Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+				Expect(body).To(Equal(map[string]any{
+					"event_name":       "Tech Conference 2025",
+					"participant_name": "Jane Smith",
+					"checked_in":       true,
+				}))
+			})
+		})
+
+		When("the token is invalid", func() {
+			It("should return 404 Not Found", func() {
+				mockUC.EXPECT().GetPublicStatus(gomock.Any(), "forged").
+					Return(nil, apperrors.NotFound("invalid QR code or participant not found"))
+
+				Expect(status("forged").Code).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
+})
@@ -0,0 +1,73 @@
+package handler
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// setLastModified writes the Last-Modified header when a modification time is known.
+func setLastModified(c *gin.Context, lastModified time.Time) {
+	if lastModified.IsZero() {
+		return
+	}
+	c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+}
+
+// notModified reports whether the request's If-Modified-Since header is at or after lastModified.
+// HTTP dates have second precision, so lastModified is truncated before comparison.
+func notModified(c *gin.Context, lastModified time.Time) bool {
+	if lastModified.IsZero() {
+		return false
+	}
+	header := c.GetHeader("If-Modified-Since")
+	if header == "" {
+		return false
+	}
+	since, err := http.ParseTime(header)
+	if err != nil {
+		return false
+	}
+	return !lastModified.Truncate(time.Second).After(since)
+}
+
+// respondNotModified sets Last-Modified and aborts with 304 when the client's copy is current.
+// Returns true when the response has been written.
+func respondNotModified(c *gin.Context, lastModified time.Time) bool {
+	setLastModified(c, lastModified)
+	if !notModified(c, lastModified) {
+		return false
+	}
+	c.Status(http.StatusNotModified)
+	return true
+}
+
+// etagMatches reports whether the request's If-None-Match header lists etag or "*".
+// Comparison is weak, so W/ prefixes are ignored on both sides.
+func etagMatches(c *gin.Context, etag string) bool {
+	header := c.GetHeader("If-None-Match")
+	if header == "" {
+		return false
+	}
+	want := strings.TrimPrefix(etag, "W/")
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == want {
+			return true
+		}
+	}
+	return false
+}
+
+// respondNotModifiedETag sets ETag and aborts with 304 when the client's copy is current.
+// Returns true when the response has been written.
+func respondNotModifiedETag(c *gin.Context, etag string) bool {
+	c.Header("ETag", etag)
+	if !etagMatches(c, etag) {
+		return false
+	}
+	c.Status(http.StatusNotModified)
+	return true
+}
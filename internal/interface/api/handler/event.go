@@ -1,38 +1,45 @@
 package handler
 
 import (
+	"errors"
+	"io"
 	"net/http"
 	"time"
 
+	"github.com/fumkob/ezqrin-server/config"
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	"github.com/fumkob/ezqrin-server/internal/usecase/event"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	openapi_types "github.com/oapi-codegen/runtime/types"
 	"go.uber.org/zap"
 )
 
-const (
-	defaultPerPage = 20
-)
-
 // EventHandler handles event-related endpoints.
 // Implements generated.ServerInterface for OpenAPI compliance.
 type EventHandler struct {
-	usecase event.Usecase
-	logger  *logger.Logger
+	usecase  event.Usecase
+	pageSize config.PageSizeConfig
+	logger   *logger.Logger
 }
 
 // NewEventHandler creates a new EventHandler
-func NewEventHandler(usecase event.Usecase, logger *logger.Logger) *EventHandler {
+func NewEventHandler(
+	usecase event.Usecase,
+	pageSize config.PageSizeConfig,
+	logger *logger.Logger,
+) *EventHandler {
 	return &EventHandler{
-		usecase: usecase,
-		logger:  logger,
+		usecase:  usecase,
+		pageSize: pageSize,
+		logger:   logger,
 	}
 }
 
@@ -50,19 +57,12 @@ func (h *EventHandler) GetEvents(c *gin.Context, params generated.GetEventsParam
 	input := event.ListEventsInput{
 		OrganizerID: organizerID,
 		Search:      "",
-		Page:        1,
-		PerPage:     defaultPerPage,
 	}
+	input.Page, input.PerPage = parsePagination(params.Page, params.PerPage, h.pageSize)
 
 	if params.Name != nil {
 		input.Search = *params.Name
 	}
-	if params.Page != nil {
-		input.Page = int(*params.Page)
-	}
-	if params.PerPage != nil {
-		input.PerPage = int(*params.PerPage)
-	}
 	if params.Status != nil {
 		status := entity.EventStatus(*params.Status)
 		input.Status = &status
@@ -73,6 +73,31 @@ func (h *EventHandler) GetEvents(c *gin.Context, params generated.GetEventsParam
 	if params.Order != nil {
 		input.Order = string(*params.Order)
 	}
+	if params.StartFrom != nil {
+		utcFrom := params.StartFrom.UTC()
+		input.StartDateFrom = &utcFrom
+	}
+	if params.StartTo != nil {
+		utcTo := params.StartTo.UTC()
+		input.StartDateTo = &utcTo
+	}
+	if params.IncludeDeleted != nil && *params.IncludeDeleted {
+		if role != string(entity.RoleAdmin) {
+			response.ProblemFromError(c, apperrors.Forbidden("only admins can list deleted events"))
+			return
+		}
+		input.IncludeDeleted = true
+	}
+	input.Cursor = params.Cursor
+
+	lastModified, err := h.usecase.GetListLastModified(c.Request.Context(), input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+	if respondNotModified(c, lastModified) {
+		return
+	}
 
 	output, err := h.usecase.List(c.Request.Context(), input)
 	if err != nil {
@@ -81,18 +106,31 @@ func (h *EventHandler) GetEvents(c *gin.Context, params generated.GetEventsParam
 	}
 
 	events := make([]generated.Event, len(output.Events))
+	relevance := make(map[string]float64)
 	for i, e := range output.Events {
 		events[i] = h.toGeneratedEvent(e)
+		if e.SearchRank != nil {
+			relevance[e.ID.String()] = *e.SearchRank
+		}
 	}
 
 	resp := generated.EventListResponse{
 		Data: events,
-		Meta: generated.PaginationMeta{
-			Page:       input.Page,
-			PerPage:    input.PerPage,
-			Total:      int(output.TotalCount),
-			TotalPages: int((output.TotalCount + int64(input.PerPage) - 1) / int64(input.PerPage)),
-		},
+		Meta: generated.EventListMeta{PerPage: input.PerPage},
+	}
+	if len(relevance) > 0 {
+		resp.Meta.Relevance = &relevance
+	}
+	if input.Cursor != nil {
+		if output.NextCursor != "" {
+			resp.Meta.NextCursor = &output.NextCursor
+		}
+	} else {
+		total := int(output.TotalCount)
+		totalPages := int((output.TotalCount + int64(input.PerPage) - 1) / int64(input.PerPage))
+		resp.Meta.Page = &input.Page
+		resp.Meta.Total = &total
+		resp.Meta.TotalPages = &totalPages
 	}
 
 	response.Data(c, http.StatusOK, resp)
@@ -115,6 +153,8 @@ func (h *EventHandler) PostEvents(c *gin.Context) {
 		StartDate:   req.StartDate.UTC(),
 		Status:      entity.EventStatus(req.Status),
 	}
+	// Values that cannot be converted are all reported at once; the usecase validates the rest
+	var fields apperrors.FieldErrors
 
 	if req.Description != nil {
 		input.Description = *req.Description
@@ -128,13 +168,43 @@ func (h *EventHandler) PostEvents(c *gin.Context) {
 	}
 	if req.Timezone != nil {
 		if _, err := time.LoadLocation(*req.Timezone); err != nil {
-			response.ProblemFromError(c, apperrors.BadRequest("invalid IANA timezone identifier"))
-			return
+			fields.Add("timezone", "invalid IANA timezone identifier")
 		}
 		input.Timezone = *req.Timezone
 	} else {
 		input.Timezone = "UTC"
 	}
+	if req.Currency != nil {
+		if !money.IsKnownCurrency(*req.Currency) {
+			fields.Add("currency", "invalid ISO 4217 currency code")
+		}
+		input.Currency = *req.Currency
+	}
+	if req.Fee != nil {
+		input.Fee = toFeeInput(*req.Fee, &fields)
+	}
+	if req.RequiresConsent != nil {
+		input.RequiresConsent = *req.RequiresConsent
+	}
+	if req.ConsentVersion != nil {
+		input.ConsentVersion = *req.ConsentVersion
+	}
+	if req.TentativeExpiryHours != nil {
+		input.TentativeExpiryHours = *req.TentativeExpiryHours
+	}
+	if req.Capacity != nil {
+		input.Capacity = *req.Capacity
+	}
+	if req.Recurrence != nil {
+		if !req.Recurrence.Frequency.Valid() {
+			fields.Add("recurrence", "invalid recurrence frequency")
+		}
+		input.Recurrence = toRecurrenceRule(*req.Recurrence)
+	}
+	if err := fields.Err(); err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
 
 	evt, err := h.usecase.Create(c.Request.Context(), input)
 	if err != nil {
@@ -161,12 +231,23 @@ func (h *EventHandler) GetEventsId(c *gin.Context, id generated.EventIDParam) {
 	// For detail, let's keep it consistent.
 	role := middleware.GetUserRole(c)
 	userID, _ := middleware.GetUserID(c)
-	if role != string(entity.RoleAdmin) && evt.OrganizerID != userID {
-		response.ProblemFromError(c, apperrors.Forbidden("you do not have permission to view this event"))
+	if err := authz.RequireEventManager(userID, evt, role == string(entity.RoleAdmin), "view this event"); err != nil {
+		response.ProblemFromError(c, err)
 		return
 	}
 
-	response.Data(c, http.StatusOK, h.toGeneratedEvent(evt))
+	spots, err := h.usecase.SpotsRemaining(c.Request.Context(), evt)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	genEvent := h.toGeneratedEvent(evt)
+	if spots != nil {
+		remaining := int(*spots)
+		genEvent.SpotsRemaining = &remaining
+	}
+	response.Data(c, http.StatusOK, genEvent)
 }
 
 // PutEventsId handles event update (PUT /events/{id}).
@@ -214,6 +295,20 @@ func (h *EventHandler) DeleteEventsId(c *gin.Context, id generated.EventIDParam)
 	c.Status(http.StatusNoContent)
 }
 
+// PostEventsIdRestore handles restoring a deleted event (POST /events/{id}/restore).
+func (h *EventHandler) PostEventsIdRestore(c *gin.Context, id generated.EventIDParam) {
+	role := middleware.GetUserRole(c)
+	userID, _ := middleware.GetUserID(c)
+
+	evt, err := h.usecase.Restore(c.Request.Context(), uuid.UUID(id), userID, role == string(entity.RoleAdmin))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, h.toGeneratedEvent(evt))
+}
+
 // GetEventsIdStats handles getting event statistics (GET /events/{id}/stats).
 func (h *EventHandler) GetEventsIdStats(c *gin.Context, id generated.EventIDParam) {
 	eventID := uuid.UUID(id)
@@ -227,29 +322,298 @@ func (h *EventHandler) GetEventsIdStats(c *gin.Context, id generated.EventIDPara
 		return
 	}
 
-	eventIDStr := openapi_types.UUID(output.EventID)
+	response.Data(c, http.StatusOK, toEventStatsResponse(output))
+}
+
+// GetEventsIdOccurrences handles listing the occurrences of a recurring event (GET /events/{id}/occurrences).
+func (h *EventHandler) GetEventsIdOccurrences(c *gin.Context, id generated.EventIDParam) {
+	role := middleware.GetUserRole(c)
+	userID, _ := middleware.GetUserID(c)
+
+	occurrences, err := h.usecase.ListOccurrences(
+		c.Request.Context(), uuid.UUID(id), userID, role == string(entity.RoleAdmin),
+	)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, h.toOccurrencesResponse(occurrences))
+}
 
+// PostEventsIdOccurrencesCancel handles cancelling a recurring event series
+// (POST /events/{id}/occurrences/cancel).
+func (h *EventHandler) PostEventsIdOccurrencesCancel(c *gin.Context, id generated.EventIDParam) {
+	role := middleware.GetUserRole(c)
+	userID, _ := middleware.GetUserID(c)
+
+	occurrences, err := h.usecase.CancelSeries(
+		c.Request.Context(), uuid.UUID(id), userID, role == string(entity.RoleAdmin),
+	)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, h.toOccurrencesResponse(occurrences))
+}
+
+// PostEventsIdClone handles copying an event into a new draft event (POST /events/{id}/clone).
+func (h *EventHandler) PostEventsIdClone(c *gin.Context, id generated.EventIDParam) {
+	var req generated.CloneEventRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	role := middleware.GetUserRole(c)
+	userID, _ := middleware.GetUserID(c)
+
+	overrides := event.CloneOverrides{Name: req.Name}
+	if req.StartDate != nil {
+		utcStart := req.StartDate.UTC()
+		overrides.StartDate = &utcStart
+	}
+	if req.EndDate != nil {
+		utcEnd := req.EndDate.UTC()
+		overrides.EndDate = &utcEnd
+	}
+
+	evt, err := h.usecase.Clone(
+		c.Request.Context(), uuid.UUID(id), userID, role == string(entity.RoleAdmin), overrides,
+	)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusCreated, h.toGeneratedEvent(evt))
+}
+
+// PutEventsIdParticipantFields handles replacing the custom participant fields of an event
+// (PUT /events/{id}/participant-fields).
+func (h *EventHandler) PutEventsIdParticipantFields(c *gin.Context, id generated.EventIDParam) {
+	var req generated.PutEventsIdParticipantFieldsJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	role := middleware.GetUserRole(c)
+	userID, _ := middleware.GetUserID(c)
+
+	evt, err := h.usecase.UpdateParticipantFields(
+		c.Request.Context(), uuid.UUID(id), userID, role == string(entity.RoleAdmin), toCustomFields(req.Fields),
+	)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, h.toGeneratedEvent(evt))
+}
+
+// PutEventsIdLogo handles uploading the logo overlaid on an event's QR codes (PUT /events/{id}/logo).
+// The request body is the PNG or JPEG image itself.
+func (h *EventHandler) PutEventsIdLogo(c *gin.Context, id generated.EventIDParam) {
+	logo, err := io.ReadAll(http.MaxBytesReader(c.Writer, c.Request.Body, event.MaxLogoBytes))
+	if err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.ProblemFromError(c, apperrors.BadRequest("logo image too large (max 1MB)"))
+			return
+		}
+		h.logger.WithContext(c.Request.Context()).Warn("failed to read logo", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	role := middleware.GetUserRole(c)
+	userID, _ := middleware.GetUserID(c)
+
+	err = h.usecase.UpdateLogo(c.Request.Context(), uuid.UUID(id), userID, role == string(entity.RoleAdmin), logo)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// DeleteEventsIdLogo handles removing an event's logo (DELETE /events/{id}/logo).
+func (h *EventHandler) DeleteEventsIdLogo(c *gin.Context, id generated.EventIDParam) {
+	role := middleware.GetUserRole(c)
+	userID, _ := middleware.GetUserID(c)
+
+	err := h.usecase.DeleteLogo(c.Request.Context(), uuid.UUID(id), userID, role == string(entity.RoleAdmin))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// PutEventsIdWebhooks handles setting the webhook that receives an event's check-in and
+// registration notifications (PUT /events/{id}/webhooks).
+func (h *EventHandler) PutEventsIdWebhooks(c *gin.Context, id generated.EventIDParam) {
+	var req generated.PutEventsIdWebhooksJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	role := middleware.GetUserRole(c)
+	userID, _ := middleware.GetUserID(c)
+
+	webhook, err := h.usecase.SetWebhook(
+		c.Request.Context(), uuid.UUID(id), userID, role == string(entity.RoleAdmin),
+		event.WebhookInput{URL: req.Url, Secret: req.Secret},
+	)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, toEventWebhookResponse(webhook))
+}
+
+// GetEventsIdWebhooks handles getting an event's webhook (GET /events/{id}/webhooks).
+func (h *EventHandler) GetEventsIdWebhooks(c *gin.Context, id generated.EventIDParam) {
+	role := middleware.GetUserRole(c)
+	userID, _ := middleware.GetUserID(c)
+
+	webhook, err := h.usecase.GetWebhook(c.Request.Context(), uuid.UUID(id), userID, role == string(entity.RoleAdmin))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, toEventWebhookResponse(webhook))
+}
+
+// DeleteEventsIdWebhooks handles removing an event's webhook (DELETE /events/{id}/webhooks).
+func (h *EventHandler) DeleteEventsIdWebhooks(c *gin.Context, id generated.EventIDParam) {
+	role := middleware.GetUserRole(c)
+	userID, _ := middleware.GetUserID(c)
+
+	err := h.usecase.DeleteWebhook(c.Request.Context(), uuid.UUID(id), userID, role == string(entity.RoleAdmin))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// toEventWebhookResponse converts an event webhook to the API response, leaving out its secret.
+func toEventWebhookResponse(webhook *entity.EventWebhook) generated.EventWebhookResponse {
+	resp := generated.EventWebhookResponse{
+		Url:            webhook.URL,
+		LastDeliveryAt: webhook.LastDeliveryAt,
+		CreatedAt:      webhook.CreatedAt,
+		UpdatedAt:      webhook.UpdatedAt,
+	}
+	if webhook.LastDeliveryStatus != nil {
+		status := generated.EventWebhookResponseLastDeliveryStatus(*webhook.LastDeliveryStatus)
+		resp.LastDeliveryStatus = &status
+	}
+	if webhook.LastDeliveryError != "" {
+		resp.LastDeliveryError = &webhook.LastDeliveryError
+	}
+	return resp
+}
+
+// toOccurrencesResponse converts the occurrences of a series to the API response.
+func (h *EventHandler) toOccurrencesResponse(occurrences []*entity.Event) generated.EventOccurrencesResponse {
+	resp := generated.EventOccurrencesResponse{Data: make([]generated.Event, len(occurrences))}
+	for i, occurrence := range occurrences {
+		resp.Data[i] = h.toGeneratedEvent(occurrence)
+	}
+	return resp
+}
+
+// PostEventsStatsBatch handles getting statistics for several events (POST /events/stats/batch).
+func (h *EventHandler) PostEventsStatsBatch(c *gin.Context) {
+	var req generated.PostEventsStatsBatchJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	role := middleware.GetUserRole(c)
+	userID, _ := middleware.GetUserID(c)
+
+	ids := make([]uuid.UUID, len(req.Ids))
+	for i, id := range req.Ids {
+		ids[i] = uuid.UUID(id)
+	}
+
+	output, err := h.usecase.GetStatsBatch(c.Request.Context(), ids, userID, role == string(entity.RoleAdmin))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	resp := generated.BatchEventStatsResponse{
+		Stats:           make(map[string]generated.EventStatsResponse, len(output.Stats)),
+		InaccessibleIds: make([]openapi_types.UUID, len(output.Inaccessible)),
+	}
+	for id, stats := range output.Stats {
+		resp.Stats[id.String()] = toEventStatsResponse(stats)
+	}
+	for i, id := range output.Inaccessible {
+		resp.InaccessibleIds[i] = openapi_types.UUID(id)
+	}
+
+	response.Data(c, http.StatusOK, resp)
+}
+
+// toEventStatsResponse converts event statistics to the API response.
+func toEventStatsResponse(output event.EventStatsOutput) generated.EventStatsResponse {
 	// Convert ByStatus from map[string]int64 to map[string]int
 	byStatus := make(map[string]int, len(output.ByStatus))
 	for k, v := range output.ByStatus {
 		byStatus[k] = int(v)
 	}
 
+	byGroup := make(map[string]generated.EventGroupStats, len(output.ByGroup))
+	for group, groupStats := range output.ByGroup {
+		byGroup[group] = generated.EventGroupStats{
+			TotalParticipants:     int(groupStats.TotalParticipants),
+			CheckedInParticipants: int(groupStats.CheckedInParticipants),
+		}
+	}
+
 	resp := generated.EventStatsResponse{
-		EventId:               eventIDStr,
+		EventId:               openapi_types.UUID(output.EventID),
 		TotalParticipants:     int(output.TotalParticipants),
 		CheckedInParticipants: int(output.CheckedInParticipants),
 		CheckinRate:           float32(output.CheckinRate),
+		WalkInParticipants:    int(output.WalkInParticipants),
+		GuestParticipants:     int(output.GuestParticipants),
 		ByStatus:              &byStatus,
+		ByGroup:               &byGroup,
+		TotalPaymentAmount:    &output.TotalPaymentAmount,
+		SpotsRemaining:        output.SpotsRemaining,
+		Warnings:              output.Warnings,
 	}
-
-	response.Data(c, http.StatusOK, resp)
+	if output.Currency != "" {
+		resp.Currency = &output.Currency
+	}
+	return resp
 }
 
 // Helpers
 
+// buildUpdateInput converts an update request into the usecase input, reporting all values that
+// cannot be converted at once; the usecase validates the rest.
 func (h *EventHandler) buildUpdateInput(req generated.UpdateEventRequest) (event.UpdateEventInput, error) {
 	input := event.UpdateEventInput{}
+	var fields apperrors.FieldErrors
 	if req.Name != nil {
 		input.Name = req.Name
 	}
@@ -269,14 +633,31 @@ func (h *EventHandler) buildUpdateInput(req generated.UpdateEventRequest) (event
 	}
 	if req.Timezone != nil {
 		if _, err := time.LoadLocation(*req.Timezone); err != nil {
-			return event.UpdateEventInput{}, apperrors.BadRequest("invalid IANA timezone identifier")
+			fields.Add("timezone", "invalid IANA timezone identifier")
 		}
 		input.Timezone = req.Timezone
 	}
+	if req.Currency != nil {
+		if !money.IsKnownCurrency(*req.Currency) {
+			fields.Add("currency", "invalid ISO 4217 currency code")
+		}
+		input.Currency = req.Currency
+	}
+	if req.Fee != nil {
+		input.Fee = toFeeInput(*req.Fee, &fields)
+	}
+	input.RequiresConsent = req.RequiresConsent
+	input.ConsentVersion = req.ConsentVersion
+	input.LegalHold = req.LegalHold
+	input.TentativeExpiryHours = req.TentativeExpiryHours
+	input.Capacity = req.Capacity
 	if req.Status != nil {
 		status := entity.EventStatus(*req.Status)
 		input.Status = &status
 	}
+	if err := fields.Err(); err != nil {
+		return event.UpdateEventInput{}, err
+	}
 	return input, nil
 }
 
@@ -313,6 +694,43 @@ func (h *EventHandler) toGeneratedEvent(e *entity.Event) generated.Event {
 		tz := e.Timezone
 		genEvent.Timezone = &tz
 	}
+	if e.Currency != "" {
+		currency := e.Currency
+		genEvent.Currency = &currency
+	}
+	genEvent.Fee = toGeneratedFee(e)
+	requiresConsent := e.RequiresConsent
+	genEvent.RequiresConsent = &requiresConsent
+	if e.ConsentVersion != "" {
+		consentVersion := e.ConsentVersion
+		genEvent.ConsentVersion = &consentVersion
+	}
+	legalHold := e.LegalHold
+	genEvent.LegalHold = &legalHold
+	if e.TentativeExpiryHours > 0 {
+		expiryHours := e.TentativeExpiryHours
+		genEvent.TentativeExpiryHours = &expiryHours
+	}
+	if e.Capacity > 0 {
+		capacity := e.Capacity
+		genEvent.Capacity = &capacity
+	}
+	if e.PIIPurgedAt != nil {
+		purgedAt := e.PIIPurgedAt.UTC()
+		genEvent.PiiPurgedAt = &purgedAt
+	}
+	if len(e.CustomFields) > 0 {
+		customFields := toGeneratedCustomFields(e.CustomFields)
+		genEvent.CustomFields = &customFields
+	}
+	if e.SeriesID != nil {
+		seriesID := openapi_types.UUID(*e.SeriesID)
+		genEvent.SeriesId = &seriesID
+	}
+	if e.DeletedAt != nil {
+		deletedAt := e.DeletedAt.UTC()
+		genEvent.DeletedAt = &deletedAt
+	}
 
 	participantCount := int(e.ParticipantCount)
 	genEvent.ParticipantCount = &participantCount
@@ -321,3 +739,101 @@ func (h *EventHandler) toGeneratedEvent(e *entity.Event) generated.Event {
 
 	return genEvent
 }
+
+// toFeeInput converts an API fee model into the usecase input, adding an unknown fee type to
+// fields. Consistency between the fee type, amount and tiers is validated by the entity.
+func toFeeInput(fee generated.EventFee, fields *apperrors.FieldErrors) *event.FeeInput {
+	if !fee.Type.Valid() {
+		fields.Add("fee", "invalid event fee type")
+		return nil
+	}
+	input := &event.FeeInput{Type: entity.FeeType(fee.Type)}
+	if fee.Amount != nil {
+		input.Amount = *fee.Amount
+	}
+	if fee.Tiers != nil {
+		input.Tiers = make([]entity.FeeTier, 0, len(*fee.Tiers))
+		for _, tier := range *fee.Tiers {
+			input.Tiers = append(input.Tiers, entity.FeeTier{Name: tier.Name, Amount: tier.Amount})
+		}
+	}
+	return input
+}
+
+// toRecurrenceRule converts an API recurrence rule into the entity; the interval defaults to 1.
+// The rule is validated by the entity.
+func toRecurrenceRule(rule generated.RecurrenceRule) *entity.RecurrenceRule {
+	recurrence := &entity.RecurrenceRule{Frequency: entity.RecurrenceFrequency(rule.Frequency), Interval: 1}
+	if rule.Interval != nil {
+		recurrence.Interval = *rule.Interval
+	}
+	if rule.Count != nil {
+		recurrence.Count = *rule.Count
+	}
+	if rule.Until != nil {
+		until := rule.Until.UTC()
+		recurrence.Until = &until
+	}
+	return recurrence
+}
+
+// toCustomFields converts API custom field definitions into the entity.
+// The definitions are validated by the entity.
+func toCustomFields(fields []generated.CustomField) []entity.CustomField {
+	customFields := make([]entity.CustomField, 0, len(fields))
+	for _, field := range fields {
+		customField := entity.CustomField{
+			Key:   field.Key,
+			Label: field.Label,
+			Type:  entity.CustomFieldType(field.Type),
+		}
+		if field.Required != nil {
+			customField.Required = *field.Required
+		}
+		if field.Options != nil {
+			customField.Options = *field.Options
+		}
+		customFields = append(customFields, customField)
+	}
+	return customFields
+}
+
+// toGeneratedCustomFields converts the event's custom field definitions for the API.
+func toGeneratedCustomFields(fields []entity.CustomField) []generated.CustomField {
+	genFields := make([]generated.CustomField, 0, len(fields))
+	for _, field := range fields {
+		required := field.Required
+		genField := generated.CustomField{
+			Key:      field.Key,
+			Label:    field.Label,
+			Type:     generated.CustomFieldType(field.Type),
+			Required: &required,
+		}
+		if len(field.Options) > 0 {
+			options := field.Options
+			genField.Options = &options
+		}
+		genFields = append(genFields, genField)
+	}
+	return genFields
+}
+
+// toGeneratedFee converts the event's fee model for the API; nil if the event has none.
+func toGeneratedFee(e *entity.Event) *generated.EventFee {
+	if e.FeeType == "" {
+		return nil
+	}
+	fee := &generated.EventFee{Type: generated.FeeType(e.FeeType)}
+	switch e.FeeType {
+	case entity.FeeTypeFixed:
+		amount := e.FeeAmount
+		fee.Amount = &amount
+	case entity.FeeTypeTiered:
+		tiers := make([]generated.FeeTier, 0, len(e.FeeTiers))
+		for _, tier := range e.FeeTiers {
+			tiers = append(tiers, generated.FeeTier{Name: tier.Name, Amount: tier.Amount})
+		}
+		fee.Tiers = &tiers
+	}
+	return fee
+}
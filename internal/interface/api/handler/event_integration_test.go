@@ -103,6 +103,7 @@ var _ = Describe("Event API Integration", func() {
 			CORS: config.CORSConfig{
 				AllowedOrigins: []string{"*"},
 			},
+			Pagination: testPagination,
 		}
 
 		log, _ = logger.New(logger.Config{
@@ -1,6 +1,7 @@
 package handler_test
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"net/http"
@@ -12,9 +13,12 @@ import (
 	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/handler"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
 	"github.com/fumkob/ezqrin-server/internal/usecase/event"
 	eventMocks "github.com/fumkob/ezqrin-server/internal/usecase/event/mocks"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
@@ -50,7 +54,7 @@ func newEventHandlerRouter(uc event.Usecase, userID uuid.UUID, role string, log
 		c.Next()
 	})
 
-	h := handler.NewEventHandler(uc, log)
+	h := handler.NewEventHandler(uc, testPagination.Events, log)
 
 	r.GET("/events", func(c *gin.Context) {
 		h.GetEvents(c, generated.GetEventsParams{})
@@ -63,6 +67,48 @@ func newEventHandlerRouter(uc event.Usecase, userID uuid.UUID, role string, log
 		id, _ := uuid.Parse(c.Param("id"))
 		h.PutEventsId(c, id)
 	})
+	r.POST("/events", h.PostEvents)
+	r.POST("/events/stats/batch", h.PostEventsStatsBatch)
+	r.GET("/events/:id/occurrences", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.GetEventsIdOccurrences(c, id)
+	})
+	r.POST("/events/:id/occurrences/cancel", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.PostEventsIdOccurrencesCancel(c, id)
+	})
+	r.POST("/events/:id/clone", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.PostEventsIdClone(c, id)
+	})
+	r.PUT("/events/:id/participant-fields", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.PutEventsIdParticipantFields(c, id)
+	})
+	r.PUT("/events/:id/logo", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.PutEventsIdLogo(c, id)
+	})
+	r.DELETE("/events/:id/logo", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.DeleteEventsIdLogo(c, id)
+	})
+	r.PUT("/events/:id/webhooks", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.PutEventsIdWebhooks(c, id)
+	})
+	r.GET("/events/:id/webhooks", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.GetEventsIdWebhooks(c, id)
+	})
+	r.DELETE("/events/:id/webhooks", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.DeleteEventsIdWebhooks(c, id)
+	})
+	r.POST("/events/:id/restore", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.PostEventsIdRestore(c, id)
+	})
 
 	return r
 }
@@ -84,7 +130,7 @@ func newEventHandlerRouterWithParams(
 		c.Next()
 	})
 
-	h := handler.NewEventHandler(uc, log)
+	h := handler.NewEventHandler(uc, testPagination.Events, log)
 
 	r.GET("/events", func(c *gin.Context) {
 		h.GetEvents(c, params)
@@ -118,6 +164,7 @@ var _ = Describe("EventHandler", func() {
 				It("should include participant_count and checked_in_count in each event in the response", func() {
 					evt := newTestEntityEvent(organizerID, 42, 10)
 					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
 					mockUC.EXPECT().List(gomock.Any(), gomock.Any()).Return(event.ListEventsOutput{
 						Events:     []*entity.Event{evt},
 						TotalCount: 1,
@@ -152,6 +199,7 @@ var _ = Describe("EventHandler", func() {
 				It("should include participant_count=0 and checked_in_count=0 in the response", func() {
 					evt := newTestEntityEvent(organizerID, 0, 0)
 					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
 					mockUC.EXPECT().List(gomock.Any(), gomock.Any()).Return(event.ListEventsOutput{
 						Events:     []*entity.Event{evt},
 						TotalCount: 1,
@@ -184,6 +232,7 @@ var _ = Describe("EventHandler", func() {
 					evt1 := newTestEntityEvent(organizerID, 5, 3)
 					evt2 := newTestEntityEvent(organizerID, 20, 18)
 					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
 					mockUC.EXPECT().List(gomock.Any(), gomock.Any()).Return(event.ListEventsOutput{
 						Events:     []*entity.Event{evt1, evt2},
 						TotalCount: 2,
@@ -224,6 +273,7 @@ var _ = Describe("EventHandler", func() {
 
 					var capturedInput event.ListEventsInput
 					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
 					mockUC.EXPECT().
 						List(gomock.Any(), gomock.Any()).
 						DoAndReturn(func(_ context.Context, input event.ListEventsInput) (event.ListEventsOutput, error) {
@@ -242,6 +292,336 @@ var _ = Describe("EventHandler", func() {
 					Expect(capturedInput.Order).To(Equal("asc"))
 				})
 			})
+
+			Context("without a cursor", func() {
+				It("should report the page and totals without a next cursor", func() {
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+					mockUC.EXPECT().List(gomock.Any(), gomock.Any()).Return(event.ListEventsOutput{
+						Events:     []*entity.Event{newTestEntityEvent(organizerID, 0, 0)},
+						TotalCount: 45,
+					}, nil)
+
+					r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusOK))
+					var body generated.EventListResponse
+					Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+					Expect(body.Meta.Page).To(HaveValue(Equal(1)))
+					Expect(body.Meta.Total).To(HaveValue(Equal(45)))
+					Expect(body.Meta.TotalPages).To(HaveValue(Equal(3)))
+					Expect(body.Meta.NextCursor).To(BeNil())
+				})
+			})
+
+			Context("with a full-text search", func() {
+				It("should report the relevance of each event by its ID", func() {
+					best := newTestEntityEvent(organizerID, 0, 0)
+					bestRank := 0.61
+					best.SearchRank = &bestRank
+					other := newTestEntityEvent(organizerID, 0, 0)
+					otherRank := 0.2
+					other.SearchRank = &otherRank
+					name := "workshop"
+
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+					mockUC.EXPECT().
+						List(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, input event.ListEventsInput) (event.ListEventsOutput, error) {
+							Expect(input.Search).To(Equal("workshop"))
+							return event.ListEventsOutput{Events: []*entity.Event{best, other}, TotalCount: 2}, nil
+						})
+
+					r := newEventHandlerRouterWithParams(
+						mockUC, organizerID, "organizer", log, generated.GetEventsParams{Name: &name},
+					)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusOK))
+					var body generated.EventListResponse
+					Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+					Expect(body.Meta.Relevance).To(HaveValue(Equal(map[string]float64{
+						best.ID.String():  0.61,
+						other.ID.String(): 0.2,
+					})))
+				})
+
+				It("should leave out the relevance when the events were not ranked", func() {
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+					mockUC.EXPECT().List(gomock.Any(), gomock.Any()).Return(event.ListEventsOutput{
+						Events:     []*entity.Event{newTestEntityEvent(organizerID, 0, 0)},
+						TotalCount: 1,
+					}, nil)
+
+					r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusOK))
+					Expect(w.Body.String()).NotTo(ContainSubstring("relevance"))
+				})
+			})
+
+			Context("with a cursor", func() {
+				It("should page by cursor and report the next cursor without totals", func() {
+					cursor := "abc"
+					params := generated.GetEventsParams{Cursor: &cursor}
+
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+					mockUC.EXPECT().
+						List(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, input event.ListEventsInput) (event.ListEventsOutput, error) {
+							Expect(input.Cursor).To(HaveValue(Equal("abc")))
+							return event.ListEventsOutput{
+								Events:     []*entity.Event{newTestEntityEvent(organizerID, 0, 0)},
+								NextCursor: "def",
+							}, nil
+						})
+
+					r := newEventHandlerRouterWithParams(mockUC, organizerID, "organizer", log, params)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusOK))
+					var body generated.EventListResponse
+					Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+					Expect(body.Data).To(HaveLen(1))
+					Expect(body.Meta.PerPage).To(Equal(testPagination.Events.DefaultPerPage))
+					Expect(body.Meta.NextCursor).To(HaveValue(Equal("def")))
+					Expect(body.Meta.Page).To(BeNil())
+					Expect(body.Meta.Total).To(BeNil())
+					Expect(body.Meta.TotalPages).To(BeNil())
+				})
+
+				It("should omit the next cursor on the last page", func() {
+					cursor := ""
+					params := generated.GetEventsParams{Cursor: &cursor}
+
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+					mockUC.EXPECT().List(gomock.Any(), gomock.Any()).Return(event.ListEventsOutput{
+						Events: []*entity.Event{},
+					}, nil)
+
+					r := newEventHandlerRouterWithParams(mockUC, organizerID, "organizer", log, params)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusOK))
+					Expect(w.Body.String()).NotTo(ContainSubstring("next_cursor"))
+				})
+
+				It("should return 400 when the cursor is rejected", func() {
+					cursor := "garbage"
+					params := generated.GetEventsParams{Cursor: &cursor}
+
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+					mockUC.EXPECT().List(gomock.Any(), gomock.Any()).
+						Return(event.ListEventsOutput{}, apperrors.BadRequest("invalid cursor"))
+
+					r := newEventHandlerRouterWithParams(mockUC, organizerID, "organizer", log, params)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("with a start date range", func() {
+				It("should pass the bounds to the usecase in UTC", func() {
+					from := time.Date(2030, 6, 1, 0, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+					to := time.Date(2030, 6, 30, 23, 59, 59, 0, time.UTC)
+					params := generated.GetEventsParams{StartFrom: &from, StartTo: &to}
+
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+					mockUC.EXPECT().
+						List(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, input event.ListEventsInput) (event.ListEventsOutput, error) {
+							Expect(input.StartDateFrom).To(HaveValue(Equal(time.Date(2030, 5, 31, 15, 0, 0, 0, time.UTC))))
+							Expect(input.StartDateTo).To(HaveValue(Equal(to)))
+							return event.ListEventsOutput{Events: []*entity.Event{}}, nil
+						})
+
+					r := newEventHandlerRouterWithParams(mockUC, organizerID, "organizer", log, params)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusOK))
+				})
+
+				It("should return 400 when the range is rejected", func() {
+					from := time.Date(2030, 7, 1, 0, 0, 0, 0, time.UTC)
+					to := time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+					params := generated.GetEventsParams{StartFrom: &from, StartTo: &to}
+
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+					mockUC.EXPECT().List(gomock.Any(), gomock.Any()).
+						Return(event.ListEventsOutput{}, apperrors.Validation("start_from must not be after start_to"))
+
+					r := newEventHandlerRouterWithParams(mockUC, organizerID, "organizer", log, params)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("including deleted events", func() {
+				It("should list deleted events with their deletion time for admins", func() {
+					includeDeleted := true
+					params := generated.GetEventsParams{IncludeDeleted: &includeDeleted}
+					deleted := newTestEntityEvent(organizerID, 0, 0)
+					deletedAt := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+					deleted.DeletedAt = &deletedAt
+
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+					mockUC.EXPECT().
+						List(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, input event.ListEventsInput) (event.ListEventsOutput, error) {
+							Expect(input.IncludeDeleted).To(BeTrue())
+							return event.ListEventsOutput{Events: []*entity.Event{deleted}, TotalCount: 1}, nil
+						})
+
+					r := newEventHandlerRouterWithParams(mockUC, organizerID, "admin", log, params)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusOK))
+					var body generated.EventListResponse
+					Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+					Expect(body.Data[0].DeletedAt).To(HaveValue(Equal(deletedAt)))
+				})
+
+				It("should return 403 for organizers", func() {
+					includeDeleted := true
+					params := generated.GetEventsParams{IncludeDeleted: &includeDeleted}
+
+					r := newEventHandlerRouterWithParams(eventMocks.NewMockUsecase(ctrl), organizerID, "organizer", log, params)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusForbidden))
+				})
+			})
+		})
+
+		When("polling with conditional requests", func() {
+			var lastModified time.Time
+
+			BeforeEach(func() {
+				lastModified = time.Date(2030, 1, 1, 12, 0, 0, 500_000_000, time.UTC)
+			})
+
+			Context("without an If-Modified-Since header", func() {
+				It("should return 200 with a Last-Modified header", func() {
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(lastModified, nil)
+					mockUC.EXPECT().List(gomock.Any(), gomock.Any()).Return(event.ListEventsOutput{
+						Events:     []*entity.Event{newTestEntityEvent(organizerID, 0, 0)},
+						TotalCount: 1,
+					}, nil)
+
+					r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusOK))
+					Expect(w.Header().Get("Last-Modified")).To(Equal("Tue, 01 Jan 2030 12:00:00 GMT"))
+				})
+			})
+
+			Context("when nothing changed since If-Modified-Since", func() {
+				It("should return 304 without listing events", func() {
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(lastModified, nil)
+
+					r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					req.Header.Set("If-Modified-Since", "Tue, 01 Jan 2030 12:00:00 GMT")
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusNotModified))
+					Expect(w.Header().Get("Last-Modified")).To(Equal("Tue, 01 Jan 2030 12:00:00 GMT"))
+					Expect(w.Body.Len()).To(BeZero())
+				})
+			})
+
+			Context("when the list changed after If-Modified-Since", func() {
+				It("should return 200 with the new Last-Modified", func() {
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().
+						GetListLastModified(gomock.Any(), gomock.Any()).
+						Return(lastModified.Add(time.Minute), nil)
+					mockUC.EXPECT().List(gomock.Any(), gomock.Any()).Return(event.ListEventsOutput{
+						Events:     []*entity.Event{},
+						TotalCount: 0,
+					}, nil)
+
+					r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					req.Header.Set("If-Modified-Since", "Tue, 01 Jan 2030 12:00:00 GMT")
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusOK))
+					Expect(w.Header().Get("Last-Modified")).To(Equal("Tue, 01 Jan 2030 12:01:00 GMT"))
+				})
+			})
+
+			Context("with a malformed If-Modified-Since header", func() {
+				It("should ignore the header and return 200", func() {
+					mockUC := eventMocks.NewMockUsecase(ctrl)
+					mockUC.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(lastModified, nil)
+					mockUC.EXPECT().List(gomock.Any(), gomock.Any()).Return(event.ListEventsOutput{
+						Events:     []*entity.Event{},
+						TotalCount: 0,
+					}, nil)
+
+					r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+
+					req := httptest.NewRequest(http.MethodGet, "/events", nil)
+					req.Header.Set("If-Modified-Since", "not-a-date")
+					w := httptest.NewRecorder()
+					r.ServeHTTP(w, req)
+
+					Expect(w.Code).To(Equal(http.StatusOK))
+				})
+			})
 		})
 	})
 
@@ -254,6 +634,7 @@ var _ = Describe("EventHandler", func() {
 
 					mockUC := eventMocks.NewMockUsecase(ctrl)
 					mockUC.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(evt, nil)
+					mockUC.EXPECT().SpotsRemaining(gomock.Any(), evt).Return(nil, nil)
 
 					r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
 
@@ -274,6 +655,86 @@ var _ = Describe("EventHandler", func() {
 			})
 		})
 
+		When("the event has a currency", func() {
+			It("should include the currency in the response", func() {
+				evt := newTestEntityEvent(organizerID, 0, 0)
+				evt.Currency = "JPY"
+
+				mockUC := eventMocks.NewMockUsecase(ctrl)
+				mockUC.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(evt, nil)
+				mockUC.EXPECT().SpotsRemaining(gomock.Any(), evt).Return(nil, nil)
+
+				r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+
+				req := httptest.NewRequest(http.MethodGet, "/events/"+evt.ID.String(), nil)
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var body map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+				Expect(body["currency"]).To(Equal("JPY"))
+			})
+		})
+
+		When("the event has a tiered fee", func() {
+			It("should include the fee tiers in the response", func() {
+				evt := newTestEntityEvent(organizerID, 0, 0)
+				evt.Currency = "JPY"
+				evt.FeeType = entity.FeeTypeTiered
+				evt.FeeTiers = []entity.FeeTier{{Name: "early_bird", Amount: money.FromMinorUnits(200000)}}
+
+				mockUC := eventMocks.NewMockUsecase(ctrl)
+				mockUC.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(evt, nil)
+				mockUC.EXPECT().SpotsRemaining(gomock.Any(), evt).Return(nil, nil)
+
+				r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+
+				req := httptest.NewRequest(http.MethodGet, "/events/"+evt.ID.String(), nil)
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var body struct {
+					Fee generated.EventFee `json:"fee"`
+				}
+				Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+				Expect(body.Fee.Type).To(Equal(generated.Tiered))
+				Expect(body.Fee.Amount).To(BeNil())
+				Expect(*body.Fee.Tiers).To(ConsistOf(generated.FeeTier{
+					Name:   "early_bird",
+					Amount: money.FromMinorUnits(200000),
+				}))
+			})
+		})
+
+		When("the event has a capacity", func() {
+			It("should include the remaining spots in the response", func() {
+				evt := newTestEntityEvent(organizerID, 0, 0)
+				evt.Capacity = 50
+				spots := int64(12)
+
+				mockUC := eventMocks.NewMockUsecase(ctrl)
+				mockUC.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(evt, nil)
+				mockUC.EXPECT().SpotsRemaining(gomock.Any(), evt).Return(&spots, nil)
+
+				r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+
+				req := httptest.NewRequest(http.MethodGet, "/events/"+evt.ID.String(), nil)
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var body map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+				Expect(body["capacity"]).To(BeEquivalentTo(50))
+				Expect(body["spots_remaining"]).To(BeEquivalentTo(12))
+			})
+		})
+
 		When("getting a single event as admin", func() {
 			Context("when the event belongs to a different organizer", func() {
 				It("should include participant_count and checked_in_count in the response", func() {
@@ -282,6 +743,7 @@ var _ = Describe("EventHandler", func() {
 
 					mockUC := eventMocks.NewMockUsecase(ctrl)
 					mockUC.EXPECT().GetByID(gomock.Any(), gomock.Any()).Return(evt, nil)
+					mockUC.EXPECT().SpotsRemaining(gomock.Any(), evt).Return(nil, nil)
 
 					r := newEventHandlerRouter(mockUC, adminID, "admin", log)
 
@@ -334,6 +796,61 @@ var _ = Describe("EventHandler", func() {
 			})
 		})
 
+		When("updating an event with an unknown currency code", func() {
+			It("should return 400 without calling the usecase", func() {
+				mockUC := eventMocks.NewMockUsecase(ctrl)
+
+				r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+
+				reqBody := `{"currency":"XYZ"}`
+				req := httptest.NewRequest(http.MethodPut, "/events/"+uuid.NewString(), strings.NewReader(reqBody))
+				req.Header.Set("Content-Type", "application/json")
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("updating an event with several invalid fields", func() {
+			It("should return 400 listing every field without calling the usecase", func() {
+				mockUC := eventMocks.NewMockUsecase(ctrl)
+
+				r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+
+				reqBody := `{"currency":"XYZ","fee":{"type":"donation"}}`
+				req := httptest.NewRequest(http.MethodPut, "/events/"+uuid.NewString(), strings.NewReader(reqBody))
+				req.Header.Set("Content-Type", "application/json")
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+				var problem response.ProblemDetails
+				Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+				Expect(problem.Errors).To(Equal([]apperrors.ValidationError{
+					{Field: "currency", Message: "invalid ISO 4217 currency code"},
+					{Field: "fee", Message: "invalid event fee type"},
+				}))
+				Expect(problem.Detail).To(Equal("invalid ISO 4217 currency code; invalid event fee type"))
+			})
+		})
+
+		When("updating an event with an unknown fee type", func() {
+			It("should return 400 without calling the usecase", func() {
+				mockUC := eventMocks.NewMockUsecase(ctrl)
+
+				r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+
+				reqBody := `{"fee":{"type":"donation"}}`
+				req := httptest.NewRequest(http.MethodPut, "/events/"+uuid.NewString(), strings.NewReader(reqBody))
+				req.Header.Set("Content-Type", "application/json")
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
 		When("updating an event as admin", func() {
 			Context("when the event belongs to a different organizer", func() {
 				It("should include participant_count and checked_in_count in the response", func() {
@@ -365,4 +882,502 @@ var _ = Describe("EventHandler", func() {
 			})
 		})
 	})
+
+	Describe("PostEventsStatsBatch", func() {
+		postBatch := func(r *gin.Engine, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/events/stats/batch", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w
+		}
+
+		When("some requested events are accessible", func() {
+			It("should return their stats keyed by event ID and list the others", func() {
+				accessibleID := uuid.New()
+				inaccessibleID := uuid.New()
+				spots := int64(5)
+				mockUC := eventMocks.NewMockUsecase(ctrl)
+				mockUC.EXPECT().
+					GetStatsBatch(gomock.Any(), []uuid.UUID{accessibleID, inaccessibleID}, organizerID, false).
+					Return(event.BatchStatsOutput{
+						Stats: map[uuid.UUID]event.EventStatsOutput{
+							accessibleID: {
+								EventID:               accessibleID,
+								TotalParticipants:     10,
+								CheckedInParticipants: 4,
+								CheckinRate:           0.4,
+								ByStatus:              map[string]int64{"confirmed": 10},
+								TotalPaymentAmount:    money.FromMinorUnits(500000),
+								Currency:              "JPY",
+								SpotsRemaining:        &spots,
+								Warnings:              []string{},
+							},
+						},
+						Inaccessible: []uuid.UUID{inaccessibleID},
+					}, nil)
+
+				r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+				w := postBatch(r, `{"ids":["`+accessibleID.String()+`","`+inaccessibleID.String()+`"]}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.BatchEventStatsResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.Stats).To(HaveLen(1))
+				stats := resp.Stats[accessibleID.String()]
+				Expect(uuid.UUID(stats.EventId)).To(Equal(accessibleID))
+				Expect(stats.TotalParticipants).To(Equal(10))
+				Expect(stats.CheckedInParticipants).To(Equal(4))
+				Expect(*stats.Currency).To(Equal("JPY"))
+				Expect(*stats.SpotsRemaining).To(Equal(int64(5)))
+				Expect(resp.InaccessibleIds).To(HaveLen(1))
+				Expect(uuid.UUID(resp.InaccessibleIds[0])).To(Equal(inaccessibleID))
+			})
+		})
+
+		When("the request body is malformed", func() {
+			It("should return 400 Bad Request without calling the usecase", func() {
+				r := newEventHandlerRouter(eventMocks.NewMockUsecase(ctrl), organizerID, "organizer", log)
+
+				w := postBatch(r, `{"ids":["not-a-uuid"]}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("PostEvents", func() {
+		postEvent := func(r *gin.Engine, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w
+		}
+
+		When("creating a recurring event", func() {
+			It("should pass the recurrence rule and return the first occurrence with its series", func() {
+				seriesID := uuid.New()
+				evt := newTestEntityEvent(organizerID, 0, 0)
+				evt.SeriesID = &seriesID
+
+				mockUC := eventMocks.NewMockUsecase(ctrl)
+				mockUC.EXPECT().
+					Create(gomock.Any(), gomock.Any()).
+					DoAndReturn(func(_ context.Context, input event.CreateEventInput) (*entity.Event, error) {
+						Expect(input.Recurrence).To(Equal(&entity.RecurrenceRule{
+							Frequency: entity.RecurrenceWeekly,
+							Interval:  1,
+							Count:     4,
+						}))
+						return evt, nil
+					})
+
+				r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+				w := postEvent(r, `{"name":"Weekly Meetup","start_date":"2030-01-01T10:00:00Z","status":"draft",`+
+					`"recurrence":{"frequency":"weekly","count":4}}`)
+
+				Expect(w.Code).To(Equal(http.StatusCreated))
+				var body generated.Event
+				Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+				Expect(body.SeriesId).To(HaveValue(Equal(seriesID)))
+			})
+		})
+
+		When("creating a recurring event with an unknown frequency", func() {
+			It("should return 400 without calling the usecase", func() {
+				r := newEventHandlerRouter(eventMocks.NewMockUsecase(ctrl), organizerID, "organizer", log)
+
+				w := postEvent(r, `{"name":"Meetup","start_date":"2030-01-01T10:00:00Z","status":"draft",`+
+					`"recurrence":{"frequency":"yearly","count":4}}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("GetEventsIdOccurrences", func() {
+		It("should return the occurrences of the series", func() {
+			seriesID := uuid.New()
+			first := newTestEntityEvent(organizerID, 0, 0)
+			second := newTestEntityEvent(organizerID, 0, 0)
+			first.SeriesID, second.SeriesID = &seriesID, &seriesID
+
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().
+				ListOccurrences(gomock.Any(), first.ID, organizerID, false).
+				Return([]*entity.Event{first, second}, nil)
+
+			r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+			req := httptest.NewRequest(http.MethodGet, "/events/"+first.ID.String()+"/occurrences", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var body generated.EventOccurrencesResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Data).To(HaveLen(2))
+			Expect(body.Data[1].Id).To(HaveValue(Equal(second.ID)))
+			Expect(body.Data[1].SeriesId).To(HaveValue(Equal(seriesID)))
+		})
+	})
+
+	Describe("PostEventsIdOccurrencesCancel", func() {
+		cancelSeries := func(r *gin.Engine, id uuid.UUID) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/events/"+id.String()+"/occurrences/cancel", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w
+		}
+
+		It("should return the occurrences with their statuses after cancelling", func() {
+			evt := newTestEntityEvent(organizerID, 0, 0)
+			evt.Status = entity.StatusCancelled
+
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().
+				CancelSeries(gomock.Any(), evt.ID, organizerID, false).
+				Return([]*entity.Event{evt}, nil)
+
+			w := cancelSeries(newEventHandlerRouter(mockUC, organizerID, "organizer", log), evt.ID)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var body generated.EventOccurrencesResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Data).To(HaveLen(1))
+			Expect(body.Data[0].Status).To(Equal(generated.EventStatusCancelled))
+		})
+
+		It("should return 400 for an event that does not recur", func() {
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().
+				CancelSeries(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, apperrors.BadRequest("event is not part of a recurring series"))
+
+			w := cancelSeries(newEventHandlerRouter(mockUC, organizerID, "organizer", log), uuid.New())
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("PostEventsIdClone", func() {
+		cloneEvent := func(r *gin.Engine, id uuid.UUID, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/events/"+id.String()+"/clone", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w
+		}
+
+		It("should pass the overrides in UTC and return the new draft event", func() {
+			sourceID := uuid.New()
+			clone := newTestEntityEvent(organizerID, 0, 0)
+			clone.Status = entity.StatusDraft
+
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().
+				Clone(gomock.Any(), sourceID, organizerID, false, gomock.Any()).
+				DoAndReturn(func(
+					_ context.Context, _, _ uuid.UUID, _ bool, overrides event.CloneOverrides,
+				) (*entity.Event, error) {
+					Expect(overrides.Name).To(HaveValue(Equal("Next Year")))
+					Expect(overrides.StartDate).To(HaveValue(Equal(time.Date(2031, 1, 1, 1, 0, 0, 0, time.UTC))))
+					Expect(overrides.EndDate).To(BeNil())
+					return clone, nil
+				})
+
+			r := newEventHandlerRouter(mockUC, organizerID, "organizer", log)
+			w := cloneEvent(r, sourceID, `{"name":"Next Year","start_date":"2031-01-01T10:00:00+09:00"}`)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+			var body generated.Event
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Id).To(HaveValue(Equal(clone.ID)))
+			Expect(body.Status).To(Equal(generated.EventStatusDraft))
+		})
+
+		It("should pass the admin role to the usecase", func() {
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().
+				Clone(gomock.Any(), gomock.Any(), organizerID, true, event.CloneOverrides{}).
+				Return(newTestEntityEvent(organizerID, 0, 0), nil)
+
+			w := cloneEvent(newEventHandlerRouter(mockUC, organizerID, "admin", log), uuid.New(), `{}`)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+		})
+
+		It("should return 403 when the user does not manage the event", func() {
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().
+				Clone(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, apperrors.Forbidden("you do not have permission to clone this event"))
+
+			w := cloneEvent(newEventHandlerRouter(mockUC, organizerID, "organizer", log), uuid.New(), `{}`)
+
+			Expect(w.Code).To(Equal(http.StatusForbidden))
+		})
+
+		It("should return 400 for a malformed body without calling the usecase", func() {
+			r := newEventHandlerRouter(eventMocks.NewMockUsecase(ctrl), organizerID, "organizer", log)
+
+			w := cloneEvent(r, uuid.New(), `{"start_date":"tomorrow"}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("PutEventsIdParticipantFields", func() {
+		updateFields := func(r *gin.Engine, id uuid.UUID, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(
+				http.MethodPut, "/events/"+id.String()+"/participant-fields", strings.NewReader(body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w
+		}
+
+		It("should pass the fields to the usecase and return the event with them", func() {
+			eventID := uuid.New()
+			fields := []entity.CustomField{
+				{Key: "company", Label: "Company", Type: entity.CustomFieldTypeText, Required: true},
+				{Key: "tshirt_size", Label: "T-shirt size", Type: entity.CustomFieldTypeSelect, Options: []string{"S", "M"}},
+			}
+			updated := newTestEntityEvent(organizerID, 0, 0)
+			updated.CustomFields = fields
+
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().
+				UpdateParticipantFields(gomock.Any(), eventID, organizerID, false, fields).
+				Return(updated, nil)
+
+			w := updateFields(newEventHandlerRouter(mockUC, organizerID, "organizer", log), eventID, `{"fields":[
+				{"key":"company","label":"Company","type":"text","required":true},
+				{"key":"tshirt_size","label":"T-shirt size","type":"select","options":["S","M"]}
+			]}`)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var body generated.Event
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.CustomFields).To(HaveValue(HaveLen(2)))
+			Expect((*body.CustomFields)[0].Required).To(HaveValue(BeTrue()))
+			Expect((*body.CustomFields)[1].Options).To(HaveValue(Equal([]string{"S", "M"})))
+		})
+
+		It("should return 400 when the usecase rejects the fields", func() {
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().
+				UpdateParticipantFields(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, apperrors.Validation("event validation failed: invalid custom field type"))
+
+			w := updateFields(newEventHandlerRouter(mockUC, organizerID, "organizer", log), uuid.New(),
+				`{"fields":[{"key":"company","label":"Company","type":"date"}]}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return 400 for a malformed body without calling the usecase", func() {
+			r := newEventHandlerRouter(eventMocks.NewMockUsecase(ctrl), organizerID, "organizer", log)
+
+			w := updateFields(r, uuid.New(), `{"fields":"company"}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("PutEventsIdLogo", func() {
+		uploadLogo := func(r *gin.Engine, id uuid.UUID, body []byte) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPut, "/events/"+id.String()+"/logo", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "image/png")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w
+		}
+
+		It("should pass the request body to the usecase and return 204", func() {
+			eventID := uuid.New()
+			logo := []byte("\x89PNG logo")
+
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().UpdateLogo(gomock.Any(), eventID, organizerID, false, logo).Return(nil)
+
+			w := uploadLogo(newEventHandlerRouter(mockUC, organizerID, "organizer", log), eventID, logo)
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+
+		It("should return 400 for a body over 1MB without calling the usecase", func() {
+			r := newEventHandlerRouter(eventMocks.NewMockUsecase(ctrl), organizerID, "organizer", log)
+
+			w := uploadLogo(r, uuid.New(), make([]byte, event.MaxLogoBytes+1))
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(w.Body.String()).To(ContainSubstring("logo image too large"))
+		})
+	})
+
+	Describe("DeleteEventsIdLogo", func() {
+		It("should remove the logo and return 204", func() {
+			eventID := uuid.New()
+
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().DeleteLogo(gomock.Any(), eventID, organizerID, false).Return(nil)
+
+			req := httptest.NewRequest(http.MethodDelete, "/events/"+eventID.String()+"/logo", nil)
+			w := httptest.NewRecorder()
+			newEventHandlerRouter(mockUC, organizerID, "organizer", log).ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Describe("PutEventsIdWebhooks", func() {
+		setWebhook := func(r *gin.Engine, id uuid.UUID, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPut, "/events/"+id.String()+"/webhooks", strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w
+		}
+
+		It("should set the webhook and return it without the secret", func() {
+			eventID := uuid.New()
+			now := time.Now().UTC()
+			input := event.WebhookInput{URL: "https://hooks.example.com/ezqrin", Secret: "whsec_0123456789"}
+
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().SetWebhook(gomock.Any(), eventID, organizerID, false, input).Return(&entity.EventWebhook{
+				EventID:   eventID,
+				URL:       input.URL,
+				Secret:    input.Secret,
+				CreatedAt: now,
+				UpdatedAt: now,
+			}, nil)
+
+			w := setWebhook(newEventHandlerRouter(mockUC, organizerID, "organizer", log), eventID,
+				`{"url":"https://hooks.example.com/ezqrin","secret":"whsec_0123456789"}`)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).NotTo(ContainSubstring("whsec_0123456789"))
+			var body generated.EventWebhookResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Url).To(Equal("https://hooks.example.com/ezqrin"))
+			Expect(body.LastDeliveryStatus).To(BeNil())
+		})
+
+		It("should return 400 when the usecase rejects the webhook", func() {
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().
+				SetWebhook(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, apperrors.Validation("webhook validation failed: webhook secret is required"))
+
+			w := setWebhook(newEventHandlerRouter(mockUC, organizerID, "organizer", log), uuid.New(),
+				`{"url":"https://hooks.example.com/ezqrin","secret":""}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+
+		It("should return 400 for a malformed body without calling the usecase", func() {
+			r := newEventHandlerRouter(eventMocks.NewMockUsecase(ctrl), organizerID, "organizer", log)
+
+			w := setWebhook(r, uuid.New(), `{"url":42}`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("GetEventsIdWebhooks", func() {
+		getWebhook := func(r *gin.Engine, id uuid.UUID) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/events/"+id.String()+"/webhooks", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w
+		}
+
+		It("should return the webhook with its last delivery", func() {
+			eventID := uuid.New()
+			deliveredAt := time.Now().UTC()
+			status := entity.WebhookDeliveryFailed
+
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().GetWebhook(gomock.Any(), eventID, organizerID, false).Return(&entity.EventWebhook{
+				EventID:            eventID,
+				URL:                "https://hooks.example.com/ezqrin",
+				Secret:             "whsec_0123456789",
+				LastDeliveryAt:     &deliveredAt,
+				LastDeliveryStatus: &status,
+				LastDeliveryError:  "webhook: https://hooks.example.com/ezqrin returned 503",
+			}, nil)
+
+			w := getWebhook(newEventHandlerRouter(mockUC, organizerID, "organizer", log), eventID)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var body generated.EventWebhookResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.LastDeliveryStatus).To(HaveValue(Equal(generated.Failed)))
+			Expect(body.LastDeliveryError).To(HaveValue(ContainSubstring("503")))
+		})
+
+		It("should return 404 when the event has no webhook", func() {
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().
+				GetWebhook(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, apperrors.NotFound("event webhook not found"))
+
+			w := getWebhook(newEventHandlerRouter(mockUC, organizerID, "organizer", log), uuid.New())
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("DeleteEventsIdWebhooks", func() {
+		It("should remove the webhook and return 204", func() {
+			eventID := uuid.New()
+
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().DeleteWebhook(gomock.Any(), eventID, organizerID, false).Return(nil)
+
+			req := httptest.NewRequest(http.MethodDelete, "/events/"+eventID.String()+"/webhooks", nil)
+			w := httptest.NewRecorder()
+			newEventHandlerRouter(mockUC, organizerID, "organizer", log).ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Describe("PostEventsIdRestore", func() {
+		restoreEvent := func(r *gin.Engine, id uuid.UUID) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/events/"+id.String()+"/restore", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w
+		}
+
+		It("should return the restored event", func() {
+			evt := newTestEntityEvent(organizerID, 3, 1)
+
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().Restore(gomock.Any(), evt.ID, organizerID, false).Return(evt, nil)
+
+			w := restoreEvent(newEventHandlerRouter(mockUC, organizerID, "organizer", log), evt.ID)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var body generated.Event
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body.Id).To(HaveValue(Equal(evt.ID)))
+			Expect(body.DeletedAt).To(BeNil())
+		})
+
+		It("should return 404 when the event is not deleted", func() {
+			mockUC := eventMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().
+				Restore(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil, apperrors.NotFound("event not found"))
+
+			w := restoreEvent(newEventHandlerRouter(mockUC, organizerID, "organizer", log), uuid.New())
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
 })
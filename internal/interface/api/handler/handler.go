@@ -12,6 +12,10 @@ type Handler struct {
 	*ParticipantHandler
 	*CheckinHandler
 	*QRCodeHandler
+	*PaymentHandler
+	*AdminHandler
+	*UserHandler
+	*AuditHandler
 }
 
 // Compile-time check to ensure Handler implements ServerInterface
@@ -25,6 +29,10 @@ func NewHandler(
 	participant *ParticipantHandler,
 	checkin *CheckinHandler,
 	qrcode *QRCodeHandler,
+	payment *PaymentHandler,
+	admin *AdminHandler,
+	user *UserHandler,
+	audit *AuditHandler,
 ) *Handler {
 	return &Handler{
 		HealthHandler:      health,
@@ -33,6 +41,10 @@ func NewHandler(
 		ParticipantHandler: participant,
 		CheckinHandler:     checkin,
 		QRCodeHandler:      qrcode,
+		PaymentHandler:     payment,
+		AdminHandler:       admin,
+		UserHandler:        user,
+		AuditHandler:       audit,
 	}
 }
 
@@ -40,7 +52,7 @@ func NewHandler(
 // GetHealth, GetHealthLive, GetHealthReady are embedded
 
 // Auth endpoints are implemented by AuthHandler
-// RegisterUser, LoginUser, RefreshToken, LogoutUser are embedded
+// RegisterUser, LoginUser, RefreshToken, LogoutUser, EnrollTwoFactor, VerifyTwoFactor, LoginTwoFactor are embedded
 
 // Placeholder methods for future endpoints - these will be implemented in subsequent tasks
 // For now, they return 501 Not Implemented to satisfy the interface
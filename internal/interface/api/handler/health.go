@@ -10,6 +10,7 @@ import (
 
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/cache"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
@@ -23,37 +24,88 @@ const (
 // HealthHandler handles health check endpoints.
 // Implements generated.ServerInterface for OpenAPI compliance.
 type HealthHandler struct {
-	db     database.HealthChecker
-	redis  cache.HealthChecker
-	logger *logger.Logger
+	db          database.HealthChecker
+	redis       cache.HealthChecker
+	qrGenerator qrcode.HealthChecker
+	logger      *logger.Logger
+	startedAt   time.Time
+}
+
+// readinessProblem is the RFC 9457 body of a failed readiness check, extended with the status
+// of each dependency so that operators can tell which one is down.
+type readinessProblem struct {
+	response.ProblemDetails
+	Checks map[string]string `json:"checks"`
 }
 
 // NewHealthHandler creates a new HealthHandler
-func NewHealthHandler(db database.HealthChecker, redis cache.HealthChecker, logger *logger.Logger) *HealthHandler {
+func NewHealthHandler(
+	db database.HealthChecker,
+	redis cache.HealthChecker,
+	qrGenerator qrcode.HealthChecker,
+	logger *logger.Logger,
+) *HealthHandler {
 	return &HealthHandler{
-		db:     db,
-		redis:  redis,
-		logger: logger,
+		db:          db,
+		redis:       redis,
+		qrGenerator: qrGenerator,
+		logger:      logger,
+		startedAt:   time.Now(),
 	}
 }
 
 // GetHealth handles basic health check endpoint (GET /health).
-// This always returns 200 OK to indicate the server is running.
+// Kept for backwards compatibility, it runs the readiness checks of GetHealthReady.
 // Implements generated.ServerInterface.GetHealth
 func (h *HealthHandler) GetHealth(c *gin.Context) {
+	checks, ready := h.checkReadiness(c.Request.Context())
+	if !ready {
+		h.notReady(c, checks)
+		return
+	}
+
 	response.Data(c, http.StatusOK, map[string]interface{}{
 		"status":    "healthy",
 		"timestamp": time.Now().UTC().Format(time.RFC3339),
+		"checks":    checks,
 	})
 }
 
 // GetHealthReady handles readiness check endpoint (GET /health/ready).
 // This checks if the service is ready to accept requests by verifying
-// database and Redis connectivity. Returns 200 if ready, 503 if not ready.
+// database and Redis connectivity and that QR codes can be generated.
+// Returns 200 if ready, 503 if not ready, with the status of each check either way.
 // Implements generated.ServerInterface.GetHealthReady
 func (h *HealthHandler) GetHealthReady(c *gin.Context) {
+	checks, ready := h.checkReadiness(c.Request.Context())
+	if !ready {
+		h.notReady(c, checks)
+		return
+	}
+
+	// Service is ready
+	response.Data(c, http.StatusOK, map[string]interface{}{
+		"status": "ready",
+		"checks": checks,
+	})
+}
+
+// GetHealthLive handles liveness check endpoint (GET /health/live).
+// This checks if the service is alive and responsive, without calling its dependencies.
+// Returns 200 if alive, should only fail if the service is completely unresponsive.
+// Implements generated.ServerInterface.GetHealthLive
+func (h *HealthHandler) GetHealthLive(c *gin.Context) {
+	response.Data(c, http.StatusOK, map[string]interface{}{
+		"status":         "alive",
+		"uptime_seconds": int64(time.Since(h.startedAt).Seconds()),
+	})
+}
+
+// checkReadiness checks the dependencies the service needs to accept traffic, returning the
+// status of each ("ok" or "unhealthy") and whether all of them are ok.
+func (h *HealthHandler) checkReadiness(ctx context.Context) (map[string]string, bool) {
 	// Create context with timeout for health checks
-	ctx, cancel := context.WithTimeout(c.Request.Context(), readinessCheckTimeout)
+	ctx, cancel := context.WithTimeout(ctx, readinessCheckTimeout)
 	defer cancel()
 
 	checks := make(map[string]string)
@@ -78,29 +130,28 @@ func (h *HealthHandler) GetHealthReady(c *gin.Context) {
 		checks["redis"] = "ok"
 	}
 
-	if !ready {
-		response.ProblemWithCode(
-			c,
-			http.StatusServiceUnavailable,
-			apperrors.CodeServiceUnavailable,
-			"Service is not ready to accept traffic",
-		)
-		return
+	// Check QR code generation
+	if err := h.qrGenerator.HealthCheck(ctx); err != nil {
+		checks["qrcode"] = "unhealthy"
+		ready = false
+		h.logger.WithContext(ctx).Warn("qrcode health check failed")
+	} else {
+		checks["qrcode"] = "ok"
 	}
 
-	// Service is ready
-	response.Data(c, http.StatusOK, map[string]interface{}{
-		"status": "ready",
-		"checks": checks,
-	})
+	return checks, ready
 }
 
-// GetHealthLive handles liveness check endpoint (GET /health/live).
-// This checks if the service is alive and responsive.
-// Returns 200 if alive, should only fail if the service is completely unresponsive.
-// Implements generated.ServerInterface.GetHealthLive
-func (h *HealthHandler) GetHealthLive(c *gin.Context) {
-	response.Data(c, http.StatusOK, map[string]interface{}{
-		"status": "alive",
+// notReady sends the 503 response of a failed readiness check.
+func (h *HealthHandler) notReady(c *gin.Context, checks map[string]string) {
+	c.Header("Content-Type", "application/problem+json")
+	c.JSON(http.StatusServiceUnavailable, readinessProblem{
+		ProblemDetails: response.NewProblem(
+			c,
+			http.StatusServiceUnavailable,
+			apperrors.CodeServiceUnavailable,
+			"Service is not ready to accept traffic",
+		),
+		Checks: checks,
 	})
 }
@@ -18,6 +18,7 @@ var _ = Describe("Health Handler OpenAPI Integration", func() {
 		log           *logger.Logger
 		mockDB        *mockDBHealthChecker
 		mockRedis     *mockRedisHealthChecker
+		mockQR        *mockQRHealthChecker
 		healthHandler *handler.HealthHandler
 	)
 
@@ -36,9 +37,10 @@ var _ = Describe("Health Handler OpenAPI Integration", func() {
 		// Create mocks
 		mockDB = &mockDBHealthChecker{healthy: true}
 		mockRedis = &mockRedisHealthChecker{shouldFail: false}
+		mockQR = &mockQRHealthChecker{}
 
-		// Create health handler with both DB and Redis health checkers
-		healthHandler = handler.NewHealthHandler(mockDB, mockRedis, log)
+		// Create health handler with DB, Redis and QR generator health checkers
+		healthHandler = handler.NewHealthHandler(mockDB, mockRedis, mockQR, log)
 
 		// Setup router with middleware
 		router = gin.New()
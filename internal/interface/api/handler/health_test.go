@@ -19,6 +19,7 @@ var _ = Describe("HealthHandler", func() {
 		log           *logger.Logger
 		mockDB        *mockDBHealthChecker
 		mockRedis     *mockRedisHealthChecker
+		mockQR        *mockQRHealthChecker
 		healthHandler *handler.HealthHandler
 	)
 
@@ -32,9 +33,10 @@ var _ = Describe("HealthHandler", func() {
 		// Create mocks
 		mockDB = &mockDBHealthChecker{healthy: true}
 		mockRedis = &mockRedisHealthChecker{shouldFail: false}
+		mockQR = &mockQRHealthChecker{}
 
-		// Create health handler with both DB and Redis health checkers
-		healthHandler = handler.NewHealthHandler(mockDB, mockRedis, log)
+		// Create health handler with DB, Redis and QR generator health checkers
+		healthHandler = handler.NewHealthHandler(mockDB, mockRedis, mockQR, log)
 
 		// Setup router with RequestID middleware for header testing
 		router = gin.New()
@@ -64,6 +66,22 @@ var _ = Describe("HealthHandler", func() {
 				Expect(w.Header().Get("X-Request-ID")).ToNot(BeEmpty())
 			})
 		})
+
+		When("a dependency is unhealthy", func() {
+			It("should return 503 like the readiness probe", func() {
+				mockDB.healthy = false
+				router.GET("/api/v1/health", healthHandler.GetHealth)
+
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/health", nil)
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusServiceUnavailable))
+				Expect(w.Body.String()).To(ContainSubstring(`"code":"SERVICE_UNAVAILABLE"`))
+				Expect(w.Body.String()).To(ContainSubstring(`"database":"unhealthy"`))
+			})
+		})
 	})
 
 	Describe("GetHealthReady", func() {
@@ -83,6 +101,7 @@ var _ = Describe("HealthHandler", func() {
 				Expect(w.Body.String()).To(ContainSubstring(`"checks"`))
 				Expect(w.Body.String()).To(ContainSubstring(`"database":"ok"`))
 				Expect(w.Body.String()).To(ContainSubstring(`"redis":"ok"`))
+				Expect(w.Body.String()).To(ContainSubstring(`"qrcode":"ok"`))
 
 				// Verify request_id is NOT in JSON body
 				Expect(w.Body.String()).ToNot(ContainSubstring(`"request_id"`))
@@ -148,6 +167,40 @@ var _ = Describe("HealthHandler", func() {
 			})
 		})
 
+		When("QR code generation fails", func() {
+			It("should return 503 Service Unavailable", func() {
+				mockQR.err = errors.New("failed to generate QR code")
+				router.GET("/api/v1/health/ready", healthHandler.GetHealthReady)
+
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/health/ready", nil)
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusServiceUnavailable))
+				Expect(w.Body.String()).To(ContainSubstring(`"code":"SERVICE_UNAVAILABLE"`))
+			})
+		})
+
+		When("some dependencies are unhealthy", func() {
+			It("should report the status of each dependency", func() {
+				mockRedis.shouldFail = true
+				mockRedis.err = errors.New("redis connection failed")
+				router.GET("/api/v1/health/ready", healthHandler.GetHealthReady)
+
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/health/ready", nil)
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusServiceUnavailable))
+				Expect(w.Header().Get("Content-Type")).To(ContainSubstring("application/problem+json"))
+				Expect(w.Body.String()).To(ContainSubstring(
+					`"checks":{"database":"ok","qrcode":"ok","redis":"unhealthy"}`,
+				))
+			})
+		})
+
 		When("both database and Redis are unhealthy", func() {
 			It("should return 503 with RFC 9457 Problem Details", func() {
 				mockDB.healthy = false
@@ -186,6 +239,7 @@ var _ = Describe("HealthHandler", func() {
 
 				Expect(w.Code).To(Equal(http.StatusOK))
 				Expect(w.Body.String()).To(ContainSubstring(`"status":"alive"`))
+				Expect(w.Body.String()).To(ContainSubstring(`"uptime_seconds":0`))
 
 				// Verify no success wrapper
 				Expect(w.Body.String()).ToNot(ContainSubstring(`"success"`))
@@ -197,5 +251,22 @@ var _ = Describe("HealthHandler", func() {
 				Expect(w.Header().Get("X-Request-ID")).ToNot(BeEmpty())
 			})
 		})
+
+		When("dependencies are unhealthy", func() {
+			It("should still return 200 OK without checking them", func() {
+				mockDB.healthy = false
+				mockRedis.shouldFail = true
+				mockRedis.err = errors.New("redis connection failed")
+				router.GET("/api/v1/health/live", healthHandler.GetHealthLive)
+
+				req := httptest.NewRequest(http.MethodGet, "/api/v1/health/live", nil)
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(`"status":"alive"`))
+			})
+		})
 	})
 })
@@ -0,0 +1,28 @@
+package handler
+
+import (
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
+)
+
+// parsePagination resolves the page and per_page query parameters of a list endpoint.
+// An omitted per_page falls back to the endpoint's configured default. Values out of range
+// are clamped rather than rejected: pages start at 1 and per_page is capped at the
+// configured maximum, so clients cannot request unbounded pages.
+func parsePagination(
+	page *generated.PageParam,
+	perPage *generated.PerPageParam,
+	size config.PageSizeConfig,
+) (int, int) {
+	resolvedPage := 1
+	if page != nil && *page > 1 {
+		resolvedPage = *page
+	}
+
+	resolvedPerPage := size.DefaultPerPage
+	if perPage != nil {
+		resolvedPerPage = min(max(*perPage, 1), size.MaxPerPage)
+	}
+
+	return resolvedPage, resolvedPerPage
+}
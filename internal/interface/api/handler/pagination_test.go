@@ -0,0 +1,173 @@
+package handler_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/handler"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
+	checkinMocks "github.com/fumkob/ezqrin-server/internal/usecase/checkin/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/event"
+	eventMocks "github.com/fumkob/ezqrin-server/internal/usecase/event/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	participantMocks "github.com/fumkob/ezqrin-server/internal/usecase/participant/mocks"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Pagination", func() {
+	var (
+		ctrl    *gomock.Controller
+		router  *gin.Engine
+		userID  uuid.UUID
+		eventID uuid.UUID
+	)
+
+	// Each endpoint gets its own bounds so that a handler reading another endpoint's
+	// configuration would be caught
+	pagination := config.PaginationConfig{
+		Events:       config.PageSizeConfig{DefaultPerPage: 15, MaxPerPage: 40},
+		Participants: config.PageSizeConfig{DefaultPerPage: 25, MaxPerPage: 60},
+		Checkins:     config.PageSizeConfig{DefaultPerPage: 50, MaxPerPage: 80},
+	}
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		ctrl = gomock.NewController(GinkgoT())
+		userID = uuid.New()
+		eventID = uuid.New()
+
+		router = gin.New()
+		router.Use(func(c *gin.Context) {
+			c.Set(middleware.ContextKeyUserID, userID)
+			c.Set(middleware.ContextKeyUserRole, "organizer")
+			c.Next()
+		})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	Describe("GET /events", func() {
+		var input event.ListEventsInput
+
+		BeforeEach(func() {
+			uc := eventMocks.NewMockUsecase(ctrl)
+			uc.EXPECT().GetListLastModified(gomock.Any(), gomock.Any()).Return(time.Time{}, nil)
+			uc.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, in event.ListEventsInput) (event.ListEventsOutput, error) {
+					input = in
+					return event.ListEventsOutput{}, nil
+				},
+			)
+
+			h := handler.NewEventHandler(uc, pagination.Events, newTestLogger())
+			router.GET("/events", func(c *gin.Context) {
+				var params generated.GetEventsParams
+				Expect(c.ShouldBindQuery(&params)).To(Succeed())
+				h.GetEvents(c, params)
+			})
+		})
+
+		It("should use the configured default when per_page is omitted", func() {
+			Expect(get("/events").Code).To(Equal(http.StatusOK))
+			Expect(input.Page).To(Equal(1))
+			Expect(input.PerPage).To(Equal(15))
+		})
+
+		It("should clamp per_page to the configured max", func() {
+			Expect(get("/events?page=2&per_page=500").Code).To(Equal(http.StatusOK))
+			Expect(input.Page).To(Equal(2))
+			Expect(input.PerPage).To(Equal(40))
+		})
+	})
+
+	Describe("GET /events/:id/participants", func() {
+		var input participant.ListParticipantsInput
+
+		BeforeEach(func() {
+			uc := participantMocks.NewMockUsecase(ctrl)
+			uc.EXPECT().GetListLastModified(gomock.Any(), userID, false, eventID).Return(time.Time{}, nil)
+			uc.EXPECT().List(gomock.Any(), userID, false, gomock.Any()).DoAndReturn(
+				func(
+					_ context.Context, _ uuid.UUID, _ bool, in participant.ListParticipantsInput,
+				) (participant.ListParticipantsOutput, error) {
+					input = in
+					return participant.ListParticipantsOutput{}, nil
+				},
+			)
+
+			h := handler.NewParticipantHandler(uc, pagination.Participants, newTestLogger())
+			router.GET("/events/:id/participants", func(c *gin.Context) {
+				var params generated.ListParticipantsParams
+				Expect(c.ShouldBindQuery(&params)).To(Succeed())
+				h.ListParticipants(c, generated.EventIDParam(eventID), params)
+			})
+		})
+
+		It("should use the configured default when per_page is omitted", func() {
+			Expect(get("/events/" + eventID.String() + "/participants").Code).To(Equal(http.StatusOK))
+			Expect(input.PerPage).To(Equal(25))
+		})
+
+		It("should clamp per_page to the configured max", func() {
+			Expect(get("/events/" + eventID.String() + "/participants?per_page=61").Code).To(Equal(http.StatusOK))
+			Expect(input.PerPage).To(Equal(60))
+		})
+	})
+
+	Describe("GET /events/:id/checkins", func() {
+		var input checkin.ListCheckInsInput
+
+		BeforeEach(func() {
+			uc := checkinMocks.NewMockUsecase(ctrl)
+			uc.EXPECT().List(gomock.Any(), userID, false, gomock.Any()).DoAndReturn(
+				func(
+					_ context.Context, _ uuid.UUID, _ bool, in checkin.ListCheckInsInput,
+				) (*checkin.ListCheckInsOutput, error) {
+					input = in
+					return &checkin.ListCheckInsOutput{}, nil
+				},
+			)
+
+			h := handler.NewCheckinHandler(uc, pagination.Checkins, newTestLogger())
+			router.GET("/events/:id/checkins", func(c *gin.Context) {
+				var params generated.ListCheckInsParams
+				Expect(c.ShouldBindQuery(&params)).To(Succeed())
+				h.ListCheckIns(c, generated.EventIDParam(eventID), params)
+			})
+		})
+
+		It("should use the configured default when per_page is omitted", func() {
+			Expect(get("/events/" + eventID.String() + "/checkins").Code).To(Equal(http.StatusOK))
+			Expect(input.PerPage).To(Equal(50))
+		})
+
+		It("should clamp per_page to the configured max", func() {
+			Expect(get("/events/" + eventID.String() + "/checkins?per_page=1000").Code).To(Equal(http.StatusOK))
+			Expect(input.PerPage).To(Equal(80))
+		})
+
+		It("should raise a non-positive per_page and page to 1", func() {
+			Expect(get("/events/" + eventID.String() + "/checkins?page=0&per_page=0").Code).To(Equal(http.StatusOK))
+			Expect(input.Page).To(Equal(1))
+			Expect(input.PerPage).To(Equal(1))
+		})
+	})
+})
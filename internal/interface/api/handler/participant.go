@@ -3,10 +3,15 @@ package handler
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"mime"
 	"mime/multipart"
 	"net/http"
+	"strconv"
+	"time"
 
+	"github.com/fumkob/ezqrin-server/config"
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
@@ -24,15 +29,21 @@ import (
 // ParticipantHandler handles participant-related endpoints.
 // Implements generated.ServerInterface for OpenAPI compliance.
 type ParticipantHandler struct {
-	usecase participant.Usecase
-	logger  *logger.Logger
+	usecase  participant.Usecase
+	pageSize config.PageSizeConfig
+	logger   *logger.Logger
 }
 
 // NewParticipantHandler creates a new ParticipantHandler
-func NewParticipantHandler(usecase participant.Usecase, logger *logger.Logger) *ParticipantHandler {
+func NewParticipantHandler(
+	usecase participant.Usecase,
+	pageSize config.PageSizeConfig,
+	logger *logger.Logger,
+) *ParticipantHandler {
 	return &ParticipantHandler{
-		usecase: usecase,
-		logger:  logger,
+		usecase:  usecase,
+		pageSize: pageSize,
+		logger:   logger,
 	}
 }
 
@@ -66,6 +77,11 @@ func (h *ParticipantHandler) CreateParticipant(c *gin.Context, eventID generated
 		PaymentStatus: entity.PaymentStatus(ptrOrDefault(req.PaymentStatus, "unpaid")),
 		PaymentAmount: req.PaymentAmount,
 		PaymentDate:   req.PaymentDate,
+		FeeTier:       req.FeeTier,
+		Notes:         req.Notes,
+		Tags:          ptrOrDefault(req.Tags, nil),
+		GroupName:     req.GroupName,
+		CustomData:    ptrOrDefault(req.CustomData, nil),
 	}
 
 	p, err := h.usecase.Create(c.Request.Context(), userID, isAdmin, input)
@@ -103,7 +119,109 @@ func (h *ParticipantHandler) BulkCreateParticipants(c *gin.Context, eventID gene
 	response.Data(c, http.StatusCreated, bulkResp)
 }
 
-const maxCSVUploadSize = 10 << 20 // 10MB
+// ValidateParticipants handles participant validation without creation
+// (POST /events/{id}/participants/validate).
+func (h *ParticipantHandler) ValidateParticipants(c *gin.Context, eventID generated.EventIDParam) {
+	var req generated.ValidateParticipantsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	// Payloads are converted exactly as for creation so that defaults apply the same way
+	input := participant.ValidateParticipantsInput{
+		EventID:      uuid.UUID(eventID),
+		Participants: make([]participant.CreateParticipantInput, len(req.Participants)),
+	}
+	for i, p := range req.Participants {
+		input.Participants[i] = h.convertToCreateInput(p, eventID)
+	}
+
+	output, err := h.usecase.ValidateParticipants(c.Request.Context(), userID, isAdmin, input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, h.convertValidateParticipantsResponse(output))
+}
+
+// InviteParticipants handles bulk invitations (POST /events/{id}/participants/invite).
+func (h *ParticipantHandler) InviteParticipants(c *gin.Context, eventID generated.EventIDParam) {
+	var req generated.InviteParticipantsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	input := participant.BulkInviteInput{
+		EventID:      uuid.UUID(eventID),
+		Participants: make([]participant.InviteParticipantInput, len(req.Participants)),
+	}
+	for i, p := range req.Participants {
+		input.Participants[i] = participant.InviteParticipantInput{
+			Name:       p.Name,
+			Email:      string(p.Email),
+			QREmail:    convertEmailPtr(p.QrEmail),
+			EmployeeID: p.EmployeeId,
+			Phone:      p.Phone,
+			Metadata:   convertMetadataToString(p.Metadata),
+			FeeTier:    p.FeeTier,
+		}
+	}
+
+	output, err := h.usecase.BulkInvite(c.Request.Context(), userID, isAdmin, input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusCreated, h.convertBulkInviteResponse(output))
+}
+
+// AcceptInvite handles invitation acceptance (POST /participants/accept-invite).
+// This endpoint is public; the signed token in the request body is the credential.
+func (h *ParticipantHandler) AcceptInvite(c *gin.Context) {
+	var req generated.AcceptInviteRequest
+	if err := c.ShouldBindJSON(&req); err != nil || req.Token == "" {
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	consentAccepted := req.ConsentAccepted != nil && *req.ConsentAccepted
+	p, err := h.usecase.AcceptInvite(c.Request.Context(), req.Token, consentAccepted)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	resp := generated.AcceptInviteResponse{
+		ParticipantId: openapi_types.UUID(p.ID),
+		EventId:       openapi_types.UUID(p.EventID),
+		Name:          p.Name,
+		Status:        generated.ParticipantStatus(p.Status),
+		QrCode:        p.QRCode,
+	}
+	if p.QRDistributionURL != "" {
+		resp.QrDistributionUrl = &p.QRDistributionURL
+	}
+	response.Data(c, http.StatusOK, resp)
+}
+
+// maxCSVFormMemory is how much of a CSV upload is held in memory; the rest goes to a temporary
+// file. The size of the upload itself is limited by middleware.MaxBodySize.
+const maxCSVFormMemory = 10 << 20 // 10MB
+
+// unrenderableBadgesHeader counts the badges of a sheet with characters the badge font cannot show.
+const unrenderableBadgesHeader = "X-Ezqrin-Unrenderable-Badges"
 
 // ImportParticipantsCSV handles CSV bulk import (POST /events/{id}/participants/import).
 func (h *ParticipantHandler) ImportParticipantsCSV(
@@ -111,9 +229,16 @@ func (h *ParticipantHandler) ImportParticipantsCSV(
 	eventID generated.EventIDParam,
 	params generated.ImportParticipantsCSVParams,
 ) {
-	// Limit request body to 10MB
-	if err := c.Request.ParseMultipartForm(maxCSVUploadSize); err != nil {
-		response.ProblemFromError(c, apperrors.BadRequest("request body too large (max 10MB)"))
+	if err := c.Request.ParseMultipartForm(maxCSVFormMemory); err != nil {
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.ProblemFromError(c, apperrors.PayloadTooLarge(
+				fmt.Sprintf("CSV file exceeds the upload limit of %d bytes", maxBytesErr.Limit),
+			))
+			return
+		}
+		h.logger.WithContext(c.Request.Context()).Warn("invalid multipart form", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid multipart form"))
 		return
 	}
 
@@ -125,16 +250,37 @@ func (h *ParticipantHandler) ImportParticipantsCSV(
 	}
 	defer func(file multipart.File) { _ = file.Close() }(file)
 
-	parsedInputs, rowErrors, err := csvparser.ParseParticipantCSV(file)
+	headerOptions, err := importHeaderOptions(c, params)
 	if err != nil {
-		response.ProblemFromError(c, apperrors.BadRequest(err.Error()))
+		response.ProblemFromError(c, err)
 		return
 	}
 
 	userID, _ := middleware.GetUserID(c)
 	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
 
+	// Columns named after the event's custom fields are read into the custom data
+	headerOptions.CustomFields, err = h.usecase.GetCustomFields(
+		c.Request.Context(), userID, isAdmin, uuid.UUID(eventID),
+	)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	parsed, err := csvparser.ParseParticipantCSVWithOptions(file, headerOptions)
+	if err != nil {
+		response.ProblemFromError(c, csvImportError(err))
+		return
+	}
+	parsedInputs := parsed.Inputs
+
 	skipDuplicates := params.SkipDuplicates != nil && *params.SkipDuplicates
+	atomic := params.Atomic != nil && *params.Atomic
+	if atomic && len(parsed.RowErrors) > 0 {
+		response.ProblemFromError(c, importAbortedError(parsed.RowErrors[0].Row, parsed.RowErrors[0].Message))
+		return
+	}
 	eventUUID := uuid.UUID(eventID)
 
 	bulkParticipants := make([]participant.CreateParticipantInput, len(parsedInputs))
@@ -149,18 +295,76 @@ func (h *ParticipantHandler) ImportParticipantsCSV(
 		EventID:        eventUUID,
 		Participants:   bulkParticipants,
 		SkipDuplicates: skipDuplicates,
+		Atomic:         atomic,
 	}
 
 	output, err := h.usecase.BulkCreate(c.Request.Context(), userID, isAdmin, bulkInput)
 	if err != nil {
+		var abortedErr *participant.BulkCreateAbortedError
+		if errors.As(err, &abortedErr) && abortedErr.Failure.Index < len(rowNumbers) {
+			err = importAbortedError(rowNumbers[abortedErr.Failure.Index], abortedErr.Failure.Message)
+		}
 		response.ProblemFromError(c, err)
 		return
 	}
 
-	resp := h.convertImportCSVResponse(output, rowErrors, rowNumbers)
+	resp := h.convertImportCSVResponse(output, parsed.RowErrors, rowNumbers, parsed.IgnoredColumns)
 	response.Data(c, http.StatusOK, resp)
 }
 
+// importHeaderOptions reads how the header row of a CSV import is mapped from the
+// header_mapping query parameter and the column_mapping form field.
+func importHeaderOptions(
+	c *gin.Context,
+	params generated.ImportParticipantsCSVParams,
+) (csvparser.HeaderOptions, error) {
+	opts := csvparser.HeaderOptions{Mode: csvparser.HeaderModeStrict}
+	if params.HeaderMapping != nil {
+		if !params.HeaderMapping.Valid() {
+			return opts, apperrors.BadRequest("header_mapping must be 'strict' or 'aliases'")
+		}
+		opts.Mode = csvparser.HeaderMode(*params.HeaderMapping)
+	}
+
+	if raw := c.Request.FormValue("column_mapping"); raw != "" {
+		if err := json.Unmarshal([]byte(raw), &opts.Mapping); err != nil {
+			return opts, apperrors.BadRequest("column_mapping must be a JSON object mapping header names to columns")
+		}
+	}
+	return opts, nil
+}
+
+// importAbortedError returns the 422 error of an atomic CSV import rolled back because the row
+// with the given number could not be imported.
+func importAbortedError(row int, message string) error {
+	return apperrors.Unprocessable(fmt.Sprintf("row %d could not be imported, so none were: %s", row, message)).
+		WithValidationErrors([]apperrors.ValidationError{{Field: fmt.Sprintf("row %d", row), Message: message}})
+}
+
+// csvImportError converts a file-level CSV parse error to a 400 response error. A header
+// lacking required columns lists the missing and the ignored columns as validation errors.
+func csvImportError(err error) error {
+	var headerErr *csvparser.HeaderError
+	if !errors.As(err, &headerErr) {
+		return apperrors.BadRequest(err.Error())
+	}
+
+	details := make([]apperrors.ValidationError, 0, len(headerErr.Missing)+len(headerErr.Ignored))
+	for _, column := range headerErr.Missing {
+		details = append(details, apperrors.ValidationError{
+			Field:   column,
+			Message: "required column not found in CSV header",
+		})
+	}
+	for _, column := range headerErr.Ignored {
+		details = append(details, apperrors.ValidationError{
+			Field:   column,
+			Message: "unknown column ignored",
+		})
+	}
+	return apperrors.BadRequest(err.Error()).WithValidationErrors(details)
+}
+
 // ListParticipants handles listing participants (GET /events/{id}/participants).
 func (h *ParticipantHandler) ListParticipants(
 	c *gin.Context,
@@ -172,18 +376,11 @@ func (h *ParticipantHandler) ListParticipants(
 
 	input := participant.ListParticipantsInput{
 		EventID: uuid.UUID(eventID),
-		Page:    1,
-		PerPage: defaultPerPage,
 		Sort:    "created_at",
 		Order:   "desc",
 	}
+	input.Page, input.PerPage = parsePagination(params.Page, params.PerPage, h.pageSize)
 
-	if params.Page != nil {
-		input.Page = int(*params.Page)
-	}
-	if params.PerPage != nil {
-		input.PerPage = int(*params.PerPage)
-	}
 	if params.Search != nil {
 		input.Search = *params.Search
 	}
@@ -191,6 +388,14 @@ func (h *ParticipantHandler) ListParticipants(
 		status := entity.ParticipantStatus(*params.Status)
 		input.Status = &status
 	}
+	input.Group = params.Group
+	if params.IncludeDeleted != nil && *params.IncludeDeleted {
+		if !isAdmin {
+			response.ProblemFromError(c, apperrors.Forbidden("only admins can list deleted participants"))
+			return
+		}
+		input.IncludeDeleted = true
+	}
 	if params.Sort != nil {
 		input.Sort = *params.Sort
 	}
@@ -198,6 +403,15 @@ func (h *ParticipantHandler) ListParticipants(
 		input.Order = string(*params.Order)
 	}
 
+	lastModified, err := h.usecase.GetListLastModified(c.Request.Context(), userID, isAdmin, input.EventID)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+	if respondNotModified(c, lastModified) {
+		return
+	}
+
 	output, err := h.usecase.List(c.Request.Context(), userID, isAdmin, input)
 	if err != nil {
 		response.ProblemFromError(c, err)
@@ -211,13 +425,108 @@ func (h *ParticipantHandler) ListParticipants(
 
 	resp := generated.ParticipantListResponse{
 		Data: participants,
-		Meta: generated.PaginationMeta{
+		Meta: generated.ParticipantListMeta{
 			Page:       input.Page,
 			PerPage:    input.PerPage,
 			Total:      int(output.TotalCount),
 			TotalPages: int((output.TotalCount + int64(input.PerPage) - 1) / int64(input.PerPage)),
 		},
 	}
+	if output.Capacity > 0 {
+		capacity := output.Capacity
+		remaining := int(output.CapacityRemaining)
+		resp.Meta.Capacity = &capacity
+		resp.Meta.CapacityRemaining = &remaining
+	}
+
+	response.Data(c, http.StatusOK, resp)
+}
+
+// ListParticipantChanges handles listing participant changes for incremental sync
+// (GET /events/{id}/participants/changes).
+func (h *ParticipantHandler) ListParticipantChanges(
+	c *gin.Context,
+	eventID generated.EventIDParam,
+	params generated.ListParticipantChangesParams,
+) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	output, err := h.usecase.ListChanges(c.Request.Context(), userID, isAdmin, uuid.UUID(eventID), params.Since)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	participants := make([]generated.Participant, len(output.Participants))
+	for i, p := range output.Participants {
+		participants[i] = h.toGeneratedParticipant(p)
+	}
+	deleted := make([]generated.DeletedParticipant, len(output.Deleted))
+	for i, d := range output.Deleted {
+		deleted[i] = generated.DeletedParticipant{Id: d.ID, DeletedAt: d.DeletedAt}
+	}
+
+	response.Data(c, http.StatusOK, generated.ParticipantChangesResponse{
+		Participants: participants,
+		Deleted:      deleted,
+		Since:        output.Since,
+	})
+}
+
+// AutocompleteParticipants handles suggesting participants by name prefix for manual check-in
+// (GET /events/{id}/participants/autocomplete).
+func (h *ParticipantHandler) AutocompleteParticipants(
+	c *gin.Context,
+	eventID generated.EventIDParam,
+	params generated.AutocompleteParticipantsParams,
+) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	matches, err := h.usecase.Autocomplete(c.Request.Context(), userID, isAdmin, uuid.UUID(eventID), params.Q)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	data := make([]generated.ParticipantSuggestion, len(matches))
+	for i, m := range matches {
+		data[i] = generated.ParticipantSuggestion{Id: m.ID, Name: m.Name, CheckedIn: m.CheckedIn}
+		if m.EmailMasked != "" {
+			data[i].EmailMasked = &m.EmailMasked
+		}
+	}
+
+	response.Data(c, http.StatusOK, generated.ParticipantAutocompleteResponse{Data: data})
+}
+
+// GetParticipantStats handles getting an event's participant statistics
+// (GET /events/{id}/participants/stats).
+func (h *ParticipantHandler) GetParticipantStats(c *gin.Context, id generated.EventIDParam) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	stats, err := h.usecase.GetStats(c.Request.Context(), userID, isAdmin, uuid.UUID(id))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	resp := generated.ParticipantStatsResponse{
+		EventId:               id,
+		TotalParticipants:     int(stats.TotalParticipants),
+		ConfirmedParticipants: int(stats.ConfirmedParticipants),
+		TentativeParticipants: int(stats.TentativeParticipants),
+		DeclinedParticipants:  int(stats.DeclinedParticipants),
+		PaidParticipants:      int(stats.PaidParticipants),
+		UnpaidParticipants:    int(stats.UnpaidParticipants),
+		TotalRevenue:          stats.TotalRevenue,
+		TotalRefunded:         stats.TotalRefunded,
+	}
+	if stats.Currency != "" {
+		resp.Currency = &stats.Currency
+	}
 
 	response.Data(c, http.StatusOK, resp)
 }
@@ -237,6 +546,31 @@ func (h *ParticipantHandler) GetParticipant(c *gin.Context, id generated.Partici
 	response.Data(c, http.StatusOK, h.toGeneratedParticipant(p))
 }
 
+// LookupParticipants handles participant lookup by email (GET /participants/lookup).
+// Staff users manage no events and are rejected outright.
+func (h *ParticipantHandler) LookupParticipants(c *gin.Context, params generated.LookupParticipantsParams) {
+	userID, _ := middleware.GetUserID(c)
+	role := middleware.GetUserRole(c)
+	if role == string(entity.RoleStaff) {
+		response.ProblemFromError(c, apperrors.Forbidden("you do not have permission to look up participants"))
+		return
+	}
+
+	participants, err := h.usecase.LookupByEmail(
+		c.Request.Context(), userID, role == string(entity.RoleAdmin), params.Email,
+	)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	data := make([]generated.Participant, len(participants))
+	for i, p := range participants {
+		data[i] = h.toGeneratedParticipant(p)
+	}
+	response.Data(c, http.StatusOK, generated.ParticipantLookupResponse{Data: data})
+}
+
 // UpdateParticipant handles participant update (PUT /participants/{id}).
 func (h *ParticipantHandler) UpdateParticipant(c *gin.Context, id generated.ParticipantIDParam) {
 	participantID := uuid.UUID(id)
@@ -261,16 +595,16 @@ func (h *ParticipantHandler) UpdateParticipant(c *gin.Context, id generated.Part
 		Metadata:      convertMetadataToString(req.Metadata),
 		PaymentAmount: req.PaymentAmount,
 		PaymentDate:   req.PaymentDate,
+		Notes:         req.Notes,
+		Tags:          req.Tags,
+		GroupName:     req.GroupName,
+		CustomData:    req.CustomData,
 	}
 
 	if req.Status != nil {
 		status := entity.ParticipantStatus(*req.Status)
 		input.Status = &status
 	}
-	if req.PaymentStatus != nil {
-		paymentStatus := entity.PaymentStatus(*req.PaymentStatus)
-		input.PaymentStatus = &paymentStatus
-	}
 
 	p, err := h.usecase.Update(c.Request.Context(), userID, isAdmin, participantID, input)
 	if err != nil {
@@ -296,6 +630,262 @@ func (h *ParticipantHandler) DeleteParticipant(c *gin.Context, id generated.Part
 	response.NoContent(c)
 }
 
+// RestoreParticipant handles restoring a deleted participant (POST /participants/{id}/restore).
+func (h *ParticipantHandler) RestoreParticipant(c *gin.Context, id generated.ParticipantIDParam) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	p, err := h.usecase.Restore(c.Request.Context(), userID, isAdmin, uuid.UUID(id))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, h.toGeneratedParticipant(p))
+}
+
+// MergeParticipants handles merging a duplicate participant into the primary (POST /participants/merge).
+func (h *ParticipantHandler) MergeParticipants(c *gin.Context) {
+	var req generated.MergeParticipantsJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	input := participant.MergeParticipantsInput{
+		PrimaryID:   uuid.UUID(req.PrimaryId),
+		DuplicateID: uuid.UUID(req.DuplicateId),
+	}
+
+	output, err := h.usecase.Merge(c.Request.Context(), userID, isAdmin, input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	resp := generated.MergeParticipantsResponse{
+		Participant: h.toGeneratedParticipant(output.Participant),
+		Checkins:    make([]generated.CheckInHistoryEntry, len(output.CheckIns)),
+	}
+	for i, checkin := range output.CheckIns {
+		resp.Checkins[i] = toCheckInHistoryEntry(checkin)
+	}
+	response.Data(c, http.StatusOK, resp)
+}
+
+// RecordParticipantConsent handles manual consent recording (POST /participants/{id}/consent).
+func (h *ParticipantHandler) RecordParticipantConsent(c *gin.Context, id generated.ParticipantIDParam) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	p, err := h.usecase.RecordConsent(c.Request.Context(), userID, isAdmin, uuid.UUID(id))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, h.toGeneratedParticipant(p))
+}
+
+// PromoteParticipant handles confirming a waitlisted participant (POST /participants/{id}/promote).
+func (h *ParticipantHandler) PromoteParticipant(c *gin.Context, id generated.ParticipantIDParam) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	p, err := h.usecase.Promote(c.Request.Context(), userID, isAdmin, uuid.UUID(id))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, h.toGeneratedParticipant(p))
+}
+
+// SendParticipantInvite handles emailing a participant their QR code
+// (POST /events/{id}/participants/{pid}/send-invite).
+func (h *ParticipantHandler) SendParticipantInvite(
+	c *gin.Context,
+	id generated.EventIDParam,
+	pid openapi_types.UUID,
+) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	p, err := h.usecase.SendInvite(c.Request.Context(), userID, isAdmin, uuid.UUID(id), uuid.UUID(pid))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, h.toGeneratedParticipant(p))
+}
+
+// SendEventInvites handles emailing participants their QR codes
+// (POST /events/{id}/participants/send-invites).
+func (h *ParticipantHandler) SendEventInvites(c *gin.Context, id generated.EventIDParam) {
+	var req generated.SendEventInvitesJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	input := participant.SendInvitesInput{EventID: uuid.UUID(id)}
+	if req.ParticipantIds != nil {
+		input.ParticipantIDs = make([]uuid.UUID, 0, len(*req.ParticipantIds))
+		for _, pid := range *req.ParticipantIds {
+			input.ParticipantIDs = append(input.ParticipantIDs, uuid.UUID(pid))
+		}
+	}
+
+	result, err := h.usecase.SendInvites(c.Request.Context(), userID, isAdmin, input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	// Return 207 Multi-Status when some sends succeeded and some failed, as for QR code links
+	statusCode := http.StatusOK
+	if result.FailedCount > 0 && result.SentCount > 0 {
+		statusCode = http.StatusMultiStatus
+	}
+
+	response.Data(c, statusCode, generated.SendQRCodesResponse{
+		SentCount:   result.SentCount,
+		FailedCount: result.FailedCount,
+		Total:       result.Total,
+		Failures:    toGeneratedFailures(result.Failures),
+	})
+}
+
+// AddParticipantGuest handles guest registration (POST /participants/{id}/guests).
+func (h *ParticipantHandler) AddParticipantGuest(c *gin.Context, id generated.ParticipantIDParam) {
+	var req generated.AddParticipantGuestJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	input := participant.AddGuestInput{
+		Name:  req.Name,
+		Email: string(ptrOrDefault(req.Email, "")),
+	}
+
+	p, err := h.usecase.AddGuest(c.Request.Context(), userID, isAdmin, uuid.UUID(id), input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusCreated, h.toGeneratedParticipant(p))
+}
+
+// UpdateParticipantTags handles adding and removing tags on many participants
+// (PATCH /events/{id}/participants/tags).
+func (h *ParticipantHandler) UpdateParticipantTags(c *gin.Context, id generated.EventIDParam) {
+	var req generated.UpdateParticipantTagsJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	input := participant.UpdateTagsInput{
+		EventID:        uuid.UUID(id),
+		ParticipantIDs: ptrOrDefault(req.ParticipantIds, nil),
+		Add:            ptrOrDefault(req.Add, nil),
+		Remove:         ptrOrDefault(req.Remove, nil),
+	}
+	if req.Filter != nil {
+		input.Filter = &participant.TagFilter{Tag: req.Filter.Tag}
+		if req.Filter.Status != nil {
+			status := entity.ParticipantStatus(*req.Filter.Status)
+			input.Filter.Status = &status
+		}
+		if req.Filter.PaymentStatus != nil {
+			paymentStatus := entity.PaymentStatus(*req.Filter.PaymentStatus)
+			input.Filter.PaymentStatus = &paymentStatus
+		}
+	}
+
+	updated, err := h.usecase.UpdateTags(c.Request.Context(), userID, isAdmin, input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, generated.UpdateParticipantTagsResponse{UpdatedCount: updated})
+}
+
+// AssignParticipantGroups handles assigning many participants to a group
+// (POST /events/{id}/participants/assign-groups).
+func (h *ParticipantHandler) AssignParticipantGroups(c *gin.Context, id generated.EventIDParam) {
+	var req generated.AssignParticipantGroupsJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	updated, err := h.usecase.AssignGroup(c.Request.Context(), userID, isAdmin, participant.AssignGroupInput{
+		EventID:        uuid.UUID(id),
+		ParticipantIDs: req.ParticipantIds,
+		GroupName:      req.GroupName,
+	})
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, generated.AssignParticipantGroupsResponse{UpdatedCount: updated})
+}
+
+// BulkUpdateParticipantStatus handles moving many participants to a status
+// (PATCH /events/{id}/participants/bulk-status).
+func (h *ParticipantHandler) BulkUpdateParticipantStatus(c *gin.Context, id generated.EventIDParam) {
+	var req generated.BulkUpdateParticipantStatusJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	output, err := h.usecase.BulkUpdateStatus(c.Request.Context(), userID, isAdmin, participant.BulkUpdateStatusInput{
+		EventID:        uuid.UUID(id),
+		ParticipantIDs: req.ParticipantIds,
+		Status:         entity.ParticipantStatus(req.Status),
+	})
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, generated.BulkUpdateParticipantStatusResponse{
+		UpdatedCount: output.UpdatedCount,
+		SkippedCount: output.SkippedCount,
+	})
+}
+
 // DownloadParticipantQRCode handles QR code download (GET /participants/{id}/qrcode).
 func (h *ParticipantHandler) DownloadParticipantQRCode(
 	c *gin.Context,
@@ -328,6 +918,30 @@ func (h *ParticipantHandler) DownloadParticipantQRCode(
 	c.Data(http.StatusOK, qr.ContentType, qr.Data)
 }
 
+// PreviewParticipantConfirmationEmail handles previewing a participant's QR code email
+// (GET /participants/{id}/confirmation-preview).
+func (h *ParticipantHandler) PreviewParticipantConfirmationEmail(c *gin.Context, id generated.ParticipantIDParam) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	preview, err := h.usecase.PreviewConfirmationEmail(c.Request.Context(), userID, isAdmin, uuid.UUID(id))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	resp := generated.ConfirmationEmailPreview{
+		To:        preview.To,
+		Subject:   preview.Subject,
+		TextBody:  preview.TextBody,
+		QrCodePng: preview.QRCodePNG,
+	}
+	if preview.HTMLBody != "" {
+		resp.HtmlBody = &preview.HTMLBody
+	}
+	response.Data(c, http.StatusOK, resp)
+}
+
 // ExportParticipantsCSV handles CSV export (GET /events/{id}/participants/export).
 func (h *ParticipantHandler) ExportParticipantsCSV(
 	c *gin.Context,
@@ -361,6 +975,89 @@ func (h *ParticipantHandler) ExportParticipantsCSV(
 	c.Data(http.StatusOK, "text/csv; charset=utf-8", buf.Bytes())
 }
 
+// PrintParticipantBadges handles streaming a PDF badge sheet for an event's participants
+// (GET /events/{id}/participants/badges).
+func (h *ParticipantHandler) PrintParticipantBadges(
+	c *gin.Context,
+	id generated.EventIDParam,
+	params generated.PrintParticipantBadgesParams,
+) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+	eventID := uuid.UUID(id)
+
+	input := participant.BadgeSheetInput{EventID: eventID}
+	if params.PerPage != nil {
+		input.PerPage = *params.PerPage
+	}
+	if params.Status != nil {
+		status := entity.ParticipantStatus(*params.Status)
+		input.Status = &status
+	}
+
+	sheet, err := h.usecase.GetBadgeSheet(c.Request.Context(), userID, isAdmin, input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	// The sheet is streamed while it is rendered and outlives the server write timeout; writers
+	// that cannot lift it (e.g. in tests) keep theirs
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	filename := fmt.Sprintf("badges_%s.pdf", eventID.String())
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Header("Content-Type", "application/pdf")
+	// Names the badge font cannot show are printed with question marks; the header lets
+	// clients warn about them, as the PDF itself cannot
+	if unrenderable := sheet.Unrenderable(); unrenderable > 0 {
+		c.Header(unrenderableBadgesHeader, strconv.Itoa(unrenderable))
+	}
+	c.Status(http.StatusOK)
+	// The response has started, so a failure can only be logged; the client gets a truncated file
+	if err := sheet.Render(c.Request.Context(), c.Writer); err != nil {
+		h.logger.WithContext(c.Request.Context()).Error("failed to render badge sheet",
+			zap.String("event_id", eventID.String()), zap.Error(err))
+	}
+}
+
+// DownloadParticipantQRCodes handles streaming the QR codes of an event's participants as a ZIP archive
+// (GET /events/{id}/participants/qrcodes.zip).
+func (h *ParticipantHandler) DownloadParticipantQRCodes(
+	c *gin.Context,
+	id generated.EventIDParam,
+	params generated.DownloadParticipantQRCodesParams,
+) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+	eventID := uuid.UUID(id)
+
+	input := participant.QRCodeArchiveInput{EventID: eventID, Format: "png"}
+	if params.Format != nil {
+		input.Format = string(*params.Format)
+	}
+
+	archive, err := h.usecase.GetQRCodeArchive(c.Request.Context(), userID, isAdmin, input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	// The archive is streamed while it is built and outlives the server write timeout; writers
+	// that cannot lift it (e.g. in tests) keep theirs
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	// The event name may be non-ASCII, which FormatMediaType encodes as RFC 2231 requires
+	c.Header("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": archive.Filename}))
+	c.Header("Content-Type", "application/zip")
+	c.Status(http.StatusOK)
+	// The response has started, so a failure can only be logged; the client gets a truncated archive
+	if err := archive.Write(c.Request.Context(), c.Writer); err != nil {
+		h.logger.WithContext(c.Request.Context()).Error("failed to write QR code archive",
+			zap.String("event_id", eventID.String()), zap.Error(err))
+	}
+}
+
 // Helper functions
 
 // convertBulkCreateRequest converts API request to usecase input
@@ -401,6 +1098,37 @@ func (h *ParticipantHandler) convertToCreateInput(
 		PaymentStatus: entity.PaymentStatus(ptrOrDefault(p.PaymentStatus, "unpaid")),
 		PaymentAmount: p.PaymentAmount,
 		PaymentDate:   p.PaymentDate,
+		FeeTier:       p.FeeTier,
+		Notes:         p.Notes,
+		Tags:          ptrOrDefault(p.Tags, nil),
+		GroupName:     p.GroupName,
+		CustomData:    ptrOrDefault(p.CustomData, nil),
+	}
+}
+
+// convertValidateParticipantsResponse converts validation results to API response
+func (h *ParticipantHandler) convertValidateParticipantsResponse(
+	output participant.ValidateParticipantsOutput,
+) generated.ValidateParticipantsResponse {
+	results := make([]generated.ParticipantValidationResult, len(output.Results))
+	for i, r := range output.Results {
+		fieldErrors := make([]generated.ValidationError, len(r.Errors))
+		for j, fe := range r.Errors {
+			fieldErrors[j] = generated.ValidationError{Field: fe.Field, Message: fe.Message}
+		}
+		results[i] = generated.ParticipantValidationResult{
+			Index:          r.Index,
+			Email:          r.Email,
+			Valid:          r.Valid,
+			DuplicateEmail: r.DuplicateEmail,
+			Errors:         fieldErrors,
+		}
+	}
+
+	return generated.ValidateParticipantsResponse{
+		ValidCount:   output.ValidCount,
+		InvalidCount: output.InvalidCount,
+		Results:      results,
 	}
 }
 
@@ -423,6 +1151,35 @@ func (h *ParticipantHandler) convertBulkCreateResponse(
 	}
 }
 
+// convertBulkInviteResponse converts usecase output to API response
+func (h *ParticipantHandler) convertBulkInviteResponse(
+	output participant.BulkInviteOutput,
+) generated.InviteParticipantsResponse {
+	invited := make([]generated.Participant, len(output.Participants))
+	for i, p := range output.Participants {
+		invited[i] = h.toGeneratedParticipant(p)
+	}
+
+	emailFailures := make([]generated.InvitationEmailFailure, len(output.EmailFailures))
+	for i, f := range output.EmailFailures {
+		emailFailures[i] = generated.InvitationEmailFailure{
+			ParticipantId: openapi_types.UUID(f.ParticipantID),
+			Email:         openapi_types.Email(f.Email),
+			Reason:        f.Reason,
+		}
+	}
+
+	bulkErrors := h.convertBulkErrors(output.Errors)
+
+	return generated.InviteParticipantsResponse{
+		InvitedCount:  output.InvitedCount,
+		FailedCount:   output.FailedCount,
+		Participants:  invited,
+		Errors:        &bulkErrors,
+		EmailFailures: emailFailures,
+	}
+}
+
 // convertBulkErrors converts bulk create errors to API format
 func (h *ParticipantHandler) convertBulkErrors(errors []participant.BulkCreateError) []struct {
 	Email openapi_types.Email `json:"email"`
@@ -453,16 +1210,19 @@ func (h *ParticipantHandler) convertBulkErrors(errors []participant.BulkCreateEr
 func (h *ParticipantHandler) toGeneratedParticipant(p *entity.Participant) generated.Participant {
 	id := openapi_types.UUID(p.ID)
 	eventID := openapi_types.UUID(p.EventID)
-	email := openapi_types.Email(p.Email)
+
+	createdAtUTC := p.CreatedAt.UTC()
+	updatedAtUTC := p.UpdatedAt.UTC()
+	qrCodeGeneratedAtUTC := p.QRCodeGeneratedAt.UTC()
 
 	genParticipant := generated.Participant{
 		Id:        &id,
 		EventId:   &eventID,
 		Name:      p.Name,
-		Email:     email,
+		Email:     p.Email,
 		Status:    generated.ParticipantStatus(p.Status),
-		CreatedAt: &p.CreatedAt,
-		UpdatedAt: &p.UpdatedAt,
+		CreatedAt: &createdAtUTC,
+		UpdatedAt: &updatedAtUTC,
 	}
 
 	if p.QREmail != nil {
@@ -479,23 +1239,50 @@ func (h *ParticipantHandler) toGeneratedParticipant(p *entity.Participant) gener
 		metadata := convertRawMessageToMap(p.Metadata)
 		genParticipant.Metadata = &metadata
 	}
+	if len(p.CustomData) > 0 {
+		customData := p.CustomData
+		genParticipant.CustomData = &customData
+	}
 
 	paymentStatus := generated.PaymentStatus(p.PaymentStatus)
 	genParticipant.PaymentStatus = &paymentStatus
 	if p.PaymentAmount != nil {
 		genParticipant.PaymentAmount = p.PaymentAmount
 	}
-	if p.PaymentDate != nil {
-		genParticipant.PaymentDate = p.PaymentDate
+	genParticipant.PaymentDate = utcTimePtr(p.PaymentDate)
+
+	genParticipant.ConsentAcceptedAt = utcTimePtr(p.ConsentAcceptedAt)
+	if p.ConsentVersion != "" {
+		genParticipant.ConsentVersion = &p.ConsentVersion
+	}
+	// Notes are internal; every route returning a full participant is restricted to the
+	// event organizer and admins, while attendee-facing responses use their own schemas
+	genParticipant.Notes = p.Notes
+	tags := p.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	genParticipant.Tags = &tags
+	genParticipant.GroupName = p.GroupName
+	if len(p.Warnings) > 0 {
+		genParticipant.Warnings = &p.Warnings
 	}
 
-	genParticipant.CheckedIn = &p.CheckedIn
-	if p.CheckedInAt != nil {
-		genParticipant.CheckedInAt = p.CheckedInAt
+	genParticipant.WalkIn = &p.WalkIn
+	if p.GuestOf != nil {
+		guestOf := openapi_types.UUID(*p.GuestOf)
+		genParticipant.GuestOf = &guestOf
 	}
+	genParticipant.InviteSentAt = utcTimePtr(p.InviteSentAt)
+	genParticipant.CheckedIn = &p.CheckedIn
+	genParticipant.CheckedInAt = utcTimePtr(p.CheckedInAt)
+	genParticipant.DeletedAt = utcTimePtr(p.DeletedAt)
 
-	genParticipant.QrCode = &p.QRCode
-	genParticipant.QrCodeGeneratedAt = &p.QRCodeGeneratedAt
+	// Invited participants have no QR code until they accept the invitation
+	if p.QRCode != "" {
+		genParticipant.QrCode = &p.QRCode
+		genParticipant.QrCodeGeneratedAt = &qrCodeGeneratedAtUTC
+	}
 	if p.QRDistributionURL != "" {
 		genParticipant.QrDistributionUrl = &p.QRDistributionURL
 	}
@@ -503,6 +1290,15 @@ func (h *ParticipantHandler) toGeneratedParticipant(p *entity.Participant) gener
 	return genParticipant
 }
 
+// utcTimePtr returns a pointer to t converted to UTC, or nil if t is nil
+func utcTimePtr(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	utc := t.UTC()
+	return &utc
+}
+
 // ptrOrDefault returns the value pointed to by ptr, or defaultVal if ptr is nil
 func ptrOrDefault[T any](ptr *T, defaultVal T) T {
 	if ptr != nil {
@@ -550,6 +1346,7 @@ func (h *ParticipantHandler) convertImportCSVResponse(
 	output participant.BulkCreateOutput,
 	rowErrors []csvparser.RowError,
 	rowNumbers []int,
+	ignoredColumns []string,
 ) generated.ImportParticipantsCSVResponse {
 	type errItem = struct {
 		Email   *string `json:"email,omitempty"`
@@ -597,13 +1394,32 @@ func (h *ParticipantHandler) convertImportCSVResponse(
 		skippedRows = append(skippedRows, item)
 	}
 
+	// Warnings have the same shape as errors
+	warnings := make([]errItem, 0)
+	for _, w := range output.Warnings {
+		row := 0
+		if w.Index < len(rowNumbers) {
+			row = rowNumbers[w.Index]
+		}
+		item := errItem{Row: row, Message: w.Message}
+		if w.Email != "" {
+			item.Email = &w.Email
+		}
+		warnings = append(warnings, item)
+	}
+
 	failedCount := output.FailedCount + len(rowErrors)
+	if ignoredColumns == nil {
+		ignoredColumns = make([]string, 0)
+	}
 
 	return generated.ImportParticipantsCSVResponse{
-		ImportedCount: output.CreatedCount,
-		SkippedCount:  output.SkippedCount,
-		FailedCount:   failedCount,
-		Errors:        &errors,
-		SkippedRows:   &skippedRows,
+		ImportedCount:  output.CreatedCount,
+		SkippedCount:   output.SkippedCount,
+		FailedCount:    failedCount,
+		Errors:         &errors,
+		SkippedRows:    &skippedRows,
+		Warnings:       &warnings,
+		IgnoredColumns: &ignoredColumns,
 	}
 }
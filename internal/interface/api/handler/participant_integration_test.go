@@ -103,6 +103,7 @@ var _ = Describe("Participant API Integration", func() {
 			CORS: config.CORSConfig{
 				AllowedOrigins: []string{"*"},
 			},
+			Pagination: testPagination,
 		}
 
 		log, _ = logger.New(logger.Config{
@@ -835,12 +836,66 @@ var _ = Describe("Participant API Integration", func() {
 			})
 		})
 
+		When("CSV uses column aliases with header_mapping=aliases", func() {
+			It("should import the rows and report ignored columns", func() {
+				body := &bytes.Buffer{}
+				writer := multipart.NewWriter(body)
+				part, _ := writer.CreateFormFile("file", "aliases.csv")
+				_, _ = strings.NewReader("Email Address,氏名,Department\nalias@example.com,Alias User,Sales").WriteTo(part)
+				_ = writer.Close()
+
+				req, _ := http.NewRequest(
+					http.MethodPost,
+					"/api/v1/events/"+testEventID+"/participants/import?header_mapping=aliases",
+					body,
+				)
+				req.Header.Set("Content-Type", writer.FormDataContentType())
+				req.Header.Set("Authorization", "Bearer "+organizerAuth.AccessToken)
+
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp["imported_count"]).To(BeNumerically("==", 1))
+				Expect(resp["ignored_columns"]).To(Equal([]interface{}{"Department"}))
+			})
+		})
+
+		When("CSV columns are mapped explicitly with column_mapping", func() {
+			It("should import the rows", func() {
+				body := &bytes.Buffer{}
+				writer := multipart.NewWriter(body)
+				part, _ := writer.CreateFormFile("file", "mapped.csv")
+				_, _ = strings.NewReader("Attendee,Work Email\nMapped User,mapped@example.com").WriteTo(part)
+				_ = writer.WriteField("column_mapping", `{"Attendee": "name", "Work Email": "email"}`)
+				_ = writer.Close()
+
+				req, _ := http.NewRequest(
+					http.MethodPost,
+					"/api/v1/events/"+testEventID+"/participants/import",
+					body,
+				)
+				req.Header.Set("Content-Type", writer.FormDataContentType())
+				req.Header.Set("Authorization", "Bearer "+organizerAuth.AccessToken)
+
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp["imported_count"]).To(BeNumerically("==", 1))
+			})
+		})
+
 		When("CSV has invalid format (missing required columns)", func() {
 			It("should return 400", func() {
 				body := &bytes.Buffer{}
 				writer := multipart.NewWriter(body)
 				part, _ := writer.CreateFormFile("file", "bad.csv")
-				_, _ = strings.NewReader("phone,employee_id\n+1-555,EMP001").WriteTo(part)
+				_, _ = strings.NewReader("phone,employee_id,Department\n+1-555,EMP001,Sales").WriteTo(part)
 				_ = writer.Close()
 
 				req, _ := http.NewRequest(
@@ -855,6 +910,13 @@ var _ = Describe("Participant API Integration", func() {
 				router.ServeHTTP(w, req)
 
 				Expect(w.Code).To(Equal(http.StatusBadRequest))
+				var problem map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+				Expect(problem["errors"]).To(ConsistOf(
+					HaveKeyWithValue("field", "name"),
+					HaveKeyWithValue("field", "email"),
+					HaveKeyWithValue("field", "Department"),
+				))
 			})
 		})
 	})
@@ -0,0 +1,1438 @@
+package handler_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode/badge"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/handler"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	participantMocks "github.com/fumkob/ezqrin-server/internal/usecase/participant/mocks"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+// testCSVUploadLimit is the body size limit of the CSV import route in newParticipantHandlerRouter
+const testCSVUploadLimit = 1024
+
+// newParticipantHandlerRouter creates a Gin test router with the creation, listing, invitation, validation,
+// lookup and sync routes. Auth context is injected only for the organizer-facing routes; accepting is public.
+func newParticipantHandlerRouter(
+	uc participant.Usecase,
+	userID uuid.UUID,
+	role string,
+	log *logger.Logger,
+) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	h := handler.NewParticipantHandler(uc, testPagination.Participants, log)
+
+	r.POST("/events/:id/participants", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.CreateParticipant(c, generated.EventIDParam(id))
+	})
+	r.GET("/events/:id/participants", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		var params generated.ListParticipantsParams
+		if includeDeleted, err := strconv.ParseBool(c.Query("include_deleted")); err == nil {
+			params.IncludeDeleted = &includeDeleted
+		}
+		h.ListParticipants(c, generated.EventIDParam(id), params)
+	})
+	r.POST("/events/:id/participants/invite", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.InviteParticipants(c, generated.EventIDParam(id))
+	})
+	r.POST("/events/:id/participants/send-invites", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.SendEventInvites(c, generated.EventIDParam(id))
+	})
+	r.POST("/events/:id/participants/:pid/send-invite", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		pid, _ := uuid.Parse(c.Param("pid"))
+		h.SendParticipantInvite(c, generated.EventIDParam(id), pid)
+	})
+	r.POST("/events/:id/participants/validate", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.ValidateParticipants(c, generated.EventIDParam(id))
+	})
+	r.GET("/participants/lookup", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		h.LookupParticipants(c, generated.LookupParticipantsParams{Email: c.Query("email")})
+	})
+	r.POST("/participants/accept-invite", h.AcceptInvite)
+	r.POST("/participants/:id/consent", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.RecordParticipantConsent(c, generated.ParticipantIDParam(id))
+	})
+	r.POST("/participants/:id/promote", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.PromoteParticipant(c, generated.ParticipantIDParam(id))
+	})
+	r.POST("/participants/merge", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		h.MergeParticipants(c)
+	})
+	r.POST("/participants/:id/restore", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.RestoreParticipant(c, generated.ParticipantIDParam(id))
+	})
+	r.POST("/participants/:id/guests", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.AddParticipantGuest(c, generated.ParticipantIDParam(id))
+	})
+	r.PATCH("/events/:id/participants/tags", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.UpdateParticipantTags(c, generated.EventIDParam(id))
+	})
+	r.POST("/events/:id/participants/assign-groups", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.AssignParticipantGroups(c, generated.EventIDParam(id))
+	})
+	r.PATCH("/events/:id/participants/bulk-status", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.BulkUpdateParticipantStatus(c, generated.EventIDParam(id))
+	})
+	r.GET("/events/:id/participants/changes", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		since, _ := time.Parse(time.RFC3339Nano, c.Query("since"))
+		h.ListParticipantChanges(c, generated.EventIDParam(id), generated.ListParticipantChangesParams{Since: since})
+	})
+	r.GET("/events/:id/participants/autocomplete", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.AutocompleteParticipants(c, generated.EventIDParam(id), generated.AutocompleteParticipantsParams{Q: c.Query("q")})
+	})
+	r.GET("/events/:id/participants/stats", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.GetParticipantStats(c, generated.EventIDParam(id))
+	})
+	r.GET("/participants/:id/confirmation-preview", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		h.PreviewParticipantConfirmationEmail(c, generated.ParticipantIDParam(id))
+	})
+
+	r.GET("/events/:id/participants/badges", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		var params generated.PrintParticipantBadgesParams
+		if perPage, err := strconv.Atoi(c.Query("per_page")); err == nil {
+			params.PerPage = &perPage
+		}
+		if status := c.Query("status"); status != "" {
+			s := generated.ParticipantStatus(status)
+			params.Status = &s
+		}
+		h.PrintParticipantBadges(c, generated.EventIDParam(id), params)
+	})
+
+	r.POST("/events/:id/participants/import", middleware.MaxBodySize(testCSVUploadLimit), func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		var params generated.ImportParticipantsCSVParams
+		if atomic, err := strconv.ParseBool(c.Query("atomic")); err == nil {
+			params.Atomic = &atomic
+		}
+		h.ImportParticipantsCSV(c, generated.EventIDParam(id), params)
+	})
+
+	r.GET("/events/:id/participants/qrcodes.zip", func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		id, _ := uuid.Parse(c.Param("id"))
+		var params generated.DownloadParticipantQRCodesParams
+		if format := c.Query("format"); format != "" {
+			f := generated.DownloadParticipantQRCodesParamsFormat(format)
+			params.Format = &f
+		}
+		h.DownloadParticipantQRCodes(c, generated.EventIDParam(id), params)
+	})
+
+	return r
+}
+
+var _ = Describe("ParticipantHandler", func() {
+	var (
+		log     *logger.Logger
+		ctrl    *gomock.Controller
+		mockUC  *participantMocks.MockUsecase
+		router  *gin.Engine
+		eventID uuid.UUID
+		userID  uuid.UUID
+	)
+
+	BeforeEach(func() {
+		log = newTestLogger()
+		ctrl = gomock.NewController(GinkgoT())
+		mockUC = participantMocks.NewMockUsecase(ctrl)
+		eventID = uuid.New()
+		userID = uuid.New()
+		router = newParticipantHandlerRouter(mockUC, userID, "organizer", log)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	post := func(path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	Describe("InviteParticipants", func() {
+		When("the usecase invites the participants", func() {
+			It("should return 201 with invited participants without QR codes", func() {
+				invitedID := uuid.New()
+				mockUC.EXPECT().BulkInvite(gomock.Any(), userID, false, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, _ bool, input participant.BulkInviteInput) (
+						participant.BulkInviteOutput, error,
+					) {
+						Expect(input.EventID).To(Equal(eventID))
+						Expect(input.Participants).To(HaveLen(1))
+						Expect(input.Participants[0].Email).To(Equal("alice@example.com"))
+						return participant.BulkInviteOutput{
+							InvitedCount: 1,
+							Participants: []*entity.Participant{{
+								ID:            invitedID,
+								EventID:       eventID,
+								Name:          "Alice",
+								Email:         "alice@example.com",
+								Status:        entity.ParticipantStatusInvited,
+								PaymentStatus: entity.PaymentUnpaid,
+							}},
+							EmailFailures: []participant.InvitationEmailFailure{},
+						}, nil
+					},
+				)
+
+				w := post(
+					"/events/"+eventID.String()+"/participants/invite",
+					`{"participants":[{"name":"Alice","email":"alice@example.com"}]}`,
+				)
+
+				Expect(w.Code).To(Equal(http.StatusCreated))
+				var resp generated.InviteParticipantsResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.InvitedCount).To(Equal(1))
+				Expect(resp.EmailFailures).To(BeEmpty())
+				Expect(resp.Participants).To(HaveLen(1))
+				Expect(resp.Participants[0].Status).To(Equal(generated.ParticipantStatusInvited))
+				Expect(resp.Participants[0].QrCode).To(BeNil())
+			})
+		})
+
+		When("the request body is malformed", func() {
+			It("should return 400 Bad Request", func() {
+				w := post("/events/"+eventID.String()+"/participants/invite", `{not json`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("ValidateParticipants", func() {
+		When("the usecase validates the payloads", func() {
+			It("should return 200 with per-row results", func() {
+				mockUC.EXPECT().ValidateParticipants(gomock.Any(), userID, false, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, _ bool, input participant.ValidateParticipantsInput) (
+						participant.ValidateParticipantsOutput, error,
+					) {
+						Expect(input.EventID).To(Equal(eventID))
+						Expect(input.Participants).To(HaveLen(2))
+						// Defaults are applied as for creation
+						Expect(input.Participants[0].Status).To(Equal(entity.ParticipantStatusTentative))
+						Expect(input.Participants[0].PaymentStatus).To(Equal(entity.PaymentUnpaid))
+						return participant.ValidateParticipantsOutput{
+							ValidCount:   1,
+							InvalidCount: 1,
+							Results: []participant.ParticipantValidationResult{
+								{Index: 0, Email: "alice@example.com", Valid: true, Errors: []participant.FieldError{}},
+								{
+									Index:          1,
+									Email:          "alice@example.com",
+									DuplicateEmail: true,
+									Errors: []participant.FieldError{{
+										Field:   "email",
+										Message: "participant with this email already exists for this event",
+									}},
+								},
+							},
+						}, nil
+					},
+				)
+
+				w := post(
+					"/events/"+eventID.String()+"/participants/validate",
+					`{"participants":[{"name":"Alice","email":"alice@example.com"},`+
+						`{"name":"Alice","email":"alice@example.com"}]}`,
+				)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.ValidateParticipantsResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.ValidCount).To(Equal(1))
+				Expect(resp.InvalidCount).To(Equal(1))
+				Expect(resp.Results).To(HaveLen(2))
+				Expect(resp.Results[0].Errors).NotTo(BeNil())
+				Expect(resp.Results[1].Valid).To(BeFalse())
+				Expect(resp.Results[1].DuplicateEmail).To(BeTrue())
+				Expect(resp.Results[1].Errors[0].Field).To(Equal("email"))
+			})
+		})
+
+		When("the user does not manage the event", func() {
+			It("should return 403 Forbidden", func() {
+				mockUC.EXPECT().ValidateParticipants(gomock.Any(), userID, false, gomock.Any()).
+					Return(participant.ValidateParticipantsOutput{},
+						apperrors.Forbidden("you do not have permission to validate participants for this event"))
+
+				w := post(
+					"/events/"+eventID.String()+"/participants/validate",
+					`{"participants":[{"name":"Alice","email":"alice@example.com"}]}`,
+				)
+
+				Expect(w.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		When("the request body is malformed", func() {
+			It("should return 400 Bad Request", func() {
+				w := post("/events/"+eventID.String()+"/participants/validate", `{not json`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("LookupParticipants", func() {
+		get := func() *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/participants/lookup?email=alice%40example.com", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the email matches participants", func() {
+			It("should return 200 with one row per event", func() {
+				mockUC.EXPECT().LookupByEmail(gomock.Any(), userID, false, "alice@example.com").Return(
+					[]*entity.Participant{
+						{ID: uuid.New(), EventID: uuid.New(), Name: "Alice", Email: "alice@example.com"},
+						{ID: uuid.New(), EventID: uuid.New(), Name: "Alice", Email: "alice@example.com"},
+					}, nil,
+				)
+
+				w := get()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.ParticipantLookupResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.Data).To(HaveLen(2))
+			})
+
+			It("should include the internal notes for the organizer", func() {
+				notes := "Needs wheelchair access"
+				mockUC.EXPECT().LookupByEmail(gomock.Any(), userID, false, "alice@example.com").Return(
+					[]*entity.Participant{
+						{ID: uuid.New(), EventID: uuid.New(), Name: "Alice", Email: "alice@example.com", Notes: &notes},
+					}, nil,
+				)
+
+				w := get()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.ParticipantLookupResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.Data[0].Notes).To(HaveValue(Equal(notes)))
+			})
+		})
+
+		When("no participant matches", func() {
+			It("should return 200 with an empty list", func() {
+				mockUC.EXPECT().LookupByEmail(gomock.Any(), userID, false, "alice@example.com").
+					Return([]*entity.Participant{}, nil)
+
+				w := get()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(MatchJSON(`{"data":[]}`))
+			})
+		})
+
+		When("the caller is staff", func() {
+			It("should return 403 Forbidden without calling the usecase", func() {
+				router = newParticipantHandlerRouter(mockUC, userID, "staff", log)
+
+				w := get()
+
+				Expect(w.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("AcceptInvite", func() {
+		When("the token is accepted", func() {
+			It("should return 200 with the issued QR code", func() {
+				participantID := uuid.New()
+				mockUC.EXPECT().AcceptInvite(gomock.Any(), "inv_token.sig", false).Return(&entity.Participant{
+					ID:                participantID,
+					EventID:           eventID,
+					Name:              "Alice",
+					Status:            entity.ParticipantStatusConfirmed,
+					QRCode:            "evt_a_prt_b_c.sig",
+					QRDistributionURL: "https://qr.example.com/qr/abc",
+				}, nil)
+
+				w := post("/participants/accept-invite", `{"token":"inv_token.sig"}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.AcceptInviteResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(uuid.UUID(resp.ParticipantId)).To(Equal(participantID))
+				Expect(resp.Status).To(Equal(generated.ParticipantStatusConfirmed))
+				Expect(resp.QrCode).To(Equal("evt_a_prt_b_c.sig"))
+				Expect(resp.QrDistributionUrl).NotTo(BeNil())
+			})
+		})
+
+		When("the invitation has expired", func() {
+			It("should return 400 Bad Request", func() {
+				mockUC.EXPECT().AcceptInvite(gomock.Any(), "expired", false).
+					Return(nil, apperrors.BadRequest("invitation has expired"))
+
+				w := post("/participants/accept-invite", `{"token":"expired"}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+				Expect(w.Body.String()).To(ContainSubstring("invitation has expired"))
+			})
+		})
+
+		When("the event requires consent and it was not accepted", func() {
+			It("should return 422 Unprocessable Entity", func() {
+				mockUC.EXPECT().AcceptInvite(gomock.Any(), "inv_token.sig", false).
+					Return(nil, apperrors.Unprocessable("this event requires accepting its consent terms before the invitation"))
+
+				w := post("/participants/accept-invite", `{"token":"inv_token.sig","consent_accepted":false}`)
+
+				Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+			})
+		})
+
+		When("the invitee accepts the consent terms", func() {
+			It("should pass the consent to the usecase", func() {
+				mockUC.EXPECT().AcceptInvite(gomock.Any(), "inv_token.sig", true).Return(&entity.Participant{
+					ID:      uuid.New(),
+					EventID: eventID,
+					Status:  entity.ParticipantStatusConfirmed,
+				}, nil)
+
+				w := post("/participants/accept-invite", `{"token":"inv_token.sig","consent_accepted":true}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+		})
+
+		When("the invitee submits notes", func() {
+			It("should neither accept nor reveal the internal notes", func() {
+				notes := "Needs wheelchair access"
+				mockUC.EXPECT().AcceptInvite(gomock.Any(), "inv_token.sig", false).Return(&entity.Participant{
+					ID:      uuid.New(),
+					EventID: eventID,
+					Name:    "Alice",
+					Status:  entity.ParticipantStatusConfirmed,
+					Notes:   &notes,
+				}, nil)
+
+				w := post("/participants/accept-invite", `{"token":"inv_token.sig","notes":"VIP, skip the queue"}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp map[string]any
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp).NotTo(HaveKey("notes"))
+			})
+		})
+
+		When("the token is missing", func() {
+			It("should return 400 Bad Request without calling the usecase", func() {
+				w := post("/participants/accept-invite", `{}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("RecordParticipantConsent", func() {
+		When("the organizer records consent", func() {
+			It("should return 200 with the consent stamped on the participant", func() {
+				participantID := uuid.New()
+				acceptedAt := time.Date(2026, 1, 10, 9, 0, 0, 0, time.UTC)
+				mockUC.EXPECT().RecordConsent(gomock.Any(), userID, false, participantID).Return(&entity.Participant{
+					ID:                participantID,
+					EventID:           eventID,
+					Name:              "Alice",
+					Email:             "alice@example.com",
+					Status:            entity.ParticipantStatusConfirmed,
+					ConsentAcceptedAt: &acceptedAt,
+					ConsentVersion:    "2026-01",
+				}, nil)
+
+				w := post("/participants/"+participantID.String()+"/consent", "")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.Participant
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.ConsentAcceptedAt).NotTo(BeNil())
+				Expect(*resp.ConsentAcceptedAt).To(Equal(acceptedAt))
+				Expect(resp.ConsentVersion).NotTo(BeNil())
+				Expect(*resp.ConsentVersion).To(Equal("2026-01"))
+			})
+		})
+
+		When("the event does not require consent", func() {
+			It("should return 400 Bad Request", func() {
+				participantID := uuid.New()
+				mockUC.EXPECT().RecordConsent(gomock.Any(), userID, false, participantID).
+					Return(nil, apperrors.BadRequest("event does not require consent"))
+
+				w := post("/participants/"+participantID.String()+"/consent", "")
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("CreateParticipant", func() {
+		When("custom data is submitted", func() {
+			It("should pass it to the usecase and return it", func() {
+				customData := map[string]any{"company": "Acme", "age": float64(30)}
+				mockUC.EXPECT().Create(gomock.Any(), userID, false, gomock.Any()).DoAndReturn(
+					func(
+						_ context.Context, _ uuid.UUID, _ bool, input participant.CreateParticipantInput,
+					) (*entity.Participant, error) {
+						Expect(input.CustomData).To(Equal(customData))
+						return &entity.Participant{
+							ID:         uuid.New(),
+							EventID:    eventID,
+							Name:       input.Name,
+							Email:      input.Email,
+							Status:     input.Status,
+							CustomData: input.CustomData,
+						}, nil
+					},
+				)
+
+				w := post("/events/"+eventID.String()+"/participants",
+					`{"name":"Alice","email":"alice@example.com","custom_data":{"company":"Acme","age":30}}`)
+
+				Expect(w.Code).To(Equal(http.StatusCreated))
+				var resp generated.Participant
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.CustomData).To(HaveValue(Equal(customData)))
+			})
+		})
+
+		When("the usecase rejects the custom data", func() {
+			It("should return 400 Bad Request", func() {
+				mockUC.EXPECT().Create(gomock.Any(), userID, false, gomock.Any()).
+					Return(nil, apperrors.Validation("custom data has a field the event does not define: \"hobby\""))
+
+				w := post("/events/"+eventID.String()+"/participants",
+					`{"name":"Alice","email":"alice@example.com","custom_data":{"hobby":"chess"}}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("PromoteParticipant", func() {
+		When("the organizer promotes a waitlisted participant", func() {
+			It("should return 200 with the participant confirmed", func() {
+				participantID := uuid.New()
+				mockUC.EXPECT().Promote(gomock.Any(), userID, false, participantID).Return(&entity.Participant{
+					ID:      participantID,
+					EventID: eventID,
+					Name:    "Alice",
+					Email:   "alice@example.com",
+					Status:  entity.ParticipantStatusConfirmed,
+				}, nil)
+
+				w := post("/participants/"+participantID.String()+"/promote", "")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.Participant
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.Status).To(Equal(generated.ParticipantStatusConfirmed))
+			})
+		})
+
+		When("the event is at capacity", func() {
+			It("should return 409 Conflict", func() {
+				participantID := uuid.New()
+				mockUC.EXPECT().Promote(gomock.Any(), userID, false, participantID).
+					Return(nil, apperrors.Conflict("event is at capacity"))
+
+				w := post("/participants/"+participantID.String()+"/promote", "")
+
+				Expect(w.Code).To(Equal(http.StatusConflict))
+			})
+		})
+	})
+
+	Describe("RestoreParticipant", func() {
+		When("the organizer restores a deleted participant", func() {
+			It("should return 200 with the participant", func() {
+				participantID := uuid.New()
+				mockUC.EXPECT().Restore(gomock.Any(), userID, false, participantID).Return(&entity.Participant{
+					ID:      participantID,
+					EventID: eventID,
+					Name:    "Alice",
+					Email:   "alice@example.com",
+					Status:  entity.ParticipantStatusConfirmed,
+				}, nil)
+
+				w := post("/participants/"+participantID.String()+"/restore", "")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.Participant
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(*resp.Id).To(Equal(participantID))
+				Expect(resp.DeletedAt).To(BeNil())
+			})
+		})
+
+		When("another participant of the event has registered with the same email", func() {
+			It("should return 409 Conflict", func() {
+				participantID := uuid.New()
+				mockUC.EXPECT().Restore(gomock.Any(), userID, false, participantID).
+					Return(nil, apperrors.Conflict("a participant with this email was registered for the event since the deletion"))
+
+				w := post("/participants/"+participantID.String()+"/restore", "")
+
+				Expect(w.Code).To(Equal(http.StatusConflict))
+			})
+		})
+	})
+
+	Describe("MergeParticipants", func() {
+		When("the organizer merges a duplicate participant", func() {
+			It("should return 200 with the primary and the combined check-in history", func() {
+				primaryID, duplicateID := uuid.New(), uuid.New()
+				checkedInAt := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+				mockUC.EXPECT().Merge(gomock.Any(), userID, false, participant.MergeParticipantsInput{
+					PrimaryID:   primaryID,
+					DuplicateID: duplicateID,
+				}).Return(&participant.MergeParticipantsOutput{
+					Participant: &entity.Participant{ID: primaryID, EventID: eventID, Name: "Alice"},
+					CheckIns: []*entity.Checkin{{
+						ID:            uuid.New(),
+						ParticipantID: primaryID,
+						CheckedInAt:   checkedInAt,
+						Method:        entity.CheckinMethodQRCode,
+					}},
+				}, nil)
+
+				w := post("/participants/merge",
+					`{"primary_id":"`+primaryID.String()+`","duplicate_id":"`+duplicateID.String()+`"}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.MergeParticipantsResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(*resp.Participant.Id).To(Equal(primaryID))
+				Expect(resp.Checkins).To(HaveLen(1))
+				Expect(resp.Checkins[0].CheckedInAt.Equal(checkedInAt)).To(BeTrue())
+				Expect(resp.Checkins[0].CheckinMethod).To(Equal(generated.CheckInMethod("qrcode")))
+			})
+		})
+
+		When("the request body is malformed", func() {
+			It("should return 400 Bad Request", func() {
+				w := post("/participants/merge", `{"primary_id": 1}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the participants belong to different events", func() {
+			It("should return 400 Bad Request", func() {
+				mockUC.EXPECT().Merge(gomock.Any(), userID, false, gomock.Any()).
+					Return(nil, apperrors.BadRequest("participants belong to different events"))
+
+				w := post("/participants/merge", `{"primary_id":"`+uuid.NewString()+`","duplicate_id":"`+uuid.NewString()+`"}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("ListParticipants with include_deleted", func() {
+		list := func(r *gin.Engine) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/participants?include_deleted=true", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w
+		}
+
+		It("should list deleted participants for an admin, marked by deleted_at", func() {
+			deletedAt := time.Date(2025, 12, 20, 10, 0, 0, 0, time.UTC)
+			mockUC.EXPECT().GetListLastModified(gomock.Any(), userID, true, eventID).Return(time.Time{}, nil)
+			mockUC.EXPECT().List(gomock.Any(), userID, true, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ uuid.UUID, _ bool, input participant.ListParticipantsInput) (
+					participant.ListParticipantsOutput, error,
+				) {
+					Expect(input.IncludeDeleted).To(BeTrue())
+					return participant.ListParticipantsOutput{
+						Participants: []*entity.Participant{{ID: uuid.New(), EventID: eventID, DeletedAt: &deletedAt}},
+						TotalCount:   1,
+					}, nil
+				})
+
+			w := list(newParticipantHandlerRouter(mockUC, userID, "admin", log))
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp generated.ParticipantListResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Data).To(HaveLen(1))
+			Expect(resp.Data[0].DeletedAt).NotTo(BeNil())
+			Expect(resp.Data[0].DeletedAt.Equal(deletedAt)).To(BeTrue())
+		})
+
+		It("should return 403 Forbidden for an organizer", func() {
+			Expect(list(router).Code).To(Equal(http.StatusForbidden))
+		})
+	})
+
+	Describe("SendParticipantInvite", func() {
+		It("should return 200 with the time the QR code email was sent", func() {
+			participantID := uuid.New()
+			sentAt := time.Date(2025, 12, 1, 9, 0, 0, 0, time.UTC)
+			mockUC.EXPECT().SendInvite(gomock.Any(), userID, false, eventID, participantID).Return(&entity.Participant{
+				ID:           participantID,
+				EventID:      eventID,
+				Name:         "Alice",
+				Email:        "alice@example.com",
+				Status:       entity.ParticipantStatusConfirmed,
+				InviteSentAt: &sentAt,
+			}, nil)
+
+			w := post("/events/"+eventID.String()+"/participants/"+participantID.String()+"/send-invite", "")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp generated.Participant
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.InviteSentAt).NotTo(BeNil())
+			Expect(resp.InviteSentAt.Equal(sentAt)).To(BeTrue())
+		})
+
+		It("should return 503 when the email cannot be sent", func() {
+			participantID := uuid.New()
+			mockUC.EXPECT().SendInvite(gomock.Any(), userID, false, eventID, participantID).
+				Return(nil, apperrors.ServiceUnavailable("failed to send the QR code email"))
+
+			w := post("/events/"+eventID.String()+"/participants/"+participantID.String()+"/send-invite", "")
+
+			Expect(w.Code).To(Equal(http.StatusServiceUnavailable))
+		})
+	})
+
+	Describe("SendEventInvites", func() {
+		It("should send to the given participants and return 207 when some fail", func() {
+			aliceID, bobID := uuid.New(), uuid.New()
+			mockUC.EXPECT().SendInvites(gomock.Any(), userID, false, participant.SendInvitesInput{
+				EventID:        eventID,
+				ParticipantIDs: []uuid.UUID{aliceID, bobID},
+			}).Return(participant.SendQRCodesOutput{
+				SentCount:   1,
+				FailedCount: 1,
+				Total:       2,
+				Failures: []participant.SendQRCodeFailure{
+					{ParticipantID: bobID, Email: "bob@example.com", Reason: "mailbox unavailable"},
+				},
+			}, nil)
+
+			body := `{"participant_ids":["` + aliceID.String() + `","` + bobID.String() + `"]}`
+			w := post("/events/"+eventID.String()+"/participants/send-invites", body)
+
+			Expect(w.Code).To(Equal(http.StatusMultiStatus))
+			var resp generated.SendQRCodesResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Failures).To(HaveLen(1))
+			Expect(uuid.UUID(resp.Failures[0].ParticipantId)).To(Equal(bobID))
+		})
+
+		It("should send to the participants not sent one yet when no IDs are given", func() {
+			mockUC.EXPECT().SendInvites(gomock.Any(), userID, false, participant.SendInvitesInput{EventID: eventID}).
+				Return(participant.SendQRCodesOutput{SentCount: 3, Total: 3}, nil)
+
+			w := post("/events/"+eventID.String()+"/participants/send-invites", `{}`)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+		})
+
+		It("should return 400 for a malformed body", func() {
+			w := post("/events/"+eventID.String()+"/participants/send-invites", `{not valid json`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+
+	Describe("AddParticipantGuest", func() {
+		When("the organizer adds a guest", func() {
+			It("should return 201 with the guest linked to the registrant", func() {
+				registrantID := uuid.New()
+				guestID := uuid.New()
+				mockUC.EXPECT().AddGuest(gomock.Any(), userID, false, registrantID, participant.AddGuestInput{
+					Name: "Bob",
+				}).Return(&entity.Participant{
+					ID:      guestID,
+					EventID: eventID,
+					GuestOf: &registrantID,
+					Name:    "Bob",
+					Status:  entity.ParticipantStatusConfirmed,
+					QRCode:  "evt_guest_qr",
+				}, nil)
+
+				w := post("/participants/"+registrantID.String()+"/guests", `{"name":"Bob"}`)
+
+				Expect(w.Code).To(Equal(http.StatusCreated))
+				var resp generated.Participant
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(*resp.Id).To(Equal(guestID))
+				Expect(resp.GuestOf).NotTo(BeNil())
+				Expect(*resp.GuestOf).To(Equal(registrantID))
+				Expect(resp.QrCode).NotTo(BeNil())
+				Expect(*resp.QrCode).To(Equal("evt_guest_qr"))
+			})
+		})
+
+		When("the request body is invalid", func() {
+			It("should return 400 Bad Request", func() {
+				w := post("/participants/"+uuid.New().String()+"/guests", `{"name":`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("UpdateParticipantTags", func() {
+		patch := func(body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(
+				http.MethodPatch, "/events/"+eventID.String()+"/participants/tags", strings.NewReader(body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the organizer tags participants selected by filter", func() {
+			It("should return 200 with the number of participants changed", func() {
+				unpaid := entity.PaymentUnpaid
+				mockUC.EXPECT().UpdateTags(gomock.Any(), userID, false, participant.UpdateTagsInput{
+					EventID: eventID,
+					Filter:  &participant.TagFilter{PaymentStatus: &unpaid},
+					Add:     []string{"follow-up"},
+				}).Return(int64(3), nil)
+
+				w := patch(`{"filter":{"payment_status":"unpaid"},"add":["follow-up"]}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.UpdateParticipantTagsResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.UpdatedCount).To(Equal(int64(3)))
+			})
+		})
+
+		When("a participant would exceed the tag cap", func() {
+			It("should return 422 Unprocessable Entity", func() {
+				participantID := uuid.New()
+				mockUC.EXPECT().UpdateTags(gomock.Any(), userID, false, participant.UpdateTagsInput{
+					EventID:        eventID,
+					ParticipantIDs: []uuid.UUID{participantID},
+					Remove:         []string{"vip"},
+					Add:            []string{"speaker"},
+				}).Return(int64(0), apperrors.Unprocessable("1 participants would have more than 20 tags"))
+
+				w := patch(`{"participant_ids":["` + participantID.String() + `"],"add":["speaker"],"remove":["vip"]}`)
+
+				Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+			})
+		})
+
+		When("the request body is invalid", func() {
+			It("should return 400 Bad Request", func() {
+				w := patch(`{"add":`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("AssignParticipantGroups", func() {
+		post := func(body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(
+				http.MethodPost, "/events/"+eventID.String()+"/participants/assign-groups", strings.NewReader(body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the organizer seats participants at a group", func() {
+			It("should return 200 with the number of participants changed", func() {
+				participantID := uuid.New()
+				groupName := "Table 5"
+				mockUC.EXPECT().AssignGroup(gomock.Any(), userID, false, participant.AssignGroupInput{
+					EventID:        eventID,
+					ParticipantIDs: []uuid.UUID{participantID},
+					GroupName:      &groupName,
+				}).Return(int64(1), nil)
+
+				w := post(`{"participant_ids":["` + participantID.String() + `"],"group_name":"Table 5"}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.AssignParticipantGroupsResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.UpdatedCount).To(Equal(int64(1)))
+			})
+		})
+
+		When("the group name is null", func() {
+			It("should clear the participants' group", func() {
+				participantID := uuid.New()
+				mockUC.EXPECT().AssignGroup(gomock.Any(), userID, false, participant.AssignGroupInput{
+					EventID:        eventID,
+					ParticipantIDs: []uuid.UUID{participantID},
+				}).Return(int64(1), nil)
+
+				w := post(`{"participant_ids":["` + participantID.String() + `"],"group_name":null}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+			})
+		})
+
+		When("the request body is invalid", func() {
+			It("should return 400 Bad Request", func() {
+				w := post(`{"participant_ids":`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("BulkUpdateParticipantStatus", func() {
+		patch := func(body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(
+				http.MethodPatch, "/events/"+eventID.String()+"/participants/bulk-status", strings.NewReader(body),
+			)
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the organizer confirms a batch of participants", func() {
+			It("should return 200 with the updated and skipped counts", func() {
+				first, second := uuid.New(), uuid.New()
+				mockUC.EXPECT().BulkUpdateStatus(gomock.Any(), userID, false, participant.BulkUpdateStatusInput{
+					EventID:        eventID,
+					ParticipantIDs: []uuid.UUID{first, second},
+					Status:         entity.ParticipantStatusConfirmed,
+				}).Return(&participant.BulkUpdateStatusOutput{UpdatedCount: 1, SkippedCount: 1}, nil)
+
+				w := patch(`{"participant_ids":["` + first.String() + `","` + second.String() + `"],"status":"confirmed"}`)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.BulkUpdateParticipantStatusResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.UpdatedCount).To(Equal(int64(1)))
+				Expect(resp.SkippedCount).To(Equal(int64(1)))
+			})
+		})
+
+		When("a participant does not belong to the event", func() {
+			It("should return 400 Bad Request", func() {
+				mockUC.EXPECT().BulkUpdateStatus(gomock.Any(), userID, false, gomock.Any()).
+					Return(nil, apperrors.BadRequest("participant does not belong to this event"))
+
+				w := patch(`{"participant_ids":["` + uuid.New().String() + `"],"status":"confirmed"}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the request body is invalid", func() {
+			It("should return 400 Bad Request", func() {
+				w := patch(`{"participant_ids":`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("ListParticipantChanges", func() {
+		since := time.Date(2025, 12, 15, 9, 0, 0, 0, time.UTC)
+
+		get := func() *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet,
+				"/events/"+eventID.String()+"/participants/changes?since="+since.Format(time.RFC3339Nano), nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("participants changed after since", func() {
+			It("should return 200 with the changed and deleted participants and the next cursor", func() {
+				updated := &entity.Participant{ID: uuid.New(), EventID: eventID, Name: "Taro Yamada"}
+				deletedID := uuid.New()
+				cursor := since.Add(15 * time.Minute)
+				mockUC.EXPECT().ListChanges(gomock.Any(), userID, false, eventID, since).Return(participant.ListChangesOutput{
+					Participants: []*entity.Participant{updated},
+					Deleted:      []participant.DeletedParticipant{{ID: deletedID, DeletedAt: since.Add(time.Minute)}},
+					Since:        cursor,
+				}, nil)
+
+				w := get()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.ParticipantChangesResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.Participants).To(HaveLen(1))
+				Expect(uuid.UUID(*resp.Participants[0].Id)).To(Equal(updated.ID))
+				Expect(resp.Deleted).To(HaveLen(1))
+				Expect(uuid.UUID(resp.Deleted[0].Id)).To(Equal(deletedID))
+				Expect(resp.Since).To(BeTemporally("==", cursor))
+			})
+		})
+
+		When("nothing changed", func() {
+			It("should return empty lists rather than null", func() {
+				mockUC.EXPECT().ListChanges(gomock.Any(), userID, false, eventID, since).
+					Return(participant.ListChangesOutput{Since: since}, nil)
+
+				w := get()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(`"participants":[]`))
+				Expect(w.Body.String()).To(ContainSubstring(`"deleted":[]`))
+			})
+		})
+
+		When("the user does not manage the event", func() {
+			It("should return 403 Forbidden", func() {
+				mockUC.EXPECT().ListChanges(gomock.Any(), userID, false, eventID, since).
+					Return(participant.ListChangesOutput{}, apperrors.Forbidden("you do not have permission"))
+
+				Expect(get().Code).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("AutocompleteParticipants", func() {
+		get := func(q string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet,
+				"/events/"+eventID.String()+"/participants/autocomplete?q="+q, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("participants match", func() {
+			It("should return 200 with the matches, omitting missing emails", func() {
+				pendingID, checkedInID := uuid.New(), uuid.New()
+				mockUC.EXPECT().Autocomplete(gomock.Any(), userID, false, eventID, "Ta").Return([]participant.AutocompleteMatch{
+					{ID: pendingID, Name: "Taro Yamada", EmailMasked: "t***@example.com"},
+					{ID: checkedInID, Name: "Takeshi Sato", CheckedIn: true},
+				}, nil)
+
+				w := get("Ta")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.ParticipantAutocompleteResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.Data).To(HaveLen(2))
+				Expect(uuid.UUID(resp.Data[0].Id)).To(Equal(pendingID))
+				Expect(resp.Data[0].EmailMasked).To(HaveValue(Equal("t***@example.com")))
+				Expect(resp.Data[0].CheckedIn).To(BeFalse())
+				Expect(uuid.UUID(resp.Data[1].Id)).To(Equal(checkedInID))
+				Expect(resp.Data[1].EmailMasked).To(BeNil())
+				Expect(resp.Data[1].CheckedIn).To(BeTrue())
+			})
+		})
+
+		When("nothing matches", func() {
+			It("should return an empty list rather than null", func() {
+				mockUC.EXPECT().Autocomplete(gomock.Any(), userID, false, eventID, "Zz").Return(nil, nil)
+
+				w := get("Zz")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(`"data":[]`))
+			})
+		})
+
+		When("the query is blank", func() {
+			It("should return 400 Bad Request", func() {
+				mockUC.EXPECT().Autocomplete(gomock.Any(), userID, false, eventID, "").
+					Return(nil, apperrors.BadRequest("query must not be empty"))
+
+				Expect(get("").Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("GetParticipantStats", func() {
+		get := func() *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/participants/stats", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the user manages the event", func() {
+			It("should return 200 with the statistics", func() {
+				mockUC.EXPECT().GetStats(gomock.Any(), userID, false, eventID).Return(participant.StatsOutput{
+					TotalParticipants:     10,
+					ConfirmedParticipants: 6,
+					TentativeParticipants: 3,
+					DeclinedParticipants:  1,
+					PaidParticipants:      4,
+					UnpaidParticipants:    6,
+					TotalRevenue:          money.FromMinorUnits(400000),
+					Currency:              "JPY",
+				}, nil)
+
+				w := get()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.ParticipantStatsResponse
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(uuid.UUID(resp.EventId)).To(Equal(eventID))
+				Expect(resp.TotalParticipants).To(Equal(10))
+				Expect(resp.ConfirmedParticipants).To(Equal(6))
+				Expect(resp.TentativeParticipants).To(Equal(3))
+				Expect(resp.DeclinedParticipants).To(Equal(1))
+				Expect(resp.PaidParticipants).To(Equal(4))
+				Expect(resp.UnpaidParticipants).To(Equal(6))
+				Expect(resp.TotalRevenue).To(Equal(money.FromMinorUnits(400000)))
+				Expect(resp.Currency).To(HaveValue(Equal("JPY")))
+			})
+
+			It("should omit the currency when the event has none", func() {
+				mockUC.EXPECT().GetStats(gomock.Any(), userID, false, eventID).Return(participant.StatsOutput{}, nil)
+
+				w := get()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).NotTo(ContainSubstring(`"currency"`))
+			})
+		})
+
+		When("the user does not manage the event", func() {
+			It("should return 403 Forbidden", func() {
+				mockUC.EXPECT().GetStats(gomock.Any(), userID, false, eventID).
+					Return(participant.StatsOutput{}, apperrors.Forbidden("not allowed"))
+
+				Expect(get().Code).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("PreviewParticipantConfirmationEmail", func() {
+		var participantID uuid.UUID
+
+		get := func() *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/participants/"+participantID.String()+"/confirmation-preview", nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		BeforeEach(func() { participantID = uuid.New() })
+
+		When("the email can be rendered", func() {
+			It("should return 200 with the rendered email and the QR code image", func() {
+				png := []byte("\x89PNG\r\n\x1a\n")
+				mockUC.EXPECT().PreviewConfirmationEmail(gomock.Any(), userID, false, participantID).
+					Return(participant.EmailPreviewOutput{
+						To:        "taro@example.com",
+						Subject:   "Your QR Code for Tech Conf",
+						HTMLBody:  "<p>Hello</p>",
+						TextBody:  "Hello",
+						QRCodePNG: png,
+					}, nil)
+
+				w := get()
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				var resp generated.ConfirmationEmailPreview
+				Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+				Expect(resp.To).To(Equal("taro@example.com"))
+				Expect(*resp.HtmlBody).To(Equal("<p>Hello</p>"))
+				Expect(resp.TextBody).To(Equal("Hello"))
+				Expect(resp.QrCodePng).To(Equal(png))
+			})
+		})
+
+		When("QR code emails are not configured", func() {
+			It("should return 503 Service Unavailable", func() {
+				mockUC.EXPECT().PreviewConfirmationEmail(gomock.Any(), userID, false, participantID).
+					Return(participant.EmailPreviewOutput{}, apperrors.ServiceUnavailable("QR code emails are not configured"))
+
+				Expect(get().Code).To(Equal(http.StatusServiceUnavailable))
+			})
+		})
+	})
+	Describe("PrintParticipantBadges", func() {
+		get := func(query string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/participants/badges"+query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the badge sheet is prepared", func() {
+			It("should stream the sheet as a PDF download", func() {
+				sheet, err := badge.NewSheet(qrcode.NewGenerator(), []badge.Badge{
+					{Name: "Taro Yamada", Email: "taro@example.com", QRCode: "token-1"},
+				}, 4)
+				Expect(err).NotTo(HaveOccurred())
+				status := entity.ParticipantStatusConfirmed
+				mockUC.EXPECT().GetBadgeSheet(gomock.Any(), userID, false, participant.BadgeSheetInput{
+					EventID: eventID,
+					PerPage: 4,
+					Status:  &status,
+				}).Return(sheet, nil)
+
+				w := get("?per_page=4&status=confirmed")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get("Content-Type")).To(Equal("application/pdf"))
+				Expect(w.Header().Get("Content-Disposition")).To(ContainSubstring("badges_" + eventID.String() + ".pdf"))
+				Expect(w.Body.String()).To(HavePrefix("%PDF-"))
+				Expect(w.Body.String()).To(HaveSuffix("%%EOF\n"))
+				Expect(w.Header().Values("X-Ezqrin-Unrenderable-Badges")).To(BeEmpty())
+			})
+
+			It("should count the badges with characters the font cannot show", func() {
+				sheet, err := badge.NewSheet(qrcode.NewGenerator(), []badge.Badge{
+					{Name: "山田太郎", Email: "taro@example.com", QRCode: "token-1"},
+					{Name: "Hanako Sato", Email: "hanako@example.com", QRCode: "token-2"},
+				}, 4)
+				Expect(err).NotTo(HaveOccurred())
+				mockUC.EXPECT().GetBadgeSheet(gomock.Any(), userID, false, gomock.Any()).Return(sheet, nil)
+
+				w := get("")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get("X-Ezqrin-Unrenderable-Badges")).To(Equal("1"))
+			})
+		})
+
+		When("per_page is out of range", func() {
+			It("should return 400 Bad Request", func() {
+				mockUC.EXPECT().GetBadgeSheet(gomock.Any(), userID, false, gomock.Any()).
+					Return(nil, apperrors.BadRequest("invalid per_page: must be between 1 and 10"))
+
+				Expect(get("?per_page=11").Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+	Describe("DownloadParticipantQRCodes", func() {
+		get := func(query string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/participants/qrcodes.zip"+query, nil)
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the archive is prepared", func() {
+			It("should stream the archive as a ZIP download named after the event", func() {
+				mockUC.EXPECT().GetQRCodeArchive(gomock.Any(), userID, false, participant.QRCodeArchiveInput{
+					EventID: eventID,
+					Format:  "png",
+				}).Return(&participant.QRCodeArchive{Filename: "Tech Conf-qrcodes.zip"}, nil)
+
+				w := get("")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Header().Get("Content-Type")).To(Equal("application/zip"))
+				Expect(w.Header().Get("Content-Disposition")).To(Equal(`attachment; filename="Tech Conf-qrcodes.zip"`))
+				zr, err := zip.NewReader(bytes.NewReader(w.Body.Bytes()), int64(w.Body.Len()))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(zr.File).To(BeEmpty())
+			})
+
+			It("should pass the requested format to the usecase", func() {
+				mockUC.EXPECT().GetQRCodeArchive(gomock.Any(), userID, false, participant.QRCodeArchiveInput{
+					EventID: eventID,
+					Format:  "svg",
+				}).Return(&participant.QRCodeArchive{Filename: "Tech Conf-qrcodes.zip"}, nil)
+
+				Expect(get("?format=svg").Code).To(Equal(http.StatusOK))
+			})
+		})
+
+		When("the user does not manage the event", func() {
+			It("should return 403 Forbidden", func() {
+				mockUC.EXPECT().GetQRCodeArchive(gomock.Any(), userID, false, gomock.Any()).
+					Return(nil, apperrors.Forbidden("you do not have permission to view participants for this event"))
+
+				Expect(get("").Code).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("ImportParticipantsCSV", func() {
+		// upload sends a CSV file as a multipart form without announcing its length, like a
+		// chunked upload, so the size limit is only hit while the form is read
+		upload := func(query, csv string) *httptest.ResponseRecorder {
+			var body bytes.Buffer
+			form := multipart.NewWriter(&body)
+			part, err := form.CreateFormFile("file", "participants.csv")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = part.Write([]byte(csv))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(form.Close()).To(Succeed())
+
+			path := "/events/" + eventID.String() + "/participants/import" + query
+			req := httptest.NewRequest(http.MethodPost, path, &body)
+			req.Header.Set("Content-Type", form.FormDataContentType())
+			req.ContentLength = -1
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the file exceeds the upload limit", func() {
+			It("should return 413 Payload Too Large", func() {
+				csv := "name,email\n" + strings.Repeat("Alice,alice@example.com\n", testCSVUploadLimit/10)
+
+				w := upload("", csv)
+
+				Expect(w.Code).To(Equal(http.StatusRequestEntityTooLarge))
+				Expect(w.Body.String()).To(ContainSubstring(apperrors.CodePayloadTooLarge))
+				Expect(w.Body.String()).To(ContainSubstring("CSV file exceeds the upload limit of 1024 bytes"))
+			})
+		})
+
+		When("importing atomically", func() {
+			BeforeEach(func() {
+				mockUC.EXPECT().GetCustomFields(gomock.Any(), userID, false, eventID).Return(nil, nil)
+			})
+
+			It("should import every row in one bulk creation", func() {
+				mockUC.EXPECT().BulkCreate(gomock.Any(), userID, false, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, _ bool, input participant.BulkCreateInput) (
+						participant.BulkCreateOutput, error,
+					) {
+						Expect(input.Atomic).To(BeTrue())
+						Expect(input.Participants).To(HaveLen(2))
+						return participant.BulkCreateOutput{CreatedCount: 2}, nil
+					},
+				)
+
+				w := upload("?atomic=true", "name,email\nAlice,alice@example.com\nBob,bob@example.com\n")
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(w.Body.String()).To(ContainSubstring(`"imported_count":2`))
+			})
+
+			It("should return 422 naming a row that cannot be parsed, importing nothing", func() {
+				w := upload("?atomic=true", "name,email,payment_amount\nAlice,alice@example.com,10\nBob,bob@example.com,abc\n")
+
+				Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(w.Body.String()).To(ContainSubstring("row 2 could not be imported, so none were"))
+				Expect(w.Body.String()).To(ContainSubstring(`"field":"row 2"`))
+			})
+
+			It("should return 422 naming the row of the participant that rolled the import back", func() {
+				mockUC.EXPECT().BulkCreate(gomock.Any(), userID, false, gomock.Any()).Return(
+					participant.BulkCreateOutput{},
+					apperrors.WrapAppError(
+						apperrors.Unprocessable("participant at index 1 could not be created, so none were"),
+						&participant.BulkCreateAbortedError{Failure: participant.BulkCreateError{
+							Index:   1,
+							Email:   "bob@example.com",
+							Message: "participant with this email already exists for this event",
+						}},
+					),
+				)
+
+				w := upload("?atomic=true", "name,email\nAlice,alice@example.com\nBob,bob@example.com\n")
+
+				Expect(w.Code).To(Equal(http.StatusUnprocessableEntity))
+				Expect(w.Body.String()).To(ContainSubstring(
+					"row 2 could not be imported, so none were: participant with this email already exists",
+				))
+			})
+		})
+
+		When("the request is not a multipart form", func() {
+			It("should return 400 Bad Request", func() {
+				w := post("/events/"+eventID.String()+"/participants/import", `{"file": "participants.csv"}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+				Expect(w.Body.String()).To(ContainSubstring("invalid multipart form"))
+			})
+		})
+	})
+})
@@ -0,0 +1,124 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	"github.com/fumkob/ezqrin-server/internal/usecase/payment"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+)
+
+// PaymentHandler handles payment-related endpoints.
+// Implements generated.ServerInterface for OpenAPI compliance.
+type PaymentHandler struct {
+	usecase payment.Usecase
+	logger  *logger.Logger
+}
+
+// NewPaymentHandler creates a new PaymentHandler
+func NewPaymentHandler(usecase payment.Usecase, logger *logger.Logger) *PaymentHandler {
+	return &PaymentHandler{
+		usecase: usecase,
+		logger:  logger,
+	}
+}
+
+// GetPaymentSummary handles getting an event's payment summary (GET /events/{id}/payments/summary).
+func (h *PaymentHandler) GetPaymentSummary(c *gin.Context, id generated.EventIDParam) {
+	eventID := uuid.UUID(id)
+
+	role := middleware.GetUserRole(c)
+	userID, _ := middleware.GetUserID(c)
+
+	output, err := h.usecase.GetSummary(c.Request.Context(), userID, role == string(entity.RoleAdmin), eventID)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	resp := generated.PaymentSummaryResponse{
+		EventId:              openapi_types.UUID(output.EventID),
+		ExpectedAmount:       output.ExpectedAmount,
+		CollectedAmount:      output.CollectedAmount,
+		OutstandingAmount:    output.OutstandingAmount,
+		TotalParticipants:    int(output.TotalParticipants),
+		PaidParticipants:     int(output.PaidParticipants),
+		UnpaidParticipants:   int(output.UnpaidParticipants),
+		UnpricedParticipants: int(output.UnpricedParticipants),
+	}
+	if output.Currency != "" {
+		resp.Currency = &output.Currency
+	}
+
+	response.Data(c, http.StatusOK, resp)
+}
+
+// RecordParticipantPayment handles recording a participant's payment (POST /participants/{id}/payment).
+func (h *PaymentHandler) RecordParticipantPayment(c *gin.Context, id generated.ParticipantIDParam) {
+	var req generated.RecordParticipantPaymentJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	input := payment.RecordPaymentInput{
+		ParticipantID: uuid.UUID(id),
+		Amount:        req.Amount,
+		Method:        entity.PaymentMethod(req.Method),
+		Reference:     ptrOrDefault(req.Reference, ""),
+	}
+
+	p, err := h.usecase.RecordPayment(c.Request.Context(), userID, isAdmin, input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusCreated, toGeneratedPayment(p))
+}
+
+// RefundParticipantPayment handles refunding a participant's payment (POST /participants/{id}/payment/refund).
+func (h *PaymentHandler) RefundParticipantPayment(c *gin.Context, id generated.ParticipantIDParam) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	p, err := h.usecase.RefundPayment(c.Request.Context(), userID, isAdmin, uuid.UUID(id))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, toGeneratedPayment(p))
+}
+
+// toGeneratedPayment converts a payment entity to its API representation.
+func toGeneratedPayment(p *entity.Payment) generated.Payment {
+	resp := generated.Payment{
+		Id:            p.ID,
+		EventId:       p.EventID,
+		ParticipantId: p.ParticipantID,
+		Amount:        p.Amount,
+		Currency:      p.Currency,
+		Method:        generated.PaymentMethod(p.Method),
+		RecordedBy:    p.RecordedBy,
+		RecordedAt:    p.RecordedAt,
+		RefundedAt:    p.RefundedAt,
+		RefundedBy:    p.RefundedBy,
+	}
+	if p.Reference != "" {
+		resp.Reference = &p.Reference
+	}
+	return resp
+}
@@ -0,0 +1,255 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/handler"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/internal/usecase/payment"
+	paymentMocks "github.com/fumkob/ezqrin-server/internal/usecase/payment/mocks"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+// newPaymentHandlerRouter creates a Gin router with PaymentHandler routes, injecting auth context.
+func newPaymentHandlerRouter(uc payment.Usecase, userID uuid.UUID, role string, log *logger.Logger) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		c.Next()
+	})
+
+	h := handler.NewPaymentHandler(uc, log)
+
+	r.GET("/events/:id/payments/summary", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.GetPaymentSummary(c, id)
+	})
+	r.POST("/participants/:id/payment", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.RecordParticipantPayment(c, id)
+	})
+	r.POST("/participants/:id/payment/refund", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.RefundParticipantPayment(c, id)
+	})
+
+	return r
+}
+
+var _ = Describe("PaymentHandler", func() {
+	var (
+		log         *logger.Logger
+		organizerID uuid.UUID
+		eventID     uuid.UUID
+		ctrl        *gomock.Controller
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		log = newTestLogger()
+		organizerID = uuid.New()
+		eventID = uuid.New()
+		ctrl = gomock.NewController(GinkgoT())
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("GetPaymentSummary", func() {
+		When("the owner requests the summary", func() {
+			It("should return amounts as decimal strings with the event currency", func() {
+				mockUC := paymentMocks.NewMockUsecase(ctrl)
+				mockUC.EXPECT().GetSummary(gomock.Any(), organizerID, false, eventID).Return(&payment.SummaryOutput{
+					EventID:              eventID,
+					Currency:             "JPY",
+					ExpectedAmount:       money.FromMinorUnits(500000),
+					CollectedAmount:      money.FromMinorUnits(350000),
+					OutstandingAmount:    money.FromMinorUnits(150000),
+					TotalParticipants:    6,
+					PaidParticipants:     4,
+					UnpaidParticipants:   2,
+					UnpricedParticipants: 1,
+				}, nil)
+
+				r := newPaymentHandlerRouter(mockUC, organizerID, "organizer", log)
+
+				req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/payments/summary", nil)
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var body map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+				Expect(body["currency"]).To(Equal("JPY"))
+				Expect(body["expected_amount"]).To(Equal("5000.00"))
+				Expect(body["collected_amount"]).To(Equal("3500.00"))
+				Expect(body["outstanding_amount"]).To(Equal("1500.00"))
+				Expect(body["paid_participants"]).To(BeEquivalentTo(4))
+				Expect(body["unpaid_participants"]).To(BeEquivalentTo(2))
+				Expect(body["unpriced_participants"]).To(BeEquivalentTo(1))
+			})
+		})
+
+		When("the event has no currency", func() {
+			It("should omit the currency", func() {
+				mockUC := paymentMocks.NewMockUsecase(ctrl)
+				mockUC.EXPECT().GetSummary(gomock.Any(), organizerID, false, eventID).
+					Return(&payment.SummaryOutput{EventID: eventID}, nil)
+
+				r := newPaymentHandlerRouter(mockUC, organizerID, "organizer", log)
+
+				req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/payments/summary", nil)
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+
+				var body map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+				Expect(body).NotTo(HaveKey("currency"))
+				Expect(body["expected_amount"]).To(Equal("0.00"))
+			})
+		})
+
+		When("the caller does not own the event", func() {
+			It("should return 403", func() {
+				mockUC := paymentMocks.NewMockUsecase(ctrl)
+				mockUC.EXPECT().GetSummary(gomock.Any(), gomock.Any(), false, eventID).
+					Return(nil, apperrors.Forbidden("you do not have permission to view payments for this event"))
+
+				r := newPaymentHandlerRouter(mockUC, uuid.New(), "organizer", log)
+
+				req := httptest.NewRequest(http.MethodGet, "/events/"+eventID.String()+"/payments/summary", nil)
+				w := httptest.NewRecorder()
+				r.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusForbidden))
+			})
+		})
+	})
+
+	Describe("RecordParticipantPayment", func() {
+		var participantID uuid.UUID
+
+		BeforeEach(func() {
+			participantID = uuid.New()
+		})
+
+		record := func(r *gin.Engine, body string) *httptest.ResponseRecorder {
+			req := httptest.NewRequest(http.MethodPost, "/participants/"+participantID.String()+"/payment",
+				strings.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+			return w
+		}
+
+		When("the owner records a payment", func() {
+			It("should return 201 with the payment", func() {
+				recordedAt := time.Date(2025, 12, 15, 9, 15, 0, 0, time.UTC)
+				mockUC := paymentMocks.NewMockUsecase(ctrl)
+				mockUC.EXPECT().RecordPayment(gomock.Any(), organizerID, false, payment.RecordPaymentInput{
+					ParticipantID: participantID,
+					Amount:        money.FromMinorUnits(150000),
+					Method:        entity.PaymentMethodBankTransfer,
+					Reference:     "TX-20251215-001",
+				}).Return(&entity.Payment{
+					ID:            uuid.New(),
+					EventID:       eventID,
+					ParticipantID: participantID,
+					Amount:        money.FromMinorUnits(150000),
+					Currency:      "JPY",
+					Method:        entity.PaymentMethodBankTransfer,
+					Reference:     "TX-20251215-001",
+					RecordedBy:    organizerID,
+					RecordedAt:    recordedAt,
+				}, nil)
+
+				w := record(newPaymentHandlerRouter(mockUC, organizerID, "organizer", log),
+					`{"amount":"1500.00","method":"bank_transfer","reference":"TX-20251215-001"}`)
+
+				Expect(w.Code).To(Equal(http.StatusCreated))
+				var body map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+				Expect(body["amount"]).To(Equal("1500.00"))
+				Expect(body["currency"]).To(Equal("JPY"))
+				Expect(body["method"]).To(Equal("bank_transfer"))
+				Expect(body["reference"]).To(Equal("TX-20251215-001"))
+				Expect(body["recorded_at"]).To(Equal("2025-12-15T09:15:00Z"))
+				Expect(body).To(HaveKeyWithValue("refunded_at", BeNil()))
+			})
+		})
+
+		When("the request body is malformed", func() {
+			It("should return 400", func() {
+				mockUC := paymentMocks.NewMockUsecase(ctrl)
+
+				w := record(newPaymentHandlerRouter(mockUC, organizerID, "organizer", log), `{"amount":"abc"}`)
+
+				Expect(w.Code).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		When("the participant has already paid", func() {
+			It("should return 409", func() {
+				mockUC := paymentMocks.NewMockUsecase(ctrl)
+				mockUC.EXPECT().RecordPayment(gomock.Any(), organizerID, false, gomock.Any()).
+					Return(nil, apperrors.Conflict("participant has already paid"))
+
+				w := record(newPaymentHandlerRouter(mockUC, organizerID, "organizer", log),
+					`{"amount":"1500.00","method":"cash"}`)
+
+				Expect(w.Code).To(Equal(http.StatusConflict))
+			})
+		})
+	})
+
+	Describe("RefundParticipantPayment", func() {
+		It("should return 200 with the refunded payment", func() {
+			participantID := uuid.New()
+			refundedAt := time.Date(2025, 12, 16, 10, 0, 0, 0, time.UTC)
+			mockUC := paymentMocks.NewMockUsecase(ctrl)
+			mockUC.EXPECT().RefundPayment(gomock.Any(), organizerID, false, participantID).Return(&entity.Payment{
+				ID:            uuid.New(),
+				EventID:       eventID,
+				ParticipantID: participantID,
+				Amount:        money.FromMinorUnits(150000),
+				Currency:      "JPY",
+				Method:        entity.PaymentMethodCash,
+				RecordedBy:    organizerID,
+				RecordedAt:    refundedAt.Add(-24 * time.Hour),
+				RefundedAt:    &refundedAt,
+				RefundedBy:    &organizerID,
+			}, nil)
+
+			r := newPaymentHandlerRouter(mockUC, organizerID, "organizer", log)
+			req := httptest.NewRequest(http.MethodPost, "/participants/"+participantID.String()+"/payment/refund", nil)
+			w := httptest.NewRecorder()
+			r.ServeHTTP(w, req)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var body map[string]interface{}
+			Expect(json.Unmarshal(w.Body.Bytes(), &body)).To(Succeed())
+			Expect(body["refunded_at"]).To(Equal("2025-12-16T10:00:00Z"))
+			Expect(body["refunded_by"]).To(Equal(organizerID.String()))
+			Expect(body).NotTo(HaveKey("reference"))
+		})
+	})
+})
@@ -101,6 +101,7 @@ var _ = Describe("QRCode API Integration", func() {
 			CORS: config.CORSConfig{
 				AllowedOrigins: []string{"*"},
 			},
+			Pagination: testPagination,
 		}
 
 		log, _ = logger.New(logger.Config{
@@ -3,11 +3,22 @@ package handler_test
 import (
 	"context"
 
+	"github.com/fumkob/ezqrin-server/config"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/database"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
 	"go.uber.org/zap"
 )
 
+// testPagination is the list endpoint page size configuration used by handler tests,
+// matching config/default.yaml.
+var testPagination = config.PaginationConfig{
+	Events:       config.PageSizeConfig{DefaultPerPage: 20, MaxPerPage: 100},
+	Participants: config.PageSizeConfig{DefaultPerPage: 20, MaxPerPage: 100},
+	Checkins:     config.PageSizeConfig{DefaultPerPage: 20, MaxPerPage: 100},
+	Users:        config.PageSizeConfig{DefaultPerPage: 20, MaxPerPage: 100},
+	AuditLogs:    config.PageSizeConfig{DefaultPerPage: 20, MaxPerPage: 100},
+}
+
 // mockDBHealthChecker implements database.HealthChecker for testing.
 type mockDBHealthChecker struct {
 	healthy bool
@@ -43,6 +54,15 @@ func (m *mockRedisHealthChecker) Ping(ctx context.Context) error {
 	return nil
 }
 
+// mockQRHealthChecker implements qrcode.HealthChecker for testing.
+type mockQRHealthChecker struct {
+	err error
+}
+
+func (m *mockQRHealthChecker) HealthCheck(ctx context.Context) error {
+	return m.err
+}
+
 // newTestLogger returns a test logger with no-op logging.
 func newTestLogger() *logger.Logger {
 	return &logger.Logger{Logger: zap.NewNop()}
@@ -0,0 +1,141 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	"github.com/fumkob/ezqrin-server/internal/usecase/user"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+)
+
+// UserHandler handles user management endpoints.
+// Implements generated.ServerInterface for OpenAPI compliance.
+type UserHandler struct {
+	usecase  user.Usecase
+	pageSize config.PageSizeConfig
+	logger   *logger.Logger
+}
+
+// NewUserHandler creates a new UserHandler
+func NewUserHandler(
+	usecase user.Usecase,
+	pageSize config.PageSizeConfig,
+	logger *logger.Logger,
+) *UserHandler {
+	return &UserHandler{
+		usecase:  usecase,
+		pageSize: pageSize,
+		logger:   logger,
+	}
+}
+
+// ListUsers handles listing users (GET /users).
+func (h *UserHandler) ListUsers(c *gin.Context, params generated.ListUsersParams) {
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	input := user.ListUsersInput{}
+	input.Page, input.PerPage = parsePagination(params.Page, params.PerPage, h.pageSize)
+	if params.Search != nil {
+		input.Search = *params.Search
+	}
+	if params.Role != nil {
+		role := entity.UserRole(*params.Role)
+		input.Role = &role
+	}
+
+	output, err := h.usecase.List(c.Request.Context(), isAdmin, input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	users := make([]generated.User, len(output.Users))
+	for i, u := range output.Users {
+		users[i] = toGeneratedUser(u)
+	}
+
+	response.Data(c, http.StatusOK, generated.UserListResponse{
+		Data: users,
+		Meta: generated.PaginationMeta{
+			Page:       input.Page,
+			PerPage:    input.PerPage,
+			Total:      int(output.TotalCount),
+			TotalPages: int((output.TotalCount + int64(input.PerPage) - 1) / int64(input.PerPage)),
+		},
+	})
+}
+
+// GetUser handles getting a user by ID (GET /users/{id}).
+func (h *UserHandler) GetUser(c *gin.Context, id generated.UserIDParam) {
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	found, err := h.usecase.GetByID(c.Request.Context(), userID, isAdmin, uuid.UUID(id))
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, toGeneratedUser(found))
+}
+
+// UpdateUser handles changing the role of a user or deactivating them (PATCH /users/{id}).
+func (h *UserHandler) UpdateUser(c *gin.Context, id generated.UserIDParam) {
+	var req generated.UpdateUserRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.logger.WithContext(c.Request.Context()).Warn("invalid request body", zap.Error(err))
+		response.ProblemFromError(c, apperrors.BadRequest("invalid request body"))
+		return
+	}
+
+	userID, _ := middleware.GetUserID(c)
+	isAdmin := middleware.GetUserRole(c) == string(entity.RoleAdmin)
+
+	input := user.UpdateUserInput{Deactivated: req.Deactivated}
+	if req.Role != nil {
+		role := entity.UserRole(*req.Role)
+		input.Role = &role
+	}
+
+	updated, err := h.usecase.Update(c.Request.Context(), userID, isAdmin, uuid.UUID(id), input)
+	if err != nil {
+		response.ProblemFromError(c, err)
+		return
+	}
+
+	response.Data(c, http.StatusOK, toGeneratedUser(updated))
+}
+
+// toGeneratedUser maps a user entity to the generated User
+func toGeneratedUser(u *entity.User) generated.User {
+	userID := openapi_types.UUID(u.ID)
+	createdAtUTC := u.CreatedAt.UTC()
+	updatedAtUTC := u.UpdatedAt.UTC()
+	twoFactorEnabled := u.TwoFactorEnabled
+	emailVerified := u.EmailVerified
+
+	result := generated.User{
+		Id:               &userID,
+		Email:            openapi_types.Email(u.Email),
+		Name:             u.Name,
+		Role:             generated.UserRole(u.Role),
+		TwoFactorEnabled: &twoFactorEnabled,
+		EmailVerified:    &emailVerified,
+		CreatedAt:        &createdAtUTC,
+		UpdatedAt:        &updatedAtUTC,
+	}
+	if u.DeactivatedAt != nil {
+		deactivatedAtUTC := u.DeactivatedAt.UTC()
+		result.DeactivatedAt = &deactivatedAtUTC
+	}
+	return result
+}
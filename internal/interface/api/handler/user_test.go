@@ -0,0 +1,150 @@
+package handler_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/handler"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/internal/usecase/user"
+	userMocks "github.com/fumkob/ezqrin-server/internal/usecase/user/mocks"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+// newUserHandlerRouter creates a Gin router with UserHandler routes, injecting auth context.
+func newUserHandlerRouter(uc user.Usecase, userID uuid.UUID, role string) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+
+	r.Use(func(c *gin.Context) {
+		c.Set(middleware.ContextKeyUserID, userID)
+		c.Set(middleware.ContextKeyUserRole, role)
+		c.Next()
+	})
+
+	h := handler.NewUserHandler(uc, testPagination.Users, newTestLogger())
+	r.GET("/users", func(c *gin.Context) {
+		var params generated.ListUsersParams
+		Expect(c.ShouldBindQuery(&params)).To(Succeed())
+		h.ListUsers(c, params)
+	})
+	r.GET("/users/:id", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.GetUser(c, id)
+	})
+	r.PATCH("/users/:id", func(c *gin.Context) {
+		id, _ := uuid.Parse(c.Param("id"))
+		h.UpdateUser(c, id)
+	})
+
+	return r
+}
+
+var _ = Describe("UserHandler", func() {
+	var (
+		ctrl    *gomock.Controller
+		mockUC  *userMocks.MockUsecase
+		adminID uuid.UUID
+		alice   *entity.User
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockUC = userMocks.NewMockUsecase(ctrl)
+		adminID = uuid.New()
+		alice = &entity.User{
+			ID:        uuid.New(),
+			Email:     "alice@example.com",
+			Name:      "Alice",
+			Role:      entity.RoleOrganizer,
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	serve := func(method, path, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		newUserHandlerRouter(mockUC, adminID, "admin").ServeHTTP(w, req)
+		return w
+	}
+
+	Describe("ListUsers", func() {
+		It("should pass the filters and return the paginated users", func() {
+			role := entity.RoleOrganizer
+			mockUC.EXPECT().
+				List(gomock.Any(), true, user.ListUsersInput{Search: "ali", Role: &role, Page: 2, PerPage: 10}).
+				Return(user.ListUsersOutput{Users: []*entity.User{alice}, TotalCount: 11}, nil)
+
+			w := serve(http.MethodGet, "/users?search=ali&role=organizer&page=2&per_page=10", "")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp generated.UserListResponse
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.Data).To(HaveLen(1))
+			Expect(resp.Data[0].Email).To(BeEquivalentTo("alice@example.com"))
+			Expect(resp.Meta).To(Equal(generated.PaginationMeta{Page: 2, PerPage: 10, Total: 11, TotalPages: 2}))
+		})
+	})
+
+	Describe("GetUser", func() {
+		It("should return 404 Not Found for an unknown user", func() {
+			mockUC.EXPECT().GetByID(gomock.Any(), adminID, true, alice.ID).
+				Return(nil, apperrors.NotFound("user not found"))
+
+			w := serve(http.MethodGet, "/users/"+alice.ID.String(), "")
+
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Describe("UpdateUser", func() {
+		It("should deactivate the user and return them with deactivated_at", func() {
+			deactivatedAt := time.Now()
+			mockUC.EXPECT().Update(gomock.Any(), adminID, true, alice.ID, gomock.Any()).
+				DoAndReturn(func(_, _, _, _ any, input user.UpdateUserInput) (*entity.User, error) {
+					Expect(input.Role).To(BeNil())
+					Expect(*input.Deactivated).To(BeTrue())
+					alice.DeactivatedAt = &deactivatedAt
+					return alice, nil
+				})
+
+			w := serve(http.MethodPatch, "/users/"+alice.ID.String(), `{"deactivated": true}`)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			var resp generated.User
+			Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+			Expect(resp.DeactivatedAt).NotTo(BeNil())
+		})
+
+		It("should return 409 Conflict when demoting the last admin", func() {
+			mockUC.EXPECT().Update(gomock.Any(), adminID, true, alice.ID, gomock.Any()).
+				Return(nil, apperrors.Conflict("cannot demote or deactivate the last remaining admin"))
+
+			w := serve(http.MethodPatch, "/users/"+alice.ID.String(), `{"role": "staff"}`)
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+		})
+
+		It("should return 400 Bad Request for a malformed body", func() {
+			w := serve(http.MethodPatch, "/users/"+alice.ID.String(), `{"role":`)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+		})
+	})
+})
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// idempotentRoutes lists the routes that accept an Idempotency-Key header, keyed by
+// "METHOD path" with the path relative to the API base path as registered by the generated
+// code. All of them require authentication.
+var idempotentRoutes = map[string]bool{
+	http.MethodPost + " /events":                  true,
+	http.MethodPost + " /events/:id/participants": true,
+	http.MethodPost + " /events/:id/checkin":      true,
+}
+
+// NewIdempotentRouter wraps router so that each route listed in routes replays its response
+// for a repeated Idempotency-Key; see middleware.Idempotency. Idempotency keys are scoped to
+// the user, so these routes authenticate before the idempotency middleware runs.
+// A zero ttl leaves the routes unchanged.
+func NewIdempotentRouter(
+	router gin.IRouter,
+	routes map[string]bool,
+	authenticate gin.HandlerFunc,
+	cache repository.CacheRepository,
+	ttl time.Duration,
+	log *logger.Logger,
+) gin.IRouter {
+	return &hookedRouter{
+		IRouter: router,
+		hook: func(method, path string, handlers []gin.HandlerFunc) []gin.HandlerFunc {
+			if !routes[method+" "+path] || ttl <= 0 {
+				return handlers
+			}
+			return append(
+				[]gin.HandlerFunc{authenticate, middleware.Idempotency(cache, ttl, log)},
+				handlers...,
+			)
+		},
+	}
+}
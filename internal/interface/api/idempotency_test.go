@@ -0,0 +1,88 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/interface/api"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("NewIdempotentRouter", func() {
+	var (
+		ctrl          *gomock.Controller
+		cache         *mocks.MockCacheRepository
+		authenticated int
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		ctrl = gomock.NewController(GinkgoT())
+		cache = mocks.NewMockCacheRepository(ctrl)
+		authenticated = 0
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	newRouter := func(ttl time.Duration) *gin.Engine {
+		r := gin.New()
+		routes := api.NewIdempotentRouter(r.Group("/api/v1"), map[string]bool{
+			http.MethodPost + " /events": true,
+		}, func(c *gin.Context) {
+			authenticated++
+			c.Set(middleware.ContextKeyUserID, uuid.New())
+		}, cache, ttl, &logger.Logger{Logger: zap.NewNop()})
+
+		created := func(c *gin.Context) { c.Status(http.StatusCreated) }
+		routes.POST("/events", created)
+		routes.POST("/participants", created)
+		return r
+	}
+
+	post := func(r *gin.Engine, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		req.Header.Set(middleware.IdempotencyKeyHeader, "key-1")
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	When("a listed route is called with an Idempotency-Key", func() {
+		It("should authenticate and record the response under the key", func() {
+			cache.EXPECT().SetIfNotExists(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(true, nil)
+			cache.EXPECT().Set(gomock.Any(), gomock.Any(), gomock.Any(), 10*time.Minute).Return(nil)
+
+			w := post(newRouter(10*time.Minute), "/api/v1/events")
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+			Expect(authenticated).To(Equal(1))
+		})
+	})
+
+	When("a route that is not listed is called with an Idempotency-Key", func() {
+		It("should leave the route unchanged", func() {
+			w := post(newRouter(10*time.Minute), "/api/v1/participants")
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+			Expect(authenticated).To(BeZero())
+		})
+	})
+
+	When("the TTL is zero", func() {
+		It("should leave the listed routes unchanged", func() {
+			w := post(newRouter(0), "/api/v1/events")
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+			Expect(authenticated).To(BeZero())
+		})
+	})
+})
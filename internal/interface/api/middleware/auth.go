@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"strings"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
@@ -37,6 +38,17 @@ func GetUserRole(c *gin.Context) string {
 	return role
 }
 
+// GetSessionID retrieves the session of the authenticated user's access token from the gin context.
+// Returns uuid.Nil when the token was issued before sessions were tracked.
+func GetSessionID(c *gin.Context) (uuid.UUID, bool) {
+	val, exists := c.Get(ContextKeySessionID)
+	if !exists {
+		return uuid.Nil, false
+	}
+	id, ok := val.(uuid.UUID)
+	return id, ok
+}
+
 const (
 	// ContextKeyUserID is the key for storing user ID in gin context
 	ContextKeyUserID = "user_id"
@@ -44,6 +56,9 @@ const (
 	// ContextKeyUserRole is the key for storing user role in gin context
 	ContextKeyUserRole = "user_role"
 
+	// ContextKeySessionID is the key for storing the session ID of the access token in gin context
+	ContextKeySessionID = "session_id"
+
 	// authHeaderParts is the number of parts in Bearer token header
 	authHeaderParts = 2 // "Bearer <token>"
 )
@@ -51,19 +66,19 @@ const (
 // AuthMiddleware provides JWT authentication middleware
 type AuthMiddleware struct {
 	blacklistRepo repository.TokenBlacklistRepository
-	jwtSecret     string
+	signer        *crypto.TokenSigner
 	logger        *logger.Logger
 }
 
 // NewAuthMiddleware creates a new AuthMiddleware
 func NewAuthMiddleware(
 	blacklistRepo repository.TokenBlacklistRepository,
-	jwtSecret string,
+	signer *crypto.TokenSigner,
 	logger *logger.Logger,
 ) *AuthMiddleware {
 	return &AuthMiddleware{
 		blacklistRepo: blacklistRepo,
-		jwtSecret:     jwtSecret,
+		signer:        signer,
 		logger:        logger,
 	}
 }
@@ -81,7 +96,7 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 		}
 
 		// Parse and validate token
-		claims, err := crypto.ParseToken(token, m.jwtSecret)
+		claims, err := m.signer.ParseToken(token)
 		if err != nil {
 			if err == crypto.ErrExpiredToken {
 				m.logger.WithContext(c.Request.Context()).Warn("expired token")
@@ -103,8 +118,8 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 			return
 		}
 
-		// Check if token is blacklisted
-		isBlacklisted, err := m.blacklistRepo.IsBlacklisted(c.Request.Context(), token)
+		// Check if token or its session is blacklisted
+		isBlacklisted, err := m.isRevoked(c.Request.Context(), token, claims)
 		if err != nil {
 			m.logger.WithContext(c.Request.Context()).Error("failed to check token blacklist", zap.Error(err))
 			response.ProblemFromError(c, apperrors.Internal("failed to validate token"))
@@ -118,9 +133,25 @@ func (m *AuthMiddleware) Authenticate() gin.HandlerFunc {
 			return
 		}
 
+		// Check if an admin has deactivated the user since the token was issued
+		isDeactivated, err := m.blacklistRepo.IsUserBlacklisted(c.Request.Context(), claims.UserID.String())
+		if err != nil {
+			m.logger.WithContext(c.Request.Context()).Error("failed to check user blacklist", zap.Error(err))
+			response.ProblemFromError(c, apperrors.Internal("failed to validate token"))
+			c.Abort()
+			return
+		}
+		if isDeactivated {
+			m.logger.WithContext(c.Request.Context()).Warn("token of deactivated user used")
+			response.ProblemFromError(c, apperrors.Unauthorized("user account has been deactivated"))
+			c.Abort()
+			return
+		}
+
 		// Set user information in context
 		c.Set(ContextKeyUserID, claims.UserID)
 		c.Set(ContextKeyUserRole, claims.Role)
+		setSessionID(c, claims)
 
 		// Continue to next handler
 		c.Next()
@@ -139,7 +170,7 @@ func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 		}
 
 		// Parse and validate token
-		claims, err := crypto.ParseToken(token, m.jwtSecret)
+		claims, err := m.signer.ParseToken(token)
 		if err != nil {
 			// Invalid token, but don't abort (optional auth)
 			m.logger.WithContext(c.Request.Context()).Debug("invalid optional auth token", zap.Error(err))
@@ -147,8 +178,8 @@ func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 			return
 		}
 
-		// Check if token is blacklisted
-		isBlacklisted, err := m.blacklistRepo.IsBlacklisted(c.Request.Context(), token)
+		// Check if token or its session is blacklisted
+		isBlacklisted, err := m.isRevoked(c.Request.Context(), token, claims)
 		if err != nil {
 			m.logger.WithContext(c.Request.Context()).Warn("failed to check token blacklist", zap.Error(err))
 			c.Next()
@@ -160,9 +191,22 @@ func (m *AuthMiddleware) OptionalAuth() gin.HandlerFunc {
 			return
 		}
 
+		// Deactivated users are treated as anonymous
+		isDeactivated, err := m.blacklistRepo.IsUserBlacklisted(c.Request.Context(), claims.UserID.String())
+		if err != nil {
+			m.logger.WithContext(c.Request.Context()).Warn("failed to check user blacklist", zap.Error(err))
+			c.Next()
+			return
+		}
+		if isDeactivated {
+			c.Next()
+			return
+		}
+
 		// Set user information in context
 		c.Set(ContextKeyUserID, claims.UserID)
 		c.Set(ContextKeyUserRole, claims.Role)
+		setSessionID(c, claims)
 
 		c.Next()
 	}
@@ -220,3 +264,19 @@ func ExtractBearerToken(c *gin.Context) string {
 
 	return parts[1]
 }
+
+// isRevoked checks if a token is blacklisted, or its token family: the tokens of a revoked session
+func (m *AuthMiddleware) isRevoked(ctx context.Context, token string, claims *crypto.Claims) (bool, error) {
+	isBlacklisted, err := m.blacklistRepo.IsBlacklisted(ctx, token)
+	if err != nil || isBlacklisted || claims.SessionID == "" {
+		return isBlacklisted, err
+	}
+	return m.blacklistRepo.IsFamilyBlacklisted(ctx, claims.SessionID)
+}
+
+// setSessionID sets the session ID of the token in context, when the token carries one
+func setSessionID(c *gin.Context, claims *crypto.Claims) {
+	if sessionID, err := uuid.Parse(claims.SessionID); err == nil {
+		c.Set(ContextKeySessionID, sessionID)
+	}
+}
@@ -21,6 +21,9 @@ import (
 
 const testJWTSecret = "test-jwt-secret-for-middleware-tests"
 
+// testSigner verifies the tokens of the tests with testJWTSecret
+var testSigner, _ = crypto.NewTokenSigner(crypto.SigningConfig{Secret: testJWTSecret})
+
 // problemResponse mirrors the detail field from the RFC 9457 response body.
 type problemResponse struct {
 	Detail string `json:"detail"`
@@ -48,7 +51,7 @@ var _ = Describe("AuthMiddleware", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockBlacklist = mocks.NewMockTokenBlacklistRepository(ctrl)
 		nopLogger = &logger.Logger{Logger: zap.NewNop()}
-		authMiddleware = middleware.NewAuthMiddleware(mockBlacklist, testJWTSecret, nopLogger)
+		authMiddleware = middleware.NewAuthMiddleware(mockBlacklist, testSigner, nopLogger)
 		router = gin.New()
 	})
 
@@ -218,6 +221,57 @@ var _ = Describe("AuthMiddleware", func() {
 			})
 		})
 
+		When("the session of the token has been revoked", func() {
+			It("should return 401 with token has been revoked", func() {
+				sessionID := uuid.New()
+				validToken, err := testSigner.GenerateSessionAccessToken(
+					uuid.New().String(), "organizer", sessionID.String(), time.Hour,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockBlacklist.EXPECT().
+					IsBlacklisted(gomock.Any(), validToken).
+					Return(false, nil)
+				mockBlacklist.EXPECT().
+					IsFamilyBlacklisted(gomock.Any(), sessionID.String()).
+					Return(true, nil)
+
+				req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+				req.Header.Set("Authorization", "Bearer "+validToken)
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusUnauthorized))
+				p := decodeProblem(w.Body.Bytes())
+				Expect(p.Detail).To(Equal("token has been revoked"))
+			})
+		})
+
+		When("the user has been deactivated", func() {
+			It("should return 401 with user account has been deactivated", func() {
+				userID := uuid.New()
+				validToken := newAccessToken(userID, "organizer", time.Hour)
+
+				mockBlacklist.EXPECT().
+					IsBlacklisted(gomock.Any(), validToken).
+					Return(false, nil)
+				mockBlacklist.EXPECT().
+					IsUserBlacklisted(gomock.Any(), userID.String()).
+					Return(true, nil)
+
+				req := httptest.NewRequest(http.MethodGet, "/protected", nil)
+				req.Header.Set("Authorization", "Bearer "+validToken)
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusUnauthorized))
+				p := decodeProblem(w.Body.Bytes())
+				Expect(p.Detail).To(Equal("user account has been deactivated"))
+			})
+		})
+
 		When("the blacklist check returns an error", func() {
 			It("should return 500 with failed to validate token", func() {
 				userID := uuid.New()
@@ -247,6 +301,9 @@ var _ = Describe("AuthMiddleware", func() {
 				mockBlacklist.EXPECT().
 					IsBlacklisted(gomock.Any(), validToken).
 					Return(false, nil)
+				mockBlacklist.EXPECT().
+					IsUserBlacklisted(gomock.Any(), userID.String()).
+					Return(false, nil)
 
 				req := httptest.NewRequest(http.MethodGet, "/protected", nil)
 				req.Header.Set("Authorization", "Bearer "+validToken)
@@ -264,6 +321,9 @@ var _ = Describe("AuthMiddleware", func() {
 				mockBlacklist.EXPECT().
 					IsBlacklisted(gomock.Any(), validToken).
 					Return(false, nil)
+				mockBlacklist.EXPECT().
+					IsUserBlacklisted(gomock.Any(), userID.String()).
+					Return(false, nil)
 
 				var capturedID uuid.UUID
 				var capturedOK bool
@@ -293,6 +353,9 @@ var _ = Describe("AuthMiddleware", func() {
 				mockBlacklist.EXPECT().
 					IsBlacklisted(gomock.Any(), validToken).
 					Return(false, nil)
+				mockBlacklist.EXPECT().
+					IsUserBlacklisted(gomock.Any(), userID.String()).
+					Return(false, nil)
 
 				var capturedRole string
 
@@ -312,6 +375,44 @@ var _ = Describe("AuthMiddleware", func() {
 				Expect(w.Code).To(Equal(http.StatusOK))
 				Expect(capturedRole).To(Equal("organizer"))
 			})
+
+			It("should set the session of the token in the gin context so GetSessionID returns it", func() {
+				sessionID := uuid.New()
+				validToken, err := testSigner.GenerateSessionAccessToken(
+					uuid.New().String(), "organizer", sessionID.String(), time.Hour,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockBlacklist.EXPECT().
+					IsBlacklisted(gomock.Any(), validToken).
+					Return(false, nil)
+				mockBlacklist.EXPECT().
+					IsFamilyBlacklisted(gomock.Any(), sessionID.String()).
+					Return(false, nil)
+				mockBlacklist.EXPECT().
+					IsUserBlacklisted(gomock.Any(), gomock.Any()).
+					Return(false, nil)
+
+				var capturedID uuid.UUID
+				var capturedOK bool
+
+				router2 := gin.New()
+				router2.Use(authMiddleware.Authenticate())
+				router2.GET("/check", func(c *gin.Context) {
+					capturedID, capturedOK = middleware.GetSessionID(c)
+					c.JSON(http.StatusOK, gin.H{"ok": true})
+				})
+
+				req := httptest.NewRequest(http.MethodGet, "/check", nil)
+				req.Header.Set("Authorization", "Bearer "+validToken)
+				w := httptest.NewRecorder()
+
+				router2.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(capturedOK).To(BeTrue())
+				Expect(capturedID).To(Equal(sessionID))
+			})
 		})
 	})
 
@@ -366,6 +467,9 @@ var _ = Describe("AuthMiddleware", func() {
 				mockBlacklist.EXPECT().
 					IsBlacklisted(gomock.Any(), validToken).
 					Return(false, nil)
+				mockBlacklist.EXPECT().
+					IsUserBlacklisted(gomock.Any(), userID.String()).
+					Return(false, nil)
 
 				var capturedID uuid.UUID
 				var capturedRole string
@@ -443,6 +547,38 @@ var _ = Describe("AuthMiddleware", func() {
 			})
 		})
 
+		When("the token of a deactivated user is provided", func() {
+			It("should continue without setting user context and return 200", func() {
+				userID := uuid.New()
+				validToken := newAccessToken(userID, "attendee", time.Hour)
+
+				mockBlacklist.EXPECT().
+					IsBlacklisted(gomock.Any(), validToken).
+					Return(false, nil)
+				mockBlacklist.EXPECT().
+					IsUserBlacklisted(gomock.Any(), userID.String()).
+					Return(true, nil)
+
+				var capturedOK bool
+
+				router2 := gin.New()
+				router2.Use(authMiddleware.OptionalAuth())
+				router2.GET("/check", func(c *gin.Context) {
+					_, capturedOK = middleware.GetUserID(c)
+					c.JSON(http.StatusOK, gin.H{"ok": true})
+				})
+
+				req := httptest.NewRequest(http.MethodGet, "/check", nil)
+				req.Header.Set("Authorization", "Bearer "+validToken)
+				w := httptest.NewRecorder()
+
+				router2.ServeHTTP(w, req)
+
+				Expect(w.Code).To(Equal(http.StatusOK))
+				Expect(capturedOK).To(BeFalse())
+			})
+		})
+
 		When("the blacklist check fails", func() {
 			It("should continue without setting user context and return 200", func() {
 				userID := uuid.New()
@@ -573,6 +709,9 @@ var _ = Describe("AuthMiddleware", func() {
 				mockBlacklist.EXPECT().
 					IsBlacklisted(gomock.Any(), validToken).
 					Return(false, nil)
+				mockBlacklist.EXPECT().
+					IsUserBlacklisted(gomock.Any(), userID.String()).
+					Return(false, nil)
 
 				var capturedID uuid.UUID
 				var capturedOK bool
@@ -629,6 +768,9 @@ var _ = Describe("AuthMiddleware", func() {
 				mockBlacklist.EXPECT().
 					IsBlacklisted(gomock.Any(), validToken).
 					Return(false, nil)
+				mockBlacklist.EXPECT().
+					IsUserBlacklisted(gomock.Any(), userID.String()).
+					Return(false, nil)
 
 				var capturedRole string
 
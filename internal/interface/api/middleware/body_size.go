@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// MaxBodySize is a middleware that limits request bodies to limit bytes. Requests declaring a
+// larger Content-Length are answered with 413 right away; bodies sent without one are cut off
+// at the limit, and reading past it fails with *http.MaxBytesError.
+// A non-positive limit disables the check.
+func MaxBodySize(limit int64) gin.HandlerFunc {
+	if limit <= 0 {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	return func(c *gin.Context) {
+		if c.Request.ContentLength > limit {
+			response.ProblemFromError(c, BodyTooLarge(limit))
+			c.Abort()
+			return
+		}
+		if c.Request.Body != nil {
+			c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		}
+		c.Next()
+	}
+}
+
+// BodyTooLarge returns the 413 error for a request body over limit bytes.
+func BodyTooLarge(limit int64) *apperrors.AppError {
+	return apperrors.PayloadTooLarge(fmt.Sprintf("request body exceeds the limit of %d bytes", limit))
+}
@@ -0,0 +1,94 @@
+package middleware_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("MaxBodySize", func() {
+	const limit = 32
+
+	var (
+		router  *gin.Engine
+		called  bool
+		readErr error
+	)
+
+	setup := func(limit int64) {
+		gin.SetMode(gin.TestMode)
+		called = false
+		readErr = nil
+		router = gin.New()
+		router.POST("/upload", middleware.MaxBodySize(limit), func(c *gin.Context) {
+			called = true
+			_, readErr = io.ReadAll(c.Request.Body)
+			c.Status(http.StatusOK)
+		})
+	}
+
+	post := func(size int, announceLength bool) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/upload", strings.NewReader(strings.Repeat("a", size)))
+		if !announceLength {
+			req.ContentLength = -1
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	BeforeEach(func() {
+		setup(limit)
+	})
+
+	When("the body is within the limit", func() {
+		It("should pass it to the handler", func() {
+			w := post(limit, true)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(called).To(BeTrue())
+			Expect(readErr).NotTo(HaveOccurred())
+		})
+	})
+
+	When("the Content-Length exceeds the limit", func() {
+		It("should return 413 without calling the handler", func() {
+			w := post(limit+1, true)
+
+			Expect(w.Code).To(Equal(http.StatusRequestEntityTooLarge))
+			Expect(w.Header().Get("Content-Type")).To(Equal("application/problem+json"))
+			Expect(w.Body.String()).To(ContainSubstring(apperrors.CodePayloadTooLarge))
+			Expect(w.Body.String()).To(ContainSubstring("request body exceeds the limit of 32 bytes"))
+			Expect(called).To(BeFalse())
+		})
+	})
+
+	When("a body without Content-Length exceeds the limit", func() {
+		It("should fail the read with http.MaxBytesError", func() {
+			post(limit+1, false)
+
+			var maxBytesErr *http.MaxBytesError
+			Expect(errors.As(readErr, &maxBytesErr)).To(BeTrue())
+			Expect(maxBytesErr.Limit).To(Equal(int64(limit)))
+		})
+	})
+
+	When("the limit is disabled", func() {
+		It("should accept any body", func() {
+			setup(0)
+
+			w := post(10*limit, false)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(readErr).NotTo(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,44 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// CaptchaTokenHeader carries the token a CAPTCHA widget issued to the client
+const CaptchaTokenHeader = "X-Captcha-Token"
+
+// CaptchaVerifier verifies CAPTCHA tokens with a CAPTCHA provider.
+// Implementations include NoopCaptchaVerifier.
+type CaptchaVerifier interface {
+	// Verify returns nil if token proves a human solved the CAPTCHA from remoteIP, and an
+	// error otherwise, including when token is empty.
+	Verify(ctx context.Context, token, remoteIP string) error
+}
+
+// NoopCaptchaVerifier accepts every request, for deployments without a CAPTCHA provider.
+type NoopCaptchaVerifier struct{}
+
+// Verify accepts any token, including none.
+func (NoopCaptchaVerifier) Verify(context.Context, string, string) error {
+	return nil
+}
+
+// Captcha is a middleware that rejects requests whose CaptchaTokenHeader the verifier does not
+// accept with 403 Forbidden.
+func Captcha(verifier CaptchaVerifier, log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if err := verifier.Verify(c.Request.Context(), c.GetHeader(CaptchaTokenHeader), c.ClientIP()); err != nil {
+			log.WithContext(c.Request.Context()).Info("captcha verification failed", zap.Error(err))
+			response.ProblemFromError(c, apperrors.Forbidden("captcha verification failed"))
+			c.Abort()
+			return
+		}
+		c.Next()
+	}
+}
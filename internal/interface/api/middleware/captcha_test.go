@@ -0,0 +1,79 @@
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+)
+
+// captchaVerifier accepts only its token and records what it was asked to verify
+type captchaVerifier struct {
+	token    string
+	verified []string
+}
+
+func (v *captchaVerifier) Verify(_ context.Context, token, remoteIP string) error {
+	v.verified = append(v.verified, token+"@"+remoteIP)
+	if token != v.token {
+		return errors.New("invalid captcha token")
+	}
+	return nil
+}
+
+var _ = Describe("Captcha", func() {
+	var handled int
+
+	newRouter := func(verifier middleware.CaptchaVerifier) *gin.Engine {
+		gin.SetMode(gin.TestMode)
+		handled = 0
+		r := gin.New()
+		r.POST("/accept-invite", middleware.Captcha(verifier, &logger.Logger{Logger: zap.NewNop()}), func(c *gin.Context) {
+			handled++
+			c.Status(http.StatusOK)
+		})
+		return r
+	}
+
+	post := func(r *gin.Engine, token string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/accept-invite", nil)
+		req.RemoteAddr = "192.0.2.1:40000"
+		if token != "" {
+			req.Header.Set(middleware.CaptchaTokenHeader, token)
+		}
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, req)
+		return w
+	}
+
+	It("should pass the token and client IP to the verifier and continue when it is accepted", func() {
+		verifier := &captchaVerifier{token: "solved"}
+
+		w := post(newRouter(verifier), "solved")
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(handled).To(Equal(1))
+		Expect(verifier.verified).To(Equal([]string{"solved@192.0.2.1"}))
+	})
+
+	It("should answer 403 without running the handler when the token is rejected", func() {
+		w := post(newRouter(&captchaVerifier{token: "solved"}), "forged")
+
+		Expect(w.Code).To(Equal(http.StatusForbidden))
+		Expect(handled).To(BeZero())
+	})
+
+	It("should accept requests without a token with the no-op verifier", func() {
+		w := post(newRouter(middleware.NoopCaptchaVerifier{}), "")
+
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(handled).To(Equal(1))
+	})
+})
@@ -0,0 +1,262 @@
+package middleware
+
+import (
+	"compress/gzip"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+)
+
+// uncompressibleContentTypes are the media types, or prefixes of them, sent as is: they are
+// already compressed, or (for event streams) must reach the client as soon as they are flushed.
+var uncompressibleContentTypes = []string{
+	"image/",
+	"video/",
+	"audio/",
+	"application/zip",
+	"application/gzip",
+	"application/pdf",
+	"application/vnd.apple.pkpass",
+	"text/event-stream",
+}
+
+var gzipWriterPool = sync.Pool{
+	New: func() any {
+		return gzip.NewWriter(nil)
+	},
+}
+
+// Compress is a middleware that gzips response bodies of at least minSize bytes for clients
+// sending Accept-Encoding: gzip. Responses whose content type is already compressed, such as
+// QR code images, are sent as is. Compressible responses carry Vary: Accept-Encoding whether
+// or not they were compressed, so caches keep the encodings apart.
+func Compress(minSize int) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		cw := &compressWriter{
+			ResponseWriter: c.Writer,
+			minSize:        minSize,
+			acceptsGzip:    acceptsGzip(c.GetHeader("Accept-Encoding")),
+			head:           c.Request.Method == http.MethodHead,
+		}
+		c.Writer = cw
+		defer func() {
+			c.Writer = cw.ResponseWriter
+		}()
+
+		c.Next()
+		cw.finish()
+	}
+}
+
+// acceptsGzip reports whether an Accept-Encoding header allows gzip, either by name or
+// through "*". Encodings with q=0 are refused.
+func acceptsGzip(header string) bool {
+	wildcard := false
+	for _, part := range strings.Split(header, ",") {
+		name, params, _ := strings.Cut(part, ";")
+		accepted := true
+		if key, value, ok := strings.Cut(strings.TrimSpace(params), "="); ok && strings.TrimSpace(key) == "q" {
+			q, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+			accepted = err == nil && q > 0
+		}
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case "gzip":
+			return accepted
+		case "*":
+			wildcard = accepted
+		}
+	}
+	return wildcard
+}
+
+// compressible reports whether a response with the given Content-Type is worth gzipping.
+// Responses without a Content-Type are left alone, as the server would sniff the gzipped bytes.
+func compressible(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.ToLower(strings.TrimSpace(mediaType))
+	if mediaType == "" {
+		return false
+	}
+	for _, prefix := range uncompressibleContentTypes {
+		if strings.HasPrefix(mediaType, prefix) {
+			return false
+		}
+	}
+	return true
+}
+
+// compressWriter holds back the start of a response until minSize bytes are written, the
+// handler flushes or the handler returns, then sends the rest either gzipped or as is.
+type compressWriter struct {
+	gin.ResponseWriter
+
+	minSize     int
+	acceptsGzip bool
+	head        bool
+
+	status  int
+	buf     []byte
+	decided bool
+	gz      *gzip.Writer
+}
+
+// WriteHeader records the status code to send once the encoding is decided.
+func (w *compressWriter) WriteHeader(code int) {
+	if w.decided {
+		w.ResponseWriter.WriteHeader(code)
+		return
+	}
+	if code > 0 {
+		w.status = code
+	}
+}
+
+// WriteHeaderNow sends the header, leaving the response uncompressed if nothing was written.
+func (w *compressWriter) WriteHeaderNow() {
+	if !w.decided {
+		_ = w.settle()
+	}
+	w.ResponseWriter.WriteHeaderNow()
+}
+
+// Write buffers data until the encoding is decided, then writes it through.
+func (w *compressWriter) Write(data []byte) (int, error) {
+	if !w.decided {
+		if w.acceptsGzip && w.mayCompress() {
+			w.buf = append(w.buf, data...)
+			if len(w.buf) < w.minSize {
+				return len(data), nil
+			}
+			w.decide(true)
+			return len(data), w.flushBuffer()
+		}
+		if err := w.settle(); err != nil {
+			return 0, err
+		}
+	}
+	if w.gz != nil {
+		return w.gz.Write(data)
+	}
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString writes s like Write.
+func (w *compressWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Status returns the status code to send.
+func (w *compressWriter) Status() int {
+	if !w.decided && w.status != 0 {
+		return w.status
+	}
+	return w.ResponseWriter.Status()
+}
+
+// Size returns the number of body bytes written by the handler so far, or -1 if none were.
+func (w *compressWriter) Size() int {
+	if !w.decided && len(w.buf) > 0 {
+		return len(w.buf)
+	}
+	return w.ResponseWriter.Size()
+}
+
+// Written reports whether the handler has written a response.
+func (w *compressWriter) Written() bool {
+	return len(w.buf) > 0 || w.ResponseWriter.Written()
+}
+
+// Flush sends what has been written so far, deciding the encoding from it if still undecided.
+func (w *compressWriter) Flush() {
+	if !w.decided {
+		_ = w.settle()
+	}
+	if w.gz != nil {
+		_ = w.gz.Flush()
+	}
+	w.ResponseWriter.Flush()
+}
+
+// Unwrap returns the underlying writer, for http.ResponseController.
+func (w *compressWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// finish sends a response the handler left undecided and completes the gzip stream.
+func (w *compressWriter) finish() {
+	if !w.decided {
+		if w.status == 0 && len(w.buf) == 0 {
+			return
+		}
+		_ = w.settle()
+	}
+	if w.gz != nil {
+		_ = w.gz.Close()
+		w.gz.Reset(nil)
+		gzipWriterPool.Put(w.gz)
+		w.gz = nil
+	}
+}
+
+// mayCompress reports whether the response could be gzipped if it is large enough.
+func (w *compressWriter) mayCompress() bool {
+	status := w.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	header := w.ResponseWriter.Header()
+	return !w.head &&
+		status >= http.StatusOK &&
+		status != http.StatusNoContent &&
+		status != http.StatusNotModified &&
+		header.Get("Content-Encoding") == "" &&
+		compressible(header.Get("Content-Type"))
+}
+
+// decide fixes the encoding of the response and sends its header.
+func (w *compressWriter) decide(compress bool) {
+	w.decided = true
+	header := w.ResponseWriter.Header()
+	if w.mayCompress() {
+		header.Add("Vary", "Accept-Encoding")
+	}
+	if compress {
+		header.Set("Content-Encoding", "gzip")
+		header.Del("Content-Length")
+		// The compressed body is no longer byte-for-byte the one a strong ETag identifies
+		if etag := header.Get("ETag"); etag != "" && !strings.HasPrefix(etag, "W/") {
+			header.Set("ETag", "W/"+etag)
+		}
+		gz, _ := gzipWriterPool.Get().(*gzip.Writer)
+		gz.Reset(w.ResponseWriter)
+		w.gz = gz
+	}
+	if w.status != 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+}
+
+// settle decides the encoding from what has been written so far and writes it through.
+func (w *compressWriter) settle() error {
+	w.decide(w.acceptsGzip && w.mayCompress() && len(w.buf) >= w.minSize)
+	return w.flushBuffer()
+}
+
+// flushBuffer writes the buffered start of the body through the chosen encoding.
+func (w *compressWriter) flushBuffer() error {
+	if len(w.buf) == 0 {
+		return nil
+	}
+	buf := w.buf
+	w.buf = nil
+	var err error
+	if w.gz != nil {
+		_, err = w.gz.Write(buf)
+	} else {
+		_, err = w.ResponseWriter.Write(buf)
+	}
+	return err
+}
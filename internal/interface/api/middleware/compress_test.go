@@ -0,0 +1,155 @@
+package middleware_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Compress", func() {
+	const minSize = 1024
+
+	var (
+		router    *gin.Engine
+		largeJSON string
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		router = gin.New()
+		router.Use(middleware.Compress(minSize))
+		largeJSON = `{"data":"` + strings.Repeat("a", 2*minSize) + `"}`
+	})
+
+	get := func(acceptEncoding string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/resource", nil)
+		if acceptEncoding != "" {
+			req.Header.Set("Accept-Encoding", acceptEncoding)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	gunzip := func(body *bytes.Buffer) string {
+		reader, err := gzip.NewReader(body)
+		Expect(err).NotTo(HaveOccurred())
+		data, err := io.ReadAll(reader)
+		Expect(err).NotTo(HaveOccurred())
+		return string(data)
+	}
+
+	When("the client accepts gzip", func() {
+		It("should gzip a body above the threshold", func() {
+			router.GET("/resource", func(c *gin.Context) {
+				c.Header("ETag", `"v1"`)
+				c.Data(http.StatusCreated, "application/json", []byte(largeJSON))
+			})
+
+			w := get("br, gzip;q=0.8")
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+			Expect(w.Header().Get("Content-Encoding")).To(Equal("gzip"))
+			Expect(w.Header().Values("Vary")).To(ContainElement("Accept-Encoding"))
+			Expect(w.Header().Get("ETag")).To(Equal(`W/"v1"`))
+			Expect(w.Body.Len()).To(BeNumerically("<", len(largeJSON)))
+			Expect(gunzip(w.Body)).To(Equal(largeJSON))
+		})
+
+		It("should gzip a body written in small pieces", func() {
+			router.GET("/resource", func(c *gin.Context) {
+				c.Header("Content-Type", "text/csv")
+				for range 100 {
+					_, _ = c.Writer.WriteString("name,email,status\n")
+				}
+			})
+
+			w := get("gzip")
+
+			Expect(w.Header().Get("Content-Encoding")).To(Equal("gzip"))
+			Expect(gunzip(w.Body)).To(Equal(strings.Repeat("name,email,status\n", 100)))
+		})
+
+		It("should send a body below the threshold as is", func() {
+			router.GET("/resource", func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"ok": true})
+			})
+
+			w := get("gzip")
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("Content-Encoding")).To(BeEmpty())
+			Expect(w.Header().Values("Vary")).To(ContainElement("Accept-Encoding"))
+			Expect(w.Body.String()).To(MatchJSON(`{"ok": true}`))
+		})
+
+		DescribeTable("should send already compressed content as is",
+			func(contentType string) {
+				body := bytes.Repeat([]byte{0x89}, 2*minSize)
+				router.GET("/resource", func(c *gin.Context) {
+					c.Data(http.StatusOK, contentType, body)
+				})
+
+				w := get("gzip")
+
+				Expect(w.Header().Get("Content-Encoding")).To(BeEmpty())
+				Expect(w.Header().Values("Vary")).To(BeEmpty())
+				Expect(w.Body.Bytes()).To(Equal(body))
+			},
+			Entry("PNG QR codes", "image/png"),
+			Entry("SVG QR codes", "image/svg+xml"),
+			Entry("wallet passes", "application/vnd.apple.pkpass"),
+		)
+
+		It("should keep a status set without a body", func() {
+			router.GET("/resource", func(c *gin.Context) {
+				c.Status(http.StatusNoContent)
+			})
+
+			w := get("gzip")
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(w.Header().Get("Content-Encoding")).To(BeEmpty())
+			Expect(w.Body.Len()).To(BeZero())
+		})
+
+		It("should send flushed event streams as is", func() {
+			router.GET("/resource", func(c *gin.Context) {
+				c.Header("Content-Type", "text/event-stream")
+				c.Status(http.StatusOK)
+				c.Writer.Flush()
+				c.SSEvent("checkin", largeJSON)
+			})
+
+			w := get("gzip")
+
+			Expect(w.Flushed).To(BeTrue())
+			Expect(w.Header().Get("Content-Encoding")).To(BeEmpty())
+			Expect(w.Body.String()).To(ContainSubstring("event:checkin"))
+		})
+	})
+
+	When("the client does not accept gzip", func() {
+		It("should send the body as is with Vary", func() {
+			router.GET("/resource", func(c *gin.Context) {
+				c.Data(http.StatusOK, "application/json", []byte(largeJSON))
+			})
+
+			for _, acceptEncoding := range []string{"", "identity", "gzip;q=0, *"} {
+				w := get(acceptEncoding)
+
+				Expect(w.Header().Get("Content-Encoding")).To(BeEmpty(), acceptEncoding)
+				Expect(w.Header().Values("Vary")).To(ContainElement("Accept-Encoding"), acceptEncoding)
+				Expect(w.Body.String()).To(Equal(largeJSON), acceptEncoding)
+			}
+		})
+	})
+})
@@ -2,6 +2,7 @@ package middleware
 
 import (
 	"net/http"
+	"net/url"
 	"strings"
 
 	"github.com/fumkob/ezqrin-server/config"
@@ -10,61 +11,90 @@ import (
 
 // CORS is a middleware that handles Cross-Origin Resource Sharing (CORS).
 // It configures allowed origins, methods, headers, and credentials based
-// on the provided configuration.
+// on the provided configuration, and answers preflight requests with 204.
+// Credentials are never allowed for an origin matched by the "*" wildcard.
 func CORS(cfg *config.CORSConfig) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		origin := c.Request.Header.Get("Origin")
+		applyCORS(c, cfg)
 
-		// Check if origin is allowed
-		if origin != "" && isOriginAllowed(origin, cfg.AllowedOrigins) {
-			c.Header("Access-Control-Allow-Origin", origin)
-		} else if len(cfg.AllowedOrigins) == 1 && cfg.AllowedOrigins[0] == "*" {
-			// Allow all origins if configured with wildcard
-			c.Header("Access-Control-Allow-Origin", "*")
+		// Handle preflight requests
+		if c.Request.Method == http.MethodOptions {
+			c.AbortWithStatus(http.StatusNoContent)
+			return
 		}
 
-		// Set allowed methods
-		if len(cfg.AllowedMethods) > 0 {
-			c.Header("Access-Control-Allow-Methods", strings.Join(cfg.AllowedMethods, ", "))
-		}
+		c.Next()
+	}
+}
 
-		// Set allowed headers
-		if len(cfg.AllowedHeaders) > 0 {
-			c.Header("Access-Control-Allow-Headers", strings.Join(cfg.AllowedHeaders, ", "))
+// CORSPreflight answers preflight requests for a path served under several CORS policies,
+// keyed by method: the policy of the method named in Access-Control-Request-Method applies.
+// Preflights for a method without a policy get no CORS headers, so browsers refuse them.
+func CORSPreflight(policies map[string]*config.CORSConfig) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if cfg, ok := policies[c.GetHeader("Access-Control-Request-Method")]; ok {
+			applyCORS(c, cfg)
 		}
+		c.AbortWithStatus(http.StatusNoContent)
+	}
+}
+
+// applyCORS sets the CORS response headers of cfg for the request's origin.
+func applyCORS(c *gin.Context, cfg *config.CORSConfig) {
+	origin := c.Request.Header.Get("Origin")
+	c.Writer.Header().Add("Vary", "Origin")
 
+	// Check if origin is allowed
+	if origin != "" && isOriginAllowed(origin, cfg.AllowedOrigins) {
+		c.Header("Access-Control-Allow-Origin", origin)
 		// Set allow credentials
 		if cfg.AllowCredentials {
 			c.Header("Access-Control-Allow-Credentials", "true")
 		}
+	} else if containsWildcard(cfg.AllowedOrigins) {
+		// Allow all origins if configured with wildcard, which browsers refuse with credentials
+		c.Header("Access-Control-Allow-Origin", "*")
+	}
 
-		// Set max age for preflight cache (24 hours)
-		c.Header("Access-Control-Max-Age", "86400")
-
-		// Handle preflight requests
-		if c.Request.Method == http.MethodOptions {
-			c.AbortWithStatus(http.StatusNoContent)
-			return
-		}
+	// Set allowed methods
+	if len(cfg.AllowedMethods) > 0 {
+		c.Header("Access-Control-Allow-Methods", strings.Join(cfg.AllowedMethods, ", "))
+	} else if method := c.GetHeader("Access-Control-Request-Method"); method != "" {
+		c.Header("Access-Control-Allow-Methods", method)
+	}
 
-		c.Next()
+	// Set allowed headers
+	if len(cfg.AllowedHeaders) > 0 {
+		c.Header("Access-Control-Allow-Headers", strings.Join(cfg.AllowedHeaders, ", "))
+	} else if headers := c.GetHeader("Access-Control-Request-Headers"); headers != "" {
+		c.Header("Access-Control-Allow-Headers", headers)
 	}
+
+	// Set max age for preflight cache (24 hours)
+	c.Header("Access-Control-Max-Age", "86400")
 }
 
-// isOriginAllowed checks if the given origin is in the list of allowed origins.
-// It supports wildcard matching for subdomains (e.g., "*.example.com").
-func isOriginAllowed(origin string, allowedOrigins []string) bool {
+// containsWildcard reports whether allowedOrigins allows every origin.
+func containsWildcard(allowedOrigins []string) bool {
 	for _, allowed := range allowedOrigins {
 		if allowed == "*" {
 			return true
 		}
+	}
+	return false
+}
+
+// isOriginAllowed checks if the given origin is in the list of allowed origins.
+// It supports wildcard matching for subdomains (e.g., "*.example.com"); the "*" wildcard
+// is left to the caller, as the origin must not be echoed for it.
+func isOriginAllowed(origin string, allowedOrigins []string) bool {
+	for _, allowed := range allowedOrigins {
 		if allowed == origin {
 			return true
 		}
 		// Support wildcard subdomain matching (e.g., "*.example.com")
-		if strings.HasPrefix(allowed, "*.") {
-			domain := allowed[2:] // Remove "*."
-			if strings.HasSuffix(origin, domain) {
+		if domain, ok := strings.CutPrefix(allowed, "*."); ok {
+			if u, err := url.Parse(origin); err == nil && strings.HasSuffix(u.Hostname(), "."+domain) {
 				return true
 			}
 		}
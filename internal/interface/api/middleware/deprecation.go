@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	// DeprecationHeader marks a response of a deprecated endpoint
+	DeprecationHeader = "Deprecation"
+	// SunsetHeader announces when a deprecated endpoint will be removed (RFC 8594)
+	SunsetHeader = "Sunset"
+	// LinkHeader points to the migration documentation of a deprecated endpoint
+	LinkHeader = "Link"
+)
+
+// Deprecation is a middleware for legacy routes. It announces the deprecation to clients
+// through the Deprecation, Sunset and Link headers, and logs every call with the route and
+// the calling user so that owners can track the migration off the route.
+// The headers are set before the handler runs, so error responses carry them too.
+func Deprecation(sunset time.Time, link string, log *logger.Logger) gin.HandlerFunc {
+	sunsetValue := sunset.UTC().Format(http.TimeFormat)
+	linkValue := ""
+	if link != "" {
+		linkValue = "<" + link + `>; rel="deprecation"; type="text/html"`
+	}
+
+	return func(c *gin.Context) {
+		c.Header(DeprecationHeader, "true")
+		c.Header(SunsetHeader, sunsetValue)
+		if linkValue != "" {
+			c.Header(LinkHeader, linkValue)
+		}
+
+		c.Next()
+
+		// The user is only known once authentication has run inside the route
+		fields := []zap.Field{
+			zap.String("method", c.Request.Method),
+			zap.String("route", c.FullPath()),
+			zap.String("sunset", sunsetValue),
+		}
+		if userID, ok := GetUserID(c); ok {
+			fields = append(fields, zap.String("user_id", userID.String()))
+		}
+		log.WithContext(c.Request.Context()).Info("deprecated endpoint called", fields...)
+	}
+}
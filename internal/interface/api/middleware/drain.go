@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/gin-gonic/gin"
+)
+
+// contextKeyShutdown is the key of the channel closed when the server starts draining
+const contextKeyShutdown = "shutdown"
+
+// Drain tracks the requests in flight so that shutdown can wait for them to finish.
+// Once Start is called, new requests are answered with 503 and long-lived handlers such as
+// event streams are told to finish through ShutdownSignal.
+type Drain struct {
+	mu       sync.Mutex
+	draining bool
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	inFlight atomic.Int64
+	waiters  []func(ctx context.Context) error
+}
+
+// NewDrain creates a Drain that accepts requests until Start is called.
+func NewDrain() *Drain {
+	return &Drain{shutdown: make(chan struct{})}
+}
+
+// Middleware tracks each request until its handler returns, or rejects it with 503 once
+// the server is draining.
+func (d *Drain) Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		d.mu.Lock()
+		if d.draining {
+			d.mu.Unlock()
+			c.Header("Connection", "close")
+			response.ProblemFromError(c, apperrors.ServiceUnavailable("server is shutting down"))
+			c.Abort()
+			return
+		}
+		d.wg.Add(1)
+		d.inFlight.Add(1)
+		d.mu.Unlock()
+
+		defer func() {
+			d.inFlight.Add(-1)
+			d.wg.Done()
+		}()
+		c.Set(contextKeyShutdown, d.shutdown)
+		c.Next()
+	}
+}
+
+// Start begins draining: later requests are rejected and ShutdownSignal channels are closed.
+// Calling it again has no effect.
+func (d *Drain) Start() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if !d.draining {
+		d.draining = true
+		close(d.shutdown)
+	}
+}
+
+// WaitFor adds background work that Wait also waits for once the requests in flight have
+// finished, such as writes that handlers leave running after answering. wait must block until
+// the work is done or ctx is done, returning ctx's error in the latter case.
+func (d *Drain) WaitFor(wait func(ctx context.Context) error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.waiters = append(d.waiters, wait)
+}
+
+// Wait blocks until every tracked request and the work added with WaitFor has finished or ctx
+// is done, returning ctx's error in the latter case. It is meant to be called after Start.
+func (d *Drain) Wait(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	d.mu.Lock()
+	waiters := d.waiters
+	d.mu.Unlock()
+	for _, wait := range waiters {
+		if err := wait(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InFlight returns the number of requests still being handled.
+func (d *Drain) InFlight() int64 {
+	return d.inFlight.Load()
+}
+
+// ShutdownSignal returns a channel that is closed when the server starts draining. Requests
+// not tracked by a Drain get a nil channel, which never fires.
+func ShutdownSignal(c *gin.Context) <-chan struct{} {
+	val, exists := c.Get(contextKeyShutdown)
+	if !exists {
+		return nil
+	}
+	shutdown, _ := val.(chan struct{})
+	return shutdown
+}
@@ -0,0 +1,154 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Drain", func() {
+	var (
+		drain   *middleware.Drain
+		router  *gin.Engine
+		release chan struct{}
+		started chan struct{}
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		drain = middleware.NewDrain()
+		release = make(chan struct{})
+		started = make(chan struct{}, 1)
+		router = gin.New()
+		router.Use(drain.Middleware())
+		router.GET("/ok", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+		router.GET("/slow", func(c *gin.Context) {
+			started <- struct{}{}
+			<-release
+			c.Status(http.StatusOK)
+		})
+		router.GET("/stream", func(c *gin.Context) {
+			started <- struct{}{}
+			<-middleware.ShutdownSignal(c)
+			c.Status(http.StatusOK)
+		})
+	})
+
+	get := func(path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		return w
+	}
+
+	// serveAsync handles a request in the background and waits until its handler runs
+	serveAsync := func(path string) <-chan *httptest.ResponseRecorder {
+		result := make(chan *httptest.ResponseRecorder, 1)
+		go func() { result <- get(path) }()
+		Eventually(started).Should(Receive())
+		return result
+	}
+
+	When("the server is not draining", func() {
+		It("should pass requests to the handler", func() {
+			Expect(get("/ok").Code).To(Equal(http.StatusOK))
+			Expect(drain.InFlight()).To(BeZero())
+		})
+	})
+
+	When("the server is draining", func() {
+		It("should reject new requests with 503 and close the connection", func() {
+			drain.Start()
+
+			w := get("/ok")
+
+			Expect(w.Code).To(Equal(http.StatusServiceUnavailable))
+			Expect(w.Header().Get("Connection")).To(Equal("close"))
+			Expect(w.Header().Get("Content-Type")).To(ContainSubstring("application/problem+json"))
+		})
+
+		It("should wait for the requests in flight to finish", func() {
+			result := serveAsync("/slow")
+			drain.Start()
+			Expect(drain.InFlight()).To(Equal(int64(1)))
+
+			waited := make(chan error, 1)
+			go func() { waited <- drain.Wait(context.Background()) }()
+			Consistently(waited, 50*time.Millisecond).ShouldNot(Receive())
+
+			close(release)
+
+			Eventually(waited).Should(Receive(BeNil()))
+			Expect((<-result).Code).To(Equal(http.StatusOK))
+		})
+
+		It("should stop waiting when the context expires", func() {
+			result := serveAsync("/slow")
+			drain.Start()
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			Expect(drain.Wait(ctx)).To(MatchError(context.DeadlineExceeded))
+
+			close(release)
+			Eventually(result).Should(Receive())
+		})
+
+		It("should wait for the background work added with WaitFor", func() {
+			finished := make(chan struct{})
+			drain.WaitFor(func(ctx context.Context) error {
+				select {
+				case <-finished:
+					return nil
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			})
+			drain.Start()
+
+			waited := make(chan error, 1)
+			go func() { waited <- drain.Wait(context.Background()) }()
+			Consistently(waited, 50*time.Millisecond).ShouldNot(Receive())
+
+			close(finished)
+
+			Eventually(waited).Should(Receive(BeNil()))
+		})
+
+		It("should stop waiting for background work when the context expires", func() {
+			drain.WaitFor(func(ctx context.Context) error {
+				<-ctx.Done()
+				return ctx.Err()
+			})
+			drain.Start()
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			Expect(drain.Wait(ctx)).To(MatchError(context.DeadlineExceeded))
+		})
+
+		It("should signal streaming handlers to finish", func() {
+			result := serveAsync("/stream")
+
+			drain.Start()
+
+			Expect(drain.Wait(context.Background())).To(Succeed())
+			Expect((<-result).Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	Describe("ShutdownSignal", func() {
+		It("should return a nil channel for requests not tracked by a Drain", func() {
+			c, _ := gin.CreateTestContext(httptest.NewRecorder())
+
+			Expect(middleware.ShutdownSignal(c)).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,198 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client's key for a request that must take effect once
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader marks a response replayed for a repeated idempotency key
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	// MaxIdempotencyKeyLength bounds the length of an idempotency key
+	MaxIdempotencyKeyLength = 255
+
+	// idempotencyLockTTL bounds how long a key stays claimed by a request that never finishes,
+	// e.g. because the server stopped while it ran
+	idempotencyLockTTL   = time.Minute
+	idempotencyKeyPrefix = "idempotency:"
+
+	idempotencyInProgressMessage = "a request with this Idempotency-Key is in progress; retry later"
+)
+
+// idempotencyRecord is the cached state of an idempotency key. A pending record claims the key
+// while its first request runs; a completed one holds the response to replay.
+type idempotencyRecord struct {
+	Pending     bool   `json:"pending,omitempty"`
+	Fingerprint string `json:"fingerprint"`
+	Status      int    `json:"status,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	Body        []byte `json:"body,omitempty"`
+}
+
+// Idempotency is a middleware that makes a route safe to retry. The first request with an
+// Idempotency-Key header runs normally and its successful response is cached for ttl; a repeat
+// with the same key gets that response again, with the Idempotent-Replayed header, instead of
+// running the handler a second time. Keys are scoped to the authenticated user and the request
+// path, so that a key reused for another event is not replayed, and the middleware must run after
+// authentication; requests without a key are not affected.
+//
+// A repeat sent while the first request still runs, or a key reused with a different request
+// body, gets 409 Conflict. Failed responses are not cached, so the client may retry them with
+// the same key. If the cache is unreachable the request runs
+// without idempotency rather than failing.
+func Idempotency(cache repository.CacheRepository, ttl time.Duration, log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		userID, authenticated := GetUserID(c)
+		if key == "" || !authenticated {
+			c.Next()
+			return
+		}
+		if len(key) > MaxIdempotencyKeyLength {
+			response.ProblemFromError(c, apperrors.BadRequest("Idempotency-Key header must be at most 255 characters"))
+			c.Abort()
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			response.ProblemFromError(c, BodyTooLarge(maxBytesErr.Limit))
+			c.Abort()
+			return
+		}
+		if err != nil {
+			response.ProblemFromError(c, apperrors.BadRequest("failed to read request body"))
+			c.Abort()
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		cacheKey := idempotencyKeyPrefix + userID.String() + ":" + c.Request.Method + " " + c.Request.URL.Path + ":" + key
+		fingerprint := requestFingerprint(body)
+		reqLog := log.WithContext(ctx).WithFields(zap.String("route", c.FullPath()))
+
+		pending, err := json.Marshal(idempotencyRecord{Pending: true, Fingerprint: fingerprint})
+		if err != nil {
+			reqLog.Error("failed to encode idempotency record", zap.Error(err))
+			c.Next()
+			return
+		}
+		claimed, err := cache.SetIfNotExists(ctx, cacheKey, string(pending), idempotencyLockTTL)
+		if err != nil {
+			reqLog.Warn("idempotency key store unavailable, running request without it", zap.Error(err))
+			c.Next()
+			return
+		}
+		if !claimed {
+			replayIdempotentResponse(c, cache, cacheKey, fingerprint, reqLog)
+			return
+		}
+
+		recorder := &recordingWriter{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+
+		// The request context may have been cancelled by now, e.g. by a timeout after the
+		// resource was created; the outcome must still be recorded
+		storeCtx := context.WithoutCancel(ctx)
+		status := recorder.Status()
+		if status < http.StatusOK || status >= http.StatusMultipleChoices {
+			if err := cache.Delete(storeCtx, cacheKey); err != nil {
+				reqLog.Warn("failed to release idempotency key", zap.Error(err))
+			}
+			return
+		}
+
+		completed, err := json.Marshal(idempotencyRecord{
+			Fingerprint: fingerprint,
+			Status:      status,
+			ContentType: recorder.Header().Get("Content-Type"),
+			Body:        recorder.body.Bytes(),
+		})
+		if err == nil {
+			err = cache.Set(storeCtx, cacheKey, string(completed), ttl)
+		}
+		if err != nil {
+			reqLog.Error("failed to store idempotent response", zap.Error(err))
+		}
+	}
+}
+
+// replayIdempotentResponse answers a request whose idempotency key was already claimed
+func replayIdempotentResponse(
+	c *gin.Context,
+	cache repository.CacheRepository,
+	cacheKey string,
+	fingerprint string,
+	log *logger.Logger,
+) {
+	defer c.Abort()
+
+	stored, err := cache.Get(c.Request.Context(), cacheKey)
+	if err != nil {
+		log.Error("failed to read idempotency record", zap.Error(err))
+		response.ProblemFromError(c, apperrors.ServiceUnavailable("failed to check the Idempotency-Key"))
+		return
+	}
+
+	var record idempotencyRecord
+	// The first request finished and released the key between the claim and this read
+	if stored == "" || json.Unmarshal([]byte(stored), &record) != nil {
+		response.ProblemFromError(c, apperrors.Conflict(idempotencyInProgressMessage))
+		return
+	}
+	if record.Fingerprint != fingerprint {
+		response.ProblemFromError(c, apperrors.Conflict("Idempotency-Key was already used for a different request"))
+		return
+	}
+	if record.Pending {
+		response.ProblemFromError(c, apperrors.Conflict(idempotencyInProgressMessage))
+		return
+	}
+
+	c.Header(IdempotentReplayedHeader, "true")
+	c.Data(record.Status, record.ContentType, record.Body)
+}
+
+// requestFingerprint identifies the request body sent with an idempotency key
+func requestFingerprint(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// recordingWriter passes the response through while keeping a copy of its body
+type recordingWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write writes data to the response and the copy.
+func (w *recordingWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// WriteString writes s to the response and the copy.
+func (w *recordingWriter) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
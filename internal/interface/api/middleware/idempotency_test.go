@@ -0,0 +1,305 @@
+package middleware_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+)
+
+// memoryCache is an in-memory repository.CacheRepository; TTLs are ignored
+type memoryCache struct {
+	mu     sync.Mutex
+	values map[string]string
+	err    error
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{values: map[string]string{}}
+}
+
+func (m *memoryCache) Get(_ context.Context, key string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.values[key], m.err
+}
+
+func (m *memoryCache) GetAndDelete(_ context.Context, key string) (string, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	value := m.values[key]
+	delete(m.values, key)
+	return value, m.err
+}
+
+func (m *memoryCache) Set(_ context.Context, key, value string, _ time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values[key] = value
+	return m.err
+}
+
+func (m *memoryCache) SetIfNotExists(_ context.Context, key, value string, _ time.Duration) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return false, m.err
+	}
+	if _, ok := m.values[key]; ok {
+		return false, nil
+	}
+	m.values[key] = value
+	return true, nil
+}
+
+func (m *memoryCache) Increment(_ context.Context, key string, _ time.Duration) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.err != nil {
+		return 0, m.err
+	}
+	count, _ := strconv.ParseInt(m.values[key], 10, 64)
+	count++
+	m.values[key] = strconv.FormatInt(count, 10)
+	return count, nil
+}
+
+func (m *memoryCache) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.values, key)
+	return m.err
+}
+
+func (m *memoryCache) Exists(_ context.Context, key string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	_, ok := m.values[key]
+	return ok, m.err
+}
+
+func (m *memoryCache) MGet(context.Context, []string) (map[string]string, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *memoryCache) MSet(context.Context, map[string]string, time.Duration) error {
+	return errors.New("not implemented")
+}
+
+func (m *memoryCache) Ping(context.Context) error { return m.err }
+
+var _ = Describe("Idempotency", func() {
+	const body = `{"name":"Tech Conference"}`
+
+	var (
+		router  *gin.Engine
+		cache   *memoryCache
+		userID  uuid.UUID
+		created int
+		status  int
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		cache = newMemoryCache()
+		userID = uuid.New()
+		created = 0
+		status = http.StatusCreated
+
+		router = gin.New()
+		router.POST("/events",
+			func(c *gin.Context) {
+				if id := c.GetHeader("X-User-ID"); id != "" {
+					c.Set(middleware.ContextKeyUserID, uuid.MustParse(id))
+				}
+			},
+			middleware.Idempotency(cache, time.Minute, &logger.Logger{Logger: zap.NewNop()}),
+			func(c *gin.Context) {
+				created++
+				c.JSON(status, gin.H{"id": uuid.New()})
+			},
+		)
+		router.POST("/events/:id/participants",
+			func(c *gin.Context) { c.Set(middleware.ContextKeyUserID, userID) },
+			middleware.Idempotency(cache, time.Minute, &logger.Logger{Logger: zap.NewNop()}),
+			func(c *gin.Context) {
+				created++
+				c.JSON(http.StatusCreated, gin.H{"id": uuid.New()})
+			},
+		)
+	})
+
+	postAs := func(user uuid.UUID, key, reqBody string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/events", strings.NewReader(reqBody))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-User-ID", user.String())
+		if key != "" {
+			req.Header.Set(middleware.IdempotencyKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	post := func(key, reqBody string) *httptest.ResponseRecorder {
+		return postAs(userID, key, reqBody)
+	}
+
+	createdID := func(w *httptest.ResponseRecorder) string {
+		var resp struct {
+			ID string `json:"id"`
+		}
+		Expect(json.Unmarshal(w.Body.Bytes(), &resp)).To(Succeed())
+		return resp.ID
+	}
+
+	When("a request is repeated with the same key", func() {
+		It("should replay the first response without running the handler again", func() {
+			first := post("key-1", body)
+			Expect(first.Code).To(Equal(http.StatusCreated))
+			Expect(first.Header().Get(middleware.IdempotentReplayedHeader)).To(BeEmpty())
+
+			replay := post("key-1", body)
+
+			Expect(replay.Code).To(Equal(http.StatusCreated))
+			Expect(replay.Header().Get(middleware.IdempotentReplayedHeader)).To(Equal("true"))
+			Expect(replay.Header().Get("Content-Type")).To(Equal(first.Header().Get("Content-Type")))
+			Expect(createdID(replay)).To(Equal(createdID(first)))
+			Expect(replay.Body.String()).To(Equal(first.Body.String()))
+			Expect(created).To(Equal(1))
+		})
+	})
+
+	When("requests use different keys", func() {
+		It("should run the handler for each of them", func() {
+			first := post("key-1", body)
+			second := post("key-2", body)
+
+			Expect(createdID(second)).NotTo(Equal(createdID(first)))
+			Expect(created).To(Equal(2))
+		})
+	})
+
+	When("another user sends the same key", func() {
+		It("should not replay the first user's response", func() {
+			first := post("key-1", body)
+			other := postAs(uuid.New(), "key-1", body)
+
+			Expect(other.Code).To(Equal(http.StatusCreated))
+			Expect(createdID(other)).NotTo(Equal(createdID(first)))
+			Expect(created).To(Equal(2))
+		})
+	})
+
+	When("the same key is sent for different resources of a route", func() {
+		It("should not replay the response of the other resource", func() {
+			postTo := func(path string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+				req.Header.Set(middleware.IdempotencyKeyHeader, "key-1")
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+				return w
+			}
+
+			first := postTo("/events/event-1/participants")
+			other := postTo("/events/event-2/participants")
+
+			Expect(other.Header().Get(middleware.IdempotentReplayedHeader)).To(BeEmpty())
+			Expect(createdID(other)).NotTo(Equal(createdID(first)))
+			Expect(created).To(Equal(2))
+		})
+	})
+
+	When("requests have no key", func() {
+		It("should run the handler for each of them", func() {
+			post("", body)
+			post("", body)
+
+			Expect(created).To(Equal(2))
+		})
+	})
+
+	When("a key is reused with a different body", func() {
+		It("should return 409 Conflict", func() {
+			post("key-1", body)
+
+			w := post("key-1", `{"name":"Other Conference"}`)
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+			Expect(created).To(Equal(1))
+		})
+	})
+
+	When("the first request with the key is still running", func() {
+		It("should return 409 Conflict", func() {
+			blocked := make(chan struct{})
+			release := make(chan struct{})
+			router = gin.New()
+			router.POST("/events",
+				func(c *gin.Context) { c.Set(middleware.ContextKeyUserID, userID) },
+				middleware.Idempotency(cache, time.Minute, &logger.Logger{Logger: zap.NewNop()}),
+				func(c *gin.Context) {
+					close(blocked)
+					<-release
+					c.JSON(http.StatusCreated, gin.H{"id": uuid.New()})
+				},
+			)
+
+			done := make(chan *httptest.ResponseRecorder)
+			go func() { done <- post("key-1", body) }()
+			<-blocked
+
+			w := post("key-1", body)
+			close(release)
+
+			Expect(w.Code).To(Equal(http.StatusConflict))
+			Expect((<-done).Code).To(Equal(http.StatusCreated))
+		})
+	})
+
+	When("the first request fails", func() {
+		It("should run the handler again for a retry with the same key", func() {
+			status = http.StatusInternalServerError
+			Expect(post("key-1", body).Code).To(Equal(http.StatusInternalServerError))
+
+			status = http.StatusCreated
+			w := post("key-1", body)
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+			Expect(w.Header().Get(middleware.IdempotentReplayedHeader)).To(BeEmpty())
+			Expect(created).To(Equal(2))
+		})
+	})
+
+	When("the key is too long", func() {
+		It("should return 400 Bad Request", func() {
+			w := post(strings.Repeat("k", middleware.MaxIdempotencyKeyLength+1), body)
+
+			Expect(w.Code).To(Equal(http.StatusBadRequest))
+			Expect(created).To(BeZero())
+		})
+	})
+
+	When("the cache is unavailable", func() {
+		It("should run the request without idempotency", func() {
+			cache.err = errors.New("connection refused")
+
+			Expect(post("key-1", body).Code).To(Equal(http.StatusCreated))
+			Expect(post("key-1", body).Code).To(Equal(http.StatusCreated))
+			Expect(created).To(Equal(2))
+		})
+	})
+})
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"github.com/fumkob/ezqrin-server/pkg/i18n"
+	"github.com/gin-gonic/gin"
+)
+
+// ContentLanguageHeader is the HTTP header announcing the locale of the response
+const ContentLanguageHeader = "Content-Language"
+
+// Locale is a middleware that negotiates the response locale from the Accept-Language
+// header. Requests without a supported language fall back to defaultLocale.
+// The negotiated locale is stored in the Gin context for the response renderer.
+func Locale(defaultLocale string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		locale := i18n.Negotiate(c.GetHeader("Accept-Language"), defaultLocale)
+
+		c.Set(i18n.ContextKey, locale)
+		c.Header(ContentLanguageHeader, locale)
+
+		c.Next()
+	}
+}
+
+// GetLocale returns the locale negotiated for the request, or i18n.DefaultLocale
+// if the Locale middleware has not run.
+func GetLocale(c *gin.Context) string {
+	if locale := c.GetString(i18n.ContextKey); locale != "" {
+		return locale
+	}
+	return i18n.DefaultLocale
+}
@@ -0,0 +1,67 @@
+package middleware
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	// metricsNamespace prefixes the names of the HTTP metrics
+	metricsNamespace = "ezqrin"
+
+	// unmatchedRoute labels requests that matched no route, so that arbitrary paths
+	// do not each create a new time series
+	unmatchedRoute = "unmatched"
+)
+
+// HTTP metrics are registered once with the default Prometheus registry, which is served by
+// promhttp.Handler.
+var (
+	httpRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_requests_total",
+		Help:      "Number of HTTP requests handled, by method, route and status code.",
+	}, []string{"method", "route", "status"})
+
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Duration of HTTP requests in seconds, by method, route and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	httpRequestsInFlight = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "http_requests_in_flight",
+		Help:      "Number of HTTP requests being handled, by method and route.",
+	}, []string{"method", "route"})
+)
+
+// Metrics is a middleware that records the count, duration and in-flight number of HTTP requests
+// as Prometheus metrics. Requests are labelled by the route pattern (e.g. /api/v1/events/:id)
+// rather than the raw path, to keep the number of time series bounded. The status code is not
+// known while a request is in flight, so the in-flight gauge is labelled by method and route only.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		method := c.Request.Method
+
+		inFlight := httpRequestsInFlight.WithLabelValues(method, route)
+		inFlight.Inc()
+		defer inFlight.Dec()
+
+		startTime := time.Now()
+		c.Next()
+
+		status := strconv.Itoa(c.Writer.Status())
+		httpRequestsTotal.WithLabelValues(method, route, status).Inc()
+		httpRequestDuration.WithLabelValues(method, route, status).Observe(time.Since(startTime).Seconds())
+	}
+}
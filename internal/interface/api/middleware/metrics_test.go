@@ -0,0 +1,71 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+var _ = Describe("Metrics", func() {
+	var router *gin.Engine
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		router = gin.New()
+		router.Use(middleware.Metrics())
+		router.GET("/metrics", gin.WrapH(promhttp.Handler()))
+	})
+
+	// scrape returns the metrics served by the default registry
+	scrape := func() string {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+		Expect(w.Code).To(Equal(http.StatusOK))
+		return w.Body.String()
+	}
+
+	When("a request matches a route", func() {
+		It("should label the metrics with the route pattern instead of the path", func() {
+			router.GET("/metrics-test/items/:id", func(c *gin.Context) {
+				c.Status(http.StatusCreated)
+			})
+
+			for _, path := range []string{"/metrics-test/items/1", "/metrics-test/items/2"} {
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+				Expect(w.Code).To(Equal(http.StatusCreated))
+			}
+
+			metrics := scrape()
+			Expect(metrics).To(ContainSubstring(
+				`ezqrin_http_requests_total{method="GET",route="/metrics-test/items/:id",status="201"} 2`,
+			))
+			Expect(metrics).To(ContainSubstring(
+				`ezqrin_http_request_duration_seconds_count{method="GET",route="/metrics-test/items/:id",status="201"} 2`,
+			))
+			Expect(metrics).To(ContainSubstring(
+				`ezqrin_http_requests_in_flight{method="GET",route="/metrics-test/items/:id"} 0`,
+			))
+			Expect(metrics).NotTo(ContainSubstring("/metrics-test/items/1"))
+		})
+	})
+
+	When("a request matches no route", func() {
+		It("should label the metrics as unmatched", func() {
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, "/metrics-test/unknown", nil))
+			Expect(w.Code).To(Equal(http.StatusNotFound))
+
+			metrics := scrape()
+			Expect(metrics).To(ContainSubstring(
+				`ezqrin_http_requests_total{method="DELETE",route="unmatched",status="404"} 1`,
+			))
+			Expect(metrics).NotTo(ContainSubstring("/metrics-test/unknown"))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 
 	"github.com/fumkob/ezqrin-server/config"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/i18n"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
 	"github.com/gin-gonic/gin"
 	. "github.com/onsi/ginkgo/v2"
@@ -123,6 +124,91 @@ var _ = Describe("Middleware", func() {
 				Expect(w.Code).To(Equal(http.StatusNoContent))
 			})
 		})
+
+		When("request from any origin under a wildcard", func() {
+			It("should not allow credentials", func() {
+				corsConfig := &config.CORSConfig{
+					AllowedOrigins:   []string{"*"},
+					AllowCredentials: true,
+				}
+
+				router.Use(middleware.CORS(corsConfig))
+				router.GET("/test", func(c *gin.Context) {
+					c.Status(http.StatusOK)
+				})
+
+				req := httptest.NewRequest(http.MethodGet, "/test", nil)
+				req.Header.Set("Origin", "https://widget.example.org")
+				w := httptest.NewRecorder()
+
+				router.ServeHTTP(w, req)
+
+				Expect(w.Header().Get("Access-Control-Allow-Origin")).To(Equal("*"))
+				Expect(w.Header().Get("Access-Control-Allow-Credentials")).To(BeEmpty())
+			})
+		})
+
+		When("request from a subdomain wildcard", func() {
+			It("should allow subdomains only", func() {
+				corsConfig := &config.CORSConfig{AllowedOrigins: []string{"*.example.com"}}
+
+				router.Use(middleware.CORS(corsConfig))
+				router.GET("/test", func(c *gin.Context) {
+					c.Status(http.StatusOK)
+				})
+
+				for origin, allowed := range map[string]bool{
+					"https://admin.example.com": true,
+					"https://evilexample.com":   false,
+				} {
+					req := httptest.NewRequest(http.MethodGet, "/test", nil)
+					req.Header.Set("Origin", origin)
+					w := httptest.NewRecorder()
+
+					router.ServeHTTP(w, req)
+
+					if allowed {
+						Expect(w.Header().Get("Access-Control-Allow-Origin")).To(Equal(origin))
+					} else {
+						Expect(w.Header().Get("Access-Control-Allow-Origin")).To(BeEmpty(), origin)
+					}
+				}
+			})
+		})
+	})
+
+	Describe("CORSPreflight", func() {
+		It("should answer under the policy of the requested method", func() {
+			router.OPTIONS("/test", middleware.CORSPreflight(map[string]*config.CORSConfig{
+				http.MethodGet:  {AllowedOrigins: []string{"*"}},
+				http.MethodPost: {AllowedOrigins: []string{"https://admin.example.com"}, AllowCredentials: true},
+			}))
+
+			preflight := func(method string) *httptest.ResponseRecorder {
+				req := httptest.NewRequest(http.MethodOptions, "/test", nil)
+				req.Header.Set("Origin", "https://admin.example.com")
+				req.Header.Set("Access-Control-Request-Method", method)
+				req.Header.Set("Access-Control-Request-Headers", "Content-Type")
+				w := httptest.NewRecorder()
+				router.ServeHTTP(w, req)
+				return w
+			}
+
+			w := preflight(http.MethodPost)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(w.Header().Get("Access-Control-Allow-Origin")).To(Equal("https://admin.example.com"))
+			Expect(w.Header().Get("Access-Control-Allow-Credentials")).To(Equal("true"))
+			Expect(w.Header().Get("Access-Control-Allow-Methods")).To(Equal(http.MethodPost))
+			Expect(w.Header().Get("Access-Control-Allow-Headers")).To(Equal("Content-Type"))
+
+			w = preflight(http.MethodGet)
+			Expect(w.Header().Get("Access-Control-Allow-Origin")).To(Equal("*"))
+			Expect(w.Header().Get("Access-Control-Allow-Credentials")).To(BeEmpty())
+
+			w = preflight(http.MethodDelete)
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(w.Header().Get("Access-Control-Allow-Origin")).To(BeEmpty())
+		})
 	})
 
 	Describe("Recovery", func() {
@@ -162,4 +248,57 @@ var _ = Describe("Middleware", func() {
 			})
 		})
 	})
+
+	Describe("Locale", func() {
+		serve := func(defaultLocale, acceptLanguage string) (string, *httptest.ResponseRecorder) {
+			var locale string
+			router.Use(middleware.Locale(defaultLocale))
+			router.GET("/test", func(c *gin.Context) {
+				locale = middleware.GetLocale(c)
+				c.JSON(http.StatusOK, gin.H{"ok": true})
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			if acceptLanguage != "" {
+				req.Header.Set("Accept-Language", acceptLanguage)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+			return locale, w
+		}
+
+		When("the client prefers a supported language", func() {
+			It("should negotiate that locale and announce it in Content-Language", func() {
+				locale, w := serve("en", "ja-JP,ja;q=0.9,en;q=0.8")
+
+				Expect(locale).To(Equal("ja"))
+				Expect(w.Header().Get(middleware.ContentLanguageHeader)).To(Equal("ja"))
+			})
+		})
+
+		When("the client only accepts unsupported languages", func() {
+			It("should fall back to the configured default locale", func() {
+				locale, w := serve("ja", "fr-FR,de;q=0.5")
+
+				Expect(locale).To(Equal("ja"))
+				Expect(w.Header().Get(middleware.ContentLanguageHeader)).To(Equal("ja"))
+			})
+		})
+
+		When("the client sends no Accept-Language header", func() {
+			It("should use the configured default locale", func() {
+				locale, _ := serve("en", "")
+
+				Expect(locale).To(Equal("en"))
+			})
+		})
+
+		When("the configured default locale is unsupported", func() {
+			It("should fall back to the package default locale", func() {
+				locale, _ := serve("xx", "fr")
+
+				Expect(locale).To(Equal(i18n.DefaultLocale))
+			})
+		})
+	})
 })
@@ -0,0 +1,97 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+const (
+	// RetryAfterHeader tells a rate limited client how many seconds to wait before retrying
+	RetryAfterHeader = "Retry-After"
+
+	rateLimitKeyPrefix = "ratelimit:"
+	rateLimitMessage   = "rate limit exceeded; retry later"
+)
+
+// RateLimitOptions contains the caps of a rate limited group of routes. Each cap counts the
+// requests in fixed windows of Window; a zero cap is disabled.
+type RateLimitOptions struct {
+	Scope    string        // Names the counters, so that each group of routes is limited independently
+	PerIP    int           // Maximum requests per client IP per window
+	PerUser  int           // Maximum requests per authenticated user per window
+	PerEvent int           // Maximum requests per event per window, for routes with an event ID
+	Window   time.Duration // Length of a counting window
+
+	// PerAccount is the maximum requests per account per window, for routes naming the account
+	// they act on before it is authenticated, such as the email of a login
+	PerAccount int
+	// Account returns the account a request names, or "" if it names none
+	Account func(c *gin.Context) string
+}
+
+// rateLimitCap is a request counter and the number of requests it allows per window
+type rateLimitCap struct {
+	key   string
+	limit int
+}
+
+// RateLimit is a middleware that caps the requests to a group of routes per client IP, per
+// authenticated user, per account named by the request (see RateLimitOptions.Account) and, for
+// routes with an event ID path parameter, per event. The user is known only once authenticated,
+// so routes limited per user run it after authentication. Requests are counted in fixed windows
+// of opts.Window shared by every server through the cache; once a cap is reached, further
+// requests in the window get 429 Too Many Requests with a Retry-After header until the window
+// ends. If the cache is unreachable the request is let through rather than failing.
+func RateLimit(cache repository.CacheRepository, opts RateLimitOptions, log *logger.Logger) gin.HandlerFunc {
+	prefix := rateLimitKeyPrefix + opts.Scope + ":"
+	return func(c *gin.Context) {
+		now := time.Now()
+		windowStart := now.Truncate(opts.Window)
+		window := strconv.FormatInt(windowStart.Unix(), 10)
+
+		caps := []rateLimitCap{{key: "ip:" + c.ClientIP(), limit: opts.PerIP}}
+		if userID, ok := GetUserID(c); ok {
+			caps = append(caps, rateLimitCap{key: "user:" + userID.String(), limit: opts.PerUser})
+		}
+		if opts.Account != nil {
+			if account := opts.Account(c); account != "" {
+				// Accounts are hashed so that the cache keys do not hold email addresses
+				sum := sha256.Sum256([]byte(account))
+				caps = append(caps, rateLimitCap{key: "account:" + hex.EncodeToString(sum[:]), limit: opts.PerAccount})
+			}
+		}
+		if eventID := c.Param("id"); eventID != "" {
+			caps = append(caps, rateLimitCap{key: "event:" + eventID, limit: opts.PerEvent})
+		}
+
+		for _, limit := range caps {
+			if limit.limit <= 0 {
+				continue
+			}
+			count, err := cache.Increment(c.Request.Context(), prefix+limit.key+":"+window, opts.Window)
+			if err != nil {
+				log.WithContext(c.Request.Context()).Warn("rate limit unavailable", zap.Error(err))
+				break
+			}
+			if count > int64(limit.limit) {
+				retryAfter := windowStart.Add(opts.Window).Sub(now)
+				c.Header(RetryAfterHeader, strconv.FormatInt(int64(math.Ceil(retryAfter.Seconds())), 10))
+				response.ProblemFromError(c, apperrors.TooManyRequests(rateLimitMessage))
+				c.Abort()
+				return
+			}
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,152 @@
+package middleware_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("RateLimit", func() {
+	var (
+		router *gin.Engine
+		cache  *memoryCache
+		limits middleware.RateLimitOptions
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		cache = newMemoryCache()
+		limits = middleware.RateLimitOptions{Scope: "public", PerIP: 3, PerEvent: 5, Window: time.Hour}
+	})
+
+	JustBeforeEach(func() {
+		router = gin.New()
+		ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+		rateLimit := middleware.RateLimit(cache, limits, &logger.Logger{Logger: zap.NewNop()})
+		router.POST("/accept-invite", rateLimit, ok)
+		router.POST("/events/:id/register", rateLimit, ok)
+		router.GET("/users/:user", func(c *gin.Context) {
+			c.Set(middleware.ContextKeyUserID, uuid.MustParse(c.Param("user")))
+		}, rateLimit, ok)
+	})
+
+	postFrom := func(ip, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, path, nil)
+		req.RemoteAddr = ip + ":40000"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	getFrom := func(ip, path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = ip + ":40000"
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	When("a client exceeds the per-IP cap", func() {
+		It("should answer 429 with Retry-After until the window ends, without limiting other clients", func() {
+			for range 3 {
+				Expect(postFrom("192.0.2.1", "/accept-invite").Code).To(Equal(http.StatusOK))
+			}
+
+			w := postFrom("192.0.2.1", "/accept-invite")
+
+			Expect(w.Code).To(Equal(http.StatusTooManyRequests))
+			Expect(w.Body.String()).To(ContainSubstring(apperrors.CodeTooManyRequests))
+			retryAfter, err := strconv.Atoi(w.Header().Get(middleware.RetryAfterHeader))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(retryAfter).To(BeNumerically(">", 0))
+			Expect(retryAfter).To(BeNumerically("<=", int(time.Hour.Seconds())))
+
+			Expect(postFrom("192.0.2.2", "/accept-invite").Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	When("clients together exceed the per-event cap", func() {
+		It("should answer 429 for that event only", func() {
+			for i := range 5 {
+				Expect(postFrom("192.0.2."+strconv.Itoa(i+1), "/events/event-1/register").Code).To(Equal(http.StatusOK))
+			}
+
+			Expect(postFrom("192.0.2.10", "/events/event-1/register").Code).To(Equal(http.StatusTooManyRequests))
+			Expect(postFrom("192.0.2.10", "/events/event-2/register").Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	When("an authenticated user exceeds the per-user cap", func() {
+		BeforeEach(func() { limits = middleware.RateLimitOptions{Scope: "api", PerUser: 2, Window: time.Hour} })
+
+		It("should answer 429 to that user from any client IP", func() {
+			user, other := "/users/"+uuid.NewString(), "/users/"+uuid.NewString()
+			Expect(getFrom("192.0.2.1", user).Code).To(Equal(http.StatusOK))
+			Expect(getFrom("192.0.2.2", user).Code).To(Equal(http.StatusOK))
+
+			Expect(getFrom("192.0.2.3", user).Code).To(Equal(http.StatusTooManyRequests))
+			Expect(getFrom("192.0.2.3", other).Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	When("clients together exceed the per-account cap", func() {
+		BeforeEach(func() {
+			limits = middleware.RateLimitOptions{
+				Scope: "auth", PerAccount: 2, Window: time.Hour,
+				Account: func(c *gin.Context) string { return c.Query("account") },
+			}
+		})
+
+		It("should answer 429 for that account only", func() {
+			Expect(postFrom("192.0.2.1", "/accept-invite?account=alice").Code).To(Equal(http.StatusOK))
+			Expect(postFrom("192.0.2.2", "/accept-invite?account=alice").Code).To(Equal(http.StatusOK))
+
+			Expect(postFrom("192.0.2.3", "/accept-invite?account=alice").Code).To(Equal(http.StatusTooManyRequests))
+			Expect(postFrom("192.0.2.3", "/accept-invite?account=bob").Code).To(Equal(http.StatusOK))
+			Expect(postFrom("192.0.2.3", "/accept-invite").Code).To(Equal(http.StatusOK))
+		})
+	})
+
+	When("two scopes limit the same client", func() {
+		It("should count their requests independently", func() {
+			other := middleware.RateLimit(cache, middleware.RateLimitOptions{Scope: "auth", PerIP: 1, Window: time.Hour},
+				&logger.Logger{Logger: zap.NewNop()})
+			router.POST("/login", other, func(c *gin.Context) { c.Status(http.StatusOK) })
+
+			for range 3 {
+				Expect(postFrom("192.0.2.1", "/accept-invite").Code).To(Equal(http.StatusOK))
+			}
+			Expect(postFrom("192.0.2.1", "/login").Code).To(Equal(http.StatusOK))
+			Expect(postFrom("192.0.2.1", "/login").Code).To(Equal(http.StatusTooManyRequests))
+		})
+	})
+
+	When("the caps are zero", func() {
+		BeforeEach(func() { limits = middleware.RateLimitOptions{Scope: "public", Window: time.Hour} })
+
+		It("should not limit requests", func() {
+			for range 10 {
+				Expect(postFrom("192.0.2.1", "/events/event-1/register").Code).To(Equal(http.StatusOK))
+			}
+		})
+	})
+
+	When("the cache is unreachable", func() {
+		BeforeEach(func() { cache.err = errors.New("connection refused") })
+
+		It("should let the request through", func() {
+			Expect(postFrom("192.0.2.1", "/accept-invite").Code).To(Equal(http.StatusOK))
+		})
+	})
+})
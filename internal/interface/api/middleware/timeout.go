@@ -0,0 +1,218 @@
+package middleware
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// timeoutWriteGrace is the time allowed on top of a route's timeout to write its response
+const timeoutWriteGrace = 5 * time.Second
+
+var errTimeoutHijack = errors.New("timeout: connections of timed routes cannot be hijacked")
+
+// Timeout is a middleware that bounds the time a route may take. The handler runs with a
+// request context that expires after timeout, so database queries and QR generation stop
+// early; if the handler has not finished by then, the client receives a 503 problem+json
+// response right away and anything the handler writes afterwards is discarded.
+// The response of the handler is buffered until it returns, so exactly one response is sent.
+// A non-positive timeout disables the limit.
+func Timeout(timeout time.Duration, log *logger.Logger) gin.HandlerFunc {
+	if timeout <= 0 {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithTimeout(c.Request.Context(), timeout)
+		defer cancel()
+		c.Request = c.Request.WithContext(ctx)
+
+		// Routes allowed to run longer than the server write timeout still need to send their
+		// response; writers that cannot move the deadline (e.g. in tests) keep the server's
+		_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Now().Add(timeout + timeoutWriteGrace))
+
+		// Everything the timeout response needs is captured before the handler starts, as the
+		// context must not be used concurrently with it
+		problem := response.NewProblem(
+			c, http.StatusServiceUnavailable, apperrors.CodeServiceUnavailable, "request timed out",
+		)
+		method, route := c.Request.Method, c.FullPath()
+
+		tw := &timeoutWriter{ResponseWriter: c.Writer, header: c.Writer.Header().Clone()}
+		c.Writer = tw
+
+		done := make(chan struct{})
+		var panicked any
+		go func() {
+			defer close(done)
+			defer func() { panicked = recover() }()
+			c.Next()
+		}()
+
+		select {
+		case <-done:
+		case <-ctx.Done():
+			select {
+			case <-done:
+			default:
+				if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+					tw.timeOut(problem)
+					log.WithContext(ctx).Warn("request timed out",
+						zap.String("method", method),
+						zap.String("route", route),
+						zap.Duration("timeout", timeout),
+					)
+				}
+			}
+			// The handler still holds the context, which gin recycles once this middleware returns
+			<-done
+		}
+
+		c.Writer = tw.ResponseWriter
+		if panicked != nil {
+			// Let Recovery handle it; it will not write over a timeout response
+			panic(panicked)
+		}
+		tw.commit()
+	}
+}
+
+// timeoutWriter buffers the response of a handler running under Timeout. Once the request
+// has timed out, writes of the handler are dropped.
+type timeoutWriter struct {
+	gin.ResponseWriter
+
+	mu       sync.Mutex
+	header   http.Header
+	body     bytes.Buffer
+	status   int
+	written  bool
+	timedOut bool
+}
+
+// Header returns the buffered header map.
+func (w *timeoutWriter) Header() http.Header {
+	return w.header
+}
+
+// WriteHeader records the status code to send.
+func (w *timeoutWriter) WriteHeader(code int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if code > 0 && !w.written {
+		w.status = code
+	}
+}
+
+// WriteHeaderNow marks the header as written.
+func (w *timeoutWriter) WriteHeaderNow() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.written = true
+}
+
+// Write buffers data, or fails with http.ErrHandlerTimeout once the request has timed out.
+func (w *timeoutWriter) Write(data []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	w.written = true
+	return w.body.Write(data)
+}
+
+// WriteString buffers s, or fails with http.ErrHandlerTimeout once the request has timed out.
+func (w *timeoutWriter) WriteString(s string) (int, error) {
+	return w.Write([]byte(s))
+}
+
+// Status returns the recorded status code.
+func (w *timeoutWriter) Status() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.status == 0 {
+		return http.StatusOK
+	}
+	return w.status
+}
+
+// Size returns the number of buffered body bytes, or -1 if nothing was written.
+func (w *timeoutWriter) Size() int {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if !w.written {
+		return -1
+	}
+	return w.body.Len()
+}
+
+// Written reports whether the handler has written a response.
+func (w *timeoutWriter) Written() bool {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	return w.written
+}
+
+// Flush is a no-op: the response is sent once the handler returns.
+func (w *timeoutWriter) Flush() {}
+
+// Hijack is not supported, as the connection would outlive the timeout.
+func (w *timeoutWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return nil, nil, errTimeoutHijack
+}
+
+// timeOut sends problem on the underlying writer and drops any later write of the handler.
+func (w *timeoutWriter) timeOut(problem response.ProblemDetails) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.timedOut = true
+
+	body, err := json.Marshal(problem)
+	if err != nil {
+		return
+	}
+	header := w.ResponseWriter.Header()
+	header.Set("Content-Type", "application/problem+json")
+	header.Set("Content-Length", strconv.Itoa(len(body)))
+	w.ResponseWriter.WriteHeader(problem.Status)
+	_, _ = w.ResponseWriter.Write(body)
+	w.ResponseWriter.Flush()
+}
+
+// commit sends the buffered response on the underlying writer, unless the request timed out.
+func (w *timeoutWriter) commit() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.timedOut {
+		return
+	}
+
+	header := w.ResponseWriter.Header()
+	for key, values := range w.header {
+		header[key] = values
+	}
+	if w.status != 0 {
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+	if w.body.Len() > 0 {
+		_, _ = w.ResponseWriter.Write(w.body.Bytes())
+	} else if w.written {
+		w.ResponseWriter.WriteHeaderNow()
+	}
+}
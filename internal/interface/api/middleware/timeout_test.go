@@ -0,0 +1,146 @@
+package middleware_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+var _ = Describe("Timeout", func() {
+	const timeout = 50 * time.Millisecond
+
+	var (
+		router *gin.Engine
+		log    *logger.Logger
+		logs   *observer.ObservedLogs
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		core, observed := observer.New(zapcore.InfoLevel)
+		logs = observed
+		log = &logger.Logger{Logger: zap.New(core)}
+		router = gin.New()
+	})
+
+	get := func() *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/slow", nil)
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	When("the handler finishes in time", func() {
+		It("should send the handler's response", func() {
+			router.GET("/slow", middleware.Timeout(timeout, log), func(c *gin.Context) {
+				c.Header("X-Custom", "value")
+				c.JSON(http.StatusCreated, gin.H{"ok": true})
+			})
+
+			w := get()
+
+			Expect(w.Code).To(Equal(http.StatusCreated))
+			Expect(w.Header().Get("X-Custom")).To(Equal("value"))
+			Expect(w.Body.String()).To(MatchJSON(`{"ok": true}`))
+			Expect(logs.FilterMessage("request timed out").Len()).To(BeZero())
+		})
+
+		It("should keep a status set without a body", func() {
+			router.GET("/slow", middleware.Timeout(timeout, log), func(c *gin.Context) {
+				c.Status(http.StatusNoContent)
+			})
+
+			w := get()
+
+			Expect(w.Code).To(Equal(http.StatusNoContent))
+			Expect(w.Body.Len()).To(BeZero())
+		})
+	})
+
+	When("the handler sleeps past the deadline", func() {
+		var handlerErr chan error
+
+		BeforeEach(func() {
+			handlerErr = make(chan error, 1)
+			router.GET("/slow", middleware.Timeout(timeout, log), func(c *gin.Context) {
+				<-c.Request.Context().Done()
+				// A handler that ignores the cancellation still tries to answer late
+				time.Sleep(2 * timeout)
+				c.Header("X-Custom", "late")
+				c.JSON(http.StatusOK, gin.H{"ok": true})
+				_, err := c.Writer.Write([]byte("more"))
+				handlerErr <- err
+			})
+		})
+
+		It("should answer 503 with a problem+json body", func() {
+			w := get()
+
+			Expect(w.Code).To(Equal(http.StatusServiceUnavailable))
+			Expect(w.Header().Get("Content-Type")).To(Equal("application/problem+json"))
+
+			var problem response.ProblemDetails
+			Expect(json.Unmarshal(w.Body.Bytes(), &problem)).To(Succeed())
+			Expect(problem.Status).To(Equal(http.StatusServiceUnavailable))
+			Expect(problem.Code).To(Equal(apperrors.CodeServiceUnavailable))
+			Expect(problem.Detail).To(Equal("request timed out"))
+			Expect(problem.Instance).To(Equal("/slow"))
+		})
+
+		It("should drop the handler's late writes", func() {
+			w := get()
+
+			Expect(w.Header().Get("X-Custom")).To(BeEmpty())
+			Expect(w.Body.String()).NotTo(ContainSubstring(`"ok"`))
+			Expect(w.Body.String()).NotTo(ContainSubstring("more"))
+			Expect(<-handlerErr).To(MatchError(http.ErrHandlerTimeout))
+		})
+
+		It("should log the timed out route", func() {
+			get()
+
+			entries := logs.FilterMessage("request timed out").All()
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].ContextMap()).To(HaveKeyWithValue("route", "/slow"))
+		})
+	})
+
+	When("the handler panics", func() {
+		It("should leave the response to Recovery", func() {
+			router.Use(middleware.Recovery(log))
+			router.GET("/slow", middleware.Timeout(timeout, log), func(c *gin.Context) {
+				c.JSON(http.StatusOK, gin.H{"ok": true})
+				panic("boom")
+			})
+
+			w := get()
+
+			Expect(w.Code).To(Equal(http.StatusInternalServerError))
+			Expect(w.Body.String()).NotTo(ContainSubstring(`"ok"`))
+		})
+	})
+
+	When("the timeout is zero", func() {
+		It("should not bound the request", func() {
+			router.GET("/slow", middleware.Timeout(0, log), func(c *gin.Context) {
+				_, hasDeadline := c.Request.Context().Deadline()
+				Expect(hasDeadline).To(BeFalse())
+				c.Status(http.StatusOK)
+			})
+
+			Expect(get().Code).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// requestIDAttribute is the span attribute holding the request ID, named like the log field
+const requestIDAttribute = attribute.Key("request_id")
+
+// TraceRequestID is a middleware that attaches the request ID to the request span, so that a trace
+// can be found from the request ID of a log line or X-Request-ID header and vice versa.
+// It must run after both RequestID and the tracing middleware that starts the span.
+func TraceRequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		if requestID := logger.GetRequestID(ctx); requestID != "" {
+			trace.SpanFromContext(ctx).SetAttributes(requestIDAttribute.String(requestID))
+		}
+
+		c.Next()
+	}
+}
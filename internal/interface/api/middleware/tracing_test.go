@@ -0,0 +1,47 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+var _ = Describe("TraceRequestID", func() {
+	var (
+		router   *gin.Engine
+		recorder *tracetest.SpanRecorder
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		recorder = tracetest.NewSpanRecorder()
+		tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+		router = gin.New()
+		router.Use(middleware.RequestID())
+		router.Use(otelgin.Middleware("test", otelgin.WithTracerProvider(tp)))
+		router.Use(middleware.TraceRequestID())
+		router.GET("/events/:id", func(c *gin.Context) {
+			c.Status(http.StatusOK)
+		})
+	})
+
+	It("should attach the request ID to the span named after the route", func() {
+		req := httptest.NewRequest(http.MethodGet, "/events/42", nil)
+		req.Header.Set(middleware.RequestIDHeader, "req-trace-123")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+
+		spans := recorder.Ended()
+		Expect(spans).To(HaveLen(1))
+		Expect(spans[0].Name()).To(Equal("GET /events/:id"))
+		Expect(spans[0].Attributes()).To(ContainElement(attribute.String("request_id", "req-trace-123")))
+	})
+})
@@ -0,0 +1,43 @@
+package middleware
+
+import (
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+)
+
+// RequireVerifiedEmail is a middleware that rejects users who have not verified their email
+// address with 403 Forbidden. The user is looked up on every request, so that a verification
+// takes effect without a new access token; it must run after authentication.
+func RequireVerifiedEmail(userRepo repository.UserRepository, log *logger.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, ok := GetUserID(c)
+		if !ok {
+			response.ProblemFromError(c, apperrors.Unauthorized("missing authorization token"))
+			c.Abort()
+			return
+		}
+
+		user, err := userRepo.FindByID(c.Request.Context(), userID)
+		if err != nil {
+			if apperrors.IsNotFound(err) {
+				response.ProblemFromError(c, apperrors.Unauthorized("user not found"))
+			} else {
+				log.WithContext(c.Request.Context()).Error("failed to check email verification", zap.Error(err))
+				response.ProblemFromError(c, apperrors.Internal("failed to check email verification"))
+			}
+			c.Abort()
+			return
+		}
+		if !user.EmailVerified {
+			response.ProblemFromError(c, apperrors.Forbidden("verify your email address to perform this action"))
+			c.Abort()
+			return
+		}
+
+		c.Next()
+	}
+}
@@ -0,0 +1,56 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// publicRoutes lists the attendee-facing routes reachable without authentication, keyed by
+// "METHOD path" with the path relative to the API base path as registered by the generated
+// code. Routes marked true let attendees register themselves and also verify a CAPTCHA.
+var publicRoutes = map[string]bool{
+	http.MethodPost + " /participants/accept-invite": true,
+	http.MethodGet + " /public/checkin-status":       false,
+}
+
+// NewPublicRouter wraps router so that each route listed in routes is rate limited per client
+// IP and per event, see middleware.RateLimit, and self-registration routes then verify a
+// CAPTCHA with captcha, see middleware.Captcha. The limits are counted in the cache; without
+// it the routes are not rate limited.
+func NewPublicRouter(
+	router gin.IRouter,
+	routes map[string]bool,
+	cache repository.CacheRepository,
+	limits config.PublicRateLimitConfig,
+	captcha middleware.CaptchaVerifier,
+	log *logger.Logger,
+) gin.IRouter {
+	rateLimit := middleware.RateLimitOptions{
+		Scope:    "public",
+		PerIP:    limits.PerIP,
+		PerEvent: limits.PerEvent,
+		Window:   limits.Window,
+	}
+	return &hookedRouter{
+		IRouter: router,
+		hook: func(method, path string, handlers []gin.HandlerFunc) []gin.HandlerFunc {
+			selfRegistration, public := routes[method+" "+path]
+			if !public {
+				return handlers
+			}
+			var guards []gin.HandlerFunc
+			if cache != nil {
+				guards = append(guards, middleware.RateLimit(cache, rateLimit, log))
+			}
+			if selfRegistration {
+				guards = append(guards, middleware.Captcha(captcha, log))
+			}
+			return append(guards, handlers...)
+		},
+	}
+}
@@ -0,0 +1,96 @@
+package api_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/interface/api"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+// rejectingCaptcha rejects every CAPTCHA token
+type rejectingCaptcha struct{}
+
+func (rejectingCaptcha) Verify(context.Context, string, string) error {
+	return errors.New("invalid captcha token")
+}
+
+var _ = Describe("NewPublicRouter", func() {
+	var (
+		ctrl  *gomock.Controller
+		cache *mocks.MockCacheRepository
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		ctrl = gomock.NewController(GinkgoT())
+		cache = mocks.NewMockCacheRepository(ctrl)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	newRouter := func(cache repository.CacheRepository) *gin.Engine {
+		r := gin.New()
+		routes := api.NewPublicRouter(r.Group("/api/v1"), map[string]bool{
+			http.MethodPost + " /participants/accept-invite": true,
+			http.MethodGet + " /events/:id/public":           false,
+		}, cache, config.PublicRateLimitConfig{PerIP: 1, PerEvent: 100, Window: time.Minute},
+			rejectingCaptcha{}, &logger.Logger{Logger: zap.NewNop()})
+
+		ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+		routes.POST("/participants/accept-invite", ok)
+		routes.GET("/events/:id/public", ok)
+		routes.GET("/events", ok)
+		return r
+	}
+
+	call := func(r *gin.Engine, method, path string) int {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w.Code
+	}
+
+	It("should rate limit a listed route per client IP and per event", func() {
+		cache.EXPECT().Increment(gomock.Any(), gomock.Any(), time.Minute).Return(int64(1), nil).Times(2)
+
+		Expect(call(newRouter(cache), http.MethodGet, "/api/v1/events/1/public")).To(Equal(http.StatusOK))
+	})
+
+	It("should answer 429 once a listed route's cap is reached", func() {
+		cache.EXPECT().Increment(gomock.Any(), gomock.Any(), time.Minute).Return(int64(2), nil)
+
+		Expect(call(newRouter(cache), http.MethodGet, "/api/v1/events/1/public")).To(Equal(http.StatusTooManyRequests))
+	})
+
+	It("should verify the CAPTCHA of a self-registration route after its rate limit", func() {
+		cache.EXPECT().Increment(gomock.Any(), gomock.Any(), time.Minute).Return(int64(1), nil)
+
+		Expect(call(newRouter(cache), http.MethodPost, "/api/v1/participants/accept-invite")).
+			To(Equal(http.StatusForbidden))
+	})
+
+	It("should leave a route that is not listed unchanged", func() {
+		Expect(call(newRouter(cache), http.MethodGet, "/api/v1/events")).To(Equal(http.StatusOK))
+	})
+
+	When("there is no cache", func() {
+		It("should not rate limit but still verify the CAPTCHA", func() {
+			r := newRouter(nil)
+
+			Expect(call(r, http.MethodGet, "/api/v1/events/1/public")).To(Equal(http.StatusOK))
+			Expect(call(r, http.MethodGet, "/api/v1/events/1/public")).To(Equal(http.StatusOK))
+			Expect(call(r, http.MethodPost, "/api/v1/participants/accept-invite")).To(Equal(http.StatusForbidden))
+		})
+	})
+})
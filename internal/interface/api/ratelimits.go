@@ -0,0 +1,108 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// authRateLimitedRoutes are the authentication routes on which credentials, codes or email
+// addresses can be guessed, relative to the API base path as registered by the generated code.
+// The other authentication routes, such as refreshing a token or listing sessions, are called
+// routinely by signed-in clients and are limited with the rest of the API once authenticated.
+var authRateLimitedRoutes = map[string]bool{
+	"/auth/register":        true,
+	"/auth/login":           true,
+	"/auth/forgot-password": true,
+	"/auth/reset-password":  true,
+	"/auth/2fa/verify":      true,
+	"/auth/2fa/login":       true,
+}
+
+// loginRoute is the authentication route that is also limited per account, see loginAccount
+const loginRoute = "/auth/login"
+
+// NewAuthRateLimitedRouter wraps router so that the routes in authRateLimitedRoutes are rate
+// limited with limits, see middleware.RateLimit. They are called before the client has a token,
+// so they are limited ahead of authentication, per client IP and, for logins, per account. Each
+// route is counted on its own, so that signing up does not use up the logins of a client. The
+// limits are counted in the cache; without it the routes are not rate limited.
+func NewAuthRateLimitedRouter(
+	router gin.IRouter,
+	cache repository.CacheRepository,
+	limits config.AuthRateLimitConfig,
+	log *logger.Logger,
+) gin.IRouter {
+	if cache == nil {
+		return router
+	}
+	return &hookedRouter{
+		IRouter: router,
+		hook: func(_, path string, handlers []gin.HandlerFunc) []gin.HandlerFunc {
+			if !authRateLimitedRoutes[path] {
+				return handlers
+			}
+			opts := middleware.RateLimitOptions{
+				Scope:  "auth" + strings.ReplaceAll(strings.TrimPrefix(path, "/auth"), "/", ":"),
+				PerIP:  limits.PerIP,
+				Window: limits.Window,
+			}
+			if path == loginRoute {
+				opts.PerAccount = limits.PerAccount
+				opts.Account = loginAccount
+			}
+			return append([]gin.HandlerFunc{middleware.RateLimit(cache, opts, log)}, handlers...)
+		},
+	}
+}
+
+// loginAccount returns the email address a login is for, lower-cased so that varying its case
+// does not dodge the cap, or "" if the body has none. The body is read ahead of the handler, so
+// it is put back for it.
+func loginAccount(c *gin.Context) string {
+	body, err := io.ReadAll(c.Request.Body)
+	// Reads past the body size limit keep failing, so the handler still rejects the body
+	c.Request.Body = readCloser{Reader: io.MultiReader(bytes.NewReader(body), c.Request.Body), Closer: c.Request.Body}
+	if err != nil {
+		return ""
+	}
+	var login struct {
+		Email string `json:"email"`
+	}
+	if json.Unmarshal(body, &login) != nil {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(login.Email))
+}
+
+// readCloser reads from Reader and closes Closer
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// NewAPIRateLimit returns the rate limit of the routes requiring authentication, which runs
+// once a request is authenticated so that it is counted per user as well as per client IP.
+// Without the cache it returns nil, as the requests cannot be counted.
+func NewAPIRateLimit(
+	cache repository.CacheRepository,
+	limits config.APIRateLimitConfig,
+	log *logger.Logger,
+) gin.HandlerFunc {
+	if cache == nil {
+		return nil
+	}
+	return middleware.RateLimit(cache, middleware.RateLimitOptions{
+		Scope:   "api",
+		PerIP:   limits.PerIP,
+		PerUser: limits.PerUser,
+		Window:  limits.Window,
+	}, log)
+}
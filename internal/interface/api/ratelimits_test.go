@@ -0,0 +1,140 @@
+package api_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/interface/api"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Rate limits", func() {
+	var (
+		ctrl  *gomock.Controller
+		cache *mocks.MockCacheRepository
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		ctrl = gomock.NewController(GinkgoT())
+		cache = mocks.NewMockCacheRepository(ctrl)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	ok := func(c *gin.Context) { c.Status(http.StatusOK) }
+
+	call := func(r *gin.Engine, method, path string) int {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w.Code
+	}
+
+	Describe("NewAuthRateLimitedRouter", func() {
+		newRouter := func(cache repository.CacheRepository) *gin.Engine {
+			r := gin.New()
+			routes := api.NewAuthRateLimitedRouter(r.Group("/api/v1"), cache,
+				config.AuthRateLimitConfig{PerIP: 1, PerAccount: 1, Window: time.Minute},
+				&logger.Logger{Logger: zap.NewNop()})
+			routes.POST("/auth/register", ok)
+			routes.POST("/auth/login", func(c *gin.Context) {
+				body, _ := io.ReadAll(c.Request.Body)
+				c.String(http.StatusOK, string(body))
+			})
+			routes.POST("/auth/refresh", ok)
+			routes.GET("/events", ok)
+			return r
+		}
+
+		It("should rate limit a guessable authentication route per route and client IP", func() {
+			cache.EXPECT().Increment(gomock.Any(), gomock.Any(), time.Minute).DoAndReturn(
+				func(_ context.Context, key string, _ time.Duration) (int64, error) {
+					Expect(key).To(HavePrefix("ratelimit:auth:register:ip:"))
+					return 2, nil
+				},
+			)
+
+			Expect(call(newRouter(cache), http.MethodPost, "/api/v1/auth/register")).To(Equal(http.StatusTooManyRequests))
+		})
+
+		It("should rate limit logins per account as well, leaving the body to the handler", func() {
+			var keys []string
+			cache.EXPECT().Increment(gomock.Any(), gomock.Any(), time.Minute).DoAndReturn(
+				func(_ context.Context, key string, _ time.Duration) (int64, error) {
+					keys = append(keys, key)
+					return 1, nil
+				},
+			).Times(2)
+
+			body := `{"email":"Alice@Example.com","password":"secret"}`
+			w := httptest.NewRecorder()
+			r := newRouter(cache)
+			r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/api/v1/auth/login", strings.NewReader(body)))
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Body.String()).To(Equal(body))
+			Expect(keys).To(ConsistOf(HavePrefix("ratelimit:auth:login:ip:"), HavePrefix("ratelimit:auth:login:account:")))
+			Expect(strings.Join(keys, " ")).NotTo(ContainSubstring("alice@example.com"))
+		})
+
+		It("should leave the other authentication routes and the other routes unchanged", func() {
+			r := newRouter(cache)
+
+			Expect(call(r, http.MethodPost, "/api/v1/auth/refresh")).To(Equal(http.StatusOK))
+			Expect(call(r, http.MethodGet, "/api/v1/events")).To(Equal(http.StatusOK))
+		})
+
+		When("there is no cache", func() {
+			It("should not rate limit", func() {
+				r := newRouter(nil)
+
+				Expect(call(r, http.MethodPost, "/api/v1/auth/register")).To(Equal(http.StatusOK))
+				Expect(call(r, http.MethodPost, "/api/v1/auth/register")).To(Equal(http.StatusOK))
+			})
+		})
+	})
+
+	Describe("NewAPIRateLimit", func() {
+		limits := config.APIRateLimitConfig{PerIP: 1, PerUser: 1, Window: time.Minute}
+
+		It("should count an authenticated request per client IP and per user", func() {
+			userID := uuid.New()
+			var keys []string
+			cache.EXPECT().Increment(gomock.Any(), gomock.Any(), time.Minute).DoAndReturn(
+				func(_ context.Context, key string, _ time.Duration) (int64, error) {
+					keys = append(keys, key)
+					return 1, nil
+				},
+			).Times(2)
+
+			r := gin.New()
+			r.GET("/events", func(c *gin.Context) {
+				c.Set(middleware.ContextKeyUserID, userID)
+			}, api.NewAPIRateLimit(cache, limits, &logger.Logger{Logger: zap.NewNop()}), ok)
+
+			Expect(call(r, http.MethodGet, "/events")).To(Equal(http.StatusOK))
+			Expect(keys).To(HaveLen(2))
+			Expect(strings.Join(keys, " ")).To(ContainSubstring("ratelimit:api:user:" + userID.String()))
+		})
+
+		When("there is no cache", func() {
+			It("should return nil", func() {
+				Expect(api.NewAPIRateLimit(nil, limits, &logger.Logger{Logger: zap.NewNop()})).To(BeNil())
+			})
+		})
+	})
+})
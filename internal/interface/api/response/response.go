@@ -6,6 +6,9 @@
 // Request IDs are provided in the X-Request-ID HTTP header (added by middleware),
 // not in response bodies, following OpenAPI specification and industry standards.
 //
+// Problem titles and details are localized for the locale negotiated by the Locale
+// middleware; the machine-readable code is never localized.
+//
 // Example usage:
 //
 //	// Success response (single entity)
@@ -30,6 +33,7 @@ import (
 
 	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/i18n"
 	"github.com/gin-gonic/gin"
 )
 
@@ -83,14 +87,21 @@ func Problem(c *gin.Context, statusCode int, problemType, title, detail string)
 // ProblemWithCode sends an RFC 9457 Problem Details error response with error code extension
 func ProblemWithCode(c *gin.Context, statusCode int, code, detail string) {
 	c.Header("Content-Type", "application/problem+json")
-	c.JSON(statusCode, ProblemDetails{
+	c.JSON(statusCode, NewProblem(c, statusCode, code, detail))
+}
+
+// NewProblem builds the localized Problem Details body that ProblemWithCode sends, for callers
+// that write the response themselves
+func NewProblem(c *gin.Context, statusCode int, code, detail string) ProblemDetails {
+	title, detail := localize(c, code, detail)
+	return ProblemDetails{
 		Type:     apperrors.ToTypeURL(code),
-		Title:    apperrors.GetTitle(code),
+		Title:    title,
 		Status:   statusCode,
 		Detail:   detail,
 		Instance: c.Request.URL.Path,
 		Code:     code,
-	})
+	}
 }
 
 // ProblemFromError sends an RFC 9457 Problem Details response based on an AppError
@@ -114,18 +125,22 @@ func ProblemFromError(c *gin.Context, err error) {
 
 		// If validation errors exist, include them
 		if len(appErr.ValidationErrors) > 0 {
+			title, detail := localize(c, errorCode, message)
 			c.Header("Content-Type", "application/problem+json")
 			c.JSON(statusCode, ProblemDetails{
 				Type:     apperrors.ToTypeURL(errorCode),
-				Title:    apperrors.GetTitle(errorCode),
+				Title:    title,
 				Status:   statusCode,
-				Detail:   message,
+				Detail:   detail,
 				Instance: c.Request.URL.Path,
 				Code:     errorCode,
 				Errors:   appErr.ValidationErrors,
 			})
 			return
 		}
+	} else if apperrors.IsTimeout(err) {
+		// The wrapped error would name the statement that timed out
+		message = "the operation timed out"
 	}
 
 	ProblemWithCode(c, statusCode, errorCode, message)
@@ -133,12 +148,13 @@ func ProblemFromError(c *gin.Context, err error) {
 
 // ValidationProblem sends an RFC 9457 Problem Details validation error response
 func ValidationProblem(c *gin.Context, validationErrors []generated.ValidationError) {
+	title, detail := localize(c, apperrors.CodeValidation, "One or more validation errors occurred")
 	c.Header("Content-Type", "application/problem+json")
 	c.JSON(http.StatusBadRequest, ProblemDetails{
 		Type:     apperrors.ToTypeURL(apperrors.CodeValidation),
-		Title:    apperrors.GetTitle(apperrors.CodeValidation),
+		Title:    title,
 		Status:   http.StatusBadRequest,
-		Detail:   "One or more validation errors occurred",
+		Detail:   detail,
 		Instance: c.Request.URL.Path,
 		Code:     apperrors.CodeValidation,
 		Errors:   validationErrors,
@@ -164,3 +180,20 @@ func UnauthorizedProblem(c *gin.Context, detail string) {
 func ForbiddenProblem(c *gin.Context, detail string) {
 	ProblemWithCode(c, http.StatusForbidden, apperrors.CodeForbidden, detail)
 }
+
+// localize returns the problem title and detail for the request's locale.
+// Messages raised in code are written in the default locale and are kept verbatim for it;
+// other locales use the catalog message for the error code. Codes missing from the
+// locale's catalog fall back to the untranslated title and detail.
+func localize(c *gin.Context, code, detail string) (string, string) {
+	locale := c.GetString(i18n.ContextKey)
+	if locale == "" || locale == i18n.DefaultLocale {
+		return apperrors.GetTitle(code), detail
+	}
+
+	msg, ok := i18n.Lookup(locale, code)
+	if !ok {
+		return apperrors.GetTitle(code), detail
+	}
+	return msg.Title, msg.Detail
+}
@@ -1,6 +1,7 @@
 package response_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
 	"github.com/fumkob/ezqrin-server/internal/interface/api/response"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/i18n"
 	"github.com/gin-gonic/gin"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -204,6 +206,75 @@ var _ = Describe("Response helpers", func() {
 		})
 	})
 
+	// -------------------------------------------------------------------------
+	// Localization
+	// -------------------------------------------------------------------------
+	Describe("Localization", func() {
+		When("the request locale has a catalog entry for the error code", func() {
+			Context("with the ja locale", func() {
+				It("returns the localized title and detail and keeps the code stable", func() {
+					c, w := newTestContext("/resources/99")
+					c.Set(i18n.ContextKey, "ja")
+					response.ProblemWithCode(c, http.StatusNotFound, apperrors.CodeNotFound, "resource not found")
+
+					msg, ok := i18n.Lookup("ja", apperrors.CodeNotFound)
+					Expect(ok).To(BeTrue())
+
+					var result map[string]interface{}
+					Expect(json.Unmarshal(w.Body.Bytes(), &result)).To(Succeed())
+					Expect(result["code"]).To(Equal(apperrors.CodeNotFound))
+					Expect(result["type"]).To(Equal(apperrors.ToTypeURL(apperrors.CodeNotFound)))
+					Expect(result["title"]).To(Equal(msg.Title))
+					Expect(result["detail"]).To(Equal(msg.Detail))
+				})
+
+				It("localizes validation problems while keeping the field errors", func() {
+					c, w := newTestContext("/events")
+					c.Set(i18n.ContextKey, "ja")
+					response.ValidationProblem(c, []generated.ValidationError{
+						{Field: "name", Message: "name is required"},
+					})
+
+					msg, _ := i18n.Lookup("ja", apperrors.CodeValidation)
+
+					var result map[string]interface{}
+					Expect(json.Unmarshal(w.Body.Bytes(), &result)).To(Succeed())
+					Expect(result["code"]).To(Equal(apperrors.CodeValidation))
+					Expect(result["title"]).To(Equal(msg.Title))
+					Expect(result["detail"]).To(Equal(msg.Detail))
+					Expect(result["errors"]).To(HaveLen(1))
+				})
+			})
+		})
+
+		When("the request locale is the default locale", func() {
+			It("keeps the original detail message", func() {
+				c, w := newTestContext("/resources/99")
+				c.Set(i18n.ContextKey, i18n.DefaultLocale)
+				response.ProblemWithCode(c, http.StatusNotFound, apperrors.CodeNotFound, "event not found")
+
+				var result map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &result)).To(Succeed())
+				Expect(result["title"]).To(Equal(apperrors.GetTitle(apperrors.CodeNotFound)))
+				Expect(result["detail"]).To(Equal("event not found"))
+			})
+		})
+
+		When("the error code has no catalog entry", func() {
+			It("falls back to the untranslated title and detail", func() {
+				c, w := newTestContext("/login")
+				c.Set(i18n.ContextKey, "ja")
+				response.ProblemWithCode(c, http.StatusUnauthorized, "TOTP_REQUIRED", "one-time code required")
+
+				var result map[string]interface{}
+				Expect(json.Unmarshal(w.Body.Bytes(), &result)).To(Succeed())
+				Expect(result["code"]).To(Equal("TOTP_REQUIRED"))
+				Expect(result["title"]).To(Equal(apperrors.GetTitle("TOTP_REQUIRED")))
+				Expect(result["detail"]).To(Equal("one-time code required"))
+			})
+		})
+	})
+
 	// -------------------------------------------------------------------------
 	// ProblemFromError
 	// -------------------------------------------------------------------------
@@ -327,6 +398,24 @@ var _ = Describe("Response helpers", func() {
 			})
 		})
 
+		When("the error is a timeout", func() {
+			Context("such as a database query exceeding its timeout", func() {
+				It("returns 503 with the SERVICE_UNAVAILABLE code without the query details", func() {
+					timeoutErr := apperrors.Wrap(context.DeadlineExceeded, "failed to list participants")
+
+					c, w := newTestContext("/events")
+					response.ProblemFromError(c, timeoutErr)
+
+					Expect(w.Code).To(Equal(http.StatusServiceUnavailable))
+
+					var result map[string]interface{}
+					Expect(json.Unmarshal(w.Body.Bytes(), &result)).To(Succeed())
+					Expect(result["code"]).To(Equal(apperrors.CodeServiceUnavailable))
+					Expect(result["detail"]).To(Equal("the operation timed out"))
+				})
+			})
+		})
+
 		When("the error is a wrapped AppError", func() {
 			Context("wrapped with fmt.Errorf %w", func() {
 				It("unwraps correctly and returns the AppError status and code", func() {
@@ -11,12 +11,17 @@ import (
 	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
 	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.uber.org/zap"
 )
 
 const (
 	// API_V1_PATH defines the base path for v1 of the API
 	API_V1_PATH = "/api/v1"
+
+	// MetricsPath serves the Prometheus metrics of the server
+	MetricsPath = "/metrics"
 )
 
 // RouterDependencies holds all dependencies required to setup the router
@@ -26,10 +31,16 @@ type RouterDependencies struct {
 	DB        database.Service     // Interface type for database operations
 	Cache     cache.Service        // Interface type for cache operations
 	Container *container.Container // Container for all other dependencies
+	// Captcha verifies the CAPTCHA of self-registration routes; nil accepts every request
+	Captcha middleware.CaptchaVerifier
+	// Drain tracks in-flight requests for graceful shutdown; nil disables the tracking
+	Drain *middleware.Drain
 }
 
 // SetupRouter creates and configures the Gin HTTP router with all middleware and routes.
-// It applies middleware in the correct order: RequestID → OTelGin → Logging → Recovery → CORS.
+// It applies middleware in the correct order:
+// RequestID → OTelGin → TraceRequestID → Metrics → Logging → Locale → Recovery → Compress → Drain.
+// CORS is applied per route, under the policy of its group, see NewCORSRouter.
 // Routes are registered using OpenAPI-generated code for type safety and spec compliance.
 func SetupRouter(deps *RouterDependencies) *gin.Engine {
 	// Set Gin mode based on environment
@@ -42,18 +53,24 @@ func SetupRouter(deps *RouterDependencies) *gin.Engine {
 	// Create router
 	router := gin.New()
 
-	// Configure trusted proxies (important for production)
-	// In production, you should specify actual proxy IPs
-	if err := router.SetTrustedProxies(nil); err != nil {
-		deps.Logger.Warn("failed to set trusted proxies")
+	// Only the configured reverse proxies may set the client IP with X-Forwarded-For, so that
+	// clients cannot pick the IP they are rate limited under
+	if err := router.SetTrustedProxies(deps.Config.Server.TrustedProxies); err != nil {
+		deps.Logger.Warn("failed to set trusted proxies", zap.Error(err))
 	}
 
 	// Apply global middleware in order
-	router.Use(middleware.RequestID())                                // Generate request ID first
-	router.Use(otelgin.Middleware(deps.Config.Telemetry.ServiceName)) // OpenTelemetry tracing
-	router.Use(middleware.Logging(deps.Logger))                       // Log requests with request ID
-	router.Use(middleware.Recovery(deps.Logger))                      // Recover from panics
-	router.Use(middleware.CORS(&deps.Config.CORS))                    // Handle CORS
+	router.Use(middleware.RequestID())                                     // Generate request ID first
+	router.Use(otelgin.Middleware(deps.Config.Telemetry.ServiceName))      // OpenTelemetry tracing
+	router.Use(middleware.TraceRequestID())                                // Attach request ID to the span
+	router.Use(middleware.Metrics())                                       // Record Prometheus request metrics
+	router.Use(middleware.Logging(deps.Logger))                            // Log requests with request ID
+	router.Use(middleware.Locale(deps.Config.I18n.DefaultLocale))          // Negotiate error message locale
+	router.Use(middleware.Recovery(deps.Logger))                           // Recover from panics
+	router.Use(middleware.Compress(deps.Config.Server.CompressionMinSize)) // Gzip large responses
+	if deps.Drain != nil {
+		router.Use(deps.Drain.Middleware()) // Track in-flight requests, reject new ones while draining
+	}
 
 	// Register OpenAPI-generated routes under the versioned base path
 	// This automatically registers all routes defined in the OpenAPI specification
@@ -62,13 +79,23 @@ func SetupRouter(deps *RouterDependencies) *gin.Engine {
 	// Initialize authentication middleware
 	authMiddleware := middleware.NewAuthMiddleware(
 		deps.Container.Repositories.Blacklist,
-		deps.Config.JWT.Secret,
+		deps.Container.TokenSigner,
 		deps.Logger,
 	)
 
+	// Route middlewares that need the user authenticate ahead of them, once per request
+	authenticate := func(c *gin.Context) {
+		if _, authenticated := middleware.GetUserID(c); !authenticated {
+			authMiddleware.Authenticate()(c)
+		}
+	}
+
 	// Initialize all handlers
 	combinedHandler := initializeHandlers(deps)
 
+	// Routes requiring authentication are rate limited per user once authenticated
+	apiRateLimit := NewAPIRateLimit(deps.Container.Repositories.Cache, deps.Config.APIRateLimit, deps.Logger)
+
 	// Register handlers with authentication middleware that respects OpenAPI security requirements
 	// This established the pattern for protecting routes:
 	// 1. Define security requirements in OpenAPI spec (e.g., security: [{ bearerAuth: [] }])
@@ -79,19 +106,93 @@ func SetupRouter(deps *RouterDependencies) *gin.Engine {
 			func(c *gin.Context) {
 				// Only authenticate if the route has security requirements (BearerAuthScopes is set by the generated wrapper)
 				if _, exists := c.Get(string(generated.BearerAuthScopes)); exists {
-					authMiddleware.Authenticate()(c)
+					// Idempotent routes have authenticated already, ahead of the idempotency middleware
+					if _, authenticated := middleware.GetUserID(c); !authenticated {
+						authMiddleware.Authenticate()(c)
+					}
+					if apiRateLimit != nil && !c.IsAborted() {
+						apiRateLimit(c)
+					}
 				}
 			},
 		},
 	}
-	generated.RegisterHandlersWithOptions(v1, combinedHandler, options)
+	// Idempotency keys are kept in the cache; without it they are ignored
+	idempotencyKeyTTL := deps.Config.Server.IdempotencyKeyTTL
+	if deps.Container.Repositories.Cache == nil {
+		idempotencyKeyTTL = 0
+	}
+
+	captcha := deps.Captcha
+	if captcha == nil {
+		captcha = middleware.NoopCaptchaVerifier{}
+	}
+
+	// Routes answer CORS under the policy of their group; routes of disabled features are
+	// not registered and answer 404; legacy routes announce their deprecation; request
+	// bodies are bounded by the route's size limit; every route is bounded by its request
+	// timeout, within which authentication routes are rate limited, routes that need a
+	// verified email reject unverified users, idempotent routes replay the response of a
+	// repeated Idempotency-Key and public routes are rate limited
+	idempotentRouter := NewIdempotentRouter(
+		NewVerifiedEmailRouter(
+			NewAuthRateLimitedRouter(
+				NewTimeoutRouter(
+					NewBodyLimitRouter(
+						NewDeprecatingRouter(
+							NewFeatureGatedRouter(
+								NewCORSRouter(v1, publicRoutes, deps.Config.CORS, deps.Config.PublicCORSPolicy()),
+								deps.Config.Features,
+							),
+							deprecatedRoutes,
+							deps.Logger,
+						),
+						deps.Config.Server,
+					),
+					deps.Config.Server,
+					deps.Logger,
+				),
+				deps.Container.Repositories.Cache,
+				deps.Config.AuthRateLimit,
+				deps.Logger,
+			),
+			verifiedEmailRoutes,
+			authenticate,
+			deps.Container.Repositories.User,
+			deps.Config.EmailVerification.Mode,
+			deps.Logger,
+		),
+		idempotentRoutes,
+		authenticate,
+		deps.Container.Repositories.Cache,
+		idempotencyKeyTTL,
+		deps.Logger,
+	)
+	routes := NewPublicRouter(
+		idempotentRouter,
+		publicRoutes,
+		deps.Container.Repositories.Cache,
+		deps.Config.PublicRateLimit,
+		captcha,
+		deps.Logger,
+	)
+	generated.RegisterHandlersWithOptions(routes, combinedHandler, options)
+
+	// Expose the request metrics for Prometheus to scrape
+	router.GET(MetricsPath, gin.WrapH(promhttp.Handler()))
+
+	// Serve the OpenAPI specification, and the interactive documentation unless disabled
+	specRoutes := router.Group("", middleware.CORS(&deps.Config.CORS))
+	if err := RegisterSpecRoutes(specRoutes, deps.Config.Server.DocsEnabled); err != nil {
+		deps.Logger.Error("failed to register OpenAPI spec routes", zap.Error(err))
+	}
 
 	return router
 }
 
 // initializeHandlers creates and combines all HTTP handlers
 func initializeHandlers(deps *RouterDependencies) *handler.Handler {
-	healthHandler := handler.NewHealthHandler(deps.DB, deps.Cache, deps.Logger)
+	healthHandler := handler.NewHealthHandler(deps.DB, deps.Cache, deps.Container.QRGenerator, deps.Logger)
 
 	authUseCases := deps.Container.UseCases.Auth
 	authHandler := handler.NewAuthHandler(
@@ -99,18 +200,29 @@ func initializeHandlers(deps *RouterDependencies) *handler.Handler {
 		authUseCases.Login,
 		authUseCases.Refresh,
 		authUseCases.Logout,
+		authUseCases.TwoFactor,
+		authUseCases.ForgotPassword,
+		authUseCases.ResetPassword,
+		authUseCases.Session,
+		authUseCases.EmailVerification,
 		deps.Logger,
 	)
 
-	eventHandler := handler.NewEventHandler(deps.Container.UseCases.Event, deps.Logger)
+	eventHandler := handler.NewEventHandler(
+		deps.Container.UseCases.Event,
+		deps.Config.Pagination.Events,
+		deps.Logger,
+	)
 
 	participantHandler := handler.NewParticipantHandler(
 		deps.Container.UseCases.Participant,
+		deps.Config.Pagination.Participants,
 		deps.Logger,
 	)
 
 	checkinHandler := handler.NewCheckinHandler(
 		deps.Container.UseCases.Checkin,
+		deps.Config.Pagination.Checkins,
 		deps.Logger,
 	)
 
@@ -119,6 +231,25 @@ func initializeHandlers(deps *RouterDependencies) *handler.Handler {
 		deps.Logger,
 	)
 
+	paymentHandler := handler.NewPaymentHandler(
+		deps.Container.UseCases.Payment,
+		deps.Logger,
+	)
+
+	adminHandler := handler.NewAdminHandler(deps.Config, deps.Logger)
+
+	userHandler := handler.NewUserHandler(
+		deps.Container.UseCases.User,
+		deps.Config.Pagination.Users,
+		deps.Logger,
+	)
+
+	auditHandler := handler.NewAuditHandler(
+		deps.Container.UseCases.Audit,
+		deps.Config.Pagination.AuditLogs,
+		deps.Logger,
+	)
+
 	return handler.NewHandler(
 		healthHandler,
 		authHandler,
@@ -126,5 +257,9 @@ func initializeHandlers(deps *RouterDependencies) *handler.Handler {
 		participantHandler,
 		checkinHandler,
 		qrcodeHandler,
+		paymentHandler,
+		adminHandler,
+		userHandler,
+		auditHandler,
 	)
 }
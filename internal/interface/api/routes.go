@@ -0,0 +1,56 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// routeHook rewrites the handler chain of a route before it is registered, keyed by method and
+// the path relative to the API base path. Returning no handlers skips the route.
+type routeHook func(method, path string, handlers []gin.HandlerFunc) []gin.HandlerFunc
+
+// hookedRouter passes every route registered on it through a routeHook, so that routes
+// registered by the generated code can be adjusted declaratively.
+type hookedRouter struct {
+	gin.IRouter
+	hook routeHook
+}
+
+// GET registers a GET route through the hook.
+func (r *hookedRouter) GET(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.handle(http.MethodGet, path, handlers)
+}
+
+// POST registers a POST route through the hook.
+func (r *hookedRouter) POST(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.handle(http.MethodPost, path, handlers)
+}
+
+// PUT registers a PUT route through the hook.
+func (r *hookedRouter) PUT(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.handle(http.MethodPut, path, handlers)
+}
+
+// PATCH registers a PATCH route through the hook.
+func (r *hookedRouter) PATCH(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.handle(http.MethodPatch, path, handlers)
+}
+
+// DELETE registers a DELETE route through the hook.
+func (r *hookedRouter) DELETE(path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.handle(http.MethodDelete, path, handlers)
+}
+
+// Handle registers a route through the hook.
+func (r *hookedRouter) Handle(method, path string, handlers ...gin.HandlerFunc) gin.IRoutes {
+	return r.handle(method, path, handlers)
+}
+
+func (r *hookedRouter) handle(method, path string, handlers []gin.HandlerFunc) gin.IRoutes {
+	handlers = r.hook(method, path, handlers)
+	if len(handlers) == 0 {
+		return r
+	}
+	return r.IRouter.Handle(method, path, handlers...)
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
+	"github.com/gin-gonic/gin"
+	"go.yaml.in/yaml/v3"
+)
+
+const (
+	// SpecJSONPath serves the OpenAPI specification as JSON
+	SpecJSONPath = "/openapi.json"
+	// SpecYAMLPath serves the OpenAPI specification as YAML
+	SpecYAMLPath = "/openapi.yaml"
+	// DocsPath serves the interactive API documentation rendering the specification
+	DocsPath = "/docs"
+)
+
+// docsPage renders the specification served at SpecJSONPath with Redoc.
+const docsPage = `<!DOCTYPE html>
+<html>
+<head>
+  <title>ezQRin API</title>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+</head>
+<body>
+  <redoc spec-url="` + SpecJSONPath + `"></redoc>
+  <script src="https://cdn.redoc.ly/redoc/latest/bundles/redoc.standalone.js"></script>
+</body>
+</html>
+`
+
+// RegisterSpecRoutes serves the OpenAPI specification so that clients can discover the API,
+// and the interactive documentation when docsEnabled. The specification is the one embedded in
+// the generated code, so it always matches the routes and types the server was built from.
+func RegisterSpecRoutes(router gin.IRouter, docsEnabled bool) error {
+	specJSON, err := generated.GetSpecJSON()
+	if err != nil {
+		return fmt.Errorf("failed to load OpenAPI spec: %w", err)
+	}
+	specYAML, err := jsonToYAML(specJSON)
+	if err != nil {
+		return fmt.Errorf("failed to convert OpenAPI spec to YAML: %w", err)
+	}
+
+	router.GET(SpecJSONPath, func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json", specJSON)
+	})
+	router.GET(SpecYAMLPath, func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/yaml", specYAML)
+	})
+	if docsEnabled {
+		router.GET(DocsPath, func(c *gin.Context) {
+			c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(docsPage))
+		})
+	}
+	return nil
+}
+
+// jsonToYAML converts a JSON document to YAML, keeping the order of its keys. JSON is valid
+// YAML, so the document is parsed as YAML and written back in block style.
+func jsonToYAML(data []byte) ([]byte, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, err
+	}
+	resetStyle(&doc)
+
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// resetStyle clears the flow and quoting styles the JSON syntax left on node and its children.
+func resetStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, child := range node.Content {
+		resetStyle(child)
+	}
+}
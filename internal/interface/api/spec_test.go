@@ -0,0 +1,77 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/fumkob/ezqrin-server/internal/interface/api"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// newSpecRouter registers the spec routes on a new router.
+func newSpecRouter(docsEnabled bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	r := gin.New()
+	Expect(api.RegisterSpecRoutes(r, docsEnabled)).To(Succeed())
+	return r
+}
+
+// get performs a GET request against the router.
+func get(r *gin.Engine, path string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	r.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	return w
+}
+
+var _ = Describe("RegisterSpecRoutes", func() {
+	expectSpec := func(w *httptest.ResponseRecorder, contentType string) {
+		Expect(w.Code).To(Equal(http.StatusOK))
+		Expect(w.Header().Get("Content-Type")).To(Equal(contentType))
+
+		spec, err := openapi3.NewLoader().LoadFromData(w.Body.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(spec.OpenAPI).To(Equal("3.0.3"))
+		Expect(spec.Info.Title).To(Equal("ezQRin API"))
+		Expect(spec.Info.Version).To(Equal("1.0.0"))
+		Expect(spec.Paths.Find("/events/{id}/participants")).NotTo(BeNil())
+	}
+
+	It("should serve the OpenAPI spec as JSON", func() {
+		expectSpec(get(newSpecRouter(false), api.SpecJSONPath), "application/json")
+	})
+
+	It("should serve the same OpenAPI spec as YAML", func() {
+		r := newSpecRouter(false)
+		w := get(r, api.SpecYAMLPath)
+		expectSpec(w, "application/yaml")
+
+		fromYAML, err := openapi3.NewLoader().LoadFromData(w.Body.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		fromJSON, err := openapi3.NewLoader().LoadFromData(get(r, api.SpecJSONPath).Body.Bytes())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fromYAML.Paths.Len()).To(Equal(fromJSON.Paths.Len()))
+		Expect(fromYAML.Components.Schemas).To(HaveLen(len(fromJSON.Components.Schemas)))
+	})
+
+	When("docs are enabled", func() {
+		It("should serve the docs page rendering the JSON spec", func() {
+			w := get(newSpecRouter(true), api.DocsPath)
+
+			Expect(w.Code).To(Equal(http.StatusOK))
+			Expect(w.Header().Get("Content-Type")).To(HavePrefix("text/html"))
+			Expect(w.Body.String()).To(ContainSubstring(`spec-url="` + api.SpecJSONPath + `"`))
+		})
+	})
+
+	When("docs are disabled", func() {
+		It("should not serve the docs page but still serve the spec", func() {
+			r := newSpecRouter(false)
+
+			Expect(get(r, api.DocsPath).Code).To(Equal(http.StatusNotFound))
+			Expect(get(r, api.SpecJSONPath).Code).To(Equal(http.StatusOK))
+		})
+	})
+})
@@ -0,0 +1,59 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// routeTimeouts returns the routes whose timeout differs from the server's request timeout,
+// keyed by "METHOD path" with the path relative to the API base path as registered by the
+// generated code. Authentication answers quickly or not at all, while CSV transfers and bulk
+// sends scale with the size of the event. The live check-in stream runs until the client
+// disconnects, and the QR code archive and badge sheet are streamed while they are built, so
+// none of them has a limit: the timeout would buffer the whole response.
+func routeTimeouts(server config.ServerConfig) map[string]time.Duration {
+	return map[string]time.Duration{
+		http.MethodPost + " /auth/login":               server.AuthRequestTimeout,
+		http.MethodPost + " /auth/logout":              server.AuthRequestTimeout,
+		http.MethodPost + " /auth/refresh":             server.AuthRequestTimeout,
+		http.MethodPost + " /auth/register":            server.AuthRequestTimeout,
+		http.MethodPost + " /auth/forgot-password":     server.AuthRequestTimeout,
+		http.MethodPost + " /auth/reset-password":      server.AuthRequestTimeout,
+		http.MethodPost + " /auth/verify-email":        server.AuthRequestTimeout,
+		http.MethodPost + " /auth/resend-verification": server.AuthRequestTimeout,
+		http.MethodPost + " /auth/2fa/enroll":          server.AuthRequestTimeout,
+		http.MethodPost + " /auth/2fa/verify":          server.AuthRequestTimeout,
+		http.MethodPost + " /auth/2fa/login":           server.AuthRequestTimeout,
+
+		http.MethodGet + " /events/:id/participants/export":        server.BulkRequestTimeout,
+		http.MethodPost + " /events/:id/participants/import":       server.BulkRequestTimeout,
+		http.MethodPost + " /events/:id/participants/bulk":         server.BulkRequestTimeout,
+		http.MethodPost + " /events/:id/qrcodes/send":              server.BulkRequestTimeout,
+		http.MethodPost + " /events/:id/participants/send-invites": server.BulkRequestTimeout,
+
+		http.MethodGet + " /events/:id/checkins/stream":          0,
+		http.MethodGet + " /events/:id/participants/qrcodes.zip": 0,
+		http.MethodGet + " /events/:id/participants/badges":      0,
+	}
+}
+
+// NewTimeoutRouter wraps router so that every route runs under middleware.Timeout, with the
+// server's request timeout unless the route has its own in routeTimeouts.
+func NewTimeoutRouter(router gin.IRouter, server config.ServerConfig, log *logger.Logger) gin.IRouter {
+	overrides := routeTimeouts(server)
+	return &hookedRouter{
+		IRouter: router,
+		hook: func(method, path string, handlers []gin.HandlerFunc) []gin.HandlerFunc {
+			timeout, ok := overrides[method+" "+path]
+			if !ok {
+				timeout = server.RequestTimeout
+			}
+			return append([]gin.HandlerFunc{middleware.Timeout(timeout, log)}, handlers...)
+		},
+	}
+}
@@ -0,0 +1,92 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/interface/api"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("NewTimeoutRouter", func() {
+	var (
+		r         *gin.Engine
+		deadlines map[string]time.Duration
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		deadlines = make(map[string]time.Duration)
+
+		r = gin.New()
+		routes := api.NewTimeoutRouter(r.Group("/api/v1"), config.ServerConfig{
+			RequestTimeout:     30 * time.Second,
+			AuthRequestTimeout: 10 * time.Second,
+			BulkRequestTimeout: 5 * time.Minute,
+		}, &logger.Logger{Logger: zap.NewNop()})
+
+		record := func(c *gin.Context) {
+			deadline, ok := c.Request.Context().Deadline()
+			Expect(ok).To(BeTrue())
+			deadlines[c.FullPath()] = time.Until(deadline)
+			c.Status(http.StatusOK)
+		}
+		routes.GET("/events", record)
+		routes.POST("/auth/login", record)
+		routes.GET("/events/:id/participants/export", record)
+		routes.POST("/events/:id/participants/send-invites", record)
+		unlimited := func(c *gin.Context) {
+			_, ok := c.Request.Context().Deadline()
+			Expect(ok).To(BeFalse())
+			c.Status(http.StatusOK)
+		}
+		routes.GET("/events/:id/checkins/stream", unlimited)
+		routes.GET("/events/:id/participants/qrcodes.zip", unlimited)
+		routes.GET("/events/:id/participants/badges", unlimited)
+	})
+
+	call := func(method, path string) {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		Expect(w.Code).To(Equal(http.StatusOK))
+	}
+
+	It("should apply the request timeout to ordinary routes", func() {
+		call(http.MethodGet, "/api/v1/events")
+		Expect(deadlines["/api/v1/events"]).To(BeNumerically("~", 30*time.Second, time.Second))
+	})
+
+	It("should apply the shorter timeout to authentication routes", func() {
+		call(http.MethodPost, "/api/v1/auth/login")
+		Expect(deadlines["/api/v1/auth/login"]).To(BeNumerically("~", 10*time.Second, time.Second))
+	})
+
+	It("should apply the longer timeout to CSV transfers", func() {
+		call(http.MethodGet, "/api/v1/events/1/participants/export")
+		Expect(deadlines["/api/v1/events/:id/participants/export"]).To(BeNumerically("~", 5*time.Minute, time.Second))
+	})
+
+	It("should apply the longer timeout to bulk invite sends", func() {
+		call(http.MethodPost, "/api/v1/events/1/participants/send-invites")
+		Expect(deadlines["/api/v1/events/:id/participants/send-invites"]).
+			To(BeNumerically("~", 5*time.Minute, time.Second))
+	})
+
+	It("should not limit the live check-in stream", func() {
+		call(http.MethodGet, "/api/v1/events/1/checkins/stream")
+	})
+
+	It("should not limit the streamed QR code archive", func() {
+		call(http.MethodGet, "/api/v1/events/1/participants/qrcodes.zip")
+	})
+
+	It("should not limit the streamed badge sheet", func() {
+		call(http.MethodGet, "/api/v1/events/1/participants/badges")
+	})
+})
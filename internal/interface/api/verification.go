@@ -0,0 +1,43 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// verifiedEmailRoutes lists the routes that unverified users cannot call in hard email
+// verification mode, keyed like idempotentRoutes. All of them require authentication.
+var verifiedEmailRoutes = map[string]bool{
+	http.MethodPost + " /events":           true,
+	http.MethodPost + " /events/:id/clone": true,
+}
+
+// NewVerifiedEmailRouter wraps router so that, in hard mode, each route listed in routes rejects
+// users who have not verified their email address; see middleware.RequireVerifiedEmail. These
+// routes authenticate before the check runs. Soft mode leaves the routes unchanged.
+func NewVerifiedEmailRouter(
+	router gin.IRouter,
+	routes map[string]bool,
+	authenticate gin.HandlerFunc,
+	userRepo repository.UserRepository,
+	mode config.EmailVerificationMode,
+	log *logger.Logger,
+) gin.IRouter {
+	return &hookedRouter{
+		IRouter: router,
+		hook: func(method, path string, handlers []gin.HandlerFunc) []gin.HandlerFunc {
+			if !routes[method+" "+path] || mode != config.EmailVerificationHard {
+				return handlers
+			}
+			return append(
+				[]gin.HandlerFunc{authenticate, middleware.RequireVerifiedEmail(userRepo, log)},
+				handlers...,
+			)
+		},
+	}
+}
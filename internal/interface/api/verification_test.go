@@ -0,0 +1,80 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/fumkob/ezqrin-server/config"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/interface/api"
+	"github.com/fumkob/ezqrin-server/internal/interface/api/middleware"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("NewVerifiedEmailRouter", func() {
+	var (
+		ctrl     *gomock.Controller
+		userRepo *mocks.MockUserRepository
+		userID   uuid.UUID
+	)
+
+	BeforeEach(func() {
+		gin.SetMode(gin.TestMode)
+		ctrl = gomock.NewController(GinkgoT())
+		userRepo = mocks.NewMockUserRepository(ctrl)
+		userID = uuid.New()
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	newRouter := func(mode config.EmailVerificationMode) *gin.Engine {
+		r := gin.New()
+		routes := api.NewVerifiedEmailRouter(r.Group("/api/v1"), map[string]bool{
+			http.MethodPost + " /events": true,
+		}, func(c *gin.Context) {
+			c.Set(middleware.ContextKeyUserID, userID)
+		}, userRepo, mode, &logger.Logger{Logger: zap.NewNop()})
+
+		created := func(c *gin.Context) { c.Status(http.StatusCreated) }
+		routes.POST("/events", created)
+		routes.POST("/participants", created)
+		return r
+	}
+
+	post := func(r *gin.Engine, path string) int {
+		w := httptest.NewRecorder()
+		r.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, nil))
+		return w.Code
+	}
+
+	When("email verification is hard", func() {
+		It("should reject unverified users on a listed route", func() {
+			userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(&entity.User{ID: userID}, nil)
+
+			Expect(post(newRouter(config.EmailVerificationHard), "/api/v1/events")).To(Equal(http.StatusForbidden))
+		})
+
+		It("should let verified users call a listed route", func() {
+			userRepo.EXPECT().FindByID(gomock.Any(), userID).Return(&entity.User{ID: userID, EmailVerified: true}, nil)
+
+			Expect(post(newRouter(config.EmailVerificationHard), "/api/v1/events")).To(Equal(http.StatusCreated))
+		})
+
+		It("should leave other routes unchanged", func() {
+			Expect(post(newRouter(config.EmailVerificationHard), "/api/v1/participants")).To(Equal(http.StatusCreated))
+		})
+	})
+
+	When("email verification is soft", func() {
+		It("should let unverified users call a listed route", func() {
+			Expect(post(newRouter(config.EmailVerificationSoft), "/api/v1/events")).To(Equal(http.StatusCreated))
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// ListAuditLogsInput defines the input for listing audit log entries.
+type ListAuditLogsInput struct {
+	ResourceType *entity.AuditResourceType
+	ResourceID   *uuid.UUID
+	Page         int
+	PerPage      int
+}
+
+// ListAuditLogsOutput defines the output for listing audit log entries.
+type ListAuditLogsOutput struct {
+	Entries    []*entity.AuditLog
+	TotalCount int64
+}
+
+//go:generate mockgen -destination=mocks/mock_usecase.go -package=mocks . Usecase
+
+// Usecase defines the interface for reading the audit log.
+type Usecase interface {
+	List(ctx context.Context, isAdmin bool, input ListAuditLogsInput) (ListAuditLogsOutput, error)
+}
@@ -0,0 +1,59 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Recorder records the audit log entries of the mutating operations of other use cases.
+// A nil Recorder records nothing.
+type Recorder struct {
+	auditRepo repository.AuditRepository
+	logger    *logger.Logger
+}
+
+// NewRecorder creates a new Recorder.
+func NewRecorder(auditRepo repository.AuditRepository, logger *logger.Logger) *Recorder {
+	return &Recorder{
+		auditRepo: auditRepo,
+		logger:    logger,
+	}
+}
+
+// Record records that actorID performed action on a resource, with the fields that differ
+// between its before and after snapshots; before is nil for creations and after for deletions.
+// It is called once the change is done, and failures are only logged, so that the change
+// stands even when it could not be recorded.
+func (r *Recorder) Record(
+	ctx context.Context,
+	actorID uuid.UUID,
+	action entity.AuditAction,
+	resourceType entity.AuditResourceType,
+	resourceID uuid.UUID,
+	before, after entity.AuditFields,
+) {
+	if r == nil {
+		return
+	}
+
+	// The change is done, so record it even if the request has been cancelled meanwhile
+	ctx = context.WithoutCancel(ctx)
+	entry, err := entity.NewAuditLog(actorID, action, resourceType, resourceID, before, after)
+	if err == nil {
+		err = r.auditRepo.Record(ctx, entry)
+	}
+	if err != nil {
+		r.logger.WithContext(ctx).Error("failed to record audit log entry",
+			zap.String("actor_id", actorID.String()),
+			zap.String("action", string(action)),
+			zap.String("resource_type", string(resourceType)),
+			zap.String("resource_id", resourceID.String()),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,48 @@
+// Package audit implements recording the audit log of mutating operations and reading it back.
+package audit
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+)
+
+var _ Usecase = (*auditUsecase)(nil)
+
+type auditUsecase struct {
+	auditRepo repository.AuditRepository
+}
+
+// NewUsecase creates a new instance of Audit Usecase.
+func NewUsecase(auditRepo repository.AuditRepository) Usecase {
+	return &auditUsecase{auditRepo: auditRepo}
+}
+
+func (u *auditUsecase) List(ctx context.Context, isAdmin bool, input ListAuditLogsInput) (ListAuditLogsOutput, error) {
+	if !isAdmin {
+		return ListAuditLogsOutput{}, apperrors.Forbidden("only admins can view the audit log")
+	}
+	if input.ResourceType != nil {
+		if err := entity.ValidateAuditResourceType(string(*input.ResourceType)); err != nil {
+			return ListAuditLogsOutput{}, apperrors.Validation(err.Error())
+		}
+	}
+
+	filter := repository.AuditLogFilter{
+		ResourceType: input.ResourceType,
+		ResourceID:   input.ResourceID,
+	}
+	offset := (input.Page - 1) * input.PerPage
+
+	entries, totalCount, err := u.auditRepo.List(ctx, filter, offset, input.PerPage)
+	if err != nil {
+		return ListAuditLogsOutput{}, err
+	}
+
+	return ListAuditLogsOutput{
+		Entries:    entries,
+		TotalCount: totalCount,
+	}, nil
+}
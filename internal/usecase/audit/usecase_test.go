@@ -0,0 +1,143 @@
+package audit_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"go.uber.org/zap/zaptest/observer"
+)
+
+var _ = Describe("Usecase", func() {
+	var (
+		ctrl      *gomock.Controller
+		auditRepo *mocks.MockAuditRepository
+		uc        audit.Usecase
+		ctx       context.Context
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		auditRepo = mocks.NewMockAuditRepository(ctrl)
+		uc = audit.NewUsecase(auditRepo)
+		ctx = context.Background()
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	Describe("List", func() {
+		It("should list the entries of a resource", func() {
+			resourceType := entity.AuditResourceParticipant
+			resourceID := uuid.New()
+			entry := &entity.AuditLog{ID: uuid.New(), ResourceType: resourceType, ResourceID: resourceID}
+			auditRepo.EXPECT().
+				List(ctx, repository.AuditLogFilter{ResourceType: &resourceType, ResourceID: &resourceID}, 20, 10).
+				Return([]*entity.AuditLog{entry}, int64(21), nil)
+
+			output, err := uc.List(ctx, true, audit.ListAuditLogsInput{
+				ResourceType: &resourceType,
+				ResourceID:   &resourceID,
+				Page:         3,
+				PerPage:      10,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.Entries).To(ConsistOf(entry))
+			Expect(output.TotalCount).To(Equal(int64(21)))
+		})
+
+		It("should reject non-admins", func() {
+			_, err := uc.List(ctx, false, audit.ListAuditLogsInput{Page: 1, PerPage: 10})
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should reject an unknown resource type", func() {
+			resourceType := entity.AuditResourceType("user")
+
+			_, err := uc.List(ctx, true, audit.ListAuditLogsInput{ResourceType: &resourceType, Page: 1, PerPage: 10})
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+		})
+	})
+})
+
+var _ = Describe("Recorder", func() {
+	var (
+		ctrl      *gomock.Controller
+		auditRepo *mocks.MockAuditRepository
+		logs      *observer.ObservedLogs
+		recorder  *audit.Recorder
+		actorID   uuid.UUID
+		eventID   uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		auditRepo = mocks.NewMockAuditRepository(ctrl)
+		var core zapcore.Core
+		core, logs = observer.New(zapcore.InfoLevel)
+		recorder = audit.NewRecorder(auditRepo, &logger.Logger{Logger: zap.New(core)})
+		actorID = uuid.New()
+		eventID = uuid.New()
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("should record the changed fields of a resource", func() {
+		auditRepo.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, entry *entity.AuditLog) error {
+				Expect(entry.ActorID).To(Equal(actorID))
+				Expect(entry.Action).To(Equal(entity.AuditActionUpdate))
+				Expect(entry.ResourceType).To(Equal(entity.AuditResourceEvent))
+				Expect(entry.ResourceID).To(Equal(eventID))
+				Expect(entry.Changes).To(HaveKey("name"))
+				return nil
+			},
+		)
+
+		recorder.Record(context.Background(), actorID, entity.AuditActionUpdate, entity.AuditResourceEvent, eventID,
+			entity.AuditFields{"name": "Tech Conf"}, entity.AuditFields{"name": "Tech Conf 2026"})
+
+		Expect(logs.Len()).To(BeZero())
+	})
+
+	It("should record even when the request was cancelled after the change", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		auditRepo.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, _ *entity.AuditLog) error { return ctx.Err() },
+		)
+
+		recorder.Record(ctx, actorID, entity.AuditActionDelete, entity.AuditResourceEvent, eventID, nil, nil)
+
+		Expect(logs.Len()).To(BeZero())
+	})
+
+	It("should log a failure to record instead of returning it", func() {
+		auditRepo.EXPECT().Record(gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+
+		recorder.Record(context.Background(), actorID, entity.AuditActionDelete, entity.AuditResourceEvent, eventID, nil, nil)
+
+		Expect(logs.FilterMessage("failed to record audit log entry").Len()).To(Equal(1))
+	})
+
+	It("should record nothing when nil", func() {
+		var nilRecorder *audit.Recorder
+
+		Expect(func() {
+			nilRecorder.Record(context.Background(), actorID, entity.AuditActionCreate, entity.AuditResourceEvent, eventID, nil, nil)
+		}).NotTo(Panic())
+	})
+})
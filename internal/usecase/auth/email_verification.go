@@ -0,0 +1,240 @@
+package auth
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	htmltemplate "html/template"
+	"sync"
+	texttemplate "text/template"
+	"time"
+
+	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/validator"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// emailVerificationKeyPrefix prefixes the cache keys of email verification tokens
+	emailVerificationKeyPrefix = "auth:email_verification:"
+
+	emailVerificationSubject = "Verify your email address"
+
+	// emailVerificationExpiryLayout formats the link expiry shown in verification emails
+	emailVerificationExpiryLayout = "2006-01-02 15:04 MST"
+)
+
+//go:embed templates/email_verification.html
+var emailVerificationHTMLTemplate string
+
+//go:embed templates/email_verification.txt
+var emailVerificationTextTemplate string
+
+// getEmailVerificationHTMLTemplate returns the parsed HTML verification template, parsing it once on first call.
+var getEmailVerificationHTMLTemplate = sync.OnceValues(func() (*htmltemplate.Template, error) {
+	return htmltemplate.New("email_verification").Parse(emailVerificationHTMLTemplate)
+})
+
+// getEmailVerificationTextTemplate returns the parsed plain-text verification template, parsing it once on first call.
+var getEmailVerificationTextTemplate = sync.OnceValues(func() (*texttemplate.Template, error) {
+	return texttemplate.New("email_verification_text").Parse(emailVerificationTextTemplate)
+})
+
+type emailVerificationData struct {
+	UserName  string
+	VerifyURL string
+	ExpiresAt string
+}
+
+// invalidVerificationToken returns the error for verification tokens that are malformed, expired or already used
+func invalidVerificationToken() error {
+	return apperrors.BadRequest("invalid or expired email verification token")
+}
+
+// EmailVerificationUseCase handles sending and confirming email verification links
+type EmailVerificationUseCase struct {
+	userRepo      repository.UserRepository
+	cacheRepo     repository.CacheRepository
+	emailSender   domainemail.Sender
+	jwtSecret     string
+	baseURL       string
+	tokenExpiry   time.Duration
+	plainTextOnly bool
+	logger        *logger.Logger
+}
+
+// NewEmailVerificationUseCase creates a new EmailVerificationUseCase.
+// Verification tokens are kept in cacheRepo and linked to baseURL; without either, verification
+// emails are not sent and email verification is unavailable.
+// When plainTextOnly is true, verification emails are sent without an HTML part.
+func NewEmailVerificationUseCase(
+	userRepo repository.UserRepository,
+	cacheRepo repository.CacheRepository,
+	emailSender domainemail.Sender,
+	jwtSecret string,
+	baseURL string,
+	tokenExpiry time.Duration,
+	plainTextOnly bool,
+	logger *logger.Logger,
+) *EmailVerificationUseCase {
+	return &EmailVerificationUseCase{
+		userRepo:      userRepo,
+		cacheRepo:     cacheRepo,
+		emailSender:   emailSender,
+		jwtSecret:     jwtSecret,
+		baseURL:       baseURL,
+		tokenExpiry:   tokenExpiry,
+		plainTextOnly: plainTextOnly,
+		logger:        logger,
+	}
+}
+
+// Resend emails the user a new verification link. Links sent earlier stay valid until they expire.
+func (u *EmailVerificationUseCase) Resend(ctx context.Context, userID uuid.UUID) error {
+	if !u.available() {
+		return apperrors.ServiceUnavailable("email verification is not configured")
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user.IsDeleted() {
+		return apperrors.NotFound("user not found")
+	}
+	if user.EmailVerified {
+		return apperrors.Conflict("email is already verified")
+	}
+
+	if err := u.send(ctx, user); err != nil {
+		u.logger.WithContext(ctx).Error("failed to send verification email", zap.Error(err))
+		return apperrors.Internal("failed to send verification email")
+	}
+
+	u.logger.WithContext(ctx).Info(fmt.Sprintf("verification email resent to user: %s", user.ID))
+	return nil
+}
+
+// Verify marks the email of the user the verification token was issued to as verified.
+// The token is invalidated on first use.
+func (u *EmailVerificationUseCase) Verify(ctx context.Context, token string) error {
+	if err := validator.ValidateRequired(token, "token"); err != nil {
+		return apperrors.Validation(err.Error())
+	}
+	if u.cacheRepo == nil {
+		return apperrors.ServiceUnavailable("email verification is not configured")
+	}
+	if !crypto.VerifyHMACToken(u.jwtSecret, token) {
+		return invalidVerificationToken()
+	}
+
+	value, err := u.cacheRepo.GetAndDelete(ctx, emailVerificationKey(token))
+	if err != nil {
+		u.logger.WithContext(ctx).Error("failed to consume email verification token", zap.Error(err))
+		return apperrors.Internal("failed to verify email")
+	}
+	userID, err := uuid.Parse(value)
+	if err != nil {
+		return invalidVerificationToken()
+	}
+
+	if err := u.userRepo.MarkEmailVerified(ctx, userID); err != nil {
+		if apperrors.IsNotFound(err) {
+			return invalidVerificationToken()
+		}
+		u.logger.WithContext(ctx).Error("failed to mark email as verified", zap.Error(err))
+		return apperrors.Internal("failed to verify email")
+	}
+
+	u.logger.WithContext(ctx).Info(fmt.Sprintf("email verified for user: %s", userID))
+	return nil
+}
+
+// sendOnRegister emails a newly registered user their verification link. Failures are only
+// logged, so that registration succeeds and the link can be resent.
+func (u *EmailVerificationUseCase) sendOnRegister(ctx context.Context, user *entity.User) {
+	if !u.available() {
+		return
+	}
+	if err := u.send(ctx, user); err != nil {
+		u.logger.WithContext(ctx).Warn("failed to send verification email on registration",
+			zap.String("user_id", user.ID.String()),
+			zap.Error(err),
+		)
+	}
+}
+
+// available reports whether verification emails can be sent
+func (u *EmailVerificationUseCase) available() bool {
+	return u.cacheRepo != nil && u.baseURL != ""
+}
+
+// send issues a single-use verification token for the user and emails them its link
+func (u *EmailVerificationUseCase) send(ctx context.Context, user *entity.User) error {
+	token, err := crypto.GenerateHMACSignedToken(u.jwtSecret)
+	if err != nil {
+		return fmt.Errorf("failed to generate email verification token: %w", err)
+	}
+	if err := u.cacheRepo.Set(ctx, emailVerificationKey(token), user.ID.String(), u.tokenExpiry); err != nil {
+		return fmt.Errorf("failed to store email verification token: %w", err)
+	}
+
+	data := emailVerificationData{
+		UserName:  user.Name,
+		VerifyURL: crypto.GenerateInviteAcceptURL(u.baseURL, token),
+		ExpiresAt: time.Now().Add(u.tokenExpiry).UTC().Format(emailVerificationExpiryLayout),
+	}
+	if data.VerifyURL == "" {
+		return fmt.Errorf("invalid email verification URL %q", u.baseURL)
+	}
+
+	msg := domainemail.Message{
+		To:      user.Email,
+		Subject: emailVerificationSubject,
+	}
+	if msg.TextBody, err = renderEmailVerificationText(data); err != nil {
+		return err
+	}
+	if !u.plainTextOnly {
+		if msg.Body, err = renderEmailVerificationHTML(data); err != nil {
+			return err
+		}
+	}
+	return u.emailSender.Send(ctx, msg)
+}
+
+func renderEmailVerificationHTML(data emailVerificationData) (string, error) {
+	tmpl, err := getEmailVerificationHTMLTemplate()
+	if err != nil {
+		return "", fmt.Errorf("failed to parse email verification template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render email verification template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+func renderEmailVerificationText(data emailVerificationData) (string, error) {
+	tmpl, err := getEmailVerificationTextTemplate()
+	if err != nil {
+		return "", fmt.Errorf("failed to parse text email verification template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render text email verification template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// emailVerificationKey returns the cache key of an email verification token
+func emailVerificationKey(token string) string {
+	return emailVerificationKeyPrefix + token
+}
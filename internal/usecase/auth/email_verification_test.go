@@ -0,0 +1,167 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
+	emailMocks "github.com/fumkob/ezqrin-server/internal/domain/email/mocks"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/auth"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+const (
+	testVerifyURL         = "https://app.example.com/verify-email"
+	testVerificationTTL   = 24 * time.Hour
+	testVerificationEmail = "organizer@example.com"
+)
+
+var _ = Describe("EmailVerificationUseCase", func() {
+	var (
+		ctrl            *gomock.Controller
+		mockUserRepo    *mocks.MockUserRepository
+		mockCacheRepo   *mocks.MockCacheRepository
+		mockEmailSender *emailMocks.MockSender
+		nopLogger       *logger.Logger
+		useCase         *auth.EmailVerificationUseCase
+		ctx             context.Context
+		user            *entity.User
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockUserRepo = mocks.NewMockUserRepository(ctrl)
+		mockCacheRepo = mocks.NewMockCacheRepository(ctrl)
+		mockEmailSender = emailMocks.NewMockSender(ctrl)
+		nopLogger = &logger.Logger{Logger: zap.NewNop()}
+		useCase = auth.NewEmailVerificationUseCase(
+			mockUserRepo, mockCacheRepo, mockEmailSender, testJWTSecret, testVerifyURL, testVerificationTTL, false, nopLogger,
+		)
+		ctx = context.Background()
+		user = &entity.User{ID: uuid.New(), Email: testVerificationEmail, Name: "Organizer", Role: entity.RoleOrganizer}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	// tokenOf returns the verification token linked from a sent email
+	tokenOf := func(msg domainemail.Message) string {
+		for _, field := range strings.Fields(msg.TextBody) {
+			u, err := url.Parse(field)
+			if err == nil && u.Query().Get("token") != "" {
+				return u.Query().Get("token")
+			}
+		}
+		return ""
+	}
+
+	Describe("Resend", func() {
+		It("should store a signed token for the user and email its link", func() {
+			var storedKey string
+			var sent domainemail.Message
+			mockUserRepo.EXPECT().FindByID(ctx, user.ID).Return(user, nil)
+			mockCacheRepo.EXPECT().Set(ctx, gomock.Any(), user.ID.String(), testVerificationTTL).DoAndReturn(
+				func(_ context.Context, key, _ string, _ time.Duration) error {
+					storedKey = key
+					return nil
+				},
+			)
+			mockEmailSender.EXPECT().Send(ctx, gomock.Any()).DoAndReturn(
+				func(_ context.Context, msg domainemail.Message) error {
+					sent = msg
+					return nil
+				},
+			)
+
+			Expect(useCase.Resend(ctx, user.ID)).To(Succeed())
+
+			Expect(sent.To).To(Equal(testVerificationEmail))
+			Expect(sent.Body).To(ContainSubstring(testVerifyURL))
+			token := tokenOf(sent)
+			Expect(crypto.VerifyHMACToken(testJWTSecret, token)).To(BeTrue())
+			Expect(storedKey).To(HaveSuffix(token))
+		})
+
+		It("should reject a user whose email is already verified", func() {
+			user.EmailVerified = true
+			mockUserRepo.EXPECT().FindByID(ctx, user.ID).Return(user, nil)
+
+			err := useCase.Resend(ctx, user.ID)
+
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+		})
+
+		It("should report an email that could not be sent", func() {
+			mockUserRepo.EXPECT().FindByID(ctx, user.ID).Return(user, nil)
+			mockCacheRepo.EXPECT().Set(ctx, gomock.Any(), user.ID.String(), testVerificationTTL).Return(nil)
+			mockEmailSender.EXPECT().Send(ctx, gomock.Any()).Return(errors.New("smtp unavailable"))
+
+			err := useCase.Resend(ctx, user.ID)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeInternal))
+		})
+
+		It("should be unavailable without a verification URL", func() {
+			useCase = auth.NewEmailVerificationUseCase(
+				mockUserRepo, mockCacheRepo, mockEmailSender, testJWTSecret, "", testVerificationTTL, false, nopLogger,
+			)
+
+			err := useCase.Resend(ctx, user.ID)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeServiceUnavailable))
+		})
+	})
+
+	Describe("Verify", func() {
+		var token string
+
+		BeforeEach(func() {
+			var err error
+			token, err = crypto.GenerateHMACSignedToken(testJWTSecret)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should consume the token and mark the email as verified", func() {
+			mockCacheRepo.EXPECT().GetAndDelete(ctx, "auth:email_verification:"+token).Return(user.ID.String(), nil)
+			mockUserRepo.EXPECT().MarkEmailVerified(ctx, user.ID).Return(nil)
+
+			Expect(useCase.Verify(ctx, token)).To(Succeed())
+		})
+
+		It("should reject a token that was already used or has expired", func() {
+			mockCacheRepo.EXPECT().GetAndDelete(ctx, "auth:email_verification:"+token).Return("", nil)
+
+			err := useCase.Verify(ctx, token)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+
+		It("should reject a token with an invalid signature without consuming it", func() {
+			err := useCase.Verify(ctx, token+"x")
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+
+		It("should reject the token of a deleted user", func() {
+			mockCacheRepo.EXPECT().GetAndDelete(ctx, gomock.Any()).Return(user.ID.String(), nil)
+			mockUserRepo.EXPECT().MarkEmailVerified(ctx, user.ID).Return(apperrors.NotFound("user not found"))
+
+			err := useCase.Verify(ctx, token)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
 	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
@@ -16,23 +17,34 @@ import (
 // LoginUseCase handles user login
 type LoginUseCase struct {
 	userRepo            repository.UserRepository
-	jwtSecret           string
+	sessionRepo         repository.SessionRepository
+	signer              *crypto.TokenSigner
+	twoFactorKey        string
+	accessExpiry        time.Duration
 	refreshExpiryWeb    time.Duration
 	refreshExpiryMobile time.Duration
 	logger              *logger.Logger
 }
 
-// NewLoginUseCase creates a new LoginUseCase
+// NewLoginUseCase creates a new LoginUseCase.
+// twoFactorKey decrypts the TOTP secrets of users with two-factor authentication enabled.
+// Each login starts a session saved in sessionRepo.
 func NewLoginUseCase(
 	userRepo repository.UserRepository,
-	jwtSecret string,
+	sessionRepo repository.SessionRepository,
+	signer *crypto.TokenSigner,
+	twoFactorKey string,
+	accessExpiry time.Duration,
 	refreshExpiryWeb time.Duration,
 	refreshExpiryMobile time.Duration,
 	logger *logger.Logger,
 ) *LoginUseCase {
 	return &LoginUseCase{
 		userRepo:            userRepo,
-		jwtSecret:           jwtSecret,
+		sessionRepo:         sessionRepo,
+		signer:              signer,
+		twoFactorKey:        twoFactorKey,
+		accessExpiry:        accessExpiry,
 		refreshExpiryWeb:    refreshExpiryWeb,
 		refreshExpiryMobile: refreshExpiryMobile,
 		logger:              logger,
@@ -43,7 +55,11 @@ func NewLoginUseCase(
 type LoginRequest struct {
 	Email      string
 	Password   string
-	ClientType string // "web" or "mobile", resolved from User-Agent by handler
+	ClientType string // "web" or "mobile", requested by the client or resolved from User-Agent by handler
+	Device     string // User-Agent of the client, shown in the user's session list
+	// TOTPCode is the second factor of users with two-factor authentication, TOTP or backup code.
+	// When empty, their login returns a challenge instead.
+	TOTPCode string
 }
 
 // Execute executes the user login use case
@@ -54,14 +70,18 @@ func (u *LoginUseCase) Execute(ctx context.Context, req *LoginRequest) (*AuthRes
 	}
 
 	// Find user by email with password hash
+	// Unknown and deleted accounts still pay for a bcrypt comparison, so the response time
+	// does not reveal which emails are registered
 	user, err := u.userRepo.FindByEmailWithPassword(ctx, req.Email)
 	if err != nil {
+		_ = crypto.DummyComparePassword(req.Password)
 		u.logger.WithContext(ctx).Warn("login attempt with non-existent email", zap.Error(err))
 		return nil, apperrors.Unauthorized("invalid credentials")
 	}
 
 	// Check if user is deleted
 	if user.IsDeleted() {
+		_ = crypto.DummyComparePassword(req.Password)
 		u.logger.WithContext(ctx).Warn(fmt.Sprintf("login attempt for deleted user: %s", user.ID))
 		return nil, apperrors.Unauthorized("invalid credentials")
 	}
@@ -72,29 +92,41 @@ func (u *LoginUseCase) Execute(ctx context.Context, req *LoginRequest) (*AuthRes
 		return nil, apperrors.Unauthorized("invalid credentials")
 	}
 
+	// Deactivated users are told so only once they have proven who they are
+	if user.IsDeactivated() {
+		u.logger.WithContext(ctx).Warn(fmt.Sprintf("login attempt for deactivated user: %s", user.ID))
+		return nil, apperrors.Unauthorized("user account has been deactivated")
+	}
+
+	// Users with two-factor authentication only get a challenge until they enter a code
+	if user.TwoFactorEnabled {
+		if req.TOTPCode != "" {
+			return u.loginWithCode(ctx, user, req.TOTPCode, req.ClientType, req.Device)
+		}
+		return u.issueTwoFactorChallenge(ctx, user, req.ClientType)
+	}
+
+	return u.issueTokens(ctx, user, req.ClientType, req.Device)
+}
+
+// issueTokens starts a session of user on device and generates its access and refresh tokens,
+// which complete the login
+func (u *LoginUseCase) issueTokens(
+	ctx context.Context,
+	user *entity.User,
+	clientType, device string,
+) (*AuthResponse, error) {
 	// Determine refresh token expiry based on client type
 	clientType, refreshExpiry := resolveRefreshExpiry(
-		req.ClientType, u.refreshExpiryWeb, u.refreshExpiryMobile,
+		clientType, u.refreshExpiryWeb, u.refreshExpiryMobile,
 	)
 
-	// Generate access token
-	accessToken, err := crypto.GenerateAccessToken(user.ID.String(), string(user.Role), u.jwtSecret, AccessTokenExpiry)
-	if err != nil {
-		u.logger.WithContext(ctx).Error("failed to generate access token", zap.Error(err))
-		return nil, apperrors.Internal("failed to generate access token")
-	}
-
-	// Generate refresh token with client type embedded in claims
-	refreshToken, err := crypto.GenerateRefreshToken(
-		user.ID.String(),
-		string(user.Role),
-		u.jwtSecret,
-		clientType,
-		refreshExpiry,
+	session := entity.NewSession(user.ID, clientType, device, time.Now())
+	accessToken, refreshToken, err := issueSessionTokens(
+		ctx, u.sessionRepo, u.signer, user, session, u.accessExpiry, refreshExpiry, u.logger,
 	)
 	if err != nil {
-		u.logger.WithContext(ctx).Error("failed to generate refresh token", zap.Error(err))
-		return nil, apperrors.Internal("failed to generate refresh token")
+		return nil, err
 	}
 
 	u.logger.WithContext(ctx).Info(fmt.Sprintf("user logged in successfully: %s", user.ID))
@@ -106,7 +138,7 @@ func (u *LoginUseCase) Execute(ctx context.Context, req *LoginRequest) (*AuthRes
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 		TokenType:    "Bearer",
-		ExpiresIn:    int(AccessTokenExpiry.Seconds()),
+		ExpiresIn:    int(u.accessExpiry.Seconds()),
 		User:         user,
 	}, nil
 }
@@ -123,5 +155,10 @@ func (u *LoginUseCase) validateRequest(req *LoginRequest) error {
 		return apperrors.Validation(err.Error())
 	}
 
+	// Validate client type
+	if err := checkClientType(req.ClientType); err != nil {
+		return apperrors.Validation(err.Error())
+	}
+
 	return nil
 }
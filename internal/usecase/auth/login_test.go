@@ -20,13 +20,14 @@ import (
 
 var _ = Describe("LoginUseCase", func() {
 	var (
-		ctrl         *gomock.Controller
-		mockUserRepo *mocks.MockUserRepository
-		useCase      *auth.LoginUseCase
-		ctx          context.Context
-		nopLogger    *logger.Logger
-		testUser     *entity.User
-		passwordHash string
+		ctrl            *gomock.Controller
+		mockUserRepo    *mocks.MockUserRepository
+		mockSessionRepo *mocks.MockSessionRepository
+		useCase         *auth.LoginUseCase
+		ctx             context.Context
+		nopLogger       *logger.Logger
+		testUser        *entity.User
+		passwordHash    string
 	)
 
 	const testPassword = "ValidPassword1!"
@@ -34,10 +35,15 @@ var _ = Describe("LoginUseCase", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockUserRepo = mocks.NewMockUserRepository(ctrl)
+		mockSessionRepo = mocks.NewMockSessionRepository(ctrl)
+		mockSessionRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		nopLogger = &logger.Logger{Logger: zap.NewNop()}
 		useCase = auth.NewLoginUseCase(
 			mockUserRepo,
-			testJWTSecret,
+			mockSessionRepo,
+			testSigner,
+			testTwoFactorKey,
+			auth.AccessTokenExpiry,
 			auth.RefreshTokenExpiryWeb,
 			auth.RefreshTokenExpiryMobile,
 			nopLogger,
@@ -141,7 +147,87 @@ var _ = Describe("LoginUseCase", func() {
 			})
 		})
 
+		When("logging in from a device", func() {
+			It("should save a session named after the device that the tokens belong to", func() {
+				sessionRepo := mocks.NewMockSessionRepository(ctrl)
+				uc := auth.NewLoginUseCase(
+					mockUserRepo,
+					sessionRepo,
+					testSigner,
+					testTwoFactorKey,
+					auth.AccessTokenExpiry,
+					auth.RefreshTokenExpiryWeb,
+					auth.RefreshTokenExpiryMobile,
+					nopLogger,
+				)
+				mockUserRepo.EXPECT().
+					FindByEmailWithPassword(ctx, "bob@example.com").
+					Return(testUser, nil)
+				var saved *entity.Session
+				sessionRepo.EXPECT().Save(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, session *entity.Session) error {
+						saved = session
+						return nil
+					},
+				)
+
+				result, err := uc.Execute(ctx, &auth.LoginRequest{
+					Email:    "bob@example.com",
+					Password: testPassword,
+					Device:   "Mozilla/5.0",
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(saved.UserID).To(Equal(testUser.ID))
+				Expect(saved.Device).To(Equal("Mozilla/5.0"))
+				Expect(saved.RefreshToken).To(Equal(result.RefreshToken))
+				accessClaims, parseErr := crypto.ParseToken(result.AccessToken, testJWTSecret)
+				Expect(parseErr).NotTo(HaveOccurred())
+				Expect(accessClaims.SessionID).To(Equal(saved.ID.String()))
+			})
+		})
+
+		When("the access token expiry is configured", func() {
+			It("should issue access tokens with that expiry and report it as expires_in", func() {
+				uc := auth.NewLoginUseCase(
+					mockUserRepo,
+					mockSessionRepo,
+					testSigner,
+					testTwoFactorKey,
+					time.Hour,
+					auth.RefreshTokenExpiryWeb,
+					auth.RefreshTokenExpiryMobile,
+					nopLogger,
+				)
+				mockUserRepo.EXPECT().
+					FindByEmailWithPassword(ctx, "bob@example.com").
+					Return(testUser, nil)
+
+				result, err := uc.Execute(ctx, &auth.LoginRequest{Email: "bob@example.com", Password: testPassword})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.ExpiresIn).To(Equal(3600))
+				accessClaims, parseErr := crypto.ParseToken(result.AccessToken, testJWTSecret)
+				Expect(parseErr).NotTo(HaveOccurred())
+				Expect(accessClaims.ExpiresAt.Time).To(BeTemporally("~", time.Now().Add(time.Hour), 5*time.Second))
+			})
+		})
+
 		When("validating the login request", func() {
+			Context("with an unknown client type", func() {
+				It("should return a validation error", func() {
+					result, err := useCase.Execute(ctx, &auth.LoginRequest{
+						Email:      "bob@example.com",
+						Password:   testPassword,
+						ClientType: "desktop",
+					})
+
+					Expect(result).To(BeNil())
+					Expect(apperrors.IsValidation(err)).To(BeTrue())
+					Expect(err.Error()).To(ContainSubstring("platform"))
+				})
+			})
+
 			Context("with empty email", func() {
 				It("should return a validation error", func() {
 					req := &auth.LoginRequest{
@@ -215,6 +301,35 @@ var _ = Describe("LoginUseCase", func() {
 					Expect(appErr.Code).To(Equal(apperrors.CodeUnauthorized))
 					Expect(appErr.Message).To(Equal("invalid credentials"))
 				})
+
+				It("should take about as long as a wrong password for an existing account", func() {
+					existingUser := &entity.User{
+						ID:           uuid.New(),
+						Email:        "known@example.com",
+						PasswordHash: passwordHash,
+						Role:         entity.RoleOrganizer,
+					}
+					mockUserRepo.EXPECT().
+						FindByEmailWithPassword(ctx, "known@example.com").
+						Return(existingUser, nil)
+					mockUserRepo.EXPECT().
+						FindByEmailWithPassword(ctx, "unknown@example.com").
+						Return(nil, errors.New("user not found")).
+						Times(2)
+					// Warm the dummy hash so only the comparison is measured
+					_, _ = useCase.Execute(ctx, &auth.LoginRequest{Email: "unknown@example.com", Password: "Wrong1!"})
+
+					start := time.Now()
+					_, knownErr := useCase.Execute(ctx, &auth.LoginRequest{Email: "known@example.com", Password: "Wrong1!"})
+					knownDuration := time.Since(start)
+
+					start = time.Now()
+					_, unknownErr := useCase.Execute(ctx, &auth.LoginRequest{Email: "unknown@example.com", Password: "Wrong1!"})
+					unknownDuration := time.Since(start)
+
+					Expect(unknownErr).To(Equal(knownErr))
+					Expect(unknownDuration).To(BeNumerically(">", knownDuration/2))
+				})
 			})
 		})
 
@@ -254,6 +369,50 @@ var _ = Describe("LoginUseCase", func() {
 			})
 		})
 
+		When("an admin has deactivated the user", func() {
+			It("should refuse the login once the password is verified", func() {
+				deactivatedAt := time.Now()
+				deactivatedUser := &entity.User{
+					ID:            uuid.New(),
+					Email:         "deactivated@example.com",
+					PasswordHash:  passwordHash,
+					Name:          "Deactivated User",
+					Role:          entity.RoleOrganizer,
+					DeactivatedAt: &deactivatedAt,
+				}
+
+				mockUserRepo.EXPECT().
+					FindByEmailWithPassword(ctx, "deactivated@example.com").
+					Return(deactivatedUser, nil)
+
+				result, err := useCase.Execute(ctx, &auth.LoginRequest{
+					Email:    "deactivated@example.com",
+					Password: testPassword,
+				})
+
+				Expect(result).To(BeNil())
+				Expect(apperrors.IsUnauthorized(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("user account has been deactivated"))
+			})
+
+			It("should not reveal the deactivation for a wrong password", func() {
+				deactivatedAt := time.Now()
+				mockUserRepo.EXPECT().
+					FindByEmailWithPassword(ctx, "deactivated@example.com").
+					Return(&entity.User{
+						ID: uuid.New(), Email: "deactivated@example.com", PasswordHash: passwordHash,
+						Role: entity.RoleOrganizer, DeactivatedAt: &deactivatedAt,
+					}, nil)
+
+				_, err := useCase.Execute(ctx, &auth.LoginRequest{
+					Email:    "deactivated@example.com",
+					Password: "WrongPassword1!",
+				})
+
+				Expect(err).To(MatchError(ContainSubstring("invalid credentials")))
+			})
+		})
+
 		When("the password does not match", func() {
 			Context("and ComparePassword fails", func() {
 				It("should return an unauthorized error to avoid user enumeration", func() {
@@ -278,14 +437,17 @@ var _ = Describe("LoginUseCase", func() {
 			})
 		})
 
-		When("generating tokens fails due to an empty JWT secret", func() {
-			Context("and the use case is constructed with an empty secret", func() {
+		When("generating tokens fails due to an invalid refresh token expiry", func() {
+			Context("and the use case is constructed with a zero expiry", func() {
 				It("should return an internal error", func() {
-					useCaseNoSecret := auth.NewLoginUseCase(
+					useCaseZeroExpiry := auth.NewLoginUseCase(
 						mockUserRepo,
-						"", // empty secret causes token generation to fail
-						auth.RefreshTokenExpiryWeb,
-						auth.RefreshTokenExpiryMobile,
+						mockSessionRepo,
+						testSigner,
+						testTwoFactorKey,
+						auth.AccessTokenExpiry,
+						0, // zero expiry causes refresh token generation to fail
+						0,
 						nopLogger,
 					)
 
@@ -298,7 +460,7 @@ var _ = Describe("LoginUseCase", func() {
 						Password: testPassword,
 					}
 
-					result, err := useCaseNoSecret.Execute(ctx, req)
+					result, err := useCaseZeroExpiry.Execute(ctx, req)
 
 					Expect(err).To(HaveOccurred())
 					Expect(result).To(BeNil())
@@ -0,0 +1,189 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/validator"
+	"go.uber.org/zap"
+)
+
+// TwoFactorChallengeExpiry is how long a user has to enter the second factor after the password
+const TwoFactorChallengeExpiry = 5 * time.Minute
+
+// totpCodePattern matches codes from an authenticator app; anything else is tried as a backup code
+var totpCodePattern = regexp.MustCompile(`^[0-9]{6}$`)
+
+// TwoFactorChallenge is returned by a password login of a user with two-factor authentication
+type TwoFactorChallenge struct {
+	Token     string // Challenge token to send with the code to LoginWithTwoFactor
+	ExpiresIn int    // Seconds until the challenge expires
+}
+
+// TwoFactorLoginRequest represents the input for completing a login with a second factor
+type TwoFactorLoginRequest struct {
+	ChallengeToken string
+	Code           string // TOTP code from the authenticator app, or an unused backup code
+	Device         string // User-Agent of the client, shown in the user's session list
+}
+
+// issueTwoFactorChallenge returns a challenge for a user who entered a valid password but
+// must still enter a second factor
+func (u *LoginUseCase) issueTwoFactorChallenge(
+	ctx context.Context,
+	user *entity.User,
+	clientType string,
+) (*AuthResponse, error) {
+	token, err := u.signer.GenerateTwoFactorChallengeToken(
+		user.ID.String(), string(user.Role), clientType, TwoFactorChallengeExpiry,
+	)
+	if err != nil {
+		u.logger.WithContext(ctx).Error("failed to generate two-factor challenge", zap.Error(err))
+		return nil, apperrors.Internal("failed to generate two-factor challenge")
+	}
+
+	u.logger.WithContext(ctx).Info(fmt.Sprintf("two-factor challenge issued for user: %s", user.ID))
+
+	return &AuthResponse{
+		TwoFactorChallenge: &TwoFactorChallenge{
+			Token:     token,
+			ExpiresIn: int(TwoFactorChallengeExpiry.Seconds()),
+		},
+	}, nil
+}
+
+// LoginWithTwoFactor completes a login that returned a two-factor challenge. The code is
+// either the current TOTP code, which is accepted only once, or an unused backup code,
+// which is consumed.
+func (u *LoginUseCase) LoginWithTwoFactor(ctx context.Context, req *TwoFactorLoginRequest) (*AuthResponse, error) {
+	if err := validator.ValidateRequired(req.ChallengeToken, "challenge"); err != nil {
+		return nil, apperrors.Validation(err.Error())
+	}
+	if err := validator.ValidateRequired(req.Code, "code"); err != nil {
+		return nil, apperrors.Validation(err.Error())
+	}
+
+	claims, err := u.signer.ParseToken(req.ChallengeToken)
+	if err != nil {
+		if errors.Is(err, crypto.ErrExpiredToken) {
+			return nil, apperrors.Unauthorized("two-factor challenge has expired")
+		}
+		u.logger.WithContext(ctx).Warn("invalid two-factor challenge", zap.Error(err))
+		return nil, apperrors.Unauthorized("invalid two-factor challenge")
+	}
+	if claims.TokenType != crypto.TokenTypeTwoFactorChallenge {
+		u.logger.WithContext(ctx).Warn("attempted two-factor login with non-challenge token")
+		return nil, apperrors.Unauthorized("invalid two-factor challenge")
+	}
+
+	user, err := u.userRepo.FindByID(ctx, claims.UserID)
+	if err != nil || user.IsDeleted() {
+		u.logger.WithContext(ctx).Warn(fmt.Sprintf("two-factor login for unknown user: %s", claims.UserID))
+		return nil, apperrors.Unauthorized("invalid two-factor challenge")
+	}
+	if user.IsDeactivated() {
+		u.logger.WithContext(ctx).Warn(fmt.Sprintf("two-factor login for deactivated user: %s", user.ID))
+		return nil, apperrors.Unauthorized("user account has been deactivated")
+	}
+
+	twoFactor, err := u.userRepo.FindTwoFactor(ctx, user.ID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil, apperrors.Unauthorized("invalid two-factor challenge")
+		}
+		return nil, err
+	}
+	if !twoFactor.IsEnabled() {
+		return nil, apperrors.Unauthorized("invalid two-factor challenge")
+	}
+
+	if err := u.verifySecondFactor(ctx, user, twoFactor, req.Code); err != nil {
+		return nil, err
+	}
+
+	return u.issueTokens(ctx, user, claims.ClientType, req.Device)
+}
+
+// loginWithCode completes the password login of a user with two-factor authentication who
+// sent their code with the password, without a challenge round trip
+func (u *LoginUseCase) loginWithCode(
+	ctx context.Context,
+	user *entity.User,
+	code string,
+	clientType, device string,
+) (*AuthResponse, error) {
+	twoFactor, err := u.userRepo.FindTwoFactor(ctx, user.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.verifySecondFactor(ctx, user, twoFactor, code); err != nil {
+		return nil, err
+	}
+
+	return u.issueTokens(ctx, user, clientType, device)
+}
+
+// verifySecondFactor accepts code as the second factor of user, unless the user is locked out
+// after too many invalid codes. Invalid codes are recorded towards the lockout.
+func (u *LoginUseCase) verifySecondFactor(
+	ctx context.Context,
+	user *entity.User,
+	twoFactor *entity.UserTwoFactor,
+	code string,
+) error {
+	now := time.Now()
+	if twoFactor.IsLockedOut(now) {
+		u.logger.WithContext(ctx).Warn(fmt.Sprintf("two-factor login locked out for user: %s", user.ID))
+		return apperrors.TooManyRequests("too many invalid two-factor codes, try again later")
+	}
+
+	accepted, err := u.acceptSecondFactor(ctx, twoFactor, code, now)
+	if err != nil {
+		return err
+	}
+	if !accepted {
+		if _, err := u.userRepo.RecordTwoFactorFailure(ctx, user.ID, now.Add(-entity.TwoFactorLockoutWindow)); err != nil {
+			u.logger.WithContext(ctx).Error("failed to record two-factor failure", zap.Error(err))
+		}
+		u.logger.WithContext(ctx).Warn(fmt.Sprintf("invalid two-factor code for user: %s", user.ID))
+		return apperrors.Unauthorized("invalid two-factor code")
+	}
+	return nil
+}
+
+// acceptSecondFactor checks code against the user's TOTP secret or backup codes and marks
+// it as used. It returns false for invalid and already used codes.
+func (u *LoginUseCase) acceptSecondFactor(
+	ctx context.Context,
+	twoFactor *entity.UserTwoFactor,
+	code string,
+	now time.Time,
+) (bool, error) {
+	if !totpCodePattern.MatchString(code) {
+		return u.userRepo.ConsumeBackupCode(ctx, twoFactor.UserID, crypto.HashBackupCode(code))
+	}
+
+	if u.twoFactorKey == "" {
+		u.logger.WithContext(ctx).Error("two-factor login attempted without an encryption key configured")
+		return false, apperrors.ServiceUnavailable("two-factor authentication is not configured")
+	}
+	secret, err := crypto.DecryptSecret(twoFactor.EncryptedSecret, u.twoFactorKey)
+	if err != nil {
+		u.logger.WithContext(ctx).Error("failed to decrypt TOTP secret", zap.Error(err))
+		return false, apperrors.Internal("failed to verify two-factor code")
+	}
+
+	step, ok := crypto.ValidateTOTP(secret, code, now)
+	if !ok {
+		return false, nil
+	}
+	// A code can only be used once, even within its validity window
+	return u.userRepo.ConsumeTwoFactorStep(ctx, twoFactor.UserID, step)
+}
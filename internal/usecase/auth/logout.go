@@ -6,26 +6,31 @@ import (
 
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
 	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
 // LogoutUseCase handles user logout
 type LogoutUseCase struct {
+	sessionRepo   repository.SessionRepository
 	blacklistRepo repository.TokenBlacklistRepository
-	jwtSecret     string
+	signer        *crypto.TokenSigner
 	logger        *logger.Logger
 }
 
 // NewLogoutUseCase creates a new LogoutUseCase
 func NewLogoutUseCase(
+	sessionRepo repository.SessionRepository,
 	blacklistRepo repository.TokenBlacklistRepository,
-	jwtSecret string,
+	signer *crypto.TokenSigner,
 	logger *logger.Logger,
 ) *LogoutUseCase {
 	return &LogoutUseCase{
+		sessionRepo:   sessionRepo,
 		blacklistRepo: blacklistRepo,
-		jwtSecret:     jwtSecret,
+		signer:        signer,
 		logger:        logger,
 	}
 }
@@ -58,6 +63,10 @@ func (u *LogoutUseCase) Execute(ctx context.Context, req *LogoutRequest) (*Logou
 			// Log but don't fail - best effort
 			u.logger.WithContext(ctx).Warn("failed to blacklist refresh token", zap.Error(err))
 		}
+		if err := u.endSession(ctx, req.RefreshToken); err != nil {
+			// Log but don't fail - the blacklisted refresh token can no longer be used anyway
+			u.logger.WithContext(ctx).Warn("failed to remove session", zap.Error(err))
+		}
 	}
 
 	u.logger.WithContext(ctx).Info("user logged out successfully")
@@ -70,7 +79,7 @@ func (u *LogoutUseCase) Execute(ctx context.Context, req *LogoutRequest) (*Logou
 // blacklistToken blacklists a token with appropriate TTL
 func (u *LogoutUseCase) blacklistToken(ctx context.Context, token string) error {
 	// Parse token to get expiry time
-	claims, err := crypto.ParseToken(token, u.jwtSecret)
+	claims, err := u.signer.ParseToken(token)
 	if err != nil {
 		// For logout, we allow expired tokens - no need to blacklist
 		if err == crypto.ErrExpiredToken {
@@ -92,3 +101,21 @@ func (u *LogoutUseCase) blacklistToken(ctx context.Context, token string) error
 	// Add token to blacklist
 	return u.blacklistRepo.AddToBlacklist(ctx, token, ttl)
 }
+
+// endSession removes the session of a refresh token so it is no longer listed
+func (u *LogoutUseCase) endSession(ctx context.Context, refreshToken string) error {
+	claims, err := u.signer.ParseToken(refreshToken)
+	if err != nil || claims.SessionID == "" {
+		// Expired, invalid or issued before sessions were tracked - nothing to remove
+		return nil
+	}
+
+	sessionID, err := uuid.Parse(claims.SessionID)
+	if err != nil {
+		return nil
+	}
+	if err := u.sessionRepo.Delete(ctx, claims.UserID, sessionID); err != nil && !apperrors.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
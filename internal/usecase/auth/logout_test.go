@@ -19,6 +19,7 @@ import (
 var _ = Describe("LogoutUseCase", func() {
 	var (
 		ctrl              *gomock.Controller
+		mockSessionRepo   *mocks.MockSessionRepository
 		mockBlacklistRepo *mocks.MockTokenBlacklistRepository
 		useCase           *auth.LogoutUseCase
 		ctx               context.Context
@@ -27,9 +28,10 @@ var _ = Describe("LogoutUseCase", func() {
 
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
+		mockSessionRepo = mocks.NewMockSessionRepository(ctrl)
 		mockBlacklistRepo = mocks.NewMockTokenBlacklistRepository(ctrl)
 		nopLoggerLogout = &logger.Logger{Logger: zap.NewNop()}
-		useCase = auth.NewLogoutUseCase(mockBlacklistRepo, testJWTSecret, nopLoggerLogout)
+		useCase = auth.NewLogoutUseCase(mockSessionRepo, mockBlacklistRepo, testSigner, nopLoggerLogout)
 		ctx = context.Background()
 	})
 
@@ -218,5 +220,24 @@ var _ = Describe("LogoutUseCase", func() {
 				})
 			})
 		})
+
+		When("the refresh token belongs to a tracked session", func() {
+			It("should remove the session so it is no longer listed", func() {
+				userID := uuid.New()
+				sessionID := uuid.New()
+				refreshToken, err := testSigner.GenerateSessionRefreshToken(
+					userID.String(), "organizer", "web", sessionID.String(), auth.RefreshTokenExpiryWeb,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				mockBlacklistRepo.EXPECT().AddToBlacklist(ctx, refreshToken, gomock.Any()).Return(nil)
+				mockSessionRepo.EXPECT().Delete(ctx, userID, sessionID).Return(nil)
+
+				result, err := useCase.Execute(ctx, &auth.LogoutRequest{RefreshToken: refreshToken})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Message).To(Equal("Successfully logged out"))
+			})
+		})
 	})
 })
@@ -0,0 +1,199 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/validator"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// PasswordResetTokenExpiry is how long a password reset token can be used (30 minutes)
+	PasswordResetTokenExpiry = 30 * time.Minute
+
+	// passwordResetKeyPrefix prefixes the cache keys of password reset tokens
+	passwordResetKeyPrefix = "auth:password_reset:"
+)
+
+// invalidResetToken returns the error for reset tokens that are malformed, expired or already used
+func invalidResetToken() error {
+	return apperrors.BadRequest("invalid or expired password reset token")
+}
+
+// ForgotPasswordUseCase handles requesting a password reset token
+type ForgotPasswordUseCase struct {
+	userRepo    repository.UserRepository
+	cacheRepo   repository.CacheRepository
+	jwtSecret   string
+	exposeToken bool
+	logger      *logger.Logger
+}
+
+// NewForgotPasswordUseCase creates a new ForgotPasswordUseCase.
+// Reset tokens are kept in cacheRepo; without it password reset is unavailable.
+// exposeToken returns the token in the response, for environments without email delivery.
+func NewForgotPasswordUseCase(
+	userRepo repository.UserRepository,
+	cacheRepo repository.CacheRepository,
+	jwtSecret string,
+	exposeToken bool,
+	logger *logger.Logger,
+) *ForgotPasswordUseCase {
+	return &ForgotPasswordUseCase{
+		userRepo:    userRepo,
+		cacheRepo:   cacheRepo,
+		jwtSecret:   jwtSecret,
+		exposeToken: exposeToken,
+		logger:      logger,
+	}
+}
+
+// ForgotPasswordRequest represents the input for requesting a password reset
+type ForgotPasswordRequest struct {
+	Email string
+}
+
+// ForgotPasswordResponse represents the password reset request response
+type ForgotPasswordResponse struct {
+	Message string
+	// ResetToken is set only when tokens are exposed and a user has the requested email
+	ResetToken string
+}
+
+// Execute issues a single-use password reset token for the user with the requested email.
+// The response is the same whether or not such a user exists, so emails cannot be enumerated.
+func (u *ForgotPasswordUseCase) Execute(
+	ctx context.Context,
+	req *ForgotPasswordRequest,
+) (*ForgotPasswordResponse, error) {
+	if err := validator.ValidateEmail(req.Email); err != nil {
+		return nil, apperrors.Validation(err.Error())
+	}
+	if u.cacheRepo == nil {
+		return nil, apperrors.ServiceUnavailable("password reset is unavailable")
+	}
+
+	resp := &ForgotPasswordResponse{
+		Message: "If an account with this email exists, a password reset token has been issued",
+	}
+
+	user, err := u.userRepo.FindByEmail(ctx, req.Email)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return resp, nil
+		}
+		u.logger.WithContext(ctx).Error("failed to find user for password reset", zap.Error(err))
+		return nil, apperrors.Internal("failed to request password reset")
+	}
+
+	token, err := crypto.GenerateHMACSignedToken(u.jwtSecret)
+	if err != nil {
+		u.logger.WithContext(ctx).Error("failed to generate password reset token", zap.Error(err))
+		return nil, apperrors.Internal("failed to request password reset")
+	}
+	if err := u.cacheRepo.Set(ctx, passwordResetKey(token), user.ID.String(), PasswordResetTokenExpiry); err != nil {
+		u.logger.WithContext(ctx).Error("failed to store password reset token", zap.Error(err))
+		return nil, apperrors.Internal("failed to request password reset")
+	}
+
+	u.logger.WithContext(ctx).Info(fmt.Sprintf("password reset requested for user: %s", user.ID))
+
+	if u.exposeToken {
+		resp.ResetToken = token
+	}
+	return resp, nil
+}
+
+// ResetPasswordUseCase handles setting a new password with a password reset token
+type ResetPasswordUseCase struct {
+	userRepo  repository.UserRepository
+	cacheRepo repository.CacheRepository
+	jwtSecret string
+	logger    *logger.Logger
+}
+
+// NewResetPasswordUseCase creates a new ResetPasswordUseCase.
+// Reset tokens are read from cacheRepo; without it password reset is unavailable.
+func NewResetPasswordUseCase(
+	userRepo repository.UserRepository,
+	cacheRepo repository.CacheRepository,
+	jwtSecret string,
+	logger *logger.Logger,
+) *ResetPasswordUseCase {
+	return &ResetPasswordUseCase{
+		userRepo:  userRepo,
+		cacheRepo: cacheRepo,
+		jwtSecret: jwtSecret,
+		logger:    logger,
+	}
+}
+
+// ResetPasswordRequest represents the input for resetting a password
+type ResetPasswordRequest struct {
+	Token       string
+	NewPassword string
+}
+
+// Execute sets the new password of the user the reset token was issued to.
+// The token is invalidated on first use, even if the password update then fails.
+func (u *ResetPasswordUseCase) Execute(ctx context.Context, req *ResetPasswordRequest) error {
+	if err := validator.ValidateRequired(req.Token, "token"); err != nil {
+		return apperrors.Validation(err.Error())
+	}
+	// Validate before consuming the token, so a rejected password can be retried
+	if err := validatePassword(req.NewPassword, "new_password"); err != nil {
+		return err
+	}
+	if u.cacheRepo == nil {
+		return apperrors.ServiceUnavailable("password reset is unavailable")
+	}
+	if !crypto.VerifyHMACToken(u.jwtSecret, req.Token) {
+		return invalidResetToken()
+	}
+
+	value, err := u.cacheRepo.GetAndDelete(ctx, passwordResetKey(req.Token))
+	if err != nil {
+		u.logger.WithContext(ctx).Error("failed to consume password reset token", zap.Error(err))
+		return apperrors.Internal("failed to reset password")
+	}
+	userID, err := uuid.Parse(value)
+	if err != nil {
+		return invalidResetToken()
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return invalidResetToken()
+		}
+		u.logger.WithContext(ctx).Error("failed to find user for password reset", zap.Error(err))
+		return apperrors.Internal("failed to reset password")
+	}
+
+	passwordHash, err := crypto.HashPassword(req.NewPassword)
+	if err != nil {
+		u.logger.WithContext(ctx).Error("failed to hash password", zap.Error(err))
+		return apperrors.Internal("failed to hash password")
+	}
+	user.PasswordHash = passwordHash
+	user.UpdatedAt = time.Now()
+	if err := u.userRepo.Update(ctx, user); err != nil {
+		u.logger.WithContext(ctx).Error("failed to update password", zap.Error(err))
+		return apperrors.Internal("failed to reset password")
+	}
+
+	u.logger.WithContext(ctx).Info(fmt.Sprintf("password reset for user: %s", user.ID))
+	return nil
+}
+
+// passwordResetKey returns the cache key of a password reset token
+func passwordResetKey(token string) string {
+	return passwordResetKeyPrefix + token
+}
@@ -0,0 +1,171 @@
+package auth_test
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/auth"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Password reset", func() {
+	var (
+		ctrl          *gomock.Controller
+		mockUserRepo  *mocks.MockUserRepository
+		mockCacheRepo *mocks.MockCacheRepository
+		nopLogger     *logger.Logger
+		ctx           context.Context
+		user          *entity.User
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockUserRepo = mocks.NewMockUserRepository(ctrl)
+		mockCacheRepo = mocks.NewMockCacheRepository(ctrl)
+		nopLogger = &logger.Logger{Logger: zap.NewNop()}
+		ctx = context.Background()
+		user = &entity.User{ID: uuid.New(), Email: "organizer@example.com", Name: "Organizer", Role: entity.RoleOrganizer}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("ForgotPasswordUseCase", func() {
+		var useCase *auth.ForgotPasswordUseCase
+
+		BeforeEach(func() {
+			useCase = auth.NewForgotPasswordUseCase(mockUserRepo, mockCacheRepo, testJWTSecret, true, nopLogger)
+		})
+
+		When("a user has the email", func() {
+			It("should store a signed token for the user and return it", func() {
+				var storedKey string
+				mockUserRepo.EXPECT().FindByEmail(ctx, user.Email).Return(user, nil)
+				mockCacheRepo.EXPECT().Set(ctx, gomock.Any(), user.ID.String(), auth.PasswordResetTokenExpiry).DoAndReturn(
+					func(_ context.Context, key, _ string, _ any) error {
+						storedKey = key
+						return nil
+					},
+				)
+
+				result, err := useCase.Execute(ctx, &auth.ForgotPasswordRequest{Email: user.Email})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(crypto.VerifyHMACToken(testJWTSecret, result.ResetToken)).To(BeTrue())
+				Expect(storedKey).To(HaveSuffix(result.ResetToken))
+			})
+
+			It("should not return the token when tokens are not exposed", func() {
+				useCase = auth.NewForgotPasswordUseCase(mockUserRepo, mockCacheRepo, testJWTSecret, false, nopLogger)
+				mockUserRepo.EXPECT().FindByEmail(ctx, user.Email).Return(user, nil)
+				mockCacheRepo.EXPECT().Set(ctx, gomock.Any(), user.ID.String(), gomock.Any()).Return(nil)
+
+				result, err := useCase.Execute(ctx, &auth.ForgotPasswordRequest{Email: user.Email})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.ResetToken).To(BeEmpty())
+			})
+		})
+
+		When("no user has the email", func() {
+			It("should respond as for a known email without issuing a token", func() {
+				mockUserRepo.EXPECT().FindByEmail(ctx, "unknown@example.com").Return(nil, apperrors.NotFound("user not found"))
+
+				result, err := useCase.Execute(ctx, &auth.ForgotPasswordRequest{Email: "unknown@example.com"})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Message).NotTo(BeEmpty())
+				Expect(result.ResetToken).To(BeEmpty())
+			})
+		})
+
+		When("the email is invalid", func() {
+			It("should return a validation error", func() {
+				_, err := useCase.Execute(ctx, &auth.ForgotPasswordRequest{Email: "not-an-email"})
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+			})
+		})
+
+		When("no cache is configured", func() {
+			It("should return service unavailable", func() {
+				useCase = auth.NewForgotPasswordUseCase(mockUserRepo, nil, testJWTSecret, true, nopLogger)
+
+				_, err := useCase.Execute(ctx, &auth.ForgotPasswordRequest{Email: user.Email})
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeServiceUnavailable))
+			})
+		})
+	})
+
+	Describe("ResetPasswordUseCase", func() {
+		const newPassword = "new-secret-password"
+
+		var (
+			useCase *auth.ResetPasswordUseCase
+			token   string
+		)
+
+		BeforeEach(func() {
+			useCase = auth.NewResetPasswordUseCase(mockUserRepo, mockCacheRepo, testJWTSecret, nopLogger)
+			var err error
+			token, err = crypto.GenerateHMACSignedToken(testJWTSecret)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the token is valid", func() {
+			It("should consume the token and set the new password", func() {
+				mockCacheRepo.EXPECT().GetAndDelete(ctx, gomock.Any()).Return(user.ID.String(), nil)
+				mockUserRepo.EXPECT().FindByID(ctx, user.ID).Return(user, nil)
+				mockUserRepo.EXPECT().Update(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, updated *entity.User) error {
+						Expect(crypto.ComparePassword(updated.PasswordHash, newPassword)).To(Succeed())
+						return nil
+					},
+				)
+
+				err := useCase.Execute(ctx, &auth.ResetPasswordRequest{Token: token, NewPassword: newPassword})
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("the token was already used or has expired", func() {
+			It("should return bad request", func() {
+				mockCacheRepo.EXPECT().GetAndDelete(ctx, gomock.Any()).Return("", nil)
+
+				err := useCase.Execute(ctx, &auth.ResetPasswordRequest{Token: token, NewPassword: newPassword})
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+			})
+		})
+
+		When("the token was not issued by this server", func() {
+			It("should return bad request without looking it up", func() {
+				forged, err := crypto.GenerateHMACSignedToken("another-secret")
+				Expect(err).NotTo(HaveOccurred())
+
+				err = useCase.Execute(ctx, &auth.ResetPasswordRequest{Token: forged, NewPassword: newPassword})
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+			})
+		})
+
+		When("the new password is too short", func() {
+			It("should return a validation error without consuming the token", func() {
+				err := useCase.Execute(ctx, &auth.ResetPasswordRequest{Token: token, NewPassword: "short"})
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+			})
+		})
+	})
+})
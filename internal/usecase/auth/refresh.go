@@ -18,8 +18,10 @@ import (
 // RefreshTokenUseCase handles refresh token rotation
 type RefreshTokenUseCase struct {
 	userRepo            repository.UserRepository
+	sessionRepo         repository.SessionRepository
 	blacklistRepo       repository.TokenBlacklistRepository
-	jwtSecret           string
+	signer              *crypto.TokenSigner
+	accessExpiry        time.Duration
 	refreshExpiryWeb    time.Duration
 	refreshExpiryMobile time.Duration
 	logger              *logger.Logger
@@ -28,16 +30,20 @@ type RefreshTokenUseCase struct {
 // NewRefreshTokenUseCase creates a new RefreshTokenUseCase
 func NewRefreshTokenUseCase(
 	userRepo repository.UserRepository,
+	sessionRepo repository.SessionRepository,
 	blacklistRepo repository.TokenBlacklistRepository,
-	jwtSecret string,
+	signer *crypto.TokenSigner,
+	accessExpiry time.Duration,
 	refreshExpiryWeb time.Duration,
 	refreshExpiryMobile time.Duration,
 	logger *logger.Logger,
 ) *RefreshTokenUseCase {
 	return &RefreshTokenUseCase{
 		userRepo:            userRepo,
+		sessionRepo:         sessionRepo,
 		blacklistRepo:       blacklistRepo,
-		jwtSecret:           jwtSecret,
+		signer:              signer,
+		accessExpiry:        accessExpiry,
 		refreshExpiryWeb:    refreshExpiryWeb,
 		refreshExpiryMobile: refreshExpiryMobile,
 		logger:              logger,
@@ -47,6 +53,7 @@ func NewRefreshTokenUseCase(
 // RefreshRequest represents the input for token refresh
 type RefreshRequest struct {
 	RefreshToken string
+	Device       string // User-Agent of the client, names the session of tokens issued before sessions
 }
 
 // Execute executes the refresh token use case
@@ -68,8 +75,14 @@ func (u *RefreshTokenUseCase) Execute(ctx context.Context, req *RefreshRequest)
 		return nil, err
 	}
 
-	// Generate new tokens (inherit client type from original claims)
-	accessToken, newRefreshToken, err := u.generateTokens(ctx, user, claims.ClientType)
+	// Find the session the token belongs to
+	session, err := u.resolveSession(ctx, req, claims)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate new tokens for the session (inherit client type from original claims)
+	accessToken, newRefreshToken, err := u.generateTokens(ctx, user, session)
 	if err != nil {
 		return nil, err
 	}
@@ -83,14 +96,14 @@ func (u *RefreshTokenUseCase) Execute(ctx context.Context, req *RefreshRequest)
 		AccessToken:  accessToken,
 		RefreshToken: newRefreshToken,
 		TokenType:    "Bearer",
-		ExpiresIn:    int(AccessTokenExpiry.Seconds()),
+		ExpiresIn:    int(u.accessExpiry.Seconds()),
 		User:         user,
 	}, nil
 }
 
 // validateToken validates the refresh token and returns claims
 func (u *RefreshTokenUseCase) validateToken(ctx context.Context, token string) (*crypto.Claims, error) {
-	claims, err := crypto.ParseToken(token, u.jwtSecret)
+	claims, err := u.signer.ParseToken(token)
 	if err != nil {
 		if err == crypto.ErrExpiredToken {
 			return nil, apperrors.Unauthorized("refresh token has expired")
@@ -112,10 +125,27 @@ func (u *RefreshTokenUseCase) validateToken(ctx context.Context, token string) (
 		return nil, apperrors.Internal("failed to validate token")
 	}
 	if isBlacklisted {
+		if claims.SessionID != "" {
+			// A rotated token presented again was stolen or leaked: one of its holders is not the user
+			return nil, u.revokeFamily(ctx, claims)
+		}
 		u.logger.WithContext(ctx).Warn(fmt.Sprintf("attempted use of blacklisted token for user: %s", claims.UserID))
 		return nil, apperrors.Unauthorized("token has been revoked")
 	}
 
+	// Check if the token family, the session of the token, is blacklisted
+	if claims.SessionID != "" {
+		isRevoked, err := u.blacklistRepo.IsFamilyBlacklisted(ctx, claims.SessionID)
+		if err != nil {
+			u.logger.WithContext(ctx).Error("failed to check token family blacklist", zap.Error(err))
+			return nil, apperrors.Internal("failed to validate token")
+		}
+		if isRevoked {
+			u.logger.WithContext(ctx).Warn(fmt.Sprintf("refresh of revoked session for user: %s", claims.UserID))
+			return nil, apperrors.Unauthorized("session has been revoked")
+		}
+	}
+
 	return claims, nil
 }
 
@@ -132,37 +162,86 @@ func (u *RefreshTokenUseCase) validateUser(ctx context.Context, userID uuid.UUID
 		return nil, apperrors.Unauthorized("user not found")
 	}
 
+	if user.IsDeactivated() {
+		u.logger.WithContext(ctx).Warn(fmt.Sprintf("refresh attempt for deactivated user: %s", user.ID))
+		return nil, apperrors.Unauthorized("user account has been deactivated")
+	}
+
 	return user, nil
 }
 
-// generateTokens generates new access and refresh tokens
-func (u *RefreshTokenUseCase) generateTokens(
-	ctx context.Context, user *entity.User, clientType string,
-) (string, string, error) {
-	accessToken, err := crypto.GenerateAccessToken(user.ID.String(), string(user.Role), u.jwtSecret, AccessTokenExpiry)
+// resolveSession returns the session of a refresh token. The token must be the session's
+// current one, so a rotated token cannot be reused even if it could not be blacklisted.
+// Tokens issued before sessions were tracked start a new session.
+func (u *RefreshTokenUseCase) resolveSession(
+	ctx context.Context,
+	req *RefreshRequest,
+	claims *crypto.Claims,
+) (*entity.Session, error) {
+	if claims.SessionID == "" {
+		// Default to web for backward compatibility with existing tokens (no ClientType claim)
+		clientType, _ := resolveRefreshExpiry(claims.ClientType, u.refreshExpiryWeb, u.refreshExpiryMobile)
+		issuedAt := time.Now()
+		if claims.IssuedAt != nil {
+			issuedAt = claims.IssuedAt.Time
+		}
+		return entity.NewSession(claims.UserID, clientType, req.Device, issuedAt), nil
+	}
+
+	sessionID, err := uuid.Parse(claims.SessionID)
 	if err != nil {
-		u.logger.WithContext(ctx).Error("failed to generate access token", zap.Error(err))
-		return "", "", apperrors.Internal("failed to generate access token")
+		u.logger.WithContext(ctx).Warn("refresh token with invalid session id", zap.Error(err))
+		return nil, apperrors.Unauthorized("invalid refresh token")
 	}
+	session, err := u.sessionRepo.FindByID(ctx, claims.UserID, sessionID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			u.logger.WithContext(ctx).Warn(fmt.Sprintf("refresh of revoked session for user: %s", claims.UserID))
+			return nil, apperrors.Unauthorized("session has been revoked")
+		}
+		u.logger.WithContext(ctx).Error("failed to find session", zap.Error(err))
+		return nil, apperrors.Internal("failed to validate token")
+	}
+	if session.RefreshToken != req.RefreshToken {
+		return nil, u.revokeFamily(ctx, claims)
+	}
+	return session, nil
+}
 
-	// Default to web for backward compatibility with existing tokens (no ClientType claim)
-	clientType, refreshExpiry := resolveRefreshExpiry(
-		clientType, u.refreshExpiryWeb, u.refreshExpiryMobile,
+// revokeFamily handles the reuse of a rotated refresh token by revoking its whole token family,
+// the session it belongs to, so that both the user and whoever else holds a token of the
+// session must log in again. Returns the error for the request.
+func (u *RefreshTokenUseCase) revokeFamily(ctx context.Context, claims *crypto.Claims) error {
+	u.logger.WithContext(ctx).Warn(fmt.Sprintf("reuse of rotated refresh token for user: %s", claims.UserID),
+		zap.String("session_id", claims.SessionID),
 	)
 
-	refreshToken, err := crypto.GenerateRefreshToken(
-		user.ID.String(),
-		string(user.Role),
-		u.jwtSecret,
-		clientType,
-		refreshExpiry,
-	)
-	if err != nil {
-		u.logger.WithContext(ctx).Error("failed to generate refresh token", zap.Error(err))
-		return "", "", apperrors.Internal("failed to generate refresh token")
+	// The family must stay blacklisted as long as its latest refresh token may live
+	_, ttl := resolveRefreshExpiry(claims.ClientType, u.refreshExpiryWeb, u.refreshExpiryMobile)
+	if err := u.blacklistRepo.AddFamilyToBlacklist(ctx, claims.SessionID, ttl); err != nil {
+		u.logger.WithContext(ctx).Error("failed to blacklist token family", zap.Error(err))
+	}
+	if sessionID, err := uuid.Parse(claims.SessionID); err == nil {
+		if err := u.sessionRepo.Delete(ctx, claims.UserID, sessionID); err != nil && !apperrors.IsNotFound(err) {
+			u.logger.WithContext(ctx).Error("failed to delete compromised session", zap.Error(err))
+		}
 	}
 
-	return accessToken, refreshToken, nil
+	return apperrors.Unauthorized("refresh token reuse detected, the session has been revoked; please log in again")
+}
+
+// generateTokens generates new access and refresh tokens for a session and saves the session
+func (u *RefreshTokenUseCase) generateTokens(
+	ctx context.Context, user *entity.User, session *entity.Session,
+) (string, string, error) {
+	clientType, refreshExpiry := resolveRefreshExpiry(
+		session.ClientType, u.refreshExpiryWeb, u.refreshExpiryMobile,
+	)
+	session.ClientType = clientType
+
+	return issueSessionTokens(
+		ctx, u.sessionRepo, u.signer, user, session, u.accessExpiry, refreshExpiry, u.logger,
+	)
 }
 
 // blacklistOldToken blacklists the old refresh token (best effort)
@@ -180,12 +259,12 @@ func (u *RefreshTokenUseCase) blacklistOldToken(ctx context.Context, token strin
 }
 
 // ParseTokenForLogout parses a token without validating expiry (for logout)
-func ParseTokenForLogout(tokenString, secret string) (*uuid.UUID, time.Duration, error) {
+func ParseTokenForLogout(tokenString string, signer *crypto.TokenSigner) (*uuid.UUID, time.Duration, error) {
 	if tokenString == "" {
 		return nil, 0, nil
 	}
 
-	claims, err := crypto.ParseToken(tokenString, secret)
+	claims, err := signer.ParseToken(tokenString)
 	if err != nil {
 		// For logout, we allow expired tokens
 		if err == crypto.ErrExpiredToken {
@@ -216,3 +295,12 @@ func resolveRefreshExpiry(clientType string, web, mobile time.Duration) (string,
 	}
 	return clientType, web
 }
+
+// checkClientType checks a client type requested by the client; empty means ClientTypeWeb
+func checkClientType(clientType string) error {
+	switch clientType {
+	case "", ClientTypeWeb, ClientTypeMobile:
+		return nil
+	}
+	return fmt.Errorf("platform must be %q or %q", ClientTypeWeb, ClientTypeMobile)
+}
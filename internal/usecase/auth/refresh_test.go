@@ -22,6 +22,7 @@ var _ = Describe("RefreshTokenUseCase", func() {
 	var (
 		ctrl              *gomock.Controller
 		mockUserRepo      *mocks.MockUserRepository
+		mockSessionRepo   *mocks.MockSessionRepository
 		mockBlacklistRepo *mocks.MockTokenBlacklistRepository
 		useCase           *auth.RefreshTokenUseCase
 		ctx               context.Context
@@ -33,12 +34,16 @@ var _ = Describe("RefreshTokenUseCase", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockUserRepo = mocks.NewMockUserRepository(ctrl)
+		mockSessionRepo = mocks.NewMockSessionRepository(ctrl)
+		mockSessionRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		mockBlacklistRepo = mocks.NewMockTokenBlacklistRepository(ctrl)
 		nopLoggerRefresh = &logger.Logger{Logger: zap.NewNop()}
 		useCase = auth.NewRefreshTokenUseCase(
 			mockUserRepo,
+			mockSessionRepo,
 			mockBlacklistRepo,
-			testJWTSecret,
+			testSigner,
+			auth.AccessTokenExpiry,
 			auth.RefreshTokenExpiryWeb,
 			auth.RefreshTokenExpiryMobile,
 			nopLoggerRefresh,
@@ -168,6 +173,111 @@ var _ = Describe("RefreshTokenUseCase", func() {
 			})
 		})
 
+		When("refreshing a token of a tracked session", func() {
+			var session *entity.Session
+
+			// expectReuseDetected expects the token family of the session to be revoked
+			expectReuseDetected := func() {
+				mockBlacklistRepo.EXPECT().
+					AddFamilyToBlacklist(ctx, session.ID.String(), auth.RefreshTokenExpiryWeb).
+					Return(nil)
+				mockSessionRepo.EXPECT().Delete(ctx, testUserID, session.ID).Return(nil)
+			}
+
+			BeforeEach(func() {
+				session = entity.NewSession(testUserID, "web", "Mozilla/5.0", time.Now().Add(-time.Hour))
+				token, err := testSigner.GenerateSessionRefreshToken(
+					testUserID.String(), string(entity.RoleOrganizer), "web", session.ID.String(),
+					auth.RefreshTokenExpiryWeb,
+				)
+				Expect(err).NotTo(HaveOccurred())
+				session.RefreshToken = token
+				session.ExpiresAt = time.Now().Add(auth.RefreshTokenExpiryWeb)
+			})
+
+			Context("and the token has not been rotated yet", func() {
+				BeforeEach(func() {
+					mockBlacklistRepo.EXPECT().IsBlacklisted(ctx, session.RefreshToken).Return(false, nil)
+				})
+
+				It("should rotate the refresh token of the same session", func() {
+					oldToken := session.RefreshToken
+					mockBlacklistRepo.EXPECT().IsFamilyBlacklisted(ctx, session.ID.String()).Return(false, nil)
+					mockUserRepo.EXPECT().FindByID(ctx, testUserID).Return(testUser, nil)
+					mockSessionRepo.EXPECT().FindByID(ctx, testUserID, session.ID).Return(session, nil)
+					mockBlacklistRepo.EXPECT().AddToBlacklist(ctx, oldToken, gomock.Any()).Return(nil)
+
+					result, err := useCase.Execute(ctx, &auth.RefreshRequest{RefreshToken: oldToken})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(session.RefreshToken).To(Equal(result.RefreshToken))
+					newClaims, parseErr := crypto.ParseToken(result.RefreshToken, testJWTSecret)
+					Expect(parseErr).NotTo(HaveOccurred())
+					Expect(newClaims.SessionID).To(Equal(session.ID.String()))
+				})
+
+				It("should reject the token of a revoked token family", func() {
+					mockBlacklistRepo.EXPECT().IsFamilyBlacklisted(ctx, session.ID.String()).Return(true, nil)
+
+					_, err := useCase.Execute(ctx, &auth.RefreshRequest{RefreshToken: session.RefreshToken})
+
+					Expect(err).To(MatchError(ContainSubstring("session has been revoked")))
+				})
+
+				It("should reject the token of a revoked session", func() {
+					mockBlacklistRepo.EXPECT().IsFamilyBlacklisted(ctx, session.ID.String()).Return(false, nil)
+					mockUserRepo.EXPECT().FindByID(ctx, testUserID).Return(testUser, nil)
+					mockSessionRepo.EXPECT().FindByID(ctx, testUserID, session.ID).
+						Return(nil, apperrors.NotFound("session not found"))
+
+					_, err := useCase.Execute(ctx, &auth.RefreshRequest{RefreshToken: session.RefreshToken})
+
+					Expect(err).To(MatchError(ContainSubstring("session has been revoked")))
+				})
+
+				It("should revoke the token family when the session has already been rotated away from it", func() {
+					// The old token could not be blacklisted on rotation
+					oldToken := session.RefreshToken
+					stored := *session
+					stored.RefreshToken = "newer.refresh.token"
+					mockBlacklistRepo.EXPECT().IsFamilyBlacklisted(ctx, session.ID.String()).Return(false, nil)
+					mockUserRepo.EXPECT().FindByID(ctx, testUserID).Return(testUser, nil)
+					mockSessionRepo.EXPECT().FindByID(ctx, testUserID, session.ID).Return(&stored, nil)
+					expectReuseDetected()
+
+					_, err := useCase.Execute(ctx, &auth.RefreshRequest{RefreshToken: oldToken})
+
+					Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeUnauthorized))
+					Expect(err).To(MatchError(ContainSubstring("reuse detected")))
+				})
+			})
+
+			Context("and the token was already rotated", func() {
+				It("should revoke the token family and require a new login", func() {
+					mockBlacklistRepo.EXPECT().IsBlacklisted(ctx, session.RefreshToken).Return(true, nil)
+					expectReuseDetected()
+
+					result, err := useCase.Execute(ctx, &auth.RefreshRequest{RefreshToken: session.RefreshToken})
+
+					Expect(result).To(BeNil())
+					Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeUnauthorized))
+					Expect(err).To(MatchError(ContainSubstring("please log in again")))
+				})
+
+				It("should still reject the token when the family cannot be blacklisted", func() {
+					mockBlacklistRepo.EXPECT().IsBlacklisted(ctx, session.RefreshToken).Return(true, nil)
+					mockBlacklistRepo.EXPECT().
+						AddFamilyToBlacklist(ctx, session.ID.String(), gomock.Any()).
+						Return(errors.New("redis error"))
+					mockSessionRepo.EXPECT().Delete(ctx, testUserID, session.ID).Return(nil)
+
+					_, err := useCase.Execute(ctx, &auth.RefreshRequest{RefreshToken: session.RefreshToken})
+
+					Expect(err).To(MatchError(ContainSubstring("reuse detected")))
+				})
+			})
+		})
+
 		When("validating the request input", func() {
 			Context("with an empty refresh token", func() {
 				It("should return a validation error", func() {
@@ -356,32 +466,61 @@ var _ = Describe("RefreshTokenUseCase", func() {
 			})
 		})
 
-		When("generating new tokens fails due to an empty JWT secret", func() {
-			Context("and the use case is constructed with an empty secret", func() {
-				It("should return an internal error", func() {
+		When("an admin has deactivated the user", func() {
+			It("should return an unauthorized error", func() {
+				refreshToken := makeRefreshToken("web")
+				deactivatedAt := time.Now()
+
+				mockBlacklistRepo.EXPECT().
+					IsBlacklisted(ctx, refreshToken).
+					Return(false, nil)
+				mockUserRepo.EXPECT().
+					FindByID(ctx, testUserID).
+					Return(&entity.User{
+						ID:            testUserID,
+						Email:         "carol@example.com",
+						Role:          entity.RoleOrganizer,
+						DeactivatedAt: &deactivatedAt,
+					}, nil)
+
+				result, err := useCase.Execute(ctx, &auth.RefreshRequest{RefreshToken: refreshToken})
+
+				Expect(result).To(BeNil())
+				Expect(apperrors.IsUnauthorized(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("user account has been deactivated"))
+			})
+		})
+
+		When("the token was signed with another JWT secret", func() {
+			Context("and the use case is constructed with a different secret", func() {
+				It("should return an unauthorized error", func() {
 					// Build a valid token with the real secret first
 					refreshToken := makeRefreshToken("web")
 
-					// Then stand up a use case with no secret (token generation will fail)
-					useCaseNoSecret := auth.NewRefreshTokenUseCase(
+					// Then stand up a use case with another secret
+					otherSigner, err := crypto.NewTokenSigner(crypto.SigningConfig{Secret: "another-jwt-secret"})
+					Expect(err).NotTo(HaveOccurred())
+					useCaseOtherSecret := auth.NewRefreshTokenUseCase(
 						mockUserRepo,
+						mockSessionRepo,
 						mockBlacklistRepo,
-						"",
+						otherSigner,
+						auth.AccessTokenExpiry,
 						auth.RefreshTokenExpiryWeb,
 						auth.RefreshTokenExpiryMobile,
 						nopLoggerRefresh,
 					)
 
-					// ParseToken will fail with empty secret too, so we expect unauthorized
+					// ParseToken fails with the other secret, so we expect unauthorized
 					req := &auth.RefreshRequest{RefreshToken: refreshToken}
 
-					result, err := useCaseNoSecret.Execute(ctx, req)
+					result, err := useCaseOtherSecret.Execute(ctx, req)
 
 					Expect(err).To(HaveOccurred())
 					Expect(result).To(BeNil())
 					var appErr *apperrors.AppError
 					Expect(errors.As(err, &appErr)).To(BeTrue())
-					// Empty secret causes parse to fail → unauthorized
+					// The other secret causes parse to fail → unauthorized
 					Expect(appErr.Code).To(Equal(apperrors.CodeUnauthorized))
 				})
 			})
@@ -392,7 +531,7 @@ var _ = Describe("RefreshTokenUseCase", func() {
 var _ = Describe("ParseTokenForLogout", func() {
 	When("the token string is empty", func() {
 		It("should return nil without error", func() {
-			id, ttl, err := auth.ParseTokenForLogout("", testJWTSecret)
+			id, ttl, err := auth.ParseTokenForLogout("", testSigner)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(id).To(BeNil())
@@ -406,7 +545,7 @@ var _ = Describe("ParseTokenForLogout", func() {
 			token, err := crypto.GenerateAccessToken(userID.String(), "organizer", testJWTSecret, 15*time.Minute)
 			Expect(err).NotTo(HaveOccurred())
 
-			id, ttl, parseErr := auth.ParseTokenForLogout(token, testJWTSecret)
+			id, ttl, parseErr := auth.ParseTokenForLogout(token, testSigner)
 
 			Expect(parseErr).NotTo(HaveOccurred())
 			Expect(id).NotTo(BeNil())
@@ -422,7 +561,7 @@ var _ = Describe("ParseTokenForLogout", func() {
 			Expect(err).NotTo(HaveOccurred())
 			time.Sleep(5 * time.Millisecond)
 
-			id, ttl, parseErr := auth.ParseTokenForLogout(expiredToken, testJWTSecret)
+			id, ttl, parseErr := auth.ParseTokenForLogout(expiredToken, testSigner)
 
 			Expect(parseErr).NotTo(HaveOccurred())
 			Expect(id).To(BeNil())
@@ -432,7 +571,7 @@ var _ = Describe("ParseTokenForLogout", func() {
 
 	When("the token is completely invalid", func() {
 		It("should return an error", func() {
-			id, ttl, err := auth.ParseTokenForLogout("not.a.jwt", testJWTSecret)
+			id, ttl, err := auth.ParseTokenForLogout("not.a.jwt", testSigner)
 
 			Expect(err).To(HaveOccurred())
 			Expect(id).To(BeNil())
@@ -447,11 +586,12 @@ var _ = Describe("resolveRefreshExpiry", func() {
 	// Here we use LoginUseCase as a proxy since it accepts client type in its request.
 
 	var (
-		ctrl         *gomock.Controller
-		mockUserRepo *mocks.MockUserRepository
-		ctx          context.Context
-		nopLog       *logger.Logger
-		testUser2    *entity.User
+		ctrl            *gomock.Controller
+		mockUserRepo    *mocks.MockUserRepository
+		mockSessionRepo *mocks.MockSessionRepository
+		ctx             context.Context
+		nopLog          *logger.Logger
+		testUser2       *entity.User
 	)
 
 	const plainPassword = "TestPassword9!"
@@ -459,6 +599,8 @@ var _ = Describe("resolveRefreshExpiry", func() {
 	BeforeEach(func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockUserRepo = mocks.NewMockUserRepository(ctrl)
+		mockSessionRepo = mocks.NewMockSessionRepository(ctrl)
+		mockSessionRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
 		nopLog = &logger.Logger{Logger: zap.NewNop()}
 		ctx = context.Background()
 
@@ -480,23 +622,31 @@ var _ = Describe("resolveRefreshExpiry", func() {
 		ctrl.Finish()
 	})
 
-	login := func(clientType string) *auth.AuthResponse {
+	execute := func(clientType string) (*auth.AuthResponse, error) {
 		uc := auth.NewLoginUseCase(
 			mockUserRepo,
-			testJWTSecret,
+			mockSessionRepo,
+			testSigner,
+			testTwoFactorKey,
+			auth.AccessTokenExpiry,
 			auth.RefreshTokenExpiryWeb,
 			auth.RefreshTokenExpiryMobile,
 			nopLog,
 		)
 		mockUserRepo.EXPECT().
 			FindByEmailWithPassword(ctx, "expiry@example.com").
-			Return(testUser2, nil)
+			Return(testUser2, nil).
+			MaxTimes(1)
 
-		result, err := uc.Execute(ctx, &auth.LoginRequest{
+		return uc.Execute(ctx, &auth.LoginRequest{
 			Email:      "expiry@example.com",
 			Password:   plainPassword,
 			ClientType: clientType,
 		})
+	}
+
+	login := func(clientType string) *auth.AuthResponse {
+		result, err := execute(clientType)
 		Expect(err).NotTo(HaveOccurred())
 		return result
 	}
@@ -532,14 +682,11 @@ var _ = Describe("resolveRefreshExpiry", func() {
 	})
 
 	When("client type is an unknown value", func() {
-		It("should fall back to web expiry and preserve the unknown client type string", func() {
-			result := login("desktop")
+		It("should reject the login with a validation error", func() {
+			result, err := execute("desktop")
 
-			claims, err := crypto.ParseToken(result.RefreshToken, testJWTSecret)
-			Expect(err).NotTo(HaveOccurred())
-			// resolveRefreshExpiry uses web expiry for any non-mobile type,
-			// but passes through the original clientType string unchanged
-			Expect(claims.ClientType).To(Equal("desktop"))
+			Expect(result).To(BeNil())
+			Expect(apperrors.IsValidation(err)).To(BeTrue())
 		})
 	})
 })
@@ -19,7 +19,7 @@ const (
 	// PasswordMinLength is the minimum password length
 	PasswordMinLength = 8
 
-	// AccessTokenExpiry is the expiry duration for access tokens (15 minutes)
+	// AccessTokenExpiry is the default expiry for access tokens (15 minutes)
 	AccessTokenExpiry = 15 * time.Minute
 
 	// RefreshTokenExpiryWeb is the default expiry for web clients (7 days)
@@ -37,26 +37,44 @@ const (
 
 // RegisterUseCase handles user registration
 type RegisterUseCase struct {
-	userRepo  repository.UserRepository
-	jwtSecret string
-	logger    *logger.Logger
+	userRepo            repository.UserRepository
+	signer              *crypto.TokenSigner
+	verification        *EmailVerificationUseCase
+	accessExpiry        time.Duration
+	refreshExpiryWeb    time.Duration
+	refreshExpiryMobile time.Duration
+	logger              *logger.Logger
 }
 
-// NewRegisterUseCase creates a new RegisterUseCase
-func NewRegisterUseCase(userRepo repository.UserRepository, jwtSecret string, logger *logger.Logger) *RegisterUseCase {
+// NewRegisterUseCase creates a new RegisterUseCase.
+// New users are emailed a verification link through verification; nil sends none.
+func NewRegisterUseCase(
+	userRepo repository.UserRepository,
+	signer *crypto.TokenSigner,
+	verification *EmailVerificationUseCase,
+	accessExpiry time.Duration,
+	refreshExpiryWeb time.Duration,
+	refreshExpiryMobile time.Duration,
+	logger *logger.Logger,
+) *RegisterUseCase {
 	return &RegisterUseCase{
-		userRepo:  userRepo,
-		jwtSecret: jwtSecret,
-		logger:    logger,
+		userRepo:            userRepo,
+		signer:              signer,
+		verification:        verification,
+		accessExpiry:        accessExpiry,
+		refreshExpiryWeb:    refreshExpiryWeb,
+		refreshExpiryMobile: refreshExpiryMobile,
+		logger:              logger,
 	}
 }
 
 // RegisterRequest represents the input for user registration
 type RegisterRequest struct {
-	Email    string
-	Password string
-	Name     string
-	Role     string
+	Email      string
+	Password   string
+	Name       string
+	Role       string
+	ClientType string // "web" or "mobile", decides the refresh token expiry; empty means web
 }
 
 // AuthResponse represents the authentication response with tokens
@@ -66,6 +84,9 @@ type AuthResponse struct {
 	TokenType    string
 	ExpiresIn    int
 	User         *entity.User
+	// TwoFactorChallenge is set instead of the tokens and user when the login still awaits
+	// a second factor
+	TwoFactorChallenge *TwoFactorChallenge
 }
 
 // Execute executes the user registration use case
@@ -82,18 +103,23 @@ func (u *RegisterUseCase) Execute(ctx context.Context, req *RegisterRequest) (*A
 	}
 
 	// Generate authentication tokens
-	accessToken, refreshToken, err := u.generateTokens(ctx, user)
+	accessToken, refreshToken, err := u.generateTokens(ctx, user, req.ClientType)
 	if err != nil {
 		return nil, err
 	}
 
+	// Email the verification link; the user can already use the API meanwhile
+	if u.verification != nil {
+		u.verification.sendOnRegister(ctx, user)
+	}
+
 	u.logger.WithContext(ctx).Info(fmt.Sprintf("user registered successfully: %s", user.ID))
 
 	return &AuthResponse{
 		AccessToken:  accessToken,
 		RefreshToken: refreshToken,
 		TokenType:    "Bearer",
-		ExpiresIn:    int(AccessTokenExpiry.Seconds()),
+		ExpiresIn:    int(u.accessExpiry.Seconds()),
 		User:         user,
 	}, nil
 }
@@ -143,20 +169,22 @@ func (u *RegisterUseCase) createUser(ctx context.Context, req *RegisterRequest)
 	return user, nil
 }
 
-// generateTokens generates access and refresh tokens for a user
-func (u *RegisterUseCase) generateTokens(ctx context.Context, user *entity.User) (string, string, error) {
-	accessToken, err := crypto.GenerateAccessToken(user.ID.String(), string(user.Role), u.jwtSecret, AccessTokenExpiry)
+// generateTokens generates access and refresh tokens for a user of clientType
+func (u *RegisterUseCase) generateTokens(
+	ctx context.Context, user *entity.User, clientType string,
+) (string, string, error) {
+	accessToken, err := u.signer.GenerateAccessToken(user.ID.String(), string(user.Role), u.accessExpiry)
 	if err != nil {
 		u.logger.WithContext(ctx).Error("failed to generate access token", zap.Error(err))
 		return "", "", apperrors.Internal("failed to generate access token")
 	}
 
-	refreshToken, err := crypto.GenerateRefreshToken(
+	clientType, refreshExpiry := resolveRefreshExpiry(clientType, u.refreshExpiryWeb, u.refreshExpiryMobile)
+	refreshToken, err := u.signer.GenerateRefreshToken(
 		user.ID.String(),
 		string(user.Role),
-		u.jwtSecret,
-		ClientTypeWeb,
-		RefreshTokenExpiryWeb,
+		clientType,
+		refreshExpiry,
 	)
 	if err != nil {
 		u.logger.WithContext(ctx).Error("failed to generate refresh token", zap.Error(err))
@@ -166,39 +194,65 @@ func (u *RegisterUseCase) generateTokens(ctx context.Context, user *entity.User)
 	return accessToken, refreshToken, nil
 }
 
-// validateRequest validates the registration request
+// validateRequest validates the registration request, reporting every invalid field at once
 func (u *RegisterUseCase) validateRequest(req *RegisterRequest) error {
+	var fields apperrors.FieldErrors
+
 	// Validate email
 	if err := validator.ValidateEmail(req.Email); err != nil {
-		return apperrors.Validation(err.Error())
+		fields.Add("email", err.Error())
 	}
 
 	// Validate password
-	if err := validator.ValidateRequired(req.Password, "password"); err != nil {
-		return apperrors.Validation(err.Error())
-	}
-	if err := validator.ValidateMinLength(req.Password, PasswordMinLength, "password"); err != nil {
-		return apperrors.Validation(err.Error())
+	if err := checkPassword(req.Password, "password"); err != nil {
+		fields.Add("password", err.Error())
 	}
 
 	// Validate name
-	if err := validator.ValidateRequired(req.Name, "name"); err != nil {
-		return apperrors.Validation(err.Error())
-	}
-	if err := validator.ValidateMinLength(req.Name, entity.UserNameMinLength, "name"); err != nil {
-		return apperrors.Validation(err.Error())
-	}
-	if err := validator.ValidateMaxLength(req.Name, entity.UserNameMaxLength, "name"); err != nil {
-		return apperrors.Validation(err.Error())
+	if err := checkName(req.Name); err != nil {
+		fields.Add("name", err.Error())
 	}
 
 	// Validate role
 	if err := validator.ValidateRequired(req.Role, "role"); err != nil {
-		return apperrors.Validation(err.Error())
+		fields.Add("role", err.Error())
+	} else if err := entity.ValidateRole(req.Role); err != nil {
+		fields.Add("role", err.Error())
 	}
-	if err := entity.ValidateRole(req.Role); err != nil {
-		return apperrors.Validation(err.Error())
+
+	// Validate client type
+	if err := checkClientType(req.ClientType); err != nil {
+		fields.Add("platform", err.Error())
 	}
 
+	return fields.Err()
+}
+
+// checkName checks a user name against the length rules
+func checkName(name string) error {
+	if err := validator.ValidateRequired(name, "name"); err != nil {
+		return err
+	}
+	if err := validator.ValidateMinLength(name, entity.UserNameMinLength, "name"); err != nil {
+		return err
+	}
+	return validator.ValidateMaxLength(name, entity.UserNameMaxLength, "name")
+}
+
+// validatePassword checks a new password against the password strength rules
+func validatePassword(password, field string) error {
+	if err := checkPassword(password, field); err != nil {
+		return apperrors.Validation(err.Error()).WithValidationErrors(
+			[]apperrors.ValidationError{{Field: field, Message: err.Error()}},
+		)
+	}
 	return nil
 }
+
+// checkPassword checks a password against the password strength rules
+func checkPassword(password, field string) error {
+	if err := validator.ValidateRequired(password, field); err != nil {
+		return err
+	}
+	return validator.ValidateMinLength(password, PasswordMinLength, field)
+}
@@ -6,8 +6,11 @@ import (
 	"strings"
 	"time"
 
+	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
+	emailMocks "github.com/fumkob/ezqrin-server/internal/domain/email/mocks"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
 	"github.com/fumkob/ezqrin-server/internal/usecase/auth"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
 	. "github.com/onsi/ginkgo/v2"
@@ -16,7 +19,13 @@ import (
 	"go.uber.org/zap"
 )
 
-const testJWTSecret = "test-jwt-secret-for-unit-tests-only"
+const (
+	testJWTSecret    = "test-jwt-secret-for-unit-tests-only"
+	testTwoFactorKey = "test-two-factor-key-for-unit-tests-only"
+)
+
+// testSigner signs and verifies the tokens of the use cases with testJWTSecret
+var testSigner, _ = crypto.NewTokenSigner(crypto.SigningConfig{Secret: testJWTSecret})
 
 var _ = Describe("RegisterUseCase", func() {
 	var (
@@ -31,7 +40,11 @@ var _ = Describe("RegisterUseCase", func() {
 		ctrl = gomock.NewController(GinkgoT())
 		mockUserRepo = mocks.NewMockUserRepository(ctrl)
 		nopLogger = &logger.Logger{Logger: zap.NewNop()}
-		useCase = auth.NewRegisterUseCase(mockUserRepo, testJWTSecret, nopLogger)
+		useCase = auth.NewRegisterUseCase(
+			mockUserRepo, testSigner, nil,
+			auth.AccessTokenExpiry, auth.RefreshTokenExpiryWeb, auth.RefreshTokenExpiryMobile,
+			nopLogger,
+		)
 		ctx = context.Background()
 	})
 
@@ -71,6 +84,82 @@ var _ = Describe("RegisterUseCase", func() {
 					// Password hash should be present (not cleared on register)
 					Expect(result.User.PasswordHash).NotTo(BeEmpty())
 				})
+
+				It("should issue a mobile refresh token to mobile clients", func() {
+					mockUserRepo.EXPECT().ExistsByEmail(ctx, "alice@example.com").Return(false, nil)
+					mockUserRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+					result, err := useCase.Execute(ctx, &auth.RegisterRequest{
+						Email:      "alice@example.com",
+						Password:   "SecurePass1!",
+						Name:       "Alice",
+						Role:       "organizer",
+						ClientType: auth.ClientTypeMobile,
+					})
+
+					Expect(err).NotTo(HaveOccurred())
+					claims, parseErr := crypto.ParseToken(result.RefreshToken, testJWTSecret)
+					Expect(parseErr).NotTo(HaveOccurred())
+					Expect(claims.ClientType).To(Equal(auth.ClientTypeMobile))
+					expected := time.Now().Add(auth.RefreshTokenExpiryMobile)
+					Expect(claims.ExpiresAt.Time).To(BeTemporally("~", expected, 5*time.Second))
+				})
+
+				It("should report the configured access token expiry", func() {
+					useCase = auth.NewRegisterUseCase(
+						mockUserRepo, testSigner, nil,
+						30*time.Minute, auth.RefreshTokenExpiryWeb, auth.RefreshTokenExpiryMobile,
+						nopLogger,
+					)
+					mockUserRepo.EXPECT().ExistsByEmail(ctx, "alice@example.com").Return(false, nil)
+					mockUserRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+					result, err := useCase.Execute(ctx, &auth.RegisterRequest{
+						Email:    "alice@example.com",
+						Password: "SecurePass1!",
+						Name:     "Alice",
+						Role:     "organizer",
+					})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.ExpiresIn).To(Equal(1800))
+				})
+
+				It("should email the new user a verification link", func() {
+					mockCacheRepo := mocks.NewMockCacheRepository(ctrl)
+					mockEmailSender := emailMocks.NewMockSender(ctrl)
+					verification := auth.NewEmailVerificationUseCase(
+						mockUserRepo, mockCacheRepo, mockEmailSender, testJWTSecret,
+						"https://app.example.com/verify-email", 24*time.Hour, true, nopLogger,
+					)
+					useCase = auth.NewRegisterUseCase(
+						mockUserRepo, testSigner, verification,
+						auth.AccessTokenExpiry, auth.RefreshTokenExpiryWeb, auth.RefreshTokenExpiryMobile,
+						nopLogger,
+					)
+					mockUserRepo.EXPECT().ExistsByEmail(ctx, "alice@example.com").Return(false, nil)
+					mockUserRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+					mockCacheRepo.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), 24*time.Hour).Return(nil)
+					mockEmailSender.EXPECT().Send(ctx, gomock.Any()).DoAndReturn(
+						func(_ context.Context, msg domainemail.Message) error {
+							Expect(msg.To).To(Equal("alice@example.com"))
+							Expect(msg.TextBody).To(ContainSubstring("https://app.example.com/verify-email?token="))
+							Expect(msg.Body).To(BeEmpty())
+							return errors.New("smtp unavailable")
+						},
+					)
+
+					result, err := useCase.Execute(ctx, &auth.RegisterRequest{
+						Email:    "alice@example.com",
+						Password: "SecurePass1!",
+						Name:     "Alice",
+						Role:     "organizer",
+					})
+
+					// A failed verification email does not fail the registration
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.User.EmailVerified).To(BeFalse())
+				})
 			})
 
 			Context("with empty email", func() {
@@ -243,39 +332,58 @@ var _ = Describe("RegisterUseCase", func() {
 					Expect(appErr.Code).To(Equal(apperrors.CodeValidation))
 				})
 			})
-		})
 
-		When("the email already exists", func() {
-			Context("and ExistsByEmail returns true", func() {
-				It("should return a conflict error", func() {
-					mockUserRepo.EXPECT().
-						ExistsByEmail(ctx, "alice@example.com").
-						Return(true, nil)
+			Context("with an unknown client type", func() {
+				It("should return a validation error for the platform", func() {
+					req := &auth.RegisterRequest{
+						Email:      "alice@example.com",
+						Password:   "SecurePass1!",
+						Name:       "Alice",
+						Role:       "organizer",
+						ClientType: "desktop",
+					}
+
+					result, err := useCase.Execute(ctx, req)
 
+					Expect(result).To(BeNil())
+					var appErr *apperrors.AppError
+					Expect(errors.As(err, &appErr)).To(BeTrue())
+					Expect(appErr.Code).To(Equal(apperrors.CodeValidation))
+					Expect(appErr.ValidationErrors).To(HaveLen(1))
+					Expect(appErr.ValidationErrors[0].Field).To(Equal("platform"))
+				})
+			})
+
+			Context("with several invalid fields", func() {
+				It("should report every invalid field", func() {
 					req := &auth.RegisterRequest{
-						Email:    "alice@example.com",
-						Password: "SecurePass1!",
-						Name:     "Alice",
+						Email:    "not-an-email",
+						Password: "short",
+						Name:     "",
 						Role:     "organizer",
 					}
 
 					result, err := useCase.Execute(ctx, req)
 
-					Expect(err).To(HaveOccurred())
 					Expect(result).To(BeNil())
 					var appErr *apperrors.AppError
 					Expect(errors.As(err, &appErr)).To(BeTrue())
-					Expect(appErr.Code).To(Equal(apperrors.CodeConflict))
+					Expect(appErr.Code).To(Equal(apperrors.CodeValidation))
+					fields := make([]string, 0, len(appErr.ValidationErrors))
+					for _, fieldErr := range appErr.ValidationErrors {
+						fields = append(fields, fieldErr.Field)
+					}
+					Expect(fields).To(Equal([]string{"email", "password", "name"}))
 				})
 			})
 		})
 
-		When("checking email existence fails", func() {
-			Context("and ExistsByEmail returns an error", func() {
-				It("should return an internal error", func() {
+		When("the email already exists", func() {
+			Context("and ExistsByEmail returns true", func() {
+				It("should return a conflict error", func() {
 					mockUserRepo.EXPECT().
 						ExistsByEmail(ctx, "alice@example.com").
-						Return(false, errors.New("db connection failed"))
+						Return(true, nil)
 
 					req := &auth.RegisterRequest{
 						Email:    "alice@example.com",
@@ -290,20 +398,17 @@ var _ = Describe("RegisterUseCase", func() {
 					Expect(result).To(BeNil())
 					var appErr *apperrors.AppError
 					Expect(errors.As(err, &appErr)).To(BeTrue())
-					Expect(appErr.Code).To(Equal(apperrors.CodeInternal))
+					Expect(appErr.Code).To(Equal(apperrors.CodeConflict))
 				})
 			})
 		})
 
-		When("persisting the user fails", func() {
-			Context("and Create returns an error", func() {
+		When("checking email existence fails", func() {
+			Context("and ExistsByEmail returns an error", func() {
 				It("should return an internal error", func() {
 					mockUserRepo.EXPECT().
 						ExistsByEmail(ctx, "alice@example.com").
-						Return(false, nil)
-					mockUserRepo.EXPECT().
-						Create(ctx, gomock.Any()).
-						Return(errors.New("insert failed"))
+						Return(false, errors.New("db connection failed"))
 
 					req := &auth.RegisterRequest{
 						Email:    "alice@example.com",
@@ -319,22 +424,19 @@ var _ = Describe("RegisterUseCase", func() {
 					var appErr *apperrors.AppError
 					Expect(errors.As(err, &appErr)).To(BeTrue())
 					Expect(appErr.Code).To(Equal(apperrors.CodeInternal))
-					Expect(appErr.Message).To(ContainSubstring("failed to create user"))
 				})
 			})
 		})
 
-		When("token generation would fail due to empty secret", func() {
-			Context("and the JWT secret is empty", func() {
+		When("persisting the user fails", func() {
+			Context("and Create returns an error", func() {
 				It("should return an internal error", func() {
-					useCaseWithEmptySecret := auth.NewRegisterUseCase(mockUserRepo, "", nopLogger)
-
 					mockUserRepo.EXPECT().
 						ExistsByEmail(ctx, "alice@example.com").
 						Return(false, nil)
 					mockUserRepo.EXPECT().
 						Create(ctx, gomock.Any()).
-						Return(nil)
+						Return(errors.New("insert failed"))
 
 					req := &auth.RegisterRequest{
 						Email:    "alice@example.com",
@@ -343,13 +445,14 @@ var _ = Describe("RegisterUseCase", func() {
 						Role:     "organizer",
 					}
 
-					result, err := useCaseWithEmptySecret.Execute(ctx, req)
+					result, err := useCase.Execute(ctx, req)
 
 					Expect(err).To(HaveOccurred())
 					Expect(result).To(BeNil())
 					var appErr *apperrors.AppError
 					Expect(errors.As(err, &appErr)).To(BeTrue())
 					Expect(appErr.Code).To(Equal(apperrors.CodeInternal))
+					Expect(appErr.Message).To(ContainSubstring("failed to create user"))
 				})
 			})
 		})
@@ -397,7 +500,7 @@ var _ = Describe("RegisterUseCase", func() {
 						Email:    "timing@example.com",
 						Password: "SecurePass1!",
 						Name:     "Timing Test",
-						Role:     "staff",
+						Role:     "organizer",
 					}
 
 					before := time.Now()
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SessionUseCase lists and revokes the login sessions of a user
+type SessionUseCase struct {
+	sessionRepo   repository.SessionRepository
+	blacklistRepo repository.TokenBlacklistRepository
+	logger        *logger.Logger
+}
+
+// NewSessionUseCase creates a new SessionUseCase
+func NewSessionUseCase(
+	sessionRepo repository.SessionRepository,
+	blacklistRepo repository.TokenBlacklistRepository,
+	logger *logger.Logger,
+) *SessionUseCase {
+	return &SessionUseCase{
+		sessionRepo:   sessionRepo,
+		blacklistRepo: blacklistRepo,
+		logger:        logger,
+	}
+}
+
+// List returns the active sessions of a user, most recently used first
+func (u *SessionUseCase) List(ctx context.Context, userID uuid.UUID) ([]*entity.Session, error) {
+	return u.sessionRepo.ListByUserID(ctx, userID)
+}
+
+// Revoke ends a session of a user by blacklisting its token family, so that neither its refresh
+// token nor the access tokens issued for it can be used any longer.
+func (u *SessionUseCase) Revoke(ctx context.Context, userID, sessionID uuid.UUID) error {
+	session, err := u.sessionRepo.FindByID(ctx, userID, sessionID)
+	if err != nil {
+		return err
+	}
+	if err := u.revoke(ctx, session); err != nil {
+		return err
+	}
+
+	u.logger.WithContext(ctx).Info(fmt.Sprintf("session %s revoked for user: %s", sessionID, userID))
+	return nil
+}
+
+// RevokeOthers ends every session of a user except currentSessionID, the session of the
+// request, and returns how many were revoked
+func (u *SessionUseCase) RevokeOthers(ctx context.Context, userID, currentSessionID uuid.UUID) (int, error) {
+	sessions, err := u.sessionRepo.ListByUserID(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	revoked := 0
+	for _, session := range sessions {
+		if session.ID == currentSessionID {
+			continue
+		}
+		if err := u.revoke(ctx, session); err != nil {
+			if apperrors.IsNotFound(err) {
+				// Revoked concurrently, e.g. by another request of the user
+				continue
+			}
+			return revoked, err
+		}
+		revoked++
+	}
+
+	u.logger.WithContext(ctx).Info(fmt.Sprintf("%d other sessions revoked for user: %s", revoked, userID))
+	return revoked, nil
+}
+
+// revoke blacklists the token family of a session and removes the session. The family is
+// blacklisted first, so a failure leaves the session listed rather than silently usable.
+func (u *SessionUseCase) revoke(ctx context.Context, session *entity.Session) error {
+	if ttl := time.Until(session.ExpiresAt); ttl > 0 {
+		if err := u.blacklistRepo.AddFamilyToBlacklist(ctx, session.ID.String(), ttl); err != nil {
+			u.logger.WithContext(ctx).Error("failed to blacklist session token family", zap.Error(err))
+			return apperrors.Internal("failed to revoke session")
+		}
+	}
+	return u.sessionRepo.Delete(ctx, session.UserID, session.ID)
+}
+
+// issueSessionTokens issues the access and refresh tokens of a session, both carrying its ID,
+// and saves the session with its new refresh token so that it can be listed and revoked
+func issueSessionTokens(
+	ctx context.Context,
+	sessionRepo repository.SessionRepository,
+	signer *crypto.TokenSigner,
+	user *entity.User,
+	session *entity.Session,
+	accessExpiry, refreshExpiry time.Duration,
+	log *logger.Logger,
+) (string, string, error) {
+	accessToken, err := signer.GenerateSessionAccessToken(
+		user.ID.String(), string(user.Role), session.ID.String(), accessExpiry,
+	)
+	if err != nil {
+		log.WithContext(ctx).Error("failed to generate access token", zap.Error(err))
+		return "", "", apperrors.Internal("failed to generate access token")
+	}
+
+	refreshToken, err := signer.GenerateSessionRefreshToken(
+		user.ID.String(), string(user.Role), session.ClientType, session.ID.String(), refreshExpiry,
+	)
+	if err != nil {
+		log.WithContext(ctx).Error("failed to generate refresh token", zap.Error(err))
+		return "", "", apperrors.Internal("failed to generate refresh token")
+	}
+
+	now := time.Now()
+	session.RefreshToken = refreshToken
+	session.LastUsedAt = now
+	session.ExpiresAt = now.Add(refreshExpiry)
+	if err := sessionRepo.Save(ctx, session); err != nil {
+		log.WithContext(ctx).Error("failed to save session", zap.Error(err))
+		return "", "", apperrors.Internal("failed to save session")
+	}
+
+	return accessToken, refreshToken, nil
+}
@@ -0,0 +1,112 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/auth"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("SessionUseCase", func() {
+	var (
+		ctrl              *gomock.Controller
+		mockSessionRepo   *mocks.MockSessionRepository
+		mockBlacklistRepo *mocks.MockTokenBlacklistRepository
+		useCase           *auth.SessionUseCase
+		ctx               context.Context
+		userID            uuid.UUID
+		current           *entity.Session
+		other             *entity.Session
+	)
+
+	newSession := func(token string) *entity.Session {
+		session := entity.NewSession(userID, "web", "Mozilla/5.0", time.Now().Add(-time.Hour))
+		session.RefreshToken = token
+		session.LastUsedAt = time.Now()
+		session.ExpiresAt = time.Now().Add(auth.RefreshTokenExpiryWeb)
+		return session
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockSessionRepo = mocks.NewMockSessionRepository(ctrl)
+		mockBlacklistRepo = mocks.NewMockTokenBlacklistRepository(ctrl)
+		useCase = auth.NewSessionUseCase(mockSessionRepo, mockBlacklistRepo, &logger.Logger{Logger: zap.NewNop()})
+		ctx = context.Background()
+		userID = uuid.New()
+		current = newSession("current.refresh.token")
+		other = newSession("other.refresh.token")
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("List", func() {
+		It("should return the sessions of the user", func() {
+			mockSessionRepo.EXPECT().ListByUserID(ctx, userID).Return([]*entity.Session{current, other}, nil)
+
+			sessions, err := useCase.List(ctx, userID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sessions).To(Equal([]*entity.Session{current, other}))
+		})
+	})
+
+	Describe("Revoke", func() {
+		It("should blacklist the token family of the session and remove it", func() {
+			mockSessionRepo.EXPECT().FindByID(ctx, userID, other.ID).Return(other, nil)
+			mockBlacklistRepo.EXPECT().AddFamilyToBlacklist(ctx, other.ID.String(), gomock.Any()).Return(nil)
+			mockSessionRepo.EXPECT().Delete(ctx, userID, other.ID).Return(nil)
+
+			Expect(useCase.Revoke(ctx, userID, other.ID)).To(Succeed())
+		})
+
+		It("should return not found for an unknown session", func() {
+			mockSessionRepo.EXPECT().FindByID(ctx, userID, other.ID).
+				Return(nil, apperrors.NotFound("session not found"))
+
+			err := useCase.Revoke(ctx, userID, other.ID)
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should keep the session when its token family cannot be blacklisted", func() {
+			mockSessionRepo.EXPECT().FindByID(ctx, userID, other.ID).Return(other, nil)
+			mockBlacklistRepo.EXPECT().AddFamilyToBlacklist(ctx, other.ID.String(), gomock.Any()).
+				Return(errors.New("redis error"))
+
+			err := useCase.Revoke(ctx, userID, other.ID)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeInternal))
+		})
+	})
+
+	Describe("RevokeOthers", func() {
+		It("should revoke every session but the current one", func() {
+			third := newSession("third.refresh.token")
+			mockSessionRepo.EXPECT().ListByUserID(ctx, userID).
+				Return([]*entity.Session{current, other, third}, nil)
+			mockBlacklistRepo.EXPECT().AddFamilyToBlacklist(ctx, other.ID.String(), gomock.Any()).Return(nil)
+			mockSessionRepo.EXPECT().Delete(ctx, userID, other.ID).Return(nil)
+			mockBlacklistRepo.EXPECT().AddFamilyToBlacklist(ctx, third.ID.String(), gomock.Any()).Return(nil)
+			mockSessionRepo.EXPECT().Delete(ctx, userID, third.ID).
+				Return(apperrors.NotFound("session not found"))
+
+			revoked, err := useCase.RevokeOthers(ctx, userID, current.ID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revoked).To(Equal(1))
+		})
+	})
+})
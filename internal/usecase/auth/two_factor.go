@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/validator"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// TwoFactorUseCase handles enrolling organizers and admins in TOTP two-factor authentication
+type TwoFactorUseCase struct {
+	userRepo      repository.UserRepository
+	qrGenerator   *qrcode.Generator
+	encryptionKey string
+	issuer        string
+	logger        *logger.Logger
+}
+
+// NewTwoFactorUseCase creates a new TwoFactorUseCase.
+// encryptionKey encrypts the stored TOTP secrets; when empty, enrollment is unavailable.
+// issuer names the service in authenticator apps.
+func NewTwoFactorUseCase(
+	userRepo repository.UserRepository,
+	qrGenerator *qrcode.Generator,
+	encryptionKey string,
+	issuer string,
+	logger *logger.Logger,
+) *TwoFactorUseCase {
+	return &TwoFactorUseCase{
+		userRepo:      userRepo,
+		qrGenerator:   qrGenerator,
+		encryptionKey: encryptionKey,
+		issuer:        issuer,
+		logger:        logger,
+	}
+}
+
+// TwoFactorEnrollment is the TOTP secret of a pending enrollment, to add to an authenticator app
+type TwoFactorEnrollment struct {
+	Secret     string // Base32 secret for manual entry
+	OTPAuthURI string // otpauth:// URI carrying the secret
+	QRCode     string // Base64-encoded PNG QR code of OTPAuthURI
+}
+
+// TwoFactorActivation is the result of verifying an enrollment
+type TwoFactorActivation struct {
+	BackupCodes []string // Single-use backup codes, shown only once
+}
+
+// Enroll starts a two-factor enrollment for the user, replacing any earlier unverified one.
+// Two-factor login is only required once the enrollment is verified with Verify.
+func (u *TwoFactorUseCase) Enroll(ctx context.Context, userID uuid.UUID) (*TwoFactorEnrollment, error) {
+	if err := u.requireConfigured(ctx); err != nil {
+		return nil, err
+	}
+
+	user, err := u.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !user.CanManageEvents() {
+		return nil, apperrors.Forbidden("two-factor authentication is only available to organizers and admins")
+	}
+	if user.TwoFactorEnabled {
+		return nil, apperrors.Conflict("two-factor authentication is already enabled")
+	}
+
+	secret, err := crypto.GenerateTOTPSecret()
+	if err != nil {
+		u.logger.WithContext(ctx).Error("failed to generate TOTP secret", zap.Error(err))
+		return nil, apperrors.Internal("failed to generate two-factor secret")
+	}
+	encrypted, err := crypto.EncryptSecret(secret, u.encryptionKey)
+	if err != nil {
+		u.logger.WithContext(ctx).Error("failed to encrypt TOTP secret", zap.Error(err))
+		return nil, apperrors.Internal("failed to generate two-factor secret")
+	}
+
+	uri := crypto.TOTPAuthURI(u.issuer, user.Email, secret)
+	qrCode, err := u.qrGenerator.GeneratePNGBase64(ctx, uri, qrcode.DEFAULT_SIZE)
+	if err != nil {
+		u.logger.WithContext(ctx).Error("failed to generate two-factor QR code", zap.Error(err))
+		return nil, apperrors.Internal("failed to generate two-factor QR code")
+	}
+
+	if err := u.userRepo.StartTwoFactorEnrollment(ctx, user.ID, encrypted); err != nil {
+		return nil, err
+	}
+
+	u.logger.WithContext(ctx).Info(fmt.Sprintf("two-factor enrollment started for user: %s", user.ID))
+
+	return &TwoFactorEnrollment{
+		Secret:     secret,
+		OTPAuthURI: uri,
+		QRCode:     qrCode,
+	}, nil
+}
+
+// Verify confirms the pending enrollment of the user with a code from the authenticator app,
+// enables two-factor login and returns the backup codes.
+func (u *TwoFactorUseCase) Verify(ctx context.Context, userID uuid.UUID, code string) (*TwoFactorActivation, error) {
+	if err := validator.ValidateRequired(code, "code"); err != nil {
+		return nil, apperrors.Validation(err.Error())
+	}
+	if err := u.requireConfigured(ctx); err != nil {
+		return nil, err
+	}
+
+	twoFactor, err := u.userRepo.FindTwoFactor(ctx, userID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil, apperrors.NotFound("no two-factor enrollment is pending, enroll first")
+		}
+		return nil, err
+	}
+	if twoFactor.IsEnabled() {
+		return nil, apperrors.Conflict("two-factor authentication is already enabled")
+	}
+
+	secret, err := crypto.DecryptSecret(twoFactor.EncryptedSecret, u.encryptionKey)
+	if err != nil {
+		u.logger.WithContext(ctx).Error("failed to decrypt TOTP secret", zap.Error(err))
+		return nil, apperrors.Internal("failed to verify two-factor code")
+	}
+	step, ok := crypto.ValidateTOTP(secret, code, time.Now())
+	if !ok {
+		return nil, apperrors.Validation("invalid two-factor code")
+	}
+
+	backupCodes, err := crypto.GenerateBackupCodes(entity.TwoFactorBackupCodeCount)
+	if err != nil {
+		u.logger.WithContext(ctx).Error("failed to generate backup codes", zap.Error(err))
+		return nil, apperrors.Internal("failed to generate backup codes")
+	}
+	hashes := make([]string, len(backupCodes))
+	for i, backupCode := range backupCodes {
+		hashes[i] = crypto.HashBackupCode(backupCode)
+	}
+
+	// The verification code counts as used, so it cannot also complete a login
+	if err := u.userRepo.EnableTwoFactor(ctx, userID, hashes, step); err != nil {
+		return nil, err
+	}
+
+	u.logger.WithContext(ctx).Info(fmt.Sprintf("two-factor authentication enabled for user: %s", userID))
+
+	return &TwoFactorActivation{BackupCodes: backupCodes}, nil
+}
+
+// requireConfigured fails when no encryption key is configured for TOTP secrets
+func (u *TwoFactorUseCase) requireConfigured(ctx context.Context) error {
+	if u.encryptionKey == "" {
+		u.logger.WithContext(ctx).Warn("two-factor enrollment attempted without an encryption key configured")
+		return apperrors.ServiceUnavailable("two-factor authentication is not configured")
+	}
+	return nil
+}
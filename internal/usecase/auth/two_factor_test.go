@@ -0,0 +1,382 @@
+package auth_test
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/usecase/auth"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("TwoFactor", func() {
+	const testPassword = "ValidPassword1!"
+
+	var (
+		ctrl            *gomock.Controller
+		mockUserRepo    *mocks.MockUserRepository
+		mockSessionRepo *mocks.MockSessionRepository
+		twoFactorUC     *auth.TwoFactorUseCase
+		loginUC         *auth.LoginUseCase
+		ctx             context.Context
+		nopLogger       *logger.Logger
+		testUser        *entity.User
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		mockUserRepo = mocks.NewMockUserRepository(ctrl)
+		mockSessionRepo = mocks.NewMockSessionRepository(ctrl)
+		mockSessionRepo.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+		nopLogger = &logger.Logger{Logger: zap.NewNop()}
+		twoFactorUC = auth.NewTwoFactorUseCase(
+			mockUserRepo, qrcode.NewGenerator(), testTwoFactorKey, "ezQRin", nopLogger,
+		)
+		loginUC = auth.NewLoginUseCase(
+			mockUserRepo,
+			mockSessionRepo,
+			testSigner,
+			testTwoFactorKey,
+			auth.AccessTokenExpiry,
+			auth.RefreshTokenExpiryWeb,
+			auth.RefreshTokenExpiryMobile,
+			nopLogger,
+		)
+		ctx = context.Background()
+
+		passwordHash, err := crypto.HashPassword(testPassword)
+		Expect(err).NotTo(HaveOccurred())
+		testUser = &entity.User{
+			ID:           uuid.New(),
+			Email:        "dave@example.com",
+			PasswordHash: passwordHash,
+			Name:         "Dave",
+			Role:         entity.RoleOrganizer,
+			CreatedAt:    time.Now(),
+			UpdatedAt:    time.Now(),
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	// enroll runs Enroll for testUser and returns the enrollment and the secret as stored
+	enroll := func() (*auth.TwoFactorEnrollment, string) {
+		var stored string
+		mockUserRepo.EXPECT().FindByID(ctx, testUser.ID).Return(testUser, nil)
+		mockUserRepo.EXPECT().
+			StartTwoFactorEnrollment(ctx, testUser.ID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ uuid.UUID, encryptedSecret string) error {
+				stored = encryptedSecret
+				return nil
+			})
+
+		enrollment, err := twoFactorUC.Enroll(ctx, testUser.ID)
+		Expect(err).NotTo(HaveOccurred())
+		return enrollment, stored
+	}
+
+	currentCode := func(secret string) string {
+		code, err := crypto.TOTPCode(secret, crypto.TOTPStep(time.Now()))
+		Expect(err).NotTo(HaveOccurred())
+		return code
+	}
+
+	Describe("Enroll", func() {
+		When("an organizer enrolls", func() {
+			It("should return the secret, its otpauth URI and QR code, and store it encrypted", func() {
+				enrollment, stored := enroll()
+
+				Expect(enrollment.Secret).NotTo(BeEmpty())
+				Expect(enrollment.QRCode).NotTo(BeEmpty())
+				uri, err := url.Parse(enrollment.OTPAuthURI)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(uri.Scheme).To(Equal("otpauth"))
+				Expect(uri.Query().Get("secret")).To(Equal(enrollment.Secret))
+				Expect(uri.Query().Get("issuer")).To(Equal("ezQRin"))
+
+				Expect(stored).NotTo(ContainSubstring(enrollment.Secret))
+				decrypted, err := crypto.DecryptSecret(stored, testTwoFactorKey)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decrypted).To(Equal(enrollment.Secret))
+			})
+		})
+
+		When("a staff user enrolls", func() {
+			It("should return a forbidden error", func() {
+				testUser.Role = entity.RoleStaff
+				mockUserRepo.EXPECT().FindByID(ctx, testUser.ID).Return(testUser, nil)
+
+				_, err := twoFactorUC.Enroll(ctx, testUser.ID)
+				Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+
+		When("two-factor authentication is already enabled", func() {
+			It("should return a conflict error", func() {
+				testUser.TwoFactorEnabled = true
+				mockUserRepo.EXPECT().FindByID(ctx, testUser.ID).Return(testUser, nil)
+
+				_, err := twoFactorUC.Enroll(ctx, testUser.ID)
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+		})
+
+		When("no encryption key is configured", func() {
+			It("should return a service unavailable error", func() {
+				unconfigured := auth.NewTwoFactorUseCase(mockUserRepo, qrcode.NewGenerator(), "", "ezQRin", nopLogger)
+
+				_, err := unconfigured.Enroll(ctx, testUser.ID)
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeServiceUnavailable))
+			})
+		})
+	})
+
+	Describe("Verify", func() {
+		var (
+			secret string
+			stored string
+		)
+
+		BeforeEach(func() {
+			var enrollment *auth.TwoFactorEnrollment
+			enrollment, stored = enroll()
+			secret = enrollment.Secret
+			mockUserRepo.EXPECT().FindTwoFactor(ctx, testUser.ID).Return(&entity.UserTwoFactor{
+				UserID:          testUser.ID,
+				EncryptedSecret: stored,
+			}, nil)
+		})
+
+		When("the code matches the enrolled secret", func() {
+			It("should enable two-factor login and return backup codes", func() {
+				var storedHashes []string
+				mockUserRepo.EXPECT().
+					EnableTwoFactor(ctx, testUser.ID, gomock.Any(), crypto.TOTPStep(time.Now())).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, hashes []string, _ int64) error {
+						storedHashes = hashes
+						return nil
+					})
+
+				activation, err := twoFactorUC.Verify(ctx, testUser.ID, currentCode(secret))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(activation.BackupCodes).To(HaveLen(entity.TwoFactorBackupCodeCount))
+				Expect(storedHashes).To(HaveLen(entity.TwoFactorBackupCodeCount))
+				Expect(storedHashes).To(ContainElement(crypto.HashBackupCode(activation.BackupCodes[0])))
+				Expect(storedHashes).NotTo(ContainElement(activation.BackupCodes[0]))
+			})
+		})
+
+		When("the code is wrong", func() {
+			It("should return a validation error without enabling two-factor login", func() {
+				code := "000000"
+				if currentCode(secret) == code {
+					code = "111111"
+				}
+
+				_, err := twoFactorUC.Verify(ctx, testUser.ID, code)
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Login with two-factor authentication", func() {
+		var (
+			secret    string
+			twoFactor *entity.UserTwoFactor
+		)
+
+		BeforeEach(func() {
+			enrollment, stored := enroll()
+			secret = enrollment.Secret
+
+			testUser.TwoFactorEnabled = true
+			enabledAt := time.Now()
+			twoFactor = &entity.UserTwoFactor{
+				UserID:           testUser.ID,
+				EncryptedSecret:  stored,
+				EnabledAt:        &enabledAt,
+				BackupCodeHashes: []string{crypto.HashBackupCode("abcde-fghij")},
+			}
+		})
+
+		// challenge logs in with the password and returns the challenge token
+		challenge := func(clientType string) string {
+			mockUserRepo.EXPECT().FindByEmailWithPassword(ctx, testUser.Email).Return(testUser, nil)
+
+			result, err := loginUC.Execute(ctx, &auth.LoginRequest{
+				Email:      testUser.Email,
+				Password:   testPassword,
+				ClientType: clientType,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.AccessToken).To(BeEmpty())
+			Expect(result.RefreshToken).To(BeEmpty())
+			Expect(result.TwoFactorChallenge).NotTo(BeNil())
+			Expect(result.TwoFactorChallenge.ExpiresIn).To(Equal(int(auth.TwoFactorChallengeExpiry.Seconds())))
+			return result.TwoFactorChallenge.Token
+		}
+
+		expectChallengeLookup := func() {
+			mockUserRepo.EXPECT().FindByID(ctx, testUser.ID).Return(testUser, nil)
+			mockUserRepo.EXPECT().FindTwoFactor(ctx, testUser.ID).Return(twoFactor, nil)
+		}
+
+		When("the password is followed by the current TOTP code", func() {
+			It("should complete the login with tokens for the original client type", func() {
+				token := challenge(auth.ClientTypeMobile)
+				expectChallengeLookup()
+				mockUserRepo.EXPECT().
+					ConsumeTwoFactorStep(ctx, testUser.ID, crypto.TOTPStep(time.Now())).
+					Return(true, nil)
+
+				result, err := loginUC.LoginWithTwoFactor(ctx, &auth.TwoFactorLoginRequest{
+					ChallengeToken: token,
+					Code:           currentCode(secret),
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.AccessToken).NotTo(BeEmpty())
+				Expect(result.TwoFactorChallenge).To(BeNil())
+				Expect(result.User.ID).To(Equal(testUser.ID))
+
+				claims, err := crypto.ParseToken(result.RefreshToken, testJWTSecret)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(claims.ClientType).To(Equal(auth.ClientTypeMobile))
+			})
+		})
+
+		When("the TOTP code is wrong", func() {
+			It("should reject the login and count the failure", func() {
+				token := challenge(auth.ClientTypeWeb)
+				expectChallengeLookup()
+				mockUserRepo.EXPECT().
+					RecordTwoFactorFailure(ctx, testUser.ID, gomock.Any()).
+					Return(1, nil)
+
+				code := "000000"
+				if currentCode(secret) == code {
+					code = "111111"
+				}
+				_, err := loginUC.LoginWithTwoFactor(ctx, &auth.TwoFactorLoginRequest{
+					ChallengeToken: token,
+					Code:           code,
+				})
+				Expect(apperrors.IsUnauthorized(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("invalid two-factor code"))
+			})
+		})
+
+		When("the TOTP code was already used", func() {
+			It("should reject the login", func() {
+				token := challenge(auth.ClientTypeWeb)
+				expectChallengeLookup()
+				mockUserRepo.EXPECT().ConsumeTwoFactorStep(ctx, testUser.ID, gomock.Any()).Return(false, nil)
+				mockUserRepo.EXPECT().RecordTwoFactorFailure(ctx, testUser.ID, gomock.Any()).Return(1, nil)
+
+				_, err := loginUC.LoginWithTwoFactor(ctx, &auth.TwoFactorLoginRequest{
+					ChallengeToken: token,
+					Code:           currentCode(secret),
+				})
+				Expect(apperrors.IsUnauthorized(err)).To(BeTrue())
+			})
+		})
+
+		When("a backup code is entered", func() {
+			It("should consume the backup code and complete the login", func() {
+				token := challenge(auth.ClientTypeWeb)
+				expectChallengeLookup()
+				mockUserRepo.EXPECT().
+					ConsumeBackupCode(ctx, testUser.ID, crypto.HashBackupCode("abcde-fghij")).
+					Return(true, nil)
+
+				result, err := loginUC.LoginWithTwoFactor(ctx, &auth.TwoFactorLoginRequest{
+					ChallengeToken: token,
+					Code:           "ABCDE-FGHIJ",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.AccessToken).NotTo(BeEmpty())
+			})
+		})
+
+		When("too many invalid codes were entered", func() {
+			It("should refuse the login without checking the code", func() {
+				token := challenge(auth.ClientTypeWeb)
+				lastFailed := time.Now()
+				twoFactor.FailedAttempts = entity.TwoFactorMaxFailedAttempts
+				twoFactor.LastFailedAt = &lastFailed
+				expectChallengeLookup()
+
+				_, err := loginUC.LoginWithTwoFactor(ctx, &auth.TwoFactorLoginRequest{
+					ChallengeToken: token,
+					Code:           currentCode(secret),
+				})
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeTooManyRequests))
+			})
+		})
+
+		When("the current TOTP code is sent with the password", func() {
+			It("should complete the login without a challenge", func() {
+				mockUserRepo.EXPECT().FindByEmailWithPassword(ctx, testUser.Email).Return(testUser, nil)
+				mockUserRepo.EXPECT().FindTwoFactor(ctx, testUser.ID).Return(twoFactor, nil)
+				mockUserRepo.EXPECT().
+					ConsumeTwoFactorStep(ctx, testUser.ID, crypto.TOTPStep(time.Now())).
+					Return(true, nil)
+
+				result, err := loginUC.Execute(ctx, &auth.LoginRequest{
+					Email:      testUser.Email,
+					Password:   testPassword,
+					ClientType: auth.ClientTypeWeb,
+					TOTPCode:   currentCode(secret),
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.AccessToken).NotTo(BeEmpty())
+				Expect(result.TwoFactorChallenge).To(BeNil())
+			})
+		})
+
+		When("a wrong TOTP code is sent with the password", func() {
+			It("should reject the login and count the failure", func() {
+				mockUserRepo.EXPECT().FindByEmailWithPassword(ctx, testUser.Email).Return(testUser, nil)
+				mockUserRepo.EXPECT().FindTwoFactor(ctx, testUser.ID).Return(twoFactor, nil)
+				mockUserRepo.EXPECT().RecordTwoFactorFailure(ctx, testUser.ID, gomock.Any()).Return(1, nil)
+
+				code := "000000"
+				if currentCode(secret) == code {
+					code = "111111"
+				}
+				_, err := loginUC.Execute(ctx, &auth.LoginRequest{
+					Email:    testUser.Email,
+					Password: testPassword,
+					TOTPCode: code,
+				})
+				Expect(apperrors.IsUnauthorized(err)).To(BeTrue())
+			})
+		})
+
+		When("an access token is sent as the challenge", func() {
+			It("should return an unauthorized error", func() {
+				accessToken, err := crypto.GenerateAccessToken(
+					testUser.ID.String(), string(testUser.Role), testJWTSecret, time.Minute,
+				)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = loginUC.LoginWithTwoFactor(ctx, &auth.TwoFactorLoginRequest{
+					ChallengeToken: accessToken,
+					Code:           currentCode(secret),
+				})
+				Expect(apperrors.IsUnauthorized(err)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,28 @@
+// Package authz centralizes the authorization rules shared by the usecases.
+package authz
+
+import (
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// IsEventManager reports whether the user may manage the event.
+// Admins manage every event; organizers manage only the events they own.
+func IsEventManager(userID uuid.UUID, event *entity.Event, isAdmin bool) bool {
+	return isAdmin || event.OrganizerID == userID
+}
+
+// CanManageEvent returns a Forbidden error unless the user may manage the event.
+func CanManageEvent(userID uuid.UUID, event *entity.Event, isAdmin bool) error {
+	return RequireEventManager(userID, event, isAdmin, "manage this event")
+}
+
+// RequireEventManager is CanManageEvent with the denied action named in the error,
+// e.g. "update this participant" yields "you do not have permission to update this participant".
+func RequireEventManager(userID uuid.UUID, event *entity.Event, isAdmin bool, action string) error {
+	if IsEventManager(userID, event, isAdmin) {
+		return nil
+	}
+	return apperrors.Forbidden("you do not have permission to " + action)
+}
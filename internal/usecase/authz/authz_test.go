@@ -0,0 +1,66 @@
+package authz_test
+
+import (
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Authz", func() {
+	var (
+		ownerID uuid.UUID
+		otherID uuid.UUID
+		event   *entity.Event
+	)
+
+	BeforeEach(func() {
+		ownerID = uuid.New()
+		otherID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: ownerID}
+	})
+
+	DescribeTable("IsEventManager",
+		func(self bool, isAdmin bool, expected bool) {
+			userID := otherID
+			if self {
+				userID = ownerID
+			}
+			Expect(authz.IsEventManager(userID, event, isAdmin)).To(Equal(expected))
+		},
+		Entry("owner", true, false, true),
+		Entry("admin who does not own the event", false, true, true),
+		Entry("owner who is also admin", true, true, true),
+		Entry("other organizer", false, false, false),
+	)
+
+	Describe("CanManageEvent", func() {
+		It("should allow the owner", func() {
+			Expect(authz.CanManageEvent(ownerID, event, false)).To(Succeed())
+		})
+
+		It("should allow an admin", func() {
+			Expect(authz.CanManageEvent(otherID, event, true)).To(Succeed())
+		})
+
+		It("should return a forbidden error for another organizer", func() {
+			err := authz.CanManageEvent(otherID, event, false)
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("you do not have permission to manage this event"))
+		})
+	})
+
+	Describe("RequireEventManager", func() {
+		It("should name the denied action in the error", func() {
+			err := authz.RequireEventManager(otherID, event, false, "export participants for this event")
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("you do not have permission to export participants for this event"))
+		})
+
+		It("should allow the owner", func() {
+			Expect(authz.RequireEventManager(ownerID, event, false, "update this event")).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,49 @@
+package checkin
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/google/uuid"
+)
+
+// GetStaffAttribution returns how many check-ins each staff member recorded for an event,
+// with self check-ins counted separately.
+func (u *checkinUsecase) GetStaffAttribution(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+) (*StaffAttributionOutput, error) {
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view check-in attribution for this event"); err != nil {
+		return nil, err
+	}
+
+	attribution, err := u.checkinRepo.CountByStaff(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &StaffAttributionOutput{
+		EventID:      eventID,
+		Staff:        make([]StaffCheckinCount, len(attribution.Staff)),
+		SelfCheckins: attribution.SelfCheckins,
+		Total:        attribution.SelfCheckins,
+	}
+	for i, staff := range attribution.Staff {
+		output.Staff[i] = StaffCheckinCount{
+			UserID:       staff.UserID,
+			Name:         staff.Name,
+			CheckinCount: staff.CheckinCount,
+		}
+		output.Total += staff.CheckinCount
+	}
+
+	return output, nil
+}
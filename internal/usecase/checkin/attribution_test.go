@@ -0,0 +1,107 @@
+package checkin_test
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("GetStaffAttribution", func() {
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		uc              checkin.Usecase
+		mockCheckinRepo *mocks.MockCheckinRepository
+		organizerID     uuid.UUID
+		eventID         uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		organizerID = uuid.New()
+		eventID = uuid.New()
+
+		mockCheckinRepo = mocks.NewMockCheckinRepository(ctrl)
+		mockEventRepo := mocks.NewMockEventRepository(ctrl)
+
+		uc = checkin.NewUsecase(
+			mockCheckinRepo, mocks.NewMockParticipantRepository(ctrl), mockEventRepo,
+			mocks.NewMockOutboxRepository(ctrl), mocks.NewMockTransactor(ctrl), nil, nil,
+			testQRHMACSecret, 0, nil, testUndoWindow, 0, 0, nil, testLogger,
+		)
+
+		mockEventRepo.EXPECT().FindByID(gomock.Any(), eventID).
+			Return(&entity.Event{ID: eventID, OrganizerID: organizerID}, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	When("check-ins were recorded by several staff members and by participants themselves", func() {
+		It("should return per-staff counts, the self check-ins and the total", func() {
+			alice, bob := uuid.New(), uuid.New()
+			mockCheckinRepo.EXPECT().CountByStaff(gomock.Any(), eventID).Return(&repository.CheckinAttribution{
+				Staff: []repository.StaffCheckinCount{
+					{UserID: alice, Name: "Alice", CheckinCount: 120},
+					{UserID: bob, Name: "Bob", CheckinCount: 80},
+				},
+				SelfCheckins: 42,
+			}, nil)
+
+			output, err := uc.GetStaffAttribution(ctx, organizerID, false, eventID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.EventID).To(Equal(eventID))
+			Expect(output.Staff).To(Equal([]checkin.StaffCheckinCount{
+				{UserID: alice, Name: "Alice", CheckinCount: 120},
+				{UserID: bob, Name: "Bob", CheckinCount: 80},
+			}))
+			Expect(output.SelfCheckins).To(Equal(int64(42)))
+			Expect(output.Total).To(Equal(int64(242)))
+		})
+	})
+
+	When("the event has no check-ins", func() {
+		It("should return an empty breakdown", func() {
+			mockCheckinRepo.EXPECT().CountByStaff(gomock.Any(), eventID).
+				Return(&repository.CheckinAttribution{Staff: []repository.StaffCheckinCount{}}, nil)
+
+			output, err := uc.GetStaffAttribution(ctx, organizerID, false, eventID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.Staff).To(BeEmpty())
+			Expect(output.Total).To(BeZero())
+		})
+	})
+
+	When("an admin requests another organizer's event", func() {
+		It("should return the breakdown", func() {
+			mockCheckinRepo.EXPECT().CountByStaff(gomock.Any(), eventID).
+				Return(&repository.CheckinAttribution{SelfCheckins: 1}, nil)
+
+			output, err := uc.GetStaffAttribution(ctx, uuid.New(), true, eventID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.Total).To(Equal(int64(1)))
+		})
+	})
+
+	When("the user does not manage the event", func() {
+		It("should return forbidden without counting", func() {
+			_, err := uc.GetStaffAttribution(ctx, uuid.New(), false, eventID)
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("you do not have permission to view check-in attribution"))
+		})
+	})
+})
@@ -0,0 +1,133 @@
+package checkin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/uuids"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// MaxBulkCheckInParticipants is the maximum number of participant IDs in one bulk check-in
+const MaxBulkCheckInParticipants = 1000
+
+// BulkCheckIn manually checks in several participants of an event at once, e.g. a group selected
+// by staff at the entrance. Participants who have already checked in are skipped, and those who
+// cannot check in are reported in the output instead of failing the request. The check-ins and
+// their outbox messages are written in one transaction.
+func (u *checkinUsecase) BulkCheckIn(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input BulkCheckInInput,
+) (*BulkCheckInOutput, error) {
+	switch {
+	case len(input.ParticipantIDs) == 0:
+		return nil, apperrors.BadRequest("participant_ids must not be empty")
+	case len(input.ParticipantIDs) > MaxBulkCheckInParticipants:
+		return nil, apperrors.BadRequest(
+			fmt.Sprintf("at most %d participant_ids can be checked in at once", MaxBulkCheckInParticipants),
+		)
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := u.checkManualCheckInAuth(entity.CheckinMethodManual, event, isAdmin, userID); err != nil {
+		return nil, err
+	}
+
+	ids := uuids.Unique(input.ParticipantIDs)
+	participants, err := u.participantRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*entity.Participant, len(participants))
+	for _, participant := range participants {
+		byID[participant.ID] = participant
+	}
+
+	output := &BulkCheckInOutput{Errors: []BulkCheckInError{}}
+	checkins := make([]*entity.Checkin, 0, len(ids))
+	for _, id := range ids {
+		participant := byID[id]
+		if reason := bulkCheckInRejection(event, participant); reason != "" {
+			output.Errors = append(output.Errors, BulkCheckInError{ParticipantID: id, Reason: reason})
+			continue
+		}
+		if participant.CheckedIn {
+			output.SkippedCount++
+			continue
+		}
+		checkin, err := u.createCheckinRecord(CheckInInput{
+			EventID:     input.EventID,
+			Method:      entity.CheckinMethodManual,
+			CheckedInBy: input.CheckedInBy,
+		}, id)
+		if err != nil {
+			return nil, err
+		}
+		checkins = append(checkins, checkin)
+	}
+	if len(checkins) == 0 {
+		return output, nil
+	}
+
+	// Participants checked in concurrently since they were read are skipped by the insert
+	created := make([]*entity.Checkin, 0, len(checkins))
+	err = u.transactor.WithTransaction(ctx, func(txCtx context.Context) error {
+		createdIDs, err := u.checkinRepo.BulkCreate(txCtx, checkins)
+		if err != nil {
+			return fmt.Errorf("failed to create check-ins: %w", err)
+		}
+		inserted := make(map[uuid.UUID]bool, len(createdIDs))
+		for _, id := range createdIDs {
+			inserted[id] = true
+		}
+		for _, checkin := range checkins {
+			if !inserted[checkin.ParticipantID] {
+				continue
+			}
+			if err := u.enqueueCheckinCreated(txCtx, checkin); err != nil {
+				return err
+			}
+			created = append(created, checkin)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output.CheckedInCount = len(created)
+	output.SkippedCount += len(checkins) - len(created)
+	if len(created) > 0 {
+		u.invalidateProgress(ctx, input.EventID)
+	}
+	for _, checkin := range created {
+		u.auditor.Record(ctx, userID, entity.AuditActionCreate, entity.AuditResourceCheckin, checkin.ID,
+			nil, checkin.AuditFields())
+		u.publishCheckinCreated(ctx, u.buildCheckInOutput(checkin, byID[checkin.ParticipantID]))
+	}
+	return output, nil
+}
+
+// bulkCheckInRejection returns why participant cannot be checked in to event, or "" if they can.
+// A nil participant was not found.
+func bulkCheckInRejection(event *entity.Event, participant *entity.Participant) string {
+	switch {
+	case participant == nil:
+		return "participant not found"
+	case participant.EventID != event.ID:
+		return "participant does not belong to this event"
+	case participant.IsCancelled() || participant.IsDeclined() || participant.IsExpired() ||
+		participant.IsWaitlisted():
+		return fmt.Sprintf("participant status is %s", participant.Status)
+	case event.RequiresConsent && !participant.HasConsent():
+		return "participant has not accepted the consent terms for this event"
+	}
+	return ""
+}
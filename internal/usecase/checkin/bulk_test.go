@@ -0,0 +1,200 @@
+package checkin_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("BulkCheckIn", func() {
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		uc              checkin.Usecase
+		mockCheckinRepo *mocks.MockCheckinRepository
+		mockParticipant *mocks.MockParticipantRepository
+		mockEventRepo   *mocks.MockEventRepository
+		mockOutboxRepo  *mocks.MockOutboxRepository
+		event           *entity.Event
+		organizerID     uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID, Name: "Test Event"}
+
+		mockCheckinRepo = mocks.NewMockCheckinRepository(ctrl)
+		mockParticipant = mocks.NewMockParticipantRepository(ctrl)
+		mockEventRepo = mocks.NewMockEventRepository(ctrl)
+		mockOutboxRepo = mocks.NewMockOutboxRepository(ctrl)
+		mockTransactor := mocks.NewMockTransactor(ctrl)
+		mockTransactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+		).AnyTimes()
+
+		uc = checkin.NewUsecase(
+			mockCheckinRepo, mockParticipant, mockEventRepo, mockOutboxRepo, mockTransactor, nil, nil,
+			testQRHMACSecret, 0, nil, testUndoWindow, 0, 0, nil, testLogger,
+		)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newParticipant := func(eventID uuid.UUID) *entity.Participant {
+		return &entity.Participant{
+			ID:      uuid.New(),
+			EventID: eventID,
+			Name:    "Jane Smith",
+			Status:  entity.ParticipantStatusConfirmed,
+		}
+	}
+
+	When("the participants can check in", func() {
+		It("should check them all in with one insert", func() {
+			first, second := newParticipant(event.ID), newParticipant(event.ID)
+			mockEventRepo.EXPECT().FindByID(gomock.Any(), event.ID).Return(event, nil)
+			mockParticipant.EXPECT().FindByIDs(gomock.Any(), []uuid.UUID{first.ID, second.ID}).
+				Return([]*entity.Participant{second, first}, nil)
+			mockCheckinRepo.EXPECT().BulkCreate(gomock.Any(), gomock.Len(2)).DoAndReturn(
+				func(_ context.Context, checkins []*entity.Checkin) ([]uuid.UUID, error) {
+					for _, c := range checkins {
+						Expect(c.Method).To(Equal(entity.CheckinMethodManual))
+						Expect(c.CheckedInBy).To(HaveValue(Equal(organizerID)))
+					}
+					return []uuid.UUID{first.ID, second.ID}, nil
+				},
+			)
+			mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+			result, err := uc.BulkCheckIn(ctx, organizerID, false, checkin.BulkCheckInInput{
+				EventID:        event.ID,
+				ParticipantIDs: []uuid.UUID{first.ID, second.ID, first.ID},
+				CheckedInBy:    organizerID,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.CheckedInCount).To(Equal(2))
+			Expect(result.SkippedCount).To(BeZero())
+			Expect(result.Errors).To(BeEmpty())
+		})
+	})
+
+	When("some participants cannot check in", func() {
+		It("should report them and skip the ones already checked in", func() {
+			valid := newParticipant(event.ID)
+			checkedIn := newParticipant(event.ID)
+			checkedIn.CheckedIn = true
+			cancelled := newParticipant(event.ID)
+			cancelled.Status = entity.ParticipantStatusCancelled
+			otherEvent := newParticipant(uuid.New())
+			missingID := uuid.New()
+			ids := []uuid.UUID{valid.ID, checkedIn.ID, cancelled.ID, otherEvent.ID, missingID}
+
+			mockEventRepo.EXPECT().FindByID(gomock.Any(), event.ID).Return(event, nil)
+			mockParticipant.EXPECT().FindByIDs(gomock.Any(), ids).
+				Return([]*entity.Participant{valid, checkedIn, cancelled, otherEvent}, nil)
+			mockCheckinRepo.EXPECT().BulkCreate(gomock.Any(), gomock.Len(1)).Return([]uuid.UUID{valid.ID}, nil)
+			mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
+
+			result, err := uc.BulkCheckIn(ctx, organizerID, false, checkin.BulkCheckInInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+				CheckedInBy:    organizerID,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.CheckedInCount).To(Equal(1))
+			Expect(result.SkippedCount).To(Equal(1))
+			Expect(result.Errors).To(Equal([]checkin.BulkCheckInError{
+				{ParticipantID: cancelled.ID, Reason: "participant status is cancelled"},
+				{ParticipantID: otherEvent.ID, Reason: "participant does not belong to this event"},
+				{ParticipantID: missingID, Reason: "participant not found"},
+			}))
+		})
+	})
+
+	When("a participant is checked in concurrently", func() {
+		It("should count them as skipped", func() {
+			first, second := newParticipant(event.ID), newParticipant(event.ID)
+			mockEventRepo.EXPECT().FindByID(gomock.Any(), event.ID).Return(event, nil)
+			mockParticipant.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).
+				Return([]*entity.Participant{first, second}, nil)
+			mockCheckinRepo.EXPECT().BulkCreate(gomock.Any(), gomock.Len(2)).Return([]uuid.UUID{second.ID}, nil)
+			mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
+
+			result, err := uc.BulkCheckIn(ctx, organizerID, false, checkin.BulkCheckInInput{
+				EventID:        event.ID,
+				ParticipantIDs: []uuid.UUID{first.ID, second.ID},
+				CheckedInBy:    organizerID,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.CheckedInCount).To(Equal(1))
+			Expect(result.SkippedCount).To(Equal(1))
+		})
+	})
+
+	When("the insert fails", func() {
+		It("should return the error", func() {
+			participant := newParticipant(event.ID)
+			mockEventRepo.EXPECT().FindByID(gomock.Any(), event.ID).Return(event, nil)
+			mockParticipant.EXPECT().FindByIDs(gomock.Any(), gomock.Any()).
+				Return([]*entity.Participant{participant}, nil)
+			mockCheckinRepo.EXPECT().BulkCreate(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+			_, err := uc.BulkCheckIn(ctx, organizerID, false, checkin.BulkCheckInInput{
+				EventID:        event.ID,
+				ParticipantIDs: []uuid.UUID{participant.ID},
+				CheckedInBy:    organizerID,
+			})
+
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	When("the user does not manage the event", func() {
+		It("should return forbidden", func() {
+			mockEventRepo.EXPECT().FindByID(gomock.Any(), event.ID).Return(event, nil)
+
+			_, err := uc.BulkCheckIn(ctx, uuid.New(), false, checkin.BulkCheckInInput{
+				EventID:        event.ID,
+				ParticipantIDs: []uuid.UUID{uuid.New()},
+			})
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeForbidden))
+		})
+	})
+
+	When("no participant IDs are given", func() {
+		It("should return bad request", func() {
+			_, err := uc.BulkCheckIn(ctx, organizerID, false, checkin.BulkCheckInInput{EventID: event.ID})
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+	})
+
+	When("too many participant IDs are given", func() {
+		It("should return bad request", func() {
+			ids := make([]uuid.UUID, checkin.MaxBulkCheckInParticipants+1)
+
+			_, err := uc.BulkCheckIn(ctx, organizerID, false, checkin.BulkCheckInInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+			})
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+	})
+})
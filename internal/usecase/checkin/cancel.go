@@ -2,13 +2,18 @@ package checkin
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/google/uuid"
 )
 
-// Cancel cancels (undo) a check-in operation
+// Cancel cancels (undo) a check-in operation.
+// The check-in is kept as cancelled, so it can be restored within the undo window.
 func (u *checkinUsecase) Cancel(
 	ctx context.Context,
 	userID uuid.UUID,
@@ -28,14 +33,131 @@ func (u *checkinUsecase) Cancel(
 	}
 
 	// Authorization: event owner or admin only
-	if !isAdmin && event.OrganizerID != userID {
-		return apperrors.Forbidden("you do not have permission to cancel check-ins for this event")
+	if err := authz.RequireEventManager(userID, event, isAdmin, "cancel check-ins for this event"); err != nil {
+		return err
+	}
+
+	// A cancelled check-in no longer exists as far as callers are concerned
+	if checkin.IsCancelled() {
+		return apperrors.NotFound("check-in not found")
+	}
+
+	if err := u.checkinRepo.Cancel(ctx, checkinID, userID, time.Now()); err != nil {
+		return fmt.Errorf("failed to cancel check-in: %w", err)
+	}
+	u.invalidateProgress(ctx, checkin.EventID)
+	u.auditor.Record(ctx, userID, entity.AuditActionDelete, entity.AuditResourceCheckin, checkinID,
+		checkin.AuditFields(), nil)
+
+	return nil
+}
+
+// Restore re-activates a cancelled check-in of an event, as long as it was cancelled
+// within the undo window and the participant has not checked in again since.
+func (u *checkinUsecase) Restore(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+	checkinID uuid.UUID,
+) (*CheckInOutput, error) {
+	checkin, err := u.checkinRepo.FindByID(ctx, checkinID)
+	if err != nil {
+		return nil, err
+	}
+	if checkin.EventID != eventID {
+		return nil, apperrors.NotFound("check-in not found")
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "restore check-ins for this event"); err != nil {
+		return nil, err
+	}
+
+	if !checkin.IsCancelled() {
+		return nil, apperrors.Conflict("check-in is not cancelled")
+	}
+	if time.Since(*checkin.CancelledAt) > u.undoWindow {
+		return nil, apperrors.BadRequest("check-in can no longer be restored: the undo window has passed")
+	}
+
+	participant, err := u.participantRepo.FindByID(ctx, checkin.ParticipantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.checkinRepo.Restore(ctx, checkinID); err != nil {
+		if errors.Is(err, entity.ErrCheckinAlreadyExists) {
+			return nil, apperrors.Conflict("participant has checked in again since the cancellation")
+		}
+		return nil, fmt.Errorf("failed to restore check-in: %w", err)
+	}
+	u.invalidateProgress(ctx, eventID)
+
+	before := checkin.AuditFields()
+	checkin.CancelledAt = nil
+	checkin.CancelledBy = nil
+	u.auditor.Record(ctx, userID, entity.AuditActionUpdate, entity.AuditResourceCheckin, checkinID,
+		before, checkin.AuditFields())
+
+	return u.buildCheckInOutput(checkin, participant), nil
+}
+
+// CheckOut cancels the active check-in of a participant found by QR code or participant ID,
+// for staff at the door who do not know the check-in ID. It is authorized like Cancel.
+func (u *checkinUsecase) CheckOut(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input CheckOutInput,
+) error {
+	if (input.QRCode == nil) == (input.ParticipantID == nil) {
+		return apperrors.BadRequest("exactly one of qr_code or participant_id is required")
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "cancel check-ins for this event"); err != nil {
+		return err
+	}
+
+	lookup := CheckInInput{EventID: input.EventID, QRCode: input.QRCode, ParticipantID: input.ParticipantID}
+	var participant *entity.Participant
+	if input.QRCode != nil {
+		participant, err = u.findParticipantByQRCode(ctx, lookup)
+	} else {
+		participant, err = u.findParticipantByID(ctx, lookup)
+	}
+	if err != nil {
+		return err
+	}
+	if participant.EventID != input.EventID {
+		return apperrors.BadRequest("participant does not belong to this event")
+	}
+
+	checkin, err := u.checkinRepo.FindByParticipant(ctx, participant.ID)
+	if apperrors.IsNotFound(err) {
+		return apperrors.NotFound("participant is not checked in")
+	}
+	if err != nil {
+		return err
 	}
 
-	// Delete check-in
-	if err := u.checkinRepo.Delete(ctx, checkinID); err != nil {
+	if err := u.checkinRepo.Cancel(ctx, checkin.ID, userID, time.Now()); err != nil {
 		return fmt.Errorf("failed to cancel check-in: %w", err)
 	}
+	u.invalidateProgress(ctx, input.EventID)
+	u.auditor.Record(ctx, userID, entity.AuditActionDelete, entity.AuditResourceCheckin, checkin.ID,
+		checkin.AuditFields(), nil)
 
 	return nil
 }
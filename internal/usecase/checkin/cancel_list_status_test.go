@@ -7,7 +7,9 @@ import (
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
 	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
@@ -37,7 +39,11 @@ var _ = Describe("Cancel UseCase", func() {
 		mockParticipant = mocks.NewMockParticipantRepository(ctrl)
 		mockEventRepo = mocks.NewMockEventRepository(ctrl)
 
-		uc = checkin.NewUsecase(mockCheckinRepo, mockParticipant, mockEventRepo, testQRHMACSecret)
+		uc = checkin.NewUsecase(
+			mockCheckinRepo, mockParticipant, mockEventRepo,
+			mocks.NewMockOutboxRepository(ctrl), mocks.NewMockTransactor(ctrl), nil, nil, testQRHMACSecret, 0, nil,
+			testUndoWindow, 0, 0, nil, testLogger,
+		)
 	})
 
 	AfterEach(func() {
@@ -69,7 +75,7 @@ var _ = Describe("Cancel UseCase", func() {
 			})
 		})
 
-		When("the repository fails to delete the check-in", func() {
+		When("the repository fails to cancel the check-in", func() {
 			It("should return a wrapped error", func() {
 				checkinID := uuid.New()
 				checkinRecord := &entity.Checkin{
@@ -88,7 +94,7 @@ var _ = Describe("Cancel UseCase", func() {
 
 				mockCheckinRepo.EXPECT().FindByID(gomock.Any(), checkinID).Return(checkinRecord, nil)
 				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
-				mockCheckinRepo.EXPECT().Delete(gomock.Any(), checkinID).
+				mockCheckinRepo.EXPECT().Cancel(gomock.Any(), checkinID, testUserID, gomock.Any()).
 					Return(errors.New("database connection failed"))
 
 				err := uc.Cancel(ctx, testUserID, false, checkinID)
@@ -98,6 +104,155 @@ var _ = Describe("Cancel UseCase", func() {
 				Expect(err.Error()).To(ContainSubstring("database connection failed"))
 			})
 		})
+
+		When("the check-in is cancelled", func() {
+			var (
+				mockAuditRepo *mocks.MockAuditRepository
+				checkinRecord *entity.Checkin
+			)
+
+			BeforeEach(func() {
+				mockAuditRepo = mocks.NewMockAuditRepository(ctrl)
+				uc = checkin.NewUsecase(
+					mockCheckinRepo, mockParticipant, mockEventRepo,
+					mocks.NewMockOutboxRepository(ctrl), mocks.NewMockTransactor(ctrl), nil, nil, testQRHMACSecret, 0, nil,
+					testUndoWindow, 0, 0, audit.NewRecorder(mockAuditRepo, testLogger), testLogger,
+				)
+				checkinRecord = &entity.Checkin{
+					ID:            uuid.New(),
+					EventID:       testEventID,
+					ParticipantID: uuid.New(),
+					CheckedInAt:   time.Now(),
+					Method:        entity.CheckinMethodManual,
+				}
+				mockCheckinRepo.EXPECT().FindByID(gomock.Any(), checkinRecord.ID).Return(checkinRecord, nil)
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).
+					Return(&entity.Event{ID: testEventID, OrganizerID: testUserID}, nil)
+				mockCheckinRepo.EXPECT().Cancel(gomock.Any(), checkinRecord.ID, testUserID, gomock.Any()).Return(nil)
+			})
+
+			It("should record the deletion in the audit log", func() {
+				mockAuditRepo.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, entry *entity.AuditLog) error {
+						Expect(entry.ActorID).To(Equal(testUserID))
+						Expect(entry.Action).To(Equal(entity.AuditActionDelete))
+						Expect(entry.ResourceType).To(Equal(entity.AuditResourceCheckin))
+						Expect(entry.ResourceID).To(Equal(checkinRecord.ID))
+						return nil
+					},
+				)
+
+				Expect(uc.Cancel(ctx, testUserID, false, checkinRecord.ID)).To(Succeed())
+			})
+
+			It("should still succeed when the audit log cannot be written", func() {
+				mockAuditRepo.EXPECT().Record(gomock.Any(), gomock.Any()).Return(errors.New("connection refused"))
+
+				Expect(uc.Cancel(ctx, testUserID, false, checkinRecord.ID)).To(Succeed())
+			})
+		})
+	})
+
+	Describe("CheckOut", func() {
+		var (
+			event         *entity.Event
+			participantID uuid.UUID
+			participant   *entity.Participant
+		)
+
+		BeforeEach(func() {
+			event = &entity.Event{ID: testEventID, OrganizerID: testUserID, Name: "Test Event"}
+			participantID = uuid.New()
+			participant = &entity.Participant{ID: participantID, EventID: testEventID, Name: "Taro Yamada"}
+		})
+
+		When("the participant found by QR code is checked in", func() {
+			It("should cancel their check-in", func() {
+				qrCode, err := crypto.GenerateHMACSignedToken(testQRHMACSecret)
+				Expect(err).NotTo(HaveOccurred())
+				checkinID := uuid.New()
+
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+				mockParticipant.EXPECT().FindByQRCode(gomock.Any(), qrCode).Return(participant, nil)
+				mockCheckinRepo.EXPECT().FindByParticipant(gomock.Any(), participantID).
+					Return(&entity.Checkin{ID: checkinID, EventID: testEventID, ParticipantID: participantID}, nil)
+				mockCheckinRepo.EXPECT().Cancel(gomock.Any(), checkinID, testUserID, gomock.Any()).Return(nil)
+
+				err = uc.CheckOut(ctx, testUserID, false, checkin.CheckOutInput{EventID: testEventID, QRCode: &qrCode})
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("the participant found by ID is checked in", func() {
+			It("should cancel their check-in", func() {
+				checkinID := uuid.New()
+
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participantID).Return(participant, nil)
+				mockCheckinRepo.EXPECT().FindByParticipant(gomock.Any(), participantID).
+					Return(&entity.Checkin{ID: checkinID, EventID: testEventID, ParticipantID: participantID}, nil)
+				mockCheckinRepo.EXPECT().Cancel(gomock.Any(), checkinID, testUserID, gomock.Any()).Return(nil)
+
+				err := uc.CheckOut(ctx, testUserID, false,
+					checkin.CheckOutInput{EventID: testEventID, ParticipantID: &participantID})
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("the participant is not checked in", func() {
+			It("should return not found", func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participantID).Return(participant, nil)
+				mockCheckinRepo.EXPECT().FindByParticipant(gomock.Any(), participantID).
+					Return(nil, apperrors.NotFound("check-in not found"))
+
+				err := uc.CheckOut(ctx, testUserID, false,
+					checkin.CheckOutInput{EventID: testEventID, ParticipantID: &participantID})
+
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("participant is not checked in"))
+			})
+		})
+
+		When("the participant belongs to another event", func() {
+			It("should return bad request", func() {
+				participant.EventID = uuid.New()
+
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participantID).Return(participant, nil)
+
+				err := uc.CheckOut(ctx, testUserID, false,
+					checkin.CheckOutInput{EventID: testEventID, ParticipantID: &participantID})
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+			})
+		})
+
+		When("the user does not manage the event", func() {
+			It("should return forbidden", func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+
+				err := uc.CheckOut(ctx, uuid.New(), false,
+					checkin.CheckOutInput{EventID: testEventID, ParticipantID: &participantID})
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeForbidden))
+			})
+		})
+
+		When("neither or both of the QR code and participant ID are given", func() {
+			It("should return bad request", func() {
+				qrCode := "token"
+
+				err := uc.CheckOut(ctx, testUserID, false, checkin.CheckOutInput{EventID: testEventID})
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+
+				err = uc.CheckOut(ctx, testUserID, false,
+					checkin.CheckOutInput{EventID: testEventID, QRCode: &qrCode, ParticipantID: &participantID})
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+			})
+		})
 	})
 })
 
@@ -123,7 +278,11 @@ var _ = Describe("List UseCase", func() {
 		mockParticipant = mocks.NewMockParticipantRepository(ctrl)
 		mockEventRepo = mocks.NewMockEventRepository(ctrl)
 
-		uc = checkin.NewUsecase(mockCheckinRepo, mockParticipant, mockEventRepo, testQRHMACSecret)
+		uc = checkin.NewUsecase(
+			mockCheckinRepo, mockParticipant, mockEventRepo,
+			mocks.NewMockOutboxRepository(ctrl), mocks.NewMockTransactor(ctrl), nil, nil, testQRHMACSecret, 0, nil,
+			testUndoWindow, 0, 0, nil, testLogger,
+		)
 	})
 
 	AfterEach(func() {
@@ -239,7 +398,11 @@ var _ = Describe("GetStatus UseCase", func() {
 		mockParticipant = mocks.NewMockParticipantRepository(ctrl)
 		mockEventRepo = mocks.NewMockEventRepository(ctrl)
 
-		uc = checkin.NewUsecase(mockCheckinRepo, mockParticipant, mockEventRepo, testQRHMACSecret)
+		uc = checkin.NewUsecase(
+			mockCheckinRepo, mockParticipant, mockEventRepo,
+			mocks.NewMockOutboxRepository(ctrl), mocks.NewMockTransactor(ctrl), nil, nil, testQRHMACSecret, 0, nil,
+			testUndoWindow, 0, 0, nil, testLogger,
+		)
 	})
 
 	AfterEach(func() {
@@ -303,4 +466,133 @@ var _ = Describe("GetStatus UseCase", func() {
 			})
 		})
 	})
+	Describe("GetHistory", func() {
+		var (
+			participantID uuid.UUID
+			participant   *entity.Participant
+		)
+
+		BeforeEach(func() {
+			participantID = uuid.New()
+			participant = &entity.Participant{ID: participantID, EventID: testEventID, Name: "Test User"}
+		})
+
+		When("the participant checked in again after checking out", func() {
+			It("should return both check-ins, oldest first", func() {
+				checkedOutAt := time.Now().Add(-time.Hour)
+				history := []*entity.Checkin{
+					{ID: uuid.New(), ParticipantID: participantID, CheckedInAt: checkedOutAt.Add(-time.Hour),
+						CancelledAt: &checkedOutAt, CancelledBy: &testUserID},
+					{ID: uuid.New(), ParticipantID: participantID, CheckedInAt: time.Now()},
+				}
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participantID).Return(participant, nil)
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).
+					Return(&entity.Event{ID: testEventID, OrganizerID: testUserID}, nil)
+				mockCheckinRepo.EXPECT().GetHistory(gomock.Any(), participantID).Return(history, nil)
+
+				result, err := uc.GetHistory(ctx, testUserID, false, participantID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(history))
+			})
+		})
+
+		When("the user does not manage the participant's event", func() {
+			It("should return a forbidden error", func() {
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participantID).Return(participant, nil)
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).
+					Return(&entity.Event{ID: testEventID, OrganizerID: uuid.New()}, nil)
+
+				_, err := uc.GetHistory(ctx, testUserID, false, participantID)
+
+				Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+
+		When("the participant does not exist", func() {
+			It("should return the error from the participant repository", func() {
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participantID).
+					Return(nil, apperrors.NotFound("participant not found"))
+
+				_, err := uc.GetHistory(ctx, testUserID, false, participantID)
+
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("GetPublicStatus", func() {
+		var (
+			participant *entity.Participant
+			token       string
+		)
+
+		BeforeEach(func() {
+			participant = &entity.Participant{
+				ID:      uuid.New(),
+				EventID: testEventID,
+				Name:    "Test User",
+				Email:   "test@example.com",
+			}
+			var err error
+			token, err = crypto.SignQRToken(testEventID, participant.ID, time.Now(), testQRHMACSecret)
+			Expect(err).NotTo(HaveOccurred())
+			participant.QRCode = token
+		})
+
+		When("the participant has checked in", func() {
+			It("should return the event name, participant name and check-in state only", func() {
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participant.ID).Return(participant, nil)
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).
+					Return(&entity.Event{ID: testEventID, Name: "Test Event"}, nil)
+				mockCheckinRepo.EXPECT().FindByParticipant(gomock.Any(), participant.ID).
+					Return(&entity.Checkin{ID: uuid.New(), ParticipantID: participant.ID}, nil)
+
+				result, err := uc.GetPublicStatus(ctx, token)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(&checkin.PublicCheckInStatusOutput{
+					EventName:       "Test Event",
+					ParticipantName: "Test User",
+					IsCheckedIn:     true,
+				}))
+			})
+		})
+
+		When("the participant has not checked in", func() {
+			It("should report that they are not checked in", func() {
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participant.ID).Return(participant, nil)
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).
+					Return(&entity.Event{ID: testEventID, Name: "Test Event"}, nil)
+				mockCheckinRepo.EXPECT().FindByParticipant(gomock.Any(), participant.ID).
+					Return(nil, apperrors.NotFound("check-in not found"))
+
+				result, err := uc.GetPublicStatus(ctx, token)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.IsCheckedIn).To(BeFalse())
+			})
+		})
+
+		When("the token is no longer the participant's QR code", func() {
+			It("should return a not found error", func() {
+				participant.QRCode = "reissued"
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participant.ID).Return(participant, nil)
+
+				_, err := uc.GetPublicStatus(ctx, token)
+
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		DescribeTable("rejects tokens that are not valid signed QR tokens",
+			func(token string) {
+				_, err := uc.GetPublicStatus(ctx, token)
+
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			},
+			Entry("random token", "a1b2c3d4e5f6"),
+			Entry("tampered signature", "qrt_"+uuid.NewString()+"_"+uuid.NewString()+"_1767225600.forged"),
+		)
+	})
 })
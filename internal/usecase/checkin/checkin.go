@@ -8,49 +8,85 @@ import (
 	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/google/uuid"
 )
 
-// CheckIn executes the check-in operation for a participant
+// CheckIn executes the check-in operation for a participant.
+// QR code check-ins also record the scan and its outcome for the event's scan analytics.
+// A repeat check-in within the debounce window answers with the participant's check-in,
+// so a double-scanned badge is not reported as a conflict. With a window margin, check-ins
+// outside the event's check-in window fail with ErrOutsideCheckinWindow.
 func (u *checkinUsecase) CheckIn(
 	ctx context.Context,
 	userID uuid.UUID,
 	isAdmin bool,
 	input CheckInInput,
-) (*CheckInOutput, error) {
+) (output *CheckInOutput, err error) {
 	// Verify event exists and check authorization
 	event, err := u.eventRepo.FindByID(ctx, input.EventID)
 	if err != nil {
 		return nil, err
 	}
 
+	var repeated bool
+	if input.Method == entity.CheckinMethodQRCode {
+		defer func() {
+			if repeated {
+				u.recordScan(ctx, input.EventID, errRepeatedScan)
+				return
+			}
+			u.recordScan(ctx, input.EventID, err)
+		}()
+	}
+
 	// Authorization check for manual check-in
-	if err := u.checkManualCheckInAuth(input.Method, isAdmin, event.OrganizerID, userID); err != nil {
+	if err := u.checkManualCheckInAuth(input.Method, event, isAdmin, userID); err != nil {
 		return nil, err
 	}
 
 	// Find participant based on check-in method
 	participant, err := u.findParticipantForCheckIn(ctx, input)
+	if apperrors.IsNotFound(err) {
+		return nil, fmt.Errorf("%w: %w", ErrParticipantNotFound, err)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	// Verify participant belongs to this event
 	if participant.EventID != input.EventID {
-		return nil, apperrors.BadRequest("participant does not belong to this event")
+		return nil, apperrors.WrapAppError(
+			apperrors.BadRequest("participant does not belong to this event"), ErrParticipantNotInEvent,
+		)
 	}
 
-	// Verify participant is active (not cancelled or declined)
-	if participant.IsCancelled() || participant.IsDeclined() {
+	// Verify participant is active (not cancelled, declined, expired or waitlisted)
+	if participant.IsCancelled() || participant.IsDeclined() || participant.IsExpired() ||
+		participant.IsWaitlisted() {
 		return nil, apperrors.BadRequest(
 			fmt.Sprintf("cannot check in: participant status is %s", participant.Status),
 		)
 	}
 
+	// Verify consent for events that require it
+	if event.RequiresConsent && !participant.HasConsent() {
+		return nil, apperrors.Unprocessable("cannot check in: participant has not accepted the consent terms for this event")
+	}
+
 	// Check for duplicate check-in
-	if err := u.checkDuplicateCheckIn(ctx, input.EventID, participant.ID); err != nil {
+	existing, err := u.checkDuplicateCheckIn(ctx, input.EventID, participant)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		repeated = true
+		return u.buildCheckInOutput(existing, participant), nil
+	}
+
+	if err := u.checkCheckinWindow(event); err != nil {
 		return nil, err
 	}
 
@@ -60,25 +96,46 @@ func (u *checkinUsecase) CheckIn(
 		return nil, err
 	}
 
-	if err := u.checkinRepo.Create(ctx, checkin); err != nil {
-		return nil, u.handleCheckinCreateError(err)
+	// Record the check-in and its outbox message atomically so webhooks are never lost
+	err = u.transactor.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.checkinRepo.Create(txCtx, checkin); err != nil {
+			return u.handleCheckinCreateError(err, participant)
+		}
+		return u.enqueueCheckinCreated(txCtx, checkin)
+	})
+	var alreadyErr *AlreadyCheckedInError
+	if errors.As(err, &alreadyErr) {
+		// A concurrent scan checked the participant in first
+		existing, err := u.debouncedCheckIn(ctx, participant)
+		if err != nil {
+			return nil, err
+		}
+		repeated = true
+		return u.buildCheckInOutput(existing, participant), nil
+	}
+	if err != nil {
+		return nil, err
 	}
+	u.invalidateProgress(ctx, input.EventID)
+	u.auditor.Record(ctx, userID, entity.AuditActionCreate, entity.AuditResourceCheckin, checkin.ID,
+		nil, checkin.AuditFields())
 
-	return u.buildCheckInOutput(checkin, participant), nil
+	output = u.buildCheckInOutput(checkin, participant)
+	u.publishCheckinCreated(ctx, output)
+	return output, nil
 }
 
 // checkManualCheckInAuth checks authorization for manual check-in
 func (u *checkinUsecase) checkManualCheckInAuth(
 	method entity.CheckinMethod,
+	event *entity.Event,
 	isAdmin bool,
-	organizerID, userID uuid.UUID,
+	userID uuid.UUID,
 ) error {
-	if method == entity.CheckinMethodManual && !isAdmin && organizerID != userID {
-		return apperrors.Forbidden(
-			"you do not have permission to manually check in participants for this event",
-		)
+	if method != entity.CheckinMethodManual {
+		return nil
 	}
-	return nil
+	return authz.RequireEventManager(userID, event, isAdmin, "manually check in participants for this event")
 }
 
 // findParticipantForCheckIn finds the participant based on check-in method
@@ -106,10 +163,15 @@ func (u *checkinUsecase) findParticipantByQRCode(
 	if input.QRCode == nil || *input.QRCode == "" {
 		return nil, apperrors.BadRequest("QR code is required for QR code check-in")
 	}
+	if crypto.IsSignedQRToken(*input.QRCode) {
+		return u.findParticipantBySignedQRToken(ctx, *input.QRCode)
+	}
 
 	// Verify HMAC signature to ensure token was issued by this server
 	if !crypto.VerifyHMACToken(u.qrHMACSecret, *input.QRCode) {
-		return nil, apperrors.NotFound("invalid QR code or participant not found")
+		return nil, apperrors.WrapAppError(
+			apperrors.NotFound("invalid QR code or participant not found"), errQRCodeNotIssued,
+		)
 	}
 
 	participant, err := u.participantRepo.FindByQRCode(ctx, *input.QRCode)
@@ -119,6 +181,30 @@ func (u *checkinUsecase) findParticipantByQRCode(
 	return participant, nil
 }
 
+// findParticipantBySignedQRToken decodes a signed QR token and finds its participant by ID
+// instead of looking the token up. The token must still be the participant's QR code, so a
+// token stops working once the participant is issued another one.
+func (u *checkinUsecase) findParticipantBySignedQRToken(
+	ctx context.Context,
+	token string,
+) (*entity.Participant, error) {
+	claims, err := crypto.VerifyQRToken(token, u.qrHMACSecret, u.qrTokenTTL, time.Now())
+	if errors.Is(err, crypto.ErrQRTokenExpired) {
+		return nil, apperrors.BadRequest("cannot check in: QR code has expired")
+	}
+	if err != nil {
+		return nil, apperrors.WrapAppError(
+			apperrors.NotFound("invalid QR code or participant not found"), errQRCodeNotIssued,
+		)
+	}
+
+	participant, err := u.participantRepo.FindByID(ctx, claims.ParticipantID)
+	if err != nil || participant.QRCode != token {
+		return nil, apperrors.NotFound("invalid QR code or participant not found")
+	}
+	return participant, nil
+}
+
 // findParticipantByID finds participant by ID
 func (u *checkinUsecase) findParticipantByID(
 	ctx context.Context,
@@ -145,19 +231,69 @@ func (u *checkinUsecase) findParticipantByEmployeeID(
 	return participant, nil
 }
 
-// checkDuplicateCheckIn checks if participant has already checked in
+// checkCheckinWindow fails unless the event's check-in window is open, if it has one
+func (u *checkinUsecase) checkCheckinWindow(event *entity.Event) error {
+	if u.windowMargin <= 0 {
+		return nil
+	}
+	opens, closes := event.CheckinWindow(u.windowMargin)
+	now := time.Now()
+	if now.Before(opens) || now.After(closes) {
+		return apperrors.WrapAppError(
+			apperrors.Unprocessable(fmt.Sprintf("cannot check in: check-in for this event is open from %s to %s",
+				opens.UTC().Format(time.RFC3339), closes.UTC().Format(time.RFC3339))),
+			ErrOutsideCheckinWindow,
+		)
+	}
+	return nil
+}
+
+// checkDuplicateCheckIn checks if participant has already checked in.
+// Returns the participant's check-in if it was made within the debounce window.
 func (u *checkinUsecase) checkDuplicateCheckIn(
 	ctx context.Context,
-	eventID, participantID uuid.UUID,
-) error {
-	exists, err := u.checkinRepo.ExistsByParticipant(ctx, eventID, participantID)
+	eventID uuid.UUID,
+	participant *entity.Participant,
+) (*entity.Checkin, error) {
+	exists, err := u.checkinRepo.ExistsByParticipant(ctx, eventID, participant.ID)
 	if err != nil {
-		return fmt.Errorf("failed to check existing check-in: %w", err)
+		return nil, fmt.Errorf("failed to check existing check-in: %w", err)
 	}
 	if exists {
-		return apperrors.Conflict("participant has already checked in")
+		return u.debouncedCheckIn(ctx, participant)
 	}
-	return nil
+	return nil, nil
+}
+
+// debouncedCheckIn returns the check-in of an already checked-in participant if it was made
+// within the debounce window, and the already checked-in conflict otherwise
+func (u *checkinUsecase) debouncedCheckIn(
+	ctx context.Context,
+	participant *entity.Participant,
+) (*entity.Checkin, error) {
+	if u.debounceWindow <= 0 {
+		return nil, alreadyCheckedIn(participant)
+	}
+	existing, err := u.checkinRepo.FindByParticipant(ctx, participant.ID)
+	if apperrors.IsNotFound(err) {
+		// The check-in was cancelled in the meantime
+		return nil, alreadyCheckedIn(participant)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to find existing check-in: %w", err)
+	}
+	if time.Since(existing.CheckedInAt) >= u.debounceWindow {
+		return nil, alreadyCheckedIn(participant)
+	}
+	return existing, nil
+}
+
+// alreadyCheckedIn returns the conflict for a participant who has already checked in
+func alreadyCheckedIn(participant *entity.Participant) error {
+	return apperrors.WrapAppError(
+		apperrors.Conflict("participant has already checked in"),
+		&AlreadyCheckedInError{ParticipantName: participant.Name},
+	)
 }
 
 // createCheckinRecord creates a check-in entity
@@ -185,6 +321,8 @@ func (u *checkinUsecase) createCheckinRecord(
 		CheckedInBy:   checkedInBy,
 		Method:        input.Method,
 		DeviceInfo:    deviceInfo,
+		Source:        input.Source,
+		DeviceID:      input.DeviceID,
 	}
 
 	if err := checkin.Validate(); err != nil {
@@ -207,14 +345,32 @@ func convertDeviceInfo(deviceInfo map[string]any) (*json.RawMessage, error) {
 	return &raw, nil
 }
 
-// handleCheckinCreateError handles check-in creation errors
-func (u *checkinUsecase) handleCheckinCreateError(err error) error {
+// handleCheckinCreateError handles the errors of creating a check-in for participant
+func (u *checkinUsecase) handleCheckinCreateError(err error, participant *entity.Participant) error {
 	if errors.Is(err, entity.ErrCheckinAlreadyExists) {
-		return apperrors.Conflict("participant has already checked in")
+		return alreadyCheckedIn(participant)
 	}
 	return fmt.Errorf("failed to create check-in: %w", err)
 }
 
+// enqueueCheckinCreated writes the checkin.created outbox message for a new check-in
+func (u *checkinUsecase) enqueueCheckinCreated(ctx context.Context, checkin *entity.Checkin) error {
+	msg, err := entity.NewOutboxMessage(entity.OutboxEventCheckinCreated, checkin.ID, entity.CheckinCreatedPayload{
+		CheckinID:     checkin.ID,
+		EventID:       checkin.EventID,
+		ParticipantID: checkin.ParticipantID,
+		CheckedInAt:   checkin.CheckedInAt,
+		Method:        checkin.Method,
+	})
+	if err != nil {
+		return err
+	}
+	if err := u.outboxRepo.Enqueue(ctx, msg); err != nil {
+		return fmt.Errorf("failed to enqueue check-in webhook: %w", err)
+	}
+	return nil
+}
+
 // buildCheckInOutput builds the check-in output
 func (u *checkinUsecase) buildCheckInOutput(
 	checkin *entity.Checkin,
@@ -227,8 +383,11 @@ func (u *checkinUsecase) buildCheckInOutput(
 		ParticipantName:       participant.Name,
 		ParticipantEmail:      participant.Email,
 		ParticipantEmployeeID: participant.EmployeeID,
+		ParticipantWalkIn:     participant.WalkIn,
 		CheckedInAt:           checkin.CheckedInAt,
 		CheckedInBy:           checkin.CheckedInBy,
 		Method:                checkin.Method,
+		Source:                checkin.Source,
+		DeviceID:              checkin.DeviceID,
 	}
 }
@@ -10,16 +10,24 @@ import (
 	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
 	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
 )
 
 // testQRHMACSecret is the HMAC secret used for tests.
 // It is 32+ characters long to satisfy the minimum length requirement.
 const testQRHMACSecret = "test-hmac-secret-for-testing-only-32chars"
 
+// testUndoWindow is how long cancelled check-ins can be restored in tests.
+const testUndoWindow = 15 * time.Minute
+
+// testLogger discards the usecase's cache warnings.
+var testLogger = &logger.Logger{Logger: zap.NewNop()}
+
 var _ = Describe("CheckIn UseCase", func() {
 	var (
 		ctrl            *gomock.Controller
@@ -28,6 +36,8 @@ var _ = Describe("CheckIn UseCase", func() {
 		mockCheckinRepo *mocks.MockCheckinRepository
 		mockParticipant *mocks.MockParticipantRepository
 		mockEventRepo   *mocks.MockEventRepository
+		mockOutboxRepo  *mocks.MockOutboxRepository
+		mockTransactor  *mocks.MockTransactor
 		testEventID     uuid.UUID
 		testUserID      uuid.UUID
 		testOrganizerID uuid.UUID
@@ -43,8 +53,18 @@ var _ = Describe("CheckIn UseCase", func() {
 		mockCheckinRepo = mocks.NewMockCheckinRepository(ctrl)
 		mockParticipant = mocks.NewMockParticipantRepository(ctrl)
 		mockEventRepo = mocks.NewMockEventRepository(ctrl)
-
-		usecase = checkin.NewUsecase(mockCheckinRepo, mockParticipant, mockEventRepo, testQRHMACSecret)
+		mockOutboxRepo = mocks.NewMockOutboxRepository(ctrl)
+		mockTransactor = mocks.NewMockTransactor(ctrl)
+		mockTransactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+		).AnyTimes()
+		// QR check-ins record their scan in the background; scan_analytics_test.go covers it
+		mockCheckinRepo.EXPECT().RecordScan(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		usecase = checkin.NewUsecase(
+			mockCheckinRepo, mockParticipant, mockEventRepo, mockOutboxRepo, mockTransactor, nil, nil,
+			testQRHMACSecret, 0, nil, testUndoWindow, 0, 0, nil, testLogger,
+		)
 	})
 
 	Describe("CheckIn", func() {
@@ -74,6 +94,7 @@ var _ = Describe("CheckIn UseCase", func() {
 						ExistsByParticipant(gomock.Any(), testEventID, participant.ID).
 						Return(false, nil)
 					mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+					mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
 
 					input := checkin.CheckInInput{
 						EventID:     testEventID,
@@ -92,6 +113,55 @@ var _ = Describe("CheckIn UseCase", func() {
 				})
 			})
 
+			Context("with a guest's QR code", func() {
+				It("should check in the guest independently of the registrant", func() {
+					qrCode, err := crypto.GenerateHMACSignedToken(testQRHMACSecret)
+					Expect(err).NotTo(HaveOccurred())
+
+					registrantID := uuid.New()
+					guest := &entity.Participant{
+						ID:      uuid.New(),
+						EventID: testEventID,
+						GuestOf: &registrantID,
+						Name:    "Guest of John",
+						QRCode:  qrCode,
+						Status:  entity.ParticipantStatusConfirmed,
+					}
+
+					event := &entity.Event{
+						ID:          testEventID,
+						OrganizerID: testOrganizerID,
+						Name:        "Test Event",
+					}
+
+					mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+					mockParticipant.EXPECT().FindByQRCode(gomock.Any(), qrCode).Return(guest, nil)
+					// Only the guest's own check-in state matters, whether or not the registrant has arrived
+					mockCheckinRepo.EXPECT().
+						ExistsByParticipant(gomock.Any(), testEventID, guest.ID).
+						Return(false, nil)
+					mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+						DoAndReturn(func(_ context.Context, c *entity.Checkin) error {
+							Expect(c.ParticipantID).To(Equal(guest.ID))
+							return nil
+						})
+					mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
+
+					input := checkin.CheckInInput{
+						EventID:     testEventID,
+						Method:      entity.CheckinMethodQRCode,
+						QRCode:      &qrCode,
+						CheckedInBy: testUserID,
+					}
+
+					result, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.ParticipantID).To(Equal(guest.ID))
+					Expect(result.ParticipantName).To(Equal("Guest of John"))
+				})
+			})
+
 			Context("with invalid QR code", func() {
 				It("should return not found error", func() {
 					// A non-HMAC-signed string will fail HMAC verification
@@ -118,6 +188,7 @@ var _ = Describe("CheckIn UseCase", func() {
 					var appErr *apperrors.AppError
 					Expect(errors.As(err, &appErr)).To(BeTrue())
 					Expect(appErr.Code).To(Equal(apperrors.CodeNotFound))
+					Expect(errors.Is(err, checkin.ErrParticipantNotFound)).To(BeTrue())
 				})
 			})
 
@@ -221,6 +292,212 @@ var _ = Describe("CheckIn UseCase", func() {
 					Expect(err.Error()).To(ContainSubstring("cannot check in"))
 				})
 			})
+
+			Context("when participant status is expired", func() {
+				It("should return bad request error", func() {
+					expiredQR, err := crypto.GenerateHMACSignedToken(testQRHMACSecret)
+					Expect(err).NotTo(HaveOccurred())
+
+					expiredParticipant := &entity.Participant{
+						ID:      uuid.New(),
+						EventID: testEventID,
+						Status:  entity.ParticipantStatusExpired,
+						QRCode:  expiredQR,
+					}
+
+					event := &entity.Event{
+						ID:          testEventID,
+						OrganizerID: testOrganizerID,
+						Name:        "Test Event",
+					}
+
+					mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+					mockParticipant.EXPECT().FindByQRCode(gomock.Any(), expiredQR).Return(expiredParticipant, nil)
+
+					input := checkin.CheckInInput{
+						EventID:     testEventID,
+						Method:      entity.CheckinMethodQRCode,
+						QRCode:      &expiredQR,
+						CheckedInBy: testUserID,
+					}
+					result, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+					Expect(result).To(BeNil())
+					Expect(err).To(MatchError(ContainSubstring("cannot check in: participant status is expired")))
+				})
+			})
+
+			Context("when participant status is waitlisted", func() {
+				It("should return bad request error", func() {
+					waitlistedQR, err := crypto.GenerateHMACSignedToken(testQRHMACSecret)
+					Expect(err).NotTo(HaveOccurred())
+
+					waitlistedParticipant := &entity.Participant{
+						ID:      uuid.New(),
+						EventID: testEventID,
+						Status:  entity.ParticipantStatusWaitlisted,
+						QRCode:  waitlistedQR,
+					}
+
+					event := &entity.Event{
+						ID:          testEventID,
+						OrganizerID: testOrganizerID,
+						Name:        "Test Event",
+						Capacity:    1,
+					}
+
+					mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+					mockParticipant.EXPECT().FindByQRCode(gomock.Any(), waitlistedQR).Return(waitlistedParticipant, nil)
+
+					input := checkin.CheckInInput{
+						EventID:     testEventID,
+						Method:      entity.CheckinMethodQRCode,
+						QRCode:      &waitlistedQR,
+						CheckedInBy: testUserID,
+					}
+					result, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+					Expect(result).To(BeNil())
+					Expect(err).To(MatchError(ContainSubstring("cannot check in: participant status is waitlisted")))
+				})
+			})
+
+			Context("with a signed QR token", func() {
+				var (
+					participant *entity.Participant
+					event       *entity.Event
+					input       checkin.CheckInInput
+				)
+
+				signedInput := func(issuedAt time.Time) {
+					token, err := crypto.SignQRToken(testEventID, participant.ID, issuedAt, testQRHMACSecret)
+					Expect(err).NotTo(HaveOccurred())
+					participant.QRCode = token
+					input.QRCode = &token
+				}
+
+				BeforeEach(func() {
+					participant = &entity.Participant{ID: uuid.New(), EventID: testEventID, Name: "John Doe"}
+					event = &entity.Event{ID: testEventID, OrganizerID: testOrganizerID, Name: "Test Event"}
+					input = checkin.CheckInInput{
+						EventID:     testEventID,
+						Method:      entity.CheckinMethodQRCode,
+						CheckedInBy: testUserID,
+					}
+					signedInput(time.Now())
+				})
+
+				It("should find the participant by the ID in the token", func() {
+					mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+					mockParticipant.EXPECT().FindByID(gomock.Any(), participant.ID).Return(participant, nil)
+					mockCheckinRepo.EXPECT().
+						ExistsByParticipant(gomock.Any(), testEventID, participant.ID).
+						Return(false, nil)
+					mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+					mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
+
+					result, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.ParticipantID).To(Equal(participant.ID))
+				})
+
+				It("should reject a token that is no longer the participant's QR code", func() {
+					mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+					mockParticipant.EXPECT().FindByID(gomock.Any(), participant.ID).
+						Return(&entity.Participant{ID: participant.ID, EventID: testEventID, QRCode: "replaced"}, nil)
+
+					_, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+					Expect(apperrors.IsNotFound(err)).To(BeTrue())
+				})
+
+				It("should reject a tampered token without looking it up", func() {
+					tampered := *input.QRCode + "x"
+					input.QRCode = &tampered
+					mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+
+					_, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+					Expect(apperrors.IsNotFound(err)).To(BeTrue())
+				})
+
+				It("should reject a token older than the TTL", func() {
+					usecase = checkin.NewUsecase(
+						mockCheckinRepo, mockParticipant, mockEventRepo, mockOutboxRepo, mockTransactor, nil, nil,
+						testQRHMACSecret, time.Hour, nil, testUndoWindow, 0, 0, nil, testLogger,
+					)
+					signedInput(time.Now().Add(-2 * time.Hour))
+					mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+
+					_, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+					Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+					Expect(err).To(MatchError(ContainSubstring("QR code has expired")))
+				})
+			})
+		})
+
+		When("check-in is limited to a window around the event", func() {
+			var (
+				participant *entity.Participant
+				input       checkin.CheckInInput
+			)
+
+			BeforeEach(func() {
+				usecase = checkin.NewUsecase(
+					mockCheckinRepo, mockParticipant, mockEventRepo, mockOutboxRepo, mockTransactor, nil, nil,
+					testQRHMACSecret, 0, nil, testUndoWindow, 0, time.Hour, nil, testLogger,
+				)
+				participant = &entity.Participant{ID: uuid.New(), EventID: testEventID, Name: "Jane Smith"}
+				input = checkin.CheckInInput{
+					EventID:       testEventID,
+					Method:        entity.CheckinMethodManual,
+					ParticipantID: &participant.ID,
+					CheckedInBy:   testUserID,
+				}
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participant.ID).Return(participant, nil)
+				mockCheckinRepo.EXPECT().ExistsByParticipant(gomock.Any(), testEventID, participant.ID).Return(false, nil)
+			})
+
+			eventStarting := func(in time.Duration) *entity.Event {
+				end := time.Now().Add(in + 4*time.Hour)
+				return &entity.Event{
+					ID:          testEventID,
+					OrganizerID: testUserID,
+					Name:        "Test Event",
+					StartDate:   time.Now().Add(in),
+					EndDate:     &end,
+				}
+			}
+
+			It("should check in within the margin before the start", func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(eventStarting(30*time.Minute), nil)
+				mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
+
+				result, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.ParticipantID).To(Equal(participant.ID))
+			})
+
+			It("should reject check-ins before the window opens", func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(eventStarting(2*time.Hour), nil)
+
+				_, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+				Expect(err).To(MatchError(checkin.ErrOutsideCheckinWindow))
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeUnprocessable))
+			})
+
+			It("should reject check-ins after the window closes", func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(eventStarting(-6*time.Hour), nil)
+
+				_, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+				Expect(err).To(MatchError(checkin.ErrOutsideCheckinWindow))
+			})
 		})
 
 		When("checking in manually", func() {
@@ -246,6 +523,7 @@ var _ = Describe("CheckIn UseCase", func() {
 						ExistsByParticipant(gomock.Any(), testEventID, participantID).
 						Return(false, nil)
 					mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+					mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
 
 					input := checkin.CheckInInput{
 						EventID:       testEventID,
@@ -287,6 +565,7 @@ var _ = Describe("CheckIn UseCase", func() {
 						ExistsByParticipant(gomock.Any(), testEventID, participantID).
 						Return(false, nil)
 					mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+					mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
 
 					input := checkin.CheckInInput{
 						EventID:       testEventID,
@@ -380,6 +659,7 @@ var _ = Describe("CheckIn UseCase", func() {
 						ExistsByParticipant(gomock.Any(), testEventID, participant.ID).
 						Return(false, nil)
 					mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+					mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
 
 					input := checkin.CheckInInput{
 						EventID:     testEventID,
@@ -424,6 +704,7 @@ var _ = Describe("CheckIn UseCase", func() {
 					var appErr *apperrors.AppError
 					Expect(errors.As(err, &appErr)).To(BeTrue())
 					Expect(appErr.Code).To(Equal(apperrors.CodeNotFound))
+					Expect(errors.Is(err, checkin.ErrParticipantNotFound)).To(BeTrue())
 				})
 			})
 		})
@@ -465,6 +746,147 @@ var _ = Describe("CheckIn UseCase", func() {
 				var appErr *apperrors.AppError
 				Expect(errors.As(err, &appErr)).To(BeTrue())
 				Expect(appErr.Code).To(Equal(apperrors.CodeConflict))
+				var alreadyErr *checkin.AlreadyCheckedInError
+				Expect(errors.As(err, &alreadyErr)).To(BeTrue())
+				Expect(alreadyErr.ParticipantName).To(Equal("Already Checked In"))
+			})
+		})
+
+		When("the event requires consent", func() {
+			var (
+				participantID uuid.UUID
+				participant   *entity.Participant
+				input         checkin.CheckInInput
+			)
+
+			BeforeEach(func() {
+				participantID = uuid.New()
+				participant = &entity.Participant{
+					ID:      participantID,
+					EventID: testEventID,
+					Name:    "Consent Pending",
+					Email:   "pending@example.com",
+				}
+				event := &entity.Event{
+					ID:              testEventID,
+					OrganizerID:     testUserID,
+					Name:            "Test Event",
+					RequiresConsent: true,
+					ConsentVersion:  "2026-01",
+				}
+				input = checkin.CheckInInput{
+					EventID:       testEventID,
+					Method:        entity.CheckinMethodManual,
+					ParticipantID: &participantID,
+					CheckedInBy:   testUserID,
+				}
+
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participantID).Return(participant, nil)
+			})
+
+			Context("and the participant has not accepted it", func() {
+				It("should return an unprocessable error without recording a check-in", func() {
+					result, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+					Expect(result).To(BeNil())
+					Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeUnprocessable))
+					Expect(err.Error()).To(ContainSubstring("consent"))
+				})
+			})
+
+			Context("and the participant has accepted it", func() {
+				It("should check the participant in", func() {
+					participant.AcceptConsent("2026-01", time.Now())
+					mockCheckinRepo.EXPECT().
+						ExistsByParticipant(gomock.Any(), testEventID, participantID).
+						Return(false, nil)
+					mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+					mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
+
+					result, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.ParticipantID).To(Equal(participantID))
+				})
+			})
+		})
+
+		When("recording the checkin.created webhook", func() {
+			var (
+				participantID uuid.UUID
+				input         checkin.CheckInInput
+			)
+
+			BeforeEach(func() {
+				participantID = uuid.New()
+				participant := &entity.Participant{
+					ID:      participantID,
+					EventID: testEventID,
+					Name:    "Webhook Guest",
+					Email:   "webhook@example.com",
+				}
+				event := &entity.Event{ID: testEventID, OrganizerID: testUserID, Name: "Test Event"}
+
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participantID).Return(participant, nil)
+				mockCheckinRepo.EXPECT().
+					ExistsByParticipant(gomock.Any(), testEventID, participantID).
+					Return(false, nil)
+				mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+				input = checkin.CheckInInput{
+					EventID:       testEventID,
+					Method:        entity.CheckinMethodManual,
+					ParticipantID: &participantID,
+					CheckedInBy:   testUserID,
+				}
+			})
+
+			Context("with the check-in saved", func() {
+				It("should enqueue a checkin.created message for the check-in", func() {
+					var enqueued *entity.OutboxMessage
+					mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+						func(_ context.Context, msg *entity.OutboxMessage) error {
+							enqueued = msg
+							return nil
+						},
+					)
+
+					result, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(enqueued).NotTo(BeNil())
+					Expect(enqueued.EventType).To(Equal(entity.OutboxEventCheckinCreated))
+					Expect(enqueued.AggregateID).To(Equal(result.ID))
+					Expect(string(enqueued.Payload)).To(ContainSubstring(participantID.String()))
+				})
+			})
+
+			Context("when the outbox write fails", func() {
+				It("should fail the check-in so the transaction rolls back", func() {
+					mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(errors.New("db down"))
+
+					result, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+					Expect(err).To(HaveOccurred())
+					Expect(result).To(BeNil())
+				})
+			})
+
+			Context("with the source and device ID of the scanning station", func() {
+				It("should record them with the check-in", func() {
+					source, deviceID := "scanner-app", "gate-a-01"
+					input.Source = &source
+					input.DeviceID = &deviceID
+					mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
+
+					result, err := usecase.CheckIn(ctx, testUserID, false, input)
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(result.Source).To(HaveValue(Equal("scanner-app")))
+					Expect(result.DeviceID).To(HaveValue(Equal("gate-a-01")))
+				})
 			})
 		})
 
@@ -502,6 +924,7 @@ var _ = Describe("CheckIn UseCase", func() {
 				var appErr *apperrors.AppError
 				Expect(errors.As(err, &appErr)).To(BeTrue())
 				Expect(appErr.Code).To(Equal(apperrors.CodeBadRequest))
+				Expect(errors.Is(err, checkin.ErrParticipantNotInEvent)).To(BeTrue())
 			})
 		})
 
@@ -523,6 +946,7 @@ var _ = Describe("CheckIn UseCase", func() {
 
 				Expect(err).To(HaveOccurred())
 				Expect(result).To(BeNil())
+				Expect(errors.Is(err, checkin.ErrParticipantNotFound)).To(BeFalse())
 			})
 		})
 
@@ -839,7 +1263,7 @@ var _ = Describe("CheckIn UseCase", func() {
 
 					mockCheckinRepo.EXPECT().FindByID(gomock.Any(), checkinID).Return(checkinRecord, nil)
 					mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
-					mockCheckinRepo.EXPECT().Delete(gomock.Any(), checkinID).Return(nil)
+					mockCheckinRepo.EXPECT().Cancel(gomock.Any(), checkinID, testUserID, gomock.Any()).Return(nil)
 
 					err := usecase.Cancel(ctx, testUserID, false, checkinID)
 
@@ -865,7 +1289,7 @@ var _ = Describe("CheckIn UseCase", func() {
 
 					mockCheckinRepo.EXPECT().FindByID(gomock.Any(), checkinID).Return(checkinRecord, nil)
 					mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil)
-					mockCheckinRepo.EXPECT().Delete(gomock.Any(), checkinID).Return(nil)
+					mockCheckinRepo.EXPECT().Cancel(gomock.Any(), checkinID, testUserID, gomock.Any()).Return(nil)
 
 					err := usecase.Cancel(ctx, testUserID, true, checkinID) // isAdmin = true
 
@@ -914,5 +1338,125 @@ var _ = Describe("CheckIn UseCase", func() {
 				Expect(appErr.Code).To(Equal(apperrors.CodeForbidden))
 			})
 		})
+
+		When("the check-in was already cancelled", func() {
+			It("should return not found error", func() {
+				checkinID := uuid.New()
+				cancelledAt := time.Now().Add(-time.Minute)
+				checkinRecord := &entity.Checkin{
+					ID:            checkinID,
+					EventID:       testEventID,
+					ParticipantID: uuid.New(),
+					CheckedInAt:   time.Now().Add(-time.Hour),
+					CancelledAt:   &cancelledAt,
+				}
+
+				mockCheckinRepo.EXPECT().FindByID(gomock.Any(), checkinID).Return(checkinRecord, nil)
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).
+					Return(&entity.Event{ID: testEventID, OrganizerID: testUserID}, nil)
+
+				err := usecase.Cancel(ctx, testUserID, false, checkinID)
+
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("Restore", func() {
+		var (
+			checkinID     uuid.UUID
+			participantID uuid.UUID
+			checkinRecord *entity.Checkin
+		)
+
+		BeforeEach(func() {
+			checkinID = uuid.New()
+			participantID = uuid.New()
+			checkinRecord = &entity.Checkin{
+				ID:            checkinID,
+				EventID:       testEventID,
+				ParticipantID: participantID,
+				CheckedInAt:   time.Now().Add(-time.Hour),
+				CheckedInBy:   &testUserID,
+				Method:        entity.CheckinMethodQRCode,
+			}
+			event := &entity.Event{ID: testEventID, OrganizerID: testUserID, Name: "Test Event"}
+
+			mockCheckinRepo.EXPECT().FindByID(gomock.Any(), checkinID).DoAndReturn(
+				func(context.Context, uuid.UUID) (*entity.Checkin, error) {
+					// Return a copy, as the repository would, so Cancel's update is not observed
+					record := *checkinRecord
+					return &record, nil
+				},
+			).AnyTimes()
+			mockEventRepo.EXPECT().FindByID(gomock.Any(), testEventID).Return(event, nil).AnyTimes()
+		})
+
+		When("a check-in is cancelled and restored within the undo window", func() {
+			It("should re-activate the check-in", func() {
+				mockCheckinRepo.EXPECT().Cancel(gomock.Any(), checkinID, testUserID, gomock.Any()).DoAndReturn(
+					func(_ context.Context, _, cancelledBy uuid.UUID, cancelledAt time.Time) error {
+						checkinRecord.CancelledAt = &cancelledAt
+						checkinRecord.CancelledBy = &cancelledBy
+						return nil
+					},
+				)
+				Expect(usecase.Cancel(ctx, testUserID, false, checkinID)).To(Succeed())
+
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participantID).
+					Return(&entity.Participant{ID: participantID, EventID: testEventID, Name: "Alice"}, nil)
+				mockCheckinRepo.EXPECT().Restore(gomock.Any(), checkinID).Return(nil)
+
+				result, err := usecase.Restore(ctx, testUserID, false, testEventID, checkinID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.ID).To(Equal(checkinID))
+				Expect(result.ParticipantName).To(Equal("Alice"))
+				Expect(result.CheckedInAt).To(Equal(checkinRecord.CheckedInAt))
+			})
+		})
+
+		When("the undo window has passed", func() {
+			It("should return bad request error", func() {
+				cancelledAt := time.Now().Add(-testUndoWindow - time.Second)
+				checkinRecord.CancelledAt = &cancelledAt
+
+				result, err := usecase.Restore(ctx, testUserID, false, testEventID, checkinID)
+
+				Expect(result).To(BeNil())
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+				Expect(err.Error()).To(ContainSubstring("undo window has passed"))
+			})
+		})
+
+		When("the participant has checked in again since the cancellation", func() {
+			It("should return conflict error", func() {
+				cancelledAt := time.Now().Add(-time.Minute)
+				checkinRecord.CancelledAt = &cancelledAt
+				mockParticipant.EXPECT().FindByID(gomock.Any(), participantID).
+					Return(&entity.Participant{ID: participantID, EventID: testEventID}, nil)
+				mockCheckinRepo.EXPECT().Restore(gomock.Any(), checkinID).Return(entity.ErrCheckinAlreadyExists)
+
+				_, err := usecase.Restore(ctx, testUserID, false, testEventID, checkinID)
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+		})
+
+		When("the check-in is not cancelled", func() {
+			It("should return conflict error", func() {
+				_, err := usecase.Restore(ctx, testUserID, false, testEventID, checkinID)
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+		})
+
+		When("the check-in belongs to another event", func() {
+			It("should return not found error", func() {
+				_, err := usecase.Restore(ctx, testUserID, false, uuid.New(), checkinID)
+
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
 	})
 })
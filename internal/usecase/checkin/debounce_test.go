@@ -0,0 +1,130 @@
+package checkin_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("CheckIn debouncing", func() {
+	const debounceWindow = 5 * time.Second
+
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		uc              checkin.Usecase
+		mockCheckinRepo *mocks.MockCheckinRepository
+		organizerID     uuid.UUID
+		eventID         uuid.UUID
+		participant     *entity.Participant
+		existing        *entity.Checkin
+		input           checkin.CheckInInput
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		organizerID = uuid.New()
+		eventID = uuid.New()
+		participant = &entity.Participant{ID: uuid.New(), EventID: eventID, Name: "Jane", Email: "jane@example.com"}
+		existing = &entity.Checkin{
+			ID:            uuid.New(),
+			EventID:       eventID,
+			ParticipantID: participant.ID,
+			CheckedInBy:   &organizerID,
+			Method:        entity.CheckinMethodManual,
+		}
+		input = checkin.CheckInInput{
+			EventID:       eventID,
+			Method:        entity.CheckinMethodManual,
+			ParticipantID: &participant.ID,
+			CheckedInBy:   organizerID,
+		}
+
+		mockCheckinRepo = mocks.NewMockCheckinRepository(ctrl)
+		mockParticipant := mocks.NewMockParticipantRepository(ctrl)
+		mockEventRepo := mocks.NewMockEventRepository(ctrl)
+		mockTransactor := mocks.NewMockTransactor(ctrl)
+		mockTransactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+		).AnyTimes()
+		// The outbox mock expects no messages: a debounced check-in records nothing
+		uc = checkin.NewUsecase(
+			mockCheckinRepo, mockParticipant, mockEventRepo, mocks.NewMockOutboxRepository(ctrl), mockTransactor,
+			nil, nil, testQRHMACSecret, 0, nil, testUndoWindow, debounceWindow, 0, nil, testLogger,
+		)
+
+		mockEventRepo.EXPECT().FindByID(gomock.Any(), eventID).
+			Return(&entity.Event{ID: eventID, OrganizerID: organizerID}, nil)
+		mockParticipant.EXPECT().FindByID(gomock.Any(), participant.ID).Return(participant, nil)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectConflict := func(result *checkin.CheckInOutput, err error) {
+		Expect(result).To(BeNil())
+		Expect(apperrors.IsConflict(err)).To(BeTrue())
+		var alreadyErr *checkin.AlreadyCheckedInError
+		Expect(errors.As(err, &alreadyErr)).To(BeTrue())
+	}
+
+	When("the participant checked in within the window", func() {
+		It("should return the existing check-in", func() {
+			existing.CheckedInAt = time.Now().Add(-2 * time.Second)
+			mockCheckinRepo.EXPECT().ExistsByParticipant(gomock.Any(), eventID, participant.ID).Return(true, nil)
+			mockCheckinRepo.EXPECT().FindByParticipant(gomock.Any(), participant.ID).Return(existing, nil)
+
+			result, err := uc.CheckIn(ctx, organizerID, false, input)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ID).To(Equal(existing.ID))
+			Expect(result.CheckedInAt).To(Equal(existing.CheckedInAt))
+			Expect(result.ParticipantName).To(Equal("Jane"))
+		})
+	})
+
+	When("the participant checked in before the window", func() {
+		It("should return conflict error", func() {
+			existing.CheckedInAt = time.Now().Add(-debounceWindow - time.Second)
+			mockCheckinRepo.EXPECT().ExistsByParticipant(gomock.Any(), eventID, participant.ID).Return(true, nil)
+			mockCheckinRepo.EXPECT().FindByParticipant(gomock.Any(), participant.ID).Return(existing, nil)
+
+			expectConflict(uc.CheckIn(ctx, organizerID, false, input))
+		})
+	})
+
+	When("the check-in is cancelled before it can be found", func() {
+		It("should return conflict error", func() {
+			mockCheckinRepo.EXPECT().ExistsByParticipant(gomock.Any(), eventID, participant.ID).Return(true, nil)
+			mockCheckinRepo.EXPECT().FindByParticipant(gomock.Any(), participant.ID).
+				Return(nil, apperrors.NotFound("check-in not found"))
+
+			expectConflict(uc.CheckIn(ctx, organizerID, false, input))
+		})
+	})
+
+	When("a concurrent check-in is saved first", func() {
+		It("should return the concurrent check-in", func() {
+			existing.CheckedInAt = time.Now()
+			mockCheckinRepo.EXPECT().ExistsByParticipant(gomock.Any(), eventID, participant.ID).Return(false, nil)
+			mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(entity.ErrCheckinAlreadyExists)
+			mockCheckinRepo.EXPECT().FindByParticipant(gomock.Any(), participant.ID).Return(existing, nil)
+
+			result, err := uc.CheckIn(ctx, organizerID, false, input)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ID).To(Equal(existing.ID))
+		})
+	})
+})
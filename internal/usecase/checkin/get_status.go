@@ -5,6 +5,9 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/google/uuid"
 )
@@ -29,18 +32,55 @@ func (u *checkinUsecase) GetStatus(
 	}
 
 	// Authorization: event owner or admin only
-	if !isAdmin && event.OrganizerID != userID {
-		return nil, apperrors.Forbidden("you do not have permission to view check-in status for this event")
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view check-in status for this event"); err != nil {
+		return nil, err
+	}
+
+	return u.checkInStatus(ctx, participant, event)
+}
+
+// GetPublicStatus retrieves the check-in status for the participant holding a signed QR token.
+// It needs no authentication: the token is the credential, so the output is limited to what the
+// participant may see about themselves (no email, payment or other personal data).
+func (u *checkinUsecase) GetPublicStatus(ctx context.Context, token string) (*PublicCheckInStatusOutput, error) {
+	if !crypto.IsSignedQRToken(token) {
+		return nil, apperrors.NotFound("invalid QR code or participant not found")
+	}
+	participant, err := u.findParticipantBySignedQRToken(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, participant.EventID)
+	if err != nil {
+		return nil, err
 	}
 
+	status, err := u.checkInStatus(ctx, participant, event)
+	if err != nil {
+		return nil, err
+	}
+	return &PublicCheckInStatusOutput{
+		EventName:       status.EventName,
+		ParticipantName: status.ParticipantName,
+		IsCheckedIn:     status.IsCheckedIn,
+	}, nil
+}
+
+// checkInStatus builds the check-in status of participant at event
+func (u *checkinUsecase) checkInStatus(
+	ctx context.Context,
+	participant *entity.Participant,
+	event *entity.Event,
+) (*CheckInStatusOutput, error) {
 	// Check if participant has checked in
-	checkin, err := u.checkinRepo.FindByParticipant(ctx, participantID)
+	checkin, err := u.checkinRepo.FindByParticipant(ctx, participant.ID)
 	if err != nil {
 		// If not found, participant hasn't checked in yet
 		var appErr *apperrors.AppError
 		if errors.As(err, &appErr) && appErr.Code == apperrors.CodeNotFound {
 			return &CheckInStatusOutput{
-				ParticipantID:    participantID,
+				ParticipantID:    participant.ID,
 				ParticipantName:  participant.Name,
 				ParticipantEmail: participant.Email,
 				EventID:          event.ID,
@@ -54,7 +94,7 @@ func (u *checkinUsecase) GetStatus(
 
 	// Build output with check-in details
 	output := &CheckInStatusOutput{
-		ParticipantID:    participantID,
+		ParticipantID:    participant.ID,
 		ParticipantName:  participant.Name,
 		ParticipantEmail: participant.Email,
 		EventID:          event.ID,
@@ -65,3 +105,34 @@ func (u *checkinUsecase) GetStatus(
 
 	return output, nil
 }
+
+// GetHistory retrieves every check-in of a participant, oldest first. Unlike GetStatus, which
+// reports the current state, it includes the check-ins ended by a check-out or cancellation.
+func (u *checkinUsecase) GetHistory(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	participantID uuid.UUID,
+) ([]*entity.Checkin, error) {
+	participant, err := u.participantRepo.FindByID(ctx, participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify event exists and check authorization
+	event, err := u.eventRepo.FindByID(ctx, participant.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view check-in history for this event"); err != nil {
+		return nil, err
+	}
+
+	history, err := u.checkinRepo.GetHistory(ctx, participantID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get check-in history: %w", err)
+	}
+	return history, nil
+}
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 
-	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	"github.com/google/uuid"
 )
 
@@ -22,8 +22,8 @@ func (u *checkinUsecase) List(
 	}
 
 	// Authorization: event owner or admin only
-	if !isAdmin && event.OrganizerID != userID {
-		return nil, apperrors.Forbidden("you do not have permission to view check-ins for this event")
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view check-ins for this event"); err != nil {
+		return nil, err
 	}
 
 	// Calculate offset from page
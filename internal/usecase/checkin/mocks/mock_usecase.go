@@ -12,7 +12,9 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
+	entity "github.com/fumkob/ezqrin-server/internal/domain/entity"
 	checkin "github.com/fumkob/ezqrin-server/internal/usecase/checkin"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -42,6 +44,21 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// BulkCheckIn mocks base method.
+func (m *MockUsecase) BulkCheckIn(ctx context.Context, userID uuid.UUID, isAdmin bool, input checkin.BulkCheckInInput) (*checkin.BulkCheckInOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCheckIn", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(*checkin.BulkCheckInOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkCheckIn indicates an expected call of BulkCheckIn.
+func (mr *MockUsecaseMockRecorder) BulkCheckIn(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCheckIn", reflect.TypeOf((*MockUsecase)(nil).BulkCheckIn), ctx, userID, isAdmin, input)
+}
+
 // Cancel mocks base method.
 func (m *MockUsecase) Cancel(ctx context.Context, userID uuid.UUID, isAdmin bool, checkinID uuid.UUID) error {
 	m.ctrl.T.Helper()
@@ -71,6 +88,110 @@ func (mr *MockUsecaseMockRecorder) CheckIn(ctx, userID, isAdmin, input any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckIn", reflect.TypeOf((*MockUsecase)(nil).CheckIn), ctx, userID, isAdmin, input)
 }
 
+// CheckInWalkIn mocks base method.
+func (m *MockUsecase) CheckInWalkIn(ctx context.Context, userID uuid.UUID, isAdmin bool, input checkin.WalkInInput) (*checkin.CheckInOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckInWalkIn", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(*checkin.CheckInOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckInWalkIn indicates an expected call of CheckInWalkIn.
+func (mr *MockUsecaseMockRecorder) CheckInWalkIn(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckInWalkIn", reflect.TypeOf((*MockUsecase)(nil).CheckInWalkIn), ctx, userID, isAdmin, input)
+}
+
+// CheckOut mocks base method.
+func (m *MockUsecase) CheckOut(ctx context.Context, userID uuid.UUID, isAdmin bool, input checkin.CheckOutInput) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckOut", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckOut indicates an expected call of CheckOut.
+func (mr *MockUsecaseMockRecorder) CheckOut(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckOut", reflect.TypeOf((*MockUsecase)(nil).CheckOut), ctx, userID, isAdmin, input)
+}
+
+// GetHistory mocks base method.
+func (m *MockUsecase) GetHistory(ctx context.Context, userID uuid.UUID, isAdmin bool, participantID uuid.UUID) ([]*entity.Checkin, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHistory", ctx, userID, isAdmin, participantID)
+	ret0, _ := ret[0].([]*entity.Checkin)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHistory indicates an expected call of GetHistory.
+func (mr *MockUsecaseMockRecorder) GetHistory(ctx, userID, isAdmin, participantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHistory", reflect.TypeOf((*MockUsecase)(nil).GetHistory), ctx, userID, isAdmin, participantID)
+}
+
+// GetProgress mocks base method.
+func (m *MockUsecase) GetProgress(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID) (*checkin.ProgressOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetProgress", ctx, userID, isAdmin, eventID)
+	ret0, _ := ret[0].(*checkin.ProgressOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProgress indicates an expected call of GetProgress.
+func (mr *MockUsecaseMockRecorder) GetProgress(ctx, userID, isAdmin, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProgress", reflect.TypeOf((*MockUsecase)(nil).GetProgress), ctx, userID, isAdmin, eventID)
+}
+
+// GetPublicStatus mocks base method.
+func (m *MockUsecase) GetPublicStatus(ctx context.Context, token string) (*checkin.PublicCheckInStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPublicStatus", ctx, token)
+	ret0, _ := ret[0].(*checkin.PublicCheckInStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPublicStatus indicates an expected call of GetPublicStatus.
+func (mr *MockUsecaseMockRecorder) GetPublicStatus(ctx, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicStatus", reflect.TypeOf((*MockUsecase)(nil).GetPublicStatus), ctx, token)
+}
+
+// GetScanAnalytics mocks base method.
+func (m *MockUsecase) GetScanAnalytics(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID, bucket time.Duration) (*checkin.ScanAnalyticsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetScanAnalytics", ctx, userID, isAdmin, eventID, bucket)
+	ret0, _ := ret[0].(*checkin.ScanAnalyticsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetScanAnalytics indicates an expected call of GetScanAnalytics.
+func (mr *MockUsecaseMockRecorder) GetScanAnalytics(ctx, userID, isAdmin, eventID, bucket any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetScanAnalytics", reflect.TypeOf((*MockUsecase)(nil).GetScanAnalytics), ctx, userID, isAdmin, eventID, bucket)
+}
+
+// GetStaffAttribution mocks base method.
+func (m *MockUsecase) GetStaffAttribution(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID) (*checkin.StaffAttributionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStaffAttribution", ctx, userID, isAdmin, eventID)
+	ret0, _ := ret[0].(*checkin.StaffAttributionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStaffAttribution indicates an expected call of GetStaffAttribution.
+func (mr *MockUsecaseMockRecorder) GetStaffAttribution(ctx, userID, isAdmin, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaffAttribution", reflect.TypeOf((*MockUsecase)(nil).GetStaffAttribution), ctx, userID, isAdmin, eventID)
+}
+
 // GetStatus mocks base method.
 func (m *MockUsecase) GetStatus(ctx context.Context, userID uuid.UUID, isAdmin bool, participantID uuid.UUID) (*checkin.CheckInStatusOutput, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +207,21 @@ func (mr *MockUsecaseMockRecorder) GetStatus(ctx, userID, isAdmin, participantID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatus", reflect.TypeOf((*MockUsecase)(nil).GetStatus), ctx, userID, isAdmin, participantID)
 }
 
+// GetTimeseries mocks base method.
+func (m *MockUsecase) GetTimeseries(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID, interval time.Duration) (*checkin.TimeseriesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTimeseries", ctx, userID, isAdmin, eventID, interval)
+	ret0, _ := ret[0].(*checkin.TimeseriesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTimeseries indicates an expected call of GetTimeseries.
+func (mr *MockUsecaseMockRecorder) GetTimeseries(ctx, userID, isAdmin, eventID, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTimeseries", reflect.TypeOf((*MockUsecase)(nil).GetTimeseries), ctx, userID, isAdmin, eventID, interval)
+}
+
 // List mocks base method.
 func (m *MockUsecase) List(ctx context.Context, userID uuid.UUID, isAdmin bool, input checkin.ListCheckInsInput) (*checkin.ListCheckInsOutput, error) {
 	m.ctrl.T.Helper()
@@ -100,3 +236,47 @@ func (mr *MockUsecaseMockRecorder) List(ctx, userID, isAdmin, input any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUsecase)(nil).List), ctx, userID, isAdmin, input)
 }
+
+// Restore mocks base method.
+func (m *MockUsecase) Restore(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID, checkinID uuid.UUID) (*checkin.CheckInOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, userID, isAdmin, eventID, checkinID)
+	ret0, _ := ret[0].(*checkin.CheckInOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockUsecaseMockRecorder) Restore(ctx, userID, isAdmin, eventID, checkinID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockUsecase)(nil).Restore), ctx, userID, isAdmin, eventID, checkinID)
+}
+
+// StreamCheckIns mocks base method.
+func (m *MockUsecase) StreamCheckIns(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID) (*checkin.CheckInStream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StreamCheckIns", ctx, userID, isAdmin, eventID)
+	ret0, _ := ret[0].(*checkin.CheckInStream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StreamCheckIns indicates an expected call of StreamCheckIns.
+func (mr *MockUsecaseMockRecorder) StreamCheckIns(ctx, userID, isAdmin, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StreamCheckIns", reflect.TypeOf((*MockUsecase)(nil).StreamCheckIns), ctx, userID, isAdmin, eventID)
+}
+
+// WaitForScans mocks base method.
+func (m *MockUsecase) WaitForScans(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForScans", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WaitForScans indicates an expected call of WaitForScans.
+func (mr *MockUsecaseMockRecorder) WaitForScans(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForScans", reflect.TypeOf((*MockUsecase)(nil).WaitForScans), ctx)
+}
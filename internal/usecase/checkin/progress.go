@@ -0,0 +1,114 @@
+package checkin
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// progressCacheTTL bounds how stale a cached check-in counter can be. Check-ins and
+// cancellations through this usecase invalidate it immediately.
+const progressCacheTTL = 5 * time.Second
+
+// GetProgress returns the live check-in counter for an event.
+// Authorization is checked on every call; only the counts are cached.
+func (u *checkinUsecase) GetProgress(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+) (*ProgressOutput, error) {
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view check-in progress for this event"); err != nil {
+		return nil, err
+	}
+
+	if cached := u.getCachedProgress(ctx, eventID); cached != nil {
+		return cached, nil
+	}
+
+	counts, err := u.checkinRepo.GetEventProgress(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := &ProgressOutput{
+		EventID:   eventID,
+		CheckedIn: counts.CheckedInCount,
+		Total:     counts.TotalParticipants,
+		UpdatedAt: time.Now().UTC(),
+	}
+	if counts.TotalParticipants > 0 {
+		rate := float64(counts.CheckedInCount) / float64(counts.TotalParticipants)
+		progress.Percentage = math.Round(rate*1000) / 10
+	}
+
+	u.setCachedProgress(ctx, progress)
+	return progress, nil
+}
+
+// progressCacheKey returns the cache key for an event's check-in progress.
+func progressCacheKey(eventID uuid.UUID) string {
+	return "checkin:progress:" + eventID.String()
+}
+
+// getCachedProgress returns cached progress, or nil on a miss or cache failure.
+// Cache failures are logged and otherwise ignored so the counts are recomputed.
+func (u *checkinUsecase) getCachedProgress(ctx context.Context, eventID uuid.UUID) *ProgressOutput {
+	if u.cacheRepo == nil {
+		return nil
+	}
+
+	raw, err := u.cacheRepo.Get(ctx, progressCacheKey(eventID))
+	if err != nil {
+		u.logger.WithContext(ctx).Warn("failed to read check-in progress from cache", zap.Error(err))
+		return nil
+	}
+	if raw == "" {
+		return nil
+	}
+
+	var progress ProgressOutput
+	if err := json.Unmarshal([]byte(raw), &progress); err != nil {
+		u.logger.WithContext(ctx).Warn("failed to decode cached check-in progress", zap.Error(err))
+		return nil
+	}
+	return &progress
+}
+
+// setCachedProgress stores progress for progressCacheTTL. Failures are logged only.
+func (u *checkinUsecase) setCachedProgress(ctx context.Context, progress *ProgressOutput) {
+	if u.cacheRepo == nil {
+		return
+	}
+
+	raw, err := json.Marshal(progress)
+	if err != nil {
+		u.logger.WithContext(ctx).Warn("failed to encode check-in progress for cache", zap.Error(err))
+		return
+	}
+	if err := u.cacheRepo.Set(ctx, progressCacheKey(progress.EventID), string(raw), progressCacheTTL); err != nil {
+		u.logger.WithContext(ctx).Warn("failed to write check-in progress to cache", zap.Error(err))
+	}
+}
+
+// invalidateProgress drops the cached progress after a check-in changes. Failures are logged
+// only; the stale counter then expires within progressCacheTTL.
+func (u *checkinUsecase) invalidateProgress(ctx context.Context, eventID uuid.UUID) {
+	if u.cacheRepo == nil {
+		return
+	}
+	if err := u.cacheRepo.Delete(ctx, progressCacheKey(eventID)); err != nil {
+		u.logger.WithContext(ctx).Warn("failed to invalidate cached check-in progress", zap.Error(err))
+	}
+}
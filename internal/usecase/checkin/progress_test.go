@@ -0,0 +1,164 @@
+package checkin_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("GetProgress", func() {
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		uc              checkin.Usecase
+		mockCheckinRepo *mocks.MockCheckinRepository
+		mockEventRepo   *mocks.MockEventRepository
+		mockCacheRepo   *mocks.MockCacheRepository
+		organizerID     uuid.UUID
+		eventID         uuid.UUID
+		cacheKey        string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		organizerID = uuid.New()
+		eventID = uuid.New()
+		cacheKey = "checkin:progress:" + eventID.String()
+
+		mockCheckinRepo = mocks.NewMockCheckinRepository(ctrl)
+		mockEventRepo = mocks.NewMockEventRepository(ctrl)
+		mockCacheRepo = mocks.NewMockCacheRepository(ctrl)
+
+		uc = checkin.NewUsecase(
+			mockCheckinRepo, mocks.NewMockParticipantRepository(ctrl), mockEventRepo,
+			mocks.NewMockOutboxRepository(ctrl), mocks.NewMockTransactor(ctrl), mockCacheRepo, nil,
+			testQRHMACSecret, 0, nil, testUndoWindow, 0, 0, nil, testLogger,
+		)
+
+		mockEventRepo.EXPECT().FindByID(gomock.Any(), eventID).
+			Return(&entity.Event{ID: eventID, OrganizerID: organizerID}, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectCount := func(checkedIn, total int64) {
+		mockCacheRepo.EXPECT().Get(gomock.Any(), cacheKey).Return("", nil)
+		mockCheckinRepo.EXPECT().GetEventProgress(gomock.Any(), eventID).Return(&repository.CheckinProgress{
+			CheckedInCount:    checkedIn,
+			TotalParticipants: total,
+		}, nil)
+		mockCacheRepo.EXPECT().Set(gomock.Any(), cacheKey, gomock.Any(), 5*time.Second).Return(nil)
+	}
+
+	When("the progress is not cached", func() {
+		Context("with some participants checked in", func() {
+			It("should return the counts with a rounded percentage", func() {
+				expectCount(342, 500)
+
+				output, err := uc.GetProgress(ctx, organizerID, false, eventID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.EventID).To(Equal(eventID))
+				Expect(output.CheckedIn).To(Equal(int64(342)))
+				Expect(output.Total).To(Equal(int64(500)))
+				Expect(output.Percentage).To(Equal(68.4))
+				Expect(output.UpdatedAt).To(BeTemporally("~", time.Now(), time.Second))
+			})
+		})
+
+		Context("with zero participants", func() {
+			It("should report zero percent", func() {
+				expectCount(0, 0)
+
+				output, err := uc.GetProgress(ctx, organizerID, false, eventID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.CheckedIn).To(BeZero())
+				Expect(output.Total).To(BeZero())
+				Expect(output.Percentage).To(BeZero())
+			})
+		})
+
+		Context("with full attendance", func() {
+			It("should report 100 percent", func() {
+				expectCount(500, 500)
+
+				output, err := uc.GetProgress(ctx, organizerID, false, eventID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.CheckedIn).To(Equal(int64(500)))
+				Expect(output.Percentage).To(Equal(100.0))
+			})
+		})
+	})
+
+	When("the progress is cached", func() {
+		It("should return the cached counts without querying the database", func() {
+			cached, err := json.Marshal(checkin.ProgressOutput{
+				EventID:    eventID,
+				CheckedIn:  10,
+				Total:      20,
+				Percentage: 50,
+				UpdatedAt:  time.Now().UTC(),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			mockCacheRepo.EXPECT().Get(gomock.Any(), cacheKey).Return(string(cached), nil)
+
+			output, err := uc.GetProgress(ctx, organizerID, false, eventID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.CheckedIn).To(Equal(int64(10)))
+			Expect(output.Percentage).To(Equal(50.0))
+		})
+	})
+
+	When("the cache is unavailable", func() {
+		It("should fall back to the database", func() {
+			mockCacheRepo.EXPECT().Get(gomock.Any(), cacheKey).Return("", errors.New("redis down"))
+			mockCheckinRepo.EXPECT().GetEventProgress(gomock.Any(), eventID).
+				Return(&repository.CheckinProgress{CheckedInCount: 1, TotalParticipants: 4}, nil)
+			mockCacheRepo.EXPECT().Set(gomock.Any(), cacheKey, gomock.Any(), gomock.Any()).
+				Return(errors.New("redis down"))
+
+			output, err := uc.GetProgress(ctx, organizerID, false, eventID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.Percentage).To(Equal(25.0))
+		})
+	})
+
+	When("the user does not manage the event", func() {
+		It("should return forbidden without reading the cache", func() {
+			_, err := uc.GetProgress(ctx, uuid.New(), false, eventID)
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("you do not have permission to view check-in progress"))
+		})
+	})
+
+	When("a check-in is cancelled", func() {
+		It("should invalidate the cached progress", func() {
+			checkinID := uuid.New()
+			mockCheckinRepo.EXPECT().FindByID(gomock.Any(), checkinID).
+				Return(&entity.Checkin{ID: checkinID, EventID: eventID}, nil)
+			mockCheckinRepo.EXPECT().Cancel(gomock.Any(), checkinID, organizerID, gomock.Any()).Return(nil)
+			mockCacheRepo.EXPECT().Delete(gomock.Any(), cacheKey).Return(nil)
+
+			Expect(uc.Cancel(ctx, organizerID, false, checkinID)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,160 @@
+package checkin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// DefaultScanBucket is the time bucket size of the scan analytics timeline
+	DefaultScanBucket = 15 * time.Minute
+	// MinScanBucket and MaxScanBucket bound the requested bucket size
+	MinScanBucket = time.Minute
+	MaxScanBucket = 24 * time.Hour
+
+	// scanRecordTimeout bounds a scan write that runs after the check-in has answered
+	scanRecordTimeout = 5 * time.Second
+)
+
+var (
+	// errQRCodeNotIssued marks QR codes whose signature shows they were not issued by this server
+	errQRCodeNotIssued = errors.New("QR code signature is invalid")
+	// errRepeatedScan marks scans answered with a check-in made within the debounce window
+	errRepeatedScan = errors.New("participant checked in within the debounce window")
+)
+
+// recordScan records the outcome of a QR code check-in in the background, so a slow or
+// failing write never delays or fails the check-in. Errors that say nothing about the
+// scanned code, such as database failures, are not recorded. It is only called once the
+// event was found; WaitForScans waits for the writes still running.
+func (u *checkinUsecase) recordScan(ctx context.Context, eventID uuid.UUID, checkInErr error) {
+	outcome, ok := scanOutcome(checkInErr)
+	if !ok {
+		return
+	}
+	scan := &entity.CheckinScan{
+		EventID:   eventID,
+		Outcome:   outcome,
+		ScannedAt: time.Now(),
+	}
+
+	// The request context is cancelled once the response is written
+	recordCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), scanRecordTimeout)
+	u.scans.Add(1)
+	go func() {
+		defer u.scans.Done()
+		defer cancel()
+		if err := u.checkinRepo.RecordScan(recordCtx, scan); err != nil {
+			u.logger.WithContext(recordCtx).Warn("failed to record check-in scan", zap.Error(err))
+		}
+	}()
+}
+
+// WaitForScans blocks until the scans being recorded in the background have been written or
+// ctx is done, returning ctx's error in the latter case.
+func (u *checkinUsecase) WaitForScans(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		u.scans.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// scanOutcome classifies the result of a QR code check-in
+func scanOutcome(err error) (entity.ScanOutcome, bool) {
+	switch {
+	case err == nil:
+		return entity.ScanOutcomeSuccess, true
+	case errors.Is(err, errQRCodeNotIssued):
+		return entity.ScanOutcomeInvalid, true
+	case errors.Is(err, ErrParticipantNotInEvent), apperrors.IsNotFound(err):
+		return entity.ScanOutcomeNotFound, true
+	case apperrors.IsConflict(err), errors.Is(err, errRepeatedScan):
+		return entity.ScanOutcomeDuplicate, true
+	}
+
+	// A missing code, or a participant who may not check in
+	switch apperrors.GetErrorCode(err) {
+	case apperrors.CodeBadRequest, apperrors.CodeValidation, apperrors.CodeUnprocessable:
+		return entity.ScanOutcomeInvalid, true
+	}
+	return "", false
+}
+
+// GetScanAnalytics summarizes an event's QR scans by outcome, in total and over time.
+// bucket is the size of the timeline buckets; zero selects DefaultScanBucket.
+func (u *checkinUsecase) GetScanAnalytics(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+	bucket time.Duration,
+) (*ScanAnalyticsOutput, error) {
+	if bucket == 0 {
+		bucket = DefaultScanBucket
+	}
+	if bucket < MinScanBucket || bucket > MaxScanBucket {
+		return nil, apperrors.Validation("interval must be between 1 minute and 24 hours")
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view scan analytics for this event"); err != nil {
+		return nil, err
+	}
+
+	counts, err := u.checkinRepo.CountScans(ctx, eventID, bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &ScanAnalyticsOutput{
+		EventID:  eventID,
+		Bucket:   bucket,
+		Timeline: []ScanBucket{},
+	}
+	for _, count := range counts {
+		last := len(output.Timeline) - 1
+		if last < 0 || !output.Timeline[last].Start.Equal(count.BucketStart) {
+			output.Timeline = append(output.Timeline, ScanBucket{Start: count.BucketStart.UTC()})
+			last++
+		}
+		output.Timeline[last].Counts.add(count.Outcome, count.Count)
+		output.Totals.add(count.Outcome, count.Count)
+	}
+
+	return output, nil
+}
+
+// add adds n scans with the given outcome
+func (c *ScanOutcomeCounts) add(outcome entity.ScanOutcome, n int64) {
+	switch outcome {
+	case entity.ScanOutcomeSuccess:
+		c.Success += n
+	case entity.ScanOutcomeDuplicate:
+		c.Duplicate += n
+	case entity.ScanOutcomeNotFound:
+		c.NotFound += n
+	case entity.ScanOutcomeInvalid:
+		c.Invalid += n
+	}
+	c.Total += n
+}
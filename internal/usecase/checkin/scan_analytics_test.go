@@ -0,0 +1,314 @@
+package checkin_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Scan analytics", func() {
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		uc              checkin.Usecase
+		mockCheckinRepo *mocks.MockCheckinRepository
+		mockParticipant *mocks.MockParticipantRepository
+		mockEventRepo   *mocks.MockEventRepository
+		organizerID     uuid.UUID
+		eventID         uuid.UUID
+		scans           chan *entity.CheckinScan
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		organizerID = uuid.New()
+		eventID = uuid.New()
+		scans = make(chan *entity.CheckinScan, 1)
+
+		mockCheckinRepo = mocks.NewMockCheckinRepository(ctrl)
+		mockParticipant = mocks.NewMockParticipantRepository(ctrl)
+		mockEventRepo = mocks.NewMockEventRepository(ctrl)
+		mockOutboxRepo := mocks.NewMockOutboxRepository(ctrl)
+		mockTransactor := mocks.NewMockTransactor(ctrl)
+		mockTransactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+		).AnyTimes()
+		mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+		uc = checkin.NewUsecase(
+			mockCheckinRepo, mockParticipant, mockEventRepo, mockOutboxRepo, mockTransactor, nil, nil,
+			testQRHMACSecret, 0, nil, testUndoWindow, 0, 0, nil, testLogger,
+		)
+
+		mockEventRepo.EXPECT().FindByID(gomock.Any(), eventID).
+			Return(&entity.Event{ID: eventID, OrganizerID: organizerID}, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("recording scans", func() {
+		var qrCode string
+
+		BeforeEach(func() {
+			var err error
+			qrCode, err = crypto.GenerateHMACSignedToken(testQRHMACSecret)
+			Expect(err).NotTo(HaveOccurred())
+
+			mockCheckinRepo.EXPECT().RecordScan(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, scan *entity.CheckinScan) error {
+					scans <- scan
+					return nil
+				}).AnyTimes()
+		})
+
+		scanQRCode := func(code string) error {
+			_, err := uc.CheckIn(ctx, uuid.New(), false, checkin.CheckInInput{
+				EventID: eventID,
+				Method:  entity.CheckinMethodQRCode,
+				QRCode:  &code,
+			})
+			return err
+		}
+
+		expectScan := func(outcome entity.ScanOutcome) {
+			var scan *entity.CheckinScan
+			Eventually(scans).Should(Receive(&scan))
+			Expect(scan.EventID).To(Equal(eventID))
+			Expect(scan.Outcome).To(Equal(outcome))
+			Expect(scan.ScannedAt).To(BeTemporally("~", time.Now(), time.Second))
+		}
+
+		participantWith := func(status entity.ParticipantStatus) *entity.Participant {
+			return &entity.Participant{ID: uuid.New(), EventID: eventID, Name: "Jane", QRCode: qrCode, Status: status}
+		}
+
+		When("the participant checks in", func() {
+			It("should record a successful scan", func() {
+				participant := participantWith(entity.ParticipantStatusConfirmed)
+				mockParticipant.EXPECT().FindByQRCode(gomock.Any(), qrCode).Return(participant, nil)
+				mockCheckinRepo.EXPECT().ExistsByParticipant(gomock.Any(), eventID, participant.ID).Return(false, nil)
+				mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+
+				Expect(scanQRCode(qrCode)).To(Succeed())
+				expectScan(entity.ScanOutcomeSuccess)
+			})
+		})
+
+		When("the participant has already checked in", func() {
+			It("should record a duplicate scan", func() {
+				participant := participantWith(entity.ParticipantStatusConfirmed)
+				mockParticipant.EXPECT().FindByQRCode(gomock.Any(), qrCode).Return(participant, nil)
+				mockCheckinRepo.EXPECT().ExistsByParticipant(gomock.Any(), eventID, participant.ID).Return(true, nil)
+
+				Expect(apperrors.IsConflict(scanQRCode(qrCode))).To(BeTrue())
+				expectScan(entity.ScanOutcomeDuplicate)
+			})
+		})
+
+		When("the QR code matches no participant", func() {
+			It("should record a not found scan", func() {
+				mockParticipant.EXPECT().FindByQRCode(gomock.Any(), qrCode).
+					Return(nil, apperrors.NotFound("participant not found"))
+
+				Expect(apperrors.IsNotFound(scanQRCode(qrCode))).To(BeTrue())
+				expectScan(entity.ScanOutcomeNotFound)
+			})
+		})
+
+		When("the QR code belongs to a participant of another event", func() {
+			It("should record a not found scan", func() {
+				participant := participantWith(entity.ParticipantStatusConfirmed)
+				participant.EventID = uuid.New()
+				mockParticipant.EXPECT().FindByQRCode(gomock.Any(), qrCode).Return(participant, nil)
+
+				err := scanQRCode(qrCode)
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+				expectScan(entity.ScanOutcomeNotFound)
+			})
+		})
+
+		When("the QR code was not issued by this server", func() {
+			It("should record an invalid scan", func() {
+				Expect(apperrors.IsNotFound(scanQRCode("not-a-signed-qr-code"))).To(BeTrue())
+				expectScan(entity.ScanOutcomeInvalid)
+			})
+		})
+
+		When("the participant has cancelled", func() {
+			It("should record an invalid scan", func() {
+				participant := participantWith(entity.ParticipantStatusCancelled)
+				mockParticipant.EXPECT().FindByQRCode(gomock.Any(), qrCode).Return(participant, nil)
+
+				Expect(scanQRCode(qrCode)).To(HaveOccurred())
+				expectScan(entity.ScanOutcomeInvalid)
+			})
+		})
+
+		When("the check-in fails for a reason unrelated to the QR code", func() {
+			It("should not record the scan", func() {
+				participant := participantWith(entity.ParticipantStatusConfirmed)
+				mockParticipant.EXPECT().FindByQRCode(gomock.Any(), qrCode).Return(participant, nil)
+				mockCheckinRepo.EXPECT().ExistsByParticipant(gomock.Any(), eventID, participant.ID).
+					Return(false, errors.New("connection reset"))
+
+				Expect(scanQRCode(qrCode)).To(HaveOccurred())
+				Consistently(scans, 100*time.Millisecond).ShouldNot(Receive())
+			})
+		})
+	})
+
+	When("the event does not exist", func() {
+		It("should not record the scan", func() {
+			missingEventID := uuid.New()
+			mockEventRepo.EXPECT().FindByID(gomock.Any(), missingEventID).
+				Return(nil, apperrors.NotFound("event not found"))
+			mockCheckinRepo.EXPECT().RecordScan(gomock.Any(), gomock.Any()).Times(0)
+
+			code := "not-a-signed-qr-code"
+			_, err := uc.CheckIn(ctx, uuid.New(), false, checkin.CheckInInput{
+				EventID: missingEventID,
+				Method:  entity.CheckinMethodQRCode,
+				QRCode:  &code,
+			})
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			Expect(uc.WaitForScans(ctx)).To(Succeed())
+		})
+	})
+
+	Describe("WaitForScans", func() {
+		It("should wait for the scans being recorded", func() {
+			release := make(chan struct{})
+			mockCheckinRepo.EXPECT().RecordScan(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(context.Context, *entity.CheckinScan) error {
+					<-release
+					return nil
+				})
+
+			code := "not-a-signed-qr-code"
+			_, err := uc.CheckIn(ctx, uuid.New(), false, checkin.CheckInInput{
+				EventID: eventID,
+				Method:  entity.CheckinMethodQRCode,
+				QRCode:  &code,
+			})
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+
+			waited := make(chan error, 1)
+			go func() { waited <- uc.WaitForScans(ctx) }()
+			Consistently(waited, 50*time.Millisecond).ShouldNot(Receive())
+
+			close(release)
+
+			Eventually(waited).Should(Receive(BeNil()))
+		})
+
+		It("should stop waiting when the context expires", func() {
+			release := make(chan struct{})
+			defer close(release)
+			mockCheckinRepo.EXPECT().RecordScan(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(context.Context, *entity.CheckinScan) error {
+					<-release
+					return nil
+				})
+
+			code := "not-a-signed-qr-code"
+			_, err := uc.CheckIn(ctx, uuid.New(), false, checkin.CheckInInput{
+				EventID: eventID,
+				Method:  entity.CheckinMethodQRCode,
+				QRCode:  &code,
+			})
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+
+			waitCtx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+			defer cancel()
+			Expect(uc.WaitForScans(waitCtx)).To(MatchError(context.DeadlineExceeded))
+		})
+	})
+
+	When("recording a scan fails", func() {
+		It("should still answer the check-in", func() {
+			recorded := make(chan struct{})
+			mockCheckinRepo.EXPECT().RecordScan(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(context.Context, *entity.CheckinScan) error {
+					close(recorded)
+					return errors.New("disk full")
+				})
+
+			code := "not-a-signed-qr-code"
+			_, err := uc.CheckIn(ctx, uuid.New(), false, checkin.CheckInInput{
+				EventID: eventID,
+				Method:  entity.CheckinMethodQRCode,
+				QRCode:  &code,
+			})
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			Eventually(recorded).Should(BeClosed())
+		})
+	})
+
+	Describe("GetScanAnalytics", func() {
+		When("scans were recorded", func() {
+			It("should return the totals and the timeline per outcome", func() {
+				first := time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC)
+				second := first.Add(15 * time.Minute)
+				mockCheckinRepo.EXPECT().CountScans(gomock.Any(), eventID, checkin.DefaultScanBucket).
+					Return([]repository.ScanOutcomeCount{
+						{BucketStart: first, Outcome: entity.ScanOutcomeDuplicate, Count: 2},
+						{BucketStart: first, Outcome: entity.ScanOutcomeSuccess, Count: 40},
+						{BucketStart: second, Outcome: entity.ScanOutcomeInvalid, Count: 1},
+						{BucketStart: second, Outcome: entity.ScanOutcomeNotFound, Count: 12},
+						{BucketStart: second, Outcome: entity.ScanOutcomeSuccess, Count: 30},
+					}, nil)
+
+				result, err := uc.GetScanAnalytics(ctx, organizerID, false, eventID, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Bucket).To(Equal(checkin.DefaultScanBucket))
+				Expect(result.Totals).To(Equal(checkin.ScanOutcomeCounts{
+					Success: 70, Duplicate: 2, NotFound: 12, Invalid: 1, Total: 85,
+				}))
+				Expect(result.Timeline).To(Equal([]checkin.ScanBucket{
+					{Start: first, Counts: checkin.ScanOutcomeCounts{Success: 40, Duplicate: 2, Total: 42}},
+					{Start: second, Counts: checkin.ScanOutcomeCounts{Success: 30, NotFound: 12, Invalid: 1, Total: 43}},
+				}))
+			})
+		})
+
+		When("no scans were recorded", func() {
+			It("should return zero totals and an empty timeline", func() {
+				mockCheckinRepo.EXPECT().CountScans(gomock.Any(), eventID, time.Hour).
+					Return([]repository.ScanOutcomeCount{}, nil)
+
+				result, err := uc.GetScanAnalytics(ctx, organizerID, false, eventID, time.Hour)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Totals.Total).To(BeZero())
+				Expect(result.Timeline).To(BeEmpty())
+			})
+		})
+
+		When("the bucket size is out of range", func() {
+			It("should return a validation error", func() {
+				_, err := uc.GetScanAnalytics(ctx, organizerID, false, eventID, 30*time.Second)
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+			})
+		})
+
+		When("the user does not manage the event", func() {
+			It("should return a forbidden error", func() {
+				_, err := uc.GetScanAnalytics(ctx, uuid.New(), false, eventID, 0)
+				Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,96 @@
+package checkin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// checkinStreamChannelPrefix prefixes the Pub/Sub channel of each event's created check-ins
+const checkinStreamChannelPrefix = "checkins:event:"
+
+func checkinStreamChannel(eventID uuid.UUID) string {
+	return checkinStreamChannelPrefix + eventID.String()
+}
+
+// CheckInStream delivers the check-ins created for an event while it is open.
+type CheckInStream struct {
+	// CheckIns receives each created check-in; it is closed when the stream ends
+	CheckIns <-chan *CheckInOutput
+	sub      repository.Subscription
+}
+
+// Close ends the stream and unsubscribes from the event's check-ins.
+func (s *CheckInStream) Close() error {
+	if s.sub == nil {
+		return nil
+	}
+	return s.sub.Close()
+}
+
+// StreamCheckIns subscribes to the check-ins created for an event from now on, across all
+// server instances, for live dashboards. The stream ends when ctx is done or it is closed.
+func (u *checkinUsecase) StreamCheckIns(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+) (*CheckInStream, error) {
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "stream check-ins for this event"); err != nil {
+		return nil, err
+	}
+
+	if u.pubSub == nil {
+		return nil, apperrors.ServiceUnavailable("live check-in stream is not available")
+	}
+	sub, err := u.pubSub.Subscribe(ctx, checkinStreamChannel(eventID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to check-ins: %w", err)
+	}
+
+	checkIns := make(chan *CheckInOutput)
+	go func() {
+		defer close(checkIns)
+		for message := range sub.Messages() {
+			var output CheckInOutput
+			if err := json.Unmarshal([]byte(message), &output); err != nil {
+				u.logger.WithContext(ctx).Warn("failed to decode streamed check-in", zap.Error(err))
+				continue
+			}
+			select {
+			case checkIns <- &output:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return &CheckInStream{CheckIns: checkIns, sub: sub}, nil
+}
+
+// publishCheckinCreated publishes a created check-in to the live stream of its event.
+// Failures are logged only; the check-in itself has been recorded.
+func (u *checkinUsecase) publishCheckinCreated(ctx context.Context, output *CheckInOutput) {
+	if u.pubSub == nil {
+		return
+	}
+	message, err := json.Marshal(output)
+	if err == nil {
+		err = u.pubSub.Publish(ctx, checkinStreamChannel(output.EventID), string(message))
+	}
+	if err != nil {
+		u.logger.WithContext(ctx).Warn("failed to publish check-in to the live stream", zap.Error(err))
+	}
+}
@@ -0,0 +1,166 @@
+package checkin_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Check-in stream", func() {
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		uc              checkin.Usecase
+		mockCheckinRepo *mocks.MockCheckinRepository
+		mockParticipant *mocks.MockParticipantRepository
+		mockEventRepo   *mocks.MockEventRepository
+		mockOutboxRepo  *mocks.MockOutboxRepository
+		mockPubSub      *mocks.MockPubSubRepository
+		event           *entity.Event
+		organizerID     uuid.UUID
+		channel         string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID, Name: "Test Event"}
+		channel = "checkins:event:" + event.ID.String()
+
+		mockCheckinRepo = mocks.NewMockCheckinRepository(ctrl)
+		mockParticipant = mocks.NewMockParticipantRepository(ctrl)
+		mockEventRepo = mocks.NewMockEventRepository(ctrl)
+		mockOutboxRepo = mocks.NewMockOutboxRepository(ctrl)
+		mockPubSub = mocks.NewMockPubSubRepository(ctrl)
+		mockTransactor := mocks.NewMockTransactor(ctrl)
+		mockTransactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+		).AnyTimes()
+
+		uc = checkin.NewUsecase(
+			mockCheckinRepo, mockParticipant, mockEventRepo, mockOutboxRepo, mockTransactor, nil, mockPubSub,
+			testQRHMACSecret, 0, nil, testUndoWindow, 0, 0, nil, testLogger,
+		)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("CheckIn", func() {
+		var (
+			participantID uuid.UUID
+			input         checkin.CheckInInput
+		)
+
+		BeforeEach(func() {
+			participantID = uuid.New()
+			participant := &entity.Participant{ID: participantID, EventID: event.ID, Name: "Jane Smith"}
+			input = checkin.CheckInInput{
+				EventID:       event.ID,
+				Method:        entity.CheckinMethodManual,
+				ParticipantID: &participantID,
+				CheckedInBy:   organizerID,
+			}
+
+			mockEventRepo.EXPECT().FindByID(gomock.Any(), event.ID).Return(event, nil)
+			mockParticipant.EXPECT().FindByID(gomock.Any(), participantID).Return(participant, nil)
+			mockCheckinRepo.EXPECT().ExistsByParticipant(gomock.Any(), event.ID, participantID).Return(false, nil)
+			mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
+		})
+
+		It("should publish the created check-in to the event's channel", func() {
+			var published string
+			mockPubSub.EXPECT().Publish(gomock.Any(), channel, gomock.Any()).DoAndReturn(
+				func(_ context.Context, _ string, message string) error {
+					published = message
+					return nil
+				},
+			)
+
+			result, err := uc.CheckIn(ctx, organizerID, false, input)
+
+			Expect(err).NotTo(HaveOccurred())
+			var streamed checkin.CheckInOutput
+			Expect(json.Unmarshal([]byte(published), &streamed)).To(Succeed())
+			Expect(streamed.ID).To(Equal(result.ID))
+			Expect(streamed.ParticipantID).To(Equal(participantID))
+			Expect(streamed.ParticipantName).To(Equal("Jane Smith"))
+		})
+
+		It("should still check the participant in when publishing fails", func() {
+			mockPubSub.EXPECT().Publish(gomock.Any(), channel, gomock.Any()).Return(errors.New("redis down"))
+
+			result, err := uc.CheckIn(ctx, organizerID, false, input)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.ParticipantID).To(Equal(participantID))
+		})
+	})
+
+	Describe("StreamCheckIns", func() {
+		When("the user manages the event", func() {
+			It("should deliver the published check-ins until closed", func() {
+				messages := make(chan string, 1)
+				sub := mocks.NewMockSubscription(ctrl)
+				sub.EXPECT().Messages().Return(messages).AnyTimes()
+				sub.EXPECT().Close().DoAndReturn(func() error {
+					close(messages)
+					return nil
+				})
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), event.ID).Return(event, nil)
+				mockPubSub.EXPECT().Subscribe(gomock.Any(), channel).Return(sub, nil)
+
+				stream, err := uc.StreamCheckIns(ctx, organizerID, false, event.ID)
+				Expect(err).NotTo(HaveOccurred())
+
+				checkinID := uuid.New()
+				message, err := json.Marshal(checkin.CheckInOutput{ID: checkinID, EventID: event.ID})
+				Expect(err).NotTo(HaveOccurred())
+				messages <- string(message)
+
+				var received *checkin.CheckInOutput
+				Eventually(stream.CheckIns).Should(Receive(&received))
+				Expect(received.ID).To(Equal(checkinID))
+
+				Expect(stream.Close()).To(Succeed())
+				Eventually(stream.CheckIns).Should(BeClosed())
+			})
+		})
+
+		When("the user does not manage the event", func() {
+			It("should return forbidden without subscribing", func() {
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), event.ID).Return(event, nil)
+
+				_, err := uc.StreamCheckIns(ctx, uuid.New(), false, event.ID)
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeForbidden))
+			})
+		})
+
+		When("no publish/subscribe service is configured", func() {
+			It("should return service unavailable", func() {
+				uc = checkin.NewUsecase(
+					mockCheckinRepo, mockParticipant, mockEventRepo, mockOutboxRepo, mocks.NewMockTransactor(ctrl),
+					nil, nil, testQRHMACSecret, 0, nil, testUndoWindow, 0, 0, nil, testLogger,
+				)
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), event.ID).Return(event, nil)
+
+				_, err := uc.StreamCheckIns(ctx, uuid.New(), true, event.ID)
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeServiceUnavailable))
+			})
+		})
+	})
+})
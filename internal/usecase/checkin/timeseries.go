@@ -0,0 +1,159 @@
+package checkin
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultTimeseriesInterval is the bucket size of the check-in time series
+	DefaultTimeseriesInterval = 15 * time.Minute
+	// MaxTimeseriesBuckets caps the buckets of one check-in time series
+	MaxTimeseriesBuckets = 1000
+)
+
+// TimeseriesIntervals are the bucket sizes the check-in time series can be requested in
+var TimeseriesIntervals = []time.Duration{5 * time.Minute, 15 * time.Minute, time.Hour}
+
+// GetTimeseries counts an event's check-ins over time for an arrival-rate chart, in buckets
+// of interval spanning the event's schedule and every check-in, in total and per device.
+// Buckets without check-ins are included with a zero count. interval must be one of
+// TimeseriesIntervals; zero selects DefaultTimeseriesInterval.
+func (u *checkinUsecase) GetTimeseries(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+	interval time.Duration,
+) (*TimeseriesOutput, error) {
+	if interval == 0 {
+		interval = DefaultTimeseriesInterval
+	}
+	if !isTimeseriesInterval(interval) {
+		return nil, apperrors.Validation("interval must be one of 5m, 15m or 1h")
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view check-in analytics for this event"); err != nil {
+		return nil, err
+	}
+
+	// Reject schedules that are too long up front; check-ins outside the schedule are caught below
+	end := event.StartDate
+	if event.EndDate != nil {
+		end = *event.EndDate
+	}
+	if end.Sub(event.StartDate)/interval >= MaxTimeseriesBuckets {
+		return nil, errTooManyBuckets()
+	}
+
+	counts, err := u.checkinRepo.CountByInterval(ctx, eventID, interval)
+	if err != nil {
+		return nil, err
+	}
+	buckets, bucketOf := timeseriesBuckets(counts)
+	if len(buckets) > MaxTimeseriesBuckets {
+		return nil, errTooManyBuckets()
+	}
+
+	output := &TimeseriesOutput{
+		EventID:  eventID,
+		Interval: interval,
+		Buckets:  buckets,
+		ByDevice: timeseriesByDevice(counts, bucketOf, buckets),
+	}
+	for _, bucket := range buckets {
+		output.Total += bucket.Count
+	}
+
+	return output, nil
+}
+
+// timeseriesBuckets sums the per-device counts of each bucket, which are ordered by bucket, and
+// returns the buckets with the index of the bucket of every count
+func timeseriesBuckets(counts []repository.CheckinIntervalCount) ([]TimeseriesBucket, []int) {
+	buckets := []TimeseriesBucket{}
+	bucketOf := make([]int, len(counts))
+	for i, count := range counts {
+		start := count.BucketStart.UTC()
+		if len(buckets) == 0 || !buckets[len(buckets)-1].Start.Equal(start) {
+			buckets = append(buckets, TimeseriesBucket{Start: start})
+		}
+		bucketOf[i] = len(buckets) - 1
+		buckets[bucketOf[i]].Count += count.Count
+	}
+	return buckets, bucketOf
+}
+
+// timeseriesByDevice breaks the time series down per device, with every bucket of the series.
+// Devices are ordered by device ID, check-ins without one last.
+func timeseriesByDevice(
+	counts []repository.CheckinIntervalCount,
+	bucketOf []int,
+	buckets []TimeseriesBucket,
+) []TimeseriesDevice {
+	devices := []TimeseriesDevice{}
+	deviceIndex := make(map[string]int) // Index in devices; device IDs are never empty, "" is no device
+	for i, count := range counts {
+		if count.Count == 0 {
+			continue
+		}
+		key := ""
+		if count.DeviceID != nil {
+			key = *count.DeviceID
+		}
+		index, ok := deviceIndex[key]
+		if !ok {
+			index = len(devices)
+			deviceIndex[key] = index
+			device := TimeseriesDevice{DeviceID: count.DeviceID, Buckets: make([]TimeseriesBucket, len(buckets))}
+			for j, bucket := range buckets {
+				device.Buckets[j].Start = bucket.Start
+			}
+			devices = append(devices, device)
+		}
+		devices[index].Buckets[bucketOf[i]].Count += count.Count
+		devices[index].Total += count.Count
+	}
+
+	slices.SortFunc(devices, func(a, b TimeseriesDevice) int {
+		switch {
+		case a.DeviceID == nil:
+			return 1
+		case b.DeviceID == nil:
+			return -1
+		}
+		return strings.Compare(*a.DeviceID, *b.DeviceID)
+	})
+	return devices
+}
+
+// isTimeseriesInterval reports whether interval is one of TimeseriesIntervals
+func isTimeseriesInterval(interval time.Duration) bool {
+	for _, allowed := range TimeseriesIntervals {
+		if interval == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// errTooManyBuckets returns the error for a time series over MaxTimeseriesBuckets buckets
+func errTooManyBuckets() error {
+	return apperrors.Validation(
+		fmt.Sprintf("the check-ins span more than %d buckets; use a longer interval", MaxTimeseriesBuckets),
+	)
+}
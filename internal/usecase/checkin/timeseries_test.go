@@ -0,0 +1,150 @@
+package checkin_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("GetTimeseries", func() {
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		uc              checkin.Usecase
+		mockCheckinRepo *mocks.MockCheckinRepository
+		organizerID     uuid.UUID
+		event           *entity.Event
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		organizerID = uuid.New()
+		start := time.Date(2026, 9, 1, 9, 0, 0, 0, time.UTC)
+		end := start.Add(3 * time.Hour)
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID, StartDate: start, EndDate: &end}
+
+		mockCheckinRepo = mocks.NewMockCheckinRepository(ctrl)
+		mockEventRepo := mocks.NewMockEventRepository(ctrl)
+		uc = checkin.NewUsecase(
+			mockCheckinRepo, mocks.NewMockParticipantRepository(ctrl), mockEventRepo,
+			mocks.NewMockOutboxRepository(ctrl), mocks.NewMockTransactor(ctrl), nil, nil,
+			testQRHMACSecret, 0, nil, testUndoWindow, 0, 0, nil, testLogger,
+		)
+
+		mockEventRepo.EXPECT().FindByID(gomock.Any(), event.ID).Return(event, nil).AnyTimes()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	When("check-ins were recorded", func() {
+		It("should return every bucket with the total", func() {
+			first := event.StartDate
+			mockCheckinRepo.EXPECT().CountByInterval(gomock.Any(), event.ID, checkin.DefaultTimeseriesInterval).
+				Return([]repository.CheckinIntervalCount{
+					{BucketStart: first, Count: 12},
+					{BucketStart: first.Add(15 * time.Minute), Count: 0},
+					{BucketStart: first.Add(30 * time.Minute), Count: 30},
+				}, nil)
+
+			result, err := uc.GetTimeseries(ctx, organizerID, false, event.ID, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Interval).To(Equal(checkin.DefaultTimeseriesInterval))
+			Expect(result.Total).To(Equal(int64(42)))
+			Expect(result.Buckets).To(Equal([]checkin.TimeseriesBucket{
+				{Start: first, Count: 12},
+				{Start: first.Add(15 * time.Minute), Count: 0},
+				{Start: first.Add(30 * time.Minute), Count: 30},
+			}))
+		})
+
+		It("should break the series down per device", func() {
+			first := event.StartDate
+			gateA, gateB := "gate-a", "gate-b"
+			mockCheckinRepo.EXPECT().CountByInterval(gomock.Any(), event.ID, checkin.DefaultTimeseriesInterval).
+				Return([]repository.CheckinIntervalCount{
+					{BucketStart: first, DeviceID: &gateB, Count: 4},
+					{BucketStart: first, Count: 1},
+					{BucketStart: first.Add(15 * time.Minute), Count: 0},
+					{BucketStart: first.Add(30 * time.Minute), DeviceID: &gateA, Count: 7},
+					{BucketStart: first.Add(30 * time.Minute), DeviceID: &gateB, Count: 2},
+				}, nil)
+
+			result, err := uc.GetTimeseries(ctx, organizerID, false, event.ID, 0)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Total).To(Equal(int64(14)))
+			Expect(result.Buckets).To(Equal([]checkin.TimeseriesBucket{
+				{Start: first, Count: 5},
+				{Start: first.Add(15 * time.Minute), Count: 0},
+				{Start: first.Add(30 * time.Minute), Count: 9},
+			}))
+			Expect(result.ByDevice).To(Equal([]checkin.TimeseriesDevice{
+				{DeviceID: &gateA, Total: 7, Buckets: []checkin.TimeseriesBucket{
+					{Start: first, Count: 0},
+					{Start: first.Add(15 * time.Minute), Count: 0},
+					{Start: first.Add(30 * time.Minute), Count: 7},
+				}},
+				{DeviceID: &gateB, Total: 6, Buckets: []checkin.TimeseriesBucket{
+					{Start: first, Count: 4},
+					{Start: first.Add(15 * time.Minute), Count: 0},
+					{Start: first.Add(30 * time.Minute), Count: 2},
+				}},
+				{Total: 1, Buckets: []checkin.TimeseriesBucket{
+					{Start: first, Count: 1},
+					{Start: first.Add(15 * time.Minute), Count: 0},
+					{Start: first.Add(30 * time.Minute), Count: 0},
+				}},
+			}))
+		})
+	})
+
+	DescribeTable("should reject intervals other than 5m, 15m and 1h",
+		func(interval time.Duration) {
+			_, err := uc.GetTimeseries(ctx, organizerID, false, event.ID, interval)
+			Expect(apperrors.IsValidation(err)).To(BeTrue())
+		},
+		Entry("1 minute", time.Minute),
+		Entry("30 minutes", 30*time.Minute),
+	)
+
+	When("the event's schedule spans too many buckets", func() {
+		It("should return a validation error without counting", func() {
+			end := event.StartDate.Add(checkin.MaxTimeseriesBuckets * 5 * time.Minute)
+			event.EndDate = &end
+
+			_, err := uc.GetTimeseries(ctx, organizerID, false, event.ID, 5*time.Minute)
+			Expect(apperrors.IsValidation(err)).To(BeTrue())
+		})
+	})
+
+	When("check-ins outside the schedule make the series too long", func() {
+		It("should return a validation error", func() {
+			counts := make([]repository.CheckinIntervalCount, checkin.MaxTimeseriesBuckets+1)
+			for i := range counts {
+				counts[i].BucketStart = event.StartDate.Add(time.Duration(i) * time.Hour)
+			}
+			mockCheckinRepo.EXPECT().CountByInterval(gomock.Any(), event.ID, time.Hour).Return(counts, nil)
+
+			_, err := uc.GetTimeseries(ctx, organizerID, false, event.ID, time.Hour)
+			Expect(apperrors.IsValidation(err)).To(BeTrue())
+		})
+	})
+
+	When("the user does not manage the event", func() {
+		It("should return a forbidden error", func() {
+			_, err := uc.GetTimeseries(ctx, uuid.New(), false, event.ID, 0)
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+})
@@ -1,12 +1,34 @@
 package checkin
 
 import (
+	"errors"
 	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/google/uuid"
 )
 
+var (
+	// ErrParticipantNotFound marks check-ins whose QR code, participant ID or employee ID
+	// matches no participant
+	ErrParticipantNotFound = errors.New("participant not found")
+	// ErrParticipantNotInEvent marks check-ins of a participant of another event
+	ErrParticipantNotInEvent = errors.New("participant belongs to another event")
+	// ErrOutsideCheckinWindow marks check-ins made while the event's check-in window is closed
+	ErrOutsideCheckinWindow = errors.New("check-in window is closed")
+)
+
+// AlreadyCheckedInError is the cause of the conflict returned when the participant has already
+// checked in, naming them so that scanner apps can show who was scanned.
+type AlreadyCheckedInError struct {
+	ParticipantName string
+}
+
+// Error implements error.
+func (e *AlreadyCheckedInError) Error() string {
+	return "participant has already checked in"
+}
+
 // CheckInInput represents input for checking in a participant
 type CheckInInput struct {
 	EventID       uuid.UUID
@@ -16,6 +38,34 @@ type CheckInInput struct {
 	EmployeeID    *string
 	CheckedInBy   uuid.UUID
 	DeviceInfo    map[string]any
+	Source        *string // Client or channel the check-in is made from (e.g. "kiosk"); optional
+	DeviceID      *string // Device or scanning station making the check-in; optional
+}
+
+// CheckOutInput represents input for checking out a participant; exactly one of QRCode and
+// ParticipantID is set
+type CheckOutInput struct {
+	EventID       uuid.UUID
+	QRCode        *string
+	ParticipantID *uuid.UUID
+}
+
+// BulkCheckInInput represents input for manually checking in several participants at once
+type BulkCheckInInput struct {
+	EventID        uuid.UUID
+	ParticipantIDs []uuid.UUID
+	CheckedInBy    uuid.UUID
+}
+
+// WalkInInput represents input for registering and checking in a walk-in participant
+type WalkInInput struct {
+	EventID     uuid.UUID
+	Name        string
+	Email       string // Optional; empty when the walk-in gives no email
+	CheckedInBy uuid.UUID
+	DeviceInfo  map[string]any
+	// ConsentAccepted reports that the walk-in accepted the event's consent terms on site
+	ConsentAccepted bool
 }
 
 // CheckInOutput represents output after checking in
@@ -26,9 +76,25 @@ type CheckInOutput struct {
 	ParticipantName       string
 	ParticipantEmail      string
 	ParticipantEmployeeID *string
+	ParticipantWalkIn     bool
 	CheckedInAt           time.Time
 	CheckedInBy           *uuid.UUID
 	Method                entity.CheckinMethod
+	Source                *string
+	DeviceID              *string
+}
+
+// BulkCheckInOutput represents the outcome of a bulk check-in
+type BulkCheckInOutput struct {
+	CheckedInCount int
+	SkippedCount   int                // Participants who had already checked in
+	Errors         []BulkCheckInError // Participants who could not be checked in, in request order
+}
+
+// BulkCheckInError represents a participant a bulk check-in could not check in
+type BulkCheckInError struct {
+	ParticipantID uuid.UUID
+	Reason        string
 }
 
 // CheckInStatusOutput represents check-in status for a participant
@@ -42,6 +108,38 @@ type CheckInStatusOutput struct {
 	CheckIn          *CheckInOutput
 }
 
+// PublicCheckInStatusOutput is the check-in status shown to the participant themselves. It must not
+// carry personal data beyond the participant's name.
+type PublicCheckInStatusOutput struct {
+	EventName       string
+	ParticipantName string
+	IsCheckedIn     bool
+}
+
+// ProgressOutput represents the live check-in counter for an event
+type ProgressOutput struct {
+	EventID    uuid.UUID
+	CheckedIn  int64
+	Total      int64
+	Percentage float64   // Share of participants checked in (0.0 - 100.0), rounded to one decimal
+	UpdatedAt  time.Time // When the counts were taken; may lag by up to progressCacheTTL
+}
+
+// StaffAttributionOutput represents an event's check-ins broken down by checking-in staff
+type StaffAttributionOutput struct {
+	EventID      uuid.UUID
+	Staff        []StaffCheckinCount // Ordered by check-in count, highest first
+	SelfCheckins int64               // Check-ins without a checking-in user, e.g. QR self-service
+	Total        int64
+}
+
+// StaffCheckinCount represents the check-ins recorded by one staff member
+type StaffCheckinCount struct {
+	UserID       uuid.UUID
+	Name         string
+	CheckinCount int64
+}
+
 // ListCheckInsInput represents input for listing check-ins
 type ListCheckInsInput struct {
 	EventID uuid.UUID
@@ -56,3 +154,48 @@ type ListCheckInsOutput struct {
 	CheckIns   []*CheckInOutput
 	TotalCount int64
 }
+
+// ScanAnalyticsOutput summarizes an event's QR scans by outcome
+type ScanAnalyticsOutput struct {
+	EventID  uuid.UUID
+	Bucket   time.Duration // Size of the timeline buckets
+	Totals   ScanOutcomeCounts
+	Timeline []ScanBucket // Buckets with at least one scan, oldest first
+}
+
+// ScanBucket is the scans of one timeline bucket
+type ScanBucket struct {
+	Start  time.Time
+	Counts ScanOutcomeCounts
+}
+
+// TimeseriesOutput counts an event's check-ins over time
+type TimeseriesOutput struct {
+	EventID  uuid.UUID
+	Interval time.Duration // Size of the buckets
+	Total    int64
+	Buckets  []TimeseriesBucket // Every bucket of the series, oldest first
+	ByDevice []TimeseriesDevice // Devices that made check-ins, by device ID; check-ins without one last
+}
+
+// TimeseriesDevice is the check-ins one device made over the time series
+type TimeseriesDevice struct {
+	DeviceID *string // nil for check-ins without a device ID
+	Total    int64
+	Buckets  []TimeseriesBucket // Every bucket of the series, like TimeseriesOutput.Buckets
+}
+
+// TimeseriesBucket is the check-ins of one time series bucket
+type TimeseriesBucket struct {
+	Start time.Time
+	Count int64
+}
+
+// ScanOutcomeCounts is the number of scans per outcome
+type ScanOutcomeCounts struct {
+	Success   int64
+	Duplicate int64
+	NotFound  int64
+	Invalid   int64
+	Total     int64
+}
@@ -2,8 +2,14 @@ package checkin
 
 import (
 	"context"
+	"sync"
+	"time"
 
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
+	"github.com/fumkob/ezqrin-server/internal/usecase/qrtoken"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
 	"github.com/google/uuid"
 )
 
@@ -17,12 +23,31 @@ type Usecase interface {
 		isAdmin bool,
 		input CheckInInput,
 	) (*CheckInOutput, error)
+	CheckInWalkIn(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		input WalkInInput,
+	) (*CheckInOutput, error)
+	BulkCheckIn(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		input BulkCheckInInput,
+	) (*BulkCheckInOutput, error)
 	GetStatus(
 		ctx context.Context,
 		userID uuid.UUID,
 		isAdmin bool,
 		participantID uuid.UUID,
 	) (*CheckInStatusOutput, error)
+	GetPublicStatus(ctx context.Context, token string) (*PublicCheckInStatusOutput, error)
+	GetHistory(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		participantID uuid.UUID,
+	) ([]*entity.Checkin, error)
 	List(
 		ctx context.Context,
 		userID uuid.UUID,
@@ -35,6 +60,52 @@ type Usecase interface {
 		isAdmin bool,
 		checkinID uuid.UUID,
 	) error
+	CheckOut(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		input CheckOutInput,
+	) error
+	Restore(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		eventID uuid.UUID,
+		checkinID uuid.UUID,
+	) (*CheckInOutput, error)
+	StreamCheckIns(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		eventID uuid.UUID,
+	) (*CheckInStream, error)
+	GetProgress(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		eventID uuid.UUID,
+	) (*ProgressOutput, error)
+	GetStaffAttribution(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		eventID uuid.UUID,
+	) (*StaffAttributionOutput, error)
+	GetScanAnalytics(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		eventID uuid.UUID,
+		bucket time.Duration,
+	) (*ScanAnalyticsOutput, error)
+	GetTimeseries(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		eventID uuid.UUID,
+		interval time.Duration,
+	) (*TimeseriesOutput, error)
+	WaitForScans(ctx context.Context) error
 }
 
 var _ Usecase = (*checkinUsecase)(nil)
@@ -43,20 +114,64 @@ type checkinUsecase struct {
 	checkinRepo     repository.CheckinRepository
 	participantRepo repository.ParticipantRepository
 	eventRepo       repository.EventRepository
+	outboxRepo      repository.OutboxRepository
+	transactor      repository.Transactor
+	cacheRepo       repository.CacheRepository
+	pubSub          repository.PubSubRepository
 	qrHMACSecret    string
+	qrTokenTTL      time.Duration
+	qrTokens        *qrtoken.Issuer
+	undoWindow      time.Duration
+	debounceWindow  time.Duration
+	windowMargin    time.Duration
+	auditor         *audit.Recorder
+	logger          *logger.Logger
+	scans           sync.WaitGroup
 }
 
-// NewUsecase creates a new check-in usecase instance
+// NewUsecase creates a new check-in usecase instance.
+// Check-ins are recorded together with a checkin.created outbox message in one transaction.
+// cacheRepo may be nil, in which case check-in progress is always counted from the database.
+// Created check-ins are published through pubSub for live streams; without it streams are unavailable.
+// Cancelled check-ins can be restored for undoWindow after the cancellation; zero disables restoring.
+// Repeat check-ins within debounceWindow of the participant's check-in answer with that check-in
+// instead of a conflict; zero disables debouncing.
+// Check-ins are accepted from windowMargin before an event starts until windowMargin after it ends;
+// zero accepts them at any time.
+// Signed QR tokens are accepted for qrTokenTTL after they were issued; zero accepts them regardless of age.
+// auditor records check-ins, their cancellation and restoring in the audit log; it may be nil.
 func NewUsecase(
 	checkinRepo repository.CheckinRepository,
 	participantRepo repository.ParticipantRepository,
 	eventRepo repository.EventRepository,
+	outboxRepo repository.OutboxRepository,
+	transactor repository.Transactor,
+	cacheRepo repository.CacheRepository,
+	pubSub repository.PubSubRepository,
 	qrHMACSecret string,
+	qrTokenTTL time.Duration,
+	qrTokens *qrtoken.Issuer,
+	undoWindow time.Duration,
+	debounceWindow time.Duration,
+	windowMargin time.Duration,
+	auditor *audit.Recorder,
+	logger *logger.Logger,
 ) Usecase {
 	return &checkinUsecase{
 		checkinRepo:     checkinRepo,
 		participantRepo: participantRepo,
 		eventRepo:       eventRepo,
+		outboxRepo:      outboxRepo,
+		transactor:      transactor,
+		cacheRepo:       cacheRepo,
+		pubSub:          pubSub,
 		qrHMACSecret:    qrHMACSecret,
+		qrTokenTTL:      qrTokenTTL,
+		qrTokens:        qrTokens,
+		undoWindow:      undoWindow,
+		debounceWindow:  debounceWindow,
+		windowMargin:    windowMargin,
+		auditor:         auditor,
+		logger:          logger,
 	}
 }
@@ -0,0 +1,127 @@
+package checkin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// CheckInWalkIn registers a participant who arrived without registering and checks them in.
+// The participant is created confirmed and flagged as a walk-in; the participant, the check-in
+// and its outbox message are written in one transaction so a failed check-in leaves no participant.
+// A walk-in takes one of the places of an event with a capacity, so walk-ins are rejected with a
+// conflict once the event is full; they are never waitlisted since they are already at the door.
+func (u *checkinUsecase) CheckInWalkIn(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input WalkInInput,
+) (*CheckInOutput, error) {
+	event, err := u.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only, as for manual check-in
+	if err := authz.RequireEventManager(userID, event, isAdmin, "check in walk-ins for this event"); err != nil {
+		return nil, err
+	}
+
+	if event.RequiresConsent && !input.ConsentAccepted {
+		return nil, apperrors.Unprocessable("cannot check in: this event requires the walk-in to accept its consent terms")
+	}
+
+	participant, err := u.newWalkInParticipant(ctx, event, input)
+	if err != nil {
+		return nil, err
+	}
+
+	checkin, err := u.createCheckinRecord(CheckInInput{
+		EventID:     input.EventID,
+		Method:      entity.CheckinMethodManual,
+		CheckedInBy: input.CheckedInBy,
+		DeviceInfo:  input.DeviceInfo,
+	}, participant.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.transactor.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.createWalkInParticipant(txCtx, event, participant); err != nil {
+			return err
+		}
+		if err := u.checkinRepo.Create(txCtx, checkin); err != nil {
+			return u.handleCheckinCreateError(err, participant)
+		}
+		return u.enqueueCheckinCreated(txCtx, checkin)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.invalidateProgress(ctx, input.EventID)
+	u.auditor.Record(ctx, userID, entity.AuditActionCreate, entity.AuditResourceParticipant, participant.ID,
+		nil, participant.AuditFields())
+	u.auditor.Record(ctx, userID, entity.AuditActionCreate, entity.AuditResourceCheckin, checkin.ID,
+		nil, checkin.AuditFields())
+
+	output := u.buildCheckInOutput(checkin, participant)
+	u.publishCheckinCreated(ctx, output)
+	return output, nil
+}
+
+// newWalkInParticipant builds a confirmed walk-in participant with a QR code, so the walk-in
+// can re-enter with the same code, and a payment defaulted from the event's fee model.
+func (u *checkinUsecase) newWalkInParticipant(
+	ctx context.Context,
+	event *entity.Event,
+	input WalkInInput,
+) (*entity.Participant, error) {
+	participantID := uuid.New()
+	qrToken, err := u.qrTokens.Issue(ctx, event.ID, participantID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	participant := &entity.Participant{
+		ID:                participantID,
+		EventID:           event.ID,
+		Name:              input.Name,
+		Email:             input.Email,
+		Status:            entity.ParticipantStatusConfirmed,
+		WalkIn:            true,
+		QRCode:            qrToken,
+		QRCodeGeneratedAt: now,
+		PaymentStatus:     entity.PaymentUnpaid,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+	if event.RequiresConsent {
+		participant.AcceptConsent(event.ConsentVersion, now)
+	}
+	if err := participant.ApplyEventFee(event, nil); err != nil {
+		return nil, apperrors.Validation(fmt.Sprintf("walk-in validation failed: %v", err))
+	}
+	if err := participant.Validate(); err != nil {
+		return nil, apperrors.Validation(fmt.Sprintf("walk-in validation failed: %v", err))
+	}
+
+	return participant, nil
+}
+
+// createWalkInParticipant saves a walk-in participant, within the capacity of events that have one.
+func (u *checkinUsecase) createWalkInParticipant(
+	ctx context.Context,
+	event *entity.Event,
+	participant *entity.Participant,
+) error {
+	if event.HasCapacity() {
+		return u.participantRepo.CreateWithinCapacity(ctx, participant)
+	}
+	return u.participantRepo.Create(ctx, participant)
+}
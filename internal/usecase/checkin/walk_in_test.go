@@ -0,0 +1,197 @@
+package checkin_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/checkin"
+	"github.com/fumkob/ezqrin-server/internal/usecase/qrtoken"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("CheckInWalkIn", func() {
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		uc              checkin.Usecase
+		mockCheckinRepo *mocks.MockCheckinRepository
+		mockParticipant *mocks.MockParticipantRepository
+		mockEventRepo   *mocks.MockEventRepository
+		mockOutboxRepo  *mocks.MockOutboxRepository
+		organizerID     uuid.UUID
+		eventID         uuid.UUID
+		input           checkin.WalkInInput
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		organizerID = uuid.New()
+		eventID = uuid.New()
+
+		mockCheckinRepo = mocks.NewMockCheckinRepository(ctrl)
+		mockParticipant = mocks.NewMockParticipantRepository(ctrl)
+		mockEventRepo = mocks.NewMockEventRepository(ctrl)
+		mockOutboxRepo = mocks.NewMockOutboxRepository(ctrl)
+		mockTransactor := mocks.NewMockTransactor(ctrl)
+		mockTransactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+		).AnyTimes()
+
+		mockParticipant.EXPECT().ExistingQRCodes(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		generator, err := crypto.NewTokenGenerator(crypto.QRTokenStrategyRandom, testQRHMACSecret, 6)
+		Expect(err).NotTo(HaveOccurred())
+
+		uc = checkin.NewUsecase(
+			mockCheckinRepo, mockParticipant, mockEventRepo, mockOutboxRepo, mockTransactor, nil, nil,
+			testQRHMACSecret, 0, qrtoken.NewIssuer(generator, mockParticipant, 3), testUndoWindow, 0, 0, nil, testLogger,
+		)
+
+		mockEventRepo.EXPECT().FindByID(gomock.Any(), eventID).
+			Return(&entity.Event{ID: eventID, OrganizerID: organizerID}, nil).AnyTimes()
+
+		input = checkin.WalkInInput{
+			EventID:     eventID,
+			Name:        "Walk-in Guest",
+			CheckedInBy: organizerID,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	When("the event manager checks in a walk-in", func() {
+		Context("without an email", func() {
+			It("should create a confirmed walk-in participant and check them in", func() {
+				var created *entity.Participant
+				mockParticipant.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, p *entity.Participant) error {
+						created = p
+						return nil
+					},
+				)
+				mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, c *entity.Checkin) error {
+						Expect(c.ParticipantID).To(Equal(created.ID))
+						Expect(c.Method).To(Equal(entity.CheckinMethodManual))
+						Expect(*c.CheckedInBy).To(Equal(organizerID))
+						return nil
+					},
+				)
+				mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
+
+				output, err := uc.CheckInWalkIn(ctx, organizerID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(created.WalkIn).To(BeTrue())
+				Expect(created.Status).To(Equal(entity.ParticipantStatusConfirmed))
+				Expect(created.Email).To(BeEmpty())
+				Expect(created.QRCode).NotTo(BeEmpty())
+				Expect(output.ParticipantID).To(Equal(created.ID))
+				Expect(output.ParticipantName).To(Equal("Walk-in Guest"))
+				Expect(output.ParticipantWalkIn).To(BeTrue())
+			})
+		})
+
+		Context("with an email already registered for the event", func() {
+			It("should return the conflict without checking in", func() {
+				input.Email = "guest@example.com"
+				mockParticipant.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(apperrors.Conflict("participant with this email already exists for this event"))
+
+				_, err := uc.CheckInWalkIn(ctx, organizerID, false, input)
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the event is at capacity", func() {
+			It("should reject the walk-in with a conflict without checking in", func() {
+				fullEventID := uuid.New()
+				input.EventID = fullEventID
+				mockEventRepo.EXPECT().FindByID(gomock.Any(), fullEventID).
+					Return(&entity.Event{ID: fullEventID, OrganizerID: organizerID, Capacity: 1}, nil)
+				mockParticipant.EXPECT().CreateWithinCapacity(gomock.Any(), gomock.Any()).
+					Return(apperrors.Conflict("event is at capacity"))
+
+				_, err := uc.CheckInWalkIn(ctx, organizerID, false, input)
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+		})
+
+		Context("when recording the check-in fails", func() {
+			It("should return the error so the participant is rolled back", func() {
+				mockParticipant.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+				mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(errors.New("db down"))
+
+				_, err := uc.CheckInWalkIn(ctx, organizerID, false, input)
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	When("the event requires consent", func() {
+		BeforeEach(func() {
+			input.EventID = uuid.New()
+			mockEventRepo.EXPECT().FindByID(gomock.Any(), input.EventID).Return(&entity.Event{
+				ID:              input.EventID,
+				OrganizerID:     organizerID,
+				RequiresConsent: true,
+				ConsentVersion:  "2026-01",
+			}, nil)
+		})
+
+		It("should reject a walk-in without consent as unprocessable", func() {
+			_, err := uc.CheckInWalkIn(ctx, organizerID, false, input)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeUnprocessable))
+		})
+
+		It("should stamp the consent version on a walk-in who accepted the terms", func() {
+			input.ConsentAccepted = true
+			var created *entity.Participant
+			mockParticipant.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, p *entity.Participant) error {
+					created = p
+					return nil
+				},
+			)
+			mockCheckinRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Return(nil)
+			mockOutboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).Return(nil)
+
+			_, err := uc.CheckInWalkIn(ctx, organizerID, false, input)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.HasConsent()).To(BeTrue())
+			Expect(created.ConsentVersion).To(Equal("2026-01"))
+		})
+	})
+
+	When("the walk-in has no name", func() {
+		It("should return a validation error", func() {
+			input.Name = ""
+
+			_, err := uc.CheckInWalkIn(ctx, organizerID, false, input)
+
+			Expect(apperrors.IsValidation(err)).To(BeTrue())
+		})
+	})
+
+	When("the user does not manage the event", func() {
+		It("should return forbidden", func() {
+			_, err := uc.CheckInWalkIn(ctx, uuid.New(), false, input)
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,82 @@
+package event
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/google/uuid"
+)
+
+// Clone copies an event into a new draft event owned by the user, applying overrides.
+// Only the event's own settings are copied: its participants, check-ins and staff are not,
+// the copy does not join the source's recurring series and it starts without a legal hold.
+func (u *eventUsecase) Clone(
+	ctx context.Context,
+	sourceID uuid.UUID,
+	userID uuid.UUID,
+	isAdmin bool,
+	overrides CloneOverrides,
+) (*entity.Event, error) {
+	source, err := u.eventRepo.FindByID(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.RequireEventManager(userID, source, isAdmin, "clone this event"); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	clone := &entity.Event{
+		ID:          uuid.New(),
+		OrganizerID: userID,
+		Name:        source.Name,
+		Description: source.Description,
+		StartDate:   source.StartDate,
+		EndDate:     source.EndDate,
+		Location:    source.Location,
+		Timezone:    source.Timezone,
+		Currency:    source.Currency,
+		FeeType:     source.FeeType,
+		FeeAmount:   source.FeeAmount,
+		FeeTiers:    slices.Clone(source.FeeTiers),
+		Status:      entity.StatusDraft,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+
+		RequiresConsent:      source.RequiresConsent,
+		ConsentVersion:       source.ConsentVersion,
+		TentativeExpiryHours: source.TentativeExpiryHours,
+		Capacity:             source.Capacity,
+		CustomFields:         slices.Clone(source.CustomFields),
+	}
+	if source.EndDate != nil {
+		endDate := *source.EndDate
+		clone.EndDate = &endDate
+	}
+	if overrides.Name != nil {
+		clone.Name = *overrides.Name
+	}
+	if overrides.StartDate != nil {
+		if clone.EndDate != nil && overrides.EndDate == nil {
+			endDate := overrides.StartDate.Add(clone.EndDate.Sub(clone.StartDate))
+			clone.EndDate = &endDate
+		}
+		clone.StartDate = *overrides.StartDate
+	}
+	if overrides.EndDate != nil {
+		clone.EndDate = overrides.EndDate
+	}
+
+	if err := clone.Validate(); err != nil {
+		return nil, eventValidationError(err)
+	}
+	if err := u.eventRepo.Create(ctx, clone); err != nil {
+		return nil, err
+	}
+
+	u.auditor.Record(ctx, userID, entity.AuditActionCreate, entity.AuditResourceEvent, clone.ID, nil, clone.AuditFields())
+	return clone, nil
+}
@@ -0,0 +1,148 @@
+package event_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/event"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Clone", func() {
+	var (
+		mockRepo *SimpleEventRepositoryMock
+		usecase  event.Usecase
+		ctx      context.Context
+		ownerID  uuid.UUID
+		source   *entity.Event
+		created  *entity.Event
+	)
+
+	BeforeEach(func() {
+		ownerID = uuid.New()
+		source = newValidEvent(ownerID)
+		source.Status = entity.StatusCompleted
+		source.Currency = "JPY"
+		source.FeeType = entity.FeeTypeTiered
+		source.FeeTiers = []entity.FeeTier{{Name: "Member", Amount: 1000}}
+		source.RequiresConsent = true
+		source.ConsentVersion = "2030-01"
+		source.LegalHold = true
+		source.Capacity = 50
+		source.CustomFields = []entity.CustomField{{Key: "company", Label: "Company", Type: entity.CustomFieldTypeText}}
+		seriesID := uuid.New()
+		source.SeriesID = &seriesID
+		source.ParticipantCount = 12
+		source.CreatedAt = time.Now().Add(-30 * 24 * time.Hour)
+		created = nil
+		mockRepo = &SimpleEventRepositoryMock{
+			findByIDFunc: func(_ context.Context, id uuid.UUID) (*entity.Event, error) {
+				if id != source.ID {
+					return nil, apperrors.NotFound("event not found")
+				}
+				return source, nil
+			},
+			createFunc: func(_ context.Context, e *entity.Event) error {
+				created = e
+				return nil
+			},
+		}
+		usecase = event.NewUsecase(
+			mockRepo, nil, &SimpleOutboxRepositoryMock{}, passthroughTransactor{}, "JPY", event.StatsWarningThresholds{}, 365,
+			nil, nil,
+		)
+		ctx = context.Background()
+	})
+
+	It("should copy the event's settings into a new draft event", func() {
+		clone, err := usecase.Clone(ctx, source.ID, ownerID, false, event.CloneOverrides{})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(created).To(Equal(clone))
+		Expect(clone.ID).NotTo(Equal(source.ID))
+		Expect(clone.Status).To(Equal(entity.StatusDraft))
+		Expect(clone.Name).To(Equal(source.Name))
+		Expect(clone.Location).To(Equal(source.Location))
+		Expect(clone.Timezone).To(Equal(source.Timezone))
+		Expect(clone.StartDate).To(Equal(source.StartDate))
+		Expect(clone.EndDate).To(Equal(source.EndDate))
+		Expect(clone.FeeTiers).To(Equal(source.FeeTiers))
+		Expect(clone.ConsentVersion).To(Equal("2030-01"))
+		Expect(clone.Capacity).To(Equal(50))
+		Expect(clone.CustomFields).To(Equal(source.CustomFields))
+		Expect(clone.CreatedAt).To(BeTemporally(">", source.CreatedAt))
+		Expect(clone.UpdatedAt).To(Equal(clone.CreatedAt))
+		Expect(clone.SeriesID).To(BeNil())
+		Expect(clone.LegalHold).To(BeFalse())
+		Expect(clone.ParticipantCount).To(BeZero())
+	})
+
+	It("should not share the fee tiers or end date with the source", func() {
+		clone, err := usecase.Clone(ctx, source.ID, ownerID, false, event.CloneOverrides{})
+
+		Expect(err).NotTo(HaveOccurred())
+		clone.FeeTiers[0].Amount = 2000
+		*clone.EndDate = clone.EndDate.Add(time.Hour)
+		Expect(source.FeeTiers[0].Amount).To(BeEquivalentTo(1000))
+		Expect(*source.EndDate).NotTo(Equal(*clone.EndDate))
+	})
+
+	It("should give the clone to the caller when an admin clones another organizer's event", func() {
+		adminID := uuid.New()
+
+		clone, err := usecase.Clone(ctx, source.ID, adminID, true, event.CloneOverrides{})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clone.OrganizerID).To(Equal(adminID))
+	})
+
+	It("should apply the overrides", func() {
+		name := "Next Year"
+		start := source.StartDate.AddDate(1, 0, 0)
+		end := start.Add(time.Hour)
+
+		clone, err := usecase.Clone(ctx, source.ID, ownerID, false, event.CloneOverrides{
+			Name: &name, StartDate: &start, EndDate: &end,
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clone.Name).To(Equal("Next Year"))
+		Expect(clone.StartDate).To(Equal(start))
+		Expect(*clone.EndDate).To(Equal(end))
+	})
+
+	It("should keep the duration when only the start date is overridden", func() {
+		start := source.StartDate.AddDate(0, 0, 7)
+
+		clone, err := usecase.Clone(ctx, source.ID, ownerID, false, event.CloneOverrides{StartDate: &start})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(clone.EndDate.Sub(clone.StartDate)).To(Equal(source.EndDate.Sub(source.StartDate)))
+	})
+
+	It("should reject overrides that make the event invalid", func() {
+		end := source.StartDate.Add(-time.Hour)
+
+		_, err := usecase.Clone(ctx, source.ID, ownerID, false, event.CloneOverrides{EndDate: &end})
+
+		Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+		Expect(created).To(BeNil())
+	})
+
+	It("should reject users who do not manage the event", func() {
+		_, err := usecase.Clone(ctx, source.ID, uuid.New(), false, event.CloneOverrides{})
+
+		Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeForbidden))
+		Expect(created).To(BeNil())
+	})
+
+	It("should return not found for an unknown event", func() {
+		_, err := usecase.Clone(ctx, uuid.New(), ownerID, false, event.CloneOverrides{})
+
+		Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeNotFound))
+	})
+})
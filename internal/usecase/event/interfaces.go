@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -17,7 +18,25 @@ type CreateEventInput struct {
 	EndDate     *time.Time
 	Location    string
 	Timezone    string
+	Currency    string // empty uses the configured default currency
+	Fee         *FeeInput
 	Status      entity.EventStatus
+	// RequiresConsent blocks check-in until participants accept the ConsentVersion terms.
+	RequiresConsent bool
+	ConsentVersion  string
+	// TentativeExpiryHours expires participants who stay tentative or invited that long; 0 never does.
+	TentativeExpiryHours int
+	// Capacity waitlists participants registering as confirmed beyond it; 0 is unlimited.
+	Capacity int
+	// Recurrence creates an occurrence of the event for each repetition, linked by a series ID.
+	Recurrence *entity.RecurrenceRule
+}
+
+// FeeInput defines an event's fee model. Amount applies to fixed fees and Tiers to tiered fees.
+type FeeInput struct {
+	Type   entity.FeeType
+	Amount money.Amount
+	Tiers  []entity.FeeTier
 }
 
 // UpdateEventInput defines the input for updating an existing event.
@@ -28,7 +47,33 @@ type UpdateEventInput struct {
 	EndDate     *time.Time
 	Location    *string
 	Timezone    *string
+	Currency    *string
+	Fee         *FeeInput // replaces the whole fee model when set
 	Status      *entity.EventStatus
+	// RequiresConsent and ConsentVersion update the consent requirement when set.
+	RequiresConsent *bool
+	ConsentVersion  *string
+	// LegalHold exempts the event from the retention purge when set. Only admins can change it.
+	LegalHold *bool
+	// TentativeExpiryHours updates the tentative expiry when set; 0 turns it off.
+	TentativeExpiryHours *int
+	// Capacity updates the event capacity when set; 0 removes the limit. It cannot be set below
+	// the confirmed participants.
+	Capacity *int
+}
+
+// CloneOverrides defines the fields of a cloned event that differ from its source.
+// Unset fields are copied; overriding only StartDate moves EndDate along, keeping the duration.
+type CloneOverrides struct {
+	Name      *string
+	StartDate *time.Time
+	EndDate   *time.Time
+}
+
+// WebhookInput defines the webhook that receives an event's check-in and registration notifications.
+type WebhookInput struct {
+	URL    string
+	Secret string
 }
 
 // ListEventsInput defines the input for listing events.
@@ -36,16 +81,25 @@ type ListEventsInput struct {
 	OrganizerID *uuid.UUID
 	Status      *entity.EventStatus
 	Search      string
-	Page        int
-	PerPage     int
-	Sort        string
-	Order       string
+	// StartDateFrom and StartDateTo select events starting within the range, both inclusive.
+	StartDateFrom *time.Time
+	StartDateTo   *time.Time
+	// IncludeDeleted lists soft deleted events along with live ones.
+	IncludeDeleted bool
+	Page           int
+	PerPage        int
+	Sort           string
+	Order          string
+	// Cursor switches to keyset pagination when set: "" starts from the first event, and a
+	// NextCursor from a previous page continues after it. Page is ignored.
+	Cursor *string
 }
 
 // ListEventsOutput defines the output for listing events.
 type ListEventsOutput struct {
 	Events     []*entity.Event
-	TotalCount int64
+	TotalCount int64 // Not counted when paging by cursor
+	NextCursor string
 }
 
 // EventStatsOutput defines the output for event statistics.
@@ -53,8 +107,43 @@ type EventStatsOutput struct {
 	EventID               uuid.UUID
 	TotalParticipants     int64
 	CheckedInParticipants int64
+	WalkInParticipants    int64
+	GuestParticipants     int64
 	CheckinRate           float64
 	ByStatus              map[string]int64
+	ByGroup               map[string]GroupStatsOutput // By the group participants are seated at
+	TotalPaymentAmount    money.Amount
+	Currency              string
+	SpotsRemaining        *int64   // Places left for confirmed participants; nil without a capacity limit
+	Warnings              []string // Threshold alerts derived from the stats; empty when none apply
+}
+
+// GroupStatsOutput defines the statistics of one group of an event's participants.
+type GroupStatsOutput struct {
+	TotalParticipants     int64
+	CheckedInParticipants int64
+}
+
+// MaxBatchStatsEvents caps the number of events whose stats can be requested at once.
+const MaxBatchStatsEvents = 100
+
+// BatchStatsOutput defines the output for batched event statistics.
+type BatchStatsOutput struct {
+	Stats map[uuid.UUID]EventStatsOutput
+	// Inaccessible lists the requested IDs that do not exist or whose stats the user may not view,
+	// in request order. Both cases are reported alike so that event IDs cannot be probed.
+	Inaccessible []uuid.UUID
+}
+
+// StatsWarningThresholds configures when GetStats reports warnings. A zero threshold disables its warning.
+type StatsWarningThresholds struct {
+	// NoShowRate warns when more than this fraction of a past event's participants never checked in.
+	NoShowRate float64
+	// LowCheckinRate warns when less than this fraction of an ongoing event's participants have checked in.
+	LowCheckinRate float64
+	// Capacity warns when confirmed participants fill at least this fraction of the capacity of an
+	// event that is not over yet. Events without a capacity never warn.
+	Capacity float64
 }
 
 //go:generate mockgen -destination=mocks/mock_usecase.go -package=mocks . Usecase
@@ -64,6 +153,7 @@ type Usecase interface {
 	Create(ctx context.Context, input CreateEventInput) (*entity.Event, error)
 	GetByID(ctx context.Context, id uuid.UUID) (*entity.Event, error)
 	List(ctx context.Context, input ListEventsInput) (ListEventsOutput, error)
+	GetListLastModified(ctx context.Context, input ListEventsInput) (time.Time, error)
 	Update(
 		ctx context.Context,
 		id uuid.UUID,
@@ -72,5 +162,35 @@ type Usecase interface {
 		input UpdateEventInput,
 	) (*entity.Event, error)
 	Delete(ctx context.Context, id uuid.UUID, organizerID uuid.UUID, isAdmin bool) error
+	Restore(ctx context.Context, id uuid.UUID, organizerID uuid.UUID, isAdmin bool) (*entity.Event, error)
 	GetStats(ctx context.Context, id uuid.UUID, organizerID uuid.UUID, isAdmin bool) (EventStatsOutput, error)
+	GetStatsBatch(ctx context.Context, ids []uuid.UUID, organizerID uuid.UUID, isAdmin bool) (BatchStatsOutput, error)
+	SpotsRemaining(ctx context.Context, event *entity.Event) (*int64, error)
+	ListOccurrences(ctx context.Context, id uuid.UUID, organizerID uuid.UUID, isAdmin bool) ([]*entity.Event, error)
+	CancelSeries(ctx context.Context, id uuid.UUID, organizerID uuid.UUID, isAdmin bool) ([]*entity.Event, error)
+	Clone(
+		ctx context.Context,
+		sourceID uuid.UUID,
+		userID uuid.UUID,
+		isAdmin bool,
+		overrides CloneOverrides,
+	) (*entity.Event, error)
+	UpdateParticipantFields(
+		ctx context.Context,
+		id uuid.UUID,
+		userID uuid.UUID,
+		isAdmin bool,
+		fields []entity.CustomField,
+	) (*entity.Event, error)
+	UpdateLogo(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool, logo []byte) error
+	DeleteLogo(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool) error
+	SetWebhook(
+		ctx context.Context,
+		id uuid.UUID,
+		userID uuid.UUID,
+		isAdmin bool,
+		input WebhookInput,
+	) (*entity.EventWebhook, error)
+	GetWebhook(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool) (*entity.EventWebhook, error)
+	DeleteWebhook(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool) error
 }
@@ -0,0 +1,76 @@
+package event
+
+import (
+	"bytes"
+	"context"
+	"image"
+	_ "image/jpeg" // register the JPEG decoder for logo uploads
+	_ "image/png"  // register the PNG decoder for logo uploads
+
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// Logo limits
+const (
+	// MaxLogoBytes is the largest logo image accepted.
+	MaxLogoBytes = 1 << 20
+	// MaxLogoDimension is the largest width or height of a logo in pixels.
+	MaxLogoDimension = 2048
+)
+
+// UpdateLogo replaces the logo overlaid on the PNG QR codes of an event's participants.
+// The logo must be a PNG or JPEG image of at most 1 MiB and 2048x2048 pixels.
+func (u *eventUsecase) UpdateLogo(
+	ctx context.Context,
+	id uuid.UUID,
+	userID uuid.UUID,
+	isAdmin bool,
+	logo []byte,
+) error {
+	event, err := u.eventRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := authz.RequireEventManager(userID, event, isAdmin, "update this event"); err != nil {
+		return err
+	}
+
+	if err := validateLogo(logo); err != nil {
+		return err
+	}
+	return u.eventRepo.UpdateLogo(ctx, id, logo)
+}
+
+// DeleteLogo removes the logo of an event, so its QR codes are generated without one again.
+func (u *eventUsecase) DeleteLogo(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool) error {
+	event, err := u.eventRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err := authz.RequireEventManager(userID, event, isAdmin, "update this event"); err != nil {
+		return err
+	}
+
+	return u.eventRepo.UpdateLogo(ctx, id, nil)
+}
+
+// validateLogo checks the size, format and dimensions of a logo image without decoding it fully.
+func validateLogo(logo []byte) error {
+	if len(logo) == 0 {
+		return apperrors.BadRequest("logo image is required")
+	}
+	if len(logo) > MaxLogoBytes {
+		return apperrors.BadRequest("logo image too large (max 1MB)")
+	}
+
+	config, format, err := image.DecodeConfig(bytes.NewReader(logo))
+	if err != nil || (format != "png" && format != "jpeg") {
+		return apperrors.BadRequest("logo must be a PNG or JPEG image")
+	}
+	if config.Width > MaxLogoDimension || config.Height > MaxLogoDimension {
+		return apperrors.BadRequest("logo image must not exceed 2048x2048 pixels")
+	}
+	return nil
+}
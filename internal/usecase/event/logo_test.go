@@ -0,0 +1,105 @@
+package event_test
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/event"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event logo", func() {
+	var (
+		mockRepo *SimpleEventRepositoryMock
+		usecase  event.Usecase
+		ctx      context.Context
+		ownerID  uuid.UUID
+		existing *entity.Event
+		stored   []byte
+		updated  bool
+	)
+
+	// pngImage encodes a blank PNG image of the given dimensions.
+	pngImage := func(width, height int) []byte {
+		var buf bytes.Buffer
+		Expect(png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, width, height)))).To(Succeed())
+		return buf.Bytes()
+	}
+
+	BeforeEach(func() {
+		ownerID = uuid.New()
+		existing = newValidEvent(ownerID)
+		stored, updated = nil, false
+		mockRepo = &SimpleEventRepositoryMock{
+			findByIDFunc: func(_ context.Context, _ uuid.UUID) (*entity.Event, error) {
+				return existing, nil
+			},
+			updateLogoFunc: func(_ context.Context, _ uuid.UUID, logo []byte) error {
+				stored, updated = logo, true
+				return nil
+			},
+		}
+		usecase = event.NewUsecase(
+			mockRepo, nil, &SimpleOutboxRepositoryMock{}, passthroughTransactor{}, "JPY", event.StatsWarningThresholds{}, 365,
+			nil, nil,
+		)
+		ctx = context.Background()
+	})
+
+	Describe("UpdateLogo", func() {
+		It("should store a PNG logo", func() {
+			logo := pngImage(64, 32)
+
+			Expect(usecase.UpdateLogo(ctx, existing.ID, ownerID, false, logo)).To(Succeed())
+			Expect(stored).To(Equal(logo))
+		})
+
+		It("should reject data that is not an image", func() {
+			err := usecase.UpdateLogo(ctx, existing.ID, ownerID, false, []byte("GIF89a not really"))
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+			Expect(updated).To(BeFalse())
+		})
+
+		It("should reject a logo larger than 2048 pixels", func() {
+			err := usecase.UpdateLogo(ctx, existing.ID, ownerID, false, pngImage(event.MaxLogoDimension+1, 10))
+
+			Expect(err).To(MatchError(ContainSubstring("must not exceed 2048x2048 pixels")))
+			Expect(updated).To(BeFalse())
+		})
+
+		It("should reject an empty logo", func() {
+			err := usecase.UpdateLogo(ctx, existing.ID, ownerID, false, nil)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+
+		It("should reject users who do not manage the event", func() {
+			err := usecase.UpdateLogo(ctx, existing.ID, uuid.New(), false, pngImage(10, 10))
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			Expect(updated).To(BeFalse())
+		})
+	})
+
+	Describe("DeleteLogo", func() {
+		It("should remove the logo", func() {
+			Expect(usecase.DeleteLogo(ctx, existing.ID, ownerID, false)).To(Succeed())
+			Expect(updated).To(BeTrue())
+			Expect(stored).To(BeNil())
+		})
+
+		It("should reject users who do not manage the event", func() {
+			err := usecase.DeleteLogo(ctx, existing.ID, uuid.New(), false)
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			Expect(updated).To(BeFalse())
+		})
+	})
+})
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/fumkob/ezqrin-server/internal/domain/entity"
 	event "github.com/fumkob/ezqrin-server/internal/usecase/event"
@@ -43,6 +44,36 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// CancelSeries mocks base method.
+func (m *MockUsecase) CancelSeries(ctx context.Context, id, organizerID uuid.UUID, isAdmin bool) ([]*entity.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelSeries", ctx, id, organizerID, isAdmin)
+	ret0, _ := ret[0].([]*entity.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelSeries indicates an expected call of CancelSeries.
+func (mr *MockUsecaseMockRecorder) CancelSeries(ctx, id, organizerID, isAdmin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelSeries", reflect.TypeOf((*MockUsecase)(nil).CancelSeries), ctx, id, organizerID, isAdmin)
+}
+
+// Clone mocks base method.
+func (m *MockUsecase) Clone(ctx context.Context, sourceID, userID uuid.UUID, isAdmin bool, overrides event.CloneOverrides) (*entity.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone", ctx, sourceID, userID, isAdmin, overrides)
+	ret0, _ := ret[0].(*entity.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockUsecaseMockRecorder) Clone(ctx, sourceID, userID, isAdmin, overrides any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockUsecase)(nil).Clone), ctx, sourceID, userID, isAdmin, overrides)
+}
+
 // Create mocks base method.
 func (m *MockUsecase) Create(ctx context.Context, input event.CreateEventInput) (*entity.Event, error) {
 	m.ctrl.T.Helper()
@@ -72,6 +103,34 @@ func (mr *MockUsecaseMockRecorder) Delete(ctx, id, organizerID, isAdmin any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockUsecase)(nil).Delete), ctx, id, organizerID, isAdmin)
 }
 
+// DeleteLogo mocks base method.
+func (m *MockUsecase) DeleteLogo(ctx context.Context, id, userID uuid.UUID, isAdmin bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLogo", ctx, id, userID, isAdmin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteLogo indicates an expected call of DeleteLogo.
+func (mr *MockUsecaseMockRecorder) DeleteLogo(ctx, id, userID, isAdmin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLogo", reflect.TypeOf((*MockUsecase)(nil).DeleteLogo), ctx, id, userID, isAdmin)
+}
+
+// DeleteWebhook mocks base method.
+func (m *MockUsecase) DeleteWebhook(ctx context.Context, id, userID uuid.UUID, isAdmin bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhook", ctx, id, userID, isAdmin)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteWebhook indicates an expected call of DeleteWebhook.
+func (mr *MockUsecaseMockRecorder) DeleteWebhook(ctx, id, userID, isAdmin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhook", reflect.TypeOf((*MockUsecase)(nil).DeleteWebhook), ctx, id, userID, isAdmin)
+}
+
 // GetByID mocks base method.
 func (m *MockUsecase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +146,21 @@ func (mr *MockUsecaseMockRecorder) GetByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUsecase)(nil).GetByID), ctx, id)
 }
 
+// GetListLastModified mocks base method.
+func (m *MockUsecase) GetListLastModified(ctx context.Context, input event.ListEventsInput) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListLastModified", ctx, input)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListLastModified indicates an expected call of GetListLastModified.
+func (mr *MockUsecaseMockRecorder) GetListLastModified(ctx, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListLastModified", reflect.TypeOf((*MockUsecase)(nil).GetListLastModified), ctx, input)
+}
+
 // GetStats mocks base method.
 func (m *MockUsecase) GetStats(ctx context.Context, id, organizerID uuid.UUID, isAdmin bool) (event.EventStatsOutput, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +176,36 @@ func (mr *MockUsecaseMockRecorder) GetStats(ctx, id, organizerID, isAdmin any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockUsecase)(nil).GetStats), ctx, id, organizerID, isAdmin)
 }
 
+// GetStatsBatch mocks base method.
+func (m *MockUsecase) GetStatsBatch(ctx context.Context, ids []uuid.UUID, organizerID uuid.UUID, isAdmin bool) (event.BatchStatsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStatsBatch", ctx, ids, organizerID, isAdmin)
+	ret0, _ := ret[0].(event.BatchStatsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStatsBatch indicates an expected call of GetStatsBatch.
+func (mr *MockUsecaseMockRecorder) GetStatsBatch(ctx, ids, organizerID, isAdmin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStatsBatch", reflect.TypeOf((*MockUsecase)(nil).GetStatsBatch), ctx, ids, organizerID, isAdmin)
+}
+
+// GetWebhook mocks base method.
+func (m *MockUsecase) GetWebhook(ctx context.Context, id, userID uuid.UUID, isAdmin bool) (*entity.EventWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWebhook", ctx, id, userID, isAdmin)
+	ret0, _ := ret[0].(*entity.EventWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetWebhook indicates an expected call of GetWebhook.
+func (mr *MockUsecaseMockRecorder) GetWebhook(ctx, id, userID, isAdmin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhook", reflect.TypeOf((*MockUsecase)(nil).GetWebhook), ctx, id, userID, isAdmin)
+}
+
 // List mocks base method.
 func (m *MockUsecase) List(ctx context.Context, input event.ListEventsInput) (event.ListEventsOutput, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +221,66 @@ func (mr *MockUsecaseMockRecorder) List(ctx, input any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUsecase)(nil).List), ctx, input)
 }
 
+// ListOccurrences mocks base method.
+func (m *MockUsecase) ListOccurrences(ctx context.Context, id, organizerID uuid.UUID, isAdmin bool) ([]*entity.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOccurrences", ctx, id, organizerID, isAdmin)
+	ret0, _ := ret[0].([]*entity.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOccurrences indicates an expected call of ListOccurrences.
+func (mr *MockUsecaseMockRecorder) ListOccurrences(ctx, id, organizerID, isAdmin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOccurrences", reflect.TypeOf((*MockUsecase)(nil).ListOccurrences), ctx, id, organizerID, isAdmin)
+}
+
+// Restore mocks base method.
+func (m *MockUsecase) Restore(ctx context.Context, id, organizerID uuid.UUID, isAdmin bool) (*entity.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id, organizerID, isAdmin)
+	ret0, _ := ret[0].(*entity.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockUsecaseMockRecorder) Restore(ctx, id, organizerID, isAdmin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockUsecase)(nil).Restore), ctx, id, organizerID, isAdmin)
+}
+
+// SetWebhook mocks base method.
+func (m *MockUsecase) SetWebhook(ctx context.Context, id, userID uuid.UUID, isAdmin bool, input event.WebhookInput) (*entity.EventWebhook, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetWebhook", ctx, id, userID, isAdmin, input)
+	ret0, _ := ret[0].(*entity.EventWebhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetWebhook indicates an expected call of SetWebhook.
+func (mr *MockUsecaseMockRecorder) SetWebhook(ctx, id, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWebhook", reflect.TypeOf((*MockUsecase)(nil).SetWebhook), ctx, id, userID, isAdmin, input)
+}
+
+// SpotsRemaining mocks base method.
+func (m *MockUsecase) SpotsRemaining(ctx context.Context, arg1 *entity.Event) (*int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SpotsRemaining", ctx, arg1)
+	ret0, _ := ret[0].(*int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SpotsRemaining indicates an expected call of SpotsRemaining.
+func (mr *MockUsecaseMockRecorder) SpotsRemaining(ctx, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SpotsRemaining", reflect.TypeOf((*MockUsecase)(nil).SpotsRemaining), ctx, arg1)
+}
+
 // Update mocks base method.
 func (m *MockUsecase) Update(ctx context.Context, id, organizerID uuid.UUID, isAdmin bool, input event.UpdateEventInput) (*entity.Event, error) {
 	m.ctrl.T.Helper()
@@ -131,3 +295,32 @@ func (mr *MockUsecaseMockRecorder) Update(ctx, id, organizerID, isAdmin, input a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUsecase)(nil).Update), ctx, id, organizerID, isAdmin, input)
 }
+
+// UpdateLogo mocks base method.
+func (m *MockUsecase) UpdateLogo(ctx context.Context, id, userID uuid.UUID, isAdmin bool, logo []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLogo", ctx, id, userID, isAdmin, logo)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLogo indicates an expected call of UpdateLogo.
+func (mr *MockUsecaseMockRecorder) UpdateLogo(ctx, id, userID, isAdmin, logo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLogo", reflect.TypeOf((*MockUsecase)(nil).UpdateLogo), ctx, id, userID, isAdmin, logo)
+}
+
+// UpdateParticipantFields mocks base method.
+func (m *MockUsecase) UpdateParticipantFields(ctx context.Context, id, userID uuid.UUID, isAdmin bool, fields []entity.CustomField) (*entity.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateParticipantFields", ctx, id, userID, isAdmin, fields)
+	ret0, _ := ret[0].(*entity.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateParticipantFields indicates an expected call of UpdateParticipantFields.
+func (mr *MockUsecaseMockRecorder) UpdateParticipantFields(ctx, id, userID, isAdmin, fields any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateParticipantFields", reflect.TypeOf((*MockUsecase)(nil).UpdateParticipantFields), ctx, id, userID, isAdmin, fields)
+}
@@ -0,0 +1,42 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// UpdateParticipantFields replaces the custom fields an event collects from its participants.
+// The custom data participants already have is kept as is: it is checked against the new
+// fields only when it is next replaced.
+func (u *eventUsecase) UpdateParticipantFields(
+	ctx context.Context,
+	id uuid.UUID,
+	userID uuid.UUID,
+	isAdmin bool,
+	fields []entity.CustomField,
+) (*entity.Event, error) {
+	event, err := u.eventRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.RequireEventManager(userID, event, isAdmin, "update this event"); err != nil {
+		return nil, err
+	}
+
+	if err := entity.ValidateCustomFields(fields); err != nil {
+		return nil, apperrors.Validation(fmt.Sprintf("event validation failed: %v", err))
+	}
+	event.CustomFields = fields
+	event.UpdatedAt = time.Now()
+
+	if err := u.eventRepo.Update(ctx, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,81 @@
+package event_test
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/event"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UpdateParticipantFields", func() {
+	var (
+		mockRepo *SimpleEventRepositoryMock
+		usecase  event.Usecase
+		ctx      context.Context
+		ownerID  uuid.UUID
+		existing *entity.Event
+		updated  *entity.Event
+		fields   []entity.CustomField
+	)
+
+	BeforeEach(func() {
+		ownerID = uuid.New()
+		existing = newValidEvent(ownerID)
+		updated = nil
+		fields = []entity.CustomField{
+			{Key: "company", Label: "Company", Type: entity.CustomFieldTypeText, Required: true},
+			{Key: "tshirt_size", Label: "T-shirt size", Type: entity.CustomFieldTypeSelect, Options: []string{"S", "M"}},
+		}
+		mockRepo = &SimpleEventRepositoryMock{
+			findByIDFunc: func(_ context.Context, _ uuid.UUID) (*entity.Event, error) {
+				return existing, nil
+			},
+			updateFunc: func(_ context.Context, e *entity.Event) error {
+				updated = e
+				return nil
+			},
+		}
+		usecase = event.NewUsecase(
+			mockRepo, nil, &SimpleOutboxRepositoryMock{}, passthroughTransactor{}, "JPY", event.StatsWarningThresholds{}, 365,
+			nil, nil,
+		)
+		ctx = context.Background()
+	})
+
+	It("should replace the event's custom fields", func() {
+		result, err := usecase.UpdateParticipantFields(ctx, existing.ID, ownerID, false, fields)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(updated).To(Equal(result))
+		Expect(result.CustomFields).To(Equal(fields))
+	})
+
+	It("should remove every custom field when given none", func() {
+		existing.CustomFields = fields
+
+		result, err := usecase.UpdateParticipantFields(ctx, existing.ID, ownerID, false, nil)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.CustomFields).To(BeEmpty())
+	})
+
+	It("should reject invalid fields", func() {
+		fields[1].Options = nil
+
+		_, err := usecase.UpdateParticipantFields(ctx, existing.ID, ownerID, false, fields)
+
+		Expect(apperrors.IsValidation(err)).To(BeTrue())
+		Expect(updated).To(BeNil())
+	})
+
+	It("should forbid users who do not manage the event", func() {
+		_, err := usecase.UpdateParticipantFields(ctx, existing.ID, uuid.New(), false, fields)
+
+		Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		Expect(updated).To(BeNil())
+	})
+})
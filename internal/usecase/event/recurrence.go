@@ -0,0 +1,158 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// createSeries creates an occurrence of first for each repetition of rule in one transaction,
+// linked by a new series ID, and returns the first occurrence. Every occurrence keeps the
+// duration of first and starts at the same local time in the event's timezone.
+func (u *eventUsecase) createSeries(
+	ctx context.Context,
+	first *entity.Event,
+	rule entity.RecurrenceRule,
+) (*entity.Event, error) {
+	if err := rule.Validate(first.StartDate); err != nil {
+		return nil, apperrors.Validation(fmt.Sprintf("recurrence validation failed: %v", err))
+	}
+	starts, err := rule.Occurrences(first.StartDate, first.TimezoneLocation(), u.maxOccurrences)
+	if errors.Is(err, entity.ErrRecurrenceTooManyOccurrences) {
+		return nil, apperrors.Validation(fmt.Sprintf(
+			"recurrence validation failed: an event may recur at most %d times", u.maxOccurrences,
+		))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	seriesID := uuid.New()
+	occurrences := make([]*entity.Event, len(starts))
+	for i, start := range starts {
+		occurrence := *first
+		if i > 0 {
+			occurrence.ID = uuid.New()
+		}
+		occurrence.SeriesID = &seriesID
+		occurrence.StartDate = start
+		if first.EndDate != nil {
+			endDate := start.Add(first.EndDate.Sub(first.StartDate))
+			occurrence.EndDate = &endDate
+		}
+		occurrences[i] = &occurrence
+	}
+
+	err = u.transactor.WithTransaction(ctx, func(txCtx context.Context) error {
+		for _, occurrence := range occurrences {
+			if err := u.eventRepo.Create(txCtx, occurrence); err != nil {
+				return err
+			}
+			if occurrence.Status == entity.StatusPublished {
+				if err := u.enqueueEventPublished(txCtx, occurrence); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, occurrence := range occurrences {
+		u.auditor.Record(ctx, occurrence.OrganizerID, entity.AuditActionCreate, entity.AuditResourceEvent,
+			occurrence.ID, nil, occurrence.AuditFields())
+	}
+	return occurrences[0], nil
+}
+
+// ListOccurrences returns the occurrences of the series an event belongs to, ordered by start
+// date. An event that does not recur is its only occurrence.
+func (u *eventUsecase) ListOccurrences(
+	ctx context.Context,
+	id uuid.UUID,
+	organizerID uuid.UUID,
+	isAdmin bool,
+) ([]*entity.Event, error) {
+	event, err := u.eventRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.RequireEventManager(organizerID, event, isAdmin, "view the occurrences of this event"); err != nil {
+		return nil, err
+	}
+
+	if event.SeriesID == nil {
+		return []*entity.Event{event}, nil
+	}
+	return u.eventRepo.FindBySeriesID(ctx, *event.SeriesID)
+}
+
+// CancelSeries cancels every occurrence of the series an event belongs to that has not started
+// yet, i.e. is still draft or published, and returns all occurrences. Ongoing and completed
+// occurrences are left alone; a single occurrence is cancelled by updating its status instead.
+func (u *eventUsecase) CancelSeries(
+	ctx context.Context,
+	id uuid.UUID,
+	organizerID uuid.UUID,
+	isAdmin bool,
+) ([]*entity.Event, error) {
+	event, err := u.eventRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.RequireEventManager(organizerID, event, isAdmin, "cancel this event series"); err != nil {
+		return nil, err
+	}
+	if event.SeriesID == nil {
+		return nil, apperrors.BadRequest("event is not part of a recurring series")
+	}
+
+	// occurrenceChange is a cancelled occurrence and its audited fields before it was cancelled
+	type occurrenceChange struct {
+		occurrence *entity.Event
+		before     entity.AuditFields
+	}
+	var occurrences []*entity.Event
+	var changes []occurrenceChange
+	err = u.transactor.WithTransaction(ctx, func(txCtx context.Context) error {
+		occurrences, err = u.eventRepo.FindBySeriesID(txCtx, *event.SeriesID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		for _, occurrence := range occurrences {
+			if occurrence.Status != entity.StatusDraft && occurrence.Status != entity.StatusPublished {
+				continue
+			}
+			before := occurrence.AuditFields()
+			if err := occurrence.TransitionTo(entity.StatusCancelled); err != nil {
+				return err
+			}
+			occurrence.UpdatedAt = now
+			if err := u.eventRepo.Update(txCtx, occurrence); err != nil {
+				return err
+			}
+			changes = append(changes, occurrenceChange{occurrence: occurrence, before: before})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, change := range changes {
+		u.auditor.Record(ctx, organizerID, entity.AuditActionUpdate, entity.AuditResourceEvent,
+			change.occurrence.ID, change.before, change.occurrence.AuditFields())
+	}
+
+	return occurrences, nil
+}
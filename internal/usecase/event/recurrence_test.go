@@ -0,0 +1,203 @@
+package event_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/event"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Recurring events", func() {
+	var (
+		mockRepo   *SimpleEventRepositoryMock
+		outboxRepo *SimpleOutboxRepositoryMock
+		usecase    event.Usecase
+		ctx        context.Context
+		userID     uuid.UUID
+		created    []*entity.Event
+	)
+
+	BeforeEach(func() {
+		created = nil
+		mockRepo = &SimpleEventRepositoryMock{
+			createFunc: func(_ context.Context, e *entity.Event) error {
+				created = append(created, e)
+				return nil
+			},
+		}
+		outboxRepo = &SimpleOutboxRepositoryMock{}
+		usecase = event.NewUsecase(
+			mockRepo, nil, outboxRepo, passthroughTransactor{}, "JPY", event.StatsWarningThresholds{}, 10, nil, nil,
+		)
+		ctx = context.Background()
+		userID = uuid.New()
+	})
+
+	Describe("Create", func() {
+		var input event.CreateEventInput
+
+		BeforeEach(func() {
+			start := time.Date(2030, 3, 3, 15, 0, 0, 0, time.UTC) // 10:00 in New York
+			end := start.Add(2 * time.Hour)
+			input = event.CreateEventInput{
+				OrganizerID: userID,
+				Name:        "Weekly Meetup",
+				StartDate:   start,
+				EndDate:     &end,
+				Timezone:    "America/New_York",
+				Status:      entity.StatusPublished,
+				Recurrence:  &entity.RecurrenceRule{Frequency: entity.RecurrenceWeekly, Interval: 1, Count: 3},
+			}
+		})
+
+		It("should create an occurrence per repetition linked by a series ID and return the first", func() {
+			first, err := usecase.Create(ctx, input)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(HaveLen(3))
+			Expect(first).To(Equal(created[0]))
+			Expect(first.SeriesID).NotTo(BeNil())
+			for _, occurrence := range created {
+				Expect(occurrence.SeriesID).To(Equal(first.SeriesID))
+				Expect(occurrence.Name).To(Equal("Weekly Meetup"))
+				Expect(occurrence.EndDate.Sub(occurrence.StartDate)).To(Equal(2 * time.Hour))
+			}
+			Expect(created[1].ID).NotTo(Equal(created[0].ID))
+			Expect(outboxRepo.enqueued).To(HaveLen(3))
+		})
+
+		It("should keep the local start time across the daylight saving change", func() {
+			_, err := usecase.Create(ctx, input)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created[1].StartDate).To(Equal(time.Date(2030, 3, 10, 14, 0, 0, 0, time.UTC)))
+			Expect(created[2].StartDate).To(Equal(time.Date(2030, 3, 17, 14, 0, 0, 0, time.UTC)))
+		})
+
+		It("should reject a rule recurring more often than the configured maximum", func() {
+			input.Recurrence.Count = 11
+
+			_, err := usecase.Create(ctx, input)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+			Expect(err.Error()).To(ContainSubstring("at most 10 times"))
+			Expect(created).To(BeEmpty())
+		})
+
+		It("should reject an invalid rule", func() {
+			input.Recurrence.Interval = 0
+
+			_, err := usecase.Create(ctx, input)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+			Expect(created).To(BeEmpty())
+		})
+
+		It("should create a single event without a series when there is no rule", func() {
+			input.Recurrence = nil
+
+			created, err := usecase.Create(ctx, input)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created.SeriesID).To(BeNil())
+		})
+	})
+
+	Describe("series", func() {
+		var (
+			seriesID    uuid.UUID
+			occurrences []*entity.Event
+		)
+
+		BeforeEach(func() {
+			seriesID = uuid.New()
+			occurrences = nil
+			for _, status := range []entity.EventStatus{
+				entity.StatusCompleted, entity.StatusOngoing, entity.StatusPublished, entity.StatusDraft,
+			} {
+				occurrence := newValidEvent(userID)
+				occurrence.ID = uuid.New()
+				occurrence.SeriesID = &seriesID
+				occurrence.Status = status
+				occurrences = append(occurrences, occurrence)
+			}
+			mockRepo.findByIDFunc = func(_ context.Context, id uuid.UUID) (*entity.Event, error) {
+				for _, occurrence := range occurrences {
+					if occurrence.ID == id {
+						copied := *occurrence
+						return &copied, nil
+					}
+				}
+				return nil, apperrors.NotFound("event not found")
+			}
+			mockRepo.findBySeriesIDFunc = func(_ context.Context, id uuid.UUID) ([]*entity.Event, error) {
+				Expect(id).To(Equal(seriesID))
+				return occurrences, nil
+			}
+		})
+
+		Describe("ListOccurrences", func() {
+			It("should return every occurrence of the series", func() {
+				result, err := usecase.ListOccurrences(ctx, occurrences[2].ID, userID, false)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(occurrences))
+			})
+
+			It("should return the event alone when it does not recur", func() {
+				occurrences[0].SeriesID = nil
+
+				result, err := usecase.ListOccurrences(ctx, occurrences[0].ID, userID, false)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(1))
+				Expect(result[0].ID).To(Equal(occurrences[0].ID))
+			})
+
+			It("should reject users who do not manage the event", func() {
+				_, err := usecase.ListOccurrences(ctx, occurrences[0].ID, uuid.New(), false)
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeForbidden))
+			})
+		})
+
+		Describe("CancelSeries", func() {
+			It("should cancel the occurrences that have not started and leave the others", func() {
+				var updated []uuid.UUID
+				mockRepo.updateFunc = func(_ context.Context, e *entity.Event) error {
+					updated = append(updated, e.ID)
+					return nil
+				}
+
+				result, err := usecase.CancelSeries(ctx, occurrences[0].ID, userID, false)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updated).To(ConsistOf(occurrences[2].ID, occurrences[3].ID))
+				Expect(result).To(HaveLen(4))
+				Expect(result[0].Status).To(Equal(entity.StatusCompleted))
+				Expect(result[1].Status).To(Equal(entity.StatusOngoing))
+				Expect(result[2].Status).To(Equal(entity.StatusCancelled))
+				Expect(result[3].Status).To(Equal(entity.StatusCancelled))
+			})
+
+			It("should reject an event that does not recur", func() {
+				occurrences[0].SeriesID = nil
+
+				_, err := usecase.CancelSeries(ctx, occurrences[0].ID, userID, false)
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+			})
+
+			It("should reject users who do not manage the event", func() {
+				_, err := usecase.CancelSeries(ctx, occurrences[0].ID, uuid.New(), false)
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeForbidden))
+			})
+		})
+	})
+})
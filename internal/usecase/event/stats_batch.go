@@ -0,0 +1,73 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/fumkob/ezqrin-server/internal/usecase/uuids"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// GetStatsBatch returns the stats of several events at once, e.g. for a dashboard.
+// The events and their stats are each loaded with a single query rather than per event.
+// Events that do not exist or that the user does not manage are reported as inaccessible
+// instead of failing the whole batch. Duplicate IDs are ignored.
+func (u *eventUsecase) GetStatsBatch(
+	ctx context.Context,
+	ids []uuid.UUID,
+	organizerID uuid.UUID,
+	isAdmin bool,
+) (BatchStatsOutput, error) {
+	ids = uuids.Unique(ids)
+	if len(ids) == 0 {
+		return BatchStatsOutput{}, apperrors.BadRequest("at least one event ID is required")
+	}
+	if len(ids) > MaxBatchStatsEvents {
+		return BatchStatsOutput{}, apperrors.BadRequest(
+			fmt.Sprintf("at most %d event IDs can be requested at once", MaxBatchStatsEvents),
+		)
+	}
+
+	events, err := u.eventRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return BatchStatsOutput{}, err
+	}
+
+	// Authorization: event owner or admin only, as for a single event
+	managed := make(map[uuid.UUID]*entity.Event, len(events))
+	managedIDs := make([]uuid.UUID, 0, len(events))
+	for _, event := range events {
+		if authz.IsEventManager(organizerID, event, isAdmin) {
+			managed[event.ID] = event
+			managedIDs = append(managedIDs, event.ID)
+		}
+	}
+
+	output := BatchStatsOutput{
+		Stats:        make(map[uuid.UUID]EventStatsOutput, len(managedIDs)),
+		Inaccessible: []uuid.UUID{},
+	}
+	if len(managedIDs) > 0 {
+		statsByEvent, err := u.eventRepo.GetStatsBatch(ctx, managedIDs)
+		if err != nil {
+			return BatchStatsOutput{}, err
+		}
+		now := time.Now()
+		for id, stats := range statsByEvent {
+			output.Stats[id] = u.buildStatsOutput(managed[id], stats, now)
+		}
+	}
+
+	// Events deleted between the two queries have no stats and count as inaccessible too
+	for _, id := range ids {
+		if _, ok := output.Stats[id]; !ok {
+			output.Inaccessible = append(output.Inaccessible, id)
+		}
+	}
+
+	return output, nil
+}
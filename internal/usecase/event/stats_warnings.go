@@ -0,0 +1,88 @@
+package event
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+)
+
+// statsWarnings returns the threshold warnings that apply to the event at now.
+// Warnings need at least one participant and are only reported for the phase of the event
+// they describe: no-shows once it is over, check-in pace while it runs, and the capacity
+// filling up, for events with a capacity, until it is over.
+func (u *eventUsecase) statsWarnings(
+	event *entity.Event,
+	totalParticipants int64,
+	confirmed int64,
+	checkinRate float64,
+	now time.Time,
+) []string {
+	warnings := []string{}
+	if totalParticipants == 0 {
+		return warnings
+	}
+
+	switch {
+	case isPastEvent(event, now):
+		noShowRate := 1 - checkinRate
+		if t := u.thresholds.NoShowRate; t > 0 && noShowRate > t {
+			warnings = append(warnings, fmt.Sprintf(
+				"no-show rate is %s, above the %s threshold", formatPercent(noShowRate), formatPercent(t),
+			))
+		}
+	case isOngoingEvent(event, now):
+		if t := u.thresholds.LowCheckinRate; t > 0 && checkinRate < t {
+			warnings = append(warnings, fmt.Sprintf(
+				"check-in rate is %s, below the expected %s", formatPercent(checkinRate), formatPercent(t),
+			))
+		}
+	}
+
+	if event.HasCapacity() && !isPastEvent(event, now) {
+		filled := float64(confirmed) / float64(event.Capacity)
+		if t := u.thresholds.Capacity; t > 0 && filled >= t {
+			warnings = append(warnings, fmt.Sprintf(
+				"capacity is %s filled, at or above the %s threshold", formatPercent(filled), formatPercent(t),
+			))
+		}
+	}
+
+	return warnings
+}
+
+// isPastEvent reports whether the event has finished. Events without an end date end at their start.
+func isPastEvent(event *entity.Event, now time.Time) bool {
+	switch event.Status {
+	case entity.StatusCompleted:
+		return true
+	case entity.StatusPublished, entity.StatusOngoing:
+		end := event.StartDate
+		if event.EndDate != nil {
+			end = *event.EndDate
+		}
+		return end.Before(now)
+	default:
+		return false
+	}
+}
+
+// isOngoingEvent reports whether the event is running and not yet over.
+func isOngoingEvent(event *entity.Event, now time.Time) bool {
+	if isPastEvent(event, now) {
+		return false
+	}
+	switch event.Status {
+	case entity.StatusOngoing:
+		return true
+	case entity.StatusPublished:
+		return !event.StartDate.After(now)
+	default:
+		return false
+	}
+}
+
+// formatPercent formats a rate in [0, 1] as a whole percentage.
+func formatPercent(rate float64) string {
+	return fmt.Sprintf("%.0f%%", rate*100)
+}
@@ -7,6 +7,9 @@ import (
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	domainwebhook "github.com/fumkob/ezqrin-server/internal/domain/webhook"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/google/uuid"
 )
@@ -14,18 +17,54 @@ import (
 var _ Usecase = (*eventUsecase)(nil)
 
 type eventUsecase struct {
-	eventRepo repository.EventRepository
+	eventRepo       repository.EventRepository
+	webhookRepo     repository.EventWebhookRepository
+	outboxRepo      repository.OutboxRepository
+	transactor      repository.Transactor
+	defaultCurrency string
+	thresholds      StatsWarningThresholds
+	maxOccurrences  int
+	webhookGuard    domainwebhook.URLGuard
+	auditor         *audit.Recorder
 }
 
 // NewUsecase creates a new instance of Event Usecase.
-func NewUsecase(eventRepo repository.EventRepository) Usecase {
+// defaultCurrency is assigned to events created without a currency; it may be empty.
+// Publishing an event records an event.published outbox message in the same transaction.
+// thresholds controls the warnings reported by GetStats.
+// maxOccurrences bounds the occurrences a recurring event is expanded into.
+// webhookGuard checks the URLs of event webhooks; it may be nil to allow any URL.
+// auditor records creating, changing and deleting events in the audit log; it may be nil.
+func NewUsecase(
+	eventRepo repository.EventRepository,
+	webhookRepo repository.EventWebhookRepository,
+	outboxRepo repository.OutboxRepository,
+	transactor repository.Transactor,
+	defaultCurrency string,
+	thresholds StatsWarningThresholds,
+	maxOccurrences int,
+	webhookGuard domainwebhook.URLGuard,
+	auditor *audit.Recorder,
+) Usecase {
 	return &eventUsecase{
-		eventRepo: eventRepo,
+		eventRepo:       eventRepo,
+		webhookRepo:     webhookRepo,
+		outboxRepo:      outboxRepo,
+		transactor:      transactor,
+		defaultCurrency: defaultCurrency,
+		thresholds:      thresholds,
+		maxOccurrences:  maxOccurrences,
+		webhookGuard:    webhookGuard,
+		auditor:         auditor,
 	}
 }
 
 func (u *eventUsecase) Create(ctx context.Context, input CreateEventInput) (*entity.Event, error) {
 	now := time.Now()
+	currency := input.Currency
+	if currency == "" {
+		currency = u.defaultCurrency
+	}
 	event := &entity.Event{
 		ID:          uuid.New(),
 		OrganizerID: input.OrganizerID,
@@ -35,19 +74,42 @@ func (u *eventUsecase) Create(ctx context.Context, input CreateEventInput) (*ent
 		EndDate:     input.EndDate,
 		Location:    input.Location,
 		Timezone:    input.Timezone,
+		Currency:    currency,
 		Status:      input.Status,
 		CreatedAt:   now,
 		UpdatedAt:   now,
+
+		RequiresConsent:      input.RequiresConsent,
+		ConsentVersion:       input.ConsentVersion,
+		TentativeExpiryHours: input.TentativeExpiryHours,
+		Capacity:             input.Capacity,
+	}
+	if input.Fee != nil {
+		applyFeeInput(event, *input.Fee)
 	}
 
 	if err := event.Validate(); err != nil {
-		return nil, apperrors.Validation(fmt.Sprintf("event validation failed: %v", err))
+		return nil, eventValidationError(err)
+	}
+	if input.Recurrence != nil {
+		return u.createSeries(ctx, event, *input.Recurrence)
 	}
 
-	if err := u.eventRepo.Create(ctx, event); err != nil {
+	err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.eventRepo.Create(txCtx, event); err != nil {
+			return err
+		}
+		if event.Status == entity.StatusPublished {
+			return u.enqueueEventPublished(txCtx, event)
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	u.auditor.Record(ctx, event.OrganizerID, entity.AuditActionCreate, entity.AuditResourceEvent, event.ID,
+		nil, event.AuditFields())
 	return event, nil
 }
 
@@ -60,12 +122,28 @@ func (u *eventUsecase) GetByID(ctx context.Context, id uuid.UUID) (*entity.Event
 }
 
 func (u *eventUsecase) List(ctx context.Context, input ListEventsInput) (ListEventsOutput, error) {
+	if input.StartDateFrom != nil && input.StartDateTo != nil && input.StartDateFrom.After(*input.StartDateTo) {
+		return ListEventsOutput{}, apperrors.Validation("start_from must not be after start_to")
+	}
+
 	filter := repository.EventListFilter{
-		OrganizerID: input.OrganizerID,
-		Status:      input.Status,
-		Search:      input.Search,
-		Sort:        input.Sort,
-		Order:       input.Order,
+		OrganizerID:   input.OrganizerID,
+		Status:        input.Status,
+		Search:        input.Search,
+		StartDateFrom: input.StartDateFrom,
+		StartDateTo:   input.StartDateTo,
+		Sort:          input.Sort,
+		Order:         input.Order,
+
+		IncludeDeleted: input.IncludeDeleted,
+	}
+
+	if input.Cursor != nil {
+		events, nextCursor, err := u.eventRepo.ListCursor(ctx, filter, *input.Cursor, input.PerPage)
+		if err != nil {
+			return ListEventsOutput{}, err
+		}
+		return ListEventsOutput{Events: events, NextCursor: nextCursor}, nil
 	}
 
 	offset := (input.Page - 1) * input.PerPage
@@ -82,6 +160,14 @@ func (u *eventUsecase) List(ctx context.Context, input ListEventsInput) (ListEve
 	}, nil
 }
 
+func (u *eventUsecase) GetListLastModified(ctx context.Context, input ListEventsInput) (time.Time, error) {
+	filter := repository.EventListFilter{
+		OrganizerID: input.OrganizerID,
+	}
+
+	return u.eventRepo.GetListLastModified(ctx, filter)
+}
+
 func (u *eventUsecase) Update(
 	ctx context.Context,
 	id uuid.UUID,
@@ -95,28 +181,97 @@ func (u *eventUsecase) Update(
 	}
 
 	// Authorization check
-	if !isAdmin && event.OrganizerID != organizerID {
-		return nil, apperrors.Forbidden("you do not have permission to update this event")
+	if err := authz.RequireEventManager(organizerID, event, isAdmin, "update this event"); err != nil {
+		return nil, err
+	}
+	if input.LegalHold != nil && *input.LegalHold != event.LegalHold && !isAdmin {
+		return nil, apperrors.Forbidden("only admins can change the legal hold of an event")
 	}
 
+	wasPublished := event.Status == entity.StatusPublished
+	previousCapacity := event.Capacity
+	before := event.AuditFields()
+
 	if err := u.applyUpdateInput(event, input); err != nil {
 		return nil, err
 	}
 
 	if err := event.Validate(); err != nil {
-		return nil, apperrors.Validation(fmt.Sprintf("event validation failed: %v", err))
+		return nil, eventValidationError(err)
 	}
 
 	// Update the timestamp after successful validation
 	event.UpdatedAt = time.Now()
 
-	if err := u.eventRepo.Update(ctx, event); err != nil {
+	err = u.transactor.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.eventRepo.Update(txCtx, event); err != nil {
+			return err
+		}
+		if err := u.checkCapacityNotBelowTaken(txCtx, event, previousCapacity); err != nil {
+			return err
+		}
+		if !wasPublished && event.Status == entity.StatusPublished {
+			return u.enqueueEventPublished(txCtx, event)
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
 
+	u.auditor.Record(ctx, organizerID, entity.AuditActionUpdate, entity.AuditResourceEvent, event.ID,
+		before, event.AuditFields())
 	return event, nil
 }
 
+// checkCapacityNotBelowTaken rejects setting or lowering the capacity of an event below its
+// confirmed participants. It runs after the event row is updated, which holds the row lock that
+// participant creations and promotions take, so that no participant is confirmed concurrently.
+func (u *eventUsecase) checkCapacityNotBelowTaken(ctx context.Context, event *entity.Event, previous int) error {
+	if !event.HasCapacity() || (previous > 0 && event.Capacity >= previous) {
+		return nil
+	}
+	confirmed, err := u.eventRepo.CountConfirmedParticipants(ctx, event.ID)
+	if err != nil {
+		return err
+	}
+	if confirmed > int64(event.Capacity) {
+		return eventValidationError(entity.ErrEventCapacityBelowTaken)
+	}
+	return nil
+}
+
+// SpotsRemaining returns how many more participants can be confirmed for event, or nil if it has
+// no capacity limit.
+func (u *eventUsecase) SpotsRemaining(ctx context.Context, event *entity.Event) (*int64, error) {
+	if !event.HasCapacity() {
+		return nil, nil
+	}
+	confirmed, err := u.eventRepo.CountConfirmedParticipants(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+	remaining := event.RemainingCapacity(confirmed)
+	return &remaining, nil
+}
+
+// enqueueEventPublished writes the event.published outbox message for an event that became published.
+func (u *eventUsecase) enqueueEventPublished(ctx context.Context, event *entity.Event) error {
+	msg, err := entity.NewOutboxMessage(entity.OutboxEventEventPublished, event.ID, entity.EventPublishedPayload{
+		EventID:     event.ID,
+		OrganizerID: event.OrganizerID,
+		Name:        event.Name,
+		StartDate:   event.StartDate,
+	})
+	if err != nil {
+		return err
+	}
+	if err := u.outboxRepo.Enqueue(ctx, msg); err != nil {
+		return fmt.Errorf("failed to enqueue event published webhook: %w", err)
+	}
+	return nil
+}
+
 func (u *eventUsecase) GetStats(
 	ctx context.Context,
 	id uuid.UUID,
@@ -129,8 +284,8 @@ func (u *eventUsecase) GetStats(
 	}
 
 	// Authorization check
-	if !isAdmin && event.OrganizerID != organizerID {
-		return EventStatsOutput{}, apperrors.Forbidden("you do not have permission to view stats for this event")
+	if err := authz.RequireEventManager(organizerID, event, isAdmin, "view stats for this event"); err != nil {
+		return EventStatsOutput{}, err
 	}
 
 	stats, err := u.eventRepo.GetStats(ctx, id)
@@ -138,18 +293,49 @@ func (u *eventUsecase) GetStats(
 		return EventStatsOutput{}, err
 	}
 
+	return u.buildStatsOutput(event, stats, time.Now()), nil
+}
+
+// buildStatsOutput derives the check-in rate and warnings of an event's stats.
+func (u *eventUsecase) buildStatsOutput(
+	event *entity.Event,
+	stats *repository.EventStats,
+	now time.Time,
+) EventStatsOutput {
 	var checkinRate float64
 	if stats.TotalParticipants > 0 {
 		checkinRate = float64(stats.CheckedInCount) / float64(stats.TotalParticipants)
 	}
 
+	byGroup := make(map[string]GroupStatsOutput, len(stats.ByGroup))
+	for group, groupStats := range stats.ByGroup {
+		byGroup[group] = GroupStatsOutput{
+			TotalParticipants:     groupStats.TotalParticipants,
+			CheckedInParticipants: groupStats.CheckedInCount,
+		}
+	}
+
+	confirmed := stats.ByStatus[string(entity.ParticipantStatusConfirmed)]
+	var spotsRemaining *int64
+	if event.HasCapacity() {
+		remaining := event.RemainingCapacity(confirmed)
+		spotsRemaining = &remaining
+	}
+
 	return EventStatsOutput{
-		EventID:               id,
+		EventID:               event.ID,
 		TotalParticipants:     stats.TotalParticipants,
 		CheckedInParticipants: stats.CheckedInCount,
+		WalkInParticipants:    stats.WalkInCount,
+		GuestParticipants:     stats.GuestCount,
 		CheckinRate:           checkinRate,
 		ByStatus:              stats.ByStatus,
-	}, nil
+		ByGroup:               byGroup,
+		TotalPaymentAmount:    stats.TotalPaymentAmount,
+		Currency:              stats.Currency,
+		SpotsRemaining:        spotsRemaining,
+		Warnings:              u.statsWarnings(event, stats.TotalParticipants, confirmed, checkinRate, now),
+	}
 }
 
 func (u *eventUsecase) Delete(
@@ -163,8 +349,8 @@ func (u *eventUsecase) Delete(
 		return err
 	}
 
-	if !isAdmin && event.OrganizerID != organizerID {
-		return apperrors.Forbidden("you do not have permission to delete this event")
+	if err := authz.RequireEventManager(organizerID, event, isAdmin, "delete this event"); err != nil {
+		return err
 	}
 
 	if event.IsOngoing() {
@@ -178,9 +364,39 @@ func (u *eventUsecase) Delete(
 		return err
 	}
 
+	u.auditor.Record(ctx, organizerID, entity.AuditActionDelete, entity.AuditResourceEvent, id, event.AuditFields(), nil)
 	return nil
 }
 
+// Restore restores a soft deleted event together with the participants deleted with it.
+func (u *eventUsecase) Restore(
+	ctx context.Context,
+	id uuid.UUID,
+	organizerID uuid.UUID,
+	isAdmin bool,
+) (*entity.Event, error) {
+	event, err := u.eventRepo.FindDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.RequireEventManager(organizerID, event, isAdmin, "restore this event"); err != nil {
+		return nil, err
+	}
+
+	if err := u.eventRepo.Restore(ctx, id); err != nil {
+		return nil, err
+	}
+
+	restored, err := u.eventRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	u.auditor.Record(ctx, organizerID, entity.AuditActionCreate, entity.AuditResourceEvent, id,
+		nil, restored.AuditFields())
+	return restored, nil
+}
+
 func (u *eventUsecase) applyUpdateInput(event *entity.Event, input UpdateEventInput) error {
 	if input.Name != nil {
 		event.Name = *input.Name
@@ -200,6 +416,27 @@ func (u *eventUsecase) applyUpdateInput(event *entity.Event, input UpdateEventIn
 	if input.Timezone != nil {
 		event.Timezone = *input.Timezone
 	}
+	if input.Currency != nil {
+		event.Currency = *input.Currency
+	}
+	if input.Fee != nil {
+		applyFeeInput(event, *input.Fee)
+	}
+	if input.RequiresConsent != nil {
+		event.RequiresConsent = *input.RequiresConsent
+	}
+	if input.ConsentVersion != nil {
+		event.ConsentVersion = *input.ConsentVersion
+	}
+	if input.LegalHold != nil {
+		event.LegalHold = *input.LegalHold
+	}
+	if input.TentativeExpiryHours != nil {
+		event.TentativeExpiryHours = *input.TentativeExpiryHours
+	}
+	if input.Capacity != nil {
+		event.Capacity = *input.Capacity
+	}
 	if input.Status != nil {
 		if err := event.TransitionTo(*input.Status); err != nil {
 			return apperrors.BadRequest(fmt.Sprintf("invalid status transition: %v", err))
@@ -207,3 +444,10 @@ func (u *eventUsecase) applyUpdateInput(event *entity.Event, input UpdateEventIn
 	}
 	return nil
 }
+
+// applyFeeInput replaces the event's fee model with the given one.
+func applyFeeInput(event *entity.Event, fee FeeInput) {
+	event.FeeType = fee.Type
+	event.FeeAmount = fee.Amount
+	event.FeeTiers = fee.Tiers
+}
@@ -7,11 +7,15 @@ import (
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
 	"github.com/fumkob/ezqrin-server/internal/usecase/event"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.uber.org/zap"
 )
 
 type eventListFunc func(
@@ -22,12 +26,31 @@ type eventListFunc func(
 
 // SimpleEventRepositoryMock is a mock implementation of EventRepository for testing
 type SimpleEventRepositoryMock struct {
-	createFunc   func(ctx context.Context, event *entity.Event) error
-	findByIDFunc func(ctx context.Context, id uuid.UUID) (*entity.Event, error)
-	listFunc     eventListFunc
+	createFunc     func(ctx context.Context, event *entity.Event) error
+	findByIDFunc   func(ctx context.Context, id uuid.UUID) (*entity.Event, error)
+	listFunc       eventListFunc
+	listCursorFunc func(
+		ctx context.Context,
+		filter repository.EventListFilter,
+		cursor string,
+		limit int,
+	) ([]*entity.Event, string, error)
 	updateFunc   func(ctx context.Context, event *entity.Event) error
 	deleteFunc   func(ctx context.Context, id uuid.UUID) error
 	getStatsFunc func(ctx context.Context, id uuid.UUID) (*repository.EventStats, error)
+
+	findDeletedByIDFunc func(ctx context.Context, id uuid.UUID) (*entity.Event, error)
+	restoreFunc         func(ctx context.Context, id uuid.UUID) error
+
+	findByIDsFunc      func(ctx context.Context, ids []uuid.UUID) ([]*entity.Event, error)
+	findBySeriesIDFunc func(ctx context.Context, seriesID uuid.UUID) ([]*entity.Event, error)
+	getStatsBatchFunc  func(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*repository.EventStats, error)
+
+	getListLastModifiedFunc func(ctx context.Context, filter repository.EventListFilter) (time.Time, error)
+
+	countConfirmedParticipantsFunc func(ctx context.Context, id uuid.UUID) (int64, error)
+
+	updateLogoFunc func(ctx context.Context, id uuid.UUID, logo []byte) error
 }
 
 func (m *SimpleEventRepositoryMock) Create(ctx context.Context, e *entity.Event) error {
@@ -55,6 +78,18 @@ func (m *SimpleEventRepositoryMock) List(
 	return nil, 0, nil
 }
 
+func (m *SimpleEventRepositoryMock) ListCursor(
+	ctx context.Context,
+	filter repository.EventListFilter,
+	cursor string,
+	limit int,
+) ([]*entity.Event, string, error) {
+	if m.listCursorFunc != nil {
+		return m.listCursorFunc(ctx, filter, cursor, limit)
+	}
+	return nil, "", nil
+}
+
 func (m *SimpleEventRepositoryMock) Update(ctx context.Context, e *entity.Event) error {
 	if m.updateFunc != nil {
 		return m.updateFunc(ctx, e)
@@ -69,6 +104,31 @@ func (m *SimpleEventRepositoryMock) Delete(ctx context.Context, id uuid.UUID) er
 	return nil
 }
 
+func (m *SimpleEventRepositoryMock) UpdateLogo(ctx context.Context, id uuid.UUID, logo []byte) error {
+	if m.updateLogoFunc != nil {
+		return m.updateLogoFunc(ctx, id, logo)
+	}
+	return nil
+}
+
+func (m *SimpleEventRepositoryMock) FindLogo(_ context.Context, _ uuid.UUID) ([]byte, error) {
+	return nil, nil
+}
+
+func (m *SimpleEventRepositoryMock) FindDeletedByID(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+	if m.findDeletedByIDFunc != nil {
+		return m.findDeletedByIDFunc(ctx, id)
+	}
+	return nil, nil
+}
+
+func (m *SimpleEventRepositoryMock) Restore(ctx context.Context, id uuid.UUID) error {
+	if m.restoreFunc != nil {
+		return m.restoreFunc(ctx, id)
+	}
+	return nil
+}
+
 func (m *SimpleEventRepositoryMock) GetStats(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
 	if m.getStatsFunc != nil {
 		return m.getStatsFunc(ctx, id)
@@ -76,10 +136,140 @@ func (m *SimpleEventRepositoryMock) GetStats(ctx context.Context, id uuid.UUID)
 	return nil, nil
 }
 
+func (m *SimpleEventRepositoryMock) FindByIDs(ctx context.Context, ids []uuid.UUID) ([]*entity.Event, error) {
+	if m.findByIDsFunc != nil {
+		return m.findByIDsFunc(ctx, ids)
+	}
+	return nil, nil
+}
+
+func (m *SimpleEventRepositoryMock) FindBySeriesID(ctx context.Context, seriesID uuid.UUID) ([]*entity.Event, error) {
+	if m.findBySeriesIDFunc != nil {
+		return m.findBySeriesIDFunc(ctx, seriesID)
+	}
+	return nil, nil
+}
+
+func (m *SimpleEventRepositoryMock) GetStatsBatch(
+	ctx context.Context,
+	ids []uuid.UUID,
+) (map[uuid.UUID]*repository.EventStats, error) {
+	if m.getStatsBatchFunc != nil {
+		return m.getStatsBatchFunc(ctx, ids)
+	}
+	return nil, nil
+}
+
+func (m *SimpleEventRepositoryMock) CountConfirmedParticipants(ctx context.Context, id uuid.UUID) (int64, error) {
+	if m.countConfirmedParticipantsFunc != nil {
+		return m.countConfirmedParticipantsFunc(ctx, id)
+	}
+	return 0, nil
+}
+
+func (m *SimpleEventRepositoryMock) GetListLastModified(
+	ctx context.Context,
+	filter repository.EventListFilter,
+) (time.Time, error) {
+	if m.getListLastModifiedFunc != nil {
+		return m.getListLastModifiedFunc(ctx, filter)
+	}
+	return time.Time{}, nil
+}
+
+func (m *SimpleEventRepositoryMock) FindPIIPurgeDue(
+	ctx context.Context,
+	endedBefore time.Time,
+	limit int,
+) ([]*entity.Event, error) {
+	return nil, nil
+}
+
+func (m *SimpleEventRepositoryMock) MarkPIIPurged(ctx context.Context, id uuid.UUID, purgedAt time.Time) (bool, error) {
+	return false, nil
+}
+
+func (m *SimpleEventRepositoryMock) RecordPIIPurge(ctx context.Context, purge *entity.PIIPurge) error {
+	return nil
+}
+
 func (m *SimpleEventRepositoryMock) HealthCheck(ctx context.Context) error {
 	return nil
 }
 
+// SimpleOutboxRepositoryMock records enqueued outbox messages for testing
+type SimpleOutboxRepositoryMock struct {
+	enqueueFunc func(ctx context.Context, msg *entity.OutboxMessage) error
+	enqueued    []*entity.OutboxMessage
+}
+
+func (m *SimpleOutboxRepositoryMock) Enqueue(ctx context.Context, msg *entity.OutboxMessage) error {
+	if m.enqueueFunc != nil {
+		if err := m.enqueueFunc(ctx, msg); err != nil {
+			return err
+		}
+	}
+	m.enqueued = append(m.enqueued, msg)
+	return nil
+}
+
+func (m *SimpleOutboxRepositoryMock) ClaimDue(
+	ctx context.Context,
+	now time.Time,
+	lease time.Duration,
+	limit int,
+) ([]*entity.OutboxMessage, error) {
+	return nil, nil
+}
+
+func (m *SimpleOutboxRepositoryMock) MarkDelivered(ctx context.Context, id uuid.UUID, deliveredAt time.Time) error {
+	return nil
+}
+
+func (m *SimpleOutboxRepositoryMock) ScheduleRetry(
+	ctx context.Context,
+	id uuid.UUID,
+	nextAttemptAt time.Time,
+	lastError string,
+	deliveredTo []string,
+) error {
+	return nil
+}
+
+func (m *SimpleOutboxRepositoryMock) MarkFailed(
+	ctx context.Context,
+	id uuid.UUID,
+	failedAt time.Time,
+	lastError string,
+) error {
+	return nil
+}
+
+// SimpleAuditRepositoryMock records audit log entries for testing
+type SimpleAuditRepositoryMock struct {
+	recorded []*entity.AuditLog
+}
+
+func (m *SimpleAuditRepositoryMock) Record(ctx context.Context, entry *entity.AuditLog) error {
+	m.recorded = append(m.recorded, entry)
+	return nil
+}
+
+func (m *SimpleAuditRepositoryMock) List(
+	ctx context.Context,
+	filter repository.AuditLogFilter,
+	offset, limit int,
+) ([]*entity.AuditLog, int64, error) {
+	return m.recorded, int64(len(m.recorded)), nil
+}
+
+// passthroughTransactor runs the function directly without a real transaction
+type passthroughTransactor struct{}
+
+func (passthroughTransactor) WithTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 // Helper functions for pointer creation
 func strPtr(s string) *string {
 	return &s
@@ -123,18 +313,24 @@ func newValidCreateInput(organizerID uuid.UUID) event.CreateEventInput {
 
 var _ = Describe("EventUsecase", func() {
 	var (
-		mockRepo  *SimpleEventRepositoryMock
-		usecase   event.Usecase
-		ctx       context.Context
-		eventID   uuid.UUID
-		userID    uuid.UUID
-		adminID   uuid.UUID
-		testEvent *entity.Event
+		mockRepo   *SimpleEventRepositoryMock
+		outboxRepo *SimpleOutboxRepositoryMock
+		usecase    event.Usecase
+		ctx        context.Context
+		eventID    uuid.UUID
+		userID     uuid.UUID
+		adminID    uuid.UUID
+		testEvent  *entity.Event
 	)
 
 	BeforeEach(func() {
 		mockRepo = &SimpleEventRepositoryMock{}
-		usecase = event.NewUsecase(mockRepo)
+		outboxRepo = &SimpleOutboxRepositoryMock{}
+		usecase = event.NewUsecase(mockRepo, nil, outboxRepo, passthroughTransactor{}, "JPY", event.StatsWarningThresholds{
+			NoShowRate:     0.3,
+			LowCheckinRate: 0.5,
+			Capacity:       0.9,
+		}, 365, nil, nil)
 		ctx = context.Background()
 
 		eventID = uuid.New()
@@ -183,6 +379,130 @@ var _ = Describe("EventUsecase", func() {
 				})
 			})
 
+			Context("without currency", func() {
+				It("should assign the configured default currency", func() {
+					input := newValidCreateInput(userID)
+
+					mockRepo.createFunc = func(ctx context.Context, e *entity.Event) error {
+						return nil
+					}
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(err).To(BeNil())
+					Expect(result.Currency).To(Equal("JPY"))
+				})
+			})
+
+			Context("with explicit currency", func() {
+				It("should keep the requested currency", func() {
+					input := newValidCreateInput(userID)
+					input.Currency = "USD"
+
+					mockRepo.createFunc = func(ctx context.Context, e *entity.Event) error {
+						return nil
+					}
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(err).To(BeNil())
+					Expect(result.Currency).To(Equal("USD"))
+				})
+			})
+
+			Context("with a tiered fee", func() {
+				It("should store the fee tiers", func() {
+					input := newValidCreateInput(userID)
+					input.Fee = &event.FeeInput{
+						Type: entity.FeeTypeTiered,
+						Tiers: []entity.FeeTier{
+							{Name: "early_bird", Amount: money.FromMinorUnits(200000)},
+							{Name: "standard", Amount: money.FromMinorUnits(300000)},
+						},
+					}
+
+					mockRepo.createFunc = func(ctx context.Context, e *entity.Event) error {
+						return nil
+					}
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(err).To(BeNil())
+					Expect(result.FeeType).To(Equal(entity.FeeTypeTiered))
+					Expect(result.FeeTiers).To(HaveLen(2))
+				})
+			})
+
+			Context("with required consent", func() {
+				It("should store the consent requirement and version", func() {
+					input := newValidCreateInput(userID)
+					input.RequiresConsent = true
+					input.ConsentVersion = "2025-01"
+
+					mockRepo.createFunc = func(ctx context.Context, e *entity.Event) error {
+						return nil
+					}
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(err).To(BeNil())
+					Expect(result.RequiresConsent).To(BeTrue())
+					Expect(result.ConsentVersion).To(Equal("2025-01"))
+				})
+			})
+
+			Context("with a tentative expiry", func() {
+				It("should store the tentative expiry", func() {
+					input := newValidCreateInput(userID)
+					input.TentativeExpiryHours = 72
+
+					mockRepo.createFunc = func(ctx context.Context, e *entity.Event) error {
+						return nil
+					}
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(err).To(BeNil())
+					Expect(result.TentativeExpiryHours).To(Equal(72))
+				})
+
+				It("should return validation error for more than a year", func() {
+					input := newValidCreateInput(userID)
+					input.TentativeExpiryHours = entity.TentativeExpiryMaxHours + 1
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(apperrors.IsValidation(err)).To(BeTrue())
+					Expect(result).To(BeNil())
+				})
+			})
+
+			Context("with a capacity", func() {
+				It("should store the capacity", func() {
+					input := newValidCreateInput(userID)
+					input.Capacity = 100
+
+					mockRepo.createFunc = func(ctx context.Context, e *entity.Event) error {
+						return nil
+					}
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(err).To(BeNil())
+					Expect(result.Capacity).To(Equal(100))
+				})
+
+				It("should return validation error for a negative capacity", func() {
+					input := newValidCreateInput(userID)
+					input.Capacity = -1
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(apperrors.IsValidation(err)).To(BeTrue())
+					Expect(result).To(BeNil())
+				})
+			})
+
 			Context("with draft status", func() {
 				It("should create event with draft status", func() {
 					input := newValidCreateInput(userID)
@@ -212,6 +532,24 @@ var _ = Describe("EventUsecase", func() {
 
 					Expect(err).To(BeNil())
 					Expect(result.Status).To(Equal(entity.StatusPublished))
+					Expect(outboxRepo.enqueued).To(HaveLen(1))
+					Expect(outboxRepo.enqueued[0].EventType).To(Equal(entity.OutboxEventEventPublished))
+					Expect(outboxRepo.enqueued[0].AggregateID).To(Equal(result.ID))
+				})
+			})
+
+			Context("when the outbox write fails for a published event", func() {
+				It("should return the error", func() {
+					input := newValidCreateInput(userID)
+					input.Status = entity.StatusPublished
+					outboxRepo.enqueueFunc = func(ctx context.Context, msg *entity.OutboxMessage) error {
+						return errors.New("db down")
+					}
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(err).To(HaveOccurred())
+					Expect(result).To(BeNil())
 				})
 			})
 		})
@@ -230,6 +568,64 @@ var _ = Describe("EventUsecase", func() {
 				})
 			})
 
+			Context("with unknown currency code", func() {
+				It("should return validation error", func() {
+					input := newValidCreateInput(userID)
+					input.Currency = "XYZ"
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(err).NotTo(BeNil())
+					Expect(apperrors.IsValidation(err)).To(BeTrue())
+					Expect(result).To(BeNil())
+				})
+			})
+
+			Context("with a fixed fee without an amount", func() {
+				It("should return validation error", func() {
+					input := newValidCreateInput(userID)
+					input.Fee = &event.FeeInput{Type: entity.FeeTypeFixed}
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(err).NotTo(BeNil())
+					Expect(apperrors.IsValidation(err)).To(BeTrue())
+					Expect(result).To(BeNil())
+				})
+			})
+
+			Context("with required consent without a version", func() {
+				It("should return validation error", func() {
+					input := newValidCreateInput(userID)
+					input.RequiresConsent = true
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(err).NotTo(BeNil())
+					Expect(apperrors.IsValidation(err)).To(BeTrue())
+					Expect(result).To(BeNil())
+				})
+			})
+
+			Context("with several invalid fields", func() {
+				It("should report every invalid field", func() {
+					input := newValidCreateInput(userID)
+					input.Name = ""
+					input.Capacity = -1
+
+					result, err := usecase.Create(ctx, input)
+
+					Expect(result).To(BeNil())
+					var appErr *apperrors.AppError
+					Expect(errors.As(err, &appErr)).To(BeTrue())
+					Expect(appErr.Code).To(Equal(apperrors.CodeValidation))
+					Expect(appErr.ValidationErrors).To(Equal([]apperrors.ValidationError{
+						{Field: "name", Message: entity.ErrEventNameRequired.Error()},
+						{Field: "capacity", Message: entity.ErrEventCapacityNegative.Error()},
+					}))
+				})
+			})
+
 			Context("with name too long (256 characters)", func() {
 				It("should return validation error", func() {
 					input := newValidCreateInput(userID)
@@ -696,6 +1092,75 @@ var _ = Describe("EventUsecase", func() {
 			})
 		})
 
+		When("including deleted events", func() {
+			It("should pass the flag to the repository", func() {
+				mockRepo.listFunc = func(
+					ctx context.Context,
+					filter repository.EventListFilter,
+					offset, limit int,
+				) ([]*entity.Event, int64, error) {
+					Expect(filter.IncludeDeleted).To(BeTrue())
+					return nil, 0, nil
+				}
+
+				_, err := usecase.List(ctx, event.ListEventsInput{IncludeDeleted: true, Page: 1, PerPage: 10})
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("filtering by start date range", func() {
+			var from, to time.Time
+
+			BeforeEach(func() {
+				from = time.Date(2030, 6, 1, 0, 0, 0, 0, time.UTC)
+				to = time.Date(2030, 6, 30, 23, 59, 59, 0, time.UTC)
+			})
+
+			It("should pass the range to the repository", func() {
+				mockRepo.listFunc = func(
+					ctx context.Context,
+					filter repository.EventListFilter,
+					offset, limit int,
+				) ([]*entity.Event, int64, error) {
+					Expect(filter.StartDateFrom).To(HaveValue(Equal(from)))
+					Expect(filter.StartDateTo).To(HaveValue(Equal(to)))
+					return nil, 0, nil
+				}
+
+				_, err := usecase.List(ctx, event.ListEventsInput{
+					StartDateFrom: &from,
+					StartDateTo:   &to,
+					Page:          1,
+					PerPage:       10,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should accept a range of a single instant", func() {
+				_, err := usecase.List(ctx, event.ListEventsInput{
+					StartDateFrom: &from,
+					StartDateTo:   &from,
+					Page:          1,
+					PerPage:       10,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject a range starting after it ends", func() {
+				_, err := usecase.List(ctx, event.ListEventsInput{
+					StartDateFrom: &to,
+					StartDateTo:   &from,
+					Page:          1,
+					PerPage:       10,
+				})
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+			})
+		})
+
 		When("using pagination", func() {
 			Context("page 1 with limit 10", func() {
 				It("should use offset 0", func() {
@@ -820,6 +1285,62 @@ var _ = Describe("EventUsecase", func() {
 			})
 		})
 
+		When("paging by cursor", func() {
+			It("should pass the cursor and page size and return the next cursor without counting", func() {
+				events := []*entity.Event{newValidEvent(userID)}
+				mockRepo.listFunc = func(
+					ctx context.Context,
+					filter repository.EventListFilter,
+					offset, limit int,
+				) ([]*entity.Event, int64, error) {
+					Fail("List should not be called when paging by cursor")
+					return nil, 0, nil
+				}
+				mockRepo.listCursorFunc = func(
+					ctx context.Context,
+					filter repository.EventListFilter,
+					cursor string,
+					limit int,
+				) ([]*entity.Event, string, error) {
+					Expect(filter.Sort).To(Equal("name"))
+					Expect(filter.Order).To(Equal("asc"))
+					Expect(cursor).To(Equal("abc"))
+					Expect(limit).To(Equal(20))
+					return events, "def", nil
+				}
+
+				cursor := "abc"
+				result, err := usecase.List(ctx, event.ListEventsInput{
+					Page:    3,
+					PerPage: 20,
+					Sort:    "name",
+					Order:   "asc",
+					Cursor:  &cursor,
+				})
+
+				Expect(err).To(BeNil())
+				Expect(result.Events).To(Equal(events))
+				Expect(result.NextCursor).To(Equal("def"))
+				Expect(result.TotalCount).To(BeZero())
+			})
+
+			It("should return the repository error for a rejected cursor", func() {
+				mockRepo.listCursorFunc = func(
+					ctx context.Context,
+					filter repository.EventListFilter,
+					cursor string,
+					limit int,
+				) ([]*entity.Event, string, error) {
+					return nil, "", apperrors.BadRequest("invalid cursor")
+				}
+
+				cursor := "garbage"
+				_, err := usecase.List(ctx, event.ListEventsInput{PerPage: 20, Cursor: &cursor})
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+			})
+		})
+
 		When("repository fails", func() {
 			Context("with database error", func() {
 				It("should return wrapped error", func() {
@@ -982,13 +1503,214 @@ var _ = Describe("EventUsecase", func() {
 		})
 	})
 
+	Describe("GetListLastModified", func() {
+		When("scoped to an organizer", func() {
+			It("should pass only the organizer scope to the repository", func() {
+				lastModified := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+				var captured repository.EventListFilter
+				mockRepo.getListLastModifiedFunc = func(
+					ctx context.Context,
+					filter repository.EventListFilter,
+				) (time.Time, error) {
+					captured = filter
+					return lastModified, nil
+				}
+
+				input := event.ListEventsInput{
+					OrganizerID: &userID,
+					Status:      statusPtr(entity.StatusPublished),
+					Search:      "conference",
+				}
+
+				result, err := usecase.GetListLastModified(ctx, input)
+
+				Expect(err).To(BeNil())
+				Expect(result).To(Equal(lastModified))
+				Expect(captured.OrganizerID).To(Equal(&userID))
+				Expect(captured.Status).To(BeNil())
+				Expect(captured.Search).To(BeEmpty())
+			})
+		})
+
+		When("the repository fails", func() {
+			It("should return the error", func() {
+				mockRepo.getListLastModifiedFunc = func(
+					ctx context.Context,
+					filter repository.EventListFilter,
+				) (time.Time, error) {
+					return time.Time{}, errors.New("database error")
+				}
+
+				_, err := usecase.GetListLastModified(ctx, event.ListEventsInput{})
+
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("GetStatsBatch", func() {
+		var (
+			events      map[uuid.UUID]*entity.Event
+			statsByID   map[uuid.UUID]*repository.EventStats
+			ongoing     *entity.Event
+			upcoming    *entity.Event
+			othersEvent *entity.Event
+			batchCalls  int
+		)
+
+		BeforeEach(func() {
+			ongoing = newValidEvent(userID)
+			ongoing.Status = entity.StatusOngoing
+			upcoming = newValidEvent(userID)
+			othersEvent = newValidEvent(uuid.New())
+			events = map[uuid.UUID]*entity.Event{ongoing.ID: ongoing, upcoming.ID: upcoming, othersEvent.ID: othersEvent}
+			statsByID = map[uuid.UUID]*repository.EventStats{
+				ongoing.ID: {
+					TotalParticipants: 10, CheckedInCount: 3, WalkInCount: 1,
+					ByStatus: map[string]int64{"confirmed": 10}, Currency: "JPY",
+				},
+				upcoming.ID:    {TotalParticipants: 4, CheckedInCount: 0, ByStatus: map[string]int64{"tentative": 4}},
+				othersEvent.ID: {TotalParticipants: 7, CheckedInCount: 7, ByStatus: map[string]int64{"confirmed": 7}},
+			}
+			batchCalls = 0
+
+			mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+				if e, ok := events[id]; ok {
+					return e, nil
+				}
+				return nil, apperrors.NotFound("event not found")
+			}
+			mockRepo.getStatsFunc = func(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
+				return statsByID[id], nil
+			}
+			mockRepo.findByIDsFunc = func(ctx context.Context, ids []uuid.UUID) ([]*entity.Event, error) {
+				found := []*entity.Event{}
+				for _, id := range ids {
+					if e, ok := events[id]; ok {
+						found = append(found, e)
+					}
+				}
+				return found, nil
+			}
+			mockRepo.getStatsBatchFunc = func(
+				ctx context.Context,
+				ids []uuid.UUID,
+			) (map[uuid.UUID]*repository.EventStats, error) {
+				batchCalls++
+				result := map[uuid.UUID]*repository.EventStats{}
+				for _, id := range ids {
+					result[id] = statsByID[id]
+				}
+				return result, nil
+			}
+		})
+
+		When("requesting events the user manages", func() {
+			It("should return the same stats as requesting each event on its own", func() {
+				output, err := usecase.GetStatsBatch(ctx, []uuid.UUID{ongoing.ID, upcoming.ID}, userID, false)
+
+				Expect(err).To(BeNil())
+				Expect(batchCalls).To(Equal(1))
+				Expect(output.Stats).To(HaveLen(2))
+				Expect(output.Inaccessible).To(BeEmpty())
+				for _, id := range []uuid.UUID{ongoing.ID, upcoming.ID} {
+					single, err := usecase.GetStats(ctx, id, userID, false)
+					Expect(err).To(BeNil())
+					Expect(output.Stats[id]).To(Equal(single))
+				}
+				Expect(output.Stats[ongoing.ID].Warnings).To(ConsistOf("check-in rate is 30%, below the expected 50%"))
+			})
+		})
+
+		When("some events are not accessible", func() {
+			It("should report unknown and foreign events as inaccessible in request order", func() {
+				missingID := uuid.New()
+
+				output, err := usecase.GetStatsBatch(
+					ctx, []uuid.UUID{missingID, ongoing.ID, othersEvent.ID, ongoing.ID}, userID, false,
+				)
+
+				Expect(err).To(BeNil())
+				Expect(output.Stats).To(HaveLen(1))
+				Expect(output.Stats).To(HaveKey(ongoing.ID))
+				Expect(output.Inaccessible).To(Equal([]uuid.UUID{missingID, othersEvent.ID}))
+			})
+
+			It("should not query stats when no event is accessible", func() {
+				output, err := usecase.GetStatsBatch(ctx, []uuid.UUID{othersEvent.ID}, userID, false)
+
+				Expect(err).To(BeNil())
+				Expect(batchCalls).To(BeZero())
+				Expect(output.Stats).To(BeEmpty())
+				Expect(output.Inaccessible).To(Equal([]uuid.UUID{othersEvent.ID}))
+			})
+		})
+
+		When("requesting as admin", func() {
+			It("should include other organizers' events", func() {
+				output, err := usecase.GetStatsBatch(ctx, []uuid.UUID{othersEvent.ID}, adminID, true)
+
+				Expect(err).To(BeNil())
+				Expect(output.Stats[othersEvent.ID].CheckinRate).To(Equal(1.0))
+			})
+		})
+
+		When("the request has no IDs", func() {
+			It("should return a bad request error", func() {
+				_, err := usecase.GetStatsBatch(ctx, nil, userID, false)
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+			})
+		})
+
+		When("the request has too many IDs", func() {
+			It("should return a bad request error", func() {
+				ids := make([]uuid.UUID, event.MaxBatchStatsEvents+1)
+				for i := range ids {
+					ids[i] = uuid.New()
+				}
+
+				_, err := usecase.GetStatsBatch(ctx, ids, userID, false)
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+				Expect(err.Error()).To(ContainSubstring("at most 100"))
+			})
+		})
+	})
+
+	Describe("SpotsRemaining", func() {
+		It("should return the places left for confirmed participants", func() {
+			testEvent.Capacity = 50
+			mockRepo.countConfirmedParticipantsFunc = func(ctx context.Context, id uuid.UUID) (int64, error) {
+				Expect(id).To(Equal(eventID))
+				return 52, nil
+			}
+
+			remaining, err := usecase.SpotsRemaining(ctx, testEvent)
+
+			Expect(err).To(BeNil())
+			Expect(*remaining).To(BeZero())
+		})
+
+		It("should return nil for an event without a capacity", func() {
+			remaining, err := usecase.SpotsRemaining(ctx, testEvent)
+
+			Expect(err).To(BeNil())
+			Expect(remaining).To(BeNil())
+		})
+	})
+
 	Describe("GetStats", func() {
 		When("getting stats as owner", func() {
 			Context("with checked in participants", func() {
 				It("should calculate stats correctly", func() {
 					stats := &repository.EventStats{
-						TotalParticipants: 10,
-						CheckedInCount:    8,
+						TotalParticipants:  10,
+						CheckedInCount:     8,
+						WalkInCount:        2,
+						GuestCount:         3,
+						TotalPaymentAmount: money.FromMinorUnits(300000),
+						Currency:           "JPY",
 					}
 
 					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
@@ -1005,7 +1727,36 @@ var _ = Describe("EventUsecase", func() {
 					Expect(result.EventID).To(Equal(eventID))
 					Expect(result.TotalParticipants).To(Equal(int64(10)))
 					Expect(result.CheckedInParticipants).To(Equal(int64(8)))
+					Expect(result.WalkInParticipants).To(Equal(int64(2)))
+					Expect(result.GuestParticipants).To(Equal(int64(3)))
 					Expect(result.CheckinRate).To(BeNumerically("~", 0.8, 0.0001))
+					Expect(result.TotalPaymentAmount).To(Equal(money.FromMinorUnits(300000)))
+					Expect(result.Currency).To(Equal("JPY"))
+					Expect(result.SpotsRemaining).To(BeNil())
+				})
+			})
+
+			Context("for an event with a capacity", func() {
+				It("should report the places left for confirmed participants", func() {
+					testEvent.Capacity = 50
+					stats := &repository.EventStats{
+						TotalParticipants: 45,
+						ByStatus:          map[string]int64{"confirmed": 42, "tentative": 3},
+					}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					mockRepo.getStatsFunc = func(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
+						return stats, nil
+					}
+
+					result, err := usecase.GetStats(ctx, eventID, userID, false)
+
+					Expect(err).To(BeNil())
+					Expect(result.SpotsRemaining).NotTo(BeNil())
+					Expect(*result.SpotsRemaining).To(Equal(int64(8)))
 				})
 			})
 
@@ -1085,82 +1836,216 @@ var _ = Describe("EventUsecase", func() {
 					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
 						return testEvent, nil
 					}
-
+
+					mockRepo.getStatsFunc = func(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
+						return stats, nil
+					}
+
+					result, err := usecase.GetStats(ctx, eventID, userID, false)
+
+					Expect(err).To(BeNil())
+					Expect(result.CheckinRate).To(Equal(0.5))
+				})
+			})
+		})
+
+		When("authorization fails", func() {
+			Context("as non-owner with organizer role", func() {
+				It("should return forbidden error", func() {
+					otherUserID := uuid.New()
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					result, err := usecase.GetStats(ctx, eventID, otherUserID, false)
+
+					Expect(err).NotTo(BeNil())
+					Expect(apperrors.IsForbidden(err)).To(BeTrue())
+					Expect(err.Error()).To(ContainSubstring("you do not have permission to view stats for this event"))
+					Expect(result.EventID).To(Equal(uuid.Nil))
+				})
+			})
+		})
+
+		When("getting stats as admin", func() {
+			Context("with other user's event", func() {
+				It("should retrieve stats successfully", func() {
+					stats := &repository.EventStats{
+						TotalParticipants: 20,
+						CheckedInCount:    15,
+					}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					mockRepo.getStatsFunc = func(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
+						return stats, nil
+					}
+
+					result, err := usecase.GetStats(ctx, eventID, adminID, true)
+
+					Expect(err).To(BeNil())
+					Expect(result.TotalParticipants).To(Equal(int64(20)))
+					Expect(result.CheckedInParticipants).To(Equal(int64(15)))
+				})
+			})
+		})
+
+		When("calculating checkin rate", func() {
+			Context("with non-zero participants", func() {
+				It("should calculate correct percentage", func() {
+					stats := &repository.EventStats{
+						TotalParticipants: 4,
+						CheckedInCount:    1,
+					}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					mockRepo.getStatsFunc = func(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
+						return stats, nil
+					}
+
+					result, err := usecase.GetStats(ctx, eventID, userID, false)
+
+					Expect(err).To(BeNil())
+					Expect(result.CheckinRate).To(Equal(0.25))
+				})
+			})
+		})
+
+		When("computing warnings", func() {
+			getWarnings := func(total, checkedIn int64) []string {
+				mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+					return testEvent, nil
+				}
+				mockRepo.getStatsFunc = func(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
+					return &repository.EventStats{TotalParticipants: total, CheckedInCount: checkedIn}, nil
+				}
+
+				result, err := usecase.GetStats(ctx, eventID, userID, false)
+				Expect(err).To(BeNil())
+				return result.Warnings
+			}
+
+			Context("with a past event whose no-show rate exceeds the threshold", func() {
+				It("should warn about no-shows", func() {
+					testEvent.Status = entity.StatusCompleted
+
+					Expect(getWarnings(10, 6)).To(ConsistOf("no-show rate is 40%, above the 30% threshold"))
+				})
+			})
+
+			Context("with a past event within the no-show threshold", func() {
+				It("should return no warnings", func() {
+					testEvent.Status = entity.StatusPublished
+					testEvent.StartDate = time.Now().Add(-48 * time.Hour)
+					testEvent.EndDate = timePtr(time.Now().Add(-24 * time.Hour))
+
+					Expect(getWarnings(10, 8)).To(BeEmpty())
+				})
+			})
+
+			Context("with an ongoing event whose check-in rate is below the threshold", func() {
+				It("should warn about the low check-in rate", func() {
+					testEvent.Status = entity.StatusOngoing
+
+					Expect(getWarnings(10, 3)).To(ConsistOf("check-in rate is 30%, below the expected 50%"))
+				})
+			})
+
+			Context("with a published event that has started", func() {
+				It("should treat it as ongoing", func() {
+					testEvent.Status = entity.StatusPublished
+					testEvent.StartDate = time.Now().Add(-time.Hour)
+
+					Expect(getWarnings(10, 3)).To(ConsistOf("check-in rate is 30%, below the expected 50%"))
+				})
+			})
+
+			Context("with an upcoming event", func() {
+				It("should not apply rate warnings", func() {
+					testEvent.Status = entity.StatusPublished
+
+					Expect(getWarnings(10, 0)).To(BeEmpty())
+				})
+			})
+
+			Context("with a cancelled event", func() {
+				It("should not apply rate warnings", func() {
+					testEvent.Status = entity.StatusCancelled
+					testEvent.EndDate = timePtr(time.Now().Add(-time.Hour))
+					testEvent.StartDate = time.Now().Add(-2 * time.Hour)
+
+					Expect(getWarnings(10, 0)).To(BeEmpty())
+				})
+			})
+
+			Context("with no participants", func() {
+				It("should return an empty list", func() {
+					testEvent.Status = entity.StatusCompleted
+
+					warnings := getWarnings(0, 0)
+					Expect(warnings).NotTo(BeNil())
+					Expect(warnings).To(BeEmpty())
+				})
+			})
+
+			Context("with an upcoming event nearly at capacity", func() {
+				getCapacityWarnings := func(confirmed int64) []string {
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
 					mockRepo.getStatsFunc = func(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
-						return stats, nil
+						return &repository.EventStats{
+							TotalParticipants: confirmed,
+							ByStatus:          map[string]int64{string(entity.ParticipantStatusConfirmed): confirmed},
+						}, nil
 					}
 
 					result, err := usecase.GetStats(ctx, eventID, userID, false)
-
 					Expect(err).To(BeNil())
-					Expect(result.CheckinRate).To(Equal(0.5))
-				})
-			})
-		})
+					return result.Warnings
+				}
 
-		When("authorization fails", func() {
-			Context("as non-owner with organizer role", func() {
-				It("should return forbidden error", func() {
-					otherUserID := uuid.New()
-					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
-						return testEvent, nil
-					}
+				BeforeEach(func() {
+					testEvent.Status = entity.StatusPublished
+					testEvent.StartDate = time.Now().Add(24 * time.Hour)
+				})
 
-					result, err := usecase.GetStats(ctx, eventID, otherUserID, false)
+				It("should warn once the threshold is reached", func() {
+					testEvent.Capacity = 20
 
-					Expect(err).NotTo(BeNil())
-					Expect(apperrors.IsForbidden(err)).To(BeTrue())
-					Expect(err.Error()).To(ContainSubstring("you do not have permission to view stats for this event"))
-					Expect(result.EventID).To(Equal(uuid.Nil))
+					Expect(getCapacityWarnings(19)).To(ConsistOf("capacity is 95% filled, at or above the 90% threshold"))
 				})
-			})
-		})
 
-		When("getting stats as admin", func() {
-			Context("with other user's event", func() {
-				It("should retrieve stats successfully", func() {
-					stats := &repository.EventStats{
-						TotalParticipants: 20,
-						CheckedInCount:    15,
-					}
+				It("should not warn below the threshold", func() {
+					testEvent.Capacity = 20
 
-					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
-						return testEvent, nil
-					}
+					Expect(getCapacityWarnings(17)).To(BeEmpty())
+				})
 
-					mockRepo.getStatsFunc = func(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
-						return stats, nil
-					}
+				It("should not warn for events without a capacity", func() {
+					Expect(getCapacityWarnings(19)).To(BeEmpty())
+				})
 
-					result, err := usecase.GetStats(ctx, eventID, adminID, true)
+				It("should not warn once the event is over", func() {
+					testEvent.Capacity = 20
+					testEvent.Status = entity.StatusCompleted
 
-					Expect(err).To(BeNil())
-					Expect(result.TotalParticipants).To(Equal(int64(20)))
-					Expect(result.CheckedInParticipants).To(Equal(int64(15)))
+					Expect(getCapacityWarnings(19)).NotTo(ContainElement(ContainSubstring("capacity")))
 				})
 			})
-		})
-
-		When("calculating checkin rate", func() {
-			Context("with non-zero participants", func() {
-				It("should calculate correct percentage", func() {
-					stats := &repository.EventStats{
-						TotalParticipants: 4,
-						CheckedInCount:    1,
-					}
-
-					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
-						return testEvent, nil
-					}
-
-					mockRepo.getStatsFunc = func(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
-						return stats, nil
-					}
 
-					result, err := usecase.GetStats(ctx, eventID, userID, false)
+			Context("with a disabled threshold", func() {
+				It("should not warn", func() {
+					usecase = event.NewUsecase(mockRepo, nil, outboxRepo, passthroughTransactor{}, "JPY",
+						event.StatsWarningThresholds{LowCheckinRate: 0.5}, 365, nil, nil)
+					testEvent.Status = entity.StatusCompleted
 
-					Expect(err).To(BeNil())
-					Expect(result.CheckinRate).To(Equal(0.25))
+					Expect(getWarnings(10, 0)).To(BeEmpty())
 				})
 			})
 		})
@@ -1227,6 +2112,34 @@ var _ = Describe("EventUsecase", func() {
 					Expect(result.ByStatus).To(HaveKeyWithValue("cancelled", int64(2)))
 				})
 			})
+
+			Context("with participants seated at groups", func() {
+				It("should return the by_group breakdown", func() {
+					stats := &repository.EventStats{
+						TotalParticipants: 8,
+						CheckedInCount:    3,
+						ByGroup: map[string]repository.EventGroupStats{
+							"Table 1": {TotalParticipants: 5, CheckedInCount: 3},
+							"Table 2": {TotalParticipants: 2},
+						},
+					}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+					mockRepo.getStatsFunc = func(ctx context.Context, id uuid.UUID) (*repository.EventStats, error) {
+						return stats, nil
+					}
+
+					result, err := usecase.GetStats(ctx, eventID, userID, false)
+
+					Expect(err).To(BeNil())
+					Expect(result.ByGroup).To(Equal(map[string]event.GroupStatsOutput{
+						"Table 1": {TotalParticipants: 5, CheckedInParticipants: 3},
+						"Table 2": {TotalParticipants: 2},
+					}))
+				})
+			})
 		})
 
 		When("context is cancelled", func() {
@@ -1294,6 +2207,105 @@ var _ = Describe("EventUsecase", func() {
 				})
 			})
 
+			Context("requiring consent", func() {
+				It("should update the consent requirement and version", func() {
+					requiresConsent := true
+					updateInput := event.UpdateEventInput{
+						RequiresConsent: &requiresConsent,
+						ConsentVersion:  strPtr("2025-02"),
+					}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					mockRepo.updateFunc = func(ctx context.Context, e *entity.Event) error {
+						Expect(e.RequiresConsent).To(BeTrue())
+						Expect(e.ConsentVersion).To(Equal("2025-02"))
+						return nil
+					}
+
+					_, err := usecase.Update(ctx, eventID, userID, false, updateInput)
+
+					Expect(err).To(BeNil())
+				})
+			})
+
+			Context("turning the tentative expiry off", func() {
+				It("should clear the tentative expiry", func() {
+					testEvent.TentativeExpiryHours = 72
+					never := 0
+					updateInput := event.UpdateEventInput{TentativeExpiryHours: &never}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					mockRepo.updateFunc = func(ctx context.Context, e *entity.Event) error {
+						Expect(e.TentativeExpiryHours).To(BeZero())
+						return nil
+					}
+
+					_, err := usecase.Update(ctx, eventID, userID, false, updateInput)
+
+					Expect(err).To(BeNil())
+				})
+			})
+
+			Context("lowering the capacity", func() {
+				BeforeEach(func() {
+					testEvent.Capacity = 100
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+					mockRepo.countConfirmedParticipantsFunc = func(ctx context.Context, id uuid.UUID) (int64, error) {
+						return 60, nil
+					}
+				})
+
+				It("should accept a capacity the confirmed participants fit in", func() {
+					capacity := 60
+
+					result, err := usecase.Update(ctx, eventID, userID, false, event.UpdateEventInput{Capacity: &capacity})
+
+					Expect(err).To(BeNil())
+					Expect(result.Capacity).To(Equal(60))
+				})
+
+				It("should reject a capacity below the confirmed participants", func() {
+					capacity := 59
+
+					_, err := usecase.Update(ctx, eventID, userID, false, event.UpdateEventInput{Capacity: &capacity})
+
+					var appErr *apperrors.AppError
+					Expect(errors.As(err, &appErr)).To(BeTrue())
+					Expect(appErr.ValidationErrors).To(ConsistOf(apperrors.ValidationError{
+						Field: "capacity", Message: entity.ErrEventCapacityBelowTaken.Error(),
+					}))
+				})
+			})
+
+			Context("removing the capacity", func() {
+				It("should clear the capacity", func() {
+					testEvent.Capacity = 100
+					unlimited := 0
+					updateInput := event.UpdateEventInput{Capacity: &unlimited}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					mockRepo.updateFunc = func(ctx context.Context, e *entity.Event) error {
+						Expect(e.Capacity).To(BeZero())
+						return nil
+					}
+
+					_, err := usecase.Update(ctx, eventID, userID, false, updateInput)
+
+					Expect(err).To(BeNil())
+				})
+			})
+
 			Context("updating dates", func() {
 				It("should update start and end dates", func() {
 					newStart := time.Now().Add(72 * time.Hour)
@@ -1360,6 +2372,65 @@ var _ = Describe("EventUsecase", func() {
 				})
 			})
 
+			Context("updating currency", func() {
+				It("should update currency", func() {
+					updateInput := event.UpdateEventInput{
+						Currency: strPtr("EUR"),
+					}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					mockRepo.updateFunc = func(ctx context.Context, e *entity.Event) error {
+						return nil
+					}
+
+					result, err := usecase.Update(ctx, eventID, userID, false, updateInput)
+
+					Expect(err).To(BeNil())
+					Expect(result.Currency).To(Equal("EUR"))
+				})
+
+				It("should reject an unknown currency code", func() {
+					updateInput := event.UpdateEventInput{
+						Currency: strPtr("ABC"),
+					}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					_, err := usecase.Update(ctx, eventID, userID, false, updateInput)
+
+					Expect(err).NotTo(BeNil())
+					Expect(apperrors.IsValidation(err)).To(BeTrue())
+				})
+			})
+
+			Context("updating the fee model", func() {
+				It("should replace the fee model", func() {
+					testEvent.Currency = "JPY"
+					updateInput := event.UpdateEventInput{
+						Fee: &event.FeeInput{Type: entity.FeeTypeFixed, Amount: money.FromMinorUnits(300000)},
+					}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					mockRepo.updateFunc = func(ctx context.Context, e *entity.Event) error {
+						return nil
+					}
+
+					result, err := usecase.Update(ctx, eventID, userID, false, updateInput)
+
+					Expect(err).To(BeNil())
+					Expect(result.FeeType).To(Equal(entity.FeeTypeFixed))
+					Expect(result.FeeAmount).To(Equal(money.FromMinorUnits(300000)))
+				})
+			})
+
 			Context("updating multiple fields", func() {
 				It("should update all provided fields", func() {
 					updateInput := event.UpdateEventInput{
@@ -1426,6 +2497,27 @@ var _ = Describe("EventUsecase", func() {
 
 					Expect(err).To(BeNil())
 					Expect(result.Status).To(Equal(entity.StatusPublished))
+					Expect(outboxRepo.enqueued).To(HaveLen(1))
+					Expect(outboxRepo.enqueued[0].EventType).To(Equal(entity.OutboxEventEventPublished))
+				})
+			})
+
+			Context("published event updated again", func() {
+				It("should not record another event.published message", func() {
+					testEvent.Status = entity.StatusPublished
+					updateInput := event.UpdateEventInput{
+						Name:   strPtr("Renamed Event"),
+						Status: statusPtr(entity.StatusPublished),
+					}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					_, err := usecase.Update(ctx, eventID, userID, false, updateInput)
+
+					Expect(err).To(BeNil())
+					Expect(outboxRepo.enqueued).To(BeEmpty())
 				})
 			})
 
@@ -1632,9 +2724,48 @@ var _ = Describe("EventUsecase", func() {
 					Expect(result).To(BeNil())
 				})
 			})
+
+			Context("owner trying to lift the legal hold", func() {
+				It("should return forbidden error", func() {
+					testEvent.LegalHold = true
+					legalHold := false
+					updateInput := event.UpdateEventInput{LegalHold: &legalHold}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					result, err := usecase.Update(ctx, eventID, userID, false, updateInput)
+
+					Expect(apperrors.IsForbidden(err)).To(BeTrue())
+					Expect(err.Error()).To(ContainSubstring("only admins can change the legal hold"))
+					Expect(result).To(BeNil())
+				})
+			})
 		})
 
 		When("updating as admin", func() {
+			Context("placing the event on legal hold", func() {
+				It("should set the legal hold", func() {
+					legalHold := true
+					updateInput := event.UpdateEventInput{LegalHold: &legalHold}
+
+					mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+						return testEvent, nil
+					}
+
+					mockRepo.updateFunc = func(ctx context.Context, e *entity.Event) error {
+						Expect(e.LegalHold).To(BeTrue())
+						return nil
+					}
+
+					result, err := usecase.Update(ctx, eventID, adminID, true, updateInput)
+
+					Expect(err).To(BeNil())
+					Expect(result.LegalHold).To(BeTrue())
+				})
+			})
+
 			Context("updating other user's event", func() {
 				It("should succeed", func() {
 					updateInput := event.UpdateEventInput{
@@ -2233,4 +3364,79 @@ var _ = Describe("EventUsecase", func() {
 			})
 		})
 	})
+
+	Describe("Restore", func() {
+		var restored bool
+
+		BeforeEach(func() {
+			restored = false
+			deletedAt := time.Now().Add(-time.Hour)
+			testEvent.DeletedAt = &deletedAt
+			mockRepo.findDeletedByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+				Expect(id).To(Equal(eventID))
+				return testEvent, nil
+			}
+			mockRepo.restoreFunc = func(ctx context.Context, id uuid.UUID) error {
+				Expect(id).To(Equal(eventID))
+				restored = true
+				return nil
+			}
+			mockRepo.findByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+				Expect(restored).To(BeTrue())
+				live := *testEvent
+				live.DeletedAt = nil
+				return &live, nil
+			}
+		})
+
+		It("should restore the event for its owner and return it", func() {
+			result, err := usecase.Restore(ctx, eventID, userID, false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored).To(BeTrue())
+			Expect(result.ID).To(Equal(eventID))
+			Expect(result.DeletedAt).To(BeNil())
+		})
+
+		It("should record the restored event in the audit log", func() {
+			auditRepo := &SimpleAuditRepositoryMock{}
+			usecase = event.NewUsecase(mockRepo, nil, outboxRepo, passthroughTransactor{}, "JPY",
+				event.StatsWarningThresholds{}, 365, nil, audit.NewRecorder(auditRepo, &logger.Logger{Logger: zap.NewNop()}))
+
+			_, err := usecase.Restore(ctx, eventID, userID, false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(auditRepo.recorded).To(HaveLen(1))
+			entry := auditRepo.recorded[0]
+			Expect(entry.ActorID).To(Equal(userID))
+			Expect(entry.Action).To(Equal(entity.AuditActionCreate))
+			Expect(entry.ResourceType).To(Equal(entity.AuditResourceEvent))
+			Expect(entry.ResourceID).To(Equal(eventID))
+		})
+
+		It("should restore another organizer's event for an admin", func() {
+			_, err := usecase.Restore(ctx, eventID, adminID, true)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(restored).To(BeTrue())
+		})
+
+		It("should reject users who do not manage the event", func() {
+			_, err := usecase.Restore(ctx, eventID, uuid.New(), false)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeForbidden))
+			Expect(restored).To(BeFalse())
+		})
+
+		It("should return not found when the event is not deleted", func() {
+			mockRepo.findDeletedByIDFunc = func(ctx context.Context, id uuid.UUID) (*entity.Event, error) {
+				return nil, apperrors.NotFound("event not found")
+			}
+
+			_, err := usecase.Restore(ctx, eventID, userID, false)
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			Expect(restored).To(BeFalse())
+		})
+	})
 })
@@ -0,0 +1,51 @@
+package event
+
+import (
+	"errors"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+)
+
+// eventValidationError converts the failures of validating an event to a validation error
+// listing each of them with the request field it concerns.
+func eventValidationError(err error) error {
+	var fields apperrors.FieldErrors
+	fields.AddError(err, eventErrorField)
+	return apperrors.Validation("event validation failed: " + fields.Summary()).WithValidationErrors(fields)
+}
+
+// eventErrorField maps an event validation error to the request field it concerns.
+func eventErrorField(err error) string {
+	switch {
+	case errors.Is(err, entity.ErrEventNameRequired), errors.Is(err, entity.ErrEventNameTooLong):
+		return "name"
+	case errors.Is(err, entity.ErrEventDescriptionTooLong):
+		return "description"
+	case errors.Is(err, entity.ErrEventStartDateRequired):
+		return "start_date"
+	case errors.Is(err, entity.ErrEventEndDateBeforeStart):
+		return "end_date"
+	case errors.Is(err, entity.ErrEventLocationTooLong):
+		return "location"
+	case errors.Is(err, entity.ErrEventTimezoneInvalid):
+		return "timezone"
+	case errors.Is(err, entity.ErrEventCurrencyInvalid):
+		return "currency"
+	case errors.Is(err, entity.ErrEventFeeTypeInvalid), errors.Is(err, entity.ErrEventFeeMismatch),
+		errors.Is(err, entity.ErrEventFeeAmountInvalid), errors.Is(err, entity.ErrEventFeeTiersRequired),
+		errors.Is(err, entity.ErrEventFeeTierNameInvalid), errors.Is(err, entity.ErrEventFeeTierAmountInvalid),
+		errors.Is(err, entity.ErrEventFeeTierDuplicate), errors.Is(err, entity.ErrEventFeeCurrencyMissing):
+		return "fee"
+	case errors.Is(err, entity.ErrEventConsentVersionNeeded), errors.Is(err, entity.ErrEventConsentVersionLong):
+		return "consent_version"
+	case errors.Is(err, entity.ErrEventTentativeExpiryRange):
+		return "tentative_expiry_hours"
+	case errors.Is(err, entity.ErrEventCapacityNegative), errors.Is(err, entity.ErrEventCapacityBelowTaken):
+		return "capacity"
+	case errors.Is(err, entity.ErrEventStatusInvalid):
+		return "status"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,80 @@
+package event
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// SetWebhook configures the webhook that receives an event's check-in and registration
+// notifications, replacing any existing one and clearing its last delivery. Its URL must
+// resolve to public addresses only.
+func (u *eventUsecase) SetWebhook(
+	ctx context.Context,
+	id uuid.UUID,
+	userID uuid.UUID,
+	isAdmin bool,
+	input WebhookInput,
+) (*entity.EventWebhook, error) {
+	if err := u.authorizeWebhook(ctx, id, userID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	webhook := &entity.EventWebhook{
+		EventID:   id,
+		URL:       input.URL,
+		Secret:    input.Secret,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if err := webhook.Validate(); err != nil {
+		return nil, apperrors.Validation(fmt.Sprintf("webhook validation failed: %v", err))
+	}
+	if u.webhookGuard != nil {
+		if err := u.webhookGuard.CheckURL(ctx, webhook.URL); err != nil {
+			return nil, apperrors.Validation(fmt.Sprintf("webhook validation failed: %v", err))
+		}
+	}
+
+	if err := u.webhookRepo.Upsert(ctx, webhook); err != nil {
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// GetWebhook retrieves the webhook of an event together with the outcome of its latest delivery.
+func (u *eventUsecase) GetWebhook(
+	ctx context.Context,
+	id uuid.UUID,
+	userID uuid.UUID,
+	isAdmin bool,
+) (*entity.EventWebhook, error) {
+	if err := u.authorizeWebhook(ctx, id, userID, isAdmin); err != nil {
+		return nil, err
+	}
+	return u.webhookRepo.FindByEventID(ctx, id)
+}
+
+// DeleteWebhook removes the webhook of an event. Notifications still waiting to be delivered
+// are dropped for the event once it has no webhook.
+func (u *eventUsecase) DeleteWebhook(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool) error {
+	if err := u.authorizeWebhook(ctx, id, userID, isAdmin); err != nil {
+		return err
+	}
+	return u.webhookRepo.Delete(ctx, id)
+}
+
+// authorizeWebhook checks that the event exists and that the user manages it.
+func (u *eventUsecase) authorizeWebhook(ctx context.Context, id uuid.UUID, userID uuid.UUID, isAdmin bool) error {
+	event, err := u.eventRepo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	return authz.RequireEventManager(userID, event, isAdmin, "manage webhooks for this event")
+}
@@ -0,0 +1,187 @@
+package event_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/event"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// SimpleEventWebhookRepositoryMock stores event webhooks in memory for testing
+type SimpleEventWebhookRepositoryMock struct {
+	webhooks map[uuid.UUID]*entity.EventWebhook
+}
+
+func (m *SimpleEventWebhookRepositoryMock) Upsert(_ context.Context, webhook *entity.EventWebhook) error {
+	if existing, ok := m.webhooks[webhook.EventID]; ok {
+		webhook.CreatedAt = existing.CreatedAt
+	}
+	m.webhooks[webhook.EventID] = webhook
+	return nil
+}
+
+func (m *SimpleEventWebhookRepositoryMock) FindByEventID(
+	_ context.Context,
+	eventID uuid.UUID,
+) (*entity.EventWebhook, error) {
+	webhook, ok := m.webhooks[eventID]
+	if !ok {
+		return nil, apperrors.NotFound("event webhook not found")
+	}
+	return webhook, nil
+}
+
+func (m *SimpleEventWebhookRepositoryMock) Delete(_ context.Context, eventID uuid.UUID) error {
+	if _, ok := m.webhooks[eventID]; !ok {
+		return apperrors.NotFound("event webhook not found")
+	}
+	delete(m.webhooks, eventID)
+	return nil
+}
+
+func (m *SimpleEventWebhookRepositoryMock) RecordDelivery(
+	_ context.Context,
+	_ uuid.UUID,
+	_ time.Time,
+	_ entity.WebhookDeliveryStatus,
+	_ string,
+) error {
+	return nil
+}
+
+// hostGuardMock rejects webhook URLs of a blocked host
+type hostGuardMock struct {
+	blocked string
+}
+
+func (m hostGuardMock) CheckURL(_ context.Context, rawURL string) error {
+	if strings.Contains(rawURL, m.blocked) {
+		return errors.New("webhook: destination address is not allowed")
+	}
+	return nil
+}
+
+var _ = Describe("Event webhook", func() {
+	var (
+		webhookRepo *SimpleEventWebhookRepositoryMock
+		usecase     event.Usecase
+		ctx         context.Context
+		ownerID     uuid.UUID
+		existing    *entity.Event
+		input       event.WebhookInput
+	)
+
+	BeforeEach(func() {
+		ownerID = uuid.New()
+		existing = newValidEvent(ownerID)
+		mockRepo := &SimpleEventRepositoryMock{
+			findByIDFunc: func(_ context.Context, _ uuid.UUID) (*entity.Event, error) {
+				return existing, nil
+			},
+		}
+		webhookRepo = &SimpleEventWebhookRepositoryMock{webhooks: map[uuid.UUID]*entity.EventWebhook{}}
+		usecase = event.NewUsecase(
+			mockRepo, webhookRepo, &SimpleOutboxRepositoryMock{}, passthroughTransactor{},
+			"JPY", event.StatsWarningThresholds{}, 365, hostGuardMock{blocked: "169.254.169.254"}, nil,
+		)
+		ctx = context.Background()
+		input = event.WebhookInput{URL: "https://hooks.example.com/ezqrin", Secret: "whsec_0123456789"}
+	})
+
+	Describe("SetWebhook", func() {
+		It("should store the webhook of the event", func() {
+			webhook, err := usecase.SetWebhook(ctx, existing.ID, ownerID, false, input)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(webhook.EventID).To(Equal(existing.ID))
+			Expect(webhookRepo.webhooks).To(HaveKey(existing.ID))
+			Expect(webhookRepo.webhooks[existing.ID].Secret).To(Equal("whsec_0123456789"))
+		})
+
+		It("should reject an invalid URL", func() {
+			input.URL = "hooks.example.com"
+
+			_, err := usecase.SetWebhook(ctx, existing.ID, ownerID, false, input)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+			Expect(webhookRepo.webhooks).To(BeEmpty())
+		})
+
+		It("should reject a URL the guard does not allow", func() {
+			input.URL = "http://169.254.169.254/latest/meta-data"
+
+			_, err := usecase.SetWebhook(ctx, existing.ID, ownerID, false, input)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+			Expect(webhookRepo.webhooks).To(BeEmpty())
+		})
+
+		It("should require a secret", func() {
+			input.Secret = ""
+
+			_, err := usecase.SetWebhook(ctx, existing.ID, ownerID, false, input)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+		})
+
+		It("should reject users who do not manage the event", func() {
+			_, err := usecase.SetWebhook(ctx, existing.ID, uuid.New(), false, input)
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			Expect(webhookRepo.webhooks).To(BeEmpty())
+		})
+
+		It("should allow admins", func() {
+			_, err := usecase.SetWebhook(ctx, existing.ID, uuid.New(), true, input)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("GetWebhook", func() {
+		It("should return the webhook of the event", func() {
+			_, err := usecase.SetWebhook(ctx, existing.ID, ownerID, false, input)
+			Expect(err).NotTo(HaveOccurred())
+
+			webhook, err := usecase.GetWebhook(ctx, existing.ID, ownerID, false)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(webhook.URL).To(Equal(input.URL))
+		})
+
+		It("should return not found when the event has no webhook", func() {
+			_, err := usecase.GetWebhook(ctx, existing.ID, ownerID, false)
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should reject users who do not manage the event", func() {
+			_, err := usecase.GetWebhook(ctx, existing.ID, uuid.New(), false)
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+
+	Describe("DeleteWebhook", func() {
+		It("should remove the webhook of the event", func() {
+			_, err := usecase.SetWebhook(ctx, existing.ID, ownerID, false, input)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(usecase.DeleteWebhook(ctx, existing.ID, ownerID, false)).To(Succeed())
+			Expect(webhookRepo.webhooks).To(BeEmpty())
+		})
+
+		It("should reject users who do not manage the event", func() {
+			err := usecase.DeleteWebhook(ctx, existing.ID, uuid.New(), false)
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,146 @@
+// Package expiry expires participants who stay tentative or invited for longer than their event allows.
+package expiry
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// ExpirerConfig controls how often the expirer looks for participants to expire.
+type ExpirerConfig struct {
+	// Interval is how long the expirer waits after expiring every due participant before looking again.
+	Interval time.Duration
+	// BatchSize is the maximum number of participants expired per run.
+	BatchSize int
+}
+
+// Expirer moves participants who stayed tentative or invited for longer than their event's
+// tentative expiry to expired status, so they no longer count toward the event's participants.
+// Events without a tentative expiry never expire their participants. Capacity counts confirmed
+// participants only, so an expiry frees no place and promotes no waitlisted participant.
+//
+// Each batch is expired in one transaction together with a participant.expired outbox message
+// per participant, which records the expiry and notifies webhook subscribers.
+type Expirer struct {
+	participantRepo repository.ParticipantRepository
+	outboxRepo      repository.OutboxRepository
+	transactor      repository.Transactor
+	cfg             ExpirerConfig
+	now             func() time.Time
+	logger          *logger.Logger
+}
+
+// NewExpirer creates a new participant expirer. now returns the current time; pass time.Now
+// outside of tests.
+func NewExpirer(
+	participantRepo repository.ParticipantRepository,
+	outboxRepo repository.OutboxRepository,
+	transactor repository.Transactor,
+	cfg ExpirerConfig,
+	now func() time.Time,
+	logger *logger.Logger,
+) *Expirer {
+	return &Expirer{
+		participantRepo: participantRepo,
+		outboxRepo:      outboxRepo,
+		transactor:      transactor,
+		cfg:             cfg,
+		now:             now,
+		logger:          logger,
+	}
+}
+
+// Run expires due participants until ctx is cancelled.
+// A full batch is followed immediately by another run so that a backlog drains quickly.
+func (e *Expirer) Run(ctx context.Context) {
+	e.logger.Info("participant expirer started",
+		zap.Duration("interval", e.cfg.Interval),
+		zap.Int("batch_size", e.cfg.BatchSize),
+	)
+	defer e.logger.Info("participant expirer stopped")
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		expired, err := e.ExpireDue(ctx)
+		if err != nil && ctx.Err() == nil {
+			e.logger.Error("failed to expire participants", zap.Error(err))
+		}
+
+		wait := e.cfg.Interval
+		if err == nil && expired == e.cfg.BatchSize {
+			wait = 0
+		}
+		timer.Reset(wait)
+	}
+}
+
+// ExpireDue expires one batch of due participants and returns the number expired.
+// If the batch fails, no participant of it is expired and it is retried on the next run.
+func (e *Expirer) ExpireDue(ctx context.Context) (int, error) {
+	now := e.now()
+
+	var expired []repository.ParticipantExpiry
+	err := e.transactor.WithTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		expired, err = e.participantRepo.ExpireDue(txCtx, now, e.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, x := range expired {
+			if err := e.enqueueParticipantExpired(txCtx, x, now); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, x := range expired {
+		e.logger.WithContext(ctx).Info("participant expired",
+			zap.String("participant_id", x.ParticipantID.String()),
+			zap.String("event_id", x.EventID.String()),
+			zap.String("previous_status", string(x.PreviousStatus)),
+		)
+	}
+
+	return len(expired), nil
+}
+
+// enqueueParticipantExpired writes the participant.expired outbox message for an expiry
+func (e *Expirer) enqueueParticipantExpired(
+	ctx context.Context,
+	expiry repository.ParticipantExpiry,
+	expiredAt time.Time,
+) error {
+	msg, err := entity.NewOutboxMessage(entity.OutboxEventParticipantExpired, expiry.ParticipantID,
+		entity.ParticipantExpiredPayload{
+			ParticipantID:  expiry.ParticipantID,
+			EventID:        expiry.EventID,
+			PreviousStatus: expiry.PreviousStatus,
+			ExpiredAt:      expiredAt,
+		})
+	if err != nil {
+		return err
+	}
+	if err := e.outboxRepo.Enqueue(ctx, msg); err != nil {
+		return fmt.Errorf("failed to enqueue participant expiry webhook: %w", err)
+	}
+	return nil
+}
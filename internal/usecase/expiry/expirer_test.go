@@ -0,0 +1,181 @@
+package expiry_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/expiry"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+// fakeClock is a manually advanced clock for the expirer
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+var _ = Describe("Expirer", func() {
+	const expiryHours = 48
+
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		participantRepo *mocks.MockParticipantRepository
+		outboxRepo      *mocks.MockOutboxRepository
+		clock           *fakeClock
+		expirer         *expiry.Expirer
+		event           *entity.Event
+		participants    []*entity.Participant
+		enqueued        []*entity.OutboxMessage
+		onEnqueue       func()
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		outboxRepo = mocks.NewMockOutboxRepository(ctrl)
+		transactor := mocks.NewMockTransactor(ctrl)
+		transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+		).AnyTimes()
+
+		registeredAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+		event = &entity.Event{ID: uuid.New(), TentativeExpiryHours: expiryHours, Status: entity.StatusPublished}
+		participants = []*entity.Participant{
+			{ID: uuid.New(), EventID: event.ID, Status: entity.ParticipantStatusTentative, CreatedAt: registeredAt},
+			{ID: uuid.New(), EventID: event.ID, Status: entity.ParticipantStatusInvited, CreatedAt: registeredAt},
+			{ID: uuid.New(), EventID: event.ID, Status: entity.ParticipantStatusConfirmed, CreatedAt: registeredAt},
+		}
+
+		// Behaves like the repository query: tentative and invited participants registered at
+		// least the event's tentative expiry ago are expired
+		participantRepo.EXPECT().ExpireDue(gomock.Any(), gomock.Any(), 10).DoAndReturn(
+			func(_ context.Context, now time.Time, _ int) ([]repository.ParticipantExpiry, error) {
+				expired := []repository.ParticipantExpiry{}
+				cutoff := now.Add(-time.Duration(event.TentativeExpiryHours) * time.Hour)
+				for _, p := range participants {
+					if p.CanExpire() && !p.CreatedAt.After(cutoff) {
+						expired = append(expired, repository.ParticipantExpiry{
+							ParticipantID: p.ID, EventID: p.EventID, PreviousStatus: p.Status,
+						})
+						p.Status = entity.ParticipantStatusExpired
+					}
+				}
+				return expired, nil
+			},
+		).AnyTimes()
+
+		enqueued = nil
+		onEnqueue = func() {}
+		outboxRepo.EXPECT().Enqueue(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, msg *entity.OutboxMessage) error {
+				enqueued = append(enqueued, msg)
+				onEnqueue()
+				return nil
+			},
+		).AnyTimes()
+
+		clock = &fakeClock{now: registeredAt}
+		expirer = expiry.NewExpirer(participantRepo, outboxRepo, transactor, expiry.ExpirerConfig{
+			Interval:  time.Minute,
+			BatchSize: 10,
+		}, clock.Now, &logger.Logger{Logger: zap.NewNop()})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("ExpireDue", func() {
+		When("the clock advances past the tentative expiry", func() {
+			It("should expire tentative and invited participants only once it has passed", func() {
+				clock.Advance(expiryHours*time.Hour - time.Minute)
+				expired, err := expirer.ExpireDue(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(expired).To(BeZero())
+				Expect(participants[0].Status).To(Equal(entity.ParticipantStatusTentative))
+
+				clock.Advance(time.Minute)
+				expired, err = expirer.ExpireDue(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(expired).To(Equal(2))
+				Expect(participants[0].Status).To(Equal(entity.ParticipantStatusExpired))
+				Expect(participants[1].Status).To(Equal(entity.ParticipantStatusExpired))
+				Expect(participants[2].Status).To(Equal(entity.ParticipantStatusConfirmed))
+
+				expired, err = expirer.ExpireDue(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(expired).To(BeZero())
+			})
+
+			It("should record a participant.expired message for each expired participant", func() {
+				clock.Advance(expiryHours * time.Hour)
+
+				_, err := expirer.ExpireDue(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(enqueued).To(HaveLen(2))
+				Expect(enqueued[0].EventType).To(Equal(entity.OutboxEventParticipantExpired))
+				Expect(enqueued[0].AggregateID).To(Equal(participants[0].ID))
+				var payload entity.ParticipantExpiredPayload
+				Expect(json.Unmarshal(enqueued[1].Payload, &payload)).To(Succeed())
+				Expect(payload).To(Equal(entity.ParticipantExpiredPayload{
+					ParticipantID:  participants[1].ID,
+					EventID:        event.ID,
+					PreviousStatus: entity.ParticipantStatusInvited,
+					ExpiredAt:      clock.Now(),
+				}))
+			})
+		})
+
+		When("expiring the batch fails", func() {
+			It("should return the error", func() {
+				failing := mocks.NewMockParticipantRepository(ctrl)
+				failing.EXPECT().ExpireDue(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("connection reset"))
+				transactor := mocks.NewMockTransactor(ctrl)
+				transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+				)
+				expirer = expiry.NewExpirer(failing, outboxRepo, transactor, expiry.ExpirerConfig{
+					Interval:  time.Minute,
+					BatchSize: 10,
+				}, clock.Now, &logger.Logger{Logger: zap.NewNop()})
+
+				expired, err := expirer.ExpireDue(ctx)
+				Expect(err).To(MatchError("connection reset"))
+				Expect(expired).To(BeZero())
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		It("should expire due participants until the context is cancelled", func() {
+			clock.Advance(expiryHours * time.Hour)
+			runCtx, cancel := context.WithCancel(ctx)
+			onEnqueue = cancel
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				expirer.Run(runCtx)
+			}()
+			Eventually(done).Should(BeClosed())
+			Expect(participants[0].Status).To(Equal(entity.ParticipantStatusExpired))
+		})
+	})
+})
@@ -0,0 +1,139 @@
+// Package outbox relays domain events recorded in the transactional outbox to webhook subscribers.
+package outbox
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/webhook"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// RelayConfig controls how often the relay polls the outbox and how it retries failed deliveries.
+type RelayConfig struct {
+	// PollInterval is how long the relay waits after draining the outbox before polling again.
+	PollInterval time.Duration
+	// BatchSize is the maximum number of messages claimed per poll.
+	BatchSize int
+	// Lease is how long a claimed message is hidden from other relays. A message whose
+	// relay crashed mid-delivery is retried once its lease expires.
+	Lease time.Duration
+	// MaxAttempts is the number of delivery attempts after which a message is abandoned.
+	MaxAttempts int
+	// RetryBaseDelay is the delay before the first retry; it doubles on every further attempt.
+	RetryBaseDelay time.Duration
+	// RetryMaxDelay caps the retry delay.
+	RetryMaxDelay time.Duration
+}
+
+// Relay delivers pending outbox messages through a webhook publisher with retries.
+type Relay struct {
+	outboxRepo repository.OutboxRepository
+	publisher  webhook.Publisher
+	cfg        RelayConfig
+	logger     *logger.Logger
+}
+
+// NewRelay creates a new outbox relay.
+func NewRelay(
+	outboxRepo repository.OutboxRepository,
+	publisher webhook.Publisher,
+	cfg RelayConfig,
+	logger *logger.Logger,
+) *Relay {
+	return &Relay{
+		outboxRepo: outboxRepo,
+		publisher:  publisher,
+		cfg:        cfg,
+		logger:     logger,
+	}
+}
+
+// Run polls and delivers outbox messages until ctx is cancelled.
+// A full batch is followed immediately by another poll so that a backlog drains quickly.
+func (r *Relay) Run(ctx context.Context) {
+	r.logger.Info("outbox relay started",
+		zap.Duration("poll_interval", r.cfg.PollInterval),
+		zap.Int("batch_size", r.cfg.BatchSize),
+	)
+	defer r.logger.Info("outbox relay stopped")
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		processed, err := r.ProcessBatch(ctx)
+		if err != nil && ctx.Err() == nil {
+			r.logger.Error("failed to process outbox batch", zap.Error(err))
+		}
+
+		wait := r.cfg.PollInterval
+		if err == nil && processed == r.cfg.BatchSize {
+			wait = 0
+		}
+		timer.Reset(wait)
+	}
+}
+
+// ProcessBatch claims one batch of due messages and attempts to deliver each of them.
+// It returns the number of messages claimed.
+func (r *Relay) ProcessBatch(ctx context.Context) (int, error) {
+	messages, err := r.outboxRepo.ClaimDue(ctx, time.Now(), r.cfg.Lease, r.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, msg := range messages {
+		log := r.logger.WithContext(ctx).WithFields(
+			zap.String("outbox_id", msg.ID.String()),
+			zap.String("event_type", string(msg.EventType)),
+			zap.Int("attempt", msg.Attempts),
+		)
+
+		publishErr := r.publisher.Publish(ctx, msg)
+		now := time.Now()
+
+		// Bookkeeping errors are only logged: the message stays leased and is
+		// retried once the lease expires, preserving at-least-once delivery.
+		switch {
+		case publishErr == nil:
+			if err := r.outboxRepo.MarkDelivered(ctx, msg.ID, now); err != nil {
+				log.Error("failed to mark outbox message delivered", zap.Error(err))
+			}
+		case msg.Attempts >= r.cfg.MaxAttempts:
+			log.Error("giving up on outbox message", zap.Error(publishErr))
+			if err := r.outboxRepo.MarkFailed(ctx, msg.ID, now, publishErr.Error()); err != nil {
+				log.Error("failed to mark outbox message failed", zap.Error(err))
+			}
+		default:
+			nextAttemptAt := now.Add(r.retryDelay(msg.Attempts))
+			log.Warn("outbox delivery failed, will retry",
+				zap.Time("next_attempt_at", nextAttemptAt),
+				zap.Error(publishErr),
+			)
+			err := r.outboxRepo.ScheduleRetry(ctx, msg.ID, nextAttemptAt, publishErr.Error(), msg.DeliveredTo)
+			if err != nil {
+				log.Error("failed to schedule outbox retry", zap.Error(err))
+			}
+		}
+	}
+
+	return len(messages), nil
+}
+
+// retryDelay returns the exponential backoff delay after the given number of attempts.
+func (r *Relay) retryDelay(attempts int) time.Duration {
+	delay := r.cfg.RetryBaseDelay
+	for i := 1; i < attempts && delay < r.cfg.RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, r.cfg.RetryMaxDelay)
+}
@@ -0,0 +1,229 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	webhookMocks "github.com/fumkob/ezqrin-server/internal/domain/webhook/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/outbox"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Relay", func() {
+	var (
+		ctrl       *gomock.Controller
+		ctx        context.Context
+		outboxRepo *mocks.MockOutboxRepository
+		publisher  *webhookMocks.MockPublisher
+		cfg        outbox.RelayConfig
+		relay      *outbox.Relay
+		msg        *entity.OutboxMessage
+	)
+
+	newRelay := func() *outbox.Relay {
+		return outbox.NewRelay(outboxRepo, publisher, cfg, &logger.Logger{Logger: zap.NewNop()})
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		outboxRepo = mocks.NewMockOutboxRepository(ctrl)
+		publisher = webhookMocks.NewMockPublisher(ctrl)
+		cfg = outbox.RelayConfig{
+			PollInterval:   time.Hour,
+			BatchSize:      10,
+			Lease:          time.Minute,
+			MaxAttempts:    5,
+			RetryBaseDelay: time.Second,
+			RetryMaxDelay:  10 * time.Second,
+		}
+		relay = newRelay()
+
+		aggregateID := uuid.New()
+		var err error
+		msg, err = entity.NewOutboxMessage(
+			entity.OutboxEventCheckinCreated,
+			aggregateID,
+			entity.CheckinCreatedPayload{CheckinID: aggregateID},
+		)
+		Expect(err).NotTo(HaveOccurred())
+		msg.Attempts = 1
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("ProcessBatch", func() {
+		When("the webhook is delivered", func() {
+			It("should mark the message delivered", func() {
+				outboxRepo.EXPECT().ClaimDue(ctx, gomock.Any(), time.Minute, 10).Return([]*entity.OutboxMessage{msg}, nil)
+				publisher.EXPECT().Publish(ctx, msg).Return(nil)
+				outboxRepo.EXPECT().MarkDelivered(ctx, msg.ID, gomock.Any()).Return(nil)
+
+				processed, err := relay.ProcessBatch(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(processed).To(Equal(1))
+			})
+		})
+
+		When("the webhook fails", func() {
+			DescribeTable("should schedule a retry with exponential backoff",
+				func(attempts int, expectedDelay time.Duration) {
+					msg.Attempts = attempts
+					outboxRepo.EXPECT().ClaimDue(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+						Return([]*entity.OutboxMessage{msg}, nil)
+					publisher.EXPECT().Publish(ctx, msg).Return(errors.New("webhook returned 500"))
+
+					var nextAttemptAt time.Time
+					outboxRepo.EXPECT().ScheduleRetry(ctx, msg.ID, gomock.Any(), "webhook returned 500", gomock.Any()).DoAndReturn(
+						func(_ context.Context, _ uuid.UUID, at time.Time, _ string, _ []string) error {
+							nextAttemptAt = at
+							return nil
+						},
+					)
+
+					before := time.Now()
+					_, err := relay.ProcessBatch(ctx)
+					after := time.Now()
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(nextAttemptAt).To(BeTemporally(">=", before.Add(expectedDelay)))
+					Expect(nextAttemptAt).To(BeTemporally("<=", after.Add(expectedDelay)))
+				},
+				Entry("first attempt", 1, time.Second),
+				Entry("third attempt", 3, 4*time.Second),
+				Entry("fourth attempt", 4, 8*time.Second),
+			)
+
+			It("should keep the destinations that accepted the message for the retry", func() {
+				outboxRepo.EXPECT().ClaimDue(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]*entity.OutboxMessage{msg}, nil)
+				publisher.EXPECT().Publish(ctx, msg).DoAndReturn(
+					func(_ context.Context, m *entity.OutboxMessage) error {
+						m.MarkDeliveredTo("https://a.example.com/hook")
+						return errors.New("webhook returned 500")
+					},
+				)
+				outboxRepo.EXPECT().
+					ScheduleRetry(ctx, msg.ID, gomock.Any(), gomock.Any(), []string{"https://a.example.com/hook"}).
+					Return(nil)
+
+				_, err := relay.ProcessBatch(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should cap the retry delay at the maximum", func() {
+				cfg.MaxAttempts = 10
+				relay = newRelay()
+				msg.Attempts = 8
+				outboxRepo.EXPECT().ClaimDue(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]*entity.OutboxMessage{msg}, nil)
+				publisher.EXPECT().Publish(ctx, msg).Return(errors.New("timeout"))
+
+				var nextAttemptAt time.Time
+				outboxRepo.EXPECT().ScheduleRetry(ctx, msg.ID, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, at time.Time, _ string, _ []string) error {
+						nextAttemptAt = at
+						return nil
+					},
+				)
+
+				_, err := relay.ProcessBatch(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(nextAttemptAt).To(BeTemporally("~", time.Now().Add(10*time.Second), time.Second))
+			})
+		})
+
+		When("the webhook fails on the last allowed attempt", func() {
+			It("should abandon the message", func() {
+				msg.Attempts = 5
+				outboxRepo.EXPECT().ClaimDue(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]*entity.OutboxMessage{msg}, nil)
+				publisher.EXPECT().Publish(ctx, msg).Return(errors.New("gone"))
+				outboxRepo.EXPECT().MarkFailed(ctx, msg.ID, gomock.Any(), "gone").Return(nil)
+
+				_, err := relay.ProcessBatch(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("claiming messages fails", func() {
+			It("should return the error", func() {
+				outboxRepo.EXPECT().ClaimDue(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(nil, errors.New("db down"))
+
+				_, err := relay.ProcessBatch(ctx)
+
+				Expect(err).To(MatchError("db down"))
+			})
+		})
+
+		When("the relay crashed before recording a delivery", func() {
+			It("should deliver the message when it is claimed again", func() {
+				crashCtx, crash := context.WithCancel(ctx)
+
+				// The first relay claims the message, then the process dies mid-delivery:
+				// neither the delivery nor the retry is recorded, leaving the message leased.
+				outboxRepo.EXPECT().ClaimDue(crashCtx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]*entity.OutboxMessage{msg}, nil)
+				publisher.EXPECT().Publish(crashCtx, msg).DoAndReturn(
+					func(ctx context.Context, _ *entity.OutboxMessage) error {
+						crash()
+						return ctx.Err()
+					},
+				)
+				outboxRepo.EXPECT().ScheduleRetry(crashCtx, msg.ID, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(context.Canceled)
+
+				_, err := relay.ProcessBatch(crashCtx)
+				Expect(err).NotTo(HaveOccurred())
+
+				// After the lease expires a restarted relay claims the same message again.
+				retried := *msg
+				retried.Attempts = 2
+				outboxRepo.EXPECT().ClaimDue(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return([]*entity.OutboxMessage{&retried}, nil)
+				publisher.EXPECT().Publish(ctx, &retried).Return(nil)
+				outboxRepo.EXPECT().MarkDelivered(ctx, msg.ID, gomock.Any()).Return(nil)
+
+				processed, err := newRelay().ProcessBatch(ctx)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(processed).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		It("should poll until the context is cancelled", func() {
+			runCtx, cancel := context.WithCancel(ctx)
+			outboxRepo.EXPECT().ClaimDue(runCtx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(context.Context, time.Time, time.Duration, int) ([]*entity.OutboxMessage, error) {
+					cancel()
+					return nil, nil
+				},
+			)
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				relay.Run(runCtx)
+			}()
+
+			Eventually(done).Should(BeClosed())
+		})
+	})
+})
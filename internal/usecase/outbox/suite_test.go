@@ -0,0 +1,13 @@
+package outbox_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestOutboxRelay(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Outbox Relay Suite")
+}
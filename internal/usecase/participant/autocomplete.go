@@ -0,0 +1,82 @@
+package participant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+const (
+	// AutocompleteLimit is the maximum number of matches Autocomplete returns
+	AutocompleteLimit = 10
+	// MaxAutocompleteQueryLength is the maximum length of an autocomplete query in characters
+	MaxAutocompleteQueryLength = 100
+)
+
+// Autocomplete suggests the participants of an event whose name starts with query, for staff
+// looking up a participant without a QR code at manual check-in. Participants who have not
+// checked in yet come first. Emails are masked since the suggestions are shown on screen at
+// the venue.
+func (u *participantUsecase) Autocomplete(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+	query string,
+) ([]AutocompleteMatch, error) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return nil, apperrors.BadRequest("query must not be empty")
+	}
+	if utf8.RuneCountInString(query) > MaxAutocompleteQueryLength {
+		return nil, apperrors.BadRequest(
+			fmt.Sprintf("query must be at most %d characters", MaxAutocompleteQueryLength),
+		)
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only, the same as manual check-in
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view participants for this event"); err != nil {
+		return nil, err
+	}
+
+	suggestions, err := u.participantRepo.SuggestByName(ctx, eventID, query, AutocompleteLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]AutocompleteMatch, 0, len(suggestions))
+	for _, s := range suggestions {
+		matches = append(matches, AutocompleteMatch{
+			ID:          s.ID,
+			Name:        s.Name,
+			EmailMasked: maskEmail(s.Email),
+			CheckedIn:   s.CheckedIn,
+		})
+	}
+	return matches, nil
+}
+
+// maskEmail keeps the first character of an email's local part and its domain, so that staff
+// can tell participants with the same name apart without the address being readable on
+// screen: "taro@example.com" becomes "t***@example.com".
+func maskEmail(email string) string {
+	if email == "" {
+		return ""
+	}
+	local, domain, found := strings.Cut(email, "@")
+	if !found || local == "" {
+		return "***"
+	}
+	first, _ := utf8.DecodeRuneInString(local)
+	return string(first) + "***@" + domain
+}
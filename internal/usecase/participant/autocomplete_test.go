@@ -0,0 +1,105 @@
+package participant_test
+
+import (
+	"context"
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Autocomplete", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	When("participants match the query", func() {
+		It("returns the matches in repository order with masked emails", func() {
+			pending := repository.ParticipantSuggestion{ID: uuid.New(), Name: "Taro Yamada", Email: "taro@example.com"}
+			noEmail := repository.ParticipantSuggestion{ID: uuid.New(), Name: "Takeshi Sato"}
+			checkedIn := repository.ParticipantSuggestion{
+				ID: uuid.New(), Name: "Tanaka Ichiro", Email: "ichiro@example.jp", CheckedIn: true,
+			}
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().SuggestByName(ctx, event.ID, "Ta", participant.AutocompleteLimit).
+				Return([]repository.ParticipantSuggestion{pending, noEmail, checkedIn}, nil)
+
+			matches, err := uc.Autocomplete(ctx, organizerID, false, event.ID, "  Ta ")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(Equal([]participant.AutocompleteMatch{
+				{ID: pending.ID, Name: "Taro Yamada", EmailMasked: "t***@example.com"},
+				{ID: noEmail.ID, Name: "Takeshi Sato"},
+				{ID: checkedIn.ID, Name: "Tanaka Ichiro", EmailMasked: "i***@example.jp", CheckedIn: true},
+			}))
+		})
+	})
+
+	When("nothing matches", func() {
+		It("returns an empty list", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().SuggestByName(ctx, event.ID, "Zz", participant.AutocompleteLimit).Return(nil, nil)
+
+			matches, err := uc.Autocomplete(ctx, uuid.New(), true, event.ID, "Zz")
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(BeEmpty())
+		})
+	})
+
+	DescribeTable("rejects invalid queries without querying",
+		func(query string) {
+			_, err := uc.Autocomplete(ctx, organizerID, false, event.ID, query)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		},
+		Entry("empty", ""),
+		Entry("blank", "   "),
+		Entry("too long", strings.Repeat("a", participant.MaxAutocompleteQueryLength+1)),
+	)
+
+	When("the user does not manage the event", func() {
+		It("returns a forbidden error", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+			_, err := uc.Autocomplete(ctx, uuid.New(), false, event.ID, "Ta")
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+
+	When("the event does not exist", func() {
+		It("returns the not found error", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(nil, apperrors.NotFound("event not found"))
+
+			_, err := uc.Autocomplete(ctx, organizerID, false, event.ID, "Ta")
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package participant
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode/badge"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// GetBadgeSheet prepares a printable PDF sheet with the badges of an event's participants.
+// Participants without a QR code, i.e. invited participants who have not accepted yet, are
+// left out. The sheet is rendered by the caller, so it can be streamed.
+func (u *participantUsecase) GetBadgeSheet(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input BadgeSheetInput,
+) (*badge.Sheet, error) {
+	event, err := u.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := authz.RequireEventManager(userID, event, isAdmin, "print badges for this event"); err != nil {
+		return nil, err
+	}
+
+	perPage := input.PerPage
+	if perPage == 0 {
+		perPage = badge.DefaultPerPage
+	}
+	if perPage < 1 || perPage > badge.MaxPerPage {
+		return nil, apperrors.BadRequest("invalid per_page: must be between 1 and 10")
+	}
+
+	participants, err := u.participantRepo.FindAllByEventID(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	badges := make([]badge.Badge, 0, len(participants))
+	for _, p := range participants {
+		if p.QRCode == "" || (input.Status != nil && p.Status != *input.Status) {
+			continue
+		}
+		badges = append(badges, badge.Badge{Name: p.Name, Email: p.Email, QRCode: p.QRCode})
+	}
+
+	return badge.NewSheet(u.qrGenerator, badges, perPage)
+}
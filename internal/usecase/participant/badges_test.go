@@ -0,0 +1,116 @@
+package participant_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("GetBadgeSheet", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+		participants    []*entity.Participant
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID}
+
+		participants = nil
+		for i := range 9 {
+			p := makeParticipant(uuid.New(), event.ID)
+			p.Name = fmt.Sprintf("Participant %d", i+1)
+			p.QRCode = fmt.Sprintf("qr-token-%d", i+1)
+			participants = append(participants, p)
+		}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("should lay out the participants 8 to a page by default", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().FindAllByEventID(ctx, event.ID).Return(participants, nil)
+
+		sheet, err := uc.GetBadgeSheet(ctx, organizerID, false, participant.BadgeSheetInput{EventID: event.ID})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sheet.Pages()).To(Equal(2))
+	})
+
+	It("should only print participants with the requested status", func() {
+		participants[0].Status = entity.ParticipantStatusTentative
+		participants[1].Status = entity.ParticipantStatusCancelled
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().FindAllByEventID(ctx, event.ID).Return(participants, nil)
+		confirmed := entity.ParticipantStatusConfirmed
+
+		sheet, err := uc.GetBadgeSheet(ctx, organizerID, false, participant.BadgeSheetInput{
+			EventID: event.ID, PerPage: 7, Status: &confirmed,
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sheet.Pages()).To(Equal(1))
+		var buf bytes.Buffer
+		Expect(sheet.Render(ctx, &buf)).To(Succeed())
+		Expect(buf.String()).NotTo(ContainSubstring("(Participant 1)"))
+		Expect(buf.String()).NotTo(ContainSubstring("(Participant 2)"))
+		Expect(buf.String()).To(ContainSubstring("(Participant 9)"))
+	})
+
+	It("should leave out participants without a QR code", func() {
+		participants[0].Status = entity.ParticipantStatusInvited
+		participants[0].QRCode = ""
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().FindAllByEventID(ctx, event.ID).Return(participants, nil)
+
+		sheet, err := uc.GetBadgeSheet(ctx, organizerID, false, participant.BadgeSheetInput{EventID: event.ID})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(sheet.Pages()).To(Equal(1))
+	})
+
+	It("should reject a number of badges per page out of range", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+		_, err := uc.GetBadgeSheet(ctx, organizerID, false, participant.BadgeSheetInput{EventID: event.ID, PerPage: 11})
+
+		Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+	})
+
+	It("should reject users who do not manage the event", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+		_, err := uc.GetBadgeSheet(ctx, uuid.New(), false, participant.BadgeSheetInput{EventID: event.ID})
+
+		Expect(apperrors.IsForbidden(err)).To(BeTrue())
+	})
+
+	It("should let admins print the badges of any event", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().FindAllByEventID(ctx, event.ID).Return(participants, nil)
+
+		_, err := uc.GetBadgeSheet(ctx, uuid.New(), true, participant.BadgeSheetInput{EventID: event.ID})
+
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
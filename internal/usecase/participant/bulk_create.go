@@ -3,16 +3,22 @@ package participant
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"time"
 
+	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/google/uuid"
 )
 
-// BulkCreate creates multiple participants with partial success support
+// BulkCreate creates multiple participants with partial success support. An atomic bulk
+// creation instead creates them in one transaction and rolls it back at the first failure, which
+// the returned error names as its *BulkCreateAbortedError cause.
 func (u *participantUsecase) BulkCreate(
 	ctx context.Context,
 	userID uuid.UUID,
@@ -26,8 +32,8 @@ func (u *participantUsecase) BulkCreate(
 	}
 
 	// Authorization: event owner or admin only
-	if !isAdmin && event.OrganizerID != userID {
-		return BulkCreateOutput{}, apperrors.Forbidden("you do not have permission to add participants to this event")
+	if err := authz.RequireEventManager(userID, event, isAdmin, "add participants to this event"); err != nil {
+		return BulkCreateOutput{}, err
 	}
 
 	// Initialize output
@@ -36,30 +42,73 @@ func (u *participantUsecase) BulkCreate(
 		Errors:       make([]BulkCreateError, 0),
 	}
 
-	// Process each participant
-	for i, participantInput := range input.Participants {
-		err := u.processSingleParticipant(
-			ctx, i, participantInput, input.EventID, input.SkipDuplicates, &output,
-		)
-		if err != nil {
-			// Error already recorded in output
-			continue
+	// Generate participant IDs first so that all QR tokens are issued in one batch
+	participantIDs := make([]uuid.UUID, len(input.Participants))
+	for i := range participantIDs {
+		participantIDs[i] = uuid.New()
+	}
+	qrTokens, err := u.qrTokens.IssueBatch(ctx, event.ID, participantIDs)
+	if err != nil {
+		return BulkCreateOutput{}, err
+	}
+
+	// Process each participant, looking each email domain up once
+	checkedDomains := make(map[string]domainemail.DomainStatus)
+	createAll := func(ctx context.Context) error {
+		for i, participantInput := range input.Participants {
+			// Errors are recorded in output
+			_ = u.processSingleParticipant(
+				ctx, i, participantInput, event, participantIDs[i], qrTokens[participantIDs[i]],
+				input, checkedDomains, &output,
+			)
+			if input.Atomic && output.FailedCount > 0 {
+				return bulkCreateAborted(output.Errors[0])
+			}
 		}
+		return nil
+	}
+	if input.Atomic {
+		err = u.transactor.WithTransaction(ctx, createAll)
+	} else {
+		err = createAll(ctx)
+	}
+	if err != nil {
+		return BulkCreateOutput{}, err
 	}
 
+	for _, participant := range output.Participants {
+		u.auditor.Record(ctx, userID, entity.AuditActionCreate, entity.AuditResourceParticipant, participant.ID,
+			nil, participant.AuditFields())
+	}
 	return output, nil
 }
 
+// bulkCreateAborted returns the error rolling back an atomic bulk creation at failure.
+func bulkCreateAborted(failure BulkCreateError) error {
+	return apperrors.WrapAppError(
+		apperrors.Unprocessable(fmt.Sprintf(
+			"participant at index %d could not be created, so none were: %s", failure.Index, failure.Message,
+		)),
+		&BulkCreateAbortedError{Failure: failure},
+	)
+}
+
 // processSingleParticipant processes a single participant in bulk creation
 func (u *participantUsecase) processSingleParticipant(
 	ctx context.Context,
 	index int,
 	input CreateParticipantInput,
-	eventID uuid.UUID,
-	skipDuplicates bool,
+	event *entity.Event,
+	participantID uuid.UUID,
+	qrToken string,
+	bulk BulkCreateInput,
+	checkedDomains map[string]domainemail.DomainStatus,
 	output *BulkCreateOutput,
 ) error {
-	participant, err := u.buildParticipantEntity(input, eventID)
+	participant, err := u.buildParticipantEntity(input, event, participantID, qrToken)
+	if err == nil {
+		err = u.checkEmailDomain(ctx, participant, checkedDomains)
+	}
 	if err != nil {
 		output.FailedCount++
 		output.Errors = append(output.Errors, BulkCreateError{
@@ -70,8 +119,9 @@ func (u *participantUsecase) processSingleParticipant(
 		return err
 	}
 
-	if err := u.participantRepo.Create(ctx, participant); err != nil {
-		if skipDuplicates && apperrors.IsConflict(err) {
+	err = u.createBulkParticipant(ctx, participant, event, bulk)
+	if err != nil {
+		if bulk.SkipDuplicates && apperrors.IsConflict(err) && !errors.Is(err, repository.ErrEventFull) {
 			output.SkippedCount++
 			output.SkippedRows = append(output.SkippedRows, BulkCreateError{
 				Index:   index,
@@ -80,33 +130,56 @@ func (u *participantUsecase) processSingleParticipant(
 			})
 			return err
 		}
+		message := fmt.Sprintf("database error: %v", err)
+		if errors.Is(err, repository.ErrEventFull) {
+			message = "event is at capacity"
+		}
 		output.FailedCount++
 		output.Errors = append(output.Errors, BulkCreateError{
 			Index:   index,
 			Email:   input.Email,
-			Message: fmt.Sprintf("database error: %v", err),
+			Message: message,
 		})
 		return err
 	}
 
 	output.CreatedCount++
 	output.Participants = append(output.Participants, participant)
+	for _, warning := range participant.Warnings {
+		output.Warnings = append(output.Warnings, BulkCreateError{Index: index, Email: input.Email, Message: warning})
+	}
 	return nil
 }
 
+// createBulkParticipant stores a participant of a bulk creation, within the event's capacity as
+// for a single creation. A failed insert aborts the transaction of an atomic bulk creation, so
+// duplicates to skip are looked for beforehand.
+func (u *participantUsecase) createBulkParticipant(
+	ctx context.Context,
+	participant *entity.Participant,
+	event *entity.Event,
+	bulk BulkCreateInput,
+) error {
+	if bulk.Atomic && bulk.SkipDuplicates {
+		exists, err := u.participantRepo.ExistsByEmail(ctx, participant.EventID, participant.Email)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return apperrors.Conflict("participant with this email already exists for this event")
+		}
+	}
+	return u.createWithinCapacity(ctx, participant, event)
+}
+
 // buildParticipantEntity builds a participant entity from input with validation
 func (u *participantUsecase) buildParticipantEntity(
 	input CreateParticipantInput,
-	eventID uuid.UUID,
+	event *entity.Event,
+	participantID uuid.UUID,
+	qrToken string,
 ) (*entity.Participant, error) {
-	// Generate participant ID first so it can be embedded in the QR token
-	participantID := uuid.New()
-
-	// Generate QR code token with structured format
-	qrToken, err := crypto.GenerateParticipantQRToken(eventID, participantID, u.qrHMACSecret)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate QR token: %w", err)
-	}
+	eventID := event.ID
 
 	// Convert metadata string to json.RawMessage
 	var metadata *json.RawMessage
@@ -133,12 +206,18 @@ func (u *participantUsecase) buildParticipantEntity(
 		PaymentStatus:     input.PaymentStatus,
 		PaymentAmount:     input.PaymentAmount,
 		PaymentDate:       input.PaymentDate,
+		Notes:             input.Notes,
+		Tags:              entity.NormalizeParticipantTags(input.Tags),
+		GroupName:         entity.NormalizeParticipantGroupName(input.GroupName),
+		CustomData:        normalizeCustomData(input.CustomData),
 		CreatedAt:         now,
 		UpdatedAt:         now,
 	}
 
-	// Validate participant
-	if err := participant.Validate(); err != nil {
+	if err := validateNewParticipant(participant, event, input.FeeTier); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := event.ValidateCustomData(participant.CustomData); err != nil {
 		return nil, fmt.Errorf("validation failed: %w", err)
 	}
 
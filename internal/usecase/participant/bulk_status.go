@@ -0,0 +1,135 @@
+package participant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/fumkob/ezqrin-server/internal/usecase/uuids"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// MaxBulkStatusParticipants is the maximum number of participant IDs in one bulk status update
+const MaxBulkStatusParticipants = 1000
+
+// errInvitedStatusReserved rejects moving participants to invited, which only invitations do
+var errInvitedStatusReserved = errors.New("participants are invited only by invitations")
+
+// BulkUpdateStatus moves many participants of an event to a status at once, e.g. to confirm a
+// batch of tentative registrants. Every ID must belong to a participant of the event. Participants
+// already in the status, or whose status cannot be changed by an update (see Update), are skipped.
+// The statuses are changed in a single statement. Confirming more participants than an event with
+// a capacity has places left is rejected with a conflict, without changing any participant.
+func (u *participantUsecase) BulkUpdateStatus(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input BulkUpdateStatusInput,
+) (*BulkUpdateStatusOutput, error) {
+	switch {
+	case len(input.ParticipantIDs) == 0:
+		return nil, apperrors.BadRequest("participant_ids must not be empty")
+	case len(input.ParticipantIDs) > MaxBulkStatusParticipants:
+		return nil, apperrors.BadRequest(
+			fmt.Sprintf("at most %d participant_ids can be updated at once", MaxBulkStatusParticipants),
+		)
+	}
+	if err := validateBulkStatus(input.Status); err != nil {
+		return nil, apperrors.Validation(err.Error()).WithValidationErrors(
+			[]apperrors.ValidationError{{Field: "status", Message: err.Error()}},
+		)
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := authz.RequireEventManager(userID, event, isAdmin, "update participants of this event"); err != nil {
+		return nil, err
+	}
+
+	ids := uuids.Unique(input.ParticipantIDs)
+	participants, err := u.participantRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*entity.Participant, len(participants))
+	for _, participant := range participants {
+		if participant.EventID == event.ID {
+			byID[participant.ID] = participant
+		}
+	}
+
+	eligible := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		participant, ok := byID[id]
+		if !ok {
+			return nil, apperrors.BadRequest(fmt.Sprintf("participant %s does not belong to this event", id))
+		}
+		if canBulkUpdateStatus(participant, input.Status) {
+			eligible = append(eligible, id)
+		}
+	}
+
+	output := &BulkUpdateStatusOutput{SkippedCount: int64(len(ids))}
+	if len(eligible) == 0 {
+		return output, nil
+	}
+
+	// Participants moved to the status concurrently since they were read are skipped by the update
+	var updated []uuid.UUID
+	if event.HasCapacity() {
+		updated, err = u.participantRepo.UpdateStatusWithinCapacity(ctx, event.ID, eligible, input.Status)
+	} else {
+		updated, err = u.participantRepo.UpdateStatus(ctx, event.ID, eligible, input.Status)
+	}
+	if err != nil {
+		return nil, err
+	}
+	output.UpdatedCount = int64(len(updated))
+	output.SkippedCount -= output.UpdatedCount
+
+	for _, id := range updated {
+		participant := byID[id]
+		before := participant.AuditFields()
+		participant.Status = input.Status
+		u.auditor.Record(ctx, userID, entity.AuditActionUpdate, entity.AuditResourceParticipant, id,
+			before, participant.AuditFields())
+	}
+	return output, nil
+}
+
+// validateBulkStatus checks that participants can be moved to status by a bulk update.
+// Participants expire only automatically and are invited only by invitations.
+func validateBulkStatus(status entity.ParticipantStatus) error {
+	probe := entity.Participant{Status: status}
+	switch {
+	case !probe.IsValidStatus():
+		return entity.ErrParticipantStatusInvalid
+	case probe.IsExpired():
+		return entity.ErrParticipantExpiredStatusReserved
+	case probe.IsInvited():
+		return errInvitedStatusReserved
+	}
+	return nil
+}
+
+// canBulkUpdateStatus reports whether participant changes to status, applying the rules of Update:
+// invited participants only leave their status by accepting, waitlisted participants are confirmed
+// by promoting them and expired invitations have no QR code to reinstate.
+func canBulkUpdateStatus(participant *entity.Participant, status entity.ParticipantStatus) bool {
+	switch {
+	case participant.Status == status:
+		return false
+	case participant.IsInvited():
+		return false
+	case participant.IsWaitlisted() && status == entity.ParticipantStatusConfirmed:
+		return false
+	case participant.IsExpired() && participant.QRCode == "":
+		return false
+	}
+	return true
+}
@@ -0,0 +1,172 @@
+package participant_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("BulkUpdateStatus", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+		tentative       *entity.Participant
+		confirmed       *entity.Participant
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID}
+		tentative = makeParticipant(uuid.New(), event.ID)
+		tentative.Status = entity.ParticipantStatusTentative
+		confirmed = makeParticipant(uuid.New(), event.ID)
+		confirmed.Status = entity.ParticipantStatusConfirmed
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	confirm := func(ids ...uuid.UUID) (*participant.BulkUpdateStatusOutput, error) {
+		return uc.BulkUpdateStatus(ctx, organizerID, false, participant.BulkUpdateStatusInput{
+			EventID:        event.ID,
+			ParticipantIDs: ids,
+			Status:         entity.ParticipantStatusConfirmed,
+		})
+	}
+
+	When("the organizer confirms a batch of participants", func() {
+		It("updates those not yet confirmed and counts the others as skipped", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{tentative.ID, confirmed.ID}).
+				Return([]*entity.Participant{tentative, confirmed}, nil)
+			participantRepo.EXPECT().
+				UpdateStatus(ctx, event.ID, []uuid.UUID{tentative.ID}, entity.ParticipantStatusConfirmed).
+				Return([]uuid.UUID{tentative.ID}, nil)
+
+			output, err := confirm(tentative.ID, confirmed.ID, tentative.ID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*output).To(Equal(participant.BulkUpdateStatusOutput{UpdatedCount: 1, SkippedCount: 1}))
+		})
+	})
+
+	When("the event has a capacity", func() {
+		BeforeEach(func() {
+			event.Capacity = 10
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{tentative.ID}).
+				Return([]*entity.Participant{tentative}, nil)
+		})
+
+		It("updates the participants within the capacity", func() {
+			participantRepo.EXPECT().
+				UpdateStatusWithinCapacity(ctx, event.ID, []uuid.UUID{tentative.ID}, entity.ParticipantStatusConfirmed).
+				Return([]uuid.UUID{tentative.ID}, nil)
+
+			output, err := confirm(tentative.ID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.UpdatedCount).To(Equal(int64(1)))
+		})
+
+		It("returns the conflict when the event has too few places left", func() {
+			participantRepo.EXPECT().
+				UpdateStatusWithinCapacity(ctx, event.ID, []uuid.UUID{tentative.ID}, entity.ParticipantStatusConfirmed).
+				Return(nil, apperrors.Conflict("event is at capacity"))
+
+			_, err := confirm(tentative.ID)
+
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+			Expect(tentative.Status).To(Equal(entity.ParticipantStatusTentative))
+		})
+	})
+
+	When("no participant can be moved to the status", func() {
+		It("skips them all without updating", func() {
+			invited := makeParticipant(uuid.New(), event.ID)
+			invited.Status = entity.ParticipantStatusInvited
+			waitlisted := makeParticipant(uuid.New(), event.ID)
+			waitlisted.Status = entity.ParticipantStatusWaitlisted
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().FindByIDs(ctx, gomock.Any()).
+				Return([]*entity.Participant{invited, waitlisted, confirmed}, nil)
+
+			output, err := confirm(invited.ID, waitlisted.ID, confirmed.ID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*output).To(Equal(participant.BulkUpdateStatusOutput{SkippedCount: 3}))
+		})
+	})
+
+	When("a participant does not belong to the event", func() {
+		It("returns a bad request error", func() {
+			other := makeParticipant(uuid.New(), uuid.New())
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().FindByIDs(ctx, gomock.Any()).
+				Return([]*entity.Participant{tentative, other}, nil)
+
+			_, err := confirm(tentative.ID, other.ID)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+	})
+
+	DescribeTable("rejects statuses participants cannot be moved to",
+		func(status entity.ParticipantStatus) {
+			_, err := uc.BulkUpdateStatus(ctx, organizerID, false, participant.BulkUpdateStatusInput{
+				EventID:        event.ID,
+				ParticipantIDs: []uuid.UUID{tentative.ID},
+				Status:         status,
+			})
+
+			var appErr *apperrors.AppError
+			Expect(errors.As(err, &appErr)).To(BeTrue())
+			Expect(appErr.Code).To(Equal(apperrors.CodeValidation))
+			Expect(appErr.ValidationErrors).To(ConsistOf(HaveField("Field", "status")))
+		},
+		Entry("unknown", entity.ParticipantStatus("attending")),
+		Entry("expired", entity.ParticipantStatusExpired),
+		Entry("invited", entity.ParticipantStatusInvited),
+	)
+
+	DescribeTable("rejects malformed participant selections",
+		func(count int) {
+			_, err := confirm(make([]uuid.UUID, count)...)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		},
+		Entry("without participants", 0),
+		Entry("with too many participants", participant.MaxBulkStatusParticipants+1),
+	)
+
+	When("the user does not manage the event", func() {
+		It("returns a forbidden error", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+			_, err := uc.BulkUpdateStatus(ctx, uuid.New(), false, participant.BulkUpdateStatusInput{
+				EventID:        event.ID,
+				ParticipantIDs: []uuid.UUID{tentative.ID},
+				Status:         entity.ParticipantStatusConfirmed,
+			})
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+})
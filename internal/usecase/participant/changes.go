@@ -0,0 +1,48 @@
+package participant
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/google/uuid"
+)
+
+// ListChanges returns the changes to an event's participant list after since: participants
+// created or updated, including check-ins and their cancellation, and participants deleted.
+// Integrations pass the returned Since to the next call to sync incrementally.
+func (u *participantUsecase) ListChanges(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+	since time.Time,
+) (ListChangesOutput, error) {
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return ListChangesOutput{}, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view participants for this event"); err != nil {
+		return ListChangesOutput{}, err
+	}
+
+	changes, err := u.participantRepo.FindChangesSince(ctx, eventID, since)
+	if err != nil {
+		return ListChangesOutput{}, err
+	}
+
+	u.populateDistributionURLs(changes.Participants)
+
+	deleted := make([]DeletedParticipant, 0, len(changes.Deleted))
+	for _, d := range changes.Deleted {
+		deleted = append(deleted, DeletedParticipant{ID: d.ParticipantID, DeletedAt: d.DeletedAt})
+	}
+
+	return ListChangesOutput{
+		Participants: changes.Participants,
+		Deleted:      deleted,
+		Since:        changes.Cursor,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package participant_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("ListChanges", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+		since           time.Time
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID}
+		since = time.Date(2025, 12, 15, 9, 0, 0, 0, time.UTC)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	When("participants changed after since", func() {
+		It("returns the changed and deleted participants with the next cursor", func() {
+			updated := &entity.Participant{ID: uuid.New(), EventID: event.ID, Name: "Taro Yamada"}
+			deletedID := uuid.New()
+			deletedAt := since.Add(time.Minute)
+			cursor := since.Add(10 * time.Minute)
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().FindChangesSince(ctx, event.ID, since).Return(&repository.ParticipantChanges{
+				Participants: []*entity.Participant{updated},
+				Deleted:      []repository.ParticipantDeletion{{ParticipantID: deletedID, DeletedAt: deletedAt}},
+				Cursor:       cursor,
+			}, nil)
+
+			output, err := uc.ListChanges(ctx, organizerID, false, event.ID, since)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.Participants).To(ConsistOf(updated))
+			Expect(output.Deleted).To(ConsistOf(participant.DeletedParticipant{ID: deletedID, DeletedAt: deletedAt}))
+			Expect(output.Since).To(Equal(cursor))
+		})
+	})
+
+	When("an admin syncs another organizer's event", func() {
+		It("returns the changes", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().FindChangesSince(ctx, event.ID, since).
+				Return(&repository.ParticipantChanges{Cursor: since}, nil)
+
+			output, err := uc.ListChanges(ctx, uuid.New(), true, event.ID, since)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.Participants).To(BeEmpty())
+			Expect(output.Deleted).To(BeEmpty())
+			Expect(output.Since).To(Equal(since))
+		})
+	})
+
+	When("the user does not manage the event", func() {
+		It("returns a forbidden error", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+			_, err := uc.ListChanges(ctx, uuid.New(), false, event.ID, since)
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+
+	When("the event does not exist", func() {
+		It("returns the not found error", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(nil, apperrors.NotFound("event not found"))
+
+			_, err := uc.ListChanges(ctx, organizerID, false, event.ID, since)
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,51 @@
+package participant
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// RecordConsent records consent on behalf of a participant, e.g. after a paper waiver was
+// signed on site. The consent is stamped with the event's current consent version, replacing
+// any earlier acceptance.
+func (u *participantUsecase) RecordConsent(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	id uuid.UUID,
+) (*entity.Participant, error) {
+	participant, err := u.participantRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, participant.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "record consent for this participant"); err != nil {
+		return nil, err
+	}
+
+	if !event.RequiresConsent {
+		return nil, apperrors.BadRequest("event does not require consent")
+	}
+
+	now := time.Now()
+	if err := u.participantRepo.RecordConsent(ctx, participant.ID, event.ConsentVersion, now); err != nil {
+		return nil, err
+	}
+
+	participant.AcceptConsent(event.ConsentVersion, now)
+	participant.UpdatedAt = now
+	u.populateDistributionURL(participant)
+
+	return participant, nil
+}
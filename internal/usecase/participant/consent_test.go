@@ -0,0 +1,79 @@
+package participant_test
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("RecordConsent", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+		p               *entity.Participant
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{
+			ID:              uuid.New(),
+			OrganizerID:     organizerID,
+			RequiresConsent: true,
+			ConsentVersion:  "2026-01",
+		}
+		p = makeParticipant(uuid.New(), event.ID)
+
+		participantRepo.EXPECT().FindByID(ctx, p.ID).Return(p, nil)
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	When("the organizer records consent for their event", func() {
+		It("stamps the event's consent version", func() {
+			participantRepo.EXPECT().RecordConsent(ctx, p.ID, "2026-01", gomock.Any()).Return(nil)
+
+			recorded, err := uc.RecordConsent(ctx, organizerID, false, p.ID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(recorded.HasConsent()).To(BeTrue())
+			Expect(recorded.ConsentVersion).To(Equal("2026-01"))
+		})
+	})
+
+	When("the event does not require consent", func() {
+		It("returns a bad request error", func() {
+			event.RequiresConsent = false
+			event.ConsentVersion = ""
+
+			_, err := uc.RecordConsent(ctx, organizerID, false, p.ID)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+	})
+
+	When("the user does not manage the event", func() {
+		It("returns a forbidden error", func() {
+			_, err := uc.RecordConsent(ctx, uuid.New(), false, p.ID)
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+})
@@ -7,12 +7,15 @@ import (
 	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 )
 
-// Create creates a new participant with QR code generation
+// Create creates a new participant with QR code generation. A confirmed participant of an event
+// at capacity is created waitlisted instead, or rejected with a conflict if waitlisting is disabled.
 func (u *participantUsecase) Create(
 	ctx context.Context,
 	userID uuid.UUID,
@@ -26,17 +29,17 @@ func (u *participantUsecase) Create(
 	}
 
 	// Authorization: event owner or admin only
-	if !isAdmin && event.OrganizerID != userID {
-		return nil, apperrors.Forbidden("you do not have permission to add participants to this event")
+	if err := authz.RequireEventManager(userID, event, isAdmin, "add participants to this event"); err != nil {
+		return nil, err
 	}
 
 	// Generate participant ID first so it can be embedded in the QR token
 	participantID := uuid.New()
 
-	// Generate QR code token with structured format: evt_{event_id}_prt_{participant_id}_{random}
-	qrToken, err := crypto.GenerateParticipantQRToken(input.EventID, participantID, u.qrHMACSecret)
+	// Issue a unique QR code token with structured format: evt_{event_id}_prt_{participant_id}_{unique}
+	qrToken, err := u.qrTokens.Issue(ctx, input.EventID, participantID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to generate QR token: %w", err)
+		return nil, err
 	}
 
 	// Convert metadata string to json.RawMessage if provided
@@ -67,19 +70,120 @@ func (u *participantUsecase) Create(
 		PaymentStatus:     input.PaymentStatus,
 		PaymentAmount:     input.PaymentAmount,
 		PaymentDate:       input.PaymentDate,
+		Notes:             input.Notes,
+		Tags:              entity.NormalizeParticipantTags(input.Tags),
+		GroupName:         entity.NormalizeParticipantGroupName(input.GroupName),
+		CustomData:        normalizeCustomData(input.CustomData),
 		CreatedAt:         now,
 		UpdatedAt:         now,
 	}
 
-	// Validate participant
-	if err := participant.Validate(); err != nil {
-		return nil, apperrors.Validation(fmt.Sprintf("participant validation failed: %v", err))
+	var fields apperrors.FieldErrors
+	fields.AddError(validateNewParticipant(participant, event, input.FeeTier), participantErrorField)
+	fields.AddError(event.ValidateCustomData(participant.CustomData), participantErrorField)
+	if len(fields) == 0 {
+		if err := u.checkEmailDomain(ctx, participant, nil); err != nil {
+			fields.Add("email", err.Error())
+		}
+	}
+	if len(fields) > 0 {
+		return nil, participantValidationError(fields)
 	}
 
-	// Save to repository
-	if err := u.participantRepo.Create(ctx, participant); err != nil {
+	if err := u.saveNewParticipant(ctx, participant, event); err != nil {
 		return nil, err
 	}
 
+	u.auditor.Record(ctx, userID, entity.AuditActionCreate, entity.AuditResourceParticipant, participant.ID,
+		nil, participant.AuditFields())
 	return participant, nil
 }
+
+// saveNewParticipant saves a participant, waitlisting or rejecting them if the event is full,
+// together with its participant.created outbox message so webhooks are never lost.
+func (u *participantUsecase) saveNewParticipant(
+	ctx context.Context,
+	participant *entity.Participant,
+	event *entity.Event,
+) error {
+	if u.outboxRepo == nil {
+		return u.createWithinCapacity(ctx, participant, event)
+	}
+
+	return u.transactor.WithTransaction(ctx, func(txCtx context.Context) error {
+		if err := u.createWithinCapacity(txCtx, participant, event); err != nil {
+			return err
+		}
+		return u.enqueueParticipantCreated(txCtx, participant)
+	})
+}
+
+// createWithinCapacity creates a participant, waitlisting a confirmed participant of a full event,
+// or rejecting them with a conflict if waitlisting is disabled.
+func (u *participantUsecase) createWithinCapacity(
+	ctx context.Context,
+	participant *entity.Participant,
+	event *entity.Event,
+) error {
+	switch {
+	case event.HasCapacity() && u.waitlistEnabled:
+		return u.participantRepo.CreateOrWaitlist(ctx, participant)
+	case event.HasCapacity():
+		return u.participantRepo.CreateWithinCapacity(ctx, participant)
+	}
+	return u.participantRepo.Create(ctx, participant)
+}
+
+// enqueueParticipantCreated records the participant.created outbox message for a new participant.
+func (u *participantUsecase) enqueueParticipantCreated(ctx context.Context, participant *entity.Participant) error {
+	msg, err := entity.NewOutboxMessage(
+		entity.OutboxEventParticipantCreated,
+		participant.ID,
+		entity.ParticipantCreatedPayload{
+			ParticipantID: participant.ID,
+			EventID:       participant.EventID,
+			Name:          participant.Name,
+			Email:         participant.Email,
+			Status:        participant.Status,
+			CreatedAt:     participant.CreatedAt,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	if err := u.outboxRepo.Enqueue(ctx, msg); err != nil {
+		return fmt.Errorf("failed to enqueue participant webhook: %w", err)
+	}
+	return nil
+}
+
+// validateNewParticipant applies the event's fee model to a participant about to be created
+// and validates the result, joining every failure found. Every creation path shares it so that
+// they reject the same input.
+func validateNewParticipant(participant *entity.Participant, event *entity.Event, feeTier *string) error {
+	if participant.IsExpired() {
+		return entity.ErrParticipantExpiredStatusReserved
+	}
+	return entity.JoinValidationErrors(
+		participant.ApplyEventFee(event, feeTier),
+		participant.Validate(),
+		checkPaymentCurrency(event, participant.PaymentAmount),
+	)
+}
+
+// normalizeCustomData returns custom data without values as nil, so that it is stored as none.
+func normalizeCustomData(data map[string]any) map[string]any {
+	if len(data) == 0 {
+		return nil
+	}
+	return data
+}
+
+// checkPaymentCurrency ensures a non-zero payment amount is only recorded for an event that
+// has a currency, since amounts are interpreted in the event's currency.
+func checkPaymentCurrency(event *entity.Event, amount *money.Amount) error {
+	if amount != nil && *amount != 0 && !event.HasCurrency() {
+		return entity.ErrParticipantPaymentCurrencyMissing
+	}
+	return nil
+}
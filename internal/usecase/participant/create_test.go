@@ -0,0 +1,177 @@
+package participant_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Create participant.created outbox message", func() {
+	const secret = "test-hmac-secret-for-testing-only-32chars"
+
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		outboxRepo      *mocks.MockOutboxRepository
+		transactor      *mocks.MockTransactor
+		uc              participant.Usecase
+		ctx             context.Context
+		userID          uuid.UUID
+		event           *entity.Event
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		outboxRepo = mocks.NewMockOutboxRepository(ctrl)
+		transactor = mocks.NewMockTransactor(ctrl)
+		transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+		).AnyTimes()
+		uc = participant.NewUsecase(
+			participantRepo, eventRepo, nil, outboxRepo, transactor, qrcode.NewGenerator(),
+			secret, newTestQRTokens(participantRepo, secret), "", "", "", 0,
+			nil, false, nil, false, true, nil, &logger.Logger{Logger: zap.NewNop()},
+		)
+		ctx = context.Background()
+		userID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: userID}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("should enqueue the message in the same transaction as the participant", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		var msg *entity.OutboxMessage
+		outboxRepo.EXPECT().Enqueue(ctx, gomock.Any()).DoAndReturn(
+			func(_ context.Context, m *entity.OutboxMessage) error {
+				msg = m
+				return nil
+			},
+		)
+
+		result, err := uc.Create(ctx, userID, false, validCreateInput(event.ID))
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(msg.EventType).To(Equal(entity.OutboxEventParticipantCreated))
+		Expect(msg.AggregateID).To(Equal(result.ID))
+
+		var payload entity.ParticipantCreatedPayload
+		Expect(json.Unmarshal(msg.Payload, &payload)).To(Succeed())
+		Expect(payload.ParticipantID).To(Equal(result.ID))
+		Expect(payload.EventID).To(Equal(event.ID))
+		Expect(payload.Email).To(Equal("alice@example.com"))
+		Expect(payload.Status).To(Equal(result.Status))
+	})
+
+	It("should fail the creation when the message cannot be enqueued", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+		outboxRepo.EXPECT().Enqueue(ctx, gomock.Any()).Return(errors.New("connection reset"))
+
+		_, err := uc.Create(ctx, userID, false, validCreateInput(event.ID))
+
+		Expect(err).To(MatchError(ContainSubstring("failed to enqueue participant webhook")))
+	})
+
+	It("should not enqueue a message when the participant cannot be saved", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(errors.New("duplicate email"))
+
+		_, err := uc.Create(ctx, userID, false, validCreateInput(event.ID))
+
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("Create with waitlisting disabled", func() {
+	const secret = "test-hmac-secret-for-testing-only-32chars"
+
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		userID          uuid.UUID
+		event           *entity.Event
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = participant.NewUsecase(
+			participantRepo, eventRepo, nil, nil, nil, qrcode.NewGenerator(),
+			secret, newTestQRTokens(participantRepo, secret), "", "", "", 0,
+			nil, false, nil, false, false, nil, &logger.Logger{Logger: zap.NewNop()},
+		)
+		ctx = context.Background()
+		userID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: userID, Capacity: 1}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("should reject a confirmed participant of a full event with a conflict", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().CreateWithinCapacity(ctx, gomock.Any()).
+			Return(apperrors.Conflict("event is at capacity"))
+
+		_, err := uc.Create(ctx, userID, false, validCreateInput(event.ID))
+
+		Expect(apperrors.IsConflict(err)).To(BeTrue())
+	})
+
+	It("should fail, not skip, bulk imported participants past the capacity", func() {
+		second := validCreateInput(event.ID)
+		second.Email = "bob@example.com"
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		gomock.InOrder(
+			participantRepo.EXPECT().CreateWithinCapacity(ctx, gomock.Any()).Return(nil),
+			participantRepo.EXPECT().CreateWithinCapacity(ctx, gomock.Any()).Return(
+				apperrors.WrapAppError(apperrors.Conflict("event is at capacity"), repository.ErrEventFull),
+			),
+		)
+
+		output, err := uc.BulkCreate(ctx, userID, false, participant.BulkCreateInput{
+			EventID:        event.ID,
+			Participants:   []participant.CreateParticipantInput{validCreateInput(event.ID), second},
+			SkipDuplicates: true,
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(output.CreatedCount).To(Equal(1))
+		Expect(output.SkippedCount).To(BeZero())
+		Expect(output.FailedCount).To(Equal(1))
+		Expect(output.Errors[0].Email).To(Equal("bob@example.com"))
+		Expect(output.Errors[0].Message).To(Equal("event is at capacity"))
+	})
+
+	It("should create participants of events without a capacity as usual", func() {
+		event.Capacity = 0
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+		_, err := uc.Create(ctx, userID, false, validCreateInput(event.ID))
+
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
@@ -0,0 +1,30 @@
+package participant
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/google/uuid"
+)
+
+// GetCustomFields returns the custom fields an event collects from its participants, e.g. to
+// map the extra columns of a CSV import to them.
+func (u *participantUsecase) GetCustomFields(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+) ([]entity.CustomField, error) {
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "add participants to this event"); err != nil {
+		return nil, err
+	}
+
+	return event.CustomFields, nil
+}
@@ -3,7 +3,8 @@ package participant
 import (
 	"context"
 
-	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	"github.com/google/uuid"
 )
 
@@ -22,14 +23,56 @@ func (u *participantUsecase) Delete(ctx context.Context, userID uuid.UUID, isAdm
 	}
 
 	// Authorization: event owner or admin only
-	if !isAdmin && event.OrganizerID != userID {
-		return apperrors.Forbidden("you do not have permission to delete this participant")
+	if err := authz.RequireEventManager(userID, event, isAdmin, "delete this participant"); err != nil {
+		return err
 	}
 
 	// Delete from repository
 	if err := u.participantRepo.Delete(ctx, id); err != nil {
 		return err
 	}
+	u.auditor.Record(ctx, userID, entity.AuditActionDelete, entity.AuditResourceParticipant, id,
+		participant.AuditFields(), nil)
 
 	return nil
 }
+
+// Restore restores a soft deleted participant together with the guests deleted with them. It
+// fails with a conflict if another live participant of the event holds their email by now.
+// Confirmed participants that no longer fit the event's capacity are restored waitlisted, or
+// rejected with a conflict if waitlisting is disabled.
+func (u *participantUsecase) Restore(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	id uuid.UUID,
+) (*entity.Participant, error) {
+	participant, err := u.participantRepo.FindDeletedByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify event exists and check authorization; participants of a deleted event stay deleted
+	event, err := u.eventRepo.FindByID(ctx, participant.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "restore this participant"); err != nil {
+		return nil, err
+	}
+
+	if err := u.participantRepo.Restore(ctx, id, u.waitlistEnabled); err != nil {
+		return nil, err
+	}
+
+	restored, err := u.participantRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	u.auditor.Record(ctx, userID, entity.AuditActionCreate, entity.AuditResourceParticipant, id,
+		nil, restored.AuditFields())
+	u.populateDistributionURL(restored)
+	return restored, nil
+}
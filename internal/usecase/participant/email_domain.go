@@ -0,0 +1,44 @@
+package participant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+)
+
+// checkEmailDomain checks that the participant's email domain can receive mail. An undeliverable
+// domain adds a warning to the participant, or fails the check when such domains are rejected.
+// Domains that cannot be checked are allowed. Results are kept in checked, which may be nil, so
+// that a bulk creation looks each domain up once.
+func (u *participantUsecase) checkEmailDomain(
+	ctx context.Context,
+	p *entity.Participant,
+	checked map[string]domainemail.DomainStatus,
+) error {
+	if u.emailDomains == nil || p.Email == "" {
+		return nil
+	}
+
+	domain := strings.ToLower(p.Email[strings.LastIndex(p.Email, "@")+1:])
+	status, ok := checked[domain]
+	if !ok {
+		status = u.emailDomains.CheckDomain(ctx, domain)
+		if checked != nil {
+			checked[domain] = status
+		}
+	}
+	if status != domainemail.DomainUndeliverable {
+		return nil
+	}
+
+	message := fmt.Sprintf("email domain %q cannot receive email; check the address for typos", domain)
+	if u.rejectUndeliverable {
+		return errors.New(message)
+	}
+	p.Warnings = append(p.Warnings, message)
+	return nil
+}
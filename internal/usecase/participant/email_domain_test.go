@@ -0,0 +1,156 @@
+package participant_test
+
+import (
+	"context"
+
+	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
+	emailMocks "github.com/fumkob/ezqrin-server/internal/domain/email/mocks"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Email domain check", func() {
+	const secret = "test-hmac-secret-for-testing-only-32chars"
+
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		domains         *emailMocks.MockDomainChecker
+		ctx             context.Context
+		userID          uuid.UUID
+		event           *entity.Event
+	)
+
+	newUsecase := func(reject bool) participant.Usecase {
+		return participant.NewUsecase(
+			participantRepo, eventRepo, nil, nil, nil, qrcode.NewGenerator(), secret,
+			newTestQRTokens(participantRepo, secret), "", "", "", 0, nil, false, domains, reject, true, nil,
+			&logger.Logger{Logger: zap.NewNop()},
+		)
+	}
+
+	inputWithEmail := func(email string) participant.CreateParticipantInput {
+		input := validCreateInput(event.ID)
+		input.Email = email
+		return input
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		domains = emailMocks.NewMockDomainChecker(ctrl)
+		ctx = context.Background()
+		userID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: userID}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	Describe("Create", func() {
+		When("the email domain can receive mail", func() {
+			It("creates the participant without warnings", func() {
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				domains.EXPECT().CheckDomain(ctx, "example.com").Return(domainemail.DomainDeliverable)
+				participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+				p, err := newUsecase(false).Create(ctx, userID, false, inputWithEmail("alice@Example.com"))
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(p.Warnings).To(BeEmpty())
+			})
+		})
+
+		When("the email domain cannot receive mail", func() {
+			It("creates the participant with a warning", func() {
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				domains.EXPECT().CheckDomain(ctx, "gmial.com").Return(domainemail.DomainUndeliverable)
+				participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+				p, err := newUsecase(false).Create(ctx, userID, false, inputWithEmail("alice@gmial.com"))
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(p.Warnings).To(ConsistOf(ContainSubstring(`"gmial.com" cannot receive email`)))
+			})
+
+			It("rejects the participant when undeliverable domains are rejected", func() {
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				domains.EXPECT().CheckDomain(ctx, "gmial.com").Return(domainemail.DomainUndeliverable)
+
+				_, err := newUsecase(true).Create(ctx, userID, false, inputWithEmail("alice@gmial.com"))
+
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(`"gmial.com" cannot receive email`))
+			})
+		})
+
+		When("the email domain could not be checked", func() {
+			It("creates the participant even when undeliverable domains are rejected", func() {
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				domains.EXPECT().CheckDomain(ctx, "slow.example").Return(domainemail.DomainUnknown)
+				participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+				p, err := newUsecase(true).Create(ctx, userID, false, inputWithEmail("alice@slow.example"))
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(p.Warnings).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("BulkCreate", func() {
+		It("looks each domain up once and reports warnings by index", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			domains.EXPECT().CheckDomain(ctx, "gmial.com").Return(domainemail.DomainUndeliverable).Times(1)
+			domains.EXPECT().CheckDomain(ctx, "example.com").Return(domainemail.DomainDeliverable).Times(1)
+			participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(3)
+
+			output, err := newUsecase(false).BulkCreate(ctx, userID, false, participant.BulkCreateInput{
+				EventID: event.ID,
+				Participants: []participant.CreateParticipantInput{
+					inputWithEmail("alice@gmial.com"),
+					inputWithEmail("bob@example.com"),
+					inputWithEmail("carol@gmial.com"),
+				},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.CreatedCount).To(Equal(3))
+			Expect(output.Warnings).To(HaveLen(2))
+			Expect(output.Warnings[0].Index).To(Equal(0))
+			Expect(output.Warnings[1].Index).To(Equal(2))
+			Expect(output.Warnings[1].Email).To(Equal("carol@gmial.com"))
+		})
+
+		It("fails the rows with undeliverable domains when they are rejected", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			domains.EXPECT().CheckDomain(ctx, "gmial.com").Return(domainemail.DomainUndeliverable)
+			domains.EXPECT().CheckDomain(ctx, "example.com").Return(domainemail.DomainDeliverable)
+			participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+			output, err := newUsecase(true).BulkCreate(ctx, userID, false, participant.BulkCreateInput{
+				EventID: event.ID,
+				Participants: []participant.CreateParticipantInput{
+					inputWithEmail("alice@gmial.com"),
+					inputWithEmail("bob@example.com"),
+				},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.CreatedCount).To(Equal(1))
+			Expect(output.FailedCount).To(Equal(1))
+			Expect(output.Errors[0].Index).To(Equal(0))
+			Expect(output.Warnings).To(BeEmpty())
+		})
+	})
+})
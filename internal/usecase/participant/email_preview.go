@@ -0,0 +1,62 @@
+package participant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// PreviewConfirmationEmail renders the QR code email a participant would receive from
+// SendQRCodes, without sending it, together with the participant's QR code image.
+func (u *participantUsecase) PreviewConfirmationEmail(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	id uuid.UUID,
+) (EmailPreviewOutput, error) {
+	participant, err := u.participantRepo.FindByID(ctx, id)
+	if err != nil {
+		return EmailPreviewOutput{}, err
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, participant.EventID)
+	if err != nil {
+		return EmailPreviewOutput{}, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "preview emails for this participant"); err != nil {
+		return EmailPreviewOutput{}, err
+	}
+
+	if participant.IsInvited() {
+		return EmailPreviewOutput{}, apperrors.Conflict("participant has not accepted the invitation yet")
+	}
+
+	u.populateDistributionURL(participant)
+	if participant.QRDistributionURL == "" {
+		return EmailPreviewOutput{}, apperrors.ServiceUnavailable("QR code emails are not configured")
+	}
+
+	msg, err := u.buildQRCodeEmail(participant, destinationEmail(participant), event.Name)
+	if err != nil {
+		return EmailPreviewOutput{}, err
+	}
+
+	qrCodePNG, err := u.qrGenerator.GeneratePNG(ctx, participant.QRCode, qrcode.DEFAULT_SIZE)
+	if err != nil {
+		return EmailPreviewOutput{}, fmt.Errorf("failed to generate PNG QR code: %w", err)
+	}
+
+	return EmailPreviewOutput{
+		To:        msg.To,
+		Subject:   msg.Subject,
+		HTMLBody:  msg.Body,
+		TextBody:  msg.TextBody,
+		QRCodePNG: qrCodePNG,
+	}, nil
+}
@@ -0,0 +1,152 @@
+package participant_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("PreviewConfirmationEmail", func() {
+	const secret = "test-hmac-secret-for-testing-only-32chars"
+
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		emailSender     *mockEmailSender
+		ctx             context.Context
+		userID          uuid.UUID
+		event           *entity.Event
+	)
+
+	newUsecase := func(hostingURL string, plainTextOnly bool) participant.Usecase {
+		return participant.NewUsecase(
+			participantRepo, eventRepo, nil, nil, nil, qrcode.NewGenerator(), secret, nil, hostingURL, "", "", 0,
+			emailSender, plainTextOnly, nil, false, true, nil, &logger.Logger{Logger: zap.NewNop()},
+		)
+	}
+
+	newParticipant := func(name string) *entity.Participant {
+		return &entity.Participant{
+			ID: uuid.New(), EventID: event.ID,
+			Name: name, Email: "alice@example.com",
+			QRCode:        "qr-token-alice",
+			Status:        entity.ParticipantStatusConfirmed,
+			PaymentStatus: entity.PaymentUnpaid,
+		}
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		emailSender = &mockEmailSender{errorsFor: map[string]error{}}
+		ctx = context.Background()
+		userID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: userID, Name: "Tech Conf"}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	// sendTo sends the QR code email for a copy of p through SendQRCodes
+	sendTo := func(uc participant.Usecase, p *entity.Participant) {
+		sent := *p
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{p.ID}).Return([]*entity.Participant{&sent}, nil)
+
+		result, err := uc.SendQRCodes(ctx, userID, false, participant.SendQRCodesInput{
+			EventID:        event.ID,
+			ParticipantIDs: []uuid.UUID{p.ID},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.SentCount).To(Equal(1))
+	}
+
+	It("should render the same email SendQRCodes sends, without sending it", func() {
+		uc := newUsecase("https://qr.example.com", false)
+		p := newParticipant("Alice")
+		participantRepo.EXPECT().FindByID(ctx, p.ID).Return(p, nil)
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+		preview, err := uc.PreviewConfirmationEmail(ctx, userID, false, p.ID)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(emailSender.sent).To(BeEmpty())
+
+		sendTo(uc, p)
+		Expect(preview.To).To(Equal(emailSender.sent[0].To))
+		Expect(preview.Subject).To(Equal(emailSender.sent[0].Subject))
+		Expect(preview.HTMLBody).To(Equal(emailSender.sent[0].Body))
+		Expect(preview.TextBody).To(Equal(emailSender.sent[0].TextBody))
+		Expect(preview.HTMLBody).To(ContainSubstring("https://qr.example.com/"))
+		Expect(preview.QRCodePNG).To(HavePrefix("\x89PNG\r\n\x1a\n"))
+	})
+
+	It("should escape participant data in the HTML body", func() {
+		p := newParticipant("<script>alert(1)</script>")
+		participantRepo.EXPECT().FindByID(ctx, p.ID).Return(p, nil)
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+		preview, err := newUsecase("https://qr.example.com", false).PreviewConfirmationEmail(ctx, userID, false, p.ID)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(preview.HTMLBody).NotTo(ContainSubstring("<script>"))
+		Expect(preview.HTMLBody).To(ContainSubstring("&lt;script&gt;"))
+	})
+
+	It("should leave the HTML body empty when emails are sent as plain text", func() {
+		p := newParticipant("Alice")
+		participantRepo.EXPECT().FindByID(ctx, p.ID).Return(p, nil)
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+		preview, err := newUsecase("https://qr.example.com", true).PreviewConfirmationEmail(ctx, userID, false, p.ID)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(preview.HTMLBody).To(BeEmpty())
+		Expect(preview.TextBody).To(ContainSubstring("https://qr.example.com/"))
+	})
+
+	It("should return Forbidden when the user does not manage the event", func() {
+		p := newParticipant("Alice")
+		participantRepo.EXPECT().FindByID(ctx, p.ID).Return(p, nil)
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+		_, err := newUsecase("https://qr.example.com", false).PreviewConfirmationEmail(ctx, uuid.New(), false, p.ID)
+
+		Expect(apperrors.IsForbidden(err)).To(BeTrue())
+	})
+
+	It("should return Conflict when the participant has not accepted the invitation", func() {
+		p := newParticipant("Alice")
+		p.Status = entity.ParticipantStatusInvited
+		participantRepo.EXPECT().FindByID(ctx, p.ID).Return(p, nil)
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+		_, err := newUsecase("https://qr.example.com", false).PreviewConfirmationEmail(ctx, userID, false, p.ID)
+
+		Expect(apperrors.IsConflict(err)).To(BeTrue())
+	})
+
+	It("should return Service Unavailable when QR code emails are not configured", func() {
+		p := newParticipant("Alice")
+		participantRepo.EXPECT().FindByID(ctx, p.ID).Return(p, nil)
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+		_, err := newUsecase("", false).PreviewConfirmationEmail(ctx, userID, false, p.ID)
+
+		var appErr *apperrors.AppError
+		Expect(errors.As(err, &appErr)).To(BeTrue())
+		Expect(appErr.Code).To(Equal(apperrors.CodeServiceUnavailable))
+	})
+})
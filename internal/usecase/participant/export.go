@@ -4,7 +4,7 @@ import (
 	"context"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
-	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	"github.com/google/uuid"
 )
 
@@ -19,10 +19,8 @@ func (u *participantUsecase) ExportCSV(
 		return nil, err
 	}
 
-	if !isAdmin && event.OrganizerID != userID {
-		return nil, apperrors.Forbidden(
-			"you do not have permission to export participants for this event",
-		)
+	if err := authz.RequireEventManager(userID, event, isAdmin, "export participants for this event"); err != nil {
+		return nil, err
 	}
 
 	participants, err := u.participantRepo.FindAllByEventID(ctx, eventID)
@@ -31,5 +29,8 @@ func (u *participantUsecase) ExportCSV(
 	}
 
 	u.populateDistributionURLs(participants)
+	for _, p := range participants {
+		p.PaymentCurrency = event.Currency
+	}
 	return participants, nil
 }
@@ -38,12 +38,22 @@ var _ = Describe("ExportCSV", func() {
 		uc = participant.NewUsecase(
 			mockParticipant,
 			mockEvent,
+			nil,
+			nil,
+			nil,
 			qrcode.NewGenerator(),
 			"test-hmac-secret-for-testing-only-32chars",
+			nil,
+			"",
 			"",
 			"",
+			0,
 			nil,
 			false,
+			nil,
+			false,
+			true,
+			nil,
 			&logger.Logger{Logger: zap.NewNop()},
 		)
 	})
@@ -53,7 +63,7 @@ var _ = Describe("ExportCSV", func() {
 	When("exporting participants", func() {
 		Context("as event organizer", func() {
 			It("should return all participants", func() {
-				event := &entity.Event{ID: eventID, OrganizerID: organizerID}
+				event := &entity.Event{ID: eventID, OrganizerID: organizerID, Currency: "USD"}
 				participants := []*entity.Participant{
 					{ID: uuid.New(), EventID: eventID, Name: "Alice", Email: "alice@example.com"},
 				}
@@ -65,6 +75,7 @@ var _ = Describe("ExportCSV", func() {
 				Expect(err).NotTo(HaveOccurred())
 				Expect(result).To(HaveLen(1))
 				Expect(result[0].Name).To(Equal("Alice"))
+				Expect(result[0].PaymentCurrency).To(Equal("USD"))
 			})
 		})
 
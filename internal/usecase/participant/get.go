@@ -4,7 +4,9 @@ import (
 	"context"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/validator"
 	"github.com/google/uuid"
 )
 
@@ -28,11 +30,38 @@ func (u *participantUsecase) GetByID(
 	}
 
 	// Authorization: event owner or admin only
-	if !isAdmin && event.OrganizerID != userID {
-		return nil, apperrors.Forbidden("you do not have permission to view this participant")
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view this participant"); err != nil {
+		return nil, err
 	}
 
 	u.populateDistributionURL(participant)
 
 	return participant, nil
 }
+
+// LookupByEmail finds the participants registered with an email, one per event.
+// Organizers only see their own events; admins search every event.
+func (u *participantUsecase) LookupByEmail(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	email string,
+) ([]*entity.Participant, error) {
+	if err := validator.ValidateEmail(email); err != nil {
+		return nil, apperrors.BadRequest("invalid email")
+	}
+
+	organizerID := &userID
+	if isAdmin {
+		organizerID = nil
+	}
+
+	participants, err := u.participantRepo.FindByEmailAcrossOrganizer(ctx, organizerID, email)
+	if err != nil {
+		return nil, err
+	}
+
+	u.populateDistributionURLs(participants)
+
+	return participants, nil
+}
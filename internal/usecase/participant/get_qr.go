@@ -1,9 +1,14 @@
 package participant
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"image"
+	_ "image/jpeg" // register the JPEG decoder for event logos
+	_ "image/png"  // register the PNG decoder for event logos
 
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/google/uuid"
 )
@@ -30,10 +35,12 @@ func (u *participantUsecase) GetQRCode(
 	}
 
 	// Authorization: event owner or admin only
-	if !isAdmin && event.OrganizerID != userID {
-		return QRCodeOutput{}, apperrors.Forbidden(
-			"you do not have permission to download QR code for this participant",
-		)
+	if err := authz.RequireEventManager(userID, event, isAdmin, "download QR code for this participant"); err != nil {
+		return QRCodeOutput{}, err
+	}
+
+	if participant.IsInvited() {
+		return QRCodeOutput{}, apperrors.Conflict("participant has not accepted the invitation yet")
 	}
 
 	// Validate QR code parameters
@@ -41,8 +48,16 @@ func (u *participantUsecase) GetQRCode(
 		return QRCodeOutput{}, err
 	}
 
+	// PNG QR codes carry the event's logo, if it has one
+	var logo image.Image
+	if format == "png" {
+		if logo, err = u.findEventLogo(ctx, event.ID); err != nil {
+			return QRCodeOutput{}, err
+		}
+	}
+
 	// Generate QR code
-	data, contentType, err := u.generateQRCodeData(ctx, participant.QRCode, format, size)
+	data, contentType, err := u.generateQRCodeData(ctx, participant.QRCode, format, size, logo)
 	if err != nil {
 		return QRCodeOutput{}, err
 	}
@@ -70,12 +85,34 @@ func validateQRCodeParams(format string, size int) error {
 	return nil
 }
 
-// generateQRCodeData generates QR code data based on format
+// findEventLogo decodes the logo of an event, or returns nil if the event has none
+func (u *participantUsecase) findEventLogo(ctx context.Context, eventID uuid.UUID) (image.Image, error) {
+	data, err := u.eventRepo.FindLogo(ctx, eventID)
+	if err != nil || data == nil {
+		return nil, err
+	}
+	logo, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode event logo: %w", err)
+	}
+	return logo, nil
+}
+
+// generateQRCodeData generates QR code data based on format, overlaying logo on PNG QR codes if given
 func (u *participantUsecase) generateQRCodeData(
 	ctx context.Context,
 	qrCode, format string,
 	size int,
+	logo image.Image,
 ) ([]byte, string, error) {
+	if format == "png" && logo != nil {
+		data, err := u.qrGenerator.GeneratePNGWithLogo(ctx, qrCode, size, logo)
+		if err != nil {
+			return nil, "", fmt.Errorf("failed to generate PNG QR code: %w", err)
+		}
+		return data, "image/png", nil
+	}
+
 	if format == "png" {
 		data, err := u.qrGenerator.GeneratePNG(ctx, qrCode, size)
 		if err != nil {
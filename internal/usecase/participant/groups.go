@@ -0,0 +1,77 @@
+package participant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/fumkob/ezqrin-server/internal/usecase/uuids"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// MaxGroupAssignParticipants is the maximum number of participant IDs in one group assignment
+const MaxGroupAssignParticipants = 1000
+
+// AssignGroup seats many participants of an event at a group at once, e.g. the guests of a
+// dinner at "Table 5", or clears their group when input.GroupName is nil. IDs of other events
+// are ignored. Returns the number of participants whose group changed, each of which is
+// recorded in the audit log.
+func (u *participantUsecase) AssignGroup(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input AssignGroupInput,
+) (int64, error) {
+	switch {
+	case len(input.ParticipantIDs) == 0:
+		return 0, apperrors.BadRequest("participant_ids must not be empty")
+	case len(input.ParticipantIDs) > MaxGroupAssignParticipants:
+		return 0, apperrors.BadRequest(
+			fmt.Sprintf("at most %d participant_ids can be assigned at once", MaxGroupAssignParticipants),
+		)
+	}
+	groupName := entity.NormalizeParticipantGroupName(input.GroupName)
+	if err := entity.ValidateParticipantGroupName(groupName); err != nil {
+		return 0, apperrors.Validation(err.Error()).WithValidationErrors(
+			[]apperrors.ValidationError{{Field: "group_name", Message: err.Error()}},
+		)
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return 0, err
+	}
+	if err := authz.RequireEventManager(userID, event, isAdmin, "assign groups to participants of this event"); err != nil {
+		return 0, err
+	}
+
+	// The participants are read first for the audit log; the update skips those whose group is
+	// already the new one
+	ids := uuids.Unique(input.ParticipantIDs)
+	participants, err := u.participantRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+	updated, err := u.participantRepo.AssignGroup(ctx, event.ID, ids, groupName)
+	if err != nil {
+		return 0, err
+	}
+
+	byID := make(map[uuid.UUID]*entity.Participant, len(participants))
+	for _, participant := range participants {
+		byID[participant.ID] = participant
+	}
+	for _, id := range updated {
+		participant, ok := byID[id]
+		if !ok {
+			continue
+		}
+		before := participant.AuditFields()
+		participant.GroupName = groupName
+		u.auditor.Record(ctx, userID, entity.AuditActionUpdate, entity.AuditResourceParticipant, id,
+			before, participant.AuditFields())
+	}
+	return int64(len(updated)), nil
+}
@@ -0,0 +1,158 @@
+package participant_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("AssignGroup", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+		ids             []uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID}
+		ids = []uuid.UUID{uuid.New(), uuid.New()}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	When("the organizer seats participants at a group", func() {
+		It("assigns the trimmed group name and returns the number of participants changed", func() {
+			groupName, want := "  Table 5 ", "Table 5"
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().FindByIDs(ctx, ids).Return(nil, nil)
+			participantRepo.EXPECT().AssignGroup(ctx, event.ID, ids, &want).Return(ids, nil)
+
+			updated, err := uc.AssignGroup(ctx, organizerID, false, participant.AssignGroupInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+				GroupName:      &groupName,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal(int64(2)))
+		})
+	})
+
+	When("the group name is null", func() {
+		It("clears the participants' group", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().FindByIDs(ctx, ids).Return(nil, nil)
+			participantRepo.EXPECT().AssignGroup(ctx, event.ID, ids, (*string)(nil)).Return(ids[:1], nil)
+
+			updated, err := uc.AssignGroup(ctx, organizerID, false, participant.AssignGroupInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal(int64(1)))
+		})
+	})
+
+	When("there is an audit log", func() {
+		It("records each participant whose group changed", func() {
+			auditRepo := mocks.NewMockAuditRepository(ctrl)
+			uc = newAuditedTestUsecase(participantRepo, eventRepo,
+				audit.NewRecorder(auditRepo, &logger.Logger{Logger: zap.NewNop()}))
+			groupName := "Table 5"
+			seated := &entity.Participant{ID: ids[0], EventID: event.ID}
+			unchanged := &entity.Participant{ID: ids[1], EventID: event.ID, GroupName: &groupName}
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().FindByIDs(ctx, ids).Return([]*entity.Participant{seated, unchanged}, nil)
+			participantRepo.EXPECT().AssignGroup(ctx, event.ID, ids, &groupName).Return(ids[:1], nil)
+
+			var entry *entity.AuditLog
+			auditRepo.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, e *entity.AuditLog) error {
+					entry = e
+					return nil
+				},
+			)
+
+			_, err := uc.AssignGroup(ctx, organizerID, false, participant.AssignGroupInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+				GroupName:      &groupName,
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entry.Action).To(Equal(entity.AuditActionUpdate))
+			Expect(entry.ResourceID).To(Equal(ids[0]))
+			Expect(entry.Changes).To(HaveLen(1))
+			Expect(entry.Changes["group_name"].New).To(BeEquivalentTo(`"Table 5"`))
+		})
+	})
+
+	DescribeTable("rejects invalid group names",
+		func(groupName string) {
+			_, err := uc.AssignGroup(ctx, organizerID, false, participant.AssignGroupInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+				GroupName:      &groupName,
+			})
+
+			var appErr *apperrors.AppError
+			Expect(errors.As(err, &appErr)).To(BeTrue())
+			Expect(appErr.Code).To(Equal(apperrors.CodeValidation))
+			Expect(appErr.ValidationErrors).To(ConsistOf(HaveField("Field", "group_name")))
+		},
+		Entry("blank", "   "),
+		Entry("too long", strings.Repeat("a", entity.ParticipantGroupNameMaxLength+1)),
+	)
+
+	DescribeTable("rejects malformed participant selections",
+		func(count int) {
+			groupName := "Table 5"
+			_, err := uc.AssignGroup(ctx, organizerID, false, participant.AssignGroupInput{
+				EventID:        event.ID,
+				ParticipantIDs: make([]uuid.UUID, count),
+				GroupName:      &groupName,
+			})
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		},
+		Entry("without participants", 0),
+		Entry("with too many participants", participant.MaxGroupAssignParticipants+1),
+	)
+
+	When("the user does not manage the event", func() {
+		It("returns a forbidden error", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+			_, err := uc.AssignGroup(ctx, uuid.New(), false, participant.AssignGroupInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+			})
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+})
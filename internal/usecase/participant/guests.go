@@ -0,0 +1,83 @@
+package participant
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// AddGuest registers a guest under a registrant, e.g. a companion of a group registration.
+// The guest is a participant of the same event with its own QR code and check-in, so it
+// counts toward the event's participants. It takes over the registrant's status; a confirmed
+// guest takes a place of the event's capacity like any other participant, so it is waitlisted,
+// or rejected with a conflict, once the event is full.
+func (u *participantUsecase) AddGuest(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	registrantID uuid.UUID,
+	input AddGuestInput,
+) (*entity.Participant, error) {
+	registrant, err := u.participantRepo.FindByID(ctx, registrantID)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, registrant.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "add guests to this participant"); err != nil {
+		return nil, err
+	}
+
+	if registrant.IsGuest() {
+		return nil, apperrors.BadRequest("guests cannot have guests of their own")
+	}
+	if !registrant.IsTentative() && !registrant.IsConfirmed() {
+		return nil, apperrors.BadRequest(
+			fmt.Sprintf("cannot add guests to a participant with status %s", registrant.Status),
+		)
+	}
+
+	guestID := uuid.New()
+	qrToken, err := u.qrTokens.Issue(ctx, event.ID, guestID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	guest := &entity.Participant{
+		ID:                guestID,
+		EventID:           event.ID,
+		GuestOf:           &registrant.ID,
+		Name:              input.Name,
+		Email:             input.Email,
+		QRCode:            qrToken,
+		QRCodeGeneratedAt: now,
+		Status:            registrant.Status,
+		PaymentStatus:     entity.PaymentUnpaid,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+
+	if err := validateNewParticipant(guest, event, nil); err != nil {
+		return nil, apperrors.Validation(fmt.Sprintf("guest validation failed: %v", err))
+	}
+
+	if err := u.createWithinCapacity(ctx, guest, event); err != nil {
+		return nil, err
+	}
+
+	u.auditor.Record(ctx, userID, entity.AuditActionCreate, entity.AuditResourceParticipant, guest.ID,
+		nil, guest.AuditFields())
+	u.populateDistributionURL(guest)
+	return guest, nil
+}
@@ -0,0 +1,148 @@
+package participant_test
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("AddGuest", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+		registrant      *entity.Participant
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID}
+		registrant = makeParticipant(uuid.New(), event.ID)
+
+		participantRepo.EXPECT().FindByID(ctx, registrant.ID).Return(registrant, nil)
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	When("the organizer adds a guest to a confirmed registrant", func() {
+		It("creates a guest with its own QR code and the registrant's status", func() {
+			var created *entity.Participant
+			participantRepo.EXPECT().Create(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *entity.Participant) error {
+					created = p
+					return nil
+				})
+
+			guest, err := uc.AddGuest(ctx, organizerID, false, registrant.ID, participant.AddGuestInput{Name: "Bob Smith"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(guest).To(BeIdenticalTo(created))
+			Expect(guest.ID).NotTo(Equal(registrant.ID))
+			Expect(guest.EventID).To(Equal(event.ID))
+			Expect(guest.GuestOf).To(HaveValue(Equal(registrant.ID)))
+			Expect(guest.Email).To(BeEmpty())
+			Expect(guest.Status).To(Equal(entity.ParticipantStatusConfirmed))
+			Expect(guest.QRCode).NotTo(BeEmpty())
+			Expect(guest.QRCode).NotTo(Equal(registrant.QRCode))
+			Expect(guest.QRDistributionURL).To(HavePrefix("https://qr.example.com/qr/"))
+		})
+	})
+
+	When("the event has a capacity", func() {
+		It("creates the guest within the capacity, waitlisting them once the event is full", func() {
+			event.Capacity = 10
+			participantRepo.EXPECT().CreateOrWaitlist(ctx, gomock.Any()).
+				DoAndReturn(func(_ context.Context, p *entity.Participant) error {
+					p.Status = entity.ParticipantStatusWaitlisted
+					return nil
+				})
+
+			guest, err := uc.AddGuest(ctx, organizerID, false, registrant.ID, participant.AddGuestInput{Name: "Bob Smith"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(guest.Status).To(Equal(entity.ParticipantStatusWaitlisted))
+		})
+	})
+
+	When("there is an audit log", func() {
+		It("records the created guest", func() {
+			auditRepo := mocks.NewMockAuditRepository(ctrl)
+			uc = newAuditedTestUsecase(participantRepo, eventRepo,
+				audit.NewRecorder(auditRepo, &logger.Logger{Logger: zap.NewNop()}))
+			participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+			var entry *entity.AuditLog
+			auditRepo.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, e *entity.AuditLog) error {
+					entry = e
+					return nil
+				},
+			)
+
+			guest, err := uc.AddGuest(ctx, organizerID, false, registrant.ID, participant.AddGuestInput{Name: "Bob Smith"})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(entry.ActorID).To(Equal(organizerID))
+			Expect(entry.Action).To(Equal(entity.AuditActionCreate))
+			Expect(entry.ResourceID).To(Equal(guest.ID))
+			Expect(entry.Changes["guest_of"].New).To(BeEquivalentTo(`"` + registrant.ID.String() + `"`))
+		})
+	})
+
+	When("the registrant is itself a guest", func() {
+		It("returns a bad request error", func() {
+			otherID := uuid.New()
+			registrant.GuestOf = &otherID
+
+			_, err := uc.AddGuest(ctx, organizerID, false, registrant.ID, participant.AddGuestInput{Name: "Bob Smith"})
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+	})
+
+	When("the registrant has not accepted their invitation", func() {
+		It("returns a bad request error", func() {
+			registrant.Status = entity.ParticipantStatusInvited
+
+			_, err := uc.AddGuest(ctx, organizerID, false, registrant.ID, participant.AddGuestInput{Name: "Bob Smith"})
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+	})
+
+	When("the guest has no name", func() {
+		It("returns a validation error", func() {
+			_, err := uc.AddGuest(ctx, organizerID, false, registrant.ID, participant.AddGuestInput{})
+
+			Expect(apperrors.IsValidation(err)).To(BeTrue())
+		})
+	})
+
+	When("the user does not manage the event", func() {
+		It("returns a forbidden error", func() {
+			_, err := uc.AddGuest(ctx, uuid.New(), false, registrant.ID, participant.AddGuestInput{Name: "Bob Smith"})
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+})
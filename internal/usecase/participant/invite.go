@@ -0,0 +1,275 @@
+package participant
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	htmltemplate "html/template"
+	"sync"
+	texttemplate "text/template"
+	"time"
+
+	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// getInvitationHTMLTemplate returns the parsed HTML invitation template, parsing it once on first call.
+var getInvitationHTMLTemplate = sync.OnceValues(func() (*htmltemplate.Template, error) {
+	return htmltemplate.New("invitation").Parse(invitationEmailTemplate)
+})
+
+// getInvitationTextTemplate returns the parsed plain-text invitation template, parsing it once on first call.
+var getInvitationTextTemplate = sync.OnceValues(func() (*texttemplate.Template, error) {
+	return texttemplate.New("invitation_text").Parse(invitationTextTemplate)
+})
+
+//go:embed templates/invitation_default.html
+var invitationEmailTemplate string
+
+//go:embed templates/invitation_default.txt
+var invitationTextTemplate string
+
+const invitationEmailSubject = "You're invited to %s"
+
+// invitationExpiryLayout formats the invitation expiry shown in emails.
+const invitationExpiryLayout = "2006-01-02 15:04 MST"
+
+type invitationEmailData struct {
+	ParticipantName string
+	EventName       string
+	AcceptURL       string
+	ExpiresAt       string
+}
+
+// BulkInvite creates participants in invited status and emails each of them an accept link.
+// Invited participants get no QR code until they accept, and do not count towards the
+// event's participants until then.
+func (u *participantUsecase) BulkInvite(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input BulkInviteInput,
+) (BulkInviteOutput, error) {
+	event, err := u.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return BulkInviteOutput{}, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "invite participants to this event"); err != nil {
+		return BulkInviteOutput{}, err
+	}
+
+	if u.inviteAcceptURL == "" {
+		return BulkInviteOutput{}, apperrors.ServiceUnavailable("participant invitations are not configured")
+	}
+
+	output := BulkInviteOutput{
+		Participants:  make([]*entity.Participant, 0, len(input.Participants)),
+		Errors:        make([]BulkCreateError, 0),
+		EmailFailures: make([]InvitationEmailFailure, 0),
+	}
+
+	for i, inviteInput := range input.Participants {
+		participant, err := buildInvitedParticipant(inviteInput, event)
+		if err == nil {
+			err = u.participantRepo.Create(ctx, participant)
+		}
+		if err != nil {
+			output.FailedCount++
+			output.Errors = append(output.Errors, BulkCreateError{
+				Index:   i,
+				Email:   inviteInput.Email,
+				Message: err.Error(),
+			})
+			continue
+		}
+
+		output.InvitedCount++
+		output.Participants = append(output.Participants, participant)
+
+		if err := u.sendInvitationEmail(ctx, participant, event.Name); err != nil {
+			u.logger.WithContext(ctx).Error("failed to send invitation email",
+				zap.String("participant_id", participant.ID.String()),
+				zap.String("email", participant.Email),
+				zap.Error(err),
+			)
+			output.EmailFailures = append(output.EmailFailures, InvitationEmailFailure{
+				ParticipantID: participant.ID,
+				Email:         participant.Email,
+				Reason:        err.Error(),
+			})
+		}
+	}
+
+	return output, nil
+}
+
+// AcceptInvite confirms the participant identified by a signed invitation token and
+// issues their QR code. The token itself is the credential, so no user is required.
+// For events requiring consent the invitee must accept the terms explicitly; the acceptance
+// is stamped with the event's current consent version. Accepting takes one of the places of
+// an event with a capacity, so it is rejected with a conflict while the event is full.
+func (u *participantUsecase) AcceptInvite(
+	ctx context.Context,
+	token string,
+	consentAccepted bool,
+) (*entity.Participant, error) {
+	participantID, err := crypto.ParseInviteToken(token, u.qrHMACSecret, time.Now())
+	if err != nil {
+		if errors.Is(err, crypto.ErrInviteTokenExpired) {
+			return nil, apperrors.BadRequest("invitation has expired")
+		}
+		return nil, apperrors.BadRequest("invalid invitation token")
+	}
+
+	participant, err := u.participantRepo.FindByID(ctx, participantID)
+	if err != nil {
+		if apperrors.IsNotFound(err) {
+			return nil, apperrors.NotFound("invitation not found")
+		}
+		return nil, err
+	}
+	if !participant.IsInvited() {
+		return nil, apperrors.Conflict("invitation has already been accepted or withdrawn")
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, participant.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.RequiresConsent && !consentAccepted {
+		return nil, apperrors.Unprocessable("this event requires accepting its consent terms before the invitation")
+	}
+
+	qrToken, err := u.qrTokens.Issue(ctx, participant.EventID, participant.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	// Consent is recorded first so a confirmed participant is never left without it
+	if event.RequiresConsent {
+		if err := u.participantRepo.RecordConsent(ctx, participant.ID, event.ConsentVersion, now); err != nil {
+			return nil, err
+		}
+		participant.AcceptConsent(event.ConsentVersion, now)
+	}
+	if err := u.participantRepo.AcceptInvitation(ctx, participant.ID, qrToken, now); err != nil {
+		return nil, err
+	}
+
+	participant.Status = entity.ParticipantStatusConfirmed
+	participant.QRCode = qrToken
+	participant.QRCodeGeneratedAt = now
+	participant.UpdatedAt = now
+	u.populateDistributionURL(participant)
+
+	return participant, nil
+}
+
+// buildInvitedParticipant builds an invited participant entity from input with validation.
+// Invited participants have no QR code; it is generated when the invitation is accepted.
+func buildInvitedParticipant(input InviteParticipantInput, event *entity.Event) (*entity.Participant, error) {
+	var metadata *json.RawMessage
+	if input.Metadata != nil {
+		raw := json.RawMessage(*input.Metadata)
+		metadata = &raw
+	}
+
+	now := time.Now()
+	participant := &entity.Participant{
+		ID:            uuid.New(),
+		EventID:       event.ID,
+		Name:          input.Name,
+		Email:         input.Email,
+		QREmail:       input.QREmail,
+		EmployeeID:    input.EmployeeID,
+		Phone:         input.Phone,
+		Status:        entity.ParticipantStatusInvited,
+		Metadata:      metadata,
+		PaymentStatus: entity.PaymentUnpaid,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if err := participant.ApplyEventFee(event, input.FeeTier); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := participant.Validate(); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+	if err := checkPaymentCurrency(event, participant.PaymentAmount); err != nil {
+		return nil, fmt.Errorf("validation failed: %w", err)
+	}
+
+	return participant, nil
+}
+
+func renderInvitationEmail(data invitationEmailData) (string, error) {
+	tmpl, err := getInvitationHTMLTemplate()
+	if err != nil {
+		return "", fmt.Errorf("failed to parse invitation template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render invitation template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+func renderInvitationTextEmail(data invitationEmailData) (string, error) {
+	tmpl, err := getInvitationTextTemplate()
+	if err != nil {
+		return "", fmt.Errorf("failed to parse text invitation template: %w", err)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("failed to render text invitation template: %w", err)
+	}
+	return buf.String(), nil
+}
+
+// sendInvitationEmail signs an invitation token for the participant and emails the accept link.
+// When emailPlainTextOnly is true, only the plain-text part is sent (no HTML).
+func (u *participantUsecase) sendInvitationEmail(ctx context.Context, p *entity.Participant, eventName string) error {
+	expiresAt := time.Now().Add(u.inviteTokenExpiry)
+	token, err := crypto.GenerateInviteToken(p.ID, expiresAt, u.qrHMACSecret)
+	if err != nil {
+		return fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+
+	data := invitationEmailData{
+		ParticipantName: p.Name,
+		EventName:       eventName,
+		AcceptURL:       crypto.GenerateInviteAcceptURL(u.inviteAcceptURL, token),
+		ExpiresAt:       expiresAt.UTC().Format(invitationExpiryLayout),
+	}
+	if data.AcceptURL == "" {
+		return fmt.Errorf("invalid invitation accept URL %q", u.inviteAcceptURL)
+	}
+
+	textBody, err := renderInvitationTextEmail(data)
+	if err != nil {
+		return err
+	}
+	msg := domainemail.Message{
+		To:       p.Email,
+		Subject:  fmt.Sprintf(invitationEmailSubject, eventName),
+		TextBody: textBody,
+	}
+	if !u.emailPlainTextOnly {
+		if msg.Body, err = renderInvitationEmail(data); err != nil {
+			return err
+		}
+	}
+	return u.emailSender.Send(ctx, msg)
+}
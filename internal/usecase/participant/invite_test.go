@@ -0,0 +1,347 @@
+package participant_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+const testInviteHMACSecret = "test-hmac-secret-for-testing-only-32chars"
+
+var acceptURLPattern = regexp.MustCompile(`https://app\.example\.com/invitations/accept\?token=\S+`)
+
+// inviteTokenFromEmail extracts the invitation token from the accept link in an email body.
+func inviteTokenFromEmail(body string) string {
+	link := acceptURLPattern.FindString(body)
+	ExpectWithOffset(1, link).NotTo(BeEmpty())
+	parsed, err := url.Parse(link)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return parsed.Query().Get("token")
+}
+
+var _ = Describe("Invitations", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		emailSender     *mockEmailSender
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+	)
+
+	newInviteUsecase := func(acceptURL string) participant.Usecase {
+		return participant.NewUsecase(
+			participantRepo, eventRepo, nil, nil, nil, qrcode.NewGenerator(),
+			testInviteHMACSecret, newTestQRTokens(participantRepo, testInviteHMACSecret),
+			"https://qr.example.com", "", acceptURL, 24*time.Hour,
+			emailSender, false, nil, false, true, nil, &logger.Logger{Logger: zap.NewNop()},
+		)
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		emailSender = &mockEmailSender{errorsFor: map[string]error{}}
+		uc = newInviteUsecase("https://app.example.com/invitations/accept")
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID, Name: "Tech Conf"}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	Describe("BulkInvite", func() {
+		input := func() participant.BulkInviteInput {
+			return participant.BulkInviteInput{
+				EventID: event.ID,
+				Participants: []participant.InviteParticipantInput{
+					{Name: "Alice", Email: "alice@example.com"},
+					{Name: "Bob", Email: "bob@example.com"},
+				},
+			}
+		}
+
+		When("the organizer invites participants", func() {
+			It("creates invited participants without QR codes and emails an accept link", func() {
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				participantRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, p *entity.Participant) error {
+						Expect(p.Status).To(Equal(entity.ParticipantStatusInvited))
+						Expect(p.QRCode).To(BeEmpty())
+						return nil
+					},
+				).Times(2)
+
+				output, err := uc.BulkInvite(ctx, organizerID, false, input())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.InvitedCount).To(Equal(2))
+				Expect(output.FailedCount).To(Equal(0))
+				Expect(output.EmailFailures).To(BeEmpty())
+				Expect(output.Participants).To(HaveLen(2))
+				Expect(output.Participants[0].QRDistributionURL).To(BeEmpty())
+
+				Expect(emailSender.sent).To(HaveLen(2))
+				Expect(emailSender.sent[0].To).To(Equal("alice@example.com"))
+				Expect(emailSender.sent[0].Subject).To(ContainSubstring("Tech Conf"))
+				Expect(emailSender.sent[0].Body).To(ContainSubstring("https://app.example.com/invitations/accept?token="))
+				Expect(inviteTokenFromEmail(emailSender.sent[0].TextBody)).NotTo(BeEmpty())
+			})
+		})
+
+		When("a participant cannot be created", func() {
+			It("records the failure and continues with the rest", func() {
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				gomock.InOrder(
+					participantRepo.EXPECT().Create(ctx, gomock.Any()).
+						Return(apperrors.Conflict("participant with this email already exists for this event")),
+					participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil),
+				)
+
+				output, err := uc.BulkInvite(ctx, organizerID, false, input())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.InvitedCount).To(Equal(1))
+				Expect(output.FailedCount).To(Equal(1))
+				Expect(output.Errors).To(HaveLen(1))
+				Expect(output.Errors[0].Index).To(Equal(0))
+				Expect(emailSender.sent).To(HaveLen(1))
+			})
+		})
+
+		When("an invitation email fails", func() {
+			It("keeps the invited participant and reports the email failure", func() {
+				emailSender.errorsFor["bob@example.com"] = errors.New("smtp unavailable")
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(2)
+
+				output, err := uc.BulkInvite(ctx, organizerID, false, input())
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.InvitedCount).To(Equal(2))
+				Expect(output.EmailFailures).To(HaveLen(1))
+				Expect(output.EmailFailures[0].Email).To(Equal("bob@example.com"))
+				Expect(output.EmailFailures[0].Reason).To(ContainSubstring("smtp unavailable"))
+			})
+		})
+
+		When("the caller does not own the event", func() {
+			It("returns a forbidden error", func() {
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+				_, err := uc.BulkInvite(ctx, uuid.New(), false, input())
+
+				Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+
+		When("no accept URL is configured", func() {
+			It("returns a service unavailable error without creating participants", func() {
+				uc = newInviteUsecase("")
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+				_, err := uc.BulkInvite(ctx, organizerID, false, input())
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeServiceUnavailable))
+			})
+		})
+	})
+
+	Describe("AcceptInvite", func() {
+		var invited *entity.Participant
+
+		BeforeEach(func() {
+			invited = &entity.Participant{
+				ID:            uuid.New(),
+				EventID:       event.ID,
+				Name:          "Alice",
+				Email:         "alice@example.com",
+				Status:        entity.ParticipantStatusInvited,
+				PaymentStatus: entity.PaymentUnpaid,
+			}
+		})
+
+		When("the invitation is accepted after being sent", func() {
+			It("confirms the participant and issues a QR code", func() {
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				participantRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, p *entity.Participant) error {
+						invited = p
+						return nil
+					},
+				)
+				_, err := uc.BulkInvite(ctx, organizerID, false, participant.BulkInviteInput{
+					EventID:      event.ID,
+					Participants: []participant.InviteParticipantInput{{Name: "Alice", Email: "alice@example.com"}},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				token := inviteTokenFromEmail(emailSender.sent[0].TextBody)
+
+				participantRepo.EXPECT().FindByID(ctx, invited.ID).Return(invited, nil)
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				participantRepo.EXPECT().AcceptInvitation(ctx, invited.ID, gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, _ uuid.UUID, qrCode string, _ time.Time) error {
+						Expect(crypto.VerifyHMACToken(testInviteHMACSecret, qrCode)).To(BeTrue())
+						return nil
+					},
+				)
+
+				accepted, err := uc.AcceptInvite(ctx, token, false)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(accepted.Status).To(Equal(entity.ParticipantStatusConfirmed))
+				Expect(accepted.QRCode).NotTo(BeEmpty())
+				Expect(accepted.QRDistributionURL).To(HavePrefix("https://qr.example.com/qr/"))
+			})
+		})
+
+		When("the event requires consent", func() {
+			var token string
+
+			BeforeEach(func() {
+				event.RequiresConsent = true
+				event.ConsentVersion = "2026-01"
+				var err error
+				token, err = crypto.GenerateInviteToken(invited.ID, time.Now().Add(time.Hour), testInviteHMACSecret)
+				Expect(err).NotTo(HaveOccurred())
+				participantRepo.EXPECT().FindByID(ctx, invited.ID).Return(invited, nil)
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			})
+
+			It("rejects an acceptance without consent as unprocessable", func() {
+				_, err := uc.AcceptInvite(ctx, token, false)
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeUnprocessable))
+				Expect(err.Error()).To(ContainSubstring("consent"))
+			})
+
+			It("stamps the consent version when the invitee accepts the terms", func() {
+				participantRepo.EXPECT().RecordConsent(ctx, invited.ID, "2026-01", gomock.Any()).Return(nil)
+				participantRepo.EXPECT().AcceptInvitation(ctx, invited.ID, gomock.Any(), gomock.Any()).Return(nil)
+
+				accepted, err := uc.AcceptInvite(ctx, token, true)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(accepted.Status).To(Equal(entity.ParticipantStatusConfirmed))
+				Expect(accepted.HasConsent()).To(BeTrue())
+				Expect(accepted.ConsentVersion).To(Equal("2026-01"))
+			})
+		})
+
+		When("the event is at capacity", func() {
+			It("returns the conflict without confirming the participant", func() {
+				event.Capacity = 1
+				token, err := crypto.GenerateInviteToken(invited.ID, time.Now().Add(time.Hour), testInviteHMACSecret)
+				Expect(err).NotTo(HaveOccurred())
+				participantRepo.EXPECT().FindByID(ctx, invited.ID).Return(invited, nil)
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				participantRepo.EXPECT().AcceptInvitation(ctx, invited.ID, gomock.Any(), gomock.Any()).
+					Return(apperrors.Conflict("event is at capacity"))
+
+				_, err = uc.AcceptInvite(ctx, token, false)
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+				Expect(invited.Status).To(Equal(entity.ParticipantStatusInvited))
+			})
+		})
+
+		When("the invitation has expired", func() {
+			It("returns a bad request error without touching the participant", func() {
+				token, err := crypto.GenerateInviteToken(invited.ID, time.Now().Add(-time.Minute), testInviteHMACSecret)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = uc.AcceptInvite(ctx, token, false)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+				Expect(err.Error()).To(ContainSubstring("expired"))
+			})
+		})
+
+		When("the token is not a valid invitation token", func() {
+			It("returns a bad request error", func() {
+				_, err := uc.AcceptInvite(ctx, "inv_forged.signature", false)
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+			})
+		})
+
+		When("the invitation was already accepted", func() {
+			It("returns a conflict error", func() {
+				token, err := crypto.GenerateInviteToken(invited.ID, time.Now().Add(time.Hour), testInviteHMACSecret)
+				Expect(err).NotTo(HaveOccurred())
+				invited.Status = entity.ParticipantStatusConfirmed
+				invited.QRCode = "evt_x_prt_y_z.sig"
+				participantRepo.EXPECT().FindByID(ctx, invited.ID).Return(invited, nil)
+
+				_, err = uc.AcceptInvite(ctx, token, false)
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+		})
+
+		When("the invited participant was deleted", func() {
+			It("returns a not found error", func() {
+				token, err := crypto.GenerateInviteToken(invited.ID, time.Now().Add(time.Hour), testInviteHMACSecret)
+				Expect(err).NotTo(HaveOccurred())
+				participantRepo.EXPECT().FindByID(ctx, invited.ID).Return(nil, apperrors.NotFound("participant not found"))
+
+				_, err = uc.AcceptInvite(ctx, token, false)
+
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("invited participants elsewhere", func() {
+		var invited *entity.Participant
+
+		BeforeEach(func() {
+			invited = &entity.Participant{
+				ID:            uuid.New(),
+				EventID:       event.ID,
+				Name:          "Alice",
+				Email:         "alice@example.com",
+				Status:        entity.ParticipantStatusInvited,
+				PaymentStatus: entity.PaymentUnpaid,
+			}
+		})
+
+		It("rejects confirming an invited participant through Update", func() {
+			participantRepo.EXPECT().FindByID(ctx, invited.ID).Return(invited, nil)
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			confirmed := entity.ParticipantStatusConfirmed
+
+			_, err := uc.Update(ctx, organizerID, false, invited.ID, participant.UpdateParticipantInput{Status: &confirmed})
+
+			Expect(apperrors.IsValidation(err)).To(BeTrue())
+		})
+
+		It("rejects downloading a QR code before the invitation is accepted", func() {
+			participantRepo.EXPECT().FindByID(ctx, invited.ID).Return(invited, nil)
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+			_, err := uc.GetQRCode(ctx, organizerID, false, invited.ID, "png", 256)
+
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package participant
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/google/uuid"
+)
+
+// GetListLastModified returns when an event's participant list last changed, with authorization check
+func (u *participantUsecase) GetListLastModified(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+) (time.Time, error) {
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view participants for this event"); err != nil {
+		return time.Time{}, err
+	}
+
+	return u.participantRepo.GetListLastModified(ctx, eventID)
+}
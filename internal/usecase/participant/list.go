@@ -4,7 +4,8 @@ import (
 	"context"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
-	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	"github.com/google/uuid"
 )
 
@@ -22,10 +23,8 @@ func (u *participantUsecase) List(
 	}
 
 	// Authorization: event owner or admin only
-	if !isAdmin && event.OrganizerID != userID {
-		return ListParticipantsOutput{}, apperrors.Forbidden(
-			"you do not have permission to view participants for this event",
-		)
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view participants for this event"); err != nil {
+		return ListParticipantsOutput{}, err
 	}
 
 	// Calculate pagination
@@ -35,8 +34,21 @@ func (u *participantUsecase) List(
 	var participants []*entity.Participant
 	var totalCount int64
 
-	// Use Search if there's a search query or status filter
-	if input.Search != "" {
+	// Filtering by group and listing deleted participants run in SQL together with the other
+	// filters; otherwise use Search if there's a search query
+	filterInSQL := input.Group != nil || input.IncludeDeleted
+	if filterInSQL {
+		participants, totalCount, err = u.participantRepo.FindByFilter(ctx, repository.ParticipantListFilter{
+			EventID:        &input.EventID,
+			Status:         input.Status,
+			Search:         input.Search,
+			Group:          input.Group,
+			IncludeDeleted: input.IncludeDeleted,
+		}, offset, limit)
+		if err != nil {
+			return ListParticipantsOutput{}, err
+		}
+	} else if input.Search != "" {
 		participants, totalCount, err = u.participantRepo.Search(ctx, input.EventID, input.Search, offset, limit)
 		if err != nil {
 			return ListParticipantsOutput{}, err
@@ -52,7 +64,7 @@ func (u *participantUsecase) List(
 	// Apply status filter in-memory if needed
 	// Note: This is not optimal for large datasets. In production, the repository
 	// should support status filtering directly in SQL.
-	if input.Status != nil {
+	if input.Status != nil && !filterInSQL {
 		filtered := make([]*entity.Participant, 0, len(participants))
 		for _, p := range participants {
 			if p.Status == *input.Status {
@@ -65,8 +77,17 @@ func (u *participantUsecase) List(
 
 	u.populateDistributionURLs(participants)
 
-	return ListParticipantsOutput{
+	output := ListParticipantsOutput{
 		Participants: participants,
 		TotalCount:   totalCount,
-	}, nil
+	}
+	if event.HasCapacity() {
+		confirmed, err := u.participantRepo.CountConfirmed(ctx, input.EventID)
+		if err != nil {
+			return ListParticipantsOutput{}, err
+		}
+		output.Capacity = event.Capacity
+		output.CapacityRemaining = event.RemainingCapacity(confirmed)
+	}
+	return output, nil
 }
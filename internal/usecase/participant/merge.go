@@ -0,0 +1,73 @@
+package participant
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// Merge merges a duplicate participant into the primary of the same event in one transaction:
+// the duplicate's check-ins and guests move to the primary, which keeps its identity, and the
+// duplicate is soft deleted. If both were checked in, the later check-in is ended by the user.
+func (u *participantUsecase) Merge(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input MergeParticipantsInput,
+) (*MergeParticipantsOutput, error) {
+	if input.PrimaryID == input.DuplicateID {
+		return nil, apperrors.BadRequest("cannot merge a participant with themselves")
+	}
+
+	primary, err := u.participantRepo.FindByID(ctx, input.PrimaryID)
+	if err != nil {
+		return nil, err
+	}
+	duplicate, err := u.participantRepo.FindByID(ctx, input.DuplicateID)
+	if err != nil {
+		return nil, err
+	}
+	if primary.EventID != duplicate.EventID {
+		return nil, apperrors.BadRequest("participants belong to different events")
+	}
+
+	// Verify event exists and check authorization
+	event, err := u.eventRepo.FindByID(ctx, primary.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "merge participants of this event"); err != nil {
+		return nil, err
+	}
+
+	mergedAt := time.Now()
+	err = u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if _, err := u.checkinRepo.Reassign(ctx, duplicate.ID, primary.ID, userID, mergedAt); err != nil {
+			return err
+		}
+		return u.participantRepo.Merge(ctx, primary.ID, duplicate.ID, mergedAt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.auditor.Record(ctx, userID, entity.AuditActionDelete, entity.AuditResourceParticipant, duplicate.ID,
+		duplicate.AuditFields(), nil)
+
+	merged, err := u.participantRepo.FindByID(ctx, primary.ID)
+	if err != nil {
+		return nil, err
+	}
+	u.populateDistributionURL(merged)
+
+	checkIns, err := u.checkinRepo.GetHistory(ctx, primary.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &MergeParticipantsOutput{Participant: merged, CheckIns: checkIns}, nil
+}
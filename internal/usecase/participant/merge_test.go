@@ -0,0 +1,137 @@
+package participant_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Merge", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		checkinRepo     *mocks.MockCheckinRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+		primary         *entity.Participant
+		duplicate       *entity.Participant
+		input           participant.MergeParticipantsInput
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		checkinRepo = mocks.NewMockCheckinRepository(ctrl)
+		transactor := mocks.NewMockTransactor(ctrl)
+		transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+		).AnyTimes()
+		uc = participant.NewUsecase(
+			participantRepo, eventRepo, checkinRepo, nil, transactor, qrcode.NewGenerator(),
+			"test-hmac-secret-for-testing-only-32chars", nil, "", "", "", 0,
+			nil, false, nil, false, true, nil, &logger.Logger{Logger: zap.NewNop()},
+		)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID}
+		primary = makeParticipant(uuid.New(), event.ID)
+		duplicate = makeParticipant(uuid.New(), event.ID)
+		input = participant.MergeParticipantsInput{PrimaryID: primary.ID, DuplicateID: duplicate.ID}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	expectLookups := func() {
+		participantRepo.EXPECT().FindByID(ctx, primary.ID).Return(primary, nil)
+		participantRepo.EXPECT().FindByID(ctx, duplicate.ID).Return(duplicate, nil)
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+	}
+
+	When("the organizer merges two participants of their event", func() {
+		It("moves the check-ins and returns the primary with the combined history", func() {
+			history := []*entity.Checkin{
+				{ID: uuid.New(), ParticipantID: primary.ID, Method: entity.CheckinMethodQRCode},
+				{ID: uuid.New(), ParticipantID: primary.ID, Method: entity.CheckinMethodManual},
+			}
+			expectLookups()
+			checkinRepo.EXPECT().
+				Reassign(gomock.Any(), duplicate.ID, primary.ID, organizerID, gomock.Any()).
+				Return(int64(1), nil)
+			participantRepo.EXPECT().Merge(gomock.Any(), primary.ID, duplicate.ID, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _, _ uuid.UUID, mergedAt time.Time) error {
+					Expect(mergedAt).To(BeTemporally("~", time.Now(), time.Second))
+					return nil
+				})
+			participantRepo.EXPECT().FindByID(ctx, primary.ID).Return(primary, nil)
+			checkinRepo.EXPECT().GetHistory(ctx, primary.ID).Return(history, nil)
+
+			output, err := uc.Merge(ctx, organizerID, false, input)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.Participant.ID).To(Equal(primary.ID))
+			Expect(output.CheckIns).To(Equal(history))
+		})
+	})
+
+	When("merging a participant with themselves", func() {
+		It("returns a bad request error", func() {
+			_, err := uc.Merge(ctx, organizerID, false, participant.MergeParticipantsInput{
+				PrimaryID:   primary.ID,
+				DuplicateID: primary.ID,
+			})
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+	})
+
+	When("the participants belong to different events", func() {
+		It("returns a bad request error", func() {
+			duplicate.EventID = uuid.New()
+			participantRepo.EXPECT().FindByID(ctx, primary.ID).Return(primary, nil)
+			participantRepo.EXPECT().FindByID(ctx, duplicate.ID).Return(duplicate, nil)
+
+			_, err := uc.Merge(ctx, organizerID, false, input)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		})
+	})
+
+	When("the user does not manage the event", func() {
+		It("returns a forbidden error", func() {
+			expectLookups()
+
+			_, err := uc.Merge(ctx, uuid.New(), false, input)
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+
+	When("the participants cannot be merged", func() {
+		It("returns the repository's conflict", func() {
+			expectLookups()
+			checkinRepo.EXPECT().Reassign(gomock.Any(), duplicate.ID, primary.ID, organizerID, gomock.Any()).
+				Return(int64(0), nil)
+			participantRepo.EXPECT().Merge(gomock.Any(), primary.ID, duplicate.ID, gomock.Any()).
+				Return(apperrors.Conflict("cannot merge a participant with guests into a guest"))
+
+			_, err := uc.Merge(ctx, organizerID, false, input)
+
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+		})
+	})
+})
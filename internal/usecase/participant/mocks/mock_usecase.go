@@ -12,8 +12,10 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	entity "github.com/fumkob/ezqrin-server/internal/domain/entity"
+	badge "github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode/badge"
 	participant "github.com/fumkob/ezqrin-server/internal/usecase/participant"
 	uuid "github.com/google/uuid"
 	gomock "go.uber.org/mock/gomock"
@@ -43,6 +45,66 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// AcceptInvite mocks base method.
+func (m *MockUsecase) AcceptInvite(ctx context.Context, token string, consentAccepted bool) (*entity.Participant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvite", ctx, token, consentAccepted)
+	ret0, _ := ret[0].(*entity.Participant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcceptInvite indicates an expected call of AcceptInvite.
+func (mr *MockUsecaseMockRecorder) AcceptInvite(ctx, token, consentAccepted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvite", reflect.TypeOf((*MockUsecase)(nil).AcceptInvite), ctx, token, consentAccepted)
+}
+
+// AddGuest mocks base method.
+func (m *MockUsecase) AddGuest(ctx context.Context, userID uuid.UUID, isAdmin bool, registrantID uuid.UUID, input participant.AddGuestInput) (*entity.Participant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGuest", ctx, userID, isAdmin, registrantID, input)
+	ret0, _ := ret[0].(*entity.Participant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGuest indicates an expected call of AddGuest.
+func (mr *MockUsecaseMockRecorder) AddGuest(ctx, userID, isAdmin, registrantID, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuest", reflect.TypeOf((*MockUsecase)(nil).AddGuest), ctx, userID, isAdmin, registrantID, input)
+}
+
+// AssignGroup mocks base method.
+func (m *MockUsecase) AssignGroup(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.AssignGroupInput) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignGroup", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignGroup indicates an expected call of AssignGroup.
+func (mr *MockUsecaseMockRecorder) AssignGroup(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignGroup", reflect.TypeOf((*MockUsecase)(nil).AssignGroup), ctx, userID, isAdmin, input)
+}
+
+// Autocomplete mocks base method.
+func (m *MockUsecase) Autocomplete(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID, query string) ([]participant.AutocompleteMatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Autocomplete", ctx, userID, isAdmin, eventID, query)
+	ret0, _ := ret[0].([]participant.AutocompleteMatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Autocomplete indicates an expected call of Autocomplete.
+func (mr *MockUsecaseMockRecorder) Autocomplete(ctx, userID, isAdmin, eventID, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Autocomplete", reflect.TypeOf((*MockUsecase)(nil).Autocomplete), ctx, userID, isAdmin, eventID, query)
+}
+
 // BulkCreate mocks base method.
 func (m *MockUsecase) BulkCreate(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.BulkCreateInput) (participant.BulkCreateOutput, error) {
 	m.ctrl.T.Helper()
@@ -58,6 +120,36 @@ func (mr *MockUsecaseMockRecorder) BulkCreate(ctx, userID, isAdmin, input any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreate", reflect.TypeOf((*MockUsecase)(nil).BulkCreate), ctx, userID, isAdmin, input)
 }
 
+// BulkInvite mocks base method.
+func (m *MockUsecase) BulkInvite(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.BulkInviteInput) (participant.BulkInviteOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkInvite", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(participant.BulkInviteOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkInvite indicates an expected call of BulkInvite.
+func (mr *MockUsecaseMockRecorder) BulkInvite(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkInvite", reflect.TypeOf((*MockUsecase)(nil).BulkInvite), ctx, userID, isAdmin, input)
+}
+
+// BulkUpdateStatus mocks base method.
+func (m *MockUsecase) BulkUpdateStatus(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.BulkUpdateStatusInput) (*participant.BulkUpdateStatusOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkUpdateStatus", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(*participant.BulkUpdateStatusOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkUpdateStatus indicates an expected call of BulkUpdateStatus.
+func (mr *MockUsecaseMockRecorder) BulkUpdateStatus(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkUpdateStatus", reflect.TypeOf((*MockUsecase)(nil).BulkUpdateStatus), ctx, userID, isAdmin, input)
+}
+
 // Create mocks base method.
 func (m *MockUsecase) Create(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.CreateParticipantInput) (*entity.Participant, error) {
 	m.ctrl.T.Helper()
@@ -102,6 +194,21 @@ func (mr *MockUsecaseMockRecorder) ExportCSV(ctx, userID, isAdmin, eventID any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportCSV", reflect.TypeOf((*MockUsecase)(nil).ExportCSV), ctx, userID, isAdmin, eventID)
 }
 
+// GetBadgeSheet mocks base method.
+func (m *MockUsecase) GetBadgeSheet(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.BadgeSheetInput) (*badge.Sheet, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBadgeSheet", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(*badge.Sheet)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBadgeSheet indicates an expected call of GetBadgeSheet.
+func (mr *MockUsecaseMockRecorder) GetBadgeSheet(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBadgeSheet", reflect.TypeOf((*MockUsecase)(nil).GetBadgeSheet), ctx, userID, isAdmin, input)
+}
+
 // GetByID mocks base method.
 func (m *MockUsecase) GetByID(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID) (*entity.Participant, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +224,36 @@ func (mr *MockUsecaseMockRecorder) GetByID(ctx, userID, isAdmin, id any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockUsecase)(nil).GetByID), ctx, userID, isAdmin, id)
 }
 
+// GetCustomFields mocks base method.
+func (m *MockUsecase) GetCustomFields(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID) ([]entity.CustomField, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCustomFields", ctx, userID, isAdmin, eventID)
+	ret0, _ := ret[0].([]entity.CustomField)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCustomFields indicates an expected call of GetCustomFields.
+func (mr *MockUsecaseMockRecorder) GetCustomFields(ctx, userID, isAdmin, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomFields", reflect.TypeOf((*MockUsecase)(nil).GetCustomFields), ctx, userID, isAdmin, eventID)
+}
+
+// GetListLastModified mocks base method.
+func (m *MockUsecase) GetListLastModified(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetListLastModified", ctx, userID, isAdmin, eventID)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetListLastModified indicates an expected call of GetListLastModified.
+func (mr *MockUsecaseMockRecorder) GetListLastModified(ctx, userID, isAdmin, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetListLastModified", reflect.TypeOf((*MockUsecase)(nil).GetListLastModified), ctx, userID, isAdmin, eventID)
+}
+
 // GetQRCode mocks base method.
 func (m *MockUsecase) GetQRCode(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID, format string, size int) (participant.QRCodeOutput, error) {
 	m.ctrl.T.Helper()
@@ -132,6 +269,36 @@ func (mr *MockUsecaseMockRecorder) GetQRCode(ctx, userID, isAdmin, id, format, s
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQRCode", reflect.TypeOf((*MockUsecase)(nil).GetQRCode), ctx, userID, isAdmin, id, format, size)
 }
 
+// GetQRCodeArchive mocks base method.
+func (m *MockUsecase) GetQRCodeArchive(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.QRCodeArchiveInput) (*participant.QRCodeArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetQRCodeArchive", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(*participant.QRCodeArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetQRCodeArchive indicates an expected call of GetQRCodeArchive.
+func (mr *MockUsecaseMockRecorder) GetQRCodeArchive(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetQRCodeArchive", reflect.TypeOf((*MockUsecase)(nil).GetQRCodeArchive), ctx, userID, isAdmin, input)
+}
+
+// GetStats mocks base method.
+func (m *MockUsecase) GetStats(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID) (participant.StatsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStats", ctx, userID, isAdmin, eventID)
+	ret0, _ := ret[0].(participant.StatsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStats indicates an expected call of GetStats.
+func (mr *MockUsecaseMockRecorder) GetStats(ctx, userID, isAdmin, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStats", reflect.TypeOf((*MockUsecase)(nil).GetStats), ctx, userID, isAdmin, eventID)
+}
+
 // List mocks base method.
 func (m *MockUsecase) List(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.ListParticipantsInput) (participant.ListParticipantsOutput, error) {
 	m.ctrl.T.Helper()
@@ -147,6 +314,141 @@ func (mr *MockUsecaseMockRecorder) List(ctx, userID, isAdmin, input any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockUsecase)(nil).List), ctx, userID, isAdmin, input)
 }
 
+// ListChanges mocks base method.
+func (m *MockUsecase) ListChanges(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID, since time.Time) (participant.ListChangesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListChanges", ctx, userID, isAdmin, eventID, since)
+	ret0, _ := ret[0].(participant.ListChangesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListChanges indicates an expected call of ListChanges.
+func (mr *MockUsecaseMockRecorder) ListChanges(ctx, userID, isAdmin, eventID, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListChanges", reflect.TypeOf((*MockUsecase)(nil).ListChanges), ctx, userID, isAdmin, eventID, since)
+}
+
+// LookupByEmail mocks base method.
+func (m *MockUsecase) LookupByEmail(ctx context.Context, userID uuid.UUID, isAdmin bool, email string) ([]*entity.Participant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LookupByEmail", ctx, userID, isAdmin, email)
+	ret0, _ := ret[0].([]*entity.Participant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LookupByEmail indicates an expected call of LookupByEmail.
+func (mr *MockUsecaseMockRecorder) LookupByEmail(ctx, userID, isAdmin, email any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LookupByEmail", reflect.TypeOf((*MockUsecase)(nil).LookupByEmail), ctx, userID, isAdmin, email)
+}
+
+// Merge mocks base method.
+func (m *MockUsecase) Merge(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.MergeParticipantsInput) (*participant.MergeParticipantsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Merge", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(*participant.MergeParticipantsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Merge indicates an expected call of Merge.
+func (mr *MockUsecaseMockRecorder) Merge(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Merge", reflect.TypeOf((*MockUsecase)(nil).Merge), ctx, userID, isAdmin, input)
+}
+
+// PreviewConfirmationEmail mocks base method.
+func (m *MockUsecase) PreviewConfirmationEmail(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID) (participant.EmailPreviewOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewConfirmationEmail", ctx, userID, isAdmin, id)
+	ret0, _ := ret[0].(participant.EmailPreviewOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewConfirmationEmail indicates an expected call of PreviewConfirmationEmail.
+func (mr *MockUsecaseMockRecorder) PreviewConfirmationEmail(ctx, userID, isAdmin, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewConfirmationEmail", reflect.TypeOf((*MockUsecase)(nil).PreviewConfirmationEmail), ctx, userID, isAdmin, id)
+}
+
+// Promote mocks base method.
+func (m *MockUsecase) Promote(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID) (*entity.Participant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Promote", ctx, userID, isAdmin, id)
+	ret0, _ := ret[0].(*entity.Participant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Promote indicates an expected call of Promote.
+func (mr *MockUsecaseMockRecorder) Promote(ctx, userID, isAdmin, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Promote", reflect.TypeOf((*MockUsecase)(nil).Promote), ctx, userID, isAdmin, id)
+}
+
+// RecordConsent mocks base method.
+func (m *MockUsecase) RecordConsent(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID) (*entity.Participant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordConsent", ctx, userID, isAdmin, id)
+	ret0, _ := ret[0].(*entity.Participant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordConsent indicates an expected call of RecordConsent.
+func (mr *MockUsecaseMockRecorder) RecordConsent(ctx, userID, isAdmin, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordConsent", reflect.TypeOf((*MockUsecase)(nil).RecordConsent), ctx, userID, isAdmin, id)
+}
+
+// Restore mocks base method.
+func (m *MockUsecase) Restore(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID) (*entity.Participant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, userID, isAdmin, id)
+	ret0, _ := ret[0].(*entity.Participant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Restore indicates an expected call of Restore.
+func (mr *MockUsecaseMockRecorder) Restore(ctx, userID, isAdmin, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockUsecase)(nil).Restore), ctx, userID, isAdmin, id)
+}
+
+// SendInvite mocks base method.
+func (m *MockUsecase) SendInvite(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID, participantID uuid.UUID) (*entity.Participant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendInvite", ctx, userID, isAdmin, eventID, participantID)
+	ret0, _ := ret[0].(*entity.Participant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendInvite indicates an expected call of SendInvite.
+func (mr *MockUsecaseMockRecorder) SendInvite(ctx, userID, isAdmin, eventID, participantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInvite", reflect.TypeOf((*MockUsecase)(nil).SendInvite), ctx, userID, isAdmin, eventID, participantID)
+}
+
+// SendInvites mocks base method.
+func (m *MockUsecase) SendInvites(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.SendInvitesInput) (participant.SendQRCodesOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendInvites", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(participant.SendQRCodesOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendInvites indicates an expected call of SendInvites.
+func (mr *MockUsecaseMockRecorder) SendInvites(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInvites", reflect.TypeOf((*MockUsecase)(nil).SendInvites), ctx, userID, isAdmin, input)
+}
+
 // SendQRCodes mocks base method.
 func (m *MockUsecase) SendQRCodes(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.SendQRCodesInput) (participant.SendQRCodesOutput, error) {
 	m.ctrl.T.Helper()
@@ -176,3 +478,33 @@ func (mr *MockUsecaseMockRecorder) Update(ctx, userID, isAdmin, id, input any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockUsecase)(nil).Update), ctx, userID, isAdmin, id, input)
 }
+
+// UpdateTags mocks base method.
+func (m *MockUsecase) UpdateTags(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.UpdateTagsInput) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTags", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTags indicates an expected call of UpdateTags.
+func (mr *MockUsecaseMockRecorder) UpdateTags(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTags", reflect.TypeOf((*MockUsecase)(nil).UpdateTags), ctx, userID, isAdmin, input)
+}
+
+// ValidateParticipants mocks base method.
+func (m *MockUsecase) ValidateParticipants(ctx context.Context, userID uuid.UUID, isAdmin bool, input participant.ValidateParticipantsInput) (participant.ValidateParticipantsOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateParticipants", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(participant.ValidateParticipantsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateParticipants indicates an expected call of ValidateParticipants.
+func (mr *MockUsecaseMockRecorder) ValidateParticipants(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateParticipants", reflect.TypeOf((*MockUsecase)(nil).ValidateParticipants), ctx, userID, isAdmin, input)
+}
@@ -1,16 +1,27 @@
 package participant_test
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
 	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
 	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	"github.com/fumkob/ezqrin-server/internal/usecase/qrtoken"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -18,23 +29,51 @@ import (
 	"go.uber.org/zap"
 )
 
+// newTestQRTokens builds a QR token issuer on the given repository mock, for which no
+// generated token ever collides with a stored one.
+func newTestQRTokens(participantRepo *mocks.MockParticipantRepository, secret string) *qrtoken.Issuer {
+	participantRepo.EXPECT().ExistingQRCodes(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	generator, err := crypto.NewTokenGenerator(crypto.QRTokenStrategyRandom, secret, 6)
+	Expect(err).NotTo(HaveOccurred())
+	return qrtoken.NewIssuer(generator, participantRepo, 3)
+}
+
 // newTestUsecase builds a participant.Usecase wired to the given mocks.
 // Uses a fixed HMAC secret that satisfies the minimum length requirement and a
 // hosting base URL so distribution URLs are populated in test assertions.
 func newTestUsecase(
 	participantRepo *mocks.MockParticipantRepository,
 	eventRepo *mocks.MockEventRepository,
+) participant.Usecase {
+	return newAuditedTestUsecase(participantRepo, eventRepo, nil)
+}
+
+// newAuditedTestUsecase builds a participant.Usecase like newTestUsecase that records changes with auditor.
+func newAuditedTestUsecase(
+	participantRepo *mocks.MockParticipantRepository,
+	eventRepo *mocks.MockEventRepository,
+	auditor *audit.Recorder,
 ) participant.Usecase {
 	nopLogger := &logger.Logger{Logger: zap.NewNop()}
 	return participant.NewUsecase(
 		participantRepo,
 		eventRepo,
+		nil,
+		nil,
+		nil,
 		qrcode.NewGenerator(),
 		"test-hmac-secret-for-testing-only-32chars",
+		newTestQRTokens(participantRepo, "test-hmac-secret-for-testing-only-32chars"),
 		"https://qr.example.com",
 		"",
+		"",
+		0,
+		nil,
+		false,
 		nil,
 		false,
+		true,
+		auditor,
 		nopLogger,
 	)
 }
@@ -111,6 +150,87 @@ var _ = Describe("Create", func() {
 			})
 		})
 
+		Context("for an event with a capacity", func() {
+			It("should create the participant through the waitlisting repository method", func() {
+				event := &entity.Event{ID: eventID, OrganizerID: userID, Capacity: 1}
+				input := validCreateInput(eventID)
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().CreateOrWaitlist(ctx, gomock.Any()).DoAndReturn(
+					func(_ context.Context, p *entity.Participant) error {
+						p.Status = entity.ParticipantStatusWaitlisted
+						return nil
+					},
+				)
+
+				result, err := uc.Create(ctx, userID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Status).To(Equal(entity.ParticipantStatusWaitlisted))
+			})
+		})
+
+		Context("for an event with custom fields", func() {
+			var event *entity.Event
+
+			BeforeEach(func() {
+				event = &entity.Event{ID: eventID, OrganizerID: userID, CustomFields: []entity.CustomField{
+					{Key: "company", Label: "Company", Type: entity.CustomFieldTypeText, Required: true},
+				}}
+			})
+
+			It("should store custom data matching the fields", func() {
+				input := validCreateInput(eventID)
+				input.CustomData = map[string]any{"company": "Acme"}
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+				result, err := uc.Create(ctx, userID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.CustomData).To(Equal(map[string]any{"company": "Acme"}))
+			})
+
+			It("should reject an unknown key", func() {
+				input := validCreateInput(eventID)
+				input.CustomData = map[string]any{"company": "Acme", "hobby": "chess"}
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				_, err := uc.Create(ctx, userID, false, input)
+
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(`"hobby"`))
+			})
+
+			It("should reject a missing required field", func() {
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				_, err := uc.Create(ctx, userID, false, validCreateInput(eventID))
+
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+			})
+
+			It("should report invalid fields and custom data together", func() {
+				input := validCreateInput(eventID)
+				input.Email = "not-an-email"
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				_, err := uc.Create(ctx, userID, false, input)
+
+				var appErr *apperrors.AppError
+				Expect(errors.As(err, &appErr)).To(BeTrue())
+				Expect(appErr.Code).To(Equal(apperrors.CodeValidation))
+				fields := make([]string, 0, len(appErr.ValidationErrors))
+				for _, fieldErr := range appErr.ValidationErrors {
+					fields = append(fields, fieldErr.Field)
+				}
+				Expect(fields).To(Equal([]string{"email", "custom_data"}))
+			})
+		})
+
 		Context("with valid input as admin (non-owner)", func() {
 			It("should bypass the organizer check and create the participant", func() {
 				adminID := uuid.New()
@@ -208,13 +328,47 @@ var _ = Describe("Create", func() {
 			})
 		})
 
+		Context("with a payment amount for an event without currency", func() {
+			It("should return a validation error", func() {
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				amount := money.FromMinorUnits(150000)
+				input := validCreateInput(eventID)
+				input.PaymentAmount = &amount
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				result, err := uc.Create(ctx, userID, false, input)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(entity.ErrParticipantPaymentCurrencyMissing.Error()))
+				Expect(result).To(BeNil())
+			})
+		})
+
+		Context("with expired status", func() {
+			It("should return a validation error", func() {
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				input := validCreateInput(eventID)
+				input.Status = entity.ParticipantStatusExpired
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				result, err := uc.Create(ctx, userID, false, input)
+
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(entity.ErrParticipantExpiredStatusReserved.Error()))
+				Expect(result).To(BeNil())
+			})
+		})
+
 		Context("with optional fields populated", func() {
 			It("should store employee ID, phone, and metadata on the participant", func() {
-				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				event := &entity.Event{ID: eventID, OrganizerID: userID, Currency: "JPY"}
 				empID := "EMP-001"
 				phone := "+819012345678"
 				meta := `{"department":"engineering"}`
-				amount := 5000.0
+				amount := money.FromMinorUnits(500000)
 				payDate := time.Now()
 
 				input := participant.CreateParticipantInput{
@@ -243,6 +397,127 @@ var _ = Describe("Create", func() {
 			})
 		})
 	})
+
+	When("the event has a fee model", func() {
+		var tieredEvent *entity.Event
+
+		BeforeEach(func() {
+			tieredEvent = &entity.Event{
+				ID:          eventID,
+				OrganizerID: userID,
+				Currency:    "JPY",
+				FeeType:     entity.FeeTypeTiered,
+				FeeTiers: []entity.FeeTier{
+					{Name: "early_bird", Amount: money.FromMinorUnits(200000)},
+					{Name: "standard", Amount: money.FromMinorUnits(300000)},
+				},
+			}
+		})
+
+		Context("with a free event", func() {
+			It("should force a zero amount and paid status", func() {
+				event := &entity.Event{ID: eventID, OrganizerID: userID, FeeType: entity.FeeTypeFree}
+				amount := money.FromMinorUnits(150000)
+				input := validCreateInput(eventID)
+				input.PaymentAmount = &amount
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+				result, err := uc.Create(ctx, userID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.PaymentAmount).NotTo(BeNil())
+				Expect(*result.PaymentAmount).To(BeZero())
+				Expect(result.PaymentStatus).To(Equal(entity.PaymentPaid))
+			})
+		})
+
+		Context("with a fixed fee event", func() {
+			var event *entity.Event
+
+			BeforeEach(func() {
+				event = &entity.Event{
+					ID:          eventID,
+					OrganizerID: userID,
+					Currency:    "JPY",
+					FeeType:     entity.FeeTypeFixed,
+					FeeAmount:   money.FromMinorUnits(300000),
+				}
+			})
+
+			It("should default the amount to the fixed fee", func() {
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+				result, err := uc.Create(ctx, userID, false, validCreateInput(eventID))
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*result.PaymentAmount).To(Equal(money.FromMinorUnits(300000)))
+				Expect(result.PaymentStatus).To(Equal(entity.PaymentUnpaid))
+			})
+
+			It("should keep an explicit amount", func() {
+				amount := money.FromMinorUnits(250000)
+				input := validCreateInput(eventID)
+				input.PaymentAmount = &amount
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+				result, err := uc.Create(ctx, userID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*result.PaymentAmount).To(Equal(money.FromMinorUnits(250000)))
+			})
+
+			It("should reject a fee tier", func() {
+				tier := "standard"
+				input := validCreateInput(eventID)
+				input.FeeTier = &tier
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				result, err := uc.Create(ctx, userID, false, input)
+
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(entity.ErrParticipantFeeTierNotApplicable.Error()))
+				Expect(result).To(BeNil())
+			})
+		})
+
+		Context("with a tiered fee event and a known tier", func() {
+			It("should default the amount to the tier amount", func() {
+				tier := "early_bird"
+				input := validCreateInput(eventID)
+				input.FeeTier = &tier
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(tieredEvent, nil)
+				participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+				result, err := uc.Create(ctx, userID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*result.PaymentAmount).To(Equal(money.FromMinorUnits(200000)))
+			})
+		})
+
+		Context("with a tiered fee event and an unknown tier", func() {
+			It("should return a validation error", func() {
+				tier := "vip"
+				input := validCreateInput(eventID)
+				input.FeeTier = &tier
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(tieredEvent, nil)
+
+				result, err := uc.Create(ctx, userID, false, input)
+
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring(entity.ErrParticipantFeeTierUnknown.Error()))
+				Expect(result).To(BeNil())
+			})
+		})
+	})
 })
 
 var _ = Describe("BulkCreate", func() {
@@ -305,6 +580,82 @@ var _ = Describe("BulkCreate", func() {
 			})
 		})
 
+		Context("with more confirmed entries than the event's capacity", func() {
+			It("should create them through the waitlisting repository method", func() {
+				event := &entity.Event{ID: eventID, OrganizerID: userID, Capacity: 1}
+				second := validCreateInput(eventID)
+				second.Email = "bob@example.com"
+				input := participant.BulkCreateInput{
+					EventID:      eventID,
+					Participants: []participant.CreateParticipantInput{validCreateInput(eventID), second},
+				}
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				gomock.InOrder(
+					participantRepo.EXPECT().CreateOrWaitlist(ctx, gomock.Any()).Return(nil),
+					participantRepo.EXPECT().CreateOrWaitlist(ctx, gomock.Any()).DoAndReturn(
+						func(_ context.Context, p *entity.Participant) error {
+							p.Status = entity.ParticipantStatusWaitlisted
+							return nil
+						},
+					),
+				)
+
+				output, err := uc.BulkCreate(ctx, userID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.CreatedCount).To(Equal(2))
+				Expect(output.Participants[0].Status).To(Equal(entity.ParticipantStatusConfirmed))
+				Expect(output.Participants[1].Status).To(Equal(entity.ParticipantStatusWaitlisted))
+			})
+		})
+
+		Context("with an unknown fee tier in one entry", func() {
+			It("should record the entry as failed and create the others", func() {
+				event := &entity.Event{
+					ID:          eventID,
+					OrganizerID: userID,
+					Currency:    "JPY",
+					FeeType:     entity.FeeTypeTiered,
+					FeeTiers:    []entity.FeeTier{{Name: "standard", Amount: money.FromMinorUnits(300000)}},
+				}
+				standard, vip := "standard", "vip"
+				input := participant.BulkCreateInput{
+					EventID: eventID,
+					Participants: []participant.CreateParticipantInput{
+						{
+							EventID:       eventID,
+							Name:          "Alice",
+							Email:         "alice@example.com",
+							Status:        entity.ParticipantStatusConfirmed,
+							PaymentStatus: entity.PaymentUnpaid,
+							FeeTier:       &standard,
+						},
+						{
+							EventID:       eventID,
+							Name:          "Bob",
+							Email:         "bob@example.com",
+							Status:        entity.ParticipantStatusConfirmed,
+							PaymentStatus: entity.PaymentUnpaid,
+							FeeTier:       &vip,
+						},
+					},
+				}
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil).Times(1)
+
+				output, err := uc.BulkCreate(ctx, userID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.CreatedCount).To(Equal(1))
+				Expect(*output.Participants[0].PaymentAmount).To(Equal(money.FromMinorUnits(300000)))
+				Expect(output.FailedCount).To(Equal(1))
+				Expect(output.Errors[0].Email).To(Equal("bob@example.com"))
+				Expect(output.Errors[0].Message).To(ContainSubstring(entity.ErrParticipantFeeTierUnknown.Error()))
+			})
+		})
+
 		Context("when the caller is neither admin nor event organizer", func() {
 			It("should return a Forbidden error before processing participants", func() {
 				otherUserID := uuid.New()
@@ -401,6 +752,35 @@ var _ = Describe("BulkCreate", func() {
 			})
 		})
 
+		Context("when an entry has a payment amount but the event has no currency", func() {
+			It("should record a failure for that entry", func() {
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				amount := money.FromMinorUnits(100000)
+				input := participant.BulkCreateInput{
+					EventID: eventID,
+					Participants: []participant.CreateParticipantInput{{
+						EventID:       eventID,
+						Name:          "Paid Person",
+						Email:         "paid@example.com",
+						Status:        entity.ParticipantStatusConfirmed,
+						PaymentStatus: entity.PaymentPaid,
+						PaymentAmount: &amount,
+					}},
+				}
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				output, err := uc.BulkCreate(ctx, userID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.CreatedCount).To(Equal(0))
+				Expect(output.FailedCount).To(Equal(1))
+				Expect(output.Errors[0].Message).To(ContainSubstring(
+					entity.ErrParticipantPaymentCurrencyMissing.Error(),
+				))
+			})
+		})
+
 		Context("when SkipDuplicates is true and a conflict error occurs", func() {
 			It("should skip the duplicate and not count it as a failure", func() {
 				event := &entity.Event{ID: eventID, OrganizerID: userID}
@@ -449,6 +829,84 @@ var _ = Describe("BulkCreate", func() {
 			})
 		})
 	})
+
+	When("bulk creating participants atomically", func() {
+		const secret = "test-hmac-secret-for-testing-only-32chars"
+
+		var (
+			transactor *mocks.MockTransactor
+			inTx       bool
+			event      *entity.Event
+			input      participant.BulkCreateInput
+		)
+
+		BeforeEach(func() {
+			transactor = mocks.NewMockTransactor(ctrl)
+			inTx = false
+			transactor.EXPECT().WithTransaction(ctx, gomock.Any()).DoAndReturn(
+				func(ctx context.Context, fn func(context.Context) error) error {
+					inTx = true
+					defer func() { inTx = false }()
+					return fn(ctx)
+				},
+			)
+			uc = participant.NewUsecase(
+				participantRepo, eventRepo, nil, nil, transactor, qrcode.NewGenerator(),
+				secret, newTestQRTokens(participantRepo, secret), "", "", "", 0,
+				nil, false, nil, false, true, nil, &logger.Logger{Logger: zap.NewNop()},
+			)
+			event = &entity.Event{ID: eventID, OrganizerID: userID}
+			bob := validCreateInput(eventID)
+			bob.Name, bob.Email = "Bob", "bob@example.com"
+			input = participant.BulkCreateInput{
+				EventID:      eventID,
+				Participants: []participant.CreateParticipantInput{validCreateInput(eventID), bob},
+				Atomic:       true,
+			}
+			eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+		})
+
+		It("should create every participant in one transaction", func() {
+			participantRepo.EXPECT().Create(ctx, gomock.Any()).DoAndReturn(
+				func(context.Context, *entity.Participant) error {
+					Expect(inTx).To(BeTrue())
+					return nil
+				},
+			).Times(2)
+
+			output, err := uc.BulkCreate(ctx, userID, false, input)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.CreatedCount).To(Equal(2))
+		})
+
+		It("should roll back at the first failure, naming the participant", func() {
+			participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+			participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(apperrors.Conflict("email already exists"))
+
+			output, err := uc.BulkCreate(ctx, userID, false, input)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeUnprocessable))
+			var abortedErr *participant.BulkCreateAbortedError
+			Expect(errors.As(err, &abortedErr)).To(BeTrue())
+			Expect(abortedErr.Failure.Index).To(Equal(1))
+			Expect(abortedErr.Failure.Email).To(Equal("bob@example.com"))
+			Expect(output).To(Equal(participant.BulkCreateOutput{}))
+		})
+
+		It("should skip duplicates without inserting them", func() {
+			input.SkipDuplicates = true
+			participantRepo.EXPECT().ExistsByEmail(ctx, eventID, "alice@example.com").Return(false, nil)
+			participantRepo.EXPECT().ExistsByEmail(ctx, eventID, "bob@example.com").Return(true, nil)
+			participantRepo.EXPECT().Create(ctx, gomock.Any()).Return(nil)
+
+			output, err := uc.BulkCreate(ctx, userID, false, input)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.CreatedCount).To(Equal(1))
+			Expect(output.SkippedCount).To(Equal(1))
+		})
+	})
 })
 
 var _ = Describe("Delete", func() {
@@ -569,29 +1027,122 @@ var _ = Describe("Delete", func() {
 			})
 		})
 	})
-})
 
-var _ = Describe("Update", func() {
-	var (
-		ctrl            *gomock.Controller
-		participantRepo *mocks.MockParticipantRepository
-		eventRepo       *mocks.MockEventRepository
-		uc              participant.Usecase
-		ctx             context.Context
-		userID          uuid.UUID
-		eventID         uuid.UUID
-		participantID   uuid.UUID
-	)
+	When("restoring a deleted participant", func() {
+		var (
+			deleted *entity.Participant
+			event   *entity.Event
+		)
+
+		BeforeEach(func() {
+			deleted = makeParticipant(participantID, eventID)
+			deletedAt := time.Now().Add(-time.Hour)
+			deleted.DeletedAt = &deletedAt
+			event = &entity.Event{ID: eventID, OrganizerID: userID}
+		})
 
-	BeforeEach(func() {
-		ctrl = gomock.NewController(GinkgoT())
-		participantRepo = mocks.NewMockParticipantRepository(ctrl)
-		eventRepo = mocks.NewMockEventRepository(ctrl)
-		uc = newTestUsecase(participantRepo, eventRepo)
-		ctx = context.Background()
-		userID = uuid.New()
-		eventID = uuid.New()
-		participantID = uuid.New()
+		Context("as the event organizer", func() {
+			It("should restore the participant and return them", func() {
+				restored := makeParticipant(participantID, eventID)
+
+				participantRepo.EXPECT().FindDeletedByID(ctx, participantID).Return(deleted, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Restore(ctx, participantID, true).Return(nil)
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(restored, nil)
+
+				result, err := uc.Restore(ctx, userID, false, participantID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.DeletedAt).To(BeNil())
+				Expect(result.QRDistributionURL).To(HavePrefix("https://qr.example.com/qr/"))
+			})
+
+			It("should record the restored participant in the audit log", func() {
+				auditRepo := mocks.NewMockAuditRepository(ctrl)
+				uc = newAuditedTestUsecase(participantRepo, eventRepo, audit.NewRecorder(auditRepo, &logger.Logger{Logger: zap.NewNop()}))
+				restored := makeParticipant(participantID, eventID)
+				restored.Status = entity.ParticipantStatusWaitlisted
+
+				participantRepo.EXPECT().FindDeletedByID(ctx, participantID).Return(deleted, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Restore(ctx, participantID, true).Return(nil)
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(restored, nil)
+
+				var entry *entity.AuditLog
+				auditRepo.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, e *entity.AuditLog) error {
+						entry = e
+						return nil
+					},
+				)
+
+				_, err := uc.Restore(ctx, userID, false, participantID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(entry.Action).To(Equal(entity.AuditActionCreate))
+				Expect(entry.ResourceID).To(Equal(participantID))
+				Expect(entry.Changes["status"].New).To(BeEquivalentTo(`"waitlisted"`))
+			})
+		})
+
+		Context("when the caller is neither admin nor event organizer", func() {
+			It("should return a Forbidden error", func() {
+				participantRepo.EXPECT().FindDeletedByID(ctx, participantID).Return(deleted, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				_, err := uc.Restore(ctx, uuid.New(), false, participantID)
+
+				Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the participant is not deleted", func() {
+			It("should return the not-found error", func() {
+				participantRepo.EXPECT().FindDeletedByID(ctx, participantID).
+					Return(nil, apperrors.NotFound("participant not found"))
+
+				_, err := uc.Restore(ctx, userID, false, participantID)
+
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		Context("when another participant of the event holds the email by now", func() {
+			It("should return the conflict error", func() {
+				participantRepo.EXPECT().FindDeletedByID(ctx, participantID).Return(deleted, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Restore(ctx, participantID, true).
+					Return(apperrors.Conflict("a participant with this email was registered for the event since the deletion"))
+
+				_, err := uc.Restore(ctx, userID, false, participantID)
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+		})
+	})
+})
+
+var _ = Describe("Update", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		userID          uuid.UUID
+		eventID         uuid.UUID
+		participantID   uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		userID = uuid.New()
+		eventID = uuid.New()
+		participantID = uuid.New()
 	})
 
 	AfterEach(func() { ctrl.Finish() })
@@ -615,14 +1166,50 @@ var _ = Describe("Update", func() {
 			})
 		})
 
+		Context("with custom data", func() {
+			var event *entity.Event
+
+			BeforeEach(func() {
+				event = &entity.Event{ID: eventID, OrganizerID: userID, CustomFields: []entity.CustomField{
+					{Key: "age", Label: "Age", Type: entity.CustomFieldTypeNumber},
+				}}
+			})
+
+			It("should replace the participant's custom data", func() {
+				p := makeParticipant(participantID, eventID)
+				customData := map[string]any{"age": float64(30)}
+				input := participant.UpdateParticipantInput{CustomData: &customData}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+				result, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.CustomData).To(Equal(customData))
+			})
+
+			It("should reject a value that does not match its field", func() {
+				p := makeParticipant(participantID, eventID)
+				customData := map[string]any{"age": "thirty"}
+				input := participant.UpdateParticipantInput{CustomData: &customData}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				_, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+			})
+		})
+
 		Context("with only payment fields changed", func() {
-			It("should update payment status and amount without touching other fields", func() {
+			It("should update the payment amount without touching other fields", func() {
 				p := makeParticipant(participantID, eventID)
-				event := &entity.Event{ID: eventID, OrganizerID: userID}
-				newStatus := entity.PaymentPaid
-				newAmount := 9800.0
+				event := &entity.Event{ID: eventID, OrganizerID: userID, Currency: "JPY"}
+				newAmount := money.FromMinorUnits(980000)
 				input := participant.UpdateParticipantInput{
-					PaymentStatus: &newStatus,
 					PaymentAmount: &newAmount,
 				}
 
@@ -633,13 +1220,116 @@ var _ = Describe("Update", func() {
 				result, err := uc.Update(ctx, userID, false, participantID, input)
 
 				Expect(err).NotTo(HaveOccurred())
-				Expect(result.PaymentStatus).To(Equal(entity.PaymentPaid))
+				Expect(result.PaymentStatus).To(Equal(entity.PaymentUnpaid))
 				Expect(result.PaymentAmount).To(Equal(&newAmount))
 				// unchanged field
 				Expect(result.Name).To(Equal("Alice Smith"))
 			})
 		})
 
+		Context("with a payment amount for an event without currency", func() {
+			It("should return a validation error", func() {
+				p := makeParticipant(participantID, eventID)
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				newAmount := money.FromMinorUnits(980000)
+				input := participant.UpdateParticipantInput{PaymentAmount: &newAmount}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				result, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+				Expect(result).To(BeNil())
+			})
+		})
+
+		Context("with expired status", func() {
+			It("should return a validation error when expiring a participant manually", func() {
+				p := makeParticipant(participantID, eventID)
+				p.Status = entity.ParticipantStatusTentative
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				expired := entity.ParticipantStatusExpired
+				input := participant.UpdateParticipantInput{Status: &expired}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				_, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("participants expire only automatically"))
+			})
+
+			It("should reinstate an expired tentative participant", func() {
+				p := makeParticipant(participantID, eventID)
+				p.Status = entity.ParticipantStatusExpired
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				confirmed := entity.ParticipantStatusConfirmed
+				input := participant.UpdateParticipantInput{Status: &confirmed}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+				result, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Status).To(Equal(entity.ParticipantStatusConfirmed))
+			})
+
+			It("should return the conflict when confirming a participant of a full event", func() {
+				p := makeParticipant(participantID, eventID)
+				p.Status = entity.ParticipantStatusTentative
+				event := &entity.Event{ID: eventID, OrganizerID: userID, Capacity: 1}
+				confirmed := entity.ParticipantStatusConfirmed
+				input := participant.UpdateParticipantInput{Status: &confirmed}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().UpdateWithinCapacity(ctx, gomock.Any()).
+					Return(apperrors.Conflict("event is at capacity"))
+
+				_, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+
+			It("should return a validation error when confirming a waitlisted participant", func() {
+				p := makeParticipant(participantID, eventID)
+				p.Status = entity.ParticipantStatusWaitlisted
+				event := &entity.Event{ID: eventID, OrganizerID: userID, Capacity: 1}
+				confirmed := entity.ParticipantStatusConfirmed
+				input := participant.UpdateParticipantInput{Status: &confirmed}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				_, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("confirmed by promoting them"))
+			})
+
+			It("should return a validation error when reinstating an expired invitation", func() {
+				p := makeParticipant(participantID, eventID)
+				p.Status = entity.ParticipantStatusExpired
+				p.QRCode = ""
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				confirmed := entity.ParticipantStatusConfirmed
+				input := participant.UpdateParticipantInput{Status: &confirmed}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				_, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+				Expect(err.Error()).To(ContainSubstring("expired invitations cannot be reinstated"))
+			})
+		})
+
 		Context("with metadata update", func() {
 			It("should set the metadata JSON on the returned participant", func() {
 				p := makeParticipant(participantID, eventID)
@@ -658,6 +1348,111 @@ var _ = Describe("Update", func() {
 			})
 		})
 
+		Context("with notes update", func() {
+			It("should set the notes on the returned participant", func() {
+				p := makeParticipant(participantID, eventID)
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				notes := "Needs wheelchair access"
+				input := participant.UpdateParticipantInput{Notes: &notes}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+				result, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Notes).To(HaveValue(Equal("Needs wheelchair access")))
+			})
+
+			It("should clear the notes when given an empty string", func() {
+				p := makeParticipant(participantID, eventID)
+				existing := "Needs wheelchair access"
+				p.Notes = &existing
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				empty := ""
+				input := participant.UpdateParticipantInput{Notes: &empty}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+				result, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Notes).To(BeNil())
+			})
+
+			It("should return a validation error for notes over the length limit", func() {
+				p := makeParticipant(participantID, eventID)
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				notes := strings.Repeat("a", entity.ParticipantNotesMaxLength+1)
+				input := participant.UpdateParticipantInput{Notes: &notes}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+				result, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(err).To(HaveOccurred())
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+				Expect(result).To(BeNil())
+			})
+		})
+
+		Context("with tags update", func() {
+			It("should replace the tags with the normalized list", func() {
+				p := makeParticipant(participantID, eventID)
+				p.Tags = []string{"vip"}
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				tags := []string{" speaker ", "follow-up", "speaker"}
+				input := participant.UpdateParticipantInput{Tags: &tags}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+				result, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.Tags).To(Equal([]string{"speaker", "follow-up"}))
+			})
+		})
+
+		Context("with an audit log", func() {
+			It("should record the changed fields, redacting personal data", func() {
+				auditRepo := mocks.NewMockAuditRepository(ctrl)
+				uc = newAuditedTestUsecase(participantRepo, eventRepo, audit.NewRecorder(auditRepo, &logger.Logger{Logger: zap.NewNop()}))
+				p := makeParticipant(participantID, eventID)
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				newEmail := "alice.updated@example.com"
+				status := entity.ParticipantStatusCancelled
+				input := participant.UpdateParticipantInput{Email: &newEmail, Status: &status}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().Update(ctx, gomock.Any()).Return(nil)
+
+				var entry *entity.AuditLog
+				auditRepo.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, e *entity.AuditLog) error {
+						entry = e
+						return nil
+					},
+				)
+
+				_, err := uc.Update(ctx, userID, false, participantID, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(entry.ActorID).To(Equal(userID))
+				Expect(entry.Action).To(Equal(entity.AuditActionUpdate))
+				Expect(entry.ResourceID).To(Equal(participantID))
+				Expect(entry.Changes).To(HaveLen(2))
+				Expect(entry.Changes["email"]).To(Equal(entity.AuditChange{Redacted: true}))
+				Expect(entry.Changes["status"].New).To(BeEquivalentTo(`"cancelled"`))
+			})
+		})
+
 		Context("when the caller is neither admin nor event organizer", func() {
 			It("should return a Forbidden error", func() {
 				otherUserID := uuid.New()
@@ -860,6 +1655,75 @@ var _ = Describe("GetByID", func() {
 	})
 })
 
+var _ = Describe("LookupByEmail", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		userID          uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		userID = uuid.New()
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	When("looking up participants by email", func() {
+		Context("as an organizer", func() {
+			It("should search only the organizer's events", func() {
+				p := makeParticipant(uuid.New(), uuid.New())
+				participantRepo.EXPECT().FindByEmailAcrossOrganizer(ctx, &userID, "alice@example.com").
+					Return([]*entity.Participant{p}, nil)
+
+				result, err := uc.LookupByEmail(ctx, userID, false, "alice@example.com")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(HaveLen(1))
+				Expect(result[0].QRDistributionURL).To(HavePrefix("https://qr.example.com/qr/"))
+			})
+		})
+
+		Context("as admin", func() {
+			It("should search every organizer's events", func() {
+				participantRepo.EXPECT().FindByEmailAcrossOrganizer(ctx, nil, "alice@example.com").
+					Return([]*entity.Participant{}, nil)
+
+				_, err := uc.LookupByEmail(ctx, userID, true, "alice@example.com")
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when no participant has the email", func() {
+			It("should return an empty list", func() {
+				participantRepo.EXPECT().FindByEmailAcrossOrganizer(ctx, &userID, "nobody@example.com").
+					Return([]*entity.Participant{}, nil)
+
+				result, err := uc.LookupByEmail(ctx, userID, false, "nobody@example.com")
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(BeEmpty())
+			})
+		})
+
+		Context("with a malformed email", func() {
+			It("should return a BadRequest error without querying", func() {
+				_, err := uc.LookupByEmail(ctx, userID, false, "not-an-email")
+
+				Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+			})
+		})
+	})
+})
+
 var _ = Describe("List", func() {
 	var (
 		ctrl            *gomock.Controller
@@ -912,6 +1776,49 @@ var _ = Describe("List", func() {
 			})
 		})
 
+		Context("for an event with a capacity", func() {
+			It("should return the capacity and the places remaining", func() {
+				event := &entity.Event{ID: eventID, OrganizerID: userID, Capacity: 10}
+				input := participant.ListParticipantsInput{EventID: eventID, Page: 1, PerPage: 10}
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().FindByEventID(ctx, eventID, 0, 10).Return(nil, int64(0), nil)
+				participantRepo.EXPECT().CountConfirmed(ctx, eventID).Return(int64(7), nil)
+
+				output, err := uc.List(ctx, userID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.Capacity).To(Equal(10))
+				Expect(output.CapacityRemaining).To(Equal(int64(3)))
+			})
+		})
+
+		Context("including deleted participants", func() {
+			It("should list them through the filter query", func() {
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				status := entity.ParticipantStatusConfirmed
+				input := participant.ListParticipantsInput{
+					EventID:        eventID,
+					Page:           1,
+					PerPage:        10,
+					Status:         &status,
+					IncludeDeleted: true,
+				}
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().FindByFilter(ctx, repository.ParticipantListFilter{
+					EventID:        &eventID,
+					Status:         &status,
+					IncludeDeleted: true,
+				}, 0, 10).Return([]*entity.Participant{makeParticipant(uuid.New(), eventID)}, int64(1), nil)
+
+				output, err := uc.List(ctx, userID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.TotalCount).To(Equal(int64(1)))
+			})
+		})
+
 		Context("with a non-empty search query", func() {
 			It("should delegate to the Search repository method", func() {
 				event := &entity.Event{ID: eventID, OrganizerID: userID}
@@ -961,6 +1868,37 @@ var _ = Describe("List", func() {
 			})
 		})
 
+		Context("with a group filter", func() {
+			It("should filter by group, status and search in the repository", func() {
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+				seated := makeParticipant(uuid.New(), eventID)
+				group := "Table 5"
+				statusFilter := entity.ParticipantStatusConfirmed
+				input := participant.ListParticipantsInput{
+					EventID: eventID,
+					Page:    2,
+					PerPage: 10,
+					Search:  "Alice",
+					Status:  &statusFilter,
+					Group:   &group,
+				}
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				participantRepo.EXPECT().FindByFilter(ctx, repository.ParticipantListFilter{
+					EventID: &eventID,
+					Status:  &statusFilter,
+					Search:  "Alice",
+					Group:   &group,
+				}, 10, 10).Return([]*entity.Participant{seated}, int64(11), nil)
+
+				output, err := uc.List(ctx, userID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(output.TotalCount).To(Equal(int64(11)))
+				Expect(output.Participants).To(ConsistOf(seated))
+			})
+		})
+
 		Context("when the caller is neither admin nor event organizer", func() {
 			It("should return a Forbidden error", func() {
 				otherUserID := uuid.New()
@@ -1045,6 +1983,64 @@ var _ = Describe("List", func() {
 	})
 })
 
+var _ = Describe("GetListLastModified", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		userID          uuid.UUID
+		eventID         uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		userID = uuid.New()
+		eventID = uuid.New()
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	When("the caller owns the event", func() {
+		It("should return the participant list modification time", func() {
+			lastModified := time.Date(2030, 1, 1, 12, 0, 0, 0, time.UTC)
+			eventRepo.EXPECT().FindByID(ctx, eventID).Return(&entity.Event{ID: eventID, OrganizerID: userID}, nil)
+			participantRepo.EXPECT().GetListLastModified(ctx, eventID).Return(lastModified, nil)
+
+			result, err := uc.GetListLastModified(ctx, userID, false, eventID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(lastModified))
+		})
+	})
+
+	When("the caller does not own the event", func() {
+		It("should return forbidden without querying the participant list", func() {
+			eventRepo.EXPECT().FindByID(ctx, eventID).Return(&entity.Event{ID: eventID, OrganizerID: uuid.New()}, nil)
+
+			_, err := uc.GetListLastModified(ctx, userID, false, eventID)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+
+	When("the event does not exist", func() {
+		It("should return not found", func() {
+			eventRepo.EXPECT().FindByID(ctx, eventID).Return(nil, apperrors.NotFound("event not found"))
+
+			_, err := uc.GetListLastModified(ctx, userID, true, eventID)
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
+
 var _ = Describe("GetQRCode", func() {
 	var (
 		ctrl            *gomock.Controller
@@ -1079,6 +2075,7 @@ var _ = Describe("GetQRCode", func() {
 
 				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
 				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				eventRepo.EXPECT().FindLogo(ctx, eventID).Return(nil, nil)
 
 				output, err := uc.GetQRCode(ctx, userID, false, participantID, "png", 300)
 
@@ -1107,6 +2104,30 @@ var _ = Describe("GetQRCode", func() {
 			})
 		})
 
+		Context("in PNG format for an event with a logo", func() {
+			It("should overlay the logo in the center of the QR code", func() {
+				red := color.RGBA{R: 255, A: 255}
+				logoImage := image.NewRGBA(image.Rect(0, 0, 20, 20))
+				draw.Draw(logoImage, logoImage.Bounds(), image.NewUniform(red), image.Point{}, draw.Src)
+				var logo bytes.Buffer
+				Expect(png.Encode(&logo, logoImage)).To(Succeed())
+
+				p := makeParticipant(participantID, eventID)
+				event := &entity.Event{ID: eventID, OrganizerID: userID}
+
+				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				eventRepo.EXPECT().FindLogo(ctx, eventID).Return(logo.Bytes(), nil)
+
+				output, err := uc.GetQRCode(ctx, userID, false, participantID, "png", 300)
+
+				Expect(err).NotTo(HaveOccurred())
+				qr, err := png.Decode(bytes.NewReader(output.Data))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(color.RGBAModel.Convert(qr.At(150, 150))).To(Equal(red))
+			})
+		})
+
 		Context("as admin (non-owner)", func() {
 			It("should bypass the organizer check and return the QR code", func() {
 				adminID := uuid.New()
@@ -1115,6 +2136,7 @@ var _ = Describe("GetQRCode", func() {
 
 				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
 				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				eventRepo.EXPECT().FindLogo(ctx, eventID).Return(nil, nil)
 
 				output, err := uc.GetQRCode(ctx, adminID, true, participantID, "png", 200)
 
@@ -1195,6 +2217,7 @@ var _ = Describe("GetQRCode", func() {
 
 				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
 				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				eventRepo.EXPECT().FindLogo(ctx, eventID).Return(nil, nil)
 
 				output, err := uc.GetQRCode(ctx, userID, false, participantID, "png", 100)
 
@@ -1208,6 +2231,7 @@ var _ = Describe("GetQRCode", func() {
 
 				participantRepo.EXPECT().FindByID(ctx, participantID).Return(p, nil)
 				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				eventRepo.EXPECT().FindLogo(ctx, eventID).Return(nil, nil)
 
 				output, err := uc.GetQRCode(ctx, userID, false, participantID, "png", 2000)
 
@@ -0,0 +1,50 @@
+package participant
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// Promote confirms a waitlisted participant, taking one of the places left in their event's
+// capacity. It fails with a conflict while the event is still full.
+func (u *participantUsecase) Promote(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	id uuid.UUID,
+) (*entity.Participant, error) {
+	participant, err := u.participantRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, participant.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "promote this participant"); err != nil {
+		return nil, err
+	}
+
+	if !participant.IsWaitlisted() {
+		return nil, apperrors.Conflict("participant is not waitlisted")
+	}
+
+	now := time.Now()
+	if err := u.participantRepo.Promote(ctx, participant.ID, now); err != nil {
+		return nil, err
+	}
+
+	participant.Status = entity.ParticipantStatusConfirmed
+	participant.UpdatedAt = now
+	u.populateDistributionURL(participant)
+
+	return participant, nil
+}
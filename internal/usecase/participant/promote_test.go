@@ -0,0 +1,84 @@
+package participant_test
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("Promote", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+		p               *entity.Participant
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID, Capacity: 10}
+		p = makeParticipant(uuid.New(), event.ID)
+		p.Status = entity.ParticipantStatusWaitlisted
+
+		participantRepo.EXPECT().FindByID(ctx, p.ID).Return(p, nil)
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	When("the organizer promotes a waitlisted participant", func() {
+		It("confirms the participant", func() {
+			participantRepo.EXPECT().Promote(ctx, p.ID, gomock.Any()).Return(nil)
+
+			promoted, err := uc.Promote(ctx, organizerID, false, p.ID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(promoted.Status).To(Equal(entity.ParticipantStatusConfirmed))
+			Expect(promoted.QRDistributionURL).To(HavePrefix("https://qr.example.com/qr/"))
+		})
+	})
+
+	When("the event is still at capacity", func() {
+		It("returns the repository's conflict error", func() {
+			participantRepo.EXPECT().Promote(ctx, p.ID, gomock.Any()).Return(apperrors.Conflict("event is at capacity"))
+
+			_, err := uc.Promote(ctx, organizerID, false, p.ID)
+
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+		})
+	})
+
+	When("the participant is not waitlisted", func() {
+		It("returns a conflict error", func() {
+			p.Status = entity.ParticipantStatusConfirmed
+
+			_, err := uc.Promote(ctx, organizerID, false, p.ID)
+
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+		})
+	})
+
+	When("the user does not manage the event", func() {
+		It("returns a forbidden error", func() {
+			_, err := uc.Promote(ctx, uuid.New(), false, p.ID)
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,129 @@
+package participant
+
+import (
+	"archive/zip"
+	"context"
+	"fmt"
+	"image"
+	"io"
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/google/uuid"
+)
+
+// archiveQRCodeSize is the size in pixels of the PNG QR codes in an archive, the default of a single download.
+const archiveQRCodeSize = 512
+
+// QRCodeArchive is a ZIP archive of the QR codes of an event's participants. Its entries are
+// generated while the archive is written, so only one QR code is held in memory at a time.
+type QRCodeArchive struct {
+	// Filename is the name of the archive, after the event.
+	Filename string
+
+	usecase      *participantUsecase
+	participants []*entity.Participant
+	format       string
+	logo         image.Image
+}
+
+// GetQRCodeArchive prepares a ZIP archive with a QR code per participant of an event, named
+// {participant_name}-{id}.{format}. Participants without a QR code, i.e. invited participants who
+// have not accepted yet, are left out. The archive is written by the caller, so it can be streamed.
+func (u *participantUsecase) GetQRCodeArchive(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input QRCodeArchiveInput,
+) (*QRCodeArchive, error) {
+	event, err := u.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: same as listing the participants
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view participants for this event"); err != nil {
+		return nil, err
+	}
+
+	if err := validateQRCodeParams(input.Format, archiveQRCodeSize); err != nil {
+		return nil, err
+	}
+
+	// PNG QR codes carry the event's logo, as they do when downloaded one by one
+	var logo image.Image
+	if input.Format == "png" {
+		if logo, err = u.findEventLogo(ctx, event.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	participants, err := u.participantRepo.FindAllByEventID(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	withQRCode := make([]*entity.Participant, 0, len(participants))
+	for _, p := range participants {
+		if p.QRCode != "" {
+			withQRCode = append(withQRCode, p)
+		}
+	}
+
+	return &QRCodeArchive{
+		Filename:     fmt.Sprintf("%s-qrcodes.zip", archiveFilename(event.Name, "event")),
+		usecase:      u,
+		participants: withQRCode,
+		format:       input.Format,
+		logo:         logo,
+	}, nil
+}
+
+// Write writes the archive to w, generating each QR code as its entry is written.
+// Writing stops when ctx is cancelled.
+func (a *QRCodeArchive) Write(ctx context.Context, w io.Writer) error {
+	zw := zip.NewWriter(w)
+	for _, p := range a.participants {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		data, _, err := a.usecase.generateQRCodeData(ctx, p.QRCode, a.format, archiveQRCodeSize, a.logo)
+		if err != nil {
+			return err
+		}
+
+		// PNG data is already compressed; SVG markup shrinks well
+		method := zip.Deflate
+		if a.format == "png" {
+			method = zip.Store
+		}
+		entry, err := zw.CreateHeader(&zip.FileHeader{
+			Name:   fmt.Sprintf("%s-%s.%s", archiveFilename(p.Name, "participant"), p.ID, a.format),
+			Method: method,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add QR code to archive: %w", err)
+		}
+		if _, err := entry.Write(data); err != nil {
+			return fmt.Errorf("failed to add QR code to archive: %w", err)
+		}
+	}
+	return zw.Close()
+}
+
+// archiveFilename makes name safe to use as a file name by replacing path separators and the
+// characters reserved on common file systems with underscores. An empty name becomes fallback.
+func archiveFilename(name, fallback string) string {
+	name = strings.Map(func(r rune) rune {
+		if r < ' ' || r == 0x7f || strings.ContainsRune(`/\:*?"<>|`, r) {
+			return '_'
+		}
+		return r
+	}, strings.TrimSpace(name))
+	if name == "" || name == "." || name == ".." {
+		return fallback
+	}
+	return name
+}
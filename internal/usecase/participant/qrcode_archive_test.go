@@ -0,0 +1,134 @@
+package participant_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"io"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("GetQRCodeArchive", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+		participants    []*entity.Participant
+	)
+
+	// readArchive writes the archive and returns its entries by name.
+	readArchive := func(archive *participant.QRCodeArchive) map[string][]byte {
+		var buf bytes.Buffer
+		Expect(archive.Write(ctx, &buf)).To(Succeed())
+		zr, err := zip.NewReader(bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		Expect(err).NotTo(HaveOccurred())
+
+		entries := make(map[string][]byte, len(zr.File))
+		for _, f := range zr.File {
+			rc, err := f.Open()
+			Expect(err).NotTo(HaveOccurred())
+			data, err := io.ReadAll(rc)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rc.Close()).To(Succeed())
+			entries[f.Name] = data
+		}
+		return entries
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID, Name: "Tech Conf 2025"}
+
+		taro := makeParticipant(uuid.New(), event.ID)
+		taro.Name = "Taro Yamada"
+		taro.QRCode = "qr-token-1"
+		hanako := makeParticipant(uuid.New(), event.ID)
+		hanako.Name = "Hanako/Sato"
+		hanako.QRCode = "qr-token-2"
+		participants = []*entity.Participant{taro, hanako}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("should archive a PNG QR code per participant, named after the event", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		eventRepo.EXPECT().FindLogo(ctx, event.ID).Return(nil, nil)
+		participantRepo.EXPECT().FindAllByEventID(ctx, event.ID).Return(participants, nil)
+
+		archive, err := uc.GetQRCodeArchive(ctx, organizerID, false, participant.QRCodeArchiveInput{
+			EventID: event.ID, Format: "png",
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(archive.Filename).To(Equal("Tech Conf 2025-qrcodes.zip"))
+		entries := readArchive(archive)
+		Expect(entries).To(HaveLen(2))
+		Expect(entries).To(HaveKey("Taro Yamada-" + participants[0].ID.String() + ".png"))
+		Expect(entries["Taro Yamada-"+participants[0].ID.String()+".png"]).To(HavePrefix("\x89PNG"))
+		Expect(entries).To(HaveKey("Hanako_Sato-" + participants[1].ID.String() + ".png"))
+	})
+
+	It("should archive SVG QR codes", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().FindAllByEventID(ctx, event.ID).Return(participants, nil)
+
+		archive, err := uc.GetQRCodeArchive(ctx, organizerID, false, participant.QRCodeArchiveInput{
+			EventID: event.ID, Format: "svg",
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		entries := readArchive(archive)
+		Expect(string(entries["Taro Yamada-"+participants[0].ID.String()+".svg"])).To(ContainSubstring("<svg"))
+	})
+
+	It("should leave out participants without a QR code", func() {
+		participants[1].Status = entity.ParticipantStatusInvited
+		participants[1].QRCode = ""
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().FindAllByEventID(ctx, event.ID).Return(participants, nil)
+
+		archive, err := uc.GetQRCodeArchive(ctx, organizerID, false, participant.QRCodeArchiveInput{
+			EventID: event.ID, Format: "svg",
+		})
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(readArchive(archive)).To(HaveLen(1))
+	})
+
+	It("should reject an unknown format", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+		_, err := uc.GetQRCodeArchive(ctx, organizerID, false, participant.QRCodeArchiveInput{
+			EventID: event.ID, Format: "gif",
+		})
+
+		Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+	})
+
+	It("should reject users who do not manage the event", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+		_, err := uc.GetQRCodeArchive(ctx, uuid.New(), false, participant.QRCodeArchiveInput{
+			EventID: event.ID, Format: "png",
+		})
+
+		Expect(apperrors.IsForbidden(err)).To(BeTrue())
+	})
+})
@@ -0,0 +1,283 @@
+package participant
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	htmltemplate "html/template"
+	"image"
+	"sync"
+	texttemplate "text/template"
+	"time"
+
+	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// getInviteHTMLTemplate returns the parsed HTML QR code invite template, parsing it once on first call.
+var getInviteHTMLTemplate = sync.OnceValues(func() (*htmltemplate.Template, error) {
+	return htmltemplate.New("qrcode_invite").Parse(qrCodeInviteTemplate)
+})
+
+// getInviteTextTemplate returns the parsed plain-text QR code invite template, parsing it once on first call.
+var getInviteTextTemplate = sync.OnceValues(func() (*texttemplate.Template, error) {
+	return texttemplate.New("qrcode_invite_text").Parse(qrCodeInviteTextTemplate)
+})
+
+//go:embed templates/qrcode_invite.html
+var qrCodeInviteTemplate string
+
+//go:embed templates/qrcode_invite.txt
+var qrCodeInviteTextTemplate string
+
+const (
+	// inviteQRCodeSize is the size in pixels of the PNG QR code embedded in an invite.
+	inviteQRCodeSize = 300
+	// inviteQRCodeContentID is the Content-ID the HTML invite references its QR code image by.
+	inviteQRCodeContentID = "qrcode"
+	// inviteDateLayout formats the event dates shown in invites, in the event's time zone.
+	inviteDateLayout = "2006-01-02 15:04 MST"
+)
+
+type qrCodeInviteData struct {
+	ParticipantName string
+	ParticipantID   string
+	EventName       string
+	StartsAt        string
+	EndsAt          string
+	Location        string
+	QRCodeContentID string
+}
+
+// SendInvite emails a participant their QR code as an embedded PNG image, together with the
+// event's dates and location, and records when it was sent.
+func (u *participantUsecase) SendInvite(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+	participantID uuid.UUID,
+) (*entity.Participant, error) {
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: same as sending QR code links
+	if err := authz.RequireEventManager(userID, event, isAdmin, "send QR codes for this event"); err != nil {
+		return nil, err
+	}
+
+	participant, err := u.participantRepo.FindByID(ctx, participantID)
+	if err != nil {
+		return nil, err
+	}
+	if participant.EventID != eventID {
+		return nil, apperrors.NotFound("participant not found in this event")
+	}
+	if participant.IsInvited() {
+		return nil, apperrors.Conflict("participant has not accepted the invitation yet")
+	}
+
+	logo, err := u.findEventLogo(ctx, event.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := u.sendInviteEmail(ctx, participant, event, logo); err != nil {
+		u.logger.WithContext(ctx).Error("failed to send qr code invite",
+			zap.String("participant_id", participant.ID.String()),
+			zap.Error(err),
+		)
+		return nil, apperrors.WrapAppError(apperrors.ServiceUnavailable("failed to send the QR code email"), err)
+	}
+	if err := u.markInviteSent(ctx, participant); err != nil {
+		return nil, err
+	}
+	return participant, nil
+}
+
+// SendInvites emails QR code invites to several participants of an event. Without participant
+// IDs, it sends to every participant with a QR code who has not been sent one yet, so it can be
+// repeated after new registrations. Failures are reported per participant.
+func (u *participantUsecase) SendInvites(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input SendInvitesInput,
+) (SendQRCodesOutput, error) {
+	event, err := u.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return SendQRCodesOutput{}, err
+	}
+
+	// Authorization: same as sending QR code links
+	if err := authz.RequireEventManager(userID, event, isAdmin, "send QR codes for this event"); err != nil {
+		return SendQRCodesOutput{}, err
+	}
+
+	participants, err := u.resolveInviteRecipients(ctx, input)
+	if err != nil {
+		return SendQRCodesOutput{}, err
+	}
+
+	logo, err := u.findEventLogo(ctx, event.ID)
+	if err != nil {
+		return SendQRCodesOutput{}, err
+	}
+
+	var failures []SendQRCodeFailure
+	sentCount := 0
+
+	for _, p := range participants {
+		if err := u.sendInviteEmail(ctx, p, event, logo); err != nil {
+			dest := destinationEmail(p)
+			u.logger.WithContext(ctx).Error("failed to send qr code invite",
+				zap.String("participant_id", p.ID.String()),
+				zap.String("email", dest),
+				zap.Error(err),
+			)
+			failures = append(failures, SendQRCodeFailure{
+				ParticipantID: p.ID,
+				Email:         dest,
+				Reason:        err.Error(),
+			})
+			continue
+		}
+		sentCount++
+		// The email is out, so a participant whose send could not be recorded still counts as sent
+		if err := u.markInviteSent(ctx, p); err != nil {
+			u.logger.WithContext(ctx).Error("failed to record qr code invite",
+				zap.String("participant_id", p.ID.String()),
+				zap.Error(err),
+			)
+		}
+	}
+
+	return SendQRCodesOutput{
+		SentCount:   sentCount,
+		FailedCount: len(failures),
+		Total:       len(participants),
+		Failures:    failures,
+	}, nil
+}
+
+// resolveInviteRecipients returns the participants to send invites to based on the input.
+func (u *participantUsecase) resolveInviteRecipients(
+	ctx context.Context,
+	input SendInvitesInput,
+) ([]*entity.Participant, error) {
+	if len(input.ParticipantIDs) > 0 {
+		return u.resolveParticipants(ctx, SendQRCodesInput{
+			EventID:        input.EventID,
+			ParticipantIDs: input.ParticipantIDs,
+		})
+	}
+
+	participants, err := u.participantRepo.FindAllByEventID(ctx, input.EventID)
+	if err != nil {
+		return nil, err
+	}
+	pending := make([]*entity.Participant, 0, len(participants))
+	for _, p := range participants {
+		if p.QRCode != "" && p.InviteSentAt == nil {
+			pending = append(pending, p)
+		}
+	}
+	return pending, nil
+}
+
+// sendInviteEmail sends the QR code invite of a participant.
+func (u *participantUsecase) sendInviteEmail(
+	ctx context.Context,
+	p *entity.Participant,
+	event *entity.Event,
+	logo image.Image,
+) error {
+	if p.IsInvited() {
+		return fmt.Errorf("participant %s has not accepted the invitation yet", p.ID)
+	}
+
+	qrCode, _, err := u.generateQRCodeData(ctx, p.QRCode, "png", inviteQRCodeSize, logo)
+	if err != nil {
+		return err
+	}
+
+	msg, err := u.buildInviteEmail(p, event, qrCode)
+	if err != nil {
+		return err
+	}
+	return u.emailSender.Send(ctx, msg)
+}
+
+// markInviteSent records that the QR code invite of a participant was sent just now.
+func (u *participantUsecase) markInviteSent(ctx context.Context, p *entity.Participant) error {
+	sentAt := time.Now()
+	if err := u.participantRepo.MarkInviteSent(ctx, p.ID, sentAt); err != nil {
+		return err
+	}
+	p.InviteSentAt = &sentAt
+	return nil
+}
+
+// buildInviteEmail renders the QR code invite of a participant with the QR code attached as an
+// inline image. When emailPlainTextOnly is true, the message has only the plain-text part and
+// the QR code is a regular attachment.
+func (u *participantUsecase) buildInviteEmail(
+	p *entity.Participant,
+	event *entity.Event,
+	qrCode []byte,
+) (domainemail.Message, error) {
+	loc := event.TimezoneLocation()
+	data := qrCodeInviteData{
+		ParticipantName: p.Name,
+		ParticipantID:   p.ID.String(),
+		EventName:       event.Name,
+		StartsAt:        event.StartDate.In(loc).Format(inviteDateLayout),
+		Location:        event.Location,
+		QRCodeContentID: inviteQRCodeContentID,
+	}
+	if event.EndDate != nil {
+		data.EndsAt = event.EndDate.In(loc).Format(inviteDateLayout)
+	}
+
+	msg := domainemail.Message{
+		To:      destinationEmail(p),
+		Subject: fmt.Sprintf(qrEmailSubject, event.Name),
+		Attachments: []domainemail.Attachment{{
+			Filename:    "qrcode.png",
+			ContentType: "image/png",
+			Data:        qrCode,
+			ContentID:   inviteQRCodeContentID,
+		}},
+	}
+
+	var textBody bytes.Buffer
+	textTmpl, err := getInviteTextTemplate()
+	if err != nil {
+		return domainemail.Message{}, fmt.Errorf("failed to parse text invite template: %w", err)
+	}
+	if err := textTmpl.Execute(&textBody, data); err != nil {
+		return domainemail.Message{}, fmt.Errorf("failed to render text invite template: %w", err)
+	}
+	msg.TextBody = textBody.String()
+	if u.emailPlainTextOnly {
+		return msg, nil
+	}
+
+	var body bytes.Buffer
+	htmlTmpl, err := getInviteHTMLTemplate()
+	if err != nil {
+		return domainemail.Message{}, fmt.Errorf("failed to parse invite template: %w", err)
+	}
+	if err := htmlTmpl.Execute(&body, data); err != nil {
+		return domainemail.Message{}, fmt.Errorf("failed to render invite template: %w", err)
+	}
+	msg.Body = body.String()
+	return msg, nil
+}
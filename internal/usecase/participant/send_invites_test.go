@@ -0,0 +1,221 @@
+package participant_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("SendInvite", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		emailSender     *mockEmailSender
+		uc              participant.Usecase
+		ctx             context.Context
+		userID          uuid.UUID
+		event           *entity.Event
+		alice           *entity.Participant
+	)
+
+	newUsecase := func(plainTextOnly bool) participant.Usecase {
+		return participant.NewUsecase(
+			participantRepo, eventRepo, nil, nil, nil, qrcode.NewGenerator(),
+			"test-hmac-secret-for-testing-only-32chars", nil, "", "", "", 0,
+			emailSender, plainTextOnly, nil, false, true, nil, &logger.Logger{Logger: zap.NewNop()},
+		)
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		emailSender = &mockEmailSender{errorsFor: map[string]error{}}
+		uc = newUsecase(false)
+		ctx = context.Background()
+		userID = uuid.New()
+
+		endDate := time.Date(2026, 11, 3, 9, 0, 0, 0, time.UTC)
+		event = &entity.Event{
+			ID:          uuid.New(),
+			OrganizerID: userID,
+			Name:        "Tech Conf",
+			StartDate:   time.Date(2026, 11, 3, 1, 0, 0, 0, time.UTC),
+			EndDate:     &endDate,
+			Location:    "Tokyo Big Sight",
+			Timezone:    "Asia/Tokyo",
+		}
+		alice = &entity.Participant{
+			ID: uuid.New(), EventID: event.ID,
+			Name: "Alice", Email: "alice@example.com",
+			QRCode: "qr-token-alice",
+			Status: entity.ParticipantStatusConfirmed,
+		}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	When("the participant has a QR code", func() {
+		BeforeEach(func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().FindByID(ctx, alice.ID).Return(alice, nil)
+			eventRepo.EXPECT().FindLogo(ctx, event.ID).Return(nil, nil)
+		})
+
+		It("should email the QR code as an inline PNG with the event details", func() {
+			participantRepo.EXPECT().MarkInviteSent(ctx, alice.ID, gomock.Any()).Return(nil)
+
+			result, err := uc.SendInvite(ctx, userID, false, event.ID, alice.ID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.InviteSentAt).NotTo(BeNil())
+			Expect(emailSender.sent).To(HaveLen(1))
+			msg := emailSender.sent[0]
+			Expect(msg.To).To(Equal("alice@example.com"))
+			Expect(msg.Subject).To(ContainSubstring("Tech Conf"))
+			Expect(msg.Body).To(ContainSubstring(`src="cid:qrcode"`))
+			Expect(msg.Body).To(ContainSubstring("2026-11-03 10:00 JST"))
+			Expect(msg.Body).To(ContainSubstring("2026-11-03 18:00 JST"))
+			Expect(msg.Body).To(ContainSubstring("Tokyo Big Sight"))
+			Expect(msg.TextBody).To(ContainSubstring("Tokyo Big Sight"))
+			Expect(msg.Attachments).To(HaveLen(1))
+			Expect(msg.Attachments[0].ContentType).To(Equal("image/png"))
+			Expect(msg.Attachments[0].ContentID).To(Equal("qrcode"))
+			Expect(msg.Attachments[0].Data[:8]).To(Equal([]byte("\x89PNG\r\n\x1a\n")))
+		})
+
+		It("should send only the plain-text part with the QR code in plain-text mode", func() {
+			uc = newUsecase(true)
+			participantRepo.EXPECT().MarkInviteSent(ctx, alice.ID, gomock.Any()).Return(nil)
+
+			_, err := uc.SendInvite(ctx, userID, false, event.ID, alice.ID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(emailSender.sent[0].Body).To(BeEmpty())
+			Expect(emailSender.sent[0].TextBody).To(ContainSubstring("2026-11-03 10:00 JST"))
+			Expect(emailSender.sent[0].Attachments).To(HaveLen(1))
+		})
+
+		It("should fail as unavailable without recording the send when the email cannot be sent", func() {
+			emailSender.errorsFor["alice@example.com"] = errors.New("smtp: connection refused")
+
+			_, err := uc.SendInvite(ctx, userID, false, event.ID, alice.ID)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeServiceUnavailable))
+			Expect(alice.InviteSentAt).To(BeNil())
+		})
+	})
+
+	It("should return not found for a participant of another event", func() {
+		alice.EventID = uuid.New()
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().FindByID(ctx, alice.ID).Return(alice, nil)
+
+		_, err := uc.SendInvite(ctx, userID, false, event.ID, alice.ID)
+
+		Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		Expect(emailSender.sent).To(BeEmpty())
+	})
+
+	It("should reject participants who have not accepted their invitation", func() {
+		alice.Status = entity.ParticipantStatusInvited
+		alice.QRCode = ""
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().FindByID(ctx, alice.ID).Return(alice, nil)
+
+		_, err := uc.SendInvite(ctx, userID, false, event.ID, alice.ID)
+
+		Expect(apperrors.IsConflict(err)).To(BeTrue())
+	})
+
+	It("should reject users who do not manage the event", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+		_, err := uc.SendInvite(ctx, uuid.New(), false, event.ID, alice.ID)
+
+		Expect(apperrors.IsForbidden(err)).To(BeTrue())
+	})
+
+	Describe("SendInvites", func() {
+		var bob *entity.Participant
+
+		BeforeEach(func() {
+			bob = &entity.Participant{
+				ID: uuid.New(), EventID: event.ID,
+				Name: "Bob", Email: "bob@example.com",
+				QRCode: "qr-token-bob",
+				Status: entity.ParticipantStatusConfirmed,
+			}
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		})
+
+		It("should send to every participant with a QR code who has not been sent one", func() {
+			sentAt := time.Now().Add(-time.Hour)
+			carol := &entity.Participant{
+				ID: uuid.New(), EventID: event.ID, Email: "carol@example.com",
+				QRCode: "qr-token-carol", InviteSentAt: &sentAt,
+			}
+			invited := &entity.Participant{
+				ID: uuid.New(), EventID: event.ID, Email: "dave@example.com",
+				Status: entity.ParticipantStatusInvited,
+			}
+			participantRepo.EXPECT().FindAllByEventID(ctx, event.ID).
+				Return([]*entity.Participant{alice, bob, carol, invited}, nil)
+			eventRepo.EXPECT().FindLogo(ctx, event.ID).Return(nil, nil)
+			participantRepo.EXPECT().MarkInviteSent(ctx, alice.ID, gomock.Any()).Return(nil)
+			participantRepo.EXPECT().MarkInviteSent(ctx, bob.ID, gomock.Any()).Return(nil)
+
+			result, err := uc.SendInvites(ctx, userID, false, participant.SendInvitesInput{EventID: event.ID})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Total).To(Equal(2))
+			Expect(result.SentCount).To(Equal(2))
+			Expect(emailSender.sent).To(HaveLen(2))
+		})
+
+		It("should report the participants whose email failed", func() {
+			emailSender.errorsFor["bob@example.com"] = errors.New("smtp: mailbox unavailable")
+			participantRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{alice.ID, bob.ID}).
+				Return([]*entity.Participant{alice, bob}, nil)
+			eventRepo.EXPECT().FindLogo(ctx, event.ID).Return(nil, nil)
+			participantRepo.EXPECT().MarkInviteSent(ctx, alice.ID, gomock.Any()).Return(nil)
+
+			result, err := uc.SendInvites(ctx, userID, false, participant.SendInvitesInput{
+				EventID:        event.ID,
+				ParticipantIDs: []uuid.UUID{alice.ID, bob.ID},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.SentCount).To(Equal(1))
+			Expect(result.FailedCount).To(Equal(1))
+			Expect(result.Failures[0].ParticipantID).To(Equal(bob.ID))
+			Expect(bob.InviteSentAt).To(BeNil())
+		})
+
+		It("should return not found when a participant belongs to another event", func() {
+			bob.EventID = uuid.New()
+			participantRepo.EXPECT().FindByIDs(ctx, []uuid.UUID{bob.ID}).Return([]*entity.Participant{bob}, nil)
+
+			_, err := uc.SendInvites(ctx, userID, false, participant.SendInvitesInput{
+				EventID:        event.ID,
+				ParticipantIDs: []uuid.UUID{bob.ID},
+			})
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			Expect(emailSender.sent).To(BeEmpty())
+		})
+	})
+})
@@ -11,6 +11,7 @@ import (
 
 	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/google/uuid"
@@ -86,10 +87,8 @@ func (u *participantUsecase) SendQRCodes(
 	if err != nil {
 		return SendQRCodesOutput{}, err
 	}
-	if !isAdmin && event.OrganizerID != userID {
-		return SendQRCodesOutput{}, apperrors.Forbidden(
-			"you do not have permission to send QR codes for this event",
-		)
+	if err := authz.RequireEventManager(userID, event, isAdmin, "send QR codes for this event"); err != nil {
+		return SendQRCodesOutput{}, err
 	}
 
 	// Resolve the target participants.
@@ -162,13 +161,28 @@ func destinationEmail(p *entity.Participant) string {
 }
 
 // sendQRCodeEmail sends a single QR code email to a participant.
-// When emailPlainTextOnly is true, only the plain-text part is sent (no HTML).
 // Returns an error if sending failed, or nil on success.
 func (u *participantUsecase) sendQRCodeEmail(ctx context.Context, p *entity.Participant, dest, eventName string) error {
+	if p.IsInvited() {
+		return fmt.Errorf("participant %s has not accepted the invitation yet", p.ID)
+	}
 	if p.QRDistributionURL == "" {
 		return fmt.Errorf("QRDistributionURL is not configured for participant %s", p.ID)
 	}
 
+	msg, err := u.buildQRCodeEmail(p, dest, eventName)
+	if err != nil {
+		return err
+	}
+	return u.emailSender.Send(ctx, msg)
+}
+
+// buildQRCodeEmail renders the QR code email of a participant. When emailPlainTextOnly is
+// true, the message has only the plain-text part (no HTML).
+func (u *participantUsecase) buildQRCodeEmail(
+	p *entity.Participant,
+	dest, eventName string,
+) (domainemail.Message, error) {
 	data := qrCodeEmailData{
 		ParticipantName: p.Name,
 		EventName:       eventName,
@@ -176,31 +190,24 @@ func (u *participantUsecase) sendQRCodeEmail(ctx context.Context, p *entity.Part
 		WalletPassURL:   crypto.GenerateWalletPassURL(u.walletPassBaseURL, p.QRCode),
 		ParticipantID:   p.ID.String(),
 	}
+	msg := domainemail.Message{
+		To:      dest,
+		Subject: fmt.Sprintf(qrEmailSubject, eventName),
+	}
 
+	textBody, err := renderQRCodeTextEmail(data)
+	if err != nil {
+		return domainemail.Message{}, err
+	}
+	msg.TextBody = textBody
 	if u.emailPlainTextOnly {
-		textBody, err := renderQRCodeTextEmail(data)
-		if err != nil {
-			return err
-		}
-		return u.emailSender.Send(ctx, domainemail.Message{
-			To:       dest,
-			Subject:  fmt.Sprintf(qrEmailSubject, eventName),
-			TextBody: textBody,
-		})
+		return msg, nil
 	}
 
 	body, err := renderQRCodeEmail(data)
 	if err != nil {
-		return err
-	}
-	textBody, err := renderQRCodeTextEmail(data)
-	if err != nil {
-		return err
+		return domainemail.Message{}, err
 	}
-	return u.emailSender.Send(ctx, domainemail.Message{
-		To:       dest,
-		Subject:  fmt.Sprintf(qrEmailSubject, eventName),
-		Body:     body,
-		TextBody: textBody,
-	})
+	msg.Body = body
+	return msg, nil
 }
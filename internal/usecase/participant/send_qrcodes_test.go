@@ -53,12 +53,14 @@ var _ = Describe("SendQRCodes", func() {
 		emailSender = &mockEmailSender{errorsFor: map[string]error{}}
 		nopLogger := &logger.Logger{Logger: zap.NewNop()}
 		uc = participant.NewUsecase(
-			participantRepo, eventRepo, qrcode.NewGenerator(),
-			"test-hmac-secret-for-testing-only-32chars", "https://qr.example.com", "", emailSender, false, nopLogger,
+			participantRepo, eventRepo, nil, nil, nil, qrcode.NewGenerator(),
+			"test-hmac-secret-for-testing-only-32chars", nil, "https://qr.example.com", "", "", 0,
+			emailSender, false, nil, false, true, nil, nopLogger,
 		)
 		ucNoURL = participant.NewUsecase(
-			participantRepo, eventRepo, qrcode.NewGenerator(),
-			"test-hmac-secret-for-testing-only-32chars", "", "", emailSender, false, nopLogger,
+			participantRepo, eventRepo, nil, nil, nil, qrcode.NewGenerator(),
+			"test-hmac-secret-for-testing-only-32chars", nil, "", "", "", 0,
+			emailSender, false, nil, false, true, nil, nopLogger,
 		)
 		ctx = context.Background()
 		userID = uuid.New()
@@ -0,0 +1,44 @@
+package participant
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/google/uuid"
+)
+
+// GetStats returns the registration and payment statistics of an event's participants, with
+// authorization check. An event without participants has all counts and the revenue zero.
+func (u *participantUsecase) GetStats(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+) (StatsOutput, error) {
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return StatsOutput{}, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view participants for this event"); err != nil {
+		return StatsOutput{}, err
+	}
+
+	stats, err := u.participantRepo.GetPaymentStats(ctx, eventID)
+	if err != nil {
+		return StatsOutput{}, err
+	}
+
+	return StatsOutput{
+		TotalParticipants:     stats.TotalParticipants,
+		ConfirmedParticipants: stats.ConfirmedParticipants,
+		TentativeParticipants: stats.TentativeParticipants,
+		DeclinedParticipants:  stats.DeclinedParticipants,
+		PaidParticipants:      stats.PaidParticipants,
+		UnpaidParticipants:    stats.UnpaidParticipants,
+		TotalRevenue:          stats.TotalPaymentAmount,
+		TotalRefunded:         stats.RefundedPaymentAmount,
+		Currency:              stats.Currency,
+	}, nil
+}
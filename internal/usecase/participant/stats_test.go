@@ -0,0 +1,95 @@
+package participant_test
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("GetStats", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	It("returns the status and payment counts with the revenue", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().GetPaymentStats(ctx, event.ID).Return(&repository.ParticipantPaymentStats{
+			TotalParticipants:     10,
+			ConfirmedParticipants: 6,
+			TentativeParticipants: 3,
+			DeclinedParticipants:  1,
+			PaidParticipants:      4,
+			UnpaidParticipants:    6,
+			TotalPaymentAmount:    money.FromMinorUnits(400000),
+			Currency:              "JPY",
+			ExpectedPaymentAmount: money.FromMinorUnits(600000),
+		}, nil)
+
+		stats, err := uc.GetStats(ctx, organizerID, false, event.ID)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats).To(Equal(participant.StatsOutput{
+			TotalParticipants:     10,
+			ConfirmedParticipants: 6,
+			TentativeParticipants: 3,
+			DeclinedParticipants:  1,
+			PaidParticipants:      4,
+			UnpaidParticipants:    6,
+			TotalRevenue:          money.FromMinorUnits(400000),
+			Currency:              "JPY",
+		}))
+	})
+
+	It("returns zeros for an event without participants", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+		participantRepo.EXPECT().GetPaymentStats(ctx, event.ID).Return(&repository.ParticipantPaymentStats{}, nil)
+
+		stats, err := uc.GetStats(ctx, uuid.New(), true, event.ID)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stats).To(Equal(participant.StatsOutput{}))
+	})
+
+	It("rejects users who do not manage the event", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+		_, err := uc.GetStats(ctx, uuid.New(), false, event.ID)
+
+		Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeForbidden))
+	})
+
+	It("returns not found for an unknown event", func() {
+		eventRepo.EXPECT().FindByID(ctx, event.ID).Return(nil, apperrors.NotFound("event not found"))
+
+		_, err := uc.GetStats(ctx, organizerID, false, event.ID)
+
+		Expect(apperrors.IsNotFound(err)).To(BeTrue())
+	})
+})
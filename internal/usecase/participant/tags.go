@@ -0,0 +1,79 @@
+package participant
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// MaxTagUpdateParticipants is the maximum number of participant IDs in one bulk tag update
+const MaxTagUpdateParticipants = 1000
+
+// UpdateTags adds and removes tags on many participants of an event at once, e.g. to tag every
+// unpaid participant "follow-up". Re-adding a tag a participant already has and removing one it
+// does not have change nothing. Returns the number of participants whose tags changed.
+func (u *participantUsecase) UpdateTags(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input UpdateTagsInput,
+) (int64, error) {
+	add := entity.NormalizeParticipantTags(input.Add)
+	remove := entity.NormalizeParticipantTags(input.Remove)
+	if err := validateTagUpdate(input, add, remove); err != nil {
+		return 0, err
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return 0, err
+	}
+	if err := authz.RequireEventManager(userID, event, isAdmin, "tag participants of this event"); err != nil {
+		return 0, err
+	}
+
+	filter := repository.ParticipantTagFilter{IDs: input.ParticipantIDs}
+	if input.Filter != nil {
+		filter.Status = input.Filter.Status
+		filter.PaymentStatus = input.Filter.PaymentStatus
+		filter.Tag = input.Filter.Tag
+	}
+
+	return u.participantRepo.UpdateTags(ctx, event.ID, filter, add, remove, entity.ParticipantMaxTags)
+}
+
+// validateTagUpdate checks the target selection and the normalized tags of a bulk tag update
+func validateTagUpdate(input UpdateTagsInput, add, remove []string) error {
+	switch {
+	case len(input.ParticipantIDs) == 0 && input.Filter == nil:
+		return apperrors.BadRequest("either participant_ids or filter must be provided")
+	case len(input.ParticipantIDs) > 0 && input.Filter != nil:
+		return apperrors.BadRequest("participant_ids and filter cannot be combined")
+	case len(input.ParticipantIDs) > MaxTagUpdateParticipants:
+		return apperrors.BadRequest(
+			fmt.Sprintf("at most %d participant_ids can be tagged at once", MaxTagUpdateParticipants),
+		)
+	case len(add) == 0 && len(remove) == 0:
+		return apperrors.BadRequest("at least one tag to add or remove must be provided")
+	}
+
+	if err := entity.ValidateParticipantTags(add); err != nil {
+		return apperrors.Validation(err.Error())
+	}
+	if err := entity.ValidateParticipantTags(remove); err != nil {
+		return apperrors.Validation(err.Error())
+	}
+	for _, tag := range add {
+		for _, removed := range remove {
+			if tag == removed {
+				return apperrors.BadRequest(fmt.Sprintf("tag %q cannot be both added and removed", tag))
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,179 @@
+package participant_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("UpdateTags", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+		ids             []uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID}
+		ids = []uuid.UUID{uuid.New(), uuid.New()}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	When("the organizer adds a tag to participants by ID", func() {
+		It("applies the normalized tags and returns the number of participants changed", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().UpdateTags(ctx, event.ID,
+				repository.ParticipantTagFilter{IDs: ids},
+				[]string{"follow-up"}, []string{}, entity.ParticipantMaxTags,
+			).Return(int64(2), nil)
+
+			updated, err := uc.UpdateTags(ctx, organizerID, false, participant.UpdateTagsInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+				Add:            []string{" follow-up ", "follow-up"},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal(int64(2)))
+		})
+	})
+
+	When("the organizer removes a tag from participants selected by filter", func() {
+		It("passes the filter to the repository", func() {
+			unpaid := entity.PaymentUnpaid
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().UpdateTags(ctx, event.ID,
+				repository.ParticipantTagFilter{PaymentStatus: &unpaid},
+				[]string{}, []string{"contacted"}, entity.ParticipantMaxTags,
+			).Return(int64(5), nil)
+
+			updated, err := uc.UpdateTags(ctx, organizerID, false, participant.UpdateTagsInput{
+				EventID: event.ID,
+				Filter:  &participant.TagFilter{PaymentStatus: &unpaid},
+				Remove:  []string{"contacted"},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(Equal(int64(5)))
+		})
+	})
+
+	When("every participant already has the added tag", func() {
+		It("reports no participants changed", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().UpdateTags(ctx, event.ID, gomock.Any(), []string{"vip"}, gomock.Any(), gomock.Any()).
+				Return(int64(0), nil)
+
+			updated, err := uc.UpdateTags(ctx, organizerID, false, participant.UpdateTagsInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+				Add:            []string{"vip"},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated).To(BeZero())
+		})
+	})
+
+	When("a participant would exceed the tag cap", func() {
+		It("returns the repository's unprocessable error", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+			participantRepo.EXPECT().UpdateTags(ctx, event.ID, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(int64(0), apperrors.Unprocessable("1 participants would have more than 20 tags"))
+
+			_, err := uc.UpdateTags(ctx, organizerID, false, participant.UpdateTagsInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+				Add:            []string{"vip"},
+			})
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeUnprocessable))
+		})
+	})
+
+	When("more tags are added than a participant can have", func() {
+		It("returns a validation error", func() {
+			tags := make([]string, entity.ParticipantMaxTags+1)
+			for i := range tags {
+				tags[i] = fmt.Sprintf("tag-%d", i)
+			}
+
+			_, err := uc.UpdateTags(ctx, organizerID, false, participant.UpdateTagsInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+				Add:            tags,
+			})
+
+			Expect(apperrors.IsValidation(err)).To(BeTrue())
+		})
+	})
+
+	When("a tag is too long", func() {
+		It("returns a validation error", func() {
+			_, err := uc.UpdateTags(ctx, organizerID, false, participant.UpdateTagsInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+				Add:            []string{fmt.Sprintf("%051d", 0)},
+			})
+
+			Expect(apperrors.IsValidation(err)).To(BeTrue())
+		})
+	})
+
+	DescribeTable("rejects malformed requests",
+		func(input participant.UpdateTagsInput) {
+			input.EventID = event.ID
+
+			_, err := uc.UpdateTags(ctx, organizerID, false, input)
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeBadRequest))
+		},
+		Entry("without participants or filter", participant.UpdateTagsInput{Add: []string{"vip"}}),
+		Entry("with both participants and filter", participant.UpdateTagsInput{
+			ParticipantIDs: []uuid.UUID{uuid.New()},
+			Filter:         &participant.TagFilter{},
+			Add:            []string{"vip"},
+		}),
+		Entry("without tags", participant.UpdateTagsInput{ParticipantIDs: []uuid.UUID{uuid.New()}}),
+		Entry("with a tag both added and removed", participant.UpdateTagsInput{
+			ParticipantIDs: []uuid.UUID{uuid.New()},
+			Add:            []string{"vip"},
+			Remove:         []string{"vip"},
+		}),
+	)
+
+	When("the user does not manage the event", func() {
+		It("returns a forbidden error", func() {
+			eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+			_, err := uc.UpdateTags(ctx, uuid.New(), false, participant.UpdateTagsInput{
+				EventID:        event.ID,
+				ParticipantIDs: ids,
+				Add:            []string{"vip"},
+			})
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+})
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 )
 
@@ -18,11 +19,61 @@ type CreateParticipantInput struct {
 	Status        entity.ParticipantStatus
 	Metadata      *string
 	PaymentStatus entity.PaymentStatus
-	PaymentAmount *float64
+	PaymentAmount *money.Amount
 	PaymentDate   *time.Time
+	FeeTier       *string // Tier of a tiered event fee; defaults PaymentAmount to the tier amount
+	Notes         *string // Internal staff notes
+	Tags          []string
+	GroupName     *string        // Table or group the participant is seated at
+	CustomData    map[string]any // Values of the event's custom fields by key
 }
 
-// UpdateParticipantInput represents input for updating a participant
+// AddGuestInput represents input for adding a guest to a registrant
+type AddGuestInput struct {
+	Name  string
+	Email string // Optional; guests without an email are reached through their registrant
+}
+
+// UpdateTagsInput represents input for adding and removing tags on many participants at once.
+// Exactly one of ParticipantIDs and Filter selects the participants.
+type UpdateTagsInput struct {
+	EventID        uuid.UUID
+	ParticipantIDs []uuid.UUID
+	Filter         *TagFilter
+	Add            []string
+	Remove         []string
+}
+
+// TagFilter selects participants of an event by their status, payment status or an existing
+// tag; unset fields match every participant
+type TagFilter struct {
+	Status        *entity.ParticipantStatus
+	PaymentStatus *entity.PaymentStatus
+	Tag           *string
+}
+
+// AssignGroupInput represents input for seating many participants at a group at once
+type AssignGroupInput struct {
+	EventID        uuid.UUID
+	ParticipantIDs []uuid.UUID
+	GroupName      *string // nil clears the participants' group
+}
+
+// BulkUpdateStatusInput represents input for moving many participants to a status at once
+type BulkUpdateStatusInput struct {
+	EventID        uuid.UUID
+	ParticipantIDs []uuid.UUID
+	Status         entity.ParticipantStatus
+}
+
+// BulkUpdateStatusOutput counts the participants moved to the status and those left unchanged
+type BulkUpdateStatusOutput struct {
+	UpdatedCount int64
+	SkippedCount int64
+}
+
+// UpdateParticipantInput represents input for updating a participant. The payment status is not
+// updated; it is set by recording or refunding a payment, see the payment usecase.
 type UpdateParticipantInput struct {
 	Name          *string
 	Email         *string
@@ -31,9 +82,24 @@ type UpdateParticipantInput struct {
 	Phone         *string
 	Status        *entity.ParticipantStatus
 	Metadata      *string
-	PaymentStatus *entity.PaymentStatus
-	PaymentAmount *float64
+	PaymentAmount *money.Amount
 	PaymentDate   *time.Time
+	Notes         *string         // Internal staff notes; an empty string clears them
+	Tags          *[]string       // Replaces the participant's tags; an empty list clears them
+	GroupName     *string         // Moves the participant to this group; an empty string clears it
+	CustomData    *map[string]any // Replaces the participant's custom data; an empty object clears it
+}
+
+// MergeParticipantsInput represents input for merging a duplicate participant into the primary
+type MergeParticipantsInput struct {
+	PrimaryID   uuid.UUID // Keeps its identity and receives the duplicate's check-ins
+	DuplicateID uuid.UUID // Soft deleted by the merge
+}
+
+// MergeParticipantsOutput represents the primary participant after a merge
+type MergeParticipantsOutput struct {
+	Participant *entity.Participant
+	CheckIns    []*entity.Checkin // The combined check-in history, oldest first
 }
 
 // ListParticipantsInput represents input for listing participants
@@ -45,12 +111,54 @@ type ListParticipantsInput struct {
 	Order   string
 	Search  string
 	Status  *entity.ParticipantStatus
+	Group   *string // Participants seated at this group only
+	// IncludeDeleted lists soft deleted participants along with live ones.
+	IncludeDeleted bool
 }
 
 // ListParticipantsOutput represents output for listing participants
 type ListParticipantsOutput struct {
-	Participants []*entity.Participant
-	TotalCount   int64
+	Participants      []*entity.Participant
+	TotalCount        int64
+	Capacity          int   // Zero if the event has no capacity limit
+	CapacityRemaining int64 // Places left for confirmed participants, if the event has a capacity
+}
+
+// ListChangesOutput represents the changes to an event's participant list
+type ListChangesOutput struct {
+	Participants []*entity.Participant // Created or updated, in the order they changed
+	Deleted      []DeletedParticipant
+	Since        time.Time // Cursor for the next request
+}
+
+// StatsOutput represents the registration and payment statistics of an event's participants.
+// TotalRevenue is the sum of the paid participants' amounts and TotalRefunded the sum of their
+// refunded payments, both in Currency ("" if unset).
+type StatsOutput struct {
+	TotalParticipants     int64
+	ConfirmedParticipants int64
+	TentativeParticipants int64
+	DeclinedParticipants  int64
+	PaidParticipants      int64
+	UnpaidParticipants    int64
+	TotalRevenue          money.Amount
+	TotalRefunded         money.Amount
+	Currency              string
+}
+
+// DeletedParticipant identifies a participant deleted from an event
+type DeletedParticipant struct {
+	ID        uuid.UUID
+	DeletedAt time.Time
+}
+
+// AutocompleteMatch is a participant suggested for a name prefix at manual check-in, with
+// their email masked for display on screen
+type AutocompleteMatch struct {
+	ID          uuid.UUID
+	Name        string
+	EmailMasked string // Empty when the participant has no email
+	CheckedIn   bool
 }
 
 // BulkCreateInput represents input for bulk creating participants
@@ -58,6 +166,7 @@ type BulkCreateInput struct {
 	EventID        uuid.UUID
 	Participants   []CreateParticipantInput
 	SkipDuplicates bool
+	Atomic         bool // Create all participants in one transaction, or none if any fails
 }
 
 // BulkCreateOutput represents output for bulk creating participants
@@ -68,15 +177,27 @@ type BulkCreateOutput struct {
 	Participants []*entity.Participant
 	Errors       []BulkCreateError
 	SkippedRows  []BulkCreateError
+	Warnings     []BulkCreateError // Created participants with data-quality warnings, one entry per warning
 }
 
-// BulkCreateError represents an error during bulk creation
+// BulkCreateError represents an error or warning during bulk creation
 type BulkCreateError struct {
 	Index   int
 	Email   string
 	Message string
 }
 
+// BulkCreateAbortedError is the cause of the error returned when an atomic bulk creation is
+// rolled back, naming the participant that could not be created.
+type BulkCreateAbortedError struct {
+	Failure BulkCreateError
+}
+
+// Error implements error.
+func (e *BulkCreateAbortedError) Error() string {
+	return e.Failure.Message
+}
+
 // QRCodeOutput represents QR code download output
 type QRCodeOutput struct {
 	Data        []byte
@@ -84,6 +205,19 @@ type QRCodeOutput struct {
 	Filename    string
 }
 
+// BadgeSheetInput is the input for the GetBadgeSheet use case.
+type BadgeSheetInput struct {
+	EventID uuid.UUID
+	PerPage int                       // badges per page; 0 uses badge.DefaultPerPage
+	Status  *entity.ParticipantStatus // only print participants with this status; nil prints all
+}
+
+// QRCodeArchiveInput is the input for the GetQRCodeArchive use case.
+type QRCodeArchiveInput struct {
+	EventID uuid.UUID
+	Format  string // "png" or "svg"
+}
+
 // SendQRCodesInput is the input for the SendQRCodes use case.
 type SendQRCodesInput struct {
 	EventID        uuid.UUID
@@ -92,6 +226,12 @@ type SendQRCodesInput struct {
 	EmailTemplate  string // "default", "minimal", "detailed"
 }
 
+// SendInvitesInput is the input for the SendInvites use case.
+type SendInvitesInput struct {
+	EventID        uuid.UUID
+	ParticipantIDs []uuid.UUID // when empty, every participant with a QR code not sent one yet
+}
+
 // SendQRCodesOutput is the result of the SendQRCodes use case.
 type SendQRCodesOutput struct {
 	SentCount   int
@@ -106,3 +246,76 @@ type SendQRCodeFailure struct {
 	Email         string
 	Reason        string
 }
+
+// EmailPreviewOutput is a rendered email that was not sent.
+type EmailPreviewOutput struct {
+	To        string
+	Subject   string
+	HTMLBody  string // Empty when emails are sent as plain text only
+	TextBody  string
+	QRCodePNG []byte // The participant's QR code, as shown on the page the email links to
+}
+
+// InviteParticipantInput represents a single person to invite to an event
+type InviteParticipantInput struct {
+	Name       string
+	Email      string
+	QREmail    *string
+	EmployeeID *string
+	Phone      *string
+	Metadata   *string
+	FeeTier    *string // Tier of a tiered event fee; defaults PaymentAmount to the tier amount
+}
+
+// BulkInviteInput represents input for inviting multiple participants
+type BulkInviteInput struct {
+	EventID      uuid.UUID
+	Participants []InviteParticipantInput
+}
+
+// BulkInviteOutput represents output for inviting multiple participants.
+// Participants whose invitation email failed are still created and listed in EmailFailures.
+type BulkInviteOutput struct {
+	InvitedCount  int
+	FailedCount   int
+	Participants  []*entity.Participant
+	Errors        []BulkCreateError
+	EmailFailures []InvitationEmailFailure
+}
+
+// InvitationEmailFailure describes an invitation email that could not be sent.
+type InvitationEmailFailure struct {
+	ParticipantID uuid.UUID
+	Email         string
+	Reason        string
+}
+
+// ValidateParticipantsInput represents participant payloads to validate without creating them
+type ValidateParticipantsInput struct {
+	EventID      uuid.UUID
+	Participants []CreateParticipantInput
+}
+
+// ValidateParticipantsOutput represents the per-row validation results in request order
+type ValidateParticipantsOutput struct {
+	ValidCount   int
+	InvalidCount int
+	Results      []ParticipantValidationResult
+}
+
+// ParticipantValidationResult describes whether a single payload would be created.
+// DuplicateEmail is set when the email is already registered for the event or appears
+// earlier in the same request; such rows are invalid.
+type ParticipantValidationResult struct {
+	Index          int
+	Email          string
+	Valid          bool
+	DuplicateEmail bool
+	Errors         []FieldError
+}
+
+// FieldError describes why a field of a participant payload was rejected
+type FieldError struct {
+	Field   string
+	Message string
+}
@@ -6,11 +6,14 @@ import (
 	"fmt"
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
 	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
 	"github.com/google/uuid"
 )
 
-// Update updates an existing participant with authorization check
+// Update updates an existing participant with authorization check. Confirming a participant
+// takes one of the places of an event with a capacity, so it is rejected with a conflict while
+// the event is full.
 func (u *participantUsecase) Update(
 	ctx context.Context,
 	userID uuid.UUID,
@@ -31,30 +34,77 @@ func (u *participantUsecase) Update(
 	}
 
 	// Authorization: event owner or admin only
-	if !isAdmin && event.OrganizerID != userID {
-		return nil, apperrors.Forbidden("you do not have permission to update this participant")
+	if err := authz.RequireEventManager(userID, event, isAdmin, "update this participant"); err != nil {
+		return nil, err
+	}
+
+	// Invited participants only leave the invited status by accepting their invitation,
+	// which is also when their QR code is issued.
+	if participant.IsInvited() && input.Status != nil && *input.Status != entity.ParticipantStatusInvited {
+		return nil, apperrors.Validation("invited participants are confirmed by accepting their invitation")
+	}
+
+	// Waitlisted participants leave the waitlist only by being promoted.
+	if participant.IsWaitlisted() && input.Status != nil && *input.Status == entity.ParticipantStatusConfirmed {
+		return nil, apperrors.Validation("waitlisted participants are confirmed by promoting them")
+	}
+
+	// Only the expirer moves participants to expired. An expired tentative participant can be
+	// reinstated, but an expired invitation has no QR code to reinstate.
+	if input.Status != nil && *input.Status != participant.Status {
+		if *input.Status == entity.ParticipantStatusExpired {
+			return nil, apperrors.Validation(
+				fmt.Sprintf("participant validation failed: %v", entity.ErrParticipantExpiredStatusReserved),
+			)
+		}
+		if participant.IsExpired() && participant.QRCode == "" {
+			return nil, apperrors.Validation("expired invitations cannot be reinstated; delete the participant and invite them again")
+		}
 	}
 
 	// Apply updates
+	before := participant.AuditFields()
 	if err := applyUpdateInput(participant, input); err != nil {
 		return nil, err
 	}
 
 	// Validate participant
-	if err := participant.Validate(); err != nil {
-		return nil, apperrors.Validation(fmt.Sprintf("participant validation failed: %v", err))
+	var fields apperrors.FieldErrors
+	fields.AddError(participant.Validate(), participantErrorField)
+	fields.AddError(checkPaymentCurrency(event, input.PaymentAmount), participantErrorField)
+	// Custom data is checked only when replaced, so that fields defined after the participant
+	// registered do not block updates of their other data.
+	if input.CustomData != nil {
+		fields.AddError(event.ValidateCustomData(participant.CustomData), participantErrorField)
+	}
+	if len(fields) > 0 {
+		return nil, participantValidationError(fields)
 	}
 
 	// Update in repository
-	if err := u.participantRepo.Update(ctx, participant); err != nil {
+	if err := u.saveParticipant(ctx, participant, event); err != nil {
 		return nil, err
 	}
+	u.auditor.Record(ctx, userID, entity.AuditActionUpdate, entity.AuditResourceParticipant, participant.ID,
+		before, participant.AuditFields())
 
 	u.populateDistributionURL(participant)
 
 	return participant, nil
 }
 
+// saveParticipant updates a participant, within the capacity of events that have one.
+func (u *participantUsecase) saveParticipant(
+	ctx context.Context,
+	participant *entity.Participant,
+	event *entity.Event,
+) error {
+	if event.HasCapacity() {
+		return u.participantRepo.UpdateWithinCapacity(ctx, participant)
+	}
+	return u.participantRepo.Update(ctx, participant)
+}
+
 // applyUpdateInput applies update input fields to participant entity
 func applyUpdateInput(participant *entity.Participant, input UpdateParticipantInput) error {
 	applyBasicFields(participant, input)
@@ -82,13 +132,28 @@ func applyBasicFields(participant *entity.Participant, input UpdateParticipantIn
 	if input.Status != nil {
 		participant.Status = *input.Status
 	}
+	if input.Notes != nil {
+		participant.Notes = input.Notes
+		if *input.Notes == "" {
+			participant.Notes = nil
+		}
+	}
+	if input.Tags != nil {
+		participant.Tags = entity.NormalizeParticipantTags(*input.Tags)
+	}
+	if input.GroupName != nil {
+		participant.GroupName = entity.NormalizeParticipantGroupName(input.GroupName)
+		if *participant.GroupName == "" {
+			participant.GroupName = nil
+		}
+	}
+	if input.CustomData != nil {
+		participant.CustomData = normalizeCustomData(*input.CustomData)
+	}
 }
 
 // applyPaymentFields applies payment-related fields from input
 func applyPaymentFields(participant *entity.Participant, input UpdateParticipantInput) {
-	if input.PaymentStatus != nil {
-		participant.PaymentStatus = *input.PaymentStatus
-	}
 	if input.PaymentAmount != nil {
 		participant.PaymentAmount = input.PaymentAmount
 	}
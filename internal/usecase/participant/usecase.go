@@ -2,11 +2,15 @@ package participant
 
 import (
 	"context"
+	"time"
 
 	domainemail "github.com/fumkob/ezqrin-server/internal/domain/email"
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/domain/repository"
 	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode"
+	"github.com/fumkob/ezqrin-server/internal/infrastructure/qrcode/badge"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
+	"github.com/fumkob/ezqrin-server/internal/usecase/qrtoken"
 	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	"github.com/fumkob/ezqrin-server/pkg/logger"
 	"github.com/google/uuid"
@@ -28,18 +32,51 @@ type Usecase interface {
 		isAdmin bool,
 		input BulkCreateInput,
 	) (BulkCreateOutput, error)
+	ValidateParticipants(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		input ValidateParticipantsInput,
+	) (ValidateParticipantsOutput, error)
 	GetByID(
 		ctx context.Context,
 		userID uuid.UUID,
 		isAdmin bool,
 		id uuid.UUID,
 	) (*entity.Participant, error)
+	LookupByEmail(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		email string,
+	) ([]*entity.Participant, error)
 	List(
 		ctx context.Context,
 		userID uuid.UUID,
 		isAdmin bool,
 		input ListParticipantsInput,
 	) (ListParticipantsOutput, error)
+	Autocomplete(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		eventID uuid.UUID,
+		query string,
+	) ([]AutocompleteMatch, error)
+	GetListLastModified(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		eventID uuid.UUID,
+	) (time.Time, error)
+	GetStats(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID) (StatsOutput, error)
+	ListChanges(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		eventID uuid.UUID,
+		since time.Time,
+	) (ListChangesOutput, error)
 	Update(
 		ctx context.Context,
 		userID uuid.UUID,
@@ -48,6 +85,13 @@ type Usecase interface {
 		input UpdateParticipantInput,
 	) (*entity.Participant, error)
 	Delete(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID) error
+	Restore(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID) (*entity.Participant, error)
+	Merge(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		input MergeParticipantsInput,
+	) (*MergeParticipantsOutput, error)
 	GetQRCode(
 		ctx context.Context,
 		userID uuid.UUID,
@@ -62,50 +106,141 @@ type Usecase interface {
 		isAdmin bool,
 		eventID uuid.UUID,
 	) ([]*entity.Participant, error)
+	GetBadgeSheet(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		input BadgeSheetInput,
+	) (*badge.Sheet, error)
+	GetQRCodeArchive(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		input QRCodeArchiveInput,
+	) (*QRCodeArchive, error)
 	SendQRCodes(
 		ctx context.Context,
 		userID uuid.UUID,
 		isAdmin bool,
 		input SendQRCodesInput,
 	) (SendQRCodesOutput, error)
+	SendInvite(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		eventID uuid.UUID,
+		participantID uuid.UUID,
+	) (*entity.Participant, error)
+	SendInvites(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		input SendInvitesInput,
+	) (SendQRCodesOutput, error)
+	PreviewConfirmationEmail(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		id uuid.UUID,
+	) (EmailPreviewOutput, error)
+	BulkInvite(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		input BulkInviteInput,
+	) (BulkInviteOutput, error)
+	AcceptInvite(ctx context.Context, token string, consentAccepted bool) (*entity.Participant, error)
+	RecordConsent(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID) (*entity.Participant, error)
+	Promote(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID) (*entity.Participant, error)
+	GetCustomFields(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID) ([]entity.CustomField, error)
+	AddGuest(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		registrantID uuid.UUID,
+		input AddGuestInput,
+	) (*entity.Participant, error)
+	UpdateTags(ctx context.Context, userID uuid.UUID, isAdmin bool, input UpdateTagsInput) (int64, error)
+	AssignGroup(ctx context.Context, userID uuid.UUID, isAdmin bool, input AssignGroupInput) (int64, error)
+	BulkUpdateStatus(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		input BulkUpdateStatusInput,
+	) (*BulkUpdateStatusOutput, error)
 }
 
 var _ Usecase = (*participantUsecase)(nil)
 
 type participantUsecase struct {
-	participantRepo    repository.ParticipantRepository
-	eventRepo          repository.EventRepository
-	qrGenerator        *qrcode.Generator
-	qrHMACSecret       string
-	qrHostingBaseURL   string
-	walletPassBaseURL  string
-	emailSender        domainemail.Sender
-	emailPlainTextOnly bool
-	logger             *logger.Logger
+	participantRepo     repository.ParticipantRepository
+	eventRepo           repository.EventRepository
+	checkinRepo         repository.CheckinRepository
+	outboxRepo          repository.OutboxRepository
+	transactor          repository.Transactor
+	qrGenerator         *qrcode.Generator
+	qrHMACSecret        string
+	qrTokens            *qrtoken.Issuer
+	qrHostingBaseURL    string
+	walletPassBaseURL   string
+	inviteAcceptURL     string
+	inviteTokenExpiry   time.Duration
+	emailSender         domainemail.Sender
+	emailPlainTextOnly  bool
+	emailDomains        domainemail.DomainChecker
+	rejectUndeliverable bool
+	waitlistEnabled     bool
+	auditor             *audit.Recorder
+	logger              *logger.Logger
 }
 
-// NewUsecase creates a new participant usecase instance
+// NewUsecase creates a new participant usecase instance. checkinRepo moves the check-ins of merged
+// participants. outboxRepo may be nil to not record participant.created messages; transactor also
+// runs atomic bulk creations and merges. emailDomains may be nil to skip checking participant
+// email domains; rejectUndeliverable turns its warnings into errors. waitlistEnabled waitlists
+// confirmed participants of events at capacity; otherwise they are rejected with a conflict.
+// auditor records creating, changing and deleting participants in the audit log; it may be nil.
 func NewUsecase(
 	participantRepo repository.ParticipantRepository,
 	eventRepo repository.EventRepository,
+	checkinRepo repository.CheckinRepository,
+	outboxRepo repository.OutboxRepository,
+	transactor repository.Transactor,
 	qrGenerator *qrcode.Generator,
 	qrHMACSecret string,
+	qrTokens *qrtoken.Issuer,
 	qrHostingBaseURL string,
 	walletPassBaseURL string,
+	inviteAcceptURL string,
+	inviteTokenExpiry time.Duration,
 	emailSender domainemail.Sender,
 	emailPlainTextOnly bool,
+	emailDomains domainemail.DomainChecker,
+	rejectUndeliverable bool,
+	waitlistEnabled bool,
+	auditor *audit.Recorder,
 	logger *logger.Logger,
 ) Usecase {
 	return &participantUsecase{
-		participantRepo:    participantRepo,
-		eventRepo:          eventRepo,
-		qrGenerator:        qrGenerator,
-		qrHMACSecret:       qrHMACSecret,
-		qrHostingBaseURL:   qrHostingBaseURL,
-		walletPassBaseURL:  walletPassBaseURL,
-		emailSender:        emailSender,
-		emailPlainTextOnly: emailPlainTextOnly,
-		logger:             logger,
+		participantRepo:     participantRepo,
+		eventRepo:           eventRepo,
+		checkinRepo:         checkinRepo,
+		outboxRepo:          outboxRepo,
+		transactor:          transactor,
+		qrGenerator:         qrGenerator,
+		qrHMACSecret:        qrHMACSecret,
+		qrTokens:            qrTokens,
+		qrHostingBaseURL:    qrHostingBaseURL,
+		walletPassBaseURL:   walletPassBaseURL,
+		inviteAcceptURL:     inviteAcceptURL,
+		inviteTokenExpiry:   inviteTokenExpiry,
+		emailSender:         emailSender,
+		emailPlainTextOnly:  emailPlainTextOnly,
+		emailDomains:        emailDomains,
+		rejectUndeliverable: rejectUndeliverable,
+		waitlistEnabled:     waitlistEnabled,
+		auditor:             auditor,
+		logger:              logger,
 	}
 }
 
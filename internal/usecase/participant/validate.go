@@ -0,0 +1,143 @@
+package participant
+
+import (
+	"context"
+	"errors"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// validationQRToken stands in for the QR token during validation. Creation issues a real
+// token, which validation only requires to be present, so no token is issued here.
+const validationQRToken = "validation"
+
+// duplicateEmailMessage is reported for a payload whose email is already taken.
+const duplicateEmailMessage = "participant with this email already exists for this event"
+
+// ValidateParticipants checks participant payloads against the same rules as creation
+// without writing anything. Emails are checked against the event's participants and
+// against earlier payloads in the same request.
+func (u *participantUsecase) ValidateParticipants(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input ValidateParticipantsInput,
+) (ValidateParticipantsOutput, error) {
+	// Verify event exists and check authorization
+	event, err := u.eventRepo.FindByID(ctx, input.EventID)
+	if err != nil {
+		return ValidateParticipantsOutput{}, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "validate participants for this event"); err != nil {
+		return ValidateParticipantsOutput{}, err
+	}
+
+	output := ValidateParticipantsOutput{
+		Results: make([]ParticipantValidationResult, 0, len(input.Participants)),
+	}
+	seenEmails := make(map[string]bool, len(input.Participants))
+
+	for i, participantInput := range input.Participants {
+		result := ParticipantValidationResult{
+			Index:  i,
+			Email:  participantInput.Email,
+			Errors: make([]FieldError, 0),
+		}
+
+		participant, err := u.buildParticipantEntity(participantInput, event, uuid.New(), validationQRToken)
+		if err != nil {
+			var fields apperrors.FieldErrors
+			fields.AddError(errors.Unwrap(err), participantErrorField)
+			for _, field := range fields {
+				result.Errors = append(result.Errors, FieldError{Field: field.Field, Message: field.Message})
+			}
+		} else {
+			duplicate, err := u.isDuplicateEmail(ctx, event.ID, participant.Email, seenEmails)
+			if err != nil {
+				return ValidateParticipantsOutput{}, err
+			}
+			if duplicate {
+				result.DuplicateEmail = true
+				result.Errors = append(result.Errors, FieldError{Field: "email", Message: duplicateEmailMessage})
+			}
+		}
+
+		result.Valid = len(result.Errors) == 0
+		if result.Valid {
+			output.ValidCount++
+		} else {
+			output.InvalidCount++
+		}
+		output.Results = append(output.Results, result)
+	}
+
+	return output, nil
+}
+
+// isDuplicateEmail reports whether email was seen earlier in the request or is already
+// registered for the event, and records it as seen.
+func (u *participantUsecase) isDuplicateEmail(
+	ctx context.Context,
+	eventID uuid.UUID,
+	email string,
+	seenEmails map[string]bool,
+) (bool, error) {
+	if email == "" {
+		return false, nil
+	}
+	if seenEmails[email] {
+		return true, nil
+	}
+	seenEmails[email] = true
+
+	return u.participantRepo.ExistsByEmail(ctx, eventID, email)
+}
+
+// participantValidationError converts the failures of validating a participant to a validation
+// error listing each of them with the request field it concerns.
+func participantValidationError(fields apperrors.FieldErrors) error {
+	return apperrors.Validation("participant validation failed: " + fields.Summary()).WithValidationErrors(fields)
+}
+
+// participantErrorField maps a participant validation error to the request field it concerns.
+func participantErrorField(err error) string {
+	switch {
+	case errors.Is(err, entity.ErrParticipantNameRequired), errors.Is(err, entity.ErrParticipantNameTooLong):
+		return "name"
+	case errors.Is(err, entity.ErrParticipantEmailRequired), errors.Is(err, entity.ErrParticipantEmailInvalid):
+		return "email"
+	case errors.Is(err, entity.ErrParticipantStatusInvalid), errors.Is(err, entity.ErrParticipantInvitedQRCode),
+		errors.Is(err, entity.ErrParticipantExpiredStatusReserved):
+		return "status"
+	case errors.Is(err, entity.ErrParticipantPhoneTooLong):
+		return "phone"
+	case errors.Is(err, entity.ErrParticipantEmployeeIDTooLong):
+		return "employee_id"
+	case errors.Is(err, entity.ErrParticipantPaymentStatusInvalid):
+		return "payment_status"
+	case errors.Is(err, entity.ErrParticipantPaymentAmountInvalid),
+		errors.Is(err, entity.ErrParticipantPaymentCurrencyMissing):
+		return "payment_amount"
+	case errors.Is(err, entity.ErrParticipantFeeTierUnknown), errors.Is(err, entity.ErrParticipantFeeTierNotApplicable):
+		return "fee_tier"
+	case errors.Is(err, entity.ErrParticipantMetadataTooLarge):
+		return "metadata"
+	case errors.Is(err, entity.ErrParticipantNotesTooLong):
+		return "notes"
+	case errors.Is(err, entity.ErrParticipantTooManyTags), errors.Is(err, entity.ErrParticipantTagInvalid):
+		return "tags"
+	case errors.Is(err, entity.ErrParticipantGroupNameInvalid):
+		return "group_name"
+	case errors.Is(err, entity.ErrCustomDataUnknownField), errors.Is(err, entity.ErrCustomDataRequired),
+		errors.Is(err, entity.ErrCustomDataTypeMismatch), errors.Is(err, entity.ErrCustomDataOptionInvalid),
+		errors.Is(err, entity.ErrCustomDataTextTooLong):
+		return "custom_data"
+	default:
+		return ""
+	}
+}
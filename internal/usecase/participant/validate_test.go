@@ -0,0 +1,183 @@
+package participant_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+var _ = Describe("ValidateParticipants", func() {
+	var (
+		ctrl            *gomock.Controller
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		uc              participant.Usecase
+		ctx             context.Context
+		organizerID     uuid.UUID
+		event           *entity.Event
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		uc = newTestUsecase(participantRepo, eventRepo)
+		ctx = context.Background()
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID, Name: "Tech Conf"}
+
+		eventRepo.EXPECT().FindByID(gomock.Any(), event.ID).Return(event, nil).AnyTimes()
+		// Validation must never write a participant
+		participantRepo.EXPECT().Create(gomock.Any(), gomock.Any()).Times(0)
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	payload := func(name, email string) participant.CreateParticipantInput {
+		return participant.CreateParticipantInput{
+			EventID:       event.ID,
+			Name:          name,
+			Email:         email,
+			Status:        entity.ParticipantStatusConfirmed,
+			PaymentStatus: entity.PaymentUnpaid,
+		}
+	}
+
+	validate := func(payloads ...participant.CreateParticipantInput) participant.ValidateParticipantsOutput {
+		output, err := uc.ValidateParticipants(ctx, organizerID, false, participant.ValidateParticipantsInput{
+			EventID:      event.ID,
+			Participants: payloads,
+		})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		return output
+	}
+
+	When("every payload is valid", func() {
+		It("should report them valid without creating participants", func() {
+			participantRepo.EXPECT().ExistsByEmail(gomock.Any(), event.ID, gomock.Any()).Return(false, nil).Times(2)
+
+			output := validate(payload("Alice", "alice@example.com"), payload("Bob", "bob@example.com"))
+
+			Expect(output.ValidCount).To(Equal(2))
+			Expect(output.InvalidCount).To(BeZero())
+			Expect(output.Results).To(HaveLen(2))
+			Expect(output.Results[1].Index).To(Equal(1))
+			Expect(output.Results[1].Valid).To(BeTrue())
+			Expect(output.Results[1].Errors).To(BeEmpty())
+		})
+	})
+
+	When("a payload has an invalid field", func() {
+		It("should report the field error", func() {
+			participantRepo.EXPECT().ExistsByEmail(gomock.Any(), event.ID, "alice@example.com").Return(false, nil)
+
+			output := validate(payload("Alice", "alice@example.com"), payload("Bob", "not-an-email"))
+
+			Expect(output.ValidCount).To(Equal(1))
+			Expect(output.InvalidCount).To(Equal(1))
+			Expect(output.Results[1].Valid).To(BeFalse())
+			Expect(output.Results[1].DuplicateEmail).To(BeFalse())
+			Expect(output.Results[1].Errors).To(ConsistOf(participant.FieldError{
+				Field:   "email",
+				Message: entity.ErrParticipantEmailInvalid.Error(),
+			}))
+		})
+	})
+
+	When("an email is already registered for the event", func() {
+		It("should flag the duplicate email", func() {
+			participantRepo.EXPECT().ExistsByEmail(gomock.Any(), event.ID, "alice@example.com").Return(true, nil)
+
+			output := validate(payload("Alice", "alice@example.com"))
+
+			Expect(output.InvalidCount).To(Equal(1))
+			Expect(output.Results[0].Valid).To(BeFalse())
+			Expect(output.Results[0].DuplicateEmail).To(BeTrue())
+			Expect(output.Results[0].Errors[0].Field).To(Equal("email"))
+		})
+	})
+
+	When("an email is repeated within the request", func() {
+		It("should flag only the later occurrence", func() {
+			participantRepo.EXPECT().ExistsByEmail(gomock.Any(), event.ID, "alice@example.com").Return(false, nil)
+
+			output := validate(payload("Alice", "alice@example.com"), payload("Alice Again", "alice@example.com"))
+
+			Expect(output.Results[0].Valid).To(BeTrue())
+			Expect(output.Results[1].Valid).To(BeFalse())
+			Expect(output.Results[1].DuplicateEmail).To(BeTrue())
+		})
+	})
+
+	When("the email lookup fails", func() {
+		It("should return the error", func() {
+			participantRepo.EXPECT().ExistsByEmail(gomock.Any(), event.ID, gomock.Any()).
+				Return(false, errors.New("connection refused"))
+
+			_, err := uc.ValidateParticipants(ctx, organizerID, false, participant.ValidateParticipantsInput{
+				EventID:      event.ID,
+				Participants: []participant.CreateParticipantInput{payload("Alice", "alice@example.com")},
+			})
+
+			Expect(err).To(MatchError(ContainSubstring("connection refused")))
+		})
+	})
+
+	When("the user does not manage the event", func() {
+		It("should return forbidden", func() {
+			_, err := uc.ValidateParticipants(ctx, uuid.New(), false, participant.ValidateParticipantsInput{
+				EventID:      event.ID,
+				Participants: []participant.CreateParticipantInput{payload("Alice", "alice@example.com")},
+			})
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+	})
+
+	DescribeTable("should reject exactly what creation rejects",
+		func(field string, modify func(*participant.CreateParticipantInput)) {
+			input := payload("Alice", "alice@example.com")
+			modify(&input)
+
+			output := validate(input)
+			Expect(output.Results[0].Valid).To(BeFalse())
+			Expect(output.Results[0].Errors).To(HaveLen(1))
+			Expect(output.Results[0].Errors[0].Field).To(Equal(field))
+
+			_, createErr := uc.Create(ctx, organizerID, false, input)
+			Expect(apperrors.IsValidation(createErr)).To(BeTrue())
+			Expect(createErr.Error()).To(ContainSubstring(output.Results[0].Errors[0].Message))
+		},
+		Entry("missing name", "name", func(in *participant.CreateParticipantInput) { in.Name = "" }),
+		Entry("name too long", "name", func(in *participant.CreateParticipantInput) {
+			in.Name = strings.Repeat("a", entity.ParticipantNameMaxLength+1)
+		}),
+		Entry("missing email", "email", func(in *participant.CreateParticipantInput) { in.Email = "" }),
+		Entry("invalid status", "status", func(in *participant.CreateParticipantInput) { in.Status = "unknown" }),
+		Entry("invited status", "status", func(in *participant.CreateParticipantInput) {
+			in.Status = entity.ParticipantStatusInvited
+		}),
+		Entry("invalid payment status", "payment_status", func(in *participant.CreateParticipantInput) {
+			in.PaymentStatus = "refunded"
+		}),
+		Entry("payment amount without an event currency", "payment_amount",
+			func(in *participant.CreateParticipantInput) {
+				amount := money.FromMinorUnits(500)
+				in.PaymentAmount = &amount
+			}),
+		Entry("fee tier on an event without tiers", "fee_tier", func(in *participant.CreateParticipantInput) {
+			tier := "vip"
+			in.FeeTier = &tier
+		}),
+	)
+})
@@ -0,0 +1,89 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: github.com/fumkob/ezqrin-server/internal/usecase/payment (interfaces: Usecase)
+//
+// Generated by this command:
+//
+//	mockgen -destination=mocks/mock_usecase.go -package=mocks . Usecase
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	entity "github.com/fumkob/ezqrin-server/internal/domain/entity"
+	payment "github.com/fumkob/ezqrin-server/internal/usecase/payment"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetSummary mocks base method.
+func (m *MockUsecase) GetSummary(ctx context.Context, userID uuid.UUID, isAdmin bool, eventID uuid.UUID) (*payment.SummaryOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummary", ctx, userID, isAdmin, eventID)
+	ret0, _ := ret[0].(*payment.SummaryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSummary indicates an expected call of GetSummary.
+func (mr *MockUsecaseMockRecorder) GetSummary(ctx, userID, isAdmin, eventID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummary", reflect.TypeOf((*MockUsecase)(nil).GetSummary), ctx, userID, isAdmin, eventID)
+}
+
+// RecordPayment mocks base method.
+func (m *MockUsecase) RecordPayment(ctx context.Context, userID uuid.UUID, isAdmin bool, input payment.RecordPaymentInput) (*entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordPayment", ctx, userID, isAdmin, input)
+	ret0, _ := ret[0].(*entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecordPayment indicates an expected call of RecordPayment.
+func (mr *MockUsecaseMockRecorder) RecordPayment(ctx, userID, isAdmin, input any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordPayment", reflect.TypeOf((*MockUsecase)(nil).RecordPayment), ctx, userID, isAdmin, input)
+}
+
+// RefundPayment mocks base method.
+func (m *MockUsecase) RefundPayment(ctx context.Context, userID uuid.UUID, isAdmin bool, participantID uuid.UUID) (*entity.Payment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundPayment", ctx, userID, isAdmin, participantID)
+	ret0, _ := ret[0].(*entity.Payment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RefundPayment indicates an expected call of RefundPayment.
+func (mr *MockUsecaseMockRecorder) RefundPayment(ctx, userID, isAdmin, participantID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundPayment", reflect.TypeOf((*MockUsecase)(nil).RefundPayment), ctx, userID, isAdmin, participantID)
+}
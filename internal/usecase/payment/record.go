@@ -0,0 +1,137 @@
+package payment
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// RecordPayment records a payment received from an unpaid participant and marks them as paid
+// with its amount and date, in one transaction. A reference can only be recorded once per event.
+// Only the payment fields of the participant are written, and only while they are unpaid, so
+// that concurrent updates of the participant are kept and a payment is recorded at most once.
+func (u *paymentUsecase) RecordPayment(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	input RecordPaymentInput,
+) (*entity.Payment, error) {
+	participant, event, err := u.findParticipantToManage(ctx, userID, isAdmin, input.ParticipantID,
+		"record payments for this event")
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	payment := &entity.Payment{
+		ID:            uuid.New(),
+		EventID:       event.ID,
+		ParticipantID: participant.ID,
+		Amount:        input.Amount,
+		Currency:      event.Currency,
+		Method:        input.Method,
+		Reference:     input.Reference,
+		RecordedBy:    userID,
+		RecordedAt:    now,
+	}
+	if err := payment.Validate(); err != nil {
+		return nil, apperrors.Validation(fmt.Sprintf("payment validation failed: %v", err))
+	}
+	if participant.IsPaid() {
+		return nil, apperrors.Conflict("participant has already paid")
+	}
+
+	before := participant.AuditFields()
+	participant.PaymentStatus = entity.PaymentPaid
+	participant.PaymentAmount = &payment.Amount
+	participant.PaymentDate = &now
+	participant.UpdatedAt = now
+	err = u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.participantRepo.UpdatePayment(ctx, participant, entity.PaymentUnpaid); err != nil {
+			return err
+		}
+		return u.paymentRepo.Create(ctx, payment)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.auditor.Record(ctx, userID, entity.AuditActionUpdate, entity.AuditResourceParticipant, participant.ID,
+		before, participant.AuditFields())
+
+	u.invalidateSummary(ctx, event.ID)
+	return payment, nil
+}
+
+// RefundPayment refunds the recorded payment of a participant and marks them as unpaid again,
+// in one transaction. The participant keeps their payment amount, which they owe again. As in
+// RecordPayment, only the payment fields of a participant still paid are written.
+func (u *paymentUsecase) RefundPayment(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	participantID uuid.UUID,
+) (*entity.Payment, error) {
+	participant, event, err := u.findParticipantToManage(ctx, userID, isAdmin, participantID,
+		"refund payments for this event")
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := u.paymentRepo.FindActiveByParticipant(ctx, participant.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	before := participant.AuditFields()
+	participant.PaymentStatus = entity.PaymentUnpaid
+	participant.PaymentDate = nil
+	participant.UpdatedAt = now
+	err = u.transactor.WithTransaction(ctx, func(ctx context.Context) error {
+		if err := u.paymentRepo.Refund(ctx, payment.ID, userID, now); err != nil {
+			return err
+		}
+		return u.participantRepo.UpdatePayment(ctx, participant, entity.PaymentPaid)
+	})
+	if err != nil {
+		return nil, err
+	}
+	u.auditor.Record(ctx, userID, entity.AuditActionUpdate, entity.AuditResourceParticipant, participant.ID,
+		before, participant.AuditFields())
+
+	payment.RefundedAt = &now
+	payment.RefundedBy = &userID
+	u.invalidateSummary(ctx, event.ID)
+	return payment, nil
+}
+
+// findParticipantToManage finds a participant and their event, checking that the user manages
+// the event; action describes the operation in the forbidden error.
+func (u *paymentUsecase) findParticipantToManage(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	participantID uuid.UUID,
+	action string,
+) (*entity.Participant, *entity.Event, error) {
+	participant, err := u.participantRepo.FindByID(ctx, participantID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	event, err := u.eventRepo.FindByID(ctx, participant.EventID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, action); err != nil {
+		return nil, nil, err
+	}
+	return participant, event, nil
+}
@@ -0,0 +1,250 @@
+package payment_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
+	"github.com/fumkob/ezqrin-server/internal/usecase/payment"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Payment recording", func() {
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		paymentRepo     *mocks.MockPaymentRepository
+		cacheRepo       *mocks.MockCacheRepository
+		auditRepo       *mocks.MockAuditRepository
+		uc              payment.Usecase
+		organizerID     uuid.UUID
+		event           *entity.Event
+		participant     *entity.Participant
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		paymentRepo = mocks.NewMockPaymentRepository(ctrl)
+		cacheRepo = mocks.NewMockCacheRepository(ctrl)
+		auditRepo = mocks.NewMockAuditRepository(ctrl)
+		transactor := mocks.NewMockTransactor(ctrl)
+		transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+		).AnyTimes()
+		log := &logger.Logger{Logger: zap.NewNop()}
+		uc = payment.NewUsecase(
+			participantRepo, eventRepo, paymentRepo, transactor, cacheRepo, audit.NewRecorder(auditRepo, log), log,
+		)
+
+		organizerID = uuid.New()
+		event = &entity.Event{ID: uuid.New(), OrganizerID: organizerID, Currency: "JPY"}
+		participant = &entity.Participant{
+			ID:            uuid.New(),
+			EventID:       event.ID,
+			Name:          "Jane Smith",
+			Email:         "jane@example.com",
+			Status:        entity.ParticipantStatusConfirmed,
+			PaymentStatus: entity.PaymentUnpaid,
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("RecordPayment", func() {
+		var input payment.RecordPaymentInput
+
+		BeforeEach(func() {
+			input = payment.RecordPaymentInput{
+				ParticipantID: participant.ID,
+				Amount:        money.FromMinorUnits(150000),
+				Method:        entity.PaymentMethodBankTransfer,
+				Reference:     "TX-20251215-001",
+			}
+		})
+
+		When("the organizer records a payment of an unpaid participant", func() {
+			It("should store the payment and mark the participant as paid", func() {
+				participantRepo.EXPECT().FindByID(ctx, participant.ID).Return(participant, nil)
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				participantRepo.EXPECT().UpdatePayment(gomock.Any(), gomock.Any(), entity.PaymentUnpaid).DoAndReturn(
+					func(_ context.Context, p *entity.Participant, _ entity.PaymentStatus) error {
+						Expect(p.PaymentStatus).To(Equal(entity.PaymentPaid))
+						Expect(*p.PaymentAmount).To(Equal(money.FromMinorUnits(150000)))
+						Expect(p.PaymentDate).NotTo(BeNil())
+						return nil
+					})
+				paymentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, p *entity.Payment) error {
+						Expect(p.EventID).To(Equal(event.ID))
+						Expect(p.Currency).To(Equal("JPY"))
+						Expect(p.RecordedBy).To(Equal(organizerID))
+						return nil
+					})
+				cacheRepo.EXPECT().Delete(ctx, "payment:summary:"+event.ID.String()).Return(nil)
+				var entry *entity.AuditLog
+				auditRepo.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, e *entity.AuditLog) error {
+						entry = e
+						return nil
+					})
+
+				result, err := uc.RecordPayment(ctx, organizerID, false, input)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(entry.ActorID).To(Equal(organizerID))
+				Expect(entry.Action).To(Equal(entity.AuditActionUpdate))
+				Expect(entry.ResourceID).To(Equal(participant.ID))
+				Expect(entry.Changes["payment_status"].New).To(BeEquivalentTo(`"paid"`))
+				Expect(result.ParticipantID).To(Equal(participant.ID))
+				Expect(result.Amount).To(Equal(money.FromMinorUnits(150000)))
+				Expect(result.Reference).To(Equal("TX-20251215-001"))
+				Expect(result.IsRefunded()).To(BeFalse())
+			})
+		})
+
+		When("the participant has already paid", func() {
+			It("should return a conflict error", func() {
+				participant.PaymentStatus = entity.PaymentPaid
+				participantRepo.EXPECT().FindByID(ctx, participant.ID).Return(participant, nil)
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+				_, err := uc.RecordPayment(ctx, organizerID, false, input)
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+		})
+
+		When("a concurrent payment marked the participant as paid first", func() {
+			It("should return the repository's conflict without storing the payment", func() {
+				participantRepo.EXPECT().FindByID(ctx, participant.ID).Return(participant, nil)
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				participantRepo.EXPECT().UpdatePayment(gomock.Any(), gomock.Any(), entity.PaymentUnpaid).
+					Return(apperrors.Conflict("participant payment status is no longer unpaid"))
+
+				_, err := uc.RecordPayment(ctx, organizerID, false, input)
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+		})
+
+		When("the reference was already recorded for the event", func() {
+			It("should return the repository's conflict", func() {
+				participantRepo.EXPECT().FindByID(ctx, participant.ID).Return(participant, nil)
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				participantRepo.EXPECT().UpdatePayment(gomock.Any(), gomock.Any(), entity.PaymentUnpaid).Return(nil)
+				paymentRepo.EXPECT().Create(gomock.Any(), gomock.Any()).
+					Return(apperrors.Conflict("a payment with this reference was already recorded for the event"))
+
+				_, err := uc.RecordPayment(ctx, organizerID, false, input)
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+		})
+
+		DescribeTable("should reject invalid payments",
+			func(mutate func()) {
+				mutate()
+				participantRepo.EXPECT().FindByID(ctx, participant.ID).Return(participant, nil)
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+				_, err := uc.RecordPayment(ctx, organizerID, false, input)
+
+				Expect(apperrors.IsValidation(err)).To(BeTrue())
+			},
+			Entry("zero amount", func() { input.Amount = 0 }),
+			Entry("unknown method", func() { input.Method = "cheque" }),
+			Entry("event without a currency", func() { event.Currency = "" }),
+		)
+
+		When("the user does not manage the event", func() {
+			It("should return a forbidden error", func() {
+				participantRepo.EXPECT().FindByID(ctx, participant.ID).Return(participant, nil)
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+
+				_, err := uc.RecordPayment(ctx, uuid.New(), false, input)
+
+				Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			})
+		})
+	})
+
+	Describe("RefundPayment", func() {
+		var recorded *entity.Payment
+
+		BeforeEach(func() {
+			participant.PaymentStatus = entity.PaymentPaid
+			paidAt := time.Now().Add(-time.Hour)
+			participant.PaymentDate = &paidAt
+			recorded = &entity.Payment{
+				ID:            uuid.New(),
+				EventID:       event.ID,
+				ParticipantID: participant.ID,
+				Amount:        money.FromMinorUnits(150000),
+				Currency:      "JPY",
+				Method:        entity.PaymentMethodCash,
+				RecordedBy:    organizerID,
+				RecordedAt:    paidAt,
+			}
+		})
+
+		When("the participant has a recorded payment", func() {
+			It("should refund it and mark the participant as unpaid", func() {
+				participantRepo.EXPECT().FindByID(ctx, participant.ID).Return(participant, nil)
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				paymentRepo.EXPECT().FindActiveByParticipant(ctx, participant.ID).Return(recorded, nil)
+				paymentRepo.EXPECT().Refund(gomock.Any(), recorded.ID, organizerID, gomock.Any()).Return(nil)
+				participantRepo.EXPECT().UpdatePayment(gomock.Any(), gomock.Any(), entity.PaymentPaid).DoAndReturn(
+					func(_ context.Context, p *entity.Participant, _ entity.PaymentStatus) error {
+						Expect(p.PaymentStatus).To(Equal(entity.PaymentUnpaid))
+						Expect(p.PaymentDate).To(BeNil())
+						return nil
+					})
+				cacheRepo.EXPECT().Delete(ctx, gomock.Any()).Return(errors.New("connection refused"))
+				var entry *entity.AuditLog
+				auditRepo.EXPECT().Record(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(_ context.Context, e *entity.AuditLog) error {
+						entry = e
+						return nil
+					})
+
+				result, err := uc.RefundPayment(ctx, organizerID, false, participant.ID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(entry.ResourceID).To(Equal(participant.ID))
+				Expect(entry.Changes["payment_status"].New).To(BeEquivalentTo(`"unpaid"`))
+				Expect(result.IsRefunded()).To(BeTrue())
+				Expect(result.RefundedBy).To(Equal(&organizerID))
+			})
+		})
+
+		When("no payment was recorded for the participant", func() {
+			It("should return a not found error", func() {
+				participantRepo.EXPECT().FindByID(ctx, participant.ID).Return(participant, nil)
+				eventRepo.EXPECT().FindByID(ctx, event.ID).Return(event, nil)
+				paymentRepo.EXPECT().FindActiveByParticipant(ctx, participant.ID).
+					Return(nil, apperrors.NotFound("payment not found"))
+
+				_, err := uc.RefundPayment(ctx, organizerID, false, participant.ID)
+
+				Expect(apperrors.IsNotFound(err)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -0,0 +1,109 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/fumkob/ezqrin-server/internal/usecase/authz"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// GetSummary returns the payment reconciliation summary for an event.
+// Authorization is checked on every call; only the computed figures are cached.
+func (u *paymentUsecase) GetSummary(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	eventID uuid.UUID,
+) (*SummaryOutput, error) {
+	event, err := u.eventRepo.FindByID(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Authorization: event owner or admin only
+	if err := authz.RequireEventManager(userID, event, isAdmin, "view payments for this event"); err != nil {
+		return nil, err
+	}
+
+	if cached := u.getCachedSummary(ctx, eventID); cached != nil {
+		return cached, nil
+	}
+
+	stats, err := u.participantRepo.GetPaymentStats(ctx, eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &SummaryOutput{
+		EventID:              eventID,
+		Currency:             stats.Currency,
+		ExpectedAmount:       stats.ExpectedPaymentAmount,
+		CollectedAmount:      stats.TotalPaymentAmount,
+		OutstandingAmount:    stats.OutstandingPaymentAmount,
+		TotalParticipants:    stats.TotalParticipants,
+		PaidParticipants:     stats.PaidParticipants,
+		UnpaidParticipants:   stats.UnpaidParticipants,
+		UnpricedParticipants: stats.UnpricedParticipants,
+	}
+
+	u.setCachedSummary(ctx, summary)
+	return summary, nil
+}
+
+// summaryCacheKey returns the cache key for an event's payment summary.
+func summaryCacheKey(eventID uuid.UUID) string {
+	return "payment:summary:" + eventID.String()
+}
+
+// getCachedSummary returns a cached summary, or nil on a miss or cache failure.
+// Cache failures are logged and otherwise ignored so the summary is recomputed.
+func (u *paymentUsecase) getCachedSummary(ctx context.Context, eventID uuid.UUID) *SummaryOutput {
+	if u.cacheRepo == nil {
+		return nil
+	}
+
+	raw, err := u.cacheRepo.Get(ctx, summaryCacheKey(eventID))
+	if err != nil {
+		u.logger.WithContext(ctx).Warn("failed to read payment summary from cache", zap.Error(err))
+		return nil
+	}
+	if raw == "" {
+		return nil
+	}
+
+	var summary SummaryOutput
+	if err := json.Unmarshal([]byte(raw), &summary); err != nil {
+		u.logger.WithContext(ctx).Warn("failed to decode cached payment summary", zap.Error(err))
+		return nil
+	}
+	return &summary
+}
+
+// invalidateSummary drops the cached summary of an event after its payments changed.
+// Failures are logged only; the stale summary then expires after summaryCacheTTL.
+func (u *paymentUsecase) invalidateSummary(ctx context.Context, eventID uuid.UUID) {
+	if u.cacheRepo == nil {
+		return
+	}
+	if err := u.cacheRepo.Delete(ctx, summaryCacheKey(eventID)); err != nil {
+		u.logger.WithContext(ctx).Warn("failed to invalidate cached payment summary", zap.Error(err))
+	}
+}
+
+// setCachedSummary stores a summary for summaryCacheTTL. Failures are logged only.
+func (u *paymentUsecase) setCachedSummary(ctx context.Context, summary *SummaryOutput) {
+	if u.cacheRepo == nil {
+		return
+	}
+
+	raw, err := json.Marshal(summary)
+	if err != nil {
+		u.logger.WithContext(ctx).Warn("failed to encode payment summary for cache", zap.Error(err))
+		return
+	}
+	if err := u.cacheRepo.Set(ctx, summaryCacheKey(summary.EventID), string(raw), summaryCacheTTL); err != nil {
+		u.logger.WithContext(ctx).Warn("failed to write payment summary to cache", zap.Error(err))
+	}
+}
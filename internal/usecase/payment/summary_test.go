@@ -0,0 +1,178 @@
+package payment_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/payment"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("GetSummary", func() {
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		participantRepo *mocks.MockParticipantRepository
+		eventRepo       *mocks.MockEventRepository
+		cacheRepo       *mocks.MockCacheRepository
+		uc              payment.Usecase
+		organizerID     uuid.UUID
+		eventID         uuid.UUID
+		event           *entity.Event
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		cacheRepo = mocks.NewMockCacheRepository(ctrl)
+		uc = payment.NewUsecase(participantRepo, eventRepo, nil, nil, cacheRepo, nil, &logger.Logger{Logger: zap.NewNop()})
+
+		organizerID = uuid.New()
+		eventID = uuid.New()
+		event = &entity.Event{ID: eventID, OrganizerID: organizerID, Currency: "JPY"}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	When("the summary is not cached", func() {
+		Context("with a mix of paid and unpaid participants", func() {
+			It("should map the payment stats and cache the result", func() {
+				stats := &repository.ParticipantPaymentStats{
+					TotalParticipants:        4,
+					PaidParticipants:         1,
+					UnpaidParticipants:       3,
+					TotalPaymentAmount:       money.FromMinorUnits(100000),
+					Currency:                 "JPY",
+					ExpectedPaymentAmount:    money.FromMinorUnits(350000),
+					OutstandingPaymentAmount: money.FromMinorUnits(250000),
+					UnpricedParticipants:     1,
+				}
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				cacheRepo.EXPECT().Get(ctx, gomock.Any()).Return("", nil)
+				participantRepo.EXPECT().GetPaymentStats(ctx, eventID).Return(stats, nil)
+				cacheRepo.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+				result, err := uc.GetSummary(ctx, organizerID, false, eventID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.EventID).To(Equal(eventID))
+				Expect(result.Currency).To(Equal("JPY"))
+				Expect(result.ExpectedAmount).To(Equal(money.FromMinorUnits(350000)))
+				Expect(result.CollectedAmount).To(Equal(money.FromMinorUnits(100000)))
+				Expect(result.OutstandingAmount).To(Equal(money.FromMinorUnits(250000)))
+				Expect(result.PaidParticipants).To(Equal(int64(1)))
+				Expect(result.UnpaidParticipants).To(Equal(int64(3)))
+				Expect(result.UnpricedParticipants).To(Equal(int64(1)))
+			})
+		})
+
+		Context("with zero participants", func() {
+			It("should return zero totals", func() {
+				stats := &repository.ParticipantPaymentStats{Currency: "JPY"}
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				cacheRepo.EXPECT().Get(ctx, gomock.Any()).Return("", nil)
+				participantRepo.EXPECT().GetPaymentStats(ctx, eventID).Return(stats, nil)
+				cacheRepo.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+				result, err := uc.GetSummary(ctx, organizerID, false, eventID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.TotalParticipants).To(BeZero())
+				Expect(result.ExpectedAmount).To(BeZero())
+				Expect(result.CollectedAmount).To(BeZero())
+				Expect(result.OutstandingAmount).To(BeZero())
+			})
+		})
+
+		Context("when the cache is unavailable", func() {
+			It("should still compute the summary", func() {
+				stats := &repository.ParticipantPaymentStats{TotalParticipants: 2, Currency: "JPY"}
+
+				eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+				cacheRepo.EXPECT().Get(ctx, gomock.Any()).Return("", errors.New("connection refused"))
+				participantRepo.EXPECT().GetPaymentStats(ctx, eventID).Return(stats, nil)
+				cacheRepo.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(errors.New("connection refused"))
+
+				result, err := uc.GetSummary(ctx, organizerID, false, eventID)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.TotalParticipants).To(Equal(int64(2)))
+			})
+		})
+	})
+
+	When("the summary is cached", func() {
+		It("should return the cached summary without querying the database", func() {
+			cached := payment.SummaryOutput{
+				EventID:         eventID,
+				Currency:        "JPY",
+				ExpectedAmount:  money.FromMinorUnits(500000),
+				CollectedAmount: money.FromMinorUnits(200000),
+			}
+			raw, err := json.Marshal(cached)
+			Expect(err).NotTo(HaveOccurred())
+
+			eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+			cacheRepo.EXPECT().Get(ctx, gomock.Any()).Return(string(raw), nil)
+
+			result, err := uc.GetSummary(ctx, organizerID, false, eventID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(*result).To(Equal(cached))
+		})
+	})
+
+	When("the caller is neither admin nor the event organizer", func() {
+		It("should return a forbidden error", func() {
+			eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+
+			result, err := uc.GetSummary(ctx, uuid.New(), false, eventID)
+
+			Expect(err).To(HaveOccurred())
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+			Expect(result).To(BeNil())
+		})
+	})
+
+	When("the caller is an admin", func() {
+		It("should return the summary for another organizer's event", func() {
+			eventRepo.EXPECT().FindByID(ctx, eventID).Return(event, nil)
+			cacheRepo.EXPECT().Get(ctx, gomock.Any()).Return("", nil)
+			participantRepo.EXPECT().GetPaymentStats(ctx, eventID).
+				Return(&repository.ParticipantPaymentStats{Currency: "JPY"}, nil)
+			cacheRepo.EXPECT().Set(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(nil)
+
+			_, err := uc.GetSummary(ctx, uuid.New(), true, eventID)
+
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	When("the event does not exist", func() {
+		It("should return the not found error", func() {
+			eventRepo.EXPECT().FindByID(ctx, eventID).Return(nil, apperrors.NotFound("event not found"))
+
+			_, err := uc.GetSummary(ctx, organizerID, false, eventID)
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package payment
+
+import (
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	"github.com/google/uuid"
+)
+
+// SummaryOutput represents the payment reconciliation summary of an event.
+// All amounts are denominated in Currency ("" if the event has no currency).
+type SummaryOutput struct {
+	EventID              uuid.UUID    `json:"event_id"`
+	Currency             string       `json:"currency"`
+	ExpectedAmount       money.Amount `json:"expected_amount"`
+	CollectedAmount      money.Amount `json:"collected_amount"`
+	OutstandingAmount    money.Amount `json:"outstanding_amount"`
+	TotalParticipants    int64        `json:"total_participants"`
+	PaidParticipants     int64        `json:"paid_participants"`
+	UnpaidParticipants   int64        `json:"unpaid_participants"`
+	UnpricedParticipants int64        `json:"unpriced_participants"`
+}
+
+// RecordPaymentInput represents input for recording a participant's payment
+type RecordPaymentInput struct {
+	ParticipantID uuid.UUID
+	Amount        money.Amount // In the event's currency
+	Method        entity.PaymentMethod
+	Reference     string // Receipt or transaction number; optional
+}
@@ -0,0 +1,66 @@
+// Package payment implements payment recording and reporting use cases for events.
+package payment
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/usecase/audit"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+)
+
+//go:generate mockgen -destination=mocks/mock_usecase.go -package=mocks . Usecase
+
+// summaryCacheTTL is how long a computed payment summary is served from cache.
+const summaryCacheTTL = 30 * time.Second
+
+// Usecase defines payment business logic operations
+type Usecase interface {
+	GetSummary(
+		ctx context.Context,
+		userID uuid.UUID,
+		isAdmin bool,
+		eventID uuid.UUID,
+	) (*SummaryOutput, error)
+	RecordPayment(ctx context.Context, userID uuid.UUID, isAdmin bool, input RecordPaymentInput) (*entity.Payment, error)
+	RefundPayment(ctx context.Context, userID uuid.UUID, isAdmin bool, participantID uuid.UUID) (*entity.Payment, error)
+}
+
+var _ Usecase = (*paymentUsecase)(nil)
+
+type paymentUsecase struct {
+	participantRepo repository.ParticipantRepository
+	eventRepo       repository.EventRepository
+	paymentRepo     repository.PaymentRepository
+	transactor      repository.Transactor
+	cacheRepo       repository.CacheRepository
+	auditor         *audit.Recorder
+	logger          *logger.Logger
+}
+
+// NewUsecase creates a new payment usecase instance. transactor records and refunds a payment
+// atomically with the participant's payment status.
+// cacheRepo may be nil, in which case summaries are always computed from the database.
+// auditor records the payment changes of participants; nil records nothing.
+func NewUsecase(
+	participantRepo repository.ParticipantRepository,
+	eventRepo repository.EventRepository,
+	paymentRepo repository.PaymentRepository,
+	transactor repository.Transactor,
+	cacheRepo repository.CacheRepository,
+	auditor *audit.Recorder,
+	logger *logger.Logger,
+) Usecase {
+	return &paymentUsecase{
+		participantRepo: participantRepo,
+		eventRepo:       eventRepo,
+		paymentRepo:     paymentRepo,
+		transactor:      transactor,
+		cacheRepo:       cacheRepo,
+		auditor:         auditor,
+		logger:          logger,
+	}
+}
@@ -0,0 +1,101 @@
+// Package qrtoken issues participant QR tokens that are unique across all participants.
+package qrtoken
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+)
+
+// Issuer generates QR tokens and retries on collisions with stored tokens or with
+// other tokens of the same batch, up to a bounded number of attempts.
+type Issuer struct {
+	generator       crypto.TokenGenerator
+	participantRepo repository.ParticipantRepository
+	maxAttempts     int
+}
+
+// NewIssuer creates a new Issuer that tries at most maxAttempts tokens per participant.
+func NewIssuer(
+	generator crypto.TokenGenerator,
+	participantRepo repository.ParticipantRepository,
+	maxAttempts int,
+) *Issuer {
+	return &Issuer{
+		generator:       generator,
+		participantRepo: participantRepo,
+		maxAttempts:     maxAttempts,
+	}
+}
+
+// Issue returns a unique QR token for a participant.
+func (i *Issuer) Issue(ctx context.Context, eventID, participantID uuid.UUID) (string, error) {
+	tokens, err := i.IssueBatch(ctx, eventID, []uuid.UUID{participantID})
+	if err != nil {
+		return "", err
+	}
+	return tokens[participantID], nil
+}
+
+// IssueBatch returns unique QR tokens for participants of an event, keyed by participant ID.
+// Stored tokens are checked with one query per attempt rather than per participant.
+// Returns a conflict error naming the first participant left without a unique token.
+func (i *Issuer) IssueBatch(
+	ctx context.Context,
+	eventID uuid.UUID,
+	participantIDs []uuid.UUID,
+) (map[uuid.UUID]string, error) {
+	tokens := make(map[uuid.UUID]string, len(participantIDs))
+	issued := make(map[string]struct{}, len(participantIDs))
+	pending := participantIDs
+
+	for attempt := 0; attempt < i.maxAttempts && len(pending) > 0; attempt++ {
+		candidates := make(map[uuid.UUID]string, len(pending))
+		codes := make([]string, 0, len(pending))
+		for _, participantID := range pending {
+			token, err := i.generator.Generate(eventID, participantID, attempt)
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate QR token: %w", err)
+			}
+			if _, taken := issued[token]; taken {
+				continue
+			}
+			issued[token] = struct{}{}
+			candidates[participantID] = token
+			codes = append(codes, token)
+		}
+
+		existing, err := i.participantRepo.ExistingQRCodes(ctx, codes)
+		if err != nil {
+			return nil, err
+		}
+		stored := make(map[string]struct{}, len(existing))
+		for _, code := range existing {
+			stored[code] = struct{}{}
+		}
+
+		var retry []uuid.UUID
+		for _, participantID := range pending {
+			token, ok := candidates[participantID]
+			if _, taken := stored[token]; !ok || taken {
+				retry = append(retry, participantID)
+				continue
+			}
+			tokens[participantID] = token
+		}
+		pending = retry
+	}
+
+	if len(pending) > 0 {
+		return nil, apperrors.Conflict(fmt.Sprintf(
+			"could not generate a unique QR code for participant %s after %d attempts",
+			pending[0], i.maxAttempts,
+		))
+	}
+
+	return tokens, nil
+}
@@ -0,0 +1,119 @@
+package qrtoken_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/qrtoken"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+)
+
+// scriptedGenerator returns the tokens listed per participant, one per attempt.
+type scriptedGenerator map[uuid.UUID][]string
+
+func (g scriptedGenerator) Generate(_, participantID uuid.UUID, attempt int) (string, error) {
+	tokens := g[participantID]
+	if attempt >= len(tokens) {
+		return fmt.Sprintf("%s-%d", participantID, attempt), nil
+	}
+	return tokens[attempt], nil
+}
+
+var _ = Describe("Issuer", func() {
+	var (
+		ctrl     *gomock.Controller
+		ctx      context.Context
+		mockRepo *mocks.MockParticipantRepository
+		eventID  uuid.UUID
+		first    uuid.UUID
+		second   uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		mockRepo = mocks.NewMockParticipantRepository(ctrl)
+		eventID = uuid.New()
+		first = uuid.New()
+		second = uuid.New()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	When("no token collides", func() {
+		It("should issue every token with a single lookup", func() {
+			issuer := qrtoken.NewIssuer(scriptedGenerator{first: {"a"}, second: {"b"}}, mockRepo, 3)
+			mockRepo.EXPECT().ExistingQRCodes(gomock.Any(), []string{"a", "b"}).Return(nil, nil)
+
+			tokens, err := issuer.IssueBatch(ctx, eventID, []uuid.UUID{first, second})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(Equal(map[uuid.UUID]string{first: "a", second: "b"}))
+		})
+	})
+
+	When("a token is already stored", func() {
+		It("should retry only the colliding participant", func() {
+			issuer := qrtoken.NewIssuer(scriptedGenerator{first: {"a"}, second: {"taken", "c"}}, mockRepo, 3)
+			gomock.InOrder(
+				mockRepo.EXPECT().ExistingQRCodes(gomock.Any(), []string{"a", "taken"}).Return([]string{"taken"}, nil),
+				mockRepo.EXPECT().ExistingQRCodes(gomock.Any(), []string{"c"}).Return(nil, nil),
+			)
+
+			tokens, err := issuer.IssueBatch(ctx, eventID, []uuid.UUID{first, second})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(Equal(map[uuid.UUID]string{first: "a", second: "c"}))
+		})
+	})
+
+	When("two participants of the batch get the same token", func() {
+		It("should retry the second one", func() {
+			issuer := qrtoken.NewIssuer(scriptedGenerator{first: {"a"}, second: {"a", "b"}}, mockRepo, 3)
+			gomock.InOrder(
+				mockRepo.EXPECT().ExistingQRCodes(gomock.Any(), []string{"a"}).Return(nil, nil),
+				mockRepo.EXPECT().ExistingQRCodes(gomock.Any(), []string{"b"}).Return(nil, nil),
+			)
+
+			tokens, err := issuer.IssueBatch(ctx, eventID, []uuid.UUID{first, second})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(Equal(map[uuid.UUID]string{first: "a", second: "b"}))
+		})
+	})
+
+	When("every attempt collides", func() {
+		It("should return a conflict naming the participant", func() {
+			issuer := qrtoken.NewIssuer(scriptedGenerator{first: {"x", "y"}}, mockRepo, 2)
+			mockRepo.EXPECT().ExistingQRCodes(gomock.Any(), gomock.Any()).
+				DoAndReturn(func(_ context.Context, codes []string) ([]string, error) { return codes, nil }).
+				Times(2)
+
+			_, err := issuer.Issue(ctx, eventID, first)
+
+			Expect(apperrors.IsConflict(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring(
+				fmt.Sprintf("could not generate a unique QR code for participant %s after 2 attempts", first),
+			))
+		})
+	})
+
+	When("the lookup fails", func() {
+		It("should return the error", func() {
+			issuer := qrtoken.NewIssuer(scriptedGenerator{first: {"a"}}, mockRepo, 3)
+			mockRepo.EXPECT().ExistingQRCodes(gomock.Any(), gomock.Any()).Return(nil, errors.New("db down"))
+
+			_, err := issuer.Issue(ctx, eventID, first)
+
+			Expect(err).To(MatchError("db down"))
+		})
+	})
+})
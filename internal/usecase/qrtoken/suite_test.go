@@ -0,0 +1,13 @@
+package qrtoken_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestQRToken(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "QR Token Suite")
+}
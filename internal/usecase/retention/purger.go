@@ -0,0 +1,166 @@
+// Package retention anonymizes the participants of completed events once their retention period has passed.
+package retention
+
+import (
+	"context"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// PurgerConfig controls the retention period and how often the purger looks for events to purge.
+type PurgerConfig struct {
+	// PurgeAfterDays is how many days after a completed event ends its participants are anonymized.
+	PurgeAfterDays int
+	// Interval is how long the purger waits after purging every due event before looking again.
+	Interval time.Duration
+	// BatchSize is the maximum number of events purged per run.
+	BatchSize int
+}
+
+// Purger anonymizes the personal data of participants of completed events whose retention
+// period has passed. Participants are kept with their status, payment and check-ins, so
+// event statistics stay intact. Events on legal hold are skipped.
+//
+// Anonymization cannot be undone. Each event is purged in its own transaction together with
+// its audit entry, so an event is either fully purged and audited or left untouched.
+type Purger struct {
+	eventRepo       repository.EventRepository
+	participantRepo repository.ParticipantRepository
+	transactor      repository.Transactor
+	cfg             PurgerConfig
+	now             func() time.Time
+	logger          *logger.Logger
+}
+
+// NewPurger creates a new retention purger. now returns the current time; pass time.Now
+// outside of tests.
+func NewPurger(
+	eventRepo repository.EventRepository,
+	participantRepo repository.ParticipantRepository,
+	transactor repository.Transactor,
+	cfg PurgerConfig,
+	now func() time.Time,
+	logger *logger.Logger,
+) *Purger {
+	return &Purger{
+		eventRepo:       eventRepo,
+		participantRepo: participantRepo,
+		transactor:      transactor,
+		cfg:             cfg,
+		now:             now,
+		logger:          logger,
+	}
+}
+
+// Run purges due events until ctx is cancelled.
+// A full batch is followed immediately by another run so that a backlog drains quickly.
+func (p *Purger) Run(ctx context.Context) {
+	p.logger.Info("retention purger started",
+		zap.Int("purge_after_days", p.cfg.PurgeAfterDays),
+		zap.Duration("interval", p.cfg.Interval),
+		zap.Int("batch_size", p.cfg.BatchSize),
+	)
+	defer p.logger.Info("retention purger stopped")
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		purged, err := p.PurgeDue(ctx)
+		if err != nil && ctx.Err() == nil {
+			p.logger.Error("failed to run retention purge", zap.Error(err))
+		}
+
+		wait := p.cfg.Interval
+		if err == nil && purged == p.cfg.BatchSize {
+			wait = 0
+		}
+		timer.Reset(wait)
+	}
+}
+
+// PurgeDue anonymizes the participants of one batch of events whose retention period has
+// passed and returns the number of events purged. An event that fails to purge is logged
+// and retried on the next run.
+func (p *Purger) PurgeDue(ctx context.Context) (int, error) {
+	now := p.now()
+	endedBefore := now.AddDate(0, 0, -p.cfg.PurgeAfterDays)
+
+	events, err := p.eventRepo.FindPIIPurgeDue(ctx, endedBefore, p.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for _, event := range events {
+		ok, err := p.purgeEvent(ctx, event, now)
+		if err != nil {
+			p.logger.WithContext(ctx).Error("failed to purge participant data of event",
+				zap.String("event_id", event.ID.String()),
+				zap.Error(err),
+			)
+			continue
+		}
+		if ok {
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+// purgeEvent anonymizes the participants of an event and records the audit entry.
+// It returns false if the event was put on legal hold or purged since it was found.
+func (p *Purger) purgeEvent(ctx context.Context, event *entity.Event, now time.Time) (bool, error) {
+	purge := &entity.PIIPurge{
+		EventID:       event.ID,
+		OrganizerID:   event.OrganizerID,
+		RetentionDays: p.cfg.PurgeAfterDays,
+		PurgedAt:      now,
+	}
+
+	var marked bool
+	err := p.transactor.WithTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		// Marking the event first re-checks the legal hold inside the transaction
+		marked, err = p.eventRepo.MarkPIIPurged(txCtx, event.ID, now)
+		if err != nil || !marked {
+			return err
+		}
+
+		purge.ParticipantsAnonymized, err = p.participantRepo.AnonymizeByEventID(txCtx, event.ID)
+		if err != nil {
+			return err
+		}
+
+		return p.eventRepo.RecordPIIPurge(txCtx, purge)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	log := p.logger.WithContext(ctx).WithFields(
+		zap.String("event_id", event.ID.String()),
+		zap.String("organizer_id", event.OrganizerID.String()),
+	)
+	if !marked {
+		log.Info("skipped retention purge of event placed on legal hold or already purged")
+		return false, nil
+	}
+	log.Info("purged participant data of event",
+		zap.Int64("participants_anonymized", purge.ParticipantsAnonymized),
+		zap.Int("retention_days", purge.RetentionDays),
+	)
+
+	return true, nil
+}
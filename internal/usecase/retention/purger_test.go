@@ -0,0 +1,181 @@
+package retention_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/retention"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+// fakeClock is a manually advanced clock for the purger
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+var _ = Describe("Purger", func() {
+	const retentionDays = 30
+
+	var (
+		ctrl            *gomock.Controller
+		ctx             context.Context
+		eventRepo       *mocks.MockEventRepository
+		participantRepo *mocks.MockParticipantRepository
+		clock           *fakeClock
+		purger          *retention.Purger
+		eventEnd        time.Time
+		event           *entity.Event
+		completedEvents []*entity.Event
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ctx = context.Background()
+		eventRepo = mocks.NewMockEventRepository(ctrl)
+		participantRepo = mocks.NewMockParticipantRepository(ctrl)
+		transactor := mocks.NewMockTransactor(ctrl)
+		transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+		).AnyTimes()
+
+		eventEnd = time.Date(2026, 3, 1, 18, 0, 0, 0, time.UTC)
+		event = &entity.Event{
+			ID:          uuid.New(),
+			OrganizerID: uuid.New(),
+			StartDate:   eventEnd.Add(-8 * time.Hour),
+			EndDate:     &eventEnd,
+			Status:      entity.StatusCompleted,
+		}
+		completedEvents = []*entity.Event{event}
+
+		// Behaves like the repository query: events that ended before the cutoff are due
+		eventRepo.EXPECT().FindPIIPurgeDue(gomock.Any(), gomock.Any(), 10).DoAndReturn(
+			func(_ context.Context, endedBefore time.Time, _ int) ([]*entity.Event, error) {
+				due := []*entity.Event{}
+				for _, e := range completedEvents {
+					if e.EndDate.Before(endedBefore) {
+						due = append(due, e)
+					}
+				}
+				return due, nil
+			},
+		).AnyTimes()
+
+		clock = &fakeClock{now: eventEnd.Add(time.Hour)}
+		purger = retention.NewPurger(eventRepo, participantRepo, transactor, retention.PurgerConfig{
+			PurgeAfterDays: retentionDays,
+			Interval:       time.Hour,
+			BatchSize:      10,
+		}, clock.Now, &logger.Logger{Logger: zap.NewNop()})
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("PurgeDue", func() {
+		When("the clock advances past the retention period", func() {
+			It("should anonymize the participants only once the period has passed", func() {
+				clock.Advance(retentionDays*24*time.Hour - 2*time.Hour)
+				purged, err := purger.PurgeDue(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(purged).To(BeZero())
+
+				clock.Advance(2 * time.Hour)
+				eventRepo.EXPECT().MarkPIIPurged(gomock.Any(), event.ID, clock.Now()).Return(true, nil)
+				participantRepo.EXPECT().AnonymizeByEventID(gomock.Any(), event.ID).Return(int64(42), nil)
+				eventRepo.EXPECT().RecordPIIPurge(gomock.Any(), &entity.PIIPurge{
+					EventID:                event.ID,
+					OrganizerID:            event.OrganizerID,
+					ParticipantsAnonymized: 42,
+					RetentionDays:          retentionDays,
+					PurgedAt:               clock.Now(),
+				}).Return(nil)
+
+				purged, err = purger.PurgeDue(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(purged).To(Equal(1))
+			})
+		})
+
+		When("the event was put on legal hold after it was found", func() {
+			It("should leave the participants untouched and record no audit entry", func() {
+				clock.Advance(retentionDays * 24 * time.Hour)
+				eventRepo.EXPECT().MarkPIIPurged(gomock.Any(), event.ID, gomock.Any()).Return(false, nil)
+
+				purged, err := purger.PurgeDue(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(purged).To(BeZero())
+			})
+		})
+
+		When("anonymizing the participants of one event fails", func() {
+			It("should still purge the other due events", func() {
+				otherEnd := eventEnd.Add(24 * time.Hour)
+				other := &entity.Event{
+					ID: uuid.New(), OrganizerID: uuid.New(), EndDate: &otherEnd, Status: entity.StatusCompleted,
+				}
+				completedEvents = append(completedEvents, other)
+				clock.Advance(retentionDays*24*time.Hour + 48*time.Hour)
+
+				eventRepo.EXPECT().MarkPIIPurged(gomock.Any(), event.ID, gomock.Any()).Return(true, nil)
+				participantRepo.EXPECT().AnonymizeByEventID(gomock.Any(), event.ID).
+					Return(int64(0), errors.New("connection reset"))
+				eventRepo.EXPECT().MarkPIIPurged(gomock.Any(), other.ID, gomock.Any()).Return(true, nil)
+				participantRepo.EXPECT().AnonymizeByEventID(gomock.Any(), other.ID).Return(int64(3), nil)
+				eventRepo.EXPECT().RecordPIIPurge(gomock.Any(), gomock.Any()).Return(nil)
+
+				purged, err := purger.PurgeDue(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(purged).To(Equal(1))
+			})
+		})
+
+		When("recording the audit entry fails", func() {
+			It("should not count the event as purged", func() {
+				clock.Advance(retentionDays * 24 * time.Hour)
+				eventRepo.EXPECT().MarkPIIPurged(gomock.Any(), event.ID, gomock.Any()).Return(true, nil)
+				participantRepo.EXPECT().AnonymizeByEventID(gomock.Any(), event.ID).Return(int64(5), nil)
+				eventRepo.EXPECT().RecordPIIPurge(gomock.Any(), gomock.Any()).Return(errors.New("disk full"))
+
+				purged, err := purger.PurgeDue(ctx)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(purged).To(BeZero())
+			})
+		})
+	})
+
+	Describe("Run", func() {
+		It("should purge due events until the context is cancelled", func() {
+			clock.Advance(retentionDays * 24 * time.Hour)
+			runCtx, cancel := context.WithCancel(ctx)
+			eventRepo.EXPECT().MarkPIIPurged(gomock.Any(), event.ID, gomock.Any()).Return(true, nil)
+			participantRepo.EXPECT().AnonymizeByEventID(gomock.Any(), event.ID).Return(int64(1), nil)
+			eventRepo.EXPECT().RecordPIIPurge(gomock.Any(), gomock.Any()).DoAndReturn(
+				func(context.Context, *entity.PIIPurge) error {
+					cancel()
+					return nil
+				},
+			)
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				purger.Run(runCtx)
+			}()
+			Eventually(done).Should(BeClosed())
+		})
+	})
+})
@@ -0,0 +1,41 @@
+package user
+
+import (
+	"context"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/google/uuid"
+)
+
+// SearchMinLength is the minimum length of a user search term.
+const SearchMinLength = 3
+
+// ListUsersInput defines the input for listing users.
+type ListUsersInput struct {
+	Search  string // partial match on email or name; at least SearchMinLength characters
+	Role    *entity.UserRole
+	Page    int
+	PerPage int
+}
+
+// ListUsersOutput defines the output for listing users.
+type ListUsersOutput struct {
+	Users      []*entity.User
+	TotalCount int64
+}
+
+// UpdateUserInput defines the changes an admin can make to a user. Nil fields are left unchanged.
+type UpdateUserInput struct {
+	Role *entity.UserRole
+	// Deactivated deactivates the user when true and reactivates them when false.
+	Deactivated *bool
+}
+
+//go:generate mockgen -destination=mocks/mock_usecase.go -package=mocks . Usecase
+
+// Usecase defines the interface for user management business logic.
+type Usecase interface {
+	List(ctx context.Context, isAdmin bool, input ListUsersInput) (ListUsersOutput, error)
+	GetByID(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID) (*entity.User, error)
+	Update(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID, input UpdateUserInput) (*entity.User, error)
+}
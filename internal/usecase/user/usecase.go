@@ -0,0 +1,195 @@
+// Package user implements the user management use cases of admins.
+package user
+
+import (
+	"context"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+var _ Usecase = (*userUsecase)(nil)
+
+type userUsecase struct {
+	userRepo          repository.UserRepository
+	blacklistRepo     repository.TokenBlacklistRepository
+	transactor        repository.Transactor
+	accessTokenExpiry time.Duration
+	logger            *logger.Logger
+}
+
+// NewUsecase creates a new instance of User Usecase.
+// Deactivating a user blacklists them for accessTokenExpiry, the lifetime of the access tokens
+// they were issued before, so those tokens are rejected right away.
+func NewUsecase(
+	userRepo repository.UserRepository,
+	blacklistRepo repository.TokenBlacklistRepository,
+	transactor repository.Transactor,
+	accessTokenExpiry time.Duration,
+	logger *logger.Logger,
+) Usecase {
+	return &userUsecase{
+		userRepo:          userRepo,
+		blacklistRepo:     blacklistRepo,
+		transactor:        transactor,
+		accessTokenExpiry: accessTokenExpiry,
+		logger:            logger,
+	}
+}
+
+func (u *userUsecase) List(ctx context.Context, isAdmin bool, input ListUsersInput) (ListUsersOutput, error) {
+	if !isAdmin {
+		return ListUsersOutput{}, apperrors.Forbidden("only admins can list users")
+	}
+	if input.Search != "" && utf8.RuneCountInString(input.Search) < SearchMinLength {
+		return ListUsersOutput{}, apperrors.Validationf("search must be at least %d characters", SearchMinLength)
+	}
+	if input.Role != nil {
+		if err := entity.ValidateRole(string(*input.Role)); err != nil {
+			return ListUsersOutput{}, apperrors.Validation(err.Error())
+		}
+	}
+
+	filter := repository.UserListFilter{
+		Search: input.Search,
+		Role:   input.Role,
+	}
+	offset := (input.Page - 1) * input.PerPage
+
+	users, totalCount, err := u.userRepo.List(ctx, filter, offset, input.PerPage)
+	if err != nil {
+		return ListUsersOutput{}, err
+	}
+
+	return ListUsersOutput{
+		Users:      users,
+		TotalCount: totalCount,
+	}, nil
+}
+
+func (u *userUsecase) GetByID(ctx context.Context, userID uuid.UUID, isAdmin bool, id uuid.UUID) (*entity.User, error) {
+	// Authorization: users can view themselves, admins can view anyone
+	if !isAdmin && userID != id {
+		return nil, apperrors.Forbidden("you do not have permission to view this user")
+	}
+
+	return u.findLiveUser(ctx, id)
+}
+
+// Update changes the role of a user or deactivates them. The last active admin can be neither
+// demoted nor deactivated, so that some admin can always manage the users.
+func (u *userUsecase) Update(
+	ctx context.Context,
+	userID uuid.UUID,
+	isAdmin bool,
+	id uuid.UUID,
+	input UpdateUserInput,
+) (*entity.User, error) {
+	if !isAdmin {
+		return nil, apperrors.Forbidden("only admins can manage users")
+	}
+	if input.Role == nil && input.Deactivated == nil {
+		return nil, apperrors.Validation("at least one of role or deactivated must be provided")
+	}
+	if input.Role != nil {
+		if err := entity.ValidateRole(string(*input.Role)); err != nil {
+			return nil, apperrors.Validation(err.Error())
+		}
+	}
+
+	var user *entity.User
+	err := u.transactor.WithTransaction(ctx, func(txCtx context.Context) error {
+		var err error
+		user, err = u.findLiveUser(txCtx, id)
+		if err != nil {
+			return err
+		}
+
+		wasActiveAdmin := user.IsAdmin() && !user.IsDeactivated()
+		now := time.Now()
+		if input.Role != nil {
+			user.Role = *input.Role
+		}
+		if input.Deactivated != nil && *input.Deactivated != user.IsDeactivated() {
+			if *input.Deactivated {
+				user.DeactivatedAt = &now
+			} else {
+				user.DeactivatedAt = nil
+			}
+		}
+
+		if wasActiveAdmin && (!user.IsAdmin() || user.IsDeactivated()) {
+			if err := u.requireAnotherAdmin(txCtx); err != nil {
+				return err
+			}
+		}
+
+		user.UpdatedAt = now
+		return u.userRepo.UpdateAccess(txCtx, user)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if input.Deactivated != nil {
+		if err := u.applyDeactivation(ctx, user); err != nil {
+			return nil, err
+		}
+	}
+
+	u.logger.WithContext(ctx).Info(fmt.Sprintf("user %s updated by admin %s", user.ID, userID),
+		zap.String("role", string(user.Role)),
+		zap.Bool("deactivated", user.IsDeactivated()),
+	)
+	return user, nil
+}
+
+// findLiveUser finds a user who has not been deleted.
+func (u *userUsecase) findLiveUser(ctx context.Context, id uuid.UUID) (*entity.User, error) {
+	user, err := u.userRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if user.IsDeleted() {
+		return nil, apperrors.NotFound("user not found")
+	}
+	return user, nil
+}
+
+// requireAnotherAdmin rejects removing an active admin when they are the last one.
+func (u *userUsecase) requireAnotherAdmin(ctx context.Context) error {
+	admins, err := u.userRepo.CountActiveAdmins(ctx)
+	if err != nil {
+		return err
+	}
+	if admins <= 1 {
+		return apperrors.Conflict("cannot demote or deactivate the last remaining admin")
+	}
+	return nil
+}
+
+// applyDeactivation blacklists a deactivated user, so that the access tokens they hold are
+// rejected, and accepts the tokens of a reactivated user again. It is applied even when the
+// user was already in the requested state, so that a failed attempt can be retried.
+func (u *userUsecase) applyDeactivation(ctx context.Context, user *entity.User) error {
+	if user.IsDeactivated() {
+		if err := u.blacklistRepo.AddUserToBlacklist(ctx, user.ID.String(), u.accessTokenExpiry); err != nil {
+			u.logger.WithContext(ctx).Error("failed to blacklist deactivated user", zap.Error(err))
+			return apperrors.Internal("user deactivated, but their access tokens could not be revoked; please retry")
+		}
+		return nil
+	}
+
+	if err := u.blacklistRepo.RemoveUserFromBlacklist(ctx, user.ID.String()); err != nil {
+		u.logger.WithContext(ctx).Error("failed to remove reactivated user from blacklist", zap.Error(err))
+		return apperrors.Internal("user reactivated, but their access tokens could not be accepted again; please retry")
+	}
+	return nil
+}
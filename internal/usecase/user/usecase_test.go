@@ -0,0 +1,223 @@
+package user_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository"
+	"github.com/fumkob/ezqrin-server/internal/domain/repository/mocks"
+	"github.com/fumkob/ezqrin-server/internal/usecase/user"
+	apperrors "github.com/fumkob/ezqrin-server/pkg/errors"
+	"github.com/fumkob/ezqrin-server/pkg/logger"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var _ = Describe("Usecase", func() {
+	var (
+		ctrl          *gomock.Controller
+		userRepo      *mocks.MockUserRepository
+		blacklistRepo *mocks.MockTokenBlacklistRepository
+		uc            user.Usecase
+		ctx           context.Context
+		adminID       uuid.UUID
+		alice         *entity.User
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		userRepo = mocks.NewMockUserRepository(ctrl)
+		blacklistRepo = mocks.NewMockTokenBlacklistRepository(ctrl)
+		transactor := mocks.NewMockTransactor(ctrl)
+		transactor.EXPECT().WithTransaction(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, fn func(context.Context) error) error { return fn(ctx) },
+		).AnyTimes()
+		uc = user.NewUsecase(userRepo, blacklistRepo, transactor, 15*time.Minute, &logger.Logger{Logger: zap.NewNop()})
+		ctx = context.Background()
+		adminID = uuid.New()
+		alice = &entity.User{
+			ID:    uuid.New(),
+			Email: "alice@example.com",
+			Name:  "Alice",
+			Role:  entity.RoleOrganizer,
+		}
+	})
+
+	AfterEach(func() { ctrl.Finish() })
+
+	Describe("List", func() {
+		It("should list the users matching the filter", func() {
+			role := entity.RoleOrganizer
+			userRepo.EXPECT().
+				List(ctx, repository.UserListFilter{Search: "alice", Role: &role}, 20, 10).
+				Return([]*entity.User{alice}, int64(11), nil)
+
+			output, err := uc.List(ctx, true, user.ListUsersInput{Search: "alice", Role: &role, Page: 3, PerPage: 10})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(output.Users).To(ConsistOf(alice))
+			Expect(output.TotalCount).To(Equal(int64(11)))
+		})
+
+		It("should reject non-admins", func() {
+			_, err := uc.List(ctx, false, user.ListUsersInput{Page: 1, PerPage: 10})
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should reject a search shorter than three characters", func() {
+			_, err := uc.List(ctx, true, user.ListUsersInput{Search: "al", Page: 1, PerPage: 10})
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+		})
+	})
+
+	Describe("GetByID", func() {
+		It("should let users view themselves", func() {
+			userRepo.EXPECT().FindByID(ctx, alice.ID).Return(alice, nil)
+
+			found, err := uc.GetByID(ctx, alice.ID, false, alice.ID)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(Equal(alice))
+		})
+
+		It("should not let users view others", func() {
+			_, err := uc.GetByID(ctx, uuid.New(), false, alice.ID)
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should return not found for a deleted user", func() {
+			deletedAt := time.Now()
+			alice.DeletedAt = &deletedAt
+			userRepo.EXPECT().FindByID(ctx, alice.ID).Return(alice, nil)
+
+			_, err := uc.GetByID(ctx, adminID, true, alice.ID)
+
+			Expect(apperrors.IsNotFound(err)).To(BeTrue())
+		})
+	})
+
+	Describe("Update", func() {
+		deactivate := func(deactivated bool) user.UpdateUserInput {
+			return user.UpdateUserInput{Deactivated: &deactivated}
+		}
+
+		It("should change the role of a user", func() {
+			role := entity.RoleStaff
+			userRepo.EXPECT().FindByID(ctx, alice.ID).Return(alice, nil)
+			userRepo.EXPECT().UpdateAccess(ctx, alice).Return(nil)
+
+			updated, err := uc.Update(ctx, adminID, true, alice.ID, user.UpdateUserInput{Role: &role})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.Role).To(Equal(entity.RoleStaff))
+			Expect(updated.IsDeactivated()).To(BeFalse())
+		})
+
+		It("should deactivate a user and reject their access tokens", func() {
+			userRepo.EXPECT().FindByID(ctx, alice.ID).Return(alice, nil)
+			userRepo.EXPECT().UpdateAccess(ctx, alice).Return(nil)
+			blacklistRepo.EXPECT().AddUserToBlacklist(ctx, alice.ID.String(), 15*time.Minute).Return(nil)
+
+			updated, err := uc.Update(ctx, adminID, true, alice.ID, deactivate(true))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.IsDeactivated()).To(BeTrue())
+		})
+
+		It("should reactivate a user and accept their access tokens again", func() {
+			deactivatedAt := time.Now().Add(-time.Hour)
+			alice.DeactivatedAt = &deactivatedAt
+			userRepo.EXPECT().FindByID(ctx, alice.ID).Return(alice, nil)
+			userRepo.EXPECT().UpdateAccess(ctx, alice).Return(nil)
+			blacklistRepo.EXPECT().RemoveUserFromBlacklist(ctx, alice.ID.String()).Return(nil)
+
+			updated, err := uc.Update(ctx, adminID, true, alice.ID, deactivate(false))
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updated.IsDeactivated()).To(BeFalse())
+		})
+
+		It("should report a deactivation whose tokens could not be revoked", func() {
+			userRepo.EXPECT().FindByID(ctx, alice.ID).Return(alice, nil)
+			userRepo.EXPECT().UpdateAccess(ctx, alice).Return(nil)
+			blacklistRepo.EXPECT().AddUserToBlacklist(ctx, alice.ID.String(), 15*time.Minute).
+				Return(errors.New("redis connection lost"))
+
+			_, err := uc.Update(ctx, adminID, true, alice.ID, deactivate(true))
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeInternal))
+		})
+
+		When("the user is an admin", func() {
+			BeforeEach(func() {
+				alice.Role = entity.RoleAdmin
+				userRepo.EXPECT().FindByID(ctx, alice.ID).Return(alice, nil)
+			})
+
+			It("should demote them while another admin remains", func() {
+				role := entity.RoleOrganizer
+				userRepo.EXPECT().CountActiveAdmins(ctx).Return(int64(2), nil)
+				userRepo.EXPECT().UpdateAccess(ctx, alice).Return(nil)
+
+				updated, err := uc.Update(ctx, adminID, true, alice.ID, user.UpdateUserInput{Role: &role})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updated.Role).To(Equal(entity.RoleOrganizer))
+			})
+
+			It("should not demote the last remaining admin", func() {
+				role := entity.RoleOrganizer
+				userRepo.EXPECT().CountActiveAdmins(ctx).Return(int64(1), nil)
+
+				_, err := uc.Update(ctx, alice.ID, true, alice.ID, user.UpdateUserInput{Role: &role})
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+
+			It("should not deactivate the last remaining admin", func() {
+				userRepo.EXPECT().CountActiveAdmins(ctx).Return(int64(1), nil)
+
+				_, err := uc.Update(ctx, adminID, true, alice.ID, deactivate(true))
+
+				Expect(apperrors.IsConflict(err)).To(BeTrue())
+			})
+
+			It("should not count admins when the admin keeps their role", func() {
+				role := entity.RoleAdmin
+				userRepo.EXPECT().UpdateAccess(ctx, alice).Return(nil)
+
+				_, err := uc.Update(ctx, adminID, true, alice.ID, user.UpdateUserInput{Role: &role})
+
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		It("should reject non-admins", func() {
+			_, err := uc.Update(ctx, alice.ID, false, alice.ID, deactivate(true))
+
+			Expect(apperrors.IsForbidden(err)).To(BeTrue())
+		})
+
+		It("should reject an unknown role", func() {
+			role := entity.UserRole("owner")
+
+			_, err := uc.Update(ctx, adminID, true, alice.ID, user.UpdateUserInput{Role: &role})
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+		})
+
+		It("should reject an update without changes", func() {
+			_, err := uc.Update(ctx, adminID, true, alice.ID, user.UpdateUserInput{})
+
+			Expect(apperrors.GetErrorCode(err)).To(Equal(apperrors.CodeValidation))
+		})
+	})
+})
@@ -0,0 +1,13 @@
+package uuids_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestUUIDs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "UUIDs Suite")
+}
@@ -0,0 +1,18 @@
+// Package uuids provides helpers for the lists of IDs the usecases take from requests.
+package uuids
+
+import "github.com/google/uuid"
+
+// Unique returns ids without duplicates, keeping the first occurrence of each.
+func Unique(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]bool, len(ids))
+	unique := make([]uuid.UUID, 0, len(ids))
+	for _, id := range ids {
+		if seen[id] {
+			continue
+		}
+		seen[id] = true
+		unique = append(unique, id)
+	}
+	return unique
+}
@@ -0,0 +1,25 @@
+package uuids_test
+
+import (
+	"github.com/fumkob/ezqrin-server/internal/usecase/uuids"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Unique", func() {
+	It("should drop duplicates, keeping the first occurrence in order", func() {
+		first, second, third := uuid.New(), uuid.New(), uuid.New()
+
+		unique := uuids.Unique([]uuid.UUID{second, first, second, third, first})
+
+		Expect(unique).To(Equal([]uuid.UUID{second, first, third}))
+	})
+
+	It("should return an empty list for no IDs", func() {
+		unique := uuids.Unique(nil)
+
+		Expect(unique).NotTo(BeNil())
+		Expect(unique).To(BeEmpty())
+	})
+})
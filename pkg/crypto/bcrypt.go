@@ -3,6 +3,7 @@ package crypto
 import (
 	"errors"
 	"fmt"
+	"sync"
 
 	"golang.org/x/crypto/bcrypt"
 )
@@ -31,6 +32,15 @@ func SetHashCost(cost int) {
 	hashCost = cost
 }
 
+// dummyPassword is hashed once per cost factor for DummyComparePassword
+const dummyPassword = "ezqrin-dummy-password-for-constant-time-login"
+
+// dummyHashes caches the bcrypt hash of dummyPassword per cost factor
+var (
+	dummyHashesMu sync.Mutex
+	dummyHashes   = map[int]string{}
+)
+
 // Bcrypt errors
 var (
 	// ErrEmptyPassword indicates the password string is empty.
@@ -115,3 +125,37 @@ func ComparePassword(hashedPassword, password string) error {
 
 	return nil
 }
+
+// DummyComparePassword compares password against a fixed bcrypt hash at the active cost factor,
+// taking as long as ComparePassword does for a stored hash of that cost. Call it when there is
+// no stored hash to compare with, such as a login for an unknown email, so the response time
+// does not reveal whether the account exists.
+//
+// It always returns ErrHashMismatch, or another error if validation or hashing fails.
+func DummyComparePassword(password string) error {
+	hash, err := dummyHash()
+	if err != nil {
+		return err
+	}
+	if err := ComparePassword(hash, password); err != nil {
+		return err
+	}
+	// Unreachable unless password is the dummy password itself
+	return ErrHashMismatch
+}
+
+// dummyHash returns the hash of dummyPassword at the active cost factor
+func dummyHash() (string, error) {
+	dummyHashesMu.Lock()
+	defer dummyHashesMu.Unlock()
+
+	if hash, ok := dummyHashes[hashCost]; ok {
+		return hash, nil
+	}
+	hash, err := HashPassword(dummyPassword)
+	if err != nil {
+		return "", err
+	}
+	dummyHashes[hashCost] = hash
+	return hash, nil
+}
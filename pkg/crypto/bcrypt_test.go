@@ -3,6 +3,7 @@ package crypto_test
 import (
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/fumkob/ezqrin-server/pkg/crypto"
 	. "github.com/onsi/ginkgo/v2"
@@ -585,6 +586,29 @@ var _ = Describe("Password Hashing with Bcrypt", func() {
 			})
 		})
 	})
+
+	Describe("DummyComparePassword", func() {
+		It("should never match", func() {
+			Expect(crypto.DummyComparePassword(testPassword)).To(MatchError(crypto.ErrHashMismatch))
+		})
+
+		It("should take about as long as comparing with a stored hash", func() {
+			hash, err := crypto.HashPassword(testPassword)
+			Expect(err).NotTo(HaveOccurred())
+			// Warm the cached dummy hash so only the comparison is measured
+			_ = crypto.DummyComparePassword(testPassword)
+
+			start := time.Now()
+			_ = crypto.ComparePassword(hash, "WrongPassword123!")
+			realCompare := time.Since(start)
+
+			start = time.Now()
+			_ = crypto.DummyComparePassword("WrongPassword123!")
+			dummyCompare := time.Since(start)
+
+			Expect(dummyCompare).To(BeNumerically(">", realCompare/2))
+		})
+	})
 })
 
 // Helper function to calculate similarity between two strings
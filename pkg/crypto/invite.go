@@ -0,0 +1,107 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// inviteTokenPrefix distinguishes invitation tokens from QR tokens signed with the same secret.
+const inviteTokenPrefix = "inv_"
+
+// Invitation token errors
+var (
+	// ErrInvalidInviteToken indicates the invitation token is malformed or its signature does not match.
+	ErrInvalidInviteToken = errors.New("invalid invitation token")
+
+	// ErrInviteTokenExpired indicates the invitation token is authentic but past its expiry.
+	ErrInviteTokenExpired = errors.New("invitation token has expired")
+)
+
+// GenerateInviteToken generates a signed, self-contained invitation token for a participant.
+// Format: inv_{participant_id}_{expires_at_unix}.{base64url_hmac_sha256}
+//
+// The token carries its own expiry, so no server-side state is needed to verify it.
+func GenerateInviteToken(participantID uuid.UUID, expiresAt time.Time, secret string) (string, error) {
+	if secret == "" {
+		return "", fmt.Errorf("%w: secret cannot be empty", ErrInvalidHMACToken)
+	}
+
+	rawToken := fmt.Sprintf("%s%s_%d", inviteTokenPrefix, participantID.String(), expiresAt.Unix())
+	return rawToken + tokenDelimiter + signInviteToken(secret, rawToken), nil
+}
+
+// ParseInviteToken verifies an invitation token and returns the invited participant's ID.
+// The signature is checked before the expiry, so ErrInviteTokenExpired is only returned
+// for tokens that were genuinely issued with the given secret.
+func ParseInviteToken(token, secret string, now time.Time) (uuid.UUID, error) {
+	if secret == "" || token == "" {
+		return uuid.Nil, ErrInvalidInviteToken
+	}
+
+	delimIdx := strings.LastIndex(token, tokenDelimiter)
+	if delimIdx == -1 {
+		return uuid.Nil, ErrInvalidInviteToken
+	}
+	rawToken, providedSig := token[:delimIdx], token[delimIdx+1:]
+
+	expectedSig := signInviteToken(secret, rawToken)
+	if !hmac.Equal([]byte(providedSig), []byte(expectedSig)) {
+		return uuid.Nil, ErrInvalidInviteToken
+	}
+
+	payload, ok := strings.CutPrefix(rawToken, inviteTokenPrefix)
+	if !ok {
+		return uuid.Nil, ErrInvalidInviteToken
+	}
+	idPart, expiryPart, ok := strings.Cut(payload, "_")
+	if !ok {
+		return uuid.Nil, ErrInvalidInviteToken
+	}
+	participantID, err := uuid.Parse(idPart)
+	if err != nil {
+		return uuid.Nil, ErrInvalidInviteToken
+	}
+	expiresAt, err := strconv.ParseInt(expiryPart, 10, 64)
+	if err != nil {
+		return uuid.Nil, ErrInvalidInviteToken
+	}
+
+	if now.Unix() >= expiresAt {
+		return uuid.Nil, ErrInviteTokenExpired
+	}
+	return participantID, nil
+}
+
+// GenerateInviteAcceptURL creates the invitation accept link by adding the token as the
+// "token" query parameter of the accept page URL.
+// Returns empty string if acceptURL or token is empty, or if acceptURL cannot be parsed.
+func GenerateInviteAcceptURL(acceptURL, token string) string {
+	if acceptURL == "" || token == "" {
+		return ""
+	}
+
+	u, err := url.Parse(acceptURL)
+	if err != nil {
+		return ""
+	}
+	query := u.Query()
+	query.Set("token", token)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// signInviteToken returns the base64url HMAC-SHA256 signature of an invitation token payload.
+func signInviteToken(secret, rawToken string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(rawToken))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,126 @@
+package crypto_test
+
+import (
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Invitation Token", func() {
+	const secret = "test-hmac-secret-for-testing-only-32chars"
+
+	var (
+		participantID uuid.UUID
+		now           time.Time
+	)
+
+	BeforeEach(func() {
+		participantID = uuid.New()
+		now = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	Describe("GenerateInviteToken and ParseInviteToken", func() {
+		When("the token is valid and not expired", func() {
+			It("should return the participant ID", func() {
+				token, err := crypto.GenerateInviteToken(participantID, now.Add(time.Hour), secret)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(token).To(HavePrefix("inv_" + participantID.String() + "_"))
+
+				id, err := crypto.ParseInviteToken(token, secret, now)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(id).To(Equal(participantID))
+			})
+		})
+
+		When("the token has expired", func() {
+			It("should return ErrInviteTokenExpired", func() {
+				token, err := crypto.GenerateInviteToken(participantID, now.Add(-time.Second), secret)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = crypto.ParseInviteToken(token, secret, now)
+				Expect(err).To(MatchError(crypto.ErrInviteTokenExpired))
+			})
+		})
+
+		When("the token is signed with a different secret", func() {
+			It("should return ErrInvalidInviteToken", func() {
+				token, err := crypto.GenerateInviteToken(participantID, now.Add(time.Hour), "another-secret")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = crypto.ParseInviteToken(token, secret, now)
+				Expect(err).To(MatchError(crypto.ErrInvalidInviteToken))
+			})
+		})
+
+		When("the expiry is tampered with", func() {
+			It("should return ErrInvalidInviteToken", func() {
+				token, err := crypto.GenerateInviteToken(participantID, now.Add(-time.Hour), secret)
+				Expect(err).NotTo(HaveOccurred())
+
+				raw, sig, _ := strings.Cut(token, ".")
+				prefix := raw[:strings.LastIndex(raw, "_")+1]
+				forged := prefix + "9999999999." + sig
+
+				_, err = crypto.ParseInviteToken(forged, secret, now)
+				Expect(err).To(MatchError(crypto.ErrInvalidInviteToken))
+			})
+		})
+
+		When("a QR token is presented as an invitation token", func() {
+			It("should return ErrInvalidInviteToken", func() {
+				qrToken, err := crypto.GenerateParticipantQRToken(uuid.New(), participantID, secret)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = crypto.ParseInviteToken(qrToken, secret, now)
+				Expect(err).To(MatchError(crypto.ErrInvalidInviteToken))
+			})
+		})
+
+		DescribeTable("malformed tokens",
+			func(token string) {
+				_, err := crypto.ParseInviteToken(token, secret, now)
+				Expect(err).To(MatchError(crypto.ErrInvalidInviteToken))
+			},
+			Entry("empty", ""),
+			Entry("no signature", "inv_"+uuid.NewString()+"_1893456000"),
+			Entry("garbage", "not-a-token.sig"),
+		)
+
+		When("the secret is empty", func() {
+			It("should fail to generate a token", func() {
+				_, err := crypto.GenerateInviteToken(participantID, now.Add(time.Hour), "")
+				Expect(err).To(MatchError(crypto.ErrInvalidHMACToken))
+			})
+		})
+	})
+
+	Describe("GenerateInviteAcceptURL", func() {
+		It("should add the token as a query parameter", func() {
+			acceptURL := crypto.GenerateInviteAcceptURL("https://app.example.com/invitations/accept", "inv_abc.sig")
+
+			parsed, err := url.Parse(acceptURL)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Host).To(Equal("app.example.com"))
+			Expect(parsed.Path).To(Equal("/invitations/accept"))
+			Expect(parsed.Query().Get("token")).To(Equal("inv_abc.sig"))
+		})
+
+		It("should keep existing query parameters", func() {
+			acceptURL := crypto.GenerateInviteAcceptURL("https://app.example.com/accept?lang=ja", "tok")
+
+			parsed, err := url.Parse(acceptURL)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Query().Get("lang")).To(Equal("ja"))
+			Expect(parsed.Query().Get("token")).To(Equal("tok"))
+		})
+
+		It("should return empty string when the accept URL is not configured", func() {
+			Expect(crypto.GenerateInviteAcceptURL("", "tok")).To(BeEmpty())
+		})
+	})
+})
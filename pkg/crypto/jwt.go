@@ -17,6 +17,20 @@
 //
 //	// Generate refresh token for web
 //	refreshToken, err := crypto.GenerateRefreshToken(userID, "attendee", secret, 168*time.Hour)
+//
+// The functions taking a secret sign with HS256. To sign with RS256 instead, create a
+// TokenSigner from a SigningConfig and use its methods of the same names:
+//
+//	signer, err := crypto.NewTokenSigner(crypto.SigningConfig{
+//		Algorithm:      crypto.SigningAlgorithmRS256,
+//		PrivateKeyPath: "/etc/ezqrin/jwt.key",
+//	})
+//	token, err := signer.GenerateAccessToken(userID, "organizer", 15*time.Minute)
+//	claims, err := signer.ParseToken(token)
+//
+// Tokens name the key they were signed with in their "kid" header. To rotate the key, move the
+// previous one to the retired keys of the SigningConfig: its tokens keep verifying until they
+// expire, while new tokens are signed with the current key.
 package crypto
 
 import (
@@ -28,7 +42,7 @@ import (
 	"github.com/google/uuid"
 )
 
-// TokenType represents the type of JWT token (access or refresh).
+// TokenType represents the type of JWT token (access, refresh or two-factor challenge).
 type TokenType string
 
 const (
@@ -37,6 +51,10 @@ const (
 
 	// TokenTypeRefresh represents a refresh token (long-lived, used to obtain new access tokens).
 	TokenTypeRefresh TokenType = "refresh"
+
+	// TokenTypeTwoFactorChallenge represents a two-factor challenge token (short-lived, proves the
+	// password was verified and only allows completing the login with a second factor).
+	TokenTypeTwoFactorChallenge TokenType = "2fa_challenge"
 )
 
 // Common JWT errors
@@ -67,8 +85,9 @@ type Claims struct {
 
 	UserID     uuid.UUID `json:"user_id"`               // Unique identifier of the authenticated user
 	Role       string    `json:"role"`                  // User role (e.g., "organizer", "attendee")
-	TokenType  TokenType `json:"token_type"`            // Type of token (access or refresh)
-	ClientType string    `json:"client_type,omitempty"` // "web" or "mobile", refresh tokens only
+	TokenType  TokenType `json:"token_type"`            // Type of token (access, refresh or 2fa_challenge)
+	ClientType string    `json:"client_type,omitempty"` // "web" or "mobile", refresh and challenge tokens only
+	SessionID  string    `json:"sid,omitempty"`         // Login session the token belongs to, if issued for one
 }
 
 // GenerateAccessToken creates a new access token with the given parameters.
@@ -82,7 +101,23 @@ type Claims struct {
 //
 // Returns the signed JWT token string or an error if generation fails.
 func GenerateAccessToken(userID, role, secret string, expiry time.Duration) (string, error) {
-	return generateToken(userID, role, secret, "", expiry, TokenTypeAccess)
+	signer, err := newHS256Signer(secret)
+	if err != nil {
+		return "", err
+	}
+	return signer.GenerateAccessToken(userID, role, expiry)
+}
+
+// GenerateAccessToken creates a new access token signed with the signer's algorithm and key.
+// See the package-level GenerateAccessToken for the parameters.
+func (s *TokenSigner) GenerateAccessToken(userID, role string, expiry time.Duration) (string, error) {
+	return s.generateToken(userID, role, "", "", expiry, TokenTypeAccess)
+}
+
+// GenerateSessionAccessToken creates a new access token belonging to the login session
+// sessionID, so requests made with it can tell which session they come from.
+func (s *TokenSigner) GenerateSessionAccessToken(userID, role, sessionID string, expiry time.Duration) (string, error) {
+	return s.generateToken(userID, role, "", sessionID, expiry, TokenTypeAccess)
 }
 
 // GenerateRefreshToken creates a new refresh token with the given parameters.
@@ -99,16 +134,58 @@ func GenerateAccessToken(userID, role, secret string, expiry time.Duration) (str
 //
 // Returns the signed JWT token string or an error if generation fails.
 func GenerateRefreshToken(userID, role, secret, clientType string, expiry time.Duration) (string, error) {
-	return generateToken(userID, role, secret, clientType, expiry, TokenTypeRefresh)
+	signer, err := newHS256Signer(secret)
+	if err != nil {
+		return "", err
+	}
+	return signer.GenerateRefreshToken(userID, role, clientType, expiry)
+}
+
+// GenerateRefreshToken creates a new refresh token signed with the signer's algorithm and key.
+// See the package-level GenerateRefreshToken for the parameters.
+func (s *TokenSigner) GenerateRefreshToken(userID, role, clientType string, expiry time.Duration) (string, error) {
+	return s.generateToken(userID, role, clientType, "", expiry, TokenTypeRefresh)
+}
+
+// GenerateSessionRefreshToken creates a new refresh token belonging to the login session
+// sessionID. The session ID is carried over when the token is rotated.
+func (s *TokenSigner) GenerateSessionRefreshToken(
+	userID, role, clientType, sessionID string,
+	expiry time.Duration,
+) (string, error) {
+	return s.generateToken(userID, role, clientType, sessionID, expiry, TokenTypeRefresh)
+}
+
+// GenerateTwoFactorChallengeToken creates a challenge token for a user whose password was
+// verified but who must still enter a second factor to log in. clientType is carried over
+// to the refresh token issued once the login completes.
+//
+// Returns the signed JWT token string or an error if generation fails.
+func GenerateTwoFactorChallengeToken(userID, role, secret, clientType string, expiry time.Duration) (string, error) {
+	signer, err := newHS256Signer(secret)
+	if err != nil {
+		return "", err
+	}
+	return signer.GenerateTwoFactorChallengeToken(userID, role, clientType, expiry)
+}
+
+// GenerateTwoFactorChallengeToken creates a challenge token signed with the signer's algorithm
+// and key. See the package-level GenerateTwoFactorChallengeToken for the parameters.
+func (s *TokenSigner) GenerateTwoFactorChallengeToken(
+	userID, role, clientType string,
+	expiry time.Duration,
+) (string, error) {
+	return s.generateToken(userID, role, clientType, "", expiry, TokenTypeTwoFactorChallenge)
 }
 
 // generateToken is a private helper function that creates and signs a JWT token.
 // It validates inputs and generates a token with custom claims.
-func generateToken(userID, role, secret, clientType string, expiry time.Duration, tokenType TokenType) (string, error) {
+func (s *TokenSigner) generateToken(
+	userID, role, clientType, sessionID string,
+	expiry time.Duration,
+	tokenType TokenType,
+) (string, error) {
 	// Validate inputs
-	if secret == "" {
-		return "", ErrEmptySecret
-	}
 	if userID == "" {
 		return "", ErrEmptyUserID
 	}
@@ -130,19 +207,27 @@ func generateToken(userID, role, secret, clientType string, expiry time.Duration
 		Role:       role,
 		TokenType:  tokenType,
 		ClientType: clientType,
+		SessionID:  sessionID,
 		RegisteredClaims: jwt.RegisteredClaims{
+			// A unique ID keeps tokens issued within the same second apart, so a rotated token
+			// is never the one it replaces
+			ID:        uuid.NewString(),
 			ExpiresAt: jwt.NewNumericDate(now.Add(expiry)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "ezqrin-server",
 		},
 	}
+	if s.audience != "" {
+		claims.Audience = jwt.ClaimStrings{s.audience}
+	}
 
-	// Create token with claims
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	// Create token with claims, naming the signing key
+	token := jwt.NewWithClaims(s.method, claims)
+	token.Header["kid"] = s.keyID
 
-	// Sign token with secret
-	tokenString, err := token.SignedString([]byte(secret))
+	// Sign token with the signer's key
+	tokenString, err := token.SignedString(s.signKey)
 	if err != nil {
 		return "", fmt.Errorf("failed to sign token: %w", err)
 	}
@@ -159,22 +244,23 @@ func generateToken(userID, role, secret, clientType string, expiry time.Duration
 //
 // Returns an error if the token is invalid, expired, or has an invalid signature.
 func ValidateToken(tokenString, secret string) error {
-	// Validate inputs
-	if secret == "" {
-		return ErrEmptySecret
+	signer, err := newHS256Signer(secret)
+	if err != nil {
+		return err
 	}
+	return signer.ValidateToken(tokenString)
+}
+
+// ValidateToken validates a JWT token's signature and expiry without parsing claims.
+// The token is verified with the current or retired key its "kid" header names; tokens signed
+// with another algorithm than their key's are invalid.
+func (s *TokenSigner) ValidateToken(tokenString string) error {
 	if tokenString == "" {
 		return ErrInvalidToken
 	}
 
 	// Parse and validate token
-	_, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		// Verify signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(secret), nil
-	})
+	_, err := jwt.ParseWithClaims(tokenString, &Claims{}, s.keyFunc, s.parserOptions()...)
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
 			return ErrExpiredToken
@@ -194,22 +280,23 @@ func ValidateToken(tokenString, secret string) error {
 //
 // Returns the parsed Claims or an error if validation fails.
 func ParseToken(tokenString, secret string) (*Claims, error) {
-	// Validate inputs
-	if secret == "" {
-		return nil, ErrEmptySecret
+	signer, err := newHS256Signer(secret)
+	if err != nil {
+		return nil, err
 	}
+	return signer.ParseToken(tokenString)
+}
+
+// ParseToken parses and validates a JWT token, returning the custom claims.
+// The token is verified with the current or retired key its "kid" header names; tokens signed
+// with another algorithm than their key's are invalid.
+func (s *TokenSigner) ParseToken(tokenString string) (*Claims, error) {
 	if tokenString == "" {
 		return nil, ErrInvalidToken
 	}
 
 	// Parse token with claims
-	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, func(token *jwt.Token) (interface{}, error) {
-		// Verify signing method
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(secret), nil
-	})
+	token, err := jwt.ParseWithClaims(tokenString, &Claims{}, s.keyFunc, s.parserOptions()...)
 	if err != nil {
 		if errors.Is(err, jwt.ErrTokenExpired) {
 			return nil, ErrExpiredToken
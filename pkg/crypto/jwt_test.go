@@ -243,6 +243,25 @@ var _ = Describe("JWT Token Management", func() {
 		})
 	})
 
+	Describe("GenerateTwoFactorChallengeToken", func() {
+		When("generating a challenge token", func() {
+			Context("with valid parameters", func() {
+				It("should generate a token of the challenge type carrying the client type", func() {
+					token, err := crypto.GenerateTwoFactorChallengeToken(
+						testUserID, testRole, testSecret, "mobile", 5*time.Minute,
+					)
+					Expect(err).NotTo(HaveOccurred())
+
+					claims, err := crypto.ParseToken(token, testSecret)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(claims.UserID.String()).To(Equal(testUserID))
+					Expect(claims.TokenType).To(Equal(crypto.TokenTypeTwoFactorChallenge))
+					Expect(claims.ClientType).To(Equal("mobile"))
+				})
+			})
+		})
+	})
+
 	Describe("Token Type Differentiation", func() {
 		When("comparing access and refresh tokens", func() {
 			Context("with both token types generated", func() {
@@ -0,0 +1,109 @@
+package crypto_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Signed QR Token", func() {
+	const secret = "test-hmac-secret-for-testing-only-32chars"
+
+	var (
+		eventID       uuid.UUID
+		participantID uuid.UUID
+		issuedAt      time.Time
+	)
+
+	BeforeEach(func() {
+		eventID = uuid.New()
+		participantID = uuid.New()
+		issuedAt = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	})
+
+	Describe("SignQRToken and VerifyQRToken", func() {
+		It("should return the claims of a valid token", func() {
+			token, err := crypto.SignQRToken(eventID, participantID, issuedAt, secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(HavePrefix("qrt_" + eventID.String() + "_" + participantID.String() + "_"))
+			Expect(crypto.IsSignedQRToken(token)).To(BeTrue())
+			Expect(crypto.VerifyHMACToken(secret, token)).To(BeTrue())
+
+			claims, err := crypto.VerifyQRToken(token, secret, 0, issuedAt.AddDate(1, 0, 0))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims).To(Equal(crypto.QRTokenClaims{
+				EventID: eventID, ParticipantID: participantID, IssuedAt: issuedAt,
+			}))
+		})
+
+		When("the token is older than the TTL", func() {
+			It("should return ErrQRTokenExpired", func() {
+				token, err := crypto.SignQRToken(eventID, participantID, issuedAt, secret)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = crypto.VerifyQRToken(token, secret, time.Hour, issuedAt.Add(59*time.Minute))
+				Expect(err).NotTo(HaveOccurred())
+				_, err = crypto.VerifyQRToken(token, secret, time.Hour, issuedAt.Add(time.Hour))
+				Expect(err).To(MatchError(crypto.ErrQRTokenExpired))
+			})
+		})
+
+		When("the token is signed with a different secret", func() {
+			It("should return ErrInvalidQRToken", func() {
+				token, err := crypto.SignQRToken(eventID, participantID, issuedAt, "another-secret")
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = crypto.VerifyQRToken(token, secret, 0, issuedAt)
+				Expect(err).To(MatchError(crypto.ErrInvalidQRToken))
+			})
+		})
+
+		When("the participant is tampered with", func() {
+			It("should return ErrInvalidQRToken", func() {
+				token, err := crypto.SignQRToken(eventID, participantID, issuedAt, secret)
+				Expect(err).NotTo(HaveOccurred())
+				tampered := strings.Replace(token, participantID.String(), uuid.New().String(), 1)
+
+				_, err = crypto.VerifyQRToken(tampered, secret, 0, issuedAt)
+				Expect(err).To(MatchError(crypto.ErrInvalidQRToken))
+			})
+		})
+
+		When("the token is a random QR token", func() {
+			It("should not be taken for a signed token", func() {
+				gen, err := crypto.NewTokenGenerator(crypto.QRTokenStrategyRandom, secret, 6)
+				Expect(err).NotTo(HaveOccurred())
+				token, err := gen.Generate(eventID, participantID, 0)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(crypto.IsSignedQRToken(token)).To(BeFalse())
+				_, err = crypto.VerifyQRToken(token, secret, 0, issuedAt)
+				Expect(err).To(MatchError(crypto.ErrInvalidQRToken))
+			})
+		})
+
+		It("should reject an empty secret", func() {
+			_, err := crypto.SignQRToken(eventID, participantID, issuedAt, "")
+			Expect(err).To(MatchError(crypto.ErrInvalidHMACToken))
+		})
+	})
+
+	Describe("signed strategy", func() {
+		It("should generate tokens verifiable with VerifyQRToken", func() {
+			gen, err := crypto.NewTokenGenerator(crypto.QRTokenStrategySigned, secret, 6)
+			Expect(err).NotTo(HaveOccurred())
+
+			token, err := gen.Generate(eventID, participantID, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			claims, err := crypto.VerifyQRToken(token, secret, time.Hour, time.Now())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.EventID).To(Equal(eventID))
+			Expect(claims.ParticipantID).To(Equal(participantID))
+		})
+	})
+})
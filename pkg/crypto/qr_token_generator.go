@@ -0,0 +1,128 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QRTokenStrategy selects how the unique part of a participant QR token is derived.
+type QRTokenStrategy string
+
+const (
+	// QRTokenStrategyRandom uses cryptographically random bytes, base62-encoded.
+	QRTokenStrategyRandom QRTokenStrategy = "random"
+
+	// QRTokenStrategyHMAC derives the unique part from an HMAC of the participant ID, so a
+	// participant's first token is reproducible from the secret.
+	QRTokenStrategyHMAC QRTokenStrategy = "hmac"
+
+	// QRTokenStrategySigned issues self-contained tokens naming the event, the participant and
+	// the issue time (see SignQRToken), which check-in verifies without looking them up.
+	QRTokenStrategySigned QRTokenStrategy = "signed"
+
+	// base62 is the encoding base of the unique part (digits 0-9a-zA-Z).
+	base62 = 62
+)
+
+// TokenGenerator generates signed participant QR tokens in the format
+// evt_{event_id[:8]}_prt_{participant_id[:8]}_{base62}.{base64url_hmac_sha256}, or in the
+// format of SignQRToken for QRTokenStrategySigned.
+type TokenGenerator interface {
+	// Generate returns a token for the participant. attempt is 0 on the first try and is
+	// incremented on every retry after a collision, so each attempt yields a different token.
+	Generate(eventID, participantID uuid.UUID, attempt int) (string, error)
+}
+
+// NewTokenGenerator creates a TokenGenerator for the strategy.
+// size is the number of random or HMAC bytes encoded into the unique part; signed tokens have none.
+func NewTokenGenerator(strategy QRTokenStrategy, secret string, size int) (TokenGenerator, error) {
+	if secret == "" {
+		return nil, fmt.Errorf("%w: secret cannot be empty", ErrInvalidHMACToken)
+	}
+	if size < 1 || size > sha256.Size {
+		return nil, fmt.Errorf("QR token size must be between 1 and %d bytes, got %d", sha256.Size, size)
+	}
+
+	switch strategy {
+	case QRTokenStrategyRandom:
+		return &randomTokenGenerator{secret: secret, size: size}, nil
+	case QRTokenStrategyHMAC:
+		return &hmacTokenGenerator{secret: secret, size: size}, nil
+	case QRTokenStrategySigned:
+		return &signedTokenGenerator{secret: secret}, nil
+	default:
+		return nil, fmt.Errorf("unknown QR token strategy %q", strategy)
+	}
+}
+
+// randomTokenGenerator implements QRTokenStrategyRandom.
+type randomTokenGenerator struct {
+	secret string
+	size   int
+}
+
+func (g *randomTokenGenerator) Generate(eventID, participantID uuid.UUID, _ int) (string, error) {
+	randomBytes := make([]byte, g.size)
+	if _, err := rand.Read(randomBytes); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrTokenGeneration, err)
+	}
+	return signQRToken(eventID, participantID, encodeBase62(randomBytes), g.secret), nil
+}
+
+// hmacTokenGenerator implements QRTokenStrategyHMAC.
+type hmacTokenGenerator struct {
+	secret string
+	size   int
+}
+
+func (g *hmacTokenGenerator) Generate(eventID, participantID uuid.UUID, attempt int) (string, error) {
+	// The attempt is mixed in on retries only, keeping first tokens reproducible
+	message := "qr-token:" + participantID.String()
+	if attempt > 0 {
+		message += ":" + strconv.Itoa(attempt)
+	}
+
+	mac := hmac.New(sha256.New, []byte(g.secret))
+	mac.Write([]byte(message))
+	return signQRToken(eventID, participantID, encodeBase62(mac.Sum(nil)[:g.size]), g.secret), nil
+}
+
+// signedTokenGenerator implements QRTokenStrategySigned.
+type signedTokenGenerator struct {
+	secret string
+}
+
+// Generate ignores the attempt: tokens name their participant, so they cannot collide with the
+// tokens of other participants.
+func (g *signedTokenGenerator) Generate(eventID, participantID uuid.UUID, _ int) (string, error) {
+	return SignQRToken(eventID, participantID, time.Now(), g.secret)
+}
+
+// signQRToken builds the structured raw token and appends its HMAC-SHA256 signature.
+func signQRToken(eventID, participantID uuid.UUID, uniquePart, secret string) string {
+	rawToken := fmt.Sprintf("evt_%s_prt_%s_%s",
+		eventID.String()[:8],
+		participantID.String()[:8],
+		uniquePart,
+	)
+	return rawToken + tokenDelimiter + signHMAC(rawToken, secret)
+}
+
+// encodeBase62 encodes b as a fixed-width base62 string, so tokens of one size share a length.
+func encodeBase62(b []byte) string {
+	width := int(math.Ceil(float64(len(b)*8) / math.Log2(base62)))
+	encoded := new(big.Int).SetBytes(b).Text(base62)
+	if len(encoded) < width {
+		encoded = strings.Repeat("0", width-len(encoded)) + encoded
+	}
+	return encoded
+}
@@ -0,0 +1,106 @@
+package crypto_test
+
+import (
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TokenGenerator", func() {
+	const secret = "test-hmac-secret-for-testing-only-32chars"
+
+	var (
+		eventID       uuid.UUID
+		participantID uuid.UUID
+	)
+
+	BeforeEach(func() {
+		eventID = uuid.New()
+		participantID = uuid.New()
+	})
+
+	// 6 bytes encode to 9 base62 characters
+	const tokenPattern = `^evt_[0-9a-f-]{8}_prt_[0-9a-f-]{8}_[0-9a-zA-Z]{9}\.[A-Za-z0-9\-_]+$`
+
+	DescribeTable("generating tokens",
+		func(strategy crypto.QRTokenStrategy) {
+			gen, err := crypto.NewTokenGenerator(strategy, secret, 6)
+			Expect(err).NotTo(HaveOccurred())
+
+			token, err := gen.Generate(eventID, participantID, 0)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(token).To(MatchRegexp(tokenPattern))
+			Expect(token).To(HavePrefix("evt_" + eventID.String()[:8] + "_prt_" + participantID.String()[:8] + "_"))
+			Expect(crypto.VerifyHMACToken(secret, token)).To(BeTrue())
+		},
+		Entry("random strategy", crypto.QRTokenStrategyRandom),
+		Entry("hmac strategy", crypto.QRTokenStrategyHMAC),
+	)
+
+	DescribeTable("generating 10k tokens",
+		func(strategy crypto.QRTokenStrategy) {
+			gen, err := crypto.NewTokenGenerator(strategy, secret, 6)
+			Expect(err).NotTo(HaveOccurred())
+
+			seen := make(map[string]struct{}, 10000)
+			for range 10000 {
+				token, err := gen.Generate(eventID, uuid.New(), 0)
+				Expect(err).NotTo(HaveOccurred())
+				seen[token] = struct{}{}
+			}
+
+			Expect(seen).To(HaveLen(10000))
+		},
+		Entry("random strategy yields no collisions", crypto.QRTokenStrategyRandom),
+		Entry("hmac strategy yields no collisions", crypto.QRTokenStrategyHMAC),
+	)
+
+	Describe("hmac strategy", func() {
+		var gen crypto.TokenGenerator
+
+		BeforeEach(func() {
+			var err error
+			gen, err = crypto.NewTokenGenerator(crypto.QRTokenStrategyHMAC, secret, 6)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reproduce the same token for the same participant", func() {
+			first, err := gen.Generate(eventID, participantID, 0)
+			Expect(err).NotTo(HaveOccurred())
+			second, err := gen.Generate(eventID, participantID, 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(second).To(Equal(first))
+		})
+
+		It("should generate a different token on retry", func() {
+			first, err := gen.Generate(eventID, participantID, 0)
+			Expect(err).NotTo(HaveOccurred())
+			retry, err := gen.Generate(eventID, participantID, 1)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(retry).NotTo(Equal(first))
+		})
+	})
+
+	Describe("NewTokenGenerator", func() {
+		It("should reject an unknown strategy", func() {
+			_, err := crypto.NewTokenGenerator("sequential", secret, 6)
+			Expect(err).To(MatchError(ContainSubstring(`unknown QR token strategy "sequential"`)))
+		})
+
+		It("should reject an empty secret", func() {
+			_, err := crypto.NewTokenGenerator(crypto.QRTokenStrategyRandom, "", 6)
+			Expect(err).To(MatchError(crypto.ErrInvalidHMACToken))
+		})
+
+		It("should reject a size outside 1 to 32 bytes", func() {
+			_, err := crypto.NewTokenGenerator(crypto.QRTokenStrategyRandom, secret, 0)
+			Expect(err).To(HaveOccurred())
+			_, err = crypto.NewTokenGenerator(crypto.QRTokenStrategyHMAC, secret, 33)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,70 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+)
+
+// Secret encryption errors
+var (
+	// ErrEmptyEncryptionKey indicates the encryption key is empty.
+	ErrEmptyEncryptionKey = errors.New("encryption key cannot be empty")
+
+	// ErrDecryptionFailed indicates the ciphertext is malformed or was sealed with another key.
+	ErrDecryptionFailed = errors.New("failed to decrypt secret")
+)
+
+// EncryptSecret seals plaintext with AES-256-GCM under a key derived from key and returns
+// it base64-encoded, with the random nonce prepended. It is used for secrets that must be
+// read back, such as TOTP secrets, unlike passwords which are hashed.
+func EncryptSecret(plaintext, key string) (string, error) {
+	aead, err := newSecretAEAD(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrTokenGeneration, err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// DecryptSecret opens a secret sealed by EncryptSecret with the same key.
+func DecryptSecret(ciphertext, key string) (string, error) {
+	aead, err := newSecretAEAD(key)
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(ciphertext)
+	if err != nil || len(sealed) < aead.NonceSize() {
+		return "", ErrDecryptionFailed
+	}
+	nonce, data := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, data, nil)
+	if err != nil {
+		return "", ErrDecryptionFailed
+	}
+	return string(plaintext), nil
+}
+
+// newSecretAEAD returns the AES-256-GCM cipher keyed with the SHA-256 digest of key, so
+// any configured key of sufficient length can be used.
+func newSecretAEAD(key string) (cipher.AEAD, error) {
+	if key == "" {
+		return nil, ErrEmptyEncryptionKey
+	}
+	digest := sha256.Sum256([]byte(key))
+	block, err := aes.NewCipher(digest[:])
+	if err != nil {
+		return nil, fmt.Errorf("failed to create cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,56 @@
+package crypto_test
+
+import (
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secret Encryption", func() {
+	const key = "test-encryption-key-for-testing-only"
+
+	Describe("EncryptSecret and DecryptSecret", func() {
+		It("should round-trip the secret", func() {
+			sealed, err := crypto.EncryptSecret("JBSWY3DPEHPK3PXP", key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sealed).NotTo(ContainSubstring("JBSWY3DPEHPK3PXP"))
+
+			plain, err := crypto.DecryptSecret(sealed, key)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plain).To(Equal("JBSWY3DPEHPK3PXP"))
+		})
+
+		It("should use a fresh nonce for every encryption", func() {
+			first, err := crypto.EncryptSecret("secret", key)
+			Expect(err).NotTo(HaveOccurred())
+			second, err := crypto.EncryptSecret("secret", key)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(first).NotTo(Equal(second))
+		})
+
+		When("the key differs", func() {
+			It("should return ErrDecryptionFailed", func() {
+				sealed, err := crypto.EncryptSecret("secret", key)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = crypto.DecryptSecret(sealed, "another-encryption-key-for-testing")
+				Expect(err).To(MatchError(crypto.ErrDecryptionFailed))
+			})
+		})
+
+		When("the ciphertext is malformed", func() {
+			It("should return ErrDecryptionFailed", func() {
+				_, err := crypto.DecryptSecret("not-base64!", key)
+				Expect(err).To(MatchError(crypto.ErrDecryptionFailed))
+			})
+		})
+
+		When("the key is empty", func() {
+			It("should return ErrEmptyEncryptionKey", func() {
+				_, err := crypto.EncryptSecret("secret", "")
+				Expect(err).To(MatchError(crypto.ErrEmptyEncryptionKey))
+			})
+		})
+	})
+})
@@ -0,0 +1,249 @@
+package crypto
+
+import (
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// SigningAlgorithm is the algorithm JWT tokens are signed with.
+type SigningAlgorithm string
+
+const (
+	// SigningAlgorithmHS256 signs tokens with HMAC-SHA256 and a shared secret.
+	SigningAlgorithmHS256 SigningAlgorithm = "HS256"
+
+	// SigningAlgorithmRS256 signs tokens with RSA-SHA256 and a private key, so that clients can
+	// verify them with the public key alone.
+	SigningAlgorithmRS256 SigningAlgorithm = "RS256"
+)
+
+// Signing configuration errors
+var (
+	// ErrUnsupportedAlgorithm indicates the signing algorithm is neither HS256 nor RS256.
+	ErrUnsupportedAlgorithm = errors.New("unsupported jwt signing algorithm")
+
+	// ErrEmptyPrivateKey indicates RS256 signing has no private key path.
+	ErrEmptyPrivateKey = errors.New("jwt private key path cannot be empty")
+)
+
+// SigningConfig selects the algorithm and keys JWT tokens are signed and verified with.
+// HS256 uses Secret. RS256 reads PEM-encoded keys: tokens are signed with the private key and
+// verified with the public key, which is derived from the private key when PublicKeyPath is empty.
+//
+// The retired keys are keys previously signed with, in order from the most recently retired:
+// HMAC secrets and paths to PEM-encoded RSA public keys. Tokens they signed are still verified
+// until they expire, so that rotating the signing key does not invalidate every issued token.
+//
+// Audience is set as the "aud" claim of signed tokens, and only tokens for it are verified, so that
+// other services sharing the key do not accept them. An empty audience skips the check.
+type SigningConfig struct {
+	Algorithm             SigningAlgorithm
+	Secret                string
+	PrivateKeyPath        string
+	PublicKeyPath         string
+	RetiredSecrets        []string
+	RetiredPublicKeyPaths []string
+	Audience              string
+}
+
+// TokenSigner signs JWT tokens with the current key of a SigningConfig, naming it in the "kid"
+// header, and verifies them with the key the header names. Tokens signed with an algorithm other
+// than their key's are rejected.
+type TokenSigner struct {
+	keyID    string
+	method   jwt.SigningMethod
+	signKey  any
+	audience string
+
+	// keys holds the current and retired verification keys, the current first
+	keys []verificationKey
+}
+
+// verificationKey is a key tokens are verified with, identified by its key ID
+type verificationKey struct {
+	id     string
+	method jwt.SigningMethod
+	key    any
+}
+
+// NewTokenSigner creates a TokenSigner from cfg, reading the RS256 keys from their files.
+// An empty algorithm selects HS256.
+func NewTokenSigner(cfg SigningConfig) (*TokenSigner, error) {
+	var (
+		signer *TokenSigner
+		err    error
+	)
+	switch cfg.Algorithm {
+	case SigningAlgorithmHS256, "":
+		signer, err = newHS256Signer(cfg.Secret)
+	case SigningAlgorithmRS256:
+		signer, err = newRS256Signer(cfg.PrivateKeyPath, cfg.PublicKeyPath)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlgorithm, cfg.Algorithm)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, secret := range cfg.RetiredSecrets {
+		if secret == "" {
+			return nil, ErrEmptySecret
+		}
+		signer.keys = append(signer.keys, hmacKey(secret))
+	}
+	for _, path := range cfg.RetiredPublicKeyPaths {
+		publicKey, err := readRSAPublicKey(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := rsaKey(publicKey)
+		if err != nil {
+			return nil, err
+		}
+		signer.keys = append(signer.keys, key)
+	}
+	signer.audience = cfg.Audience
+	return signer, nil
+}
+
+// Algorithm returns the algorithm the signer signs tokens with.
+func (s *TokenSigner) Algorithm() SigningAlgorithm {
+	return SigningAlgorithm(s.method.Alg())
+}
+
+// KeyID returns the ID of the key the signer signs tokens with, which the tokens name in their
+// "kid" header. It is derived from the key, so every instance sharing the key agrees on it.
+func (s *TokenSigner) KeyID() string {
+	return s.keyID
+}
+
+// newHS256Signer creates a signer for HMAC-SHA256 tokens with secret.
+func newHS256Signer(secret string) (*TokenSigner, error) {
+	if secret == "" {
+		return nil, ErrEmptySecret
+	}
+	key := hmacKey(secret)
+	return &TokenSigner{keyID: key.id, method: key.method, signKey: key.key, keys: []verificationKey{key}}, nil
+}
+
+// newRS256Signer creates a signer for RSA-SHA256 tokens with the PEM-encoded keys at the paths.
+func newRS256Signer(privateKeyPath, publicKeyPath string) (*TokenSigner, error) {
+	if privateKeyPath == "" {
+		return nil, ErrEmptyPrivateKey
+	}
+	privatePEM, err := os.ReadFile(privateKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jwt private key: %w", err)
+	}
+	privateKey, err := jwt.ParseRSAPrivateKeyFromPEM(privatePEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse jwt private key: %w", err)
+	}
+
+	publicKey := &privateKey.PublicKey
+	if publicKeyPath != "" {
+		publicKey, err = readRSAPublicKey(publicKeyPath)
+		if err != nil {
+			return nil, err
+		}
+		if !publicKey.Equal(&privateKey.PublicKey) {
+			return nil, errors.New("jwt public key does not match the private key")
+		}
+	}
+
+	key, err := rsaKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &TokenSigner{keyID: key.id, method: key.method, signKey: privateKey, keys: []verificationKey{key}}, nil
+}
+
+// readRSAPublicKey reads the PEM-encoded RSA public key at path.
+func readRSAPublicKey(path string) (*rsa.PublicKey, error) {
+	publicPEM, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jwt public key: %w", err)
+	}
+	publicKey, err := jwt.ParseRSAPublicKeyFromPEM(publicPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse jwt public key: %w", err)
+	}
+	return publicKey, nil
+}
+
+// hmacKey returns the HS256 verification key of secret.
+func hmacKey(secret string) verificationKey {
+	return verificationKey{id: keyID([]byte(secret)), method: jwt.SigningMethodHS256, key: []byte(secret)}
+}
+
+// rsaKey returns the RS256 verification key of publicKey.
+func rsaKey(publicKey *rsa.PublicKey) (verificationKey, error) {
+	der, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return verificationKey{}, fmt.Errorf("failed to encode jwt public key: %w", err)
+	}
+	return verificationKey{id: keyID(der), method: jwt.SigningMethodRS256, key: publicKey}, nil
+}
+
+// keyID derives a key ID from key material: the first 8 bytes of its SHA-256 hash, hex-encoded.
+// The hash does not reveal the key, but tells keys apart.
+func keyID(material []byte) string {
+	sum := sha256.Sum256(material)
+	return hex.EncodeToString(sum[:8])
+}
+
+// keyFunc returns the key a token is verified with: the key its "kid" header names. Tokens
+// without the header, signed before key IDs were introduced, are verified with every key of
+// their algorithm. The algorithm must be the key's, before the signature is checked, so that
+// e.g. an RS256 public key is never used as an HMAC secret and unsigned ("none") tokens are
+// refused.
+func (s *TokenSigner) keyFunc(token *jwt.Token) (any, error) {
+	kid, hasKID := token.Header["kid"].(string)
+	if hasKID {
+		for _, key := range s.keys {
+			if key.id != kid {
+				continue
+			}
+			if token.Method.Alg() != key.method.Alg() {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+			return key.key, nil
+		}
+		return nil, fmt.Errorf("unknown key id: %s", kid)
+	}
+
+	var candidates []jwt.VerificationKey
+	for _, key := range s.keys {
+		if token.Method.Alg() == key.method.Alg() {
+			candidates = append(candidates, key.key)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+	}
+	return jwt.VerificationKeySet{Keys: candidates}, nil
+}
+
+// parserOptions restricts parsing to the algorithms of the signer's keys and, if it has one, to
+// tokens for the signer's audience.
+func (s *TokenSigner) parserOptions() []jwt.ParserOption {
+	methods := make([]string, 0, len(s.keys))
+	for _, key := range s.keys {
+		if !slices.Contains(methods, key.method.Alg()) {
+			methods = append(methods, key.method.Alg())
+		}
+	}
+	options := []jwt.ParserOption{jwt.WithValidMethods(methods)}
+	if s.audience != "" {
+		options = append(options, jwt.WithAudience(s.audience))
+	}
+	return options
+}
@@ -0,0 +1,452 @@
+package crypto_test
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TokenSigner", func() {
+	var (
+		testUserID string
+		dir        string
+		privateKey *rsa.PrivateKey
+	)
+
+	// writeKeys writes key's private and public PEM files to dir and returns their paths
+	writeKeys := func(key *rsa.PrivateKey, name string) (string, string) {
+		publicDER, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+		privatePath := filepath.Join(dir, name+".key")
+		publicPath := filepath.Join(dir, name+".pub")
+		Expect(os.WriteFile(privatePath, pem.EncodeToMemory(&pem.Block{
+			Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key),
+		}), 0o600)).To(Succeed())
+		Expect(os.WriteFile(publicPath, pem.EncodeToMemory(&pem.Block{
+			Type: "PUBLIC KEY", Bytes: publicDER,
+		}), 0o600)).To(Succeed())
+		return privatePath, publicPath
+	}
+
+	BeforeEach(func() {
+		testUserID = uuid.New().String()
+		dir = GinkgoT().TempDir()
+		var err error
+		privateKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("RS256", func() {
+		var signer *crypto.TokenSigner
+
+		BeforeEach(func() {
+			privatePath, publicPath := writeKeys(privateKey, "jwt")
+			var err error
+			signer, err = crypto.NewTokenSigner(crypto.SigningConfig{
+				Algorithm:      crypto.SigningAlgorithmRS256,
+				PrivateKeyPath: privatePath,
+				PublicKeyPath:  publicPath,
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should sign tokens that verify with the public key alone", func() {
+			token, err := signer.GenerateAccessToken(testUserID, "organizer", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			claims, err := signer.ParseToken(token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.UserID.String()).To(Equal(testUserID))
+			Expect(claims.TokenType).To(Equal(crypto.TokenTypeAccess))
+
+			parsed, err := jwt.ParseWithClaims(token, &crypto.Claims{}, func(*jwt.Token) (any, error) {
+				return &privateKey.PublicKey, nil
+			}, jwt.WithValidMethods([]string{"RS256"}))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Valid).To(BeTrue())
+			Expect(signer.Algorithm()).To(Equal(crypto.SigningAlgorithmRS256))
+		})
+
+		It("should sign refresh and two-factor challenge tokens", func() {
+			refresh, err := signer.GenerateRefreshToken(testUserID, "organizer", "mobile", time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			challenge, err := signer.GenerateTwoFactorChallengeToken(testUserID, "organizer", "web", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			claims, err := signer.ParseToken(refresh)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.ClientType).To(Equal("mobile"))
+			Expect(signer.ValidateToken(challenge)).To(Succeed())
+		})
+
+		It("should reject an HS256 token signed with the public key as the secret", func() {
+			publicPEM, err := os.ReadFile(filepath.Join(dir, "jwt.pub"))
+			Expect(err).NotTo(HaveOccurred())
+			forged, err := crypto.GenerateAccessToken(testUserID, "admin", string(publicPEM), time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = signer.ParseToken(forged)
+			Expect(err).To(MatchError(crypto.ErrInvalidToken))
+			Expect(signer.ValidateToken(forged)).To(MatchError(crypto.ErrInvalidToken))
+		})
+
+		It("should reject an unsigned token", func() {
+			unsigned, err := jwt.NewWithClaims(jwt.SigningMethodNone, &crypto.Claims{
+				UserID: uuid.MustParse(testUserID),
+			}).SignedString(jwt.UnsafeAllowNoneSignatureType)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = signer.ParseToken(unsigned)
+			Expect(err).To(MatchError(crypto.ErrInvalidToken))
+		})
+
+		It("should reject a token signed with another private key", func() {
+			otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			otherPath, _ := writeKeys(otherKey, "other")
+			other, err := crypto.NewTokenSigner(crypto.SigningConfig{
+				Algorithm: crypto.SigningAlgorithmRS256, PrivateKeyPath: otherPath,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			token, err := other.GenerateAccessToken(testUserID, "organizer", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = signer.ParseToken(token)
+			Expect(err).To(MatchError(crypto.ErrInvalidToken))
+		})
+	})
+
+	Describe("HS256", func() {
+		It("should reject an RS256 token", func() {
+			privatePath, _ := writeKeys(privateKey, "jwt")
+			rs256, err := crypto.NewTokenSigner(crypto.SigningConfig{
+				Algorithm: crypto.SigningAlgorithmRS256, PrivateKeyPath: privatePath,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			token, err := rs256.GenerateAccessToken(testUserID, "organizer", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			hs256, err := crypto.NewTokenSigner(crypto.SigningConfig{Secret: "test-secret-key-minimum-32-chars-long"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(hs256.Algorithm()).To(Equal(crypto.SigningAlgorithmHS256))
+
+			_, err = hs256.ParseToken(token)
+			Expect(err).To(MatchError(crypto.ErrInvalidToken))
+		})
+
+		It("should accept the tokens of the package-level functions with the same secret", func() {
+			const secret = "test-secret-key-minimum-32-chars-long"
+			signer, err := crypto.NewTokenSigner(crypto.SigningConfig{
+				Algorithm: crypto.SigningAlgorithmHS256, Secret: secret,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			token, err := crypto.GenerateAccessToken(testUserID, "organizer", secret, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			claims, err := signer.ParseToken(token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.UserID.String()).To(Equal(testUserID))
+		})
+	})
+
+	Describe("session tokens", func() {
+		It("should carry the session ID in access and refresh tokens", func() {
+			signer, err := crypto.NewTokenSigner(crypto.SigningConfig{Secret: "test-secret-key-minimum-32-chars-long"})
+			Expect(err).NotTo(HaveOccurred())
+			sessionID := uuid.New().String()
+
+			access, err := signer.GenerateSessionAccessToken(testUserID, "organizer", sessionID, time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			refresh, err := signer.GenerateSessionRefreshToken(testUserID, "organizer", "web", sessionID, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+
+			accessClaims, err := signer.ParseToken(access)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(accessClaims.SessionID).To(Equal(sessionID))
+			refreshClaims, err := signer.ParseToken(refresh)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(refreshClaims.SessionID).To(Equal(sessionID))
+			Expect(refreshClaims.TokenType).To(Equal(crypto.TokenTypeRefresh))
+		})
+
+		It("should leave the session ID out of tokens issued without one", func() {
+			signer, err := crypto.NewTokenSigner(crypto.SigningConfig{Secret: "test-secret-key-minimum-32-chars-long"})
+			Expect(err).NotTo(HaveOccurred())
+			token, err := signer.GenerateRefreshToken(testUserID, "organizer", "web", time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+
+			claims, err := signer.ParseToken(token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.SessionID).To(BeEmpty())
+		})
+
+		It("should issue distinct tokens for the same session within the same second", func() {
+			signer, err := crypto.NewTokenSigner(crypto.SigningConfig{Secret: "test-secret-key-minimum-32-chars-long"})
+			Expect(err).NotTo(HaveOccurred())
+			sessionID := uuid.New().String()
+
+			first, err := signer.GenerateSessionRefreshToken(testUserID, "organizer", "web", sessionID, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+			second, err := signer.GenerateSessionRefreshToken(testUserID, "organizer", "web", sessionID, time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(second).NotTo(Equal(first))
+		})
+	})
+
+	Describe("audience", func() {
+		const secret = "audience-secret-key-minimum-32-chars-long"
+
+		newSigner := func(audience string) *crypto.TokenSigner {
+			signer, err := crypto.NewTokenSigner(crypto.SigningConfig{Secret: secret, Audience: audience})
+			Expect(err).NotTo(HaveOccurred())
+			return signer
+		}
+
+		It("should set the audience claim and verify tokens for it", func() {
+			signer := newSigner("ezqrin-api")
+			token, err := signer.GenerateAccessToken(testUserID, "organizer", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			claims, err := signer.ParseToken(token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.Audience).To(Equal(jwt.ClaimStrings{"ezqrin-api"}))
+		})
+
+		It("should reject tokens for another audience", func() {
+			token, err := newSigner("other-service").GenerateRefreshToken(testUserID, "organizer", "web", time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = newSigner("ezqrin-api").ParseToken(token)
+			Expect(err).To(MatchError(crypto.ErrInvalidToken))
+			Expect(newSigner("ezqrin-api").ValidateToken(token)).To(MatchError(crypto.ErrInvalidToken))
+		})
+
+		It("should reject tokens without an audience", func() {
+			token, err := newSigner("").GenerateAccessToken(testUserID, "organizer", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = newSigner("ezqrin-api").ParseToken(token)
+			Expect(err).To(MatchError(crypto.ErrInvalidToken))
+		})
+
+		It("should skip the check without an expected audience", func() {
+			token, err := newSigner("ezqrin-api").GenerateAccessToken(testUserID, "organizer", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			claims, err := newSigner("").ParseToken(token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.UserID.String()).To(Equal(testUserID))
+		})
+	})
+
+	Describe("key rotation", func() {
+		const (
+			previousSecret = "previous-secret-key-minimum-32-chars-long"
+			currentSecret  = "current-secret-key-minimum-32-chars-long"
+		)
+
+		var previous, current *crypto.TokenSigner
+
+		BeforeEach(func() {
+			var err error
+			previous, err = crypto.NewTokenSigner(crypto.SigningConfig{Secret: previousSecret})
+			Expect(err).NotTo(HaveOccurred())
+			current, err = crypto.NewTokenSigner(crypto.SigningConfig{
+				Secret: currentSecret, RetiredSecrets: []string{previousSecret},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should name the signing key in the kid header", func() {
+			token, err := current.GenerateAccessToken(testUserID, "organizer", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, &crypto.Claims{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Header["kid"]).To(Equal(current.KeyID()))
+			Expect(current.KeyID()).NotTo(Equal(previous.KeyID()))
+		})
+
+		It("should verify the tokens of a retired key", func() {
+			token, err := previous.GenerateRefreshToken(testUserID, "organizer", "web", time.Hour)
+			Expect(err).NotTo(HaveOccurred())
+
+			claims, err := current.ParseToken(token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(claims.UserID.String()).To(Equal(testUserID))
+			Expect(current.ValidateToken(token)).To(Succeed())
+		})
+
+		It("should reject the tokens of a key that is no longer configured", func() {
+			token, err := previous.GenerateAccessToken(testUserID, "organizer", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+			rotatedAgain, err := crypto.NewTokenSigner(crypto.SigningConfig{Secret: currentSecret})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = rotatedAgain.ParseToken(token)
+			Expect(err).To(MatchError(crypto.ErrInvalidToken))
+		})
+
+		It("should verify tokens without a kid header with every key", func() {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &crypto.Claims{
+				UserID: uuid.MustParse(testUserID),
+				RegisteredClaims: jwt.RegisteredClaims{
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+				},
+			}).SignedString([]byte(previousSecret))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = current.ParseToken(token)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should reject a token naming another key than it was signed with", func() {
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &crypto.Claims{
+				UserID: uuid.MustParse(testUserID),
+				RegisteredClaims: jwt.RegisteredClaims{
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+				},
+			}).SignedString([]byte(previousSecret))
+			Expect(err).NotTo(HaveOccurred())
+			parsed, _, err := jwt.NewParser().ParseUnverified(token, &crypto.Claims{})
+			Expect(err).NotTo(HaveOccurred())
+			parsed.Header["kid"] = current.KeyID()
+			relabelled, err := parsed.SignedString([]byte(previousSecret))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = current.ParseToken(relabelled)
+			Expect(err).To(MatchError(crypto.ErrInvalidToken))
+		})
+
+		It("should reject a token naming an unknown key", func() {
+			token := jwt.NewWithClaims(jwt.SigningMethodHS256, &crypto.Claims{UserID: uuid.MustParse(testUserID)})
+			token.Header["kid"] = "unknown"
+			signed, err := token.SignedString([]byte(currentSecret))
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = current.ParseToken(signed)
+			Expect(err).To(MatchError(crypto.ErrInvalidToken))
+		})
+
+		When("rotating from HS256 to RS256", func() {
+			var rs256 *crypto.TokenSigner
+
+			BeforeEach(func() {
+				privatePath, _ := writeKeys(privateKey, "jwt")
+				var err error
+				rs256, err = crypto.NewTokenSigner(crypto.SigningConfig{
+					Algorithm:      crypto.SigningAlgorithmRS256,
+					PrivateKeyPath: privatePath,
+					RetiredSecrets: []string{previousSecret},
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should verify the HS256 tokens of the retired secret", func() {
+				token, err := previous.GenerateAccessToken(testUserID, "organizer", time.Minute)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = rs256.ParseToken(token)
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should reject an HS256 token naming the RSA key", func() {
+				publicPEM, err := os.ReadFile(filepath.Join(dir, "jwt.pub"))
+				Expect(err).NotTo(HaveOccurred())
+				token := jwt.NewWithClaims(jwt.SigningMethodHS256, &crypto.Claims{UserID: uuid.MustParse(testUserID)})
+				token.Header["kid"] = rs256.KeyID()
+				forged, err := token.SignedString(publicPEM)
+				Expect(err).NotTo(HaveOccurred())
+
+				_, err = rs256.ParseToken(forged)
+				Expect(err).To(MatchError(crypto.ErrInvalidToken))
+			})
+		})
+
+		It("should verify the tokens of a retired RSA public key", func() {
+			previousPath, previousPublicPath := writeKeys(privateKey, "previous")
+			previousRSA, err := crypto.NewTokenSigner(crypto.SigningConfig{
+				Algorithm: crypto.SigningAlgorithmRS256, PrivateKeyPath: previousPath,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			token, err := previousRSA.GenerateAccessToken(testUserID, "organizer", time.Minute)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			currentPath, _ := writeKeys(otherKey, "current")
+			currentRSA, err := crypto.NewTokenSigner(crypto.SigningConfig{
+				Algorithm:             crypto.SigningAlgorithmRS256,
+				PrivateKeyPath:        currentPath,
+				RetiredPublicKeyPaths: []string{previousPublicPath},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = currentRSA.ParseToken(token)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("NewTokenSigner", func() {
+		It("should require a secret for HS256", func() {
+			_, err := crypto.NewTokenSigner(crypto.SigningConfig{Algorithm: crypto.SigningAlgorithmHS256})
+			Expect(err).To(MatchError(crypto.ErrEmptySecret))
+		})
+
+		It("should require a private key for RS256", func() {
+			_, err := crypto.NewTokenSigner(crypto.SigningConfig{Algorithm: crypto.SigningAlgorithmRS256})
+			Expect(err).To(MatchError(crypto.ErrEmptyPrivateKey))
+		})
+
+		It("should reject a public key that does not match the private key", func() {
+			privatePath, _ := writeKeys(privateKey, "jwt")
+			otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+			Expect(err).NotTo(HaveOccurred())
+			_, otherPublicPath := writeKeys(otherKey, "other")
+
+			_, err = crypto.NewTokenSigner(crypto.SigningConfig{
+				Algorithm:      crypto.SigningAlgorithmRS256,
+				PrivateKeyPath: privatePath,
+				PublicKeyPath:  otherPublicPath,
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject a private key file that does not exist", func() {
+			_, err := crypto.NewTokenSigner(crypto.SigningConfig{
+				Algorithm:      crypto.SigningAlgorithmRS256,
+				PrivateKeyPath: filepath.Join(dir, "missing.key"),
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject an empty retired secret", func() {
+			_, err := crypto.NewTokenSigner(crypto.SigningConfig{Secret: "secret", RetiredSecrets: []string{""}})
+			Expect(err).To(MatchError(crypto.ErrEmptySecret))
+		})
+
+		It("should reject a retired public key file that does not exist", func() {
+			_, err := crypto.NewTokenSigner(crypto.SigningConfig{
+				Secret:                "secret",
+				RetiredPublicKeyPaths: []string{filepath.Join(dir, "missing.pub")},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should reject an unsupported algorithm", func() {
+			_, err := crypto.NewTokenSigner(crypto.SigningConfig{Algorithm: "ES256", Secret: "secret"})
+			Expect(err).To(MatchError(crypto.ErrUnsupportedAlgorithm))
+		})
+	})
+})
@@ -85,11 +85,7 @@ func GenerateHMACSignedToken(secret string) (string, error) {
 	}
 
 	// Compute HMAC-SHA256 signature of the raw token
-	mac := hmac.New(sha256.New, []byte(secret))
-	mac.Write([]byte(rawToken))
-	signature := base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
-
-	return rawToken + tokenDelimiter + signature, nil
+	return rawToken + tokenDelimiter + signHMAC(rawToken, secret), nil
 }
 
 // GenerateParticipantQRToken generates a structured QR token for a participant.
@@ -113,19 +109,8 @@ func GenerateParticipantQRToken(eventID, participantID uuid.UUID, secret string)
 	}
 	randomPart := hex.EncodeToString(randomBytes)
 
-	// Build structured raw token
-	rawToken := fmt.Sprintf("evt_%s_prt_%s_%s",
-		eventID.String()[:8],
-		participantID.String()[:8],
-		randomPart,
-	)
-
-	// Sign with HMAC-SHA256
-	mac := hmac.New(sha256.New, []byte(secret))
-	mac.Write([]byte(rawToken))
-	signature := base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
-
-	return rawToken + tokenDelimiter + signature, nil
+	// Build the structured raw token and sign it with HMAC-SHA256
+	return signQRToken(eventID, participantID, randomPart, secret), nil
 }
 
 // VerifyHMACToken verifies that the signed token was generated with the given secret.
@@ -153,10 +138,15 @@ func VerifyHMACToken(secret, signedToken string) bool {
 	}
 
 	// Compute expected signature
-	mac := hmac.New(sha256.New, []byte(secret))
-	mac.Write([]byte(rawToken))
-	expectedSig := base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+	expectedSig := signHMAC(rawToken, secret)
 
 	// Use constant-time comparison to prevent timing attacks
 	return hmac.Equal([]byte(providedSig), []byte(expectedSig))
 }
+
+// signHMAC returns the base64url-encoded HMAC-SHA256 signature of rawToken.
+func signHMAC(rawToken, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(rawToken))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
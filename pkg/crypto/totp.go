@@ -0,0 +1,139 @@
+package crypto
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec // RFC 6238 TOTP uses HMAC-SHA1, which authenticator apps expect
+	"crypto/sha256"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// TOTPPeriod is the time step of TOTP codes (RFC 6238 default).
+	TOTPPeriod = 30 * time.Second
+
+	// TOTPDigits is the number of digits of a TOTP code.
+	TOTPDigits = 6
+
+	// TOTPSkew is the number of time steps before and after the current one that are still
+	// accepted, to tolerate clock drift between the server and the authenticator.
+	TOTPSkew = 1
+
+	// totpSecretBytes is the size of generated TOTP secrets (160 bits, as recommended by RFC 4226).
+	totpSecretBytes = 20
+
+	// backupCodeBytes is the random input of a backup code; its first 10 base32 characters
+	// (50 bits) form the code.
+	backupCodeBytes = 10
+)
+
+// totpEncoding encodes TOTP secrets as authenticator apps expect them: base32 without padding.
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// ErrInvalidTOTPSecret indicates the TOTP secret is not valid base32.
+var ErrInvalidTOTPSecret = errors.New("invalid TOTP secret")
+
+// GenerateTOTPSecret generates a random base32-encoded TOTP secret.
+func GenerateTOTPSecret() (string, error) {
+	secret := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("%w: %w", ErrTokenGeneration, err)
+	}
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// TOTPStep returns the TOTP time step t falls in.
+func TOTPStep(t time.Time) int64 {
+	return t.Unix() / int64(TOTPPeriod/time.Second)
+}
+
+// TOTPCode returns the TOTP code of secret for the given time step (RFC 6238, HMAC-SHA1).
+func TOTPCode(secret string, step int64) (string, error) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(key) == 0 {
+		return "", ErrInvalidTOTPSecret
+	}
+
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(step)) //nolint:gosec // time steps are positive
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation (RFC 4226 section 5.3)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	return fmt.Sprintf("%0*d", TOTPDigits, value%1_000_000), nil
+}
+
+// ValidateTOTP checks code against secret for the time steps around now, allowing TOTPSkew
+// steps of clock drift. It returns the matched time step, which callers should record to
+// reject a second use of the same code.
+func ValidateTOTP(secret, code string, now time.Time) (int64, bool) {
+	code = strings.TrimSpace(code)
+	if len(code) != TOTPDigits {
+		return 0, false
+	}
+
+	current := TOTPStep(now)
+	for step := current - TOTPSkew; step <= current+TOTPSkew; step++ {
+		expected, err := TOTPCode(secret, step)
+		if err != nil {
+			return 0, false
+		}
+		if hmac.Equal([]byte(code), []byte(expected)) {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// TOTPAuthURI returns the otpauth:// URI that authenticator apps import, usually by
+// scanning it as a QR code.
+func TOTPAuthURI(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", fmt.Sprint(TOTPDigits))
+	query.Set("period", fmt.Sprint(int(TOTPPeriod/time.Second)))
+
+	u := url.URL{
+		Scheme:   "otpauth",
+		Host:     "totp",
+		Path:     "/" + issuer + ":" + account,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+// GenerateBackupCodes generates n random single-use backup codes formatted as "xxxxx-xxxxx".
+func GenerateBackupCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	for i := range codes {
+		raw := make([]byte, backupCodeBytes)
+		if _, err := rand.Read(raw); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrTokenGeneration, err)
+		}
+		encoded := strings.ToLower(totpEncoding.EncodeToString(raw))[:10]
+		codes[i] = encoded[:5] + "-" + encoded[5:]
+	}
+	return codes, nil
+}
+
+// HashBackupCode returns the SHA-256 hex digest of a backup code, ignoring case, spaces and
+// dashes so codes can be typed the way they are displayed or not.
+// Backup codes are random, so a fast hash is sufficient to keep them unreadable at rest.
+func HashBackupCode(code string) string {
+	normalized := strings.NewReplacer("-", "", " ", "").Replace(strings.ToLower(strings.TrimSpace(code)))
+	sum := sha256.Sum256([]byte(normalized))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,115 @@
+package crypto_test
+
+import (
+	"encoding/base32"
+	"net/url"
+	"time"
+
+	"github.com/fumkob/ezqrin-server/pkg/crypto"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TOTP", func() {
+	// RFC 6238 appendix B test secret for HMAC-SHA1
+	rfcSecret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+	Describe("TOTPCode", func() {
+		DescribeTable("should match the RFC 6238 test vectors (last 6 digits)",
+			func(unix int64, expected string) {
+				code, err := crypto.TOTPCode(rfcSecret, crypto.TOTPStep(time.Unix(unix, 0)))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(code).To(Equal(expected))
+			},
+			Entry("at 59", int64(59), "287082"),
+			Entry("at 1111111109", int64(1111111109), "081804"),
+			Entry("at 1234567890", int64(1234567890), "005924"),
+			Entry("at 2000000000", int64(2000000000), "279037"),
+		)
+
+		When("the secret is not base32", func() {
+			It("should return ErrInvalidTOTPSecret", func() {
+				_, err := crypto.TOTPCode("not base32!", 1)
+				Expect(err).To(MatchError(crypto.ErrInvalidTOTPSecret))
+			})
+		})
+	})
+
+	Describe("ValidateTOTP", func() {
+		var (
+			secret string
+			now    time.Time
+		)
+
+		BeforeEach(func() {
+			var err error
+			secret, err = crypto.GenerateTOTPSecret()
+			Expect(err).NotTo(HaveOccurred())
+			now = time.Date(2026, 6, 1, 12, 0, 10, 0, time.UTC)
+		})
+
+		It("should accept the current code and return its step", func() {
+			code, err := crypto.TOTPCode(secret, crypto.TOTPStep(now))
+			Expect(err).NotTo(HaveOccurred())
+
+			step, ok := crypto.ValidateTOTP(secret, code, now)
+			Expect(ok).To(BeTrue())
+			Expect(step).To(Equal(crypto.TOTPStep(now)))
+		})
+
+		It("should accept the code of the previous step to tolerate clock drift", func() {
+			code, err := crypto.TOTPCode(secret, crypto.TOTPStep(now)-1)
+			Expect(err).NotTo(HaveOccurred())
+
+			step, ok := crypto.ValidateTOTP(secret, code, now)
+			Expect(ok).To(BeTrue())
+			Expect(step).To(Equal(crypto.TOTPStep(now) - 1))
+		})
+
+		It("should reject codes outside the skew window", func() {
+			code, err := crypto.TOTPCode(secret, crypto.TOTPStep(now)-2)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, ok := crypto.ValidateTOTP(secret, code, now)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should reject codes of the wrong length", func() {
+			_, ok := crypto.ValidateTOTP(secret, "12345", now)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("TOTPAuthURI", func() {
+		It("should build an otpauth URI with the issuer, account and secret", func() {
+			uri := crypto.TOTPAuthURI("ezQRin", "alice@example.com", "JBSWY3DPEHPK3PXP")
+
+			parsed, err := url.Parse(uri)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(parsed.Scheme).To(Equal("otpauth"))
+			Expect(parsed.Host).To(Equal("totp"))
+			Expect(parsed.Path).To(Equal("/ezQRin:alice@example.com"))
+			Expect(parsed.Query().Get("secret")).To(Equal("JBSWY3DPEHPK3PXP"))
+			Expect(parsed.Query().Get("issuer")).To(Equal("ezQRin"))
+			Expect(parsed.Query().Get("digits")).To(Equal("6"))
+			Expect(parsed.Query().Get("period")).To(Equal("30"))
+		})
+	})
+
+	Describe("GenerateBackupCodes and HashBackupCode", func() {
+		It("should generate distinct formatted codes", func() {
+			codes, err := crypto.GenerateBackupCodes(10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(codes).To(HaveLen(10))
+			for _, code := range codes {
+				Expect(code).To(MatchRegexp(`^[a-z2-7]{5}-[a-z2-7]{5}$`))
+			}
+			Expect(codes[0]).NotTo(Equal(codes[1]))
+		})
+
+		It("should hash codes regardless of case and dashes", func() {
+			Expect(crypto.HashBackupCode("abcde-fghij")).To(Equal(crypto.HashBackupCode(" ABCDEFGHIJ ")))
+			Expect(crypto.HashBackupCode("abcde-fghij")).NotTo(Equal(crypto.HashBackupCode("abcde-fghik")))
+		})
+	})
+})
@@ -0,0 +1,180 @@
+package csvparser
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+)
+
+// HeaderMode selects how the header row of an import is matched to participant columns.
+type HeaderMode string
+
+const (
+	// HeaderModeStrict only accepts the exact participant column names (e.g. "employee_id"). Default.
+	HeaderModeStrict HeaderMode = "strict"
+	// HeaderModeAliases also accepts common aliases of the column names, case-insensitively
+	// (e.g. "Email Address" or "氏名").
+	HeaderModeAliases HeaderMode = "aliases"
+)
+
+// HeaderOptions controls how the header row of an import is mapped to participant columns.
+type HeaderOptions struct {
+	Mode HeaderMode
+	// Mapping maps header names of the file to participant columns. It is matched
+	// case-insensitively and takes precedence over Mode. Columns may also be custom field keys.
+	Mapping map[string]string
+	// CustomFields are the custom fields of the event, whose columns are read into the custom
+	// data of the participants. A header column maps to a field named by its key, or in
+	// HeaderModeAliases also by its label, when it maps to no participant column.
+	CustomFields []entity.CustomField
+}
+
+// HeaderError reports a header row that lacks required participant columns.
+type HeaderError struct {
+	// Missing lists the required participant columns that no header column maps to
+	Missing []string
+	// Ignored lists the header columns that were not mapped to a participant column
+	Ignored []string
+}
+
+// Error implements the error interface.
+func (e *HeaderError) Error() string {
+	msg := "CSV header is missing required columns " + quoteColumns(e.Missing)
+	if len(e.Ignored) > 0 {
+		msg += "; ignored unknown columns " + quoteColumns(e.Ignored)
+	}
+	return msg
+}
+
+// requiredColumns are the participant columns every import must provide.
+var requiredColumns = []string{"name", "email"}
+
+// importColumns are the participant columns read by an import.
+var importColumns = map[string]bool{
+	"name":           true,
+	"email":          true,
+	"employee_id":    true,
+	"phone":          true,
+	"status":         true,
+	"payment_status": true,
+	"payment_amount": true,
+	"payment_date":   true,
+	"metadata":       true,
+}
+
+// columnAliases lists the header names accepted for each participant column in
+// HeaderModeAliases, besides the column name itself.
+var columnAliases = map[string][]string{
+	"name":           {"full name", "participant name", "氏名", "名前", "お名前"},
+	"email":          {"e-mail", "email address", "e-mail address", "mail", "メール", "メールアドレス"},
+	"employee_id":    {"employee number", "staff id", "社員番号", "従業員番号"},
+	"phone":          {"phone number", "telephone", "tel", "電話番号", "電話"},
+	"status":         {"attendance", "ステータス", "出欠"},
+	"payment_status": {"paid", "支払状況", "支払いステータス"},
+	"payment_amount": {"amount", "金額", "支払金額"},
+	"payment_date":   {"paid at", "支払日"},
+	"metadata":       {"メタデータ"},
+}
+
+// aliasIndex maps each normalized column name and alias to its participant column.
+var aliasIndex = buildAliasIndex()
+
+func buildAliasIndex() map[string]string {
+	index := make(map[string]string)
+	for column, aliases := range columnAliases {
+		index[normalizeHeader(column)] = column
+		for _, alias := range aliases {
+			index[normalizeHeader(alias)] = column
+		}
+	}
+	return index
+}
+
+// normalizeHeader lowercases a header name and treats "_", "-" and runs of whitespace as
+// a single space, so "Email_Address" and "email address" compare equal.
+func normalizeHeader(h string) string {
+	h = strings.NewReplacer("_", " ", "-", " ").Replace(strings.ToLower(h))
+	return strings.Join(strings.Fields(h), " ")
+}
+
+// mapHeader maps the header columns to participant columns and custom field keys, returning
+// the index of each mapped column and the header columns that were ignored. When several header
+// columns map to the same column, the first one is used.
+func mapHeader(headers []string, opts HeaderOptions) (map[string]int, []string, error) {
+	customIndex := customFieldIndex(opts.CustomFields, opts.Mode)
+
+	mapping := make(map[string]string, len(opts.Mapping))
+	for header, column := range opts.Mapping {
+		if !importColumns[column] && !hasCustomField(opts.CustomFields, column) {
+			return nil, nil, fmt.Errorf("column mapping for %q targets unknown column %q", header, column)
+		}
+		mapping[normalizeHeader(header)] = column
+	}
+
+	colIndex := make(map[string]int, len(headers))
+	var ignored []string
+	for i, header := range headers {
+		column, ok := mapping[normalizeHeader(header)]
+		if !ok {
+			column, ok = matchColumn(header, opts.Mode, customIndex)
+		}
+		if _, taken := colIndex[column]; !ok || taken {
+			ignored = append(ignored, header)
+			continue
+		}
+		colIndex[column] = i
+	}
+	return colIndex, ignored, nil
+}
+
+// matchColumn returns the participant column or custom field key a header column names under
+// mode. Participant columns take precedence over custom fields.
+func matchColumn(header string, mode HeaderMode, customIndex map[string]string) (string, bool) {
+	if mode == HeaderModeAliases {
+		header = normalizeHeader(header)
+		if column, ok := aliasIndex[header]; ok {
+			return column, true
+		}
+	} else if importColumns[header] {
+		return header, true
+	}
+	key, ok := customIndex[header]
+	return key, ok
+}
+
+// hasCustomField reports whether one of the custom fields has the key.
+func hasCustomField(fields []entity.CustomField, key string) bool {
+	return slices.ContainsFunc(fields, func(field entity.CustomField) bool { return field.Key == key })
+}
+
+// customFieldIndex maps the header names accepted for each custom field under mode to its key:
+// the exact key in HeaderModeStrict, the normalized key and label in HeaderModeAliases. Keys take
+// precedence over labels.
+func customFieldIndex(fields []entity.CustomField, mode HeaderMode) map[string]string {
+	index := make(map[string]string, len(fields))
+	if mode != HeaderModeAliases {
+		for _, field := range fields {
+			index[field.Key] = field.Key
+		}
+		return index
+	}
+	for _, field := range fields {
+		index[normalizeHeader(field.Key)] = field.Key
+	}
+	for _, field := range fields {
+		if _, taken := index[normalizeHeader(field.Label)]; !taken {
+			index[normalizeHeader(field.Label)] = field.Key
+		}
+	}
+	return index
+}
+
+func quoteColumns(columns []string) string {
+	quoted := make([]string, len(columns))
+	for i, column := range columns {
+		quoted[i] = "'" + column + "'"
+	}
+	return strings.Join(quoted, ", ")
+}
@@ -0,0 +1,160 @@
+package csvparser_test
+
+import (
+	"strings"
+
+	"github.com/fumkob/ezqrin-server/internal/domain/entity"
+	"github.com/fumkob/ezqrin-server/pkg/csvparser"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ParseParticipantCSVWithOptions", func() {
+	parse := func(csv string, opts csvparser.HeaderOptions) (csvparser.ParseResult, error) {
+		return csvparser.ParseParticipantCSVWithOptions(strings.NewReader(csv), opts)
+	}
+
+	aliases := csvparser.HeaderOptions{Mode: csvparser.HeaderModeAliases}
+
+	When("matching aliases", func() {
+		It("should map aliases case-insensitively regardless of column order", func() {
+			result, err := parse("Phone Number,E-Mail Address,Full Name,Department\n"+
+				"+1-555-0123,jane@example.com,Jane Smith,Sales", aliases)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Inputs).To(HaveLen(1))
+			Expect(result.Inputs[0].Input.Name).To(Equal("Jane Smith"))
+			Expect(result.Inputs[0].Input.Email).To(Equal("jane@example.com"))
+			Expect(result.Inputs[0].Input.Phone).To(HaveValue(Equal("+1-555-0123")))
+			Expect(result.IgnoredColumns).To(Equal([]string{"Department"}))
+		})
+
+		It("should map Japanese aliases", func() {
+			result, err := parse("メールアドレス,氏名,社員番号\ntaro@example.com,山田太郎,EMP001", aliases)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Inputs).To(HaveLen(1))
+			Expect(result.Inputs[0].Input.Name).To(Equal("山田太郎"))
+			Expect(result.Inputs[0].Input.Email).To(Equal("taro@example.com"))
+			Expect(result.Inputs[0].Input.EmployeeID).To(HaveValue(Equal("EMP001")))
+		})
+
+		It("should use the first of several columns mapping to the same field", func() {
+			result, err := parse("Email,Mail,Name\nfirst@example.com,second@example.com,Jane", aliases)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Inputs[0].Input.Email).To(Equal("first@example.com"))
+			Expect(result.IgnoredColumns).To(Equal([]string{"Mail"}))
+		})
+	})
+
+	When("using the strict mode", func() {
+		It("should not accept aliases", func() {
+			_, err := parse("Full Name,Email Address\nJane Smith,jane@example.com",
+				csvparser.HeaderOptions{Mode: csvparser.HeaderModeStrict})
+
+			var headerErr *csvparser.HeaderError
+			Expect(err).To(BeAssignableToTypeOf(headerErr))
+			Expect(err.(*csvparser.HeaderError).Missing).To(Equal([]string{"name", "email"}))
+		})
+	})
+
+	When("an explicit mapping is given", func() {
+		It("should map the named headers ahead of the aliases", func() {
+			result, err := parse("Attendee,Work Email,Email\nJane Smith,jane@corp.example,jane@home.example",
+				csvparser.HeaderOptions{
+					Mode:    csvparser.HeaderModeAliases,
+					Mapping: map[string]string{"attendee": "name", "WORK EMAIL": "email"},
+				})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Inputs[0].Input.Name).To(Equal("Jane Smith"))
+			Expect(result.Inputs[0].Input.Email).To(Equal("jane@corp.example"))
+			Expect(result.IgnoredColumns).To(Equal([]string{"Email"}))
+		})
+
+		It("should reject a mapping to an unknown column", func() {
+			_, err := parse("Attendee,Email\nJane Smith,jane@example.com",
+				csvparser.HeaderOptions{Mapping: map[string]string{"Attendee": "full_name"}})
+
+			Expect(err).To(MatchError(ContainSubstring(`unknown column "full_name"`)))
+		})
+	})
+
+	When("the event has custom fields", func() {
+		customFields := []entity.CustomField{
+			{Key: "company", Label: "Company Name", Type: entity.CustomFieldTypeText},
+			{Key: "tshirt_size", Label: "T-shirt size", Type: entity.CustomFieldTypeSelect, Options: []string{"S", "M"}},
+			{Key: "age", Label: "Age", Type: entity.CustomFieldTypeNumber},
+		}
+
+		It("should read the columns named after their keys into the custom data", func() {
+			result, err := parse("name,email,company,tshirt_size,age,department\n"+
+				"Jane Smith,jane@example.com,Acme,M,30,Sales\n"+
+				"John Doe,john@example.com,,S,,Sales",
+				csvparser.HeaderOptions{Mode: csvparser.HeaderModeStrict, CustomFields: customFields})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Inputs).To(HaveLen(2))
+			Expect(result.Inputs[0].Input.CustomData).To(Equal(
+				map[string]any{"company": "Acme", "tshirt_size": "M", "age": float64(30)},
+			))
+			Expect(result.Inputs[1].Input.CustomData).To(Equal(map[string]any{"tshirt_size": "S"}))
+			Expect(result.IgnoredColumns).To(Equal([]string{"department"}))
+		})
+
+		It("should match the labels of the fields with aliases", func() {
+			result, err := parse("Full Name,Email,Company Name,T-Shirt Size\nJane Smith,jane@example.com,Acme,M",
+				csvparser.HeaderOptions{Mode: csvparser.HeaderModeAliases, CustomFields: customFields})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Inputs[0].Input.CustomData).To(Equal(map[string]any{"company": "Acme", "tshirt_size": "M"}))
+			Expect(result.IgnoredColumns).To(BeEmpty())
+		})
+
+		It("should not match the labels in the strict mode", func() {
+			result, err := parse("name,email,Company Name\nJane Smith,jane@example.com,Acme",
+				csvparser.HeaderOptions{Mode: csvparser.HeaderModeStrict, CustomFields: customFields})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Inputs[0].Input.CustomData).To(BeNil())
+			Expect(result.IgnoredColumns).To(Equal([]string{"Company Name"}))
+		})
+
+		It("should accept an explicit mapping to a field", func() {
+			result, err := parse("name,email,Organisation\nJane Smith,jane@example.com,Acme",
+				csvparser.HeaderOptions{
+					Mapping:      map[string]string{"Organisation": "company"},
+					CustomFields: customFields,
+				})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Inputs[0].Input.CustomData).To(Equal(map[string]any{"company": "Acme"}))
+		})
+
+		It("should report a row with a value that does not fit its field", func() {
+			result, err := parse("name,email,age\nJane Smith,jane@example.com,thirty",
+				csvparser.HeaderOptions{Mode: csvparser.HeaderModeStrict, CustomFields: customFields})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Inputs).To(BeEmpty())
+			Expect(result.RowErrors).To(HaveLen(1))
+			Expect(result.RowErrors[0].Message).To(ContainSubstring(`invalid age "thirty"`))
+		})
+	})
+
+	When("required columns are missing", func() {
+		It("should list the missing and the ignored columns", func() {
+			_, err := parse("Full Name,Department,Notes\nJane Smith,Sales,VIP", aliases)
+
+			Expect(err).To(HaveOccurred())
+			headerErr, ok := err.(*csvparser.HeaderError)
+			Expect(ok).To(BeTrue())
+			Expect(headerErr.Missing).To(Equal([]string{"email"}))
+			Expect(headerErr.Ignored).To(Equal([]string{"Department", "Notes"}))
+			Expect(err.Error()).To(Equal(
+				"CSV header is missing required columns 'email'; ignored unknown columns 'Department', 'Notes'",
+			))
+		})
+	})
+})
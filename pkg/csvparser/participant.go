@@ -11,6 +11,8 @@ import (
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/internal/usecase/participant"
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	"github.com/google/uuid"
 )
 
 // ParsedInput holds a parsed CSV row with its original 1-based row number.
@@ -39,46 +41,81 @@ func stripBOM(r io.Reader) io.Reader {
 	return br
 }
 
-// ParseParticipantCSV parses a CSV reader into participant inputs.
+// ParseParticipantCSV parses a CSV reader into participant inputs, matching the header row
+// strictly against the participant column names.
 // Returns (parsedInputs, rowErrors, fileError).
 // fileError is non-nil for structural issues (empty file, missing required columns).
 // rowErrors collects per-row parse issues; valid rows are still returned in parsedInputs.
 func ParseParticipantCSV(r io.Reader) ([]ParsedInput, []RowError, error) {
+	result, err := ParseParticipantCSVWithOptions(r, HeaderOptions{Mode: HeaderModeStrict})
+	if err != nil {
+		return nil, nil, err
+	}
+	return result.Inputs, result.RowErrors, nil
+}
+
+// ParseResult holds the outcome of parsing a participant CSV.
+type ParseResult struct {
+	Inputs    []ParsedInput
+	RowErrors []RowError
+	// IgnoredColumns lists the header columns that were not mapped to a participant column
+	IgnoredColumns []string
+}
+
+// ParseParticipantCSVWithOptions parses a CSV reader into participant inputs, mapping the
+// header row to participant columns as configured by opts.
+// A *HeaderError is returned when required columns cannot be mapped.
+func ParseParticipantCSVWithOptions(r io.Reader, opts HeaderOptions) (ParseResult, error) {
 	reader := csv.NewReader(stripBOM(r))
 	reader.TrimLeadingSpace = true
 
-	colIndex, err := readAndValidateHeader(reader)
+	colIndex, ignored, err := readAndMapHeader(reader, opts)
 	if err != nil {
-		return nil, nil, err
+		return ParseResult{}, err
 	}
 
-	return readDataRows(reader, colIndex)
+	inputs, rowErrors, err := readDataRows(reader, colIndex, opts.CustomFields)
+	if err != nil {
+		return ParseResult{}, err
+	}
+	return ParseResult{Inputs: inputs, RowErrors: rowErrors, IgnoredColumns: ignored}, nil
 }
 
-// readAndValidateHeader reads the CSV header and validates required columns.
-func readAndValidateHeader(reader *csv.Reader) (map[string]int, error) {
+// readAndMapHeader reads the CSV header, maps it to participant columns and validates that
+// the required columns are present.
+func readAndMapHeader(reader *csv.Reader, opts HeaderOptions) (map[string]int, []string, error) {
 	headers, err := reader.Read()
 	if err == io.EOF {
-		return nil, fmt.Errorf("CSV file is empty")
+		return nil, nil, fmt.Errorf("CSV file is empty")
 	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to read CSV header: %w", err)
+		return nil, nil, fmt.Errorf("failed to read CSV header: %w", err)
 	}
 
-	colIndex := buildColumnIndex(headers)
+	colIndex, ignored, err := mapHeader(headers, opts)
+	if err != nil {
+		return nil, nil, err
+	}
 
-	if _, ok := colIndex["name"]; !ok {
-		return nil, fmt.Errorf("required column 'name' not found in CSV header")
+	var missing []string
+	for _, column := range requiredColumns {
+		if _, ok := colIndex[column]; !ok {
+			missing = append(missing, column)
+		}
 	}
-	if _, ok := colIndex["email"]; !ok {
-		return nil, fmt.Errorf("required column 'email' not found in CSV header")
+	if len(missing) > 0 {
+		return nil, nil, &HeaderError{Missing: missing, Ignored: ignored}
 	}
 
-	return colIndex, nil
+	return colIndex, ignored, nil
 }
 
 // readDataRows reads all data rows from the CSV and returns parsed inputs and row errors.
-func readDataRows(reader *csv.Reader, colIndex map[string]int) ([]ParsedInput, []RowError, error) {
+func readDataRows(
+	reader *csv.Reader,
+	colIndex map[string]int,
+	customFields []entity.CustomField,
+) ([]ParsedInput, []RowError, error) {
 	var inputs []ParsedInput
 	var rowErrors []RowError
 	csvRowNum := 1 // 1 for header row
@@ -95,7 +132,7 @@ func readDataRows(reader *csv.Reader, colIndex map[string]int) ([]ParsedInput, [
 
 		dataRowNum := csvRowNum - 1 // 1-based data row number (excluding header)
 		email := getField(colIndex, row, "email")
-		input, parseErr := parseRow(colIndex, row)
+		input, parseErr := parseRow(colIndex, row, customFields)
 		if parseErr != nil {
 			rowErrors = append(rowErrors, RowError{
 				Row:     dataRowNum,
@@ -114,15 +151,6 @@ func readDataRows(reader *csv.Reader, colIndex map[string]int) ([]ParsedInput, [
 	return inputs, rowErrors, nil
 }
 
-// buildColumnIndex maps lowercase column name to index in header slice.
-func buildColumnIndex(headers []string) map[string]int {
-	index := make(map[string]int, len(headers))
-	for i, h := range headers {
-		index[h] = i
-	}
-	return index
-}
-
 // getField returns the value at the named column, or "" if out of range or column not present.
 func getField(colIndex map[string]int, row []string, name string) string {
 	i, ok := colIndex[name]
@@ -152,9 +180,9 @@ const (
 
 // csvExportHeaders defines the column order for CSV export.
 var csvExportHeaders = []string{
-	"id", "name", "email", "employee_id", "phone", "qr_email",
+	"id", "name", "email", "employee_id", "phone", "qr_email", "guest_of",
 	"status", "qr_code", "qr_code_generated_at", "qr_distribution_url",
-	"payment_status", "payment_amount", "payment_date",
+	"payment_status", "payment_amount", "payment_currency", "payment_date",
 	"checked_in", "checked_in_at", "metadata", "created_at", "updated_at",
 }
 
@@ -191,12 +219,14 @@ func participantToCSVRow(p *entity.Participant, format StatusFormat) []string {
 		derefStr(p.EmployeeID),
 		derefStr(p.Phone),
 		derefStr(p.QREmail),
+		formatExportGuestOf(p.GuestOf),
 		statusStr,
 		p.QRCode,
 		p.QRCodeGeneratedAt.UTC().Format(time.RFC3339),
 		p.QRDistributionURL,
 		string(p.PaymentStatus),
-		formatExportFloat(p.PaymentAmount),
+		formatExportAmount(p.PaymentAmount),
+		formatExportCurrency(p),
 		formatExportTime(p.PaymentDate),
 		strconv.FormatBool(p.CheckedIn),
 		formatExportTime(p.CheckedInAt),
@@ -213,11 +243,29 @@ func derefStr(s *string) string {
 	return *s
 }
 
-func formatExportFloat(f *float64) string {
-	if f == nil {
+// formatExportGuestOf returns the ID of the registrant a guest belongs to, or an empty string
+// for participants who are not guests.
+func formatExportGuestOf(guestOf *uuid.UUID) string {
+	if guestOf == nil {
+		return ""
+	}
+	return guestOf.String()
+}
+
+func formatExportAmount(a *money.Amount) string {
+	if a == nil {
+		return ""
+	}
+	return a.String()
+}
+
+// formatExportCurrency returns the participant's payment currency, or an empty string
+// when no payment amount is recorded.
+func formatExportCurrency(p *entity.Participant) string {
+	if p.PaymentAmount == nil {
 		return ""
 	}
-	return strconv.FormatFloat(*f, 'f', -1, 64)
+	return p.PaymentCurrency
 }
 
 func formatExportTime(t *time.Time) string {
@@ -234,8 +282,13 @@ func formatExportMetadata(m *json.RawMessage) string {
 	return string(*m)
 }
 
-// parseRow converts a CSV row into a CreateParticipantInput.
-func parseRow(colIndex map[string]int, row []string) (participant.CreateParticipantInput, error) {
+// parseRow converts a CSV row into a CreateParticipantInput. The columns of custom fields are
+// read into its custom data; empty cells are left out.
+func parseRow(
+	colIndex map[string]int,
+	row []string,
+	customFields []entity.CustomField,
+) (participant.CreateParticipantInput, error) {
 	input := participant.CreateParticipantInput{
 		Name:          getField(colIndex, row, "name"),
 		Email:         getField(colIndex, row, "email"),
@@ -253,7 +306,7 @@ func parseRow(colIndex map[string]int, row []string) (participant.CreateParticip
 	}
 
 	if s := getField(colIndex, row, "payment_amount"); s != "" {
-		v, err := strconv.ParseFloat(s, 64)
+		v, err := money.Parse(s)
 		if err != nil {
 			return participant.CreateParticipantInput{}, fmt.Errorf("invalid payment_amount %q: %w", s, err)
 		}
@@ -273,6 +326,21 @@ func parseRow(colIndex map[string]int, row []string) (participant.CreateParticip
 		input.Metadata = &s
 	}
 
+	for _, field := range customFields {
+		s := getField(colIndex, row, field.Key)
+		if s == "" {
+			continue
+		}
+		v, err := field.ParseValue(s)
+		if err != nil {
+			return participant.CreateParticipantInput{}, fmt.Errorf("invalid %s %q: %w", field.Key, s, err)
+		}
+		if input.CustomData == nil {
+			input.CustomData = make(map[string]any)
+		}
+		input.CustomData[field.Key] = v
+	}
+
 	return input, nil
 }
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/fumkob/ezqrin-server/internal/domain/entity"
 	"github.com/fumkob/ezqrin-server/pkg/csvparser"
+	"github.com/fumkob/ezqrin-server/pkg/money"
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -30,7 +31,7 @@ John Doe,john@example.com,,,tentative,unpaid,,,`
 				Expect(inputs[0].Input.Email).To(Equal("jane@example.com"))
 				Expect(inputs[0].Input.EmployeeID).To(HaveValue(Equal("EMP001")))
 				Expect(inputs[0].Input.Phone).To(HaveValue(Equal("+1-555-0123")))
-				Expect(inputs[0].Input.PaymentAmount).To(HaveValue(BeNumerically("==", 150.0)))
+				Expect(inputs[0].Input.PaymentAmount).To(HaveValue(Equal(money.FromMinorUnits(15000))))
 				Expect(inputs[0].Input.PaymentDate).NotTo(BeNil())
 				Expect(inputs[1].Row).To(Equal(2))
 				Expect(inputs[1].Input.Name).To(Equal("John Doe"))
@@ -169,7 +170,7 @@ var _ = Describe("ExportParticipantCSV", func() {
 				empID := "EMP001"
 				phone := "+81-90-1234-5678"
 				meta := json.RawMessage(`{"foo":"bar"}`)
-				amount := 1000.0
+				amount := money.FromMinorUnits(100000)
 				checkedInAt := now
 
 				p := &entity.Participant{
@@ -185,6 +186,7 @@ var _ = Describe("ExportParticipantCSV", func() {
 					QRDistributionURL: "https://example.com/qr",
 					PaymentStatus:     entity.PaymentPaid,
 					PaymentAmount:     &amount,
+					PaymentCurrency:   "JPY",
 					PaymentDate:       &now,
 					Metadata:          &meta,
 					CreatedAt:         now,
@@ -200,14 +202,15 @@ var _ = Describe("ExportParticipantCSV", func() {
 				lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
 				Expect(lines).To(HaveLen(2))
 				Expect(lines[0]).To(Equal(
-					"id,name,email,employee_id,phone,qr_email,status,qr_code," +
+					"id,name,email,employee_id,phone,qr_email,guest_of,status,qr_code," +
 						"qr_code_generated_at,qr_distribution_url,payment_status," +
-						"payment_amount,payment_date,checked_in,checked_in_at,metadata," +
+						"payment_amount,payment_currency,payment_date,checked_in,checked_in_at,metadata," +
 						"created_at,updated_at",
 				))
 				Expect(lines[1]).To(ContainSubstring("alice@example.com"))
 				Expect(lines[1]).To(ContainSubstring("confirmed"))
 				Expect(lines[1]).To(ContainSubstring("true"))
+				Expect(lines[1]).To(ContainSubstring(",1000.00,JPY,"))
 			})
 		})
 
@@ -268,7 +271,28 @@ var _ = Describe("ExportParticipantCSV", func() {
 				lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
 				Expect(lines).To(HaveLen(2))
 				fields := strings.Split(lines[1], ",")
-				Expect(fields).To(HaveLen(18)) // 18 columns
+				Expect(fields).To(HaveLen(20)) // 20 columns
+			})
+		})
+
+		Context("with a guest", func() {
+			It("should write the registrant in the guest_of column", func() {
+				registrantID := uuid.MustParse("00000000-0000-0000-0000-000000000001")
+				guest := &entity.Participant{
+					ID:      uuid.MustParse("00000000-0000-0000-0000-000000000002"),
+					GuestOf: &registrantID,
+					Name:    "Guest", Status: entity.ParticipantStatusConfirmed, QRCode: "qr-guest",
+					PaymentStatus: entity.PaymentUnpaid, CreatedAt: time.Now(), UpdatedAt: time.Now(),
+				}
+
+				var buf strings.Builder
+				err := csvparser.ExportParticipantCSV(&buf, []*entity.Participant{guest}, csvparser.StatusFormatEnglish)
+				Expect(err).NotTo(HaveOccurred())
+
+				lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+				Expect(lines).To(HaveLen(2))
+				fields := strings.Split(lines[1], ",")
+				Expect(fields[6]).To(Equal(registrantID.String()))
 			})
 		})
 
@@ -31,6 +31,7 @@
 package errors
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -50,6 +51,8 @@ const (
 	CodeBadRequest         = "BAD_REQUEST"
 	CodeTooManyRequests    = "TOO_MANY_REQUESTS"
 	CodeServiceUnavailable = "SERVICE_UNAVAILABLE"
+	CodeUnprocessable      = "UNPROCESSABLE_ENTITY"
+	CodePayloadTooLarge    = "PAYLOAD_TOO_LARGE"
 )
 
 // ProblemTypeBaseURL is the base URL for RFC 9457 problem type URIs.
@@ -69,6 +72,8 @@ var ErrorTitles = map[string]string{
 	CodeBadRequest:         "Bad Request",
 	CodeTooManyRequests:    "Too Many Requests",
 	CodeServiceUnavailable: "Service Unavailable",
+	CodeUnprocessable:      "Unprocessable Entity",
+	CodePayloadTooLarge:    "Payload Too Large",
 }
 
 // ValidationError is an alias for the OpenAPI-generated ValidationError type.
@@ -241,6 +246,25 @@ func ServiceUnavailable(message string) *AppError {
 	}
 }
 
+// Unprocessable creates a 422 Unprocessable Entity error for well-formed requests
+// that cannot be carried out in the current state of the resource
+func Unprocessable(message string) *AppError {
+	return &AppError{
+		Code:       CodeUnprocessable,
+		Message:    message,
+		StatusCode: http.StatusUnprocessableEntity,
+	}
+}
+
+// PayloadTooLarge creates a 413 Content Too Large error for request bodies over their size limit
+func PayloadTooLarge(message string) *AppError {
+	return &AppError{
+		Code:       CodePayloadTooLarge,
+		Message:    message,
+		StatusCode: http.StatusRequestEntityTooLarge,
+	}
+}
+
 // Wrap wraps an error with additional context while preserving the original error.
 // Uses %w to maintain the error chain, enabling errors.Is and errors.As to traverse
 // and check for specific error types even after multiple wrapping operations.
@@ -269,7 +293,7 @@ func WrapAppError(appErr *AppError, err error) *AppError {
 	return appErr
 }
 
-// GetStatusCode extracts HTTP status code from error, defaults to 500
+// GetStatusCode extracts HTTP status code from error, defaults to 503 for timeouts and 500 otherwise
 func GetStatusCode(err error) int {
 	if err == nil {
 		return http.StatusOK
@@ -279,11 +303,15 @@ func GetStatusCode(err error) int {
 	if errors.As(err, &appErr) {
 		return appErr.StatusCode
 	}
+	if IsTimeout(err) {
+		return http.StatusServiceUnavailable
+	}
 
 	return http.StatusInternalServerError
 }
 
-// GetErrorCode extracts error code from error, defaults to INTERNAL_ERROR
+// GetErrorCode extracts error code from error, defaults to SERVICE_UNAVAILABLE for timeouts
+// and INTERNAL_ERROR otherwise
 func GetErrorCode(err error) string {
 	if err == nil {
 		return ""
@@ -293,10 +321,19 @@ func GetErrorCode(err error) string {
 	if errors.As(err, &appErr) {
 		return appErr.Code
 	}
+	if IsTimeout(err) {
+		return CodeServiceUnavailable
+	}
 
 	return CodeInternal
 }
 
+// IsTimeout checks if error wraps an expired deadline, e.g. a database query that exceeded its
+// timeout. Cancellations by the caller are not timeouts.
+func IsTimeout(err error) bool {
+	return errors.Is(err, context.DeadlineExceeded)
+}
+
 // IsNotFound checks if error is a NotFound error
 func IsNotFound(err error) bool {
 	var appErr *AppError
@@ -367,3 +404,54 @@ func (e *AppError) WithValidationErrors(errors []ValidationError) *AppError {
 	e.ValidationErrors = errors
 	return e
 }
+
+// FieldErrors collects the field-level failures of validating a request, so that all of them
+// are reported at once instead of only the first.
+//
+// Example usage:
+//
+//	var fields errors.FieldErrors
+//	fields.Add("email", "invalid email format")
+//	fields.AddError(entityErr, fieldOf) // one entry per error joined by errors.Join
+//	if err := fields.Err(); err != nil {
+//		return err // 400 listing every failure
+//	}
+type FieldErrors []ValidationError
+
+// Add records a failure of field. An empty field marks a failure of the request as a whole.
+func (f *FieldErrors) Add(field, message string) {
+	*f = append(*f, ValidationError{Field: field, Message: message})
+}
+
+// AddError records err as failures: one for each error joined by errors.Join, or err itself,
+// each of the field that fieldOf names. A nil err records nothing.
+func (f *FieldErrors) AddError(err error, fieldOf func(error) string) {
+	if err == nil {
+		return
+	}
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		for _, e := range joined.Unwrap() {
+			f.AddError(e, fieldOf)
+		}
+		return
+	}
+	f.Add(fieldOf(err), err.Error())
+}
+
+// Summary joins the messages of the failures into one, for the detail of the response.
+func (f FieldErrors) Summary() string {
+	messages := make([]string, len(f))
+	for i, e := range f {
+		messages[i] = e.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Err returns a validation error listing the failures and summarizing them in its message, or
+// nil if there are none.
+func (f FieldErrors) Err() error {
+	if len(f) == 0 {
+		return nil
+	}
+	return Validation(f.Summary()).WithValidationErrors(f)
+}
@@ -1,6 +1,7 @@
 package errors_test
 
 import (
+	"context"
 	"errors"
 	"net/http"
 
@@ -183,6 +184,28 @@ var _ = Describe("AppError", func() {
 				Expect(err.StatusCode).To(Equal(http.StatusServiceUnavailable))
 			})
 		})
+
+		Context("with Unprocessable constructor", func() {
+			It("should create unprocessable entity error", func() {
+				err := pkgerrors.Unprocessable("consent has not been accepted")
+
+				Expect(err).NotTo(BeNil())
+				Expect(err.Code).To(Equal(pkgerrors.CodeUnprocessable))
+				Expect(err.Message).To(Equal("consent has not been accepted"))
+				Expect(err.StatusCode).To(Equal(http.StatusUnprocessableEntity))
+			})
+		})
+
+		Context("with PayloadTooLarge constructor", func() {
+			It("should create payload too large error", func() {
+				err := pkgerrors.PayloadTooLarge("request body exceeds the limit of 1048576 bytes")
+
+				Expect(err).NotTo(BeNil())
+				Expect(err.Code).To(Equal(pkgerrors.CodePayloadTooLarge))
+				Expect(err.Message).To(Equal("request body exceeds the limit of 1048576 bytes"))
+				Expect(err.StatusCode).To(Equal(http.StatusRequestEntityTooLarge))
+			})
+		})
 	})
 
 	When("formatting error messages", func() {
@@ -384,6 +407,22 @@ var _ = Describe("AppError", func() {
 				Expect(statusCode).To(Equal(http.StatusInternalServerError))
 			})
 
+			It("should return 503 for a wrapped timeout", func() {
+				err := pkgerrors.Wrap(context.DeadlineExceeded, "failed to find event")
+
+				statusCode := pkgerrors.GetStatusCode(err)
+
+				Expect(statusCode).To(Equal(http.StatusServiceUnavailable))
+			})
+
+			It("should return 500 for a cancellation", func() {
+				err := pkgerrors.Wrap(context.Canceled, "failed to find event")
+
+				statusCode := pkgerrors.GetStatusCode(err)
+
+				Expect(statusCode).To(Equal(http.StatusInternalServerError))
+			})
+
 			It("should return 200 for nil error", func() {
 				statusCode := pkgerrors.GetStatusCode(nil)
 
@@ -427,6 +466,14 @@ var _ = Describe("AppError", func() {
 				Expect(code).To(Equal(pkgerrors.CodeInternal))
 			})
 
+			It("should return SERVICE_UNAVAILABLE for a wrapped timeout", func() {
+				err := pkgerrors.Wrap(context.DeadlineExceeded, "failed to find event")
+
+				code := pkgerrors.GetErrorCode(err)
+
+				Expect(code).To(Equal(pkgerrors.CodeServiceUnavailable))
+			})
+
 			It("should return empty string for nil error", func() {
 				code := pkgerrors.GetErrorCode(nil)
 
@@ -472,6 +519,22 @@ var _ = Describe("AppError", func() {
 			})
 		})
 
+		Context("with IsTimeout function", func() {
+			It("should return true for a wrapped deadline expiry", func() {
+				err := pkgerrors.Wrap(context.DeadlineExceeded, "failed to find event")
+
+				Expect(pkgerrors.IsTimeout(err)).To(BeTrue())
+			})
+
+			It("should return false for a cancellation", func() {
+				Expect(pkgerrors.IsTimeout(context.Canceled)).To(BeFalse())
+			})
+
+			It("should return false for a not found error", func() {
+				Expect(pkgerrors.IsTimeout(pkgerrors.NotFound("event not found"))).To(BeFalse())
+			})
+		})
+
 		Context("with IsValidation function", func() {
 			It("should return true for Validation error", func() {
 				err := pkgerrors.Validation("invalid")
@@ -590,4 +653,41 @@ var _ = Describe("AppError", func() {
 			})
 		})
 	})
+
+	When("collecting field errors", func() {
+		errNameRequired := errors.New("name is required")
+		fieldOf := func(err error) string {
+			if errors.Is(err, errNameRequired) {
+				return "name"
+			}
+			return "email"
+		}
+
+		It("should report no error when nothing was added", func() {
+			var fields pkgerrors.FieldErrors
+			fields.AddError(nil, fieldOf)
+
+			Expect(fields.Err()).To(BeNil())
+		})
+
+		It("should list every failure in a validation error summarizing them", func() {
+			var fields pkgerrors.FieldErrors
+			fields.Add("password", "password must be at least 8 characters")
+			fields.AddError(errors.Join(errNameRequired, errors.New("invalid email format")), fieldOf)
+
+			err := fields.Err()
+
+			Expect(pkgerrors.IsValidation(err)).To(BeTrue())
+			var appErr *pkgerrors.AppError
+			Expect(errors.As(err, &appErr)).To(BeTrue())
+			Expect(appErr.Message).To(Equal(
+				"password must be at least 8 characters; name is required; invalid email format",
+			))
+			Expect(appErr.ValidationErrors).To(Equal([]pkgerrors.ValidationError{
+				{Field: "password", Message: "password must be at least 8 characters"},
+				{Field: "name", Message: "name is required"},
+				{Field: "email", Message: "invalid email format"},
+			}))
+		})
+	})
 })
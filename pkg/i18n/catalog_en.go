@@ -0,0 +1,49 @@
+package i18n
+
+// catalogEN holds the English messages for common error codes.
+var catalogEN = map[string]Message{
+	"NOT_FOUND": {
+		Title:  "Resource Not Found",
+		Detail: "The requested resource was not found.",
+	},
+	"VALIDATION_ERROR": {
+		Title:  "Validation Error",
+		Detail: "The request contains invalid values.",
+	},
+	"UNAUTHORIZED": {
+		Title:  "Unauthorized",
+		Detail: "Authentication is required to access this resource.",
+	},
+	"FORBIDDEN": {
+		Title:  "Forbidden",
+		Detail: "You do not have permission to perform this action.",
+	},
+	"INTERNAL_ERROR": {
+		Title:  "Internal Server Error",
+		Detail: "An unexpected error occurred. Please try again later.",
+	},
+	"CONFLICT": {
+		Title:  "Conflict",
+		Detail: "The request conflicts with the current state of the resource.",
+	},
+	"BAD_REQUEST": {
+		Title:  "Bad Request",
+		Detail: "The request could not be processed.",
+	},
+	"TOO_MANY_REQUESTS": {
+		Title:  "Too Many Requests",
+		Detail: "Too many requests. Please wait a moment and try again.",
+	},
+	"SERVICE_UNAVAILABLE": {
+		Title:  "Service Unavailable",
+		Detail: "The service is temporarily unavailable. Please try again later.",
+	},
+	"UNPROCESSABLE_ENTITY": {
+		Title:  "Unprocessable Entity",
+		Detail: "The request cannot be carried out in the current state of the resource.",
+	},
+	"PAYLOAD_TOO_LARGE": {
+		Title:  "Payload Too Large",
+		Detail: "The request body is larger than the server accepts.",
+	},
+}
@@ -0,0 +1,49 @@
+package i18n
+
+// catalogJA holds the Japanese messages for common error codes.
+var catalogJA = map[string]Message{
+	"NOT_FOUND": {
+		Title:  "リソースが見つかりません",
+		Detail: "指定されたリソースは見つかりませんでした。",
+	},
+	"VALIDATION_ERROR": {
+		Title:  "入力エラー",
+		Detail: "入力内容に誤りがあります。",
+	},
+	"UNAUTHORIZED": {
+		Title:  "認証が必要です",
+		Detail: "このリソースにアクセスするにはログインが必要です。",
+	},
+	"FORBIDDEN": {
+		Title:  "アクセス権限がありません",
+		Detail: "この操作を行う権限がありません。",
+	},
+	"INTERNAL_ERROR": {
+		Title:  "サーバーエラー",
+		Detail: "予期しないエラーが発生しました。しばらくしてから再度お試しください。",
+	},
+	"CONFLICT": {
+		Title:  "競合が発生しました",
+		Detail: "リソースの現在の状態と競合するため、処理できませんでした。",
+	},
+	"BAD_REQUEST": {
+		Title:  "不正なリクエスト",
+		Detail: "リクエストを処理できませんでした。",
+	},
+	"TOO_MANY_REQUESTS": {
+		Title:  "リクエストが多すぎます",
+		Detail: "リクエストが多すぎます。しばらく待ってから再度お試しください。",
+	},
+	"SERVICE_UNAVAILABLE": {
+		Title:  "サービス利用不可",
+		Detail: "現在サービスを利用できません。しばらくしてから再度お試しください。",
+	},
+	"UNPROCESSABLE_ENTITY": {
+		Title:  "処理できないリクエスト",
+		Detail: "リソースの現在の状態では、このリクエストを処理できません。",
+	},
+	"PAYLOAD_TOO_LARGE": {
+		Title:  "リクエストが大きすぎます",
+		Detail: "リクエストの本文がサーバーで受け付けられるサイズを超えています。",
+	},
+}
@@ -0,0 +1,119 @@
+// Package i18n provides message catalogs for localizing API error messages.
+//
+// Catalogs are keyed by the machine-readable error code (e.g. "NOT_FOUND") so that the
+// code in a response stays stable while its title and detail follow the client's locale.
+// The locale is negotiated from an Accept-Language header against the supported catalogs,
+// falling back to a configured default and finally to English.
+//
+// Example usage:
+//
+//	locale := i18n.Negotiate("ja-JP, ja;q=0.9, en;q=0.8", i18n.DefaultLocale) // "ja"
+//	if msg, ok := i18n.Lookup(locale, "NOT_FOUND"); ok {
+//		fmt.Println(msg.Title, msg.Detail)
+//	}
+package i18n
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale is the locale of messages written in code and the final fallback.
+const DefaultLocale = "en"
+
+// ContextKey is the key under which the negotiated locale is stored in a request context.
+const ContextKey = "locale"
+
+// Message is a localized error message.
+type Message struct {
+	Title  string // Short summary of the problem type
+	Detail string // Generic explanation, used when the original message cannot be shown
+}
+
+// catalogs maps a locale to its messages keyed by error code.
+var catalogs = map[string]map[string]Message{
+	"en": catalogEN,
+	"ja": catalogJA,
+}
+
+// IsSupported reports whether a catalog exists for locale.
+func IsSupported(locale string) bool {
+	_, ok := catalogs[locale]
+	return ok
+}
+
+// SupportedLocales returns the supported locales in sorted order.
+func SupportedLocales() []string {
+	locales := make([]string, 0, len(catalogs))
+	for locale := range catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Lookup returns the message for code in locale. It reports false if the locale is
+// unsupported or its catalog has no entry for code.
+func Lookup(locale, code string) (Message, bool) {
+	msg, ok := catalogs[locale][code]
+	return msg, ok
+}
+
+// languageRange is a single entry of an Accept-Language header.
+type languageRange struct {
+	tag     string
+	quality float64
+}
+
+// Negotiate selects the best supported locale for an Accept-Language header value.
+// Ranges are tried in order of quality; a region-qualified tag such as "ja-JP" matches
+// its base language. If nothing matches, fallback is returned when supported, otherwise
+// DefaultLocale.
+func Negotiate(acceptLanguage, fallback string) string {
+	for _, r := range parseAcceptLanguage(acceptLanguage) {
+		if IsSupported(r.tag) {
+			return r.tag
+		}
+		if base, _, found := strings.Cut(r.tag, "-"); found && IsSupported(base) {
+			return base
+		}
+	}
+
+	if IsSupported(fallback) {
+		return fallback
+	}
+	return DefaultLocale
+}
+
+// parseAcceptLanguage parses an Accept-Language header into ranges sorted by descending
+// quality. Ranges with a zero or malformed quality and the "*" wildcard are dropped.
+func parseAcceptLanguage(header string) []languageRange {
+	var ranges []languageRange
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(q, 64)
+			if err != nil {
+				continue
+			}
+			quality = parsed
+		}
+		if quality <= 0 {
+			continue
+		}
+
+		ranges = append(ranges, languageRange{tag: tag, quality: quality})
+	}
+
+	sort.SliceStable(ranges, func(i, j int) bool {
+		return ranges[i].quality > ranges[j].quality
+	})
+	return ranges
+}
@@ -0,0 +1,63 @@
+package i18n_test
+
+import (
+	"github.com/fumkob/ezqrin-server/pkg/i18n"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("I18n", func() {
+	DescribeTable("Negotiate",
+		func(header, fallback, expected string) {
+			Expect(i18n.Negotiate(header, fallback)).To(Equal(expected))
+		},
+		Entry("exact match", "ja", "en", "ja"),
+		Entry("region-qualified tag matches base language", "ja-JP", "en", "ja"),
+		Entry("case-insensitive tag", "JA-jp", "en", "ja"),
+		Entry("highest quality wins regardless of order", "en;q=0.5, ja;q=0.9", "en", "ja"),
+		Entry("unsupported preferred language is skipped", "fr-FR, ja;q=0.8", "en", "ja"),
+		Entry("zero quality excludes a language", "ja;q=0, en;q=0.5", "ja", "en"),
+		Entry("empty header uses fallback", "", "ja", "ja"),
+		Entry("wildcard uses fallback", "*", "ja", "ja"),
+		Entry("unknown locale uses fallback", "de-DE, fr", "ja", "ja"),
+		Entry("malformed quality is ignored", "ja;q=abc, en", "ja", "en"),
+		Entry("unsupported fallback uses default locale", "de", "xx", i18n.DefaultLocale),
+	)
+
+	Describe("Lookup", func() {
+		It("should return the localized message for a known code", func() {
+			msg, ok := i18n.Lookup("ja", "NOT_FOUND")
+			Expect(ok).To(BeTrue())
+			Expect(msg.Title).To(Equal("リソースが見つかりません"))
+		})
+
+		It("should report an unknown code", func() {
+			_, ok := i18n.Lookup("ja", "NO_SUCH_CODE")
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should report an unsupported locale", func() {
+			_, ok := i18n.Lookup("de", "NOT_FOUND")
+			Expect(ok).To(BeFalse())
+		})
+	})
+
+	Describe("catalogs", func() {
+		It("should cover the same codes in every supported locale", func() {
+			codes := []string{
+				"NOT_FOUND", "VALIDATION_ERROR", "UNAUTHORIZED", "FORBIDDEN", "INTERNAL_ERROR",
+				"CONFLICT", "BAD_REQUEST", "TOO_MANY_REQUESTS", "SERVICE_UNAVAILABLE", "UNPROCESSABLE_ENTITY",
+				"PAYLOAD_TOO_LARGE",
+			}
+			Expect(i18n.SupportedLocales()).To(Equal([]string{"en", "ja"}))
+			for _, locale := range i18n.SupportedLocales() {
+				for _, code := range codes {
+					msg, ok := i18n.Lookup(locale, code)
+					Expect(ok).To(BeTrue(), "%s is missing %s", locale, code)
+					Expect(msg.Title).NotTo(BeEmpty())
+					Expect(msg.Detail).NotTo(BeEmpty())
+				}
+			}
+		})
+	})
+})
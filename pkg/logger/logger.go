@@ -2,7 +2,7 @@
 //
 // The logger supports different log levels (debug, info, warn, error) and formats
 // (JSON for production, console for development). It integrates with context.Context
-// to automatically track request IDs across service boundaries.
+// to automatically track request IDs and trace IDs across service boundaries.
 //
 // Example usage:
 //
@@ -37,6 +37,7 @@ import (
 	"context"
 	"fmt"
 
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -128,14 +129,25 @@ func parseLevel(level string) (zapcore.Level, error) {
 	}
 }
 
-// WithContext returns a logger with request ID from context if present
+// WithContext returns a logger with the request ID and the trace and span IDs of the active span
+// from context if present, so that log lines can be correlated with traces
 func (l *Logger) WithContext(ctx context.Context) *Logger {
+	var fields []zap.Field
 	if requestID := GetRequestID(ctx); requestID != "" {
-		return &Logger{
-			Logger: l.With(zap.String("request_id", requestID)),
-		}
+		fields = append(fields, zap.String("request_id", requestID))
+	}
+	if spanCtx := trace.SpanContextFromContext(ctx); spanCtx.IsValid() {
+		fields = append(fields,
+			zap.String("trace_id", spanCtx.TraceID().String()),
+			zap.String("span_id", spanCtx.SpanID().String()),
+		)
+	}
+	if len(fields) == 0 {
+		return l
+	}
+	return &Logger{
+		Logger: l.With(fields...),
 	}
-	return l
 }
 
 // WithRequestID returns a logger with the specified request ID
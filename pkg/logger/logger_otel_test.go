@@ -1,9 +1,13 @@
 package logger_test
 
 import (
+	"context"
+
 	"github.com/fumkob/ezqrin-server/pkg/logger"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 	"go.uber.org/zap/zaptest/observer"
 )
@@ -69,4 +73,26 @@ var _ = Describe("Logger", func() {
 			})
 		})
 	})
+
+	When("using WithContext with an active span", func() {
+		It("should add the trace and span IDs to log lines", func() {
+			observedCore, logs := observer.New(zapcore.InfoLevel)
+			log := &logger.Logger{Logger: zap.New(observedCore)}
+			spanCtx := trace.NewSpanContext(trace.SpanContextConfig{
+				TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6},
+				SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+				TraceFlags: trace.FlagsSampled,
+			})
+			ctx := trace.ContextWithSpanContext(logger.ContextWithRequestID(context.Background(), "req-123"), spanCtx)
+
+			log.WithContext(ctx).Info("traced message")
+
+			Expect(logs.Len()).To(Equal(1))
+			Expect(logs.All()[0].ContextMap()).To(Equal(map[string]interface{}{
+				"request_id": "req-123",
+				"trace_id":   spanCtx.TraceID().String(),
+				"span_id":    spanCtx.SpanID().String(),
+			}))
+		})
+	})
 })
@@ -0,0 +1,30 @@
+package money_test
+
+import (
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Currency", func() {
+	DescribeTable("ValidateCurrency",
+		func(code string, valid bool) {
+			err := money.ValidateCurrency(code)
+			if valid {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(money.IsKnownCurrency(code)).To(BeTrue())
+			} else {
+				Expect(err).To(MatchError(money.ErrUnknownCurrency))
+				Expect(money.IsKnownCurrency(code)).To(BeFalse())
+			}
+		},
+		Entry("JPY", "JPY", true),
+		Entry("USD", "USD", true),
+		Entry("EUR", "EUR", true),
+		Entry("empty", "", false),
+		Entry("lower case", "usd", false),
+		Entry("unknown code", "XYZ", false),
+		Entry("too long", "USDT", false),
+		Entry("too short", "US", false),
+	)
+})
@@ -0,0 +1,173 @@
+// Package money provides an exact monetary amount type for payment data.
+//
+// Amounts are held as integer minor units (hundredths of the major unit) so that
+// sums and comparisons never suffer from floating-point rounding. At the API
+// boundary an Amount is serialized as a decimal string with exactly two
+// fractional digits (e.g. "150.00").
+package money
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Scale is the number of minor units per major unit.
+const Scale = 100
+
+// scaleDigits is the number of fractional digits represented by Scale.
+const scaleDigits = 2
+
+// Common parsing errors
+var (
+	ErrInvalidAmount   = errors.New("invalid money amount")
+	ErrTooManyDecimals = errors.New("money amount must not have more than 2 decimal places")
+	ErrOutOfRange      = errors.New("money amount is out of range")
+)
+
+// Amount is a monetary amount expressed in integer minor units.
+type Amount int64
+
+// FromMinorUnits creates an Amount from integer minor units (e.g. cents).
+func FromMinorUnits(minor int64) Amount {
+	return Amount(minor)
+}
+
+// Parse parses a decimal string such as "150", "150.5" or "-3.25" into an Amount.
+// The value is parsed exactly without going through floating point.
+func Parse(s string) (Amount, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, ErrInvalidAmount
+	}
+
+	negative := false
+	switch s[0] {
+	case '-':
+		negative = true
+		s = s[1:]
+	case '+':
+		s = s[1:]
+	}
+
+	intPart, fracPart, hasDot := strings.Cut(s, ".")
+	if intPart == "" || (hasDot && fracPart == "") || !isDigits(intPart) || !isDigits(fracPart) {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidAmount, s)
+	}
+	if len(fracPart) > scaleDigits {
+		return 0, ErrTooManyDecimals
+	}
+	fracPart += strings.Repeat("0", scaleDigits-len(fracPart))
+
+	major, err := strconv.ParseInt(intPart, 10, 64)
+	if err != nil {
+		return 0, ErrOutOfRange
+	}
+	minor, _ := strconv.ParseInt(fracPart, 10, 64)
+
+	if major > (1<<63-1-minor)/Scale {
+		return 0, ErrOutOfRange
+	}
+	total := major*Scale + minor
+	if negative {
+		total = -total
+	}
+	return Amount(total), nil
+}
+
+// MinorUnits returns the amount in integer minor units.
+func (a Amount) MinorUnits() int64 {
+	return int64(a)
+}
+
+// IsNegative reports whether the amount is below zero.
+func (a Amount) IsNegative() bool {
+	return a < 0
+}
+
+// String formats the amount as a decimal string with two fractional digits.
+func (a Amount) String() string {
+	v := int64(a)
+	sign := ""
+	if v < 0 {
+		sign = "-"
+		v = -v
+	}
+	return fmt.Sprintf("%s%d.%02d", sign, v/Scale, v%Scale)
+}
+
+// MarshalJSON encodes the amount as a decimal string, e.g. "150.00".
+func (a Amount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.String())
+}
+
+// UnmarshalJSON accepts either a decimal string ("150.00") or a JSON number (150.5).
+// Numbers are parsed from their literal text, so no float rounding occurs.
+func (a *Amount) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("null")) {
+		return nil
+	}
+
+	var text string
+	if len(data) > 0 && data[0] == '"' {
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+	} else {
+		text = string(data)
+	}
+
+	parsed, err := Parse(text)
+	if err != nil {
+		return err
+	}
+	*a = parsed
+	return nil
+}
+
+// Value implements the driver.Valuer interface, storing the amount as minor units.
+func (a Amount) Value() (driver.Value, error) {
+	return int64(a), nil
+}
+
+// Scan implements the sql.Scanner interface for minor-unit integer columns.
+func (a *Amount) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case nil:
+		*a = 0
+	case int64:
+		*a = Amount(v)
+	case int32:
+		*a = Amount(v)
+	case []byte:
+		return a.scanString(string(v))
+	case string:
+		return a.scanString(v)
+	default:
+		return fmt.Errorf("cannot scan %T into money.Amount", value)
+	}
+	return nil
+}
+
+func (a *Amount) scanString(s string) error {
+	minor, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return fmt.Errorf("cannot scan %q into money.Amount: %w", s, err)
+	}
+	*a = Amount(minor)
+	return nil
+}
+
+func isDigits(s string) bool {
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
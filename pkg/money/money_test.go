@@ -0,0 +1,105 @@
+package money_test
+
+import (
+	"encoding/json"
+
+	"github.com/fumkob/ezqrin-server/pkg/money"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Amount", func() {
+	Describe("Parse", func() {
+		DescribeTable("valid decimal strings",
+			func(input string, expected int64) {
+				amount, err := money.Parse(input)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(amount.MinorUnits()).To(Equal(expected))
+			},
+			Entry("integer", "150", int64(15000)),
+			Entry("one decimal place", "150.5", int64(15050)),
+			Entry("two decimal places", "150.25", int64(15025)),
+			Entry("zero", "0", int64(0)),
+			Entry("negative", "-3.25", int64(-325)),
+			Entry("explicit plus sign", "+1.10", int64(110)),
+			Entry("surrounding whitespace", " 9.99 ", int64(999)),
+			Entry("value that is inexact as float64", "0.10", int64(10)),
+		)
+
+		DescribeTable("invalid decimal strings",
+			func(input string, expected error) {
+				_, err := money.Parse(input)
+				Expect(err).To(MatchError(expected))
+			},
+			Entry("empty", "", money.ErrInvalidAmount),
+			Entry("letters", "abc", money.ErrInvalidAmount),
+			Entry("trailing dot", "10.", money.ErrInvalidAmount),
+			Entry("leading dot", ".5", money.ErrInvalidAmount),
+			Entry("exponent", "1e2", money.ErrInvalidAmount),
+			Entry("three decimal places", "1.005", money.ErrTooManyDecimals),
+			Entry("overflow", "99999999999999999999", money.ErrOutOfRange),
+		)
+	})
+
+	Describe("String", func() {
+		It("should always render two fractional digits", func() {
+			Expect(money.FromMinorUnits(15000).String()).To(Equal("150.00"))
+			Expect(money.FromMinorUnits(5).String()).To(Equal("0.05"))
+			Expect(money.FromMinorUnits(-325).String()).To(Equal("-3.25"))
+		})
+	})
+
+	Describe("JSON serialization", func() {
+		type payload struct {
+			Amount *money.Amount `json:"amount,omitempty"`
+		}
+
+		It("should marshal as a decimal string", func() {
+			amount := money.FromMinorUnits(15050)
+			data, err := json.Marshal(payload{Amount: &amount})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal(`{"amount":"150.50"}`))
+		})
+
+		It("should unmarshal a decimal string", func() {
+			var p payload
+			Expect(json.Unmarshal([]byte(`{"amount":"150.50"}`), &p)).To(Succeed())
+			Expect(p.Amount.MinorUnits()).To(Equal(int64(15050)))
+		})
+
+		It("should unmarshal a JSON number without float rounding", func() {
+			var p payload
+			Expect(json.Unmarshal([]byte(`{"amount":1234567.89}`), &p)).To(Succeed())
+			Expect(p.Amount.MinorUnits()).To(Equal(int64(123456789)))
+		})
+
+		It("should leave the pointer nil for null", func() {
+			var p payload
+			Expect(json.Unmarshal([]byte(`{"amount":null}`), &p)).To(Succeed())
+			Expect(p.Amount).To(BeNil())
+		})
+
+		It("should reject amounts with more than two decimal places", func() {
+			var p payload
+			Expect(json.Unmarshal([]byte(`{"amount":"1.234"}`), &p)).To(MatchError(money.ErrTooManyDecimals))
+		})
+	})
+
+	Describe("database round trip", func() {
+		It("should store and scan minor units", func() {
+			amount := money.FromMinorUnits(9999)
+			value, err := amount.Value()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(value).To(Equal(int64(9999)))
+
+			var scanned money.Amount
+			Expect(scanned.Scan(value)).To(Succeed())
+			Expect(scanned).To(Equal(amount))
+		})
+
+		It("should reject unsupported source types", func() {
+			var scanned money.Amount
+			Expect(scanned.Scan(1.5)).To(HaveOccurred())
+		})
+	})
+})
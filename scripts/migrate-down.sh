@@ -32,6 +32,6 @@ echo "Rolling back migrations..."
 echo "Database: $DB_USER@$DB_HOST:$DB_PORT/$DB_NAME"
 
 # Rollback one migration step
-go run cmd/migrate/main.go step -1
+go run ./cmd/migrate step -1
 
 echo "Rollback completed successfully!"
@@ -32,6 +32,6 @@ echo "Running migrations..."
 echo "Database: $DB_USER@$DB_HOST:$DB_PORT/$DB_NAME"
 
 # Run migrations
-go run cmd/migrate/main.go up
+go run ./cmd/migrate up
 
 echo "Migrations completed successfully!"
@@ -7,6 +7,7 @@ package e2e_test
 import (
 	"net/http"
 
+	"github.com/fumkob/ezqrin-server/internal/interface/api/generated"
 	"github.com/fumkob/ezqrin-server/test/fixtures"
 	"github.com/fumkob/ezqrin-server/test/testutil"
 	. "github.com/onsi/ginkgo/v2"
@@ -82,7 +83,7 @@ var _ = Describe("Full Event Lifecycle", func() {
 				)
 				Expect(status1).To(Equal(http.StatusOK))
 				Expect(checkin1.ParticipantId.String()).To(Equal(p1.Id.String()))
-				Expect(string(checkin1.CheckinMethod)).To(Equal("qrcode"))
+				Expect(checkin1.CheckinMethod).To(HaveValue(BeEquivalentTo("qrcode")))
 
 				By("Step 6: Check in participant 2 by manual (participant ID, organizer only)")
 				// Manual check-in requires the organizer or admin: staff role is not permitted
@@ -91,7 +92,7 @@ var _ = Describe("Full Event Lifecycle", func() {
 				)
 				Expect(status2).To(Equal(http.StatusOK))
 				Expect(checkin2.ParticipantId.String()).To(Equal(p2.Id.String()))
-				Expect(string(checkin2.CheckinMethod)).To(Equal("manual"))
+				Expect(checkin2.CheckinMethod).To(HaveValue(BeEquivalentTo("manual")))
 
 				By("Step 7: Check in participant 4 by employee ID")
 				checkin4, status4 := helper.CheckInByEmployeeID(
@@ -99,7 +100,7 @@ var _ = Describe("Full Event Lifecycle", func() {
 				)
 				Expect(status4).To(Equal(http.StatusOK))
 				Expect(checkin4.ParticipantId.String()).To(Equal(p4.Id.String()))
-				Expect(string(checkin4.CheckinMethod)).To(Equal("manual"))
+				Expect(checkin4.CheckinMethod).To(HaveValue(BeEquivalentTo("manual")))
 
 				By("Step 8: Verify event statistics reflect 3 check-ins out of 4 participants")
 				stats, statsStatus := helper.GetEventStats(organizerAuth.AccessToken, eventID)
@@ -115,11 +116,13 @@ var _ = Describe("Full Event Lifecycle", func() {
 				Expect(status3).To(Equal(http.StatusOK))
 				Expect(checkin3.ParticipantId.String()).To(Equal(p3.Id.String()))
 
-				By("Step 10: Duplicate check-in should be rejected")
-				_, dupStatus := helper.CheckInByQR(
+				By("Step 10: Duplicate check-in should be reported as already checked in")
+				dup, dupStatus := helper.CheckInByQR(
 					organizerAuth.AccessToken, eventID, *p1.QrCode,
 				)
-				Expect(dupStatus).To(Equal(http.StatusConflict))
+				Expect(dupStatus).To(Equal(http.StatusOK))
+				Expect(dup.Outcome).To(Equal(generated.CheckInOutcomeAlreadyCheckedIn))
+				Expect(dup.Id).To(BeNil())
 			})
 		})
 	})